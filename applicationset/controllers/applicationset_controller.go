@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"reflect"
 	"runtime/debug"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -66,6 +67,8 @@ const (
 	//   https://github.com/argoproj-labs/argocd-notifications/blob/33d345fa838829bb50fca5c08523aba380d2c12b/pkg/controller/state.go#L17
 	NotifiedAnnotationKey             = "notified.notifications.argoproj.io"
 	ReconcileRequeueOnValidationError = time.Minute * 3
+	// ReconcileRequeueOnRollingUpdate is the maximum duration between two reconciles of a RollingUpdate in progress
+	ReconcileRequeueOnRollingUpdate = time.Minute
 )
 
 var defaultPreservedAnnotations = []string{
@@ -266,6 +269,30 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		}
 	}
 
+	rolloutComplete := true
+	if r.EnableProgressiveSyncs && isRollingUpdateStrategy(&applicationSetInfo) &&
+		utils.DefaultPolicy(applicationSetInfo.Spec.SyncPolicy, r.Policy, r.EnablePolicyOverride).AllowUpdate() {
+		// hold back the changes of the Applications which are not part of the current stage of the rollout
+		var rolloutCondition argov1alpha1.ApplicationSetCondition
+		validApps, rolloutCondition, rolloutComplete, err = r.performRollingUpdate(logCtx, applicationSetInfo, currentApplications, validApps)
+		if err != nil {
+			_ = r.setApplicationSetStatusCondition(ctx,
+				&applicationSetInfo,
+				argov1alpha1.ApplicationSetCondition{
+					Type:    argov1alpha1.ApplicationSetConditionErrorOccurred,
+					Message: err.Error(),
+					Reason:  argov1alpha1.ApplicationSetReasonUpdateApplicationError,
+					Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
+				}, parametersGenerated,
+			)
+			return ctrl.Result{RequeueAfter: ReconcileRequeueOnValidationError}, nil
+		}
+		_ = r.setApplicationSetStatusCondition(ctx, &applicationSetInfo, rolloutCondition, parametersGenerated)
+	}
+
+	// the paused Applications are excluded from the reconciliation
+	validApps = filterPausedApplications(logCtx, currentApplications, validApps)
+
 	if utils.DefaultPolicy(applicationSetInfo.Spec.SyncPolicy, r.Policy, r.EnablePolicyOverride).AllowUpdate() {
 		err = r.createOrUpdateInCluster(ctx, logCtx, applicationSetInfo, validApps)
 		if err != nil {
@@ -349,6 +376,11 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		requeueAfter = ReconcileRequeueOnValidationError
 	}
 
+	if !rolloutComplete && (requeueAfter == time.Duration(0) || requeueAfter > ReconcileRequeueOnRollingUpdate) {
+		// Ensure that the rollout progresses even if no Application event is received.
+		requeueAfter = ReconcileRequeueOnRollingUpdate
+	}
+
 	logCtx.WithField("requeueAfter", requeueAfter).Info("end reconcile in ", time.Since(startReconcile))
 
 	return ctrl.Result{
@@ -423,7 +455,7 @@ func (r *ApplicationSetReconciler) setApplicationSetStatusCondition(ctx context.
 	}
 	newConditions := []argov1alpha1.ApplicationSetCondition{errOccurredCondition, paramtersGeneratedCondition, resourceUpToDateCondition}
 
-	if progressiveSyncsRollingSyncStrategyEnabled(applicationSet) {
+	if progressiveSyncsRollingSyncStrategyEnabled(applicationSet) || isRollingUpdateStrategy(applicationSet) {
 		evaluatedTypes[argov1alpha1.ApplicationSetConditionRolloutProgressing] = true
 
 		if condition.Type == argov1alpha1.ApplicationSetConditionRolloutProgressing {
@@ -480,6 +512,9 @@ func (r *ApplicationSetReconciler) setApplicationSetStatusCondition(ctx context.
 func (r *ApplicationSetReconciler) validateGeneratedApplications(ctx context.Context, desiredApplications []argov1alpha1.Application, applicationSetInfo argov1alpha1.ApplicationSet) (map[int]error, error) {
 	errorsByIndex := map[int]error{}
 	namesSet := map[string]bool{}
+	// the applications of the cluster, listed when a project with a quota is found
+	var apps []*argov1alpha1.Application
+	appsListed := false
 	for i, app := range desiredApplications {
 		if namesSet[app.Name] {
 			errorsByIndex[i] = fmt.Errorf("ApplicationSet %s contains applications with duplicate name: %s", applicationSetInfo.Name, app.Name)
@@ -501,6 +536,29 @@ func (r *ApplicationSetReconciler) validateGeneratedApplications(ctx context.Con
 			errorsByIndex[i] = fmt.Errorf("application destination spec is invalid: %s", err.Error())
 			continue
 		}
+
+		if appProject.Spec.Quota != nil {
+			if !appsListed {
+				appList := &argov1alpha1.ApplicationList{}
+				if err := r.List(ctx, appList); err != nil {
+					return nil, fmt.Errorf("error listing applications: %w", err)
+				}
+				for j := range appList.Items {
+					apps = append(apps, &appList.Items[j])
+				}
+				appsListed = true
+			}
+			if err := appProject.CheckQuota(apps, &desiredApplications[i]); err != nil {
+				errorsByIndex[i] = fmt.Errorf("application exceeds the project quota: %s", err.Error())
+				continue
+			}
+			if !slices.ContainsFunc(apps, func(a *argov1alpha1.Application) bool {
+				return a.Name == app.Name && a.Namespace == app.Namespace
+			}) {
+				// the next applications are checked against the usage including the new application
+				apps = append(apps, &desiredApplications[i])
+			}
+		}
 	}
 
 	return errorsByIndex, nil
@@ -743,6 +801,26 @@ func (r *ApplicationSetReconciler) deleteInCluster(ctx context.Context, logCtx *
 		logCtx = logCtx.WithField("app", app.QualifiedName())
 		_, exists := m[app.Name]
 
+		if !exists && isApplicationPaused(&app) {
+			logCtx.Info("Not deleting paused application")
+			continue
+		}
+
+		if !exists && applicationSet.Spec.SyncPolicy != nil && applicationSet.Spec.SyncPolicy.OrphanPolicy == argov1alpha1.ApplicationSetOrphanPolicyOrphan {
+			err := r.orphanApplication(ctx, applicationSet, &app)
+			if err != nil {
+				logCtx.WithError(err).Error("failed to orphan Application")
+				if firstError == nil {
+					firstError = err
+				}
+				continue
+			}
+			r.Metrics.IncOrphanedApplications(&applicationSet)
+			r.Recorder.Eventf(&applicationSet, corev1.EventTypeNormal, "Orphaned", "Orphaned Application %q", app.Name)
+			logCtx.Log(log.InfoLevel, "Orphaned application")
+			continue
+		}
+
 		if !exists {
 			// Removes the Argo CD resources finalizer if the application contains an invalid target (eg missing cluster)
 			err := r.removeFinalizerOnInvalidDestination(ctx, applicationSet, &app, clusterList, logCtx)
@@ -769,6 +847,34 @@ func (r *ApplicationSetReconciler) deleteInCluster(ctx context.Context, logCtx *
 	return firstError
 }
 
+// isApplicationPaused returns true if the Application is excluded from the reconciliation of its ApplicationSet
+func isApplicationPaused(app *argov1alpha1.Application) bool {
+	return app.Annotations[common.AnnotationApplicationSetPaused] == "true"
+}
+
+// filterPausedApplications removes the generated Applications which are paused in the cluster
+func filterPausedApplications(logCtx *log.Entry, currentApplications []argov1alpha1.Application, desiredApplications []argov1alpha1.Application) []argov1alpha1.Application {
+	paused := map[string]bool{}
+	for i := range currentApplications {
+		if isApplicationPaused(&currentApplications[i]) {
+			paused[currentApplications[i].Name] = true
+		}
+	}
+	if len(paused) == 0 {
+		return desiredApplications
+	}
+
+	var apps []argov1alpha1.Application
+	for _, app := range desiredApplications {
+		if paused[app.Name] {
+			logCtx.WithField("app", app.QualifiedName()).Info("Not updating paused application")
+			continue
+		}
+		apps = append(apps, app)
+	}
+	return apps
+}
+
 // removeFinalizerOnInvalidDestination removes the Argo CD resources finalizer if the application contains an invalid target (eg missing cluster)
 func (r *ApplicationSetReconciler) removeFinalizerOnInvalidDestination(ctx context.Context, applicationSet argov1alpha1.ApplicationSet, app *argov1alpha1.Application, clusterList []utils.ClusterSpecifier, appLog *log.Entry) error {
 	// Only check if the finalizers need to be removed IF there are finalizers to remove
@@ -837,6 +943,29 @@ func (r *ApplicationSetReconciler) removeFinalizerOnInvalidDestination(ctx conte
 	return nil
 }
 
+// orphanApplication removes the ownership of the ApplicationSet on an Application which is no longer generated,
+// instead of deleting it. The Application is annotated with the name of the ApplicationSet.
+func (r *ApplicationSetReconciler) orphanApplication(ctx context.Context, applicationSet argov1alpha1.ApplicationSet, app *argov1alpha1.Application) error {
+	updated := app.DeepCopy()
+	var ownerReferences []metav1.OwnerReference
+	for _, ownerReference := range app.OwnerReferences {
+		if ownerReference.Kind == application.ApplicationSetKind && ownerReference.Name == applicationSet.Name {
+			continue
+		}
+		ownerReferences = append(ownerReferences, ownerReference)
+	}
+	updated.OwnerReferences = ownerReferences
+	if updated.Annotations == nil {
+		updated.Annotations = map[string]string{}
+	}
+	updated.Annotations[common.AnnotationApplicationSetOrphaned] = applicationSet.Name
+
+	if err := r.Patch(ctx, updated, client.MergeFrom(app)); err != nil {
+		return fmt.Errorf("error removing the owner reference: %w", err)
+	}
+	return nil
+}
+
 func (r *ApplicationSetReconciler) removeOwnerReferencesOnDeleteAppSet(ctx context.Context, applicationSet argov1alpha1.ApplicationSet) error {
 	applications, err := r.getCurrentApplications(ctx, applicationSet)
 	if err != nil {
@@ -901,23 +1030,7 @@ func (r *ApplicationSetReconciler) buildAppDependencyList(logCtx *log.Entry, app
 	// use applicationLabelSelectors to filter generated Applications into steps and status by name
 	for _, app := range applications {
 		for i, step := range steps {
-			selected := true // default to true, assuming the current Application is a match for the given step matchExpression
-
-			for _, matchExpression := range step.MatchExpressions {
-				if val, ok := app.Labels[matchExpression.Key]; ok {
-					valueMatched := labelMatchedExpression(logCtx, val, matchExpression)
-
-					if !valueMatched { // none of the matchExpression values was a match with the Application's labels
-						selected = false
-						break
-					}
-				} else if matchExpression.Operator == "In" {
-					selected = false // no matching label key with "In" operator means this Application will not be included in the current step
-					break
-				}
-			}
-
-			if selected {
+			if appMatchesRolloutStep(logCtx, app, step) {
 				appDependencyList[i] = append(appDependencyList[i], app.Name)
 				if val, ok := appStepMap[app.Name]; ok {
 					logCtx.Warnf("AppSet '%v' has a invalid matchExpression that selects Application '%v' label twice, in steps %v and %v", applicationSet.Name, app.Name, val+1, i+1)
@@ -931,6 +1044,20 @@ func (r *ApplicationSetReconciler) buildAppDependencyList(logCtx *log.Entry, app
 	return appDependencyList, appStepMap
 }
 
+// appMatchesRolloutStep returns whether the labels of the Application match all the matchExpressions of the step
+func appMatchesRolloutStep(logCtx *log.Entry, app argov1alpha1.Application, step argov1alpha1.ApplicationSetRolloutStep) bool {
+	for _, matchExpression := range step.MatchExpressions {
+		if val, ok := app.Labels[matchExpression.Key]; ok {
+			if !labelMatchedExpression(logCtx, val, matchExpression) { // none of the matchExpression values was a match with the Application's labels
+				return false
+			}
+		} else if matchExpression.Operator == "In" {
+			return false // no matching label key with "In" operator means this Application will not be included in the current step
+		}
+	}
+	return true
+}
+
 func labelMatchedExpression(logCtx *log.Entry, val string, matchExpression argov1alpha1.ApplicationMatchExpression) bool {
 	if matchExpression.Operator != "In" && matchExpression.Operator != "NotIn" {
 		logCtx.Errorf("skipping AppSet rollingUpdate step Application selection, invalid matchExpression operator provided: %q ", matchExpression.Operator)
@@ -1310,6 +1437,7 @@ func (r *ApplicationSetReconciler) updateResourcesStatus(ctx context.Context, lo
 		return statuses[i].Name < statuses[j].Name
 	})
 	appset.Status.Resources = statuses
+	appset.Status.ApplicationsSummary = status.BuildApplicationsSummary(statuses)
 	// DefaultRetry will retry 5 times with a backoff factor of 1, jitter of 0.1 and a duration of 10ms
 	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		namespacedName := types.NamespacedName{Namespace: appset.Namespace, Name: appset.Name}
@@ -1322,6 +1450,7 @@ func (r *ApplicationSetReconciler) updateResourcesStatus(ctx context.Context, lo
 		}
 
 		updatedAppset.Status.Resources = appset.Status.Resources
+		updatedAppset.Status.ApplicationsSummary = appset.Status.ApplicationsSummary
 
 		// Update the newly fetched object with new status resources
 		err := r.Client.Status().Update(ctx, updatedAppset)
@@ -1768,6 +1768,56 @@ func TestDeleteInCluster(t *testing.T) {
 				},
 			},
 		},
+		{
+			appSet: v1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "name",
+					Namespace: "namespace",
+				},
+				Spec: v1alpha1.ApplicationSetSpec{
+					Template: v1alpha1.ApplicationSetTemplate{
+						Spec: v1alpha1.ApplicationSpec{
+							Project: "project",
+						},
+					},
+				},
+			},
+			existingApps: []v1alpha1.Application{
+				{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       application.ApplicationKind,
+						APIVersion: "argoproj.io/v1alpha1",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "paused",
+						Namespace:       "namespace",
+						ResourceVersion: "2",
+						Annotations:     map[string]string{argocommon.AnnotationApplicationSetPaused: "true"},
+					},
+					Spec: v1alpha1.ApplicationSpec{
+						Project: "project",
+					},
+				},
+			},
+			desiredApps: []v1alpha1.Application{},
+			expected: []v1alpha1.Application{
+				{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       application.ApplicationKind,
+						APIVersion: "argoproj.io/v1alpha1",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "paused",
+						Namespace:       "namespace",
+						ResourceVersion: "2",
+						Annotations:     map[string]string{argocommon.AnnotationApplicationSetPaused: "true"},
+					},
+					Spec: v1alpha1.ApplicationSpec{
+						Project: "project",
+					},
+				},
+			},
+		},
 	} {
 		initObjs := []crtclient.Object{&c.appSet}
 		for _, a := range c.existingApps {
@@ -1818,6 +1868,89 @@ func TestDeleteInCluster(t *testing.T) {
 	}
 }
 
+func TestDeleteInClusterOrphanPolicy(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "name",
+			Namespace: "namespace",
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			SyncPolicy: &v1alpha1.ApplicationSetSyncPolicy{
+				OrphanPolicy: v1alpha1.ApplicationSetOrphanPolicyOrphan,
+			},
+			Template: v1alpha1.ApplicationSetTemplate{
+				Spec: v1alpha1.ApplicationSpec{
+					Project: "project",
+				},
+			},
+		},
+	}
+	initObjs := []crtclient.Object{&appSet}
+	for _, name := range []string{"orphan", "keep"} {
+		app := &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "namespace",
+			},
+			Spec: v1alpha1.ApplicationSpec{
+				Project: "project",
+			},
+		}
+		err = controllerutil.SetControllerReference(&appSet, app, scheme)
+		require.NoError(t, err)
+		initObjs = append(initObjs, app)
+	}
+
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(initObjs...).WithIndex(&v1alpha1.Application{}, ".metadata.controller", appControllerIndexer).Build()
+	r := ApplicationSetReconciler{
+		Client:        client,
+		Scheme:        scheme,
+		Recorder:      record.NewFakeRecorder(len(initObjs)),
+		KubeClientset: kubefake.NewSimpleClientset(),
+		Metrics:       appsetmetrics.NewFakeAppsetMetrics(),
+	}
+
+	err = r.deleteInCluster(t.Context(), log.NewEntry(log.StandardLogger()), appSet, []v1alpha1.Application{
+		{ObjectMeta: metav1.ObjectMeta{Name: "keep"}},
+	})
+	require.NoError(t, err)
+
+	orphaned := &v1alpha1.Application{}
+	err = client.Get(t.Context(), crtclient.ObjectKey{Namespace: "namespace", Name: "orphan"}, orphaned)
+	require.NoError(t, err)
+	assert.Empty(t, orphaned.OwnerReferences)
+	assert.Equal(t, "name", orphaned.Annotations[argocommon.AnnotationApplicationSetOrphaned])
+
+	kept := &v1alpha1.Application{}
+	err = client.Get(t.Context(), crtclient.ObjectKey{Namespace: "namespace", Name: "keep"}, kept)
+	require.NoError(t, err)
+	assert.Len(t, kept.OwnerReferences, 1)
+
+	current, err := r.getCurrentApplications(t.Context(), appSet)
+	require.NoError(t, err)
+	assert.Len(t, current, 1)
+}
+
+func TestFilterPausedApplications(t *testing.T) {
+	currentApps := []v1alpha1.Application{
+		{ObjectMeta: metav1.ObjectMeta{Name: "paused", Annotations: map[string]string{argocommon.AnnotationApplicationSetPaused: "true"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "running"}},
+	}
+	desiredApps := []v1alpha1.Application{
+		{ObjectMeta: metav1.ObjectMeta{Name: "paused"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "running"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "new"}},
+	}
+
+	apps := filterPausedApplications(log.NewEntry(log.StandardLogger()), currentApps, desiredApps)
+
+	assert.Equal(t, []v1alpha1.Application{desiredApps[1], desiredApps[2]}, apps)
+}
+
 func TestGetMinRequeueAfter(t *testing.T) {
 	scheme := runtime.NewScheme()
 	err := v1alpha1.AddToScheme(scheme)
@@ -2107,6 +2240,67 @@ func TestValidateGeneratedApplications(t *testing.T) {
 	}
 }
 
+func TestValidateGeneratedApplicationsProjectQuota(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	project := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: "argocd"},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []v1alpha1.ApplicationDestination{{Namespace: "*", Server: "*"}},
+			Quota:        &v1alpha1.ProjectQuota{MaxApplications: 2},
+		},
+	}
+	newApp := func(name string) v1alpha1.Application {
+		return v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+			Spec: v1alpha1.ApplicationSpec{
+				Project:     "quota",
+				Source:      &v1alpha1.ApplicationSource{RepoURL: "https://url", Path: "/", TargetRevision: "HEAD"},
+				Destination: v1alpha1.ApplicationDestination{Namespace: "namespace", Name: "my-cluster"},
+			},
+		}
+	}
+	existingApp := newApp("app1")
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(project, &existingApp).Build()
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-secret",
+			Namespace: "argocd",
+			Labels: map[string]string{
+				argocommon.LabelKeySecretType: argocommon.LabelValueSecretTypeCluster,
+			},
+		},
+		Data: map[string][]byte{
+			"name":   []byte("my-cluster"),
+			"server": []byte("https://kubernetes.default.svc"),
+			"config": []byte("{\"username\":\"foo\",\"password\":\"foo\"}"),
+		},
+	}
+	kubeclientset := getDefaultTestClientSet(secret)
+	argodb := db.NewDB("argocd", settings.NewSettingsManager(t.Context(), kubeclientset, "argocd"), kubeclientset)
+
+	r := ApplicationSetReconciler{
+		Client:          client,
+		Scheme:          scheme,
+		Recorder:        record.NewFakeRecorder(1),
+		Generators:      map[string]generators.Generator{},
+		ArgoDB:          argodb,
+		ArgoCDNamespace: "argocd",
+		KubeClientset:   kubeclientset,
+		Metrics:         appsetmetrics.NewFakeAppsetMetrics(),
+	}
+
+	validationErrors, err := r.validateGeneratedApplications(t.Context(), []v1alpha1.Application{newApp("app1"), newApp("app2"), newApp("app3")}, v1alpha1.ApplicationSet{})
+	require.NoError(t, err)
+	assert.Equal(t, map[int]error{
+		2: errors.New("application exceeds the project quota: project 'quota' has reached its quota of 2 applications"),
+	}, validationErrors)
+}
+
 func TestReconcilerValidationProjectErrorBehaviour(t *testing.T) {
 	scheme := runtime.NewScheme()
 	err := v1alpha1.AddToScheme(scheme)
@@ -6317,6 +6511,51 @@ func TestUpdateResourceStatus(t *testing.T) {
 	}
 }
 
+func TestUpdateResourceStatusApplicationsSummary(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "name",
+			Namespace: "argocd",
+		},
+	}
+	apps := generateNHealthyApps(3)
+	apps[1].Status.Sync.Status = v1alpha1.SyncStatusCodeOutOfSync
+	apps[1].Status.Health.Status = health.HealthStatusDegraded
+	apps[2].Status.Health.Status = health.HealthStatusDegraded
+	apps = append(apps, v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: "app3"}})
+
+	kubeclientset := kubefake.NewSimpleClientset()
+	client := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&appSet).WithObjects(&appSet).Build()
+	r := ApplicationSetReconciler{
+		Client:        client,
+		Scheme:        scheme,
+		Recorder:      record.NewFakeRecorder(1),
+		Generators:    map[string]generators.Generator{},
+		ArgoDB:        db.NewDB("argocd", settings.NewSettingsManager(t.Context(), kubeclientset, "argocd"), kubeclientset),
+		KubeClientset: kubeclientset,
+		Metrics:       appsetmetrics.NewFakeAppsetMetrics(),
+	}
+
+	err = r.updateResourcesStatus(t.Context(), log.NewEntry(log.StandardLogger()), &appSet, apps)
+	require.NoError(t, err)
+
+	expected := &v1alpha1.ApplicationSetApplicationsSummary{
+		Total:    4,
+		Health:   map[string]int64{"Healthy": 1, "Degraded": 2, "Unknown": 1},
+		Sync:     map[string]int64{"Synced": 2, "OutOfSync": 1, "Unknown": 1},
+		Degraded: []string{"app1", "app2"},
+	}
+	assert.Equal(t, expected, appSet.Status.ApplicationsSummary)
+
+	updatedAppSet := &v1alpha1.ApplicationSet{}
+	require.NoError(t, client.Get(t.Context(), types.NamespacedName{Namespace: "argocd", Name: "name"}, updatedAppSet))
+	assert.Equal(t, expected, updatedAppSet.Status.ApplicationsSummary)
+}
+
 func generateNAppResourceStatuses(n int) []v1alpha1.ResourceStatus {
 	var r []v1alpha1.ResourceStatus
 	for i := 0; i < n; i++ {
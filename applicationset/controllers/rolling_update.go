@@ -0,0 +1,184 @@
+package controllers
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func isRollingUpdateStrategy(appset *argov1alpha1.ApplicationSet) bool {
+	// It's only RollingUpdate if the type specifically sets it
+	return appset.Spec.Strategy != nil && appset.Spec.Strategy.Type == "RollingUpdate" && appset.Spec.Strategy.RollingUpdate != nil
+}
+
+// applicationTemplateHash returns the hash of the fields of the Application owned by the ApplicationSet controller,
+// which is used to detect the Applications not updated yet to the generated Application.
+func applicationTemplateHash(app argov1alpha1.Application) (string, error) {
+	data, err := json.Marshal(struct {
+		Spec        argov1alpha1.ApplicationSpec `json:"spec"`
+		Labels      map[string]string            `json:"labels,omitempty"`
+		Annotations map[string]string            `json:"annotations,omitempty"`
+		Finalizers  []string                     `json:"finalizers,omitempty"`
+	}{app.Spec, app.Labels, app.Annotations, app.Finalizers})
+	if err != nil {
+		return "", fmt.Errorf("error marshaling application %s: %w", app.Name, err)
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(data)), nil
+}
+
+// scaledRollingUpdateValue returns the value of the number or percentage of the given total, or the default if unset.
+func scaledRollingUpdateValue(value *intstr.IntOrString, total int, defaultValue int) (int, error) {
+	if value == nil {
+		return defaultValue, nil
+	}
+	scaled, err := intstr.GetScaledValueFromIntOrPercent(value, total, false)
+	if err != nil {
+		return 0, err
+	}
+	// ensure that percentage values greater than 0% always result in at least 1 Application
+	if value.Type == intstr.String && value.StrVal != "0%" && scaled < 1 {
+		scaled = 1
+	}
+	return scaled, nil
+}
+
+// performRollingUpdate returns the generated Applications to create or update with the RollingUpdate strategy, and
+// the RolloutProgressing condition of the rollout. New and up to date Applications are always returned, while the
+// changed Applications are returned only once the Applications of the previous steps are Healthy, by at most
+// maxConcurrent Applications being updated at the same time. No Application is updated while more than maxUnhealthy
+// Applications are Degraded. The returned complete is false while the rollout is in progress.
+func (r *ApplicationSetReconciler) performRollingUpdate(logCtx *log.Entry, applicationSet argov1alpha1.ApplicationSet, currentApplications []argov1alpha1.Application, desiredApplications []argov1alpha1.Application) ([]argov1alpha1.Application, argov1alpha1.ApplicationSetCondition, bool, error) {
+	strategy := applicationSet.Spec.Strategy.RollingUpdate
+	steps := strategy.Steps
+
+	currentAppMap := map[string]argov1alpha1.Application{}
+	for _, app := range currentApplications {
+		currentAppMap[app.Name] = app
+	}
+
+	total := len(desiredApplications)
+	maxConcurrent, err := scaledRollingUpdateValue(strategy.MaxConcurrent, total, 1)
+	if err != nil {
+		return nil, argov1alpha1.ApplicationSetCondition{}, false, fmt.Errorf("invalid maxConcurrent value %q: %w", strategy.MaxConcurrent.String(), err)
+	}
+	maxUnhealthy, err := scaledRollingUpdateValue(strategy.MaxUnhealthy, total, 0)
+	if err != nil {
+		return nil, argov1alpha1.ApplicationSetCondition{}, false, fmt.Errorf("invalid maxUnhealthy value %q: %w", strategy.MaxUnhealthy.String(), err)
+	}
+
+	// the Applications matching no step are in the last stage, after the steps
+	appStage := func(app argov1alpha1.Application) int {
+		for i, step := range steps {
+			if appMatchesRolloutStep(logCtx, app, step) {
+				return i
+			}
+		}
+		return len(steps)
+	}
+
+	apps := make([]argov1alpha1.Application, 0, len(desiredApplications))
+	pendingByStage := map[int][]argov1alpha1.Application{}
+	inFlightByStage := map[int]int{}
+	stageTotal := map[int]int{}
+	unhealthy := 0
+	pending := 0
+	inFlight := 0
+	for _, app := range desiredApplications {
+		hash, err := applicationTemplateHash(app)
+		if err != nil {
+			return nil, argov1alpha1.ApplicationSetCondition{}, false, err
+		}
+		if app.Annotations == nil {
+			app.Annotations = map[string]string{}
+		}
+		app.Annotations[common.AnnotationApplicationSetTemplateHash] = hash
+
+		stage := appStage(app)
+		stageTotal[stage]++
+		current, ok := currentAppMap[app.Name]
+		if !ok {
+			// new Applications are created right away
+			apps = append(apps, app)
+			continue
+		}
+		if current.Status.Health.Status == health.HealthStatusDegraded {
+			unhealthy++
+		}
+		if current.Annotations[common.AnnotationApplicationSetTemplateHash] != hash {
+			pendingByStage[stage] = append(pendingByStage[stage], app)
+			pending++
+			continue
+		}
+		apps = append(apps, app)
+		if current.Status.Health.Status != health.HealthStatusHealthy {
+			inFlightByStage[stage]++
+			inFlight++
+		}
+	}
+
+	if pending == 0 && inFlight == 0 {
+		return apps, argov1alpha1.ApplicationSetCondition{
+			Type:    argov1alpha1.ApplicationSetConditionRolloutProgressing,
+			Message: "ApplicationSet Rollout has completed",
+			Reason:  argov1alpha1.ApplicationSetReasonApplicationSetRolloutComplete,
+			Status:  argov1alpha1.ApplicationSetConditionStatusFalse,
+		}, true, nil
+	}
+
+	if unhealthy > maxUnhealthy {
+		logCtx.Infof("Rollout of ApplicationSet %v is paused, %v Applications are Degraded", applicationSet.Name, unhealthy)
+		return apps, argov1alpha1.ApplicationSetCondition{
+			Type:    argov1alpha1.ApplicationSetConditionRolloutProgressing,
+			Message: fmt.Sprintf("ApplicationSet Rollout is paused, %d Applications are Degraded (maxUnhealthy %d), %d Applications are pending", unhealthy, maxUnhealthy, pending),
+			Reason:  argov1alpha1.ApplicationSetReasonApplicationSetRolloutPaused,
+			Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
+		}, false, nil
+	}
+
+	// the current stage is the first one with Applications not updated yet or not Healthy yet
+	stage := len(steps)
+	for i := 0; i < len(steps); i++ {
+		if len(pendingByStage[i]) > 0 || inFlightByStage[i] > 0 {
+			stage = i
+			break
+		}
+	}
+
+	limit := maxConcurrent - inFlight
+	if stage < len(steps) && steps[stage].MaxUpdate != nil {
+		maxUpdate, err := scaledRollingUpdateValue(steps[stage].MaxUpdate, stageTotal[stage], maxConcurrent)
+		if err != nil {
+			return nil, argov1alpha1.ApplicationSetCondition{}, false, fmt.Errorf("invalid maxUpdate value %q of step %d: %w", steps[stage].MaxUpdate.String(), stage+1, err)
+		}
+		limit = maxUpdate - inFlightByStage[stage]
+	}
+
+	stagePending := pendingByStage[stage]
+	sort.Slice(stagePending, func(i, j int) bool {
+		return stagePending[i].Name < stagePending[j].Name
+	})
+	updating := 0
+	for _, app := range stagePending {
+		if updating >= limit {
+			break
+		}
+		logCtx.Infof("updating Application %v in step %v of the rollout of ApplicationSet %v", app.Name, stage+1, applicationSet.Name)
+		apps = append(apps, app)
+		updating++
+	}
+
+	return apps, argov1alpha1.ApplicationSetCondition{
+		Type:    argov1alpha1.ApplicationSetConditionRolloutProgressing,
+		Message: fmt.Sprintf("ApplicationSet Rollout is updating step %d, %d Applications are updating, %d Applications are pending", stage+1, inFlight+updating, pending-updating),
+		Reason:  argov1alpha1.ApplicationSetReasonApplicationSetModified,
+		Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
+	}, false, nil
+}
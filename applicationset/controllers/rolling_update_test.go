@@ -0,0 +1,227 @@
+package controllers
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestPerformRollingUpdate(t *testing.T) {
+	newApp := func(name string, env string, revision string) v1alpha1.Application {
+		app := v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+			Spec: v1alpha1.ApplicationSpec{
+				Source: &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", TargetRevision: revision},
+			},
+		}
+		if env != "" {
+			app.Labels = map[string]string{"env": env}
+		}
+		return app
+	}
+	desiredApps := []v1alpha1.Application{
+		newApp("dev-2", "dev", "v2"),
+		newApp("dev-1", "dev", "v2"),
+		newApp("prod-1", "prod", "v2"),
+		newApp("other", "", "v2"),
+	}
+	// currentApp returns the current Application of a desired Application, up to date or to the previous revision
+	currentApp := func(t *testing.T, name string, upToDate bool, healthStatus health.HealthStatusCode) v1alpha1.Application {
+		t.Helper()
+		for _, desired := range desiredApps {
+			if desired.Name != name {
+				continue
+			}
+			app := *desired.DeepCopy()
+			if !upToDate {
+				app.Spec.Source.TargetRevision = "v1"
+			}
+			hash, err := applicationTemplateHash(app)
+			require.NoError(t, err)
+			app.Annotations = map[string]string{common.AnnotationApplicationSetTemplateHash: hash}
+			app.Status.Health.Status = healthStatus
+			return app
+		}
+		t.Fatalf("unknown application %s", name)
+		return v1alpha1.Application{}
+	}
+	newAppSet := func(maxConcurrent *intstr.IntOrString, maxUnhealthy *intstr.IntOrString) v1alpha1.ApplicationSet {
+		return v1alpha1.ApplicationSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "argocd"},
+			Spec: v1alpha1.ApplicationSetSpec{
+				Strategy: &v1alpha1.ApplicationSetStrategy{
+					Type: "RollingUpdate",
+					RollingUpdate: &v1alpha1.ApplicationSetRollingUpdateStrategy{
+						Steps: []v1alpha1.ApplicationSetRolloutStep{
+							{MatchExpressions: []v1alpha1.ApplicationMatchExpression{{Key: "env", Operator: "In", Values: []string{"dev"}}}},
+							{MatchExpressions: []v1alpha1.ApplicationMatchExpression{{Key: "env", Operator: "In", Values: []string{"prod"}}}},
+						},
+						MaxConcurrent: maxConcurrent,
+						MaxUnhealthy:  maxUnhealthy,
+					},
+				},
+			},
+		}
+	}
+	percent := intstr.FromString("50%")
+	one := intstr.FromInt(1)
+
+	for _, cc := range []struct {
+		name             string
+		appSet           v1alpha1.ApplicationSet
+		currentApps      func(t *testing.T) []v1alpha1.Application
+		expectedApps     []string
+		expectedReason   string
+		expectedComplete bool
+	}{
+		{
+			name:   "creates the new applications right away",
+			appSet: newAppSet(nil, nil),
+			currentApps: func(_ *testing.T) []v1alpha1.Application {
+				return nil
+			},
+			expectedApps:     []string{"dev-1", "dev-2", "other", "prod-1"},
+			expectedReason:   v1alpha1.ApplicationSetReasonApplicationSetRolloutComplete,
+			expectedComplete: true,
+		},
+		{
+			name:   "updates one application of the first step by default",
+			appSet: newAppSet(nil, nil),
+			currentApps: func(t *testing.T) []v1alpha1.Application {
+				return []v1alpha1.Application{
+					currentApp(t, "dev-1", false, health.HealthStatusHealthy),
+					currentApp(t, "dev-2", false, health.HealthStatusHealthy),
+					currentApp(t, "prod-1", false, health.HealthStatusHealthy),
+					currentApp(t, "other", false, health.HealthStatusHealthy),
+				}
+			},
+			expectedApps:   []string{"dev-1"},
+			expectedReason: v1alpha1.ApplicationSetReasonApplicationSetModified,
+		},
+		{
+			name:   "waits for the updated application to be healthy",
+			appSet: newAppSet(nil, nil),
+			currentApps: func(t *testing.T) []v1alpha1.Application {
+				return []v1alpha1.Application{
+					currentApp(t, "dev-1", true, health.HealthStatusProgressing),
+					currentApp(t, "dev-2", false, health.HealthStatusHealthy),
+					currentApp(t, "prod-1", false, health.HealthStatusHealthy),
+					currentApp(t, "other", false, health.HealthStatusHealthy),
+				}
+			},
+			expectedApps:   []string{"dev-1"},
+			expectedReason: v1alpha1.ApplicationSetReasonApplicationSetModified,
+		},
+		{
+			name:   "updates the next step once the previous step is healthy",
+			appSet: newAppSet(nil, nil),
+			currentApps: func(t *testing.T) []v1alpha1.Application {
+				return []v1alpha1.Application{
+					currentApp(t, "dev-1", true, health.HealthStatusHealthy),
+					currentApp(t, "dev-2", true, health.HealthStatusHealthy),
+					currentApp(t, "prod-1", false, health.HealthStatusHealthy),
+					currentApp(t, "other", false, health.HealthStatusHealthy),
+				}
+			},
+			expectedApps:   []string{"dev-1", "dev-2", "prod-1"},
+			expectedReason: v1alpha1.ApplicationSetReasonApplicationSetModified,
+		},
+		{
+			name:   "updates the applications matching no step last",
+			appSet: newAppSet(nil, nil),
+			currentApps: func(t *testing.T) []v1alpha1.Application {
+				return []v1alpha1.Application{
+					currentApp(t, "dev-1", true, health.HealthStatusHealthy),
+					currentApp(t, "dev-2", true, health.HealthStatusHealthy),
+					currentApp(t, "prod-1", true, health.HealthStatusHealthy),
+					currentApp(t, "other", false, health.HealthStatusHealthy),
+				}
+			},
+			expectedApps:   []string{"dev-1", "dev-2", "other", "prod-1"},
+			expectedReason: v1alpha1.ApplicationSetReasonApplicationSetModified,
+		},
+		{
+			name:   "updates a percentage of the applications at the same time",
+			appSet: newAppSet(&percent, nil),
+			currentApps: func(t *testing.T) []v1alpha1.Application {
+				return []v1alpha1.Application{
+					currentApp(t, "dev-1", false, health.HealthStatusHealthy),
+					currentApp(t, "dev-2", false, health.HealthStatusHealthy),
+					currentApp(t, "prod-1", false, health.HealthStatusHealthy),
+					currentApp(t, "other", false, health.HealthStatusHealthy),
+				}
+			},
+			expectedApps:   []string{"dev-1", "dev-2"},
+			expectedReason: v1alpha1.ApplicationSetReasonApplicationSetModified,
+		},
+		{
+			name:   "pauses when too many applications are degraded",
+			appSet: newAppSet(nil, nil),
+			currentApps: func(t *testing.T) []v1alpha1.Application {
+				return []v1alpha1.Application{
+					currentApp(t, "dev-1", true, health.HealthStatusDegraded),
+					currentApp(t, "dev-2", false, health.HealthStatusHealthy),
+					currentApp(t, "prod-1", false, health.HealthStatusHealthy),
+					currentApp(t, "other", false, health.HealthStatusHealthy),
+				}
+			},
+			expectedApps:   []string{"dev-1"},
+			expectedReason: v1alpha1.ApplicationSetReasonApplicationSetRolloutPaused,
+		},
+		{
+			name:   "tolerates maxUnhealthy degraded applications",
+			appSet: newAppSet(&percent, &one),
+			currentApps: func(t *testing.T) []v1alpha1.Application {
+				return []v1alpha1.Application{
+					currentApp(t, "dev-1", true, health.HealthStatusDegraded),
+					currentApp(t, "dev-2", false, health.HealthStatusHealthy),
+					currentApp(t, "prod-1", false, health.HealthStatusHealthy),
+					currentApp(t, "other", false, health.HealthStatusHealthy),
+				}
+			},
+			expectedApps:   []string{"dev-1", "dev-2"},
+			expectedReason: v1alpha1.ApplicationSetReasonApplicationSetModified,
+		},
+		{
+			name:   "completes when all the applications are updated and healthy",
+			appSet: newAppSet(nil, nil),
+			currentApps: func(t *testing.T) []v1alpha1.Application {
+				return []v1alpha1.Application{
+					currentApp(t, "dev-1", true, health.HealthStatusHealthy),
+					currentApp(t, "dev-2", true, health.HealthStatusHealthy),
+					currentApp(t, "prod-1", true, health.HealthStatusHealthy),
+					currentApp(t, "other", true, health.HealthStatusHealthy),
+				}
+			},
+			expectedApps:     []string{"dev-1", "dev-2", "other", "prod-1"},
+			expectedReason:   v1alpha1.ApplicationSetReasonApplicationSetRolloutComplete,
+			expectedComplete: true,
+		},
+	} {
+		t.Run(cc.name, func(t *testing.T) {
+			r := ApplicationSetReconciler{}
+			apps, condition, complete, err := r.performRollingUpdate(log.NewEntry(log.StandardLogger()), cc.appSet, cc.currentApps(t), desiredApps)
+			require.NoError(t, err)
+
+			var names []string
+			for _, app := range apps {
+				names = append(names, app.Name)
+				assert.NotEmpty(t, app.Annotations[common.AnnotationApplicationSetTemplateHash])
+			}
+			sort.Strings(names)
+			assert.Equal(t, cc.expectedApps, names)
+			assert.Equal(t, v1alpha1.ApplicationSetConditionRolloutProgressing, condition.Type)
+			assert.Equal(t, cc.expectedReason, condition.Reason)
+			assert.Equal(t, cc.expectedComplete, complete)
+		})
+	}
+}
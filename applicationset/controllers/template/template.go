@@ -63,6 +63,23 @@ func GenerateApplications(logCtx *log.Entry, applicationSetInfo argov1alpha1.App
 					app = patchedApplication
 				}
 
+				// The template patch file of the directory of the Application in the Git repository, if any
+				if templatePatch, ok := p[generators.TemplatePatchParam].(string); ok && templatePatch != "" && usesTemplatePatchFile(requestedGenerator) {
+					patchedApplication, err := applyTemplatePatch(app, templatePatch)
+					if err != nil {
+						log.WithError(err).WithField("params", a.Params).WithField("generator", requestedGenerator).
+							Error("error applying the template patch file to application")
+
+						if firstError == nil {
+							firstError = fmt.Errorf("error applying the template patch file to application %s: %w", app.Name, err)
+							applicationSetReason = argov1alpha1.ApplicationSetReasonRenderTemplateParamsError
+						}
+						continue
+					}
+
+					app = patchedApplication
+				}
+
 				// The app's namespace must be the same as the AppSet's namespace to preserve the appsets-in-any-namespace
 				// security boundary.
 				app.Namespace = applicationSetInfo.Namespace
@@ -79,6 +96,38 @@ func GenerateApplications(logCtx *log.Entry, applicationSetInfo argov1alpha1.App
 	return res, applicationSetReason, firstError
 }
 
+// usesTemplatePatchFile returns whether the generator, or one of its child generators, is a Git generator reading
+// template patch files. The template patch param is ignored otherwise, so that the params of the other generators,
+// e.g. the titles of pull requests, cannot patch the generated Applications.
+func usesTemplatePatchFile(generator argov1alpha1.ApplicationSetGenerator) bool {
+	if generator.Git != nil && generator.Git.TemplatePatchFile != "" {
+		return true
+	}
+	var children []argov1alpha1.ApplicationSetNestedGenerator
+	if generator.Matrix != nil {
+		children = append(children, generator.Matrix.Generators...)
+	}
+	if generator.Merge != nil {
+		children = append(children, generator.Merge.Generators...)
+	}
+	for _, child := range children {
+		if usesTemplatePatchFile(argov1alpha1.ApplicationSetGenerator{Git: child.Git}) {
+			return true
+		}
+		if nestedMatrix, err := argov1alpha1.ToNestedMatrixGenerator(child.Matrix); err == nil && nestedMatrix != nil {
+			if usesTemplatePatchFile(argov1alpha1.ApplicationSetGenerator{Matrix: nestedMatrix.ToMatrixGenerator()}) {
+				return true
+			}
+		}
+		if nestedMerge, err := argov1alpha1.ToNestedMergeGenerator(child.Merge); err == nil && nestedMerge != nil {
+			if usesTemplatePatchFile(argov1alpha1.ApplicationSetGenerator{Merge: nestedMerge.ToMergeGenerator()}) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func renderTemplatePatch(r utils.Renderer, app *argov1alpha1.Application, applicationSetInfo argov1alpha1.ApplicationSet, params map[string]any) (*argov1alpha1.Application, error) {
 	replacedTemplate, err := r.Replace(*applicationSetInfo.Spec.TemplatePatch, params, applicationSetInfo.Spec.GoTemplate, applicationSetInfo.Spec.GoTemplateOptions)
 	if err != nil {
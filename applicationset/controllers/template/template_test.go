@@ -10,6 +10,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -348,3 +349,85 @@ func TestGenerateAppsUsingPullRequestGenerator(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateApplicationsWithTemplatePatchFile(t *testing.T) {
+	params := []map[string]any{{
+		"name": "app1",
+		generators.TemplatePatchParam: `
+metadata:
+  labels:
+    team: platform
+spec:
+  project: other
+`,
+	}}
+	generate := func(generator v1alpha1.ApplicationSetGenerator, name string) []v1alpha1.Application {
+		t.Helper()
+		generatorMock := genmock.Generator{}
+		generatorMock.On("GenerateParams", &generator, mock.AnythingOfType("*v1alpha1.ApplicationSet"), mock.Anything).
+			Return(params, nil)
+		generatorMock.On("GetTemplate", &generator).
+			Return(&v1alpha1.ApplicationSetTemplate{})
+
+		rendererMock := rendmock.Renderer{}
+		rendererMock.On("RenderTemplateParams", mock.Anything, mock.Anything, params[0], false, []string(nil)).
+			Return(&v1alpha1.Application{
+				ObjectMeta: metav1.ObjectMeta{Name: "app1"},
+				Spec:       v1alpha1.ApplicationSpec{Project: "default"},
+			}, nil)
+
+		got, _, err := GenerateApplications(log.NewEntry(log.StandardLogger()), v1alpha1.ApplicationSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "namespace"},
+			Spec: v1alpha1.ApplicationSetSpec{
+				Generators: []v1alpha1.ApplicationSetGenerator{generator},
+			},
+		},
+			map[string]generators.Generator{name: &generatorMock},
+			&rendererMock,
+			nil,
+		)
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		return got
+	}
+
+	t.Run("git generator with a template patch file", func(t *testing.T) {
+		got := generate(v1alpha1.ApplicationSetGenerator{
+			Git: &v1alpha1.GitGenerator{RepoURL: "https://github.com/org/repo", TemplatePatchFile: ".argocd-appset-patch.yaml"},
+		}, "Git")
+		assert.Equal(t, map[string]string{"team": "platform"}, got[0].Labels)
+		// the project of the Application can't be changed by the template patch file
+		assert.Equal(t, "default", got[0].Spec.Project)
+	})
+	t.Run("git generator without a template patch file", func(t *testing.T) {
+		got := generate(v1alpha1.ApplicationSetGenerator{
+			Git: &v1alpha1.GitGenerator{RepoURL: "https://github.com/org/repo"},
+		}, "Git")
+		assert.Empty(t, got[0].Labels)
+	})
+	t.Run("other generator", func(t *testing.T) {
+		got := generate(v1alpha1.ApplicationSetGenerator{
+			List: &v1alpha1.ListGenerator{},
+		}, "List")
+		assert.Empty(t, got[0].Labels)
+	})
+}
+
+func TestUsesTemplatePatchFile(t *testing.T) {
+	git := &v1alpha1.GitGenerator{RepoURL: "https://github.com/org/repo", TemplatePatchFile: ".argocd-appset-patch.yaml"}
+	assert.True(t, usesTemplatePatchFile(v1alpha1.ApplicationSetGenerator{Git: git}))
+	assert.False(t, usesTemplatePatchFile(v1alpha1.ApplicationSetGenerator{Git: &v1alpha1.GitGenerator{RepoURL: git.RepoURL}}))
+	assert.False(t, usesTemplatePatchFile(v1alpha1.ApplicationSetGenerator{List: &v1alpha1.ListGenerator{}}))
+	assert.True(t, usesTemplatePatchFile(v1alpha1.ApplicationSetGenerator{Matrix: &v1alpha1.MatrixGenerator{
+		Generators: []v1alpha1.ApplicationSetNestedGenerator{{List: &v1alpha1.ListGenerator{}}, {Git: git}},
+	}}))
+	assert.True(t, usesTemplatePatchFile(v1alpha1.ApplicationSetGenerator{Merge: &v1alpha1.MergeGenerator{
+		Generators: []v1alpha1.ApplicationSetNestedGenerator{
+			{List: &v1alpha1.ListGenerator{}},
+			{Matrix: &apiextensionsv1.JSON{Raw: []byte(`{"generators": [{"list": {"elements": []}}, {"git": {"repoURL": "https://github.com/org/repo", "revision": "HEAD", "templatePatchFile": "patch.yaml"}}]}`)}},
+		},
+	}}))
+	assert.False(t, usesTemplatePatchFile(v1alpha1.ApplicationSetGenerator{Matrix: &v1alpha1.MatrixGenerator{
+		Generators: []v1alpha1.ApplicationSetNestedGenerator{{List: &v1alpha1.ListGenerator{}}, {Plugin: &v1alpha1.PluginGenerator{}}},
+	}}))
+}
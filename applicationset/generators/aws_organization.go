@@ -0,0 +1,153 @@
+package generators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/aws_organization"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+var _ Generator = (*AWSOrganizationGenerator)(nil)
+
+const (
+	DefaultAWSOrganizationRequeueAfter = 30 * time.Minute
+)
+
+type AWSOrganizationGenerator struct {
+	newServiceFunc func(*argoprojiov1alpha1.AWSOrganizationGenerator) (aws_organization.OrganizationService, error)
+}
+
+func NewAWSOrganizationGenerator() Generator {
+	return &AWSOrganizationGenerator{
+		newServiceFunc: newAWSOrganizationService,
+	}
+}
+
+func newAWSOrganizationService(generatorConfig *argoprojiov1alpha1.AWSOrganizationGenerator) (aws_organization.OrganizationService, error) {
+	var clusterRoleName string
+	if generatorConfig.EKSClusters != nil {
+		clusterRoleName = generatorConfig.EKSClusters.RoleName
+	}
+	return aws_organization.NewAWSOrganizationService(generatorConfig.Role, generatorConfig.OrganizationalUnits, clusterRoleName)
+}
+
+func (g *AWSOrganizationGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) time.Duration {
+	// Return a requeue default of 30 minutes, if no default is specified.
+
+	if appSetGenerator.AWSOrganization.RequeueAfterSeconds != nil {
+		return time.Duration(*appSetGenerator.AWSOrganization.RequeueAfterSeconds) * time.Second
+	}
+
+	return DefaultAWSOrganizationRequeueAfter
+}
+
+func (g *AWSOrganizationGenerator) GetTemplate(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) *argoprojiov1alpha1.ApplicationSetTemplate {
+	return &appSetGenerator.AWSOrganization.Template
+}
+
+func (g *AWSOrganizationGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
+	if appSetGenerator == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	if appSetGenerator.AWSOrganization == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	generatorConfig := appSetGenerator.AWSOrganization
+	eksClusters := generatorConfig.EKSClusters
+	if eksClusters != nil && (eksClusters.RoleName == "" || len(eksClusters.Regions) == 0) {
+		return nil, errors.New("the role name and the regions of the EKS clusters are required")
+	}
+
+	ctx := context.Background()
+	svc, err := g.newServiceFunc(generatorConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing AWS Organization service: %w", err)
+	}
+	accounts, err := svc.ListAccounts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing AWS Organization accounts: %w", err)
+	}
+
+	useGoTemplate := applicationSetInfo.Spec.GoTemplate
+	paramsArray := make([]map[string]any, 0, len(accounts))
+	for _, account := range accounts {
+		if !awsAccountMatchesTagFilters(account, generatorConfig.TagFilters) {
+			continue
+		}
+		accountParams := map[string]any{
+			"id":    account.ID,
+			"name":  account.Name,
+			"email": account.Email,
+			"arn":   account.ARN,
+		}
+		if eksClusters == nil {
+			params := map[string]any{}
+			appendAWSParams(params, "account", accountParams, account.Tags, useGoTemplate)
+			if err := appendTemplatedValues(generatorConfig.Values, params, useGoTemplate, applicationSetInfo.Spec.GoTemplateOptions); err != nil {
+				return nil, fmt.Errorf("failed to append templated values: %w", err)
+			}
+			paramsArray = append(paramsArray, params)
+			continue
+		}
+
+		clusters, err := svc.ListClusters(ctx, account, eksClusters.Regions)
+		if err != nil {
+			return nil, fmt.Errorf("error listing EKS clusters: %w", err)
+		}
+		for _, cluster := range clusters {
+			params := map[string]any{}
+			appendAWSParams(params, "account", accountParams, account.Tags, useGoTemplate)
+			appendAWSParams(params, "cluster", map[string]any{
+				"name":     cluster.Name,
+				"arn":      cluster.ARN,
+				"endpoint": cluster.Endpoint,
+				"region":   cluster.Region,
+				"version":  cluster.Version,
+				"caData":   cluster.CAData,
+			}, cluster.Tags, useGoTemplate)
+			if err := appendTemplatedValues(generatorConfig.Values, params, useGoTemplate, applicationSetInfo.Spec.GoTemplateOptions); err != nil {
+				return nil, fmt.Errorf("failed to append templated values: %w", err)
+			}
+			paramsArray = append(paramsArray, params)
+		}
+	}
+	return paramsArray, nil
+}
+
+// awsAccountMatchesTagFilters returns whether the account has all the tags of the filters. An empty filter value
+// matches any value of the tag.
+func awsAccountMatchesTagFilters(account *aws_organization.Account, tagFilters []*argoprojiov1alpha1.TagFilter) bool {
+	for _, tagFilter := range tagFilters {
+		value, ok := account.Tags[tagFilter.Key]
+		if !ok || (tagFilter.Value != "" && value != tagFilter.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+// appendAWSParams adds the fields and the tags of an AWS account or cluster to the params, as a nested map with Go
+// templates or as dot-separated keys otherwise, e.g. account.id and account.tags.env.
+func appendAWSParams(params map[string]any, prefix string, fields map[string]any, tags map[string]string, useGoTemplate bool) {
+	if useGoTemplate {
+		nested := map[string]any{"tags": tags}
+		for key, value := range fields {
+			nested[key] = value
+		}
+		params[prefix] = nested
+		return
+	}
+	for key, value := range fields {
+		params[prefix+"."+key] = value
+	}
+	for key, value := range tags {
+		params[prefix+".tags."+key] = value
+	}
+}
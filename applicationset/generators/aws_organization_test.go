@@ -0,0 +1,139 @@
+package generators
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/aws_organization"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestAWSOrganizationGenerateParams(t *testing.T) {
+	accounts := []*aws_organization.Account{{
+		ID:    "111111111111",
+		Name:  "dev",
+		Email: "dev@example.com",
+		ARN:   "arn:aws:organizations::000000000000:account/o-example/111111111111",
+		Tags:  map[string]string{"env": "dev", "team": "platform"},
+	}, {
+		ID:    "222222222222",
+		Name:  "prod",
+		Email: "prod@example.com",
+		ARN:   "arn:aws:organizations::000000000000:account/o-example/222222222222",
+		Tags:  map[string]string{"env": "prod"},
+	}}
+	clusters := map[string][]*aws_organization.Cluster{
+		"222222222222": {{
+			Name:     "prod-eu",
+			ARN:      "arn:aws:eks:eu-west-1:222222222222:cluster/prod-eu",
+			Endpoint: "https://prod-eu.eks.amazonaws.com",
+			Region:   "eu-west-1",
+			Version:  "1.31",
+			CAData:   "Y2EtZGF0YQ==",
+			Tags:     map[string]string{"tier": "critical"},
+		}, {
+			Name:   "prod-us",
+			Region: "us-east-1",
+		}},
+	}
+
+	cases := []struct {
+		name        string
+		generator   *argoprojiov1alpha1.AWSOrganizationGenerator
+		goTemplate  bool
+		listError   error
+		expected    []map[string]any
+		expectedErr string
+	}{
+		{
+			name:      "Accounts",
+			generator: &argoprojiov1alpha1.AWSOrganizationGenerator{Values: map[string]string{"stage": "{{account.tags.env}}"}},
+			expected: []map[string]any{{
+				"account.id":        "111111111111",
+				"account.name":      "dev",
+				"account.email":     "dev@example.com",
+				"account.arn":       "arn:aws:organizations::000000000000:account/o-example/111111111111",
+				"account.tags.env":  "dev",
+				"account.tags.team": "platform",
+				"values.stage":      "dev",
+			}, {
+				"account.id":       "222222222222",
+				"account.name":     "prod",
+				"account.email":    "prod@example.com",
+				"account.arn":      "arn:aws:organizations::000000000000:account/o-example/222222222222",
+				"account.tags.env": "prod",
+				"values.stage":     "prod",
+			}},
+		},
+		{
+			name: "TagFilters",
+			generator: &argoprojiov1alpha1.AWSOrganizationGenerator{TagFilters: []*argoprojiov1alpha1.TagFilter{
+				{Key: "team"},
+				{Key: "env", Value: "dev"},
+			}},
+			goTemplate: true,
+			expected: []map[string]any{{
+				"account": map[string]any{
+					"id":    "111111111111",
+					"name":  "dev",
+					"email": "dev@example.com",
+					"arn":   "arn:aws:organizations::000000000000:account/o-example/111111111111",
+					"tags":  map[string]string{"env": "dev", "team": "platform"},
+				},
+			}},
+		},
+		{
+			name: "EKSClusters",
+			generator: &argoprojiov1alpha1.AWSOrganizationGenerator{EKSClusters: &argoprojiov1alpha1.AWSOrganizationEKSClusters{
+				RoleName: "argocd-discovery",
+				Regions:  []string{"eu-west-1"},
+			}},
+			expected: []map[string]any{{
+				"account.id":        "222222222222",
+				"account.name":      "prod",
+				"account.email":     "prod@example.com",
+				"account.arn":       "arn:aws:organizations::000000000000:account/o-example/222222222222",
+				"account.tags.env":  "prod",
+				"cluster.name":      "prod-eu",
+				"cluster.arn":       "arn:aws:eks:eu-west-1:222222222222:cluster/prod-eu",
+				"cluster.endpoint":  "https://prod-eu.eks.amazonaws.com",
+				"cluster.region":    "eu-west-1",
+				"cluster.version":   "1.31",
+				"cluster.caData":    "Y2EtZGF0YQ==",
+				"cluster.tags.tier": "critical",
+			}},
+		},
+		{
+			name:        "EKSClustersWithoutRegions",
+			generator:   &argoprojiov1alpha1.AWSOrganizationGenerator{EKSClusters: &argoprojiov1alpha1.AWSOrganizationEKSClusters{RoleName: "argocd-discovery"}},
+			expectedErr: "the role name and the regions of the EKS clusters are required",
+		},
+		{
+			name:        "ListError",
+			generator:   &argoprojiov1alpha1.AWSOrganizationGenerator{},
+			listError:   errors.New("access denied"),
+			expectedErr: "error listing AWS Organization accounts: access denied",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			gen := &AWSOrganizationGenerator{
+				newServiceFunc: func(*argoprojiov1alpha1.AWSOrganizationGenerator) (aws_organization.OrganizationService, error) {
+					return aws_organization.NewFakeService(accounts, clusters, c.listError)
+				},
+			}
+			appSet := &argoprojiov1alpha1.ApplicationSet{Spec: argoprojiov1alpha1.ApplicationSetSpec{GoTemplate: c.goTemplate}}
+			params, err := gen.GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{AWSOrganization: c.generator}, appSet, nil)
+			if c.expectedErr != "" {
+				require.EqualError(t, err, c.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, params)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/bmatcuk/doublestar/v4"
 	"github.com/jeremywohl/flatten"
 	log "github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/types"
@@ -23,6 +24,10 @@ import (
 
 var _ Generator = (*GitGenerator)(nil)
 
+// TemplatePatchParam is the param holding the content of the template patch file of the directory of the generated
+// Application, which is applied to the generated Application.
+const TemplatePatchParam = "_templatePatch"
+
 type GitGenerator struct {
 	repos     services.Repos
 	namespace string
@@ -78,7 +83,7 @@ func (g *GitGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.Applic
 			return nil, fmt.Errorf("error getting project %s: %w", project, err)
 		}
 		// we need to verify the signature on the Git revision if GPG is enabled
-		verifyCommit = len(appProject.Spec.SignatureKeys) > 0 && gpg.IsGPGEnabled()
+		verifyCommit = isSignatureRequired(appSetGenerator.Git, appProject) && gpg.IsGPGEnabled()
 	}
 
 	// If the project field is templated, we cannot resolve the project name, so we pass an empty string to the repo-server.
@@ -102,9 +107,47 @@ func (g *GitGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.Applic
 	return res, nil
 }
 
+// isSignatureRequired returns whether the project requires signed commits for any of the paths read by the git
+// generator. A path with wildcards may match any path requiring a signed commit, so it requires one as soon as the
+// project has signature keys.
+func isSignatureRequired(gen *argoprojiov1alpha1.GitGenerator, proj *argoprojiov1alpha1.AppProject) bool {
+	var paths []string
+	for _, dir := range gen.Directories {
+		if !dir.Exclude {
+			paths = append(paths, dir.Path)
+		}
+	}
+	for _, file := range gen.Files {
+		if !file.Exclude {
+			paths = append(paths, file.Path, path.Dir(file.Path))
+		}
+	}
+	for _, p := range paths {
+		if strings.ContainsAny(p, "*?[{") {
+			if len(proj.Spec.SignatureKeys) > 0 {
+				return true
+			}
+			continue
+		}
+		if proj.IsSignatureRequired(p) {
+			return true
+		}
+	}
+	return false
+}
+
 func (g *GitGenerator) generateParamsForGitDirectories(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, noRevisionCache, verifyCommit, useGoTemplate bool, project string, goTemplateOptions []string) ([]map[string]any, error) {
-	// Directories, not files
-	allPaths, err := g.repos.GetDirectories(context.TODO(), appSetGenerator.Git.RepoURL, appSetGenerator.Git.Revision, project, noRevisionCache, verifyCommit)
+	// Directories, not files. The repo server only returns the directories matching the requested paths, which are
+	// still filtered below in case the repo server doesn't support it.
+	var includePaths, excludePaths []string
+	for _, requestedPath := range appSetGenerator.Git.Directories {
+		if requestedPath.Exclude {
+			excludePaths = append(excludePaths, requestedPath.Path)
+		} else {
+			includePaths = append(includePaths, requestedPath.Path)
+		}
+	}
+	allPaths, err := g.repos.GetDirectories(context.TODO(), appSetGenerator.Git.RepoURL, appSetGenerator.Git.Revision, project, includePaths, excludePaths, noRevisionCache, verifyCommit)
 	if err != nil {
 		return nil, fmt.Errorf("error getting directories from repo: %w", err)
 	}
@@ -124,18 +167,65 @@ func (g *GitGenerator) generateParamsForGitDirectories(appSetGenerator *argoproj
 		return nil, fmt.Errorf("error generating params from apps: %w", err)
 	}
 
+	templatePatches, err := g.getTemplatePatches(appSetGenerator, noRevisionCache, verifyCommit, project)
+	if err != nil {
+		return nil, err
+	}
+	for i, appPath := range requestedApps {
+		if templatePatch, ok := templatePatches[appPath]; ok {
+			res[i][TemplatePatchParam] = templatePatch
+		}
+	}
+
 	return res, nil
 }
 
+// getTemplatePatches returns the content of the template patch files of the repository by directory, if the generator
+// has a template patch file.
+func (g *GitGenerator) getTemplatePatches(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, noRevisionCache, verifyCommit bool, project string) (map[string]string, error) {
+	templatePatchFile := appSetGenerator.Git.TemplatePatchFile
+	if templatePatchFile == "" {
+		return nil, nil
+	}
+	if strings.ContainsAny(templatePatchFile, "/*?[") {
+		return nil, fmt.Errorf("invalid template patch file %q, it must be a file name", templatePatchFile)
+	}
+	files, err := g.repos.GetFiles(context.TODO(), appSetGenerator.Git.RepoURL, appSetGenerator.Git.Revision, project, "**/"+templatePatchFile, noRevisionCache, verifyCommit)
+	if err != nil {
+		return nil, fmt.Errorf("error getting template patch files from repo: %w", err)
+	}
+	templatePatches := make(map[string]string, len(files))
+	for filePath, content := range files {
+		// the pattern may also match the files ending with the file name
+		if path.Base(filePath) == templatePatchFile {
+			templatePatches[path.Dir(filePath)] = string(content)
+		}
+	}
+	return templatePatches, nil
+}
+
 func (g *GitGenerator) generateParamsForGitFiles(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, noRevisionCache, verifyCommit, useGoTemplate bool, project string, goTemplateOptions []string) ([]map[string]any, error) {
-	// Get all files that match the requested path string, removing duplicates
+	var excludePaths []string
+	for _, requestedPath := range appSetGenerator.Git.Files {
+		if requestedPath.Exclude {
+			excludePaths = append(excludePaths, requestedPath.Path)
+		}
+	}
+
+	// Get all files that match the requested path string, removing duplicates and excluded files
 	allFiles := make(map[string][]byte)
 	for _, requestedPath := range appSetGenerator.Git.Files {
+		if requestedPath.Exclude {
+			continue
+		}
 		files, err := g.repos.GetFiles(context.TODO(), appSetGenerator.Git.RepoURL, appSetGenerator.Git.Revision, project, requestedPath.Path, noRevisionCache, verifyCommit)
 		if err != nil {
 			return nil, err
 		}
 		for filePath, content := range files {
+			if isGitFileExcluded(filePath, excludePaths) {
+				continue
+			}
 			allFiles[filePath] = content
 		}
 	}
@@ -148,13 +238,23 @@ func (g *GitGenerator) generateParamsForGitFiles(appSetGenerator *argoprojiov1al
 	}
 	sort.Strings(allPaths)
 
+	templatePatches, err := g.getTemplatePatches(appSetGenerator, noRevisionCache, verifyCommit, project)
+	if err != nil {
+		return nil, err
+	}
+
 	// Generate params from each path, and return
 	res := []map[string]any{}
-	for _, path := range allPaths {
+	for _, filePath := range allPaths {
 		// A JSON / YAML file path can contain multiple sets of parameters (ie it is an array)
-		paramsArray, err := g.generateParamsFromGitFile(path, allFiles[path], appSetGenerator.Git.Values, useGoTemplate, goTemplateOptions, appSetGenerator.Git.PathParamPrefix)
+		paramsArray, err := g.generateParamsFromGitFile(filePath, allFiles[filePath], appSetGenerator.Git.Values, useGoTemplate, goTemplateOptions, appSetGenerator.Git.PathParamPrefix)
 		if err != nil {
-			return nil, fmt.Errorf("unable to process file '%s': %w", path, err)
+			return nil, fmt.Errorf("unable to process file '%s': %w", filePath, err)
+		}
+		if templatePatch, ok := templatePatches[path.Dir(filePath)]; ok {
+			for _, params := range paramsArray {
+				params[TemplatePatchParam] = templatePatch
+			}
 		}
 
 		res = append(res, paramsArray...)
@@ -238,6 +338,22 @@ func (g *GitGenerator) generateParamsFromGitFile(filePath string, fileContent []
 	return res, nil
 }
 
+// isGitFileExcluded returns whether the file matches one of the exclude patterns
+func isGitFileExcluded(filePath string, excludePaths []string) bool {
+	for _, excludePath := range excludePaths {
+		match, err := doublestar.Match(excludePath, filePath)
+		if err != nil {
+			log.WithError(err).WithField("excludePath", excludePath).
+				WithField("filePath", filePath).Error("error while matching filePath to excludePath")
+			continue
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
 func (g *GitGenerator) filterApps(directories []argoprojiov1alpha1.GitDirectoryGeneratorItem, allPaths []string) []string {
 	res := []string{}
 	for _, appPath := range allPaths {
@@ -321,7 +321,7 @@ func TestGitGenerateParamsFromDirectories(t *testing.T) {
 
 			argoCDServiceMock := mocks.Repos{}
 
-			argoCDServiceMock.On("GetDirectories", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(testCaseCopy.repoApps, testCaseCopy.repoError)
+			argoCDServiceMock.On("GetDirectories", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(testCaseCopy.repoApps, testCaseCopy.repoError)
 
 			gitGenerator := NewGitGenerator(&argoCDServiceMock, "")
 			applicationSetInfo := v1alpha1.ApplicationSet{
@@ -622,7 +622,7 @@ func TestGitGenerateParamsFromDirectoriesGoTemplate(t *testing.T) {
 
 			argoCDServiceMock := mocks.Repos{}
 
-			argoCDServiceMock.On("GetDirectories", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(testCaseCopy.repoApps, testCaseCopy.repoError)
+			argoCDServiceMock.On("GetDirectories", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(testCaseCopy.repoApps, testCaseCopy.repoError)
 
 			gitGenerator := NewGitGenerator(&argoCDServiceMock, "")
 			applicationSetInfo := v1alpha1.ApplicationSet{
@@ -736,6 +736,29 @@ func TestGitGenerateParamsFromFiles(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			name:  "excludes the files matching an exclude path",
+			files: []v1alpha1.GitFileGeneratorItem{{Path: "**/config.json"}, {Path: "cluster-config/**/staging/*", Exclude: true}},
+			repoFileContents: map[string][]byte{
+				"cluster-config/production/config.json":    []byte(`{"cluster": {"name": "production"}}`),
+				"cluster-config/eu/staging/config.json":    []byte(`{"cluster": {"name": "staging"}}`),
+				"cluster-config/eu/us/staging/config.json": []byte(`{"cluster": {"name": "staging"}}`),
+			},
+			repoPathsError: nil,
+			expected: []map[string]any{
+				{
+					"cluster.name":            "production",
+					"path":                    "cluster-config/production",
+					"path.basename":           "production",
+					"path[0]":                 "cluster-config",
+					"path[1]":                 "production",
+					"path.basenameNormalized": "production",
+					"path.filename":           "config.json",
+					"path.filenameNormalized": "config.json",
+				},
+			},
+			expectedError: nil,
+		},
 		{
 			name:  "Value variable interpolation",
 			files: []v1alpha1.GitFileGeneratorItem{{Path: "**/config.json"}},
@@ -1384,6 +1407,96 @@ cluster:
 	}
 }
 
+func TestGitGenerateParamsWithTemplatePatchFile(t *testing.T) {
+	patchFiles := map[string][]byte{
+		"apps/app1/.argocd-appset-patch.yaml":     []byte("spec:\n  syncPolicy: {}\n"),
+		"apps/app2/not-.argocd-appset-patch.yaml": []byte("ignored"),
+	}
+
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&v1alpha1.AppProject{}).Build()
+
+	t.Run("directories", func(t *testing.T) {
+		argoCDServiceMock := mocks.Repos{}
+		argoCDServiceMock.On("GetDirectories", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return([]string{"apps/app1", "apps/app2"}, nil)
+		argoCDServiceMock.On("GetFiles", mock.Anything, mock.Anything, mock.Anything, mock.Anything, "**/.argocd-appset-patch.yaml", mock.Anything, mock.Anything).
+			Return(patchFiles, nil)
+
+		gitGenerator := NewGitGenerator(&argoCDServiceMock, "")
+		appSetGenerator := &v1alpha1.ApplicationSetGenerator{
+			Git: &v1alpha1.GitGenerator{
+				RepoURL:           "RepoURL",
+				Revision:          "Revision",
+				Directories:       []v1alpha1.GitDirectoryGeneratorItem{{Path: "apps/*"}},
+				TemplatePatchFile: ".argocd-appset-patch.yaml",
+			},
+		}
+		applicationSetInfo := &v1alpha1.ApplicationSet{Spec: v1alpha1.ApplicationSetSpec{GoTemplate: true}}
+
+		got, err := gitGenerator.GenerateParams(appSetGenerator, applicationSetInfo, client)
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		assert.Equal(t, "spec:\n  syncPolicy: {}\n", got[0][TemplatePatchParam])
+		assert.NotContains(t, got[1], TemplatePatchParam)
+		argoCDServiceMock.AssertExpectations(t)
+	})
+
+	t.Run("files", func(t *testing.T) {
+		argoCDServiceMock := mocks.Repos{}
+		argoCDServiceMock.On("GetFiles", mock.Anything, mock.Anything, mock.Anything, mock.Anything, "apps/*/config.json", mock.Anything, mock.Anything).
+			Return(map[string][]byte{
+				"apps/app1/config.json": []byte(`{"name": "app1"}`),
+				"apps/app2/config.json": []byte(`{"name": "app2"}`),
+			}, nil)
+		argoCDServiceMock.On("GetFiles", mock.Anything, mock.Anything, mock.Anything, mock.Anything, "**/.argocd-appset-patch.yaml", mock.Anything, mock.Anything).
+			Return(patchFiles, nil)
+
+		gitGenerator := NewGitGenerator(&argoCDServiceMock, "")
+		appSetGenerator := &v1alpha1.ApplicationSetGenerator{
+			Git: &v1alpha1.GitGenerator{
+				RepoURL:           "RepoURL",
+				Revision:          "Revision",
+				Files:             []v1alpha1.GitFileGeneratorItem{{Path: "apps/*/config.json"}},
+				TemplatePatchFile: ".argocd-appset-patch.yaml",
+			},
+		}
+		applicationSetInfo := &v1alpha1.ApplicationSet{Spec: v1alpha1.ApplicationSetSpec{GoTemplate: true}}
+
+		got, err := gitGenerator.GenerateParams(appSetGenerator, applicationSetInfo, client)
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		for _, params := range got {
+			if params["name"] == "app1" {
+				assert.Equal(t, "spec:\n  syncPolicy: {}\n", params[TemplatePatchParam])
+			} else {
+				assert.NotContains(t, params, TemplatePatchParam)
+			}
+		}
+		argoCDServiceMock.AssertExpectations(t)
+	})
+
+	t.Run("invalid file name", func(t *testing.T) {
+		argoCDServiceMock := mocks.Repos{}
+		argoCDServiceMock.On("GetDirectories", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return([]string{"apps/app1"}, nil)
+
+		gitGenerator := NewGitGenerator(&argoCDServiceMock, "")
+		appSetGenerator := &v1alpha1.ApplicationSetGenerator{
+			Git: &v1alpha1.GitGenerator{
+				RepoURL:           "RepoURL",
+				Directories:       []v1alpha1.GitDirectoryGeneratorItem{{Path: "apps/*"}},
+				TemplatePatchFile: "patches/*.yaml",
+			},
+		}
+
+		_, err := gitGenerator.GenerateParams(appSetGenerator, &v1alpha1.ApplicationSet{}, client)
+		require.ErrorContains(t, err, `invalid template patch file "patches/*.yaml"`)
+	})
+}
+
 func TestGitGenerator_GenerateParams(t *testing.T) {
 	cases := []struct {
 		name               string
@@ -1555,7 +1668,7 @@ func TestGitGenerator_GenerateParams(t *testing.T) {
 				project = mock.Anything
 			}
 
-			argoCDServiceMock.On("GetDirectories", mock.Anything, mock.Anything, mock.Anything, project, mock.Anything, mock.Anything).Return(testCase.repoApps, testCase.repoPathsError)
+			argoCDServiceMock.On("GetDirectories", mock.Anything, mock.Anything, mock.Anything, project, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(testCase.repoApps, testCase.repoPathsError)
 		}
 		gitGenerator := NewGitGenerator(&argoCDServiceMock, "argocd")
 
@@ -1577,3 +1690,24 @@ func TestGitGenerator_GenerateParams(t *testing.T) {
 		argoCDServiceMock.AssertExpectations(t)
 	}
 }
+
+func TestIsSignatureRequired(t *testing.T) {
+	proj := &v1alpha1.AppProject{Spec: v1alpha1.AppProjectSpec{SignatureKeys: []v1alpha1.SignatureKey{{KeyID: "4AEE18F83AFDEB23", Paths: []string{"prod/*"}}}}}
+	for _, tc := range []struct {
+		name     string
+		gen      *v1alpha1.GitGenerator
+		expected bool
+	}{
+		{"directory requiring a signature", &v1alpha1.GitGenerator{Directories: []v1alpha1.GitDirectoryGeneratorItem{{Path: "prod/app"}}}, true},
+		{"directory not requiring a signature", &v1alpha1.GitGenerator{Directories: []v1alpha1.GitDirectoryGeneratorItem{{Path: "dev/app"}}}, false},
+		{"excluded directory", &v1alpha1.GitGenerator{Directories: []v1alpha1.GitDirectoryGeneratorItem{{Path: "dev/app"}, {Path: "prod/app", Exclude: true}}}, false},
+		{"directory with wildcards", &v1alpha1.GitGenerator{Directories: []v1alpha1.GitDirectoryGeneratorItem{{Path: "dev/*"}}}, true},
+		{"file in a directory requiring a signature", &v1alpha1.GitGenerator{Files: []v1alpha1.GitFileGeneratorItem{{Path: "prod/app/config.json"}}}, true},
+		{"file not requiring a signature", &v1alpha1.GitGenerator{Files: []v1alpha1.GitFileGeneratorItem{{Path: "dev/app/config.json"}}}, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, isSignatureRequired(tc.gen, proj))
+		})
+	}
+	assert.False(t, isSignatureRequired(&v1alpha1.GitGenerator{Directories: []v1alpha1.GitDirectoryGeneratorItem{{Path: "*"}}}, &v1alpha1.AppProject{}))
+}
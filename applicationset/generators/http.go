@@ -0,0 +1,211 @@
+package generators
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/jeremywohl/flatten"
+	"google.golang.org/protobuf/types/known/structpb"
+	"k8s.io/client-go/util/jsonpath"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/http_endpoint"
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+var _ Generator = (*HTTPGenerator)(nil)
+
+const (
+	DefaultHTTPRequeueAfter = 30 * time.Minute
+	// httpResponseVariable is the variable holding the response of the endpoint in the CEL expressions
+	httpResponseVariable = "response"
+	// httpCELCostLimit is the maximum cost of the evaluation of a CEL expression, to bound the time spent evaluating it
+	httpCELCostLimit = 1000000
+)
+
+type HTTPGenerator struct {
+	client  client.Client
+	service *http_endpoint.Service
+	SCMConfig
+}
+
+func NewHTTPGenerator(client client.Client, scmConfig SCMConfig) Generator {
+	// the endpoints which are not allowed explicitly can't reach the loopback and link-local addresses, e.g. the
+	// metadata endpoints of the cloud providers
+	return &HTTPGenerator{
+		client:    client,
+		service:   http_endpoint.NewService(len(scmConfig.allowedSCMProviders) == 0),
+		SCMConfig: scmConfig,
+	}
+}
+
+func (g *HTTPGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) time.Duration {
+	// Return a requeue default of 30 minutes, if no default is specified.
+
+	if appSetGenerator.HTTP.RequeueAfterSeconds != nil {
+		return time.Duration(*appSetGenerator.HTTP.RequeueAfterSeconds) * time.Second
+	}
+
+	return DefaultHTTPRequeueAfter
+}
+
+func (g *HTTPGenerator) GetTemplate(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) *argoprojiov1alpha1.ApplicationSetTemplate {
+	return &appSetGenerator.HTTP.Template
+}
+
+func (g *HTTPGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
+	if appSetGenerator == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	if appSetGenerator.HTTP == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	// The endpoints are requested by the ApplicationSet controller like the custom API URLs of the SCM providers, so
+	// they are restricted the same way.
+	if !g.enableSCMProviders {
+		return nil, ErrSCMProvidersDisabled
+	}
+
+	ctx := context.Background()
+	generatorConfig := appSetGenerator.HTTP
+
+	if err := ScmProviderAllowed(applicationSetInfo, generatorConfig, g.allowedSCMProviders); err != nil {
+		return nil, fmt.Errorf("http endpoint not allowed: %w", err)
+	}
+
+	headers := map[string]string{}
+	for name, value := range generatorConfig.Headers {
+		headers[name] = value
+	}
+	token, err := utils.GetSecretRef(ctx, g.client, generatorConfig.TokenRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching Secret token: %w", err)
+	}
+	if token != "" {
+		headers["Authorization"] = "Bearer " + token
+	}
+
+	body, err := g.service.Get(ctx, generatorConfig.URL, headers, generatorConfig.Insecure)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching the HTTP endpoint: %w", err)
+	}
+
+	objectsFound, err := extractHTTPObjects(body, generatorConfig.JSONPath, generatorConfig.CEL)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]map[string]any, 0, len(objectsFound))
+	for _, objectFound := range objectsFound {
+		params := map[string]any{}
+
+		if applicationSetInfo.Spec.GoTemplate {
+			for k, v := range objectFound {
+				params[k] = v
+			}
+		} else {
+			flat, err := flatten.Flatten(objectFound, "", flatten.DotStyle)
+			if err != nil {
+				return nil, fmt.Errorf("error flattening object: %w", err)
+			}
+			for k, v := range flat {
+				params[k] = fmt.Sprintf("%v", v)
+			}
+		}
+
+		err := appendTemplatedValues(generatorConfig.Values, params, applicationSetInfo.Spec.GoTemplate, applicationSetInfo.Spec.GoTemplateOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to append templated values: %w", err)
+		}
+
+		res = append(res, params)
+	}
+
+	return res, nil
+}
+
+// extractHTTPObjects returns the objects of the JSON array of the response, extracted with the JSONPath or the CEL
+// expression if not empty. The arrays found by the JSONPath expression are flattened.
+func extractHTTPObjects(body []byte, jsonPathExpression string, celExpression string) ([]map[string]any, error) {
+	if jsonPathExpression != "" && celExpression != "" {
+		return nil, errors.New("the JSONPath and the CEL expression of the HTTP generator can't be both set")
+	}
+	var data any
+	if err := json.Unmarshal(body, &data); err != nil {
+		return nil, fmt.Errorf("error parsing the response of the HTTP endpoint: %w", err)
+	}
+
+	values := []any{data}
+	switch {
+	case jsonPathExpression != "":
+		jp := jsonpath.New("http")
+		if err := jp.Parse(jsonPathExpression); err != nil {
+			return nil, fmt.Errorf("error parsing the JSONPath %q: %w", jsonPathExpression, err)
+		}
+		results, err := jp.FindResults(data)
+		if err != nil {
+			return nil, fmt.Errorf("error evaluating the JSONPath %q: %w", jsonPathExpression, err)
+		}
+		values = nil
+		for _, result := range results {
+			for _, value := range result {
+				values = append(values, value.Interface())
+			}
+		}
+	case celExpression != "":
+		value, err := evaluateHTTPCEL(data, celExpression)
+		if err != nil {
+			return nil, err
+		}
+		values = []any{value}
+	}
+
+	var objects []map[string]any
+	for _, value := range values {
+		items, ok := value.([]any)
+		if !ok {
+			items = []any{value}
+		}
+		for _, item := range items {
+			object, ok := item.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("the HTTP endpoint returned a %T instead of an object", item)
+			}
+			objects = append(objects, object)
+		}
+	}
+	return objects, nil
+}
+
+// evaluateHTTPCEL returns the result of the CEL expression evaluated with the response of the endpoint
+func evaluateHTTPCEL(data any, expression string) (any, error) {
+	env, err := cel.NewEnv(cel.Variable(httpResponseVariable, cel.DynType))
+	if err != nil {
+		return nil, fmt.Errorf("error creating the CEL environment: %w", err)
+	}
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("error compiling the CEL expression %q: %w", expression, issues.Err())
+	}
+	program, err := env.Program(ast, cel.CostLimit(httpCELCostLimit))
+	if err != nil {
+		return nil, fmt.Errorf("error creating the program of the CEL expression %q: %w", expression, err)
+	}
+	val, _, err := program.Eval(map[string]any{httpResponseVariable: data})
+	if err != nil {
+		return nil, fmt.Errorf("error evaluating the CEL expression %q: %w", expression, err)
+	}
+	native, err := val.ConvertToNative(reflect.TypeOf(&structpb.Value{}))
+	if err != nil {
+		return nil, fmt.Errorf("error converting the result of the CEL expression %q: %w", expression, err)
+	}
+	return native.(*structpb.Value).AsInterface(), nil
+}
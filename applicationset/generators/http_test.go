@@ -0,0 +1,161 @@
+package generators
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/http_endpoint"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestHTTPGenerateParams(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer inventory-token" || r.Header.Get("X-Team") != "platform" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/clusters":
+			_, _ = w.Write([]byte(`[{"name":"dev","labels":{"env":"dev"}},{"name":"prod","labels":{"env":"prod"}}]`))
+		case "/inventory":
+			_, _ = w.Write([]byte(`{"total":2,"items":[{"name":"dev"},{"name":"prod"}]}`))
+		case "/invalid":
+			_, _ = w.Write([]byte(`["dev","prod"]`))
+		}
+	}))
+	defer server.Close()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "inventory", Namespace: "argocd"},
+		Data:       map[string][]byte{"token": []byte("inventory-token")},
+	}).Build()
+
+	cases := []struct {
+		name        string
+		path        string
+		jsonPath    string
+		cel         string
+		goTemplate  bool
+		values      map[string]string
+		expected    []map[string]any
+		expectedErr string
+	}{
+		{
+			name:   "Array",
+			path:   "/clusters",
+			values: map[string]string{"stage": "{{labels.env}}"},
+			expected: []map[string]any{
+				{"name": "dev", "labels.env": "dev", "values.stage": "dev"},
+				{"name": "prod", "labels.env": "prod", "values.stage": "prod"},
+			},
+		},
+		{
+			name:       "GoTemplate",
+			path:       "/clusters",
+			goTemplate: true,
+			expected: []map[string]any{
+				{"name": "dev", "labels": map[string]any{"env": "dev"}},
+				{"name": "prod", "labels": map[string]any{"env": "prod"}},
+			},
+		},
+		{
+			name:     "JSONPath",
+			path:     "/inventory",
+			jsonPath: "{.items}",
+			expected: []map[string]any{{"name": "dev"}, {"name": "prod"}},
+		},
+		{
+			name:     "CEL",
+			path:     "/inventory",
+			cel:      "response.items.filter(i, i.name != 'dev')",
+			expected: []map[string]any{{"name": "prod"}},
+		},
+		{
+			name:        "InvalidCEL",
+			path:        "/inventory",
+			cel:         "response.items.filter(",
+			expectedErr: "error compiling the CEL expression",
+		},
+		{
+			name:        "CELAndJSONPath",
+			path:        "/inventory",
+			jsonPath:    "{.items}",
+			cel:         "response.items",
+			expectedErr: "the JSONPath and the CEL expression of the HTTP generator can't be both set",
+		},
+		{
+			name:        "NotObjects",
+			path:        "/invalid",
+			expectedErr: "the HTTP endpoint returned a string instead of an object",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			generator := &HTTPGenerator{client: client, service: http_endpoint.NewService(false), SCMConfig: SCMConfig{enableSCMProviders: true}}
+			appSetGenerator := &argoprojiov1alpha1.ApplicationSetGenerator{
+				HTTP: &argoprojiov1alpha1.HTTPGenerator{
+					URL:      server.URL + c.path,
+					TokenRef: &argoprojiov1alpha1.SecretRef{SecretName: "inventory", Key: "token"},
+					Headers:  map[string]string{"X-Team": "platform"},
+					JSONPath: c.jsonPath,
+					CEL:      c.cel,
+					Values:   c.values,
+				},
+			}
+			appSet := &argoprojiov1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "set", Namespace: "argocd"},
+				Spec:       argoprojiov1alpha1.ApplicationSetSpec{GoTemplate: c.goTemplate},
+			}
+
+			params, err := generator.GenerateParams(appSetGenerator, appSet, nil)
+			if c.expectedErr != "" {
+				require.ErrorContains(t, err, c.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, params)
+		})
+	}
+}
+
+func TestHTTPGenerateParamsAllowedSCMProviders(t *testing.T) {
+	appSet := &argoprojiov1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "set", Namespace: "argocd"}}
+	appSetGenerator := &argoprojiov1alpha1.ApplicationSetGenerator{
+		HTTP: &argoprojiov1alpha1.HTTPGenerator{URL: "http://169.254.169.254/latest/meta-data"},
+	}
+
+	generator := &HTTPGenerator{service: http_endpoint.NewService(false), SCMConfig: SCMConfig{
+		enableSCMProviders:  true,
+		allowedSCMProviders: []string{"https://inventory.example.com/clusters"},
+	}}
+	_, err := generator.GenerateParams(appSetGenerator, appSet, nil)
+	var disallowedErr ErrDisallowedSCMProvider
+	require.ErrorAs(t, err, &disallowedErr)
+
+	generator = &HTTPGenerator{service: http_endpoint.NewService(false)}
+	_, err = generator.GenerateParams(appSetGenerator, appSet, nil)
+	require.ErrorIs(t, err, ErrSCMProvidersDisabled)
+
+	// the endpoints can't reach the loopback and link-local addresses unless they are allowed explicitly
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+	appSetGenerator.HTTP.URL = server.URL
+	_, err = NewHTTPGenerator(nil, SCMConfig{enableSCMProviders: true}).GenerateParams(appSetGenerator, appSet, nil)
+	require.ErrorContains(t, err, "are not allowed")
+
+	_, err = NewHTTPGenerator(nil, SCMConfig{enableSCMProviders: true, allowedSCMProviders: []string{server.URL}}).GenerateParams(appSetGenerator, appSet, nil)
+	require.NoError(t, err)
+}
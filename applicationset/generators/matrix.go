@@ -1,8 +1,11 @@
 package generators
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"regexp"
+	"sort"
 	"time"
 
 	"dario.cat/mergo"
@@ -15,9 +18,9 @@ import (
 var _ Generator = (*MatrixGenerator)(nil)
 
 var (
-	ErrMoreThanTwoGenerators      = errors.New("found more than two generators, Matrix support only two")
-	ErrLessThanTwoGenerators      = errors.New("found less than two generators, Matrix support only two")
+	ErrLessThanTwoGenerators      = errors.New("found less than two generators, Matrix requires two or more")
 	ErrMoreThenOneInnerGenerators = errors.New("found more than one generator in matrix.Generators")
+	ErrMatrixCircularDependency   = errors.New("a child generator can only use the parameters of the preceding child generators")
 )
 
 type MatrixGenerator struct {
@@ -41,42 +44,110 @@ func (m *MatrixGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.App
 		return nil, ErrLessThanTwoGenerators
 	}
 
-	if len(appSetGenerator.Matrix.Generators) > 2 {
-		return nil, ErrMoreThanTwoGenerators
+	// The combinations are computed from the first child generator to the last one, each child generator being
+	// interpolated with the parameters of the preceding ones. With Go templates, the parameters of the preceding child
+	// generators take precedence over the parameters of the following ones with the same name.
+	res := []map[string]any{{}}
+	generated := make([]map[string]bool, len(appSetGenerator.Matrix.Generators))
+	for i, generator := range appSetGenerator.Matrix.Generators {
+		generated[i] = map[string]bool{}
+		combinations := []map[string]any{}
+		for _, a := range res {
+			var interpolation map[string]any
+			if i > 0 {
+				interpolation = a
+			}
+			gi, err := m.getParams(generator, appSet, interpolation, client)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get params for child generator #%d in the matrix generator: %w", i+1, err)
+			}
+			for _, b := range gi {
+				for k := range b {
+					generated[i][k] = true
+				}
+				if appSet.Spec.GoTemplate {
+					tmp := map[string]any{}
+					if err := mergo.Merge(&tmp, b, mergo.WithOverride); err != nil {
+						return nil, fmt.Errorf("failed to merge params from child generator #%d in the matrix generator with temp map: %w", i+1, err)
+					}
+					if err := mergo.Merge(&tmp, a, mergo.WithOverride); err != nil {
+						return nil, fmt.Errorf("failed to merge params from child generator #%d in the matrix generator with the preceding ones: %w", i+1, err)
+					}
+					combinations = append(combinations, tmp)
+				} else {
+					val, err := utils.CombineStringMaps(a, b)
+					if err != nil {
+						return nil, fmt.Errorf("failed to combine string maps with merging params for the matrix generator: %w", err)
+					}
+					combinations = append(combinations, utils.ConvertToMapStringInterface(val))
+				}
+			}
+		}
+		res = combinations
 	}
 
-	res := []map[string]any{}
-
-	g0, err := m.getParams(appSetGenerator.Matrix.Generators[0], appSet, nil, client)
-	if err != nil {
-		return nil, fmt.Errorf("error failed to get params for first generator in matrix generator: %w", err)
+	if err := checkMatrixDependencies(appSetGenerator.Matrix.Generators, generated, appSet.Spec.GoTemplate); err != nil {
+		return nil, err
 	}
-	for _, a := range g0 {
-		g1, err := m.getParams(appSetGenerator.Matrix.Generators[1], appSet, a, client)
+
+	return res, nil
+}
+
+var (
+	templateActionRegexp    = regexp.MustCompile(`(?s)\{\{(.*?)\}\}`)
+	goTemplateFieldRegexp   = regexp.MustCompile(`(?:^|[\s(|,-])\.([A-Za-z_]\w*)`)
+	fastTemplateFieldRegexp = regexp.MustCompile(`^\s*([\w.\-\[\]]+)\s*$`)
+)
+
+// checkMatrixDependencies returns an error if a child generator references a parameter which is only generated by a
+// following child generator. Such a parameter can't be interpolated since the child generators are generated in order,
+// and two child generators referencing the parameters of each other form a circular dependency.
+func checkMatrixDependencies(generators []argoprojiov1alpha1.ApplicationSetNestedGenerator, generated []map[string]bool, goTemplate bool) error {
+	for i, generator := range generators {
+		references, err := templateReferences(generator, goTemplate)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get params for second generator in the matrix generator: %w", err)
+			return fmt.Errorf("error finding the parameters referenced by child generator #%d in the matrix generator: %w", i+1, err)
 		}
-		for _, b := range g1 {
-			if appSet.Spec.GoTemplate {
-				tmp := map[string]any{}
-				if err := mergo.Merge(&tmp, b, mergo.WithOverride); err != nil {
-					return nil, fmt.Errorf("failed to merge params from the second generator in the matrix generator with temp map: %w", err)
+	reference:
+		for _, reference := range references {
+			for j := 0; j <= i; j++ {
+				if generated[j][reference] {
+					continue reference
 				}
-				if err := mergo.Merge(&tmp, a, mergo.WithOverride); err != nil {
-					return nil, fmt.Errorf("failed to merge params from the second generator in the matrix generator with the first: %w", err)
-				}
-				res = append(res, tmp)
-			} else {
-				val, err := utils.CombineStringMaps(a, b)
-				if err != nil {
-					return nil, fmt.Errorf("failed to combine string maps with merging params for the matrix generator: %w", err)
+			}
+			for j := i + 1; j < len(generators); j++ {
+				if generated[j][reference] {
+					return fmt.Errorf("child generator #%d in the matrix generator references the parameter %q generated by the following child generator #%d: %w", i+1, reference, j+1, ErrMatrixCircularDependency)
 				}
-				res = append(res, utils.ConvertToMapStringInterface(val))
 			}
 		}
 	}
+	return nil
+}
 
-	return res, nil
+// templateReferences returns the sorted names of the parameters referenced by the templated fields of the generator.
+// With Go templates, only the top-level name of the parameters is returned, e.g. path for {{ .path.basename }}.
+func templateReferences(generator argoprojiov1alpha1.ApplicationSetNestedGenerator, goTemplate bool) ([]string, error) {
+	spec, err := json.Marshal(generator)
+	if err != nil {
+		return nil, err
+	}
+	names := map[string]bool{}
+	for _, action := range templateActionRegexp.FindAllStringSubmatch(string(spec), -1) {
+		if goTemplate {
+			for _, field := range goTemplateFieldRegexp.FindAllStringSubmatch(action[1], -1) {
+				names[field[1]] = true
+			}
+		} else if field := fastTemplateFieldRegexp.FindStringSubmatch(action[1]); field != nil {
+			names[field[1]] = true
+		}
+	}
+	references := make([]string, 0, len(names))
+	for name := range names {
+		references = append(references, name)
+	}
+	sort.Strings(references)
+	return references, nil
 }
 
 func (m *MatrixGenerator) getParams(appSetBaseGenerator argoprojiov1alpha1.ApplicationSetNestedGenerator, appSet *argoprojiov1alpha1.ApplicationSet, params map[string]any, client client.Client) ([]map[string]any, error) {
@@ -98,6 +169,8 @@ func (m *MatrixGenerator) getParams(appSetBaseGenerator argoprojiov1alpha1.Appli
 			ClusterDecisionResource: appSetBaseGenerator.ClusterDecisionResource,
 			PullRequest:             appSetBaseGenerator.PullRequest,
 			Plugin:                  appSetBaseGenerator.Plugin,
+			AWSOrganization:         appSetBaseGenerator.AWSOrganization,
+			HTTP:                    appSetBaseGenerator.HTTP,
 			Matrix:                  matrixGen,
 			Merge:                   mergeGen,
 			Selector:                appSetBaseGenerator.Selector,
@@ -137,6 +210,8 @@ func (m *MatrixGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.Ap
 			Git:                     r.Git,
 			PullRequest:             r.PullRequest,
 			Plugin:                  r.Plugin,
+			AWSOrganization:         r.AWSOrganization,
+			HTTP:                    r.HTTP,
 			SCMProvider:             r.SCMProvider,
 			ClusterDecisionResource: r.ClusterDecisionResource,
 			Matrix:                  matrixGen,
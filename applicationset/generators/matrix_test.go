@@ -90,19 +90,57 @@ func TestMatrixGenerate(t *testing.T) {
 			expectedErr: ErrLessThanTwoGenerators,
 		},
 		{
-			name: "returns error if there is more than two base generators",
+			name: "happy flow - generate params from three lists",
 			baseGenerators: []v1alpha1.ApplicationSetNestedGenerator{
 				{
-					List: listGenerator,
+					List: &v1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{
+							{Raw: []byte(`{"cluster": "dev"}`)},
+							{Raw: []byte(`{"cluster": "prod"}`)},
+						},
+					},
 				},
 				{
-					List: listGenerator,
+					List: &v1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{
+							{Raw: []byte(`{"env": "{{cluster}}-a"}`)},
+							{Raw: []byte(`{"env": "{{cluster}}-b"}`)},
+						},
+					},
+				},
+				{
+					List: &v1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{
+							{Raw: []byte(`{"team": "{{env}}-team"}`)},
+						},
+					},
+				},
+			},
+			expected: []map[string]any{
+				{"cluster": "dev", "env": "dev-a", "team": "dev-a-team"},
+				{"cluster": "dev", "env": "dev-b", "team": "dev-b-team"},
+				{"cluster": "prod", "env": "prod-a", "team": "prod-a-team"},
+				{"cluster": "prod", "env": "prod-b", "team": "prod-b-team"},
+			},
+		},
+		{
+			name: "returns error if a base generator uses the params of a following one",
+			baseGenerators: []v1alpha1.ApplicationSetNestedGenerator{
+				{
+					List: &v1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"stage": "{{team}}"}`)}},
+					},
 				},
 				{
 					List: listGenerator,
 				},
+				{
+					List: &v1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"team": "{{cluster}}-team"}`)}},
+					},
+				},
 			},
-			expectedErr: ErrMoreThanTwoGenerators,
+			expectedErr: ErrMatrixCircularDependency,
 		},
 		{
 			name: "returns error if there is more than one inner generator in the first base generator",
@@ -297,19 +335,57 @@ func TestMatrixGenerateGoTemplate(t *testing.T) {
 			expectedErr: ErrLessThanTwoGenerators,
 		},
 		{
-			name: "returns error if there is more than two base generators",
+			name: "happy flow - generate params from three lists with precedence of the first",
 			baseGenerators: []v1alpha1.ApplicationSetNestedGenerator{
 				{
-					List: listGenerator,
+					List: &v1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{
+							{Raw: []byte(`{"cluster": "dev"}`)},
+							{Raw: []byte(`{"cluster": "prod"}`)},
+						},
+					},
 				},
 				{
-					List: listGenerator,
+					List: &v1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{
+							{Raw: []byte(`{"env": "{{.cluster}}-a"}`)},
+							{Raw: []byte(`{"env": "{{.cluster}}-b"}`)},
+						},
+					},
+				},
+				{
+					List: &v1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{
+							{Raw: []byte(`{"team": "{{.env}}-team", "cluster": "overridden"}`)},
+						},
+					},
+				},
+			},
+			expected: []map[string]any{
+				{"cluster": "dev", "env": "dev-a", "team": "dev-a-team"},
+				{"cluster": "dev", "env": "dev-b", "team": "dev-b-team"},
+				{"cluster": "prod", "env": "prod-a", "team": "prod-a-team"},
+				{"cluster": "prod", "env": "prod-b", "team": "prod-b-team"},
+			},
+		},
+		{
+			name: "returns error if a base generator uses the params of a following one",
+			baseGenerators: []v1alpha1.ApplicationSetNestedGenerator{
+				{
+					List: &v1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"stage": "{{ .team }}"}`)}},
+					},
 				},
 				{
 					List: listGenerator,
 				},
+				{
+					List: &v1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"team": "{{.cluster}}-team"}`)}},
+					},
+				},
 			},
-			expectedErr: ErrMoreThanTwoGenerators,
+			expectedErr: ErrMatrixCircularDependency,
 		},
 		{
 			name: "returns error if there is more than one inner generator in the first base generator",
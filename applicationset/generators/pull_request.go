@@ -4,7 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -109,6 +112,24 @@ func (g *PullRequestGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 			"author":             pull.Author,
 		}
 
+		if overridesConfig := appSetGenerator.PullRequest.ParameterOverrides; overridesConfig != nil {
+			overrides, err := getParameterOverrides(ctx, svc, pull, overridesConfig)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get parameter overrides of pull request %d: %w", pull.Number, err)
+			}
+			if applicationSetInfo != nil && applicationSetInfo.Spec.GoTemplate {
+				overridesMap := map[string]any{}
+				for k, v := range overrides {
+					overridesMap[k] = v
+				}
+				paramMap["overrides"] = overridesMap
+			} else {
+				for k, v := range overrides {
+					paramMap["overrides."+k] = v
+				}
+			}
+		}
+
 		err := appendTemplatedValues(appSetGenerator.PullRequest.Values, paramMap, applicationSetInfo.Spec.GoTemplate, applicationSetInfo.Spec.GoTemplateOptions)
 		if err != nil {
 			return nil, fmt.Errorf("failed to append templated values: %w", err)
@@ -123,6 +144,55 @@ func (g *PullRequestGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 	return params, nil
 }
 
+// parameterOverrideValueRegex matches the values of the parameter overrides, e.g. image references or numbers, which
+// cannot inject template expressions, quotes or YAML in the generated applications
+var parameterOverrideValueRegex = regexp.MustCompile(`^[A-Za-z0-9._:/@+=-]*$`)
+
+// getParameterOverrides returns the parameters set by the labels and the comments of the pull request. The comments
+// take precedence over the labels, and the newest comments over the oldest ones. Only the comments of the users with
+// the write or maintainer permission on the repository are used, and the parameters which are not listed by the keys
+// or whose value is not a plain value are ignored.
+func getParameterOverrides(ctx context.Context, svc pullrequest.PullRequestService, pull *pullrequest.PullRequest, config *argoprojiov1alpha1.PullRequestGeneratorParameterOverrides) (map[string]string, error) {
+	overrides := map[string]string{}
+	setOverride := func(pair string) {
+		key, value, found := strings.Cut(pair, "=")
+		if found && slices.Contains(config.Keys, key) && parameterOverrideValueRegex.MatchString(value) {
+			overrides[key] = value
+		}
+	}
+
+	if config.LabelPrefix != "" {
+		for _, label := range pull.Labels {
+			if pair, found := strings.CutPrefix(label, config.LabelPrefix); found {
+				setOverride(pair)
+			}
+		}
+	}
+
+	if config.CommentCommand != "" {
+		commentSvc, ok := svc.(pullrequest.CommentService)
+		if !ok {
+			return nil, errors.New("the pull request provider does not support comment commands")
+		}
+		comments, err := commentSvc.ListComments(ctx, pull.Number)
+		if err != nil {
+			return nil, fmt.Errorf("error listing comments: %w", err)
+		}
+		for _, comment := range comments {
+			for _, line := range strings.Split(comment, "\n") {
+				pairs, found := strings.CutPrefix(strings.TrimSpace(line), config.CommentCommand+" ")
+				if !found {
+					continue
+				}
+				for _, pair := range strings.Fields(pairs) {
+					setOverride(pair)
+				}
+			}
+		}
+	}
+	return overrides, nil
+}
+
 // selectServiceProvider selects the provider to get pull requests from the configuration
 func (g *PullRequestGenerator) selectServiceProvider(ctx context.Context, generatorConfig *argoprojiov1alpha1.PullRequestGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet) (pullrequest.PullRequestService, error) {
 	if !g.enableSCMProviders {
@@ -153,11 +223,19 @@ func (g *PullRequestGenerator) selectServiceProvider(ctx context.Context, genera
 	}
 	if generatorConfig.Gitea != nil {
 		providerConfig := generatorConfig.Gitea
+		var caCerts []byte
+		var prErr error
+		if providerConfig.CARef != nil {
+			caCerts, prErr = utils.GetConfigMapData(ctx, g.client, providerConfig.CARef, applicationSetInfo.Namespace)
+			if prErr != nil {
+				return nil, fmt.Errorf("error fetching CA certificates from ConfigMap: %w", prErr)
+			}
+		}
 		token, err := utils.GetSecretRef(ctx, g.client, providerConfig.TokenRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
 		if err != nil {
 			return nil, fmt.Errorf("error fetching Secret token: %w", err)
 		}
-		return pullrequest.NewGiteaService(token, providerConfig.API, providerConfig.Owner, providerConfig.Repo, providerConfig.Insecure)
+		return pullrequest.NewGiteaService(token, providerConfig.API, providerConfig.Owner, providerConfig.Repo, providerConfig.Insecure, g.scmRootCAPath, caCerts)
 	}
 	if generatorConfig.BitbucketServer != nil {
 		providerConfig := generatorConfig.BitbucketServer
@@ -203,11 +281,19 @@ func (g *PullRequestGenerator) selectServiceProvider(ctx context.Context, genera
 	}
 	if generatorConfig.AzureDevOps != nil {
 		providerConfig := generatorConfig.AzureDevOps
+		var caCerts []byte
+		var prErr error
+		if providerConfig.CARef != nil {
+			caCerts, prErr = utils.GetConfigMapData(ctx, g.client, providerConfig.CARef, applicationSetInfo.Namespace)
+			if prErr != nil {
+				return nil, fmt.Errorf("error fetching CA certificates from ConfigMap: %w", prErr)
+			}
+		}
 		token, err := utils.GetSecretRef(ctx, g.client, providerConfig.TokenRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
 		if err != nil {
 			return nil, fmt.Errorf("error fetching Secret token: %w", err)
 		}
-		return pullrequest.NewAzureDevOpsService(token, providerConfig.API, providerConfig.Organization, providerConfig.Project, providerConfig.Repo, providerConfig.Labels)
+		return pullrequest.NewAzureDevOpsService(token, providerConfig.API, providerConfig.Organization, providerConfig.Project, providerConfig.Repo, providerConfig.Labels, providerConfig.Insecure, g.scmRootCAPath, caCerts)
 	}
 	return nil, errors.New("no Pull Request provider implementation configured")
 }
@@ -274,6 +274,96 @@ func TestPullRequestGithubGenerateParams(t *testing.T) {
 	}
 }
 
+func TestPullRequestGenerateParamsWithParameterOverrides(t *testing.T) {
+	pulls := []*pullrequest.PullRequest{
+		{
+			Number:       1,
+			Title:        "title1",
+			Branch:       "branch1",
+			TargetBranch: "master",
+			HeadSHA:      "089d92cbf9ff857a39e6feccd32798ca700fb958",
+			Labels:       []string{"preview", "preview/image=foo:1.0", "preview/replicas=2", "preview/namespace=kube-system"},
+			Author:       "testName",
+		},
+	}
+	comments := map[int][]string{
+		1: {
+			"LGTM",
+			"Testing with a new image\n/argocd set image=foo:2.0 debug=true\n/argocd set namespace=kube-system",
+			"/argocd set debug=false",
+			// values which are not plain values are ignored
+			"/argocd set image=foo:3.0'}}{{.evil}}",
+		},
+	}
+	overrides := &argoprojiov1alpha1.PullRequestGeneratorParameterOverrides{
+		Keys:           []string{"image", "replicas", "debug"},
+		LabelPrefix:    "preview/",
+		CommentCommand: "/argocd set",
+	}
+
+	cases := []struct {
+		name        string
+		goTemplate  bool
+		listOnly    bool
+		expected    map[string]any
+		expectedErr string
+	}{
+		{
+			name:       "go template",
+			goTemplate: true,
+			expected:   map[string]any{"image": "foo:2.0", "replicas": "2", "debug": "false"},
+		},
+		{
+			name:     "fasttemplate",
+			expected: map[string]any{"overrides.image": "foo:2.0", "overrides.replicas": "2", "overrides.debug": "false"},
+		},
+		{
+			name:        "comments not supported by the provider",
+			listOnly:    true,
+			expectedErr: "the pull request provider does not support comment commands",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			gen := PullRequestGenerator{
+				selectServiceProviderFunc: func(ctx context.Context, _ *argoprojiov1alpha1.PullRequestGenerator, _ *argoprojiov1alpha1.ApplicationSet) (pullrequest.PullRequestService, error) {
+					svc, err := pullrequest.NewFakeCommentService(ctx, pulls, comments)
+					if c.listOnly {
+						// Hide the comments of the fake service
+						return struct{ pullrequest.PullRequestService }{svc}, err
+					}
+					return svc, err
+				},
+			}
+			generatorConfig := argoprojiov1alpha1.ApplicationSetGenerator{
+				PullRequest: &argoprojiov1alpha1.PullRequestGenerator{
+					ParameterOverrides: overrides,
+				},
+			}
+			appSet := argoprojiov1alpha1.ApplicationSet{
+				Spec: argoprojiov1alpha1.ApplicationSetSpec{GoTemplate: c.goTemplate},
+			}
+
+			got, err := gen.GenerateParams(&generatorConfig, &appSet, nil)
+			if c.expectedErr != "" {
+				require.ErrorContains(t, err, c.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, got, 1)
+			if c.goTemplate {
+				assert.Equal(t, c.expected, got[0]["overrides"])
+			} else {
+				for k, v := range c.expected {
+					assert.Equal(t, v, got[0][k])
+				}
+				assert.NotContains(t, got[0], "overrides.namespace")
+			}
+		})
+	}
+}
+
 func TestAllowedSCMProviderPullRequest(t *testing.T) {
 	cases := []struct {
 		name           string
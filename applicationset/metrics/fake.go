@@ -16,6 +16,7 @@ func NewFakeAppsetMetrics() *ApplicationsetMetrics {
 	)
 
 	return &ApplicationsetMetrics{
-		reconcileHistogram: reconcileHistogram,
+		reconcileHistogram:  reconcileHistogram,
+		orphanedAppsCounter: newOrphanedAppsCounter(),
 	}
 }
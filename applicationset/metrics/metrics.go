@@ -32,7 +32,8 @@ var (
 )
 
 type ApplicationsetMetrics struct {
-	reconcileHistogram *prometheus.HistogramVec
+	reconcileHistogram  *prometheus.HistogramVec
+	orphanedAppsCounter *prometheus.CounterVec
 }
 
 type appsetCollector struct {
@@ -52,10 +53,13 @@ func NewApplicationsetMetrics(appsetLister applisters.ApplicationSetLister, apps
 		descAppsetDefaultLabels,
 	)
 
+	orphanedAppsCounter := newOrphanedAppsCounter()
+
 	appsetCollector := newAppsetCollector(appsetLister, appsetLabels, appsetFilter)
 
 	// Register collectors and metrics
 	metrics.Registry.MustRegister(reconcileHistogram)
+	metrics.Registry.MustRegister(orphanedAppsCounter)
 	metrics.Registry.MustRegister(appsetCollector)
 
 	kubectlMetricsServer := kubectl.NewKubectlMetrics()
@@ -63,14 +67,30 @@ func NewApplicationsetMetrics(appsetLister applisters.ApplicationSetLister, apps
 	kubectl.RegisterWithPrometheus(metrics.Registry)
 
 	return ApplicationsetMetrics{
-		reconcileHistogram: reconcileHistogram,
+		reconcileHistogram:  reconcileHistogram,
+		orphanedAppsCounter: orphanedAppsCounter,
 	}
 }
 
+func newOrphanedAppsCounter() *prometheus.CounterVec {
+	return prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_appset_orphaned_applications_total",
+			Help: "Number of applications orphaned by the applicationset instead of being deleted.",
+		},
+		descAppsetDefaultLabels,
+	)
+}
+
 func (m *ApplicationsetMetrics) ObserveReconcile(appset *argoappv1.ApplicationSet, duration time.Duration) {
 	m.reconcileHistogram.WithLabelValues(appset.Namespace, appset.Name).Observe(duration.Seconds())
 }
 
+// IncOrphanedApplications increments the number of applications orphaned by the applicationset
+func (m *ApplicationsetMetrics) IncOrphanedApplications(appset *argoappv1.ApplicationSet) {
+	m.orphanedAppsCounter.WithLabelValues(appset.Namespace, appset.Name).Inc()
+}
+
 func newAppsetCollector(lister applisters.ApplicationSetLister, labels []string, filter func(appset *argoappv1.ApplicationSet) bool) *appsetCollector {
 	descAppsetDefaultLabels = []string{"namespace", "name"}
 
@@ -0,0 +1,38 @@
+package aws_organization
+
+import (
+	"context"
+)
+
+type FakeService struct {
+	accounts []*Account
+	// clusters are the clusters by account id
+	clusters  map[string][]*Cluster
+	listError error
+}
+
+var _ OrganizationService = (*FakeService)(nil)
+
+func NewFakeService(accounts []*Account, clusters map[string][]*Cluster, listError error) (OrganizationService, error) {
+	return &FakeService{
+		accounts:  accounts,
+		clusters:  clusters,
+		listError: listError,
+	}, nil
+}
+
+func (s *FakeService) ListAccounts(_ context.Context) ([]*Account, error) {
+	return s.accounts, s.listError
+}
+
+func (s *FakeService) ListClusters(_ context.Context, account *Account, regions []string) ([]*Cluster, error) {
+	var clusters []*Cluster
+	for _, cluster := range s.clusters[account.ID] {
+		for _, region := range regions {
+			if cluster.Region == region {
+				clusters = append(clusters, cluster)
+			}
+		}
+	}
+	return clusters, s.listError
+}
@@ -0,0 +1,193 @@
+package aws_organization
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	log "github.com/sirupsen/logrus"
+)
+
+// Account is an active account of an AWS Organization.
+type Account struct {
+	// ID is the 12-digit id of the account.
+	ID string
+	// Name is the friendly name of the account.
+	Name string
+	// Email is the email address of the owner of the account.
+	Email string
+	// ARN is the Amazon Resource Name of the account.
+	ARN string
+	// Tags are the tags attached to the account.
+	Tags map[string]string
+}
+
+// Cluster is an EKS cluster of an account of an AWS Organization.
+type Cluster struct {
+	// Name is the name of the cluster.
+	Name string
+	// ARN is the Amazon Resource Name of the cluster.
+	ARN string
+	// Endpoint is the URL of the Kubernetes API server of the cluster.
+	Endpoint string
+	// Region is the region of the cluster.
+	Region string
+	// Version is the Kubernetes version of the cluster.
+	Version string
+	// CAData is the base64-encoded certificate authority data of the cluster.
+	CAData string
+	// Tags are the tags attached to the cluster.
+	Tags map[string]string
+}
+
+type OrganizationService interface {
+	// ListAccounts lists the active accounts of the Organization.
+	ListAccounts(ctx context.Context) ([]*Account, error)
+	// ListClusters lists the active EKS clusters of an account in the given regions.
+	ListClusters(ctx context.Context, account *Account, regions []string) ([]*Cluster, error)
+}
+
+// AWSOrganizationsClient is a lean facade to the organizationsiface.OrganizationsAPI
+type AWSOrganizationsClient interface {
+	ListAccountsPagesWithContext(aws.Context, *organizations.ListAccountsInput, func(*organizations.ListAccountsOutput, bool) bool, ...request.Option) error
+	ListAccountsForParentPagesWithContext(aws.Context, *organizations.ListAccountsForParentInput, func(*organizations.ListAccountsForParentOutput, bool) bool, ...request.Option) error
+	ListTagsForResourcePagesWithContext(aws.Context, *organizations.ListTagsForResourceInput, func(*organizations.ListTagsForResourceOutput, bool) bool, ...request.Option) error
+}
+
+// AWSEKSClient is a lean facade to the eksiface.EKSAPI
+type AWSEKSClient interface {
+	ListClustersPagesWithContext(aws.Context, *eks.ListClustersInput, func(*eks.ListClustersOutput, bool) bool, ...request.Option) error
+	DescribeClusterWithContext(aws.Context, *eks.DescribeClusterInput, ...request.Option) (*eks.DescribeClusterOutput, error)
+}
+
+type AWSOrganizationService struct {
+	organizationsClient AWSOrganizationsClient
+	// newEKSClient returns a client of the EKS API of a region of an account
+	newEKSClient        func(accountID string, region string) AWSEKSClient
+	organizationalUnits []string
+}
+
+var _ OrganizationService = (*AWSOrganizationService)(nil)
+
+// NewAWSOrganizationService returns a service listing the accounts of the Organization with the given role, or with
+// the pod role if empty, and the EKS clusters of the accounts with the role of the given name in each account.
+func NewAWSOrganizationService(role string, organizationalUnits []string, clusterRoleName string) (*AWSOrganizationService, error) {
+	podSession, err := session.NewSession()
+	if err != nil {
+		return nil, fmt.Errorf("error creating new AWS pod session: %w", err)
+	}
+	organizationSession := podSession
+	if role != "" {
+		log.Debugf("role %s is provided for AWS Organization discovery", role)
+		organizationSession, err = session.NewSession(&aws.Config{
+			Credentials: stscreds.NewCredentials(podSession, role),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error creating new AWS Organization discovery session: %w", err)
+		}
+	}
+	newEKSClient := func(accountID string, region string) AWSEKSClient {
+		clusterRole := fmt.Sprintf("arn:aws:iam::%s:role/%s", accountID, clusterRoleName)
+		return eks.New(podSession, &aws.Config{
+			Credentials: stscreds.NewCredentials(podSession, clusterRole),
+			Region:      aws.String(region),
+		})
+	}
+	return &AWSOrganizationService{
+		organizationsClient: organizations.New(organizationSession),
+		newEKSClient:        newEKSClient,
+		organizationalUnits: organizationalUnits,
+	}, nil
+}
+
+func (s *AWSOrganizationService) ListAccounts(ctx context.Context) ([]*Account, error) {
+	var orgAccounts []*organizations.Account
+	if len(s.organizationalUnits) == 0 {
+		err := s.organizationsClient.ListAccountsPagesWithContext(ctx, &organizations.ListAccountsInput{}, func(output *organizations.ListAccountsOutput, _ bool) bool {
+			orgAccounts = append(orgAccounts, output.Accounts...)
+			return true
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list accounts: %w", err)
+		}
+	}
+	for _, ou := range s.organizationalUnits {
+		err := s.organizationsClient.ListAccountsForParentPagesWithContext(ctx, &organizations.ListAccountsForParentInput{ParentId: aws.String(ou)}, func(output *organizations.ListAccountsForParentOutput, _ bool) bool {
+			orgAccounts = append(orgAccounts, output.Accounts...)
+			return true
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list accounts of organizational unit %s: %w", ou, err)
+		}
+	}
+
+	accounts := make([]*Account, 0, len(orgAccounts))
+	for _, orgAccount := range orgAccounts {
+		if aws.StringValue(orgAccount.Status) != organizations.AccountStatusActive {
+			continue
+		}
+		account := &Account{
+			ID:    aws.StringValue(orgAccount.Id),
+			Name:  aws.StringValue(orgAccount.Name),
+			Email: aws.StringValue(orgAccount.Email),
+			ARN:   aws.StringValue(orgAccount.Arn),
+			Tags:  map[string]string{},
+		}
+		err := s.organizationsClient.ListTagsForResourcePagesWithContext(ctx, &organizations.ListTagsForResourceInput{ResourceId: orgAccount.Id}, func(output *organizations.ListTagsForResourceOutput, _ bool) bool {
+			for _, tag := range output.Tags {
+				account.Tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+			}
+			return true
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list tags of account %s: %w", account.ID, err)
+		}
+		accounts = append(accounts, account)
+	}
+	return accounts, nil
+}
+
+func (s *AWSOrganizationService) ListClusters(ctx context.Context, account *Account, regions []string) ([]*Cluster, error) {
+	clusters := make([]*Cluster, 0)
+	for _, region := range regions {
+		eksClient := s.newEKSClient(account.ID, region)
+		var names []*string
+		err := eksClient.ListClustersPagesWithContext(ctx, &eks.ListClustersInput{}, func(output *eks.ListClustersOutput, _ bool) bool {
+			names = append(names, output.Clusters...)
+			return true
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list EKS clusters of account %s in region %s: %w", account.ID, region, err)
+		}
+		for _, name := range names {
+			output, err := eksClient.DescribeClusterWithContext(ctx, &eks.DescribeClusterInput{Name: name})
+			if err != nil {
+				return nil, fmt.Errorf("failed to describe EKS cluster %s of account %s in region %s: %w", aws.StringValue(name), account.ID, region, err)
+			}
+			eksCluster := output.Cluster
+			if eksCluster == nil || aws.StringValue(eksCluster.Status) != eks.ClusterStatusActive {
+				// clusters being created or deleted don't have a usable endpoint
+				log.Debugf("EKS cluster %s of account %s in region %s is not active, skipped", aws.StringValue(name), account.ID, region)
+				continue
+			}
+			cluster := &Cluster{
+				Name:     aws.StringValue(eksCluster.Name),
+				ARN:      aws.StringValue(eksCluster.Arn),
+				Endpoint: aws.StringValue(eksCluster.Endpoint),
+				Region:   region,
+				Version:  aws.StringValue(eksCluster.Version),
+				Tags:     aws.StringValueMap(eksCluster.Tags),
+			}
+			if eksCluster.CertificateAuthority != nil {
+				cluster.CAData = aws.StringValue(eksCluster.CertificateAuthority.Data)
+			}
+			clusters = append(clusters, cluster)
+		}
+	}
+	return clusters, nil
+}
@@ -0,0 +1,151 @@
+package aws_organization
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeOrganizationsClient struct {
+	accounts         []*organizations.Account
+	accountsByParent map[string][]*organizations.Account
+	tags             map[string][]*organizations.Tag
+}
+
+func (c *fakeOrganizationsClient) ListAccountsPagesWithContext(_ aws.Context, _ *organizations.ListAccountsInput, fn func(*organizations.ListAccountsOutput, bool) bool, _ ...request.Option) error {
+	// two pages, to check that all the pages are listed
+	if fn(&organizations.ListAccountsOutput{Accounts: c.accounts[:1]}, false) {
+		fn(&organizations.ListAccountsOutput{Accounts: c.accounts[1:]}, true)
+	}
+	return nil
+}
+
+func (c *fakeOrganizationsClient) ListAccountsForParentPagesWithContext(_ aws.Context, input *organizations.ListAccountsForParentInput, fn func(*organizations.ListAccountsForParentOutput, bool) bool, _ ...request.Option) error {
+	accounts, ok := c.accountsByParent[aws.StringValue(input.ParentId)]
+	if !ok {
+		return errors.New("parent not found")
+	}
+	fn(&organizations.ListAccountsForParentOutput{Accounts: accounts}, true)
+	return nil
+}
+
+func (c *fakeOrganizationsClient) ListTagsForResourcePagesWithContext(_ aws.Context, input *organizations.ListTagsForResourceInput, fn func(*organizations.ListTagsForResourceOutput, bool) bool, _ ...request.Option) error {
+	fn(&organizations.ListTagsForResourceOutput{Tags: c.tags[aws.StringValue(input.ResourceId)]}, true)
+	return nil
+}
+
+type fakeEKSClient struct {
+	clusters []*eks.Cluster
+}
+
+func (c *fakeEKSClient) ListClustersPagesWithContext(_ aws.Context, _ *eks.ListClustersInput, fn func(*eks.ListClustersOutput, bool) bool, _ ...request.Option) error {
+	var names []*string
+	for _, cluster := range c.clusters {
+		names = append(names, cluster.Name)
+	}
+	fn(&eks.ListClustersOutput{Clusters: names}, true)
+	return nil
+}
+
+func (c *fakeEKSClient) DescribeClusterWithContext(_ aws.Context, input *eks.DescribeClusterInput, _ ...request.Option) (*eks.DescribeClusterOutput, error) {
+	for _, cluster := range c.clusters {
+		if aws.StringValue(cluster.Name) == aws.StringValue(input.Name) {
+			return &eks.DescribeClusterOutput{Cluster: cluster}, nil
+		}
+	}
+	return nil, errors.New("cluster not found")
+}
+
+func newOrgAccount(id string, status string) *organizations.Account {
+	return &organizations.Account{
+		Id:     aws.String(id),
+		Name:   aws.String("account-" + id),
+		Email:  aws.String(id + "@example.com"),
+		Arn:    aws.String("arn:aws:organizations::000000000000:account/o-example/" + id),
+		Status: aws.String(status),
+	}
+}
+
+func TestAWSOrganizationService_ListAccounts(t *testing.T) {
+	client := &fakeOrganizationsClient{
+		accounts: []*organizations.Account{
+			newOrgAccount("111111111111", organizations.AccountStatusActive),
+			newOrgAccount("222222222222", organizations.AccountStatusSuspended),
+			newOrgAccount("333333333333", organizations.AccountStatusActive),
+		},
+		accountsByParent: map[string][]*organizations.Account{
+			"ou-prod": {newOrgAccount("333333333333", organizations.AccountStatusActive)},
+		},
+		tags: map[string][]*organizations.Tag{
+			"111111111111": {{Key: aws.String("env"), Value: aws.String("dev")}},
+		},
+	}
+
+	t.Run("All", func(t *testing.T) {
+		svc := &AWSOrganizationService{organizationsClient: client}
+		accounts, err := svc.ListAccounts(context.Background())
+		require.NoError(t, err)
+		require.Len(t, accounts, 2)
+		assert.Equal(t, &Account{
+			ID:    "111111111111",
+			Name:  "account-111111111111",
+			Email: "111111111111@example.com",
+			ARN:   "arn:aws:organizations::000000000000:account/o-example/111111111111",
+			Tags:  map[string]string{"env": "dev"},
+		}, accounts[0])
+		assert.Equal(t, "333333333333", accounts[1].ID)
+		assert.Empty(t, accounts[1].Tags)
+	})
+	t.Run("OrganizationalUnits", func(t *testing.T) {
+		svc := &AWSOrganizationService{organizationsClient: client, organizationalUnits: []string{"ou-prod"}}
+		accounts, err := svc.ListAccounts(context.Background())
+		require.NoError(t, err)
+		require.Len(t, accounts, 1)
+		assert.Equal(t, "333333333333", accounts[0].ID)
+	})
+	t.Run("UnknownOrganizationalUnit", func(t *testing.T) {
+		svc := &AWSOrganizationService{organizationsClient: client, organizationalUnits: []string{"ou-unknown"}}
+		_, err := svc.ListAccounts(context.Background())
+		require.ErrorContains(t, err, "failed to list accounts of organizational unit ou-unknown")
+	})
+}
+
+func TestAWSOrganizationService_ListClusters(t *testing.T) {
+	eksClients := map[string]*fakeEKSClient{
+		"111111111111/eu-west-1": {clusters: []*eks.Cluster{{
+			Name:                 aws.String("prod"),
+			Arn:                  aws.String("arn:aws:eks:eu-west-1:111111111111:cluster/prod"),
+			Endpoint:             aws.String("https://prod.eks.amazonaws.com"),
+			Version:              aws.String("1.31"),
+			Status:               aws.String(eks.ClusterStatusActive),
+			CertificateAuthority: &eks.Certificate{Data: aws.String("Y2EtZGF0YQ==")},
+			Tags:                 map[string]*string{"team": aws.String("platform")},
+		}, {
+			Name:   aws.String("creating"),
+			Status: aws.String(eks.ClusterStatusCreating),
+		}}},
+		"111111111111/us-east-1": {},
+	}
+	svc := &AWSOrganizationService{newEKSClient: func(accountID string, region string) AWSEKSClient {
+		return eksClients[accountID+"/"+region]
+	}}
+
+	clusters, err := svc.ListClusters(context.Background(), &Account{ID: "111111111111"}, []string{"eu-west-1", "us-east-1"})
+	require.NoError(t, err)
+	assert.Equal(t, []*Cluster{{
+		Name:     "prod",
+		ARN:      "arn:aws:eks:eu-west-1:111111111111:cluster/prod",
+		Endpoint: "https://prod.eks.amazonaws.com",
+		Region:   "eu-west-1",
+		Version:  "1.31",
+		CAData:   "Y2EtZGF0YQ==",
+		Tags:     map[string]string{"team": "platform"},
+	}}, clusters)
+}
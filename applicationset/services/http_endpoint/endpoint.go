@@ -0,0 +1,173 @@
+package http_endpoint
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const (
+	userAgent      = "argocd-applicationset"
+	defaultTimeout = 30 * time.Second
+	// maxResponseSize is the maximum size of the response of an endpoint
+	maxResponseSize = 10 * 1024 * 1024
+	// cacheTTL is the duration a response is cached after it was last requested, so that the responses of the
+	// endpoints which are not requested anymore are evicted
+	cacheTTL = 24 * time.Hour
+)
+
+type cachedResponse struct {
+	etag         string
+	lastModified string
+	body         []byte
+	expiresAt    time.Time
+}
+
+// Service fetches the responses of HTTP endpoints, revalidating the responses with an ETag or a Last-Modified header
+// with conditional requests, so that an unchanged response is not transferred again.
+type Service struct {
+	client         *http.Client
+	insecureClient *http.Client
+	now            func() time.Time
+
+	lock  sync.Mutex
+	cache map[string]cachedResponse
+}
+
+// NewService returns a service fetching the responses of HTTP endpoints. If restrictAddresses is true, the connections
+// to the loopback, link-local and unspecified addresses are rejected, e.g. to the metadata endpoints of the cloud
+// providers.
+func NewService(restrictAddresses bool) *Service {
+	return &Service{
+		client:         newHTTPClient(false, restrictAddresses),
+		insecureClient: newHTTPClient(true, restrictAddresses),
+		now:            time.Now,
+		cache:          map[string]cachedResponse{},
+	}
+}
+
+func newHTTPClient(insecure bool, restrictAddresses bool) *http.Client {
+	tr := http.DefaultTransport.(*http.Transport).Clone()
+	if insecure {
+		tr.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+	if restrictAddresses {
+		// the addresses are checked once resolved, so that the host names resolving to the restricted addresses and
+		// the redirects to them are rejected as well
+		dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second, Control: checkAddress}
+		tr.DialContext = dialer.DialContext
+	}
+	return &http.Client{Timeout: defaultTimeout, Transport: tr}
+}
+
+// checkAddress rejects the connections to the loopback, link-local and unspecified addresses
+func checkAddress(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsUnspecified() {
+		return fmt.Errorf("connections to %s are not allowed", host)
+	}
+	return nil
+}
+
+// cacheKey returns the key of the cached response of the request, the headers holding credentials being hashed
+func cacheKey(url string, headers map[string]string) string {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	h := sha256.New()
+	_, _ = h.Write([]byte(url))
+	for _, name := range names {
+		_, _ = fmt.Fprintf(h, "\n%s: %s", name, headers[name])
+	}
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+// Get returns the body of the response of a GET request to the URL, with the given headers.
+func (s *Service) Get(ctx context.Context, url string, headers map[string]string, insecure bool) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	key := cacheKey(url, headers)
+	s.lock.Lock()
+	s.evictExpired()
+	cached, ok := s.cache[key]
+	s.lock.Unlock()
+	if ok {
+		if cached.etag != "" {
+			req.Header.Set("If-None-Match", cached.etag)
+		}
+		if cached.lastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.lastModified)
+		}
+	}
+
+	client := s.client
+	if insecure {
+		client = s.insecureClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error requesting %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if ok && resp.StatusCode == http.StatusNotModified {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		cached.expiresAt = s.now().Add(cacheTTL)
+		s.cache[key] = cached
+		return cached.body, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("unexpected status code %d from %s", resp.StatusCode, url)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("error reading the response of %s: %w", url, err)
+	}
+	if len(body) > maxResponseSize {
+		return nil, fmt.Errorf("the response of %s exceeds the maximum size of %d bytes", url, maxResponseSize)
+	}
+
+	etag, lastModified := resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if etag != "" || lastModified != "" {
+		s.cache[key] = cachedResponse{etag: etag, lastModified: lastModified, body: body, expiresAt: s.now().Add(cacheTTL)}
+	} else {
+		delete(s.cache, key)
+	}
+	return body, nil
+}
+
+// evictExpired removes the expired responses from the cache. The lock must be held.
+func (s *Service) evictExpired() {
+	now := s.now()
+	for key, cached := range s.cache {
+		if now.After(cached.expiresAt) {
+			delete(s.cache, key)
+		}
+	}
+}
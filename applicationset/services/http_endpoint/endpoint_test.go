@@ -0,0 +1,86 @@
+package http_endpoint
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Get(t *testing.T) {
+	requests := 0
+	notModified := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(`[{"name":"a"}]`))
+	}))
+	defer server.Close()
+
+	svc := NewService(false)
+	headers := map[string]string{"Authorization": "Bearer token"}
+
+	body, err := svc.Get(t.Context(), server.URL, headers, true)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"name":"a"}]`, string(body))
+
+	// the cached response is revalidated
+	body, err = svc.Get(t.Context(), server.URL, headers, true)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"name":"a"}]`, string(body))
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, 1, notModified)
+
+	_, err = svc.Get(t.Context(), server.URL, nil, true)
+	require.ErrorContains(t, err, "unexpected status code 401")
+
+	// the TLS certificate of the test server is not trusted
+	_, err = svc.Get(t.Context(), server.URL, headers, false)
+	require.Error(t, err)
+}
+
+func TestService_GetCacheExpiration(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	svc := NewService(false)
+	svc.now = func() time.Time { return now }
+	_, err := svc.Get(t.Context(), server.URL, nil, false)
+	require.NoError(t, err)
+	assert.Len(t, svc.cache, 1)
+
+	now = now.Add(cacheTTL + time.Second)
+	_, err = svc.Get(t.Context(), server.URL+"/other", nil, false)
+	require.NoError(t, err)
+	assert.Len(t, svc.cache, 1)
+	assert.NotContains(t, svc.cache, cacheKey(server.URL, nil))
+}
+
+func TestService_GetRestrictedAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	_, err := NewService(true).Get(t.Context(), server.URL, nil, false)
+	require.ErrorContains(t, err, "connections to 127.0.0.1 are not allowed")
+
+	_, err = NewService(false).Get(t.Context(), server.URL, nil, false)
+	require.NoError(t, err)
+}
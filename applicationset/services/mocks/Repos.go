@@ -13,9 +13,9 @@ type Repos struct {
 	mock.Mock
 }
 
-// GetDirectories provides a mock function with given fields: ctx, repoURL, revision, project, noRevisionCache, verifyCommit
-func (_m *Repos) GetDirectories(ctx context.Context, repoURL string, revision string, project string, noRevisionCache bool, verifyCommit bool) ([]string, error) {
-	ret := _m.Called(ctx, repoURL, revision, project, noRevisionCache, verifyCommit)
+// GetDirectories provides a mock function with given fields: ctx, repoURL, revision, project, includePaths, excludePaths, noRevisionCache, verifyCommit
+func (_m *Repos) GetDirectories(ctx context.Context, repoURL string, revision string, project string, includePaths []string, excludePaths []string, noRevisionCache bool, verifyCommit bool) ([]string, error) {
+	ret := _m.Called(ctx, repoURL, revision, project, includePaths, excludePaths, noRevisionCache, verifyCommit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetDirectories")
@@ -23,19 +23,19 @@ func (_m *Repos) GetDirectories(ctx context.Context, repoURL string, revision st
 
 	var r0 []string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, bool, bool) ([]string, error)); ok {
-		return rf(ctx, repoURL, revision, project, noRevisionCache, verifyCommit)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, []string, []string, bool, bool) ([]string, error)); ok {
+		return rf(ctx, repoURL, revision, project, includePaths, excludePaths, noRevisionCache, verifyCommit)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, bool, bool) []string); ok {
-		r0 = rf(ctx, repoURL, revision, project, noRevisionCache, verifyCommit)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, []string, []string, bool, bool) []string); ok {
+		r0 = rf(ctx, repoURL, revision, project, includePaths, excludePaths, noRevisionCache, verifyCommit)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, bool, bool) error); ok {
-		r1 = rf(ctx, repoURL, revision, project, noRevisionCache, verifyCommit)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, []string, []string, bool, bool) error); ok {
+		r1 = rf(ctx, repoURL, revision, project, includePaths, excludePaths, noRevisionCache, verifyCommit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -8,10 +8,16 @@ import (
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
 )
 
 const AZURE_DEVOPS_DEFAULT_URL = "https://dev.azure.com"
 
+// azureDevOpsPageSize is the number of pull requests requested per page
+const azureDevOpsPageSize = 100
+
 type AzureDevOpsClientFactory interface {
 	// Returns an Azure Devops Client interface.
 	GetClient(ctx context.Context) (git.Client, error)
@@ -41,7 +47,7 @@ var (
 	_ AzureDevOpsClientFactory = &devopsFactoryImpl{}
 )
 
-func NewAzureDevOpsService(token, url, organization, project, repo string, labels []string) (PullRequestService, error) {
+func NewAzureDevOpsService(token, url, organization, project, repo string, labels []string, insecure bool, scmRootCAPath string, caCerts []byte) (PullRequestService, error) {
 	organizationURL := buildURL(url, organization)
 
 	var connection *azuredevops.Connection
@@ -50,6 +56,11 @@ func NewAzureDevOpsService(token, url, organization, project, repo string, label
 	} else {
 		connection = azuredevops.NewPatConnection(organizationURL, token)
 	}
+	// Azure DevOps Server instances may use certificates signed by a private CA. The default transport is kept
+	// otherwise, as a custom TLS configuration replaces it.
+	if insecure || scmRootCAPath != "" || len(caCerts) > 0 {
+		connection.TlsConfig = utils.GetTlsConfig(scmRootCAPath, insecure, caCerts)
+	}
 
 	return &AzureDevOpsService{
 		clientFactory: &devopsFactoryImpl{connection: connection},
@@ -65,19 +76,31 @@ func (a *AzureDevOpsService) List(ctx context.Context) ([]*PullRequest, error) {
 		return nil, fmt.Errorf("failed to get Azure DevOps client: %w", err)
 	}
 
-	args := git.GetPullRequestsByProjectArgs{
-		Project:        &a.project,
-		SearchCriteria: &git.GitPullRequestSearchCriteria{},
-	}
+	var azurePullRequests []git.GitPullRequest
+	for skip := 0; ; skip += azureDevOpsPageSize {
+		args := git.GetPullRequestsByProjectArgs{
+			Project:        &a.project,
+			SearchCriteria: &git.GitPullRequestSearchCriteria{},
+			Top:            ptr.To(azureDevOpsPageSize),
+			Skip:           ptr.To(skip),
+		}
 
-	azurePullRequests, err := client.GetPullRequestsByProject(ctx, args)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get pull requests by project: %w", err)
+		page, err := client.GetPullRequestsByProject(ctx, args)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pull requests by project: %w", err)
+		}
+		if page == nil {
+			break
+		}
+		azurePullRequests = append(azurePullRequests, *page...)
+		if len(*page) < azureDevOpsPageSize {
+			break
+		}
 	}
 
 	pullRequests := []*PullRequest{}
 
-	for _, pr := range *azurePullRequests {
+	for _, pr := range azurePullRequests {
 		if pr.Repository == nil ||
 			pr.Repository.Name == nil ||
 			pr.PullRequestId == nil ||
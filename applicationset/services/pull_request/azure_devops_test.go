@@ -88,6 +88,8 @@ func TestListPullRequest(t *testing.T) {
 	args := git.GetPullRequestsByProjectArgs{
 		Project:        &teamProject,
 		SearchCriteria: &git.GitPullRequestSearchCriteria{},
+		Top:            createIntPtr(azureDevOpsPageSize),
+		Skip:           createIntPtr(0),
 	}
 
 	gitClientMock := azureMock.Client{}
@@ -113,6 +115,63 @@ func TestListPullRequest(t *testing.T) {
 	assert.Equal(t, uniqueName, list[0].Author)
 }
 
+func TestListPullRequestAzureDevOpsPagination(t *testing.T) {
+	teamProject := "myorg_project"
+	repoName := "myorg_project_repo"
+	ctx := t.Context()
+
+	newPullRequest := func(id int, repo string) git.GitPullRequest {
+		return git.GitPullRequest{
+			PullRequestId: createIntPtr(id),
+			Title:         createStringPtr("feat"),
+			SourceRefName: createStringPtr("refs/heads/feature-branch"),
+			TargetRefName: createStringPtr("refs/heads/main"),
+			LastMergeSourceCommit: &git.GitCommitRef{
+				CommitId: createStringPtr("cd4973d9d14a08ffe6b641a89a68891d6aac8056"),
+			},
+			Repository: &git.GitRepository{
+				Name: createStringPtr(repo),
+			},
+			CreatedBy: &webapi.IdentityRef{
+				UniqueName: createUniqueNamePtr("testName@example.com"),
+			},
+		}
+	}
+	firstPage := []git.GitPullRequest{}
+	for i := range azureDevOpsPageSize {
+		firstPage = append(firstPage, newPullRequest(i, "other_repo"))
+	}
+	secondPage := []git.GitPullRequest{newPullRequest(azureDevOpsPageSize, repoName)}
+
+	gitClientMock := azureMock.Client{}
+	clientFactoryMock := &AzureClientFactoryMock{mock: &mock.Mock{}}
+	clientFactoryMock.mock.On("GetClient", mock.Anything).Return(&gitClientMock, nil)
+	gitClientMock.On("GetPullRequestsByProject", ctx, git.GetPullRequestsByProjectArgs{
+		Project:        &teamProject,
+		SearchCriteria: &git.GitPullRequestSearchCriteria{},
+		Top:            createIntPtr(azureDevOpsPageSize),
+		Skip:           createIntPtr(0),
+	}).Return(&firstPage, nil)
+	gitClientMock.On("GetPullRequestsByProject", ctx, git.GetPullRequestsByProjectArgs{
+		Project:        &teamProject,
+		SearchCriteria: &git.GitPullRequestSearchCriteria{},
+		Top:            createIntPtr(azureDevOpsPageSize),
+		Skip:           createIntPtr(azureDevOpsPageSize),
+	}).Return(&secondPage, nil)
+
+	provider := AzureDevOpsService{
+		clientFactory: clientFactoryMock,
+		project:       teamProject,
+		repo:          repoName,
+	}
+
+	list, err := provider.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, azureDevOpsPageSize, list[0].Number)
+	gitClientMock.AssertExpectations(t)
+}
+
 func TestConvertLabes(t *testing.T) {
 	testCases := []struct {
 		name           string
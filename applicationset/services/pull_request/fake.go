@@ -7,9 +7,13 @@ import (
 type FakeService struct {
 	listPullReuests []*PullRequest
 	listError       error
+	comments        map[int][]string
 }
 
-var _ PullRequestService = (*FakeService)(nil)
+var (
+	_ PullRequestService = (*FakeService)(nil)
+	_ CommentService     = (*FakeService)(nil)
+)
 
 func NewFakeService(_ context.Context, listPullReuests []*PullRequest, listError error) (PullRequestService, error) {
 	return &FakeService{
@@ -21,3 +25,15 @@ func NewFakeService(_ context.Context, listPullReuests []*PullRequest, listError
 func (g *FakeService) List(_ context.Context) ([]*PullRequest, error) {
 	return g.listPullReuests, g.listError
 }
+
+// NewFakeCommentService returns a fake service listing the pull requests and their comments, indexed by number.
+func NewFakeCommentService(_ context.Context, listPullReuests []*PullRequest, comments map[int][]string) (PullRequestService, error) {
+	return &FakeService{
+		listPullReuests: listPullReuests,
+		comments:        comments,
+	}, nil
+}
+
+func (g *FakeService) ListComments(_ context.Context, number int) ([]string, error) {
+	return g.comments[number], nil
+}
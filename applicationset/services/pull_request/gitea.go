@@ -2,37 +2,41 @@ package pull_request
 
 import (
 	"context"
-	"crypto/tls"
+	"fmt"
 	"net/http"
 	"net/http/cookiejar"
 	"os"
 
 	"code.gitea.io/sdk/gitea"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
 )
 
+// giteaPageSize is the number of pull requests requested per page, Gitea and Forgejo cap it to their MAX_RESPONSE_ITEMS
+// setting
+const giteaPageSize = 50
+
 type GiteaService struct {
 	client *gitea.Client
 	owner  string
 	repo   string
 }
 
-var _ PullRequestService = (*GiteaService)(nil)
+var (
+	_ PullRequestService = (*GiteaService)(nil)
+	_ CommentService     = (*GiteaService)(nil)
+)
 
-func NewGiteaService(token, url, owner, repo string, insecure bool) (PullRequestService, error) {
+func NewGiteaService(token, url, owner, repo string, insecure bool, scmRootCAPath string, caCerts []byte) (PullRequestService, error) {
 	if token == "" {
 		token = os.Getenv("GITEA_TOKEN")
 	}
-	httpClient := &http.Client{}
-	if insecure {
-		cookieJar, _ := cookiejar.New(nil)
-
-		tr := http.DefaultTransport.(*http.Transport).Clone()
-		tr.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
-
-		httpClient = &http.Client{
-			Jar:       cookieJar,
-			Transport: tr,
-		}
+	cookieJar, _ := cookiejar.New(nil)
+	tr := http.DefaultTransport.(*http.Transport).Clone()
+	tr.TLSClientConfig = utils.GetTlsConfig(scmRootCAPath, insecure, caCerts)
+	httpClient := &http.Client{
+		Jar:       cookieJar,
+		Transport: utils.NewRateLimitTransport(tr),
 	}
 	client, err := gitea.NewClient(url, gitea.SetToken(token), gitea.SetHTTPClient(httpClient))
 	if err != nil {
@@ -47,28 +51,87 @@ func NewGiteaService(token, url, owner, repo string, insecure bool) (PullRequest
 
 func (g *GiteaService) List(ctx context.Context) ([]*PullRequest, error) {
 	opts := gitea.ListPullRequestsOptions{
-		State: gitea.StateOpen,
+		ListOptions: gitea.ListOptions{PageSize: giteaPageSize},
+		State:       gitea.StateOpen,
 	}
 	g.client.SetContext(ctx)
-	prs, _, err := g.client.ListRepoPullRequests(g.owner, g.repo, opts)
-	if err != nil {
-		return nil, err
-	}
 	list := []*PullRequest{}
-	for _, pr := range prs {
-		list = append(list, &PullRequest{
-			Number:       int(pr.Index),
-			Title:        pr.Title,
-			Branch:       pr.Head.Ref,
-			TargetBranch: pr.Base.Ref,
-			HeadSHA:      pr.Head.Sha,
-			Labels:       getGiteaPRLabelNames(pr.Labels),
-			Author:       pr.Poster.UserName,
-		})
+	for {
+		prs, resp, err := g.client.ListRepoPullRequests(g.owner, g.repo, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, pr := range prs {
+			list = append(list, &PullRequest{
+				Number:       int(pr.Index),
+				Title:        pr.Title,
+				Branch:       pr.Head.Ref,
+				TargetBranch: pr.Base.Ref,
+				HeadSHA:      pr.Head.Sha,
+				Labels:       getGiteaPRLabelNames(pr.Labels),
+				Author:       pr.Poster.UserName,
+			})
+		}
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
 	}
 	return list, nil
 }
 
+func (g *GiteaService) ListComments(ctx context.Context, number int) ([]string, error) {
+	opts := gitea.ListIssueCommentOptions{
+		ListOptions: gitea.ListOptions{PageSize: giteaPageSize},
+	}
+	g.client.SetContext(ctx)
+	comments := []string{}
+	canWrite := map[string]bool{}
+	for {
+		issueComments, resp, err := g.client.ListIssueComments(g.owner, g.repo, int64(number), opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, comment := range issueComments {
+			if comment.Poster == nil {
+				continue
+			}
+			allowed, ok := canWrite[comment.Poster.UserName]
+			if !ok {
+				allowed, err = g.canWrite(comment.Poster.UserName)
+				if err != nil {
+					return nil, err
+				}
+				canWrite[comment.Poster.UserName] = allowed
+			}
+			if allowed {
+				comments = append(comments, comment.Body)
+			}
+		}
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return comments, nil
+}
+
+// canWrite returns true if the user has the write or admin permission on the repository
+func (g *GiteaService) canWrite(user string) (bool, error) {
+	permission, resp, err := g.client.CollaboratorPermission(g.owner, g.repo, user)
+	if resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusForbidden) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("error getting the permission of %s on %s/%s: %w", user, g.owner, g.repo, err)
+	}
+	switch permission.Permission {
+	case gitea.AccessModeWrite, gitea.AccessModeAdmin, gitea.AccessModeOwner:
+		return true, nil
+	}
+	return false, nil
+}
+
 // Get the Gitea pull request label names.
 func getGiteaPRLabelNames(giteaLabels []*gitea.Label) []string {
 	var labelNames []string
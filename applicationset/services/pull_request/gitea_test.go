@@ -23,7 +23,7 @@ func giteaMockHandler(t *testing.T) func(http.ResponseWriter, *http.Request) {
 			if err != nil {
 				t.Fail()
 			}
-		case "/api/v1/repos/test-argocd/pr-test/pulls?limit=0&page=1&state=open":
+		case "/api/v1/repos/test-argocd/pr-test/pulls?limit=50&page=1&state=open":
 			_, err := io.WriteString(w, `[{
 				"id": 50721,
 				"url": "https://gitea.com/test-argocd/pr-test/pulls/1",
@@ -250,7 +250,7 @@ func TestGiteaList(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		giteaMockHandler(t)(w, r)
 	}))
-	host, err := NewGiteaService("", ts.URL, "test-argocd", "pr-test", false)
+	host, err := NewGiteaService("", ts.URL, "test-argocd", "pr-test", false, "", nil)
 	require.NoError(t, err)
 	prs, err := host.List(t.Context())
 	require.NoError(t, err)
@@ -16,7 +16,10 @@ type GithubService struct {
 	labels []string
 }
 
-var _ PullRequestService = (*GithubService)(nil)
+var (
+	_ PullRequestService = (*GithubService)(nil)
+	_ CommentService     = (*GithubService)(nil)
+)
 
 func NewGithubService(token, url, owner, repo string, labels []string) (PullRequestService, error) {
 	// Undocumented environment variable to set a default token, to be used in testing to dodge anonymous rate limits.
@@ -80,6 +83,61 @@ func (g *GithubService) List(ctx context.Context) ([]*PullRequest, error) {
 	return pullRequests, nil
 }
 
+func (g *GithubService) ListComments(ctx context.Context, number int) ([]string, error) {
+	opts := &github.IssueListCommentsOptions{
+		ListOptions: github.ListOptions{
+			PerPage: 100,
+		},
+	}
+	comments := []string{}
+	canWrite := map[string]bool{}
+	for {
+		issueComments, resp, err := g.client.Issues.ListComments(ctx, g.owner, g.repo, number, opts)
+		if err != nil {
+			return nil, fmt.Errorf("error listing comments of pull request %d for %s/%s: %w", number, g.owner, g.repo, err)
+		}
+		for _, comment := range issueComments {
+			login := comment.GetUser().GetLogin()
+			allowed, ok := canWrite[login]
+			if !ok {
+				allowed, err = g.canWrite(ctx, login)
+				if err != nil {
+					return nil, err
+				}
+				canWrite[login] = allowed
+			}
+			if allowed {
+				comments = append(comments, comment.GetBody())
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return comments, nil
+}
+
+// canWrite returns true if the user has the write, maintain or admin permission on the repository
+func (g *GithubService) canWrite(ctx context.Context, login string) (bool, error) {
+	if login == "" {
+		return false, nil
+	}
+	permission, resp, err := g.client.Repositories.GetPermissionLevel(ctx, g.owner, g.repo, login)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("error getting the permission of %s on %s/%s: %w", login, g.owner, g.repo, err)
+	}
+	// the maintain role is reported with the write permission
+	switch permission.GetPermission() {
+	case "write", "admin":
+		return true, nil
+	}
+	return false, nil
+}
+
 // containLabels returns true if gotLabels contains expectedLabels
 func containLabels(expectedLabels []string, gotLabels []*github.Label) bool {
 	for _, expected := range expectedLabels {
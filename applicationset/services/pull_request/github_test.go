@@ -1,9 +1,13 @@
 package pull_request
 
 import (
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/google/go-github/v69/github"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -86,3 +90,32 @@ func TestGetGitHubPRLabelNames(t *testing.T) {
 		})
 	}
 }
+
+func TestGithubListComments(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v3/repos/argoproj/argo-cd/issues/1/comments", func(w http.ResponseWriter, _ *http.Request) {
+		_, err := io.WriteString(w, `[{"body":"/argocd set image=foo:bar","user":{"login":"maintainer"}},{"body":"/argocd set image=evil","user":{"login":"reader"}},{"body":"/argocd set image=evil","user":{"login":"outsider"}}]`)
+		require.NoError(t, err)
+	})
+	mux.HandleFunc("/api/v3/repos/argoproj/argo-cd/collaborators/maintainer/permission", func(w http.ResponseWriter, _ *http.Request) {
+		_, err := io.WriteString(w, `{"permission":"write","role_name":"maintain"}`)
+		require.NoError(t, err)
+	})
+	mux.HandleFunc("/api/v3/repos/argoproj/argo-cd/collaborators/reader/permission", func(w http.ResponseWriter, _ *http.Request) {
+		_, err := io.WriteString(w, `{"permission":"read","role_name":"read"}`)
+		require.NoError(t, err)
+	})
+	mux.HandleFunc("/api/v3/repos/argoproj/argo-cd/collaborators/outsider/permission", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	svc, err := NewGithubService("", server.URL, "argoproj", "argo-cd", nil)
+	require.NoError(t, err)
+
+	comments, err := svc.(CommentService).ListComments(t.Context(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/argocd set image=foo:bar"}, comments)
+}
@@ -19,7 +19,10 @@ type GitLabService struct {
 	pullRequestState string
 }
 
-var _ PullRequestService = (*GitLabService)(nil)
+var (
+	_ PullRequestService = (*GitLabService)(nil)
+	_ CommentService     = (*GitLabService)(nil)
+)
 
 func NewGitLabService(token, url, project string, labels []string, pullRequestState string, scmRootCAPath string, insecure bool, caCerts []byte) (PullRequestService, error) {
 	var clientOptionFns []gitlab.ClientOptionFunc
@@ -96,3 +99,56 @@ func (g *GitLabService) List(ctx context.Context) ([]*PullRequest, error) {
 	}
 	return pullRequests, nil
 }
+
+func (g *GitLabService) ListComments(ctx context.Context, number int) ([]string, error) {
+	opts := &gitlab.ListMergeRequestNotesOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+		},
+		OrderBy: gitlab.Ptr("created_at"),
+		Sort:    gitlab.Ptr("asc"),
+	}
+	comments := []string{}
+	canWrite := map[int]bool{}
+	for {
+		notes, resp, err := g.client.Notes.ListMergeRequestNotes(g.project, number, opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("error listing notes of merge request %d for project '%s': %w", number, g.project, err)
+		}
+		for _, note := range notes {
+			// System notes record the events of the merge request, e.g. the pushed commits
+			if note.System {
+				continue
+			}
+			allowed, ok := canWrite[note.Author.ID]
+			if !ok {
+				allowed, err = g.canWrite(ctx, note.Author.ID)
+				if err != nil {
+					return nil, err
+				}
+				canWrite[note.Author.ID] = allowed
+			}
+			if allowed {
+				comments = append(comments, note.Body)
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return comments, nil
+}
+
+// canWrite returns true if the user is a member of the project, directly or through its groups, with at least the
+// developer role, which is allowed to push to the repository
+func (g *GitLabService) canWrite(ctx context.Context, userID int) (bool, error) {
+	member, resp, err := g.client.ProjectMembers.GetInheritedProjectMember(g.project, userID, gitlab.WithContext(ctx))
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("error getting the membership of user %d in project '%s': %w", userID, g.project, err)
+	}
+	return member.AccessLevel >= gitlab.DeveloperPermissions, nil
+}
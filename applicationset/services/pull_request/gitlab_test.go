@@ -191,3 +191,36 @@ func TestListWithStateTLS(t *testing.T) {
 		})
 	}
 }
+
+func TestListComments(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	path := "/api/v4/projects/278964/merge_requests/15442/notes"
+
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, path+"?order_by=created_at&per_page=100&sort=asc", r.URL.RequestURI())
+		_, err := io.WriteString(w, `[{"id":1,"body":"added 1 commit","system":true},{"id":2,"body":"/argocd set image=foo:bar","system":false,"author":{"id":10}},{"id":3,"body":"/argocd set image=evil","system":false,"author":{"id":11}},{"id":4,"body":"/argocd set image=evil","system":false,"author":{"id":12}}]`)
+		require.NoError(t, err)
+	})
+	// the comments of the users without the developer role are ignored
+	mux.HandleFunc("/api/v4/projects/278964/members/all/10", func(w http.ResponseWriter, _ *http.Request) {
+		_, err := io.WriteString(w, `{"id":10,"access_level":40}`)
+		require.NoError(t, err)
+	})
+	mux.HandleFunc("/api/v4/projects/278964/members/all/11", func(w http.ResponseWriter, _ *http.Request) {
+		_, err := io.WriteString(w, `{"id":11,"access_level":20}`)
+		require.NoError(t, err)
+	})
+	mux.HandleFunc("/api/v4/projects/278964/members/all/12", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	svc, err := NewGitLabService("", server.URL, "278964", []string{}, "", "", false, nil)
+	require.NoError(t, err)
+
+	comments, err := svc.(CommentService).ListComments(t.Context(), 15442)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/argocd set image=foo:bar"}, comments)
+}
@@ -27,6 +27,13 @@ type PullRequestService interface {
 	List(ctx context.Context) ([]*PullRequest, error)
 }
 
+// CommentService is implemented by the pull request services which can list the comments of the pull requests.
+type CommentService interface {
+	// ListComments gets the bodies of the comments of a pull request written by users with the write or maintainer
+	// permission on the repository, from the oldest to the newest. The comments of the other users are ignored.
+	ListComments(ctx context.Context, number int) ([]string, error)
+}
+
 type Filter struct {
 	BranchMatch       *regexp.Regexp
 	TargetBranchMatch *regexp.Regexp
@@ -22,8 +22,9 @@ type Repos interface {
 	// GetFiles returns content of files (not directories) within the target repo
 	GetFiles(ctx context.Context, repoURL, revision, project, pattern string, noRevisionCache, verifyCommit bool) (map[string][]byte, error)
 
-	// GetDirectories returns a list of directories (not files) within the target repo
-	GetDirectories(ctx context.Context, repoURL, revision, project string, noRevisionCache, verifyCommit bool) ([]string, error)
+	// GetDirectories returns a list of directories (not files) within the target repo, matching the include patterns, or
+	// all the directories if empty, and not matching the exclude patterns
+	GetDirectories(ctx context.Context, repoURL, revision, project string, includePaths, excludePaths []string, noRevisionCache, verifyCommit bool) ([]string, error)
 }
 
 func NewArgoCDService(db db.ArgoDB, submoduleEnabled bool, repoClientset apiclient.Clientset, newFileGlobbingEnabled bool) Repos {
@@ -72,7 +73,7 @@ func (a *argoCDService) GetFiles(ctx context.Context, repoURL, revision, project
 	return fileResponse.GetMap(), nil
 }
 
-func (a *argoCDService) GetDirectories(ctx context.Context, repoURL, revision, project string, noRevisionCache, verifyCommit bool) ([]string, error) {
+func (a *argoCDService) GetDirectories(ctx context.Context, repoURL, revision, project string, includePaths, excludePaths []string, noRevisionCache, verifyCommit bool) ([]string, error) {
 	repo, err := a.getRepository(ctx, repoURL, project)
 	if err != nil {
 		return nil, fmt.Errorf("error in GetRepository: %w", err)
@@ -84,6 +85,8 @@ func (a *argoCDService) GetDirectories(ctx context.Context, repoURL, revision, p
 		Revision:         revision,
 		NoRevisionCache:  noRevisionCache,
 		VerifyCommit:     verifyCommit,
+		IncludePaths:     includePaths,
+		ExcludePaths:     excludePaths,
 	}
 
 	dirResponse, err := a.getGitDirectoriesFromRepoServer(ctx, dirRequest)
@@ -10,6 +10,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
 	azureGit "github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
 )
 
 const AZURE_DEVOPS_DEFAULT_URL = "https://dev.azure.com"
@@ -57,7 +59,7 @@ var (
 	_ AzureDevOpsClientFactory = &devopsFactoryImpl{}
 )
 
-func NewAzureDevOpsProvider(accessToken string, org string, url string, project string, allBranches bool) (*AzureDevOpsProvider, error) {
+func NewAzureDevOpsProvider(accessToken string, org string, url string, project string, allBranches bool, insecure bool, scmRootCAPath string, caCerts []byte) (*AzureDevOpsProvider, error) {
 	if accessToken == "" {
 		return nil, errors.New("no access token provided")
 	}
@@ -68,6 +70,11 @@ func NewAzureDevOpsProvider(accessToken string, org string, url string, project
 	}
 
 	connection := azuredevops.NewPatConnection(devOpsURL, accessToken)
+	// Azure DevOps Server instances may use certificates signed by a private CA. The default transport is kept
+	// otherwise, as a custom TLS configuration replaces it.
+	if insecure || scmRootCAPath != "" || len(caCerts) > 0 {
+		connection.TlsConfig = utils.GetTlsConfig(scmRootCAPath, insecure, caCerts)
+	}
 
 	return &AzureDevOpsProvider{organization: org, teamProject: project, accessToken: accessToken, clientFactory: &devopsFactoryImpl{connection: connection}, allBranches: allBranches}, nil
 }
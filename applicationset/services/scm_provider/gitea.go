@@ -2,15 +2,19 @@ package scm_provider
 
 import (
 	"context"
-	"crypto/tls"
 	"fmt"
 	"net/http"
 	"net/http/cookiejar"
 	"os"
 
 	"code.gitea.io/sdk/gitea"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
 )
 
+// giteaPageSize is the number of items requested per page, Gitea and Forgejo cap it to their MAX_RESPONSE_ITEMS setting
+const giteaPageSize = 50
+
 type GiteaProvider struct {
 	client      *gitea.Client
 	owner       string
@@ -19,21 +23,16 @@ type GiteaProvider struct {
 
 var _ SCMProviderService = &GiteaProvider{}
 
-func NewGiteaProvider(owner, token, url string, allBranches, insecure bool) (*GiteaProvider, error) {
+func NewGiteaProvider(owner, token, url string, allBranches, insecure bool, scmRootCAPath string, caCerts []byte) (*GiteaProvider, error) {
 	if token == "" {
 		token = os.Getenv("GITEA_TOKEN")
 	}
-	httpClient := &http.Client{}
-	if insecure {
-		cookieJar, _ := cookiejar.New(nil)
-
-		tr := http.DefaultTransport.(*http.Transport).Clone()
-		tr.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
-
-		httpClient = &http.Client{
-			Jar:       cookieJar,
-			Transport: tr,
-		}
+	cookieJar, _ := cookiejar.New(nil)
+	tr := http.DefaultTransport.(*http.Transport).Clone()
+	tr.TLSClientConfig = utils.GetTlsConfig(scmRootCAPath, insecure, caCerts)
+	httpClient := &http.Client{
+		Jar:       cookieJar,
+		Transport: utils.NewRateLimitTransport(tr),
 	}
 	client, err := gitea.NewClient(url, gitea.SetToken(token), gitea.SetHTTPClient(httpClient))
 	if err != nil {
@@ -68,31 +67,45 @@ func (g *GiteaProvider) GetBranches(_ context.Context, repo *Repository) ([]*Rep
 		}, nil
 	}
 	repos := []*Repository{}
-	opts := gitea.ListRepoBranchesOptions{}
-	branches, _, err := g.client.ListRepoBranches(g.owner, repo.Repository, opts)
-	if err != nil {
-		return nil, err
-	}
-	for _, branch := range branches {
-		repos = append(repos, &Repository{
-			Organization: repo.Organization,
-			Repository:   repo.Repository,
-			Branch:       branch.Name,
-			URL:          repo.URL,
-			SHA:          branch.Commit.ID,
-			Labels:       repo.Labels,
-			RepositoryId: repo.RepositoryId,
-		})
+	opts := gitea.ListRepoBranchesOptions{ListOptions: gitea.ListOptions{PageSize: giteaPageSize}}
+	for {
+		branches, resp, err := g.client.ListRepoBranches(g.owner, repo.Repository, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, branch := range branches {
+			repos = append(repos, &Repository{
+				Organization: repo.Organization,
+				Repository:   repo.Repository,
+				Branch:       branch.Name,
+				URL:          repo.URL,
+				SHA:          branch.Commit.ID,
+				Labels:       repo.Labels,
+				RepositoryId: repo.RepositoryId,
+			})
+		}
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
 	}
 	return repos, nil
 }
 
 func (g *GiteaProvider) ListRepos(_ context.Context, cloneProtocol string) ([]*Repository, error) {
 	repos := []*Repository{}
-	repoOpts := gitea.ListOrgReposOptions{}
-	giteaRepos, _, err := g.client.ListOrgRepos(g.owner, repoOpts)
-	if err != nil {
-		return nil, err
+	var giteaRepos []*gitea.Repository
+	repoOpts := gitea.ListOrgReposOptions{ListOptions: gitea.ListOptions{PageSize: giteaPageSize}}
+	for {
+		page, resp, err := g.client.ListOrgRepos(g.owner, repoOpts)
+		if err != nil {
+			return nil, err
+		}
+		giteaRepos = append(giteaRepos, page...)
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		repoOpts.Page = resp.NextPage
 	}
 	for _, repo := range giteaRepos {
 		var url string
@@ -105,14 +118,20 @@ func (g *GiteaProvider) ListRepos(_ context.Context, cloneProtocol string) ([]*R
 		default:
 			return nil, fmt.Errorf("unknown clone protocol for GitHub %v", cloneProtocol)
 		}
-		labelOpts := gitea.ListLabelsOptions{}
-		giteaLabels, _, err := g.client.ListRepoLabels(g.owner, repo.Name, labelOpts)
-		if err != nil {
-			return nil, err
-		}
 		labels := []string{}
-		for _, label := range giteaLabels {
-			labels = append(labels, label.Name)
+		labelOpts := gitea.ListLabelsOptions{ListOptions: gitea.ListOptions{PageSize: giteaPageSize}}
+		for {
+			giteaLabels, resp, err := g.client.ListRepoLabels(g.owner, repo.Name, labelOpts)
+			if err != nil {
+				return nil, err
+			}
+			for _, label := range giteaLabels {
+				labels = append(labels, label.Name)
+			}
+			if resp == nil || resp.NextPage == 0 {
+				break
+			}
+			labelOpts.Page = resp.NextPage
 		}
 		repos = append(repos, &Repository{
 			Organization: g.owner,
@@ -23,7 +23,7 @@ func giteaMockHandler(t *testing.T) func(http.ResponseWriter, *http.Request) {
 			if err != nil {
 				t.Fail()
 			}
-		case "/api/v1/orgs/test-argocd/repos?limit=0&page=1":
+		case "/api/v1/orgs/test-argocd/repos?limit=50&page=1":
 			_, err := io.WriteString(w, `[{
 					"id": 21618,
 					"owner": {
@@ -144,7 +144,7 @@ func giteaMockHandler(t *testing.T) func(http.ResponseWriter, *http.Request) {
 			if err != nil {
 				t.Fail()
 			}
-		case "/api/v1/repos/test-argocd/pr-test/branches?limit=0&page=1":
+		case "/api/v1/repos/test-argocd/pr-test/branches?limit=50&page=1":
 			_, err := io.WriteString(w, `[{
 				"name": "main",
 				"commit": {
@@ -303,7 +303,7 @@ func TestGiteaListRepos(t *testing.T) {
 	defer ts.Close()
 	for _, c := range cases {
 		t.Run(c.name, func(t *testing.T) {
-			provider, _ := NewGiteaProvider("test-argocd", "", ts.URL, c.allBranches, false)
+			provider, _ := NewGiteaProvider("test-argocd", "", ts.URL, c.allBranches, false, "", nil)
 			rawRepos, err := ListRepos(t.Context(), provider, c.filters, c.proto)
 			if c.hasError {
 				require.Error(t, err)
@@ -328,12 +328,47 @@ func TestGiteaListRepos(t *testing.T) {
 	}
 }
 
+func TestGiteaListReposPagination(t *testing.T) {
+	rateLimited := false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.RequestURI {
+		case "/api/v1/version":
+			_, _ = io.WriteString(w, `{"version":"1.17.0+dev-452-g1f0541780"}`)
+		case "/api/v1/orgs/test-argocd/repos?limit=50&page=1":
+			w.Header().Set("Link", `<`+"http://"+r.Host+`/api/v1/orgs/test-argocd/repos?limit=50&page=2>; rel="next"`)
+			_, _ = io.WriteString(w, `[{"id": 1, "name": "repo1", "default_branch": "main", "ssh_url": "git@gitea.com:test-argocd/repo1.git"}]`)
+		case "/api/v1/orgs/test-argocd/repos?limit=50&page=2":
+			// the second page is rejected once by the rate limit
+			if !rateLimited {
+				rateLimited = true
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			_, _ = io.WriteString(w, `[{"id": 2, "name": "repo2", "default_branch": "main", "ssh_url": "git@gitea.com:test-argocd/repo2.git"}]`)
+		default:
+			_, _ = io.WriteString(w, `[]`)
+		}
+	}))
+	defer ts.Close()
+
+	provider, err := NewGiteaProvider("test-argocd", "", ts.URL, false, false, "", nil)
+	require.NoError(t, err)
+	repos, err := provider.ListRepos(t.Context(), "ssh")
+	require.NoError(t, err)
+	require.Len(t, repos, 2)
+	assert.Equal(t, "repo1", repos[0].Repository)
+	assert.Equal(t, "repo2", repos[1].Repository)
+	assert.True(t, rateLimited)
+}
+
 func TestGiteaHasPath(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		giteaMockHandler(t)(w, r)
 	}))
 	defer ts.Close()
-	host, _ := NewGiteaProvider("gitea", "", ts.URL, false, false)
+	host, _ := NewGiteaProvider("gitea", "", ts.URL, false, false, "", nil)
 	repo := &Repository{
 		Organization: "gitea",
 		Repository:   "go-sdk",
@@ -1,6 +1,8 @@
 package status
 
 import (
+	"github.com/argoproj/gitops-engine/pkg/health"
+
 	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
@@ -26,6 +28,33 @@ func BuildResourceStatus(statusMap map[string]argov1alpha1.ResourceStatus, apps
 	return statusMap
 }
 
+// BuildApplicationsSummary counts the Applications of the statuses per health and sync status. The statuses are
+// expected to be sorted by name, so that the Degraded Applications are listed in order.
+func BuildApplicationsSummary(statuses []argov1alpha1.ResourceStatus) *argov1alpha1.ApplicationSetApplicationsSummary {
+	summary := &argov1alpha1.ApplicationSetApplicationsSummary{
+		Total:  int64(len(statuses)),
+		Health: map[string]int64{},
+		Sync:   map[string]int64{},
+	}
+	for _, status := range statuses {
+		healthStatus := health.HealthStatusUnknown
+		if status.Health != nil && status.Health.Status != "" {
+			healthStatus = status.Health.Status
+		}
+		summary.Health[string(healthStatus)]++
+		if healthStatus == health.HealthStatusDegraded {
+			summary.Degraded = append(summary.Degraded, status.Name)
+		}
+
+		syncStatus := status.Status
+		if syncStatus == "" {
+			syncStatus = argov1alpha1.SyncStatusCodeUnknown
+		}
+		summary.Sync[string(syncStatus)]++
+	}
+	return summary
+}
+
 func GetResourceStatusMap(appset *argov1alpha1.ApplicationSet) map[string]argov1alpha1.ResourceStatus {
 	statusMap := map[string]argov1alpha1.ResourceStatus{}
 	for _, status := range appset.Status.Resources {
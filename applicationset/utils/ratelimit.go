@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// rateLimitMaxRetries is the maximum number of retries of a request rejected by the rate limit of an SCM provider
+	rateLimitMaxRetries = 3
+	// rateLimitMaxDelay is the maximum delay to wait for before retrying a request, the requests which must be delayed
+	// longer are not retried so that the reconciliation is not blocked
+	rateLimitMaxDelay = time.Minute
+	// rateLimitDefaultDelay is the delay before retrying a request when the SCM provider doesn't return one
+	rateLimitDefaultDelay = time.Second
+)
+
+// rateLimitTransport retries the GET requests rejected by the rate limit of an SCM provider, after the delay requested
+// by the provider.
+type rateLimitTransport struct {
+	base http.RoundTripper
+	// now and sleep are overridden by the tests
+	now   func() time.Time
+	sleep func(req *http.Request, d time.Duration) error
+}
+
+// NewRateLimitTransport returns a transport retrying the GET requests rejected by the rate limit of an SCM provider,
+// e.g. when paginating through the repositories of a large organization.
+func NewRateLimitTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &rateLimitTransport{base: base, now: time.Now, sleep: sleepWithContext}
+}
+
+func sleepWithContext(req *http.Request, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-req.Context().Done():
+		return req.Context().Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := t.base.RoundTrip(req)
+		if err != nil || req.Method != http.MethodGet || attempt >= rateLimitMaxRetries {
+			return resp, err
+		}
+		delay, limited := t.rateLimitDelay(resp, attempt)
+		if !limited || delay > rateLimitMaxDelay {
+			return resp, nil
+		}
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		if err := t.sleep(req, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// rateLimitDelay returns the delay to wait for before retrying the request, and whether the response was rejected by
+// the rate limit of the SCM provider.
+func (t *rateLimitTransport) rateLimitDelay(resp *http.Response, attempt int) (time.Duration, bool) {
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+	case resp.StatusCode == http.StatusForbidden && resp.Header.Get("X-RateLimit-Remaining") == "0":
+	default:
+		return 0, false
+	}
+
+	if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if date, err := http.ParseTime(retryAfter); err == nil {
+			return max(date.Sub(t.now()), 0), true
+		}
+	}
+	if reset := resp.Header.Get("X-RateLimit-Reset"); reset != "" {
+		if epoch, err := strconv.ParseInt(reset, 10, 64); err == nil {
+			return max(time.Unix(epoch, 0).Sub(t.now()), 0), true
+		}
+	}
+	return rateLimitDefaultDelay << attempt, true
+}
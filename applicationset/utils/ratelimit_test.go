@@ -0,0 +1,117 @@
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitTransport(t *testing.T) {
+	cases := []struct {
+		name             string
+		method           string
+		headers          map[string]string
+		status           int
+		rejections       int
+		expectedStatus   int
+		expectedRequests int
+		expectedDelays   []time.Duration
+	}{
+		{
+			name:             "retries after the Retry-After delay",
+			method:           http.MethodGet,
+			headers:          map[string]string{"Retry-After": "2"},
+			status:           http.StatusTooManyRequests,
+			rejections:       1,
+			expectedStatus:   http.StatusOK,
+			expectedRequests: 2,
+			expectedDelays:   []time.Duration{2 * time.Second},
+		},
+		{
+			name:             "retries after the rate limit reset",
+			method:           http.MethodGet,
+			headers:          map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "1010"},
+			status:           http.StatusForbidden,
+			rejections:       1,
+			expectedStatus:   http.StatusOK,
+			expectedRequests: 2,
+			expectedDelays:   []time.Duration{10 * time.Second},
+		},
+		{
+			name:             "backs off without delay requested",
+			method:           http.MethodGet,
+			status:           http.StatusTooManyRequests,
+			rejections:       5,
+			expectedStatus:   http.StatusTooManyRequests,
+			expectedRequests: 4,
+			expectedDelays:   []time.Duration{time.Second, 2 * time.Second, 4 * time.Second},
+		},
+		{
+			name:             "does not wait longer than the maximum delay",
+			method:           http.MethodGet,
+			headers:          map[string]string{"Retry-After": "3600"},
+			status:           http.StatusTooManyRequests,
+			rejections:       1,
+			expectedStatus:   http.StatusTooManyRequests,
+			expectedRequests: 1,
+		},
+		{
+			name:             "does not retry forbidden requests",
+			method:           http.MethodGet,
+			status:           http.StatusForbidden,
+			rejections:       1,
+			expectedStatus:   http.StatusForbidden,
+			expectedRequests: 1,
+		},
+		{
+			name:             "does not retry other methods",
+			method:           http.MethodPost,
+			status:           http.StatusTooManyRequests,
+			rejections:       1,
+			expectedStatus:   http.StatusTooManyRequests,
+			expectedRequests: 1,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				requests++
+				if requests <= c.rejections {
+					for name, value := range c.headers {
+						w.Header().Set(name, value)
+					}
+					w.WriteHeader(c.status)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			var delays []time.Duration
+			transport := &rateLimitTransport{
+				base: http.DefaultTransport,
+				now:  func() time.Time { return time.Unix(1000, 0) },
+				sleep: func(_ *http.Request, d time.Duration) error {
+					delays = append(delays, d)
+					return nil
+				},
+			}
+
+			req, err := http.NewRequestWithContext(t.Context(), c.method, server.URL, http.NoBody)
+			require.NoError(t, err)
+			resp, err := transport.RoundTrip(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, c.expectedStatus, resp.StatusCode)
+			assert.Equal(t, c.expectedRequests, requests)
+			assert.Equal(t, c.expectedDelays, delays)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"html"
+	"maps"
 	"net/http"
 	"net/url"
 	"regexp"
@@ -22,6 +23,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/webhook"
 
 	"github.com/go-playground/webhooks/v6/azuredevops"
+	bitbucketserver "github.com/go-playground/webhooks/v6/bitbucket-server"
 	"github.com/go-playground/webhooks/v6/github"
 	"github.com/go-playground/webhooks/v6/gitlab"
 	log "github.com/sirupsen/logrus"
@@ -30,26 +32,28 @@ import (
 const payloadQueueSize = 50000
 
 type WebhookHandler struct {
-	sync.WaitGroup // for testing
-	namespace      string
-	github         *github.Webhook
-	gitlab         *gitlab.Webhook
-	azuredevops    *azuredevops.Webhook
-	client         client.Client
-	generators     map[string]generators.Generator
-	queue          chan any
+	sync.WaitGroup  // for testing
+	namespace       string
+	github          *github.Webhook
+	gitlab          *gitlab.Webhook
+	azuredevops     *azuredevops.Webhook
+	bitbucketserver *bitbucketserver.Webhook
+	client          client.Client
+	generators      map[string]generators.Generator
+	queue           chan any
 }
 
 type gitGeneratorInfo struct {
 	Revision    string
 	TouchedHead bool
-	RepoRegexp  *regexp.Regexp
+	RepoRegexps []*regexp.Regexp
 }
 
 type prGeneratorInfo struct {
-	Azuredevops *prGeneratorAzuredevopsInfo
-	Github      *prGeneratorGithubInfo
-	Gitlab      *prGeneratorGitlabInfo
+	Azuredevops     *prGeneratorAzuredevopsInfo
+	BitbucketServer *prGeneratorBitbucketServerInfo
+	Github          *prGeneratorGithubInfo
+	Gitlab          *prGeneratorGitlabInfo
 }
 
 type prGeneratorAzuredevopsInfo struct {
@@ -57,6 +61,12 @@ type prGeneratorAzuredevopsInfo struct {
 	Project string
 }
 
+type prGeneratorBitbucketServerInfo struct {
+	Project     string
+	Repo        string
+	APIHostname string
+}
+
 type prGeneratorGithubInfo struct {
 	Repo      string
 	Owner     string
@@ -86,15 +96,20 @@ func NewWebhookHandler(namespace string, webhookParallelism int, argocdSettingsM
 	if err != nil {
 		return nil, fmt.Errorf("unable to init Azure DevOps webhook: %w", err)
 	}
+	bitbucketserverHandler, err := bitbucketserver.New(bitbucketserver.Options.Secret(argocdSettings.WebhookBitbucketServerSecret))
+	if err != nil {
+		return nil, fmt.Errorf("unable to init Bitbucket Server webhook: %w", err)
+	}
 
 	webhookHandler := &WebhookHandler{
-		namespace:   namespace,
-		github:      githubHandler,
-		gitlab:      gitlabHandler,
-		azuredevops: azuredevopsHandler,
-		client:      client,
-		generators:  generators,
-		queue:       make(chan any, payloadQueueSize),
+		namespace:       namespace,
+		github:          githubHandler,
+		gitlab:          gitlabHandler,
+		azuredevops:     azuredevopsHandler,
+		bitbucketserver: bitbucketserverHandler,
+		client:          client,
+		generators:      generators,
+		queue:           make(chan any, payloadQueueSize),
 	}
 
 	webhookHandler.startWorkerPool(webhookParallelism)
@@ -119,6 +134,17 @@ func (h *WebhookHandler) startWorkerPool(webhookParallelism int) {
 }
 
 func (h *WebhookHandler) HandleEvent(payload any) {
+	// Bitbucket Server includes all the references pushed at once in a single event, which is handled as one
+	// event per reference
+	if refsChanged, ok := payload.(bitbucketserver.RepositoryReferenceChangedPayload); ok && len(refsChanged.Changes) > 1 {
+		for _, c := range refsChanged.Changes {
+			refChanged := refsChanged
+			refChanged.Changes = []bitbucketserver.RepositoryChange{c}
+			h.HandleEvent(refChanged)
+		}
+		return
+	}
+
 	gitGenInfo := getGitGeneratorInfo(payload)
 	prGenInfo := getPRGeneratorInfo(payload)
 	if gitGenInfo == nil && prGenInfo == nil {
@@ -167,6 +193,13 @@ func (h *WebhookHandler) Handler(w http.ResponseWriter, r *http.Request) {
 		payload, err = h.gitlab.Parse(r, gitlab.PushEvents, gitlab.TagEvents, gitlab.MergeRequestEvents, gitlab.SystemHookEvents)
 	case r.Header.Get("X-Vss-Activityid") != "":
 		payload, err = h.azuredevops.Parse(r, azuredevops.GitPushEventType, azuredevops.GitPullRequestCreatedEventType, azuredevops.GitPullRequestUpdatedEventType, azuredevops.GitPullRequestMergedEventType)
+	case r.Header.Get("X-Hook-UUID") != "":
+		// Bitbucket Cloud also sets the X-Event-Key header, its events must not be parsed as Bitbucket Server events
+		log.Debug("Ignoring Bitbucket Cloud webhook event")
+		http.Error(w, "Bitbucket Cloud webhook events are not supported", http.StatusBadRequest)
+		return
+	case r.Header.Get("X-Event-Key") != "":
+		payload, err = h.bitbucketserver.Parse(r, bitbucketserver.RepositoryReferenceChangedEvent, bitbucketserver.PullRequestOpenedEvent, bitbucketserver.PullRequestFromReferenceUpdatedEvent, bitbucketserver.PullRequestModifiedEvent, bitbucketserver.PullRequestMergedEvent, bitbucketserver.PullRequestDeclinedEvent, bitbucketserver.PullRequestDeletedEvent, bitbucketserver.DiagnosticsPingEvent)
 	default:
 		log.Debug("Ignoring unknown webhook event")
 		http.Error(w, "Unknown webhook event", http.StatusBadRequest)
@@ -193,41 +226,56 @@ func (h *WebhookHandler) Handler(w http.ResponseWriter, r *http.Request) {
 
 func getGitGeneratorInfo(payload any) *gitGeneratorInfo {
 	var (
-		webURL      string
+		webURLs     []string
 		revision    string
 		touchedHead bool
 	)
 	switch payload := payload.(type) {
 	case github.PushPayload:
-		webURL = payload.Repository.HTMLURL
+		webURLs = append(webURLs, payload.Repository.HTMLURL)
 		revision = webhook.ParseRevision(payload.Ref)
 		touchedHead = payload.Repository.DefaultBranch == revision
 	case gitlab.PushEventPayload:
-		webURL = payload.Project.WebURL
+		webURLs = append(webURLs, payload.Project.WebURL)
 		revision = webhook.ParseRevision(payload.Ref)
 		touchedHead = payload.Project.DefaultBranch == revision
 	case azuredevops.GitPushEvent:
 		// See: https://learn.microsoft.com/en-us/azure/devops/service-hooks/events?view=azure-devops#git.push
-		webURL = payload.Resource.Repository.RemoteURL
+		webURLs = append(webURLs, payload.Resource.Repository.RemoteURL)
 		revision = webhook.ParseRevision(payload.Resource.RefUpdates[0].Name)
 		touchedHead = payload.Resource.RefUpdates[0].Name == payload.Resource.Repository.DefaultBranch
 		// unfortunately, Azure DevOps doesn't provide a list of changed files
+	case bitbucketserver.RepositoryReferenceChangedPayload:
+		// See: https://confluence.atlassian.com/bitbucketserver/event-payload-938025882.html#Eventpayload-Push
+		// HandleEvent splits the events with multiple changes, so there is at most one change here
+		if len(payload.Changes) == 0 {
+			return nil
+		}
+		webURLs = webhook.BitbucketServerCloneURLs(payload.Repository)
+		revision = webhook.ParseRevision(payload.Changes[0].Reference.ID)
+		// Bitbucket Server doesn't provide the default branch of the repository, so the change is assumed to touch it
+		touchedHead = true
 	default:
 		return nil
 	}
 
-	log.Infof("Received push event repo: %s, revision: %s, touchedHead: %v", webURL, revision, touchedHead)
-	repoRegexp, err := webhook.GetWebURLRegex(webURL)
-	if err != nil {
-		log.Errorf("Failed to compile regexp for repoURL '%s'", webURL)
-		return nil
-	}
-
-	return &gitGeneratorInfo{
-		RepoRegexp:  repoRegexp,
+	info := &gitGeneratorInfo{
 		TouchedHead: touchedHead,
 		Revision:    revision,
 	}
+	for _, webURL := range webURLs {
+		log.Infof("Received push event repo: %s, revision: %s, touchedHead: %v", webURL, revision, touchedHead)
+		repoRegexp, err := webhook.GetWebURLRegex(webURL)
+		if err != nil {
+			log.Errorf("Failed to compile regexp for repoURL '%s'", webURL)
+			continue
+		}
+		info.RepoRegexps = append(info.RepoRegexps, repoRegexp)
+	}
+	if len(info.RepoRegexps) == 0 {
+		return nil
+	}
+	return info
 }
 
 func getPRGeneratorInfo(payload any) *prGeneratorInfo {
@@ -277,13 +325,56 @@ func getPRGeneratorInfo(payload any) *prGeneratorInfo {
 			Repo:    repo,
 			Project: project,
 		}
+	case bitbucketserver.PullRequestOpenedPayload:
+		info.BitbucketServer = getBitbucketServerPRGeneratorInfo(payload.PullRequest)
+	case bitbucketserver.PullRequestFromReferenceUpdatedPayload:
+		info.BitbucketServer = getBitbucketServerPRGeneratorInfo(payload.PullRequest)
+	case bitbucketserver.PullRequestModifiedPayload:
+		info.BitbucketServer = getBitbucketServerPRGeneratorInfo(payload.PullRequest)
+	case bitbucketserver.PullRequestMergedPayload:
+		info.BitbucketServer = getBitbucketServerPRGeneratorInfo(payload.PullRequest)
+	case bitbucketserver.PullRequestDeclinedPayload:
+		info.BitbucketServer = getBitbucketServerPRGeneratorInfo(payload.PullRequest)
+	case bitbucketserver.PullRequestDeletedPayload:
+		info.BitbucketServer = getBitbucketServerPRGeneratorInfo(payload.PullRequest)
 	default:
 		return nil
 	}
 
+	if info == (prGeneratorInfo{}) {
+		return nil
+	}
 	return &info
 }
 
+// getBitbucketServerPRGeneratorInfo returns the info of the repository targeted by a Bitbucket Server pull request,
+// which is the repository the pull request generator lists the pull requests of
+func getBitbucketServerPRGeneratorInfo(pr bitbucketserver.PullRequest) *prGeneratorBitbucketServerInfo {
+	repo := pr.ToRef.Repository
+	var apiHostname string
+	for _, cloneURL := range webhook.BitbucketServerCloneURLs(repo) {
+		urlObj, err := url.Parse(cloneURL)
+		if err != nil {
+			log.Errorf("Failed to parse repoURL '%s'", cloneURL)
+			continue
+		}
+		if urlObj.Scheme == "http" || urlObj.Scheme == "https" {
+			apiHostname = urlObj.Hostname()
+			break
+		}
+	}
+	if apiHostname == "" {
+		log.Errorf("Failed to find the http clone URL of the Bitbucket Server repository '%s/%s'", repo.Project.Key, repo.Slug)
+		return nil
+	}
+
+	return &prGeneratorBitbucketServerInfo{
+		Project:     repo.Project.Key,
+		Repo:        repo.Slug,
+		APIHostname: apiHostname,
+	}
+}
+
 // githubAllowedPullRequestActions is a list of github actions that allow refresh
 var githubAllowedPullRequestActions = []string{
 	"opened",
@@ -343,7 +434,7 @@ func shouldRefreshGitGenerator(gen *v1alpha1.GitGenerator, info *gitGeneratorInf
 		return false
 	}
 
-	if !gitGeneratorUsesURL(gen, info.Revision, info.RepoRegexp) {
+	if !gitGeneratorUsesURL(gen, info.RepoRegexps) {
 		return false
 	}
 	if !genRevisionHasChanged(gen, info.Revision, info.TouchedHead) {
@@ -365,14 +456,15 @@ func genRevisionHasChanged(gen *v1alpha1.GitGenerator, revision string, touchedH
 	return targetRev == revision || gen.Revision == revision
 }
 
-func gitGeneratorUsesURL(gen *v1alpha1.GitGenerator, webURL string, repoRegexp *regexp.Regexp) bool {
-	if !repoRegexp.MatchString(gen.RepoURL) {
+func gitGeneratorUsesURL(gen *v1alpha1.GitGenerator, repoRegexps []*regexp.Regexp) bool {
+	for _, repoRegexp := range repoRegexps {
+		if repoRegexp.MatchString(gen.RepoURL) {
+			log.Debugf("%s matches %s", gen.RepoURL, repoRegexp.String())
+			return true
+		}
 		log.Debugf("%s does not match %s", gen.RepoURL, repoRegexp.String())
-		return false
 	}
-
-	log.Debugf("%s uses repoURL %s", gen.RepoURL, webURL)
-	return true
+	return false
 }
 
 func shouldRefreshPRGenerator(gen *v1alpha1.PullRequestGenerator, info *prGeneratorInfo) bool {
@@ -425,6 +517,28 @@ func shouldRefreshPRGenerator(gen *v1alpha1.PullRequestGenerator, info *prGenera
 		return true
 	}
 
+	if gen.BitbucketServer != nil && info.BitbucketServer != nil {
+		// Bitbucket Server project keys are case-insensitive, while the slugs of the repositories are lowercase
+		if !strings.EqualFold(gen.BitbucketServer.Project, info.BitbucketServer.Project) {
+			return false
+		}
+		if !strings.EqualFold(gen.BitbucketServer.Repo, info.BitbucketServer.Repo) {
+			return false
+		}
+
+		urlObj, err := url.Parse(gen.BitbucketServer.API)
+		if err != nil {
+			log.Errorf("Failed to parse repoURL '%s'", gen.BitbucketServer.API)
+			return false
+		}
+		if urlObj.Hostname() != info.BitbucketServer.APIHostname {
+			log.Debugf("%s does not match %s", gen.BitbucketServer.API, info.BitbucketServer.APIHostname)
+			return false
+		}
+
+		return true
+	}
+
 	if gen.AzureDevOps != nil && info.Azuredevops != nil {
 		if gen.AzureDevOps.Project != info.Azuredevops.Project {
 			return false
@@ -444,147 +558,115 @@ func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenera
 	}
 
 	// Silently ignore, the ApplicationSetReconciler will log the error as part of the reconcile
-	if len(gen.Generators) < 2 || len(gen.Generators) > 2 {
+	if len(gen.Generators) < 2 {
 		return false
 	}
 
-	g0 := gen.Generators[0]
-
-	// Check first child generator for Git or Pull Request Generator
-	if shouldRefreshGitGenerator(g0.Git, gitGenInfo) ||
-		shouldRefreshPRGenerator(g0.PullRequest, prGenInfo) {
-		return true
-	}
-
-	// Check first child generator for nested Matrix generator
-	var matrixGenerator0 *v1alpha1.MatrixGenerator
-	if g0.Matrix != nil {
-		// Since nested matrix generator is represented as a JSON object in the CRD, we unmarshall it back to a Go struct here.
-		nestedMatrix, err := v1alpha1.ToNestedMatrixGenerator(g0.Matrix)
+	// The child generators are checked in order, each one being interpolated with the combinations of the params of
+	// the preceding child generators, as the matrix generator does
+	var params []map[string]any
+	for i, g := range gen.Generators {
+		requestedGenerator, err := toApplicationSetGenerator(g)
 		if err != nil {
-			log.Errorf("Failed to unmarshall nested matrix generator: %v", err)
+			log.Error(err)
 			return false
 		}
-		if nestedMatrix != nil {
-			matrixGenerator0 = nestedMatrix.ToMatrixGenerator()
-			if h.shouldRefreshMatrixGenerator(matrixGenerator0, appSet, gitGenInfo, prGenInfo) {
-				return true
+
+		// Interpolate the child generator with params from the preceding child generators, if there are any params
+		interpolatedGenerators := []*v1alpha1.ApplicationSetGenerator{requestedGenerator}
+		if len(params) != 0 {
+			interpolatedGenerators = nil
+			for _, p := range params {
+				interpolatedGenerator, err := generators.InterpolateGenerator(requestedGenerator, p, appSet.Spec.GoTemplate, appSet.Spec.GoTemplateOptions)
+				if err != nil {
+					log.Error(err)
+					return false
+				}
+				interpolatedGenerators = append(interpolatedGenerators, &interpolatedGenerator)
 			}
 		}
-	}
 
-	// Check first child generator for nested Merge generator
-	var mergeGenerator0 *v1alpha1.MergeGenerator
-	if g0.Merge != nil {
-		// Since nested merge generator is represented as a JSON object in the CRD, we unmarshall it back to a Go struct here.
-		nestedMerge, err := v1alpha1.ToNestedMergeGenerator(g0.Merge)
-		if err != nil {
-			log.Errorf("Failed to unmarshall nested merge generator: %v", err)
-			return false
-		}
-		if nestedMerge != nil {
-			mergeGenerator0 = nestedMerge.ToMergeGenerator()
-			if h.shouldRefreshMergeGenerator(mergeGenerator0, appSet, gitGenInfo, prGenInfo) {
+		// Check all interpolated child generators
+		for _, interpolatedGenerator := range interpolatedGenerators {
+			if shouldRefreshGitGenerator(interpolatedGenerator.Git, gitGenInfo) ||
+				shouldRefreshPRGenerator(interpolatedGenerator.PullRequest, prGenInfo) ||
+				(i > 0 && shouldRefreshPluginGenerator(interpolatedGenerator.Plugin)) ||
+				h.shouldRefreshMatrixGenerator(interpolatedGenerator.Matrix, appSet, gitGenInfo, prGenInfo) ||
+				h.shouldRefreshMergeGenerator(interpolatedGenerator.Merge, appSet, gitGenInfo, prGenInfo) {
 				return true
 			}
 		}
-	}
 
-	// Create ApplicationSetGenerator for first child generator from its ApplicationSetNestedGenerator
-	requestedGenerator0 := &v1alpha1.ApplicationSetGenerator{
-		List:                    g0.List,
-		Clusters:                g0.Clusters,
-		Git:                     g0.Git,
-		SCMProvider:             g0.SCMProvider,
-		ClusterDecisionResource: g0.ClusterDecisionResource,
-		PullRequest:             g0.PullRequest,
-		Plugin:                  g0.Plugin,
-		Matrix:                  matrixGenerator0,
-		Merge:                   mergeGenerator0,
-	}
+		// The params of the last child generator are not used to interpolate any other child generator
+		if i == len(gen.Generators)-1 {
+			break
+		}
 
-	// Generate params for first child generator
-	relGenerators := generators.GetRelevantGenerators(requestedGenerator0, h.generators)
-	params := []map[string]any{}
-	for _, g := range relGenerators {
-		p, err := g.GenerateParams(requestedGenerator0, appSet, h.client)
-		if err != nil {
-			log.Error(err)
-			return false
+		// Generate params for the child generator and combine them with the params of the preceding child generators
+		var combinations []map[string]any
+		for j, interpolatedGenerator := range interpolatedGenerators {
+			childParams := []map[string]any{}
+			for _, relGenerator := range generators.GetRelevantGenerators(interpolatedGenerator, h.generators) {
+				p, err := relGenerator.GenerateParams(interpolatedGenerator, appSet, h.client)
+				if err != nil {
+					log.Error(err)
+					return false
+				}
+				childParams = append(childParams, p...)
+			}
+			for _, p := range childParams {
+				combination := map[string]any{}
+				maps.Copy(combination, p)
+				if len(params) != 0 {
+					// the params of the preceding child generators take precedence, as in the matrix generator
+					maps.Copy(combination, params[j])
+				}
+				combinations = append(combinations, combination)
+			}
 		}
-		params = append(params, p...)
+		params = combinations
 	}
+	return false
+}
 
-	g1 := gen.Generators[1]
-
-	// Create Matrix generator for nested Matrix generator as second child generator
-	var matrixGenerator1 *v1alpha1.MatrixGenerator
-	if g1.Matrix != nil {
-		// Since nested matrix generator is represented as a JSON object in the CRD, we unmarshall it back to a Go struct here.
-		nestedMatrix, err := v1alpha1.ToNestedMatrixGenerator(g1.Matrix)
+// toApplicationSetGenerator creates an ApplicationSetGenerator from a child generator of a matrix generator,
+// unmarshalling its nested matrix or merge generator
+func toApplicationSetGenerator(g v1alpha1.ApplicationSetNestedGenerator) (*v1alpha1.ApplicationSetGenerator, error) {
+	// Since nested matrix and merge generators are represented as JSON objects in the CRD, we unmarshall them back to Go
+	// structs here.
+	var matrixGenerator *v1alpha1.MatrixGenerator
+	if g.Matrix != nil {
+		nestedMatrix, err := v1alpha1.ToNestedMatrixGenerator(g.Matrix)
 		if err != nil {
-			log.Errorf("Failed to unmarshall nested matrix generator: %v", err)
-			return false
+			return nil, fmt.Errorf("failed to unmarshall nested matrix generator: %w", err)
 		}
 		if nestedMatrix != nil {
-			matrixGenerator1 = nestedMatrix.ToMatrixGenerator()
+			matrixGenerator = nestedMatrix.ToMatrixGenerator()
 		}
 	}
-
-	// Create Merge generator for nested Merge generator as second child generator
-	var mergeGenerator1 *v1alpha1.MergeGenerator
-	if g1.Merge != nil {
-		// Since nested merge generator is represented as a JSON object in the CRD, we unmarshall it back to a Go struct here.
-		nestedMerge, err := v1alpha1.ToNestedMergeGenerator(g1.Merge)
+	var mergeGenerator *v1alpha1.MergeGenerator
+	if g.Merge != nil {
+		nestedMerge, err := v1alpha1.ToNestedMergeGenerator(g.Merge)
 		if err != nil {
-			log.Errorf("Failed to unmarshall nested merge generator: %v", err)
-			return false
+			return nil, fmt.Errorf("failed to unmarshall nested merge generator: %w", err)
 		}
 		if nestedMerge != nil {
-			mergeGenerator1 = nestedMerge.ToMergeGenerator()
-		}
-	}
-
-	// Create ApplicationSetGenerator for second child generator from its ApplicationSetNestedGenerator
-	requestedGenerator1 := &v1alpha1.ApplicationSetGenerator{
-		List:                    g1.List,
-		Clusters:                g1.Clusters,
-		Git:                     g1.Git,
-		SCMProvider:             g1.SCMProvider,
-		ClusterDecisionResource: g1.ClusterDecisionResource,
-		PullRequest:             g1.PullRequest,
-		Plugin:                  g1.Plugin,
-		Matrix:                  matrixGenerator1,
-		Merge:                   mergeGenerator1,
-	}
-
-	// Interpolate second child generator with params from first child generator, if there are any params
-	if len(params) != 0 {
-		for _, p := range params {
-			tempInterpolatedGenerator, err := generators.InterpolateGenerator(requestedGenerator1, p, appSet.Spec.GoTemplate, appSet.Spec.GoTemplateOptions)
-			interpolatedGenerator := &tempInterpolatedGenerator
-			if err != nil {
-				log.Error(err)
-				return false
-			}
-
-			// Check all interpolated child generators
-			if shouldRefreshGitGenerator(interpolatedGenerator.Git, gitGenInfo) ||
-				shouldRefreshPRGenerator(interpolatedGenerator.PullRequest, prGenInfo) ||
-				shouldRefreshPluginGenerator(interpolatedGenerator.Plugin) ||
-				h.shouldRefreshMatrixGenerator(interpolatedGenerator.Matrix, appSet, gitGenInfo, prGenInfo) ||
-				h.shouldRefreshMergeGenerator(requestedGenerator1.Merge, appSet, gitGenInfo, prGenInfo) {
-				return true
-			}
-		}
-	}
-
-	// First child generator didn't return any params, just check the second child generator
-	return shouldRefreshGitGenerator(requestedGenerator1.Git, gitGenInfo) ||
-		shouldRefreshPRGenerator(requestedGenerator1.PullRequest, prGenInfo) ||
-		shouldRefreshPluginGenerator(requestedGenerator1.Plugin) ||
-		h.shouldRefreshMatrixGenerator(requestedGenerator1.Matrix, appSet, gitGenInfo, prGenInfo) ||
-		h.shouldRefreshMergeGenerator(requestedGenerator1.Merge, appSet, gitGenInfo, prGenInfo)
+			mergeGenerator = nestedMerge.ToMergeGenerator()
+		}
+	}
+	return &v1alpha1.ApplicationSetGenerator{
+		List:                    g.List,
+		Clusters:                g.Clusters,
+		Git:                     g.Git,
+		SCMProvider:             g.SCMProvider,
+		ClusterDecisionResource: g.ClusterDecisionResource,
+		PullRequest:             g.PullRequest,
+		Plugin:                  g.Plugin,
+		AWSOrganization:         g.AWSOrganization,
+		HTTP:                    g.HTTP,
+		Matrix:                  matrixGenerator,
+		Merge:                   mergeGenerator,
+	}, nil
 }
 
 func (h *WebhookHandler) shouldRefreshMergeGenerator(gen *v1alpha1.MergeGenerator, appSet *v1alpha1.ApplicationSet, gitGenInfo *gitGeneratorInfo, prGenInfo *prGeneratorInfo) bool {
@@ -62,7 +62,7 @@ func TestWebhookHandler(t *testing.T) {
 			headerKey:          "X-GitHub-Event",
 			headerValue:        "push",
 			payloadFile:        "github-commit-event.json",
-			effectedAppSets:    []string{"git-github", "git-github-ssh", "git-github-alt-ssh", "matrix-git-github", "merge-git-github", "matrix-scm-git-github", "matrix-nested-git-github", "merge-nested-git-github", "plugin", "matrix-pull-request-github-plugin"},
+			effectedAppSets:    []string{"git-github", "git-github-ssh", "git-github-alt-ssh", "matrix-git-github", "merge-git-github", "matrix-scm-git-github", "matrix-nested-git-github", "matrix-three-git-github", "merge-nested-git-github", "plugin", "matrix-pull-request-github-plugin"},
 			expectedStatusCode: http.StatusOK,
 			expectedRefresh:    true,
 		},
@@ -192,6 +192,33 @@ func TestWebhookHandler(t *testing.T) {
 			expectedStatusCode: http.StatusOK,
 			expectedRefresh:    true,
 		},
+		{
+			desc:               "WebHook from a Bitbucket Server repository via Commit",
+			headerKey:          "X-Event-Key",
+			headerValue:        "repo:refs_changed",
+			payloadFile:        "bitbucket-server-push-event.json",
+			effectedAppSets:    []string{"git-bitbucket-server", "git-bitbucket-server-ssh", "plugin", "matrix-pull-request-github-plugin"},
+			expectedStatusCode: http.StatusOK,
+			expectedRefresh:    true,
+		},
+		{
+			desc:               "WebHook from a Bitbucket Server repository via pull request opened event",
+			headerKey:          "X-Event-Key",
+			headerValue:        "pr:opened",
+			payloadFile:        "bitbucket-server-pull-request-opened-event.json",
+			effectedAppSets:    []string{"pull-request-bitbucket-server", "plugin", "matrix-pull-request-github-plugin"},
+			expectedStatusCode: http.StatusOK,
+			expectedRefresh:    true,
+		},
+		{
+			desc:               "WebHook from a Bitbucket Server ping event",
+			headerKey:          "X-Event-Key",
+			headerValue:        "diagnostics:ping",
+			payloadFile:        "bitbucket-server-push-event.json",
+			effectedAppSets:    []string{"git-bitbucket-server", "pull-request-bitbucket-server", "plugin"},
+			expectedStatusCode: http.StatusOK,
+			expectedRefresh:    false,
+		},
 	}
 
 	namespace := "test"
@@ -218,12 +245,17 @@ func TestWebhookHandler(t *testing.T) {
 				fakeAppWithGithubPullRequestGenerator("pull-request-github", namespace, "CodErTOcat", "Hello-World"),
 				fakeAppWithGitlabPullRequestGenerator("pull-request-gitlab", namespace, "100500"),
 				fakeAppWithAzureDevOpsPullRequestGenerator("pull-request-azure-devops", namespace, "DefaultCollection", "Fabrikam"),
+				fakeAppWithGitGenerator("git-bitbucket-server", namespace, "https://bitbucketserver/scm/myproject/test-repo"),
+				fakeAppWithGitGenerator("git-bitbucket-server-ssh", namespace, "ssh://git@bitbucketserver:7999/myproject/test-repo.git"),
+				fakeAppWithBitbucketServerPullRequestGenerator("pull-request-bitbucket-server", namespace, "MYPROJECT", "test-repo", "https://bitbucketserver/rest"),
+				fakeAppWithBitbucketServerPullRequestGenerator("pull-request-bitbucket-server-other-api", namespace, "MYPROJECT", "test-repo", "https://other-bitbucketserver/rest"),
 				fakeAppWithPluginGenerator("plugin", namespace),
 				fakeAppWithMatrixAndGitGenerator("matrix-git-github", namespace, "https://github.com/org/repo"),
 				fakeAppWithMatrixAndPullRequestGenerator("matrix-pull-request-github", namespace, "Codertocat", "Hello-World"),
 				fakeAppWithMatrixAndScmWithGitGenerator("matrix-scm-git-github", namespace, "org"),
 				fakeAppWithMatrixAndScmWithPullRequestGenerator("matrix-scm-pull-request-github", namespace, "Codertocat"),
 				fakeAppWithMatrixAndNestedGitGenerator("matrix-nested-git-github", namespace, "https://github.com/org/repo"),
+				fakeAppWithMatrixOfThreeAndGitGenerator("matrix-three-git-github", namespace, "https://github.com/{{org}}/{{repo}}"),
 				fakeAppWithMatrixAndPullRequestGeneratorWithPluginGenerator("matrix-pull-request-github-plugin", namespace, "coDErtoCat", "HeLLO-WorLD", "plugin-cm"),
 				fakeAppWithMergeAndGitGenerator("merge-git-github", namespace, "https://github.com/org/repo"),
 				fakeAppWithMergeAndPullRequestGenerator("merge-pull-request-github", namespace, "Codertocat", "Hello-World"),
@@ -269,6 +301,30 @@ func TestWebhookHandler(t *testing.T) {
 	}
 }
 
+func TestWebhookHandlerBitbucketCloud(t *testing.T) {
+	namespace := "test"
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	fc := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		fakeAppWithGitGenerator("git-bitbucket-server", namespace, "https://bitbucketserver/scm/myproject/test-repo"),
+	).Build()
+	set := argosettings.NewSettingsManager(t.Context(), newFakeClient(namespace), namespace)
+	h, err := NewWebhookHandler(namespace, 10, set, fc, mockGenerators())
+	require.NoError(t, err)
+
+	// Bitbucket Cloud sets both the X-Hook-UUID and the X-Event-Key headers
+	req := httptest.NewRequest(http.MethodPost, "/api/webhook", bytes.NewReader([]byte(`{"push":{"changes":[]}}`)))
+	req.Header.Set("X-Hook-UUID", "2b9ea6d4-7e8c-4b36-9f5c-35e3f6b1a1a3")
+	req.Header.Set("X-Event-Key", "repo:push")
+	w := httptest.NewRecorder()
+
+	h.Handler(w, req)
+	close(h.queue)
+	h.Wait()
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "Bitbucket Cloud webhook events are not supported")
+}
+
 func mockGenerators() map[string]generators.Generator {
 	// generatorMockList := generatorMock{}
 	generatorMockGit := &generatorMock{}
@@ -469,6 +525,28 @@ func fakeAppWithAzureDevOpsPullRequestGenerator(name, namespace, project, repo s
 	}
 }
 
+func fakeAppWithBitbucketServerPullRequestGenerator(name, namespace, project, repo, api string) *v1alpha1.ApplicationSet {
+	return &v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			Generators: []v1alpha1.ApplicationSetGenerator{
+				{
+					PullRequest: &v1alpha1.PullRequestGenerator{
+						BitbucketServer: &v1alpha1.PullRequestGeneratorBitbucketServer{
+							Project: project,
+							Repo:    repo,
+							API:     api,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func fakeAppWithMatrixAndGitGenerator(name, namespace, repo string) *v1alpha1.ApplicationSet {
 	return &v1alpha1.ApplicationSet{
 		ObjectMeta: metav1.ObjectMeta{
@@ -496,6 +574,40 @@ func fakeAppWithMatrixAndGitGenerator(name, namespace, repo string) *v1alpha1.Ap
 	}
 }
 
+func fakeAppWithMatrixOfThreeAndGitGenerator(name, namespace, repo string) *v1alpha1.ApplicationSet {
+	return &v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			Generators: []v1alpha1.ApplicationSetGenerator{
+				{
+					Matrix: &v1alpha1.MatrixGenerator{
+						Generators: []v1alpha1.ApplicationSetNestedGenerator{
+							{
+								List: &v1alpha1.ListGenerator{
+									Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"org": "org"}`)}},
+								},
+							},
+							{
+								List: &v1alpha1.ListGenerator{
+									Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"repo": "repo"}`)}},
+								},
+							},
+							{
+								Git: &v1alpha1.GitGenerator{
+									RepoURL: repo,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func fakeAppWithMatrixAndPullRequestGenerator(name, namespace, owner, repo string) *v1alpha1.ApplicationSet {
 	return &v1alpha1.ApplicationSet{
 		ObjectMeta: metav1.ObjectMeta{
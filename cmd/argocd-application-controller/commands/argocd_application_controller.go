@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"net"
 	"os"
 	"os/signal"
 	"runtime/debug"
@@ -34,6 +35,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/cli"
 	"github.com/argoproj/argo-cd/v3/util/env"
 	"github.com/argoproj/argo-cd/v3/util/errors"
+	"github.com/argoproj/argo-cd/v3/util/helm"
 	kubeutil "github.com/argoproj/argo-cd/v3/util/kube"
 	"github.com/argoproj/argo-cd/v3/util/settings"
 	"github.com/argoproj/argo-cd/v3/util/tls"
@@ -89,9 +91,13 @@ func NewCommand() *cobra.Command {
 		applicationNamespaces            []string
 		persistResourceHealth            bool
 		shardingAlgorithm                string
+		shardingLabelRules               string
 		enableDynamicClusterDistribution bool
 		serverSideDiff                   bool
 		ignoreNormalizerOpts             normalizers.IgnoreNormalizerOpts
+		historyRetention                 controller.RevisionHistoryRetention
+		helmLookupProxyListenAddress     string
+		helmLookupProxyURL               string
 
 		// argocd k8s event logging flag
 		enableK8sEvent  []string
@@ -177,8 +183,21 @@ func NewCommand() *cobra.Command {
 				appController.InvalidateProjectsCache()
 			}))
 			kubectl := kubeutil.NewKubectl()
-			clusterSharding, err := sharding.GetClusterSharding(kubeClient, settingsMgr, shardingAlgorithm, enableDynamicClusterDistribution)
+			labelRules, err := sharding.ParseShardLabelRules(shardingLabelRules)
 			errors.CheckError(err)
+			clusterSharding, err := sharding.GetClusterSharding(kubeClient, settingsMgr, shardingAlgorithm, enableDynamicClusterDistribution, labelRules)
+			errors.CheckError(err)
+			var helmLookupProxy *helm.LookupProxy
+			if helmLookupProxyListenAddress != "" && helmLookupProxyURL != "" {
+				listener, err := net.Listen("tcp", helmLookupProxyListenAddress)
+				errors.CheckError(err)
+				helmLookupProxy = helm.NewLookupProxy(helmLookupProxyURL)
+				go func() {
+					if err := helmLookupProxy.Serve(ctx, listener); err != nil {
+						log.Fatalf("Helm lookup proxy stopped: %v", err)
+					}
+				}()
+			}
 			var selfHealBackoff *wait.Backoff
 			if selfHealBackoffTimeoutSeconds != 0 {
 				selfHealBackoff = &wait.Backoff{
@@ -218,6 +237,8 @@ func NewCommand() *cobra.Command {
 				ignoreNormalizerOpts,
 				enableK8sEvent,
 				hydratorEnabled,
+				historyRetention,
+				helmLookupProxy,
 			)
 			errors.CheckError(err)
 			cacheutil.CollectMetrics(redisClient, appController.GetMetricsServer(), nil)
@@ -286,6 +307,7 @@ func NewCommand() *cobra.Command {
 	command.Flags().StringSliceVar(&applicationNamespaces, "application-namespaces", env.StringsFromEnv("ARGOCD_APPLICATION_NAMESPACES", []string{}, ","), "List of additional namespaces that applications are allowed to be reconciled from")
 	command.Flags().BoolVar(&persistResourceHealth, "persist-resource-health", env.ParseBoolFromEnv("ARGOCD_APPLICATION_CONTROLLER_PERSIST_RESOURCE_HEALTH", false), "Enables storing the managed resources health in the Application CRD")
 	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", env.StringFromEnv(common.EnvControllerShardingAlgorithm, common.DefaultShardingAlgorithm), "Enables choice of sharding method. Supported sharding methods are : [legacy, round-robin, consistent-hashing] ")
+	command.Flags().StringVar(&shardingLabelRules, "sharding-label-rules", env.StringFromEnv(common.EnvControllerShardingLabelRules, ""), "YAML or JSON list of rules assigning the clusters matching a label selector to a shard, e.g. [{\"selector\": \"region=eu\", \"shard\": 2}]. Clusters which explicitly set their shard or match no rule are assigned using the sharding method")
 	// global queue rate limit config
 	command.Flags().Int64Var(&workqueueRateLimit.BucketSize, "wq-bucket-size", env.ParseInt64FromEnv("WORKQUEUE_BUCKET_SIZE", 500, 1, math.MaxInt64), "Set Workqueue Rate Limiter Bucket Size, default 500")
 	command.Flags().Float64Var(&workqueueRateLimit.BucketQPS, "wq-bucket-qps", env.ParseFloat64FromEnv("WORKQUEUE_BUCKET_QPS", math.MaxFloat64, 1, math.MaxFloat64), "Set Workqueue Rate Limiter Bucket QPS, default set to MaxFloat64 which disables the bucket limiter")
@@ -300,6 +322,10 @@ func NewCommand() *cobra.Command {
 	command.Flags().DurationVar(&ignoreNormalizerOpts.JQExecutionTimeout, "ignore-normalizer-jq-execution-timeout-seconds", env.ParseDurationFromEnv("ARGOCD_IGNORE_NORMALIZER_JQ_TIMEOUT", 0*time.Second, 0, math.MaxInt64), "Set ignore normalizer JQ execution timeout")
 	// argocd k8s event logging flag
 	command.Flags().StringSliceVar(&enableK8sEvent, "enable-k8s-event", env.StringsFromEnv("ARGOCD_ENABLE_K8S_EVENT", argo.DefaultEnableEventList(), ","), "Enable ArgoCD to use k8s event. For disabling all events, set the value as `none`. (e.g --enable-k8s-event=none), For enabling specific events, set the value as `event reason`. (e.g --enable-k8s-event=StatusRefreshed,ResourceCreated)")
+	command.Flags().DurationVar(&historyRetention.MaxAge, "revision-history-max-age", env.ParseDurationFromEnv("ARGOCD_APPLICATION_CONTROLLER_REVISION_HISTORY_MAX_AGE", 0, 0, math.MaxInt64), "Maximum age of the entries of the revision history of applications, the most recent entry is always kept. Zero disables the limit")
+	command.Flags().IntVar(&historyRetention.MaxBytes, "revision-history-max-bytes", env.ParseNumFromEnv("ARGOCD_APPLICATION_CONTROLLER_REVISION_HISTORY_MAX_BYTES", 0, 0, math.MaxInt32), "Maximum JSON encoded size in bytes of the revision history of applications, the most recent entry is always kept. Zero disables the limit")
+	command.Flags().StringVar(&helmLookupProxyListenAddress, "helm-lookup-proxy-listen-address", env.StringFromEnv("ARGOCD_APPLICATION_CONTROLLER_HELM_LOOKUP_PROXY_LISTEN_ADDRESS", ""), "Listen on given address for the Helm lookup proxy, e.g. :8084. The Helm lookups are disabled if empty")
+	command.Flags().StringVar(&helmLookupProxyURL, "helm-lookup-proxy-url", env.StringFromEnv("ARGOCD_APPLICATION_CONTROLLER_HELM_LOOKUP_PROXY_URL", ""), "URL of the Helm lookup proxy reachable by the repo server, e.g. http://$(POD_IP):8084. The Helm lookups are disabled if empty")
 	command.Flags().BoolVar(&hydratorEnabled, "hydrator-enabled", env.ParseBoolFromEnv("ARGOCD_HYDRATOR_ENABLED", false), "Feature flag to enable Hydrator. Default (\"false\")")
 	cacheSource = appstatecache.AddCacheFlagsToCmd(&command, cacheutil.Options{
 		OnClientCreated: func(client *redis.Client) {
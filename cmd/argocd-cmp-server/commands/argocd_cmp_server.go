@@ -15,6 +15,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/cli"
 	"github.com/argoproj/argo-cd/v3/util/env"
 	"github.com/argoproj/argo-cd/v3/util/errors"
+	executil "github.com/argoproj/argo-cd/v3/util/exec"
 	traceutil "github.com/argoproj/argo-cd/v3/util/trace"
 )
 
@@ -63,6 +64,10 @@ func NewCommand() *cobra.Command {
 				log.Infof("No discovery configuration is defined for plugin %s. To use this plugin, specify %q in the Application's spec.source.plugin.name field.", config.Metadata.Name, name)
 			}
 
+			if config.Spec.Sandbox.HasResourceLimits() {
+				errors.CheckError(executil.InitSandboxCgroup())
+			}
+
 			if otlpAddress != "" {
 				var closer func()
 				var err error
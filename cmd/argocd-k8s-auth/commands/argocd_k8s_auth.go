@@ -21,6 +21,7 @@ func NewCommand() *cobra.Command {
 	command.AddCommand(newAWSCommand())
 	command.AddCommand(newGCPCommand())
 	command.AddCommand(newAzureCommand())
+	command.AddCommand(newExternalCommand())
 
 	return command
 }
@@ -99,15 +99,19 @@ func getSignedRequest(clusterName, roleARN string, profile string) (string, erro
 
 func formatJSON(token string, expiration time.Time) string {
 	expirationTimestamp := metav1.NewTime(expiration)
+	return formatExecCredential(&clientauthv1beta1.ExecCredentialStatus{
+		ExpirationTimestamp: &expirationTimestamp,
+		Token:               token,
+	})
+}
+
+func formatExecCredential(status *clientauthv1beta1.ExecCredentialStatus) string {
 	execInput := &clientauthv1beta1.ExecCredential{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "client.authentication.k8s.io/v1beta1",
 			Kind:       "ExecCredential",
 		},
-		Status: &clientauthv1beta1.ExecCredentialStatus{
-			ExpirationTimestamp: &expirationTimestamp,
-			Token:               token,
-		},
+		Status: status,
 	}
 	enc, _ := json.Marshal(execInput)
 	return string(enc)
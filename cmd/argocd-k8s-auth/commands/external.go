@@ -0,0 +1,236 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientauthv1beta1 "k8s.io/client-go/pkg/apis/clientauthentication/v1beta1"
+
+	argoerrors "github.com/argoproj/argo-cd/v3/util/errors"
+)
+
+const (
+	vaultRefPrefix     = "vault:"
+	awsSecretRefPrefix = "arn:aws:secretsmanager:"
+
+	// defaultExternalCredentialsTTL is how long the resolved credentials are cached by the Kubernetes client
+	defaultExternalCredentialsTTL = 5 * time.Minute
+
+	envVaultAddr      = "VAULT_ADDR"
+	envVaultToken     = "VAULT_TOKEN"
+	envVaultNamespace = "VAULT_NAMESPACE"
+	envVaultRole      = "VAULT_ROLE"
+	envVaultAuthPath  = "VAULT_AUTH_PATH"
+
+	serviceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+)
+
+// newExternalCommand returns a new instance of an external command that resolves cluster credentials held by an
+// external secret manager
+func newExternalCommand() *cobra.Command {
+	var (
+		tokenRef string
+		certRef  string
+		keyRef   string
+		ttl      time.Duration
+	)
+	command := &cobra.Command{
+		Use:   "external",
+		Short: "Resolve cluster credentials from Vault or AWS Secrets Manager",
+		Run: func(c *cobra.Command, _ []string) {
+			ctx := c.Context()
+
+			if tokenRef == "" && (certRef == "" || keyRef == "") {
+				argoerrors.CheckError(errors.New("either --token-ref or both --cert-ref and --key-ref must be set"))
+			}
+			r := &secretResolver{httpClient: http.DefaultClient, newSecretsManager: newSecretsManager}
+			status := &clientauthv1beta1.ExecCredentialStatus{}
+			var err error
+			if tokenRef != "" {
+				status.Token, err = r.resolve(ctx, tokenRef)
+				argoerrors.CheckError(err)
+			}
+			if certRef != "" && keyRef != "" {
+				status.ClientCertificateData, err = r.resolve(ctx, certRef)
+				argoerrors.CheckError(err)
+				status.ClientKeyData, err = r.resolve(ctx, keyRef)
+				argoerrors.CheckError(err)
+			}
+			expirationTimestamp := metav1.NewTime(time.Now().Add(ttl))
+			status.ExpirationTimestamp = &expirationTimestamp
+			_, _ = fmt.Fprint(os.Stdout, formatExecCredential(status))
+		},
+	}
+	command.Flags().StringVar(&tokenRef, "token-ref", "", "Reference to the bearer token, e.g. vault:secret/data/cluster-foo#token or an AWS Secrets Manager secret ARN")
+	command.Flags().StringVar(&certRef, "cert-ref", "", "Reference to the PEM encoded client certificate")
+	command.Flags().StringVar(&keyRef, "key-ref", "", "Reference to the PEM encoded client certificate key")
+	command.Flags().DurationVar(&ttl, "ttl", defaultExternalCredentialsTTL, "How long the resolved credentials are cached before they are resolved again")
+	return command
+}
+
+type secretResolver struct {
+	httpClient        *http.Client
+	newSecretsManager func(region string) (secretsmanageriface.SecretsManagerAPI, error)
+}
+
+// resolve returns the value of the secret referenced by ref. References have the form `vault:<path>#<field>` for
+// Vault, or `<secret ARN>[#<field>]` for AWS Secrets Manager, where the field selects a key of a JSON secret.
+func (r *secretResolver) resolve(ctx context.Context, ref string) (string, error) {
+	switch {
+	case strings.HasPrefix(ref, vaultRefPrefix):
+		path, field, _ := strings.Cut(strings.TrimPrefix(ref, vaultRefPrefix), "#")
+		if path == "" || field == "" {
+			return "", fmt.Errorf("invalid Vault reference %q: expected vault:<path>#<field>", ref)
+		}
+		return r.resolveVault(ctx, path, field)
+	case strings.HasPrefix(ref, awsSecretRefPrefix):
+		secretARN, field, _ := strings.Cut(ref, "#")
+		return r.resolveAWSSecret(ctx, secretARN, field)
+	}
+	return "", fmt.Errorf("unsupported secret reference %q: must start with %q or %q", ref, vaultRefPrefix, awsSecretRefPrefix)
+}
+
+func (r *secretResolver) resolveVault(ctx context.Context, path string, field string) (string, error) {
+	addr := strings.TrimSuffix(os.Getenv(envVaultAddr), "/")
+	if addr == "" {
+		return "", fmt.Errorf("%s must be set to resolve Vault references", envVaultAddr)
+	}
+	token, err := r.vaultToken(ctx, addr)
+	if err != nil {
+		return "", err
+	}
+	var resp struct {
+		Data map[string]any `json:"data"`
+	}
+	if err := r.vaultRequest(ctx, http.MethodGet, addr+"/v1/"+strings.TrimPrefix(path, "/"), token, nil, &resp); err != nil {
+		return "", fmt.Errorf("failed to read Vault secret %q: %w", path, err)
+	}
+	data := resp.Data
+	// KV version 2 secret engines nest the secret data
+	if nested, ok := data["data"].(map[string]any); ok {
+		if _, ok := data["metadata"]; ok {
+			data = nested
+		}
+	}
+	value, ok := data[field].(string)
+	if !ok {
+		return "", fmt.Errorf("Vault secret %q does not contain the string field %q", path, field)
+	}
+	return value, nil
+}
+
+// vaultToken returns the VAULT_TOKEN, or logs in using the Kubernetes auth method with the pod service account if
+// VAULT_ROLE is set
+func (r *secretResolver) vaultToken(ctx context.Context, addr string) (string, error) {
+	if token := os.Getenv(envVaultToken); token != "" {
+		return token, nil
+	}
+	role := os.Getenv(envVaultRole)
+	if role == "" {
+		return "", fmt.Errorf("either %s or %s must be set to resolve Vault references", envVaultToken, envVaultRole)
+	}
+	authPath := os.Getenv(envVaultAuthPath)
+	if authPath == "" {
+		authPath = "kubernetes"
+	}
+	jwt, err := os.ReadFile(serviceAccountTokenPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read service account token: %w", err)
+	}
+	body, err := json.Marshal(map[string]string{"role": role, "jwt": string(jwt)})
+	if err != nil {
+		return "", err
+	}
+	var resp struct {
+		Auth struct {
+			ClientToken string `json:"client_token"`
+		} `json:"auth"`
+	}
+	if err := r.vaultRequest(ctx, http.MethodPost, fmt.Sprintf("%s/v1/auth/%s/login", addr, strings.Trim(authPath, "/")), "", body, &resp); err != nil {
+		return "", fmt.Errorf("failed to log in to Vault: %w", err)
+	}
+	return resp.Auth.ClientToken, nil
+}
+
+func (r *secretResolver) vaultRequest(ctx context.Context, method string, url string, token string, body []byte, out any) error {
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+	if namespace := os.Getenv(envVaultNamespace); namespace != "" {
+		req.Header.Set("X-Vault-Namespace", namespace)
+	}
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	return json.Unmarshal(data, out)
+}
+
+func (r *secretResolver) resolveAWSSecret(ctx context.Context, secretARN string, field string) (string, error) {
+	parsed, err := arn.Parse(secretARN)
+	if err != nil {
+		return "", fmt.Errorf("invalid AWS Secrets Manager secret ARN %q: %w", secretARN, err)
+	}
+	api, err := r.newSecretsManager(parsed.Region)
+	if err != nil {
+		return "", err
+	}
+	out, err := api.GetSecretValueWithContext(ctx, &secretsmanager.GetSecretValueInput{SecretId: aws.String(secretARN)})
+	if err != nil {
+		return "", fmt.Errorf("failed to get AWS secret %q: %w", secretARN, err)
+	}
+	var value string
+	switch {
+	case out.SecretString != nil:
+		value = *out.SecretString
+	default:
+		value = string(out.SecretBinary)
+	}
+	if field == "" {
+		return value, nil
+	}
+	var fields map[string]any
+	if err := json.Unmarshal([]byte(value), &fields); err != nil {
+		return "", fmt.Errorf("AWS secret %q is not a JSON object: %w", secretARN, err)
+	}
+	fieldValue, ok := fields[field].(string)
+	if !ok {
+		return "", fmt.Errorf("AWS secret %q does not contain the string field %q", secretARN, field)
+	}
+	return fieldValue, nil
+}
+
+func newSecretsManager(region string) (secretsmanageriface.SecretsManagerAPI, error) {
+	sess, err := session.NewSession(&aws.Config{Region: aws.String(region)})
+	if err != nil {
+		return nil, fmt.Errorf("error creating new AWS session: %w", err)
+	}
+	return secretsmanager.New(sess), nil
+}
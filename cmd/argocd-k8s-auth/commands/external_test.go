@@ -0,0 +1,125 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSecretsManager struct {
+	secretsmanageriface.SecretsManagerAPI
+	secrets map[string]string
+}
+
+func (f *fakeSecretsManager) GetSecretValueWithContext(_ aws.Context, input *secretsmanager.GetSecretValueInput, _ ...request.Option) (*secretsmanager.GetSecretValueOutput, error) {
+	value, ok := f.secrets[*input.SecretId]
+	if !ok {
+		return nil, errors.New("secret not found")
+	}
+	return &secretsmanager.GetSecretValueOutput{SecretString: aws.String(value)}, nil
+}
+
+func newVaultServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "vault-token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		var body any
+		switch r.URL.Path {
+		case "/v1/secret/data/cluster-foo":
+			body = map[string]any{"data": map[string]any{
+				"data":     map[string]any{"token": "kv2-token"},
+				"metadata": map[string]any{"version": 1},
+			}}
+		case "/v1/kv/cluster-foo":
+			body = map[string]any{"data": map[string]any{"token": "kv1-token"}}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestSecretResolver_Resolve(t *testing.T) {
+	server := newVaultServer(t)
+	t.Setenv(envVaultAddr, server.URL)
+	t.Setenv(envVaultToken, "vault-token")
+
+	secretARN := "arn:aws:secretsmanager:us-east-1:123456789012:secret:cluster-foo"
+	var region string
+	r := &secretResolver{
+		httpClient: server.Client(),
+		newSecretsManager: func(r string) (secretsmanageriface.SecretsManagerAPI, error) {
+			region = r
+			return &fakeSecretsManager{secrets: map[string]string{
+				secretARN: `{"token":"aws-token"}`,
+			}}, nil
+		},
+	}
+
+	t.Run("Vault KV version 2", func(t *testing.T) {
+		value, err := r.resolve(t.Context(), "vault:secret/data/cluster-foo#token")
+		require.NoError(t, err)
+		assert.Equal(t, "kv2-token", value)
+	})
+	t.Run("Vault KV version 1", func(t *testing.T) {
+		value, err := r.resolve(t.Context(), "vault:kv/cluster-foo#token")
+		require.NoError(t, err)
+		assert.Equal(t, "kv1-token", value)
+	})
+	t.Run("Vault missing field", func(t *testing.T) {
+		_, err := r.resolve(t.Context(), "vault:kv/cluster-foo#cert")
+		assert.ErrorContains(t, err, `does not contain the string field "cert"`)
+	})
+	t.Run("Vault missing secret", func(t *testing.T) {
+		_, err := r.resolve(t.Context(), "vault:kv/cluster-bar#token")
+		assert.ErrorContains(t, err, "unexpected status 404")
+	})
+	t.Run("Vault reference without field", func(t *testing.T) {
+		_, err := r.resolve(t.Context(), "vault:kv/cluster-foo")
+		assert.ErrorContains(t, err, "invalid Vault reference")
+	})
+	t.Run("AWS secret field", func(t *testing.T) {
+		value, err := r.resolve(t.Context(), secretARN+"#token")
+		require.NoError(t, err)
+		assert.Equal(t, "aws-token", value)
+		assert.Equal(t, "us-east-1", region)
+	})
+	t.Run("AWS secret string", func(t *testing.T) {
+		value, err := r.resolve(t.Context(), secretARN)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"token":"aws-token"}`, value)
+	})
+	t.Run("AWS missing secret", func(t *testing.T) {
+		_, err := r.resolve(t.Context(), "arn:aws:secretsmanager:us-east-1:123456789012:secret:cluster-bar")
+		assert.ErrorContains(t, err, "secret not found")
+	})
+	t.Run("unsupported reference", func(t *testing.T) {
+		_, err := r.resolve(t.Context(), "gcp:projects/foo/secrets/bar")
+		assert.ErrorContains(t, err, "unsupported secret reference")
+	})
+}
+
+func TestSecretResolver_ResolveVaultRequiresToken(t *testing.T) {
+	t.Setenv(envVaultAddr, "http://vault.example.com")
+	t.Setenv(envVaultToken, "")
+	t.Setenv(envVaultRole, "")
+
+	r := &secretResolver{httpClient: http.DefaultClient}
+	_, err := r.resolve(t.Context(), "vault:secret/data/cluster-foo#token")
+	assert.ErrorContains(t, err, "either VAULT_TOKEN or VAULT_ROLE must be set")
+}
@@ -12,6 +12,7 @@ import (
 	"syscall"
 
 	"github.com/argoproj/argo-cd/v3/common"
+	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 
 	"github.com/argoproj/argo-cd/v3/util/env"
@@ -93,6 +94,10 @@ func NewCommand() *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("failed to create Kubernetes client: %w", err)
 			}
+			appClient, err := appclientset.NewForConfig(restConfig)
+			if err != nil {
+				return fmt.Errorf("failed to create Argo CD client: %w", err)
+			}
 			if namespace == "" {
 				namespace, _, err = clientConfig.Namespace()
 				if err != nil {
@@ -138,7 +143,7 @@ func NewCommand() *cobra.Command {
 				tlsConfig.Certificates = pool
 			}
 			repoClientset := apiclient.NewRepoServerClientset(argocdRepoServer, 5, tlsConfig)
-			argocdService, err := service.NewArgoCDService(k8sClient, namespace, repoClientset)
+			argocdService, err := service.NewArgoCDService(k8sClient, appClient, namespace, repoClientset)
 			if err != nil {
 				return fmt.Errorf("failed to initialize Argo CD service: %w", err)
 			}
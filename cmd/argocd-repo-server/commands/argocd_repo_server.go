@@ -18,6 +18,8 @@ import (
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 
 	cmdutil "github.com/argoproj/argo-cd/v3/cmd/util"
 	"github.com/argoproj/argo-cd/v3/common"
@@ -31,9 +33,12 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/cli"
 	"github.com/argoproj/argo-cd/v3/util/env"
 	"github.com/argoproj/argo-cd/v3/util/errors"
+	executil "github.com/argoproj/argo-cd/v3/util/exec"
 	"github.com/argoproj/argo-cd/v3/util/gpg"
 	"github.com/argoproj/argo-cd/v3/util/healthz"
+	"github.com/argoproj/argo-cd/v3/util/helm"
 	ioutil "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/oci"
 	"github.com/argoproj/argo-cd/v3/util/tls"
 	traceutil "github.com/argoproj/argo-cd/v3/util/trace"
 )
@@ -77,6 +82,15 @@ func NewCommand() *cobra.Command {
 		disableManifestMaxExtractedSize   bool
 		includeHiddenDirectories          bool
 		cmpUseManifestGeneratePaths       bool
+		helmSecretValuesKubernetes        bool
+		helmSecretValuesVaultAddress      string
+		helmSecretValuesVaultPathPrefix   string
+		helmSecretValuesCacheExpiration   time.Duration
+		sandboxConfig                     string
+		ociManifestMaxExtractedSize       string
+		ociCosignPublicKeys               string
+		manifestGenerationLockTimeout     time.Duration
+		sharedChartCacheMaxSize           string
 	)
 	command := cobra.Command{
 		Use:               cliName,
@@ -128,6 +142,43 @@ func NewCommand() *cobra.Command {
 			helmRegistryMaxIndexSizeQuantity, err := resource.ParseQuantity(helmRegistryMaxIndexSize)
 			errors.CheckError(err)
 
+			ociManifestMaxExtractedSizeQuantity, err := resource.ParseQuantity(ociManifestMaxExtractedSize)
+			errors.CheckError(err)
+
+			ociSignatureVerifier, err := oci.NewSignatureVerifier(ociCosignPublicKeys)
+			errors.CheckError(err)
+
+			sharedChartCacheMaxSizeQuantity, err := resource.ParseQuantity(sharedChartCacheMaxSize)
+			errors.CheckError(err)
+
+			var helmSecretValuesResolver helm.SecretValuesResolver
+			if helmSecretValuesKubernetes || helmSecretValuesVaultAddress != "" {
+				opts := helm.SecretValuesResolverOpts{
+					VaultAddress:    helmSecretValuesVaultAddress,
+					VaultTokenEnv:   "VAULT_TOKEN",
+					VaultPathPrefix: helmSecretValuesVaultPathPrefix,
+					CacheExpiration: helmSecretValuesCacheExpiration,
+				}
+				if helmSecretValuesKubernetes {
+					// The repo server doesn't need a service account token otherwise, e.g. automountServiceAccountToken
+					// might be disabled, in which case the references to Kubernetes Secrets are refused
+					config, err := rest.InClusterConfig()
+					if err != nil {
+						log.Warnf("The references to Kubernetes Secrets of the Helm values are disabled, since the in-cluster config is not available: %v", err)
+					} else {
+						opts.Clientset, err = kubernetes.NewForConfig(config)
+						errors.CheckError(err)
+					}
+				}
+				helmSecretValuesResolver = helm.NewSecretValuesResolver(opts)
+			}
+
+			sandboxes, err := repository.ParseSandboxConfig(sandboxConfig)
+			errors.CheckError(err)
+			if sandboxes.HasResourceLimits() {
+				errors.CheckError(executil.InitSandboxCgroup())
+			}
+
 			askPassServer := askpass.NewServer(askpass.SocketPath)
 			metricsServer := metrics.NewMetricsServer()
 			cacheutil.CollectMetrics(redisClient, metricsServer, nil)
@@ -146,6 +197,12 @@ func NewCommand() *cobra.Command {
 				HelmRegistryMaxIndexSize:                     helmRegistryMaxIndexSizeQuantity.ToDec().Value(),
 				IncludeHiddenDirectories:                     includeHiddenDirectories,
 				CMPUseManifestGeneratePaths:                  cmpUseManifestGeneratePaths,
+				HelmSecretValuesResolver:                     helmSecretValuesResolver,
+				SandboxConfig:                                sandboxes,
+				OCIManifestMaxExtractedSize:                  ociManifestMaxExtractedSizeQuantity.ToDec().Value(),
+				OCISignatureVerifier:                         ociSignatureVerifier,
+				ManifestGenerationLockTimeout:                manifestGenerationLockTimeout,
+				SharedChartCacheMaxSize:                      sharedChartCacheMaxSizeQuantity.ToDec().Value(),
 			}, askPassServer)
 			errors.CheckError(err)
 
@@ -251,6 +308,15 @@ func NewCommand() *cobra.Command {
 	command.Flags().StringVar(&helmRegistryMaxIndexSize, "helm-registry-max-index-size", env.StringFromEnv("ARGOCD_REPO_SERVER_HELM_MANIFEST_MAX_INDEX_SIZE", "1G"), "Maximum size of registry index file")
 	command.Flags().BoolVar(&disableManifestMaxExtractedSize, "disable-helm-manifest-max-extracted-size", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_DISABLE_HELM_MANIFEST_MAX_EXTRACTED_SIZE", false), "Disable maximum size of helm manifest archives when extracted")
 	command.Flags().BoolVar(&includeHiddenDirectories, "include-hidden-directories", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_INCLUDE_HIDDEN_DIRECTORIES", false), "Include hidden directories from Git")
+	command.Flags().BoolVar(&helmSecretValuesKubernetes, "helm-secret-values-kubernetes", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_HELM_SECRET_VALUES_KUBERNETES", false), "Resolve the references to Kubernetes Secrets ($secret:<namespace>/<name>:<key>) of the Helm values")
+	command.Flags().StringVar(&helmSecretValuesVaultAddress, "helm-secret-values-vault-address", env.StringFromEnv("ARGOCD_REPO_SERVER_HELM_SECRET_VALUES_VAULT_ADDRESS", ""), "Address of the Vault server resolving the references to Vault secrets ($vault:<path>:<key>) of the Helm values, authenticated with the VAULT_TOKEN environment variable")
+	command.Flags().StringVar(&helmSecretValuesVaultPathPrefix, "helm-secret-values-vault-path-prefix", env.StringFromEnv("ARGOCD_REPO_SERVER_HELM_SECRET_VALUES_VAULT_PATH_PREFIX", "secret/data/argocd"), "Path of the Vault secrets referenced by the Helm values, under which every project can only reference the secrets of <prefix>/<project>/")
+	command.Flags().DurationVar(&helmSecretValuesCacheExpiration, "helm-secret-values-cache-expiration", env.ParseDurationFromEnv("ARGOCD_REPO_SERVER_HELM_SECRET_VALUES_CACHE_EXPIRATION", time.Minute, 0, math.MaxInt64), "Cache expiration of the secrets referenced by the Helm values")
+	command.Flags().StringVar(&sandboxConfig, "sandbox-config", env.StringFromEnv("ARGOCD_REPO_SERVER_SANDBOX_CONFIG", ""), "YAML configuration of the sandboxes constraining the CPU, memory, duration and network of the helm and kustomize commands, per tool and per project")
+	command.Flags().StringVar(&ociManifestMaxExtractedSize, "oci-manifest-max-extracted-size", env.StringFromEnv("ARGOCD_REPO_SERVER_OCI_MANIFEST_MAX_EXTRACTED_SIZE", "1G"), "Maximum size of the OCI artifacts of the Applications when extracted")
+	command.Flags().StringVar(&ociCosignPublicKeys, "oci-cosign-public-keys", env.StringFromEnv("ARGOCD_REPO_SERVER_OCI_COSIGN_PUBLIC_KEYS", ""), "PEM encoded cosign public keys verifying the signatures of the OCI artifacts of the Applications, the signatures are not verified if empty")
+	command.Flags().DurationVar(&manifestGenerationLockTimeout, "manifest-generation-lock-timeout", env.ParseDurationFromEnv("ARGOCD_REPO_SERVER_MANIFEST_GENERATION_LOCK_TIMEOUT", 0, 0, math.MaxInt64), "Maximum duration a replica waits for another replica generating the same manifests before generating them, the replicas don't wait for each other if 0")
+	command.Flags().StringVar(&sharedChartCacheMaxSize, "shared-chart-cache-max-size", env.StringFromEnv("ARGOCD_REPO_SERVER_SHARED_CHART_CACHE_MAX_SIZE", "0"), "Maximum size of the Helm chart and dependency archives shared between the replicas through Redis, the archives aren't shared if 0")
 	command.Flags().BoolVar(&cmpUseManifestGeneratePaths, "plugin-use-manifest-generate-paths", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_PLUGIN_USE_MANIFEST_GENERATE_PATHS", false), "Pass the resources described in argocd.argoproj.io/manifest-generate-paths value to the cmpserver to generate the application manifests.")
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(&command)
 	cacheSrc = reposervercache.AddCacheFlagsToCmd(&command, cacheutil.Options{
@@ -60,6 +60,7 @@ func NewAccountCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewAccountGenerateTokenCommand(clientOpts))
 	command.AddCommand(NewAccountGetCommand(clientOpts))
 	command.AddCommand(NewAccountDeleteTokenCommand(clientOpts))
+	command.AddCommand(NewAccountListExpiringTokensCommand(clientOpts))
 	command.AddCommand(NewBcryptCmd())
 	return command
 }
@@ -447,3 +448,54 @@ argocd account delete-token --account <account-name> ID`,
 	cmd.Flags().StringVarP(&account, "account", "a", "", "Account name. Defaults to the current account.")
 	return cmd
 }
+
+func printExpiringTokensTable(items []*accountpkg.ExpiringToken) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "KIND\tNAME\tROLE\tID\tISSUED AT\tEXPIRING AT\n")
+	for _, t := range items {
+		expiresAt := time.Unix(t.ExpiresAt, 0)
+		expiresAtFormatted := expiresAt.Format(time.RFC3339)
+		if expiresAt.Before(time.Now()) {
+			expiresAtFormatted = expiresAtFormatted + " (expired)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", t.Kind, t.Name, t.Role, t.Id, time.Unix(t.IssuedAt, 0).Format(time.RFC3339), expiresAtFormatted)
+	}
+	_ = w.Flush()
+}
+
+func NewAccountListExpiringTokensCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		output string
+		within string
+	)
+	cmd := &cobra.Command{
+		Use:   "list-expiring-tokens",
+		Short: "List the tokens of the local accounts and of the project roles expiring soon",
+		Example: `# List the tokens expiring within 7 days
+argocd account list-expiring-tokens
+
+# List the tokens expiring within 30 days
+argocd account list-expiring-tokens --within 30d`,
+		Run: func(c *cobra.Command, _ []string) {
+			ctx := c.Context()
+
+			conn, client := headless.NewClientOrDie(clientOpts, c).NewAccountClientOrDie()
+			defer io.Close(conn)
+
+			response, err := client.ListExpiringTokens(ctx, &accountpkg.ListExpiringTokensRequest{Within: within})
+			errors.CheckError(err)
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(response.Items, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				printExpiringTokensTable(response.Items)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	cmd.Flags().StringVar(&within, "within", "7d", "Duration the listed tokens expire within")
+	return cmd
+}
@@ -0,0 +1,174 @@
+package admin
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/argoproj/argo-cd/v3/util/breakglass"
+	"github.com/argoproj/argo-cd/v3/util/cli"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	"github.com/argoproj/argo-cd/v3/util/session"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+// NewAccessCommand returns a new instance of the argocd admin access command
+func NewAccessCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "access",
+		Short: "Manage the elevated roles granted to the users for a limited time in an emergency",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+		},
+	}
+	command.AddCommand(NewAccessGrantCommand())
+	command.AddCommand(NewAccessRevokeCommand())
+	command.AddCommand(NewAccessListCommand())
+	return command
+}
+
+func newAccessGrantStore(ctx context.Context, clientConfig clientcmd.ClientConfig) (*breakglass.Store, kubernetes.Interface) {
+	config, err := clientConfig.ClientConfig()
+	errors.CheckError(err)
+	namespace, _, err := clientConfig.Namespace()
+	errors.CheckError(err)
+	kubeClientset := kubernetes.NewForConfigOrDie(config)
+	return breakglass.NewStore(namespace, kubeClientset, settings.NewSettingsManager(ctx, kubeClientset, namespace), "argocd-cli"), kubeClientset
+}
+
+// getKubeUser returns the name of the user authenticated by the Kubernetes API server, recorded as the user granting or
+// revoking the roles. The name of the kubeconfig user is chosen by the local user, it isn't recorded.
+func getKubeUser(ctx context.Context, kubeClientset kubernetes.Interface) (string, error) {
+	review, err := kubeClientset.AuthenticationV1().SelfSubjectReviews().Create(ctx, &authenticationv1.SelfSubjectReview{}, metav1.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get the user authenticated by the Kubernetes API server: %w", err)
+	}
+	if review.Status.UserInfo.Username == "" {
+		return "", stderrors.New("the Kubernetes API server did not return the authenticated user")
+	}
+	return review.Status.UserInfo.Username, nil
+}
+
+// NewAccessGrantCommand returns a new instance of the argocd admin access grant command
+func NewAccessGrantCommand() *cobra.Command {
+	var (
+		clientConfig clientcmd.ClientConfig
+		issuer       string
+		role         string
+		duration     time.Duration
+		reason       string
+	)
+	command := &cobra.Command{
+		Use:   "grant SUBJECT",
+		Short: "Grant a role to a user for a limited time",
+		Long:  "Grant a role to a user, identified by the issuer and the subject of its tokens, for a limited time. The grant is recorded as a Kubernetes event and is revoked automatically when it expires.",
+		Example: `# Grant the admin role to the local user alice for one hour
+argocd admin access grant alice --role admin --duration 1h --reason "Production outage INC-1234"
+
+# Grant the admin role to a user of the identity provider for one hour
+argocd admin access grant 00u1a2b3c4 --issuer https://idp.example.com --role admin --duration 1h --reason "Production outage INC-1234"`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			store, kubeClientset := newAccessGrantStore(ctx, clientConfig)
+			grantedBy, err := getKubeUser(ctx, kubeClientset)
+			errors.CheckError(err)
+			grant, err := store.Create(ctx, issuer, args[0], role, reason, grantedBy, duration)
+			errors.CheckError(err)
+			fmt.Printf("Granted role %s to %s until %s (grant %s)\n", grant.Role, grant.Subject, grant.ExpiresAt.Format(time.RFC3339), grant.ID)
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	command.Flags().StringVar(&issuer, "issuer", session.SessionManagerClaimsIssuer, "Issuer of the tokens of the user, e.g. the issuer of the OIDC provider. Defaults to the issuer of the tokens of the local users")
+	command.Flags().StringVar(&role, "role", "", "Role to grant, e.g. admin")
+	command.Flags().DurationVar(&duration, "duration", time.Hour, "Duration the role is granted for")
+	command.Flags().StringVar(&reason, "reason", "", "Reason the role is granted for, recorded in the audit events")
+	return command
+}
+
+// NewAccessRevokeCommand returns a new instance of the argocd admin access revoke command
+func NewAccessRevokeCommand() *cobra.Command {
+	var clientConfig clientcmd.ClientConfig
+	command := &cobra.Command{
+		Use:   "revoke ID",
+		Short: "Revoke a role granted to a user before it expires",
+		Example: `# Revoke a grant
+argocd admin access revoke 7f5a0a2e-6b36-4d3a-9d7f-2f3f1b0a6c1e`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			store, kubeClientset := newAccessGrantStore(ctx, clientConfig)
+			revokedBy, err := getKubeUser(ctx, kubeClientset)
+			errors.CheckError(err)
+			grant, err := store.Revoke(ctx, args[0], revokedBy)
+			errors.CheckError(err)
+			fmt.Printf("Revoked role %s of %s (grant %s)\n", grant.Role, grant.Subject, grant.ID)
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	return command
+}
+
+// NewAccessListCommand returns a new instance of the argocd admin access list command
+func NewAccessListCommand() *cobra.Command {
+	var (
+		clientConfig clientcmd.ClientConfig
+		all          bool
+	)
+	command := &cobra.Command{
+		Use:   "list",
+		Short: "List the roles granted to the users",
+		Example: `# List the active grants
+argocd admin access list
+
+# List all the grants, including the revoked and expired ones
+argocd admin access list --all`,
+		Run: func(c *cobra.Command, _ []string) {
+			ctx := c.Context()
+
+			store, _ := newAccessGrantStore(ctx, clientConfig)
+			grants, err := store.List(ctx)
+			errors.CheckError(err)
+			printAccessGrants(grants, all, time.Now())
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	command.Flags().BoolVar(&all, "all", false, "List the revoked and expired grants too")
+	return command
+}
+
+func printAccessGrants(grants []breakglass.Grant, all bool, now time.Time) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "ID\tISSUER\tSUBJECT\tROLE\tGRANTED BY\tEXPIRES AT\tSTATUS\tREASON\n")
+	for _, grant := range grants {
+		status := "Active"
+		switch {
+		case grant.RevokedAt != nil:
+			status = "Revoked"
+		case !grant.IsActive(now):
+			status = "Expired"
+		}
+		if !all && status != "Active" {
+			continue
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", grant.ID, grant.Issuer, grant.Subject, grant.Role, grant.GrantedBy, grant.ExpiresAt.Format(time.RFC3339), status, grant.Reason)
+	}
+	_ = w.Flush()
+}
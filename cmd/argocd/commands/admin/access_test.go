@@ -0,0 +1,30 @@
+package admin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	kubetesting "k8s.io/client-go/testing"
+)
+
+func TestGetKubeUser(t *testing.T) {
+	kubeClientset := fake.NewClientset()
+	kubeClientset.PrependReactor("create", "selfsubjectreviews", func(kubetesting.Action) (bool, runtime.Object, error) {
+		return true, &authenticationv1.SelfSubjectReview{Status: authenticationv1.SelfSubjectReviewStatus{UserInfo: authenticationv1.UserInfo{Username: "ops@example.com"}}}, nil
+	})
+	user, err := getKubeUser(t.Context(), kubeClientset)
+	require.NoError(t, err)
+	assert.Equal(t, "ops@example.com", user)
+
+	// the user of the kubeconfig is never recorded in place of the authenticated user
+	kubeClientset = fake.NewClientset()
+	kubeClientset.PrependReactor("create", "selfsubjectreviews", func(kubetesting.Action) (bool, runtime.Object, error) {
+		return true, &authenticationv1.SelfSubjectReview{}, nil
+	})
+	_, err = getKubeUser(t.Context(), kubeClientset)
+	require.ErrorContains(t, err, "did not return the authenticated user")
+}
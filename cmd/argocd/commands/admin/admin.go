@@ -74,13 +74,15 @@ $ argocd admin initial-password reset
 	command.AddCommand(NewProjectsCommand())
 	command.AddCommand(NewSettingsCommand())
 	command.AddCommand(NewAppCommand(clientOpts))
-	command.AddCommand(NewRepoCommand())
+	command.AddCommand(NewRepoCommand(clientOpts))
 	command.AddCommand(NewImportCommand())
 	command.AddCommand(NewExportCommand())
 	command.AddCommand(NewDashboardCommand(clientOpts))
 	command.AddCommand(NewNotificationsCommand())
 	command.AddCommand(NewInitialPasswordCommand())
 	command.AddCommand(NewRedisInitialPasswordCommand())
+	command.AddCommand(NewAccessCommand())
+	command.AddCommand(NewSessionsCommand(clientOpts))
 
 	command.Flags().StringVar(&cmdutil.LogFormat, "logformat", "json", "Set the logging format. One of: json|text")
 	command.Flags().StringVar(&cmdutil.LogLevel, "loglevel", "info", "Set the logging level. One of: debug|info|warn|error")
@@ -14,7 +14,9 @@ import (
 	"github.com/redis/go-redis/v9"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/rest"
@@ -33,6 +35,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/cli"
 	"github.com/argoproj/argo-cd/v3/util/clusterauth"
 	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/env"
 	"github.com/argoproj/argo-cd/v3/util/errors"
 	"github.com/argoproj/argo-cd/v3/util/glob"
 	kubeutil "github.com/argoproj/argo-cd/v3/util/kube"
@@ -61,7 +64,9 @@ argocd admin cluster namespaces my-cluster `,
 	command.AddCommand(NewClusterConfig())
 	command.AddCommand(NewGenClusterConfigCommand(pathOpts))
 	command.AddCommand(NewClusterStatsCommand(clientOpts))
+	command.AddCommand(NewClusterResourcesCommand(clientOpts))
 	command.AddCommand(NewClusterShardsCommand(clientOpts))
+	command.AddCommand(NewClusterValidateCommand())
 	namespacesCommand := NewClusterNamespacesCommand()
 	namespacesCommand.AddCommand(NewClusterEnableNamespacedMode())
 	namespacesCommand.AddCommand(NewClusterDisableNamespacedMode())
@@ -78,7 +83,7 @@ type ClusterWithInfo struct {
 	Namespaces []string
 }
 
-func loadClusters(ctx context.Context, kubeClient kubernetes.Interface, appClient versioned.Interface, replicas int, shardingAlgorithm string, namespace string, portForwardRedis bool, cacheSrc func() (*appstatecache.Cache, error), shard int, redisName string, redisHaProxyName string, redisCompressionStr string) ([]ClusterWithInfo, error) {
+func loadClusters(ctx context.Context, kubeClient kubernetes.Interface, appClient versioned.Interface, replicas int, shardingAlgorithm string, shardingLabelRules []sharding.ShardLabelRule, namespace string, portForwardRedis bool, cacheSrc func() (*appstatecache.Cache, error), shard int, redisName string, redisHaProxyName string, redisCompressionStr string) ([]ClusterWithInfo, error) {
 	settingsMgr := settings.NewSettingsManager(ctx, kubeClient, namespace)
 
 	argoDB := db.NewDB(namespace, settingsMgr, kubeClient)
@@ -90,7 +95,7 @@ func loadClusters(ctx context.Context, kubeClient kubernetes.Interface, appClien
 	if err != nil {
 		return nil, err
 	}
-	clusterShardingCache := sharding.NewClusterSharding(argoDB, shard, replicas, shardingAlgorithm)
+	clusterShardingCache := sharding.NewClusterShardingWithLabelRules(argoDB, shard, replicas, shardingAlgorithm, shardingLabelRules)
 	clusterShardingCache.Init(clustersList, appItems)
 	clusterShards := clusterShardingCache.GetDistribution()
 
@@ -180,12 +185,13 @@ func getControllerReplicas(ctx context.Context, kubeClient *kubernetes.Clientset
 
 func NewClusterShardsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		shard             int
-		replicas          int
-		shardingAlgorithm string
-		clientConfig      clientcmd.ClientConfig
-		cacheSrc          func() (*appstatecache.Cache, error)
-		portForwardRedis  bool
+		shard              int
+		replicas           int
+		shardingAlgorithm  string
+		shardingLabelRules string
+		clientConfig       clientcmd.ClientConfig
+		cacheSrc           func() (*appstatecache.Cache, error)
+		portForwardRedis   bool
 	)
 	command := cobra.Command{
 		Use:   "shards",
@@ -209,7 +215,9 @@ func NewClusterShardsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 			if replicas == 0 {
 				return
 			}
-			clusters, err := loadClusters(ctx, kubeClient, appClient, replicas, shardingAlgorithm, namespace, portForwardRedis, cacheSrc, shard, clientOpts.RedisName, clientOpts.RedisHaProxyName, clientOpts.RedisCompression)
+			labelRules, err := sharding.ParseShardLabelRules(shardingLabelRules)
+			errors.CheckError(err)
+			clusters, err := loadClusters(ctx, kubeClient, appClient, replicas, shardingAlgorithm, labelRules, namespace, portForwardRedis, cacheSrc, shard, clientOpts.RedisName, clientOpts.RedisHaProxyName, clientOpts.RedisCompression)
 			errors.CheckError(err)
 			if len(clusters) == 0 {
 				return
@@ -222,6 +230,7 @@ func NewClusterShardsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 	command.Flags().IntVar(&shard, "shard", -1, "Cluster shard filter")
 	command.Flags().IntVar(&replicas, "replicas", 0, "Application controller replicas count. Inferred from number of running controller pods if not specified")
 	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", common.DefaultShardingAlgorithm, "Sharding method. Defaults: legacy. Supported sharding methods are : [legacy, round-robin, consistent-hashing] ")
+	command.Flags().StringVar(&shardingLabelRules, "sharding-label-rules", env.StringFromEnv(common.EnvControllerShardingLabelRules, ""), "Shard label rules of the application controller, as a YAML or JSON list, e.g. [{\"selector\": \"region=eu\", \"shard\": 2}]")
 	command.Flags().BoolVar(&portForwardRedis, "port-forward-redis", true, "Automatically port-forward ha proxy redis from current namespace?")
 
 	cacheSrc = appstatecache.AddCacheFlagsToCmd(&command)
@@ -233,6 +242,112 @@ func NewClusterShardsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 	return &command
 }
 
+// NewClusterValidateCommand returns a new instance of an `argocd admin cluster validate` command
+func NewClusterValidateCommand() *cobra.Command {
+	var (
+		clientConfig    clientcmd.ClientConfig
+		files           []string
+		checkConnection bool
+	)
+	command := cobra.Command{
+		Use:   "validate [SECRET...]",
+		Short: "Validate declarative cluster secrets",
+		Long: "Check cluster secrets for a malformed config, an invalid server URL, expired certificates, namespaces overlapping " +
+			"with another cluster secret of the same server and an unreachable server. Validates the secrets read from --file, " +
+			"the named secrets, or all the cluster secrets of the namespace.",
+		Example: `  # Validate all the cluster secrets of the Argo CD namespace:
+  argocd admin cluster validate
+
+  # Validate a cluster secret manifest before applying it:
+  argocd admin cluster validate --file cluster-secret.yaml`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := cmd.Context()
+
+			log.SetLevel(log.WarnLevel)
+
+			if len(files) > 0 && len(args) > 0 {
+				log.Fatal("Can only use one of secret names or --file")
+			}
+			clientCfg, err := clientConfig.ClientConfig()
+			errors.CheckError(err)
+			namespace, _, err := clientConfig.Namespace()
+			errors.CheckError(err)
+			kubeClient := kubernetes.NewForConfigOrDie(clientCfg)
+
+			var secrets []*corev1.Secret
+			switch {
+			case len(files) > 0:
+				secrets, err = readClusterSecretsFromFiles(files)
+				errors.CheckError(err)
+			case len(args) > 0:
+				for _, name := range args {
+					secret, err := kubeClient.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+					errors.CheckError(err)
+					secrets = append(secrets, secret)
+				}
+			default:
+				list, err := kubeClient.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{
+					LabelSelector: fmt.Sprintf("%s=%s", common.LabelKeySecretType, common.LabelValueSecretTypeCluster),
+				})
+				errors.CheckError(err)
+				for i := range list.Items {
+					secrets = append(secrets, &list.Items[i])
+				}
+			}
+
+			settingsMgr := settings.NewSettingsManager(ctx, kubeClient, namespace)
+			argoDB := db.NewDB(namespace, settingsMgr, kubeClient)
+			invalid := 0
+			for _, secret := range secrets {
+				problems, err := argoDB.ValidateClusterSecret(ctx, secret, checkConnection)
+				errors.CheckError(err)
+				if len(problems) == 0 {
+					fmt.Printf("Secret '%s' is valid\n", secret.Name)
+					continue
+				}
+				invalid++
+				fmt.Printf("Secret '%s' is invalid:\n", secret.Name)
+				for _, problem := range problems {
+					fmt.Printf("  - %s\n", problem)
+				}
+			}
+			if invalid > 0 {
+				log.Fatalf("%d of %d cluster secret(s) are invalid", invalid, len(secrets))
+			}
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(&command)
+	command.Flags().StringArrayVarP(&files, "file", "f", nil, "Path to a YAML file with the cluster secrets to validate")
+	command.Flags().BoolVar(&checkConnection, "check-connection", true, "Test the connection to the cluster servers")
+	return &command
+}
+
+// readClusterSecretsFromFiles returns the secrets of the YAML files. Objects of other kinds are ignored.
+func readClusterSecretsFromFiles(paths []string) ([]*corev1.Secret, error) {
+	var secrets []*corev1.Secret
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		objs, err := kube.SplitYAML(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+		for _, obj := range objs {
+			if obj.GetKind() != kube.SecretKind {
+				continue
+			}
+			var secret corev1.Secret
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &secret); err != nil {
+				return nil, fmt.Errorf("failed to convert secret %s of %s: %w", obj.GetName(), path, err)
+			}
+			secrets = append(secrets, &secret)
+		}
+	}
+	return secrets, nil
+}
+
 func printStatsSummary(clusters []ClusterWithInfo) {
 	totalResourcesCount := int64(0)
 	resourcesCountByShard := map[int]int64{}
@@ -459,12 +574,13 @@ func NewClusterDisableNamespacedMode() *cobra.Command {
 
 func NewClusterStatsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		shard             int
-		replicas          int
-		shardingAlgorithm string
-		clientConfig      clientcmd.ClientConfig
-		cacheSrc          func() (*appstatecache.Cache, error)
-		portForwardRedis  bool
+		shard              int
+		replicas           int
+		shardingAlgorithm  string
+		shardingLabelRules string
+		clientConfig       clientcmd.ClientConfig
+		cacheSrc           func() (*appstatecache.Cache, error)
+		portForwardRedis   bool
 	)
 	command := cobra.Command{
 		Use:   "stats",
@@ -494,7 +610,9 @@ argocd admin cluster stats target-cluster`,
 				replicas, err = getControllerReplicas(ctx, kubeClient, namespace, clientOpts.AppControllerName)
 				errors.CheckError(err)
 			}
-			clusters, err := loadClusters(ctx, kubeClient, appClient, replicas, shardingAlgorithm, namespace, portForwardRedis, cacheSrc, shard, clientOpts.RedisName, clientOpts.RedisHaProxyName, clientOpts.RedisCompression)
+			labelRules, err := sharding.ParseShardLabelRules(shardingLabelRules)
+			errors.CheckError(err)
+			clusters, err := loadClusters(ctx, kubeClient, appClient, replicas, shardingAlgorithm, labelRules, namespace, portForwardRedis, cacheSrc, shard, clientOpts.RedisName, clientOpts.RedisHaProxyName, clientOpts.RedisCompression)
 			errors.CheckError(err)
 
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
@@ -509,6 +627,7 @@ argocd admin cluster stats target-cluster`,
 	command.Flags().IntVar(&shard, "shard", -1, "Cluster shard filter")
 	command.Flags().IntVar(&replicas, "replicas", 0, "Application controller replicas count. Inferred from number of running controller pods if not specified")
 	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", common.DefaultShardingAlgorithm, "Sharding method. Defaults: legacy. Supported sharding methods are : [legacy, round-robin, consistent-hashing] ")
+	command.Flags().StringVar(&shardingLabelRules, "sharding-label-rules", env.StringFromEnv(common.EnvControllerShardingLabelRules, ""), "Shard label rules of the application controller, as a YAML or JSON list, e.g. [{\"selector\": \"region=eu\", \"shard\": 2}]")
 	command.Flags().BoolVar(&portForwardRedis, "port-forward-redis", true, "Automatically port-forward ha proxy redis from current namespace?")
 	cacheSrc = appstatecache.AddCacheFlagsToCmd(&command)
 
@@ -619,30 +738,31 @@ func NewGenClusterConfigCommand(pathOpts *clientcmd.PathOptions) *cobra.Command
 					RoleARN:     clusterOpts.AwsRoleArn,
 					Profile:     clusterOpts.AwsProfile,
 				}
-			case clusterOpts.ExecProviderCommand != "":
-				execProviderConf = &v1alpha1.ExecProviderConfig{
-					Command:     clusterOpts.ExecProviderCommand,
-					Args:        clusterOpts.ExecProviderArgs,
-					Env:         clusterOpts.ExecProviderEnv,
-					APIVersion:  clusterOpts.ExecProviderAPIVersion,
-					InstallHint: clusterOpts.ExecProviderInstallHint,
-				}
+			case clusterOpts.ExecProviderConfig() != nil:
+				execProviderConf = clusterOpts.ExecProviderConfig()
+			case clusterOpts.ExternalCredentialsConfig() != nil:
+				// The credentials are resolved from the external secret manager when connecting to the cluster
 			case generateToken:
 				bearerToken, err = GenerateToken(clusterOpts, conf)
 				errors.CheckError(err)
 			case bearerToken == "":
 				bearerToken = "bearer-token"
 			}
-			if clusterOpts.Name != "" {
-				contextName = clusterOpts.Name
-			}
+			contextName = clusterOpts.ClusterName(contextName)
 
 			labelsMap, err := label.Parse(labels)
 			errors.CheckError(err)
 			annotationsMap, err := label.Parse(annotations)
 			errors.CheckError(err)
 
-			clst := cmdutil.NewCluster(contextName, clusterOpts.Namespaces, clusterOpts.ClusterResources, conf, bearerToken, awsAuthConf, execProviderConf, labelsMap, annotationsMap)
+			clst := cmdutil.NewCluster(contextName, clusterOpts.Namespaces, clusterOpts.ClusterResources, conf, cmdutil.ClusterManagerCredentials{BearerToken: bearerToken}, awsAuthConf, execProviderConf, labelsMap, annotationsMap)
+			cmdutil.SetExternalCredentials(clst, clusterOpts.ExternalCredentialsConfig())
+			clst.Config.ImpersonationConfig, err = clusterOpts.ImpersonationConfig()
+			errors.CheckError(err)
+			clst.Config.RateLimitConfig = clusterOpts.RateLimitConfig()
+			cmdutil.SetProxyCredentials(clst, clusterOpts.ProxyCredentialsSecretRef())
+			clst.Config.SSHTunnelConfig, err = clusterOpts.SSHTunnelConfig()
+			errors.CheckError(err)
 			if clusterOpts.InClusterEndpoint() {
 				clst.Server = v1alpha1.KubernetesInternalAPIServerAddr
 			}
@@ -0,0 +1,202 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/controller"
+	"github.com/argoproj/argo-cd/v3/controller/sharding"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-cd/v3/util/cli"
+	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/env"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	kubeutil "github.com/argoproj/argo-cd/v3/util/kube"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+// getClusterShard returns the shard of the controller handling the cluster
+func getClusterShard(ctx context.Context, kubeClient kubernetes.Interface, appClient versioned.Interface, namespace string, server string, replicas int, shardingAlgorithm string, shardingLabelRules []sharding.ShardLabelRule) (int, error) {
+	argoDB := db.NewDB(namespace, settings.NewSettingsManager(ctx, kubeClient, namespace), kubeClient)
+	clustersList, err := argoDB.ListClusters(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("error listing clusters: %w", err)
+	}
+	appItems, err := appClient.ArgoprojV1alpha1().Applications(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("error listing applications: %w", err)
+	}
+	clusterShardingCache := sharding.NewClusterShardingWithLabelRules(argoDB, -1, replicas, shardingAlgorithm, shardingLabelRules)
+	clusterShardingCache.Init(clustersList, appItems)
+	shard, ok := clusterShardingCache.GetDistribution()[server]
+	if !ok {
+		return 0, fmt.Errorf("cluster %s not found", server)
+	}
+	return shard, nil
+}
+
+func getClusterResourceInventory(client *http.Client, controllerURL string, server string) (*controller.ClusterResourceInventory, error) {
+	resp, err := client.Get(fmt.Sprintf("%s%s?server=%s", controllerURL, controller.ClusterResourcesPath, url.QueryEscape(server)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the resources of cluster %s: %w", server, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to get the resources of cluster %s: %s: %s", server, resp.Status, strings.TrimSpace(string(body)))
+	}
+	var inventory controller.ClusterResourceInventory
+	if err := json.NewDecoder(resp.Body).Decode(&inventory); err != nil {
+		return nil, fmt.Errorf("failed to decode the resources of cluster %s: %w", server, err)
+	}
+	return &inventory, nil
+}
+
+// formatCounts formats the counts by decreasing count, only the limit highest if limit is not 0
+func formatCounts(counts map[string]int, limit int) string {
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	items := make([]string, 0, len(keys))
+	for i, key := range keys {
+		if limit > 0 && i == limit {
+			items = append(items, fmt.Sprintf("(total %d)", len(keys)))
+			break
+		}
+		items = append(items, fmt.Sprintf("%s=%d", key, counts[key]))
+	}
+	return strings.Join(items, ",")
+}
+
+func printClusterResourceInventory(out io.Writer, inventory *controller.ClusterResourceInventory) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "GROUP\tKIND\tCOUNT\tNAMESPACES\tAPPLICATIONS\tOWNERS\tHEALTH\n")
+	for _, kind := range inventory.Kinds {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\t%s\t%s\n",
+			kind.Group,
+			kind.Kind,
+			kind.Count,
+			formatCounts(kind.Namespaces, 3),
+			formatCounts(kind.Applications, 3),
+			formatCounts(kind.OwnerKinds, 3),
+			formatCounts(kind.Health, 0))
+	}
+	_ = w.Flush()
+}
+
+// NewClusterResourcesCommand returns a new instance of an `argocd admin cluster resources` command
+func NewClusterResourcesCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		clientConfig       clientcmd.ClientConfig
+		controllerURL      string
+		shard              int
+		replicas           int
+		shardingAlgorithm  string
+		shardingLabelRules string
+		outputFormat       string
+	)
+	command := &cobra.Command{
+		Use:   "resources SERVER",
+		Short: "Print the resources of a cluster held by the live state cache of the application controller",
+		Long:  "Print the resources of a cluster held by the live state cache of the application controller, counted by kind, namespace, managing application, kind of owner and health status. The resources are fetched from the debug server of the controller shard handling the cluster, which only listens on the loopback interface of the controller pod and is reached by port-forwarding to the pod.",
+		Example: `  # Print the cached resources of a cluster, port-forwarding to the controller pod of the shard handling the cluster
+  argocd admin cluster resources https://kubernetes.default.svc
+
+  # Export the cached resources of a cluster as JSON
+  argocd admin cluster resources https://kubernetes.default.svc -o json
+
+  # Print the cached resources of a cluster from the debug server of a controller already port-forwarded
+  argocd admin cluster resources https://kubernetes.default.svc --controller-url http://localhost:8088`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			server := args[0]
+			log.SetLevel(log.WarnLevel)
+
+			if controllerURL == "" {
+				clientCfg, err := clientConfig.ClientConfig()
+				errors.CheckError(err)
+				namespace, _, err := clientConfig.Namespace()
+				errors.CheckError(err)
+				kubeClient := kubernetes.NewForConfigOrDie(clientCfg)
+				appClient := versioned.NewForConfigOrDie(clientCfg)
+
+				if replicas == 0 {
+					replicas, err = getControllerReplicas(ctx, kubeClient, namespace, clientOpts.AppControllerName)
+					errors.CheckError(err)
+				}
+				controllerPodLabelSelector := common.LabelKeyAppName + "=" + clientOpts.AppControllerName
+				if replicas > 1 {
+					if shard < 0 {
+						labelRules, err := sharding.ParseShardLabelRules(shardingLabelRules)
+						errors.CheckError(err)
+						shard, err = getClusterShard(ctx, kubeClient, appClient, namespace, server, replicas, shardingAlgorithm, labelRules)
+						errors.CheckError(err)
+					}
+					// the pods of the controller stateful set are named after their shard
+					controllerPodLabelSelector = fmt.Sprintf("statefulset.kubernetes.io/pod-name=%s-%d", clientOpts.AppControllerName, shard)
+				}
+				overrides := clientcmd.ConfigOverrides{}
+				port, err := kubeutil.PortForward(common.DefaultPortArgoCDControllerDebug, namespace, &overrides, controllerPodLabelSelector)
+				errors.CheckError(err)
+				controllerURL = fmt.Sprintf("http://localhost:%d", port)
+			}
+
+			inventory, err := getClusterResourceInventory(&http.Client{Timeout: 30 * time.Second}, strings.TrimSuffix(controllerURL, "/"), server)
+			errors.CheckError(err)
+
+			switch outputFormat {
+			case "wide", "":
+				printClusterResourceInventory(os.Stdout, inventory)
+			case "json":
+				jsonBytes, err := json.MarshalIndent(inventory, "", "  ")
+				errors.CheckError(err)
+				fmt.Println(string(jsonBytes))
+			case "yaml":
+				yamlBytes, err := yaml.Marshal(inventory)
+				errors.CheckError(err)
+				fmt.Print(string(yamlBytes))
+			default:
+				errors.CheckError(stderrors.New("unknown output format: " + outputFormat))
+			}
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToSet(command.Flags())
+	command.Flags().StringVar(&controllerURL, "controller-url", "", "URL of the debug server of the controller handling the cluster, port-forwards to the controller pod of the shard handling the cluster if not set")
+	command.Flags().IntVar(&shard, "shard", -1, "Shard of the controller handling the cluster. Inferred from the sharding of the clusters if not specified")
+	command.Flags().IntVar(&replicas, "replicas", 0, "Application controller replicas count. Inferred from number of running controller pods if not specified")
+	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", common.DefaultShardingAlgorithm, "Sharding method. Defaults: legacy. Supported sharding methods are : [legacy, round-robin, consistent-hashing] ")
+	command.Flags().StringVar(&shardingLabelRules, "sharding-label-rules", env.StringFromEnv(common.EnvControllerShardingLabelRules, ""), "Shard label rules of the application controller, as a YAML or JSON list, e.g. [{\"selector\": \"region=eu\", \"shard\": 2}]")
+	command.Flags().StringVarP(&outputFormat, "output", "o", "wide", "Output format. One of: wide|json|yaml")
+	return command
+}
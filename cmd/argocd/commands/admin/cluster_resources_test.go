@@ -0,0 +1,57 @@
+package admin
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/controller"
+)
+
+func TestGetClusterResourceInventory(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, controller.ClusterResourcesPath, r.URL.Path)
+		server := r.URL.Query().Get("server")
+		if server != "https://kubernetes.default.svc" {
+			http.Error(w, "cluster "+server+" is not handled by this controller shard", http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(controller.ClusterResourceInventory{Server: server, ResourcesCount: 1, Kinds: []controller.ResourceKindInventory{{Kind: "Pod", Count: 1}}})
+	}))
+	defer srv.Close()
+
+	inventory, err := getClusterResourceInventory(srv.Client(), srv.URL, "https://kubernetes.default.svc")
+	require.NoError(t, err)
+	assert.Equal(t, &controller.ClusterResourceInventory{Server: "https://kubernetes.default.svc", ResourcesCount: 1, Kinds: []controller.ResourceKindInventory{{Kind: "Pod", Count: 1}}}, inventory)
+
+	_, err = getClusterResourceInventory(srv.Client(), srv.URL, "https://other")
+	assert.ErrorContains(t, err, "404 Not Found: cluster https://other is not handled by this controller shard")
+}
+
+func TestPrintClusterResourceInventory(t *testing.T) {
+	var out bytes.Buffer
+	printClusterResourceInventory(&out, &controller.ClusterResourceInventory{
+		Server: "https://kubernetes.default.svc",
+		Kinds: []controller.ResourceKindInventory{{
+			Kind:       "Pod",
+			Count:      6,
+			Namespaces: map[string]int{"a": 1, "b": 3, "c": 1, "d": 1},
+			OwnerKinds: map[string]int{"ReplicaSet": 6},
+			Health:     map[string]int{"Healthy": 5, "Progressing": 1},
+		}, {
+			Group:        "apps",
+			Kind:         "Deployment",
+			Count:        1,
+			Namespaces:   map[string]int{"b": 1},
+			Applications: map[string]int{"guestbook": 1},
+		}},
+	})
+	assert.Equal(t, "GROUP  KIND        COUNT  NAMESPACES             APPLICATIONS  OWNERS        HEALTH\n"+
+		"       Pod         6      b=3,a=1,c=1,(total 4)                ReplicaSet=6  Healthy=5,Progressing=1\n"+
+		"apps   Deployment  1      b=1                    guestbook=1                 \n", out.String())
+}
@@ -1,6 +1,8 @@
 package admin
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -56,7 +58,7 @@ func Test_loadClusters(t *testing.T) {
 	cacheSrc := func() (*appstate.Cache, error) {
 		return appstate.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Minute)), time.Minute), nil
 	}
-	clusters, err := loadClusters(ctx, kubeClient, appClient, 3, "", "argocd", false, cacheSrc, 0, "", "", "")
+	clusters, err := loadClusters(ctx, kubeClient, appClient, 3, "", nil, "argocd", false, cacheSrc, 0, "", "", "")
 	require.NoError(t, err)
 	for i := range clusters {
 		// This changes, nil it to avoid testing it.
@@ -79,3 +81,33 @@ func Test_loadClusters(t *testing.T) {
 	}}
 	assert.Equal(t, expected, clusters)
 }
+
+func Test_readClusterSecretsFromFiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "clusters.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: not-a-secret
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: my-cluster
+  labels:
+    argocd.argoproj.io/secret-type: cluster
+data:
+  server: aHR0cHM6Ly9rdWJlcm5ldGVzLmRlZmF1bHQuc3Zj
+stringData:
+  name: my-cluster
+`), 0o644))
+
+	secrets, err := readClusterSecretsFromFiles([]string{path})
+	require.NoError(t, err)
+	require.Len(t, secrets, 1)
+	assert.Equal(t, "my-cluster", secrets[0].Name)
+	assert.Equal(t, "https://kubernetes.default.svc", string(secrets[0].Data["server"]))
+	assert.Equal(t, "my-cluster", secrets[0].StringData["name"])
+
+	_, err = readClusterSecretsFromFiles([]string{filepath.Join(t.TempDir(), "missing.yaml")})
+	require.ErrorContains(t, err, "failed to read")
+}
@@ -12,6 +12,7 @@ import (
 
 	cmdutil "github.com/argoproj/argo-cd/v3/cmd/util"
 	"github.com/argoproj/argo-cd/v3/common"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
 	"github.com/argoproj/argo-cd/v3/util/cli"
 	"github.com/argoproj/argo-cd/v3/util/db"
 	"github.com/argoproj/argo-cd/v3/util/errors"
@@ -24,7 +25,7 @@ const (
 	repoSecretPrefix = "repo"
 )
 
-func NewRepoCommand() *cobra.Command {
+func NewRepoCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command := &cobra.Command{
 		Use:   "repo",
 		Short: "Manage repositories configuration",
@@ -33,6 +34,7 @@ func NewRepoCommand() *cobra.Command {
 		},
 	}
 	command.AddCommand(NewGenRepoSpecCommand())
+	command.AddCommand(NewRepoTopCommand(clientOpts))
 
 	return command
 }
@@ -128,6 +130,8 @@ func NewGenRepoSpecCommand() *cobra.Command {
 			repoOpts.Repo.InsecureIgnoreHostKey = repoOpts.InsecureIgnoreHostKey
 			repoOpts.Repo.Insecure = repoOpts.InsecureSkipServerVerification
 			repoOpts.Repo.EnableLFS = repoOpts.EnableLfs
+			repoOpts.Repo.Depth = repoOpts.Depth
+			repoOpts.Repo.Filter = repoOpts.Filter
 			repoOpts.Repo.EnableOCI = repoOpts.EnableOci
 			repoOpts.Repo.UseAzureWorkloadIdentity = repoOpts.UseAzureWorkloadIdentity
 
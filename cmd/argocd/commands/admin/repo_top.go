@@ -0,0 +1,230 @@
+package admin
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/cli"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	kubeutil "github.com/argoproj/argo-cd/v3/util/kube"
+)
+
+const (
+	manifestGenerationDurationMetric = "argocd_repo_manifest_generation_duration_seconds"
+	manifestGenerationCPUMetric      = "argocd_repo_manifest_generation_cpu_seconds_total"
+	manifestGenerationOutputMetric   = "argocd_repo_manifest_generation_output_bytes_total"
+)
+
+// appRenderCost is the cost of generating the manifests of an application, since the start of the repo servers
+type appRenderCost struct {
+	Application string  `json:"application"`
+	SourceType  string  `json:"sourceType"`
+	Generations uint64  `json:"generations"`
+	Failures    uint64  `json:"failures"`
+	Duration    float64 `json:"durationSeconds"`
+	CPU         float64 `json:"cpuSeconds"`
+	OutputBytes float64 `json:"outputBytes"`
+}
+
+// renderCosts aggregates the render costs of the applications scraped from the metrics of the repo servers
+type renderCosts map[string]*appRenderCost
+
+func (r renderCosts) get(metric *dto.Metric) *appRenderCost {
+	labels := map[string]string{}
+	for _, label := range metric.GetLabel() {
+		labels[label.GetName()] = label.GetValue()
+	}
+	key := labels["application"] + "/" + labels["source_type"]
+	cost, ok := r[key]
+	if !ok {
+		cost = &appRenderCost{Application: labels["application"], SourceType: labels["source_type"]}
+		r[key] = cost
+	}
+	return cost
+}
+
+// add adds the render costs of the metrics of a repo server, in the Prometheus text format
+func (r renderCosts) add(metrics io.Reader) error {
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(metrics)
+	if err != nil {
+		return fmt.Errorf("failed to parse the metrics: %w", err)
+	}
+	for _, metric := range families[manifestGenerationDurationMetric].GetMetric() {
+		cost := r.get(metric)
+		count := metric.GetHistogram().GetSampleCount()
+		cost.Generations += count
+		cost.Duration += metric.GetHistogram().GetSampleSum()
+		for _, label := range metric.GetLabel() {
+			if label.GetName() == "failed" && label.GetValue() == "true" {
+				cost.Failures += count
+			}
+		}
+	}
+	for _, metric := range families[manifestGenerationCPUMetric].GetMetric() {
+		r.get(metric).CPU += metric.GetCounter().GetValue()
+	}
+	for _, metric := range families[manifestGenerationOutputMetric].GetMetric() {
+		r.get(metric).OutputBytes += metric.GetCounter().GetValue()
+	}
+	return nil
+}
+
+// top returns the limit most expensive applications, all if limit is 0
+func (r renderCosts) top(sortBy string, limit int) ([]*appRenderCost, error) {
+	var value func(cost *appRenderCost) float64
+	switch sortBy {
+	case "cpu":
+		value = func(cost *appRenderCost) float64 { return cost.CPU }
+	case "duration":
+		value = func(cost *appRenderCost) float64 { return cost.Duration }
+	case "size":
+		value = func(cost *appRenderCost) float64 { return cost.OutputBytes }
+	case "count":
+		value = func(cost *appRenderCost) float64 { return float64(cost.Generations) }
+	default:
+		return nil, fmt.Errorf("unknown sort key %q, must be one of cpu, duration, size, count", sortBy)
+	}
+	costs := make([]*appRenderCost, 0, len(r))
+	for _, cost := range r {
+		costs = append(costs, cost)
+	}
+	sort.Slice(costs, func(i, j int) bool {
+		if value(costs[i]) != value(costs[j]) {
+			return value(costs[i]) > value(costs[j])
+		}
+		if costs[i].Application != costs[j].Application {
+			return costs[i].Application < costs[j].Application
+		}
+		return costs[i].SourceType < costs[j].SourceType
+	})
+	if limit > 0 && len(costs) > limit {
+		costs = costs[:limit]
+	}
+	return costs, nil
+}
+
+func scrapeRenderCosts(client *http.Client, url string, costs renderCosts) error {
+	resp, err := client.Get(url)
+	if err != nil {
+		return fmt.Errorf("failed to get the metrics of %s: %w", url, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to get the metrics of %s: unexpected status %s", url, resp.Status)
+	}
+	return costs.add(resp.Body)
+}
+
+func printRenderCosts(out io.Writer, costs []*appRenderCost) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "APPLICATION\tSOURCE TYPE\tGENERATIONS\tFAILURES\tAVG DURATION\tTOTAL DURATION\tCPU\tOUTPUT\n")
+	for _, cost := range costs {
+		avgDuration := time.Duration(0)
+		if cost.Generations > 0 {
+			avgDuration = time.Duration(cost.Duration / float64(cost.Generations) * float64(time.Second))
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\t%s\t%s\t%s\n",
+			cost.Application,
+			cost.SourceType,
+			cost.Generations,
+			cost.Failures,
+			avgDuration.Round(time.Millisecond),
+			time.Duration(cost.Duration*float64(time.Second)).Round(time.Millisecond),
+			time.Duration(cost.CPU*float64(time.Second)).Round(time.Millisecond),
+			formatBytes(cost.OutputBytes))
+	}
+	_ = w.Flush()
+}
+
+func formatBytes(size float64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%.0fB", size)
+	}
+	exp := 0
+	for n := size / unit; n >= unit && exp < 4; n /= unit {
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", size/float64(uint64(1)<<(10*(exp+1))), "KMGTP"[exp])
+}
+
+// NewRepoTopCommand returns a new instance of an `argocd admin repo top` command
+func NewRepoTopCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		clientConfig clientcmd.ClientConfig
+		metricsURLs  []string
+		sortBy       string
+		limit        int
+		outputFormat string
+	)
+	command := &cobra.Command{
+		Use:   "top",
+		Short: "Show the applications responsible for the load of the repo server",
+		Long:  "Show the cost of generating the manifests of the applications since the start of the repo servers, scraped from the metrics of the repo servers. The CPU is the CPU time of the config management tools run to generate the manifests.",
+		Example: `  # Show the applications using the most CPU of the repo server, port-forwarding to a repo server pod
+  argocd admin repo top
+
+  # Show the 10 applications whose manifest generation takes the longest, across all the replicas of the repo server
+  argocd admin repo top --sort-by duration --limit 10 --metrics-url http://10.0.0.1:8084/metrics --metrics-url http://10.0.0.2:8084/metrics`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 0 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			if len(metricsURLs) == 0 {
+				namespace, _, err := clientConfig.Namespace()
+				errors.CheckError(err)
+				overrides := clientcmd.ConfigOverrides{}
+				repoServerPodLabelSelector := common.LabelKeyAppName + "=" + clientOpts.RepoServerName
+				port, err := kubeutil.PortForward(common.DefaultPortRepoServerMetrics, namespace, &overrides, repoServerPodLabelSelector)
+				errors.CheckError(err)
+				metricsURLs = []string{fmt.Sprintf("http://localhost:%d/metrics", port)}
+			}
+
+			costs := renderCosts{}
+			client := &http.Client{Timeout: 30 * time.Second}
+			for _, url := range metricsURLs {
+				errors.CheckError(scrapeRenderCosts(client, url, costs))
+			}
+			top, err := costs.top(sortBy, limit)
+			errors.CheckError(err)
+
+			switch outputFormat {
+			case "wide", "":
+				printRenderCosts(os.Stdout, top)
+			case "json":
+				jsonBytes, err := json.MarshalIndent(top, "", "  ")
+				errors.CheckError(err)
+				fmt.Println(string(jsonBytes))
+			case "yaml":
+				yamlBytes, err := yaml.Marshal(top)
+				errors.CheckError(err)
+				fmt.Print(string(yamlBytes))
+			default:
+				errors.CheckError(stderrors.New("unknown output format: " + outputFormat))
+			}
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToSet(command.Flags())
+	command.Flags().StringArrayVar(&metricsURLs, "metrics-url", []string{}, "URL of the metrics endpoint of a repo server, port-forwards to a repo server pod if not set")
+	command.Flags().StringVar(&sortBy, "sort-by", "cpu", "Sort the applications by cpu, duration, size or count")
+	command.Flags().IntVar(&limit, "limit", 20, "Maximum number of applications to show, all if 0")
+	command.Flags().StringVarP(&outputFormat, "output", "o", "wide", "Output format. One of: wide|json|yaml")
+	return command
+}
@@ -0,0 +1,61 @@
+package admin
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const repoServerMetrics = `# HELP argocd_repo_manifest_generation_duration_seconds Manifest generation duration seconds, by application and source type.
+# TYPE argocd_repo_manifest_generation_duration_seconds histogram
+argocd_repo_manifest_generation_duration_seconds_bucket{application="guestbook",failed="false",source_type="Helm",le="+Inf"} 3
+argocd_repo_manifest_generation_duration_seconds_sum{application="guestbook",failed="false",source_type="Helm"} 6
+argocd_repo_manifest_generation_duration_seconds_count{application="guestbook",failed="false",source_type="Helm"} 3
+argocd_repo_manifest_generation_duration_seconds_bucket{application="guestbook",failed="true",source_type="Helm",le="+Inf"} 1
+argocd_repo_manifest_generation_duration_seconds_sum{application="guestbook",failed="true",source_type="Helm"} 1
+argocd_repo_manifest_generation_duration_seconds_count{application="guestbook",failed="true",source_type="Helm"} 1
+argocd_repo_manifest_generation_duration_seconds_bucket{application="monitoring",failed="false",source_type="Kustomize",le="+Inf"} 2
+argocd_repo_manifest_generation_duration_seconds_sum{application="monitoring",failed="false",source_type="Kustomize"} 10
+argocd_repo_manifest_generation_duration_seconds_count{application="monitoring",failed="false",source_type="Kustomize"} 2
+# HELP argocd_repo_manifest_generation_cpu_seconds_total CPU seconds consumed by the config management tools generating the manifests, by application and source type.
+# TYPE argocd_repo_manifest_generation_cpu_seconds_total counter
+argocd_repo_manifest_generation_cpu_seconds_total{application="guestbook",source_type="Helm"} 4.5
+argocd_repo_manifest_generation_cpu_seconds_total{application="monitoring",source_type="Kustomize"} 1.5
+# HELP argocd_repo_manifest_generation_output_bytes_total Size in bytes of the generated manifests, by application and source type.
+# TYPE argocd_repo_manifest_generation_output_bytes_total counter
+argocd_repo_manifest_generation_output_bytes_total{application="guestbook",source_type="Helm"} 3072
+argocd_repo_manifest_generation_output_bytes_total{application="monitoring",source_type="Kustomize"} 2.097152e+06
+`
+
+func TestRenderCosts(t *testing.T) {
+	costs := renderCosts{}
+	// the costs of the replicas are summed
+	require.NoError(t, costs.add(strings.NewReader(repoServerMetrics)))
+	require.NoError(t, costs.add(strings.NewReader(repoServerMetrics)))
+
+	top, err := costs.top("cpu", 0)
+	require.NoError(t, err)
+	require.Len(t, top, 2)
+	assert.Equal(t, appRenderCost{Application: "guestbook", SourceType: "Helm", Generations: 8, Failures: 2, Duration: 14, CPU: 9, OutputBytes: 6144}, *top[0])
+	assert.Equal(t, "monitoring", top[1].Application)
+
+	top, err = costs.top("duration", 1)
+	require.NoError(t, err)
+	require.Len(t, top, 1)
+	assert.Equal(t, "monitoring", top[0].Application)
+
+	_, err = costs.top("memory", 0)
+	require.ErrorContains(t, err, "unknown sort key")
+
+	var out bytes.Buffer
+	top, err = costs.top("size", 0)
+	require.NoError(t, err)
+	printRenderCosts(&out, top)
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.Equal(t, []string{"monitoring", "Kustomize", "4", "0", "5s", "20s", "3s", "4.0MiB"}, strings.Fields(lines[1]))
+	assert.Equal(t, []string{"guestbook", "Helm", "8", "2", "1.75s", "14s", "9s", "6.0KiB"}, strings.Fields(lines[2]))
+}
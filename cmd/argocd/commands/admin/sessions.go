@@ -0,0 +1,124 @@
+package admin
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	sessionpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/session"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	ioutil "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+// NewSessionsCommand returns a new instance of the argocd admin sessions command
+func NewSessionsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "sessions",
+		Short: "Manage the active sessions of the users",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+		},
+	}
+	command.AddCommand(NewSessionsListCommand(clientOpts))
+	command.AddCommand(NewSessionsRevokeCommand(clientOpts))
+	return command
+}
+
+// NewSessionsListCommand returns a new instance of the argocd admin sessions list command
+func NewSessionsListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		subject      string
+		outputFormat string
+	)
+	command := &cobra.Command{
+		Use:   "list",
+		Short: "List the sessions which were active in the last 24 hours",
+		Example: `# List the active sessions
+argocd admin sessions list
+
+# List the active sessions of alice
+argocd admin sessions list --subject alice`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 0 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, client := headless.NewClientOrDie(clientOpts, c).NewSessionClientOrDie()
+			defer ioutil.Close(conn)
+
+			sessions, err := client.ListSessions(ctx, &sessionpkg.SessionListRequest{Subject: subject})
+			errors.CheckError(err)
+
+			switch outputFormat {
+			case "wide", "":
+				printSessions(os.Stdout, sessions.Items)
+			case "json":
+				jsonBytes, err := json.MarshalIndent(sessions.Items, "", "  ")
+				errors.CheckError(err)
+				fmt.Println(string(jsonBytes))
+			case "yaml":
+				yamlBytes, err := yaml.Marshal(sessions.Items)
+				errors.CheckError(err)
+				fmt.Print(string(yamlBytes))
+			default:
+				errors.CheckError(stderrors.New("unknown output format: " + outputFormat))
+			}
+		},
+	}
+	command.Flags().StringVar(&subject, "subject", "", "List only the sessions of the given subject")
+	command.Flags().StringVarP(&outputFormat, "output", "o", "wide", "Output format. One of: wide|json|yaml")
+	return command
+}
+
+// NewSessionsRevokeCommand returns a new instance of the argocd admin sessions revoke command
+func NewSessionsRevokeCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "revoke ID",
+		Short: "Revoke an active session, whose token is rejected until it expires",
+		Example: `# Revoke a session
+argocd admin sessions revoke 0f6b2c8e7d1a4b3c`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, client := headless.NewClientOrDie(clientOpts, c).NewSessionClientOrDie()
+			defer ioutil.Close(conn)
+
+			revoked, err := client.RevokeSession(ctx, &sessionpkg.SessionRevokeRequest{Id: args[0]})
+			errors.CheckError(err)
+			fmt.Printf("Revoked session %s of %s\n", revoked.Id, revoked.Subject)
+		},
+	}
+	return command
+}
+
+func formatUnixTime(seconds int64) string {
+	if seconds == 0 {
+		return "-"
+	}
+	return time.Unix(seconds, 0).UTC().Format(time.RFC3339)
+}
+
+func printSessions(out io.Writer, sessions []*sessionpkg.ActiveSession) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "ID\tSUBJECT\tISSUER\tISSUED AT\tLAST ACTIVITY\tEXPIRES AT\tCLIENT IP\n")
+	for _, session := range sessions {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", session.Id, session.Subject, session.Issuer,
+			formatUnixTime(session.IssuedAt), formatUnixTime(session.LastActivity), formatUnixTime(session.ExpiresAt), session.ClientIP)
+	}
+	_ = w.Flush()
+}
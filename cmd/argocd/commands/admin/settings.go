@@ -212,6 +212,11 @@ var validatorsByGroup = map[string]settingValidator{
 			}
 			ssoProvider = "OIDC"
 		}
+		if general.AdditionalOIDCConfigsRAW != "" {
+			if err := settings.ValidateAdditionalOIDCConfigs(general.AdditionalOIDCConfigsRAW, general.IssuerURL()); err != nil {
+				return "", fmt.Errorf("invalid oidc.additionalConfigs: %w", err)
+			}
+		}
 		var summary string
 		if ssoProvider != "" {
 			summary = ssoProvider + " is configured"
@@ -2,21 +2,30 @@ package admin
 
 import (
 	"context"
+	"encoding/csv"
+	"encoding/json"
+	stderrors "errors"
 	"fmt"
+	"io"
 	"os"
 	"strings"
+	"text/tabwriter"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/v3/util/assets"
 	"github.com/argoproj/argo-cd/v3/util/cli"
+	"github.com/argoproj/argo-cd/v3/util/errors"
 	"github.com/argoproj/argo-cd/v3/util/rbac"
 )
 
@@ -81,13 +90,15 @@ var defaultCRDActions = actionTraitMap{
 }
 
 var applicationsActions = actionTraitMap{
-	rbac.ActionCreate:   rbacTrait{},
-	rbac.ActionGet:      rbacTrait{},
-	rbac.ActionUpdate:   rbacTrait{allowPath: true},
-	rbac.ActionDelete:   rbacTrait{allowPath: true},
-	rbac.ActionAction:   rbacTrait{allowPath: true},
-	rbac.ActionOverride: rbacTrait{},
-	rbac.ActionSync:     rbacTrait{},
+	rbac.ActionCreate:           rbacTrait{},
+	rbac.ActionGet:              rbacTrait{},
+	rbac.ActionUpdate:           rbacTrait{allowPath: true},
+	rbac.ActionDelete:           rbacTrait{allowPath: true},
+	rbac.ActionAction:           rbacTrait{allowPath: true},
+	rbac.ActionOverride:         rbacTrait{},
+	rbac.ActionSync:             rbacTrait{},
+	rbac.ActionApprove:          rbacTrait{},
+	rbac.ActionRemoveFinalizers: rbacTrait{},
 }
 
 var accountsActions = actionTraitMap{
@@ -118,6 +129,8 @@ func NewRBACCommand() *cobra.Command {
 	}
 	command.AddCommand(NewRBACCanCommand())
 	command.AddCommand(NewRBACValidateCommand())
+	command.AddCommand(NewRBACExplainCommand())
+	command.AddCommand(NewRBACSimulateCommand())
 	return command
 }
 
@@ -300,6 +313,328 @@ argocd admin settings rbac validate --namespace argocd
 	return command
 }
 
+// NewRBACExplainCommand is the command for 'rbac explain'
+func NewRBACExplainCommand() *cobra.Command {
+	var (
+		policyFile   string
+		projectFile  string
+		defaultRole  string
+		useBuiltin   bool
+		strict       bool
+		groups       []string
+		outputFormat string
+		clientConfig clientcmd.ClientConfig
+	)
+	command := &cobra.Command{
+		Use:   "explain SUBJECT ACTION RESOURCE [SUB-RESOURCE]",
+		Short: "Explain the RBAC decision for a subject",
+		Long: `
+Explain whether a given subject has RBAC permissions to do something, showing
+the subjects the request is evaluated for (the default role, the subject and
+its groups), the roles they are assigned to and the policy lines matching the
+request. The roles of the project of the request are evaluated too.
+`,
+		Example: `
+# Explain whether alice, member of the my-org:team-a group, can sync the
+# application 'default/guestbook', using the ConfigMap 'argocd-rbac-cm' and the
+# AppProject 'default' from K8s
+argocd admin settings rbac explain alice sync applications 'default/guestbook' --groups my-org:team-a --namespace argocd
+
+# Explain whether a project role can sync the application, using a local
+# policy.csv file and a local AppProject manifest
+argocd admin settings rbac explain proj:default:ci sync applications 'default/guestbook' --policy-file policy.csv --project-file project.yaml
+`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) < 3 || len(args) > 4 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			subject, action, resource := args[0], args[1], args[2]
+			subResource := ""
+			if len(args) > 3 {
+				subResource = args[3]
+			}
+
+			namespace, nsOverride, err := clientConfig.Namespace()
+			errors.CheckError(err)
+			// Exactly one of --namespace or --policy-file must be given.
+			if (!nsOverride && policyFile == "") || (nsOverride && policyFile != "") {
+				c.HelpFunc()(c, args)
+				log.Fatalf("please provide exactly one of --policy-file or --namespace")
+			}
+			restConfig, err := clientConfig.ClientConfig()
+			errors.CheckError(err)
+			realClientset, err := kubernetes.NewForConfig(restConfig)
+			errors.CheckError(err)
+
+			userPolicy, newDefaultRole, matchMode := getPolicy(ctx, policyFile, realClientset, namespace)
+			builtinPolicy := ""
+			if useBuiltin {
+				builtinPolicy = assets.BuiltinPolicyCSV
+			}
+			if newDefaultRole != "" && defaultRole == "" {
+				defaultRole = newDefaultRole
+			}
+			enf, err := newPolicyEnforcer(builtinPolicy, userPolicy, defaultRole, matchMode)
+			errors.CheckError(err)
+			realResource, subResource, err := resolveRBACRequest(action, resource, subResource, strict)
+			errors.CheckError(err)
+
+			var proj *v1alpha1.AppProject
+			if projName := getRBACRequestProject(realResource, subResource); projName != "" {
+				if projectFile != "" {
+					proj, err = getProjectFromFile(projectFile)
+					errors.CheckError(err)
+				} else if policyFile == "" {
+					proj, err = appclientset.NewForConfigOrDie(restConfig).ArgoprojV1alpha1().AppProjects(namespace).Get(ctx, projName, metav1.GetOptions{})
+					if err != nil && !apierrors.IsNotFound(err) {
+						errors.CheckError(err)
+					}
+				}
+				if proj != nil && proj.Name != projName {
+					proj = nil
+				}
+			}
+			project, runtimePolicy := "", ""
+			if proj != nil {
+				project, runtimePolicy = proj.Name, proj.ProjectPoliciesString()
+			}
+
+			explanation, err := enf.Explain(project, runtimePolicy, append([]string{subject}, groups...), realResource, action, subResource)
+			errors.CheckError(err)
+
+			switch outputFormat {
+			case "wide", "":
+				printRBACExplanation(os.Stdout, explanation)
+			case "json":
+				jsonBytes, err := json.MarshalIndent(explanation, "", "  ")
+				errors.CheckError(err)
+				fmt.Println(string(jsonBytes))
+			case "yaml":
+				yamlBytes, err := yaml.Marshal(explanation)
+				errors.CheckError(err)
+				fmt.Print(string(yamlBytes))
+			default:
+				errors.CheckError(stderrors.New("unknown output format: " + outputFormat))
+			}
+			if !explanation.Allowed {
+				os.Exit(1)
+			}
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	command.Flags().StringVar(&policyFile, "policy-file", "", "path to the policy file to use")
+	command.Flags().StringVar(&projectFile, "project-file", "", "path to the AppProject manifest whose roles are evaluated, the AppProject is fetched from K8s if not given")
+	command.Flags().StringVar(&defaultRole, "default-role", "", "name of the default role to use")
+	command.Flags().BoolVar(&useBuiltin, "use-builtin-policy", true, "whether to also use builtin-policy")
+	command.Flags().BoolVar(&strict, "strict", true, "whether to perform strict check on action and resource names")
+	command.Flags().StringSliceVar(&groups, "groups", []string{}, "groups of the subject, as found in the groups claim of its token")
+	command.Flags().StringVarP(&outputFormat, "output", "o", "wide", "Output format. One of: wide|json|yaml")
+	return command
+}
+
+// NewRBACSimulateCommand is the command for 'rbac simulate'
+func NewRBACSimulateCommand() *cobra.Command {
+	var (
+		policyFile    string
+		newPolicyFile string
+		requestsFile  string
+		useBuiltin    bool
+		outputFormat  string
+		clientConfig  clientcmd.ClientConfig
+	)
+	command := &cobra.Command{
+		Use:   "simulate --new-policy-file POLICYFILE",
+		Short: "Show the access changed by a new RBAC policy",
+		Long: `
+Show the requests which are allowed by the current RBAC policy and denied by
+the new one, or the other way around, before rolling out the new policy. The
+requests are read from a CSV file with lines 'SUBJECT, ACTION, RESOURCE, OBJECT'.
+If no requests file is given, the requests of every subject of the policies,
+i.e. the subjects of the policy lines and the members of the roles, are
+simulated for the resource, action and object of every policy line.
+`,
+		Example: `
+# Show the access changed by a new policy.csv, compared to the ConfigMap
+# 'argocd-rbac-cm' from K8s
+argocd admin settings rbac simulate --new-policy-file policy.csv --namespace argocd
+
+# Compare two local policy files for the given requests
+argocd admin settings rbac simulate --policy-file current.csv --new-policy-file new.csv --requests-file requests.csv
+`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 0 || newPolicyFile == "" {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			namespace, nsOverride, err := clientConfig.Namespace()
+			errors.CheckError(err)
+			// Exactly one of --namespace or --policy-file must be given.
+			if (!nsOverride && policyFile == "") || (nsOverride && policyFile != "") {
+				c.HelpFunc()(c, args)
+				log.Fatalf("please provide exactly one of --policy-file or --namespace")
+			}
+			restConfig, err := clientConfig.ClientConfig()
+			errors.CheckError(err)
+			realClientset, err := kubernetes.NewForConfig(restConfig)
+			errors.CheckError(err)
+
+			builtinPolicy := ""
+			if useBuiltin {
+				builtinPolicy = assets.BuiltinPolicyCSV
+			}
+			currentPolicy, currentDefaultRole, currentMatchMode := getPolicy(ctx, policyFile, realClientset, namespace)
+			current, err := newPolicyEnforcer(builtinPolicy, currentPolicy, currentDefaultRole, currentMatchMode)
+			errors.CheckError(err)
+			newPolicy, newDefaultRole, newMatchMode, err := getPolicyFromFile(newPolicyFile)
+			errors.CheckError(err)
+			updated, err := newPolicyEnforcer(builtinPolicy, newPolicy, newDefaultRole, newMatchMode)
+			errors.CheckError(err)
+
+			var requests []rbac.Request
+			if requestsFile != "" {
+				requests, err = getRBACRequestsFromFile(requestsFile)
+			} else {
+				requests, err = rbac.SimulationRequests(currentPolicy, newPolicy)
+			}
+			errors.CheckError(err)
+			changes := rbac.DiffAccess(current, updated, requests)
+
+			switch outputFormat {
+			case "wide", "":
+				printRBACAccessChanges(os.Stdout, changes)
+			case "json":
+				jsonBytes, err := json.MarshalIndent(changes, "", "  ")
+				errors.CheckError(err)
+				fmt.Println(string(jsonBytes))
+			case "yaml":
+				yamlBytes, err := yaml.Marshal(changes)
+				errors.CheckError(err)
+				fmt.Print(string(yamlBytes))
+			default:
+				errors.CheckError(stderrors.New("unknown output format: " + outputFormat))
+			}
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	command.Flags().StringVar(&policyFile, "policy-file", "", "path to the current policy file to use")
+	command.Flags().StringVar(&newPolicyFile, "new-policy-file", "", "path to the new policy file to compare with the current policy")
+	command.Flags().StringVar(&requestsFile, "requests-file", "", "path to the CSV file of the requests to simulate")
+	command.Flags().BoolVar(&useBuiltin, "use-builtin-policy", true, "whether to also use builtin-policy")
+	command.Flags().StringVarP(&outputFormat, "output", "o", "wide", "Output format. One of: wide|json|yaml")
+	return command
+}
+
+// getRBACRequestProject returns the name of the project whose roles are evaluated for the request, like the API server
+func getRBACRequestProject(resource, object string) string {
+	switch resource {
+	case rbac.ResourceApplications, rbac.ResourceRepositories, rbac.ResourceClusters, rbac.ResourceLogs, rbac.ResourceExec:
+		if projName, _, ok := strings.Cut(object, "/"); ok && projName != "*" {
+			return projName
+		}
+	case rbac.ResourceProjects:
+		if object != "*" {
+			return object
+		}
+	}
+	return ""
+}
+
+// getProjectFromFile loads an AppProject from the given path
+func getProjectFromFile(projectFile string) (*v1alpha1.AppProject, error) {
+	projectBytes, err := os.ReadFile(projectFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading project file: %w", err)
+	}
+	var proj v1alpha1.AppProject
+	if err := yaml.Unmarshal(projectBytes, &proj); err != nil {
+		return nil, fmt.Errorf("error unmarshaling project file: %w", err)
+	}
+	return &proj, nil
+}
+
+// getRBACRequestsFromFile loads the requests from a CSV file with lines 'SUBJECT, ACTION, RESOURCE, OBJECT'
+func getRBACRequestsFromFile(requestsFile string) ([]rbac.Request, error) {
+	requestsBytes, err := os.ReadFile(requestsFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading requests file: %w", err)
+	}
+	var requests []rbac.Request
+	for _, line := range strings.Split(string(requestsBytes), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		reader := csv.NewReader(strings.NewReader(line))
+		reader.TrimLeadingSpace = true
+		tokens, err := reader.Read()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing request %q: %w", line, err)
+		}
+		if len(tokens) != 4 {
+			return nil, fmt.Errorf("invalid request %q: expected SUBJECT, ACTION, RESOURCE, OBJECT", line)
+		}
+		realResource, object, err := resolveRBACRequest(tokens[1], tokens[2], tokens[3], false)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, rbac.Request{Subject: tokens[0], Action: tokens[1], Resource: realResource, Object: object})
+	}
+	return requests, nil
+}
+
+func formatRBACDecision(allowed bool) string {
+	if allowed {
+		return "Allowed"
+	}
+	return "Denied"
+}
+
+func printRBACExplanation(out io.Writer, explanation *rbac.Explanation) {
+	_, _ = fmt.Fprintf(out, "Request: %s, %s, %s\n", explanation.Resource, explanation.Action, explanation.Object)
+	if explanation.Project != "" {
+		_, _ = fmt.Fprintf(out, "Project: %s\n", explanation.Project)
+	}
+	for _, trace := range explanation.Subjects {
+		subject := trace.Subject
+		if trace.DefaultRole {
+			subject += " (default role)"
+		}
+		_, _ = fmt.Fprintf(out, "\nSubject: %s\n", subject)
+		roles := "-"
+		if len(trace.Roles) > 0 {
+			roles = strings.Join(trace.Roles, ", ")
+		}
+		_, _ = fmt.Fprintf(out, "Roles:   %s\n", roles)
+		_, _ = fmt.Fprintf(out, "Result:  %s\n", formatRBACDecision(trace.Allowed))
+		if len(trace.MatchedRules) == 0 {
+			_, _ = fmt.Fprintf(out, "No matching policy line\n")
+		}
+		for _, rule := range trace.MatchedRules {
+			_, _ = fmt.Fprintf(out, "  %s\n", rule.String())
+		}
+	}
+	_, _ = fmt.Fprintf(out, "\nDecision: %s\n", formatRBACDecision(explanation.Allowed))
+}
+
+func printRBACAccessChanges(out io.Writer, changes []rbac.AccessChange) {
+	if len(changes) == 0 {
+		_, _ = fmt.Fprintln(out, "No access changed.")
+		return
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "SUBJECT\tACTION\tRESOURCE\tOBJECT\tCURRENT\tNEW\n")
+	for _, change := range changes {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", change.Subject, change.Action, change.Resource, change.Object,
+			formatRBACDecision(change.Before), formatRBACDecision(change.After))
+	}
+	_ = w.Flush()
+}
+
 // Load user policy file if requested or use Kubernetes client to get the
 // appropriate ConfigMap from the current context
 func getPolicy(ctx context.Context, policyFile string, kubeClient kubernetes.Interface, namespace string) (userPolicy string, defaultRole string, matchMode string) {
@@ -372,29 +707,29 @@ func getPolicyConfigMap(ctx context.Context, client kubernetes.Interface, namesp
 	return cm, nil
 }
 
-// checkPolicy checks whether given subject is allowed to execute specified
-// action against specified resource
-func checkPolicy(subject, action, resource, subResource, builtinPolicy, userPolicy, defaultRole, matchMode string, strict bool) bool {
+// newPolicyEnforcer returns an enforcer of the given built-in and user policies
+func newPolicyEnforcer(builtinPolicy, userPolicy, defaultRole, matchMode string) (*rbac.Enforcer, error) {
 	enf := rbac.NewEnforcer(nil, "argocd", "argocd-rbac-cm", nil)
 	enf.SetDefaultRole(defaultRole)
 	enf.SetMatchMode(matchMode)
 	if builtinPolicy != "" {
 		if err := enf.SetBuiltinPolicy(builtinPolicy); err != nil {
-			log.Fatalf("could not set built-in policy: %v", err)
-			return false
+			return nil, fmt.Errorf("could not set built-in policy: %w", err)
 		}
 	}
 	if userPolicy != "" {
 		if err := rbac.ValidatePolicy(userPolicy); err != nil {
-			log.Fatalf("invalid user policy: %v", err)
-			return false
+			return nil, fmt.Errorf("invalid user policy: %w", err)
 		}
 		if err := enf.SetUserPolicy(userPolicy); err != nil {
-			log.Fatalf("could not set user policy: %v", err)
-			return false
+			return nil, fmt.Errorf("could not set user policy: %w", err)
 		}
 	}
+	return enf, nil
+}
 
+// resolveRBACRequest resolves the resource and the sub-resource of a request given by the user
+func resolveRBACRequest(action, resource, subResource string, strict bool) (string, string, error) {
 	// User could have used a mutation of the resource name (i.e. 'cert' for
 	// 'certificate') - let's resolve it to the valid resource.
 	realResource := resolveRBACResourceName(resource)
@@ -403,8 +738,7 @@ func checkPolicy(subject, action, resource, subResource, builtinPolicy, userPoli
 	// actually valid tokens.
 	if strict {
 		if err := validateRBACResourceAction(realResource, action); err != nil {
-			log.Fatalf("error in RBAC request: %v", err)
-			return false
+			return "", "", fmt.Errorf("error in RBAC request: %w", err)
 		}
 	}
 
@@ -416,6 +750,22 @@ func checkPolicy(subject, action, resource, subResource, builtinPolicy, userPoli
 			subResource = "*/*"
 		}
 	}
+	return realResource, subResource, nil
+}
+
+// checkPolicy checks whether given subject is allowed to execute specified
+// action against specified resource
+func checkPolicy(subject, action, resource, subResource, builtinPolicy, userPolicy, defaultRole, matchMode string, strict bool) bool {
+	enf, err := newPolicyEnforcer(builtinPolicy, userPolicy, defaultRole, matchMode)
+	if err != nil {
+		log.Fatal(err)
+		return false
+	}
+	realResource, subResource, err := resolveRBACRequest(action, resource, subResource, strict)
+	if err != nil {
+		log.Fatal(err)
+		return false
+	}
 	return enf.Enforce(subject, realResource, action, subResource)
 }
 
@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"bytes"
 	"os"
 	"testing"
 
@@ -307,3 +308,50 @@ func TestNewRBACValidateCommand(t *testing.T) {
 	assert.Equal(t, "validate", command.Name())
 	assert.Equal(t, "Validate RBAC policy", command.Short)
 }
+
+func Test_getRBACRequestProject(t *testing.T) {
+	assert.Equal(t, "default", getRBACRequestProject(rbac.ResourceApplications, "default/guestbook"))
+	assert.Equal(t, "default", getRBACRequestProject(rbac.ResourceProjects, "default"))
+	assert.Empty(t, getRBACRequestProject(rbac.ResourceApplications, "*/*"))
+	assert.Empty(t, getRBACRequestProject(rbac.ResourceCertificates, "default/guestbook"))
+}
+
+func Test_getRBACRequestsFromFile(t *testing.T) {
+	requests, err := getRBACRequestsFromFile("testdata/rbac/requests.csv")
+	require.NoError(t, err)
+	assert.Equal(t, []rbac.Request{
+		{Subject: "my-org:team-qa", Action: "update", Resource: rbac.ResourceProjects, Object: "foo"},
+		{Subject: "alice", Action: "sync", Resource: rbac.ResourceApplications, Object: "default/guestbook"},
+	}, requests)
+}
+
+func Test_printRBACExplanation(t *testing.T) {
+	uPol, dRole, matchMode := getPolicy(t.Context(), "testdata/rbac/argocd-rbac-cm.yaml", nil, "")
+	enf, err := newPolicyEnforcer("", uPol, dRole, matchMode)
+	require.NoError(t, err)
+	explanation, err := enf.Explain("", "", []string{"alice", "my-org:team-qa"}, rbac.ResourceProjects, "update", "foo")
+	require.NoError(t, err)
+	assert.True(t, explanation.Allowed)
+
+	out := &bytes.Buffer{}
+	printRBACExplanation(out, explanation)
+	assert.Contains(t, out.String(), "Subject: role:unknown (default role)")
+	assert.Contains(t, out.String(), "Subject: my-org:team-qa\nRoles:   role:tester\nResult:  Allowed\n  p, role:tester, projects, *, *, allow\n")
+	assert.Contains(t, out.String(), "Decision: Allowed")
+}
+
+func TestNewRBACExplainCommand(t *testing.T) {
+	command := NewRBACExplainCommand()
+
+	require.NotNil(t, command)
+	assert.Equal(t, "explain", command.Name())
+	assert.Equal(t, "Explain the RBAC decision for a subject", command.Short)
+}
+
+func TestNewRBACSimulateCommand(t *testing.T) {
+	command := NewRBACSimulateCommand()
+
+	require.NotNil(t, command)
+	assert.Equal(t, "simulate", command.Name())
+	assert.Equal(t, "Show the access changed by a new RBAC policy", command.Short)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/argoproj/gitops-engine/pkg/sync/ignore"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
+	"github.com/itchyny/gojq"
 	"github.com/mattn/go-isatty"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -30,6 +31,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	k8swatch "k8s.io/apimachinery/pkg/watch"
 	"k8s.io/utils/ptr"
@@ -92,11 +94,17 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.AddCommand(NewApplicationWaitCommand(clientOpts))
 	command.AddCommand(NewApplicationManifestsCommand(clientOpts))
 	command.AddCommand(NewApplicationTerminateOpCommand(clientOpts))
+	command.AddCommand(NewApplicationApproveCommand(clientOpts))
+	command.AddCommand(NewApplicationDriftHistoryCommand(clientOpts))
 	command.AddCommand(NewApplicationEditCommand(clientOpts))
 	command.AddCommand(NewApplicationPatchCommand(clientOpts))
 	command.AddCommand(NewApplicationPatchResourceCommand(clientOpts))
 	command.AddCommand(NewApplicationDeleteResourceCommand(clientOpts))
+	command.AddCommand(NewApplicationAdoptCommand(clientOpts))
+	command.AddCommand(NewApplicationPruneStatusCommand(clientOpts))
+	command.AddCommand(NewApplicationRemoveFinalizersCommand(clientOpts))
 	command.AddCommand(NewApplicationResourceActionsCommand(clientOpts))
+	command.AddCommand(NewApplicationNotificationsCommand(clientOpts))
 	command.AddCommand(NewApplicationListResourcesCommand(clientOpts))
 	command.AddCommand(NewApplicationLogsCommand(clientOpts))
 	command.AddCommand(NewApplicationAddSourceCommand(clientOpts))
@@ -113,6 +121,45 @@ type watchOpts struct {
 	degraded  bool
 	delete    bool
 	hydrated  bool
+	// condition is a jq expression which should evaluate to true against the application
+	condition *gojq.Code
+}
+
+// parseWaitCondition compiles the jq expression of the --condition flag of `argocd app wait`
+func parseWaitCondition(expression string) (*gojq.Code, error) {
+	query, err := gojq.Parse(expression)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse condition '%s': %w", expression, err)
+	}
+	code, err := gojq.Compile(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile condition '%s': %w", expression, err)
+	}
+	return code, nil
+}
+
+// checkWaitCondition returns whether the condition evaluates to a value other than false or null against the
+// application. A condition which fails to evaluate, e.g. because a field is not set yet, is not met.
+func checkWaitCondition(condition *gojq.Code, app *argoappv1.Application) bool {
+	if condition == nil {
+		return true
+	}
+	data, err := json.Marshal(app)
+	if err != nil {
+		return false
+	}
+	var obj map[string]any
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return false
+	}
+	value, ok := condition.Run(obj).Next()
+	if !ok {
+		return false
+	}
+	if _, isErr := value.(error); isErr {
+		return false
+	}
+	return value != nil && value != false
 }
 
 // NewApplicationCreateCommand returns a new instance of an `argocd app create` command
@@ -342,6 +389,7 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 		appNamespace   string
 		sourcePosition int
 		sourceName     string
+		resources      []string
 	)
 	command := &cobra.Command{
 		Use:   "get APPNAME",
@@ -374,6 +422,9 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
   # Perform a hard refresh, including refreshing application data and target manifests cache
   argocd app get my-app --hard-refresh
 
+  # Perform a hard refresh scoped to some resources, re-generating the manifests but re-comparing only these resources
+  argocd app get my-app --hard-refresh --resource apps:Deployment:my-deployment --resource :ConfigMap:my-namespace/my-config
+
   # Get application details and display them in a tree format
   argocd app get my-app --output tree
   
@@ -393,10 +444,21 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 
 			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
 
+			if len(resources) > 0 {
+				if !refresh && !hardRefresh {
+					errors.Fatal(errors.ErrorGeneric, "--resource can only be used together with --refresh or --hard-refresh")
+				}
+				for _, resource := range resources {
+					_, err := argoappv1.ParseResourceRef(resource)
+					errors.CheckError(err)
+				}
+			}
+
 			app, err := appIf.Get(ctx, &application.ApplicationQuery{
-				Name:         &appName,
-				Refresh:      getRefreshType(refresh, hardRefresh),
-				AppNamespace: &appNs,
+				Name:             &appName,
+				Refresh:          getRefreshType(refresh, hardRefresh),
+				AppNamespace:     &appNs,
+				RefreshResources: resources,
 			})
 			errors.CheckError(err)
 
@@ -428,7 +490,14 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: app.Spec.Project})
 			errors.CheckError(err)
 
-			windows := proj.Spec.SyncWindows.Matches(app)
+			clusterConn, clusterIf := acdClient.NewClusterClientOrDie()
+			defer argoio.Close(clusterConn)
+			destCluster, err := clusterIf.Get(ctx, &clusterpkg.ClusterQuery{Name: app.Spec.Destination.Name, Server: app.Spec.Destination.Server})
+			if err != nil {
+				// users without access to the destination cluster only see the windows of the project
+				log.Debugf("Failed to get the destination cluster of the application: %v", err)
+			}
+			windows := proj.Spec.SyncWindows.MatchesWithCluster(app, destCluster)
 
 			switch output {
 			case "yaml", "json":
@@ -466,6 +535,7 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 	command.Flags().BoolVar(&showParams, "show-params", false, "Show application parameters and overrides")
 	command.Flags().BoolVar(&refresh, "refresh", false, "Refresh application data when retrieving")
 	command.Flags().BoolVar(&hardRefresh, "hard-refresh", false, "Refresh application data as well as target manifests cache")
+	command.Flags().StringArrayVar(&resources, "resource", []string{}, "Scope the refresh to a resource in the GROUP:KIND:NAME or GROUP:KIND:NAMESPACE/NAME format, only the specified resources are re-compared. A hard refresh still re-generates the target manifests. This flag can be repeated")
 	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only get application from namespace")
 	command.Flags().IntVar(&sourcePosition, "source-position", -1, "Position of the source from the list of sources of the app. Counting starts at 1.")
 	command.Flags().StringVar(&sourceName, "source-name", "", "Name of the source from the list of sources of the app.")
@@ -894,6 +964,8 @@ type unsetOpts struct {
 	kustomizeImages         []string
 	kustomizeReplicas       []string
 	ignoreMissingComponents bool
+	kustomizeComponents     []string
+	kustomizeBuildOptions   bool
 	parameters              []string
 	valuesFiles             []string
 	valuesLiteral           bool
@@ -911,8 +983,10 @@ func (o *unsetOpts) KustomizeIsZero() bool {
 			!o.kustomizeVersion &&
 			!o.kustomizeNamespace &&
 			!o.ignoreMissingComponents &&
+			!o.kustomizeBuildOptions &&
 			len(o.kustomizeImages) == 0 &&
-			len(o.kustomizeReplicas) == 0
+			len(o.kustomizeReplicas) == 0 &&
+			len(o.kustomizeComponents) == 0
 }
 
 // NewApplicationUnsetCommand returns a new instance of an `argocd app unset` command
@@ -1017,6 +1091,8 @@ func NewApplicationUnsetCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
 	command.Flags().StringArrayVar(&opts.kustomizeImages, "kustomize-image", []string{}, "Kustomize images name (e.g. --kustomize-image node --kustomize-image mysql)")
 	command.Flags().StringArrayVar(&opts.kustomizeReplicas, "kustomize-replica", []string{}, "Kustomize replicas name (e.g. --kustomize-replica my-deployment --kustomize-replica my-statefulset)")
 	command.Flags().BoolVar(&opts.ignoreMissingComponents, "ignore-missing-components", false, "Unset the kustomize ignore-missing-components option (revert to false)")
+	command.Flags().StringArrayVar(&opts.kustomizeComponents, "kustomize-component", []string{}, "Kustomize components (e.g. --kustomize-component ../component1 --kustomize-component ../component2)")
+	command.Flags().BoolVar(&opts.kustomizeBuildOptions, "kustomize-build-options", false, "Kustomize build options")
 	command.Flags().StringArrayVar(&opts.pluginEnvs, "plugin-env", []string{}, "Unset plugin env variables (e.g --plugin-env name)")
 	command.Flags().BoolVar(&opts.passCredentials, "pass-credentials", false, "Unset passCredentials")
 	command.Flags().BoolVar(&opts.ref, "ref", false, "Unset ref on the source")
@@ -1062,6 +1138,18 @@ func unset(source *argoappv1.ApplicationSource, opts unsetOpts) (updated bool, n
 			updated = true
 		}
 
+		if opts.kustomizeBuildOptions && source.Kustomize.BuildOptions != "" {
+			source.Kustomize.BuildOptions = ""
+			updated = true
+		}
+
+		for _, kustomizeComponent := range opts.kustomizeComponents {
+			if i := slices.Index(source.Kustomize.Components, kustomizeComponent); i >= 0 {
+				source.Kustomize.Components = slices.Delete(source.Kustomize.Components, i, i+1)
+				updated = true
+			}
+		}
+
 		for _, kustomizeImage := range opts.kustomizeImages {
 			for i, item := range source.Kustomize.Images {
 				if argoappv1.KustomizeImage(kustomizeImage).Match(item) {
@@ -1238,6 +1326,8 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		revision             string
 		localRepoRoot        string
 		serverSideGenerate   bool
+		serverSideDryRun     bool
+		includeHooks         bool
 		localIncludes        []string
 		appNamespace         string
 		revisions            []string
@@ -1355,8 +1445,26 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					diffOption.cluster = cluster
 				}
 			}
+			if includeHooks && diffOption.res == nil && diffOption.serversideRes == nil && diffOption.local == "" {
+				res, err := appIf.GetManifests(ctx, &application.ApplicationManifestQuery{Name: &appName, AppNamespace: &appNs})
+				errors.CheckError(err)
+				diffOption.hooksRes = res
+			}
+			diffOption.includeHooks = includeHooks
 			proj := getProject(ctx, c, clientOpts, app.Spec.Project)
-			foundDiffs := findandPrintDiff(ctx, app, proj.Project, resources, argoSettings, diffOption, ignoreNormalizerOpts)
+			var foundDiffs bool
+			if serverSideDryRun {
+				res, err := appIf.ServerSideDiff(ctx, &application.ApplicationServerSideDiffQuery{
+					AppName:         &appName,
+					AppNamespace:    &appNs,
+					Project:         &app.Spec.Project,
+					TargetManifests: getServerSideDryRunManifests(ctx, app, proj.Project, argoSettings, diffOption),
+				})
+				errors.CheckError(err)
+				foundDiffs = printServerSideDiff(res.Items)
+			} else {
+				foundDiffs = findandPrintDiff(ctx, app, proj.Project, resources, argoSettings, diffOption, ignoreNormalizerOpts)
+			}
 			if foundDiffs && exitCode {
 				os.Exit(diffExitCode)
 			}
@@ -1370,6 +1478,8 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().StringVar(&revision, "revision", "", "Compare live app to a particular revision")
 	command.Flags().StringVar(&localRepoRoot, "local-repo-root", "/", "Path to the repository root. Used together with --local allows setting the repository root")
 	command.Flags().BoolVar(&serverSideGenerate, "server-side-generate", false, "Used with --local, this will send your manifests to the server for diffing")
+	command.Flags().BoolVar(&includeHooks, "include-hooks", false, "Also print the manifests of the hooks run by a sync, including the names generated for the hooks with a generateName. The hooks don't change the exit code")
+	command.Flags().BoolVar(&serverSideDryRun, "server-side-dry-run", false, "Compare the live state with the result of a server-side apply dry-run of the manifests in the destination cluster, so that defaulting and mutating webhooks are reflected in the diff")
 	command.Flags().StringArrayVar(&localIncludes, "local-include", []string{"*.yaml", "*.yml", "*.json"}, "Used with --server-side-generate, specify patterns of filenames to send. Matching is based on filename and not path.")
 	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only render the difference in namespace")
 	command.Flags().StringArrayVar(&revisions, "revisions", []string{}, "Show manifests at specific revisions for source position in source-positions")
@@ -1389,6 +1499,10 @@ type DifferenceOption struct {
 	serversideRes   *repoapiclient.ManifestResponse
 	revisions       []string
 	sourcePositions []int64
+	includeHooks    bool
+	// hooksRes holds the target manifests of the application when its managed resources are compared, since they
+	// don't include the hooks
+	hooksRes *repoapiclient.ManifestResponse
 }
 
 // findandPrintDiff ... Prints difference between application current state and state stored in git or locally, returns boolean as true if difference is found else returns false
@@ -1397,29 +1511,25 @@ func findandPrintDiff(ctx context.Context, app *argoappv1.Application, proj *arg
 	liveObjs, err := cmdutil.LiveObjects(resources.Items)
 	errors.CheckError(err)
 	items := make([]objKeyLiveTarget, 0)
+	// targetObjs holds the target manifests of the application, including its hooks, if the managed resources are not compared
+	var targetObjs []*unstructured.Unstructured
 	switch {
 	case diffOptions.local != "":
-		localObjs := groupObjsByKey(getLocalObjects(ctx, app, proj, diffOptions.local, diffOptions.localRepoRoot, argoSettings.AppLabelKey, diffOptions.cluster.Info.ServerVersion, diffOptions.cluster.Info.APIVersions, argoSettings.KustomizeOptions, argoSettings.TrackingMethod), liveObjs, app.Spec.Destination.Namespace)
+		targetObjs = getLocalObjects(ctx, app, proj, diffOptions.local, diffOptions.localRepoRoot, argoSettings.AppLabelKey, diffOptions.cluster.Info.ServerVersion, diffOptions.cluster.Info.APIVersions, argoSettings.KustomizeOptions, argoSettings.TrackingMethod)
+		localObjs := groupObjsByKey(targetObjs, liveObjs, app.Spec.Destination.Namespace)
 		items = groupObjsForDiff(resources, localObjs, items, argoSettings, app.InstanceName(argoSettings.ControllerNamespace), app.Spec.Destination.Namespace)
 	case diffOptions.revision != "" || len(diffOptions.revisions) > 0:
-		var unstructureds []*unstructured.Unstructured
-		for _, mfst := range diffOptions.res.Manifests {
-			obj, err := argoappv1.UnmarshalToUnstructured(mfst)
-			errors.CheckError(err)
-			unstructureds = append(unstructureds, obj)
-		}
-		groupedObjs := groupObjsByKey(unstructureds, liveObjs, app.Spec.Destination.Namespace)
+		targetObjs = unmarshalManifests(diffOptions.res.Manifests)
+		groupedObjs := groupObjsByKey(targetObjs, liveObjs, app.Spec.Destination.Namespace)
 		items = groupObjsForDiff(resources, groupedObjs, items, argoSettings, app.InstanceName(argoSettings.ControllerNamespace), app.Spec.Destination.Namespace)
 	case diffOptions.serversideRes != nil:
-		var unstructureds []*unstructured.Unstructured
-		for _, mfst := range diffOptions.serversideRes.Manifests {
-			obj, err := argoappv1.UnmarshalToUnstructured(mfst)
-			errors.CheckError(err)
-			unstructureds = append(unstructureds, obj)
-		}
-		groupedObjs := groupObjsByKey(unstructureds, liveObjs, app.Spec.Destination.Namespace)
+		targetObjs = unmarshalManifests(diffOptions.serversideRes.Manifests)
+		groupedObjs := groupObjsByKey(targetObjs, liveObjs, app.Spec.Destination.Namespace)
 		items = groupObjsForDiff(resources, groupedObjs, items, argoSettings, app.InstanceName(argoSettings.ControllerNamespace), app.Spec.Destination.Namespace)
 	default:
+		if diffOptions.hooksRes != nil {
+			targetObjs = unmarshalManifests(diffOptions.hooksRes.Manifests)
+		}
 		for i := range resources.Items {
 			res := resources.Items[i]
 			live := &unstructured.Unstructured{}
@@ -1443,12 +1553,16 @@ func findandPrintDiff(ctx context.Context, app *argoappv1.Application, proj *arg
 			val := argoSettings.ResourceOverrides[k]
 			overrides[k] = *val
 		}
+		ignoreDifferences := slices.Clone(app.GetIgnoreDifferences())
+		for _, ignoreDifference := range argoSettings.ResourceIgnoreDifferences {
+			ignoreDifferences = append(ignoreDifferences, *ignoreDifference)
+		}
 
 		// TODO remove hardcoded IgnoreAggregatedRoles and retrieve the
 		// compareOptions in the protobuf
 		ignoreAggregatedRoles := false
 		diffConfig, err := argodiff.NewDiffConfigBuilder().
-			WithDiffSettings(app.Spec.IgnoreDifferences, overrides, ignoreAggregatedRoles, ignoreNormalizerOpts).
+			WithDiffSettings(ignoreDifferences, overrides, ignoreAggregatedRoles, ignoreNormalizerOpts).
 			WithTracking(argoSettings.AppLabelKey, argoSettings.TrackingMethod).
 			WithNoCache().
 			WithLogger(logutils.NewLogrusLogger(logutils.NewWithCurrentConfig())).
@@ -1476,6 +1590,99 @@ func findandPrintDiff(ctx context.Context, app *argoappv1.Application, proj *arg
 			_ = cli.PrintDiff(item.key.Name, live, target)
 		}
 	}
+	if diffOptions.includeHooks {
+		revision := diffOptions.revision
+		if revision == "" && diffOptions.local == "" && len(diffOptions.revisions) == 0 {
+			revision = app.Status.Sync.Revision
+		}
+		printHooksPreview(targetObjs, app.Spec.Destination.Namespace, revision)
+	}
+	return foundDiffs
+}
+
+func unmarshalManifests(manifests []string) []*unstructured.Unstructured {
+	var objs []*unstructured.Unstructured
+	for _, mfst := range manifests {
+		obj, err := argoappv1.UnmarshalToUnstructured(mfst)
+		errors.CheckError(err)
+		objs = append(objs, obj)
+	}
+	return objs
+}
+
+// hookPreviewName returns the name of a hook in a sync phase. The hooks with a generateName are named after the
+// revision, the phase and the start time of the sync.
+func hookPreviewName(obj *unstructured.Unstructured, phase common.SyncPhase, revision string) string {
+	if obj.GetName() != "" {
+		return obj.GetName()
+	}
+	if revision == "" {
+		revision = "<revision>"
+	} else if len(revision) >= 8 {
+		revision = revision[0:7]
+	}
+	return strings.ToLower(fmt.Sprintf("%s%s-%s-", obj.GetGenerateName(), revision, phase)) + "<timestamp>"
+}
+
+// printHooksPreview prints the hooks run by a sync of the target manifests, once per sync phase of the hooks. The hooks
+// are created by each sync and are printed as new resources.
+func printHooksPreview(targetObjs []*unstructured.Unstructured, namespace string, revision string) {
+	for _, obj := range targetObjs {
+		if !hook.IsHook(obj) {
+			continue
+		}
+		hookNamespace := obj.GetNamespace()
+		if hookNamespace == "" {
+			hookNamespace = namespace
+		}
+		gvk := obj.GroupVersionKind()
+		for _, hookType := range hook.Types(obj) {
+			if hookType == common.HookTypeSkip {
+				continue
+			}
+			name := hookPreviewName(obj, common.SyncPhase(hookType), revision)
+			fmt.Printf("\n===== %s/%s %s/%s (%s hook) ======\n", gvk.Group, gvk.Kind, hookNamespace, name, hookType)
+			_ = cli.PrintDiff(name, nil, obj)
+		}
+	}
+}
+
+// getServerSideDryRunManifests returns the manifests to compare using a server-side apply dry-run, none if the target
+// state of the application is compared
+func getServerSideDryRunManifests(ctx context.Context, app *argoappv1.Application, proj *argoappv1.AppProject, argoSettings *settings.Settings, diffOptions *DifferenceOption) []string {
+	switch {
+	case diffOptions.local != "":
+		var manifests []string
+		for _, obj := range getLocalObjects(ctx, app, proj, diffOptions.local, diffOptions.localRepoRoot, argoSettings.AppLabelKey, diffOptions.cluster.Info.ServerVersion, diffOptions.cluster.Info.APIVersions, argoSettings.KustomizeOptions, argoSettings.TrackingMethod) {
+			manifest, err := json.Marshal(obj)
+			errors.CheckError(err)
+			manifests = append(manifests, string(manifest))
+		}
+		return manifests
+	case diffOptions.revision != "" || len(diffOptions.revisions) > 0:
+		return diffOptions.res.Manifests
+	case diffOptions.serversideRes != nil:
+		return diffOptions.serversideRes.Manifests
+	}
+	return nil
+}
+
+// printServerSideDiff prints the differences between the normalized and the predicted live state of the resources
+// compared using a server-side apply dry-run, returns true if a difference is found
+func printServerSideDiff(items []*argoappv1.ResourceDiff) bool {
+	var foundDiffs bool
+	for _, item := range items {
+		if !item.Modified {
+			continue
+		}
+		live, err := argoappv1.UnmarshalToUnstructured(item.NormalizedLiveState)
+		errors.CheckError(err)
+		target, err := argoappv1.UnmarshalToUnstructured(item.PredictedLiveState)
+		errors.CheckError(err)
+		fmt.Printf("\n===== %s/%s %s/%s ======\n", item.Group, item.Kind, item.Namespace, item.Name)
+		foundDiffs = true
+		_ = cli.PrintDiff(item.Name, live, target)
+	}
 	return foundDiffs
 }
 
@@ -1838,6 +2045,7 @@ func NewApplicationWaitCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		resources    []string
 		output       string
 		appNamespace string
+		condition    string
 	)
 	command := &cobra.Command{
 		Use:   "wait [APPNAME.. | -l selector]",
@@ -1863,7 +2071,10 @@ func NewApplicationWaitCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
   argocd app wait -l app.kubernetes.io/instance!=my-app
   argocd app wait -l app.kubernetes.io/instance
   argocd app wait -l '!app.kubernetes.io/instance'
-  argocd app wait -l 'app.kubernetes.io/instance notin (my-app,other-app)'`,
+  argocd app wait -l 'app.kubernetes.io/instance notin (my-app,other-app)'
+
+  # Wait for a jq expression evaluated against the application to be true, e.g. for an image to be rolled out
+  argocd app wait my-app --condition '.status.summary.images | any(contains("v1.4.2"))'`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -1871,6 +2082,11 @@ func NewApplicationWaitCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
+			if condition != "" {
+				code, err := parseWaitCondition(condition)
+				errors.CheckError(err)
+				watch.condition = code
+			}
 			watch = getWatchOpts(watch)
 			selectedResources, err := parseSelectedResources(resources)
 			errors.CheckError(err)
@@ -1906,6 +2122,7 @@ func NewApplicationWaitCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().BoolVar(&watch.operation, "operation", false, "Wait for pending operations")
 	command.Flags().UintVar(&timeout, "timeout", defaultCheckTimeoutSeconds, "Time out after this many seconds")
 	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only wait for an application  in namespace")
+	command.Flags().StringVar(&condition, "condition", "", "Wait for a jq expression evaluated against the application to return a value other than false or null. Used alone, it does not wait for sync, health and operations")
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide|tree|tree=detailed")
 	return command
 }
@@ -1945,12 +2162,16 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		sourceNames             []string
 		resources               []string
 		labels                  []string
+		resourceSelector        string
 		selector                string
+		cascadeDeps             bool
 		prune                   bool
 		dryRun                  bool
+		resume                  bool
 		timeout                 uint
 		strategy                string
 		force                   bool
+		forceConflictsFor       []string
 		replace                 bool
 		serverSideApply         bool
 		applyOutOfSyncOnly      bool
@@ -1997,7 +2218,20 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
   argocd app sync my-app --resource apps:Deployment:my-service --resource :Service:my-service
   argocd app sync my-app --resource '!*:Service:*'
   # Specify namespace if the application has resources with the same name in different namespaces
-  argocd app sync my-app --resource argoproj.io:Rollout:my-namespace/my-rollout`,
+  argocd app sync my-app --resource argoproj.io:Rollout:my-namespace/my-rollout
+
+  # Sync only the live or target resources matching a label selector
+  argocd app sync my-app --resource-selector app.kubernetes.io/component=backend
+  argocd app sync my-app --resource-selector 'app.kubernetes.io/component in (backend,database),tier!=canary'
+
+  # Sync an app after the apps it depends on, directly or not, waiting for each app to be healthy before syncing the next
+  argocd app sync my-app --cascade-deps
+
+  # Resume a failed or terminated sync of an app from its last completed wave
+  argocd app sync my-app --resume
+
+  # Sync an app with server-side apply, taking over the fields owned by kubectl edit and failing on the other conflicts
+  argocd app sync my-app --server-side --force-conflicts-for kubectl-edit`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 			if len(args) == 0 && selector == "" && len(projects) == 0 {
@@ -2007,6 +2241,13 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 			if len(args) > 1 && selector != "" {
 				log.Fatal("Cannot use selector option when application name(s) passed as argument(s)")
 			}
+			if resume && local != "" {
+				log.Fatal("Cannot use resume option with local option")
+			}
+
+			if cascadeDeps && async {
+				log.Fatal("Cannot use --cascade-deps with --async, the dependencies of an application must be healthy before it is synced")
+			}
 
 			if len(args) != 1 && (len(revisions) > 0 || len(sourcePositions) > 0) {
 				log.Fatal("Cannot use --revisions and --source-positions options when 0 or more than 1 application names are passed as argument(s)")
@@ -2083,6 +2324,11 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				}
 			}
 
+			if cascadeDeps {
+				appNames, err = getAppNamesWithDependencies(ctx, appIf, appNames, appNamespace)
+				errors.CheckError(err)
+			}
+
 			for _, appQualifiedName := range appNames {
 				// Construct QualifiedName
 				if appNamespace != "" && !strings.Contains(appQualifiedName, "/") {
@@ -2125,6 +2371,19 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					}
 				}
 
+				if resourceSelector != "" {
+					managedResources, err := appIf.ManagedResources(ctx, &application.ResourcesQuery{ApplicationName: &appName, AppNamespace: &appNs})
+					errors.CheckError(err)
+					matchingResources, err := getResourcesMatchingSelector(managedResources, resourceSelector)
+					errors.CheckError(err)
+					// If no resource matches the selector return error only if specific resources were also not specified.
+					if len(matchingResources) == 0 && len(resources) == 0 {
+						log.Fatalf("No matching resources found for resource selector: %s", resourceSelector)
+						return
+					}
+					resources = append(resources, matchingResources...)
+				}
+
 				selectedResources, err := parseSelectedResources(resources)
 				errors.CheckError(err)
 
@@ -2204,17 +2463,19 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				}
 
 				syncReq := application.ApplicationSyncRequest{
-					Name:            &appName,
-					AppNamespace:    &appNs,
-					DryRun:          &dryRun,
-					Revision:        &revision,
-					Resources:       filteredResources,
-					Prune:           &prune,
-					Manifests:       localObjsStrings,
-					Infos:           getInfos(infos),
-					SyncOptions:     syncOptionsFactory(),
-					Revisions:       revisions,
-					SourcePositions: sourcePositions,
+					Name:              &appName,
+					AppNamespace:      &appNs,
+					DryRun:            &dryRun,
+					Revision:          &revision,
+					Resources:         filteredResources,
+					Prune:             &prune,
+					Manifests:         localObjsStrings,
+					Infos:             getInfos(infos),
+					SyncOptions:       syncOptionsFactory(),
+					Revisions:         revisions,
+					SourcePositions:   sourcePositions,
+					Resume:            &resume,
+					ForceConflictsFor: forceConflictsFor,
 				}
 
 				switch strategy {
@@ -2268,7 +2529,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				errors.CheckError(err)
 
 				if !async {
-					app, opState, err := waitOnApplicationStatus(ctx, acdClient, appQualifiedName, timeout, watchOpts{operation: true}, selectedResources, output)
+					app, opState, err := waitOnApplicationStatus(ctx, acdClient, appQualifiedName, timeout, watchOpts{operation: true, health: cascadeDeps}, selectedResources, output)
 					errors.CheckError(err)
 
 					if !dryRun {
@@ -2288,10 +2549,13 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	}
 	command.Flags().BoolVar(&dryRun, "dry-run", false, "Preview apply without affecting cluster")
 	command.Flags().BoolVar(&prune, "prune", false, "Allow deleting unexpected resources")
+	command.Flags().BoolVar(&resume, "resume", false, "Skip the tasks completed by the previous sync, if it failed or was terminated while syncing the same revision")
 	command.Flags().StringVar(&revision, "revision", "", "Sync to a specific revision. Preserves parameter overrides")
 	command.Flags().StringArrayVar(&resources, "resource", []string{}, fmt.Sprintf("Sync only specific resources as GROUP%[1]sKIND%[1]sNAME or %[2]sGROUP%[1]sKIND%[1]sNAME. Fields may be blank and '*' can be used. This option may be specified repeatedly", resourceFieldDelimiter, resourceExcludeIndicator))
 	command.Flags().StringVarP(&selector, "selector", "l", "", "Sync apps that match this label. Supports '=', '==', '!=', in, notin, exists & not exists. Matching apps must satisfy all of the specified label constraints.")
 	command.Flags().StringArrayVar(&labels, "label", []string{}, "Sync only specific resources with a label. This option may be specified repeatedly.")
+	command.Flags().BoolVar(&cascadeDeps, "cascade-deps", false, "Sync the apps the specified apps depend on, directly or not, before these apps. Each app is synced after its dependencies are synced and healthy")
+	command.Flags().StringVar(&resourceSelector, "resource-selector", "", "Sync only the resources whose live or target state matches this label selector. Supports '=', '==', '!=', in, notin, exists & not exists. Matching resources must satisfy all of the specified label constraints.")
 	command.Flags().UintVar(&timeout, "timeout", defaultCheckTimeoutSeconds, "Time out after this many seconds")
 	command.Flags().Int64Var(&retryLimit, "retry-limit", 0, "Max number of allowed sync retries")
 	command.Flags().DurationVar(&retryBackoffDuration, "retry-backoff-duration", argoappv1.DefaultSyncRetryDuration, "Retry backoff base duration. Input needs to be a duration (e.g. 2m, 1h)")
@@ -2299,6 +2563,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().Int64Var(&retryBackoffFactor, "retry-backoff-factor", argoappv1.DefaultSyncRetryFactor, "Factor multiplies the base duration after each failed retry")
 	command.Flags().StringVar(&strategy, "strategy", "", "Sync strategy (one of: apply|hook)")
 	command.Flags().BoolVar(&force, "force", false, "Use a force apply")
+	command.Flags().StringArrayVar(&forceConflictsFor, "force-conflicts-for", []string{}, "Take over the fields of this field manager on server-side apply conflicts, the conflicts with other field managers fail the sync. This option may be specified repeatedly")
 	command.Flags().BoolVar(&replace, "replace", false, "Use a kubectl create/replace instead apply")
 	command.Flags().BoolVar(&serverSideApply, "server-side", false, "Use server-side apply while syncing the application")
 	command.Flags().BoolVar(&applyOutOfSyncOnly, "apply-out-of-sync-only", false, "Sync only out-of-sync resources")
@@ -2318,6 +2583,83 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	return command
 }
 
+// getAppNamesWithDependencies returns the qualified names of the given applications and of the applications they depend
+// on, directly or not, ordered so that every application comes after its dependencies
+func getAppNamesWithDependencies(ctx context.Context, appIf application.ApplicationServiceClient, appNames []string, appNamespace string) ([]string, error) {
+	const (
+		visiting = iota + 1
+		visited
+	)
+	var orderedAppNames []string
+	states := make(map[string]int)
+	var visit func(appQualifiedName string, path []string) error
+	visit = func(appQualifiedName string, path []string) error {
+		appName, appNs := argo.ParseFromQualifiedName(appQualifiedName, appNamespace)
+		app, err := appIf.Get(ctx, &application.ApplicationQuery{Name: &appName, AppNamespace: &appNs})
+		if err != nil {
+			return fmt.Errorf("failed to get application %s: %w", appQualifiedName, err)
+		}
+		appQualifiedName = app.QualifiedName()
+		path = append(slices.Clone(path), appQualifiedName)
+		switch states[appQualifiedName] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("circular dependency between applications: %s", strings.Join(path, " -> "))
+		}
+		states[appQualifiedName] = visiting
+		for _, dependency := range app.Spec.DependsOn {
+			if app.Namespace != "" {
+				dependency = app.Namespace + "/" + dependency
+			}
+			if err := visit(dependency, path); err != nil {
+				return err
+			}
+		}
+		states[appQualifiedName] = visited
+		orderedAppNames = append(orderedAppNames, appQualifiedName)
+		return nil
+	}
+	for _, appQualifiedName := range appNames {
+		if err := visit(appQualifiedName, nil); err != nil {
+			return nil, err
+		}
+	}
+	return orderedAppNames, nil
+}
+
+// getResourcesMatchingSelector returns the managed resources, formatted as GROUP:KIND:NAMESPACE/NAME or GROUP:KIND:NAME,
+// whose live or target state has labels matching the selector
+func getResourcesMatchingSelector(managedResources *application.ManagedResourcesResponse, selector string) ([]string, error) {
+	labelSelector, err := k8slabels.Parse(selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid resource selector %q: %w", selector, err)
+	}
+	var matchingResources []string
+	for _, res := range managedResources.Items {
+		matches := false
+		for _, state := range []string{res.TargetState, res.LiveState} {
+			obj, err := argoappv1.UnmarshalToUnstructured(state)
+			if err != nil {
+				return nil, fmt.Errorf("failed to unmarshal state of resource %s/%s: %w", res.Kind, res.Name, err)
+			}
+			if obj != nil && labelSelector.Matches(k8slabels.Set(obj.GetLabels())) {
+				matches = true
+				break
+			}
+		}
+		if !matches {
+			continue
+		}
+		name := res.Name
+		if res.Namespace != "" {
+			name = res.Namespace + resourceFieldNamespaceDelimiter + res.Name
+		}
+		matchingResources = append(matchingResources, strings.Join([]string{res.Group, res.Kind, name}, resourceFieldDelimiter))
+	}
+	return matchingResources, nil
+}
+
 func getAppNamesBySelector(ctx context.Context, appIf application.ApplicationServiceClient, selector string) ([]string, error) {
 	appNames := []string{}
 	if selector != "" {
@@ -2683,7 +3025,7 @@ func waitOnApplicationStatus(ctx context.Context, acdClient argocdclient.Client,
 			selectedResourcesAreReady = checkResourceStatus(watch, string(app.Status.Health.Status), string(app.Status.Sync.Status), appEvent.Application.Operation, hydrationFinished)
 		}
 
-		if selectedResourcesAreReady && (!operationInProgress || !watch.operation) {
+		if selectedResourcesAreReady && (!operationInProgress || !watch.operation) && checkWaitCondition(watch.condition, app) {
 			app = printFinalStatus(app)
 			return app, finalOperationState, nil
 		}
@@ -2842,11 +3184,63 @@ func NewApplicationHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra
 			}
 		},
 	}
+	command.AddCommand(NewApplicationHistoryExportCommand(clientOpts))
 	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only show application deployment history in namespace")
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: wide|id")
 	return command
 }
 
+// applicationHistoryExport is the audit trail of the deployments of an application exported by `argocd app history export`
+type applicationHistoryExport struct {
+	Application string                      `json:"application"`
+	History     argoappv1.RevisionHistories `json:"history"`
+}
+
+// newApplicationHistoryExport returns the audit trail of the deployments of the given application
+func newApplicationHistoryExport(app *argoappv1.Application) applicationHistoryExport {
+	history := app.Status.History
+	if history == nil {
+		history = argoappv1.RevisionHistories{}
+	}
+	return applicationHistoryExport{Application: app.QualifiedName(), History: history}
+}
+
+// NewApplicationHistoryExportCommand returns a new instance of an `argocd app history export` command
+func NewApplicationHistoryExportCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		output       string
+		appNamespace string
+	)
+	command := &cobra.Command{
+		Use:   "export APPNAME",
+		Short: "Export application deployment history",
+		Example: `  # Export the deployment history of an application as JSON
+  argocd app history export my-app -o json > my-app-history.json`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			app, err := appIf.Get(ctx, &application.ApplicationQuery{
+				Name:         &appName,
+				AppNamespace: &appNs,
+			})
+			errors.CheckError(err)
+
+			err = PrintResource(newApplicationHistoryExport(app), output)
+			errors.CheckError(err)
+		},
+	}
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only export application deployment history in namespace")
+	command.Flags().StringVarP(&output, "output", "o", "json", "Output format. One of: json|yaml")
+	return command
+}
+
 func findRevisionHistory(application *argoappv1.Application, historyId int64) (*argoappv1.RevisionHistory, error) {
 	// in case if history id not passed and need fetch previous history revision
 	if historyId == -1 {
@@ -2871,16 +3265,33 @@ func NewApplicationRollbackCommand(clientOpts *argocdclient.ClientOptions) *cobr
 		timeout      uint
 		output       string
 		appNamespace string
+		revision     string
+		confirm      bool
 	)
 	command := &cobra.Command{
 		Use:   "rollback APPNAME [ID]",
 		Short: "Rollback application to a previous deployed version by History ID, omitted will Rollback to the previous version",
+		Long:  "Rollback application to a previous deployed version by History ID, omitted will Rollback to the previous version. With --revision, the application is rolled back to a Git revision or chart version: the differences with the live state are previewed and the rollback is only executed with --confirm.",
+		Example: `  # Rollback application to the previous deployed version
+  argocd app rollback my-app
+
+  # Rollback application to the deployed version with History ID 3
+  argocd app rollback my-app 3
+
+  # Preview the rollback of the application to a Git revision
+  argocd app rollback my-app --revision v1.2.0
+
+  # Rollback application to a Git revision
+  argocd app rollback my-app --revision v1.2.0 --confirm`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 			if len(args) == 0 {
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
+			if revision != "" && len(args) > 1 {
+				errors.Fatal(errors.ErrorGeneric, "Only one of ID and --revision can be specified.")
+			}
 			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
 			var err error
 			depID := -1
@@ -2897,15 +3308,30 @@ func NewApplicationRollbackCommand(clientOpts *argocdclient.ClientOptions) *cobr
 			})
 			errors.CheckError(err)
 
-			depInfo, err := findRevisionHistory(app, int64(depID))
-			errors.CheckError(err)
-
-			_, err = appIf.Rollback(ctx, &application.ApplicationRollbackRequest{
+			req := &application.ApplicationRollbackRequest{
 				Name:         &appName,
 				AppNamespace: &appNs,
-				Id:           ptr.To(depInfo.ID),
 				Prune:        ptr.To(prune),
-			})
+			}
+			if revision != "" {
+				foundDiffs := previewRollbackToRevision(ctx, c, clientOpts, acdClient, appIf, app, revision)
+				if !foundDiffs {
+					fmt.Printf("No differences found with revision '%s'\n", revision)
+				}
+				if !confirm {
+					fmt.Printf("Rollback of application '%s' to revision '%s' was not executed, re-run with --confirm to execute it\n", app.QualifiedName(), revision)
+					return
+				}
+				// the ID is required by the API but ignored with a revision
+				req.Id = ptr.To(int64(0))
+				req.Revision = &revision
+			} else {
+				depInfo, err := findRevisionHistory(app, int64(depID))
+				errors.CheckError(err)
+				req.Id = ptr.To(depInfo.ID)
+			}
+
+			_, err = appIf.Rollback(ctx, req)
 			errors.CheckError(err)
 
 			_, _, err = waitOnApplicationStatus(ctx, acdClient, app.QualifiedName(), timeout, watchOpts{
@@ -2918,9 +3344,34 @@ func NewApplicationRollbackCommand(clientOpts *argocdclient.ClientOptions) *cobr
 	command.Flags().UintVar(&timeout, "timeout", defaultCheckTimeoutSeconds, "Time out after this many seconds")
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide|tree|tree=detailed")
 	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Rollback application in namespace")
+	command.Flags().StringVar(&revision, "revision", "", "Rollback application to a revision (Git) or chart version (Helm) instead of a History ID, the differences are previewed and --confirm is required to execute the rollback")
+	command.Flags().BoolVar(&confirm, "confirm", false, "Execute the rollback to the revision given with --revision after previewing the differences")
 	return command
 }
 
+// previewRollbackToRevision prints the differences between the live state of the application and the manifests of the
+// revision, returns true if a difference is found
+func previewRollbackToRevision(ctx context.Context, c *cobra.Command, clientOpts *argocdclient.ClientOptions, acdClient argocdclient.Client, appIf application.ApplicationServiceClient, app *argoappv1.Application, revision string) bool {
+	if app.Spec.HasMultipleSources() {
+		errors.Fatal(errors.ErrorGeneric, "Rollback to a revision is not supported for applications with multiple sources, rollback to a History ID instead.")
+	}
+	appName, appNs := app.Name, app.Namespace
+	res, err := appIf.GetManifests(ctx, &application.ApplicationManifestQuery{
+		Name:         &appName,
+		Revision:     &revision,
+		AppNamespace: &appNs,
+	})
+	errors.CheckError(err)
+	resources, err := appIf.ManagedResources(ctx, &application.ResourcesQuery{ApplicationName: &appName, AppNamespace: &appNs})
+	errors.CheckError(err)
+	conn, settingsIf := acdClient.NewSettingsClientOrDie()
+	defer argoio.Close(conn)
+	argoSettings, err := settingsIf.Get(ctx, &settings.SettingsQuery{})
+	errors.CheckError(err)
+	proj := getProject(ctx, c, clientOpts, app.Spec.Project)
+	return findandPrintDiff(ctx, app, proj.Project, resources, argoSettings, &DifferenceOption{revision: revision, res: res}, normalizers.IgnoreNormalizerOpts{JQExecutionTimeout: normalizers.DefaultJQExecutionTimeout})
+}
+
 const (
 	printOpFmtStr              = "%-20s%s\n"
 	defaultCheckTimeoutSeconds = 0
@@ -2951,6 +3402,30 @@ func printOperationResult(opState *argoappv1.OperationState) {
 	if opState.Message != "" {
 		fmt.Printf(printOpFmtStr, "Message:", opState.Message)
 	}
+	if opState.SyncResult != nil {
+		printServerSideApplyConflicts(opState.SyncResult.Resources)
+	}
+}
+
+// printServerSideApplyConflicts prints the field ownership conflicts hit by the server-side apply of the resources
+func printServerSideApplyConflicts(resources argoappv1.ResourceResults) {
+	var conflicts []string
+	for _, res := range resources {
+		for _, conflict := range res.Conflicts {
+			resolution := "not forced"
+			if conflict.Forced {
+				resolution = "forced"
+			}
+			conflicts = append(conflicts, fmt.Sprintf("%s/%s: %s owns %s (%s)", res.Kind, res.Name, conflict.Manager, strings.Join(conflict.Fields, ", "), resolution))
+		}
+	}
+	for i, conflict := range conflicts {
+		label := ""
+		if i == 0 {
+			label = "Conflicts:"
+		}
+		fmt.Printf(printOpFmtStr, label, conflict)
+	}
 }
 
 // NewApplicationManifestsCommand returns a new instance of an `argocd app manifests` command
@@ -2963,6 +3438,8 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 		sourceNames     []string
 		local           string
 		localRepoRoot   string
+		filter          argo.ManifestFilter
+		fields          []string
 	)
 	command := &cobra.Command{
 		Use:   "manifests APPNAME",
@@ -2979,6 +3456,12 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 
   # Get manifests for a multi-source application at specific revisions for specific sources
   argocd app manifests my-app --revisions 0.0.1 --source-positions 1 --revisions 0.0.2 --source-positions 2
+
+  # Get the manifest of a specific resource
+  argocd app manifests my-app --kind Deployment --name my-deployment
+
+  # Get only some fields of the manifests of the resources of a kind
+  argocd app manifests my-app --group apps --kind Deployment --fields metadata.name,spec.replicas
   		`),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -3060,6 +3543,11 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 						Revision:        ptr.To(revision),
 						Revisions:       revisions,
 						SourcePositions: sourcePositions,
+						Group:           ptr.To(filter.Group),
+						Kind:            ptr.To(filter.Kind),
+						Namespace:       ptr.To(filter.Namespace),
+						ResourceName:    ptr.To(filter.Name),
+						Fields:          fields,
 					}
 					res, err := appIf.GetManifests(ctx, &q)
 					errors.CheckError(err)
@@ -3074,6 +3562,11 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 						Name:         &appName,
 						AppNamespace: &appNs,
 						Revision:     ptr.To(revision),
+						Group:        ptr.To(filter.Group),
+						Kind:         ptr.To(filter.Kind),
+						Namespace:    ptr.To(filter.Namespace),
+						ResourceName: ptr.To(filter.Name),
+						Fields:       fields,
 					}
 					res, err := appIf.GetManifests(ctx, &q)
 					errors.CheckError(err)
@@ -3097,6 +3590,12 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 			}
 
 			for _, obj := range unstructureds {
+				if obj != nil {
+					if !filter.Matches(obj) {
+						continue
+					}
+					obj = argo.ProjectManifestFields(obj, fields)
+				}
 				fmt.Println("---")
 				yamlBytes, err := yaml.Marshal(obj)
 				errors.CheckError(err)
@@ -3111,6 +3610,11 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 	command.Flags().StringArrayVar(&sourceNames, "source-names", []string{}, "List of source names. Default is an empty array.")
 	command.Flags().StringVar(&local, "local", "", "If set, show locally-generated manifests. Value is the absolute path to app manifests within the manifest repo. Example: '/home/username/apps/env/app-1'.")
 	command.Flags().StringVar(&localRepoRoot, "local-repo-root", ".", "Path to the local repository root. Used together with --local allows setting the repository root. Example: '/home/username/apps'.")
+	command.Flags().StringVar(&filter.Group, "group", "", "Only show the manifests of the resources of this group")
+	command.Flags().StringVar(&filter.Kind, "kind", "", "Only show the manifests of the resources of this kind")
+	command.Flags().StringVar(&filter.Namespace, "namespace", "", "Only show the manifests of the resources in this namespace")
+	command.Flags().StringVar(&filter.Name, "name", "", "Only show the manifests of the resources with this name")
+	command.Flags().StringSliceVar(&fields, "fields", []string{}, "Only show these fields of the manifests, as dot-separated paths, in addition to apiVersion and kind. Example: 'metadata.name,spec.replicas'")
 	return command
 }
 
@@ -3140,6 +3644,106 @@ func NewApplicationTerminateOpCommand(clientOpts *argocdclient.ClientOptions) *c
 	return command
 }
 
+// NewApplicationApproveCommand returns a new instance of an `argocd app approve` command
+func NewApplicationApproveCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		appNamespace string
+		revision     string
+	)
+	command := &cobra.Command{
+		Use:   "approve APPNAME",
+		Short: "Approve the automated sync of an application requiring a diff approval to a revision",
+		Example: `  # Approve the automated sync of an application to a revision
+  argocd app approve my-app --revision 8d4a1f3c0e2b7a9d6c5f4e3b2a1908f7e6d5c4b3
+
+  # Approve the automated sync of a multi-source application to the revisions of its sources
+  argocd app approve my-app --revision 8d4a1f3c0e2b7a9d6c5f4e3b2a1908f7e6d5c4b3,0.1.0`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 || revision == "" {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+			_, err := appIf.ApproveSync(ctx, &application.ApplicationApproveSyncRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				Revision:     &revision,
+			})
+			errors.CheckError(err)
+			fmt.Printf("Application '%s' sync to revision %s approved\n", appName, revision)
+		},
+	}
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only approve the sync of an application in namespace")
+	command.Flags().StringVar(&revision, "revision", "", "Revision, or comma-separated revisions of a multi-source application, approved for sync")
+	return command
+}
+
+// NewApplicationDriftHistoryCommand returns a new instance of an `argocd app drift-history` command
+func NewApplicationDriftHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		output       string
+		appNamespace string
+	)
+	command := &cobra.Command{
+		Use:   "drift-history APPNAME",
+		Short: "Show the out-of-band changes of the application resources reverted by the self-heal",
+		Example: `  # List the out-of-band changes reverted by the self-heal
+  argocd app drift-history my-app
+
+  # Print the diffs of the reverted changes
+  argocd app drift-history my-app -o yaml`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			res, err := appIf.DriftHistory(ctx, &application.ApplicationDriftHistoryQuery{
+				Name:         &appName,
+				AppNamespace: &appNs,
+			})
+			errors.CheckError(err)
+
+			switch output {
+			case "json", "yaml":
+				err = PrintResourceList(res.Items, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				printDriftHistoryTable(res.Items)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only show the drift history of an application in namespace")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: wide|json|yaml")
+	return command
+}
+
+// printDriftHistoryTable prints a drifted resource per line, with the field managers which changed it
+func printDriftHistoryTable(events []*argoappv1.DriftEvent) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "REVERTED AT\tREVISION\tGROUP\tKIND\tNAMESPACE\tNAME\tMANAGERS\n")
+	for _, event := range events {
+		for _, res := range event.Resources {
+			managers := strings.Join(res.Managers, ",")
+			if managers == "" {
+				managers = "<unknown>"
+			}
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", event.RevertedAt.Format(time.RFC3339), event.Revision, res.Group, res.Kind, res.Namespace, res.Name, managers)
+		}
+	}
+	_ = w.Flush()
+}
+
 func NewApplicationEditCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var appNamespace string
 	command := &cobra.Command{
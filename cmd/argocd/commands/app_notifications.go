@@ -0,0 +1,295 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	notificationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/notification"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	"github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/templates"
+)
+
+var appNotificationsExample = templates.Examples(`
+	# List the notification subscriptions of an application
+	argocd app notifications list APPNAME
+
+	# Subscribe a Slack channel to the on-sync-failed notifications of an application
+	argocd app notifications subscribe APPNAME --trigger on-sync-failed --service slack --recipient my-channel
+
+	# Unsubscribe a Slack channel from the on-sync-failed notifications of an application
+	argocd app notifications unsubscribe APPNAME --trigger on-sync-failed --service slack --recipient my-channel
+
+	# Send a test notification of an application to a Slack channel
+	argocd app notifications test APPNAME --template app-sync-failed --service slack --recipient my-channel
+
+	# List the status of the delivery of the latest notifications of an application
+	argocd app notifications deliveries APPNAME
+	`)
+
+// NewApplicationNotificationsCommand returns a new instance of an `argocd app notifications` command
+func NewApplicationNotificationsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:     "notifications",
+		Short:   "Manage the notification subscriptions of an application",
+		Example: appNotificationsExample,
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+	}
+	command.AddCommand(NewApplicationNotificationsListCommand(clientOpts))
+	command.AddCommand(NewApplicationNotificationsSubscribeCommand(clientOpts))
+	command.AddCommand(NewApplicationNotificationsUnsubscribeCommand(clientOpts))
+	command.AddCommand(NewApplicationNotificationsTestCommand(clientOpts))
+	command.AddCommand(NewApplicationNotificationsDeliveriesCommand(clientOpts))
+	return command
+}
+
+// NewApplicationNotificationsListCommand returns a new instance of an `argocd app notifications list` command
+func NewApplicationNotificationsListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:   "list APPNAME",
+		Short: "List the effective notification subscriptions of an application",
+		Long:  "List the notification subscriptions of an application, including the ones inherited from its project and from the default subscriptions",
+		Example: templates.Examples(`
+	# List the notification subscriptions of an application
+	argocd app notifications list APPNAME
+	`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+			conn, notifIf := headless.NewClientOrDie(clientOpts, c).NewNotificationClientOrDie()
+			defer io.Close(conn)
+			subscriptions, err := notifIf.ListApplicationSubscriptions(ctx, &notificationpkg.ApplicationSubscriptionsRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+			})
+			errors.CheckError(err)
+			printSubscriptions(subscriptions, output)
+		},
+	}
+	command.Flags().StringVarP(&output, "out", "o", "", "Output format. One of: yaml, json")
+	return command
+}
+
+// NewApplicationNotificationsSubscribeCommand returns a new instance of an `argocd app notifications subscribe` command
+func NewApplicationNotificationsSubscribeCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		trigger    string
+		service    string
+		recipients []string
+	)
+	command := &cobra.Command{
+		Use:   "subscribe APPNAME",
+		Short: "Subscribe recipients to the notifications of an application",
+		Example: templates.Examples(`
+	# Subscribe two Slack channels to the on-sync-failed notifications of an application
+	argocd app notifications subscribe APPNAME --trigger on-sync-failed --service slack --recipient my-channel --recipient other-channel
+	`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 || trigger == "" || service == "" || len(recipients) == 0 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+			conn, notifIf := headless.NewClientOrDie(clientOpts, c).NewNotificationClientOrDie()
+			defer io.Close(conn)
+			subscriptions, err := notifIf.SubscribeApplication(ctx, &notificationpkg.ApplicationSubscriptionRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				Trigger:      &trigger,
+				Service:      &service,
+				Recipients:   recipients,
+			})
+			errors.CheckError(err)
+			printSubscriptions(subscriptions, "")
+		},
+	}
+	command.Flags().StringVar(&trigger, "trigger", "", "Name of the trigger")
+	command.Flags().StringVar(&service, "service", "", "Name of the notification service")
+	command.Flags().StringArrayVar(&recipients, "recipient", []string{}, "Recipient of the notifications. This flag can be repeated")
+	return command
+}
+
+// NewApplicationNotificationsUnsubscribeCommand returns a new instance of an `argocd app notifications unsubscribe` command
+func NewApplicationNotificationsUnsubscribeCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		trigger    string
+		service    string
+		recipients []string
+	)
+	command := &cobra.Command{
+		Use:   "unsubscribe APPNAME",
+		Short: "Unsubscribe recipients from the notifications of an application",
+		Example: templates.Examples(`
+	# Unsubscribe a Slack channel from the on-sync-failed notifications of an application
+	argocd app notifications unsubscribe APPNAME --trigger on-sync-failed --service slack --recipient my-channel
+
+	# Unsubscribe all the Slack channels from the on-sync-failed notifications of an application
+	argocd app notifications unsubscribe APPNAME --trigger on-sync-failed --service slack
+	`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 || trigger == "" || service == "" {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+			conn, notifIf := headless.NewClientOrDie(clientOpts, c).NewNotificationClientOrDie()
+			defer io.Close(conn)
+			subscriptions, err := notifIf.UnsubscribeApplication(ctx, &notificationpkg.ApplicationSubscriptionRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				Trigger:      &trigger,
+				Service:      &service,
+				Recipients:   recipients,
+			})
+			errors.CheckError(err)
+			printSubscriptions(subscriptions, "")
+		},
+	}
+	command.Flags().StringVar(&trigger, "trigger", "", "Name of the trigger")
+	command.Flags().StringVar(&service, "service", "", "Name of the notification service")
+	command.Flags().StringArrayVar(&recipients, "recipient", []string{}, "Recipient to unsubscribe, all the recipients are unsubscribed if not set. This flag can be repeated")
+	return command
+}
+
+// NewApplicationNotificationsTestCommand returns a new instance of an `argocd app notifications test` command
+func NewApplicationNotificationsTestCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		template   string
+		service    string
+		recipients []string
+	)
+	command := &cobra.Command{
+		Use:   "test APPNAME",
+		Short: "Send a test notification of an application",
+		Long:  "Send the notification of an application rendered with the given template to the recipients, regardless of the triggers and subscriptions of the application",
+		Example: templates.Examples(`
+	# Send a test notification of an application to a Slack channel
+	argocd app notifications test APPNAME --template app-sync-failed --service slack --recipient my-channel
+	`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 || template == "" || service == "" || len(recipients) == 0 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+			conn, notifIf := headless.NewClientOrDie(clientOpts, c).NewNotificationClientOrDie()
+			defer io.Close(conn)
+			_, err := notifIf.TestApplicationNotification(ctx, &notificationpkg.ApplicationNotificationTestRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				Template:     &template,
+				Service:      &service,
+				Recipients:   recipients,
+			})
+			errors.CheckError(err)
+			fmt.Printf("Notification of application '%s' sent to %d recipient(s)\n", appName, len(recipients))
+		},
+	}
+	command.Flags().StringVar(&template, "template", "", "Name of the template")
+	command.Flags().StringVar(&service, "service", "", "Name of the notification service")
+	command.Flags().StringArrayVar(&recipients, "recipient", []string{}, "Recipient of the notification. This flag can be repeated")
+	return command
+}
+
+// NewApplicationNotificationsDeliveriesCommand returns a new instance of an `argocd app notifications deliveries` command
+func NewApplicationNotificationsDeliveriesCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:   "deliveries APPNAME",
+		Short: "List the status of the delivery of the latest notifications of an application",
+		Long:  "List the status of the delivery of the latest notifications of an application. The deliveries are recorded by the notifications controller when the delivery settings are configured.",
+		Example: templates.Examples(`
+	# List the status of the delivery of the latest notifications of an application
+	argocd app notifications deliveries APPNAME
+	`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+			conn, notifIf := headless.NewClientOrDie(clientOpts, c).NewNotificationClientOrDie()
+			defer io.Close(conn)
+			deliveries, err := notifIf.ListApplicationDeliveries(ctx, &notificationpkg.ApplicationDeliveriesRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+			})
+			errors.CheckError(err)
+			switch output {
+			case "yaml":
+				yamlBytes, err := yaml.Marshal(deliveries.Items)
+				errors.CheckError(err)
+				fmt.Println(string(yamlBytes))
+			case "json":
+				jsonBytes, err := json.MarshalIndent(deliveries.Items, "", "  ")
+				errors.CheckError(err)
+				fmt.Println(string(jsonBytes))
+			case "":
+				w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+				fmt.Fprintf(w, "TIME\tTEMPLATES\tSERVICE\tRECIPIENT\tSTATUS\tATTEMPTS\tHTTP STATUS\tMESSAGE\n")
+				for _, d := range deliveries.Items {
+					httpStatus := ""
+					if d.GetHttpStatus() != 0 {
+						httpStatus = strconv.Itoa(int(d.GetHttpStatus()))
+					}
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\t%s\t%s\n", time.Unix(d.GetTime(), 0).Format(time.RFC3339), strings.Join(d.Templates, ","), d.GetService(), d.GetRecipient(), d.GetStatus(), d.GetAttempts(), httpStatus, d.GetMessage())
+				}
+				_ = w.Flush()
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "out", "o", "", "Output format. One of: yaml, json")
+	return command
+}
+
+func printSubscriptions(subscriptions *notificationpkg.SubscriptionList, output string) {
+	switch output {
+	case "yaml":
+		yamlBytes, err := yaml.Marshal(subscriptions.Items)
+		errors.CheckError(err)
+		fmt.Println(string(yamlBytes))
+	case "json":
+		jsonBytes, err := json.MarshalIndent(subscriptions.Items, "", "  ")
+		errors.CheckError(err)
+		fmt.Println(string(jsonBytes))
+	case "":
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(w, "TRIGGER\tSERVICE\tRECIPIENT\tSOURCE\n")
+		for _, subscription := range subscriptions.Items {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", subscription.GetTrigger(), subscription.GetService(), subscription.GetRecipient(), subscription.GetSource())
+		}
+		_ = w.Flush()
+	default:
+		errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+	}
+}
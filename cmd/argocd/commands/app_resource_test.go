@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"testing"
 	"text/tabwriter"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/settings"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
@@ -117,3 +120,82 @@ func TestPrintResourcesTree(t *testing.T) {
 
 	assert.Equal(t, expectation, output)
 }
+
+func TestFindOrphanedResource(t *testing.T) {
+	app := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"},
+		Spec:       v1alpha1.ApplicationSpec{Destination: v1alpha1.ApplicationDestination{Namespace: "default"}},
+	}
+	tree := &v1alpha1.ApplicationTree{
+		Nodes: []v1alpha1.ResourceNode{{ResourceRef: v1alpha1.ResourceRef{Kind: "ConfigMap", Namespace: "default", Name: "managed"}}},
+		OrphanedNodes: []v1alpha1.ResourceNode{
+			{ResourceRef: v1alpha1.ResourceRef{Kind: "ConfigMap", Version: "v1", Namespace: "default", Name: "orphan"}},
+			{ResourceRef: v1alpha1.ResourceRef{Group: "apps", Kind: "Deployment", Version: "v1", Namespace: "other", Name: "orphan"}},
+		},
+	}
+
+	node, err := findOrphanedResource(app, tree, ":ConfigMap:orphan")
+	require.NoError(t, err)
+	assert.Equal(t, tree.OrphanedNodes[0], *node)
+
+	node, err = findOrphanedResource(app, tree, "apps:Deployment:other/orphan")
+	require.NoError(t, err)
+	assert.Equal(t, tree.OrphanedNodes[1], *node)
+
+	_, err = findOrphanedResource(app, tree, ":ConfigMap:managed")
+	require.ErrorContains(t, err, "resource :ConfigMap:managed is not an orphaned resource of application 'argocd/guestbook'")
+	_, err = findOrphanedResource(app, tree, "ConfigMap")
+	require.Error(t, err)
+}
+
+func TestGetAdoptionPatch(t *testing.T) {
+	app := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"},
+		Spec:       v1alpha1.ApplicationSpec{Destination: v1alpha1.ApplicationDestination{Namespace: "default"}},
+	}
+	node := &v1alpha1.ResourceNode{ResourceRef: v1alpha1.ResourceRef{Group: "apps", Kind: "Deployment", Version: "v1", Namespace: "default", Name: "orphan"}}
+
+	patch, err := getAdoptionPatch(app, node, &settings.Settings{AppLabelKey: "app.kubernetes.io/instance", ControllerNamespace: "argocd"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"metadata":{"annotations":{"argocd.argoproj.io/tracking-id":"guestbook:apps/Deployment:default/orphan"}}}`, patch)
+
+	patch, err = getAdoptionPatch(app, node, &settings.Settings{AppLabelKey: "app.kubernetes.io/instance", ControllerNamespace: "argocd", TrackingMethod: "label"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"metadata":{"labels":{"app.kubernetes.io/instance":"guestbook"}}}`, patch)
+
+	patch, err = getAdoptionPatch(app, node, &settings.Settings{AppLabelKey: "app.kubernetes.io/instance", ControllerNamespace: "argocd", InstallationID: "my-argocd"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"metadata":{"annotations":{"argocd.argoproj.io/installation-id":"my-argocd","argocd.argoproj.io/tracking-id":"guestbook:apps/Deployment:default/orphan"}}}`, patch)
+}
+
+func TestPrintPendingDeletions(t *testing.T) {
+	app := &v1alpha1.Application{Status: v1alpha1.ApplicationStatus{Resources: []v1alpha1.ResourceStatus{
+		{Kind: "ConfigMap", Namespace: "default", Name: "synced"},
+		{
+			Group:           "example.com",
+			Kind:            "Database",
+			Namespace:       "default",
+			Name:            "orders",
+			PendingDeletion: &v1alpha1.PendingDeletion{DeletionRequestedAt: metav1.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Finalizers: []string{"example.com/backup", "example.com/cleanup"}},
+		},
+	}}}
+	pending := getPendingDeletions(app)
+	require.Len(t, pending, 1)
+
+	output, err := captureOutput(func() error {
+		printPendingDeletions(pending, time.Date(2024, 1, 1, 0, 5, 30, 0, time.UTC))
+		return nil
+	})
+	require.NoError(t, err)
+	expectation := `GROUP        KIND      NAMESPACE  NAME    PENDING FOR  FINALIZERS
+example.com  Database  default    orders  5m30s        example.com/backup,example.com/cleanup
+`
+	assert.Equal(t, expectation, output)
+
+	output, err = captureOutput(func() error {
+		printPendingDeletions(nil, time.Now())
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "No resources pending deletion\n", output)
+}
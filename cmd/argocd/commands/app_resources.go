@@ -1,9 +1,12 @@
 package commands
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/utils"
 	"github.com/argoproj/argo-cd/v3/cmd/util"
@@ -11,12 +14,15 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
 	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
 	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/settings"
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/errors"
 	argoio "github.com/argoproj/argo-cd/v3/util/io"
@@ -163,6 +169,236 @@ func NewApplicationDeleteResourceCommand(clientOpts *argocdclient.ClientOptions)
 	return command
 }
 
+// NewApplicationAdoptCommand returns a new instance of an `argocd app adopt` command
+func NewApplicationAdoptCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var resources []string
+	var project string
+	command := &cobra.Command{
+		Use:   "adopt APPNAME --resource GROUP:KIND:NAME",
+		Short: "Adopt orphaned resources into an application",
+		Long:  "Adopt orphaned resources into an application by setting the tracking label or annotation of the application on the live resources, without deleting or syncing them. The adopted resources should be added to the sources of the application, otherwise they require pruning and are deleted by syncs with pruning enabled.",
+		Example: `  # Adopt an orphaned ConfigMap of the destination namespace of the application
+  argocd app adopt my-app --resource :ConfigMap:my-config
+
+  # Adopt several orphaned resources
+  argocd app adopt my-app --resource apps:Deployment:my-namespace/my-deployment --resource :Service:my-namespace/my-service`,
+	}
+	command.Flags().StringArrayVar(&resources, "resource", []string{}, "Orphaned resource to adopt as GROUP:KIND:NAME or GROUP:KIND:NAMESPACE/NAME. The namespace defaults to the destination namespace of the application. This option may be specified repeatedly")
+	err := command.MarkFlagRequired("resource")
+	errors.CheckError(err)
+	command.Flags().StringVar(&project, "project", "", `The name of the application's project - specifying this allows the command to report "not found" instead of "permission denied" if the app does not exist`)
+	command.Run = func(c *cobra.Command, args []string) {
+		ctx := c.Context()
+
+		if len(args) != 1 {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		}
+		appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+
+		acdClient := headless.NewClientOrDie(clientOpts, c)
+		conn, appIf := acdClient.NewApplicationClientOrDie()
+		defer argoio.Close(conn)
+		settingsConn, settingsIf := acdClient.NewSettingsClientOrDie()
+		defer argoio.Close(settingsConn)
+		argoSettings, err := settingsIf.Get(ctx, &settings.SettingsQuery{})
+		errors.CheckError(err)
+		app, err := appIf.Get(ctx, &applicationpkg.ApplicationQuery{Name: &appName, AppNamespace: &appNs})
+		errors.CheckError(err)
+		tree, err := appIf.ResourceTree(ctx, &applicationpkg.ResourcesQuery{ApplicationName: &appName, AppNamespace: &appNs, Project: &project})
+		errors.CheckError(err)
+
+		for _, resource := range resources {
+			node, err := findOrphanedResource(app, tree, resource)
+			errors.CheckError(err)
+			patch, err := getAdoptionPatch(app, node, argoSettings)
+			errors.CheckError(err)
+			_, err = appIf.PatchResource(ctx, &applicationpkg.ApplicationResourcePatchRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				Namespace:    ptr.To(node.Namespace),
+				ResourceName: ptr.To(node.Name),
+				Version:      ptr.To(node.Version),
+				Group:        ptr.To(node.Group),
+				Kind:         ptr.To(node.Kind),
+				Patch:        ptr.To(patch),
+				PatchType:    ptr.To(string(types.MergePatchType)),
+				Project:      ptr.To(project),
+			})
+			errors.CheckError(err)
+			log.Infof("Resource '%s' adopted by application '%s'", node.Name, app.QualifiedName())
+		}
+	}
+	return command
+}
+
+// NewApplicationPruneStatusCommand returns a new instance of an `argocd app prune-status` command
+func NewApplicationPruneStatusCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var output string
+	var appNamespace string
+	command := &cobra.Command{
+		Use:   "prune-status APPNAME",
+		Short: "List the resources of an application pending deletion and the finalizers blocking them",
+		Example: `  # List the resources pending deletion
+  argocd app prune-status my-app`,
+	}
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only show the resources pending deletion of an application in namespace")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: wide|json|yaml")
+	command.Run = func(c *cobra.Command, args []string) {
+		ctx := c.Context()
+
+		if len(args) != 1 {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		}
+		appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+
+		conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+		defer argoio.Close(conn)
+		app, err := appIf.Get(ctx, &applicationpkg.ApplicationQuery{Name: &appName, AppNamespace: &appNs})
+		errors.CheckError(err)
+
+		pending := getPendingDeletions(app)
+		switch output {
+		case "json", "yaml":
+			err = PrintResourceList(pending, output, false)
+			errors.CheckError(err)
+		case "wide", "":
+			printPendingDeletions(pending, time.Now())
+		default:
+			errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+		}
+	}
+	return command
+}
+
+// getPendingDeletions returns the resources of the application whose deletion is pending
+func getPendingDeletions(app *v1alpha1.Application) []v1alpha1.ResourceStatus {
+	pending := []v1alpha1.ResourceStatus{}
+	for _, res := range app.Status.Resources {
+		if res.PendingDeletion != nil {
+			pending = append(pending, res)
+		}
+	}
+	return pending
+}
+
+func printPendingDeletions(pending []v1alpha1.ResourceStatus, now time.Time) {
+	if len(pending) == 0 {
+		fmt.Println("No resources pending deletion")
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "GROUP\tKIND\tNAMESPACE\tNAME\tPENDING FOR\tFINALIZERS\n")
+	for _, res := range pending {
+		elapsed := now.Sub(res.PendingDeletion.DeletionRequestedAt.Time).Truncate(time.Second)
+		finalizers := strings.Join(res.PendingDeletion.Finalizers, ",")
+		if finalizers == "" {
+			finalizers = "<none>"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", res.Group, res.Kind, res.Namespace, res.Name, elapsed, finalizers)
+	}
+	_ = w.Flush()
+}
+
+// NewApplicationRemoveFinalizersCommand returns a new instance of an `argocd app remove-finalizers` command
+func NewApplicationRemoveFinalizersCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var resourceName string
+	var namespace string
+	var kind string
+	var group string
+	var project string
+	command := &cobra.Command{
+		Use:   "remove-finalizers APPNAME",
+		Short: "Remove the finalizers of an application resource pending deletion",
+		Long:  "Remove the finalizers of an application resource pending deletion, which deletes the resource without the cleanup its finalizers guard. Requires the remove-finalizers RBAC action on the application.",
+		Example: `  # Remove the finalizers of a resource pending deletion
+  argocd app remove-finalizers my-app --kind MyKind --resource-name my-resource --namespace my-namespace`,
+	}
+	command.Flags().StringVar(&resourceName, "resource-name", "", "Name of resource")
+	command.Flags().StringVar(&kind, "kind", "", "Kind")
+	err := command.MarkFlagRequired("kind")
+	errors.CheckError(err)
+	err = command.MarkFlagRequired("resource-name")
+	errors.CheckError(err)
+	command.Flags().StringVar(&group, "group", "", "Group")
+	command.Flags().StringVar(&namespace, "namespace", "", "Namespace")
+	command.Flags().StringVar(&project, "project", "", `The name of the application's project - specifying this allows the command to report "not found" instead of "permission denied" if the app does not exist`)
+	command.Run = func(c *cobra.Command, args []string) {
+		ctx := c.Context()
+
+		if len(args) != 1 {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		}
+		appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+
+		promptUtil := utils.NewPrompt(clientOpts.PromptsEnabled)
+		if !promptUtil.Confirm(fmt.Sprintf("Are you sure you want to remove the finalizers of %s/%s %s/%s ? [y/n]", group, kind, namespace, resourceName)) {
+			fmt.Printf("The command to remove the finalizers of %s/%s %s/%s was cancelled.\n", group, kind, namespace, resourceName)
+			return
+		}
+
+		conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+		defer argoio.Close(conn)
+		_, err := appIf.RemoveResourceFinalizers(ctx, &applicationpkg.ApplicationResourceRequest{
+			Name:         &appName,
+			AppNamespace: &appNs,
+			Namespace:    ptr.To(namespace),
+			ResourceName: ptr.To(resourceName),
+			Group:        ptr.To(group),
+			Kind:         ptr.To(kind),
+			Project:      ptr.To(project),
+		})
+		errors.CheckError(err)
+		log.Infof("Finalizers of resource '%s' removed", resourceName)
+	}
+	return command
+}
+
+// findOrphanedResource returns the orphaned resource of the application tree referenced as GROUP:KIND:NAME or
+// GROUP:KIND:NAMESPACE/NAME
+func findOrphanedResource(app *v1alpha1.Application, tree *v1alpha1.ApplicationTree, resource string) (*v1alpha1.ResourceNode, error) {
+	ref, err := v1alpha1.ParseResourceRef(resource)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Namespace == "" {
+		ref.Namespace = app.Spec.Destination.Namespace
+	}
+	for i := range tree.OrphanedNodes {
+		node := tree.OrphanedNodes[i]
+		if node.Group == ref.Group && node.Kind == ref.Kind && node.Namespace == ref.Namespace && node.Name == ref.Name {
+			return &node, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %s is not an orphaned resource of application '%s'", resource, app.QualifiedName())
+}
+
+// getAdoptionPatch returns the merge patch setting the tracking metadata of the application on the given resource
+func getAdoptionPatch(app *v1alpha1.Application, node *v1alpha1.ResourceNode, argoSettings *settings.Settings) (string, error) {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(schema.GroupVersionKind{Group: node.Group, Version: node.Version, Kind: node.Kind})
+	obj.SetNamespace(node.Namespace)
+	obj.SetName(node.Name)
+	err := argo.NewResourceTracking().SetAppInstance(obj, argoSettings.AppLabelKey, app.InstanceName(argoSettings.ControllerNamespace), app.Spec.Destination.Namespace, v1alpha1.TrackingMethod(argoSettings.TrackingMethod), argoSettings.InstallationID)
+	if err != nil {
+		return "", fmt.Errorf("failed to set tracking metadata: %w", err)
+	}
+	metadata := map[string]any{}
+	if labels := obj.GetLabels(); len(labels) > 0 {
+		metadata["labels"] = labels
+	}
+	if annotations := obj.GetAnnotations(); len(annotations) > 0 {
+		metadata["annotations"] = annotations
+	}
+	patch, err := json.Marshal(map[string]any{"metadata": metadata})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal patch: %w", err)
+	}
+	return string(patch), nil
+}
+
 func parentChildInfo(nodes []v1alpha1.ResourceNode) (map[string]v1alpha1.ResourceNode, map[string][]string, map[string]struct{}) {
 	mapUIDToNode := make(map[string]v1alpha1.ResourceNode)
 	mapParentToChild := make(map[string][]string)
@@ -359,6 +359,21 @@ func TestFindRevisionHistoryWithPassedIdThatNotExist(t *testing.T) {
 	require.EqualError(t, err, "application '' does not have deployment id '4' in history", "Find revision history should fail with correct error message")
 }
 
+func Test_getServerSideDryRunManifests(t *testing.T) {
+	ctx := t.Context()
+	manifests := []string{`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"cm"}}`}
+
+	assert.Equal(t, manifests, getServerSideDryRunManifests(ctx, nil, nil, nil, &DifferenceOption{
+		revision: "v1.0.0",
+		res:      &apiclient.ManifestResponse{Manifests: manifests},
+	}))
+	assert.Equal(t, manifests, getServerSideDryRunManifests(ctx, nil, nil, nil, &DifferenceOption{
+		serversideRes: &apiclient.ManifestResponse{Manifests: manifests},
+	}))
+	// the target state of the managed resources is used by the server
+	assert.Nil(t, getServerSideDryRunManifests(ctx, nil, nil, nil, &DifferenceOption{}))
+}
+
 func Test_groupObjsByKey(t *testing.T) {
 	localObjs := []*unstructured.Unstructured{
 		{
@@ -412,6 +427,58 @@ func Test_groupObjsByKey(t *testing.T) {
 	assert.Equal(t, expected, objByKey)
 }
 
+func Test_hookPreviewName(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "batch/v1",
+		"kind":       "Job",
+		"metadata": map[string]any{
+			"generateName": "migrate-",
+		},
+	}}
+	assert.Equal(t, "migrate-abcdef0-presync-<timestamp>", hookPreviewName(obj, "PreSync", "abcdef0123456789"))
+	assert.Equal(t, "migrate-main-postsync-<timestamp>", hookPreviewName(obj, "PostSync", "main"))
+	assert.Equal(t, "migrate-<revision>-sync-<timestamp>", hookPreviewName(obj, "Sync", ""))
+
+	obj.SetName("migrate")
+	assert.Equal(t, "migrate", hookPreviewName(obj, "PreSync", "abcdef0123456789"))
+}
+
+func Test_printHooksPreview(t *testing.T) {
+	targetObjs := []*unstructured.Unstructured{
+		{
+			Object: map[string]any{
+				"apiVersion": "batch/v1",
+				"kind":       "Job",
+				"metadata": map[string]any{
+					"generateName": "migrate-",
+					"annotations": map[string]any{
+						"argocd.argoproj.io/hook": "PreSync,PostSync",
+					},
+				},
+			},
+		},
+		{
+			Object: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+				"metadata": map[string]any{
+					"name":      "pod-name",
+					"namespace": "default",
+				},
+			},
+		},
+	}
+
+	output, err := captureOutput(func() error {
+		printHooksPreview(targetObjs, "my-namespace", "abcdef0123456789")
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Contains(t, output, "===== batch/Job my-namespace/migrate-abcdef0-presync-<timestamp> (PreSync hook) ======")
+	assert.Contains(t, output, "===== batch/Job my-namespace/migrate-abcdef0-postsync-<timestamp> (PostSync hook) ======")
+	assert.NotContains(t, output, "pod-name")
+}
+
 func TestFormatSyncPolicy(t *testing.T) {
 	t.Run("Policy not defined", func(t *testing.T) {
 		app := v1alpha1.Application{}
@@ -551,6 +618,32 @@ func TestPrintOperationResult(t *testing.T) {
 	})
 }
 
+func TestNewApplicationHistoryExport(t *testing.T) {
+	app := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "team"},
+		Status: v1alpha1.ApplicationStatus{
+			History: v1alpha1.RevisionHistories{{ID: 1, Revision: "abc123", InitiatedBy: v1alpha1.OperationInitiator{Username: "admin"}}},
+		},
+	}
+
+	output, err := captureOutput(func() error {
+		return PrintResource(newApplicationHistoryExport(app), "json")
+	})
+	require.NoError(t, err)
+	var export applicationHistoryExport
+	require.NoError(t, json.Unmarshal([]byte(output), &export))
+	assert.Equal(t, "team/guestbook", export.Application)
+	assert.Equal(t, app.Status.History, export.History)
+
+	// an empty history is exported as an empty list
+	app.Status.History = nil
+	output, err = captureOutput(func() error {
+		return PrintResource(newApplicationHistoryExport(app), "json")
+	})
+	require.NoError(t, err)
+	assert.Contains(t, output, `"history": []`)
+}
+
 func TestPrintApplicationHistoryTable(t *testing.T) {
 	histories := []v1alpha1.RevisionHistory{
 		{
@@ -586,6 +679,28 @@ func TestPrintApplicationHistoryTable(t *testing.T) {
 	require.Equalf(t, output, expectation, "Incorrect print operation output %q, should be %q", output, expectation)
 }
 
+func TestPrintDriftHistoryTable(t *testing.T) {
+	events := []*v1alpha1.DriftEvent{{
+		RevertedAt: metav1.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		Revision:   "aaaaaaa",
+		Resources: []v1alpha1.DriftedResource{
+			{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook", Managers: []string{"helm", "kubectl-edit"}},
+			{Kind: "ConfigMap", Namespace: "default", Name: "guestbook"},
+		},
+	}}
+
+	output, _ := captureOutput(func() error {
+		printDriftHistoryTable(events)
+		return nil
+	})
+
+	expectation := `REVERTED AT           REVISION  GROUP  KIND        NAMESPACE  NAME       MANAGERS
+2024-01-02T03:04:05Z  aaaaaaa   apps   Deployment  default    guestbook  helm,kubectl-edit
+2024-01-02T03:04:05Z  aaaaaaa          ConfigMap   default    guestbook  <unknown>
+`
+	assert.Equal(t, expectation, output)
+}
+
 func TestPrintApplicationHistoryTableWithMultipleSources(t *testing.T) {
 	histories := []v1alpha1.RevisionHistory{
 		{
@@ -1056,6 +1171,8 @@ func Test_unset(t *testing.T) {
 			NamePrefix:              "some-prefix",
 			NameSuffix:              "some-suffix",
 			Version:                 "123",
+			Components:              []string{"../component1", "../component2"},
+			BuildOptions:            "--enable-helm",
 			Images: v1alpha1.KustomizeImages{
 				"old1=new:tag",
 				"old2=new:tag",
@@ -1164,6 +1281,22 @@ func Test_unset(t *testing.T) {
 	assert.False(t, updated)
 	assert.False(t, nothingToUnset)
 
+	updated, nothingToUnset = unset(kustomizeSource, unsetOpts{kustomizeComponents: []string{"../component1"}})
+	assert.Equal(t, []string{"../component2"}, kustomizeSource.Kustomize.Components)
+	assert.True(t, updated)
+	assert.False(t, nothingToUnset)
+	updated, nothingToUnset = unset(kustomizeSource, unsetOpts{kustomizeComponents: []string{"../component1"}})
+	assert.False(t, updated)
+	assert.False(t, nothingToUnset)
+
+	updated, nothingToUnset = unset(kustomizeSource, unsetOpts{kustomizeBuildOptions: true})
+	assert.Empty(t, kustomizeSource.Kustomize.BuildOptions)
+	assert.True(t, updated)
+	assert.False(t, nothingToUnset)
+	updated, nothingToUnset = unset(kustomizeSource, unsetOpts{kustomizeBuildOptions: true})
+	assert.False(t, updated)
+	assert.False(t, nothingToUnset)
+
 	assert.Len(t, helmSource.Helm.Parameters, 2)
 	updated, nothingToUnset = unset(helmSource, unsetOpts{parameters: []string{"name-1"}})
 	assert.Len(t, helmSource.Helm.Parameters, 1)
@@ -1545,6 +1678,87 @@ func TestParseSelectedResourcesEmptyList(t *testing.T) {
 	assert.Empty(t, operationResources)
 }
 
+type fakeDependenciesAppServiceClient struct {
+	applicationpkg.ApplicationServiceClient
+	apps map[string]*v1alpha1.Application
+}
+
+func (c *fakeDependenciesAppServiceClient) Get(_ context.Context, in *applicationpkg.ApplicationQuery, _ ...grpc.CallOption) (*v1alpha1.Application, error) {
+	app, ok := c.apps[in.GetAppNamespace()+"/"+in.GetName()]
+	if !ok {
+		return nil, fmt.Errorf("application %s not found", in.GetName())
+	}
+	return app, nil
+}
+
+func TestGetAppNamesWithDependencies(t *testing.T) {
+	newApp := func(name string, dependsOn ...string) *v1alpha1.Application {
+		return &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+			Spec:       v1alpha1.ApplicationSpec{DependsOn: dependsOn},
+		}
+	}
+	appIf := &fakeDependenciesAppServiceClient{apps: map[string]*v1alpha1.Application{}}
+	for _, app := range []*v1alpha1.Application{
+		newApp("frontend", "backend", "config"),
+		newApp("backend", "database", "config"),
+		newApp("database"),
+		newApp("config"),
+		newApp("cycle-a", "cycle-b"),
+		newApp("cycle-b", "cycle-a"),
+		newApp("missing", "unknown"),
+	} {
+		appIf.apps[app.QualifiedName()] = app
+	}
+
+	appNames, err := getAppNamesWithDependencies(t.Context(), appIf, []string{"frontend", "argocd/database"}, "argocd")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"argocd/database", "argocd/config", "argocd/backend", "argocd/frontend"}, appNames)
+
+	_, err = getAppNamesWithDependencies(t.Context(), appIf, []string{"cycle-a"}, "argocd")
+	require.EqualError(t, err, "circular dependency between applications: argocd/cycle-a -> argocd/cycle-b -> argocd/cycle-a")
+
+	_, err = getAppNamesWithDependencies(t.Context(), appIf, []string{"missing"}, "argocd")
+	require.ErrorContains(t, err, "failed to get application argocd/unknown")
+}
+
+func TestGetResourcesMatchingSelector(t *testing.T) {
+	managedResources := &applicationpkg.ManagedResourcesResponse{
+		Items: []*v1alpha1.ResourceDiff{
+			{
+				Group: "apps", Kind: "Deployment", Namespace: "default", Name: "backend",
+				TargetState: `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"backend","labels":{"component":"backend"}}}`,
+				LiveState:   "null",
+			},
+			{
+				Kind: "Service", Namespace: "default", Name: "frontend",
+				TargetState: `{"apiVersion":"v1","kind":"Service","metadata":{"name":"frontend","labels":{"component":"frontend"}}}`,
+				LiveState:   `{"apiVersion":"v1","kind":"Service","metadata":{"name":"frontend","labels":{"component":"frontend"}}}`,
+			},
+			{
+				Kind: "Namespace", Name: "backend",
+				TargetState: "null",
+				LiveState:   `{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"backend","labels":{"component":"backend"}}}`,
+			},
+		},
+	}
+
+	resources, err := getResourcesMatchingSelector(managedResources, "component=backend")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"apps:Deployment:default/backend", ":Namespace:backend"}, resources)
+
+	resources, err = getResourcesMatchingSelector(managedResources, "component in (backend,frontend),component!=backend")
+	require.NoError(t, err)
+	assert.Equal(t, []string{":Service:default/frontend"}, resources)
+
+	resources, err = getResourcesMatchingSelector(managedResources, "tier=canary")
+	require.NoError(t, err)
+	assert.Empty(t, resources)
+
+	_, err = getResourcesMatchingSelector(managedResources, "component==in==")
+	assert.ErrorContains(t, err, "invalid resource selector")
+}
+
 func TestPrintApplicationTableNotWide(t *testing.T) {
 	output, err := captureOutput(func() error {
 		app := &v1alpha1.Application{
@@ -1703,6 +1917,35 @@ func TestMergeWitoutUpdate(t *testing.T) {
 	assert.False(t, updated)
 }
 
+func TestCheckWaitCondition(t *testing.T) {
+	app := &v1alpha1.Application{
+		Status: v1alpha1.ApplicationStatus{
+			Summary: v1alpha1.ApplicationSummary{Images: []string{"nginx:v1.4.1", "sidecar:v2"}},
+			Health:  v1alpha1.HealthStatus{Status: health.HealthStatusHealthy},
+		},
+	}
+	check := func(expression string) bool {
+		t.Helper()
+		condition, err := parseWaitCondition(expression)
+		require.NoError(t, err)
+		return checkWaitCondition(condition, app)
+	}
+
+	assert.True(t, checkWaitCondition(nil, app))
+	assert.False(t, check(`.status.summary.images | any(contains("v1.4.2"))`))
+	app.Status.Summary.Images[0] = "nginx:v1.4.2"
+	assert.True(t, check(`.status.summary.images | any(contains("v1.4.2"))`))
+	assert.True(t, check(`.status.health.status == "Healthy"`))
+	assert.True(t, check(`.status.health`))
+	// null, false and evaluation errors are not met
+	assert.False(t, check(`.status.operationState`))
+	assert.False(t, check(`.status.health.status == "Degraded"`))
+	assert.False(t, check(`.status.operationState.syncResult.resources[] | .status`))
+
+	_, err := parseWaitCondition(`.status.health ==`)
+	require.ErrorContains(t, err, "failed to parse condition")
+}
+
 func TestCheckResourceStatus(t *testing.T) {
 	t.Run("Degraded, Suspended and health status passed", func(t *testing.T) {
 		res := checkResourceStatus(watchOpts{
@@ -2131,6 +2374,10 @@ func (c *fakeAppServiceClient) ManagedResources(_ context.Context, _ *applicatio
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) ServerSideDiff(_ context.Context, _ *applicationpkg.ApplicationServerSideDiffQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationServerSideDiffResponse, error) {
+	return nil, nil
+}
+
 func (c *fakeAppServiceClient) ResourceTree(_ context.Context, _ *applicationpkg.ResourcesQuery, _ ...grpc.CallOption) (*v1alpha1.ApplicationTree, error) {
 	return nil, nil
 }
@@ -2143,6 +2390,18 @@ func (c *fakeAppServiceClient) Rollback(_ context.Context, _ *applicationpkg.App
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) ApproveSync(_ context.Context, _ *applicationpkg.ApplicationApproveSyncRequest, _ ...grpc.CallOption) (*v1alpha1.Application, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) DriftHistory(_ context.Context, _ *applicationpkg.ApplicationDriftHistoryQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationDriftHistoryResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) RemoveResourceFinalizers(_ context.Context, _ *applicationpkg.ApplicationResourceRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationResponse, error) {
+	return nil, nil
+}
+
 func (c *fakeAppServiceClient) TerminateOperation(_ context.Context, _ *applicationpkg.OperationTerminateRequest, _ ...grpc.CallOption) (*applicationpkg.OperationTerminateResponse, error) {
 	return nil, nil
 }
@@ -3,8 +3,12 @@ package commands
 import (
 	"fmt"
 	"io"
+	"maps"
 	"os"
 	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/mattn/go-isatty"
@@ -55,6 +59,8 @@ func NewAppSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewApplicationSetListCommand(clientOpts))
 	command.AddCommand(NewApplicationSetDeleteCommand(clientOpts))
 	command.AddCommand(NewApplicationSetGenerateCommand(clientOpts))
+	command.AddCommand(NewApplicationSetPauseCommand(clientOpts))
+	command.AddCommand(NewApplicationSetResumeCommand(clientOpts))
 	return command
 }
 
@@ -211,47 +217,61 @@ func NewApplicationSetCreateCommand(clientOpts *argocdclient.ClientOptions) *cob
 
 // NewApplicationSetGenerateCommand returns a new instance of an `argocd appset generate` command
 func NewApplicationSetGenerateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var output string
+	var (
+		output string
+		files  []string
+		dryRun bool
+	)
 	command := &cobra.Command{
 		Use:   "generate",
 		Short: "Generate apps of ApplicationSet rendered templates",
+		Long:  "Generate apps of ApplicationSet rendered templates. All the generators are run by the API server, including the Matrix and Merge generators, and the generated Applications are printed without being created.",
 		Example: templates.Examples(`
 	# Generate apps of ApplicationSet rendered templates
 	argocd appset generate <filename or URL> (<filename or URL>...)
+
+	# Generate apps of all the ApplicationSets of the files, e.g. to validate a change in CI
+	argocd appset generate -f appset.yaml -f other-appsets.yaml -o yaml
 `),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
-			if len(args) == 0 {
+			fileURLs := slices.Concat(files, args)
+			if len(fileURLs) == 0 {
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
-			argocdClient := headless.NewClientOrDie(clientOpts, c)
-			fileURL := args[0]
-			appsets, err := cmdutil.ConstructApplicationSet(fileURL)
-			errors.CheckError(err)
-
-			if len(appsets) != 1 {
-				fmt.Printf("Input file must contain one ApplicationSet")
-				os.Exit(1)
+			if !dryRun {
+				errors.Fatal(errors.ErrorGeneric, "The generated applications can't be created, only --dry-run is supported. Create the ApplicationSet with 'argocd appset create' instead")
 			}
-			appset := appsets[0]
-			if appset.Name == "" {
-				errors.Fatal(errors.ErrorGeneric, fmt.Sprintf("Error generating apps for ApplicationSet %s. ApplicationSet does not have Name field set", appset))
+			argocdClient := headless.NewClientOrDie(clientOpts, c)
+			var appsets []*arogappsetv1.ApplicationSet
+			for _, fileURL := range fileURLs {
+				fileAppsets, err := cmdutil.ConstructApplicationSet(fileURL)
+				errors.CheckError(err)
+				if len(fileAppsets) == 0 {
+					errors.Fatal(errors.ErrorGeneric, fmt.Sprintf("File %s does not contain any ApplicationSet", fileURL))
+				}
+				appsets = append(appsets, fileAppsets...)
 			}
 
 			conn, appIf := argocdClient.NewApplicationSetClientOrDie()
 			defer argoio.Close(conn)
 
-			req := applicationset.ApplicationSetGenerateRequest{
-				ApplicationSet: appset,
-			}
-			resp, err := appIf.Generate(ctx, &req)
-			errors.CheckError(err)
-
 			var appsList []arogappsetv1.Application
-			for i := range resp.Applications {
-				appsList = append(appsList, *resp.Applications[i])
+			for _, appset := range appsets {
+				if appset.Name == "" {
+					errors.Fatal(errors.ErrorGeneric, fmt.Sprintf("Error generating apps for ApplicationSet %s. ApplicationSet does not have Name field set", appset))
+				}
+				req := applicationset.ApplicationSetGenerateRequest{
+					ApplicationSet: appset,
+				}
+				resp, err := appIf.Generate(ctx, &req)
+				errors.CheckError(err)
+
+				for i := range resp.Applications {
+					appsList = append(appsList, *resp.Applications[i])
+				}
 			}
 
 			switch output {
@@ -274,6 +294,8 @@ func NewApplicationSetGenerateCommand(clientOpts *argocdclient.ClientOptions) *c
 		},
 	}
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().StringArrayVarP(&files, "file", "f", []string{}, "Filename or URL of the ApplicationSets to generate apps of, may be repeated")
+	command.Flags().BoolVar(&dryRun, "dry-run", true, "Print the generated applications without creating them, the only supported mode. See 'argocd appset create --dry-run'")
 	return command
 }
 
@@ -384,6 +406,77 @@ func NewApplicationSetDeleteCommand(clientOpts *argocdclient.ClientOptions) *cob
 	return command
 }
 
+// NewApplicationSetPauseCommand returns a new instance of an `argocd appset pause` command
+func NewApplicationSetPauseCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var apps []string
+	command := &cobra.Command{
+		Use:   "pause APPSETNAME",
+		Short: "Pause the reconciliation of Applications generated by an ApplicationSet",
+		Long:  "Pause the reconciliation of Applications generated by an ApplicationSet. The paused Applications are neither updated nor deleted by the ApplicationSet controller, until they are resumed.",
+		Example: templates.Examples(`
+	# Pause the reconciliation of an Application generated by an ApplicationSet
+	argocd appset pause APPSETNAME --app APPNAME
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 || len(apps) == 0 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appSetName, appSetNs := argo.ParseFromQualifiedName(args[0], "")
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationSetClientOrDie()
+			defer argoio.Close(conn)
+			_, err := appIf.Pause(ctx, &applicationset.ApplicationSetPauseRequest{
+				Name:            appSetName,
+				AppsetNamespace: appSetNs,
+				Applications:    apps,
+			})
+			errors.CheckError(err)
+			for _, app := range apps {
+				fmt.Printf("application '%s' of applicationset '%s' paused\n", app, args[0])
+			}
+		},
+	}
+	command.Flags().StringArrayVar(&apps, "app", []string{}, "Name of a generated Application to pause, can be repeated")
+	return command
+}
+
+// NewApplicationSetResumeCommand returns a new instance of an `argocd appset resume` command
+func NewApplicationSetResumeCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var apps []string
+	command := &cobra.Command{
+		Use:   "resume APPSETNAME",
+		Short: "Resume the reconciliation of paused Applications generated by an ApplicationSet",
+		Example: templates.Examples(`
+	# Resume the reconciliation of a paused Application generated by an ApplicationSet
+	argocd appset resume APPSETNAME --app APPNAME
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 || len(apps) == 0 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appSetName, appSetNs := argo.ParseFromQualifiedName(args[0], "")
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationSetClientOrDie()
+			defer argoio.Close(conn)
+			_, err := appIf.Resume(ctx, &applicationset.ApplicationSetPauseRequest{
+				Name:            appSetName,
+				AppsetNamespace: appSetNs,
+				Applications:    apps,
+			})
+			errors.CheckError(err)
+			for _, app := range apps {
+				fmt.Printf("application '%s' of applicationset '%s' resumed\n", app, args[0])
+			}
+		},
+	}
+	command.Flags().StringArrayVar(&apps, "app", []string{}, "Name of a paused Application to resume, can be repeated")
+	return command
+}
+
 // Print simple list of application names
 func printApplicationSetNames(apps []arogappsetv1.ApplicationSet) {
 	for _, app := range apps {
@@ -467,6 +560,24 @@ func printAppSetSummaryTable(appSet *arogappsetv1.ApplicationSet) {
 		syncPolicyStr = "<none>"
 	}
 	fmt.Printf(printOpFmtStr, "SyncPolicy:", syncPolicyStr)
+
+	if summary := appSet.Status.ApplicationsSummary; summary != nil {
+		fmt.Printf(printOpFmtStr, "Applications:", strconv.FormatInt(summary.Total, 10))
+		fmt.Printf(printOpFmtStr, "Health:", formatAppSetStatusCounts(summary.Health))
+		fmt.Printf(printOpFmtStr, "Sync:", formatAppSetStatusCounts(summary.Sync))
+		if len(summary.Degraded) > 0 {
+			fmt.Printf(printOpFmtStr, "Degraded:", strings.Join(summary.Degraded, ", "))
+		}
+	}
+}
+
+// formatAppSetStatusCounts formats the number of Applications per status, e.g. "2 Degraded, 48 Healthy"
+func formatAppSetStatusCounts(counts map[string]int64) string {
+	formatted := make([]string, 0, len(counts))
+	for _, status := range slices.Sorted(maps.Keys(counts)) {
+		formatted = append(formatted, fmt.Sprintf("%d %s", counts[status], status))
+	}
+	return strings.Join(formatted, ", ")
 }
 
 func printAppSetConditions(w io.Writer, appSet *arogappsetv1.ApplicationSet) {
@@ -188,6 +188,14 @@ func TestPrintAppSetSummaryTable(t *testing.T) {
 		},
 	}
 
+	appSetApplicationsSummary := baseAppSet.DeepCopy()
+	appSetApplicationsSummary.Status.ApplicationsSummary = &v1alpha1.ApplicationSetApplicationsSummary{
+		Total:    50,
+		Health:   map[string]int64{"Healthy": 48, "Degraded": 2},
+		Sync:     map[string]int64{"Synced": 50},
+		Degraded: []string{"app-1", "app-2"},
+	}
+
 	for _, tt := range []struct {
 		name           string
 		appSet         *v1alpha1.ApplicationSet
@@ -261,6 +269,23 @@ Sources:
   Target:           master2
   Path:             /test2
 SyncPolicy:         <none>
+`,
+		},
+		{
+			name:   "appset with an applications summary",
+			appSet: appSetApplicationsSummary,
+			expectedOutput: `Name:               app-name
+Project:            default
+Server:             
+Namespace:          
+Source:
+- Repo:             
+  Target:           
+SyncPolicy:         <none>
+Applications:       50
+Health:             2 Degraded, 48 Healthy
+Sync:               50 Synced
+Degraded:           app-1, app-2
 `,
 		},
 	} {
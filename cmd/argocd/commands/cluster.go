@@ -1,12 +1,19 @@
 package commands
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
+	"reflect"
 	"regexp"
+	"sort"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -14,10 +21,15 @@ import (
 	"github.com/mattn/go-isatty"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/version"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/admin"
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
 	cmdutil "github.com/argoproj/argo-cd/v3/cmd/util"
 	"github.com/argoproj/argo-cd/v3/common"
@@ -26,8 +38,9 @@ import (
 	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/cli"
 	"github.com/argoproj/argo-cd/v3/util/clusterauth"
+	"github.com/argoproj/argo-cd/v3/util/db"
 	"github.com/argoproj/argo-cd/v3/util/errors"
-	"github.com/argoproj/argo-cd/v3/util/io"
+	argoio "github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/text/label"
 )
 
@@ -42,6 +55,8 @@ const (
 	clusterFieldLabel = "labels"
 	// cluster field is 'annotations'
 	clusterFieldAnnotation = "annotations"
+	// cluster field is 'maintenance'
+	clusterFieldMaintenance = "maintenance"
 	// indicates managing all namespaces
 	allNamespaces = "*"
 )
@@ -78,6 +93,10 @@ func NewClusterCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clientc
 	command.AddCommand(NewClusterRemoveCommand(clientOpts, pathOpts))
 	command.AddCommand(NewClusterRotateAuthCommand(clientOpts))
 	command.AddCommand(NewClusterSetCommand(clientOpts))
+	command.AddCommand(NewClusterMaintenanceCommand(clientOpts))
+	command.AddCommand(NewClusterWindowsCommand(clientOpts))
+	command.AddCommand(NewClusterDiffConfigCommand(clientOpts, pathOpts))
+	command.AddCommand(NewClusterInspectPublicCommand(pathOpts))
 	return command
 }
 
@@ -88,15 +107,46 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 		skipConfirmation bool
 		labels           []string
 		annotations      []string
+		dryRun           bool
+		output           string
+		fromKubeconfig   string
+		allContexts      bool
 	)
 	command := &cobra.Command{
 		Use:   "add CONTEXT",
 		Short: cliName + " cluster add CONTEXT",
+		Example: `  # Add a target cluster configuration to Argo CD. The context must exist in your kubectl config:
+  argocd cluster add example-cluster
+
+  # Grant the created service account the least-privilege rules from a file, using Roles in the managed namespaces only:
+  argocd cluster add example-cluster --namespace team-a --namespace team-b --rbac-rules-file rules.yaml
+
+  # Authenticate using a client certificate instead of a service account bearer token:
+  argocd cluster add example-cluster --auth-type cert
+
+  # Resolve the bearer token from Vault whenever Argo CD connects, instead of storing it in the cluster Secret:
+  argocd cluster add example-cluster --external-token-ref vault:secret/data/example-cluster#token
+
+  # Add the clusters of all contexts in a kubeconfig file:
+  argocd cluster add --from-kubeconfig ~/.kube/fleet-config --all-contexts
+
+  # Print the declarative cluster Secret instead of adding the cluster to Argo CD:
+  argocd cluster add example-cluster --dry-run -o yaml`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
+			if fromKubeconfig != "" {
+				pathOpts.LoadingRules.ExplicitPath = fromKubeconfig
+			}
 			var configAccess clientcmd.ConfigAccess = pathOpts
-			if len(args) == 0 {
+			if allContexts {
+				if len(args) != 0 {
+					log.Fatal("Can only use one of a context name or --all-contexts")
+				}
+				if clusterOpts.Name != "" {
+					log.Fatal("--name cannot be used with --all-contexts")
+				}
+			} else if len(args) == 0 {
 				log.Error("Choose a context name from:")
 				cmdutil.PrintKubeContexts(configAccess)
 				os.Exit(1)
@@ -106,107 +156,293 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 				log.Fatal("Can only use one of --in-cluster or --cluster-endpoint")
 				return
 			}
-
-			contextName := args[0]
-			conf, err := getRestConfig(pathOpts, contextName)
-			errors.CheckError(err)
-			if clusterOpts.ProxyUrl != "" {
-				u, err := argoappv1.ParseProxyUrl(clusterOpts.ProxyUrl)
-				errors.CheckError(err)
-				conf.Proxy = http.ProxyURL(u)
+			if clusterOpts.AuthType != cmdutil.ClusterAuthTypeToken && clusterOpts.AuthType != cmdutil.ClusterAuthTypeCert {
+				log.Fatalf("Unsupported auth type %q, must be one of: %s, %s", clusterOpts.AuthType, cmdutil.ClusterAuthTypeToken, cmdutil.ClusterAuthTypeCert)
 			}
-			clientset, err := kubernetes.NewForConfig(conf)
+			if clusterOpts.ServiceAccount != "" && (clusterOpts.RBACRulesFile != "" || clusterOpts.AuthType == cmdutil.ClusterAuthTypeCert) {
+				log.Fatal("--service-account cannot be used with --rbac-rules-file or --auth-type=cert")
+			}
+			rbacRules, err := clusterOpts.RBACRules()
+			errors.CheckError(err)
+			labelsMap, err := label.Parse(labels)
+			errors.CheckError(err)
+			annotationsMap, err := label.Parse(annotations)
 			errors.CheckError(err)
-			managerBearerToken := ""
-			var awsAuthConf *argoappv1.AWSAuthConfig
-			var execProviderConf *argoappv1.ExecProviderConfig
-			switch {
-			case clusterOpts.AwsClusterName != "":
-				awsAuthConf = &argoappv1.AWSAuthConfig{
-					ClusterName: clusterOpts.AwsClusterName,
-					RoleARN:     clusterOpts.AwsRoleArn,
-					Profile:     clusterOpts.AwsProfile,
+
+			if !allContexts {
+				contextName := args[0]
+				if !skipConfirmation && !dryRun {
+					confirmClusterManagerInstall(clusterOpts, rbacRules, fmt.Sprintf("the cluster referenced by context `%s`", contextName))
 				}
-			case clusterOpts.ExecProviderCommand != "":
-				execProviderConf = &argoappv1.ExecProviderConfig{
-					Command:     clusterOpts.ExecProviderCommand,
-					Args:        clusterOpts.ExecProviderArgs,
-					Env:         clusterOpts.ExecProviderEnv,
-					APIVersion:  clusterOpts.ExecProviderAPIVersion,
-					InstallHint: clusterOpts.ExecProviderInstallHint,
+				clst, err := newClusterFromContext(ctx, pathOpts, contextName, clusterOpts, rbacRules, labelsMap, annotationsMap, dryRun)
+				errors.CheckError(err)
+				if dryRun {
+					secret, err := db.NewClusterSecret(clst, "")
+					errors.CheckError(err)
+					errors.CheckError(admin.PrintResources(output, os.Stdout, secret))
+					return
 				}
-			default:
-				// Install RBAC resources for managing the cluster
-				if clusterOpts.ServiceAccount != "" {
-					managerBearerToken, err = clusterauth.GetServiceAccountBearerToken(clientset, clusterOpts.SystemNamespace, clusterOpts.ServiceAccount, common.BearerTokenTimeout)
-				} else {
-					isTerminal := isatty.IsTerminal(os.Stdout.Fd()) || isatty.IsCygwinTerminal(os.Stdout.Fd())
-					if isTerminal && !skipConfirmation {
-						accessLevel := "cluster"
-						if len(clusterOpts.Namespaces) > 0 {
-							accessLevel = "namespace"
-						}
-						message := fmt.Sprintf("WARNING: This will create a service account `argocd-manager` on the cluster referenced by context `%s` with full %s level privileges. Do you want to continue [y/N]? ", contextName, accessLevel)
-						if !cli.AskToProceed(message) {
-							os.Exit(1)
-						}
-					}
-					managerBearerToken, err = clusterauth.InstallClusterManagerRBAC(clientset, clusterOpts.SystemNamespace, clusterOpts.Namespaces, common.BearerTokenTimeout)
+				conn, clusterIf := headless.NewClientOrDie(clientOpts, c).NewClusterClientOrDie()
+				defer argoio.Close(conn)
+				clstCreateReq := clusterpkg.ClusterCreateRequest{
+					Cluster: clst,
+					Upsert:  clusterOpts.Upsert,
 				}
+				_, err = clusterIf.Create(ctx, &clstCreateReq)
 				errors.CheckError(err)
+				fmt.Printf("Cluster '%s' added\n", clst.Server)
+				return
 			}
 
-			labelsMap, err := label.Parse(labels)
-			errors.CheckError(err)
-			annotationsMap, err := label.Parse(annotations)
+			config, err := configAccess.GetStartingConfig()
 			errors.CheckError(err)
-
-			conn, clusterIf := headless.NewClientOrDie(clientOpts, c).NewClusterClientOrDie()
-			defer io.Close(conn)
-			if clusterOpts.Name != "" {
-				contextName = clusterOpts.Name
+			contextNames := kubeContextNames(config)
+			if len(contextNames) == 0 {
+				log.Fatal("The kubeconfig does not have any contexts")
 			}
-			clst := cmdutil.NewCluster(contextName, clusterOpts.Namespaces, clusterOpts.ClusterResources, conf, managerBearerToken, awsAuthConf, execProviderConf, labelsMap, annotationsMap)
-			if clusterOpts.InClusterEndpoint() {
-				clst.Server = argoappv1.KubernetesInternalAPIServerAddr
-			} else if clusterOpts.ClusterEndpoint == string(cmdutil.KubePublicEndpoint) {
-				endpoint, caData, err := cmdutil.GetKubePublicEndpoint(clientset)
-				if err != nil || len(endpoint) == 0 {
-					log.Warnf("Failed to find the cluster endpoint from kube-public data: %v", err)
-					log.Infof("Falling back to the endpoint '%s' as listed in the kubeconfig context", clst.Server)
-					endpoint = clst.Server
+			if !skipConfirmation && !dryRun {
+				confirmClusterManagerInstall(clusterOpts, rbacRules, fmt.Sprintf("the clusters referenced by all %d contexts of the kubeconfig", len(contextNames)))
+			}
+			var clusterIf clusterpkg.ClusterServiceClient
+			if !dryRun {
+				var conn argoio.Closer
+				conn, clusterIf = headless.NewClientOrDie(clientOpts, c).NewClusterClientOrDie()
+				defer argoio.Close(conn)
+			}
+			results := make([]clusterAddResult, 0, len(contextNames))
+			var secrets []any
+			for _, contextName := range contextNames {
+				result := clusterAddResult{context: contextName}
+				clst, err := newClusterFromContext(ctx, pathOpts, contextName, clusterOpts, rbacRules, labelsMap, annotationsMap, dryRun)
+				if err == nil {
+					result.server = clst.Server
+					if dryRun {
+						var secret any
+						secret, err = db.NewClusterSecret(clst, "")
+						secrets = append(secrets, secret)
+					} else {
+						_, err = clusterIf.Create(ctx, &clusterpkg.ClusterCreateRequest{Cluster: clst, Upsert: clusterOpts.Upsert})
+					}
 				}
-				clst.Server = endpoint
-				clst.Config.CAData = caData
+				result.err = err
+				results = append(results, result)
 			}
 
-			if clusterOpts.Shard >= 0 {
-				clst.Shard = &clusterOpts.Shard
-			}
-			if clusterOpts.Project != "" {
-				clst.Project = clusterOpts.Project
+			// the secrets are printed to stdout, so the summary is printed to stderr to keep the output usable
+			summary := os.Stdout
+			if dryRun {
+				errors.CheckError(admin.PrintResources(output, os.Stdout, secrets...))
+				summary = os.Stderr
 			}
-			clstCreateReq := clusterpkg.ClusterCreateRequest{
-				Cluster: clst,
-				Upsert:  clusterOpts.Upsert,
+			if failed := printClusterAddResults(summary, results, dryRun); failed > 0 {
+				log.Fatalf("Failed to add %d of %d cluster(s)", failed, len(results))
 			}
-			_, err = clusterIf.Create(ctx, &clstCreateReq)
-			errors.CheckError(err)
-			fmt.Printf("Cluster '%s' added\n", clst.Server)
 		},
 	}
 	command.PersistentFlags().StringVar(&pathOpts.LoadingRules.ExplicitPath, pathOpts.ExplicitFileFlag, pathOpts.LoadingRules.ExplicitPath, "use a particular kubeconfig file")
 	command.Flags().BoolVar(&clusterOpts.Upsert, "upsert", false, "Override an existing cluster with the same name even if the spec differs")
 	command.Flags().StringVar(&clusterOpts.ServiceAccount, "service-account", "", fmt.Sprintf("System namespace service account to use for kubernetes resource management. If not set then default %q SA will be created", clusterauth.ArgoCDManagerServiceAccount))
 	command.Flags().StringVar(&clusterOpts.SystemNamespace, "system-namespace", common.DefaultSystemNamespace, "Use different system namespace")
+	command.Flags().StringVar(&clusterOpts.AuthType, "auth-type", cmdutil.ClusterAuthTypeToken, fmt.Sprintf("How Argo CD authenticates to the cluster. One of: %s (service account bearer token), %s (client certificate issued through the CertificateSigningRequest API and renewed by the application controller)", cmdutil.ClusterAuthTypeToken, cmdutil.ClusterAuthTypeCert))
+	command.Flags().DurationVar(&clusterOpts.CertExpiration, "cert-expiration", 0, "Requested lifetime of the client certificate used with --auth-type=cert. Defaults to the lifetime configured for the cluster signer")
+	command.Flags().StringVar(&clusterOpts.RBACRulesFile, "rbac-rules-file", "", "Path to a YAML file with the RBAC rules to grant to the created service account instead of full access. Rules are granted using a ClusterRole, or using Roles only if --namespace is set")
 	command.Flags().BoolVarP(&skipConfirmation, "yes", "y", false, "Skip explicit confirmation")
 	command.Flags().StringArrayVar(&labels, "label", nil, "Set metadata labels (e.g. --label key=value)")
 	command.Flags().StringArrayVar(&annotations, "annotation", nil, "Set metadata annotations (e.g. --annotation key=value)")
 	command.Flags().StringVar(&clusterOpts.ProxyUrl, "proxy-url", "", "use proxy to connect cluster")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Print the declarative cluster Secret instead of adding the cluster to Argo CD, and the resources which would be created in the target cluster without creating them")
+	command.Flags().StringVarP(&output, "output", "o", "yaml", "Output format of the cluster Secret when --dry-run is set. One of: json|yaml")
+	command.Flags().StringVar(&fromKubeconfig, "from-kubeconfig", "", "Path to the kubeconfig file to read the contexts from")
+	command.Flags().BoolVar(&allContexts, "all-contexts", false, "Add the clusters of all contexts in the kubeconfig and print a summary of the results")
 	cmdutil.AddClusterFlags(command, &clusterOpts)
 	return command
 }
 
+// getKubePublicEndpoint returns the endpoint and CA data of the named cluster published in kube-public. If a
+// bootstrap token is given, the published endpoints must be signed by it.
+func getKubePublicEndpoint(ctx context.Context, clientset kubernetes.Interface, name string, bootstrapToken string) (string, []byte, error) {
+	endpoints, err := clusterauth.GetKubePublicEndpoints(ctx, clientset, bootstrapToken)
+	if err != nil {
+		return "", nil, err
+	}
+	endpoint, err := clusterauth.FindKubePublicEndpoint(endpoints, name)
+	if err != nil {
+		return "", nil, err
+	}
+	return endpoint.Server, endpoint.CertificateAuthorityData, nil
+}
+
+// newClusterFromContext returns the cluster referenced by the kubeconfig context. Unless the cluster is accessed using
+// AWS, an exec provider, external credentials or an existing service account, the cluster manager RBAC resources
+// are installed in the cluster. If dryRun is true, nothing is created in the cluster: the resources which would be
+// created are printed instead, and the returned cluster has no manager credentials.
+func newClusterFromContext(ctx context.Context, pathOpts *clientcmd.PathOptions, contextName string, clusterOpts cmdutil.ClusterOptions, rbacRules *clusterauth.ClusterManagerRBACRules, labels, annotations map[string]string, dryRun bool) (*argoappv1.Cluster, error) {
+	conf, err := getRestConfig(pathOpts, contextName)
+	if err != nil {
+		return nil, err
+	}
+	if clusterOpts.ProxyUrl != "" {
+		u, err := argoappv1.ParseProxyUrl(clusterOpts.ProxyUrl)
+		if err != nil {
+			return nil, err
+		}
+		conf.Proxy = http.ProxyURL(u)
+	}
+	clientset, err := kubernetes.NewForConfig(conf)
+	if err != nil {
+		return nil, err
+	}
+	var manager cmdutil.ClusterManagerCredentials
+	var awsAuthConf *argoappv1.AWSAuthConfig
+	var execProviderConf *argoappv1.ExecProviderConfig
+	switch {
+	case clusterOpts.AwsClusterName != "":
+		awsAuthConf = &argoappv1.AWSAuthConfig{
+			ClusterName: clusterOpts.AwsClusterName,
+			RoleARN:     clusterOpts.AwsRoleArn,
+			Profile:     clusterOpts.AwsProfile,
+		}
+	case clusterOpts.ExecProviderConfig() != nil:
+		execProviderConf = clusterOpts.ExecProviderConfig()
+	case clusterOpts.ExternalCredentialsConfig() != nil:
+		// The credentials are resolved from the external secret manager when connecting to the cluster
+	case clusterOpts.ServiceAccount != "" && dryRun:
+		// the token Secret of the service account is created if it does not exist
+		printDryRunResources(contextName, []string{fmt.Sprintf("Secret %s/%s%s", clusterOpts.SystemNamespace, clusterOpts.ServiceAccount, clusterauth.SATokenSecretSuffix)})
+	case clusterOpts.ServiceAccount != "":
+		manager.BearerToken, err = clusterauth.GetServiceAccountBearerToken(clientset, clusterOpts.SystemNamespace, clusterOpts.ServiceAccount, common.BearerTokenTimeout)
+	case dryRun:
+		var resources []string
+		resources, err = clusterauth.ClusterManagerRBACResources(clusterOpts.SystemNamespace, clusterOpts.Namespaces, rbacRules, clusterOpts.AuthType == cmdutil.ClusterAuthTypeCert)
+		printDryRunResources(contextName, resources)
+	case clusterOpts.AuthType == cmdutil.ClusterAuthTypeCert:
+		// Install RBAC resources for managing the cluster
+		if err = clusterauth.InstallClusterManagerCertRBAC(clientset, clusterOpts.Namespaces, rbacRules); err != nil {
+			return nil, err
+		}
+		manager.CertData, manager.KeyData, err = clusterauth.GenerateClusterManagerClientCertificate(clientset, clusterOpts.CertExpiration, common.BearerTokenTimeout)
+	default:
+		// Install RBAC resources for managing the cluster
+		manager.BearerToken, err = clusterauth.InstallClusterManagerRBACWithRules(clientset, clusterOpts.SystemNamespace, clusterOpts.Namespaces, rbacRules, common.BearerTokenTimeout)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	clst := cmdutil.NewCluster(clusterOpts.ClusterName(contextName), clusterOpts.Namespaces, clusterOpts.ClusterResources, conf, manager, awsAuthConf, execProviderConf, labels, annotations)
+	cmdutil.SetExternalCredentials(clst, clusterOpts.ExternalCredentialsConfig())
+	if clst.Config.ImpersonationConfig, err = clusterOpts.ImpersonationConfig(); err != nil {
+		return nil, err
+	}
+	clst.Config.RateLimitConfig = clusterOpts.RateLimitConfig()
+	cmdutil.SetProxyCredentials(clst, clusterOpts.ProxyCredentialsSecretRef())
+	if clst.Config.SSHTunnelConfig, err = clusterOpts.SSHTunnelConfig(); err != nil {
+		return nil, err
+	}
+	if clusterOpts.InClusterEndpoint() {
+		clst.Server = argoappv1.KubernetesInternalAPIServerAddr
+	} else if clusterOpts.ClusterEndpoint == string(cmdutil.KubePublicEndpoint) {
+		endpoint, caData, err := getKubePublicEndpoint(ctx, clientset, clusterOpts.KubePublicClusterName, clusterOpts.KubePublicBootstrapToken)
+		if err != nil || len(endpoint) == 0 {
+			log.Warnf("Failed to find the cluster endpoint from kube-public data: %v", err)
+			log.Infof("Falling back to the endpoint '%s' as listed in the kubeconfig context", clst.Server)
+			endpoint = clst.Server
+		} else {
+			// allows the application controller to refresh the CA data from kube-public when the cluster CA rotates
+			if clst.Annotations == nil {
+				clst.Annotations = map[string]string{}
+			}
+			clst.Annotations[common.AnnotationKeyClusterEndpoint] = string(cmdutil.KubePublicEndpoint)
+			clst.Config.BootstrapToken = clusterOpts.KubePublicBootstrapToken
+		}
+		clst.Server = endpoint
+		clst.Config.CAData = caData
+	}
+
+	if clusterOpts.Shard >= 0 {
+		clst.Shard = &clusterOpts.Shard
+	}
+	if clusterOpts.Project != "" {
+		clst.Project = clusterOpts.Project
+	}
+	return clst, nil
+}
+
+// printDryRunResources prints to stderr the resources which would be created in the cluster of the context, so that
+// stdout only holds the printed cluster Secrets
+func printDryRunResources(contextName string, resources []string) {
+	_, _ = fmt.Fprintf(os.Stderr, "Would create in the cluster of context '%s' (the credentials of the created identity are not included in the cluster Secret):\n", contextName)
+	for _, resource := range resources {
+		_, _ = fmt.Fprintf(os.Stderr, "- %s\n", resource)
+	}
+}
+
+// confirmClusterManagerInstall asks the user to confirm the installation of the cluster manager RBAC resources in the
+// target clusters, and exits if the user declines. Nothing is asked if no resources are installed or the output is
+// not a terminal.
+func confirmClusterManagerInstall(clusterOpts cmdutil.ClusterOptions, rbacRules *clusterauth.ClusterManagerRBACRules, target string) {
+	if clusterOpts.AwsClusterName != "" || clusterOpts.ExecProviderConfig() != nil || clusterOpts.ExternalCredentialsConfig() != nil || clusterOpts.ServiceAccount != "" {
+		return
+	}
+	if !isatty.IsTerminal(os.Stdout.Fd()) && !isatty.IsCygwinTerminal(os.Stdout.Fd()) {
+		return
+	}
+	accessLevel := "cluster"
+	if len(clusterOpts.Namespaces) > 0 {
+		accessLevel = "namespace"
+	}
+	identity := "a service account `argocd-manager`"
+	if clusterOpts.AuthType == cmdutil.ClusterAuthTypeCert {
+		identity = "a client certificate for the user `argocd-manager`"
+	}
+	message := fmt.Sprintf("WARNING: This will create %s on %s with full %s level privileges. Do you want to continue [y/N]? ", identity, target, accessLevel)
+	if rbacRules != nil {
+		message = fmt.Sprintf("WARNING: This will create %s on %s with the %s level privileges defined in %s. Do you want to continue [y/N]? ", identity, target, accessLevel, clusterOpts.RBACRulesFile)
+	}
+	if !cli.AskToProceed(message) {
+		os.Exit(1)
+	}
+}
+
+// kubeContextNames returns the sorted names of the contexts of the kubeconfig
+func kubeContextNames(config *clientcmdapi.Config) []string {
+	names := make([]string, 0, len(config.Contexts))
+	for name := range config.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// clusterAddResult is the result of adding the cluster of a kubeconfig context
+type clusterAddResult struct {
+	context string
+	server  string
+	err     error
+}
+
+// printClusterAddResults prints a summary table of the results and returns the number of clusters which failed. With
+// dryRun, the clusters which succeeded are reported as not added yet.
+func printClusterAddResults(out io.Writer, results []clusterAddResult, dryRun bool) int {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "CONTEXT\tSERVER\tSTATUS\tMESSAGE\n")
+	added := "Added"
+	if dryRun {
+		added = "Would add"
+	}
+	failed := 0
+	for _, result := range results {
+		state, message := added, ""
+		if result.err != nil {
+			failed++
+			state, message = "Failed", result.err.Error()
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", result.context, result.server, state, message)
+	}
+	_ = w.Flush()
+	return failed
+}
+
 func getRestConfig(pathOpts *clientcmd.PathOptions, ctxName string) (*rest.Config, error) {
 	config, err := pathOpts.GetStartingConfig()
 	if err != nil {
@@ -254,7 +490,7 @@ func NewClusterSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 			// name of the cluster whose fields have to be updated.
 			clusterName = args[0]
 			conn, clusterIf := headless.NewClientOrDie(clientOpts, c).NewClusterClientOrDie()
-			defer io.Close(conn)
+			defer argoio.Close(conn)
 			// checks the fields that needs to be updated
 			updatedFields := checkFieldsToUpdate(clusterOptions, labels, annotations)
 			namespaces := clusterOptions.Namespaces
@@ -302,6 +538,74 @@ func NewClusterSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 	return command
 }
 
+// NewClusterMaintenanceCommand returns a new instance of an `argocd cluster maintenance` command
+func NewClusterMaintenanceCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "maintenance",
+		Short: "Manage the maintenance mode of clusters",
+		Long:  "While a cluster is in maintenance, automated sync and self-heal are suspended for the applications deployed to the cluster. The status of the applications is still refreshed and manual syncs are allowed.",
+		Example: `  # Suspend automated sync of the applications deployed to a cluster
+  argocd cluster maintenance enable https://12.34.567.89
+
+  # Resume automated sync of the applications deployed to a cluster
+  argocd cluster maintenance disable cluster-name`,
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+	}
+	command.AddCommand(newClusterSetMaintenanceCommand(clientOpts, "enable", true))
+	command.AddCommand(newClusterSetMaintenanceCommand(clientOpts, "disable", false))
+	return command
+}
+
+func newClusterSetMaintenanceCommand(clientOpts *argocdclient.ClientOptions, use string, maintenance bool) *cobra.Command {
+	short := "Put clusters in maintenance, suspending automated sync of their applications"
+	if !maintenance {
+		short = "Take clusters out of maintenance, resuming automated sync of their applications"
+	}
+	return &cobra.Command{
+		Use:   use + " SERVER/NAME...",
+		Short: short,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+			if len(args) == 0 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, clusterIf := headless.NewClientOrDie(clientOpts, c).NewClusterClientOrDie()
+			defer argoio.Close(conn)
+			for _, clusterSelector := range args {
+				_, err := clusterIf.Update(ctx, newClusterMaintenanceUpdateRequest(clusterSelector, maintenance))
+				errors.CheckError(err)
+				if maintenance {
+					fmt.Printf("Cluster '%s' is in maintenance.\n", clusterSelector)
+				} else {
+					fmt.Printf("Cluster '%s' is no longer in maintenance.\n", clusterSelector)
+				}
+			}
+		},
+	}
+}
+
+// newClusterMaintenanceUpdateRequest returns the request updating the maintenance mode of the selected cluster
+func newClusterMaintenanceUpdateRequest(clusterSelector string, maintenance bool) *clusterpkg.ClusterUpdateRequest {
+	query := getQueryBySelector(clusterSelector)
+	id := &clusterpkg.ClusterID{Type: "url", Value: query.Server}
+	if query.Server == "" {
+		id = &clusterpkg.ClusterID{Type: clusterIdTypeName, Value: query.Name}
+	}
+	return &clusterpkg.ClusterUpdateRequest{
+		Cluster: &argoappv1.Cluster{
+			Server:      query.Server,
+			Name:        query.Name,
+			Maintenance: maintenance,
+		},
+		UpdatedFields: []string{clusterFieldMaintenance},
+		Id:            id,
+	}
+}
+
 // checkFieldsToUpdate returns the fields that needs to be updated
 func checkFieldsToUpdate(clusterOptions cmdutil.ClusterOptions, labels []string, annotations []string) []string {
 	var updatedFields []string
@@ -320,14 +624,208 @@ func checkFieldsToUpdate(clusterOptions cmdutil.ClusterOptions, labels []string,
 	return updatedFields
 }
 
+// NewClusterInspectPublicCommand returns a new instance of an `argocd cluster inspect-public` command
+func NewClusterInspectPublicCommand(pathOpts *clientcmd.PathOptions) *cobra.Command {
+	var (
+		bootstrapToken string
+		output         string
+	)
+	command := &cobra.Command{
+		Use:   "inspect-public CONTEXT",
+		Short: "Show the cluster endpoints published in kube-public",
+		Long: "Show the API server endpoints and CA data published in the kube-public cluster-info ConfigMap of the " +
+			"cluster referenced by the kubeconfig context, as used by `argocd cluster add --cluster-endpoint=kube-public`. " +
+			"Nothing is installed in the cluster.",
+		Example: `  # Show the endpoints published by the cluster:
+  argocd cluster inspect-public example-cluster
+
+  # Verify the published endpoints are signed by a kubeadm bootstrap token:
+  argocd cluster inspect-public example-cluster --bootstrap-token abcdef.0123456789abcdef`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conf, err := getRestConfig(pathOpts, args[0])
+			errors.CheckError(err)
+			clientset, err := kubernetes.NewForConfig(conf)
+			errors.CheckError(err)
+			endpoints, err := clusterauth.GetKubePublicEndpoints(ctx, clientset, bootstrapToken)
+			errors.CheckError(err)
+			switch output {
+			case "json", "yaml":
+				errors.CheckError(PrintResourceList(endpoints, output, false))
+			case "wide", "":
+				printKubePublicEndpoints(os.Stdout, endpoints)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVar(&bootstrapToken, "bootstrap-token", "", "Bootstrap token, as <token-id>.<token-secret>, which must have signed the published endpoints")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+// printKubePublicEndpoints prints the endpoints published in kube-public, with the SHA-256 fingerprint of their CA data
+func printKubePublicEndpoints(out io.Writer, endpoints []clusterauth.KubePublicEndpoint) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "NAME\tSERVER\tCA\tSIGNED BY\n")
+	for _, endpoint := range endpoints {
+		ca := "-"
+		if len(endpoint.CertificateAuthorityData) > 0 {
+			ca = fmt.Sprintf("sha256:%x", sha256.Sum256(endpoint.CertificateAuthorityData))
+		}
+		signedBy := "-"
+		if len(endpoint.SignedBy) > 0 {
+			signedBy = strings.Join(endpoint.SignedBy, ",")
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", endpoint.Name, endpoint.Server, ca, signedBy)
+	}
+	_ = w.Flush()
+}
+
+// NewClusterDiffConfigCommand returns a new instance of an `argocd cluster diff-config` command
+func NewClusterDiffConfigCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clientcmd.PathOptions) *cobra.Command {
+	var (
+		clusterOpts cmdutil.ClusterOptions
+		labels      []string
+		exitCode    bool
+	)
+	command := &cobra.Command{
+		Use:   "diff-config CONTEXT",
+		Short: "Compare the registered cluster configuration against the kubeconfig context",
+		Long: "Compare the connection configuration Argo CD holds for a cluster (server URL, CA, namespaces, labels, ...) " +
+			"against the configuration `argocd cluster add` would produce from the current kubeconfig context. " +
+			"Nothing is installed in the cluster.",
+		Example: `  # Detect drift between the registration of a cluster and the kubeconfig context:
+  argocd cluster diff-config example-cluster
+
+  # Pass the flags the cluster was added with, so they are not reported as drift:
+  argocd cluster diff-config example-cluster --name example --namespace team-a --label env=prod`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			contextName := args[0]
+			labelsMap, err := label.Parse(labels)
+			errors.CheckError(err)
+			conf, err := getRestConfig(pathOpts, contextName)
+			errors.CheckError(err)
+			if clusterOpts.ProxyUrl != "" {
+				u, err := argoappv1.ParseProxyUrl(clusterOpts.ProxyUrl)
+				errors.CheckError(err)
+				conf.Proxy = http.ProxyURL(u)
+			}
+			target := cmdutil.NewCluster(clusterOpts.ClusterName(contextName), clusterOpts.Namespaces, clusterOpts.ClusterResources, conf, cmdutil.ClusterManagerCredentials{}, nil, nil, labelsMap, nil)
+			if clusterOpts.InClusterEndpoint() {
+				target.Server = argoappv1.KubernetesInternalAPIServerAddr
+			}
+			target.Project = clusterOpts.Project
+
+			conn, clusterIf := headless.NewClientOrDie(clientOpts, c).NewClusterClientOrDie()
+			defer argoio.Close(conn)
+			live, err := clusterIf.Get(ctx, &clusterpkg.ClusterQuery{Server: target.Server})
+			if status.Code(err) == codes.NotFound || status.Code(err) == codes.PermissionDenied {
+				// the API does not tell apart missing clusters and clusters the user cannot access
+				fmt.Printf("Cluster '%s' is not registered in Argo CD or is not accessible\n", target.Server)
+				live = nil
+			} else {
+				errors.CheckError(err)
+			}
+
+			liveObj, err := newClusterDiffConfigObject(live)
+			errors.CheckError(err)
+			targetObj, err := newClusterDiffConfigObject(target)
+			errors.CheckError(err)
+			if reflect.DeepEqual(liveObj, targetObj) {
+				fmt.Printf("Cluster '%s' matches the kubeconfig context '%s'\n", target.Server, contextName)
+				return
+			}
+			fmt.Printf("===== Cluster %s ======\n", target.Server)
+			_ = cli.PrintDiff(contextName, liveObj, targetObj)
+			if exitCode {
+				os.Exit(1)
+			}
+		},
+	}
+	command.PersistentFlags().StringVar(&pathOpts.LoadingRules.ExplicitPath, pathOpts.ExplicitFileFlag, pathOpts.LoadingRules.ExplicitPath, "use a particular kubeconfig file")
+	command.Flags().StringVar(&clusterOpts.Name, "name", "", "Name the cluster was added with, defaults to the context name")
+	command.Flags().BoolVar(&clusterOpts.InCluster, "in-cluster", false, "Indicates the cluster was added with --in-cluster")
+	command.Flags().StringArrayVar(&clusterOpts.Namespaces, "namespace", nil, "List of namespaces the cluster was added with")
+	command.Flags().BoolVar(&clusterOpts.ClusterResources, "cluster-resources", false, "Indicates the cluster was added with --cluster-resources")
+	command.Flags().StringVar(&clusterOpts.Project, "project", "", "Project the cluster was added with")
+	command.Flags().StringVar(&clusterOpts.ProxyUrl, "proxy-url", "", "Proxy URL the cluster was added with")
+	command.Flags().StringArrayVar(&labels, "label", nil, "Metadata labels the cluster was added with (e.g. --label key=value)")
+	command.Flags().BoolVar(&exitCode, "exit-code", true, "Return non-zero exit code when there is a diff")
+	return command
+}
+
+// clusterDiffConfig holds the fields of a cluster compared by `argocd cluster diff-config`
+type clusterDiffConfig struct {
+	Server           string            `json:"server"`
+	Name             string            `json:"name,omitempty"`
+	Namespaces       []string          `json:"namespaces,omitempty"`
+	ClusterResources bool              `json:"clusterResources,omitempty"`
+	Project          string            `json:"project,omitempty"`
+	Labels           map[string]string `json:"labels,omitempty"`
+	ProxyUrl         string            `json:"proxyUrl,omitempty"` //nolint:revive //FIXME(var-naming)
+	Insecure         bool              `json:"insecure,omitempty"`
+	ServerName       string            `json:"serverName,omitempty"`
+	// CAData is the fingerprint of the CA data, to keep the diff readable
+	CAData string `json:"caData,omitempty"`
+}
+
+// newClusterDiffConfigObject returns the fields of the cluster compared by `argocd cluster diff-config`, or nil if the
+// cluster is nil
+func newClusterDiffConfigObject(clst *argoappv1.Cluster) (*unstructured.Unstructured, error) {
+	if clst == nil {
+		return nil, nil
+	}
+	config := clusterDiffConfig{
+		Server:           clst.Server,
+		Name:             clst.Name,
+		Namespaces:       clst.Namespaces,
+		ClusterResources: clst.ClusterResources,
+		Project:          clst.Project,
+		Labels:           clst.Labels,
+		ProxyUrl:         clst.Config.ProxyUrl,
+		Insecure:         clst.Config.Insecure,
+		ServerName:       clst.Config.ServerName,
+	}
+	if len(clst.Config.CAData) > 0 {
+		config.CAData = fmt.Sprintf("sha256:%x", sha256.Sum256(clst.Config.CAData))
+	}
+	data, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	un := &unstructured.Unstructured{}
+	if err := json.Unmarshal(data, &un.Object); err != nil {
+		return nil, err
+	}
+	return un, nil
+}
+
 // NewClusterGetCommand returns a new instance of an `argocd cluster get` command
 func NewClusterGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var output string
+	var (
+		output       string
+		showCapacity bool
+	)
 	command := &cobra.Command{
 		Use:   "get SERVER/NAME",
 		Short: "Get cluster information",
 		Example: `argocd cluster get https://12.34.567.89
-argocd cluster get in-cluster`,
+argocd cluster get in-cluster
+
+# Show the node and pod capacity of the cluster
+argocd cluster get in-cluster -o wide --show-capacity`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -336,7 +834,7 @@ argocd cluster get in-cluster`,
 				os.Exit(1)
 			}
 			conn, clusterIf := headless.NewClientOrDie(clientOpts, c).NewClusterClientOrDie()
-			defer io.Close(conn)
+			defer argoio.Close(conn)
 			clusters := make([]argoappv1.Cluster, 0)
 			for _, clusterSelector := range args {
 				clst, err := clusterIf.Get(ctx, getQueryBySelector(clusterSelector))
@@ -348,7 +846,7 @@ argocd cluster get in-cluster`,
 				err := PrintResourceList(clusters, output, true)
 				errors.CheckError(err)
 			case "wide", "":
-				printClusterDetails(clusters)
+				printClusterDetails(clusters, showCapacity)
 			case "server":
 				printClusterServers(clusters)
 			default:
@@ -358,6 +856,7 @@ argocd cluster get in-cluster`,
 	}
 	// we have yaml as default to not break backwards-compatibility
 	command.Flags().StringVarP(&output, "output", "o", "yaml", "Output format. One of: json|yaml|wide|server")
+	command.Flags().BoolVar(&showCapacity, "show-capacity", false, "Show the node and pod capacity of the cluster, as observed by the application controller, in the wide output")
 	return command
 }
 
@@ -375,7 +874,7 @@ func formatNamespaces(cluster argoappv1.Cluster) string {
 	return strings.Join(cluster.Namespaces, ", ")
 }
 
-func printClusterDetails(clusters []argoappv1.Cluster) {
+func printClusterDetails(clusters []argoappv1.Cluster, showCapacity bool) {
 	for _, cluster := range clusters {
 		fmt.Printf("Cluster information\n\n")
 		fmt.Printf("  Server URL:            %s\n", cluster.Server)
@@ -390,12 +889,86 @@ func printClusterDetails(clusters []argoappv1.Cluster) {
 		fmt.Printf("  Basic authentication:  %v\n", cluster.Config.Username != "")
 		fmt.Printf("  oAuth authentication:  %v\n", cluster.Config.BearerToken != "")
 		fmt.Printf("  AWS authentication:    %v\n", cluster.Config.AWSAuthConfig != nil)
+		fmt.Printf("  Impersonation:         %v\n", cluster.Config.ImpersonationConfig != nil)
 		fmt.Printf("\nDisable compression: %v\n", cluster.Config.DisableCompression)
 		fmt.Printf("\nUse proxy: %v\n", cluster.Config.ProxyUrl != "")
+		fmt.Printf("\nMaintenance: %v\n", cluster.Maintenance)
+		fmt.Printf("\nSync windows: %d\n", len(cluster.SyncWindows))
+		if showCapacity {
+			printClusterCapacity(cluster.Info)
+		}
 		fmt.Println()
 	}
 }
 
+func printClusterCapacity(info argoappv1.ClusterInfo) {
+	fmt.Printf("\nCapacity\n\n")
+	capacity := info.CapacityInfo
+	if capacity == nil {
+		fmt.Printf("  Capacity information is not available\n")
+		return
+	}
+	fmt.Printf("  Nodes:                 %d\n", capacity.NodesCount)
+	fmt.Printf("  Pods:                  %d/%d\n", capacity.PodsCount, capacity.AllocatablePods)
+	fmt.Printf("  Allocatable CPU:       %s\n", resource.NewMilliQuantity(capacity.AllocatableCPU, resource.DecimalSI))
+	fmt.Printf("  Allocatable memory:    %s\n", resource.NewQuantity(capacity.AllocatableMemory, resource.BinarySI))
+	fmt.Printf("  Kubelet versions:      %s\n", strWithDefault(strings.Join(capacity.KubeletVersions, ", "), "-"))
+	fmt.Printf("  Version skew:          %s\n", formatVersionSkew(info.ServerVersion, capacity.KubeletVersions))
+}
+
+// formatVersionSkew describes how many minor versions the oldest kubelet is behind the API server
+func formatVersionSkew(serverVersion string, kubeletVersions []string) string {
+	server, err := version.ParseGeneric(serverVersion)
+	if err != nil {
+		return "-"
+	}
+	var oldest *version.Version
+	for _, v := range kubeletVersions {
+		kubelet, err := version.ParseGeneric(v)
+		if err != nil {
+			continue
+		}
+		if oldest == nil || kubelet.LessThan(oldest) {
+			oldest = kubelet
+		}
+	}
+	if oldest == nil {
+		return "-"
+	}
+	skew := int(server.Minor()) - int(oldest.Minor())
+	if server.Major() != oldest.Major() || skew < 0 {
+		return fmt.Sprintf("kubelet %s is not compatible with server %s", oldest, server)
+	}
+	if skew == 0 {
+		return "none"
+	}
+	return fmt.Sprintf("%d minor version(s), oldest kubelet %s", skew, oldest)
+}
+
+func formatClusterCapacity(info argoappv1.ClusterInfo) (string, string, string, string) {
+	capacity := info.CapacityInfo
+	if capacity == nil {
+		return "-", "-", "-", "-"
+	}
+	return fmt.Sprintf("%d", capacity.NodesCount),
+		fmt.Sprintf("%d/%d", capacity.PodsCount, capacity.AllocatablePods),
+		resource.NewMilliQuantity(capacity.AllocatableCPU, resource.DecimalSI).String(),
+		resource.NewQuantity(capacity.AllocatableMemory, resource.BinarySI).String()
+}
+
+// formatClusterLatency returns the API server round-trip time and the watch lag of the cluster
+func formatClusterLatency(info argoappv1.ClusterInfo) (string, string) {
+	latency := info.LatencyInfo
+	if latency == nil {
+		return "-", "-"
+	}
+	rtt := (time.Duration(latency.APIServerRTTMilliseconds) * time.Millisecond).String()
+	if latency.WatchEventsCount == 0 {
+		return rtt, "-"
+	}
+	return rtt, (time.Duration(latency.WatchLagMilliseconds) * time.Millisecond).String()
+}
+
 // NewClusterRemoveCommand returns a new instance of an `argocd cluster rm` command
 func NewClusterRemoveCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clientcmd.PathOptions) *cobra.Command {
 	var noPrompt bool
@@ -412,7 +985,7 @@ argocd cluster rm cluster-name`,
 				os.Exit(1)
 			}
 			conn, clusterIf := headless.NewClientOrDie(clientOpts, c).NewClusterClientOrDie()
-			defer io.Close(conn)
+			defer argoio.Close(conn)
 			numOfClusters := len(args)
 			var isConfirmAll bool
 
@@ -469,14 +1042,16 @@ argocd cluster rm cluster-name`,
 // Print table of cluster information
 func printClusterTable(clusters []argoappv1.Cluster) {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	_, _ = fmt.Fprintf(w, "SERVER\tNAME\tVERSION\tSTATUS\tMESSAGE\tPROJECT\n")
+	_, _ = fmt.Fprintf(w, "SERVER\tNAME\tVERSION\tSTATUS\tMESSAGE\tPROJECT\tNODES\tPODS\tCPU\tMEMORY\tRTT\tWATCH LAG\n")
 	for _, c := range clusters {
 		server := c.Server
 		if len(c.Namespaces) > 0 {
 			server = fmt.Sprintf("%s (%d namespaces)", c.Server, len(c.Namespaces))
 		}
+		nodes, pods, cpu, memory := formatClusterCapacity(c.Info)
+		rtt, watchLag := formatClusterLatency(c.Info)
 		//nolint:staticcheck
-		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", server, c.Name, c.ServerVersion, c.ConnectionState.Status, c.ConnectionState.Message, c.Project)
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", server, c.Name, c.ServerVersion, c.ConnectionState.Status, c.ConnectionState.Message, c.Project, nodes, pods, cpu, memory, rtt, watchLag)
 	}
 	_ = w.Flush()
 }
@@ -510,7 +1085,7 @@ func NewClusterListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 			ctx := c.Context()
 
 			conn, clusterIf := headless.NewClientOrDie(clientOpts, c).NewClusterClientOrDie()
-			defer io.Close(conn)
+			defer argoio.Close(conn)
 			clusters, err := clusterIf.List(ctx, &clusterpkg.ClusterQuery{})
 			errors.CheckError(err)
 			switch output {
@@ -549,28 +1124,56 @@ argocd cluster list -o server <ARGOCD_SERVER_ADDRESS>
 
 // NewClusterRotateAuthCommand returns a new instance of an `argocd cluster rotate-auth` command
 func NewClusterRotateAuthCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var all bool
 	command := &cobra.Command{
 		Use:   "rotate-auth SERVER/NAME",
 		Short: cliName + " cluster rotate-auth SERVER/NAME",
 		Example: `argocd cluster rotate-auth https://12.34.567.89
-argocd cluster rotate-auth cluster-name`,
+argocd cluster rotate-auth cluster-name
+
+# Rotate the bearer tokens of all clusters which use service account token auth
+argocd cluster rotate-auth --all`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
-			if len(args) != 1 {
+			if (all && len(args) != 0) || (!all && len(args) != 1) {
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
 			conn, clusterIf := headless.NewClientOrDie(clientOpts, c).NewClusterClientOrDie()
-			defer io.Close(conn)
+			defer argoio.Close(conn)
 
-			cluster := args[0]
-			clusterQuery := getQueryBySelector(cluster)
-			_, err := clusterIf.RotateAuth(ctx, clusterQuery)
-			errors.CheckError(err)
+			if !all {
+				cluster := args[0]
+				clusterQuery := getQueryBySelector(cluster)
+				_, err := clusterIf.RotateAuth(ctx, clusterQuery)
+				errors.CheckError(err)
 
-			fmt.Printf("Cluster '%s' rotated auth\n", cluster)
+				fmt.Printf("Cluster '%s' rotated auth\n", cluster)
+				return
+			}
+
+			clusters, err := clusterIf.List(ctx, &clusterpkg.ClusterQuery{})
+			errors.CheckError(err)
+			failed := 0
+			for _, cluster := range clusters.Items {
+				_, err := clusterIf.RotateAuth(ctx, &clusterpkg.ClusterQuery{Server: cluster.Server})
+				switch {
+				case status.Code(err) == codes.InvalidArgument:
+					// the cluster does not use service account bearer token auth
+					fmt.Printf("Cluster '%s' skipped: %s\n", cluster.Server, status.Convert(err).Message())
+				case err != nil:
+					failed++
+					fmt.Printf("Cluster '%s' failed to rotate auth: %v\n", cluster.Server, err)
+				default:
+					fmt.Printf("Cluster '%s' rotated auth\n", cluster.Server)
+				}
+			}
+			if failed > 0 {
+				log.Fatalf("Failed to rotate auth of %d cluster(s)", failed)
+			}
 		},
 	}
+	command.Flags().BoolVar(&all, "all", false, "Rotate the auth of all clusters which use service account bearer token auth")
 	return command
 }
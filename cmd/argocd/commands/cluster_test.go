@@ -1,6 +1,8 @@
 package commands
 
 import (
+	"bytes"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -9,7 +11,9 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 
+	cmdutil "github.com/argoproj/argo-cd/v3/cmd/util"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/clusterauth"
 )
 
 func Test_getQueryBySelector(t *testing.T) {
@@ -49,6 +53,106 @@ func Test_printClusterTable(_ *testing.T) {
 	})
 }
 
+func Test_formatVersionSkew(t *testing.T) {
+	assert.Equal(t, "-", formatVersionSkew("", []string{"v1.29.1"}))
+	assert.Equal(t, "-", formatVersionSkew("v1.29.1", nil))
+	assert.Equal(t, "none", formatVersionSkew("v1.29.1", []string{"v1.29.0"}))
+	assert.Equal(t, "2 minor version(s), oldest kubelet 1.27.3", formatVersionSkew("v1.29.1", []string{"v1.29.0", "v1.27.3", "v1.28.2"}))
+	assert.Equal(t, "kubelet 1.30.0 is not compatible with server 1.29.1", formatVersionSkew("v1.29.1", []string{"v1.30.0"}))
+}
+
+func Test_formatClusterCapacity(t *testing.T) {
+	nodes, pods, cpu, memory := formatClusterCapacity(v1alpha1.ClusterInfo{})
+	assert.Equal(t, []string{"-", "-", "-", "-"}, []string{nodes, pods, cpu, memory})
+
+	nodes, pods, cpu, memory = formatClusterCapacity(v1alpha1.ClusterInfo{CapacityInfo: &v1alpha1.ClusterCapacityInfo{
+		NodesCount:        3,
+		PodsCount:         42,
+		AllocatablePods:   330,
+		AllocatableCPU:    4500,
+		AllocatableMemory: 3 * 1024 * 1024 * 1024,
+	}})
+	assert.Equal(t, []string{"3", "42/330", "4500m", "3Gi"}, []string{nodes, pods, cpu, memory})
+}
+
+func Test_formatClusterLatency(t *testing.T) {
+	rtt, watchLag := formatClusterLatency(v1alpha1.ClusterInfo{})
+	assert.Equal(t, []string{"-", "-"}, []string{rtt, watchLag})
+
+	rtt, watchLag = formatClusterLatency(v1alpha1.ClusterInfo{LatencyInfo: &v1alpha1.ClusterLatencyInfo{APIServerRTTMilliseconds: 42}})
+	assert.Equal(t, []string{"42ms", "-"}, []string{rtt, watchLag})
+
+	rtt, watchLag = formatClusterLatency(v1alpha1.ClusterInfo{LatencyInfo: &v1alpha1.ClusterLatencyInfo{
+		APIServerRTTMilliseconds: 42,
+		WatchLagMilliseconds:     1500,
+		WatchEventsCount:         4,
+	}})
+	assert.Equal(t, []string{"42ms", "1.5s"}, []string{rtt, watchLag})
+}
+
+func Test_newClusterMaintenanceUpdateRequest(t *testing.T) {
+	req := newClusterMaintenanceUpdateRequest("https://12.34.567.89", true)
+	assert.Equal(t, []string{"maintenance"}, req.UpdatedFields)
+	assert.Equal(t, "url", req.Id.Type)
+	assert.Equal(t, "https://12.34.567.89", req.Id.Value)
+	assert.True(t, req.Cluster.Maintenance)
+
+	req = newClusterMaintenanceUpdateRequest("cluster-name", false)
+	assert.Equal(t, "name", req.Id.Type)
+	assert.Equal(t, "cluster-name", req.Id.Value)
+	assert.Equal(t, "cluster-name", req.Cluster.Name)
+	assert.False(t, req.Cluster.Maintenance)
+}
+
+func Test_newClusterDiffConfigObject(t *testing.T) {
+	obj, err := newClusterDiffConfigObject(nil)
+	require.NoError(t, err)
+	assert.Nil(t, obj)
+
+	live := &v1alpha1.Cluster{
+		Server: "https://my-server",
+		Name:   "my-cluster",
+		Config: v1alpha1.ClusterConfig{
+			BearerToken:     "token",
+			TLSClientConfig: v1alpha1.TLSClientConfig{CAData: []byte("ca")},
+		},
+	}
+	obj, err = newClusterDiffConfigObject(live)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"server": "https://my-server",
+		"name":   "my-cluster",
+		"caData": "sha256:6959097001d10501ac7d54c0bdb8db61420f658f2922cc26e46d536119a31126",
+	}, obj.Object)
+
+	// empty labels are not reported as drift, credentials are not compared
+	target := live.DeepCopy()
+	target.Labels = map[string]string{}
+	target.Config.BearerToken = ""
+	targetObj, err := newClusterDiffConfigObject(target)
+	require.NoError(t, err)
+	assert.Equal(t, obj, targetObj)
+
+	target.Config.CAData = []byte("rotated")
+	target.Namespaces = []string{"team-a"}
+	targetObj, err = newClusterDiffConfigObject(target)
+	require.NoError(t, err)
+	assert.NotEqual(t, obj.Object["caData"], targetObj.Object["caData"])
+	assert.Equal(t, []any{"team-a"}, targetObj.Object["namespaces"])
+}
+
+func Test_printKubePublicEndpoints(t *testing.T) {
+	var out bytes.Buffer
+	printKubePublicEndpoints(&out, []clusterauth.KubePublicEndpoint{
+		{Name: "internal", Server: "https://10.0.0.1:6443", CertificateAuthorityData: []byte("ca"), SignedBy: []string{"abc123", "def456"}},
+		{Name: "external", Server: "https://cluster.example.com:6443"},
+	})
+	assert.Equal(t, `NAME      SERVER                            CA                                                                       SIGNED BY
+internal  https://10.0.0.1:6443             sha256:6959097001d10501ac7d54c0bdb8db61420f658f2922cc26e46d536119a31126  abc123,def456
+external  https://cluster.example.com:6443  -                                                                        -
+`, out.String())
+}
+
 func Test_getRestConfig(t *testing.T) {
 	type args struct {
 		pathOpts *clientcmd.PathOptions
@@ -108,3 +212,68 @@ func Test_getRestConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_newClusterFromContext(t *testing.T) {
+	pathOpts := &clientcmd.PathOptions{
+		GlobalFile:   "./testdata/config",
+		LoadingRules: clientcmd.NewDefaultClientConfigLoadingRules(),
+	}
+	clusterOpts := cmdutil.ClusterOptions{GcpClusterName: "my-gke", Shard: -1, Project: "my-project"}
+
+	clst, err := newClusterFromContext(t.Context(), pathOpts, "argocd1.example.com:443", clusterOpts, nil, map[string]string{"env": "prod"}, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, "argocd1.example.com:443", clst.Server)
+	assert.Equal(t, "my-gke", clst.Name)
+	assert.Equal(t, "my-project", clst.Project)
+	assert.Equal(t, map[string]string{"env": "prod"}, clst.Labels)
+	require.NotNil(t, clst.Config.ExecProviderConfig)
+	assert.Nil(t, clst.Shard)
+
+	_, err = newClusterFromContext(t.Context(), pathOpts, "not-exist", clusterOpts, nil, nil, nil, false)
+	require.EqualError(t, err, "context not-exist does not exist in kubeconfig")
+}
+
+func Test_newClusterFromContext_DryRun(t *testing.T) {
+	pathOpts := &clientcmd.PathOptions{
+		GlobalFile:   "./testdata/config",
+		LoadingRules: clientcmd.NewDefaultClientConfigLoadingRules(),
+	}
+	// the cluster of the context is not reachable, so any call to it would fail
+	clusterOpts := cmdutil.ClusterOptions{SystemNamespace: "kube-system", Shard: -1}
+
+	clst, err := newClusterFromContext(t.Context(), pathOpts, "argocd1.example.com:443", clusterOpts, nil, nil, nil, true)
+	require.NoError(t, err)
+	assert.Equal(t, "argocd1.example.com:443", clst.Server)
+	assert.Empty(t, clst.Config.BearerToken)
+
+	clusterOpts.AuthType = cmdutil.ClusterAuthTypeCert
+	clst, err = newClusterFromContext(t.Context(), pathOpts, "argocd1.example.com:443", clusterOpts, nil, nil, nil, true)
+	require.NoError(t, err)
+	assert.Empty(t, clst.Config.CertData)
+}
+
+func Test_kubeContextNames(t *testing.T) {
+	config, err := clientcmd.LoadFromFile("./testdata/config")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"argocd1.example.com:443", "argocd2.example.com:443", "localhost:8080"}, kubeContextNames(config))
+}
+
+func Test_printClusterAddResults(t *testing.T) {
+	var out bytes.Buffer
+	failed := printClusterAddResults(&out, []clusterAddResult{
+		{context: "prod", server: "https://prod.example.com"},
+		{context: "staging", err: errors.New("context staging does not exist in kubeconfig")},
+	}, false)
+	assert.Equal(t, 1, failed)
+	assert.Equal(t, `CONTEXT  SERVER                    STATUS  MESSAGE
+prod     https://prod.example.com  Added   
+staging                            Failed  context staging does not exist in kubeconfig
+`, out.String())
+
+	out.Reset()
+	failed = printClusterAddResults(&out, []clusterAddResult{{context: "prod", server: "https://prod.example.com"}}, true)
+	assert.Equal(t, 0, failed)
+	assert.Equal(t, `CONTEXT  SERVER                    STATUS     MESSAGE
+prod     https://prod.example.com  Would add  
+`, out.String())
+}
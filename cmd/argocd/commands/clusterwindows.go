@@ -0,0 +1,220 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/utils"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	clusterpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	argoio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+const clusterFieldSyncWindows = "syncWindows"
+
+// NewClusterWindowsCommand returns a new instance of the `argocd cluster windows` command
+func NewClusterWindowsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "windows",
+		Short: "Manage a cluster's sync windows",
+		Long:  "Cluster sync windows apply to the applications deployed to the cluster, regardless of their project. They are evaluated together with the sync windows of the project of each application.",
+		Example: `  # Deny syncs to a cluster every night
+  argocd cluster windows add https://12.34.567.89 --kind deny --schedule "0 22 * * *" --duration 8h
+
+  # Delete a sync window from a cluster
+  argocd cluster windows delete cluster-name 0
+
+  # List cluster sync windows
+  argocd cluster windows list cluster-name`,
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+	}
+	command.AddCommand(NewClusterWindowsAddCommand(clientOpts))
+	command.AddCommand(NewClusterWindowsDeleteCommand(clientOpts))
+	command.AddCommand(NewClusterWindowsListCommand(clientOpts))
+	return command
+}
+
+// NewClusterWindowsAddCommand returns a new instance of an `argocd cluster windows add` command
+func NewClusterWindowsAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		kind         string
+		schedule     string
+		duration     string
+		applications []string
+		namespaces   []string
+		manualSync   bool
+		timeZone     string
+		andOperator  bool
+	)
+	command := &cobra.Command{
+		Use:   "add SERVER/NAME",
+		Short: "Add a sync window to a cluster",
+		Long:  "Add a sync window to a cluster. Without applications and namespaces the window applies to all the applications deployed to the cluster.",
+		Example: `  # Deny syncs of all the applications deployed to the cluster during the night, allowing manual syncs
+  argocd cluster windows add https://12.34.567.89 \
+    --kind deny \
+    --schedule "0 22 * * *" \
+    --duration 8h \
+    --manual-sync
+
+  # Only allow syncs of the applications deployed to the prod-* namespaces during working hours
+  argocd cluster windows add cluster-name \
+    --kind allow \
+    --schedule "0 8 * * 1-5" \
+    --duration 10h \
+    --namespaces "prod-\\*" \
+    --time-zone "Europe/Amsterdam"`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, clusterIf := headless.NewClientOrDie(clientOpts, c).NewClusterClientOrDie()
+			defer argoio.Close(conn)
+
+			cluster, err := clusterIf.Get(ctx, getQueryBySelector(args[0]))
+			errors.CheckError(err)
+
+			err = cluster.AddSyncWindow(kind, schedule, duration, applications, namespaces, manualSync, timeZone, andOperator)
+			errors.CheckError(err)
+
+			_, err = clusterIf.Update(ctx, newClusterSyncWindowsUpdateRequest(cluster))
+			errors.CheckError(err)
+		},
+	}
+	command.Flags().StringVarP(&kind, "kind", "k", "", "Sync window kind, either allow or deny")
+	command.Flags().StringVar(&schedule, "schedule", "", "Sync window schedule in cron format. (e.g. --schedule \"0 22 * * *\")")
+	command.Flags().StringVar(&duration, "duration", "", "Sync window duration. (e.g. --duration 1h)")
+	command.Flags().StringSliceVar(&applications, "applications", []string{}, "Applications that the schedule will be applied to. Comma separated, wildcards supported (e.g. --applications prod-\\*,website)")
+	command.Flags().StringSliceVar(&namespaces, "namespaces", []string{}, "Namespaces that the schedule will be applied to. Comma separated, wildcards supported (e.g. --namespaces default,\\*-prod)")
+	command.Flags().BoolVar(&manualSync, "manual-sync", false, "Allow manual syncs for both deny and allow windows")
+	command.Flags().StringVar(&timeZone, "time-zone", "UTC", "Time zone of the sync window")
+	command.Flags().BoolVar(&andOperator, "use-and-operator", false, "Use AND operator for matching applications and namespaces instead of the default OR operator")
+	return command
+}
+
+// NewClusterWindowsDeleteCommand returns a new instance of an `argocd cluster windows delete` command
+func NewClusterWindowsDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "delete SERVER/NAME ID",
+		Short: "Delete a sync window from a cluster. Requires ID which can be found by running \"argocd cluster windows list SERVER/NAME\"",
+		Example: `  # Delete the sync window with ID 0 from a cluster
+  argocd cluster windows delete cluster-name 0`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			id, err := strconv.Atoi(args[1])
+			errors.CheckError(err)
+
+			conn, clusterIf := headless.NewClientOrDie(clientOpts, c).NewClusterClientOrDie()
+			defer argoio.Close(conn)
+
+			cluster, err := clusterIf.Get(ctx, getQueryBySelector(args[0]))
+			errors.CheckError(err)
+
+			err = cluster.DeleteSyncWindow(id)
+			errors.CheckError(err)
+
+			promptUtil := utils.NewPrompt(clientOpts.PromptsEnabled)
+			if promptUtil.Confirm("Are you sure you want to delete sync window? [y/n]") {
+				_, err = clusterIf.Update(ctx, newClusterSyncWindowsUpdateRequest(cluster))
+				errors.CheckError(err)
+			} else {
+				fmt.Printf("The command to delete the sync window was cancelled\n")
+			}
+		},
+	}
+	return command
+}
+
+// NewClusterWindowsListCommand returns a new instance of an `argocd cluster windows list` command
+func NewClusterWindowsListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:   "list SERVER/NAME",
+		Short: "List cluster sync windows",
+		Example: `  # List the sync windows of a cluster
+  argocd cluster windows list cluster-name
+
+  # List the sync windows of a cluster in yaml format
+  argocd cluster windows list https://12.34.567.89 -o yaml`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, clusterIf := headless.NewClientOrDie(clientOpts, c).NewClusterClientOrDie()
+			defer argoio.Close(conn)
+
+			cluster, err := clusterIf.Get(ctx, getQueryBySelector(args[0]))
+			errors.CheckError(err)
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(cluster.SyncWindows, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				printClusterSyncWindows(os.Stdout, cluster.SyncWindows)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+// newClusterSyncWindowsUpdateRequest returns the request updating the sync windows of the cluster
+func newClusterSyncWindowsUpdateRequest(cluster *v1alpha1.Cluster) *clusterpkg.ClusterUpdateRequest {
+	return &clusterpkg.ClusterUpdateRequest{
+		Cluster: &v1alpha1.Cluster{
+			Server:      cluster.Server,
+			Name:        cluster.Name,
+			SyncWindows: cluster.SyncWindows,
+		},
+		UpdatedFields: []string{clusterFieldSyncWindows},
+		Id:            &clusterpkg.ClusterID{Type: "url", Value: cluster.Server},
+	}
+}
+
+// printClusterSyncWindows prints a table of the sync windows of a cluster
+func printClusterSyncWindows(out io.Writer, windows v1alpha1.SyncWindows) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	headers := []any{"ID", "STATUS", "KIND", "SCHEDULE", "DURATION", "APPLICATIONS", "NAMESPACES", "MANUALSYNC", "TIMEZONE"}
+	fmtStr := strings.Repeat("%s\t", len(headers)) + "\n"
+	fmt.Fprintf(w, fmtStr, headers...)
+	for i, window := range windows {
+		isActive, _ := window.Active()
+		fmt.Fprintf(w, fmtStr,
+			strconv.Itoa(i),
+			formatBoolOutput(isActive),
+			window.Kind,
+			window.Schedule,
+			window.Duration,
+			formatListOutput(window.Applications),
+			formatListOutput(window.Namespaces),
+			formatBoolEnabledOutput(window.ManualSync),
+			window.TimeZone,
+		)
+	}
+	_ = w.Flush()
+}
@@ -0,0 +1,36 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func Test_newClusterSyncWindowsUpdateRequest(t *testing.T) {
+	windows := v1alpha1.SyncWindows{{Kind: "deny", Schedule: "0 22 * * *", Duration: "8h"}}
+	req := newClusterSyncWindowsUpdateRequest(&v1alpha1.Cluster{
+		Server:      "https://12.34.567.89",
+		Name:        "cluster-name",
+		Config:      v1alpha1.ClusterConfig{BearerToken: "token"},
+		SyncWindows: windows,
+	})
+	assert.Equal(t, []string{"syncWindows"}, req.UpdatedFields)
+	assert.Equal(t, "url", req.Id.Type)
+	assert.Equal(t, "https://12.34.567.89", req.Id.Value)
+	assert.Equal(t, windows, req.Cluster.SyncWindows)
+	assert.Empty(t, req.Cluster.Config.BearerToken)
+}
+
+func Test_printClusterSyncWindows(t *testing.T) {
+	var out bytes.Buffer
+	printClusterSyncWindows(&out, v1alpha1.SyncWindows{
+		{Kind: "deny", Schedule: "* * * * *", Duration: "8h", ManualSync: true, TimeZone: "UTC"},
+		{Kind: "allow", Schedule: "* * * * *", Duration: "10h", Applications: []string{"app"}, Namespaces: []string{"prod-*", "staging"}, TimeZone: "Europe/Amsterdam"},
+	})
+	assert.Equal(t, "ID  STATUS  KIND   SCHEDULE   DURATION  APPLICATIONS  NAMESPACES      MANUALSYNC  TIMEZONE          \n"+
+		"0   Active  deny   * * * * *  8h        -             -               Enabled     UTC               \n"+
+		"1   Active  allow  * * * * *  10h       app           prod-*,staging  Disabled    Europe/Amsterdam  \n", out.String())
+}
@@ -44,6 +44,7 @@ func NewLoginCommand(globalClientOpts *argocdclient.ClientOptions) *cobra.Comman
 		ssoPort          int
 		skipTestTLS      bool
 		ssoLaunchBrowser bool
+		ssoProvider      string
 	)
 	command := &cobra.Command{
 		Use:   "login SERVER",
@@ -55,6 +56,9 @@ argocd login cd.argoproj.io
 # Login to Argo CD using SSO
 argocd login cd.argoproj.io --sso
 
+# Login to Argo CD using SSO with one of the additional OIDC providers
+argocd login cd.argoproj.io --sso --sso-provider keycloak
+
 # Configure direct access using Kubernetes API server
 argocd login cd.argoproj.io --core`,
 		Run: func(c *cobra.Command, args []string) {
@@ -135,6 +139,9 @@ argocd login cd.argoproj.io --core`,
 					ctx = oidc.ClientContext(ctx, httpClient)
 					acdSet, err := setIf.Get(ctx, &settingspkg.SettingsQuery{})
 					errors.CheckError(err)
+					if ssoProvider != "" {
+						acdSet.OIDCConfig = getAdditionalOIDCConfig(acdSet, ssoProvider)
+					}
 					oauth2conf, provider, err := acdClient.OIDCConfig(ctx, acdSet)
 					errors.CheckError(err)
 					tokenString, refreshToken = oauth2Login(ctx, ssoPort, acdSet.GetOIDCConfig(), oauth2conf, provider, ssoLaunchBrowser)
@@ -189,9 +196,22 @@ argocd login cd.argoproj.io --core`,
 	command.Flags().
 		BoolVar(&skipTestTLS, "skip-test-tls", false, "Skip testing whether the server is configured with TLS (this can help when the command hangs for no apparent reason)")
 	command.Flags().BoolVar(&ssoLaunchBrowser, "sso-launch-browser", true, "Automatically launch the system default browser when performing SSO login")
+	command.Flags().StringVar(&ssoProvider, "sso-provider", "", "Name of the additional OIDC provider to perform SSO login with")
 	return command
 }
 
+// getAdditionalOIDCConfig returns the config of the additional OIDC provider with the given name, or fails if there is
+// none
+func getAdditionalOIDCConfig(acdSet *settingspkg.Settings, name string) *settingspkg.OIDCConfig {
+	for _, config := range acdSet.AdditionalOIDCConfigs {
+		if config.Name == name {
+			return config
+		}
+	}
+	errors.Fatalf(errors.ErrorGeneric, "OIDC provider %q is not configured", name)
+	return nil
+}
+
 func userDisplayName(claims *claimsutil.ArgoClaims) string {
 	if claims == nil {
 		return ""
@@ -65,6 +65,7 @@ func NewProjectCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewProjectGetCommand(clientOpts))
 	command.AddCommand(NewProjectDeleteCommand(clientOpts))
 	command.AddCommand(NewProjectListCommand(clientOpts))
+	command.AddCommand(NewProjectListTemplatesCommand(clientOpts))
 	command.AddCommand(NewProjectSetCommand(clientOpts))
 	command.AddCommand(NewProjectEditCommand(clientOpts))
 	command.AddCommand(NewProjectAddSignatureKeyCommand(clientOpts))
@@ -101,9 +102,12 @@ func humanizeTimestamp(epoch int64) string {
 // NewProjectCreateCommand returns a new instance of an `argocd proj create` command
 func NewProjectCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		opts    cmdutil.ProjectOpts
-		fileURL string
-		upsert  bool
+		opts               cmdutil.ProjectOpts
+		fileURL            string
+		upsert             bool
+		fromTemplate       string
+		templateParams     []string
+		templateParamsFile string
 	)
 	command := &cobra.Command{
 		Use:   "create PROJECT",
@@ -114,10 +118,38 @@ func NewProjectCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 
 			# Create a new project with name PROJECT from a file or URL to a Kubernetes manifest
 			argocd proj create PROJECT -f FILE|URL
+
+			# Create a new project with name PROJECT from the project template TEMPLATE
+			argocd proj create PROJECT --from-template TEMPLATE --template-param team=payments
+
+			# Create the projects listed with their template parameters in a file or URL from the project template TEMPLATE
+			argocd proj create --from-template TEMPLATE --template-params-file FILE|URL
 		`),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
+			if fromTemplate != "" {
+				if fileURL != "" {
+					errors.Fatal(errors.ErrorGeneric, "--file can't be used with --from-template")
+				}
+				projects, err := cmdutil.ConstructProjTemplateParams(templateParamsFile, args, templateParams)
+				errors.CheckError(err)
+
+				conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+				defer argoio.Close(conn)
+				for _, p := range projects {
+					_, err = projIf.CreateFromTemplate(ctx, &projectpkg.ProjectCreateFromTemplateRequest{
+						Template:   fromTemplate,
+						Name:       p.Name,
+						Parameters: p.Parameters,
+						Upsert:     upsert,
+					})
+					errors.CheckError(err)
+					fmt.Printf("Project '%s' created from template '%s'\n", p.Name, fromTemplate)
+				}
+				return
+			}
+
 			proj, err := cmdutil.ConstructAppProj(fileURL, args, opts, c)
 			errors.CheckError(err)
 
@@ -133,10 +165,67 @@ func NewProjectCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 	if err != nil {
 		log.Fatal(err)
 	}
+	command.Flags().StringVar(&fromTemplate, "from-template", "", "Name of the project template the project is created from")
+	command.Flags().StringArrayVar(&templateParams, "template-param", []string{}, "Parameter of the project template (e.g. --template-param team=payments)")
+	command.Flags().StringVar(&templateParamsFile, "template-params-file", "", "Filename or URL to a list of the names and template parameters of the projects to create from the project template")
 	cmdutil.AddProjFlags(command, &opts)
 	return command
 }
 
+// NewProjectListTemplatesCommand returns a new instance of an `argocd proj list-templates` command
+func NewProjectListTemplatesCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:   "list-templates",
+		Short: "List project templates",
+		Example: templates.Examples(`
+			# List the project templates
+			argocd proj list-templates
+
+			# List the project templates in yaml format
+			argocd proj list-templates -o yaml
+		`),
+		Run: func(c *cobra.Command, _ []string) {
+			ctx := c.Context()
+
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer argoio.Close(conn)
+			projectTemplates, err := projIf.ListTemplates(ctx, &projectpkg.ProjectTemplatesQuery{})
+			errors.CheckError(err)
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(projectTemplates.Items, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				printProjectTemplateTable(projectTemplates.Items)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+// Print table of project templates
+func printProjectTemplateTable(projectTemplates []*projectpkg.ProjectTemplate) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "NAME\tDESCRIPTION\tPARAMETERS\n")
+	for _, t := range projectTemplates {
+		params := make([]string, len(t.Parameters))
+		for i, param := range t.Parameters {
+			params[i] = param.Name
+			if param.Required {
+				params[i] += " (required)"
+			} else if param.Default != "" {
+				params[i] += "=" + param.Default
+			}
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", t.Name, t.Description, strings.Join(params, ", "))
+	}
+	_ = w.Flush()
+}
+
 // NewProjectSetCommand returns a new instance of an `argocd proj set` command
 func NewProjectSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var opts cmdutil.ProjectOpts
@@ -180,12 +269,16 @@ func NewProjectSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 
 // NewProjectAddSignatureKeyCommand returns a new instance of an `argocd proj add-signature-key` command
 func NewProjectAddSignatureKeyCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var paths []string
 	command := &cobra.Command{
 		Use:   "add-signature-key PROJECT KEY-ID",
-		Short: "Add GnuPG signature key to project",
+		Short: "Add GnuPG signature key or SSH key fingerprint to project",
 		Example: templates.Examples(`
 			# Add GnuPG signature key KEY-ID to project PROJECT
 			argocd proj add-signature-key PROJECT KEY-ID
+
+			# Require the commits of the sources under clusters/prod to be signed with an SSH key
+			argocd proj add-signature-key PROJECT SHA256:deAWW1bT8yqjG55QrNOzkLJwMPF0J9yDb+nJO+N/leQ --path 'clusters/prod/**'
 		`),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -197,8 +290,8 @@ func NewProjectAddSignatureKeyCommand(clientOpts *argocdclient.ClientOptions) *c
 			projName := args[0]
 			signatureKey := args[1]
 
-			if !gpg.IsShortKeyID(signatureKey) && !gpg.IsLongKeyID(signatureKey) {
-				log.Fatalf("%s is not a valid GnuPG key ID", signatureKey)
+			if gpg.SignatureKeyID(signatureKey) == "" {
+				log.Fatalf("%s is not a valid GnuPG key ID or SSH key fingerprint", signatureKey)
 			}
 
 			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
@@ -212,11 +305,12 @@ func NewProjectAddSignatureKeyCommand(clientOpts *argocdclient.ClientOptions) *c
 					log.Fatal("Specified signature key is already defined in project")
 				}
 			}
-			proj.Spec.SignatureKeys = append(proj.Spec.SignatureKeys, v1alpha1.SignatureKey{KeyID: signatureKey})
+			proj.Spec.SignatureKeys = append(proj.Spec.SignatureKeys, v1alpha1.SignatureKey{KeyID: signatureKey, Paths: paths})
 			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
 			errors.CheckError(err)
 		},
 	}
+	command.Flags().StringArrayVar(&paths, "path", []string{}, "Glob pattern of the source paths the key is required for, the key is required for all the paths if not set")
 	return command
 }
 
@@ -911,11 +1005,14 @@ func printProjectLine(w io.Writer, p *v1alpha1.AppProject) {
 	fmt.Fprintf(w, "%s\t%s\t%v\t%v\t%v\t%v\t%v\t%v\t%v\n", p.Name, p.Spec.Description, destinations, sourceRepos, clusterWhitelist, namespaceBlacklist, signatureKeys, formatOrphanedResources(p), destinationServiceAccounts)
 }
 
-func printProject(p *v1alpha1.AppProject, scopedRepositories []*v1alpha1.Repository, scopedClusters []*v1alpha1.Cluster) {
+func printProject(p *v1alpha1.AppProject, scopedRepositories []*v1alpha1.Repository, scopedClusters []*v1alpha1.Cluster, quotaUsage *v1alpha1.ProjectQuotaUsage) {
 	const printProjFmtStr = "%-29s%s\n"
 
 	fmt.Printf(printProjFmtStr, "Name:", p.Name)
 	fmt.Printf(printProjFmtStr, "Description:", p.Spec.Description)
+	if p.Spec.ParentProject != "" {
+		fmt.Printf(printProjFmtStr, "Parent Project:", p.Spec.ParentProject)
+	}
 
 	// Print destinations
 	dest0 := "<none>"
@@ -999,6 +1096,25 @@ func printProject(p *v1alpha1.AppProject, scopedRepositories []*v1alpha1.Reposit
 	fmt.Printf(printProjFmtStr, "Signature keys:", signatureKeysStr)
 
 	fmt.Printf(printProjFmtStr, "Orphaned Resources:", formatOrphanedResources(p))
+
+	// Print quota usage
+	if p.Spec.Quota == nil {
+		fmt.Printf(printProjFmtStr, "Quota:", "<none>")
+	} else {
+		if quotaUsage == nil {
+			quotaUsage = &v1alpha1.ProjectQuotaUsage{}
+		}
+		fmt.Printf(printProjFmtStr, "Quota:", formatQuotaUsage("applications", quotaUsage.Applications, p.Spec.Quota.MaxApplications))
+		fmt.Printf(printProjFmtStr, "", formatQuotaUsage("resources", quotaUsage.Resources, p.Spec.Quota.MaxResources))
+		fmt.Printf(printProjFmtStr, "", formatQuotaUsage("clusters", quotaUsage.Clusters, p.Spec.Quota.MaxClusters))
+	}
+}
+
+func formatQuotaUsage(name string, used, limit int64) string {
+	if limit <= 0 {
+		return fmt.Sprintf("%s: %d/unlimited", name, used)
+	}
+	return fmt.Sprintf("%s: %d/%d", name, used, limit)
 }
 
 // NewProjectGetCommand returns a new instance of an `argocd proj get` command
@@ -1030,7 +1146,7 @@ func NewProjectGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 				err := PrintResource(detailedProject.Project, output)
 				errors.CheckError(err)
 			case "wide", "":
-				printProject(detailedProject.Project, detailedProject.Repositories, detailedProject.Clusters)
+				printProject(detailedProject.Project, detailedProject.Repositories, detailedProject.Clusters, detailedProject.QuotaUsage)
 			default:
 				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
 			}
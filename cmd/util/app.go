@@ -53,6 +53,7 @@ type AppOptions struct {
 	helmSkipCrds                    bool
 	helmSkipSchemaValidation        bool
 	helmSkipTests                   bool
+	helmEnableLookups               bool
 	helmNamespace                   string
 	helmKubeVersion                 string
 	helmApiVersions                 []string //nolint:revive //FIXME(var-naming)
@@ -71,6 +72,14 @@ type AppOptions struct {
 	jsonnetExtVarStr                []string
 	jsonnetExtVarCode               []string
 	jsonnetLibs                     []string
+	jsonnetTlaStrFile               []string
+	jsonnetTlaCodeFile              []string
+	jsonnetExtVarStrFile            []string
+	jsonnetExtVarCodeFile           []string
+	jsonnetBundler                  bool
+	cuePackages                     []string
+	cueTags                         []string
+	cueExpression                   string
 	kustomizeImages                 []string
 	kustomizeReplicas               []string
 	kustomizeVersion                string
@@ -84,6 +93,8 @@ type AppOptions struct {
 	kustomizeKubeVersion            string
 	kustomizeApiVersions            []string //nolint:revive //FIXME(var-naming)
 	ignoreMissingComponents         bool
+	kustomizeComponents             []string
+	kustomizeBuildOptions           string
 	pluginEnvs                      []string
 	Validate                        bool
 	directoryExclude                string
@@ -144,6 +155,7 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().BoolVar(&opts.helmSkipCrds, "helm-skip-crds", false, "Skip helm crd installation step")
 	command.Flags().BoolVar(&opts.helmSkipSchemaValidation, "helm-skip-schema-validation", false, "Skip helm schema validation step")
 	command.Flags().BoolVar(&opts.helmSkipTests, "helm-skip-tests", false, "Skip helm test manifests installation step")
+	command.Flags().BoolVar(&opts.helmEnableLookups, "helm-enable-lookups", false, "Allow the helm lookup function to read the resources of the destination cluster")
 	command.Flags().StringVar(&opts.helmNamespace, "helm-namespace", "", "Helm namespace to use when running helm template. If not set, use app.spec.destination.namespace")
 	command.Flags().StringVar(&opts.helmKubeVersion, "helm-kube-version", "", "Helm kube-version to use when running helm template. If not set, use the kube version from the destination cluster")
 	command.Flags().StringArrayVar(&opts.helmApiVersions, "helm-api-versions", []string{}, "Helm api-versions (in format [group/]version/kind) to use when running helm template (Can be repeated to set several values: --helm-api-versions traefik.io/v1alpha1/TLSOption --helm-api-versions v1/Service). If not set, use the api-versions from the destination cluster")
@@ -163,9 +175,19 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().StringArrayVar(&opts.jsonnetExtVarStr, "jsonnet-ext-var-str", []string{}, "Jsonnet string ext var")
 	command.Flags().StringArrayVar(&opts.jsonnetExtVarCode, "jsonnet-ext-var-code", []string{}, "Jsonnet ext var")
 	command.Flags().StringArrayVar(&opts.jsonnetLibs, "jsonnet-libs", []string{}, "Additional jsonnet libs (prefixed by repoRoot)")
+	command.Flags().StringArrayVar(&opts.jsonnetTlaStrFile, "jsonnet-tla-str-file", []string{}, "Jsonnet top level string arguments read from files of the repository, relative to the application path (e.g. --jsonnet-tla-str-file name=path)")
+	command.Flags().StringArrayVar(&opts.jsonnetTlaCodeFile, "jsonnet-tla-code-file", []string{}, "Jsonnet top level code arguments read from files of the repository, relative to the application path (e.g. --jsonnet-tla-code-file name=path)")
+	command.Flags().StringArrayVar(&opts.jsonnetExtVarStrFile, "jsonnet-ext-var-str-file", []string{}, "Jsonnet string ext vars read from files of the repository, relative to the application path (e.g. --jsonnet-ext-var-str-file name=path)")
+	command.Flags().StringArrayVar(&opts.jsonnetExtVarCodeFile, "jsonnet-ext-var-code-file", []string{}, "Jsonnet ext vars read from files of the repository, relative to the application path (e.g. --jsonnet-ext-var-code-file name=path)")
+	command.Flags().BoolVar(&opts.jsonnetBundler, "jsonnet-bundler", false, "Install the jsonnet dependencies locked by jsonnetfile.lock.json with jsonnet-bundler before rendering")
+	command.Flags().StringArrayVar(&opts.cuePackages, "cue-package", []string{}, "CUE packages to export, relative to the application path (e.g. --cue-package ./prod)")
+	command.Flags().StringArrayVar(&opts.cueTags, "cue-tag", []string{}, "CUE tags to inject (e.g. --cue-tag env=prod)")
+	command.Flags().StringVar(&opts.cueExpression, "cue-expression", "", "CUE expression selecting the manifests to export")
 	command.Flags().StringArrayVar(&opts.kustomizeImages, "kustomize-image", []string{}, "Kustomize images (e.g. --kustomize-image node:8.15.0 --kustomize-image mysql=mariadb,alpine@sha256:24a0c4b4a4c0eb97a1aabb8e29f18e917d05abfe1b7a7c07857230879ce7d3d)")
 	command.Flags().StringArrayVar(&opts.kustomizeReplicas, "kustomize-replica", []string{}, "Kustomize replicas (e.g. --kustomize-replica my-development=2 --kustomize-replica my-statefulset=4)")
 	command.Flags().BoolVar(&opts.ignoreMissingComponents, "ignore-missing-components", false, "Ignore locally missing component directories when setting Kustomize components")
+	command.Flags().StringArrayVar(&opts.kustomizeComponents, "kustomize-component", []string{}, "Kustomize components to add to the kustomization (can be repeated to add several components: --kustomize-component ../component1 --kustomize-component ../component2)")
+	command.Flags().StringVar(&opts.kustomizeBuildOptions, "kustomize-build-options", "", "Kustomize build options overriding the build options of Argo CD, restricted to the options allowed by the kustomize.allowedBuildOptions setting (e.g. --enable-helm)")
 	command.Flags().StringArrayVar(&opts.pluginEnvs, "plugin-env", []string{}, "Additional plugin envs")
 	command.Flags().BoolVar(&opts.Validate, "validate", true, "Validation of repo and cluster")
 	command.Flags().StringArrayVar(&opts.kustomizeCommonLabels, "kustomize-common-label", []string{}, "Set common labels in Kustomize")
@@ -326,6 +348,8 @@ type kustomizeOpts struct {
 	kubeVersion             string
 	apiVersions             []string
 	ignoreMissingComponents bool
+	components              []string
+	buildOptions            string
 }
 
 func setKustomizeOpt(src *argoappv1.ApplicationSource, opts kustomizeOpts) {
@@ -371,6 +395,12 @@ func setKustomizeOpt(src *argoappv1.ApplicationSource, opts kustomizeOpts) {
 	if opts.ignoreMissingComponents {
 		src.Kustomize.IgnoreMissingComponents = opts.ignoreMissingComponents
 	}
+	if len(opts.components) > 0 {
+		src.Kustomize.Components = opts.components
+	}
+	if opts.buildOptions != "" {
+		src.Kustomize.BuildOptions = opts.buildOptions
+	}
 	for _, image := range opts.images {
 		src.Kustomize.MergeImage(argoappv1.KustomizeImage(image))
 	}
@@ -414,6 +444,7 @@ type helmOpts struct {
 	skipCrds                bool
 	skipSchemaValidation    bool
 	skipTests               bool
+	enableLookups           bool
 	namespace               string
 	kubeVersion             string
 	apiVersions             []string
@@ -453,6 +484,9 @@ func setHelmOpt(src *argoappv1.ApplicationSource, opts helmOpts) {
 	if opts.skipTests {
 		src.Helm.SkipTests = opts.skipTests
 	}
+	if opts.enableLookups {
+		src.Helm.EnableLookups = opts.enableLookups
+	}
 	if opts.namespace != "" {
 		src.Helm.Namespace = opts.namespace
 	}
@@ -506,6 +540,31 @@ func setJsonnetOptExtVar(src *argoappv1.ApplicationSource, jsonnetExtVar []strin
 	}
 }
 
+func setJsonnetOptFile(src *argoappv1.ApplicationSource, tlaParameters []string, code bool) {
+	if src.Directory == nil {
+		src.Directory = &argoappv1.ApplicationSourceDirectory{}
+	}
+	for _, j := range tlaParameters {
+		src.Directory.Jsonnet.TLAs = append(src.Directory.Jsonnet.TLAs, argoappv1.NewJsonnetFileVar(j, code))
+	}
+}
+
+func setJsonnetOptExtVarFile(src *argoappv1.ApplicationSource, jsonnetExtVar []string, code bool) {
+	if src.Directory == nil {
+		src.Directory = &argoappv1.ApplicationSourceDirectory{}
+	}
+	for _, j := range jsonnetExtVar {
+		src.Directory.Jsonnet.ExtVars = append(src.Directory.Jsonnet.ExtVars, argoappv1.NewJsonnetFileVar(j, code))
+	}
+}
+
+func setJsonnetOptBundler(src *argoappv1.ApplicationSource, bundler bool) {
+	if src.Directory == nil {
+		src.Directory = &argoappv1.ApplicationSourceDirectory{}
+	}
+	src.Directory.Jsonnet.Bundler = bundler
+}
+
 func setJsonnetOptLibs(src *argoappv1.ApplicationSource, libs []string) {
 	if src.Directory == nil {
 		src.Directory = &argoappv1.ApplicationSourceDirectory{}
@@ -513,6 +572,23 @@ func setJsonnetOptLibs(src *argoappv1.ApplicationSource, libs []string) {
 	src.Directory.Jsonnet.Libs = append(src.Directory.Jsonnet.Libs, libs...)
 }
 
+type cueOpts struct {
+	packages   []string
+	tags       []string
+	expression *string
+}
+
+func setCUEOpt(src *argoappv1.ApplicationSource, opts cueOpts) {
+	if src.CUE == nil {
+		src.CUE = &argoappv1.ApplicationSourceCUE{}
+	}
+	src.CUE.Packages = append(src.CUE.Packages, opts.packages...)
+	src.CUE.Tags = append(src.CUE.Tags, opts.tags...)
+	if opts.expression != nil {
+		src.CUE.Expression = *opts.expression
+	}
+}
+
 // SetParameterOverrides updates an existing or appends a new parameter override in the application
 // The app is assumed to be a helm app and is expected to be in the form:
 // param=value
@@ -722,6 +798,8 @@ func ConstructSource(source *argoappv1.ApplicationSource, appOpts AppOptions, fl
 			setHelmOpt(source, helmOpts{skipSchemaValidation: appOpts.helmSkipSchemaValidation})
 		case "helm-skip-tests":
 			setHelmOpt(source, helmOpts{skipTests: appOpts.helmSkipTests})
+		case "helm-enable-lookups":
+			setHelmOpt(source, helmOpts{enableLookups: appOpts.helmEnableLookups})
 		case "helm-namespace":
 			setHelmOpt(source, helmOpts{namespace: appOpts.helmNamespace})
 		case "helm-kube-version":
@@ -782,6 +860,10 @@ func ConstructSource(source *argoappv1.ApplicationSource, appOpts AppOptions, fl
 			setKustomizeOpt(source, kustomizeOpts{forceCommonAnnotations: appOpts.kustomizeForceCommonAnnotations})
 		case "ignore-missing-components":
 			setKustomizeOpt(source, kustomizeOpts{ignoreMissingComponents: appOpts.ignoreMissingComponents})
+		case "kustomize-component":
+			setKustomizeOpt(source, kustomizeOpts{components: appOpts.kustomizeComponents})
+		case "kustomize-build-options":
+			setKustomizeOpt(source, kustomizeOpts{buildOptions: appOpts.kustomizeBuildOptions})
 		case "jsonnet-tla-str":
 			setJsonnetOpt(source, appOpts.jsonnetTlaStr, false)
 		case "jsonnet-tla-code":
@@ -792,6 +874,22 @@ func ConstructSource(source *argoappv1.ApplicationSource, appOpts AppOptions, fl
 			setJsonnetOptExtVar(source, appOpts.jsonnetExtVarCode, true)
 		case "jsonnet-libs":
 			setJsonnetOptLibs(source, appOpts.jsonnetLibs)
+		case "jsonnet-tla-str-file":
+			setJsonnetOptFile(source, appOpts.jsonnetTlaStrFile, false)
+		case "jsonnet-tla-code-file":
+			setJsonnetOptFile(source, appOpts.jsonnetTlaCodeFile, true)
+		case "jsonnet-ext-var-str-file":
+			setJsonnetOptExtVarFile(source, appOpts.jsonnetExtVarStrFile, false)
+		case "jsonnet-ext-var-code-file":
+			setJsonnetOptExtVarFile(source, appOpts.jsonnetExtVarCodeFile, true)
+		case "jsonnet-bundler":
+			setJsonnetOptBundler(source, appOpts.jsonnetBundler)
+		case "cue-package":
+			setCUEOpt(source, cueOpts{packages: appOpts.cuePackages})
+		case "cue-tag":
+			setCUEOpt(source, cueOpts{tags: appOpts.cueTags})
+		case "cue-expression":
+			setCUEOpt(source, cueOpts{expression: &appOpts.cueExpression})
 		case "plugin-env":
 			setPluginOptEnvs(source, appOpts.pluginEnvs)
 		case "ref":
@@ -76,6 +76,11 @@ func Test_setHelmOpt(t *testing.T) {
 		setHelmOpt(&src, helmOpts{skipTests: true})
 		assert.True(t, src.Helm.SkipTests)
 	})
+	t.Run("HelmEnableLookups", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		setHelmOpt(&src, helmOpts{enableLookups: true})
+		assert.True(t, src.Helm.EnableLookups)
+	})
 	t.Run("HelmNamespace", func(t *testing.T) {
 		src := v1alpha1.ApplicationSource{}
 		setHelmOpt(&src, helmOpts{namespace: "custom-namespace"})
@@ -135,6 +140,16 @@ func Test_setKustomizeOpt(t *testing.T) {
 		setKustomizeOpt(&src, kustomizeOpts{version: "v0.1"})
 		assert.Equal(t, &v1alpha1.ApplicationSourceKustomize{Version: "v0.1"}, src.Kustomize)
 	})
+	t.Run("Components", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		setKustomizeOpt(&src, kustomizeOpts{components: []string{"../component"}})
+		assert.Equal(t, &v1alpha1.ApplicationSourceKustomize{Components: []string{"../component"}}, src.Kustomize)
+	})
+	t.Run("BuildOptions", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		setKustomizeOpt(&src, kustomizeOpts{buildOptions: "--enable-helm"})
+		assert.Equal(t, &v1alpha1.ApplicationSourceKustomize{BuildOptions: "--enable-helm"}, src.Kustomize)
+	})
 	t.Run("Namespace", func(t *testing.T) {
 		src := v1alpha1.ApplicationSource{}
 		setKustomizeOpt(&src, kustomizeOpts{namespace: "custom-namespace"})
@@ -193,6 +208,31 @@ func Test_setJsonnetOpt(t *testing.T) {
 		setJsonnetOptExtVar(&src, []string{"bar=baz"}, false)
 		assert.Equal(t, []v1alpha1.JsonnetVar{{Name: "foo", Value: "bar"}, {Name: "bar", Value: "baz"}}, src.Directory.Jsonnet.ExtVars)
 	})
+	t.Run("FileSets", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		setJsonnetOptFile(&src, []string{"config=config.jsonnet"}, true)
+		assert.Equal(t, []v1alpha1.JsonnetVar{{Name: "config", File: "config.jsonnet", Code: true}}, src.Directory.Jsonnet.TLAs)
+		setJsonnetOptExtVarFile(&src, []string{"greeting=greeting.txt"}, false)
+		assert.Equal(t, []v1alpha1.JsonnetVar{{Name: "greeting", File: "greeting.txt"}}, src.Directory.Jsonnet.ExtVars)
+	})
+	t.Run("Bundler", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		setJsonnetOptBundler(&src, true)
+		assert.True(t, src.Directory.Jsonnet.Bundler)
+	})
+}
+
+func Test_setCUEOpt(t *testing.T) {
+	src := v1alpha1.ApplicationSource{}
+	setCUEOpt(&src, cueOpts{packages: []string{"./prod"}, tags: []string{"env=prod"}})
+	setCUEOpt(&src, cueOpts{tags: []string{"team=payments"}})
+	expression := "objects"
+	setCUEOpt(&src, cueOpts{expression: &expression})
+	assert.Equal(t, &v1alpha1.ApplicationSourceCUE{
+		Packages:   []string{"./prod"},
+		Tags:       []string{"env=prod", "team=payments"},
+		Expression: "objects",
+	}, src.CUE)
 }
 
 func Test_setPluginOptEnvs(t *testing.T) {
@@ -1,23 +1,22 @@
 package util
 
 import (
-	"context"
 	stderrors "errors"
 	"fmt"
+	"net/url"
 	"os"
 	"sort"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
-	clientcmdapiv1 "k8s.io/client-go/tools/clientcmd/api/v1"
 	"sigs.k8s.io/yaml"
 
 	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/clusterauth"
 	"github.com/argoproj/argo-cd/v3/util/errors"
 )
 
@@ -29,6 +28,32 @@ const (
 	KubeInternalEndpoint ClusterEndpoint = "internal"
 )
 
+const (
+	// k8sAuthCommand is the credential helper shipped with the Argo CD image
+	k8sAuthCommand = "argocd-k8s-auth"
+	// execProviderAPIVersion is the ExecCredential API version produced by argocd-k8s-auth
+	execProviderAPIVersion = "client.authentication.k8s.io/v1beta1"
+	// DefaultAzureLoginMethod is the kubelogin login flow used when none is specified
+	DefaultAzureLoginMethod = "workloadidentity"
+)
+
+const (
+	// ClusterAuthTypeToken authenticates the cluster manager using a service account bearer token
+	ClusterAuthTypeToken = "token"
+	// ClusterAuthTypeCert authenticates the cluster manager using a client certificate issued through the CSR API
+	ClusterAuthTypeCert = "cert"
+)
+
+// ClusterManagerCredentials are the credentials of the manager identity which Argo CD installed on the cluster
+type ClusterManagerCredentials struct {
+	// BearerToken is the service account token of the manager
+	BearerToken string
+	// CertData is the PEM encoded client certificate of the manager
+	CertData []byte
+	// KeyData is the PEM encoded client certificate key of the manager
+	KeyData []byte
+}
+
 func PrintKubeContexts(ca clientcmd.ConfigAccess) {
 	config, err := ca.GetStartingConfig()
 	errors.CheckError(err)
@@ -68,7 +93,7 @@ func PrintKubeContexts(ca clientcmd.ConfigAccess) {
 	}
 }
 
-func NewCluster(name string, namespaces []string, clusterResources bool, conf *rest.Config, managerBearerToken string, awsAuthConf *argoappv1.AWSAuthConfig, execProviderConf *argoappv1.ExecProviderConfig, labels, annotations map[string]string) *argoappv1.Cluster {
+func NewCluster(name string, namespaces []string, clusterResources bool, conf *rest.Config, manager ClusterManagerCredentials, awsAuthConf *argoappv1.AWSAuthConfig, execProviderConf *argoappv1.ExecProviderConfig, labels, annotations map[string]string) *argoappv1.Cluster {
 	tlsClientConfig := argoappv1.TLSClientConfig{
 		Insecure:   conf.Insecure,
 		ServerName: conf.ServerName,
@@ -91,6 +116,11 @@ func NewCluster(name string, namespaces []string, clusterResources bool, conf *r
 		errors.CheckError(err)
 		tlsClientConfig.KeyData = data
 	}
+	// The manager client certificate replaces the credentials of the kubeconfig
+	if len(manager.CertData) > 0 && len(manager.KeyData) > 0 {
+		tlsClientConfig.CertData = manager.CertData
+		tlsClientConfig.KeyData = manager.KeyData
+	}
 
 	clst := argoappv1.Cluster{
 		Server:           conf.Host,
@@ -117,65 +147,251 @@ func NewCluster(name string, namespaces []string, clusterResources bool, conf *r
 	// Even in presence of key/cert credentials
 	// So set bearer token only if the key/cert data is absent
 	if len(tlsClientConfig.CertData) == 0 || len(tlsClientConfig.KeyData) == 0 {
-		clst.Config.BearerToken = managerBearerToken
+		clst.Config.BearerToken = manager.BearerToken
 	}
 
 	return &clst
 }
 
-// GetKubePublicEndpoint returns the kubernetes apiserver endpoint and certificate authority data as published
-// in the kube-public.
-func GetKubePublicEndpoint(client kubernetes.Interface) (string, []byte, error) {
-	clusterInfo, err := client.CoreV1().ConfigMaps("kube-public").Get(context.TODO(), "cluster-info", metav1.GetOptions{})
+type ClusterOptions struct {
+	InCluster                bool
+	Upsert                   bool
+	ServiceAccount           string
+	AwsRoleArn               string
+	AwsProfile               string
+	AwsClusterName           string
+	GcpClusterName           string
+	AzureClusterName         string
+	AzureLoginMethod         string
+	AzureClientID            string
+	AzureTenantID            string
+	AzureEnvironment         string
+	SystemNamespace          string
+	RBACRulesFile            string
+	AuthType                 string
+	CertExpiration           time.Duration
+	Namespaces               []string
+	ClusterResources         bool
+	Name                     string
+	Project                  string
+	Shard                    int64
+	ExecProviderCommand      string
+	ExecProviderArgs         []string
+	ExecProviderEnv          map[string]string
+	ExecProviderAPIVersion   string
+	ExecProviderInstallHint  string
+	ExternalTokenRef         string
+	ExternalCertRef          string
+	ExternalKeyRef           string
+	ExternalCredentialsTTL   time.Duration
+	ImpersonateUser          string
+	ImpersonateGroups        []string
+	RateLimitQPS             int64
+	RateLimitBurst           int64
+	ProxyCredentialsSecret   string
+	TunnelSSH                string
+	TunnelSSHPrivateKeyPath  string
+	TunnelSSHHostKey         string
+	TunnelSSHInsecure        bool
+	ClusterEndpoint          string
+	KubePublicClusterName    string
+	KubePublicBootstrapToken string
+	DisableCompression       bool
+	ProxyUrl                 string //nolint:revive //FIXME(var-naming)
+}
+
+// InClusterEndpoint returns true if ArgoCD should reference the in-cluster
+// endpoint when registering the target cluster.
+func (o ClusterOptions) InClusterEndpoint() bool {
+	return o.InCluster || o.ClusterEndpoint == string(KubeInternalEndpoint)
+}
+
+// RBACRules returns the policy rules to grant to the cluster manager service account, loaded from the RBAC rules
+// file. Returns nil if no file is set, meaning the default rules should be used.
+func (o ClusterOptions) RBACRules() (*clusterauth.ClusterManagerRBACRules, error) {
+	if o.RBACRulesFile == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(o.RBACRulesFile)
 	if err != nil {
-		return "", nil, err
+		return nil, fmt.Errorf("failed to read RBAC rules file: %w", err)
 	}
-	kubeconfig, ok := clusterInfo.Data["kubeconfig"]
-	if !ok {
-		return "", nil, stderrors.New("cluster-info does not contain a public kubeconfig")
+	var rules clusterauth.ClusterManagerRBACRules
+	if err := yaml.UnmarshalStrict(data, &rules); err != nil {
+		return nil, fmt.Errorf("failed to parse RBAC rules file %s: %w", o.RBACRulesFile, err)
 	}
-	// Parse Kubeconfig and get server address
-	config := &clientcmdapiv1.Config{}
-	err = yaml.Unmarshal([]byte(kubeconfig), config)
-	if err != nil {
-		return "", nil, fmt.Errorf("failed to parse cluster-info kubeconfig: %w", err)
+	if err := rules.Validate(o.Namespaces); err != nil {
+		return nil, fmt.Errorf("invalid RBAC rules file %s: %w", o.RBACRulesFile, err)
 	}
-	if len(config.Clusters) == 0 {
-		return "", nil, stderrors.New("cluster-info kubeconfig does not have any clusters")
+	return &rules, nil
+}
+
+// ClusterName returns the name the cluster should be registered under. An explicit name takes
+// precedence over a GKE or AKS cluster name, which in turn takes precedence over the context name.
+func (o ClusterOptions) ClusterName(contextName string) string {
+	switch {
+	case o.Name != "":
+		return o.Name
+	case o.GcpClusterName != "":
+		return o.GcpClusterName
+	case o.AzureClusterName != "":
+		return o.AzureClusterName
 	}
+	return contextName
+}
 
-	endpoint := config.Clusters[0].Cluster.Server
-	certificateAuthorityData := config.Clusters[0].Cluster.CertificateAuthorityData
-	return endpoint, certificateAuthorityData, nil
+// ExecProviderConfig returns the exec provider configuration derived from the options, or nil if
+// the cluster should not be accessed using an exec provider.
+func (o ClusterOptions) ExecProviderConfig() *argoappv1.ExecProviderConfig {
+	switch {
+	case o.GcpClusterName != "":
+		return &argoappv1.ExecProviderConfig{
+			Command:     k8sAuthCommand,
+			Args:        []string{"gcp"},
+			APIVersion:  execProviderAPIVersion,
+			InstallHint: "argocd-k8s-auth is bundled with the Argo CD image and requires GCP Workload Identity or application default credentials",
+		}
+	case o.AzureClusterName != "":
+		loginMethod := o.AzureLoginMethod
+		if loginMethod == "" {
+			loginMethod = DefaultAzureLoginMethod
+		}
+		env := map[string]string{
+			"AAD_LOGIN_METHOD": loginMethod,
+		}
+		if o.AzureClientID != "" {
+			env["AZURE_CLIENT_ID"] = o.AzureClientID
+		}
+		if o.AzureTenantID != "" {
+			env["AZURE_TENANT_ID"] = o.AzureTenantID
+		}
+		if o.AzureEnvironment != "" {
+			env["AAD_ENVIRONMENT_NAME"] = o.AzureEnvironment
+		}
+		return &argoappv1.ExecProviderConfig{
+			Command:     k8sAuthCommand,
+			Args:        []string{"azure"},
+			Env:         env,
+			APIVersion:  execProviderAPIVersion,
+			InstallHint: "argocd-k8s-auth is bundled with the Argo CD image and obtains AKS tokens using kubelogin",
+		}
+	case o.ExecProviderCommand != "":
+		return &argoappv1.ExecProviderConfig{
+			Command:     o.ExecProviderCommand,
+			Args:        o.ExecProviderArgs,
+			Env:         o.ExecProviderEnv,
+			APIVersion:  o.ExecProviderAPIVersion,
+			InstallHint: o.ExecProviderInstallHint,
+		}
+	}
+	return nil
 }
 
-type ClusterOptions struct {
-	InCluster               bool
-	Upsert                  bool
-	ServiceAccount          string
-	AwsRoleArn              string
-	AwsProfile              string
-	AwsClusterName          string
-	SystemNamespace         string
-	Namespaces              []string
-	ClusterResources        bool
-	Name                    string
-	Project                 string
-	Shard                   int64
-	ExecProviderCommand     string
-	ExecProviderArgs        []string
-	ExecProviderEnv         map[string]string
-	ExecProviderAPIVersion  string
-	ExecProviderInstallHint string
-	ClusterEndpoint         string
-	DisableCompression      bool
-	ProxyUrl                string //nolint:revive //FIXME(var-naming)
+// ExternalCredentialsConfig returns the references to the cluster credentials held by an external secret manager,
+// or nil if the credentials should be stored in the cluster secret.
+func (o ClusterOptions) ExternalCredentialsConfig() *argoappv1.ExternalCredentialsConfig {
+	if o.ExternalTokenRef == "" && o.ExternalCertRef == "" && o.ExternalKeyRef == "" {
+		return nil
+	}
+	conf := &argoappv1.ExternalCredentialsConfig{
+		BearerTokenRef: o.ExternalTokenRef,
+		CertDataRef:    o.ExternalCertRef,
+		KeyDataRef:     o.ExternalKeyRef,
+	}
+	if o.ExternalCredentialsTTL > 0 {
+		conf.CacheTTL = o.ExternalCredentialsTTL.String()
+	}
+	return conf
 }
 
-// InClusterEndpoint returns true if ArgoCD should reference the in-cluster
-// endpoint when registering the target cluster.
-func (o ClusterOptions) InClusterEndpoint() bool {
-	return o.InCluster || o.ClusterEndpoint == string(KubeInternalEndpoint)
+// SetExternalCredentials configures the cluster to resolve its credentials from an external secret manager and
+// removes the client certificate taken from the kubeconfig, so no credentials are stored in the cluster secret.
+func SetExternalCredentials(clst *argoappv1.Cluster, conf *argoappv1.ExternalCredentialsConfig) {
+	if conf == nil {
+		return
+	}
+	clst.Config.ExternalCredentialsConfig = conf
+	clst.Config.BearerToken = ""
+	clst.Config.CertData = nil
+	clst.Config.KeyData = nil
+}
+
+// SetProxyCredentials references the secret holding the proxy credentials of the cluster, and removes the
+// credentials embedded in the proxy url, so no credentials are stored in the cluster secret.
+func SetProxyCredentials(clst *argoappv1.Cluster, ref *argoappv1.ProxyCredentialsSecretRef) {
+	if ref == nil {
+		return
+	}
+	clst.Config.ProxyCredentialsSecretRef = ref
+	if u, err := url.Parse(clst.Config.ProxyUrl); err == nil && u.User != nil {
+		u.User = nil
+		clst.Config.ProxyUrl = u.String()
+	}
+}
+
+// ProxyCredentialsSecretRef returns the reference to the secret holding the proxy credentials of the cluster, or nil
+// if the proxy does not require credentials or they are embedded in the proxy url.
+func (o ClusterOptions) ProxyCredentialsSecretRef() *argoappv1.ProxyCredentialsSecretRef {
+	if o.ProxyCredentialsSecret == "" {
+		return nil
+	}
+	return &argoappv1.ProxyCredentialsSecretRef{SecretName: o.ProxyCredentialsSecret}
+}
+
+// SSHTunnelConfig returns the SSH server through which the connections to the cluster are tunneled, or nil if the
+// cluster is reached directly. The SSH server is given as user@host[:port].
+func (o ClusterOptions) SSHTunnelConfig() (*argoappv1.SSHTunnelConfig, error) {
+	if o.TunnelSSH == "" {
+		return nil, nil
+	}
+	user, host, ok := strings.Cut(o.TunnelSSH, "@")
+	if !ok || user == "" || host == "" {
+		return nil, fmt.Errorf("invalid SSH tunnel %q, expected user@host[:port]", o.TunnelSSH)
+	}
+	if o.TunnelSSHPrivateKeyPath == "" {
+		return nil, stderrors.New("--tunnel-ssh-private-key-path is required with --tunnel-ssh")
+	}
+	if o.TunnelSSHHostKey == "" && !o.TunnelSSHInsecure {
+		return nil, stderrors.New("--tunnel-ssh-host-key is required with --tunnel-ssh unless --tunnel-ssh-insecure-ignore-host-key is set")
+	}
+	privateKey, err := os.ReadFile(o.TunnelSSHPrivateKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read SSH private key: %w", err)
+	}
+	return &argoappv1.SSHTunnelConfig{
+		Host:                  host,
+		User:                  user,
+		PrivateKey:            string(privateKey),
+		HostKey:               o.TunnelSSHHostKey,
+		InsecureIgnoreHostKey: o.TunnelSSHInsecure,
+	}, nil
+}
+
+// ImpersonationConfig returns the user and groups to impersonate when connecting to the cluster, or nil if no
+// impersonation is requested. The groups can't be impersonated without a user.
+func (o ClusterOptions) ImpersonationConfig() (*argoappv1.ImpersonationConfig, error) {
+	if o.ImpersonateUser == "" {
+		if len(o.ImpersonateGroups) > 0 {
+			return nil, stderrors.New("--as-group requires --as")
+		}
+		return nil, nil
+	}
+	return &argoappv1.ImpersonationConfig{
+		Username: o.ImpersonateUser,
+		Groups:   o.ImpersonateGroups,
+	}, nil
+}
+
+// RateLimitConfig returns the rate limit of the requests sent to the cluster, or nil if the global client
+// settings should be used.
+func (o ClusterOptions) RateLimitConfig() *argoappv1.ClusterRateLimitConfig {
+	if o.RateLimitQPS <= 0 && o.RateLimitBurst <= 0 {
+		return nil
+	}
+	return &argoappv1.ClusterRateLimitConfig{
+		QPS:   o.RateLimitQPS,
+		Burst: o.RateLimitBurst,
+	}
 }
 
 func AddClusterFlags(command *cobra.Command, opts *ClusterOptions) {
@@ -183,6 +399,12 @@ func AddClusterFlags(command *cobra.Command, opts *ClusterOptions) {
 	command.Flags().StringVar(&opts.AwsClusterName, "aws-cluster-name", "", "AWS Cluster name if set then aws cli eks token command will be used to access cluster")
 	command.Flags().StringVar(&opts.AwsRoleArn, "aws-role-arn", "", "Optional AWS role arn. If set then AWS IAM Authenticator assumes a role to perform cluster operations instead of the default AWS credential provider chain.")
 	command.Flags().StringVar(&opts.AwsProfile, "aws-profile", "", "Optional AWS profile. If set then AWS IAM Authenticator uses this profile to perform cluster operations instead of the default AWS credential provider chain.")
+	command.Flags().StringVar(&opts.GcpClusterName, "gcp-cluster-name", "", "GKE cluster name. If set then argocd-k8s-auth gcp will be used to access the cluster and the name is used as the cluster name unless --name is set")
+	command.Flags().StringVar(&opts.AzureClusterName, "azure-cluster-name", "", "AKS cluster name. If set then argocd-k8s-auth azure (kubelogin) will be used to access the cluster and the name is used as the cluster name unless --name is set")
+	command.Flags().StringVar(&opts.AzureLoginMethod, "azure-login-method", DefaultAzureLoginMethod, "kubelogin login method used with --azure-cluster-name. One of: devicecode, spn, ropc, msi, azurecli, workloadidentity")
+	command.Flags().StringVar(&opts.AzureClientID, "azure-client-id", "", "Optional Azure client ID used with --azure-cluster-name")
+	command.Flags().StringVar(&opts.AzureTenantID, "azure-tenant-id", "", "Optional Azure tenant ID used with --azure-cluster-name")
+	command.Flags().StringVar(&opts.AzureEnvironment, "azure-environment", "", "Optional Azure environment name used with --azure-cluster-name (e.g. AzurePublicCloud)")
 	command.Flags().StringArrayVar(&opts.Namespaces, "namespace", nil, "List of namespaces which are allowed to manage")
 	command.Flags().BoolVar(&opts.ClusterResources, "cluster-resources", false, "Indicates if cluster level resources should be managed. The setting is used only if list of managed namespaces is not empty.")
 	command.Flags().StringVar(&opts.Name, "name", "", "Overwrite the cluster name")
@@ -193,6 +415,21 @@ func AddClusterFlags(command *cobra.Command, opts *ClusterOptions) {
 	command.Flags().StringToStringVar(&opts.ExecProviderEnv, "exec-command-env", nil, "Environment vars to set when running the --exec-command executable")
 	command.Flags().StringVar(&opts.ExecProviderAPIVersion, "exec-command-api-version", "", "Preferred input version of the ExecInfo for the --exec-command executable")
 	command.Flags().StringVar(&opts.ExecProviderInstallHint, "exec-command-install-hint", "", "Text shown to the user when the --exec-command executable doesn't seem to be present")
+	command.Flags().StringVar(&opts.ExternalTokenRef, "external-token-ref", "", "Reference to the bearer token held by an external secret manager, e.g. vault:secret/data/cluster-foo#token or an AWS Secrets Manager secret ARN. The token is resolved by argocd-k8s-auth when connecting to the cluster")
+	command.Flags().StringVar(&opts.ExternalCertRef, "external-cert-ref", "", "Reference to the client certificate held by an external secret manager. Requires --external-key-ref")
+	command.Flags().StringVar(&opts.ExternalKeyRef, "external-key-ref", "", "Reference to the client certificate key held by an external secret manager. Requires --external-cert-ref")
+	command.Flags().DurationVar(&opts.ExternalCredentialsTTL, "external-credentials-ttl", 0, "How long credentials resolved from an external secret manager are cached. Defaults to 5m")
+	command.Flags().StringVar(&opts.ImpersonateUser, "as", "", "Username to impersonate when Argo CD connects to the cluster. The cluster credentials must be allowed to impersonate the user")
+	command.Flags().StringArrayVar(&opts.ImpersonateGroups, "as-group", nil, "Group to impersonate when Argo CD connects to the cluster, this flag can be repeated to specify multiple groups")
+	command.Flags().Int64Var(&opts.RateLimitQPS, "rate-limit-qps", 0, "Maximum number of requests per second Argo CD sends to the cluster. The rate is lowered while the cluster throttles requests. Defaults to the global client QPS")
+	command.Flags().Int64Var(&opts.RateLimitBurst, "rate-limit-burst", 0, "Maximum burst of requests Argo CD sends to the cluster. Defaults to twice the rate limit QPS")
+	command.Flags().StringVar(&opts.ProxyCredentialsSecret, "proxy-credentials-secret", "", "Name of the secret in the Argo CD namespace holding the username and password keys used to authenticate to the cluster proxy. The secret must be labelled with argocd.argoproj.io/secret-type=cluster-proxy")
+	command.Flags().StringVar(&opts.TunnelSSH, "tunnel-ssh", "", "SSH server, as user@host[:port], through which Argo CD tunnels the connections to the cluster API server, e.g. a bastion host of a private cluster")
+	command.Flags().StringVar(&opts.TunnelSSHPrivateKeyPath, "tunnel-ssh-private-key-path", "", "Path to the private key used to authenticate to the --tunnel-ssh server")
+	command.Flags().StringVar(&opts.TunnelSSHHostKey, "tunnel-ssh-host-key", "", "Public key of the --tunnel-ssh server in the authorized_keys format, e.g. an entry of ssh-keyscan")
+	command.Flags().BoolVar(&opts.TunnelSSHInsecure, "tunnel-ssh-insecure-ignore-host-key", false, "Disables the verification of the --tunnel-ssh server host key")
 	command.Flags().StringVar(&opts.ClusterEndpoint, "cluster-endpoint", "", "Cluster endpoint to use. Can be one of the following: 'kubeconfig', 'kube-public', or 'internal'.")
+	command.Flags().StringVar(&opts.KubePublicClusterName, "kube-public-cluster-name", "", "Name of the cluster to use with --cluster-endpoint=kube-public, if the cluster-info kubeconfig lists several clusters")
+	command.Flags().StringVar(&opts.KubePublicBootstrapToken, "kube-public-bootstrap-token", "", "Bootstrap token, as <token-id>.<token-secret>, which must have signed the cluster-info kubeconfig used with --cluster-endpoint=kube-public. It is stored with the cluster, so that the CA data refreshed from kube-public is verified with it as well")
 	command.Flags().BoolVar(&opts.DisableCompression, "disable-compression", false, "Bypasses automatic GZip compression requests to the server")
 }
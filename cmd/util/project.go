@@ -2,6 +2,7 @@ package util
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"log"
 	"net/url"
@@ -26,6 +27,7 @@ type ProjectOpts struct {
 	Sources                    []string
 	SignatureKeys              []string
 	SourceNamespaces           []string
+	ParentProject              string
 
 	orphanedResourcesEnabled   bool
 	orphanedResourcesWarn      bool
@@ -40,7 +42,7 @@ func AddProjFlags(command *cobra.Command, opts *ProjectOpts) {
 	command.Flags().StringArrayVarP(&opts.destinations, "dest", "d", []string{},
 		"Permitted destination server and namespace (e.g. https://192.168.99.100:8443,default)")
 	command.Flags().StringArrayVarP(&opts.Sources, "src", "s", []string{}, "Permitted source repository URL")
-	command.Flags().StringSliceVar(&opts.SignatureKeys, "signature-keys", []string{}, "GnuPG public key IDs for commit signature verification")
+	command.Flags().StringSliceVar(&opts.SignatureKeys, "signature-keys", []string{}, "GnuPG public key IDs or SSH key fingerprints for commit signature verification")
 	command.Flags().BoolVar(&opts.orphanedResourcesEnabled, "orphaned-resources", false, "Enables orphaned resources monitoring")
 	command.Flags().BoolVar(&opts.orphanedResourcesWarn, "orphaned-resources-warn", false, "Specifies if applications should have a warning condition when orphaned resources detected")
 	command.Flags().StringArrayVar(&opts.allowedClusterResources, "allow-cluster-resource", []string{}, "List of allowed cluster level resources")
@@ -50,6 +52,7 @@ func AddProjFlags(command *cobra.Command, opts *ProjectOpts) {
 	command.Flags().StringSliceVar(&opts.SourceNamespaces, "source-namespaces", []string{}, "List of source namespaces for applications")
 	command.Flags().StringArrayVar(&opts.destinationServiceAccounts, "dest-service-accounts", []string{},
 		"Destination server, namespace and target service account (e.g. https://192.168.99.100:8443,default,default-sa)")
+	command.Flags().StringVar(&opts.ParentProject, "parent", "", "Parent project whose destinations, source repositories, roles and allowed resources are inherited")
 }
 
 func getGroupKindList(values []string) []metav1.GroupKind {
@@ -115,10 +118,11 @@ func (opts *ProjectOpts) GetDestinationServiceAccounts() []v1alpha1.ApplicationD
 func (opts *ProjectOpts) GetSignatureKeys() []v1alpha1.SignatureKey {
 	signatureKeys := make([]v1alpha1.SignatureKey, 0)
 	for _, keyStr := range opts.SignatureKeys {
-		if !gpg.IsShortKeyID(keyStr) && !gpg.IsLongKeyID(keyStr) {
-			log.Fatalf("'%s' is not a valid GnuPG key ID", keyStr)
+		keyID := gpg.SignatureKeyID(keyStr)
+		if keyID == "" {
+			log.Fatalf("'%s' is not a valid GnuPG key ID or SSH key fingerprint", keyStr)
 		}
-		signatureKeys = append(signatureKeys, v1alpha1.SignatureKey{KeyID: gpg.KeyID(keyStr)})
+		signatureKeys = append(signatureKeys, v1alpha1.SignatureKey{KeyID: keyID})
 	}
 	return signatureKeys
 }
@@ -186,6 +190,8 @@ func SetProjSpecOptions(flags *pflag.FlagSet, spec *v1alpha1.AppProjectSpec, pro
 			spec.SourceNamespaces = projOpts.GetSourceNamespaces()
 		case "dest-service-accounts":
 			spec.DestinationServiceAccounts = projOpts.GetDestinationServiceAccounts()
+		case "parent":
+			spec.ParentProject = projOpts.ParentProject
 		}
 	})
 	if flags.Changed("orphaned-resources") || flags.Changed("orphaned-resources-warn") {
@@ -230,3 +236,47 @@ func ConstructAppProj(fileURL string, args []string, opts ProjectOpts, c *cobra.
 	SetProjSpecOptions(c.Flags(), &proj.Spec, &opts)
 	return &proj, nil
 }
+
+// ProjectTemplateParams holds the name and the template parameters of a project created from a project template
+type ProjectTemplateParams struct {
+	Name       string            `json:"name"`
+	Parameters map[string]string `json:"parameters,omitempty"`
+}
+
+// ConstructProjTemplateParams returns the projects to create from a project template, either the project given by the
+// arguments with the parameters given as NAME=VALUE, or the projects listed in the given file or URL
+func ConstructProjTemplateParams(paramsFileURL string, args []string, params []string) ([]ProjectTemplateParams, error) {
+	if paramsFileURL != "" {
+		if len(args) > 0 || len(params) > 0 {
+			return nil, errors.New("the project name and the template parameters can't be given with a template parameters file")
+		}
+		var projects []ProjectTemplateParams
+		parsedURL, err := url.ParseRequestURI(paramsFileURL)
+		if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") {
+			err = config.UnmarshalLocalFile(paramsFileURL, &projects)
+		} else {
+			err = config.UnmarshalRemoteFile(paramsFileURL, &projects)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading template parameters from uri: %w", err)
+		}
+		for _, p := range projects {
+			if p.Name == "" {
+				return nil, errors.New("the name of each project of the template parameters file is required")
+			}
+		}
+		return projects, nil
+	}
+	if len(args) != 1 {
+		return nil, errors.New("a project name is required")
+	}
+	project := ProjectTemplateParams{Name: args[0], Parameters: map[string]string{}}
+	for _, param := range params {
+		name, value, ok := strings.Cut(param, "=")
+		if !ok {
+			return nil, fmt.Errorf("template parameter '%s' is not in the NAME=VALUE format", param)
+		}
+		project.Parameters[name] = value
+	}
+	return []ProjectTemplateParams{project}, nil
+}
@@ -1,9 +1,12 @@
 package util
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -46,3 +49,43 @@ func TestProjectOpts_GetDestinationServiceAccounts(t *testing.T) {
 		}, opts.GetDestinationServiceAccounts(),
 	)
 }
+
+func TestConstructProjTemplateParams(t *testing.T) {
+	t.Run("Arguments", func(t *testing.T) {
+		projects, err := ConstructProjTemplateParams("", []string{"payments"}, []string{"group=payments-admins", "clusters=https://a,https://b"})
+		require.NoError(t, err)
+		assert.Equal(t, []ProjectTemplateParams{{
+			Name:       "payments",
+			Parameters: map[string]string{"group": "payments-admins", "clusters": "https://a,https://b"},
+		}}, projects)
+	})
+
+	t.Run("InvalidParameter", func(t *testing.T) {
+		_, err := ConstructProjTemplateParams("", []string{"payments"}, []string{"group"})
+		assert.EqualError(t, err, "template parameter 'group' is not in the NAME=VALUE format")
+	})
+
+	t.Run("MissingName", func(t *testing.T) {
+		_, err := ConstructProjTemplateParams("", nil, nil)
+		assert.EqualError(t, err, "a project name is required")
+	})
+
+	t.Run("File", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "projects.yaml")
+		require.NoError(t, os.WriteFile(file, []byte(`
+- name: payments
+  parameters:
+    group: payments-admins
+- name: billing
+`), 0o644))
+		projects, err := ConstructProjTemplateParams(file, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []ProjectTemplateParams{
+			{Name: "payments", Parameters: map[string]string{"group": "payments-admins"}},
+			{Name: "billing"},
+		}, projects)
+
+		_, err = ConstructProjTemplateParams(file, []string{"payments"}, nil)
+		assert.EqualError(t, err, "the project name and the template parameters can't be given with a template parameters file")
+	})
+}
@@ -17,6 +17,10 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/io"
 )
 
+// ProtocolVersion is the version of the protocol between the repo-server and the cmp-servers. The version 3 advertises
+// the capabilities of the plugins and streams the generated manifests.
+const ProtocolVersion = 3
+
 // MaxGRPCMessageSize contains max grpc message size
 var MaxGRPCMessageSize = env.ParseNumFromEnv(common.EnvGRPCMaxSizeMB, 100, 0, math.MaxInt32) * 1024 * 1024
 
@@ -317,6 +317,55 @@ func (m *ManifestResponse) GetSourceType() string {
 	return ""
 }
 
+// ManifestStreamResponse holds one of the manifests generated by the plugin, sent as soon as the generate command
+// outputs it.
+type ManifestStreamResponse struct {
+	Manifest             string   `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ManifestStreamResponse) Reset()         { *m = ManifestStreamResponse{} }
+func (m *ManifestStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*ManifestStreamResponse) ProtoMessage()    {}
+func (*ManifestStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21875a7079a06ed, []int{4}
+}
+func (m *ManifestStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManifestStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ManifestStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ManifestStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManifestStreamResponse.Merge(m, src)
+}
+func (m *ManifestStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManifestStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManifestStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManifestStreamResponse proto.InternalMessageInfo
+
+func (m *ManifestStreamResponse) GetManifest() string {
+	if m != nil {
+		return m.Manifest
+	}
+	return ""
+}
+
 type RepositoryResponse struct {
 	IsSupported          bool     `protobuf:"varint,1,opt,name=isSupported,proto3" json:"isSupported,omitempty"`
 	IsDiscoveryEnabled   bool     `protobuf:"varint,2,opt,name=isDiscoveryEnabled,proto3" json:"isDiscoveryEnabled,omitempty"`
@@ -329,7 +378,7 @@ func (m *RepositoryResponse) Reset()         { *m = RepositoryResponse{} }
 func (m *RepositoryResponse) String() string { return proto.CompactTextString(m) }
 func (*RepositoryResponse) ProtoMessage()    {}
 func (*RepositoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b21875a7079a06ed, []int{4}
+	return fileDescriptor_b21875a7079a06ed, []int{5}
 }
 func (m *RepositoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,7 +434,7 @@ func (m *ParametersAnnouncementResponse) Reset()         { *m = ParametersAnnoun
 func (m *ParametersAnnouncementResponse) String() string { return proto.CompactTextString(m) }
 func (*ParametersAnnouncementResponse) ProtoMessage()    {}
 func (*ParametersAnnouncementResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b21875a7079a06ed, []int{5}
+	return fileDescriptor_b21875a7079a06ed, []int{6}
 }
 func (m *ParametersAnnouncementResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -432,7 +481,7 @@ func (m *File) Reset()         { *m = File{} }
 func (m *File) String() string { return proto.CompactTextString(m) }
 func (*File) ProtoMessage()    {}
 func (*File) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b21875a7079a06ed, []int{6}
+	return fileDescriptor_b21875a7079a06ed, []int{7}
 }
 func (m *File) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -470,18 +519,23 @@ func (m *File) GetChunk() []byte {
 
 // CheckPluginConfigurationResponse contains a list of plugin configuration flags.
 type CheckPluginConfigurationResponse struct {
-	IsDiscoveryConfigured bool     `protobuf:"varint,1,opt,name=isDiscoveryConfigured,proto3" json:"isDiscoveryConfigured,omitempty"`
-	ProvideGitCreds       bool     `protobuf:"varint,2,opt,name=provideGitCreds,proto3" json:"provideGitCreds,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
-	XXX_unrecognized      []byte   `json:"-"`
-	XXX_sizecache         int32    `json:"-"`
+	IsDiscoveryConfigured bool `protobuf:"varint,1,opt,name=isDiscoveryConfigured,proto3" json:"isDiscoveryConfigured,omitempty"`
+	ProvideGitCreds       bool `protobuf:"varint,2,opt,name=provideGitCreds,proto3" json:"provideGitCreds,omitempty"`
+	// protocolVersion is the version of the protocol implemented by the cmp-server. It is 0 for the cmp-servers
+	// predating the versioning of the protocol, which only support the GenerateManifest RPC to generate manifests.
+	ProtocolVersion int32 `protobuf:"varint,3,opt,name=protocolVersion,proto3" json:"protocolVersion,omitempty"`
+	// capabilities are the capabilities of the plugin, only set from the protocol version 3
+	Capabilities         *PluginCapabilities `protobuf:"bytes,4,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *CheckPluginConfigurationResponse) Reset()         { *m = CheckPluginConfigurationResponse{} }
 func (m *CheckPluginConfigurationResponse) String() string { return proto.CompactTextString(m) }
 func (*CheckPluginConfigurationResponse) ProtoMessage()    {}
 func (*CheckPluginConfigurationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b21875a7079a06ed, []int{7}
+	return fileDescriptor_b21875a7079a06ed, []int{8}
 }
 func (m *CheckPluginConfigurationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -524,63 +578,163 @@ func (m *CheckPluginConfigurationResponse) GetProvideGitCreds() bool {
 	return false
 }
 
+func (m *CheckPluginConfigurationResponse) GetProtocolVersion() int32 {
+	if m != nil {
+		return m.ProtocolVersion
+	}
+	return 0
+}
+
+func (m *CheckPluginConfigurationResponse) GetCapabilities() *PluginCapabilities {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
+// PluginCapabilities defines the capabilities advertised by a plugin.
+type PluginCapabilities struct {
+	// discover is whether the plugin can discover the applications it supports
+	Discover bool `protobuf:"varint,1,opt,name=discover,proto3" json:"discover,omitempty"`
+	// parameters is whether the plugin announces parameters, statically or dynamically
+	Parameters bool `protobuf:"varint,2,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	// generate is whether the plugin generates manifests
+	Generate bool `protobuf:"varint,3,opt,name=generate,proto3" json:"generate,omitempty"`
+	// generateStream is whether the plugin streams the generated manifests with the GenerateManifestStream RPC
+	GenerateStream       bool     `protobuf:"varint,4,opt,name=generateStream,proto3" json:"generateStream,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PluginCapabilities) Reset()         { *m = PluginCapabilities{} }
+func (m *PluginCapabilities) String() string { return proto.CompactTextString(m) }
+func (*PluginCapabilities) ProtoMessage()    {}
+func (*PluginCapabilities) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21875a7079a06ed, []int{9}
+}
+func (m *PluginCapabilities) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PluginCapabilities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PluginCapabilities.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PluginCapabilities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PluginCapabilities.Merge(m, src)
+}
+func (m *PluginCapabilities) XXX_Size() int {
+	return m.Size()
+}
+func (m *PluginCapabilities) XXX_DiscardUnknown() {
+	xxx_messageInfo_PluginCapabilities.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PluginCapabilities proto.InternalMessageInfo
+
+func (m *PluginCapabilities) GetDiscover() bool {
+	if m != nil {
+		return m.Discover
+	}
+	return false
+}
+
+func (m *PluginCapabilities) GetParameters() bool {
+	if m != nil {
+		return m.Parameters
+	}
+	return false
+}
+
+func (m *PluginCapabilities) GetGenerate() bool {
+	if m != nil {
+		return m.Generate
+	}
+	return false
+}
+
+func (m *PluginCapabilities) GetGenerateStream() bool {
+	if m != nil {
+		return m.GenerateStream
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*AppStreamRequest)(nil), "plugin.AppStreamRequest")
 	proto.RegisterType((*ManifestRequestMetadata)(nil), "plugin.ManifestRequestMetadata")
 	proto.RegisterType((*EnvEntry)(nil), "plugin.EnvEntry")
 	proto.RegisterType((*ManifestResponse)(nil), "plugin.ManifestResponse")
+	proto.RegisterType((*ManifestStreamResponse)(nil), "plugin.ManifestStreamResponse")
 	proto.RegisterType((*RepositoryResponse)(nil), "plugin.RepositoryResponse")
 	proto.RegisterType((*ParametersAnnouncementResponse)(nil), "plugin.ParametersAnnouncementResponse")
 	proto.RegisterType((*File)(nil), "plugin.File")
 	proto.RegisterType((*CheckPluginConfigurationResponse)(nil), "plugin.CheckPluginConfigurationResponse")
+	proto.RegisterType((*PluginCapabilities)(nil), "plugin.PluginCapabilities")
 }
 
 func init() { proto.RegisterFile("cmpserver/plugin/plugin.proto", fileDescriptor_b21875a7079a06ed) }
 
 var fileDescriptor_b21875a7079a06ed = []byte{
-	// 668 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x9b, 0xb4, 0x4d, 0x26, 0x95, 0x1a, 0xad, 0xa0, 0x98, 0xd0, 0x86, 0xe0, 0x03, 0xca,
-	0x05, 0x47, 0x4a, 0x7b, 0x45, 0xa2, 0x2d, 0xa1, 0x15, 0x28, 0x28, 0x72, 0xb9, 0xc0, 0x01, 0x69,
-	0xe3, 0x4c, 0x92, 0xa5, 0xf6, 0xee, 0xb2, 0x5e, 0x5b, 0x0a, 0x5c, 0x10, 0x2f, 0xc3, 0xab, 0x70,
-	0xe4, 0x11, 0x50, 0x5f, 0x83, 0x0b, 0xf2, 0xda, 0x4e, 0xa2, 0x36, 0x6d, 0x4f, 0x9e, 0xbf, 0xfd,
-	0xfc, 0xcd, 0xec, 0x37, 0x0b, 0x07, 0x7e, 0x28, 0x23, 0x54, 0x09, 0xaa, 0xae, 0x0c, 0xe2, 0x29,
-	0xe3, 0xf9, 0xc7, 0x95, 0x4a, 0x68, 0x41, 0xb6, 0x32, 0xaf, 0xd9, 0x9f, 0x32, 0x3d, 0x8b, 0x47,
-	0xae, 0x2f, 0xc2, 0x2e, 0x55, 0x53, 0x21, 0x95, 0xf8, 0x62, 0x8c, 0x17, 0xfe, 0xb8, 0x9b, 0x1c,
-	0x76, 0x15, 0x4a, 0x91, 0xc3, 0x18, 0x93, 0x69, 0xa1, 0xe6, 0x2b, 0x66, 0x06, 0xd7, 0x7c, 0x32,
-	0x15, 0x62, 0x1a, 0x60, 0xd7, 0x78, 0xa3, 0x78, 0xd2, 0xc5, 0x50, 0xea, 0x3c, 0xe9, 0xfc, 0xb0,
-	0xa0, 0x71, 0x2c, 0xe5, 0x85, 0x56, 0x48, 0x43, 0x0f, 0xbf, 0xc6, 0x18, 0x69, 0xf2, 0x12, 0xaa,
-	0x21, 0x6a, 0x3a, 0xa6, 0x9a, 0xda, 0x56, 0xdb, 0xea, 0xd4, 0x7b, 0x4f, 0xdd, 0x9c, 0xe1, 0x80,
-	0x72, 0x36, 0xc1, 0x48, 0xe7, 0xa5, 0x83, 0xbc, 0xec, 0xbc, 0xe4, 0x2d, 0x8e, 0x10, 0x07, 0x2a,
-	0x13, 0x16, 0xa0, 0xbd, 0x61, 0x8e, 0xee, 0x14, 0x47, 0xdf, 0xb0, 0x00, 0xcf, 0x4b, 0x9e, 0xc9,
-	0x9d, 0xd4, 0x60, 0x5b, 0x65, 0x10, 0xce, 0x2f, 0x0b, 0x1e, 0xdd, 0x02, 0x4b, 0x6c, 0xd8, 0xa6,
-	0x52, 0xbe, 0xa7, 0x21, 0x1a, 0x22, 0x35, 0xaf, 0x70, 0x49, 0x0b, 0x80, 0x4a, 0xe9, 0x61, 0x30,
-	0xa4, 0x7a, 0x66, 0x7e, 0x55, 0xf3, 0x56, 0x22, 0xa4, 0x09, 0x55, 0x7f, 0x86, 0xfe, 0x65, 0x14,
-	0x87, 0x76, 0xd9, 0x64, 0x17, 0x3e, 0x21, 0x50, 0x89, 0xd8, 0x37, 0xb4, 0x2b, 0x6d, 0xab, 0x53,
-	0xf6, 0x8c, 0x4d, 0x1c, 0x28, 0x23, 0x4f, 0xec, 0xcd, 0x76, 0xb9, 0x53, 0xef, 0x35, 0x0a, 0xce,
-	0x7d, 0x9e, 0xf4, 0xb9, 0x56, 0x73, 0x2f, 0x4d, 0x3a, 0x47, 0x50, 0x2d, 0x02, 0x29, 0x06, 0x5f,
-	0xd2, 0x32, 0x36, 0x79, 0x00, 0x9b, 0x09, 0x0d, 0x62, 0xcc, 0xe9, 0x64, 0x8e, 0x33, 0x84, 0xc6,
-	0xb2, 0xbd, 0x48, 0x0a, 0x1e, 0x21, 0xd9, 0x87, 0x5a, 0x98, 0xc7, 0x22, 0xdb, 0x6a, 0x97, 0x3b,
-	0x35, 0x6f, 0x19, 0x48, 0x7b, 0x8b, 0x44, 0xac, 0x7c, 0xfc, 0x30, 0x97, 0x05, 0xd8, 0x4a, 0xc4,
-	0x99, 0x00, 0xf1, 0x16, 0xb7, 0xbc, 0xc0, 0x6c, 0x43, 0x9d, 0x45, 0x17, 0xb1, 0x94, 0x42, 0x69,
-	0x1c, 0x1b, 0x62, 0x55, 0x6f, 0x35, 0x44, 0x5c, 0x20, 0x2c, 0x7a, 0xcd, 0x22, 0x5f, 0x24, 0xa8,
-	0xe6, 0x7d, 0x4e, 0x47, 0x01, 0x8e, 0x0d, 0x7e, 0xd5, 0x5b, 0x93, 0x71, 0xbe, 0x43, 0x6b, 0x48,
-	0x15, 0x0d, 0x51, 0xa3, 0x8a, 0x8e, 0x39, 0x17, 0x31, 0xf7, 0x31, 0x44, 0xbe, 0xec, 0xe3, 0x23,
-	0xec, 0xc9, 0xa2, 0x62, 0xb5, 0x20, 0x6b, 0xaa, 0xde, 0x7b, 0xe6, 0xae, 0xc8, 0x71, 0xb8, 0xae,
-	0xd2, 0xbb, 0x05, 0xc0, 0xd9, 0x87, 0x4a, 0xaa, 0x98, 0x74, 0xa8, 0xfe, 0x2c, 0xe6, 0x97, 0xa6,
-	0xa1, 0x1d, 0x2f, 0x73, 0x9c, 0x9f, 0x16, 0xb4, 0x4f, 0xd3, 0xfb, 0x1c, 0x9a, 0x8b, 0x3a, 0x15,
-	0x7c, 0xc2, 0xa6, 0xb1, 0xa2, 0x9a, 0x09, 0xbe, 0x60, 0x77, 0x04, 0x0f, 0x57, 0xba, 0x2a, 0x6a,
-	0x16, 0xb3, 0x59, 0x9f, 0x24, 0x1d, 0xd8, 0x95, 0x4a, 0x24, 0x6c, 0x8c, 0x67, 0x4c, 0x9f, 0x2a,
-	0x1c, 0x47, 0xf9, 0x88, 0xae, 0x87, 0x7b, 0xff, 0x36, 0xe0, 0x20, 0x3b, 0x38, 0xa0, 0x9c, 0x4e,
-	0x0d, 0xf1, 0x8c, 0xcf, 0x05, 0xaa, 0x84, 0xf9, 0x48, 0xde, 0x42, 0xe3, 0x0c, 0x39, 0x2a, 0xaa,
-	0xb1, 0xd0, 0x00, 0xb1, 0x0b, 0x71, 0x5d, 0xdf, 0xbb, 0xa6, 0x7d, 0x73, 0xcb, 0xb2, 0x4e, 0x9c,
-	0x52, 0xc7, 0x22, 0x9f, 0xc1, 0xbe, 0xad, 0x63, 0xb2, 0xe7, 0x66, 0x4b, 0xee, 0x16, 0x4b, 0xee,
-	0xf6, 0xd3, 0x25, 0x6f, 0x76, 0x0a, 0xc4, 0xfb, 0x66, 0xe5, 0x94, 0xc8, 0x3b, 0xd8, 0x1d, 0x50,
-	0xed, 0xcf, 0x96, 0xd2, 0xba, 0x83, 0x6a, 0xb3, 0xc8, 0xdc, 0x14, 0xa2, 0x21, 0x4b, 0xe1, 0xf1,
-	0x19, 0xea, 0xf5, 0xea, 0xb9, 0x03, 0xf6, 0x79, 0x91, 0xb9, 0x5b, 0x77, 0xe9, 0x2f, 0x4e, 0x5e,
-	0xfd, 0xbe, 0x6a, 0x59, 0x7f, 0xae, 0x5a, 0xd6, 0xdf, 0xab, 0x96, 0xf5, 0xa9, 0x77, 0xcf, 0x63,
-	0xb9, 0x7c, 0x72, 0xa9, 0x64, 0x7e, 0xc0, 0x90, 0xeb, 0xd1, 0x96, 0x99, 0xd6, 0xe1, 0xff, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xb0, 0x8d, 0xb8, 0x47, 0x90, 0x05, 0x00, 0x00,
+	// 794 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0x4f, 0x6f, 0x23, 0x35,
+	0x14, 0xef, 0x90, 0x76, 0x37, 0x79, 0xa9, 0xd8, 0xca, 0x82, 0x32, 0x84, 0xdd, 0x10, 0xe6, 0xb0,
+	0xca, 0x85, 0x04, 0x65, 0x7b, 0x05, 0xb1, 0x5b, 0x42, 0x57, 0xa0, 0xa2, 0xc8, 0x45, 0x08, 0x38,
+	0x20, 0x39, 0x93, 0x97, 0xc4, 0xec, 0x8c, 0x6d, 0x6c, 0x4f, 0xa4, 0xc2, 0x85, 0x0f, 0xc1, 0x77,
+	0xe0, 0xab, 0x70, 0xe4, 0xc2, 0x1d, 0xf5, 0xcc, 0x87, 0x40, 0xe3, 0xb1, 0x27, 0xb3, 0x69, 0x9b,
+	0x3d, 0xe5, 0xfd, 0xfd, 0xe5, 0xf7, 0x9e, 0x7f, 0xf6, 0xc0, 0x93, 0x34, 0x57, 0x06, 0xf5, 0x06,
+	0xf5, 0x58, 0x65, 0xc5, 0x8a, 0x0b, 0xff, 0x33, 0x52, 0x5a, 0x5a, 0x49, 0x1e, 0x54, 0x5e, 0x6f,
+	0xba, 0xe2, 0x76, 0x5d, 0xcc, 0x47, 0xa9, 0xcc, 0xc7, 0x4c, 0xaf, 0xa4, 0xd2, 0xf2, 0x67, 0x67,
+	0x7c, 0x9c, 0x2e, 0xc6, 0x9b, 0x67, 0x63, 0x8d, 0x4a, 0x7a, 0x18, 0x67, 0x72, 0x2b, 0xf5, 0x75,
+	0xc3, 0xac, 0xe0, 0x7a, 0x1f, 0xac, 0xa4, 0x5c, 0x65, 0x38, 0x76, 0xde, 0xbc, 0x58, 0x8e, 0x31,
+	0x57, 0xd6, 0x27, 0x93, 0xdf, 0x23, 0x38, 0x79, 0xae, 0xd4, 0x95, 0xd5, 0xc8, 0x72, 0x8a, 0xbf,
+	0x14, 0x68, 0x2c, 0xf9, 0x14, 0xda, 0x39, 0x5a, 0xb6, 0x60, 0x96, 0xc5, 0xd1, 0x20, 0x1a, 0x76,
+	0x27, 0x1f, 0x8e, 0x3c, 0xc3, 0x4b, 0x26, 0xf8, 0x12, 0x8d, 0xf5, 0xa5, 0x97, 0xbe, 0xec, 0xe5,
+	0x01, 0xad, 0x5b, 0x48, 0x02, 0x87, 0x4b, 0x9e, 0x61, 0xfc, 0x96, 0x6b, 0x3d, 0x0e, 0xad, 0x5f,
+	0xf2, 0x0c, 0x5f, 0x1e, 0x50, 0x97, 0x7b, 0xd1, 0x81, 0x87, 0xba, 0x82, 0x48, 0xfe, 0x8c, 0xe0,
+	0xbd, 0x7b, 0x60, 0x49, 0x0c, 0x0f, 0x99, 0x52, 0xdf, 0xb0, 0x1c, 0x1d, 0x91, 0x0e, 0x0d, 0x2e,
+	0xe9, 0x03, 0x30, 0xa5, 0x28, 0x66, 0x33, 0x66, 0xd7, 0xee, 0xaf, 0x3a, 0xb4, 0x11, 0x21, 0x3d,
+	0x68, 0xa7, 0x6b, 0x4c, 0x5f, 0x99, 0x22, 0x8f, 0x5b, 0x2e, 0x5b, 0xfb, 0x84, 0xc0, 0xa1, 0xe1,
+	0xbf, 0x62, 0x7c, 0x38, 0x88, 0x86, 0x2d, 0xea, 0x6c, 0x92, 0x40, 0x0b, 0xc5, 0x26, 0x3e, 0x1a,
+	0xb4, 0x86, 0xdd, 0xc9, 0x49, 0xe0, 0x3c, 0x15, 0x9b, 0xa9, 0xb0, 0xfa, 0x9a, 0x96, 0xc9, 0xe4,
+	0x0c, 0xda, 0x21, 0x50, 0x62, 0x88, 0x2d, 0x2d, 0x67, 0x93, 0x77, 0xe0, 0x68, 0xc3, 0xb2, 0x02,
+	0x3d, 0x9d, 0xca, 0x49, 0x66, 0x70, 0xb2, 0x1d, 0xcf, 0x28, 0x29, 0x0c, 0x92, 0xc7, 0xd0, 0xc9,
+	0x7d, 0xcc, 0xc4, 0xd1, 0xa0, 0x35, 0xec, 0xd0, 0x6d, 0xa0, 0x9c, 0xcd, 0xc8, 0x42, 0xa7, 0xf8,
+	0xed, 0xb5, 0x0a, 0x60, 0x8d, 0x48, 0x72, 0x06, 0xa7, 0x01, 0x31, 0x1c, 0x9c, 0xc7, 0xed, 0x41,
+	0x3b, 0xc0, 0x78, 0x66, 0xb5, 0x9f, 0x2c, 0x81, 0xd0, 0x5a, 0x1b, 0x75, 0xc7, 0x00, 0xba, 0xdc,
+	0x5c, 0x15, 0x4a, 0x49, 0x6d, 0x71, 0xe1, 0x9a, 0xda, 0xb4, 0x19, 0x22, 0x23, 0x20, 0xdc, 0x7c,
+	0xc1, 0x4d, 0x2a, 0x37, 0xa8, 0xaf, 0xa7, 0x82, 0xcd, 0x33, 0x5c, 0x38, 0x56, 0x6d, 0x7a, 0x47,
+	0x26, 0xf9, 0x0d, 0xfa, 0x33, 0xa6, 0x59, 0x8e, 0x16, 0xb5, 0x79, 0x2e, 0x84, 0x2c, 0x44, 0x8a,
+	0x39, 0x8a, 0xed, 0xf4, 0x3f, 0xc0, 0xa9, 0x0a, 0x15, 0xcd, 0x82, 0x6a, 0x15, 0xdd, 0xc9, 0x47,
+	0xa3, 0x86, 0x88, 0x67, 0x77, 0x55, 0xd2, 0x7b, 0x00, 0x92, 0xc7, 0x70, 0x58, 0xea, 0xac, 0x3c,
+	0x8a, 0x74, 0x5d, 0x88, 0x57, 0x6e, 0xa0, 0x63, 0x5a, 0x39, 0xc9, 0x7f, 0x11, 0x0c, 0xce, 0x4b,
+	0x15, 0xcc, 0xdc, 0xf1, 0x9e, 0x4b, 0xb1, 0xe4, 0xab, 0x42, 0x33, 0xcb, 0xa5, 0xa8, 0xd9, 0x9d,
+	0xc1, 0xbb, 0x8d, 0xa9, 0x42, 0x4d, 0xbd, 0x9b, 0xbb, 0x93, 0x64, 0x08, 0x8f, 0x94, 0x96, 0x1b,
+	0xbe, 0xc0, 0x0b, 0x6e, 0xcf, 0x35, 0x2e, 0x8c, 0x5f, 0xd1, 0x6e, 0xd8, 0x57, 0x5a, 0x99, 0xca,
+	0xec, 0x3b, 0xd4, 0x86, 0x4b, 0xe1, 0x04, 0x7a, 0x44, 0x77, 0xc3, 0xe4, 0x33, 0x38, 0x4e, 0x99,
+	0x62, 0x73, 0x9e, 0x71, 0xcb, 0xd1, 0x38, 0xbd, 0x76, 0x27, 0xbd, 0x20, 0x4e, 0x3f, 0x44, 0xa3,
+	0x82, 0xbe, 0x56, 0x9f, 0xfc, 0x11, 0x01, 0xb9, 0x5d, 0x54, 0x8a, 0x64, 0xe1, 0x27, 0xf0, 0x33,
+	0xd5, 0x7e, 0x29, 0xbd, 0x7a, 0xb3, 0x61, 0x82, 0x46, 0xa4, 0xec, 0x5d, 0xa1, 0x40, 0xcd, 0x2c,
+	0x3a, 0xd6, 0x6d, 0x5a, 0xfb, 0xe4, 0x29, 0xbc, 0x1d, 0xec, 0x4a, 0x96, 0x8e, 0x70, 0x9b, 0xee,
+	0x44, 0x27, 0xff, 0xb4, 0xe0, 0x49, 0xb5, 0xb9, 0x4b, 0x26, 0xd8, 0xca, 0x9d, 0x5c, 0x45, 0xf3,
+	0x0a, 0xf5, 0x86, 0xa7, 0x48, 0xbe, 0x82, 0x93, 0x0b, 0xdf, 0x13, 0x84, 0x4e, 0xe2, 0x30, 0xf6,
+	0xee, 0x73, 0xd5, 0x8b, 0x6f, 0x3f, 0x4e, 0xd5, 0x51, 0x26, 0x07, 0xc3, 0x88, 0x7c, 0x0f, 0xa7,
+	0xbb, 0x58, 0x55, 0xfb, 0x1e, 0xc4, 0xfe, 0x2e, 0xe2, 0xeb, 0xd7, 0xac, 0xc4, 0xfd, 0x24, 0x22,
+	0x3f, 0x41, 0x7c, 0x9f, 0x98, 0xc8, 0xe9, 0xa8, 0x7a, 0x75, 0x47, 0xe1, 0xd5, 0x1d, 0x4d, 0xcb,
+	0x57, 0xb7, 0x37, 0x0c, 0xc8, 0x6f, 0x92, 0x61, 0x72, 0x40, 0xbe, 0x86, 0x47, 0x97, 0xcc, 0xa6,
+	0xeb, 0xed, 0xad, 0xdd, 0x43, 0xb9, 0x56, 0xc5, 0xed, 0x3b, 0xee, 0xd6, 0xc0, 0xe0, 0xfd, 0x0b,
+	0xb4, 0x77, 0x5f, 0xcc, 0x3d, 0xb0, 0x4f, 0x6b, 0xb1, 0xed, 0xbd, 0xd2, 0xe5, 0x5f, 0xbc, 0xf8,
+	0xfc, 0xaf, 0x9b, 0x7e, 0xf4, 0xf7, 0x4d, 0x3f, 0xfa, 0xf7, 0xa6, 0x1f, 0xfd, 0x38, 0x79, 0xc3,
+	0xd7, 0x6b, 0xfb, 0x0d, 0x64, 0x8a, 0xa7, 0x19, 0x47, 0x61, 0xe7, 0x0f, 0xdc, 0xb6, 0x9e, 0xfd,
+	0x3f, 0x00, 0x37, 0xff, 0x25, 0x32, 0x21, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -598,6 +752,10 @@ type ConfigManagementPluginServiceClient interface {
 	// GenerateManifests receive a stream containing a tgz archive with all required files necessary
 	// to generate manifests
 	GenerateManifest(ctx context.Context, opts ...grpc.CallOption) (ConfigManagementPluginService_GenerateManifestClient, error)
+	// GenerateManifestStream receives a stream containing a tgz archive with all required files necessary
+	// to generate manifests, and streams the manifests as they are generated, so that neither the cmp-server
+	// nor the gRPC messages have to hold the whole output of the plugin.
+	GenerateManifestStream(ctx context.Context, opts ...grpc.CallOption) (ConfigManagementPluginService_GenerateManifestStreamClient, error)
 	// CheckPluginConfiguration is a pre-flight request  to check the plugin configuration
 	// without sending the whole repo.
 	CheckPluginConfiguration(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CheckPluginConfigurationResponse, error)
@@ -649,6 +807,37 @@ func (x *configManagementPluginServiceGenerateManifestClient) CloseAndRecv() (*M
 	return m, nil
 }
 
+func (c *configManagementPluginServiceClient) GenerateManifestStream(ctx context.Context, opts ...grpc.CallOption) (ConfigManagementPluginService_GenerateManifestStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ConfigManagementPluginService_serviceDesc.Streams[1], "/plugin.ConfigManagementPluginService/GenerateManifestStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &configManagementPluginServiceGenerateManifestStreamClient{stream}
+	return x, nil
+}
+
+type ConfigManagementPluginService_GenerateManifestStreamClient interface {
+	Send(*AppStreamRequest) error
+	Recv() (*ManifestStreamResponse, error)
+	grpc.ClientStream
+}
+
+type configManagementPluginServiceGenerateManifestStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *configManagementPluginServiceGenerateManifestStreamClient) Send(m *AppStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *configManagementPluginServiceGenerateManifestStreamClient) Recv() (*ManifestStreamResponse, error) {
+	m := new(ManifestStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *configManagementPluginServiceClient) CheckPluginConfiguration(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CheckPluginConfigurationResponse, error) {
 	out := new(CheckPluginConfigurationResponse)
 	err := c.cc.Invoke(ctx, "/plugin.ConfigManagementPluginService/CheckPluginConfiguration", in, out, opts...)
@@ -659,7 +848,7 @@ func (c *configManagementPluginServiceClient) CheckPluginConfiguration(ctx conte
 }
 
 func (c *configManagementPluginServiceClient) MatchRepository(ctx context.Context, opts ...grpc.CallOption) (ConfigManagementPluginService_MatchRepositoryClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ConfigManagementPluginService_serviceDesc.Streams[1], "/plugin.ConfigManagementPluginService/MatchRepository", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ConfigManagementPluginService_serviceDesc.Streams[2], "/plugin.ConfigManagementPluginService/MatchRepository", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -693,7 +882,7 @@ func (x *configManagementPluginServiceMatchRepositoryClient) CloseAndRecv() (*Re
 }
 
 func (c *configManagementPluginServiceClient) GetParametersAnnouncement(ctx context.Context, opts ...grpc.CallOption) (ConfigManagementPluginService_GetParametersAnnouncementClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ConfigManagementPluginService_serviceDesc.Streams[2], "/plugin.ConfigManagementPluginService/GetParametersAnnouncement", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ConfigManagementPluginService_serviceDesc.Streams[3], "/plugin.ConfigManagementPluginService/GetParametersAnnouncement", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -731,6 +920,10 @@ type ConfigManagementPluginServiceServer interface {
 	// GenerateManifests receive a stream containing a tgz archive with all required files necessary
 	// to generate manifests
 	GenerateManifest(ConfigManagementPluginService_GenerateManifestServer) error
+	// GenerateManifestStream receives a stream containing a tgz archive with all required files necessary
+	// to generate manifests, and streams the manifests as they are generated, so that neither the cmp-server
+	// nor the gRPC messages have to hold the whole output of the plugin.
+	GenerateManifestStream(ConfigManagementPluginService_GenerateManifestStreamServer) error
 	// CheckPluginConfiguration is a pre-flight request  to check the plugin configuration
 	// without sending the whole repo.
 	CheckPluginConfiguration(context.Context, *emptypb.Empty) (*CheckPluginConfigurationResponse, error)
@@ -747,6 +940,9 @@ type UnimplementedConfigManagementPluginServiceServer struct {
 func (*UnimplementedConfigManagementPluginServiceServer) GenerateManifest(srv ConfigManagementPluginService_GenerateManifestServer) error {
 	return status.Errorf(codes.Unimplemented, "method GenerateManifest not implemented")
 }
+func (*UnimplementedConfigManagementPluginServiceServer) GenerateManifestStream(srv ConfigManagementPluginService_GenerateManifestStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GenerateManifestStream not implemented")
+}
 func (*UnimplementedConfigManagementPluginServiceServer) CheckPluginConfiguration(ctx context.Context, req *emptypb.Empty) (*CheckPluginConfigurationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckPluginConfiguration not implemented")
 }
@@ -787,6 +983,32 @@ func (x *configManagementPluginServiceGenerateManifestServer) Recv() (*AppStream
 	return m, nil
 }
 
+func _ConfigManagementPluginService_GenerateManifestStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ConfigManagementPluginServiceServer).GenerateManifestStream(&configManagementPluginServiceGenerateManifestStreamServer{stream})
+}
+
+type ConfigManagementPluginService_GenerateManifestStreamServer interface {
+	Send(*ManifestStreamResponse) error
+	Recv() (*AppStreamRequest, error)
+	grpc.ServerStream
+}
+
+type configManagementPluginServiceGenerateManifestStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *configManagementPluginServiceGenerateManifestStreamServer) Send(m *ManifestStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *configManagementPluginServiceGenerateManifestStreamServer) Recv() (*AppStreamRequest, error) {
+	m := new(AppStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _ConfigManagementPluginService_CheckPluginConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -872,6 +1094,12 @@ var _ConfigManagementPluginService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _ConfigManagementPluginService_GenerateManifest_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "GenerateManifestStream",
+			Handler:       _ConfigManagementPluginService_GenerateManifestStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "MatchRepository",
 			Handler:       _ConfigManagementPluginService_MatchRepository_Handler,
@@ -1115,6 +1343,40 @@ func (m *ManifestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ManifestStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManifestStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManifestStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Manifest) > 0 {
+		i -= len(m.Manifest)
+		copy(dAtA[i:], m.Manifest)
+		i = encodeVarintPlugin(dAtA, i, uint64(len(m.Manifest)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RepositoryResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1261,6 +1523,23 @@ func (m *CheckPluginConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (in
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Capabilities != nil {
+		{
+			size, err := m.Capabilities.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlugin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ProtocolVersion != 0 {
+		i = encodeVarintPlugin(dAtA, i, uint64(m.ProtocolVersion))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.ProvideGitCreds {
 		i--
 		if m.ProvideGitCreds {
@@ -1284,6 +1563,73 @@ func (m *CheckPluginConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *PluginCapabilities) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PluginCapabilities) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PluginCapabilities) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.GenerateStream {
+		i--
+		if m.GenerateStream {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Generate {
+		i--
+		if m.Generate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Parameters {
+		i--
+		if m.Parameters {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Discover {
+		i--
+		if m.Discover {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPlugin(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPlugin(v)
 	base := offset
@@ -1409,6 +1755,22 @@ func (m *ManifestResponse) Size() (n int) {
 	return n
 }
 
+func (m *ManifestStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Manifest)
+	if l > 0 {
+		n += 1 + l + sovPlugin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *RepositoryResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1473,6 +1835,37 @@ func (m *CheckPluginConfigurationResponse) Size() (n int) {
 	if m.ProvideGitCreds {
 		n += 2
 	}
+	if m.ProtocolVersion != 0 {
+		n += 1 + sovPlugin(uint64(m.ProtocolVersion))
+	}
+	if m.Capabilities != nil {
+		l = m.Capabilities.Size()
+		n += 1 + l + sovPlugin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PluginCapabilities) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Discover {
+		n += 2
+	}
+	if m.Parameters {
+		n += 2
+	}
+	if m.Generate {
+		n += 2
+	}
+	if m.GenerateStream {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2036,6 +2429,89 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ManifestStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlugin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManifestStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManifestStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manifest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlugin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RepositoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2366,6 +2842,192 @@ func (m *CheckPluginConfigurationResponse) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ProvideGitCreds = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+			}
+			m.ProtocolVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolVersion |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Capabilities == nil {
+				m.Capabilities = &PluginCapabilities{}
+			}
+			if err := m.Capabilities.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlugin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PluginCapabilities) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlugin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PluginCapabilities: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PluginCapabilities: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discover", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Discover = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Parameters = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Generate = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenerateStream", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GenerateStream = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlugin(dAtA[iNdEx:])
@@ -10,6 +10,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	configUtil "github.com/argoproj/argo-cd/v3/util/config"
+	argoexec "github.com/argoproj/argo-cd/v3/util/exec"
 )
 
 const (
@@ -30,6 +31,8 @@ type PluginConfigSpec struct {
 	Parameters       Parameters `yaml:"parameters"`
 	PreserveFileMode bool       `json:"preserveFileMode,omitempty"`
 	ProvideGitCreds  bool       `json:"provideGitCreds,omitempty"`
+	// Sandbox constrains the resources of the init and generate commands, which run without sandbox if nil
+	Sandbox *argoexec.Sandbox `json:"sandbox,omitempty"`
 }
 
 // Discover holds find and fileName
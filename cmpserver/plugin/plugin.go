@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -28,6 +29,8 @@ import (
 	securejoin "github.com/cyphar/filepath-securejoin"
 	"github.com/mattn/go-zglob"
 	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeyaml "k8s.io/apimachinery/pkg/util/yaml"
 )
 
 // cmpTimeoutBuffer is the amount of time before the request deadline to timeout server-side work. It makes sure there's
@@ -72,9 +75,33 @@ func randExecID() (string, error) {
 	return hex.EncodeToString(execIDBytes)[0:execIDLen], nil
 }
 
-func runCommand(ctx context.Context, command Command, path string, env []string) (string, error) {
+func runCommand(ctx context.Context, command Command, path string, env []string, sandbox *argoexec.Sandbox) (string, error) {
+	var stdout bytes.Buffer
+	err := runCommandWithOutput(ctx, command, path, env, sandbox, &stdout)
+	return strings.TrimSuffix(stdout.String(), "\n"), err
+}
+
+// countingWriter counts the bytes written to the underlying writer
+type countingWriter struct {
+	w     io.Writer
+	count int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.count += int64(n)
+	return n, err
+}
+
+// runCommandWithOutput runs the command and writes its standard output to stdout as it is produced.
+func runCommandWithOutput(ctx context.Context, command Command, path string, env []string, sandbox *argoexec.Sandbox, stdout io.Writer) error {
 	if len(command.Command) == 0 {
-		return "", errors.New("Command is empty")
+		return errors.New("Command is empty")
+	}
+	if timeout := sandbox.GetTimeout(); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
 	}
 	cmd := exec.CommandContext(ctx, command.Command[0], append(command.Command[1:], command.Args...)...)
 
@@ -83,25 +110,31 @@ func runCommand(ctx context.Context, command Command, path string, env []string)
 
 	execId, err := randExecID()
 	if err != nil {
-		return "", err
+		return err
 	}
 	logCtx := log.WithFields(log.Fields{"execID": execId})
 
 	argsToLog := argoexec.GetCommandArgsToLog(cmd)
 	logCtx.WithFields(log.Fields{"dir": cmd.Dir}).Info(argsToLog)
 
-	var stdout bytes.Buffer
+	output := &countingWriter{w: stdout}
 	var stderr bytes.Buffer
-	cmd.Stdout = &stdout
+	cmd.Stdout = output
 	cmd.Stderr = &stderr
 
 	// Make sure the command is killed immediately on timeout. https://stackoverflow.com/a/38133948/684776
 	cmd.SysProcAttr = newSysProcAttr(true)
 
+	release, err := sandbox.Prepare(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to prepare the sandbox of the command: %w", err)
+	}
+
 	start := time.Now()
 	err = cmd.Start()
 	if err != nil {
-		return "", err
+		_ = release()
+		return err
 	}
 
 	go func() {
@@ -120,30 +153,35 @@ func runCommand(ctx context.Context, command Command, path string, env []string)
 	}()
 
 	err = cmd.Wait()
+	if sandboxErr := release(); sandboxErr != nil {
+		err = sandboxErr
+	}
 
 	duration := time.Since(start)
-	output := stdout.String()
-
-	logCtx.WithFields(log.Fields{"duration": duration}).Debug(output)
+	if buffer, ok := stdout.(*bytes.Buffer); ok {
+		logCtx.WithFields(log.Fields{"duration": duration}).Debug(buffer.String())
+	} else {
+		logCtx.WithFields(log.Fields{"duration": duration}).Debugf("Streamed %d bytes of output", output.count)
+	}
 
 	if err != nil {
 		err := newCmdError(argsToLog, errors.New(err.Error()), strings.TrimSpace(stderr.String()))
 		logCtx.Error(err.Error())
-		return strings.TrimSuffix(output, "\n"), err
+		return err
 	}
 
 	logCtx = logCtx.WithFields(log.Fields{
 		"stderr":  stderr.String(),
 		"command": command,
 	})
-	if len(output) == 0 {
+	if output.count == 0 {
 		logCtx.Warn("Plugin command returned zero output")
 	} else {
 		// Log stderr even on successful commands to help develop plugins
 		logCtx.Info("Plugin command successful")
 	}
 
-	return strings.TrimSuffix(output, "\n"), nil
+	return nil
 }
 
 type CmdError struct {
@@ -251,13 +289,13 @@ func (s *Service) generateManifest(ctx context.Context, appDir string, envEntrie
 
 	env := append(os.Environ(), environ(envEntries)...)
 	if len(config.Spec.Init.Command) > 0 {
-		_, err := runCommand(ctx, config.Spec.Init, appDir, env)
+		_, err := runCommand(ctx, config.Spec.Init, appDir, env, config.Spec.Sandbox)
 		if err != nil {
 			return &apiclient.ManifestResponse{}, err
 		}
 	}
 
-	out, err := runCommand(ctx, config.Spec.Generate, appDir, env)
+	out, err := runCommand(ctx, config.Spec.Generate, appDir, env, config.Spec.Sandbox)
 	if err != nil {
 		return &apiclient.ManifestResponse{}, err
 	}
@@ -277,6 +315,112 @@ func (s *Service) generateManifest(ctx context.Context, appDir string, envEntrie
 	}, err
 }
 
+// ManifestStream defines an interface able to receive the application's files and to send the generated manifests as
+// they are generated.
+type ManifestStream interface {
+	Stream
+	Send(response *apiclient.ManifestStreamResponse) error
+}
+
+// GenerateManifestStream runs generate command from plugin config file and streams the generated manifests as the
+// command outputs them
+func (s *Service) GenerateManifestStream(stream apiclient.ConfigManagementPluginService_GenerateManifestStreamServer) error {
+	return s.generateManifestStreamGeneric(stream)
+}
+
+func (s *Service) generateManifestStreamGeneric(stream ManifestStream) error {
+	ctx, cancel := buffered_context.WithEarlierDeadline(stream.Context(), cmpTimeoutBuffer)
+	defer cancel()
+	workDir, cleanup, err := getTempDirMustCleanup(common.GetCMPWorkDir())
+	if err != nil {
+		return fmt.Errorf("error creating workdir for manifest generation: %w", err)
+	}
+	defer cleanup()
+
+	metadata, err := cmp.ReceiveRepoStream(ctx, stream, workDir, s.initConstants.PluginConfig.Spec.PreserveFileMode)
+	if err != nil {
+		return fmt.Errorf("generate manifest error receiving stream: %w", err)
+	}
+
+	appPath := filepath.Clean(filepath.Join(workDir, metadata.AppRelPath))
+	if !strings.HasPrefix(appPath, workDir) {
+		return errors.New("illegal appPath: out of workDir bound")
+	}
+	count := 0
+	err = s.streamManifests(ctx, appPath, metadata.GetEnv(), func(manifest string) error {
+		count++
+		return stream.Send(&apiclient.ManifestStreamResponse{Manifest: manifest})
+	})
+	if err != nil {
+		return fmt.Errorf("error generating manifests: %w", err)
+	}
+	log.Debugf("Streamed %d generated manifests", count)
+	return nil
+}
+
+// streamManifests runs generate command from plugin config file and calls send with each manifest as soon as the
+// command outputs it, so that the output of the command is never held in memory.
+func (s *Service) streamManifests(ctx context.Context, appDir string, envEntries []*apiclient.EnvEntry, send func(manifest string) error) error {
+	if deadline, ok := ctx.Deadline(); ok {
+		log.Infof("Streaming manifests with deadline %v from now", time.Until(deadline))
+	} else {
+		log.Info("Streaming manifests with no request-level timeout")
+	}
+
+	config := s.initConstants.PluginConfig
+
+	env := append(os.Environ(), environ(envEntries)...)
+	if len(config.Spec.Init.Command) > 0 {
+		_, err := runCommand(ctx, config.Spec.Init, appDir, env, config.Spec.Sandbox)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The command is killed if the manifests can't be decoded or sent
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	reader, writer := io.Pipe()
+	decoded := make(chan error, 1)
+	go func() {
+		err := decodeManifests(reader, send)
+		if err != nil {
+			cancel()
+		}
+		// consume the rest of the output so that the command isn't blocked until it is killed
+		_, _ = io.Copy(io.Discard, reader)
+		decoded <- err
+	}()
+
+	err := runCommandWithOutput(ctx, config.Spec.Generate, appDir, env, config.Spec.Sandbox, writer)
+	_ = writer.Close()
+	if decodeErr := <-decoded; decodeErr != nil {
+		return decodeErr
+	}
+	return err
+}
+
+// decodeManifests decodes the YAML or JSON manifests read from r, and calls send with each manifest as JSON.
+func decodeManifests(r io.Reader, send func(manifest string) error) error {
+	d := kubeyaml.NewYAMLOrJSONDecoder(r, 4096)
+	for {
+		ext := runtime.RawExtension{}
+		if err := d.Decode(&ext); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("failed to unmarshal manifest: %w", err)
+		}
+		ext.Raw = bytes.TrimSpace(ext.Raw)
+		if len(ext.Raw) == 0 || bytes.Equal(ext.Raw, []byte("null")) {
+			continue
+		}
+		if err := send(string(ext.Raw)); err != nil {
+			return fmt.Errorf("error sending manifest: %w", err)
+		}
+	}
+}
+
 type MatchRepositoryStream interface {
 	Stream
 	SendAndClose(response *apiclient.RepositoryResponse) error
@@ -362,7 +506,7 @@ func (s *Service) matchRepository(ctx context.Context, workdir string, envEntrie
 	if len(config.Spec.Discover.Find.Command.Command) > 0 {
 		log.Debugf("Going to try runCommand.")
 		env := append(os.Environ(), environ(envEntries)...)
-		find, err := runCommand(ctx, config.Spec.Discover.Find.Command, appPath, env)
+		find, err := runCommand(ctx, config.Spec.Discover.Find.Command, appPath, env, nil)
 		if err != nil {
 			return false, true, fmt.Errorf("error running find command: %w", err)
 		}
@@ -415,7 +559,7 @@ func getParametersAnnouncement(ctx context.Context, appDir string, announcements
 
 	if len(command.Command) > 0 {
 		env := append(os.Environ(), environ(envEntries)...)
-		stdout, err := runCommand(ctx, command, appDir, env)
+		stdout, err := runCommand(ctx, command, appDir, env, nil)
 		if err != nil {
 			return nil, fmt.Errorf("error executing dynamic parameter output command: %w", err)
 		}
@@ -438,7 +582,18 @@ func getParametersAnnouncement(ctx context.Context, appDir string, announcements
 
 func (s *Service) CheckPluginConfiguration(_ context.Context, _ *empty.Empty) (*apiclient.CheckPluginConfigurationResponse, error) {
 	isDiscoveryConfigured := s.isDiscoveryConfigured()
-	response := &apiclient.CheckPluginConfigurationResponse{IsDiscoveryConfigured: isDiscoveryConfigured, ProvideGitCreds: s.initConstants.PluginConfig.Spec.ProvideGitCreds}
+	parameters := s.initConstants.PluginConfig.Spec.Parameters
+	response := &apiclient.CheckPluginConfigurationResponse{
+		IsDiscoveryConfigured: isDiscoveryConfigured,
+		ProvideGitCreds:       s.initConstants.PluginConfig.Spec.ProvideGitCreds,
+		ProtocolVersion:       apiclient.ProtocolVersion,
+		Capabilities: &apiclient.PluginCapabilities{
+			Discover:       isDiscoveryConfigured,
+			Parameters:     len(parameters.Static) > 0 || len(parameters.Dynamic.Command) > 0,
+			Generate:       len(s.initConstants.PluginConfig.Spec.Generate.Command) > 0,
+			GenerateStream: true,
+		},
+	}
 
 	return response, nil
 }
@@ -3,6 +3,7 @@ package plugin
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -337,6 +338,57 @@ func TestGenerateManifest(t *testing.T) {
 	})
 }
 
+func TestStreamManifests(t *testing.T) {
+	configFilePath := "./testdata/kustomize/config"
+
+	t.Run("successful generate", func(t *testing.T) {
+		service, err := newService(configFilePath)
+		require.NoError(t, err)
+		service.WithGenerateCommand(Command{Command: []string{"sh", "-c"}, Args: []string{"echo 'kind: ConfigMap'; echo '---'; echo; echo '---'; echo 'kind: Secret'"}})
+
+		var manifests []string
+		err = service.streamManifests(t.Context(), "testdata/kustomize", nil, func(manifest string) error {
+			manifests = append(manifests, manifest)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{`{"kind":"ConfigMap"}`, `{"kind":"Secret"}`}, manifests)
+	})
+	t.Run("bad generate command", func(t *testing.T) {
+		service, err := newService(configFilePath)
+		require.NoError(t, err)
+		service.WithGenerateCommand(Command{Command: []string{"bad-command"}})
+
+		err = service.streamManifests(t.Context(), "testdata/kustomize", nil, func(_ string) error {
+			return nil
+		})
+		require.ErrorContains(t, err, "executable file not found")
+	})
+	t.Run("bad yaml output", func(t *testing.T) {
+		service, err := newService(configFilePath)
+		require.NoError(t, err)
+		service.WithGenerateCommand(Command{Command: []string{"echo", "invalid yaml: }"}})
+
+		err = service.streamManifests(t.Context(), "testdata/kustomize", nil, func(_ string) error {
+			return nil
+		})
+		require.ErrorContains(t, err, "failed to unmarshal manifest")
+	})
+	t.Run("send failure kills the command", func(t *testing.T) {
+		// the output is padded, so that the decoder doesn't wait for the end of the output to guess its format
+		service, err := newService(configFilePath)
+		require.NoError(t, err)
+		service.WithGenerateCommand(Command{Command: []string{"sh", "-c"}, Args: []string{"echo 'kind: ConfigMap'; echo '---'; yes '# padding' | head -n 1000; sleep 30"}})
+
+		before := time.Now()
+		err = service.streamManifests(t.Context(), "testdata/kustomize", nil, func(_ string) error {
+			return errors.New("stream closed")
+		})
+		require.EqualError(t, err, "error sending manifest: stream closed")
+		assert.Less(t, time.Since(before), 10*time.Second)
+	})
+}
+
 func TestGenerateManifest_deadline_exceeded(t *testing.T) {
 	configFilePath := "./testdata/kustomize/config"
 	service, err := newService(configFilePath)
@@ -358,14 +410,14 @@ func TestRunCommandContextTimeout(t *testing.T) {
 		Args:    []string{"sleep 5"},
 	}
 	before := time.Now()
-	_, err := runCommand(ctx, command, "", []string{})
+	_, err := runCommand(ctx, command, "", []string{}, nil)
 	after := time.Now()
 	require.Error(t, err) // The command should time out, causing an error.
 	assert.Less(t, after.Sub(before), 1*time.Second)
 }
 
 func TestRunCommandEmptyCommand(t *testing.T) {
-	_, err := runCommand(t.Context(), Command{}, "", nil)
+	_, err := runCommand(t.Context(), Command{}, "", nil, nil)
 	require.ErrorContains(t, err, "Command is empty")
 }
 
@@ -382,7 +434,7 @@ func TestRunCommandContextTimeoutWithCleanup(t *testing.T) {
 	}
 
 	before := time.Now()
-	output, err := runCommand(ctx, command, "", []string{})
+	output, err := runCommand(ctx, command, "", []string{}, nil)
 	after := time.Now()
 
 	require.Error(t, err) // The command should time out, causing an error.
@@ -675,6 +727,44 @@ func TestService_GenerateManifest(t *testing.T) {
 	})
 }
 
+type MockManifestStream struct {
+	*MockGenerateManifestStream
+	manifests []string
+}
+
+func (m *MockManifestStream) Send(response *apiclient.ManifestStreamResponse) error {
+	m.manifests = append(m.manifests, response.Manifest)
+	return nil
+}
+
+func TestService_GenerateManifestStream(t *testing.T) {
+	configFilePath := "./testdata/kustomize/config"
+	service, err := newService(configFilePath)
+	require.NoError(t, err)
+	service.WithGenerateCommand(Command{Command: []string{"cat", "kustomization.yaml"}})
+
+	t.Run("successful generate", func(t *testing.T) {
+		generateStream, err := NewMockGenerateManifestStream("./testdata/kustomize", "./testdata/kustomize", nil)
+		require.NoError(t, err)
+		s := &MockManifestStream{MockGenerateManifestStream: generateStream}
+		err = service.generateManifestStreamGeneric(s)
+		require.NoError(t, err)
+		require.Len(t, s.manifests, 1)
+		assert.Contains(t, s.manifests[0], `"kind":"Kustomization"`)
+	})
+
+	t.Run("out-of-bounds app path", func(t *testing.T) {
+		generateStream, err := NewMockGenerateManifestStream("./testdata/kustomize", "./testdata/kustomize", nil)
+		require.NoError(t, err)
+		s := &MockManifestStream{MockGenerateManifestStream: generateStream}
+		// set a malicious app path on the metadata
+		s.metadataRequest.Request.(*apiclient.AppStreamRequest_Metadata).Metadata.AppRelPath = "../out-of-bounds"
+		err = service.generateManifestStreamGeneric(s)
+		require.ErrorContains(t, err, "illegal appPath")
+		assert.Empty(t, s.manifests)
+	})
+}
+
 type MockMatchRepositoryStream struct {
 	metadataSent    bool
 	fileSent        bool
@@ -887,4 +977,22 @@ func TestService_CheckPluginConfiguration(t *testing.T) {
 		require.NoError(t, err)
 		assert.False(t, resp.IsDiscoveryConfigured)
 	})
+
+	t.Run("capabilities are advertised", func(t *testing.T) {
+		// given
+		d := Discover{
+			FileName: "kustomization.yaml",
+		}
+		f := setup(t, withDiscover(d))
+		f.service.initConstants.PluginConfig.Spec.Parameters.Static = []*repoclient.ParameterAnnouncement{{Name: "static-parameter"}}
+		f.service.WithGenerateCommand(Command{Command: []string{"cat", "manifests.yaml"}})
+
+		// when
+		resp, err := f.service.CheckPluginConfiguration(t.Context(), &empty.Empty{})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, int32(apiclient.ProtocolVersion), resp.ProtocolVersion)
+		assert.Equal(t, &apiclient.PluginCapabilities{Discover: true, Parameters: true, Generate: true, GenerateStream: true}, resp.Capabilities)
+	})
 }
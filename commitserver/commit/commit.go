@@ -99,6 +99,17 @@ func (s *Service) handleCommitRequest(logCtx *log.Entry, r *apiclient.CommitHydr
 	}
 	defer cleanup()
 
+	// The metadata of the dry commit is recorded as provenance of the hydrated manifests. The manifests are hydrated
+	// without it if the dry commit can't be read.
+	var dryCommitMetadata *git.RevisionMetadata
+	if r.DrySha != "" {
+		logCtx.Debug("Getting dry commit metadata")
+		dryCommitMetadata, err = gitClient.RevisionMetadata(r.DrySha)
+		if err != nil {
+			logCtx.WithError(err).Warn("failed to get dry commit metadata")
+		}
+	}
+
 	logCtx.Debugf("Checking out sync branch %s", r.SyncBranch)
 	var out string
 	out, err = gitClient.CheckoutOrOrphan(r.SyncBranch, false)
@@ -119,7 +130,7 @@ func (s *Service) handleCommitRequest(logCtx *log.Entry, r *apiclient.CommitHydr
 	}
 
 	logCtx.Debug("Writing manifests")
-	err = WriteForPaths(dirPath, r.Repo.Repo, r.DrySha, r.Paths)
+	err = WriteForPaths(dirPath, r.Repo.Repo, r.DrySha, dryCommitMetadata, r.Paths)
 	if err != nil {
 		return "", "", fmt.Errorf("failed to write manifests: %w", err)
 	}
@@ -209,6 +220,12 @@ type hydratorMetadataFile struct {
 	RepoURL  string   `json:"repoURL"`
 	DrySHA   string   `json:"drySha"`
 	Commands []string `json:"commands"`
+	// Author, Date, Subject and Body describe the dry commit, so that the hydrated manifests can be traced back to the
+	// change which produced them
+	Author  string `json:"author,omitempty"`
+	Date    string `json:"date,omitempty"`
+	Subject string `json:"subject,omitempty"`
+	Body    string `json:"body,omitempty"`
 }
 
 // TODO: make this configurable via ConfigMap.
@@ -223,4 +240,12 @@ git clone {{ .RepoURL }}
 git checkout {{ .DrySHA }}
 {{ range $command := .Commands -}}
 {{ $command }}
-{{ end -}}` + "```"
+{{ end -}}` + "```" + `
+{{- if .Author }}
+
+The manifests were hydrated from the following commit:
+
+* Author: {{ .Author }}
+* Date: {{ .Date }}
+* Subject: {{ .Subject }}
+{{- end }}`
@@ -24,6 +24,7 @@ func Test_CommitHydratedManifests(t *testing.T) {
 		},
 		TargetBranch:  "main",
 		SyncBranch:    "env/test",
+		DrySha:        "abc123",
 		CommitMessage: "test commit message",
 	}
 
@@ -97,6 +98,7 @@ func Test_CommitHydratedManifests(t *testing.T) {
 		mockGitClient.On("Init").Return(nil).Once()
 		mockGitClient.On("Fetch", mock.Anything).Return(nil).Once()
 		mockGitClient.On("SetAuthor", "Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.On("RevisionMetadata", "abc123").Return(&git.RevisionMetadata{Author: "test-user <test-user@example.com>", Message: "test subject"}, nil).Once()
 		mockGitClient.On("CheckoutOrOrphan", "env/test", false).Return("", nil).Once()
 		mockGitClient.On("CheckoutOrNew", "main", "env/test", false).Return("", nil).Once()
 		mockGitClient.On("RemoveContents").Return("", nil).Once()
@@ -5,21 +5,27 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"strings"
 	"text/template"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v3"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/git"
 	"github.com/argoproj/argo-cd/v3/util/io/files"
 )
 
 // WriteForPaths writes the manifests, hydrator.metadata, and README.md files for each path in the provided paths. It
-// also writes a root-level hydrator.metadata file containing the repo URL and dry SHA.
-func WriteForPaths(rootPath string, repoUrl string, drySha string, paths []*apiclient.PathDetails) error { //nolint:revive //FIXME(var-naming)
+// also writes a root-level hydrator.metadata file containing the repo URL and dry SHA. The metadata of the dry commit
+// is recorded in the hydrator.metadata files if not nil.
+func WriteForPaths(rootPath string, repoUrl string, drySha string, dryCommitMetadata *git.RevisionMetadata, paths []*apiclient.PathDetails) error { //nolint:revive //FIXME(var-naming)
 	// Write the top-level readme.
-	err := writeMetadata(rootPath, hydratorMetadataFile{DrySHA: drySha, RepoURL: repoUrl})
+	topMetadata := hydratorMetadataFile{DrySHA: drySha, RepoURL: repoUrl}
+	setDryCommitMetadata(&topMetadata, dryCommitMetadata)
+	err := writeMetadata(rootPath, topMetadata)
 	if err != nil {
 		return fmt.Errorf("failed to write top-level hydrator metadata: %w", err)
 	}
@@ -48,6 +54,7 @@ func WriteForPaths(rootPath string, repoUrl string, drySha string, paths []*apic
 			DrySHA:   drySha,
 			RepoURL:  repoUrl,
 		}
+		setDryCommitMetadata(&hydratorMetadata, dryCommitMetadata)
 		err = writeMetadata(fullHydratePath, hydratorMetadata)
 		if err != nil {
 			return fmt.Errorf("failed to write hydrator metadata: %w", err)
@@ -62,6 +69,20 @@ func WriteForPaths(rootPath string, repoUrl string, drySha string, paths []*apic
 	return nil
 }
 
+// setDryCommitMetadata sets the author, date, subject and body of the dry commit in the metadata
+func setDryCommitMetadata(metadata *hydratorMetadataFile, dryCommitMetadata *git.RevisionMetadata) {
+	if dryCommitMetadata == nil {
+		return
+	}
+	metadata.Author = dryCommitMetadata.Author
+	if !dryCommitMetadata.Date.IsZero() {
+		metadata.Date = dryCommitMetadata.Date.UTC().Format(time.RFC3339)
+	}
+	subject, body, _ := strings.Cut(dryCommitMetadata.Message, "\n")
+	metadata.Subject = strings.TrimSpace(subject)
+	metadata.Body = strings.TrimSpace(body)
+}
+
 // writeMetadata writes the metadata to the hydrator.metadata file.
 func writeMetadata(dirPath string, metadata hydratorMetadataFile) error {
 	hydratorMetadataJSON, err := json.MarshalIndent(metadata, "", "  ")
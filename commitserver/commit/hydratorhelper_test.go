@@ -5,12 +5,14 @@ import (
 	"os"
 	"path"
 	"testing"
+	"time"
 
 	securejoin "github.com/cyphar/filepath-securejoin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/git"
 )
 
 func TestWriteForPaths(t *testing.T) {
@@ -35,7 +37,13 @@ func TestWriteForPaths(t *testing.T) {
 		},
 	}
 
-	err := WriteForPaths(dir, repoURL, drySha, paths)
+	dryCommitMetadata := &git.RevisionMetadata{
+		Author:  "test-user <test-user@example.com>",
+		Date:    time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		Message: "test subject\n\ntest body",
+	}
+
+	err := WriteForPaths(dir, repoURL, drySha, dryCommitMetadata, paths)
 	require.NoError(t, err)
 
 	// Check if the top-level hydrator.metadata exists and contains the repo URL and dry SHA
@@ -48,6 +56,10 @@ func TestWriteForPaths(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, repoURL, topMetadata.RepoURL)
 	assert.Equal(t, drySha, topMetadata.DrySHA)
+	assert.Equal(t, "test-user <test-user@example.com>", topMetadata.Author)
+	assert.Equal(t, "2025-01-02T03:04:05Z", topMetadata.Date)
+	assert.Equal(t, "test subject", topMetadata.Subject)
+	assert.Equal(t, "test body", topMetadata.Body)
 
 	for _, p := range paths {
 		fullHydratePath, err := securejoin.SecureJoin(dir, p.Path)
@@ -65,12 +77,15 @@ func TestWriteForPaths(t *testing.T) {
 		err = json.Unmarshal(metadataBytes, &readMetadata)
 		require.NoError(t, err)
 		assert.Equal(t, repoURL, readMetadata.RepoURL)
+		assert.Equal(t, p.Commands, readMetadata.Commands)
+		assert.Equal(t, "test subject", readMetadata.Subject)
 
 		// Check if each path contains a README.md file and contains the repo URL
 		readmePath := path.Join(fullHydratePath, "README.md")
 		readmeBytes, err := os.ReadFile(readmePath)
 		require.NoError(t, err)
 		assert.Contains(t, string(readmeBytes), repoURL)
+		assert.Contains(t, string(readmeBytes), "* Author: test-user <test-user@example.com>")
 
 		// Check if each path contains a manifest.yaml file and contains the word Pod
 		manifestPath := path.Join(fullHydratePath, "manifest.yaml")
@@ -116,6 +131,7 @@ func TestWriteReadme(t *testing.T) {
 	readmeBytes, err := os.ReadFile(readmePath)
 	require.NoError(t, err)
 	assert.Contains(t, string(readmeBytes), metadata.RepoURL)
+	assert.NotContains(t, string(readmeBytes), "hydrated from the following commit")
 }
 
 func TestWriteManifests(t *testing.T) {
@@ -50,6 +50,12 @@ const (
 	// ArgoCDAppControllerShardConfigMapName contains the application controller to shard mapping
 	ArgoCDAppControllerShardConfigMapName = "argocd-app-controller-shard-cm"
 	ArgoCDCmdParamsConfigMapName          = "argocd-cmd-params-cm"
+	// ArgoCDHookLibraryConfigMapName contains the hooks shared by the applications, run by the syncs of the applications referencing them
+	ArgoCDHookLibraryConfigMapName = "argocd-hook-library-cm"
+	// ArgoCDSCIMConfigMapName contains the users and the groups provisioned by the identity providers through the SCIM endpoints
+	ArgoCDSCIMConfigMapName = "argocd-scim-cm"
+	// ArgoCDAccessGrantsConfigMapName contains the elevated roles granted to the users for a limited time in an emergency
+	ArgoCDAccessGrantsConfigMapName = "argocd-access-grants-cm"
 )
 
 // Some default configurables
@@ -67,6 +73,9 @@ const (
 	DefaultPortRepoServerMetrics      = 8084
 	DefaultPortCommitServer           = 8086
 	DefaultPortCommitServerMetrics    = 8087
+	// DefaultPortArgoCDControllerDebug is the port of the debug server of the application controller, which only
+	// listens on the loopback interface of the controller pods
+	DefaultPortArgoCDControllerDebug = 8088
 )
 
 // DefaultAddressAPIServer for ArgoCD components
@@ -178,6 +187,8 @@ const (
 	LabelKeySecretType = "argocd.argoproj.io/secret-type"
 	// LabelKeyClusterKubernetesVersion contains the kubernetes version of the cluster secret if it has been enabled
 	LabelKeyClusterKubernetesVersion = "argocd.argoproj.io/kubernetes-version"
+	// LabelKeyClusterDiscoverySource contains the name of the cluster discovery source which registered the cluster
+	LabelKeyClusterDiscoverySource = "argocd.argoproj.io/cluster-discovery-source"
 	// LabelValueSecretTypeCluster indicates a secret type of cluster
 	LabelValueSecretTypeCluster = "cluster"
 	// LabelValueSecretTypeRepository indicates a secret type of repository
@@ -188,6 +199,8 @@ const (
 	LabelValueSecretTypeRepositoryWrite = "repository-write"
 	// LabelValueSecretTypeSCMCreds indicates a secret type of SCM credentials
 	LabelValueSecretTypeSCMCreds = "scm-creds"
+	// LabelValueSecretTypeClusterProxy indicates a secret type of cluster proxy credentials
+	LabelValueSecretTypeClusterProxy = "cluster-proxy"
 
 	// AnnotationKeyAppInstance is the Argo CD application name is used as the instance name
 	AnnotationKeyAppInstance = "argocd.argoproj.io/tracking-id"
@@ -200,6 +213,25 @@ const (
 	// can be disregarded.
 	AnnotationIgnoreHealthCheck = "argocd.argoproj.io/ignore-healthcheck"
 
+	// AnnotationSyncWavePause when set on a resource to a duration pauses the sync for this duration after the sync
+	// wave of the resource has been applied. The sync fails if a resource of the wave degrades during the pause.
+	AnnotationSyncWavePause = "argocd.argoproj.io/sync-wave-pause"
+
+	// AnnotationSyncTimeout when set on a resource to a duration bounds the time the sync waits for the resource to
+	// become healthy once applied, after which the sync timeout policy of the resource applies.
+	AnnotationSyncTimeout = "argocd.argoproj.io/sync-timeout"
+	// AnnotationSyncTimeoutPolicy holds the policy applied when a resource is not healthy at the end of its sync
+	// timeout, either Fail (default) or Skip.
+	AnnotationSyncTimeoutPolicy = "argocd.argoproj.io/sync-timeout-policy"
+	// SyncTimeoutPolicyFail fails the sync when a resource is not healthy at the end of its sync timeout
+	SyncTimeoutPolicyFail = "Fail"
+	// SyncTimeoutPolicySkip continues the sync without waiting any longer for a resource which is not healthy at the
+	// end of its sync timeout
+	SyncTimeoutPolicySkip = "Skip"
+	// AnnotationPrunePropagationPolicy when set on a resource to orphan, background or foreground overrides the
+	// deletion propagation policy of the resource when it is pruned
+	AnnotationPrunePropagationPolicy = "argocd.argoproj.io/prune-propagation-policy"
+
 	// AnnotationKeyManagedBy is annotation name which indicates that k8s resource is managed by an application.
 	AnnotationKeyManagedBy = "managed-by"
 	// AnnotationValueManagedByArgoCD is a 'managed-by' annotation value for resources managed by Argo CD
@@ -218,6 +250,10 @@ const (
 	// AnnotationKeyAppSkipReconcile tells the Application to skip the Application controller reconcile.
 	// Skip reconcile when the value is "true" or any other string values that can be strconv.ParseBool() to be true.
 	AnnotationKeyAppSkipReconcile = "argocd.argoproj.io/skip-reconcile"
+	// AnnotationKeyClusterAuthRotatedAt records when the application controller last rotated the bearer token of a cluster
+	AnnotationKeyClusterAuthRotatedAt = "argocd.argoproj.io/auth-rotated-at"
+	// AnnotationKeyClusterEndpoint records where the endpoint and CA data of a cluster were taken from when it was added
+	AnnotationKeyClusterEndpoint = "argocd.argoproj.io/cluster-endpoint"
 	// LabelKeyComponentRepoServer is the label key to identify the component as repo-server
 	LabelKeyComponentRepoServer = "app.kubernetes.io/component"
 	// LabelValueComponentRepoServer is the label value for the repo-server component
@@ -262,6 +298,8 @@ const (
 	EnvControllerShard = "ARGOCD_CONTROLLER_SHARD"
 	// EnvControllerShardingAlgorithm is the distribution sharding algorithm to be used: legacy or round-robin
 	EnvControllerShardingAlgorithm = "ARGOCD_CONTROLLER_SHARDING_ALGORITHM"
+	// EnvControllerShardingLabelRules is the list of rules assigning clusters to shards based on their labels
+	EnvControllerShardingLabelRules = "ARGOCD_CONTROLLER_SHARDING_LABEL_RULES"
 	// EnvEnableDynamicClusterDistribution enables dynamic sharding (ALPHA)
 	EnvEnableDynamicClusterDistribution = "ARGOCD_ENABLE_DYNAMIC_CLUSTER_DISTRIBUTION"
 	// EnvEnableGRPCTimeHistogramEnv enables gRPC metrics collection
@@ -403,6 +441,12 @@ func GetCMPWorkDir() string {
 const (
 	// AnnotationApplicationSetRefresh is an annotation that is added when an ApplicationSet is requested to be refreshed by a webhook. The ApplicationSet controller will remove this annotation at the end of reconciliation.
 	AnnotationApplicationSetRefresh = "argocd.argoproj.io/application-set-refresh"
+	// AnnotationApplicationSetTemplateHash is an annotation set on the Applications generated by an ApplicationSet with the RollingUpdate strategy, holding the hash of the generated Application the Application was last updated to.
+	AnnotationApplicationSetTemplateHash = "argocd.argoproj.io/application-set-template-hash"
+	// AnnotationApplicationSetPaused is an annotation set on the Applications generated by an ApplicationSet which are excluded from the reconciliation of the ApplicationSet. The paused Applications are neither updated nor deleted by the ApplicationSet controller.
+	AnnotationApplicationSetPaused = "argocd.argoproj.io/application-set-paused"
+	// AnnotationApplicationSetOrphaned is an annotation set on the Applications orphaned by an ApplicationSet with the orphan policy, when they are no longer generated. It holds the name of the ApplicationSet.
+	AnnotationApplicationSetOrphaned = "argocd.argoproj.io/application-set-orphaned"
 )
 
 // gRPC settings
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"reflect"
 	"runtime/debug"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -75,6 +76,8 @@ const (
 	defaultDeploymentInformerResyncDuration = 10 * time.Second
 	// orphanedIndex contains application which monitor orphaned resources by namespace
 	orphanedIndex = "orphaned"
+	// dependsOnIndex contains applications by the keys of the applications they depend on
+	dependsOnIndex = "dependsOn"
 )
 
 type CompareWith int
@@ -185,6 +188,8 @@ func NewApplicationController(
 	ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts,
 	enableK8sEvent []string,
 	hydratorEnabled bool,
+	historyRetention RevisionHistoryRetention,
+	helmLookupProxy *helm.LookupProxy,
 ) (*ApplicationController, error) {
 	log.Infof("appResyncPeriod=%v, appHardResyncPeriod=%v, appResyncJitter=%v", appResyncPeriod, appHardResyncPeriod, appResyncJitter)
 	db := db.NewDB(namespace, settingsMgr, kubeClientset)
@@ -198,8 +203,6 @@ func NewApplicationController(
 		kubeClientset:                     kubeClientset,
 		kubectl:                           kubectl,
 		applicationClientset:              applicationClientset,
-		appRefreshQueue:                   workqueue.NewTypedRateLimitingQueueWithConfig(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), workqueue.TypedRateLimitingQueueConfig[string]{Name: "app_reconciliation_queue"}),
-		appOperationQueue:                 workqueue.NewTypedRateLimitingQueueWithConfig(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), workqueue.TypedRateLimitingQueueConfig[string]{Name: "app_operation_processing_queue"}),
 		projectRefreshQueue:               workqueue.NewTypedRateLimitingQueueWithConfig(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), workqueue.TypedRateLimitingQueueConfig[string]{Name: "project_reconciliation_queue"}),
 		appComparisonTypeRefreshQueue:     workqueue.NewTypedRateLimitingQueue(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig)),
 		appHydrateQueue:                   workqueue.NewTypedRateLimitingQueueWithConfig(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), workqueue.TypedRateLimitingQueueConfig[string]{Name: "app_hydration_queue"}),
@@ -222,6 +225,8 @@ func NewApplicationController(
 		ignoreNormalizerOpts:              ignoreNormalizerOpts,
 		metricsClusterLabels:              metricsClusterLabels,
 	}
+	ctrl.appRefreshQueue = newAppPriorityRateLimitingQueue("app_reconciliation_queue", rateLimiterConfig, ctrl.getAppPriority)
+	ctrl.appOperationQueue = newAppPriorityRateLimitingQueue("app_operation_processing_queue", rateLimiterConfig, ctrl.getAppPriority)
 	if hydratorEnabled {
 		ctrl.hydrator = hydrator.NewHydrator(&ctrl, appResyncPeriod, commitClientset)
 	}
@@ -332,7 +337,7 @@ func NewApplicationController(
 		}
 	}
 	stateCache := statecache.NewLiveStateCache(db, appInformer, ctrl.settingsMgr, kubectl, ctrl.metricsServer, ctrl.handleObjectUpdated, clusterSharding, argo.NewResourceTracking())
-	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectl, ctrl.onKubectlRun, ctrl.settingsMgr, stateCache, projInformer, ctrl.metricsServer, argoCache, ctrl.statusRefreshTimeout, argo.NewResourceTracking(), persistResourceHealth, repoErrorGracePeriod, serverSideDiff, ignoreNormalizerOpts)
+	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectl, ctrl.onKubectlRun, ctrl.settingsMgr, stateCache, projInformer, ctrl.metricsServer, argoCache, ctrl.statusRefreshTimeout, argo.NewResourceTracking(), persistResourceHealth, repoErrorGracePeriod, serverSideDiff, ignoreNormalizerOpts, historyRetention, helmLookupProxy)
 	ctrl.appInformer = appInformer
 	ctrl.appLister = appLister
 	ctrl.projInformer = projInformer
@@ -814,6 +819,10 @@ func (ctrl *ApplicationController) hideSecretData(destCluster *appv1.Cluster, ap
 			if err != nil {
 				return nil, fmt.Errorf("error getting resource overrides: %w", err)
 			}
+			globalIgnoreDifferences, err := ctrl.settingsMgr.GetResourceIgnoreDifferences()
+			if err != nil {
+				return nil, fmt.Errorf("error getting ignored differences: %w", err)
+			}
 			appLabelKey, err := ctrl.settingsMgr.GetAppInstanceLabelKey()
 			if err != nil {
 				return nil, fmt.Errorf("error getting app instance label key: %w", err)
@@ -828,7 +837,7 @@ func (ctrl *ApplicationController) hideSecretData(destCluster *appv1.Cluster, ap
 				return nil, fmt.Errorf("error getting cluster cache: %w", err)
 			}
 			diffConfig, err := argodiff.NewDiffConfigBuilder().
-				WithDiffSettings(app.Spec.IgnoreDifferences, resourceOverrides, compareOptions.IgnoreAggregatedRoles, ctrl.ignoreNormalizerOpts).
+				WithDiffSettings(slices.Concat(app.GetIgnoreDifferences(), globalIgnoreDifferences), resourceOverrides, compareOptions.IgnoreAggregatedRoles, ctrl.ignoreNormalizerOpts).
 				WithTracking(appLabelKey, trackingMethod).
 				WithNoCache().
 				WithLogger(logutils.NewLogrusLogger(logutils.NewWithCurrentConfig())).
@@ -884,6 +893,8 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 	defer ctrl.hydrationQueue.ShutDown()
 
 	ctrl.RegisterClusterSecretUpdater(ctx)
+	ctrl.RegisterClusterAuthRotator(ctx)
+	ctrl.RegisterClusterDiscoverer(ctx)
 	ctrl.metricsServer.RegisterClustersInfoSource(ctx, ctrl.stateCache, ctrl.db, ctrl.metricsClusterLabels)
 
 	if ctrl.dynamicClusterDistributionEnabled {
@@ -916,6 +927,7 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 
 	go func() { errors.CheckError(ctrl.stateCache.Run(ctx)) }()
 	go func() { errors.CheckError(ctrl.metricsServer.ListenAndServe()) }()
+	go ctrl.serveDebug(ctx, fmt.Sprintf("127.0.0.1:%d", common.DefaultPortArgoCDControllerDebug))
 
 	for i := 0; i < statusProcessors; i++ {
 		go wait.Until(func() {
@@ -1422,6 +1434,10 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 		}
 	} else {
 		state = &appv1.OperationState{Phase: synccommon.OperationRunning, Operation: *app.Operation, StartedAt: metav1.Now()}
+		if syncRes := resumedSyncResult(app, app.Operation.Sync); syncRes != nil {
+			state.SyncResult = syncRes
+			logCtx.Infof("Resuming previous sync operation, skipping %d completed tasks", len(syncRes.Resources))
+		}
 		ctrl.setOperationState(app, state)
 		if ctrl.syncTimeout != time.Duration(0) {
 			// Schedule a check during which the timeout would be checked.
@@ -1450,6 +1466,10 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 
 	switch state.Phase {
 	case synccommon.OperationRunning:
+		// resume the sync at the end of a pause between sync waves
+		if state.SyncResult != nil && state.SyncResult.WavePause != nil {
+			ctrl.appOperationQueue.AddAfter(ctrl.toAppKey(app.QualifiedName()), time.Until(state.SyncResult.WavePause.Until.Time))
+		}
 		// It's possible for an app to be terminated while we were operating on it. We do not want
 		// to clobber the Terminated state with Running. Get the latest app state to check for this.
 		freshApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(context.Background(), app.Name, metav1.GetOptions{})
@@ -1630,7 +1650,19 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		return
 	}
 	origApp = origApp.DeepCopy()
-	needRefresh, refreshType, comparisonLevel := ctrl.needRefreshAppStatus(origApp, ctrl.statusRefreshTimeout, ctrl.statusHardRefreshTimeout)
+	refreshTimeout := ctrl.statusRefreshTimeout
+	reconcileInterval, err := origApp.Spec.SyncPolicy.GetReconcileInterval()
+	if err != nil {
+		getAppLog(origApp).Warnf("Ignoring reconcile interval: %v", err)
+	} else if reconcileInterval > 0 {
+		refreshTimeout = reconcileInterval
+		// the informer resync period follows the global reconciliation timeout, so the next refresh of the app is
+		// scheduled according to its own interval
+		defer func() {
+			ctrl.appRefreshQueue.AddAfter(appKey, nextReconcileDelay(origApp, reconcileInterval))
+		}()
+	}
+	needRefresh, refreshType, comparisonLevel := ctrl.needRefreshAppStatus(origApp, refreshTimeout, ctrl.statusHardRefreshTimeout)
 
 	if !needRefresh {
 		return
@@ -1762,19 +1794,23 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		app.Status.Summary = tree.GetSummary(app)
 	}
 
-	canSync, _ := project.Spec.SyncWindows.Matches(app).CanSync(false)
+	canSync, _ := project.Spec.SyncWindows.CanSyncWithCluster(app, destCluster, false)
+	if canSync && destCluster.Maintenance {
+		logCtx.Infof("Skipping auto-sync: destination cluster %s is in maintenance", destCluster.Server)
+		canSync = false
+	}
 	if canSync {
-		syncErrCond, opDuration := ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources, compareResult.revisionUpdated)
+		syncErrCond, opDuration := ctrl.autoSync(app, project, compareResult.syncStatus, compareResult.resources, compareResult.managedResources, compareResult.revisionUpdated)
 		setOpDuration = opDuration
 		if syncErrCond != nil {
 			app.Status.SetConditions(
 				[]appv1.ApplicationCondition{*syncErrCond},
-				map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncError: true},
+				map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncError: true, appv1.ApplicationConditionSyncApprovalPending: true},
 			)
 		} else {
 			app.Status.SetConditions(
 				[]appv1.ApplicationCondition{},
-				map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncError: true},
+				map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncError: true, appv1.ApplicationConditionSyncApprovalPending: true},
 			)
 		}
 	} else {
@@ -1890,6 +1926,28 @@ func currentSourceEqualsSyncedSource(app *appv1.Application) bool {
 	return source.Equals(&app.Status.Sync.ComparedTo.Source)
 }
 
+// nextReconcileDelay returns the delay until the reconcile interval of the application expires, the interval itself if
+// the application has just been reconciled
+func nextReconcileDelay(app *appv1.Application, reconcileInterval time.Duration) time.Duration {
+	if app.Status.ReconciledAt != nil {
+		if delay := time.Until(app.Status.ReconciledAt.Add(reconcileInterval)); delay > 0 {
+			return delay
+		}
+	}
+	return reconcileInterval
+}
+
+// comparedIgnoreDifferences returns the differences ignored when comparing the application, including the ones of the
+// resource.ignoreDifferences setting unless it is invalid, in which case the setting is ignored by the comparison too
+// and the error is reported by the comparison
+func (ctrl *ApplicationController) comparedIgnoreDifferences(app *appv1.Application) appv1.IgnoreDifferences {
+	globalIgnoreDifferences, err := ctrl.settingsMgr.GetResourceIgnoreDifferences()
+	if err != nil {
+		getAppLog(app).Debugf("Could not get ignored differences from ConfigMap (assuming none): %v", err)
+	}
+	return comparedIgnoreDifferences(app, globalIgnoreDifferences)
+}
+
 // needRefreshAppStatus answers if application status needs to be refreshed.
 // Returns true if application never been compared, has changed or comparison result has expired.
 // Additionally, it returns whether full refresh was requested or not.
@@ -1932,8 +1990,8 @@ func (ctrl *ApplicationController) needRefreshAppStatus(app *appv1.Application,
 			reason = "spec.destination differs"
 		} else if app.HasChangedManagedNamespaceMetadata() {
 			reason = "spec.syncPolicy.managedNamespaceMetadata differs"
-		} else if !app.Spec.IgnoreDifferences.Equals(app.Status.Sync.ComparedTo.IgnoreDifferences) {
-			reason = "spec.ignoreDifferences differs"
+		} else if !ctrl.comparedIgnoreDifferences(app).Equals(app.Status.Sync.ComparedTo.IgnoreDifferences) {
+			reason = "spec.ignoreDifferences or resource.ignoreDifferences setting differs"
 		} else if requested, level := ctrl.isRefreshRequested(app.QualifiedName()); requested {
 			compareWith = level
 			reason = "controller refresh requested"
@@ -2023,6 +2081,7 @@ func (ctrl *ApplicationController) persistAppStatus(orig *appv1.Application, new
 			newAnnotations[k] = v
 		}
 		delete(newAnnotations, appv1.AnnotationKeyRefresh)
+		delete(newAnnotations, appv1.AnnotationKeyRefreshResources)
 		delete(newAnnotations, appv1.AnnotationKeyHydrate)
 	}
 	patch, modified, err := createMergePatch(
@@ -2050,8 +2109,40 @@ func (ctrl *ApplicationController) persistAppStatus(orig *appv1.Application, new
 	return patchDuration
 }
 
+// pendingDependencies returns the names of the applications the given application depends on which are not synced and
+// healthy yet
+func (ctrl *ApplicationController) pendingDependencies(app *appv1.Application) []string {
+	var pending []string
+	for _, dependency := range app.Spec.DependsOn {
+		obj, exists, err := ctrl.appInformer.GetIndexer().GetByKey(app.Namespace + "/" + dependency)
+		if err != nil || !exists {
+			pending = append(pending, dependency)
+			continue
+		}
+		dependencyApp, ok := obj.(*appv1.Application)
+		if !ok || dependencyApp.Status.Sync.Status != appv1.SyncStatusCodeSynced || dependencyApp.Status.Health.Status != health.HealthStatusHealthy {
+			pending = append(pending, dependency)
+		}
+	}
+	return pending
+}
+
+// requestDependentAppsRefresh requests a refresh of the applications depending on the application with the given key
+func (ctrl *ApplicationController) requestDependentAppsRefresh(key string) {
+	objs, err := ctrl.appInformer.GetIndexer().ByIndex(dependsOnIndex, key)
+	if err != nil {
+		log.Warnf("Failed to get applications depending on %s: %v", key, err)
+		return
+	}
+	for _, obj := range objs {
+		if app, ok := obj.(*appv1.Application); ok {
+			ctrl.requestAppRefresh(app.QualifiedName(), CompareWithRecent.Pointer(), nil)
+		}
+	}
+}
+
 // autoSync will initiate a sync operation for an application configured with automated sync
-func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *appv1.SyncStatus, resources []appv1.ResourceStatus, revisionUpdated bool) (*appv1.ApplicationCondition, time.Duration) {
+func (ctrl *ApplicationController) autoSync(app *appv1.Application, project *appv1.AppProject, syncStatus *appv1.SyncStatus, resources []appv1.ResourceStatus, managedResources []managedResource, revisionUpdated bool) (*appv1.ApplicationCondition, time.Duration) {
 	logCtx := getAppLog(app)
 	ts := stats.NewTimingStats()
 	defer func() {
@@ -2081,7 +2172,15 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 		return nil, 0
 	}
 
-	if !app.Spec.SyncPolicy.Automated.Prune {
+	if pending := ctrl.pendingDependencies(app); len(pending) > 0 {
+		logCtx.Infof("Skipping auto-sync: waiting for dependencies %s to be synced and healthy", strings.Join(pending, ", "))
+		return nil, 0
+	}
+
+	// the defaults and the restrictions of the project apply to the sync policy of the application
+	syncPolicy := project.GetEffectiveSyncPolicy(app.Spec.SyncPolicy)
+
+	if !syncPolicy.Automated.Prune {
 		requirePruneOnly := true
 		for _, r := range resources {
 			if r.Status != appv1.SyncStatusCodeSynced && !r.RequiresPruning {
@@ -2095,7 +2194,7 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 		}
 	}
 
-	selfHeal := app.Spec.SyncPolicy.Automated.SelfHeal
+	selfHeal := syncPolicy.Automated.SelfHeal
 	// Multi-Source Apps with selfHeal disabled should not trigger an autosync if
 	// the last sync revision and the new sync revision is the same.
 	if app.Spec.HasMultipleSources() && !selfHeal && reflect.DeepEqual(app.Status.Sync.Revisions, syncStatus.Revisions) {
@@ -2107,19 +2206,21 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 	desiredCommitSHAsMS := syncStatus.Revisions
 	alreadyAttempted, attemptPhase := alreadyAttemptedSync(app, desiredCommitSHA, desiredCommitSHAsMS, app.Spec.HasMultipleSources(), revisionUpdated)
 	ts.AddCheckpoint("already_attempted_sync_ms")
+	// driftEvent records the out-of-band changes reverted by the self-heal
+	var driftEvent *appv1.DriftEvent
 	op := appv1.Operation{
 		Sync: &appv1.SyncOperation{
 			Revision:    desiredCommitSHA,
-			Prune:       app.Spec.SyncPolicy.Automated.Prune,
-			SyncOptions: app.Spec.SyncPolicy.SyncOptions,
+			Prune:       syncPolicy.Automated.Prune,
+			SyncOptions: syncPolicy.SyncOptions,
 			Revisions:   desiredCommitSHAsMS,
 		},
 		InitiatedBy: appv1.OperationInitiator{Automated: true},
 		Retry:       appv1.RetryStrategy{Limit: 5},
 	}
 
-	if app.Spec.SyncPolicy.Retry != nil {
-		op.Retry = *app.Spec.SyncPolicy.Retry
+	if syncPolicy.Retry != nil {
+		op.Retry = *syncPolicy.Retry
 	}
 	// It is possible for manifests to remain OutOfSync even after a sync/kubectl apply (e.g.
 	// auto-sync with pruning disabled). We need to ensure that we do not keep Syncing an
@@ -2146,6 +2247,8 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 				return nil, 0
 			}
 			op.Sync.SelfHealAttemptsCount++
+			event := newDriftEvent(app, desiredCommitSHA, resources, managedResources, time.Now())
+			driftEvent = &event
 			for _, resource := range resources {
 				if resource.Status != appv1.SyncStatusCodeSynced {
 					op.Sync.Resources = append(op.Sync.Resources, appv1.SyncOperationResource{
@@ -2159,7 +2262,7 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 	}
 	ts.AddCheckpoint("already_attempted_check_ms")
 
-	if app.Spec.SyncPolicy.Automated.Prune && !app.Spec.SyncPolicy.Automated.AllowEmpty {
+	if syncPolicy.Automated.Prune && !syncPolicy.Automated.AllowEmpty {
 		bAllNeedPrune := true
 		for _, r := range resources {
 			if !r.RequiresPruning {
@@ -2173,6 +2276,11 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 		}
 	}
 
+	if approvalCond := syncApprovalPendingCondition(app, syncPolicy, desiredCommitSHA, desiredCommitSHAsMS, resources); approvalCond != nil {
+		logCtx.Infof("Skipping auto-sync: sync to %s waits for the approval of the diff", desiredCommitSHA)
+		return approvalCond, 0
+	}
+
 	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
 	ts.AddCheckpoint("get_applications_ms")
 	start := time.Now()
@@ -2192,6 +2300,10 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 	}
 	ctrl.writeBackToInformer(updatedApp)
 	ts.AddCheckpoint("write_back_to_informer_ms")
+	if driftEvent != nil {
+		appendDriftEvent(app, *driftEvent)
+		ctrl.logAppEvent(context.TODO(), app, argo.EventInfo{Reason: argo.EventReasonDriftReverted, Type: corev1.EventTypeNormal}, fmt.Sprintf("Reverting out-of-band changes of %d resources", len(driftEvent.Resources)))
+	}
 
 	var target string
 	if updatedApp.Spec.HasMultipleSources() {
@@ -2382,6 +2494,17 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				}
 				return nil, nil
 			},
+			dependsOnIndex: func(obj any) ([]string, error) {
+				app, ok := obj.(*appv1.Application)
+				if !ok {
+					return nil, nil
+				}
+				keys := make([]string, 0, len(app.Spec.DependsOn))
+				for _, dependency := range app.Spec.DependsOn {
+					keys = append(keys, app.Namespace+"/"+dependency)
+				}
+				return keys, nil
+			},
 		},
 	)
 	lister := applisters.NewApplicationLister(informer.GetIndexer())
@@ -2428,6 +2551,10 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				}
 
 				ctrl.requestAppRefresh(newApp.QualifiedName(), compareWith, delay)
+				if oldOK && newOK && (oldApp.Status.Health.Status != newApp.Status.Health.Status || oldApp.Status.Sync.Status != newApp.Status.Sync.Status) {
+					// the applications depending on this one might be waiting for it to be synced and healthy
+					ctrl.requestDependentAppsRefresh(key)
+				}
 				if !newOK || (delay != nil && *delay != time.Duration(0)) {
 					ctrl.appOperationQueue.AddRateLimited(key)
 				}
@@ -2478,6 +2605,19 @@ func (ctrl *ApplicationController) RegisterClusterSecretUpdater(ctx context.Cont
 	go updater.Run(ctx)
 }
 
+// RegisterClusterAuthRotator starts the periodic rotation of the credentials of the clusters managed by this shard
+func (ctrl *ApplicationController) RegisterClusterAuthRotator(ctx context.Context) {
+	rotator := newClusterAuthRotator(ctrl.db, ctrl.settingsMgr, ctrl.clusterSharding.IsManagedCluster)
+	go rotator.Run(ctx)
+}
+
+// RegisterClusterDiscoverer starts the automatic registration of the downstream clusters of the management clusters
+// managed by this shard
+func (ctrl *ApplicationController) RegisterClusterDiscoverer(ctx context.Context) {
+	discoverer := newClusterDiscoverer(ctrl.db, ctrl.settingsMgr, ctrl.clusterSharding.IsManagedCluster)
+	go discoverer.Run(ctx)
+}
+
 func isOperationInProgress(app *appv1.Application) bool {
 	return app.Status.OperationState != nil && !app.Status.OperationState.Phase.Completed()
 }
@@ -2521,6 +2661,24 @@ func (ctrl *ApplicationController) toAppKey(appName string) string {
 	return strings.ReplaceAll(appName, "_", "/")
 }
 
+// getAppPriority returns the priority of the application with the given key in the work queues, normal if the
+// application is unknown or its priority is invalid
+func (ctrl *ApplicationController) getAppPriority(appKey string) appv1.ApplicationPriority {
+	if ctrl.appInformer == nil {
+		return appv1.ApplicationPriorityNormal
+	}
+	obj, exists, err := ctrl.appInformer.GetIndexer().GetByKey(appKey)
+	if err != nil || !exists {
+		return appv1.ApplicationPriorityNormal
+	}
+	app, ok := obj.(*appv1.Application)
+	if !ok {
+		return appv1.ApplicationPriorityNormal
+	}
+	priority, _ := app.Spec.SyncPolicy.GetPriority()
+	return priority
+}
+
 func (ctrl *ApplicationController) toAppQualifiedName(appName, appNamespace string) string {
 	return fmt.Sprintf("%s/%s", appNamespace, appName)
 }
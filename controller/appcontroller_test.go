@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"strconv"
 	"testing"
 	"time"
 
@@ -53,6 +55,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
 	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
 	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
+	"github.com/argoproj/argo-cd/v3/util/helm"
 	"github.com/argoproj/argo-cd/v3/util/settings"
 	utilTest "github.com/argoproj/argo-cd/v3/util/test"
 )
@@ -75,6 +78,8 @@ type fakeData struct {
 	applicationNamespaces          []string
 	updateRevisionForPathsResponse *apiclient.UpdateRevisionForPathsResponse
 	additionalObjs                 []runtime.Object
+	clusterSecretData              map[string][]byte
+	helmLookupProxy                *helm.LookupProxy
 }
 
 type MockKubectl struct {
@@ -104,6 +109,7 @@ func newFakeControllerWithResync(data *fakeData, appResyncPeriod time.Duration,
 	if err != nil {
 		panic(err)
 	}
+	maps.Copy(clust.Data, data.clusterSecretData)
 
 	// Mock out call to GenerateManifest
 	mockRepoClient := mockrepoclient.RepoServerServiceClient{}
@@ -189,6 +195,8 @@ func newFakeControllerWithResync(data *fakeData, appResyncPeriod time.Duration,
 		normalizers.IgnoreNormalizerOpts{},
 		testEnableEventList,
 		false,
+		RevisionHistoryRetention{},
+		data.helmLookupProxy,
 	)
 	db := &dbmocks.ArgoDB{}
 	db.On("GetApplicationControllerReplicas").Return(1)
@@ -593,7 +601,7 @@ func TestAutoSync(t *testing.T) {
 		Status:   v1alpha1.SyncStatusCodeOutOfSync,
 		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 	}
-	cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+	cond, _ := ctrl.autoSync(app, &defaultProj, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, nil, true)
 	assert.Nil(t, cond)
 	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 	require.NoError(t, err)
@@ -602,6 +610,146 @@ func TestAutoSync(t *testing.T) {
 	assert.False(t, app.Operation.Sync.Prune)
 }
 
+func TestAutoSyncProjectSyncPolicy(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.SyncPolicy.Automated.Prune = true
+	app.Spec.SyncPolicy.SyncOptions = v1alpha1.SyncOptions{"Replace=true"}
+	proj := defaultProj.DeepCopy()
+	proj.Spec.SyncPolicy = &v1alpha1.ProjectSyncPolicy{
+		SyncOptions:           v1alpha1.SyncOptions{"ServerSideApply=true"},
+		Retry:                 &v1alpha1.RetryStrategy{Limit: 2},
+		DeniedSyncOptions:     []string{"Replace=true"},
+		DisableAutomatedPrune: true,
+	}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	cond, _ := ctrl.autoSync(app, proj, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, nil, true)
+	assert.Nil(t, cond)
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, app.Operation)
+	assert.False(t, app.Operation.Sync.Prune)
+	assert.Equal(t, v1alpha1.SyncOptions{"ServerSideApply=true"}, app.Operation.Sync.SyncOptions)
+	assert.Equal(t, int64(2), app.Operation.Retry.Limit)
+}
+
+func TestAutoSyncDependencies(t *testing.T) {
+	newDependency := func(name string, syncStatus v1alpha1.SyncStatusCode, healthStatus health.HealthStatusCode) *v1alpha1.Application {
+		dependency := newFakeApp()
+		dependency.Name = name
+		dependency.Status.Sync.Status = syncStatus
+		dependency.Status.Health.Status = healthStatus
+		return dependency
+	}
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	resources := []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}
+
+	t.Run("Pending", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.DependsOn = []string{"database", "config", "unknown"}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{
+			app,
+			newDependency("database", v1alpha1.SyncStatusCodeSynced, health.HealthStatusProgressing),
+			newDependency("config", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy),
+		}}, nil)
+
+		assert.Equal(t, []string{"database", "unknown"}, ctrl.pendingDependencies(app))
+		cond, _ := ctrl.autoSync(app, &defaultProj, &syncStatus, resources, nil, true)
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Nil(t, app.Operation)
+	})
+	t.Run("Ready", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.DependsOn = []string{"database"}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{
+			app,
+			newDependency("database", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy),
+		}}, nil)
+
+		assert.Empty(t, ctrl.pendingDependencies(app))
+		cond, _ := ctrl.autoSync(app, &defaultProj, &syncStatus, resources, nil, true)
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.NotNil(t, app.Operation)
+	})
+}
+
+func TestAutoSyncRequireDiffApproval(t *testing.T) {
+	const revision = "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: revision,
+	}
+	resources := []v1alpha1.ResourceStatus{
+		{Name: "guestbook", Namespace: test.FakeDestNamespace, Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync},
+		{Name: "guestbook", Namespace: test.FakeDestNamespace, Kind: kube.ServiceKind, Status: v1alpha1.SyncStatusCodeSynced},
+	}
+
+	t.Run("Pending", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.SyncOptions = v1alpha1.SyncOptions{"RequireDiffApproval=true"}
+		app.Annotations = map[string]string{v1alpha1.AnnotationKeySyncApprovedRevision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+
+		cond, _ := ctrl.autoSync(app, &defaultProj, &syncStatus, resources, nil, true)
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionSyncApprovalPending, cond.Type)
+		assert.Contains(t, cond.Message, "Deployment fake-dest-ns/guestbook (OutOfSync)")
+		assert.NotContains(t, cond.Message, "Service")
+		assert.Contains(t, cond.Message, "argocd app approve fake-argocd-ns/my-app --revision "+revision)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Nil(t, app.Operation)
+	})
+	t.Run("Approved", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.SyncOptions = v1alpha1.SyncOptions{"RequireDiffApproval=true"}
+		app.Annotations = map[string]string{v1alpha1.AnnotationKeySyncApprovedRevision: revision}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+
+		cond, _ := ctrl.autoSync(app, &defaultProj, &syncStatus, resources, nil, true)
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.NotNil(t, app.Operation)
+	})
+	t.Run("PendingByDefaultOfProject", func(t *testing.T) {
+		app := newFakeApp()
+		proj := defaultProj.DeepCopy()
+		proj.Spec.SyncPolicy = &v1alpha1.ProjectSyncPolicy{SyncOptions: v1alpha1.SyncOptions{"RequireDiffApproval=true"}}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, proj}}, nil)
+
+		cond, _ := ctrl.autoSync(app, proj, &syncStatus, resources, nil, true)
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionSyncApprovalPending, cond.Type)
+	})
+}
+
+func TestRequestDependentAppsRefresh(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.DependsOn = []string{"database"}
+	dependency := newFakeApp()
+	dependency.Name = "database"
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, dependency}}, nil)
+
+	ctrl.requestDependentAppsRefresh(dependency.QualifiedName())
+
+	requested, level := ctrl.isRefreshRequested(app.QualifiedName())
+	assert.True(t, requested)
+	assert.Equal(t, CompareWithRecent, level)
+	requested, _ = ctrl.isRefreshRequested(dependency.QualifiedName())
+	assert.False(t, requested)
+}
+
 func TestAutoSyncEnabledSetToTrue(t *testing.T) {
 	app := newFakeApp()
 	enable := true
@@ -611,7 +759,7 @@ func TestAutoSyncEnabledSetToTrue(t *testing.T) {
 		Status:   v1alpha1.SyncStatusCodeOutOfSync,
 		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 	}
-	cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+	cond, _ := ctrl.autoSync(app, &defaultProj, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, nil, true)
 	assert.Nil(t, cond)
 	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 	require.NoError(t, err)
@@ -632,7 +780,7 @@ func TestMultiSourceSelfHeal(t *testing.T) {
 			Status:    v1alpha1.SyncStatusCodeOutOfSync,
 			Revisions: []string{"z", "x", "v"},
 		}
-		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook-1", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+		cond, _ := ctrl.autoSync(app, &defaultProj, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook-1", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, nil, true)
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -648,7 +796,7 @@ func TestMultiSourceSelfHeal(t *testing.T) {
 			Status:    v1alpha1.SyncStatusCodeOutOfSync,
 			Revisions: []string{"z", "x", "v"},
 		}
-		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook-1", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+		cond, _ := ctrl.autoSync(app, &defaultProj, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook-1", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, nil, true)
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -664,7 +812,7 @@ func TestAutoSyncNotAllowEmpty(t *testing.T) {
 		Status:   v1alpha1.SyncStatusCodeOutOfSync,
 		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 	}
-	cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{}, true)
+	cond, _ := ctrl.autoSync(app, &defaultProj, &syncStatus, []v1alpha1.ResourceStatus{}, nil, true)
 	assert.NotNil(t, cond)
 }
 
@@ -677,7 +825,7 @@ func TestAutoSyncAllowEmpty(t *testing.T) {
 		Status:   v1alpha1.SyncStatusCodeOutOfSync,
 		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 	}
-	cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{}, true)
+	cond, _ := ctrl.autoSync(app, &defaultProj, &syncStatus, []v1alpha1.ResourceStatus{}, nil, true)
 	assert.Nil(t, cond)
 }
 
@@ -691,7 +839,7 @@ func TestSkipAutoSync(t *testing.T) {
 			Status:   v1alpha1.SyncStatusCodeOutOfSync,
 			Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
 		}
-		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{}, true)
+		cond, _ := ctrl.autoSync(app, &defaultProj, &syncStatus, []v1alpha1.ResourceStatus{}, nil, true)
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -706,7 +854,7 @@ func TestSkipAutoSync(t *testing.T) {
 			Status:   v1alpha1.SyncStatusCodeSynced,
 			Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 		}
-		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{}, true)
+		cond, _ := ctrl.autoSync(app, &defaultProj, &syncStatus, []v1alpha1.ResourceStatus{}, nil, true)
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -722,7 +870,7 @@ func TestSkipAutoSync(t *testing.T) {
 			Status:   v1alpha1.SyncStatusCodeOutOfSync,
 			Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 		}
-		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{}, true)
+		cond, _ := ctrl.autoSync(app, &defaultProj, &syncStatus, []v1alpha1.ResourceStatus{}, nil, true)
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -739,7 +887,7 @@ func TestSkipAutoSync(t *testing.T) {
 			Status:   v1alpha1.SyncStatusCodeOutOfSync,
 			Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 		}
-		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{}, true)
+		cond, _ := ctrl.autoSync(app, &defaultProj, &syncStatus, []v1alpha1.ResourceStatus{}, nil, true)
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -756,7 +904,7 @@ func TestSkipAutoSync(t *testing.T) {
 			Status:   v1alpha1.SyncStatusCodeOutOfSync,
 			Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 		}
-		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{}, true)
+		cond, _ := ctrl.autoSync(app, &defaultProj, &syncStatus, []v1alpha1.ResourceStatus{}, nil, true)
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -782,7 +930,7 @@ func TestSkipAutoSync(t *testing.T) {
 			Status:   v1alpha1.SyncStatusCodeOutOfSync,
 			Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 		}
-		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+		cond, _ := ctrl.autoSync(app, &defaultProj, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, nil, true)
 		assert.NotNil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -796,9 +944,9 @@ func TestSkipAutoSync(t *testing.T) {
 			Status:   v1alpha1.SyncStatusCodeOutOfSync,
 			Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 		}
-		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{
+		cond, _ := ctrl.autoSync(app, &defaultProj, &syncStatus, []v1alpha1.ResourceStatus{
 			{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync, RequiresPruning: true},
-		}, true)
+		}, nil, true)
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -834,7 +982,7 @@ func TestAutoSyncIndicateError(t *testing.T) {
 			Source:   *app.Spec.Source.DeepCopy(),
 		},
 	}
-	cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+	cond, _ := ctrl.autoSync(app, &defaultProj, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, nil, true)
 	assert.NotNil(t, cond)
 	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 	require.NoError(t, err)
@@ -877,7 +1025,7 @@ func TestAutoSyncParameterOverrides(t *testing.T) {
 			Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
 		},
 	}
-	cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+	cond, _ := ctrl.autoSync(app, &defaultProj, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, nil, true)
 	assert.Nil(t, cond)
 	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 	require.NoError(t, err)
@@ -1573,6 +1721,37 @@ func TestNeedRefreshAppStatus(t *testing.T) {
 				assert.Equal(t, v1alpha1.RefreshTypeNormal, refreshType)
 				assert.Equal(t, CompareWithLatest, compareWith)
 			})
+
+			t.Run("ensure that CompareWithLatest level is used if the resource.ignoreDifferences setting changes", func(t *testing.T) {
+				app := app.DeepCopy()
+				ctrl := newFakeController(&fakeData{apps: []runtime.Object{}, configMapData: map[string]string{
+					"resource.ignoreDifferences": "- group: apps\n  kind: Deployment\n  jsonPointers: [/spec/replicas]",
+				}}, nil)
+
+				needRefresh, refreshType, compareWith := ctrl.needRefreshAppStatus(app, 1*time.Hour, 2*time.Hour)
+				assert.True(t, needRefresh)
+				assert.Equal(t, v1alpha1.RefreshTypeNormal, refreshType)
+				assert.Equal(t, CompareWithLatest, compareWith)
+
+				app.Status.Sync.ComparedTo.IgnoreDifferences = append(app.Status.Sync.ComparedTo.IgnoreDifferences, v1alpha1.ResourceIgnoreDifferences{
+					Group: "apps", Kind: "Deployment", JSONPointers: []string{"/spec/replicas"},
+				})
+				needRefresh, _, _ = ctrl.needRefreshAppStatus(app, 1*time.Hour, 2*time.Hour)
+				assert.False(t, needRefresh)
+			})
+
+			t.Run("ensure that CompareWithLatest level is used if ignored managed fields managers change", func(t *testing.T) {
+				app := app.DeepCopy()
+				needRefresh, _, _ := ctrl.needRefreshAppStatus(app, 1*time.Hour, 2*time.Hour)
+				assert.False(t, needRefresh)
+
+				app.SetAnnotations(map[string]string{v1alpha1.AnnotationKeyIgnoreManagedFieldsManagers: "kube-controller-manager"})
+
+				needRefresh, refreshType, compareWith := ctrl.needRefreshAppStatus(app, 1*time.Hour, 2*time.Hour)
+				assert.True(t, needRefresh)
+				assert.Equal(t, v1alpha1.RefreshTypeNormal, refreshType)
+				assert.Equal(t, CompareWithLatest, compareWith)
+			})
 		})
 	}
 }
@@ -1738,6 +1917,115 @@ func TestUpdateReconciledAt(t *testing.T) {
 	})
 }
 
+func TestProcessAppRefreshQueueItem_ReconcileInterval(t *testing.T) {
+	for _, reconcileInterval := range []string{"", "5m"} {
+		t.Run("reconcileInterval="+reconcileInterval, func(t *testing.T) {
+			app := newFakeApp()
+			app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{ReconcileInterval: reconcileInterval}
+			reconciledAt := metav1.NewTime(time.Now().Add(-10 * time.Minute))
+			app.Status = v1alpha1.ApplicationStatus{ReconciledAt: &reconciledAt}
+			app.Status.Sync = v1alpha1.SyncStatus{ComparedTo: v1alpha1.ComparedTo{Source: app.Spec.GetSource(), Destination: app.Spec.Destination, IgnoreDifferences: app.Spec.IgnoreDifferences}}
+			ctrl := newFakeController(&fakeData{
+				apps: []runtime.Object{app, &defaultProj},
+				manifestResponse: &apiclient.ManifestResponse{
+					Manifests: []string{},
+					Namespace: test.FakeDestNamespace,
+					Server:    test.FakeClusterURL,
+					Revision:  "abc123",
+				},
+				managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+			}, nil)
+			ctrl.statusRefreshTimeout = time.Hour
+			ctrl.statusHardRefreshTimeout = 0
+			key, _ := cache.MetaNamespaceKeyFunc(app)
+			fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+			fakeAppCs.ReactionChain = nil
+			receivedPatch := map[string]any{}
+			fakeAppCs.AddReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+				if patchAction, ok := action.(kubetesting.PatchAction); ok {
+					require.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+				}
+				return true, &v1alpha1.Application{}, nil
+			})
+			ctrl.appRefreshQueue.AddRateLimited(key)
+
+			ctrl.processAppRefreshQueueItem()
+
+			_, updated, err := unstructured.NestedString(receivedPatch, "status", "reconciledAt")
+			require.NoError(t, err)
+			assert.Equal(t, reconcileInterval != "", updated)
+		})
+	}
+}
+
+func TestNextReconcileDelay(t *testing.T) {
+	app := newFakeApp()
+	assert.Equal(t, 5*time.Minute, nextReconcileDelay(app, 5*time.Minute))
+
+	reconciledAt := metav1.NewTime(time.Now().Add(-2 * time.Minute))
+	app.Status.ReconciledAt = &reconciledAt
+	assert.InDelta(t, 3*time.Minute, nextReconcileDelay(app, 5*time.Minute), float64(time.Second))
+
+	// expired interval, the app is being reconciled
+	assert.Equal(t, time.Minute, nextReconcileDelay(app, time.Minute))
+}
+
+func TestProcessAppRefreshQueueItem_ClusterMaintenance(t *testing.T) {
+	for _, maintenance := range []bool{false, true} {
+		t.Run(fmt.Sprintf("maintenance=%v", maintenance), func(t *testing.T) {
+			app := newFakeApp()
+			ctrl := newFakeController(&fakeData{
+				apps:              []runtime.Object{app, &defaultProj},
+				clusterSecretData: map[string][]byte{"maintenance": []byte(strconv.FormatBool(maintenance))},
+				manifestResponse: &apiclient.ManifestResponse{
+					Manifests: []string{`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"test-cm","namespace":"` + test.FakeDestNamespace + `"}}`},
+					Namespace: test.FakeDestNamespace,
+					Server:    test.FakeClusterURL,
+					Revision:  "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+				},
+				managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+			}, nil)
+			key, _ := cache.MetaNamespaceKeyFunc(app)
+			ctrl.requestAppRefresh(app.Name, CompareWithLatest.Pointer(), nil)
+			ctrl.appRefreshQueue.AddRateLimited(key)
+
+			ctrl.processAppRefreshQueueItem()
+
+			updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), app.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+			if maintenance {
+				assert.Nil(t, updatedApp.Operation, "auto-sync must be suspended while the cluster is in maintenance")
+			} else {
+				assert.NotNil(t, updatedApp.Operation)
+			}
+		})
+	}
+}
+
+func TestProcessAppRefreshQueueItem_ClusterSyncWindow(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{
+		apps:              []runtime.Object{app, &defaultProj},
+		clusterSecretData: map[string][]byte{"syncWindows": []byte(`[{"kind":"deny","schedule":"0 0 * * *","duration":"24h"}]`)},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"test-cm","namespace":"` + test.FakeDestNamespace + `"}}`},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}, nil)
+	key, _ := cache.MetaNamespaceKeyFunc(app)
+	ctrl.requestAppRefresh(app.Name, CompareWithLatest.Pointer(), nil)
+	ctrl.appRefreshQueue.AddRateLimited(key)
+
+	ctrl.processAppRefreshQueueItem()
+
+	updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), app.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, updatedApp.Operation, "auto-sync must be prevented by the sync window of the destination cluster")
+}
+
 func TestUpdateHealthStatusTransitionTime(t *testing.T) {
 	deployment := kube.MustToUnstructured(&appsv1.Deployment{
 		TypeMeta: metav1.TypeMeta{
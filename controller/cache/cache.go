@@ -151,6 +151,10 @@ type LiveStateCache interface {
 	Run(ctx context.Context) error
 	// Returns information about monitored clusters
 	GetClustersInfo() []clustercache.ClusterInfo
+	// Returns the watch lag observed for the cluster since the previous call
+	GetClusterWatchLag(server string) (WatchLagInfo, bool)
+	// Returns the node and pod capacity of the cluster, or false if the cluster is not cached
+	GetClusterCapacityInfo(server string) (*appv1.ClusterCapacityInfo, bool)
 	// Init must be executed before cache can be used
 	Init() error
 	// UpdateShard will update the shard of ClusterSharding when the shard has changed.
@@ -166,9 +170,10 @@ type PodInfo struct {
 }
 
 type NodeInfo struct {
-	Name       string
-	Capacity   corev1.ResourceList
-	SystemInfo corev1.NodeSystemInfo
+	Name        string
+	Capacity    corev1.ResourceList
+	Allocatable corev1.ResourceList
+	SystemInfo  corev1.NodeSystemInfo
 }
 
 type ResourceInfo struct {
@@ -206,6 +211,8 @@ func NewLiveStateCache(
 		metricsServer:    metricsServer,
 		clusterSharding:  clusterSharding,
 		resourceTracking: resourceTracking,
+		watchLags:        newWatchLagTracker(),
+		capacity:         newCapacityTracker(),
 	}
 }
 
@@ -235,6 +242,8 @@ type liveStateCache struct {
 	clusters      map[string]clustercache.ClusterCache
 	cacheSettings cacheSettings
 	lock          sync.RWMutex
+	watchLags     *watchLagTracker
+	capacity      *capacityTracker
 }
 
 func (c *liveStateCache) loadCacheSettings() (*cacheSettings, error) {
@@ -534,6 +543,7 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 		clustercache.SetPopulateResourceInfoHandler(func(un *unstructured.Unstructured, isRoot bool) (any, bool) {
 			res := &ResourceInfo{}
 			populateNodeInfo(un, res, resourceCustomLabels)
+			c.capacity.update(cluster.Server, kube.GetResourceKey(un), res)
 			c.lock.RLock()
 			cacheSettings := c.cacheSettings
 			c.lock.RUnlock()
@@ -576,6 +586,8 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 			ref = newRes.Ref
 		} else {
 			ref = oldRes.Ref
+			// the capacity of new and updated resources is recorded when their info is populated
+			c.capacity.remove(cluster.Server, oldRes.ResourceKey())
 		}
 
 		c.lock.RLock()
@@ -614,9 +626,10 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 		c.onObjectUpdated(toNotify, ref)
 	})
 
-	_ = clusterCache.OnEvent(func(_ watch.EventType, un *unstructured.Unstructured) {
+	_ = clusterCache.OnEvent(func(event watch.EventType, un *unstructured.Unstructured) {
 		gvk := un.GroupVersionKind()
 		c.metricsServer.IncClusterEventsCount(cluster.Server, gvk.Group, gvk.Kind)
+		c.watchLags.record(cluster.Server, event, un, time.Now())
 	})
 
 	_ = clusterCache.OnProcessEventsHandler(func(duration time.Duration, processedEventsNumber int) {
@@ -647,7 +660,9 @@ func (c *liveStateCache) invalidate(cacheSettings cacheSettings) {
 	clusters := c.clusters
 	c.lock.Unlock()
 
-	for _, clust := range clusters {
+	for server, clust := range clusters {
+		// the capacity is recorded again once the cluster cache is resynchronized
+		c.capacity.delete(server)
 		clust.Invalidate(clustercache.SetSettings(cacheSettings.clusterSettings))
 	}
 	log.Info("live state cache invalidated")
@@ -836,6 +851,7 @@ func (c *liveStateCache) handleModEvent(oldCluster *appv1.Cluster, newCluster *a
 	c.lock.Unlock()
 	if ok {
 		if !c.canHandleCluster(newCluster) {
+			c.capacity.delete(newCluster.Server)
 			cluster.Invalidate()
 			c.lock.Lock()
 			delete(c.clusters, newCluster.Server)
@@ -866,6 +882,7 @@ func (c *liveStateCache) handleModEvent(oldCluster *appv1.Cluster, newCluster *a
 		}
 
 		if len(updateSettings) > 0 || forceInvalidate {
+			c.capacity.delete(newCluster.Server)
 			cluster.Invalidate(updateSettings...)
 			go func() {
 				// warm up cluster cache
@@ -886,6 +903,8 @@ func (c *liveStateCache) handleDeleteEvent(clusterServer string) {
 		delete(c.clusters, clusterServer)
 		c.lock.Unlock()
 	}
+	c.watchLags.delete(clusterServer)
+	c.capacity.delete(clusterServer)
 }
 
 func (c *liveStateCache) GetClustersInfo() []clustercache.ClusterInfo {
@@ -905,6 +924,14 @@ func (c *liveStateCache) GetClustersInfo() []clustercache.ClusterInfo {
 	return res
 }
 
+func (c *liveStateCache) GetClusterWatchLag(server string) (WatchLagInfo, bool) {
+	return c.watchLags.pop(server)
+}
+
+func (c *liveStateCache) GetClusterCapacityInfo(server string) (*appv1.ClusterCapacityInfo, bool) {
+	return c.capacity.get(server)
+}
+
 func (c *liveStateCache) GetClusterCache(server *appv1.Cluster) (clustercache.ClusterCache, error) {
 	return c.getSyncedCluster(server)
 }
@@ -50,6 +50,7 @@ func TestHandleModEvent_HasChanges(_ *testing.T) {
 			"https://mycluster": clusterCache,
 		},
 		clusterSharding: sharding.NewClusterSharding(db, 0, 1, common.DefaultShardingAlgorithm),
+		capacity:        newCapacityTracker(),
 	}
 
 	clustersCache.handleModEvent(&appv1.Cluster{
@@ -82,6 +83,7 @@ func TestHandleModEvent_ClusterExcluded(t *testing.T) {
 		clusters:         map[string]cache.ClusterCache{"https://mycluster": clusterCache},
 		cacheSettings:    cacheSettings{},
 		lock:             sync.RWMutex{},
+		capacity:         newCapacityTracker(),
 	}
 
 	clustersCache.handleModEvent(&appv1.Cluster{
@@ -107,6 +109,7 @@ func TestHandleModEvent_NoChanges(_ *testing.T) {
 			"https://mycluster": clusterCache,
 		},
 		clusterSharding: sharding.NewClusterSharding(db, 0, 1, common.DefaultShardingAlgorithm),
+		capacity:        newCapacityTracker(),
 	}
 
 	clustersCache.handleModEvent(&appv1.Cluster{
@@ -124,6 +127,7 @@ func TestHandleAddEvent_ClusterExcluded(t *testing.T) {
 	clustersCache := liveStateCache{
 		clusters:        map[string]cache.ClusterCache{},
 		clusterSharding: sharding.NewClusterSharding(db, 0, 2, common.DefaultShardingAlgorithm),
+		capacity:        newCapacityTracker(),
 	}
 	clustersCache.handleAddEvent(&appv1.Cluster{
 		Server: "https://mycluster",
@@ -150,6 +154,8 @@ func TestHandleDeleteEvent_CacheDeadlock(t *testing.T) {
 		},
 		clusterSharding: sharding.NewClusterSharding(db, 0, 1, common.DefaultShardingAlgorithm),
 		settingsMgr:     settingsMgr,
+		watchLags:       newWatchLagTracker(),
+		capacity:        newCapacityTracker(),
 		// Set the lock here so we can reference it later
 		//nolint:govet // We need to overwrite here to have access to the lock
 		lock: liveStateCacheLock,
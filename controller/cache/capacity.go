@@ -0,0 +1,143 @@
+package cache
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	corev1 "k8s.io/api/core/v1"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// capacityContribution is the contribution of a node or a pod to the capacity of its cluster
+type capacityContribution struct {
+	nodes             int64
+	pods              int64
+	allocatablePods   int64
+	allocatableCPU    int64
+	allocatableMemory int64
+	kubeletVersion    string
+}
+
+// clusterCapacity is the capacity of a cluster, maintained from the contributions of its nodes and pods
+type clusterCapacity struct {
+	contributions   map[kube.ResourceKey]capacityContribution
+	total           capacityContribution
+	kubeletVersions map[string]int
+}
+
+// capacityTracker maintains the node and pod capacity of the clusters as their resources are added to and removed
+// from the cluster caches, so that it is not computed by iterating all cached resources.
+type capacityTracker struct {
+	lock     sync.Mutex
+	clusters map[string]*clusterCapacity
+}
+
+func newCapacityTracker() *capacityTracker {
+	return &capacityTracker{clusters: make(map[string]*clusterCapacity)}
+}
+
+// getCapacityContribution returns the contribution of the resource to the capacity of the cluster, or false if it is
+// neither a node nor a pod
+func getCapacityContribution(key kube.ResourceKey, info *ResourceInfo) (capacityContribution, bool) {
+	switch {
+	case info.NodeInfo != nil && key.Group == "" && key.Kind == "Node":
+		return capacityContribution{
+			nodes:             1,
+			allocatablePods:   info.NodeInfo.Allocatable.Pods().Value(),
+			allocatableCPU:    info.NodeInfo.Allocatable.Cpu().MilliValue(),
+			allocatableMemory: info.NodeInfo.Allocatable.Memory().Value(),
+			kubeletVersion:    info.NodeInfo.SystemInfo.KubeletVersion,
+		}, true
+	case info.PodInfo != nil && key.Group == "" && key.Kind == kube.PodKind:
+		contribution := capacityContribution{}
+		if info.PodInfo.Phase != corev1.PodSucceeded && info.PodInfo.Phase != corev1.PodFailed {
+			contribution.pods = 1
+		}
+		return contribution, true
+	}
+	return capacityContribution{}, false
+}
+
+// update records the node or pod of the cluster, replacing its previous version
+func (t *capacityTracker) update(server string, key kube.ResourceKey, info *ResourceInfo) {
+	contribution, ok := getCapacityContribution(key, info)
+	if !ok {
+		return
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	capacity, ok := t.clusters[server]
+	if !ok {
+		capacity = &clusterCapacity{contributions: map[kube.ResourceKey]capacityContribution{}, kubeletVersions: map[string]int{}}
+		t.clusters[server] = capacity
+	}
+	capacity.remove(key)
+	capacity.contributions[key] = contribution
+	capacity.total.nodes += contribution.nodes
+	capacity.total.pods += contribution.pods
+	capacity.total.allocatablePods += contribution.allocatablePods
+	capacity.total.allocatableCPU += contribution.allocatableCPU
+	capacity.total.allocatableMemory += contribution.allocatableMemory
+	if contribution.kubeletVersion != "" {
+		capacity.kubeletVersions[contribution.kubeletVersion]++
+	}
+}
+
+// remove removes the node or pod of the cluster
+func (t *capacityTracker) remove(server string, key kube.ResourceKey) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if capacity, ok := t.clusters[server]; ok {
+		capacity.remove(key)
+	}
+}
+
+func (c *clusterCapacity) remove(key kube.ResourceKey) {
+	contribution, ok := c.contributions[key]
+	if !ok {
+		return
+	}
+	delete(c.contributions, key)
+	c.total.nodes -= contribution.nodes
+	c.total.pods -= contribution.pods
+	c.total.allocatablePods -= contribution.allocatablePods
+	c.total.allocatableCPU -= contribution.allocatableCPU
+	c.total.allocatableMemory -= contribution.allocatableMemory
+	if contribution.kubeletVersion != "" {
+		c.kubeletVersions[contribution.kubeletVersion]--
+		if c.kubeletVersions[contribution.kubeletVersion] == 0 {
+			delete(c.kubeletVersions, contribution.kubeletVersion)
+		}
+	}
+}
+
+// get returns the capacity of the cluster, or false if none of its nodes and pods is cached
+func (t *capacityTracker) get(server string) (*appv1.ClusterCapacityInfo, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	capacity, ok := t.clusters[server]
+	if !ok {
+		return nil, false
+	}
+	info := &appv1.ClusterCapacityInfo{
+		NodesCount:        capacity.total.nodes,
+		PodsCount:         capacity.total.pods,
+		AllocatablePods:   capacity.total.allocatablePods,
+		AllocatableCPU:    capacity.total.allocatableCPU,
+		AllocatableMemory: capacity.total.allocatableMemory,
+	}
+	for version := range capacity.kubeletVersions {
+		info.KubeletVersions = append(info.KubeletVersions, version)
+	}
+	sort.Strings(info.KubeletVersions)
+	return info, true
+}
+
+// delete removes the capacity recorded for the cluster, e.g. when its cache is invalidated and resynchronized
+func (t *capacityTracker) delete(server string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	delete(t.clusters, server)
+}
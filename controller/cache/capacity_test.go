@@ -0,0 +1,68 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestCapacityTracker(t *testing.T) {
+	const server = "https://cluster"
+	tracker := newCapacityTracker()
+	updateNode := func(name, version string) {
+		tracker.update(server, kube.ResourceKey{Kind: "Node", Name: name}, &ResourceInfo{NodeInfo: &NodeInfo{
+			Name: name,
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("1500m"),
+				corev1.ResourceMemory: resource.MustParse("1Gi"),
+				corev1.ResourcePods:   resource.MustParse("110"),
+			},
+			SystemInfo: corev1.NodeSystemInfo{KubeletVersion: version},
+		}})
+	}
+	updatePod := func(name string, phase corev1.PodPhase) {
+		tracker.update(server, kube.ResourceKey{Kind: kube.PodKind, Namespace: "default", Name: name}, &ResourceInfo{PodInfo: &PodInfo{Phase: phase}})
+	}
+
+	_, ok := tracker.get(server)
+	assert.False(t, ok)
+
+	updateNode("node-1", "v1.29.1")
+	updateNode("node-2", "v1.28.5")
+	updateNode("node-3", "v1.29.1")
+	updatePod("running", corev1.PodRunning)
+	updatePod("pending", corev1.PodPending)
+	updatePod("completed", corev1.PodSucceeded)
+	tracker.update(server, kube.ResourceKey{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "app"}, &ResourceInfo{})
+	capacityInfo, ok := tracker.get(server)
+	require.True(t, ok)
+	assert.Equal(t, &appv1.ClusterCapacityInfo{
+		NodesCount:        3,
+		PodsCount:         2,
+		AllocatablePods:   330,
+		AllocatableCPU:    4500,
+		AllocatableMemory: 3 * 1024 * 1024 * 1024,
+		KubeletVersions:   []string{"v1.28.5", "v1.29.1"},
+	}, capacityInfo)
+
+	// updates replace the previous version of the resource
+	updatePod("running", corev1.PodSucceeded)
+	updateNode("node-2", "v1.29.1")
+	tracker.remove(server, kube.ResourceKey{Kind: "Node", Name: "node-3"})
+	capacityInfo, ok = tracker.get(server)
+	require.True(t, ok)
+	assert.Equal(t, int64(2), capacityInfo.NodesCount)
+	assert.Equal(t, int64(1), capacityInfo.PodsCount)
+	assert.Equal(t, int64(220), capacityInfo.AllocatablePods)
+	assert.Equal(t, []string{"v1.29.1"}, capacityInfo.KubeletVersions)
+
+	tracker.delete(server)
+	_, ok = tracker.get(server)
+	assert.False(t, ok)
+}
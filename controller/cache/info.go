@@ -469,9 +469,10 @@ func populateHostNodeInfo(un *unstructured.Unstructured, res *ResourceInfo) {
 		return
 	}
 	res.NodeInfo = &NodeInfo{
-		Name:       node.Name,
-		Capacity:   node.Status.Capacity,
-		SystemInfo: node.Status.NodeInfo,
+		Name:        node.Name,
+		Capacity:    node.Status.Capacity,
+		Allocatable: node.Status.Allocatable,
+		SystemInfo:  node.Status.NodeInfo,
 	}
 }
 
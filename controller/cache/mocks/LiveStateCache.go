@@ -55,6 +55,64 @@ func (_m *LiveStateCache) GetClusterCache(server *v1alpha1.Cluster) (cache.Clust
 	return r0, r1
 }
 
+// GetClusterCapacityInfo provides a mock function with given fields: server
+func (_m *LiveStateCache) GetClusterCapacityInfo(server string) (*v1alpha1.ClusterCapacityInfo, bool) {
+	ret := _m.Called(server)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClusterCapacityInfo")
+	}
+
+	var r0 *v1alpha1.ClusterCapacityInfo
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (*v1alpha1.ClusterCapacityInfo, bool)); ok {
+		return rf(server)
+	}
+	if rf, ok := ret.Get(0).(func(string) *v1alpha1.ClusterCapacityInfo); ok {
+		r0 = rf(server)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.ClusterCapacityInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(server)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GetClusterWatchLag provides a mock function with given fields: server
+func (_m *LiveStateCache) GetClusterWatchLag(server string) (controllercache.WatchLagInfo, bool) {
+	ret := _m.Called(server)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClusterWatchLag")
+	}
+
+	var r0 controllercache.WatchLagInfo
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (controllercache.WatchLagInfo, bool)); ok {
+		return rf(server)
+	}
+	if rf, ok := ret.Get(0).(func(string) controllercache.WatchLagInfo); ok {
+		r0 = rf(server)
+	} else {
+		r0 = ret.Get(0).(controllercache.WatchLagInfo)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(server)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GetClustersInfo provides a mock function with no fields
 func (_m *LiveStateCache) GetClustersInfo() []cache.ClusterInfo {
 	ret := _m.Called()
@@ -0,0 +1,91 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// maxWatchLag is the maximum delay which is considered to be a watch lag. Longer delays are caused by objects which
+// were last modified before the watch was (re)started.
+const maxWatchLag = 5 * time.Minute
+
+// WatchLagInfo contains the watch lag observed for a cluster
+type WatchLagInfo struct {
+	// MaxLag is the maximum delay between the modification of a resource and the reception of its watch event
+	MaxLag time.Duration
+	// EventsCount is the number of watch events used to measure the lag
+	EventsCount int64
+}
+
+// watchLagTracker records the watch lag of the clusters between two measurements
+type watchLagTracker struct {
+	lock sync.Mutex
+	lags map[string]*WatchLagInfo
+}
+
+func newWatchLagTracker() *watchLagTracker {
+	return &watchLagTracker{lags: make(map[string]*WatchLagInfo)}
+}
+
+// record records the lag of the watch event of the cluster
+func (t *watchLagTracker) record(server string, event watch.EventType, un *unstructured.Unstructured, now time.Time) {
+	// the modification time of deleted objects is not the time of the deletion
+	if event == watch.Deleted {
+		return
+	}
+	lag, ok := getWatchLag(un, now)
+	if !ok {
+		return
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	info, ok := t.lags[server]
+	if !ok {
+		info = &WatchLagInfo{}
+		t.lags[server] = info
+	}
+	info.MaxLag = max(info.MaxLag, lag)
+	info.EventsCount++
+}
+
+// pop returns the watch lag recorded for the cluster since the previous call, and starts a new measurement
+func (t *watchLagTracker) pop(server string) (WatchLagInfo, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	info, ok := t.lags[server]
+	if !ok {
+		return WatchLagInfo{}, false
+	}
+	delete(t.lags, server)
+	return *info, true
+}
+
+// delete removes the watch lag recorded for the cluster
+func (t *watchLagTracker) delete(server string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	delete(t.lags, server)
+}
+
+// getWatchLag returns the delay between the last modification of the object, as recorded by its managed fields, and
+// now. Returns false if the modification time of the object is unknown or too old.
+func getWatchLag(un *unstructured.Unstructured, now time.Time) (time.Duration, bool) {
+	var modifiedAt time.Time
+	for _, field := range un.GetManagedFields() {
+		if field.Time != nil && field.Time.After(modifiedAt) {
+			modifiedAt = field.Time.Time
+		}
+	}
+	if modifiedAt.IsZero() {
+		return 0, false
+	}
+	lag := now.Sub(modifiedAt)
+	if lag > maxWatchLag {
+		return 0, false
+	}
+	// the clocks of the API server and the controller may differ slightly
+	return max(lag, 0), true
+}
@@ -0,0 +1,60 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+func newModifiedObject(times ...time.Time) *unstructured.Unstructured {
+	un := &unstructured.Unstructured{}
+	var fields []metav1.ManagedFieldsEntry
+	for _, t := range times {
+		fields = append(fields, metav1.ManagedFieldsEntry{Manager: "test", Time: &metav1.Time{Time: t}})
+	}
+	un.SetManagedFields(fields)
+	return un
+}
+
+func TestGetWatchLag(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+
+	_, ok := getWatchLag(&unstructured.Unstructured{}, now)
+	assert.False(t, ok)
+
+	lag, ok := getWatchLag(newModifiedObject(now.Add(-time.Minute), now.Add(-2*time.Second)), now)
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Second, lag)
+
+	lag, ok = getWatchLag(newModifiedObject(now.Add(time.Second)), now)
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), lag)
+
+	_, ok = getWatchLag(newModifiedObject(now.Add(-time.Hour)), now)
+	assert.False(t, ok)
+}
+
+func TestWatchLagTracker(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	tracker := newWatchLagTracker()
+
+	tracker.record("https://cluster", watch.Modified, newModifiedObject(now.Add(-time.Second)), now)
+	tracker.record("https://cluster", watch.Added, newModifiedObject(now.Add(-3*time.Second)), now)
+	tracker.record("https://cluster", watch.Deleted, newModifiedObject(now.Add(-4*time.Second)), now)
+
+	info, ok := tracker.pop("https://cluster")
+	assert.True(t, ok)
+	assert.Equal(t, WatchLagInfo{MaxLag: 3 * time.Second, EventsCount: 2}, info)
+
+	_, ok = tracker.pop("https://cluster")
+	assert.False(t, ok)
+
+	tracker.record("https://cluster", watch.Modified, newModifiedObject(now.Add(-time.Second)), now)
+	tracker.delete("https://cluster")
+	_, ok = tracker.pop("https://cluster")
+	assert.False(t, ok)
+}
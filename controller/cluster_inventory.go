@@ -0,0 +1,140 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
+	log "github.com/sirupsen/logrus"
+
+	statecache "github.com/argoproj/argo-cd/v3/controller/cache"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// ClusterResourcesPath is the path of the debug server endpoint exporting the inventory of the resources of a cluster
+// held by the live state cache of the controller
+const ClusterResourcesPath = "/debug/cluster-resources"
+
+// ClusterResourceInventory is the inventory of the resources of a cluster held by the live state cache
+type ClusterResourceInventory struct {
+	Server         string                  `json:"server"`
+	ResourcesCount int                     `json:"resourcesCount"`
+	Kinds          []ResourceKindInventory `json:"kinds"`
+}
+
+// ResourceKindInventory is the inventory of the cached resources of a kind. The resources are counted by namespace,
+// by managing application, by kind of owner and by health status.
+type ResourceKindInventory struct {
+	Group        string         `json:"group,omitempty"`
+	Kind         string         `json:"kind"`
+	Count        int            `json:"count"`
+	Namespaces   map[string]int `json:"namespaces,omitempty"`
+	Applications map[string]int `json:"applications,omitempty"`
+	OwnerKinds   map[string]int `json:"ownerKinds,omitempty"`
+	Health       map[string]int `json:"health,omitempty"`
+}
+
+// getClusterResourceInventory returns the inventory of the resources of the cluster held by the live state cache
+func getClusterResourceInventory(stateCache statecache.LiveStateCache, cluster *appv1.Cluster) (*ClusterResourceInventory, error) {
+	kinds := map[string]*ResourceKindInventory{}
+	err := stateCache.IterateResources(cluster, func(res *clustercache.Resource, info *statecache.ResourceInfo) {
+		key := res.ResourceKey()
+		kind, ok := kinds[key.Group+"/"+key.Kind]
+		if !ok {
+			kind = &ResourceKindInventory{Group: key.Group, Kind: key.Kind}
+			kinds[key.Group+"/"+key.Kind] = kind
+		}
+		kind.Count++
+		if key.Namespace != "" {
+			kind.Namespaces = incCount(kind.Namespaces, key.Namespace)
+		}
+		if info.AppName != "" {
+			kind.Applications = incCount(kind.Applications, info.AppName)
+		}
+		for _, ownerRef := range res.OwnerRefs {
+			kind.OwnerKinds = incCount(kind.OwnerKinds, ownerRef.Kind)
+		}
+		if info.Health != nil {
+			kind.Health = incCount(kind.Health, string(info.Health.Status))
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	inventory := &ClusterResourceInventory{Server: cluster.Server, Kinds: []ResourceKindInventory{}}
+	for _, kind := range kinds {
+		inventory.ResourcesCount += kind.Count
+		inventory.Kinds = append(inventory.Kinds, *kind)
+	}
+	sort.Slice(inventory.Kinds, func(i, j int) bool {
+		if inventory.Kinds[i].Group != inventory.Kinds[j].Group {
+			return inventory.Kinds[i].Group < inventory.Kinds[j].Group
+		}
+		return inventory.Kinds[i].Kind < inventory.Kinds[j].Kind
+	})
+	return inventory, nil
+}
+
+func incCount(counts map[string]int, key string) map[string]int {
+	if counts == nil {
+		counts = map[string]int{}
+	}
+	counts[key]++
+	return counts
+}
+
+// serveClusterResources serves the inventory of the resources of the cluster given by the server query parameter. Only
+// the clusters handled by the shard of the controller are cached.
+func (ctrl *ApplicationController) serveClusterResources(w http.ResponseWriter, r *http.Request) {
+	server := r.URL.Query().Get("server")
+	if server == "" {
+		http.Error(w, "the server query parameter is required", http.StatusBadRequest)
+		return
+	}
+	handled := false
+	for _, info := range ctrl.stateCache.GetClustersInfo() {
+		if info.Server == server {
+			handled = true
+			break
+		}
+	}
+	if !handled {
+		http.Error(w, fmt.Sprintf("cluster %s is not handled by this controller shard", server), http.StatusNotFound)
+		return
+	}
+	cluster, err := ctrl.db.GetCluster(r.Context(), server)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to get cluster %s: %v", server, err), http.StatusInternalServerError)
+		return
+	}
+	inventory, err := getClusterResourceInventory(ctrl.stateCache, cluster)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to get the resources of cluster %s: %v", server, err), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(inventory)
+}
+
+// serveDebug serves the debug endpoints of the controller until the context is done. The endpoints expose the
+// resources of the clusters without authentication, the address must only be reachable by port-forwarding to the
+// controller pod, which requires the Kubernetes permission to do so.
+func (ctrl *ApplicationController) serveDebug(ctx context.Context, addr string) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(ClusterResourcesPath, ctrl.serveClusterResources)
+	server := &http.Server{Addr: addr, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Warnf("Failed to serve the debug endpoints on %s: %v", addr, err)
+	}
+}
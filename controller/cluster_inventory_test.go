@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	statecache "github.com/argoproj/argo-cd/v3/controller/cache"
+	mockstatecache "github.com/argoproj/argo-cd/v3/controller/cache/mocks"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newInventoryStateCache() *mockstatecache.LiveStateCache {
+	stateCache := &mockstatecache.LiveStateCache{}
+	stateCache.On("GetClustersInfo").Return([]clustercache.ClusterInfo{{Server: v1alpha1.KubernetesInternalAPIServerAddr}})
+	stateCache.On("IterateResources", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		callback := args[1].(func(res *clustercache.Resource, info *statecache.ResourceInfo))
+		callback(&clustercache.Resource{
+			Ref: corev1.ObjectReference{Name: "guestbook", Kind: "Deployment", APIVersion: "apps/v1", Namespace: "default"},
+		}, &statecache.ResourceInfo{AppName: "guestbook", Health: &health.HealthStatus{Status: health.HealthStatusHealthy}})
+		callback(&clustercache.Resource{
+			Ref:       corev1.ObjectReference{Name: "guestbook-1", Kind: "Pod", APIVersion: "v1", Namespace: "default"},
+			OwnerRefs: []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "guestbook"}},
+		}, &statecache.ResourceInfo{Health: &health.HealthStatus{Status: health.HealthStatusHealthy}})
+		callback(&clustercache.Resource{
+			Ref: corev1.ObjectReference{Name: "guestbook-2", Kind: "Pod", APIVersion: "v1", Namespace: "other"},
+		}, &statecache.ResourceInfo{Health: &health.HealthStatus{Status: health.HealthStatusProgressing}})
+		callback(&clustercache.Resource{
+			Ref: corev1.ObjectReference{Name: "minikube", Kind: "Node", APIVersion: "v1"},
+		}, &statecache.ResourceInfo{})
+	}).Return(nil)
+	return stateCache
+}
+
+func TestGetClusterResourceInventory(t *testing.T) {
+	inventory, err := getClusterResourceInventory(newInventoryStateCache(), &v1alpha1.Cluster{Server: v1alpha1.KubernetesInternalAPIServerAddr})
+	require.NoError(t, err)
+	assert.Equal(t, &ClusterResourceInventory{
+		Server:         v1alpha1.KubernetesInternalAPIServerAddr,
+		ResourcesCount: 4,
+		Kinds: []ResourceKindInventory{{
+			Kind:  "Node",
+			Count: 1,
+		}, {
+			Kind:       "Pod",
+			Count:      2,
+			Namespaces: map[string]int{"default": 1, "other": 1},
+			OwnerKinds: map[string]int{"ReplicaSet": 1},
+			Health:     map[string]int{"Healthy": 1, "Progressing": 1},
+		}, {
+			Group:        "apps",
+			Kind:         "Deployment",
+			Count:        1,
+			Namespaces:   map[string]int{"default": 1},
+			Applications: map[string]int{"guestbook": 1},
+			Health:       map[string]int{"Healthy": 1},
+		}},
+	}, inventory)
+}
+
+func TestServeClusterResources(t *testing.T) {
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{&defaultProj}}, nil)
+	ctrl.stateCache = newInventoryStateCache()
+
+	t.Run("MissingServer", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		ctrl.serveClusterResources(rec, httptest.NewRequest(http.MethodGet, ClusterResourcesPath, http.NoBody))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("ClusterNotHandled", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		ctrl.serveClusterResources(rec, httptest.NewRequest(http.MethodGet, ClusterResourcesPath+"?server=https://other", http.NoBody))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Contains(t, rec.Body.String(), "cluster https://other is not handled by this controller shard")
+	})
+
+	t.Run("ClusterHandled", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		ctrl.serveClusterResources(rec, httptest.NewRequest(http.MethodGet, ClusterResourcesPath+"?server="+v1alpha1.KubernetesInternalAPIServerAddr, http.NoBody))
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var inventory ClusterResourceInventory
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &inventory))
+		assert.Equal(t, v1alpha1.KubernetesInternalAPIServerAddr, inventory.Server)
+		assert.Equal(t, 4, inventory.ResourcesCount)
+		assert.Len(t, inventory.Kinds, 3)
+	})
+}
@@ -0,0 +1,345 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/clusterauth"
+	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const (
+	// clusterAuthRotationCheckInterval is how often the controller checks whether cluster credentials are due for rotation
+	clusterAuthRotationCheckInterval = 10 * time.Minute
+	// kubePublicClusterEndpoint is the value of the cluster endpoint annotation of clusters added from kube-public
+	kubePublicClusterEndpoint = "kube-public"
+)
+
+// clusterAuthRotator periodically rotates the credentials of the managed clusters which were created by
+// `argocd cluster add`: service account bearer tokens according to the interval configured in argocd-cm, and
+// client certificates before they expire, once their renewal was approved. If enabled in argocd-cm, it also updates
+// the CA data of clusters added from kube-public once the cluster CA rotated.
+type clusterAuthRotator struct {
+	db            db.ArgoDB
+	settingsMgr   *settings.SettingsManager
+	clusterFilter func(cluster *appv1.Cluster) bool
+	newClientset  func(config *rest.Config) (kubernetes.Interface, error)
+	now           func() time.Time
+	// pendingCertificates are the client certificate requests which wait for an approval, by cluster server
+	pendingCertificates map[string]pendingClientCertificate
+}
+
+// pendingClientCertificate is a CertificateSigningRequest for a renewed client certificate and its private key
+type pendingClientCertificate struct {
+	name    string
+	keyData []byte
+}
+
+func newClusterAuthRotator(db db.ArgoDB, settingsMgr *settings.SettingsManager, clusterFilter func(cluster *appv1.Cluster) bool) *clusterAuthRotator {
+	return &clusterAuthRotator{
+		db:            db,
+		settingsMgr:   settingsMgr,
+		clusterFilter: clusterFilter,
+		newClientset: func(config *rest.Config) (kubernetes.Interface, error) {
+			return kubernetes.NewForConfig(config)
+		},
+		now:                 time.Now,
+		pendingCertificates: map[string]pendingClientCertificate{},
+	}
+}
+
+func (r *clusterAuthRotator) Run(ctx context.Context) {
+	ticker := time.NewTicker(clusterAuthRotationCheckInterval)
+	defer ticker.Stop()
+	for {
+		r.rotateClusters(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *clusterAuthRotator) rotateClusters(ctx context.Context) {
+	interval, err := r.settingsMgr.GetClusterAuthRotationInterval()
+	if err != nil {
+		// client certificates are still renewed, regardless of the token rotation interval
+		log.Warnf("Failed to get cluster auth rotation interval: %v", err)
+		interval = 0
+	}
+	caAutoUpdate, err := r.settingsMgr.IsClusterCAAutoUpdateEnabled()
+	if err != nil {
+		log.Warnf("Failed to check whether cluster CA auto update is enabled: %v", err)
+	}
+	clusters, err := r.db.ListClusters(ctx)
+	if err != nil {
+		log.Warnf("Failed to list clusters for auth rotation: %v", err)
+		return
+	}
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+		if r.clusterFilter != nil && !r.clusterFilter(cluster) {
+			continue
+		}
+		logCtx := log.WithField("cluster", cluster.Server)
+		if caAutoUpdate && cluster.Annotations[common.AnnotationKeyClusterEndpoint] == kubePublicClusterEndpoint {
+			updated, err := r.updateClusterCA(ctx, cluster)
+			if err != nil {
+				// the credentials are still rotated with the current CA data
+				logCtx.Warnf("Failed to update cluster CA data: %v", err)
+			}
+			if updated {
+				// the credentials are checked again on the next run, using the new CA data
+				logCtx.Info("Updated cluster CA data from kube-public")
+				continue
+			}
+		}
+		switch {
+		case isClientCertificateRenewalDue(cluster, r.now()):
+			renewed, err := r.renewClientCertificate(ctx, cluster)
+			if err != nil {
+				logCtx.Warnf("Failed to renew cluster client certificate: %v", err)
+				continue
+			}
+			if renewed {
+				logCtx.Info("Renewed cluster client certificate")
+			}
+		case interval > 0 && isClusterAuthRotationDue(cluster, interval, r.now()):
+			if err := r.rotateClusterAuth(ctx, cluster); err != nil {
+				logCtx.Warnf("Failed to rotate cluster auth: %v", err)
+				continue
+			}
+			logCtx.Info("Rotated cluster auth")
+		}
+	}
+}
+
+// isClientCertificateRenewalDue returns true if the cluster uses a client certificate issued for the cluster manager
+// which is close to its expiry.
+func isClientCertificateRenewalDue(cluster *appv1.Cluster, now time.Time) bool {
+	if cluster.Config.BearerToken != "" || len(cluster.Config.CertData) == 0 || len(cluster.Config.KeyData) == 0 {
+		return false
+	}
+	cert, err := clusterauth.ParseClusterManagerClientCertificate(cluster.Config.CertData)
+	if err != nil {
+		// only client certificates issued by `argocd cluster add --auth-type=cert` can be renewed
+		return false
+	}
+	return clusterauth.IsClientCertificateRenewalDue(cert, now)
+}
+
+// renewClientCertificate requests a new client certificate for the cluster and replaces the current one once the
+// request was approved and signed. argocd-manager cannot approve its own requests, so they must be approved by an
+// approver of the cluster, e.g. with `kubectl certificate approve`. Returns true if the certificate was replaced.
+func (r *clusterAuthRotator) renewClientCertificate(ctx context.Context, cluster *appv1.Cluster) (bool, error) {
+	cert, err := clusterauth.ParseClusterManagerClientCertificate(cluster.Config.CertData)
+	if err != nil {
+		return false, err
+	}
+	restCfg, err := cluster.RESTConfig()
+	if err != nil {
+		return false, fmt.Errorf("failed to get REST config for cluster: %w", err)
+	}
+	clientset, err := r.newClientset(restCfg)
+	if err != nil {
+		return false, fmt.Errorf("failed to create Kubernetes clientset: %w", err)
+	}
+
+	pending, ok := r.pendingCertificates[cluster.Server]
+	if !ok {
+		// request the same lifetime as the current certificate
+		name, keyData, err := clusterauth.RequestClusterManagerClientCertificate(clientset, cert.NotAfter.Sub(cert.NotBefore))
+		if err != nil {
+			return false, fmt.Errorf("failed to request new client certificate: %w", err)
+		}
+		r.pendingCertificates[cluster.Server] = pendingClientCertificate{name: name, keyData: keyData}
+		log.WithField("cluster", cluster.Server).Infof("Requested a new cluster client certificate, waiting for the approval of the certificate signing request %q", name)
+		return false, nil
+	}
+	certData, err := clusterauth.GetIssuedClientCertificate(clientset, pending.name)
+	if err != nil {
+		// a new certificate is requested on the next run
+		delete(r.pendingCertificates, cluster.Server)
+		clusterauth.DeleteCertificateSigningRequest(clientset, pending.name)
+		return false, err
+	}
+	if len(certData) == 0 {
+		log.WithField("cluster", cluster.Server).Infof("Waiting for the approval of the certificate signing request %q", pending.name)
+		return false, nil
+	}
+	delete(r.pendingCertificates, cluster.Server)
+	defer clusterauth.DeleteCertificateSigningRequest(clientset, pending.name)
+
+	updated := cluster.DeepCopy()
+	updated.Config.CertData = certData
+	updated.Config.KeyData = pending.keyData
+
+	// Test the certificate we just created before persisting it
+	if err := r.verifyClusterConnection(updated); err != nil {
+		return false, err
+	}
+	if _, err := r.db.UpdateCluster(ctx, updated); err != nil {
+		return false, fmt.Errorf("failed to update cluster in database: %w", err)
+	}
+	return true, nil
+}
+
+func (r *clusterAuthRotator) verifyClusterConnection(cluster *appv1.Cluster) error {
+	restCfg, err := cluster.RESTConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get REST config for cluster: %w", err)
+	}
+	clientset, err := r.newClientset(restCfg)
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes clientset: %w", err)
+	}
+	if _, err := clientset.Discovery().ServerVersion(); err != nil {
+		return fmt.Errorf("failed to get server version: %w", err)
+	}
+	return nil
+}
+
+// isClusterAuthRotationDue returns true if the cluster uses a service account secret token which has not been
+// rotated within the given interval.
+func isClusterAuthRotationDue(cluster *appv1.Cluster, interval time.Duration, now time.Time) bool {
+	if cluster.Config.BearerToken == "" {
+		return false
+	}
+	claims, err := clusterauth.ParseServiceAccountToken(cluster.Config.BearerToken)
+	if err != nil || claims.SecretName == "" {
+		// only tokens stored in service account secrets can be rotated
+		return false
+	}
+	rotatedAt, err := time.Parse(time.RFC3339, cluster.Annotations[common.AnnotationKeyClusterAuthRotatedAt])
+	if err != nil {
+		return true
+	}
+	return now.Sub(rotatedAt) >= interval
+}
+
+func (r *clusterAuthRotator) rotateClusterAuth(ctx context.Context, cluster *appv1.Cluster) error {
+	claims, err := clusterauth.ParseServiceAccountToken(cluster.Config.BearerToken)
+	if err != nil {
+		return err
+	}
+	restCfg, err := cluster.RESTConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get REST config for cluster: %w", err)
+	}
+	clientset, err := r.newClientset(restCfg)
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes clientset: %w", err)
+	}
+	newSecret, err := clusterauth.GenerateNewClusterManagerSecret(clientset, claims)
+	if err != nil {
+		return fmt.Errorf("failed to generate new cluster manager secret: %w", err)
+	}
+
+	updated := cluster.DeepCopy()
+	// we are using token auth, make sure we don't store client-cert information
+	updated.Config.KeyData = nil
+	updated.Config.CertData = nil
+	updated.Config.BearerToken = string(newSecret.Data["token"])
+	if updated.Annotations == nil {
+		updated.Annotations = map[string]string{}
+	}
+	updated.Annotations[common.AnnotationKeyClusterAuthRotatedAt] = r.now().UTC().Format(time.RFC3339)
+
+	// Test the token we just created before persisting it
+	if err := r.verifyClusterConnection(updated); err != nil {
+		return err
+	}
+	if _, err := r.db.UpdateCluster(ctx, updated); err != nil {
+		return fmt.Errorf("failed to update cluster in database: %w", err)
+	}
+	if err := clusterauth.RotateServiceAccountSecrets(clientset, claims, newSecret); err != nil {
+		return fmt.Errorf("failed to rotate service account secrets: %w", err)
+	}
+	return nil
+}
+
+// updateClusterCA replaces the CA data of the cluster with the CA data published in kube-public, if it changed.
+// Returns true if the cluster was updated.
+func (r *clusterAuthRotator) updateClusterCA(ctx context.Context, cluster *appv1.Cluster) (bool, error) {
+	updated, err := r.refreshClusterCA(ctx, cluster)
+	if err != nil || updated == nil {
+		return false, err
+	}
+	if _, err := r.db.UpdateCluster(ctx, updated); err != nil {
+		return false, fmt.Errorf("failed to update cluster in database: %w", err)
+	}
+	return true, nil
+}
+
+// refreshClusterCA returns a copy of the cluster using the CA data published in kube-public, or nil if the published
+// CA data did not change. The cluster-info ConfigMap is read over a connection verified with the current CA data, so
+// the CA data can only be refreshed while the API server certificate is still signed by a trusted CA, e.g. while
+// kube-public publishes both the old and the new CA during a CA rotation. If the cluster has a bootstrap token, the
+// published endpoints must also be signed by it, so that the CA data cannot be replaced by anyone allowed to edit
+// the ConfigMap.
+func (r *clusterAuthRotator) refreshClusterCA(ctx context.Context, cluster *appv1.Cluster) (*appv1.Cluster, error) {
+	restCfg, err := cluster.RawRestConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get REST config for cluster: %w", err)
+	}
+	// the cluster-info ConfigMap is readable anonymously, so no credentials are sent
+	clientset, err := r.newClientset(rest.AnonymousClientConfig(restCfg))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kubernetes clientset: %w", err)
+	}
+	endpoints, err := clusterauth.GetKubePublicEndpoints(ctx, clientset, cluster.Config.BootstrapToken)
+	if err != nil {
+		if isCertificateVerificationError(err) {
+			return nil, errors.New("the cluster API server certificate is not signed by the current CA, so the CA data cannot be refreshed securely from kube-public: add the cluster again")
+		}
+		return nil, fmt.Errorf("failed to get cluster endpoint from kube-public: %w", err)
+	}
+	var caData []byte
+	found := false
+	for _, endpoint := range endpoints {
+		if strings.TrimRight(endpoint.Server, "/") == strings.TrimRight(cluster.Server, "/") {
+			caData = endpoint.CertificateAuthorityData
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, errors.New("none of the cluster endpoints published in kube-public matches the cluster server")
+	}
+	if len(caData) == 0 {
+		return nil, errors.New("kube-public does not publish CA data for the cluster server")
+	}
+	if bytes.Equal(caData, cluster.Config.CAData) {
+		return nil, nil
+	}
+
+	updated := cluster.DeepCopy()
+	updated.Config.CAData = caData
+	// Verify the API server certificate is signed by the new CA before persisting it
+	if err := r.verifyClusterConnection(updated); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// isCertificateVerificationError returns true if the error is caused by a server certificate which is not signed by a
+// trusted CA
+func isCertificateVerificationError(err error) bool {
+	var verificationErr *tls.CertificateVerificationError
+	var unknownAuthorityErr x509.UnknownAuthorityError
+	return errors.As(err, &verificationErr) || errors.As(err, &unknownAuthorityErr)
+}
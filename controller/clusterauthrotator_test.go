@@ -0,0 +1,267 @@
+package controller
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	clientcmdapiv1 "k8s.io/client-go/tools/clientcmd/api/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/clusterauth"
+	dbmocks "github.com/argoproj/argo-cd/v3/util/db/mocks"
+)
+
+// service account secret token for kube-system/argocd-manager, see util/clusterauth
+const testServiceAccountToken = "eyJhbGciOiJSUzI1NiIsImtpZCI6IiJ9.eyJpc3MiOiJrdWJlcm5ldGVzL3NlcnZpY2VhY2NvdW50Iiwia3ViZXJuZXRlcy5pby9zZXJ2aWNlYWNjb3VudC9uYW1lc3BhY2UiOiJrdWJlLXN5c3RlbSIsImt1YmVybmV0ZXMuaW8vc2VydmljZWFjY291bnQvc2VjcmV0Lm5hbWUiOiJhcmdvY2QtbWFuYWdlci10b2tlbi10ajc5ciIsImt1YmVybmV0ZXMuaW8vc2VydmljZWFjY291bnQvc2VydmljZS1hY2NvdW50Lm5hbWUiOiJhcmdvY2QtbWFuYWdlciIsImt1YmVybmV0ZXMuaW8vc2VydmljZWFjY291bnQvc2VydmljZS1hY2NvdW50LnVpZCI6IjkxZGQzN2NmLThkOTItMTFlOS1hMDkxLWQ2NWYyYWU3ZmE4ZCIsInN1YiI6InN5c3RlbTpzZXJ2aWNlYWNjb3VudDprdWJlLXN5c3RlbTphcmdvY2QtbWFuYWdlciJ9.ytZjt2pDV8-A7DBMR06zQ3wt9cuVEfq262TQw7sdra-KRpDpMPnziMhc8bkwvgW-LGhTWUh5iu1y-1QhEx6mtbCt7vQArlBRxfvM5ys6ClFkplzq5c2TtZ7EzGSD0Up7tdxuG9dvR6TGXYdfFcG779yCdZo2H48sz5OSJfdEriduMEY1iL5suZd3ebOoVi1fGflmqFEkZX6SvxkoArl5mtNP6TvZ1eTcn64xh4ws152hxio42E-eSnl_CET4tpB5vgP5BVlSKW2xB7w2GJxqdETA5LJRI_OilY77dTOp8cMr_Ck3EOeda3zHfh4Okflg8rZFEeAuJYahQNeAILLkcA"
+
+func TestIsClusterAuthRotationDue(t *testing.T) {
+	now := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	newCluster := func(token string, rotatedAt *time.Time) *v1alpha1.Cluster {
+		cluster := &v1alpha1.Cluster{Server: "https://cluster", Config: v1alpha1.ClusterConfig{BearerToken: token}}
+		if rotatedAt != nil {
+			cluster.Annotations = map[string]string{
+				common.AnnotationKeyClusterAuthRotatedAt: rotatedAt.Format(time.RFC3339),
+			}
+		}
+		return cluster
+	}
+	recently := now.Add(-time.Hour)
+	longAgo := now.Add(-48 * time.Hour)
+
+	assert.False(t, isClusterAuthRotationDue(newCluster("", nil), 24*time.Hour, now), "no bearer token")
+	assert.False(t, isClusterAuthRotationDue(newCluster("not-a-jwt", nil), 24*time.Hour, now), "unparsable token")
+	assert.True(t, isClusterAuthRotationDue(newCluster(testServiceAccountToken, nil), 24*time.Hour, now), "never rotated")
+	assert.False(t, isClusterAuthRotationDue(newCluster(testServiceAccountToken, &recently), 24*time.Hour, now), "rotated recently")
+	assert.True(t, isClusterAuthRotationDue(newCluster(testServiceAccountToken, &longAgo), 24*time.Hour, now), "rotated long ago")
+}
+
+func TestIsClientCertificateRenewalDue(t *testing.T) {
+	notBefore := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newCertificate := func(commonName string) []byte {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: commonName},
+			NotBefore:    notBefore,
+			NotAfter:     notBefore.Add(90 * 24 * time.Hour),
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		require.NoError(t, err)
+		return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	}
+	newCluster := func(certData []byte, bearerToken string) *v1alpha1.Cluster {
+		return &v1alpha1.Cluster{Server: "https://cluster", Config: v1alpha1.ClusterConfig{
+			BearerToken:     bearerToken,
+			TLSClientConfig: v1alpha1.TLSClientConfig{CertData: certData, KeyData: []byte("key")},
+		}}
+	}
+	managerCert := newCertificate(clusterauth.ArgoCDManagerUser)
+	early := notBefore.Add(24 * time.Hour)
+	late := notBefore.Add(80 * 24 * time.Hour)
+
+	assert.False(t, isClientCertificateRenewalDue(&v1alpha1.Cluster{}, late), "no client certificate")
+	assert.False(t, isClientCertificateRenewalDue(newCluster(managerCert, "token"), late), "bearer token takes precedence")
+	assert.False(t, isClientCertificateRenewalDue(newCluster(newCertificate("admin"), ""), late), "not issued for the cluster manager")
+	assert.False(t, isClientCertificateRenewalDue(newCluster(managerCert, ""), early), "not close to expiry")
+	assert.True(t, isClientCertificateRenewalDue(newCluster(managerCert, ""), late), "close to expiry")
+}
+
+func TestClusterAuthRotator_RenewClientCertificate(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: clusterauth.ArgoCDManagerUser},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	certData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	keyData := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	cluster := &v1alpha1.Cluster{Server: "https://cluster", Config: v1alpha1.ClusterConfig{
+		TLSClientConfig: v1alpha1.TLSClientConfig{CertData: certData, KeyData: keyData},
+	}}
+
+	cs := fake.NewClientset()
+	argoDB := &dbmocks.ArgoDB{}
+	rotator := newClusterAuthRotator(argoDB, nil, nil)
+	rotator.newClientset = func(_ *rest.Config) (kubernetes.Interface, error) {
+		return cs, nil
+	}
+
+	renewed, err := rotator.renewClientCertificate(t.Context(), cluster)
+	require.NoError(t, err)
+	assert.False(t, renewed)
+	csrs, err := cs.CertificatesV1().CertificateSigningRequests().List(t.Context(), metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, csrs.Items, 1)
+	csr := csrs.Items[0]
+	assert.Empty(t, csr.Status.Conditions, "the certificate signing request must not be approved by the controller")
+
+	// not approved yet
+	renewed, err = rotator.renewClientCertificate(t.Context(), cluster)
+	require.NoError(t, err)
+	assert.False(t, renewed)
+
+	// approved and signed by the cluster
+	block, _ := pem.Decode(csr.Spec.Request)
+	require.NotNil(t, block)
+	request, err := x509.ParseCertificateRequest(block.Bytes)
+	require.NoError(t, err)
+	der, err = x509.CreateCertificate(rand.Reader, template, template, request.PublicKey, key)
+	require.NoError(t, err)
+	issued := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	csr.Status.Certificate = issued
+	_, err = cs.CertificatesV1().CertificateSigningRequests().UpdateStatus(t.Context(), &csr, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	argoDB.On("UpdateCluster", mock.Anything, mock.MatchedBy(func(c *v1alpha1.Cluster) bool {
+		return bytes.Equal(issued, c.Config.CertData) && !bytes.Equal(keyData, c.Config.KeyData)
+	})).Return(cluster, nil)
+	renewed, err = rotator.renewClientCertificate(t.Context(), cluster)
+	require.NoError(t, err)
+	assert.True(t, renewed)
+	argoDB.AssertExpectations(t)
+	_, err = cs.CertificatesV1().CertificateSigningRequests().Get(t.Context(), csr.Name, metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err), "the certificate signing request must be deleted")
+	assert.Empty(t, rotator.pendingCertificates)
+}
+
+func TestClusterAuthRotator_RefreshClusterCA(t *testing.T) {
+	var clusterInfoKubeconfig, clusterInfoSignature string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/version":
+			_ = json.NewEncoder(w).Encode(map[string]string{"major": "1", "minor": "30"})
+		case "/api/v1/namespaces/kube-public/configmaps/cluster-info":
+			assert.Empty(t, r.Header.Get("Authorization"), "cluster-info must be read anonymously")
+			_ = json.NewEncoder(w).Encode(corev1.ConfigMap{
+				TypeMeta:   metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster-info", Namespace: "kube-public"},
+				Data:       map[string]string{"kubeconfig": clusterInfoKubeconfig, "jws-kubeconfig-abc123": clusterInfoSignature},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	newKubeconfig := func(endpoint string, caData []byte) string {
+		data, err := yaml.Marshal(clientcmdapiv1.Config{Clusters: []clientcmdapiv1.NamedCluster{{
+			Name:    "cluster",
+			Cluster: clientcmdapiv1.Cluster{Server: endpoint, CertificateAuthorityData: caData},
+		}}})
+		require.NoError(t, err)
+		return string(data)
+	}
+	serverCA := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "rotated-ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	rotatedCA := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+
+	rotator := newClusterAuthRotator(nil, nil, nil)
+	newCluster := func(caData []byte) *v1alpha1.Cluster {
+		return &v1alpha1.Cluster{
+			Server:      server.URL,
+			Annotations: map[string]string{common.AnnotationKeyClusterEndpoint: kubePublicClusterEndpoint},
+			Config: v1alpha1.ClusterConfig{
+				BearerToken:     "token",
+				TLSClientConfig: v1alpha1.TLSClientConfig{CAData: caData},
+			},
+		}
+	}
+
+	t.Run("CA is unchanged", func(t *testing.T) {
+		clusterInfoKubeconfig = newKubeconfig(server.URL, serverCA)
+		updated, err := rotator.refreshClusterCA(t.Context(), newCluster(serverCA))
+		require.NoError(t, err)
+		assert.Nil(t, updated)
+	})
+	t.Run("CA rotation started", func(t *testing.T) {
+		caBundle := append(append([]byte{}, serverCA...), rotatedCA...)
+		clusterInfoKubeconfig = newKubeconfig(server.URL, caBundle)
+		updated, err := rotator.refreshClusterCA(t.Context(), newCluster(serverCA))
+		require.NoError(t, err)
+		require.NotNil(t, updated)
+		assert.Equal(t, caBundle, updated.Config.CAData)
+		assert.Equal(t, "token", updated.Config.BearerToken)
+	})
+	t.Run("CA rotation started with a bootstrap token", func(t *testing.T) {
+		caBundle := append(append([]byte{}, serverCA...), rotatedCA...)
+		clusterInfoKubeconfig = newKubeconfig(server.URL, caBundle)
+		// detached JWS as created by kubeadm for the bootstrap token abc123.0123456789abcdef
+		sign := func(kubeconfig string) string {
+			header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","kid":"abc123"}`))
+			mac := hmac.New(sha256.New, []byte("0123456789abcdef"))
+			mac.Write([]byte(header + "." + base64.RawURLEncoding.EncodeToString([]byte(kubeconfig))))
+			return header + ".." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+		}
+		defer func() { clusterInfoSignature = "" }()
+		cluster := newCluster(serverCA)
+		cluster.Config.BootstrapToken = "abc123.0123456789abcdef"
+
+		clusterInfoSignature = sign(clusterInfoKubeconfig)
+		updated, err := rotator.refreshClusterCA(t.Context(), cluster)
+		require.NoError(t, err)
+		require.NotNil(t, updated)
+		assert.Equal(t, caBundle, updated.Config.CAData)
+
+		// the CA data was replaced without signing the kubeconfig with the bootstrap token
+		clusterInfoSignature = sign(newKubeconfig(server.URL, serverCA))
+		_, err = rotator.refreshClusterCA(t.Context(), cluster)
+		require.ErrorContains(t, err, "signature of bootstrap token abc123 is invalid")
+	})
+	t.Run("current CA is not trusted by the server", func(t *testing.T) {
+		clusterInfoKubeconfig = newKubeconfig(server.URL, serverCA)
+		_, err := rotator.refreshClusterCA(t.Context(), newCluster(rotatedCA))
+		require.ErrorContains(t, err, "cannot be refreshed securely")
+	})
+	t.Run("published CA is not trusted by the server", func(t *testing.T) {
+		clusterInfoKubeconfig = newKubeconfig(server.URL, rotatedCA)
+		_, err := rotator.refreshClusterCA(t.Context(), newCluster(serverCA))
+		require.ErrorContains(t, err, "failed to get server version")
+	})
+	t.Run("published endpoint does not match", func(t *testing.T) {
+		clusterInfoKubeconfig = newKubeconfig("https://other-cluster:6443", serverCA)
+		_, err := rotator.refreshClusterCA(t.Context(), newCluster(serverCA))
+		require.ErrorContains(t, err, "matches the cluster server")
+	})
+}
@@ -0,0 +1,392 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"reflect"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	appsetutils "github.com/argoproj/argo-cd/v3/applicationset/utils"
+	"github.com/argoproj/argo-cd/v3/common"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const (
+	// clusterDiscoveryInterval is how often the controller synchronizes the clusters registered by the discovery sources
+	clusterDiscoveryInterval = 3 * time.Minute
+	// rancherLocalClusterName is the name of the Rancher cluster object of the management cluster itself
+	rancherLocalClusterName = "local"
+)
+
+var (
+	clusterAPIClusterGVR = schema.GroupVersionResource{Group: "cluster.x-k8s.io", Version: "v1beta1", Resource: "clusters"}
+	rancherClusterGVR    = schema.GroupVersionResource{Group: "management.cattle.io", Version: "v3", Resource: "clusters"}
+)
+
+// discoveredCluster is a downstream cluster found in a management cluster
+type discoveredCluster struct {
+	obj    *unstructured.Unstructured
+	name   string
+	server string
+	config appv1.ClusterConfig
+}
+
+// clusterDiscoverer registers the downstream clusters of the Cluster API and Rancher management clusters configured in
+// argocd-cm, and removes the clusters it registered once they disappear from the management cluster. Each source is
+// handled by the controller shard which manages its management cluster.
+type clusterDiscoverer struct {
+	db               db.ArgoDB
+	settingsMgr      *settings.SettingsManager
+	clusterFilter    func(cluster *appv1.Cluster) bool
+	newClientset     func(config *rest.Config) (kubernetes.Interface, error)
+	newDynamicClient func(config *rest.Config) (dynamic.Interface, error)
+}
+
+func newClusterDiscoverer(db db.ArgoDB, settingsMgr *settings.SettingsManager, clusterFilter func(cluster *appv1.Cluster) bool) *clusterDiscoverer {
+	return &clusterDiscoverer{
+		db:            db,
+		settingsMgr:   settingsMgr,
+		clusterFilter: clusterFilter,
+		newClientset: func(config *rest.Config) (kubernetes.Interface, error) {
+			return kubernetes.NewForConfig(config)
+		},
+		newDynamicClient: func(config *rest.Config) (dynamic.Interface, error) {
+			return dynamic.NewForConfig(config)
+		},
+	}
+}
+
+func (d *clusterDiscoverer) Run(ctx context.Context) {
+	ticker := time.NewTicker(clusterDiscoveryInterval)
+	defer ticker.Stop()
+	for {
+		d.discoverClusters(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *clusterDiscoverer) discoverClusters(ctx context.Context) {
+	sources, err := d.settingsMgr.GetClusterDiscoverySources()
+	if err != nil {
+		log.Warnf("Failed to get cluster discovery sources: %v", err)
+		return
+	}
+	if len(sources) == 0 {
+		return
+	}
+	clusters, err := d.db.ListClusters(ctx)
+	if err != nil {
+		log.Warnf("Failed to list clusters for cluster discovery: %v", err)
+		return
+	}
+	for _, source := range sources {
+		logCtx := log.WithField("source", source.Name)
+		server := source.Server
+		if server == "" {
+			server = appv1.KubernetesInternalAPIServerAddr
+		}
+		var management *appv1.Cluster
+		for i := range clusters.Items {
+			if clusters.Items[i].Server == server {
+				management = &clusters.Items[i]
+				break
+			}
+		}
+		if management == nil {
+			logCtx.Warnf("Management cluster %s is not registered", server)
+			continue
+		}
+		if d.clusterFilter != nil && !d.clusterFilter(management) {
+			continue
+		}
+		discovered, err := d.listDownstreamClusters(ctx, source, management)
+		if err != nil {
+			// no cluster is removed unless the downstream clusters could be listed
+			logCtx.Warnf("Failed to discover clusters: %v", err)
+			continue
+		}
+		d.syncDiscoveredClusters(ctx, source, discovered, clusters.Items)
+	}
+}
+
+func (d *clusterDiscoverer) listDownstreamClusters(ctx context.Context, source settings.ClusterDiscoverySource, management *appv1.Cluster) ([]discoveredCluster, error) {
+	restCfg, err := management.RESTConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get REST config for management cluster: %w", err)
+	}
+	dynamicClient, err := d.newDynamicClient(restCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dynamic client for management cluster: %w", err)
+	}
+	opts := metav1.ListOptions{}
+	if source.Selector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(source.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector: %w", err)
+		}
+		opts.LabelSelector = selector.String()
+	}
+	switch source.Provider {
+	case settings.ClusterDiscoveryProviderClusterAPI:
+		kubeClient, err := d.newClientset(restCfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create clientset for management cluster: %w", err)
+		}
+		return listClusterAPIClusters(ctx, dynamicClient, kubeClient, source, opts)
+	case settings.ClusterDiscoveryProviderRancher:
+		return d.listRancherClusters(ctx, dynamicClient, source, opts)
+	}
+	return nil, fmt.Errorf("unknown provider %q", source.Provider)
+}
+
+// listClusterAPIClusters returns the provisioned Cluster API clusters, using the admin kubeconfig which Cluster API
+// stores in the <cluster>-kubeconfig secret
+func listClusterAPIClusters(ctx context.Context, dynamicClient dynamic.Interface, kubeClient kubernetes.Interface, source settings.ClusterDiscoverySource, opts metav1.ListOptions) ([]discoveredCluster, error) {
+	namespaces := source.Namespaces
+	if len(namespaces) == 0 {
+		namespaces = []string{metav1.NamespaceAll}
+	}
+	var discovered []discoveredCluster
+	for _, namespace := range namespaces {
+		list, err := dynamicClient.Resource(clusterAPIClusterGVR).Namespace(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list Cluster API clusters: %w", err)
+		}
+		for i := range list.Items {
+			obj := &list.Items[i]
+			if phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase"); phase != "Provisioned" {
+				continue
+			}
+			secret, err := kubeClient.CoreV1().Secrets(obj.GetNamespace()).Get(ctx, obj.GetName()+"-kubeconfig", metav1.GetOptions{})
+			if err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return nil, fmt.Errorf("failed to get kubeconfig of Cluster API cluster %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+			}
+			restCfg, err := clientcmd.RESTConfigFromKubeConfig(secret.Data["value"])
+			if err != nil {
+				return nil, fmt.Errorf("invalid kubeconfig of Cluster API cluster %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+			}
+			discovered = append(discovered, discoveredCluster{
+				obj:    obj,
+				name:   obj.GetName(),
+				server: restCfg.Host,
+				config: appv1.ClusterConfig{
+					Username:    restCfg.Username,
+					Password:    restCfg.Password,
+					BearerToken: restCfg.BearerToken,
+					TLSClientConfig: appv1.TLSClientConfig{
+						Insecure:   restCfg.Insecure,
+						ServerName: restCfg.ServerName,
+						CertData:   restCfg.CertData,
+						KeyData:    restCfg.KeyData,
+						CAData:     restCfg.CAData,
+					},
+				},
+			})
+		}
+	}
+	return discovered, nil
+}
+
+// listRancherClusters returns the ready Rancher downstream clusters, which are accessed through the Rancher proxy
+// using the Rancher API token
+func (d *clusterDiscoverer) listRancherClusters(ctx context.Context, dynamicClient dynamic.Interface, source settings.ClusterDiscoverySource, opts metav1.ListOptions) ([]discoveredCluster, error) {
+	var credentials *corev1.Secret
+	if source.RancherCredentialsSecret != "" {
+		var err error
+		credentials, err = d.settingsMgr.GetSecretByName(source.RancherCredentialsSecret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Rancher credentials: %w", err)
+		}
+	}
+	list, err := dynamicClient.Resource(rancherClusterGVR).List(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Rancher clusters: %w", err)
+	}
+	var discovered []discoveredCluster
+	for i := range list.Items {
+		obj := &list.Items[i]
+		if obj.GetName() == rancherLocalClusterName || !isRancherClusterReady(obj) {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(obj.Object, "spec", "displayName")
+		if name == "" {
+			name = obj.GetName()
+		}
+		cluster := discoveredCluster{
+			obj:    obj,
+			name:   name,
+			server: strings.TrimSuffix(source.RancherURL, "/") + "/k8s/clusters/" + obj.GetName(),
+		}
+		if credentials != nil {
+			cluster.config.BearerToken = string(credentials.Data["token"])
+			cluster.config.CAData = credentials.Data["caData"]
+		}
+		discovered = append(discovered, cluster)
+	}
+	return discovered, nil
+}
+
+func isRancherClusterReady(obj *unstructured.Unstructured) bool {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, item := range conditions {
+		condition, ok := item.(map[string]any)
+		if ok && condition["type"] == "Ready" {
+			return condition["status"] == "True"
+		}
+	}
+	return false
+}
+
+// syncDiscoveredClusters creates or updates the clusters of the discovered downstream clusters, and deletes the
+// clusters previously registered by the source which are no longer discovered. Clusters which were not registered by
+// the source are left untouched.
+func (d *clusterDiscoverer) syncDiscoveredClusters(ctx context.Context, source settings.ClusterDiscoverySource, discovered []discoveredCluster, clusters []appv1.Cluster) {
+	existing := map[string]*appv1.Cluster{}
+	for i := range clusters {
+		existing[clusters[i].Server] = &clusters[i]
+	}
+	desired := map[string]bool{}
+	for _, item := range discovered {
+		logCtx := log.WithFields(log.Fields{"source": source.Name, "cluster": item.server})
+		desired[item.server] = true
+		cluster, err := newDiscoveredCluster(source, item)
+		if err != nil {
+			logCtx.Warnf("Failed to render cluster template: %v", err)
+			continue
+		}
+		current, ok := existing[item.server]
+		switch {
+		case !ok:
+			if _, err := d.db.CreateCluster(ctx, cluster); err != nil {
+				logCtx.Warnf("Failed to register discovered cluster: %v", err)
+				continue
+			}
+			logCtx.Info("Registered discovered cluster")
+		case current.Labels[common.LabelKeyClusterDiscoverySource] != source.Name:
+			logCtx.Debug("Skipping discovered cluster, it is already registered")
+		case isDiscoveredClusterChanged(current, cluster):
+			updated := current.DeepCopy()
+			updated.Name = cluster.Name
+			updated.Project = cluster.Project
+			// labels and annotations added by other components, e.g. the kubernetes version label, are kept
+			updated.Labels = mergeStringMaps(current.Labels, cluster.Labels)
+			updated.Annotations = mergeStringMaps(current.Annotations, cluster.Annotations)
+			updated.Config = cluster.Config
+			if _, err := d.db.UpdateCluster(ctx, updated); err != nil {
+				logCtx.Warnf("Failed to update discovered cluster: %v", err)
+				continue
+			}
+			logCtx.Info("Updated discovered cluster")
+		}
+	}
+	for _, cluster := range clusters {
+		if cluster.Labels[common.LabelKeyClusterDiscoverySource] != source.Name || desired[cluster.Server] {
+			continue
+		}
+		logCtx := log.WithFields(log.Fields{"source": source.Name, "cluster": cluster.Server})
+		if err := d.db.DeleteCluster(ctx, cluster.Server); err != nil {
+			logCtx.Warnf("Failed to remove cluster which is no longer discovered: %v", err)
+			continue
+		}
+		logCtx.Info("Removed cluster which is no longer discovered")
+	}
+}
+
+// newDiscoveredCluster renders the cluster template of the source for a discovered downstream cluster
+func newDiscoveredCluster(source settings.ClusterDiscoverySource, item discoveredCluster) (*appv1.Cluster, error) {
+	params := map[string]any{
+		"name":        item.name,
+		"namespace":   item.obj.GetNamespace(),
+		"provider":    source.Provider,
+		"labels":      item.obj.GetLabels(),
+		"annotations": item.obj.GetAnnotations(),
+	}
+	render := func(tmpl string) (string, error) {
+		return (&appsetutils.Render{}).Replace(tmpl, params, true, nil)
+	}
+	nameTemplate := source.Template.Name
+	if nameTemplate == "" {
+		nameTemplate = "{{ .name }}"
+	}
+	name, err := render(nameTemplate)
+	if err != nil {
+		return nil, err
+	}
+	project, err := render(source.Template.Project)
+	if err != nil {
+		return nil, err
+	}
+	labels := map[string]string{}
+	for key, tmpl := range source.Template.Labels {
+		if labels[key], err = render(tmpl); err != nil {
+			return nil, err
+		}
+	}
+	labels[common.LabelKeyClusterDiscoverySource] = source.Name
+	var annotations map[string]string
+	if len(source.Template.Annotations) > 0 {
+		annotations = map[string]string{}
+		for key, tmpl := range source.Template.Annotations {
+			if annotations[key], err = render(tmpl); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &appv1.Cluster{
+		Server:      item.server,
+		Name:        name,
+		Project:     project,
+		Labels:      labels,
+		Annotations: annotations,
+		Config:      item.config,
+	}, nil
+}
+
+func isDiscoveredClusterChanged(current *appv1.Cluster, cluster *appv1.Cluster) bool {
+	return current.Name != cluster.Name ||
+		current.Project != cluster.Project ||
+		!containsStringMap(current.Labels, cluster.Labels) ||
+		!containsStringMap(current.Annotations, cluster.Annotations) ||
+		!reflect.DeepEqual(current.Config, cluster.Config)
+}
+
+// containsStringMap returns true if all the entries of expected are in actual
+func containsStringMap(actual map[string]string, expected map[string]string) bool {
+	for key, value := range expected {
+		if v, ok := actual[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+func mergeStringMaps(base map[string]string, overrides map[string]string) map[string]string {
+	merged := maps.Clone(base)
+	if merged == nil {
+		merged = map[string]string{}
+	}
+	maps.Copy(merged, overrides)
+	return merged
+}
@@ -0,0 +1,196 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	dbmocks "github.com/argoproj/argo-cd/v3/util/db/mocks"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const testCAPIKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: workload
+  cluster:
+    server: https://workload.example.com:6443
+    certificate-authority-data: Y2E=
+users:
+- name: workload-admin
+  user:
+    client-certificate-data: Y2VydA==
+    client-key-data: a2V5
+contexts:
+- name: workload-admin@workload
+  context:
+    cluster: workload
+    user: workload-admin
+current-context: workload-admin@workload
+`
+
+func newDownstreamClusterObject(gvr schema.GroupVersionResource, kind string, namespace string, name string, labels map[string]string, status map[string]any) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": gvr.GroupVersion().String(),
+		"kind":       kind,
+		"metadata":   map[string]any{"name": name, "namespace": namespace},
+		"status":     status,
+	}}
+	obj.SetLabels(labels)
+	return obj
+}
+
+func newFakeDynamicClient(objs ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		clusterAPIClusterGVR: "ClusterList",
+		rancherClusterGVR:    "ClusterList",
+	}, objs...)
+}
+
+func TestListClusterAPIClusters(t *testing.T) {
+	provisioned := map[string]any{"phase": "Provisioned"}
+	dynamicClient := newFakeDynamicClient(
+		newDownstreamClusterObject(clusterAPIClusterGVR, "Cluster", "fleet", "workload", map[string]string{"env": "prod"}, provisioned),
+		newDownstreamClusterObject(clusterAPIClusterGVR, "Cluster", "fleet", "no-kubeconfig", nil, provisioned),
+		newDownstreamClusterObject(clusterAPIClusterGVR, "Cluster", "fleet", "provisioning", nil, map[string]any{"phase": "Provisioning"}),
+		newDownstreamClusterObject(clusterAPIClusterGVR, "Cluster", "other", "other", nil, provisioned),
+	)
+	kubeClient := fake.NewClientset(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "workload-kubeconfig", Namespace: "fleet"},
+			Data:       map[string][]byte{"value": []byte(testCAPIKubeconfig)},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "provisioning-kubeconfig", Namespace: "fleet"},
+			Data:       map[string][]byte{"value": []byte(testCAPIKubeconfig)},
+		},
+	)
+	source := settings.ClusterDiscoverySource{Name: "capi", Provider: settings.ClusterDiscoveryProviderClusterAPI, Namespaces: []string{"fleet"}}
+
+	discovered, err := listClusterAPIClusters(t.Context(), dynamicClient, kubeClient, source, metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, discovered, 1)
+	assert.Equal(t, "workload", discovered[0].name)
+	assert.Equal(t, "https://workload.example.com:6443", discovered[0].server)
+	assert.Equal(t, v1alpha1.TLSClientConfig{CAData: []byte("ca"), CertData: []byte("cert"), KeyData: []byte("key")}, discovered[0].config.TLSClientConfig)
+}
+
+func TestClusterDiscoverer_ListRancherClusters(t *testing.T) {
+	ready := map[string]any{"conditions": []any{map[string]any{"type": "Ready", "status": "True"}}}
+	downstream := newDownstreamClusterObject(rancherClusterGVR, "Cluster", "", "c-m-abcd1234", nil, ready)
+	require.NoError(t, unstructured.SetNestedField(downstream.Object, "edge-01", "spec", "displayName"))
+	dynamicClient := newFakeDynamicClient(
+		downstream,
+		newDownstreamClusterObject(rancherClusterGVR, "Cluster", "", rancherLocalClusterName, nil, ready),
+		newDownstreamClusterObject(rancherClusterGVR, "Cluster", "", "c-m-efgh5678", nil, map[string]any{
+			"conditions": []any{map[string]any{"type": "Ready", "status": "False"}},
+		}),
+	)
+	kubeClient := fake.NewClientset(
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: "argocd", Labels: map[string]string{"app.kubernetes.io/part-of": "argocd"}}},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "rancher-token", Namespace: "argocd", Labels: map[string]string{"app.kubernetes.io/part-of": "argocd"}},
+			Data:       map[string][]byte{"token": []byte("token-abcd:secret"), "caData": []byte("rancher-ca")},
+		},
+	)
+	discoverer := newClusterDiscoverer(nil, settings.NewSettingsManager(t.Context(), kubeClient, "argocd"), nil)
+	source := settings.ClusterDiscoverySource{
+		Name:                     "rancher",
+		Provider:                 settings.ClusterDiscoveryProviderRancher,
+		RancherURL:               "https://rancher.example.com/",
+		RancherCredentialsSecret: "rancher-token",
+	}
+
+	discovered, err := discoverer.listRancherClusters(t.Context(), dynamicClient, source, metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, discovered, 1)
+	assert.Equal(t, "edge-01", discovered[0].name)
+	assert.Equal(t, "https://rancher.example.com/k8s/clusters/c-m-abcd1234", discovered[0].server)
+	assert.Equal(t, "token-abcd:secret", discovered[0].config.BearerToken)
+	assert.Equal(t, []byte("rancher-ca"), discovered[0].config.CAData)
+}
+
+func TestNewDiscoveredCluster(t *testing.T) {
+	source := settings.ClusterDiscoverySource{
+		Name:     "capi",
+		Provider: settings.ClusterDiscoveryProviderClusterAPI,
+		Template: settings.ClusterDiscoveryTemplate{
+			Name:    "{{ .namespace }}-{{ .name }}",
+			Project: `{{ index .labels "team" }}`,
+			Labels:  map[string]string{"env": `{{ index .labels "env" | default "dev" }}`},
+		},
+	}
+	item := discoveredCluster{
+		obj:    newDownstreamClusterObject(clusterAPIClusterGVR, "Cluster", "fleet", "workload", map[string]string{"team": "payments"}, nil),
+		name:   "workload",
+		server: "https://workload.example.com:6443",
+		config: v1alpha1.ClusterConfig{BearerToken: "token"},
+	}
+
+	cluster, err := newDiscoveredCluster(source, item)
+	require.NoError(t, err)
+	assert.Equal(t, "fleet-workload", cluster.Name)
+	assert.Equal(t, "payments", cluster.Project)
+	assert.Equal(t, "https://workload.example.com:6443", cluster.Server)
+	assert.Equal(t, map[string]string{"env": "dev", common.LabelKeyClusterDiscoverySource: "capi"}, cluster.Labels)
+	assert.Equal(t, "token", cluster.Config.BearerToken)
+
+	source.Template.Name = "{{ .name"
+	_, err = newDiscoveredCluster(source, item)
+	require.ErrorContains(t, err, "failed to parse template")
+}
+
+func TestClusterDiscoverer_SyncDiscoveredClusters(t *testing.T) {
+	source := settings.ClusterDiscoverySource{Name: "capi", Provider: settings.ClusterDiscoveryProviderClusterAPI}
+	discoveredLabels := map[string]string{common.LabelKeyClusterDiscoverySource: "capi"}
+	newItem := func(name string, token string) discoveredCluster {
+		return discoveredCluster{
+			obj:    newDownstreamClusterObject(clusterAPIClusterGVR, "Cluster", "fleet", name, nil, nil),
+			name:   name,
+			server: "https://" + name,
+			config: v1alpha1.ClusterConfig{BearerToken: token},
+		}
+	}
+	clusters := []v1alpha1.Cluster{
+		// registered manually, must not be taken over
+		{Server: "https://manual", Name: "manual"},
+		// up to date, the labels added by other components are ignored
+		{Server: "https://unchanged", Name: "unchanged", Labels: map[string]string{
+			common.LabelKeyClusterDiscoverySource:   "capi",
+			common.LabelKeyClusterKubernetesVersion: "1.30",
+		}, Config: v1alpha1.ClusterConfig{BearerToken: "token"}},
+		{Server: "https://rotated", Name: "rotated", Labels: discoveredLabels, Config: v1alpha1.ClusterConfig{BearerToken: "old"}},
+		{Server: "https://removed", Name: "removed", Labels: discoveredLabels},
+		// registered by another source
+		{Server: "https://other", Name: "other", Labels: map[string]string{common.LabelKeyClusterDiscoverySource: "rancher"}},
+	}
+
+	argoDB := &dbmocks.ArgoDB{}
+	argoDB.On("CreateCluster", mock.Anything, mock.MatchedBy(func(c *v1alpha1.Cluster) bool {
+		return c.Server == "https://new" && c.Name == "new" && c.Labels[common.LabelKeyClusterDiscoverySource] == "capi"
+	})).Return(&v1alpha1.Cluster{}, nil).Once()
+	argoDB.On("UpdateCluster", mock.Anything, mock.MatchedBy(func(c *v1alpha1.Cluster) bool {
+		return c.Server == "https://rotated" && c.Config.BearerToken == "new"
+	})).Return(&v1alpha1.Cluster{}, nil).Once()
+	argoDB.On("DeleteCluster", mock.Anything, "https://removed").Return(nil).Once()
+
+	discoverer := newClusterDiscoverer(argoDB, nil, nil)
+	discoverer.syncDiscoveredClusters(t.Context(), source, []discoveredCluster{
+		newItem("manual", "token"),
+		newItem("unchanged", "token"),
+		newItem("rotated", "new"),
+		newItem("new", "token"),
+	}, clusters)
+	argoDB.AssertExpectations(t)
+}
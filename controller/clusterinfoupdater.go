@@ -12,9 +12,11 @@ import (
 	log "github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/discovery"
 
 	"github.com/argoproj/argo-cd/v3/util/env"
 
+	statecache "github.com/argoproj/argo-cd/v3/controller/cache"
 	"github.com/argoproj/argo-cd/v3/controller/metrics"
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
@@ -31,8 +33,15 @@ const (
 
 var clusterInfoTimeout = env.ParseDurationFromEnv(EnvClusterInfoTimeout, defaultSecretUpdateInterval, defaultSecretUpdateInterval, 1*time.Minute)
 
+// clusterInfoSource provides the information about the clusters cached by the application controller
+type clusterInfoSource interface {
+	metrics.HasClustersInfo
+	GetClusterCapacityInfo(server string) (*appv1.ClusterCapacityInfo, bool)
+	GetClusterWatchLag(server string) (statecache.WatchLagInfo, bool)
+}
+
 type clusterInfoUpdater struct {
-	infoSource    metrics.HasClustersInfo
+	infoSource    clusterInfoSource
 	db            db.ArgoDB
 	appLister     v1alpha1.ApplicationNamespaceLister
 	cache         *appstatecache.Cache
@@ -40,10 +49,11 @@ type clusterInfoUpdater struct {
 	projGetter    func(app *appv1.Application) (*appv1.AppProject, error)
 	namespace     string
 	lastUpdated   time.Time
+	measureRTT    func(ctx context.Context, cluster *appv1.Cluster) (time.Duration, error)
 }
 
 func NewClusterInfoUpdater(
-	infoSource metrics.HasClustersInfo,
+	infoSource clusterInfoSource,
 	db db.ArgoDB,
 	appLister v1alpha1.ApplicationNamespaceLister,
 	cache *appstatecache.Cache,
@@ -51,7 +61,7 @@ func NewClusterInfoUpdater(
 	projGetter func(app *appv1.Application) (*appv1.AppProject, error),
 	namespace string,
 ) *clusterInfoUpdater {
-	return &clusterInfoUpdater{infoSource, db, appLister, cache, clusterFilter, projGetter, namespace, time.Time{}}
+	return &clusterInfoUpdater{infoSource, db, appLister, cache, clusterFilter, projGetter, namespace, time.Time{}, measureAPIServerRTT}
 }
 
 func (c *clusterInfoUpdater) Run(ctx context.Context) {
@@ -120,9 +130,53 @@ func (c *clusterInfoUpdater) updateClusterInfo(ctx context.Context, cluster appv
 	}
 
 	updated := c.getUpdatedClusterInfo(ctx, apps, cluster, info, metav1.Now())
+	if updated.ConnectionState.Status == appv1.ConnectionStatusSuccessful {
+		// the capacity is maintained by the cluster cache as nodes and pods are added, updated and removed
+		if capacityInfo, ok := c.infoSource.GetClusterCapacityInfo(cluster.Server); ok {
+			updated.CapacityInfo = capacityInfo
+		}
+		updated.LatencyInfo = c.getClusterLatencyInfo(ctx, &cluster)
+	}
 	return c.cache.SetClusterInfo(cluster.Server, &updated)
 }
 
+// getClusterLatencyInfo measures the round-trip time to the cluster API server and returns it with the watch lag
+// observed by the cluster cache since the previous update
+func (c *clusterInfoUpdater) getClusterLatencyInfo(ctx context.Context, cluster *appv1.Cluster) *appv1.ClusterLatencyInfo {
+	latencyInfo := &appv1.ClusterLatencyInfo{}
+	if rtt, err := c.measureRTT(ctx, cluster); err != nil {
+		log.WithField("cluster", cluster.Server).Warnf("Failed to measure the API server round-trip time: %v", err)
+	} else {
+		latencyInfo.APIServerRTTMilliseconds = rtt.Milliseconds()
+	}
+	if watchLag, ok := c.infoSource.GetClusterWatchLag(cluster.Server); ok {
+		latencyInfo.WatchLagMilliseconds = watchLag.MaxLag.Milliseconds()
+		latencyInfo.WatchEventsCount = watchLag.EventsCount
+	}
+	return latencyInfo
+}
+
+// measureAPIServerRTT returns the duration of a request of the cluster API server version. The connection is warmed
+// up by a first request, so that the measured duration does not include the TCP and TLS handshakes.
+func measureAPIServerRTT(ctx context.Context, cluster *appv1.Cluster) (time.Duration, error) {
+	config, err := cluster.RESTConfig()
+	if err != nil {
+		return 0, fmt.Errorf("error getting cluster REST config: %w", err)
+	}
+	client, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return 0, fmt.Errorf("error creating discovery client: %w", err)
+	}
+	if err := client.RESTClient().Get().AbsPath("/version").Do(ctx).Error(); err != nil {
+		return 0, fmt.Errorf("error getting server version: %w", err)
+	}
+	start := time.Now()
+	if err := client.RESTClient().Get().AbsPath("/version").Do(ctx).Error(); err != nil {
+		return 0, fmt.Errorf("error getting server version: %w", err)
+	}
+	return time.Since(start), nil
+}
+
 func (c *clusterInfoUpdater) getUpdatedClusterInfo(ctx context.Context, apps []*appv1.Application, cluster appv1.Cluster, info *cache.ClusterInfo, now metav1.Time) appv1.ClusterInfo {
 	var appCount int64
 	for _, a := range apps {
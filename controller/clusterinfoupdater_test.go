@@ -2,8 +2,13 @@ package controller
 
 import (
 	"context"
+	"encoding/pem"
 	"errors"
 	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -11,6 +16,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-cd/v3/common"
+	statecache "github.com/argoproj/argo-cd/v3/controller/cache"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	appsfake "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
@@ -28,6 +34,56 @@ import (
 	"k8s.io/client-go/tools/cache"
 )
 
+type fakeClusterInfoSource struct {
+	clustersInfo []clustercache.ClusterInfo
+	capacityInfo *v1alpha1.ClusterCapacityInfo
+	watchLag     *statecache.WatchLagInfo
+}
+
+func (s *fakeClusterInfoSource) GetClustersInfo() []clustercache.ClusterInfo {
+	return s.clustersInfo
+}
+
+func (s *fakeClusterInfoSource) GetClusterCapacityInfo(_ string) (*v1alpha1.ClusterCapacityInfo, bool) {
+	return s.capacityInfo, s.capacityInfo != nil
+}
+
+func (s *fakeClusterInfoSource) GetClusterWatchLag(_ string) (statecache.WatchLagInfo, bool) {
+	if s.watchLag == nil {
+		return statecache.WatchLagInfo{}, false
+	}
+	return *s.watchLag, true
+}
+
+func newFakeCapacitySource() *fakeClusterInfoSource {
+	return &fakeClusterInfoSource{capacityInfo: &v1alpha1.ClusterCapacityInfo{NodesCount: 3, PodsCount: 2}}
+}
+
+func TestMeasureAPIServerRTT(t *testing.T) {
+	var requests, connections atomic.Int32
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"major": "1", "minor": "30"}`))
+	}))
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			connections.Add(1)
+		}
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	cluster := &v1alpha1.Cluster{Server: server.URL, Config: v1alpha1.ClusterConfig{
+		TLSClientConfig: v1alpha1.TLSClientConfig{CAData: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})},
+	}}
+	_, err := measureAPIServerRTT(t.Context(), cluster)
+	require.NoError(t, err)
+	// the measured request reuses the connection of the warm-up request
+	assert.Equal(t, int32(2), requests.Load())
+	assert.Equal(t, int32(1), connections.Load())
+}
+
 // Expect cluster cache update is persisted in cluster secret
 func TestClusterSecretUpdater(t *testing.T) {
 	const fakeNamespace = "fake-ns"
@@ -88,7 +144,12 @@ func TestClusterSecretUpdater(t *testing.T) {
 		}
 
 		lister := applisters.NewApplicationLister(appInformer.GetIndexer()).Applications(fakeNamespace)
-		updater := NewClusterInfoUpdater(nil, argoDB, lister, appCache, nil, nil, fakeNamespace)
+		source := newFakeCapacitySource()
+		source.watchLag = &statecache.WatchLagInfo{MaxLag: 1500 * time.Millisecond, EventsCount: 4}
+		updater := NewClusterInfoUpdater(source, argoDB, lister, appCache, nil, nil, fakeNamespace)
+		updater.measureRTT = func(_ context.Context, _ *v1alpha1.Cluster) (time.Duration, error) {
+			return 42 * time.Millisecond, nil
+		}
 
 		err = updater.updateClusterInfo(t.Context(), *cluster, info)
 		require.NoError(t, err, "Invoking updateClusterInfo failed.")
@@ -98,6 +159,18 @@ func TestClusterSecretUpdater(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, updatedK8sVersion, clusterInfo.ServerVersion)
 		assert.Equal(t, test.ExpectedStatus, clusterInfo.ConnectionState.Status)
+		if test.ExpectedStatus == v1alpha1.ConnectionStatusSuccessful {
+			require.NotNil(t, clusterInfo.CapacityInfo)
+			assert.Equal(t, int64(3), clusterInfo.CapacityInfo.NodesCount)
+			assert.Equal(t, &v1alpha1.ClusterLatencyInfo{
+				APIServerRTTMilliseconds: 42,
+				WatchLagMilliseconds:     1500,
+				WatchEventsCount:         4,
+			}, clusterInfo.LatencyInfo)
+		} else {
+			assert.Nil(t, clusterInfo.CapacityInfo)
+			assert.Nil(t, clusterInfo.LatencyInfo)
+		}
 	}
 }
 
@@ -0,0 +1,109 @@
+package controller
+
+import (
+	"sort"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	jsonpatch "github.com/evanphx/json-patch"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const (
+	// maxDriftHistory is the maximum number of drift events kept in the status of an application
+	maxDriftHistory = 10
+	// maxDriftedResources is the maximum number of drifted resources recorded in a drift event
+	maxDriftedResources = 20
+	// maxDriftDiffSize is the maximum size of the diff recorded for a drifted resource
+	maxDriftDiffSize = 4096
+	// maxDriftEventDiffSize is the maximum total size of the diffs recorded in a drift event. The diffs of the
+	// resources past this size are not recorded.
+	maxDriftEventDiffSize = 16384
+)
+
+// newDriftEvent returns the drift event recording the out-of-band changes of the resources of the application about
+// to be reverted by the self-heal to the given revision. At most maxDriftedResources resources are recorded, and their
+// diffs are recorded up to maxDriftEventDiffSize in total, to bound the size of the drift history.
+func newDriftEvent(app *appv1.Application, revision string, resources []appv1.ResourceStatus, managedResources []managedResource, now time.Time) appv1.DriftEvent {
+	managedByKey := map[kube.ResourceKey]managedResource{}
+	for _, res := range managedResources {
+		managedByKey[kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)] = res
+	}
+	var since time.Time
+	if app.Status.OperationState != nil && app.Status.OperationState.FinishedAt != nil {
+		since = app.Status.OperationState.FinishedAt.Time
+	}
+	event := appv1.DriftEvent{RevertedAt: metav1.NewTime(now), Revision: revision}
+	diffSize := 0
+	for _, res := range resources {
+		if res.Status == appv1.SyncStatusCodeSynced || res.Hook {
+			continue
+		}
+		if len(event.Resources) >= maxDriftedResources {
+			break
+		}
+		drifted := appv1.DriftedResource{Group: res.Group, Version: res.Version, Kind: res.Kind, Namespace: res.Namespace, Name: res.Name}
+		if managed, ok := managedByKey[kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)]; ok {
+			drifted.Managers = driftManagers(managed.Live, since)
+			if diff := driftDiff(managed); diffSize+len(diff) <= maxDriftEventDiffSize {
+				drifted.Diff = diff
+				diffSize += len(diff)
+			}
+		}
+		event.Resources = append(event.Resources, drifted)
+	}
+	return event
+}
+
+// driftManagers returns the field managers of the live resource other than Argo CD which updated it since the given
+// time
+func driftManagers(live *unstructured.Unstructured, since time.Time) []string {
+	if live == nil {
+		return nil
+	}
+	seen := map[string]bool{}
+	var managers []string
+	for _, entry := range live.GetManagedFields() {
+		if entry.Manager == "" || entry.Manager == common.ArgoCDSSAManager || seen[entry.Manager] {
+			continue
+		}
+		if entry.Time != nil && entry.Time.Time.Before(since) {
+			continue
+		}
+		seen[entry.Manager] = true
+		managers = append(managers, entry.Manager)
+	}
+	sort.Strings(managers)
+	return managers
+}
+
+// driftDiff returns the JSON merge patch from the target state to the live state of the resource. The diff of the
+// secrets is not recorded to keep their data out of the application status.
+func driftDiff(res managedResource) string {
+	if res.Group == "" && res.Kind == kube.SecretKind {
+		return ""
+	}
+	if !res.Diff.Modified || len(res.Diff.PredictedLive) == 0 || len(res.Diff.NormalizedLive) == 0 {
+		return ""
+	}
+	patch, err := jsonpatch.CreateMergePatch(res.Diff.PredictedLive, res.Diff.NormalizedLive)
+	if err != nil {
+		return ""
+	}
+	if len(patch) > maxDriftDiffSize {
+		return string(patch[:maxDriftDiffSize]) + "..."
+	}
+	return string(patch)
+}
+
+// appendDriftEvent appends the drift event to the drift history of the application, keeping the most recent events
+func appendDriftEvent(app *appv1.Application, event appv1.DriftEvent) {
+	app.Status.DriftHistory = append(app.Status.DriftHistory, event)
+	if len(app.Status.DriftHistory) > maxDriftHistory {
+		app.Status.DriftHistory = app.Status.DriftHistory[len(app.Status.DriftHistory)-maxDriftHistory:]
+	}
+}
@@ -0,0 +1,146 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/diff"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func newDriftedManagedResource(t *testing.T) managedResource {
+	t.Helper()
+	target := test.NewConfigMap()
+	target.SetNamespace("default")
+	live := target.DeepCopy()
+	live.Object["data"] = map[string]any{"key": "changed"}
+	live.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{Manager: "argocd-controller", Operation: metav1.ManagedFieldsOperationApply, Time: &metav1.Time{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}},
+		{Manager: "kubectl-edit", Operation: metav1.ManagedFieldsOperationUpdate, Time: &metav1.Time{Time: time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)}},
+		{Manager: "kube-controller-manager", Operation: metav1.ManagedFieldsOperationUpdate, Time: &metav1.Time{Time: time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)}},
+	})
+	predictedLive, err := json.Marshal(target)
+	require.NoError(t, err)
+	normalizedLive, err := json.Marshal(map[string]any{"apiVersion": "v1", "kind": "ConfigMap", "metadata": target.Object["metadata"], "data": live.Object["data"]})
+	require.NoError(t, err)
+	return managedResource{
+		Target:    target,
+		Live:      live,
+		Diff:      diff.DiffResult{Modified: true, PredictedLive: predictedLive, NormalizedLive: normalizedLive},
+		Version:   "v1",
+		Kind:      "ConfigMap",
+		Namespace: "default",
+		Name:      target.GetName(),
+	}
+}
+
+func TestNewDriftEvent(t *testing.T) {
+	res := newDriftedManagedResource(t)
+	app := newFakeApp()
+	app.Status.OperationState = &v1alpha1.OperationState{FinishedAt: &metav1.Time{Time: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)}}
+	resources := []v1alpha1.ResourceStatus{
+		{Version: "v1", Kind: "ConfigMap", Namespace: "default", Name: res.Name, Status: v1alpha1.SyncStatusCodeOutOfSync},
+		{Group: "apps", Version: "v1", Kind: kube.DeploymentKind, Namespace: "default", Name: "guestbook", Status: v1alpha1.SyncStatusCodeSynced},
+	}
+	now := time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC)
+
+	event := newDriftEvent(app, "aaaaaaa", resources, []managedResource{res}, now)
+	assert.Equal(t, v1alpha1.DriftEvent{
+		RevertedAt: metav1.NewTime(now),
+		Revision:   "aaaaaaa",
+		Resources: []v1alpha1.DriftedResource{{
+			Version:   "v1",
+			Kind:      "ConfigMap",
+			Namespace: "default",
+			Name:      res.Name,
+			Managers:  []string{"kubectl-edit"},
+			Diff:      `{"data":{"config.yaml":null,"key":"changed"}}`,
+		}},
+	}, event)
+}
+
+func TestNewDriftEvent_Bounded(t *testing.T) {
+	app := newFakeApp()
+	var resources []v1alpha1.ResourceStatus
+	var managedResources []managedResource
+	for i := 0; i < maxDriftedResources+5; i++ {
+		res := newDriftedManagedResource(t)
+		res.Name = fmt.Sprintf("cm-%d", i)
+		res.Diff.NormalizedLive = []byte(`{"data":{"key":"` + strings.Repeat("a", maxDriftDiffSize) + `"}}`)
+		managedResources = append(managedResources, res)
+		resources = append(resources, v1alpha1.ResourceStatus{Version: "v1", Kind: "ConfigMap", Namespace: "default", Name: res.Name, Status: v1alpha1.SyncStatusCodeOutOfSync})
+	}
+
+	event := newDriftEvent(app, "aaaaaaa", resources, managedResources, time.Now())
+	require.Len(t, event.Resources, maxDriftedResources)
+	diffSize := 0
+	for _, res := range event.Resources {
+		diffSize += len(res.Diff)
+	}
+	assert.LessOrEqual(t, diffSize, maxDriftEventDiffSize)
+	assert.NotEmpty(t, event.Resources[0].Diff)
+	assert.Empty(t, event.Resources[maxDriftedResources-1].Diff)
+}
+
+func TestAutoSync_SelfHealDriftHistory(t *testing.T) {
+	res := newDriftedManagedResource(t)
+	app := newFakeApp()
+	app.Spec.SyncPolicy.Automated.SelfHeal = true
+	app.Status.OperationState = &v1alpha1.OperationState{
+		Operation: v1alpha1.Operation{
+			Sync: &v1alpha1.SyncOperation{},
+		},
+		Phase:      synccommon.OperationSucceeded,
+		FinishedAt: &metav1.Time{Time: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+		SyncResult: &v1alpha1.SyncOperationResult{
+			Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			Source:   *app.Spec.Source.DeepCopy(),
+		},
+	}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+	}
+	resources := []v1alpha1.ResourceStatus{{Version: "v1", Kind: "ConfigMap", Namespace: "default", Name: res.Name, Status: v1alpha1.SyncStatusCodeOutOfSync}}
+
+	cond, _ := ctrl.autoSync(app, &defaultProj, &syncStatus, resources, []managedResource{res}, false)
+	assert.Nil(t, cond)
+	require.Len(t, app.Status.DriftHistory, 1)
+	assert.Equal(t, "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", app.Status.DriftHistory[0].Revision)
+	assert.Equal(t, []string{"kubectl-edit"}, app.Status.DriftHistory[0].Resources[0].Managers)
+}
+
+func TestDriftDiff(t *testing.T) {
+	t.Run("Secret", func(t *testing.T) {
+		res := newDriftedManagedResource(t)
+		res.Kind = kube.SecretKind
+		assert.Empty(t, driftDiff(res))
+	})
+
+	t.Run("Truncated", func(t *testing.T) {
+		res := newDriftedManagedResource(t)
+		res.Diff.NormalizedLive = []byte(`{"data":{"key":"` + strings.Repeat("a", maxDriftDiffSize) + `"}}`)
+		assert.Len(t, driftDiff(res), maxDriftDiffSize+len("..."))
+	})
+}
+
+func TestAppendDriftEvent(t *testing.T) {
+	app := newFakeApp()
+	for i := 0; i < maxDriftHistory+2; i++ {
+		appendDriftEvent(app, v1alpha1.DriftEvent{Revision: strings.Repeat("a", i+1)})
+	}
+	require.Len(t, app.Status.DriftHistory, maxDriftHistory)
+	assert.Equal(t, "aaa", app.Status.DriftHistory[0].Revision)
+}
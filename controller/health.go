@@ -2,6 +2,8 @@ package controller
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/health"
 	hookutil "github.com/argoproj/gitops-engine/pkg/sync/hook"
@@ -58,6 +60,12 @@ func setApplicationHealth(resources []managedResource, statuses []appv1.Resource
 		if healthStatus == nil {
 			continue
 		}
+		if res.Live != nil && res.Live.GetDeletionTimestamp() != nil && len(res.Live.GetFinalizers()) > 0 {
+			healthStatus = &health.HealthStatus{
+				Status:  healthStatus.Status,
+				Message: fmt.Sprintf("Pending deletion since %s, blocked by the finalizers %s", res.Live.GetDeletionTimestamp().UTC().Format(time.RFC3339), strings.Join(res.Live.GetFinalizers(), ", ")),
+			}
+		}
 
 		if persistResourceHealth {
 			resHealth := appv1.HealthStatus{Status: healthStatus.Status, Message: healthStatus.Message}
@@ -114,6 +114,21 @@ func TestSetApplicationHealth_ResourceHealthNotPersisted(t *testing.T) {
 	assert.Nil(t, resourceStatuses[0].Health)
 }
 
+func TestSetApplicationHealth_PendingDeletion(t *testing.T) {
+	runningPod := resourceFromFile("./testdata/pod-running-restart-always.yaml")
+	runningPod.SetDeletionTimestamp(&testTimestamp)
+	runningPod.SetFinalizers([]string{"example.com/cleanup", "example.com/backup"})
+
+	resources := []managedResource{{
+		Group: "", Version: "v1", Kind: "Pod", Live: &runningPod,
+	}}
+	resourceStatuses := initStatuses(resources)
+
+	_, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	require.NoError(t, err)
+	assert.Equal(t, "Pending deletion since 2020-01-01T12:00:00Z, blocked by the finalizers example.com/cleanup, example.com/backup", resourceStatuses[0].Health.Message)
+}
+
 func TestSetApplicationHealth_MissingResource(t *testing.T) {
 	pod := resourceFromFile("./testdata/pod-running-restart-always.yaml")
 
@@ -12,33 +12,14 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/rest"
 
+	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/lua"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
-var (
-	postDeleteHook  = "PostDelete"
-	postDeleteHooks = map[string]string{
-		"argocd.argoproj.io/hook": postDeleteHook,
-		"helm.sh/hook":            "post-delete",
-	}
-)
-
 func isHook(obj *unstructured.Unstructured) bool {
-	return hook.IsHook(obj) || isPostDeleteHook(obj)
-}
-
-func isPostDeleteHook(obj *unstructured.Unstructured) bool {
-	if obj == nil || obj.GetAnnotations() == nil {
-		return false
-	}
-	for k, v := range postDeleteHooks {
-		if val, ok := obj.GetAnnotations()[k]; ok && val == v {
-			return true
-		}
-	}
-	return false
+	return hook.IsHook(obj) || argo.IsPostDeleteHook(obj)
 }
 
 func (ctrl *ApplicationController) executePostDeleteHooks(app *v1alpha1.Application, proj *v1alpha1.AppProject, liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry) (bool, error) {
@@ -57,7 +38,7 @@ func (ctrl *ApplicationController) executePostDeleteHooks(app *v1alpha1.Applicat
 	}
 	runningHooks := map[kube.ResourceKey]*unstructured.Unstructured{}
 	for key, obj := range liveObjs {
-		if isPostDeleteHook(obj) {
+		if argo.IsPostDeleteHook(obj) {
 			runningHooks[key] = obj
 		}
 	}
@@ -65,9 +46,13 @@ func (ctrl *ApplicationController) executePostDeleteHooks(app *v1alpha1.Applicat
 	expectedHook := map[kube.ResourceKey]*unstructured.Unstructured{}
 	for _, obj := range targets {
 		if obj.GetNamespace() == "" {
-			obj.SetNamespace(app.Spec.Destination.Namespace)
+			namespace, err := app.Spec.GetDestinationNamespace(obj.GetLabels())
+			if err != nil {
+				return false, err
+			}
+			obj.SetNamespace(namespace)
 		}
-		if !isPostDeleteHook(obj) {
+		if !argo.IsPostDeleteHook(obj) {
 			continue
 		}
 		if runningHook := runningHooks[kube.GetResourceKey(obj)]; runningHook == nil {
@@ -133,7 +118,7 @@ func (ctrl *ApplicationController) cleanupPostDeleteHooks(liveObjs map[kube.Reso
 	aggregatedHealth := health.HealthStatusHealthy
 	var hooks []*unstructured.Unstructured
 	for _, obj := range liveObjs {
-		if !isPostDeleteHook(obj) {
+		if !argo.IsPostDeleteHook(obj) {
 			continue
 		}
 		hookHealth, err := health.GetResourceHealth(obj, healthOverrides)
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"k8s.io/client-go/util/workqueue"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/pkg/ratelimiter"
+)
+
+// appPriorities lists the application priorities, from the highest to the lowest
+var appPriorities = []appv1.ApplicationPriority{appv1.ApplicationPriorityHigh, appv1.ApplicationPriorityNormal, appv1.ApplicationPriorityLow}
+
+// appPriorityQueue is a workqueue.Queue which pops the keys of the applications with the highest priority first, and the
+// keys of the applications with the same priority in FIFO order
+type appPriorityQueue struct {
+	getPriority func(key string) appv1.ApplicationPriority
+	items       map[appv1.ApplicationPriority][]string
+}
+
+func newAppPriorityQueue(getPriority func(key string) appv1.ApplicationPriority) *appPriorityQueue {
+	return &appPriorityQueue{getPriority: getPriority, items: map[appv1.ApplicationPriority][]string{}}
+}
+
+// Touch is a no-op: the priority of an application is evaluated when its key is pushed
+func (q *appPriorityQueue) Touch(_ string) {}
+
+func (q *appPriorityQueue) Push(key string) {
+	priority := q.getPriority(key)
+	q.items[priority] = append(q.items[priority], key)
+}
+
+func (q *appPriorityQueue) Len() int {
+	length := 0
+	for _, items := range q.items {
+		length += len(items)
+	}
+	return length
+}
+
+func (q *appPriorityQueue) Pop() string {
+	for _, priority := range appPriorities {
+		if items := q.items[priority]; len(items) > 0 {
+			key := items[0]
+			// the slot is cleared to let the key be garbage collected
+			items[0] = ""
+			q.items[priority] = items[1:]
+			return key
+		}
+	}
+	return ""
+}
+
+// newAppPriorityRateLimitingQueue returns a rate limiting work queue of application keys honoring the priority of the
+// applications
+func newAppPriorityRateLimitingQueue(name string, rateLimiterConfig *ratelimiter.AppControllerRateLimiterConfig, getPriority func(key string) appv1.ApplicationPriority) workqueue.TypedRateLimitingInterface[string] {
+	return workqueue.NewTypedRateLimitingQueueWithConfig(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), workqueue.TypedRateLimitingQueueConfig[string]{
+		Name: name,
+		DelayingQueue: workqueue.NewTypedDelayingQueueWithConfig(workqueue.TypedDelayingQueueConfig[string]{
+			Name: name,
+			Queue: workqueue.NewTypedWithConfig(workqueue.TypedQueueConfig[string]{
+				Name:  name,
+				Queue: newAppPriorityQueue(getPriority),
+			}),
+		}),
+	})
+}
@@ -0,0 +1,53 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/pkg/ratelimiter"
+)
+
+func TestAppPriorityQueue(t *testing.T) {
+	priorities := map[string]appv1.ApplicationPriority{
+		"argocd/preview-1":    appv1.ApplicationPriorityLow,
+		"argocd/production-1": appv1.ApplicationPriorityHigh,
+		"argocd/production-2": appv1.ApplicationPriorityHigh,
+		"argocd/preview-2":    appv1.ApplicationPriorityLow,
+	}
+	queue := newAppPriorityRateLimitingQueue("", ratelimiter.GetDefaultAppRateLimiterConfig(), func(key string) appv1.ApplicationPriority {
+		if priority, ok := priorities[key]; ok {
+			return priority
+		}
+		return appv1.ApplicationPriorityNormal
+	})
+	defer queue.ShutDown()
+
+	for _, key := range []string{"argocd/preview-1", "argocd/staging", "argocd/production-1", "argocd/preview-2", "argocd/production-2"} {
+		queue.Add(key)
+	}
+	assert.Equal(t, 5, queue.Len())
+
+	var keys []string
+	for queue.Len() > 0 {
+		key, _ := queue.Get()
+		keys = append(keys, key)
+		queue.Done(key)
+	}
+	assert.Equal(t, []string{"argocd/production-1", "argocd/production-2", "argocd/staging", "argocd/preview-1", "argocd/preview-2"}, keys)
+}
+
+func TestGetAppPriority(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.SyncPolicy = &appv1.SyncPolicy{Priority: appv1.ApplicationPriorityHigh}
+	invalidApp := newFakeApp()
+	invalidApp.Name = "invalid"
+	invalidApp.Spec.SyncPolicy = &appv1.SyncPolicy{Priority: "urgent"}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, invalidApp, &defaultProj}}, nil)
+
+	assert.Equal(t, appv1.ApplicationPriorityHigh, ctrl.getAppPriority(app.Namespace+"/"+app.Name))
+	assert.Equal(t, appv1.ApplicationPriorityNormal, ctrl.getAppPriority(invalidApp.Namespace+"/"+invalidApp.Name))
+	assert.Equal(t, appv1.ApplicationPriorityNormal, ctrl.getAppPriority(app.Namespace+"/unknown"))
+}
@@ -1,6 +1,7 @@
 package sharding
 
 import (
+	"maps"
 	"sync"
 
 	log "github.com/sirupsen/logrus"
@@ -33,7 +34,13 @@ type ClusterSharding struct {
 	getClusterShard DistributionFunction
 }
 
-func NewClusterSharding(_ db.ArgoDB, shard, replicas int, shardingAlgorithm string) ClusterShardingCache {
+func NewClusterSharding(argoDB db.ArgoDB, shard, replicas int, shardingAlgorithm string) ClusterShardingCache {
+	return NewClusterShardingWithLabelRules(argoDB, shard, replicas, shardingAlgorithm, nil)
+}
+
+// NewClusterShardingWithLabelRules returns a ClusterShardingCache which assigns the clusters matching the label rules
+// to the shards of the rules, and distributes the other clusters using the sharding algorithm.
+func NewClusterShardingWithLabelRules(_ db.ArgoDB, shard, replicas int, shardingAlgorithm string, labelRules []ShardLabelRule) ClusterShardingCache {
 	log.Debugf("Processing clusters from shard %d: Using filter function:  %s", shard, shardingAlgorithm)
 	clusterSharding := &ClusterSharding{
 		Shard:    shard,
@@ -46,6 +53,9 @@ func NewClusterSharding(_ db.ArgoDB, shard, replicas int, shardingAlgorithm stri
 	if replicas > 1 {
 		log.Debugf("Processing clusters from shard %d: Using filter function:  %s", shard, shardingAlgorithm)
 		distributionFunction = GetDistributionFunction(clusterSharding.getClusterAccessor(), clusterSharding.getAppAccessor(), shardingAlgorithm, replicas)
+		if len(labelRules) > 0 {
+			distributionFunction = LabelRulesDistributionFunction(labelRules, replicas, distributionFunction)
+		}
 	} else {
 		log.Info("Processing all cluster shards")
 	}
@@ -182,6 +192,11 @@ func hasShardingUpdates(old, new *v1alpha1.Cluster) bool {
 		return true
 	}
 
+	// returns true if the labels have changed because clusters may be assigned to shards by label rules.
+	if !maps.Equal(old.Labels, new.Labels) {
+		return true
+	}
+
 	// return false if the shard field has not been modified
 	if old.Shard == nil && new.Shard == nil {
 		return false
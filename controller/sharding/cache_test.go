@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	dbmocks "github.com/argoproj/argo-cd/v3/util/db/mocks"
@@ -122,6 +123,26 @@ func TestClusterSharding_AddRoundRobin_Redistributes(t *testing.T) {
 	assert.Equal(t, 0, clusterDistributionB) // will be reassigned to shard 0 because the .ID is bigger then the "C" cluster
 }
 
+func TestClusterSharding_LabelRules(t *testing.T) {
+	rules, err := ParseShardLabelRules(`[{"selector": "region=eu", "shard": 1}]`)
+	require.NoError(t, err)
+	sharding := NewClusterShardingWithLabelRules(&dbmocks.ArgoDB{}, 0, 2, "legacy", rules).(*ClusterSharding)
+
+	// the legacy algorithm assigns the cluster with id 1 to shard 0
+	cluster := &v1alpha1.Cluster{
+		ID:     "1",
+		Server: "https://127.0.0.1:6443",
+	}
+	sharding.Add(cluster)
+	assert.Equal(t, 0, sharding.GetDistribution()[cluster.Server])
+
+	labeledCluster := cluster.DeepCopy()
+	labeledCluster.Labels = map[string]string{"region": "eu"}
+	sharding.Update(cluster, labeledCluster)
+	assert.Equal(t, 1, sharding.GetDistribution()[cluster.Server])
+	assert.False(t, sharding.IsManagedCluster(labeledCluster))
+}
+
 func TestClusterSharding_Delete(t *testing.T) {
 	shard := 1
 	replicas := 2
@@ -431,6 +452,18 @@ func TestHasShardingUpdates(t *testing.T) {
 			new:      nil,
 			expected: false,
 		},
+		{
+			name: "Labels have changed",
+			old: &v1alpha1.Cluster{
+				Server: "https://kubernetes.default.svc",
+				Labels: map[string]string{"region": "eu"},
+			},
+			new: &v1alpha1.Cluster{
+				Server: "https://kubernetes.default.svc",
+				Labels: map[string]string{"region": "us"},
+			},
+			expected: true,
+		},
 		{
 			name:     "Both are nil",
 			old:      nil,
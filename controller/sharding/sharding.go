@@ -16,7 +16,9 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/controller/sharding/consistent"
@@ -98,6 +100,76 @@ func GetDistributionFunction(clusters clusterAccessor, apps appAccessor, shardin
 	return distributionFunction
 }
 
+// ShardLabelRule assigns the clusters whose labels match the label selector to a shard
+type ShardLabelRule struct {
+	// Selector is a label selector matched against the cluster labels, e.g. region=eu
+	Selector string `json:"selector"`
+	// Shard is the shard the matching clusters are assigned to
+	Shard int `json:"shard"`
+
+	selector labels.Selector
+}
+
+// ParseShardLabelRules parses a YAML or JSON list of shard label rules, e.g. `[{"selector": "region=eu", "shard": 2}]`
+func ParseShardLabelRules(data string) ([]ShardLabelRule, error) {
+	if strings.TrimSpace(data) == "" {
+		return nil, nil
+	}
+	var rules []ShardLabelRule
+	if err := yaml.UnmarshalStrict([]byte(data), &rules); err != nil {
+		return nil, fmt.Errorf("failed to parse shard label rules: %w", err)
+	}
+	for i := range rules {
+		selector, err := labels.Parse(rules[i].Selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector %q of shard label rule %d: %w", rules[i].Selector, i, err)
+		}
+		if selector.Empty() {
+			return nil, fmt.Errorf("selector of shard label rule %d must not be empty", i)
+		}
+		if rules[i].Shard < 0 {
+			return nil, fmt.Errorf("shard of shard label rule %d must not be negative", i)
+		}
+		rules[i].selector = selector
+	}
+	return rules, nil
+}
+
+// LabelRulesDistributionFunction returns a DistributionFunction which assigns a cluster to the shard of the first
+// rule, in the order of the rules list, whose selector matches the cluster labels. Clusters which do not match any
+// rule, or which explicitly set their shard, are assigned using the distributionFunction.
+func LabelRulesDistributionFunction(rules []ShardLabelRule, replicas int, distributionFunction DistributionFunction) DistributionFunction {
+	return func(c *v1alpha1.Cluster) int {
+		if c == nil || c.Shard != nil {
+			return distributionFunction(c)
+		}
+		var matching []ShardLabelRule
+		for _, rule := range rules {
+			if rule.selector.Matches(labels.Set(c.Labels)) {
+				matching = append(matching, rule)
+			}
+		}
+		if len(matching) == 0 {
+			log.Debugf("Cluster %s does not match any shard label rule. Assigning using the sharding method.", c.Name)
+			return distributionFunction(c)
+		}
+		rule := matching[0]
+		if len(matching) > 1 {
+			selectors := make([]string, 0, len(matching))
+			for _, r := range matching {
+				selectors = append(selectors, strconv.Quote(r.Selector))
+			}
+			log.Warnf("Cluster %s matches the overlapping shard label rules %s. Using the first rule %q.", c.Name, strings.Join(selectors, ", "), rule.Selector)
+		}
+		if rule.Shard >= replicas {
+			log.Warnf("Shard (%d) of the shard label rule %q matching cluster %s is greater than the number of available shards. Assigning automatically.", rule.Shard, rule.Selector, c.Name)
+			return distributionFunction(c)
+		}
+		log.Debugf("Cluster with id=%s matches the shard label rule %q and will be processed by shard %d", c.ID, rule.Selector, rule.Shard)
+		return rule.Shard
+	}
+}
+
 // LegacyDistributionFunction returns a DistributionFunction using a stable distribution algorithm:
 // for a given cluster the function will return the shard number based on the cluster id. This function
 // is lightweight and can be distributed easily, however, it does not ensure an homogenous distribution as
@@ -454,7 +526,7 @@ func getDefaultShardMappingData(replicas int) []shardApplicationControllerMappin
 	return shardMappingData
 }
 
-func GetClusterSharding(kubeClient kubernetes.Interface, settingsMgr *settings.SettingsManager, shardingAlgorithm string, enableDynamicClusterDistribution bool) (ClusterShardingCache, error) {
+func GetClusterSharding(kubeClient kubernetes.Interface, settingsMgr *settings.SettingsManager, shardingAlgorithm string, enableDynamicClusterDistribution bool, labelRules []ShardLabelRule) (ClusterShardingCache, error) {
 	var replicasCount int
 	if enableDynamicClusterDistribution {
 		applicationControllerName := env.StringFromEnv(common.EnvAppControllerName, common.DefaultApplicationControllerName)
@@ -507,5 +579,5 @@ func GetClusterSharding(kubeClient kubernetes.Interface, settingsMgr *settings.S
 		shardNumber = 0
 	}
 	db := db.NewDB(settingsMgr.GetNamespace(), settingsMgr, kubeClient)
-	return NewClusterSharding(db, shardNumber, replicasCount, shardingAlgorithm), nil
+	return NewClusterShardingWithLabelRules(db, shardNumber, replicasCount, shardingAlgorithm, labelRules), nil
 }
@@ -9,6 +9,8 @@ import (
 	"testing"
 	"time"
 
+	log "github.com/sirupsen/logrus"
+	logtest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -394,6 +396,86 @@ func TestGetShardByIndexModuloReplicasCountDistributionFunction(t *testing.T) {
 	assert.Equal(t, expectedShardForCluster2, shardForCluster2, "Expected shard for cluster2 to be %d but got %d", expectedShardForCluster2, shardForCluster2)
 }
 
+func TestParseShardLabelRules(t *testing.T) {
+	rules, err := ParseShardLabelRules("")
+	require.NoError(t, err)
+	assert.Nil(t, rules)
+
+	rules, err = ParseShardLabelRules(`
+- selector: region=eu
+  shard: 2
+- selector: region in (us-east, us-west),tier!=critical
+  shard: 1
+`)
+	require.NoError(t, err)
+	require.Len(t, rules, 2)
+	assert.Equal(t, "region=eu", rules[0].Selector)
+	assert.Equal(t, 2, rules[0].Shard)
+	assert.Equal(t, 1, rules[1].Shard)
+
+	_, err = ParseShardLabelRules(`[{"selector": "region=eu", "shard": 2, "replicas": 3}]`)
+	require.ErrorContains(t, err, "failed to parse shard label rules")
+	_, err = ParseShardLabelRules(`[{"selector": "region in eu", "shard": 2}]`)
+	require.ErrorContains(t, err, "invalid selector")
+	_, err = ParseShardLabelRules(`[{"selector": "", "shard": 2}]`)
+	require.ErrorContains(t, err, "must not be empty")
+	_, err = ParseShardLabelRules(`[{"selector": "region=eu", "shard": -1}]`)
+	require.ErrorContains(t, err, "must not be negative")
+}
+
+func TestLabelRulesDistributionFunction(t *testing.T) {
+	rules, err := ParseShardLabelRules(`
+- selector: region=eu
+  shard: 2
+- selector: region=us
+  shard: 5
+- selector: tier=critical
+  shard: 1
+`)
+	require.NoError(t, err)
+	replicasCount := 3
+	distributionFunction := LabelRulesDistributionFunction(rules, replicasCount, func(_ *v1alpha1.Cluster) int {
+		return 0
+	})
+
+	var fixedShard int64 = 1
+	assert.Equal(t, 2, distributionFunction(&v1alpha1.Cluster{ID: "1", Labels: map[string]string{"region": "eu", "tier": "critical"}}))
+	assert.Equal(t, 1, distributionFunction(&v1alpha1.Cluster{ID: "2", Labels: map[string]string{"tier": "critical"}}))
+	// the shard of the first matching rule is greater than the number of replicas
+	assert.Equal(t, 0, distributionFunction(&v1alpha1.Cluster{ID: "3", Labels: map[string]string{"region": "us", "tier": "critical"}}))
+	assert.Equal(t, 0, distributionFunction(&v1alpha1.Cluster{ID: "4", Labels: map[string]string{"region": "ap"}}))
+	assert.Equal(t, 0, distributionFunction(&v1alpha1.Cluster{ID: "5", Shard: &fixedShard, Labels: map[string]string{"region": "eu"}}))
+	assert.Equal(t, 0, distributionFunction(nil))
+}
+
+func TestLabelRulesDistributionFunction_Logging(t *testing.T) {
+	rules, err := ParseShardLabelRules(`
+- selector: tier=critical
+  shard: 1
+- selector: region=eu
+  shard: 2
+`)
+	require.NoError(t, err)
+	distributionFunction := LabelRulesDistributionFunction(rules, 3, func(_ *v1alpha1.Cluster) int {
+		return 0
+	})
+	hook := logtest.NewGlobal()
+	defer hook.Reset()
+
+	// overlapping rules are resolved by the order of the rules
+	assert.Equal(t, 1, distributionFunction(&v1alpha1.Cluster{Name: "eu-critical", Labels: map[string]string{"region": "eu", "tier": "critical"}}))
+	require.NotNil(t, hook.LastEntry())
+	assert.Equal(t, log.WarnLevel, hook.LastEntry().Level)
+	assert.Equal(t, `Cluster eu-critical matches the overlapping shard label rules "tier=critical", "region=eu". Using the first rule "tier=critical".`, hook.LastEntry().Message)
+
+	level := log.GetLevel()
+	defer log.SetLevel(level)
+	log.SetLevel(log.DebugLevel)
+	assert.Equal(t, 0, distributionFunction(&v1alpha1.Cluster{Name: "ap", Labels: map[string]string{"region": "ap"}}))
+	assert.Equal(t, log.DebugLevel, hook.LastEntry().Level)
+	assert.Equal(t, "Cluster ap does not match any shard label rule. Assigning using the sharding method.", hook.LastEntry().Message)
+}
+
 func TestInferShard(t *testing.T) {
 	// Override the os.Hostname function to return a specific hostname for testing
 	defer func() { osHostnameFunction = os.Hostname }()
@@ -961,7 +1043,7 @@ func TestGetClusterSharding(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			tc.envsSetter(t)
 			defer tc.cleanup()
-			shardingCache, err := GetClusterSharding(kubeclientset, settingsMgr, "round-robin", tc.useDynamicSharding)
+			shardingCache, err := GetClusterSharding(kubeclientset, settingsMgr, "round-robin", tc.useDynamicSharding, nil)
 
 			if shardingCache != nil {
 				clusterSharding := shardingCache.(*ClusterSharding)
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 	goSync "sync"
 	"time"
@@ -41,6 +42,7 @@ import (
 	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v3/util/db"
 	"github.com/argoproj/argo-cd/v3/util/gpg"
+	"github.com/argoproj/argo-cd/v3/util/helm"
 	"github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/settings"
 	"github.com/argoproj/argo-cd/v3/util/stats"
@@ -120,6 +122,30 @@ type appStateManager struct {
 	repoErrorGracePeriod  time.Duration
 	serverSideDiff        bool
 	ignoreNormalizerOpts  normalizers.IgnoreNormalizerOpts
+	historyRetention      RevisionHistoryRetention
+	// helmLookupProxy gives the Helm templates rendered by the repo server access to the destination namespace, nil
+	// if the lookups are disabled
+	helmLookupProxy *helm.LookupProxy
+}
+
+// RevisionHistoryRetention limits the age and the size of the revision history of applications, in addition to the
+// number of entries configured by spec.revisionHistoryLimit. Zero values disable the corresponding limit.
+type RevisionHistoryRetention struct {
+	// MaxAge is the maximum age of the revision history entries
+	MaxAge time.Duration
+	// MaxBytes is the maximum JSON encoded size of the revision history
+	MaxBytes int
+}
+
+// Trunc removes the revision history entries exceeding the retention policy, the most recent entry is always kept
+func (r RevisionHistoryRetention) Trunc(history v1alpha1.RevisionHistories) v1alpha1.RevisionHistories {
+	if r.MaxAge > 0 {
+		history = history.TruncByAge(time.Now().Add(-r.MaxAge))
+	}
+	if r.MaxBytes > 0 {
+		history = history.TruncBySize(r.MaxBytes)
+	}
+	return history
 }
 
 // GetRepoObjs will generate the manifests for the given application delegating the
@@ -151,6 +177,11 @@ func (m *appStateManager) GetRepoObjs(app *v1alpha1.Application, sources []v1alp
 	if err != nil {
 		return nil, nil, false, fmt.Errorf("failed to get enabled source types: %w", err)
 	}
+	globalQuota, err := m.settingsMgr.GetApplicationResourceQuota()
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("failed to get application resource quota: %w", err)
+	}
+	quota := proj.GetApplicationResourceQuota(globalQuota)
 	ts.AddCheckpoint("plugins_ms")
 
 	kustomizeSettings, err := m.settingsMgr.GetKustomizeSettings()
@@ -235,7 +266,7 @@ func (m *appStateManager) GetRepoObjs(app *v1alpha1.Application, sources []v1alp
 			appNamespace = ""
 		}
 
-		if !source.IsHelm() && syncedRevision != "" && keyManifestGenerateAnnotationExists && keyManifestGenerateAnnotationVal != "" {
+		if !source.IsHelm() && !source.IsOCI() && syncedRevision != "" && keyManifestGenerateAnnotationExists && keyManifestGenerateAnnotationVal != "" {
 			// Validate the manifest-generate-path annotation to avoid generating manifests if it has not changed.
 			updateRevisionResult, err := repoClient.UpdateRevisionForPaths(context.Background(), &apiclient.UpdateRevisionForPathsRequest{
 				Repo:               repo,
@@ -270,6 +301,20 @@ func (m *appStateManager) GetRepoObjs(app *v1alpha1.Application, sources []v1alp
 			atLeastOneRevisionIsNotPossibleToBeUpdated = true
 		}
 
+		var lookupProxy *apiclient.HelmLookupProxy
+		if source.IsHelmLookupsEnabled() && m.helmLookupProxy != nil {
+			config, err := destCluster.RawRestConfig()
+			if err != nil {
+				return nil, nil, false, fmt.Errorf("failed to get the REST config of the destination cluster: %w", err)
+			}
+			token, closeSession, err := m.helmLookupProxy.Open(config, app.Spec.Destination.Namespace)
+			if err != nil {
+				return nil, nil, false, fmt.Errorf("failed to open a session of the Helm lookup proxy: %w", err)
+			}
+			defer closeSession()
+			lookupProxy = &apiclient.HelmLookupProxy{Url: m.helmLookupProxy.URL(), Token: token}
+		}
+
 		log.Debugf("Generating Manifest for source %s revision %s", source, revision)
 		manifestInfo, err := repoClient.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
 			Repo:                            repo,
@@ -284,7 +329,7 @@ func (m *appStateManager) GetRepoObjs(app *v1alpha1.Application, sources []v1alp
 			KustomizeOptions:                kustomizeOptions,
 			KubeVersion:                     serverVersion,
 			ApiVersions:                     apiVersions,
-			VerifySignature:                 verifySignature,
+			VerifySignature:                 verifySignature && proj.IsSignatureRequired(source.Path),
 			HelmRepoCreds:                   permittedHelmCredentials,
 			TrackingMethod:                  string(argo.GetTrackingMethod(m.settingsMgr)),
 			EnabledSourceTypes:              enabledSourceTypes,
@@ -295,6 +340,10 @@ func (m *appStateManager) GetRepoObjs(app *v1alpha1.Application, sources []v1alp
 			ProjectSourceRepos:              proj.Spec.SourceRepos,
 			AnnotationManifestGeneratePaths: app.GetAnnotation(v1alpha1.AnnotationKeyManifestGeneratePaths),
 			InstallationID:                  installationID,
+			MaxResources:                    quota.MaxResources,
+			MaxManifestsSize:                quota.GetMaxManifestsSize(),
+			HelmLookupProxy:                 lookupProxy,
+			ManifestGenerationWebhooks:      proj.GetManifestGenerationWebhooks(),
 		})
 		if err != nil {
 			return nil, nil, false, fmt.Errorf("failed to generate manifest for source %d of %d: %w", i+1, len(sources), err)
@@ -308,6 +357,15 @@ func (m *appStateManager) GetRepoObjs(app *v1alpha1.Application, sources []v1alp
 		manifestInfos = append(manifestInfos, manifestInfo)
 	}
 
+	// the quota is checked by the repo server for every source, and here for all of them
+	var manifests []string
+	for _, manifestInfo := range manifestInfos {
+		manifests = append(manifests, manifestInfo.Manifests...)
+	}
+	if err := quota.CheckManifests(manifests); err != nil {
+		return nil, nil, false, fmt.Errorf("project %q resource quota exceeded: %w", proj.Name, err)
+	}
+
 	ts.AddCheckpoint("manifests_ms")
 	logCtx := log.WithField("application", app.QualifiedName())
 	for k, v := range ts.Timings() {
@@ -410,6 +468,25 @@ func DeduplicateTargetObjects(
 	return result, conditions, nil
 }
 
+// setDestinationNamespaces sets the namespace of the namespace-scoped target objects which have not set a value for
+// .metadata.namespace according to the destination namespaces mapping of the application
+func setDestinationNamespaces(app *v1alpha1.Application, objs []*unstructured.Unstructured, infoProvider kubeutil.ResourceInfoProvider) error {
+	if len(app.Spec.DestinationNamespaces) == 0 {
+		return nil
+	}
+	for _, obj := range objs {
+		if obj == nil || obj.GetNamespace() != "" || !kubeutil.IsNamespacedOrUnknown(infoProvider, obj.GroupVersionKind().GroupKind()) {
+			continue
+		}
+		namespace, err := app.Spec.GetDestinationNamespace(obj.GetLabels())
+		if err != nil {
+			return err
+		}
+		obj.SetNamespace(namespace)
+	}
+	return nil
+}
+
 // normalizeClusterScopeTracking will set the app instance tracking metadata on malformed cluster-scoped resources where
 // metadata.namespace is not empty. The repo-server doesn't know which resources are cluster-scoped, so it may apply
 // an incorrect tracking annotation using the metadata.namespace. This function will correct that.
@@ -456,8 +533,8 @@ func (m *appStateManager) getComparisonSettings() (string, map[string]v1alpha1.R
 }
 
 // verifyGnuPGSignature verifies the result of a GnuPG operation for a given git
-// revision.
-func verifyGnuPGSignature(revision string, project *v1alpha1.AppProject, manifestInfo *apiclient.ManifestResponse) []v1alpha1.ApplicationCondition {
+// revision, against the keys required for the path of the source.
+func verifyGnuPGSignature(revision string, sourcePath string, project *v1alpha1.AppProject, manifestInfo *apiclient.ManifestResponse) []v1alpha1.ApplicationCondition {
 	now := metav1.Now()
 	conditions := make([]v1alpha1.ApplicationCondition, 0)
 	// We need to have some data in the verification result to parse, otherwise there was no signature
@@ -467,8 +544,8 @@ func verifyGnuPGSignature(revision string, project *v1alpha1.AppProject, manifes
 		case gpg.VerifyResultGood:
 			// This is the only case we allow to sync to, but we need to make sure signing key is allowed
 			validKey := false
-			for _, k := range project.Spec.SignatureKeys {
-				if gpg.KeyID(k.KeyID) == gpg.KeyID(verifyResult.KeyID) && gpg.KeyID(k.KeyID) != "" {
+			for _, k := range project.GetSignatureKeys(sourcePath) {
+				if gpg.SignatureKeyID(k.KeyID) == gpg.SignatureKeyID(verifyResult.KeyID) && gpg.SignatureKeyID(k.KeyID) != "" {
 					validKey = true
 					break
 				}
@@ -604,6 +681,20 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 		// empty out manifestInfoMap
 		manifestInfos = make([]*apiclient.ManifestResponse, 0)
 	}
+	if !failedToLoadObjs && app.Spec.SyncPolicy != nil && len(app.Spec.SyncPolicy.Hooks) > 0 {
+		revision := ""
+		if len(manifestInfos) > 0 {
+			revision = manifestInfos[0].Revision
+		}
+		libraryHooks, err := argo.GetLibraryHooks(app, m.settingsMgr, m.resourceTracking, m.namespace, revision)
+		if err != nil {
+			msg := "Failed to load hooks of the hook library: " + err.Error()
+			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
+			failedToLoadObjs = true
+		} else {
+			targetObjs = append(targetObjs, libraryHooks...)
+		}
+	}
 	ts.AddCheckpoint("git_ms")
 
 	var infoProvider kubeutil.ResourceInfoProvider
@@ -622,6 +713,12 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
 	}
 
+	err = setDestinationNamespaces(app, targetObjs, infoProvider)
+	if err != nil {
+		msg := "Failed to map resources to destination namespaces: " + err.Error()
+		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
+	}
+
 	targetObjs, dedupConditions, err := DeduplicateTargetObjects(app.Spec.Destination.Namespace, targetObjs, infoProvider)
 	if err != nil {
 		msg := "Failed to deduplicate target state: " + err.Error()
@@ -726,7 +823,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 	}
 	hasPostDeleteHooks := false
 	for _, obj := range targetObjs {
-		if isPostDeleteHook(obj) {
+		if argo.IsPostDeleteHook(obj) {
 			hasPostDeleteHooks = true
 		}
 	}
@@ -739,6 +836,10 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 		log.Warnf("Could not get compare options from ConfigMap (assuming defaults): %v", err)
 		compareOptions = settings.GetDefaultDiffOptions()
 	}
+	globalIgnoreDifferences, err := m.settingsMgr.GetResourceIgnoreDifferences()
+	if err != nil {
+		log.Warnf("Could not get ignored differences from ConfigMap (assuming none): %v", err)
+	}
 	manifestRevisions := make([]string, 0)
 
 	for _, manifestInfo := range manifestInfos {
@@ -754,14 +855,22 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 		serverSideDiff = false
 	}
 
-	useDiffCache := useDiffCache(noCache, manifestInfos, sources, app, manifestRevisions, m.statusRefreshTimeout, serverSideDiff, logCtx)
+	useDiffCache := useDiffCache(noCache, manifestInfos, sources, app, globalIgnoreDifferences, manifestRevisions, m.statusRefreshTimeout, serverSideDiff, logCtx)
 
+	ignoreDifferences := comparedIgnoreDifferences(app, globalIgnoreDifferences)
 	diffConfigBuilder := argodiff.NewDiffConfigBuilder().
-		WithDiffSettings(app.Spec.IgnoreDifferences, resourceOverrides, compareOptions.IgnoreAggregatedRoles, m.ignoreNormalizerOpts).
+		WithDiffSettings(ignoreDifferences, resourceOverrides, compareOptions.IgnoreAggregatedRoles, m.ignoreNormalizerOpts).
 		WithTracking(appLabelKey, string(trackingMethod))
 
 	if useDiffCache {
 		diffConfigBuilder.WithCache(m.cache, app.InstanceName(m.namespace))
+		refreshedResources := app.GetRefreshResources()
+		if noCache {
+			// the manifests are re-generated by a hard refresh scoped to resources, so the cached diff of the resources
+			// whose target state changed is stale as well
+			refreshedResources = append(refreshedResources, m.changedTargetResources(app, reconciliation.Target)...)
+		}
+		diffConfigBuilder.WithRefreshedResources(refreshedResources)
 	} else {
 		diffConfigBuilder.WithNoCache()
 	}
@@ -789,8 +898,12 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 		diffConfigBuilder.WithServerSideDryRunner(diff.NewK8sServerSideDryRunner(applier))
 	}
 
-	// enable structured merge diff if application syncs with server-side apply
-	if app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.SyncOptions.HasOption("ServerSideApply=true") {
+	// enable structured merge diff if application syncs with server-side apply, including by default of the project
+	var syncOptions v1alpha1.SyncOptions
+	if app.Spec.SyncPolicy != nil {
+		syncOptions = app.Spec.SyncPolicy.SyncOptions
+	}
+	if project.GetSyncOptions(syncOptions).HasOption("ServerSideApply=true") {
 		diffConfigBuilder.WithStructuredMergeDiff(true)
 	}
 
@@ -892,6 +1005,9 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 		resourceVersion := ""
 		if liveObj != nil {
 			resourceVersion = liveObj.GetResourceVersion()
+			if deletionTimestamp := liveObj.GetDeletionTimestamp(); deletionTimestamp != nil {
+				resState.PendingDeletion = &v1alpha1.PendingDeletion{DeletionRequestedAt: *deletionTimestamp, Finalizers: liveObj.GetFinalizers()}
+			}
 		}
 		managedResources[i] = managedResource{
 			Name:            resState.Name,
@@ -924,7 +1040,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 			ComparedTo: v1alpha1.ComparedTo{
 				Destination:       app.Spec.Destination,
 				Sources:           sources,
-				IgnoreDifferences: app.Spec.IgnoreDifferences,
+				IgnoreDifferences: ignoreDifferences,
 			},
 			Status:    syncCode,
 			Revisions: manifestRevisions,
@@ -934,7 +1050,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 			ComparedTo: v1alpha1.ComparedTo{
 				Destination:       app.Spec.Destination,
 				Source:            app.Spec.GetSource(),
-				IgnoreDifferences: app.Spec.IgnoreDifferences,
+				IgnoreDifferences: ignoreDifferences,
 			},
 			Status:   syncCode,
 			Revision: revision,
@@ -951,9 +1067,11 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 	// Git has already performed the signature verification via its GPG interface, and the result is available
 	// in the manifest info received from the repository server. We now need to form our opinion about the result
 	// and stop processing if we do not agree about the outcome.
-	for _, manifestInfo := range manifestInfos {
-		if gpg.IsGPGEnabled() && verifySignature && manifestInfo != nil {
-			conditions = append(conditions, verifyGnuPGSignature(manifestInfo.Revision, project, manifestInfo)...)
+	// The manifests of the sources are in the order of the sources, only the sources whose path requires a signature
+	// are verified.
+	for i, manifestInfo := range manifestInfos {
+		if gpg.IsGPGEnabled() && verifySignature && manifestInfo != nil && i < len(sources) && project.IsSignatureRequired(sources[i].Path) {
+			conditions = append(conditions, verifyGnuPGSignature(manifestInfo.Revision, sources[i].Path, project, manifestInfo)...)
 		}
 	}
 
@@ -991,15 +1109,43 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 	return &compRes, nil
 }
 
+// changedTargetResources returns the target resources whose state differs from the target state recorded with the
+// cached diff of the application
+func (m *appStateManager) changedTargetResources(app *v1alpha1.Application, targets []*unstructured.Unstructured) []v1alpha1.SyncOperationResource {
+	cachedDiff := make([]*v1alpha1.ResourceDiff, 0)
+	if err := m.cache.GetAppManagedResources(app.InstanceName(m.namespace), &cachedDiff); err != nil {
+		return nil
+	}
+	targetStates := map[kubeutil.ResourceKey]string{}
+	for _, res := range cachedDiff {
+		targetStates[kubeutil.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)] = res.TargetState
+	}
+	var changed []v1alpha1.SyncOperationResource
+	for _, target := range targets {
+		if target == nil {
+			continue
+		}
+		key := kubeutil.GetResourceKey(target)
+		if data, err := json.Marshal(target); err == nil && targetStates[key] == string(data) {
+			continue
+		}
+		changed = append(changed, v1alpha1.SyncOperationResource{Group: key.Group, Kind: key.Kind, Namespace: key.Namespace, Name: key.Name})
+	}
+	return changed
+}
+
 // useDiffCache will determine if the diff should be calculated based
 // on the existing live state cache or not.
-func useDiffCache(noCache bool, manifestInfos []*apiclient.ManifestResponse, sources []v1alpha1.ApplicationSource, app *v1alpha1.Application, manifestRevisions []string, statusRefreshTimeout time.Duration, serverSideDiff bool, log *log.Entry) bool {
-	if noCache {
+func useDiffCache(noCache bool, manifestInfos []*apiclient.ManifestResponse, sources []v1alpha1.ApplicationSource, app *v1alpha1.Application, globalIgnoreDifferences []v1alpha1.ResourceIgnoreDifferences, manifestRevisions []string, statusRefreshTimeout time.Duration, serverSideDiff bool, log *log.Entry) bool {
+	refreshType, refreshRequested := app.IsRefreshRequested()
+	// a refresh scoped to resources only ignores the cached diff of these resources, even though the manifests are
+	// re-generated by a hard refresh
+	scopedRefresh := refreshRequested && len(app.GetRefreshResources()) > 0
+	if noCache && !scopedRefresh {
 		log.WithField("useDiffCache", "false").Debug("noCache is true")
 		return false
 	}
-	refreshType, refreshRequested := app.IsRefreshRequested()
-	if refreshRequested {
+	if refreshRequested && !scopedRefresh {
 		log.WithField("useDiffCache", "false").Debugf("refresh type %s requested", string(refreshType))
 		return false
 	}
@@ -1024,7 +1170,7 @@ func useDiffCache(noCache bool, manifestInfos []*apiclient.ManifestResponse, sou
 		return false
 	}
 
-	if !specEqualsCompareTo(app.Spec, app.Status.Sync.ComparedTo) {
+	if !specEqualsCompareTo(app.Spec, comparedIgnoreDifferences(app, globalIgnoreDifferences), app.Status.Sync.ComparedTo) {
 		log.WithField("useDiffCache", "false").Debug("specChanged")
 		return false
 	}
@@ -1033,15 +1179,25 @@ func useDiffCache(noCache bool, manifestInfos []*apiclient.ManifestResponse, sou
 	return true
 }
 
-// specEqualsCompareTo compares the application spec to the comparedTo status. It normalizes the destination to match
-// the comparedTo destination before comparing. It does not mutate the original spec or comparedTo.
-func specEqualsCompareTo(spec v1alpha1.ApplicationSpec, comparedTo v1alpha1.ComparedTo) bool {
+// specEqualsCompareTo compares the application spec and the ignored differences recorded by the comparison, as returned
+// by comparedIgnoreDifferences, to the comparedTo status. It normalizes the destination to match the comparedTo
+// destination before comparing. It does not mutate the original spec or comparedTo.
+func specEqualsCompareTo(spec v1alpha1.ApplicationSpec, ignoreDifferences v1alpha1.IgnoreDifferences, comparedTo v1alpha1.ComparedTo) bool {
 	// Make a copy to be sure we don't mutate the original.
 	specCopy := spec.DeepCopy()
 	currentSpec := specCopy.BuildComparedToStatus()
+	currentSpec.IgnoreDifferences = ignoreDifferences
 	return reflect.DeepEqual(comparedTo, currentSpec)
 }
 
+// comparedIgnoreDifferences returns the differences ignored when comparing the application: the ones of its spec and of
+// its ignored managed fields managers annotation, followed by the ones of the resource.ignoreDifferences setting. They
+// are recorded in the comparedTo status, so that a change of the annotation or of the setting invalidates the cached
+// comparison like a change of the spec does.
+func comparedIgnoreDifferences(app *v1alpha1.Application, globalIgnoreDifferences []v1alpha1.ResourceIgnoreDifferences) v1alpha1.IgnoreDifferences {
+	return slices.Concat(app.GetIgnoreDifferences(), globalIgnoreDifferences)
+}
+
 func (m *appStateManager) persistRevisionHistory(
 	app *v1alpha1.Application,
 	revision string,
@@ -1051,6 +1207,7 @@ func (m *appStateManager) persistRevisionHistory(
 	hasMultipleSources bool,
 	startedAt metav1.Time,
 	initiatedBy v1alpha1.OperationInitiator,
+	rollback *v1alpha1.RollbackInfo,
 ) error {
 	var nextID int64
 	if len(app.Status.History) > 0 {
@@ -1065,6 +1222,7 @@ func (m *appStateManager) persistRevisionHistory(
 			Sources:         sources,
 			Revisions:       revisions,
 			InitiatedBy:     initiatedBy,
+			Rollback:        rollback,
 		})
 	} else {
 		app.Status.History = append(app.Status.History, v1alpha1.RevisionHistory{
@@ -1074,10 +1232,11 @@ func (m *appStateManager) persistRevisionHistory(
 			ID:              nextID,
 			Source:          source,
 			InitiatedBy:     initiatedBy,
+			Rollback:        rollback,
 		})
 	}
 
-	app.Status.History = app.Status.History.Trunc(app.Spec.GetRevisionHistoryLimit())
+	app.Status.History = m.historyRetention.Trunc(app.Status.History.Trunc(app.Spec.GetRevisionHistoryLimit()))
 
 	patch, err := json.Marshal(map[string]map[string][]v1alpha1.RevisionHistory{
 		"status": {
@@ -1110,6 +1269,8 @@ func NewAppStateManager(
 	repoErrorGracePeriod time.Duration,
 	serverSideDiff bool,
 	ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts,
+	historyRetention RevisionHistoryRetention,
+	helmLookupProxy *helm.LookupProxy,
 ) AppStateManager {
 	return &appStateManager{
 		liveStateCache:        liveStateCache,
@@ -1129,6 +1290,8 @@ func NewAppStateManager(
 		repoErrorGracePeriod:  repoErrorGracePeriod,
 		serverSideDiff:        serverSideDiff,
 		ignoreNormalizerOpts:  ignoreNormalizerOpts,
+		historyRetention:      historyRetention,
+		helmLookupProxy:       helmLookupProxy,
 	}
 }
 
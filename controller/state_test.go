@@ -3,7 +3,10 @@ package controller
 import (
 	"encoding/json"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -30,8 +33,10 @@ import (
 	"github.com/argoproj/argo-cd/v3/controller/testdata"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	mockrepoclient "github.com/argoproj/argo-cd/v3/reposerver/apiclient/mocks"
 	"github.com/argoproj/argo-cd/v3/test"
 	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/helm"
 )
 
 // TestCompareAppStateEmpty tests comparison when both git and live have no objects
@@ -384,6 +389,57 @@ func TestCompareAppStateHook(t *testing.T) {
 	assert.Empty(t, app.Status.Conditions)
 }
 
+func TestCompareAppStateLibraryHook(t *testing.T) {
+	newData := func(app *v1alpha1.Application, library map[string]string) *fakeData {
+		return &fakeData{
+			apps: []runtime.Object{app},
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: []string{},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+			additionalObjs: []runtime.Object{&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      common.ArgoCDHookLibraryConfigMapName,
+					Namespace: test.FakeArgoCDNamespace,
+					Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+				},
+				Data: library,
+			}},
+		}
+	}
+	library := map[string]string{
+		"db-migration": "apiVersion: batch/v1\nkind: Job\nmetadata:\n  name: ${ARGOCD_APP_NAME}-${DATABASE}\n  annotations:\n    argocd.argoproj.io/hook: PreSync\n",
+	}
+
+	t.Run("Rendered", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{Hooks: []v1alpha1.SyncHookReference{{Name: "db-migration", Parameters: v1alpha1.Env{{Name: "DATABASE", Value: "orders"}}}}}
+		ctrl := newFakeController(newData(app, library), nil)
+		compRes, err := ctrl.appStateManager.CompareAppState(app, &defaultProj, []string{""}, []v1alpha1.ApplicationSource{app.Spec.GetSource()}, false, false, nil, false, false)
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+		assert.Empty(t, compRes.managedResources)
+		require.Len(t, compRes.reconciliationResult.Hooks, 1)
+		assert.Equal(t, "my-app-orders", compRes.reconciliationResult.Hooks[0].GetName())
+		assert.Empty(t, app.Status.Conditions)
+	})
+
+	t.Run("UnknownHook", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{Hooks: []v1alpha1.SyncHookReference{{Name: "unknown"}}}
+		ctrl := newFakeController(newData(app, library), nil)
+		compRes, err := ctrl.appStateManager.CompareAppState(app, &defaultProj, []string{""}, []v1alpha1.ApplicationSource{app.Spec.GetSource()}, false, false, nil, false, false)
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
+		require.Len(t, app.Status.Conditions, 1)
+		assert.Equal(t, v1alpha1.ApplicationConditionComparisonError, app.Status.Conditions[0].Type)
+		assert.Contains(t, app.Status.Conditions[0].Message, `hook "unknown" is not defined in the hook library`)
+	})
+}
+
 // TestCompareAppStateSkipHook checks that skipped resources are detected during manifest generation, and not
 // considered as part of resources when assessing Synced status
 func TestCompareAppStateSkipHook(t *testing.T) {
@@ -599,6 +655,121 @@ func TestCompareAppStateDuplicatedNamespacedResources(t *testing.T) {
 	assert.Len(t, compRes.resources, 4)
 }
 
+func TestCompareAppStateDestinationNamespaces(t *testing.T) {
+	frontend := NewPod()
+	frontend.SetName("frontend")
+	frontend.SetLabels(map[string]string{"tier": "frontend"})
+	backend := NewPod()
+	backend.SetName("backend")
+	backend.SetLabels(map[string]string{"tier": "backend"})
+	explicit := NewPod()
+	explicit.SetName("explicit")
+	explicit.SetNamespace("kube-system")
+	explicit.SetLabels(map[string]string{"tier": "frontend"})
+
+	app := newFakeApp()
+	app.Spec.DestinationNamespaces = []v1alpha1.DestinationNamespaceMapping{
+		{Namespace: "frontend", Selector: metav1.LabelSelector{MatchLabels: map[string]string{"tier": "frontend"}}},
+	}
+	data := fakeData{
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, frontend), toJSON(t, backend), toJSON(t, explicit)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data, nil)
+	sources := []v1alpha1.ApplicationSource{app.Spec.GetSource()}
+	compRes, err := ctrl.appStateManager.CompareAppState(app, &defaultProj, []string{""}, sources, false, false, nil, false, false)
+	require.NoError(t, err)
+
+	namespaces := map[string]string{}
+	for _, res := range compRes.resources {
+		namespaces[res.Name] = res.Namespace
+	}
+	assert.Equal(t, map[string]string{"frontend": "frontend", "backend": test.FakeDestNamespace, "explicit": "kube-system"}, namespaces)
+}
+
+func TestCompareAppStateGlobalIgnoreDifferences(t *testing.T) {
+	pod := NewPod()
+	pod.SetNamespace(test.FakeDestNamespace)
+	livePod := pod.DeepCopy()
+	require.NoError(t, unstructured.SetNestedField(pod.Object, int64(10), "spec", "priority"))
+
+	compareAppState := func(configMapData map[string]string) *comparisonResult {
+		app := newFakeApp()
+		data := fakeData{
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: []string{toJSON(t, pod)},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+			managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+				kube.GetResourceKey(livePod): livePod,
+			},
+			configMapData: configMapData,
+		}
+		ctrl := newFakeController(&data, nil)
+		sources := []v1alpha1.ApplicationSource{app.Spec.GetSource()}
+		compRes, err := ctrl.appStateManager.CompareAppState(app, &defaultProj, []string{""}, sources, false, false, nil, false, false)
+		require.NoError(t, err)
+		return compRes
+	}
+
+	assert.Equal(t, v1alpha1.SyncStatusCodeOutOfSync, compareAppState(nil).syncStatus.Status)
+	compRes := compareAppState(map[string]string{
+		"resource.ignoreDifferences": "- kind: Pod\n  jsonPointers: [/spec/priority]",
+	})
+	assert.Equal(t, v1alpha1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+	// the setting is recorded as compared so that changing it invalidates the comparison
+	assert.Equal(t, v1alpha1.IgnoreDifferences{{Kind: "Pod", JSONPointers: []string{"/spec/priority"}}}, compRes.syncStatus.ComparedTo.IgnoreDifferences)
+	// an invalid configuration is ignored
+	assert.Equal(t, v1alpha1.SyncStatusCodeOutOfSync, compareAppState(map[string]string{
+		"resource.ignoreDifferences": "- jsonPointers: [/spec/priority]",
+	}).syncStatus.Status)
+}
+
+func TestCompareAppStateApplicationResourceQuota(t *testing.T) {
+	compareAppState := func(proj *v1alpha1.AppProject, configMapData map[string]string) *v1alpha1.Application {
+		app := newFakeApp()
+		data := fakeData{
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: []string{toJSON(t, NewPod()), toJSON(t, NewPod())},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+			managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{},
+			configMapData:   configMapData,
+		}
+		ctrl := newFakeController(&data, nil)
+		sources := []v1alpha1.ApplicationSource{app.Spec.GetSource()}
+		_, err := ctrl.appStateManager.CompareAppState(app, proj, []string{""}, sources, false, true, nil, false, false)
+		require.NoError(t, err)
+		return app
+	}
+	hasQuotaError := func(app *v1alpha1.Application) bool {
+		for _, condition := range app.Status.Conditions {
+			if condition.Type == v1alpha1.ApplicationConditionComparisonError && strings.Contains(condition.Message, "resource quota exceeded") {
+				return true
+			}
+		}
+		return false
+	}
+
+	assert.False(t, hasQuotaError(compareAppState(&defaultProj, nil)))
+	assert.True(t, hasQuotaError(compareAppState(&defaultProj, map[string]string{"application.resourceQuota": "maxResources: 1"})))
+
+	proj := defaultProj.DeepCopy()
+	proj.Spec.ApplicationResourceQuota = &v1alpha1.ApplicationResourceQuota{MaxResources: 1}
+	assert.True(t, hasQuotaError(compareAppState(proj, nil)))
+	proj.Spec.ApplicationResourceQuota.MaxResources = 2
+	assert.False(t, hasQuotaError(compareAppState(proj, map[string]string{"application.resourceQuota": "maxResources: 1"})))
+}
+
 func TestCompareAppStateManagedNamespaceMetadataWithLiveNsDoesNotGetPruned(t *testing.T) {
 	app := newFakeApp()
 	app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{
@@ -915,7 +1086,7 @@ func Test_appStateManager_persistRevisionHistory(t *testing.T) {
 		app.Spec.RevisionHistoryLimit = &i
 	}
 	addHistory := func() {
-		err := manager.persistRevisionHistory(app, "my-revision", v1alpha1.ApplicationSource{}, []string{}, []v1alpha1.ApplicationSource{}, false, metav1.Time{}, v1alpha1.OperationInitiator{})
+		err := manager.persistRevisionHistory(app, "my-revision", v1alpha1.ApplicationSource{}, []string{}, []v1alpha1.ApplicationSource{}, false, metav1.Time{}, v1alpha1.OperationInitiator{}, nil)
 		require.NoError(t, err)
 	}
 	addHistory()
@@ -951,9 +1122,40 @@ func Test_appStateManager_persistRevisionHistory(t *testing.T) {
 	assert.Len(t, app.Status.History, 9)
 
 	metav1NowTime := metav1.NewTime(time.Now())
-	err := manager.persistRevisionHistory(app, "my-revision", v1alpha1.ApplicationSource{}, []string{}, []v1alpha1.ApplicationSource{}, false, metav1NowTime, v1alpha1.OperationInitiator{})
+	err := manager.persistRevisionHistory(app, "my-revision", v1alpha1.ApplicationSource{}, []string{}, []v1alpha1.ApplicationSource{}, false, metav1NowTime, v1alpha1.OperationInitiator{}, nil)
 	require.NoError(t, err)
 	assert.Equal(t, app.Status.History.LastRevisionHistory().DeployStartedAt, &metav1NowTime)
+	assert.Nil(t, app.Status.History.LastRevisionHistory().Rollback)
+
+	rollback := &v1alpha1.RollbackInfo{Revision: "v1.0.0", FromRevision: "my-revision"}
+	err = manager.persistRevisionHistory(app, "abc123", v1alpha1.ApplicationSource{}, []string{}, []v1alpha1.ApplicationSource{}, false, metav1NowTime, v1alpha1.OperationInitiator{}, rollback)
+	require.NoError(t, err)
+	assert.Equal(t, rollback, app.Status.History.LastRevisionHistory().Rollback)
+}
+
+func Test_appStateManager_persistRevisionHistory_Retention(t *testing.T) {
+	app := newFakeApp()
+	app.Status.History = v1alpha1.RevisionHistories{
+		{ID: 1, Revision: "old", DeployedAt: metav1.NewTime(time.Now().Add(-48 * time.Hour))},
+		{ID: 2, Revision: "recent", DeployedAt: metav1.NewTime(time.Now().Add(-time.Hour))},
+	}
+	ctrl := newFakeController(&fakeData{
+		apps: []runtime.Object{app},
+	}, nil)
+	manager := ctrl.appStateManager.(*appStateManager)
+	manager.historyRetention = RevisionHistoryRetention{MaxAge: 24 * time.Hour}
+
+	err := manager.persistRevisionHistory(app, "my-revision", v1alpha1.ApplicationSource{}, []string{}, []v1alpha1.ApplicationSource{}, false, metav1.Time{}, v1alpha1.OperationInitiator{}, nil)
+	require.NoError(t, err)
+	require.Len(t, app.Status.History, 2)
+	assert.Equal(t, "recent", app.Status.History[0].Revision)
+	assert.Equal(t, "my-revision", app.Status.History[1].Revision)
+
+	manager.historyRetention = RevisionHistoryRetention{MaxBytes: 1}
+	err = manager.persistRevisionHistory(app, "abc123", v1alpha1.ApplicationSource{}, []string{}, []v1alpha1.ApplicationSource{}, false, metav1.Time{}, v1alpha1.OperationInitiator{}, nil)
+	require.NoError(t, err)
+	require.Len(t, app.Status.History, 1)
+	assert.Equal(t, "abc123", app.Status.History[0].Revision)
 }
 
 // helper function to read contents of a file to string
@@ -1279,6 +1481,54 @@ func TestSignedResponseSignatureRequired(t *testing.T) {
 	}
 }
 
+func TestSignedResponseSignatureRequiredForPath(t *testing.T) {
+	t.Setenv("ARGOCD_GPG_ENABLED", "true")
+
+	newPathProj := func(paths ...string) *v1alpha1.AppProject {
+		proj := signedProj.DeepCopy()
+		proj.Spec.SignatureKeys = []v1alpha1.SignatureKey{{KeyID: "SHA256:deAWW1bT8yqjG55QrNOzkLJwMPF0J9yDb+nJO+N/leQ", Paths: paths}}
+		return proj
+	}
+	compare := func(t *testing.T, proj *v1alpha1.AppProject, verifyResult string) *v1alpha1.Application {
+		t.Helper()
+		app := newFakeApp()
+		data := fakeData{
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests:    []string{},
+				Namespace:    test.FakeDestNamespace,
+				Server:       test.FakeClusterURL,
+				Revision:     "abc123",
+				VerifyResult: verifyResult,
+			},
+			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		}
+		ctrl := newFakeController(&data, nil)
+		sources := []v1alpha1.ApplicationSource{app.Spec.GetSource()}
+		compRes, err := ctrl.appStateManager.CompareAppState(app, proj, []string{"abc123"}, sources, false, false, nil, false, false)
+		require.NoError(t, err)
+		assert.NotNil(t, compRes)
+		return app
+	}
+
+	// The path of the source requires a signature made with the SSH key - sync!
+	app := compare(t, newPathProj("some/**"), mustReadFile("../util/gpg/testdata/good_signature_ssh.txt"))
+	assert.Empty(t, app.Status.Conditions)
+
+	// The path of the source requires a signature, but the commit is not signed - do not sync
+	app = compare(t, newPathProj("some/**"), "")
+	require.Len(t, app.Status.Conditions, 1)
+	assert.Contains(t, app.Status.Conditions[0].Message, "is not signed")
+
+	// The path of the source requires a signature, but the SSH signature is invalid - do not sync
+	app = compare(t, newPathProj("some/**"), mustReadFile("../util/gpg/testdata/bad_signature_ssh.txt"))
+	require.Len(t, app.Status.Conditions, 1)
+	assert.Contains(t, app.Status.Conditions[0].Message, "incorrect signature")
+
+	// The path of the source doesn't require a signature - sync!
+	app = compare(t, newPathProj("clusters/prod/**"), "")
+	assert.Empty(t, app.Status.Conditions)
+}
+
 func TestComparisonResult_GetHealthStatus(t *testing.T) {
 	status := &v1alpha1.HealthStatus{Status: health.HealthStatusMissing}
 	res := comparisonResult{
@@ -1478,15 +1728,16 @@ func TestUseDiffCache(t *testing.T) {
 	t.Parallel()
 
 	type fixture struct {
-		testName             string
-		noCache              bool
-		manifestInfos        []*apiclient.ManifestResponse
-		sources              []v1alpha1.ApplicationSource
-		app                  *v1alpha1.Application
-		manifestRevisions    []string
-		statusRefreshTimeout time.Duration
-		expectedUseCache     bool
-		serverSideDiff       bool
+		testName                string
+		noCache                 bool
+		manifestInfos           []*apiclient.ManifestResponse
+		sources                 []v1alpha1.ApplicationSource
+		app                     *v1alpha1.Application
+		globalIgnoreDifferences []v1alpha1.ResourceIgnoreDifferences
+		manifestRevisions       []string
+		statusRefreshTimeout    time.Duration
+		expectedUseCache        bool
+		serverSideDiff          bool
 	}
 
 	manifestInfos := func(revision string) []*apiclient.ManifestResponse {
@@ -1662,6 +1913,37 @@ func TestUseDiffCache(t *testing.T) {
 			expectedUseCache:     false,
 			serverSideDiff:       false,
 		},
+		{
+			testName:      "will use diff cache if requested refresh is scoped to resources",
+			noCache:       false,
+			manifestInfos: manifestInfos("rev1"),
+			sources:       sources(),
+			app: func() *v1alpha1.Application {
+				a := app("httpbin", "rev1", true, nil)
+				a.Annotations[v1alpha1.AnnotationKeyRefreshResources] = "apps:Deployment:httpbin"
+				return a
+			}(),
+			manifestRevisions:    []string{"rev1"},
+			statusRefreshTimeout: time.Hour * 24,
+			expectedUseCache:     true,
+			serverSideDiff:       false,
+		},
+		{
+			testName:      "will use diff cache if requested hard refresh is scoped to resources",
+			noCache:       true,
+			manifestInfos: manifestInfos("rev1"),
+			sources:       sources(),
+			app: func() *v1alpha1.Application {
+				a := app("httpbin", "rev1", true, nil)
+				a.Annotations[v1alpha1.AnnotationKeyRefresh] = string(v1alpha1.RefreshTypeHard)
+				a.Annotations[v1alpha1.AnnotationKeyRefreshResources] = "apps:Deployment:httpbin"
+				return a
+			}(),
+			manifestRevisions:    []string{"rev1"},
+			statusRefreshTimeout: time.Hour * 24,
+			expectedUseCache:     true,
+			serverSideDiff:       false,
+		},
 		{
 			testName:             "will return false if status expired",
 			noCache:              false,
@@ -1735,6 +2017,73 @@ func TestUseDiffCache(t *testing.T) {
 			expectedUseCache:     false,
 			serverSideDiff:       false,
 		},
+		{
+			testName:      "will return false if resource.ignoreDifferences setting changed",
+			noCache:       false,
+			manifestInfos: manifestInfos("rev1"),
+			sources:       sources(),
+			app:           app("httpbin", "rev1", false, nil),
+			globalIgnoreDifferences: []v1alpha1.ResourceIgnoreDifferences{
+				{Group: "apps", Kind: "Deployment", JSONPointers: []string{"/spec/replicas"}},
+			},
+			manifestRevisions:    []string{"rev1"},
+			statusRefreshTimeout: time.Hour * 24,
+			expectedUseCache:     false,
+			serverSideDiff:       true,
+		},
+		{
+			testName:      "will use diff cache if resource.ignoreDifferences setting is unchanged",
+			noCache:       false,
+			manifestInfos: manifestInfos("rev1"),
+			sources:       sources(),
+			app: func() *v1alpha1.Application {
+				a := app("httpbin", "rev1", false, nil)
+				a.Status.Sync.ComparedTo.IgnoreDifferences = []v1alpha1.ResourceIgnoreDifferences{
+					{Group: "apps", Kind: "Deployment", JSONPointers: []string{"/spec/replicas"}},
+				}
+				return a
+			}(),
+			globalIgnoreDifferences: []v1alpha1.ResourceIgnoreDifferences{
+				{Group: "apps", Kind: "Deployment", JSONPointers: []string{"/spec/replicas"}},
+			},
+			manifestRevisions:    []string{"rev1"},
+			statusRefreshTimeout: time.Hour * 24,
+			expectedUseCache:     true,
+			serverSideDiff:       true,
+		},
+		{
+			testName:      "will use diff cache if ignored managed fields managers are unchanged",
+			noCache:       false,
+			manifestInfos: manifestInfos("rev1"),
+			sources:       sources(),
+			app: func() *v1alpha1.Application {
+				a := app("httpbin", "rev1", false, nil)
+				a.Annotations = map[string]string{v1alpha1.AnnotationKeyIgnoreManagedFieldsManagers: "kube-controller-manager"}
+				a.Status.Sync.ComparedTo.IgnoreDifferences = []v1alpha1.ResourceIgnoreDifferences{
+					{Group: "*", Kind: "*", ManagedFieldsManagers: []string{"kube-controller-manager"}},
+				}
+				return a
+			}(),
+			manifestRevisions:    []string{"rev1"},
+			statusRefreshTimeout: time.Hour * 24,
+			expectedUseCache:     true,
+			serverSideDiff:       false,
+		},
+		{
+			testName:      "will return false if ignored managed fields managers changed",
+			noCache:       false,
+			manifestInfos: manifestInfos("rev1"),
+			sources:       sources(),
+			app: func() *v1alpha1.Application {
+				a := app("httpbin", "rev1", false, nil)
+				a.Annotations = map[string]string{v1alpha1.AnnotationKeyIgnoreManagedFieldsManagers: "kube-controller-manager"}
+				return a
+			}(),
+			manifestRevisions:    []string{"rev1"},
+			statusRefreshTimeout: time.Hour * 24,
+			expectedUseCache:     false,
+			serverSideDiff:       false,
+		},
 	}
 
 	for _, tc := range cases {
@@ -1746,7 +2095,7 @@ func TestUseDiffCache(t *testing.T) {
 			log := logrus.NewEntry(logger)
 
 			// When
-			useDiffCache := useDiffCache(tc.noCache, tc.manifestInfos, tc.sources, tc.app, tc.manifestRevisions, tc.statusRefreshTimeout, tc.serverSideDiff, log)
+			useDiffCache := useDiffCache(tc.noCache, tc.manifestInfos, tc.sources, tc.app, tc.globalIgnoreDifferences, tc.manifestRevisions, tc.statusRefreshTimeout, tc.serverSideDiff, log)
 
 			// Then
 			assert.Equal(t, tc.expectedUseCache, useDiffCache)
@@ -1754,6 +2103,33 @@ func TestUseDiffCache(t *testing.T) {
 	}
 }
 
+func Test_appStateManager_changedTargetResources(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+	manager := ctrl.appStateManager.(*appStateManager)
+
+	unchanged := kube.MustToUnstructured(NewPod())
+	changed := kube.MustToUnstructured(NewPod())
+	changed.SetName("changed")
+	added := kube.MustToUnstructured(NewPod())
+	added.SetName("added")
+	targetState := func(obj *unstructured.Unstructured) string {
+		data, err := json.Marshal(obj)
+		require.NoError(t, err)
+		return string(data)
+	}
+	require.NoError(t, manager.cache.SetAppManagedResources(app.InstanceName(manager.namespace), []*v1alpha1.ResourceDiff{
+		{Kind: "Pod", Namespace: unchanged.GetNamespace(), Name: unchanged.GetName(), TargetState: targetState(unchanged)},
+		{Kind: "Pod", Namespace: changed.GetNamespace(), Name: changed.GetName(), TargetState: `{"kind":"Pod"}`},
+	}))
+
+	resources := manager.changedTargetResources(app, []*unstructured.Unstructured{unchanged, changed, added, nil})
+	assert.Equal(t, []v1alpha1.SyncOperationResource{
+		{Kind: "Pod", Namespace: changed.GetNamespace(), Name: "changed"},
+		{Kind: "Pod", Namespace: added.GetNamespace(), Name: "added"},
+	}, resources)
+}
+
 func TestCompareAppStateDefaultRevisionUpdated(t *testing.T) {
 	app := newFakeApp()
 	data := fakeData{
@@ -1820,3 +2196,42 @@ func Test_normalizeClusterScopeTracking(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, called, "normalization function should have called the callback function")
 }
+
+func TestCompareAppStateHelmLookupProxy(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Source.Helm = &v1alpha1.ApplicationSourceHelm{EnableLookups: true}
+	data := fakeData{
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		helmLookupProxy: helm.NewLookupProxy("http://argocd-application-controller:8084"),
+	}
+	ctrl := newFakeController(&data, nil)
+	sources := []v1alpha1.ApplicationSource{app.Spec.GetSource()}
+	_, err := ctrl.appStateManager.CompareAppState(app, &defaultProj, []string{""}, sources, false, false, nil, false, false)
+	require.NoError(t, err)
+
+	repoClient := ctrl.appStateManager.(*appStateManager).repoClientset.(*mockrepoclient.Clientset).RepoServerServiceClient.(*mockrepoclient.RepoServerServiceClient)
+	var request *apiclient.ManifestRequest
+	for _, call := range repoClient.Calls {
+		if call.Method == "GenerateManifest" {
+			request = call.Arguments.Get(1).(*apiclient.ManifestRequest)
+		}
+	}
+	require.NotNil(t, request)
+	require.NotNil(t, request.HelmLookupProxy)
+	assert.Equal(t, "http://argocd-application-controller:8084", request.HelmLookupProxy.Url)
+	assert.NotEmpty(t, request.HelmLookupProxy.Token)
+
+	// the session is closed once the manifests are generated
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "/api/v1/namespaces/"+test.FakeDestNamespace+"/configmaps", http.NoBody)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+request.HelmLookupProxy.Token)
+	w := httptest.NewRecorder()
+	data.helmLookupProxy.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
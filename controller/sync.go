@@ -17,6 +17,7 @@ import (
 	gitopsDiff "github.com/argoproj/gitops-engine/pkg/diff"
 	"github.com/argoproj/gitops-engine/pkg/sync"
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	resourceutil "github.com/argoproj/gitops-engine/pkg/sync/resource"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	jsonpatch "github.com/evanphx/json-patch"
 	log "github.com/sirupsen/logrus"
@@ -33,6 +34,7 @@ import (
 	listersv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/argo/diff"
+	"github.com/argoproj/argo-cd/v3/util/git"
 	"github.com/argoproj/argo-cd/v3/util/glob"
 	kubeutil "github.com/argoproj/argo-cd/v3/util/kube"
 	logutils "github.com/argoproj/argo-cd/v3/util/log"
@@ -177,7 +179,9 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		state.Message = fmt.Sprintf("Failed to load application project: %v", err)
 		return
 	} else {
-		isBlocked, err := syncWindowPreventsSync(app, proj)
+		// a missing destination cluster fails the operation below, until then only the project windows apply
+		windowCluster, _ := argo.GetDestinationCluster(context.Background(), app.Spec.Destination, m.db)
+		isBlocked, err := syncWindowPreventsSync(app, proj, windowCluster)
 		if isBlocked {
 			// If the operation is currently running, simply let the user know the sync is blocked by a current sync window
 			if state.Phase == common.OperationRunning {
@@ -190,6 +194,25 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		}
 	}
 
+	// the default sync options of the project apply to every sync of its applications, the options it denies to none
+	syncOp.SyncOptions = proj.GetSyncOptions(syncOp.SyncOptions)
+
+	// the sources may no longer be permitted if the project or the application changed, and the operation may override
+	// the sources or the revisions of the application
+	if app.Spec.SourceHydrator == nil {
+		opSources := []v1alpha1.ApplicationSource{source}
+		opRevisions := []string{syncOp.Revision}
+		if isMultiSourceRevision {
+			opSources = sources
+			opRevisions = syncOp.Revisions
+		}
+		if err := checkOperationSourceRestrictions(proj, opSources, opRevisions); err != nil {
+			state.Phase = common.OperationFailed
+			state.Message = err.Error()
+			return
+		}
+	}
+
 	if !isMultiSourceRevision {
 		sources = []v1alpha1.ApplicationSource{source}
 		revisions = []string{revision}
@@ -247,6 +270,12 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		return
 	}
 
+	kindSyncTimeouts, err := m.settingsMgr.GetResourceSyncTimeouts()
+	if err != nil {
+		// a misconfigured setting must not fail every sync, the resource kinds then have no sync timeout
+		log.WithField("application", app.QualifiedName()).Warnf("Ignoring the resource sync timeouts: %v", err)
+	}
+
 	atomic.AddUint64(&syncIdPrefix, 1)
 	randSuffix, err := rand.String(5)
 	if err != nil {
@@ -258,8 +287,12 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 
 	logEntry := log.WithFields(log.Fields{"application": app.QualifiedName(), "syncId": syncId})
 	initialResourcesRes := make([]common.ResourceSyncResult, 0)
+	appliedAt := map[kube.ResourceKey]time.Time{}
 	for i, res := range syncRes.Resources {
 		key := kube.ResourceKey{Group: res.Group, Kind: res.Kind, Namespace: res.Namespace, Name: res.Name}
+		if res.AppliedAt != nil {
+			appliedAt[key] = res.AppliedAt.Time
+		}
 		initialResourcesRes = append(initialResourcesRes, common.ResourceSyncResult{
 			ResourceKey: key,
 			Message:     res.Message,
@@ -289,6 +322,13 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		return
 	}
 
+	applyConcurrency, err := getApplyConcurrency(syncOp.SyncOptions)
+	if err != nil {
+		state.Phase = common.OperationError
+		state.Message = err.Error()
+		return
+	}
+
 	reconciliationResult := compareResult.reconciliationResult
 
 	// if RespectIgnoreDifferences is enabled, it should normalize the target
@@ -304,6 +344,14 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		reconciliationResult.Target = patchedTargets
 	}
 
+	if syncOp.SyncOptions.HasOption(syncOptionSkipInSyncWaveHooks) {
+		// the out-of-sync waves are recorded by the first sync operation run, before their resources are synced
+		if len(syncRes.Resources) == 0 {
+			syncRes.OutOfSyncWaves = getOutOfSyncWaves(reconciliationResult, compareResult.diffResultList, syncOp.Prune)
+		}
+		reconciliationResult.Hooks = filterOutOfSyncWaveHooks(reconciliationResult.Hooks, syncRes.OutOfSyncWaves)
+	}
+
 	installationID, err := m.settingsMgr.GetInstallationID()
 	if err != nil {
 		log.Errorf("Could not get installation ID: %v", err)
@@ -333,13 +381,25 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		}
 	}
 
+	timeoutHealthOverride := &syncTimeoutHealthOverride{
+		HealthOverride: lua.ResourceHealthOverrides(resourceOverrides),
+		kindTimeouts:   kindSyncTimeouts,
+		appliedAt:      appliedAt,
+	}
 	opts := []sync.SyncOpt{
 		sync.WithLogr(logutils.NewLogrusLogger(logEntry)),
-		sync.WithHealthOverride(lua.ResourceHealthOverrides(resourceOverrides)),
+		sync.WithHealthOverride(timeoutHealthOverride),
 		sync.WithPermissionValidator(func(un *unstructured.Unstructured, res *metav1.APIResource) error {
 			if !proj.IsGroupKindPermitted(un.GroupVersionKind().GroupKind(), res.Namespaced) {
 				return fmt.Errorf("resource %s:%s is not permitted in project %s", un.GroupVersionKind().Group, un.GroupVersionKind().Kind, proj.Name)
 			}
+			// the sync options denied by the project don't apply to the resources either
+			if option := deniedResourceSyncOption(proj, un); option != "" {
+				return fmt.Errorf("sync option %s is denied in project %s", option, proj.Name)
+			}
+			if err := validateResourceSyncTimeout(un); err != nil {
+				return err
+			}
 			if res.Namespaced {
 				permitted, err := proj.IsDestinationPermitted(destCluster, un.GetNamespace(), func(project string) ([]*v1alpha1.Cluster, error) {
 					return m.db.GetProjectClusters(context.TODO(), project)
@@ -358,12 +418,12 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		sync.WithInitialState(state.Phase, state.Message, initialResourcesRes, state.StartedAt),
 		sync.WithResourcesFilter(func(key kube.ResourceKey, target *unstructured.Unstructured, live *unstructured.Unstructured) bool {
 			return (len(syncOp.Resources) == 0 ||
-				isPostDeleteHook(target) ||
+				argo.IsPostDeleteHook(target) ||
 				argo.ContainsSyncResource(key.Name, key.Namespace, schema.GroupVersionKind{Kind: key.Kind, Group: key.Group}, syncOp.Resources)) &&
 				m.isSelfReferencedObj(live, target, app.GetName(), trackingMethod, installationID)
 		}),
 		sync.WithManifestValidation(!syncOp.SyncOptions.HasOption(common.SyncOptionsDisableValidation)),
-		sync.WithSyncWaveHook(delayBetweenSyncWaves),
+		sync.WithSyncWaveHook(newSyncWaveHook(reconciliationResult.Target, syncRes, syncOp.DryRun)),
 		sync.WithPruneLast(syncOp.SyncOptions.HasOption(common.SyncOptionPruneLast)),
 		sync.WithResourceModificationChecker(syncOp.SyncOptions.HasOption("ApplyOutOfSyncOnly=true"), compareResult.diffResultList),
 		sync.WithPrunePropagationPolicy(&prunePropagationPolicy),
@@ -378,12 +438,16 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		opts = append(opts, sync.WithNamespaceModifier(syncNamespace(app.Spec.SyncPolicy)))
 	}
 
+	conflictChecker := newSSAConflictChecker(&syncOp)
 	syncCtx, cleanup, err := sync.NewSyncContext(
 		compareResult.syncStatus.Revision,
 		reconciliationResult,
 		restConfig,
 		rawConfig,
-		m.kubectl,
+		&prunePropagationKubectl{
+			Kubectl:  &ssaConflictsKubectl{Kubectl: newApplyConcurrencyKubectl(m.kubectl, applyConcurrency), checker: conflictChecker},
+			policies: getPrunePropagationPolicies(reconciliationResult.Live),
+		},
 		app.Spec.Destination.Namespace,
 		openAPISchema,
 		opts...,
@@ -398,19 +462,66 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 
 	start := time.Now()
 
-	if state.Phase == common.OperationTerminating {
+	var pauseMessage, pauseFailedMessage string
+	switch {
+	case state.Phase == common.OperationTerminating:
 		syncCtx.Terminate()
-	} else {
+	case syncRes.WavePause != nil:
+		// the next wave is not synced until the end of the pause, the sync fails if the paused wave degrades
+		if degraded := degradedSyncWaveResources(compareResult.managedResources, syncRes.WavePause, lua.ResourceHealthOverrides(resourceOverrides)); len(degraded) > 0 {
+			pauseFailedMessage = syncWaveDegradedMessage(syncRes.WavePause, degraded)
+			syncRes.WavePause = nil
+		} else if time.Now().Before(syncRes.WavePause.Until.Time) {
+			pauseMessage = syncWavePauseMessage(syncRes.WavePause)
+		} else {
+			syncRes.WavePause = nil
+			syncCtx.Sync()
+		}
+	default:
 		syncCtx.Sync()
 	}
 	var resState []common.ResourceSyncResult
 	state.Phase, state.Message, resState = syncCtx.GetState()
+	switch {
+	case pauseFailedMessage != "":
+		state.Phase = common.OperationFailed
+		state.Message = pauseFailedMessage
+	case pauseMessage != "":
+		state.Phase = common.OperationRunning
+		state.Message = pauseMessage
+	case state.Phase.Completed():
+		syncRes.WavePause = nil
+	case syncRes.WavePause != nil:
+		state.Message = syncWavePauseMessage(syncRes.WavePause)
+	}
+	// the syncs failed by resources which are not healthy at the end of their sync timeout are retried with the retry
+	// strategy of their kinds
+	if state.Phase == common.OperationFailed {
+		if retry := timeoutHealthOverride.retryStrategy(); retry != nil {
+			state.Operation.Retry = *retry
+		}
+	}
+	// the conflicts are only known by the sync operation run which applied the resources
+	prevConflicts := map[kube.ResourceKey][]v1alpha1.ServerSideApplyConflict{}
+	for _, res := range state.SyncResult.Resources {
+		if len(res.Conflicts) > 0 {
+			prevConflicts[kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)] = res.Conflicts
+		}
+	}
 	state.SyncResult.Resources = nil
 
 	if app.Spec.SyncPolicy != nil {
 		state.SyncResult.ManagedNamespaceMetadata = app.Spec.SyncPolicy.ManagedNamespaceMetadata
 	}
 
+	targetsByKey := map[kube.ResourceKey]*unstructured.Unstructured{}
+	for _, target := range reconciliationResult.Target {
+		if target != nil {
+			targetsByKey[kube.GetResourceKey(target)] = target
+		}
+	}
+	now := metav1.Now()
+
 	var apiVersion []kube.APIResourceInfo
 	for _, res := range resState {
 		augmentedMsg, err := argo.AugmentSyncMsg(res, func() ([]kube.APIResourceInfo, error) {
@@ -429,6 +540,10 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 			res.Message = augmentedMsg
 		}
 
+		conflicts := conflictChecker.getConflicts(res.ResourceKey)
+		if conflicts == nil {
+			conflicts = prevConflicts[res.ResourceKey]
+		}
 		state.SyncResult.Resources = append(state.SyncResult.Resources, &v1alpha1.ResourceResult{
 			HookType:  res.HookType,
 			Group:     res.ResourceKey.Group,
@@ -440,13 +555,15 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 			HookPhase: res.HookPhase,
 			Status:    res.Status,
 			Message:   res.Message,
+			Conflicts: conflicts,
+			AppliedAt: getResourceAppliedAt(res, appliedAt, targetsByKey[res.ResourceKey], kindSyncTimeouts, syncOp.DryRun, now),
 		})
 	}
 
 	logEntry.WithField("duration", time.Since(start)).Info("sync/terminate complete")
 
 	if !syncOp.DryRun && len(syncOp.Resources) == 0 && state.Phase.Successful() {
-		err := m.persistRevisionHistory(app, compareResult.syncStatus.Revision, source, compareResult.syncStatus.Revisions, compareResult.syncStatus.ComparedTo.Sources, isMultiSourceRevision, state.StartedAt, state.Operation.InitiatedBy)
+		err := m.persistRevisionHistory(app, compareResult.syncStatus.Revision, source, compareResult.syncStatus.Revisions, compareResult.syncStatus.ComparedTo.Sources, isMultiSourceRevision, state.StartedAt, state.Operation.InitiatedBy, syncOp.Rollback)
 		if err != nil {
 			state.Phase = common.OperationError
 			state.Message = fmt.Sprintf("failed to record sync to history: %v", err)
@@ -454,6 +571,46 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	}
 }
 
+// checkOperationSourceRestrictions returns an error if a source of the operation, or its revision, is not permitted by
+// the source restrictions of the project. The commit SHAs the revisions are resolved to can't be matched against the
+// revision patterns, so the target revision of the source is checked instead, unless it is a commit SHA as well, e.g.
+// for a rollback. The API server checks the revisions requested by the users before resolving them.
+func checkOperationSourceRestrictions(proj *v1alpha1.AppProject, sources []v1alpha1.ApplicationSource, revisions []string) error {
+	for i, src := range sources {
+		revision := ""
+		if i < len(revisions) {
+			revision = revisions[i]
+		}
+		if git.IsCommitSHA(revision) {
+			revision = ""
+		}
+		if revision == "" && git.IsCommitSHA(src.TargetRevision) {
+			if err := proj.CheckSourcePathRestrictions(src); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := proj.CheckSourceRestrictions(src, revision); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deniedResourceSyncOption returns the sync option of the sync-options annotation of the resource which is denied by
+// the project, or an empty string if there is none
+func deniedResourceSyncOption(proj *v1alpha1.AppProject, obj *unstructured.Unstructured) string {
+	if obj == nil || proj.Spec.SyncPolicy == nil {
+		return ""
+	}
+	for _, option := range resourceutil.GetAnnotationCSVs(obj, common.AnnotationSyncOptions) {
+		if proj.Spec.SyncPolicy.IsSyncOptionDenied(option) {
+			return option
+		}
+	}
+	return ""
+}
+
 // normalizeTargetResources modifies target resources to ensure ignored fields are not touched during synchronization:
 //   - applies normalization to the target resources based on the live resources
 //   - copies ignored fields from the matching live resources: apply normalizer to the live resource,
@@ -579,13 +736,12 @@ func delayBetweenSyncWaves(_ common.SyncPhase, _ int, finalWave bool) error {
 	return nil
 }
 
-func syncWindowPreventsSync(app *v1alpha1.Application, proj *v1alpha1.AppProject) (bool, error) {
-	window := proj.Spec.SyncWindows.Matches(app)
+func syncWindowPreventsSync(app *v1alpha1.Application, proj *v1alpha1.AppProject, destCluster *v1alpha1.Cluster) (bool, error) {
 	isManual := false
 	if app.Status.OperationState != nil {
 		isManual = !app.Status.OperationState.Operation.InitiatedBy.Automated
 	}
-	canSync, err := window.CanSync(isManual)
+	canSync, err := proj.Spec.SyncWindows.CanSyncWithCluster(app, destCluster, isManual)
 	if err != nil {
 		// prevents sync because sync window has an error
 		return true, err
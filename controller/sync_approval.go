@@ -0,0 +1,50 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// maxSyncApprovalResources is the maximum number of resources listed in the message of a sync approval condition
+const maxSyncApprovalResources = 20
+
+// syncApprovalPendingCondition returns a condition with the pending diff if the automated sync of the application to the
+// given revisions requires an approval, with the RequireDiffApproval=true sync option of the effective sync policy of the
+// application, which was not given yet. Nil is returned otherwise.
+func syncApprovalPendingCondition(app *v1alpha1.Application, syncPolicy *v1alpha1.SyncPolicy, revision string, revisions []string, resources []v1alpha1.ResourceStatus) *v1alpha1.ApplicationCondition {
+	if syncPolicy == nil || !syncPolicy.SyncOptions.HasOption("RequireDiffApproval=true") {
+		return nil
+	}
+	desiredRevision := revision
+	if app.Spec.HasMultipleSources() {
+		desiredRevision = strings.Join(revisions, ",")
+	}
+	if app.GetAnnotation(v1alpha1.AnnotationKeySyncApprovedRevision) == desiredRevision {
+		return nil
+	}
+
+	var diff []string
+	for _, res := range resources {
+		if res.Status == v1alpha1.SyncStatusCodeSynced {
+			continue
+		}
+		if len(diff) == maxSyncApprovalResources {
+			diff = append(diff, "...")
+			break
+		}
+		name := res.Name
+		if res.Namespace != "" {
+			name = res.Namespace + "/" + name
+		}
+		status := string(res.Status)
+		if res.RequiresPruning {
+			status = "requires pruning"
+		}
+		diff = append(diff, fmt.Sprintf("%s %s (%s)", res.Kind, name, status))
+	}
+	message := fmt.Sprintf("Automated sync to %s waits for the approval of the diff: %s. Approve it with 'argocd app approve %s --revision %s'",
+		desiredRevision, strings.Join(diff, ", "), app.QualifiedName(), desiredRevision)
+	return &v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionSyncApprovalPending, Message: message}
+}
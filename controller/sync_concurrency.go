@@ -0,0 +1,110 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/openapi"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// syncOptionApplyConcurrency is the prefix of the sync option capping the number of resources applied concurrently
+// within a wave, e.g. ApplyConcurrency=5
+const syncOptionApplyConcurrency = "ApplyConcurrency="
+
+// getApplyConcurrency returns the maximum number of resources applied concurrently set by the sync options, 0 if the
+// applies are not capped
+func getApplyConcurrency(syncOptions v1alpha1.SyncOptions) (int, error) {
+	for _, option := range syncOptions {
+		value, ok := strings.CutPrefix(option, syncOptionApplyConcurrency)
+		if !ok {
+			continue
+		}
+		concurrency, err := strconv.Atoi(value)
+		if err != nil || concurrency < 1 {
+			return 0, fmt.Errorf("invalid sync option %s: the apply concurrency must be a positive integer", option)
+		}
+		return concurrency, nil
+	}
+	return 0, nil
+}
+
+// applyConcurrencyKubectl is the kubectl of a sync operation capping the number of resources applied concurrently
+type applyConcurrencyKubectl struct {
+	kube.Kubectl
+	// applies holds a token per ongoing apply
+	applies chan struct{}
+}
+
+func newApplyConcurrencyKubectl(kubectl kube.Kubectl, concurrency int) kube.Kubectl {
+	if concurrency == 0 {
+		return kubectl
+	}
+	return &applyConcurrencyKubectl{Kubectl: kubectl, applies: make(chan struct{}, concurrency)}
+}
+
+func (k *applyConcurrencyKubectl) ManageResources(config *rest.Config, openAPISchema openapi.Resources) (kube.ResourceOperations, func(), error) {
+	ops, cleanup, err := k.Kubectl.ManageResources(config, openAPISchema)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &applyConcurrencyResourceOperations{ResourceOperations: ops, applies: k.applies}, cleanup, nil
+}
+
+type applyConcurrencyResourceOperations struct {
+	kube.ResourceOperations
+	applies chan struct{}
+}
+
+// acquire waits for a free apply slot, and returns the function releasing it
+func (o *applyConcurrencyResourceOperations) acquire(ctx context.Context) (func(), error) {
+	select {
+	case o.applies <- struct{}{}:
+		return func() { <-o.applies }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (o *applyConcurrencyResourceOperations) ApplyResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, force bool, validate bool, serverSideApply bool, manager string) (string, error) {
+	release, err := o.acquire(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	return o.ResourceOperations.ApplyResource(ctx, obj, dryRunStrategy, force, validate, serverSideApply, manager)
+}
+
+func (o *applyConcurrencyResourceOperations) ReplaceResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, force bool) (string, error) {
+	release, err := o.acquire(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	return o.ResourceOperations.ReplaceResource(ctx, obj, dryRunStrategy, force)
+}
+
+func (o *applyConcurrencyResourceOperations) CreateResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, validate bool) (string, error) {
+	release, err := o.acquire(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	return o.ResourceOperations.CreateResource(ctx, obj, dryRunStrategy, validate)
+}
+
+func (o *applyConcurrencyResourceOperations) UpdateResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy) (*unstructured.Unstructured, error) {
+	release, err := o.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return o.ResourceOperations.UpdateResource(ctx, obj, dryRunStrategy)
+}
@@ -0,0 +1,80 @@
+package controller
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+// concurrentApplyResourceOps records the maximum number of concurrent applies
+type concurrentApplyResourceOps struct {
+	kube.ResourceOperations
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+}
+
+func (o *concurrentApplyResourceOps) ApplyResource(_ context.Context, _ *unstructured.Unstructured, _ cmdutil.DryRunStrategy, _ bool, _ bool, _ bool, _ string) (string, error) {
+	inFlight := o.inFlight.Add(1)
+	defer o.inFlight.Add(-1)
+	for {
+		maxInFlight := o.maxInFlight.Load()
+		if inFlight <= maxInFlight || o.maxInFlight.CompareAndSwap(maxInFlight, inFlight) {
+			break
+		}
+	}
+	time.Sleep(10 * time.Millisecond)
+	return "applied", nil
+}
+
+func TestGetApplyConcurrency(t *testing.T) {
+	concurrency, err := getApplyConcurrency(v1alpha1.SyncOptions{"Prune=true", "ApplyConcurrency=5"})
+	require.NoError(t, err)
+	assert.Equal(t, 5, concurrency)
+
+	concurrency, err = getApplyConcurrency(v1alpha1.SyncOptions{"Prune=true"})
+	require.NoError(t, err)
+	assert.Equal(t, 0, concurrency)
+
+	_, err = getApplyConcurrency(v1alpha1.SyncOptions{"ApplyConcurrency=0"})
+	require.ErrorContains(t, err, "invalid sync option ApplyConcurrency=0")
+
+	_, err = getApplyConcurrency(v1alpha1.SyncOptions{"ApplyConcurrency=many"})
+	require.ErrorContains(t, err, "must be a positive integer")
+}
+
+func TestApplyConcurrencyResourceOperations_ApplyResource(t *testing.T) {
+	resourceOps := &concurrentApplyResourceOps{}
+	ops := &applyConcurrencyResourceOperations{ResourceOperations: resourceOps, applies: make(chan struct{}, 2)}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := ops.ApplyResource(t.Context(), test.NewConfigMap(), cmdutil.DryRunNone, false, false, false, "argocd-controller")
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(2), resourceOps.maxInFlight.Load())
+}
+
+func TestApplyConcurrencyResourceOperations_Canceled(t *testing.T) {
+	ops := &applyConcurrencyResourceOperations{ResourceOperations: &concurrentApplyResourceOps{}, applies: make(chan struct{}, 1)}
+	ops.applies <- struct{}{}
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	_, err := ops.ApplyResource(ctx, test.NewConfigMap(), cmdutil.DryRunNone, false, false, false, "argocd-controller")
+	require.ErrorIs(t, err, context.Canceled)
+}
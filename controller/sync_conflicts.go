@@ -0,0 +1,206 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	resourceutil "github.com/argoproj/gitops-engine/pkg/sync/resource"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/openapi"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// syncOptionServerSideApplyConflictsFail is the sync option failing the server-side apply of the resources on field
+// ownership conflicts with other field managers, instead of taking the conflicting fields over
+const syncOptionServerSideApplyConflictsFail = "ServerSideApplyConflicts=Fail"
+
+// fieldManagerConflictRegexp matches the field manager in the messages of the field manager conflict causes, e.g.
+// conflict with "kubectl-edit" using apps/v1
+var fieldManagerConflictRegexp = regexp.MustCompile(`conflict with "([^"]*)"`)
+
+// ssaConflictChecker checks the field ownership conflicts of the server-side applies of a sync operation, and records
+// them so that they are reported in the result of the sync operation
+type ssaConflictChecker struct {
+	failOnConflicts   bool
+	forceConflictsFor []string
+
+	lock      sync.Mutex
+	conflicts map[kube.ResourceKey][]v1alpha1.ServerSideApplyConflict
+}
+
+func newSSAConflictChecker(syncOp *v1alpha1.SyncOperation) *ssaConflictChecker {
+	return &ssaConflictChecker{
+		failOnConflicts:   syncOp.SyncOptions.HasOption(syncOptionServerSideApplyConflictsFail),
+		forceConflictsFor: syncOp.ForceConflictsFor,
+		conflicts:         map[kube.ResourceKey][]v1alpha1.ServerSideApplyConflict{},
+	}
+}
+
+// isEnabled returns whether the conflicts of the server-side apply of the resource are checked. The conflicts are
+// taken over without being checked unless the sync option is set or the field managers to take over are specified.
+func (c *ssaConflictChecker) isEnabled(obj *unstructured.Unstructured) bool {
+	return c.failOnConflicts || len(c.forceConflictsFor) > 0 ||
+		resourceutil.HasAnnotationOption(obj, synccommon.AnnotationSyncOptions, syncOptionServerSideApplyConflictsFail)
+}
+
+func (c *ssaConflictChecker) setConflicts(key kube.ResourceKey, conflicts []v1alpha1.ServerSideApplyConflict) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.conflicts[key] = conflicts
+}
+
+// getConflicts returns the conflicts hit by the server-side apply of the resource, if any
+func (c *ssaConflictChecker) getConflicts(key kube.ResourceKey) []v1alpha1.ServerSideApplyConflict {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.conflicts[key]
+}
+
+// toConflicts returns the field ownership conflicts of a server-side apply error, grouped by field manager. The
+// conflicts with the field managers listed in the forced managers are flagged as forced.
+func toConflicts(err error, forceConflictsFor []string) []v1alpha1.ServerSideApplyConflict {
+	var status apierrors.APIStatus
+	if !apierrors.IsConflict(err) || !errors.As(err, &status) || status.Status().Details == nil {
+		return nil
+	}
+	fieldsByManager := map[string][]string{}
+	for _, cause := range status.Status().Details.Causes {
+		if cause.Type != metav1.CauseTypeFieldManagerConflict {
+			continue
+		}
+		manager := ""
+		if match := fieldManagerConflictRegexp.FindStringSubmatch(cause.Message); match != nil {
+			manager = match[1]
+		}
+		fieldsByManager[manager] = append(fieldsByManager[manager], cause.Field)
+	}
+	conflicts := make([]v1alpha1.ServerSideApplyConflict, 0, len(fieldsByManager))
+	for manager, fields := range fieldsByManager {
+		sort.Strings(fields)
+		conflicts = append(conflicts, v1alpha1.ServerSideApplyConflict{
+			Manager: manager,
+			Fields:  fields,
+			Forced:  slices.Contains(forceConflictsFor, manager),
+		})
+	}
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].Manager < conflicts[j].Manager
+	})
+	return conflicts
+}
+
+// conflictsError returns the error failing the server-side apply of a resource, nil if all the conflicts are forced
+func conflictsError(conflicts []v1alpha1.ServerSideApplyConflict) error {
+	var unforced []string
+	for _, conflict := range conflicts {
+		if !conflict.Forced {
+			unforced = append(unforced, fmt.Sprintf("%s (%s)", conflict.Manager, strings.Join(conflict.Fields, ", ")))
+		}
+	}
+	if len(unforced) == 0 {
+		return nil
+	}
+	return fmt.Errorf("server-side apply conflicts with the fields of other field managers: %s. Use --force-conflicts-for <manager> to take the fields over", strings.Join(unforced, "; "))
+}
+
+// ssaConflictsKubectl is the kubectl of a sync operation checking the field ownership conflicts of the server-side
+// applies before taking the conflicting fields over
+type ssaConflictsKubectl struct {
+	kube.Kubectl
+	checker *ssaConflictChecker
+}
+
+func (k *ssaConflictsKubectl) ManageResources(config *rest.Config, openAPISchema openapi.Resources) (kube.ResourceOperations, func(), error) {
+	ops, cleanup, err := k.Kubectl.ManageResources(config, openAPISchema)
+	if err != nil {
+		return nil, nil, err
+	}
+	dynamicClient, err := k.NewDynamicClient(config)
+	if err != nil {
+		cleanup()
+		return nil, nil, fmt.Errorf("failed to create dynamic client: %w", err)
+	}
+	disco, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		cleanup()
+		return nil, nil, fmt.Errorf("failed to create discovery client: %w", err)
+	}
+	return &ssaConflictsResourceOperations{
+		ResourceOperations: ops,
+		checker:            k.checker,
+		dynamicClient:      dynamicClient,
+		disco:              memory.NewMemCacheClient(disco),
+	}, cleanup, nil
+}
+
+type ssaConflictsResourceOperations struct {
+	kube.ResourceOperations
+	checker       *ssaConflictChecker
+	dynamicClient dynamic.Interface
+	disco         discovery.DiscoveryInterface
+}
+
+func (o *ssaConflictsResourceOperations) ApplyResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, force bool, validate bool, serverSideApply bool, manager string) (string, error) {
+	if serverSideApply && dryRunStrategy == cmdutil.DryRunNone && o.checker.isEnabled(obj) {
+		conflicts, err := o.dryRunApply(ctx, obj, manager)
+		if err != nil {
+			// the errors other than the conflicts are returned by the apply itself
+			log.Warnf("Failed to check the server-side apply conflicts of %s/%s: %v", obj.GetKind(), obj.GetName(), err)
+		} else if len(conflicts) > 0 {
+			o.checker.setConflicts(kube.GetResourceKey(obj), conflicts)
+			if err := conflictsError(conflicts); err != nil {
+				return "", err
+			}
+		}
+	}
+	return o.ResourceOperations.ApplyResource(ctx, obj, dryRunStrategy, force, validate, serverSideApply, manager)
+}
+
+// dryRunApply runs a server-side apply of the resource in dry run mode without taking the conflicting fields over, and
+// returns the conflicts hit by the apply
+func (o *ssaConflictsResourceOperations) dryRunApply(ctx context.Context, obj *unstructured.Unstructured, manager string) ([]v1alpha1.ServerSideApplyConflict, error) {
+	gvk := obj.GroupVersionKind()
+	apiResource, err := kube.ServerResourceForGroupVersionKind(o.disco, gvk, "patch")
+	if err != nil {
+		return nil, err
+	}
+	gvr := kube.ToGroupVersionResource(gvk.GroupVersion().String(), apiResource)
+	var client dynamic.ResourceInterface = o.dynamicClient.Resource(gvr)
+	if apiResource.Namespaced {
+		client = o.dynamicClient.Resource(gvr).Namespace(obj.GetNamespace())
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	_, err = client.Patch(ctx, obj.GetName(), types.ApplyPatchType, data, metav1.PatchOptions{
+		FieldManager: manager,
+		DryRun:       []string{metav1.DryRunAll},
+	})
+	if err == nil {
+		return nil, nil
+	}
+	if conflicts := toConflicts(err, o.checker.forceConflictsFor); len(conflicts) > 0 {
+		return conflicts, nil
+	}
+	return nil, err
+}
@@ -0,0 +1,130 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakedisco "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/dynamic/fake"
+	kubetesting "k8s.io/client-go/testing"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func newApplyConflictError() error {
+	return apierrors.NewApplyConflict([]metav1.StatusCause{
+		{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "kubectl-edit" using apps/v1`, Field: ".spec.replicas"},
+		{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "helm" using apps/v1`, Field: ".spec.template.spec.containers[name=\"nginx\"].image"},
+		{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "kubectl-edit" using apps/v1`, Field: ".metadata.labels.tier"},
+	}, "Apply failed with 3 conflicts")
+}
+
+func TestToConflicts(t *testing.T) {
+	conflicts := toConflicts(newApplyConflictError(), []string{"kubectl-edit"})
+	assert.Equal(t, []v1alpha1.ServerSideApplyConflict{
+		{Manager: "helm", Fields: []string{".spec.template.spec.containers[name=\"nginx\"].image"}},
+		{Manager: "kubectl-edit", Fields: []string{".metadata.labels.tier", ".spec.replicas"}, Forced: true},
+	}, conflicts)
+
+	assert.Nil(t, toConflicts(apierrors.NewBadRequest("invalid"), nil))
+}
+
+func TestConflictsError(t *testing.T) {
+	require.NoError(t, conflictsError([]v1alpha1.ServerSideApplyConflict{{Manager: "kubectl-edit", Fields: []string{".spec.replicas"}, Forced: true}}))
+
+	err := conflictsError([]v1alpha1.ServerSideApplyConflict{
+		{Manager: "helm", Fields: []string{".spec.replicas", ".metadata.labels.tier"}},
+		{Manager: "kubectl-edit", Fields: []string{".spec.paused"}, Forced: true},
+	})
+	require.EqualError(t, err, "server-side apply conflicts with the fields of other field managers: helm (.spec.replicas, .metadata.labels.tier). Use --force-conflicts-for <manager> to take the fields over")
+}
+
+func TestSSAConflictChecker_IsEnabled(t *testing.T) {
+	obj := test.NewConfigMap()
+	assert.False(t, newSSAConflictChecker(&v1alpha1.SyncOperation{}).isEnabled(obj))
+	assert.True(t, newSSAConflictChecker(&v1alpha1.SyncOperation{SyncOptions: v1alpha1.SyncOptions{syncOptionServerSideApplyConflictsFail}}).isEnabled(obj))
+	assert.True(t, newSSAConflictChecker(&v1alpha1.SyncOperation{ForceConflictsFor: []string{"kubectl-edit"}}).isEnabled(obj))
+
+	obj.SetAnnotations(map[string]string{"argocd.argoproj.io/sync-options": syncOptionServerSideApplyConflictsFail})
+	assert.True(t, newSSAConflictChecker(&v1alpha1.SyncOperation{}).isEnabled(obj))
+}
+
+func TestSSAConflictsResourceOperations_ApplyResource(t *testing.T) {
+	newResourceOps := func(forceConflictsFor []string, patchErr error) (*ssaConflictsResourceOperations, *kubetest.MockResourceOps) {
+		dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme())
+		dynamicClient.PrependReactor("patch", "configmaps", func(action kubetesting.Action) (bool, runtime.Object, error) {
+			patchAction := action.(kubetesting.PatchActionImpl)
+			assert.Equal(t, "default", patchAction.GetNamespace())
+			return true, nil, patchErr
+		})
+		disco := &fakedisco.FakeDiscovery{Fake: &kubetesting.Fake{Resources: []*metav1.APIResourceList{{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{{Name: "configmaps", Kind: "ConfigMap", Namespaced: true, Verbs: []string{"patch"}}},
+		}}}}
+		mockOps := &kubetest.MockResourceOps{}
+		return &ssaConflictsResourceOperations{
+			ResourceOperations: mockOps,
+			checker:            newSSAConflictChecker(&v1alpha1.SyncOperation{ForceConflictsFor: forceConflictsFor}),
+			dynamicClient:      dynamicClient,
+			disco:              disco,
+		}, mockOps
+	}
+	obj := test.NewConfigMap()
+	obj.SetNamespace("default")
+	key := kube.GetResourceKey(obj)
+
+	t.Run("NoConflicts", func(t *testing.T) {
+		ops, mockOps := newResourceOps([]string{"kubectl-edit"}, nil)
+		_, err := ops.ApplyResource(t.Context(), obj, cmdutil.DryRunNone, false, false, true, "argocd-controller")
+		require.NoError(t, err)
+		assert.Equal(t, "apply", mockOps.GetLastResourceCommand(key))
+		assert.Empty(t, ops.checker.getConflicts(key))
+	})
+
+	t.Run("ForcedConflicts", func(t *testing.T) {
+		ops, mockOps := newResourceOps([]string{"kubectl-edit", "helm"}, newApplyConflictError())
+		_, err := ops.ApplyResource(t.Context(), obj, cmdutil.DryRunNone, false, false, true, "argocd-controller")
+		require.NoError(t, err)
+		assert.Equal(t, "apply", mockOps.GetLastResourceCommand(key))
+		assert.Len(t, ops.checker.getConflicts(key), 2)
+	})
+
+	t.Run("UnforcedConflicts", func(t *testing.T) {
+		ops, mockOps := newResourceOps([]string{"kubectl-edit"}, newApplyConflictError())
+		_, err := ops.ApplyResource(t.Context(), obj, cmdutil.DryRunNone, false, false, true, "argocd-controller")
+		require.ErrorContains(t, err, "helm (.spec.template.spec.containers[name=\"nginx\"].image)")
+		assert.Empty(t, mockOps.GetLastResourceCommand(key))
+		assert.Len(t, ops.checker.getConflicts(key), 2)
+	})
+
+	t.Run("ClientSideApply", func(t *testing.T) {
+		ops, mockOps := newResourceOps([]string{"kubectl-edit"}, newApplyConflictError())
+		_, err := ops.ApplyResource(t.Context(), obj, cmdutil.DryRunNone, false, false, false, "argocd-controller")
+		require.NoError(t, err)
+		assert.Equal(t, "apply", mockOps.GetLastResourceCommand(key))
+		assert.Empty(t, ops.checker.getConflicts(key))
+	})
+}
+
+func TestSSAConflictsResourceOperations_UnknownResource(t *testing.T) {
+	ops := &ssaConflictsResourceOperations{
+		ResourceOperations: &kubetest.MockResourceOps{},
+		checker:            newSSAConflictChecker(&v1alpha1.SyncOperation{ForceConflictsFor: []string{"kubectl-edit"}}),
+		dynamicClient:      fake.NewSimpleDynamicClient(runtime.NewScheme()),
+		disco:              &fakedisco.FakeDiscovery{Fake: &kubetesting.Fake{}},
+	}
+	obj := test.NewConfigMap()
+	obj.SetGroupVersionKind(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Unknown"})
+	// the failures of the conflict check are reported by the apply itself
+	_, err := ops.ApplyResource(t.Context(), obj, cmdutil.DryRunNone, false, false, true, "argocd-controller")
+	require.NoError(t, err)
+}
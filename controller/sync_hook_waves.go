@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"slices"
+
+	"github.com/argoproj/gitops-engine/pkg/diff"
+	"github.com/argoproj/gitops-engine/pkg/sync"
+	"github.com/argoproj/gitops-engine/pkg/sync/hook"
+	"github.com/argoproj/gitops-engine/pkg/sync/syncwaves"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// syncOptionSkipInSyncWaveHooks is the sync option only running the hooks of the sync waves with out-of-sync resources
+const syncOptionSkipInSyncWaveHooks = "SkipInSyncWaveHooks=true"
+
+// getOutOfSyncWaves returns the sync waves with resources to apply, or to prune if the sync prunes, sorted in ascending
+// order
+func getOutOfSyncWaves(reconciliationResult sync.ReconciliationResult, diffResults *diff.DiffResultList, prune bool) []int64 {
+	var waves []int64
+	for i, target := range reconciliationResult.Target {
+		live := reconciliationResult.Live[i]
+		obj := target
+		switch {
+		case target == nil && live == nil:
+			continue
+		case target == nil:
+			if !prune {
+				continue
+			}
+			obj = live
+		case live != nil && diffResults != nil && i < len(diffResults.Diffs) && !diffResults.Diffs[i].Modified:
+			continue
+		}
+		if hook.IsHook(obj) {
+			continue
+		}
+		if wave := int64(syncwaves.Wave(obj)); !slices.Contains(waves, wave) {
+			waves = append(waves, wave)
+		}
+	}
+	slices.Sort(waves)
+	return waves
+}
+
+// filterOutOfSyncWaveHooks returns the hooks of the given sync waves
+func filterOutOfSyncWaveHooks(hooks []*unstructured.Unstructured, waves []int64) []*unstructured.Unstructured {
+	var filtered []*unstructured.Unstructured
+	for _, obj := range hooks {
+		if slices.Contains(waves, int64(syncwaves.Wave(obj))) {
+			filtered = append(filtered, obj)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,49 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/diff"
+	"github.com/argoproj/gitops-engine/pkg/sync"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func newSyncWaveConfigMap(name string, wave string) *unstructured.Unstructured {
+	obj := test.NewConfigMap()
+	obj.SetName(name)
+	obj.SetAnnotations(map[string]string{"argocd.argoproj.io/sync-wave": wave})
+	return obj
+}
+
+func TestGetOutOfSyncWaves(t *testing.T) {
+	inSync := newSyncWaveConfigMap("in-sync", "1")
+	modified := newSyncWaveConfigMap("modified", "2")
+	missing := newSyncWaveConfigMap("missing", "-1")
+	extra := newSyncWaveConfigMap("extra", "3")
+	reconciliationResult := sync.ReconciliationResult{
+		Target: []*unstructured.Unstructured{inSync, modified, missing, nil},
+		Live:   []*unstructured.Unstructured{inSync, modified, nil, extra},
+	}
+	diffResults := &diff.DiffResultList{Diffs: []diff.DiffResult{{Modified: false}, {Modified: true}, {Modified: true}, {Modified: true}}}
+
+	assert.Equal(t, []int64{-1, 2}, getOutOfSyncWaves(reconciliationResult, diffResults, false))
+	assert.Equal(t, []int64{-1, 2, 3}, getOutOfSyncWaves(reconciliationResult, diffResults, true))
+	assert.Empty(t, getOutOfSyncWaves(sync.ReconciliationResult{
+		Target: []*unstructured.Unstructured{inSync},
+		Live:   []*unstructured.Unstructured{inSync},
+	}, &diff.DiffResultList{Diffs: []diff.DiffResult{{Modified: false}}}, true))
+}
+
+func TestFilterOutOfSyncWaveHooks(t *testing.T) {
+	migration := newSyncWaveConfigMap("migration", "1")
+	migration.SetAnnotations(map[string]string{"argocd.argoproj.io/hook": "PreSync", "argocd.argoproj.io/sync-wave": "1"})
+	smokeTest := test.NewConfigMap()
+	smokeTest.SetName("smoke-test")
+	smokeTest.SetAnnotations(map[string]string{"argocd.argoproj.io/hook": "PostSync"})
+
+	assert.Equal(t, []*unstructured.Unstructured{smokeTest}, filterOutOfSyncWaveHooks([]*unstructured.Unstructured{migration, smokeTest}, []int64{0, 2}))
+	assert.Empty(t, filterOutOfSyncWaveHooks([]*unstructured.Unstructured{migration, smokeTest}, nil))
+}
@@ -0,0 +1,55 @@
+package controller
+
+import (
+	"context"
+	"strings"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+
+	cdcommon "github.com/argoproj/argo-cd/v3/common"
+)
+
+// getPrunePropagationPolicies returns the deletion propagation policies of the live resources overriding the prune
+// propagation policy of the sync
+func getPrunePropagationPolicies(liveObjs []*unstructured.Unstructured) map[kube.ResourceKey]metav1.DeletionPropagation {
+	policies := map[kube.ResourceKey]metav1.DeletionPropagation{}
+	for _, obj := range liveObjs {
+		if obj == nil {
+			continue
+		}
+		value, ok := obj.GetAnnotations()[cdcommon.AnnotationPrunePropagationPolicy]
+		if !ok {
+			continue
+		}
+		switch strings.ToLower(strings.TrimSpace(value)) {
+		case "orphan":
+			policies[kube.GetResourceKey(obj)] = metav1.DeletePropagationOrphan
+		case "background":
+			policies[kube.GetResourceKey(obj)] = metav1.DeletePropagationBackground
+		case "foreground":
+			policies[kube.GetResourceKey(obj)] = metav1.DeletePropagationForeground
+		default:
+			log.Warnf("Ignoring invalid %s annotation '%s' of %s/%s", cdcommon.AnnotationPrunePropagationPolicy, value, obj.GetKind(), obj.GetName())
+		}
+	}
+	return policies
+}
+
+// prunePropagationKubectl is the kubectl of a sync operation pruning the resources with their own deletion propagation
+// policy
+type prunePropagationKubectl struct {
+	kube.Kubectl
+	policies map[kube.ResourceKey]metav1.DeletionPropagation
+}
+
+func (k *prunePropagationKubectl) DeleteResource(ctx context.Context, config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, deleteOptions metav1.DeleteOptions) error {
+	if policy, ok := k.policies[kube.NewResourceKey(gvk.Group, gvk.Kind, namespace, name)]; ok {
+		deleteOptions.PropagationPolicy = &policy
+	}
+	return k.Kubectl.DeleteResource(ctx, config, gvk, name, namespace, deleteOptions)
+}
@@ -0,0 +1,60 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+type deleteOptionsKubectl struct {
+	kubetest.MockKubectlCmd
+	deleteOptions metav1.DeleteOptions
+}
+
+func (k *deleteOptionsKubectl) DeleteResource(_ context.Context, _ *rest.Config, _ schema.GroupVersionKind, _ string, _ string, deleteOptions metav1.DeleteOptions) error {
+	k.deleteOptions = deleteOptions
+	return nil
+}
+
+func TestGetPrunePropagationPolicies(t *testing.T) {
+	orphaned := test.NewConfigMap()
+	orphaned.SetName("orphaned")
+	orphaned.SetAnnotations(map[string]string{"argocd.argoproj.io/prune-propagation-policy": "Orphan"})
+	invalid := test.NewConfigMap()
+	invalid.SetName("invalid")
+	invalid.SetAnnotations(map[string]string{"argocd.argoproj.io/prune-propagation-policy": "cascade"})
+
+	policies := getPrunePropagationPolicies([]*unstructured.Unstructured{orphaned, nil, invalid, test.NewConfigMap()})
+	assert.Equal(t, map[kube.ResourceKey]metav1.DeletionPropagation{
+		kube.GetResourceKey(orphaned): metav1.DeletePropagationOrphan,
+	}, policies)
+}
+
+func TestPrunePropagationKubectl_DeleteResource(t *testing.T) {
+	obj := test.NewConfigMap()
+	obj.SetNamespace("default")
+	kubectl := &deleteOptionsKubectl{}
+	prunePropagation := &prunePropagationKubectl{
+		Kubectl:  kubectl,
+		policies: map[kube.ResourceKey]metav1.DeletionPropagation{kube.GetResourceKey(obj): metav1.DeletePropagationOrphan},
+	}
+	foreground := metav1.DeletePropagationForeground
+
+	err := prunePropagation.DeleteResource(t.Context(), nil, obj.GroupVersionKind(), obj.GetName(), "default", metav1.DeleteOptions{PropagationPolicy: &foreground})
+	require.NoError(t, err)
+	assert.Equal(t, metav1.DeletePropagationOrphan, *kubectl.deleteOptions.PropagationPolicy)
+
+	err = prunePropagation.DeleteResource(t.Context(), nil, obj.GroupVersionKind(), "other", "default", metav1.DeleteOptions{PropagationPolicy: &foreground})
+	require.NoError(t, err)
+	assert.Equal(t, metav1.DeletePropagationForeground, *kubectl.deleteOptions.PropagationPolicy)
+}
@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"slices"
+
+	"github.com/argoproj/gitops-engine/pkg/sync/common"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// resumedSyncResult returns the initial result of a new sync operation resuming the previous sync operation of the
+// application: the result of the previous sync operation restricted to its successfully completed tasks, which are
+// skipped by the new sync operation. Nil is returned if the sync operation does not resume the previous one, or if the
+// previous sync operation succeeded, is not completed or synced other revisions or sources.
+func resumedSyncResult(app *v1alpha1.Application, syncOp *v1alpha1.SyncOperation) *v1alpha1.SyncOperationResult {
+	if syncOp == nil || !syncOp.Resume || syncOp.DryRun || syncOp.Manifests != nil {
+		return nil
+	}
+	prevState := app.Status.OperationState
+	if prevState == nil || prevState.Operation.Sync == nil || prevState.SyncResult == nil || !prevState.Phase.Completed() || prevState.Phase.Successful() {
+		return nil
+	}
+	prevRes := prevState.SyncResult
+	if app.Spec.HasMultipleSources() {
+		sources := syncOp.Sources
+		if len(sources) == 0 {
+			sources = app.Spec.Sources
+		}
+		if !slices.Equal(prevRes.Revisions, syncOp.Revisions) || !prevRes.Sources.Equals(sources) {
+			return nil
+		}
+	} else {
+		source := app.Spec.GetSource()
+		if syncOp.Source != nil {
+			source = *syncOp.Source
+		}
+		if prevRes.Revision != syncOp.Revision || !prevRes.Source.Equals(&source) {
+			return nil
+		}
+	}
+
+	resumed := &v1alpha1.SyncOperationResult{
+		Revision:                 prevRes.Revision,
+		Revisions:                prevRes.Revisions,
+		Source:                   prevRes.Source,
+		Sources:                  prevRes.Sources,
+		ManagedNamespaceMetadata: prevRes.ManagedNamespaceMetadata,
+	}
+	for _, res := range prevRes.Resources {
+		if res.HookPhase.Successful() && res.Status != common.ResultCodeSyncFailed {
+			resumedRes := res.DeepCopy()
+			// the sync timeouts are counted from the apply by the new sync operation, not by the previous one
+			resumedRes.AppliedAt = nil
+			resumed.Resources = append(resumed.Resources, resumedRes)
+		}
+	}
+	if len(resumed.Resources) == 0 {
+		return nil
+	}
+	return resumed
+}
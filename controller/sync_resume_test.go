@@ -0,0 +1,69 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestResumedSyncResult(t *testing.T) {
+	const revision = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	newApp := func(phase common.OperationPhase) *v1alpha1.Application {
+		app := newFakeApp()
+		app.Status.OperationState.Phase = phase
+		app.Status.OperationState.SyncResult.Resources = v1alpha1.ResourceResults{
+			{Kind: "ConfigMap", Name: "wave-0", Status: common.ResultCodeSynced, HookPhase: common.OperationSucceeded, SyncPhase: common.SyncPhaseSync, AppliedAt: &metav1.Time{Time: time.Now().Add(-time.Hour)}},
+			{Kind: "Job", Name: "pre-sync", HookType: common.HookTypePreSync, HookPhase: common.OperationSucceeded, SyncPhase: common.SyncPhasePreSync},
+			{Kind: "Deployment", Name: "wave-1", Status: common.ResultCodeSynced, HookPhase: common.OperationRunning, SyncPhase: common.SyncPhaseSync},
+			{Kind: "Service", Name: "wave-1", Status: common.ResultCodeSyncFailed, HookPhase: common.OperationFailed, SyncPhase: common.SyncPhaseSync},
+		}
+		return app
+	}
+
+	t.Run("Resumed", func(t *testing.T) {
+		app := newApp(common.OperationFailed)
+		syncRes := resumedSyncResult(app, &v1alpha1.SyncOperation{Revision: revision, Resume: true})
+		require.NotNil(t, syncRes)
+		assert.Equal(t, revision, syncRes.Revision)
+		assert.Equal(t, app.Spec.GetSource(), syncRes.Source)
+		var names []string
+		for _, res := range syncRes.Resources {
+			names = append(names, res.Kind+"/"+res.Name)
+		}
+		assert.Equal(t, []string{"ConfigMap/wave-0", "Job/pre-sync"}, names)
+		// the sync timeouts are counted again from the new sync operation
+		assert.Nil(t, syncRes.Resources[0].AppliedAt)
+		assert.NotNil(t, app.Status.OperationState.SyncResult.Resources[0].AppliedAt)
+	})
+	t.Run("NotRequested", func(t *testing.T) {
+		assert.Nil(t, resumedSyncResult(newApp(common.OperationFailed), &v1alpha1.SyncOperation{Revision: revision}))
+	})
+	t.Run("PreviousSucceeded", func(t *testing.T) {
+		assert.Nil(t, resumedSyncResult(newApp(common.OperationSucceeded), &v1alpha1.SyncOperation{Revision: revision, Resume: true}))
+	})
+	t.Run("PreviousRunning", func(t *testing.T) {
+		assert.Nil(t, resumedSyncResult(newApp(common.OperationRunning), &v1alpha1.SyncOperation{Revision: revision, Resume: true}))
+	})
+	t.Run("OtherRevision", func(t *testing.T) {
+		assert.Nil(t, resumedSyncResult(newApp(common.OperationError), &v1alpha1.SyncOperation{Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", Resume: true}))
+	})
+	t.Run("OtherSource", func(t *testing.T) {
+		app := newApp(common.OperationFailed)
+		app.Spec.Source.Path = "other/path"
+		assert.Nil(t, resumedSyncResult(app, &v1alpha1.SyncOperation{Revision: revision, Resume: true}))
+	})
+	t.Run("DryRun", func(t *testing.T) {
+		assert.Nil(t, resumedSyncResult(newApp(common.OperationFailed), &v1alpha1.SyncOperation{Revision: revision, Resume: true, DryRun: true}))
+	})
+	t.Run("NothingCompleted", func(t *testing.T) {
+		app := newApp(common.OperationFailed)
+		app.Status.OperationState.SyncResult.Resources = app.Status.OperationState.SyncResult.Resources[2:]
+		assert.Nil(t, resumedSyncResult(app, &v1alpha1.SyncOperation{Revision: revision, Resume: true}))
+	})
+}
@@ -188,6 +188,68 @@ func TestSyncComparisonError(t *testing.T) {
 	assert.Equal(t, "abc123", opState.SyncResult.Revision)
 }
 
+func TestSyncSourceRestrictions(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	app.Status.History = nil
+	app.Spec.Source.TargetRevision = "main"
+
+	project := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: test.FakeArgoCDNamespace,
+			Name:      "default",
+		},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRestrictions: []v1alpha1.SourceRestriction{{TargetRevisions: []string{"release/*"}}},
+		},
+	}
+	data := fakeData{
+		apps: []runtime.Object{app, project},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data, nil)
+
+	opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{},
+	}}
+	ctrl.appStateManager.SyncAppState(app, opState)
+	assert.Equal(t, common.OperationFailed, opState.Phase)
+	assert.Equal(t, "target revision main of repo "+app.Spec.Source.RepoURL+" is not permitted in project 'default'", opState.Message)
+
+	// the sources and the revisions of the operations are checked as well
+	permittedSource := app.Spec.Source.DeepCopy()
+	permittedSource.TargetRevision = "release/1.0"
+	deniedSource := app.Spec.Source.DeepCopy()
+	commitSHA := "a4c7b2e9d1f0836e5b7c9a2d4f6e8b0c1d3e5f7a"
+	rollbackSource := app.Spec.Source.DeepCopy()
+	rollbackSource.TargetRevision = commitSHA
+	app.Spec.Source.TargetRevision = "release/1.0"
+	for _, tc := range []struct {
+		name          string
+		syncOp        v1alpha1.SyncOperation
+		expectedPhase common.OperationPhase
+	}{
+		{name: "revision", syncOp: v1alpha1.SyncOperation{Revision: "main"}, expectedPhase: common.OperationFailed},
+		{name: "source", syncOp: v1alpha1.SyncOperation{Source: deniedSource}, expectedPhase: common.OperationFailed},
+		{name: "permitted source", syncOp: v1alpha1.SyncOperation{Source: permittedSource}, expectedPhase: common.OperationSucceeded},
+		{name: "resolved revision", syncOp: v1alpha1.SyncOperation{Revision: commitSHA}, expectedPhase: common.OperationSucceeded},
+		{name: "rollback", syncOp: v1alpha1.SyncOperation{Revision: commitSHA, Source: rollbackSource}, expectedPhase: common.OperationSucceeded},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := newFakeController(&data, nil)
+			opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{Sync: &tc.syncOp}}
+			ctrl.appStateManager.SyncAppState(app, opState)
+			assert.Equal(t, tc.expectedPhase, opState.Phase, opState.Message)
+		})
+	}
+}
+
 func TestAppStateManager_SyncAppState(t *testing.T) {
 	type fixture struct {
 		project     *v1alpha1.AppProject
@@ -326,6 +388,54 @@ func TestSyncWindowDeniesSync(t *testing.T) {
 	})
 }
 
+func TestClusterSyncWindowDeniesSync(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	app.Status.History = nil
+	// the cluster deny window takes precedence over the allow window of the project
+	proj := defaultProj.DeepCopy()
+	proj.Spec.SyncWindows = v1alpha1.SyncWindows{{Kind: "allow", Schedule: "0 0 * * *", Duration: "24h", Applications: []string{"*"}}}
+	ctrl := newFakeController(&fakeData{
+		apps:              []runtime.Object{app, proj},
+		clusterSecretData: map[string][]byte{"syncWindows": []byte(`[{"kind":"deny","schedule":"0 0 * * *","duration":"24h"}]`)},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}, nil)
+	opState := &v1alpha1.OperationState{
+		Operation: v1alpha1.Operation{
+			Sync: &v1alpha1.SyncOperation{
+				Source: &v1alpha1.ApplicationSource{},
+			},
+		},
+		Phase: common.OperationRunning,
+	}
+
+	ctrl.appStateManager.SyncAppState(app, opState)
+
+	assert.Equal(t, common.OperationRunning, opState.Phase)
+	assert.Contains(t, opState.Message, "Sync operation blocked by sync window")
+}
+
+func TestDeniedResourceSyncOption(t *testing.T) {
+	proj := &v1alpha1.AppProject{Spec: v1alpha1.AppProjectSpec{SyncPolicy: &v1alpha1.ProjectSyncPolicy{
+		DeniedSyncOptions: []string{"Replace=true"},
+	}}}
+	obj := &unstructured.Unstructured{}
+	obj.SetAnnotations(map[string]string{common.AnnotationSyncOptions: "Prune=false, Replace=true"})
+
+	assert.Equal(t, "Replace=true", deniedResourceSyncOption(proj, obj))
+	assert.Empty(t, deniedResourceSyncOption(proj, nil))
+	assert.Empty(t, deniedResourceSyncOption(&v1alpha1.AppProject{}, obj))
+
+	obj.SetAnnotations(map[string]string{common.AnnotationSyncOptions: "Prune=false"})
+	assert.Empty(t, deniedResourceSyncOption(proj, obj))
+}
+
 func TestNormalizeTargetResources(t *testing.T) {
 	type fixture struct {
 		comparisonResult *comparisonResult
@@ -0,0 +1,163 @@
+package controller
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/sync/hook"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	cdcommon "github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+// resourceSyncTimeout is the time the sync waits for a resource to become healthy once applied, and the policy applied
+// when the resource is not healthy at the end of the timeout
+type resourceSyncTimeout struct {
+	timeout time.Duration
+	policy  string
+}
+
+// getResourceSyncTimeout returns the sync timeout of the resource from its annotations, or else from the sync timeouts
+// of the resource kinds. Nil is returned if the resource has no sync timeout.
+func getResourceSyncTimeout(obj *unstructured.Unstructured, kindTimeouts []settings.ResourceSyncTimeout) *resourceSyncTimeout {
+	if obj == nil {
+		return nil
+	}
+	if value, ok := obj.GetAnnotations()[cdcommon.AnnotationSyncTimeout]; ok {
+		timeout, err := time.ParseDuration(value)
+		if err != nil {
+			log.Warnf("Ignoring invalid %s annotation '%s' of %s/%s: %v", cdcommon.AnnotationSyncTimeout, value, obj.GetKind(), obj.GetName(), err)
+			return nil
+		}
+		return &resourceSyncTimeout{timeout: timeout, policy: obj.GetAnnotations()[cdcommon.AnnotationSyncTimeoutPolicy]}
+	}
+	gvk := obj.GroupVersionKind()
+	for _, kindTimeout := range kindTimeouts {
+		if kindTimeout.Group != gvk.Group || kindTimeout.Kind != gvk.Kind {
+			continue
+		}
+		// the timeouts of the resource kinds are validated when loaded from the settings
+		timeout, _ := time.ParseDuration(kindTimeout.Timeout)
+		policy := kindTimeout.Policy
+		if value, ok := obj.GetAnnotations()[cdcommon.AnnotationSyncTimeoutPolicy]; ok {
+			policy = value
+		}
+		return &resourceSyncTimeout{timeout: timeout, policy: policy}
+	}
+	return nil
+}
+
+// validateResourceSyncTimeout returns an error if the sync timeout annotations of the resource are invalid, so that a
+// mistyped timeout or policy fails the sync rather than being ignored
+func validateResourceSyncTimeout(obj *unstructured.Unstructured) error {
+	annotations := obj.GetAnnotations()
+	if value, ok := annotations[cdcommon.AnnotationSyncTimeout]; ok {
+		if _, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("invalid %s annotation %q: %w", cdcommon.AnnotationSyncTimeout, value, err)
+		}
+	}
+	if value, ok := annotations[cdcommon.AnnotationSyncTimeoutPolicy]; ok && value != cdcommon.SyncTimeoutPolicyFail && value != cdcommon.SyncTimeoutPolicySkip {
+		return fmt.Errorf("invalid %s annotation %q: must be %s or %s", cdcommon.AnnotationSyncTimeoutPolicy, value, cdcommon.SyncTimeoutPolicyFail, cdcommon.SyncTimeoutPolicySkip)
+	}
+	return nil
+}
+
+// getResourceAppliedAt returns the time at which the resource was applied by the sync operation, recorded for the
+// resources with a sync timeout which are not hooks. The sync timeouts are counted from the first sync operation run
+// which applied the resources.
+func getResourceAppliedAt(res synccommon.ResourceSyncResult, appliedAt map[kube.ResourceKey]time.Time, target *unstructured.Unstructured, kindTimeouts []settings.ResourceSyncTimeout, dryRun bool, now metav1.Time) *metav1.Time {
+	if t, ok := appliedAt[res.ResourceKey]; ok {
+		return &metav1.Time{Time: t}
+	}
+	if dryRun || res.HookType != "" || res.Status != synccommon.ResultCodeSynced || getResourceSyncTimeout(target, kindTimeouts) == nil {
+		return nil
+	}
+	return &now
+}
+
+// syncTimeoutHealthOverride is the health override of a sync operation applying the sync timeout policies of the
+// resources which are not healthy at the end of their sync timeout
+type syncTimeoutHealthOverride struct {
+	health.HealthOverride
+	kindTimeouts []settings.ResourceSyncTimeout
+	// appliedAt holds the time at which the resources were applied by the sync operation
+	appliedAt map[kube.ResourceKey]time.Time
+
+	lock sync.Mutex
+	// timedOut holds the kinds of the resources which failed the sync since they were not healthy at the end of their
+	// sync timeout
+	timedOut map[schema.GroupKind]bool
+}
+
+func (o *syncTimeoutHealthOverride) GetResourceHealth(obj *unstructured.Unstructured) (*health.HealthStatus, error) {
+	healthStatus, err := health.GetResourceHealth(obj, o.HealthOverride)
+	if err != nil || healthStatus == nil || healthStatus.Status == health.HealthStatusHealthy || healthStatus.Status == health.HealthStatusDegraded {
+		return healthStatus, err
+	}
+	syncTimeout := getResourceSyncTimeout(obj, o.kindTimeouts)
+	if syncTimeout == nil {
+		return healthStatus, nil
+	}
+	// the hooks are created by the sync operation
+	appliedAt := obj.GetCreationTimestamp().Time
+	if !hook.IsHook(obj) {
+		var ok bool
+		if appliedAt, ok = o.appliedAt[kube.GetResourceKey(obj)]; !ok {
+			return healthStatus, nil
+		}
+	}
+	if time.Since(appliedAt) < syncTimeout.timeout {
+		return healthStatus, nil
+	}
+	if syncTimeout.policy == cdcommon.SyncTimeoutPolicySkip {
+		return &health.HealthStatus{
+			Status:  health.HealthStatusHealthy,
+			Message: syncTimeoutMessage(fmt.Sprintf("skipped after the sync timeout of %s", syncTimeout.timeout), healthStatus),
+		}, nil
+	}
+	o.lock.Lock()
+	if o.timedOut == nil {
+		o.timedOut = map[schema.GroupKind]bool{}
+	}
+	o.timedOut[obj.GroupVersionKind().GroupKind()] = true
+	o.lock.Unlock()
+	return &health.HealthStatus{
+		Status:  health.HealthStatusDegraded,
+		Message: syncTimeoutMessage(fmt.Sprintf("not healthy within the sync timeout of %s", syncTimeout.timeout), healthStatus),
+	}, nil
+}
+
+// retryStrategy returns the retry strategy of the kinds of the resources which failed the sync since they were not
+// healthy at the end of their sync timeout, the one allowing the most retries if there are several. Nil is returned if
+// none of these kinds has a retry strategy.
+func (o *syncTimeoutHealthOverride) retryStrategy() *v1alpha1.RetryStrategy {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	var retry *v1alpha1.RetryStrategy
+	for _, kindTimeout := range o.kindTimeouts {
+		if kindTimeout.Retry == nil || !o.timedOut[schema.GroupKind{Group: kindTimeout.Group, Kind: kindTimeout.Kind}] {
+			continue
+		}
+		// a negative limit allows unlimited retries
+		if retry == nil || (retry.Limit >= 0 && (kindTimeout.Retry.Limit < 0 || kindTimeout.Retry.Limit > retry.Limit)) {
+			retry = kindTimeout.Retry
+		}
+	}
+	return retry
+}
+
+func syncTimeoutMessage(message string, healthStatus *health.HealthStatus) string {
+	if healthStatus.Message == "" {
+		return message
+	}
+	return fmt.Sprintf("%s: %s", message, healthStatus.Message)
+}
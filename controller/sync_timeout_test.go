@@ -0,0 +1,147 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	testingutils "github.com/argoproj/gitops-engine/pkg/utils/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	cdcommon "github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+func newProgressingPod(annotations map[string]string) *unstructured.Unstructured {
+	pod := testingutils.NewPod()
+	pod.SetAnnotations(annotations)
+	_ = unstructured.SetNestedField(pod.Object, "Pending", "status", "phase")
+	return pod
+}
+
+func TestGetResourceSyncTimeout(t *testing.T) {
+	kindTimeouts := []settings.ResourceSyncTimeout{{Kind: "Pod", Timeout: "10m", Policy: cdcommon.SyncTimeoutPolicySkip}}
+
+	assert.Nil(t, getResourceSyncTimeout(nil, kindTimeouts))
+	assert.Nil(t, getResourceSyncTimeout(newProgressingPod(nil), nil))
+	assert.Nil(t, getResourceSyncTimeout(newProgressingPod(map[string]string{cdcommon.AnnotationSyncTimeout: "invalid"}), nil))
+	assert.Nil(t, getResourceSyncTimeout(newProgressingPod(nil), []settings.ResourceSyncTimeout{{Group: "apps", Kind: "Pod", Timeout: "10m"}}))
+
+	assert.Equal(t, &resourceSyncTimeout{timeout: 10 * time.Minute, policy: cdcommon.SyncTimeoutPolicySkip}, getResourceSyncTimeout(newProgressingPod(nil), kindTimeouts))
+	assert.Equal(t, &resourceSyncTimeout{timeout: 10 * time.Minute, policy: cdcommon.SyncTimeoutPolicyFail},
+		getResourceSyncTimeout(newProgressingPod(map[string]string{cdcommon.AnnotationSyncTimeoutPolicy: cdcommon.SyncTimeoutPolicyFail}), kindTimeouts))
+	assert.Equal(t, &resourceSyncTimeout{timeout: 5 * time.Minute},
+		getResourceSyncTimeout(newProgressingPod(map[string]string{cdcommon.AnnotationSyncTimeout: "5m"}), kindTimeouts))
+}
+
+func TestSyncTimeoutHealthOverride(t *testing.T) {
+	newOverride := func(obj *unstructured.Unstructured, appliedAt time.Time) *syncTimeoutHealthOverride {
+		return &syncTimeoutHealthOverride{appliedAt: map[kube.ResourceKey]time.Time{kube.GetResourceKey(obj): appliedAt}}
+	}
+
+	t.Run("NoTimeout", func(t *testing.T) {
+		pod := newProgressingPod(nil)
+		healthStatus, err := newOverride(pod, time.Now().Add(-time.Hour)).GetResourceHealth(pod)
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusProgressing, healthStatus.Status)
+	})
+
+	t.Run("WithinTimeout", func(t *testing.T) {
+		pod := newProgressingPod(map[string]string{cdcommon.AnnotationSyncTimeout: "5m"})
+		healthStatus, err := newOverride(pod, time.Now().Add(-time.Minute)).GetResourceHealth(pod)
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusProgressing, healthStatus.Status)
+	})
+
+	t.Run("NotApplied", func(t *testing.T) {
+		pod := newProgressingPod(map[string]string{cdcommon.AnnotationSyncTimeout: "5m"})
+		healthStatus, err := (&syncTimeoutHealthOverride{}).GetResourceHealth(pod)
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusProgressing, healthStatus.Status)
+	})
+
+	t.Run("Fail", func(t *testing.T) {
+		pod := newProgressingPod(map[string]string{cdcommon.AnnotationSyncTimeout: "5m"})
+		healthStatus, err := newOverride(pod, time.Now().Add(-time.Hour)).GetResourceHealth(pod)
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusDegraded, healthStatus.Status)
+		assert.Equal(t, "not healthy within the sync timeout of 5m0s", healthStatus.Message)
+	})
+
+	t.Run("Skip", func(t *testing.T) {
+		pod := newProgressingPod(map[string]string{cdcommon.AnnotationSyncTimeout: "5m", cdcommon.AnnotationSyncTimeoutPolicy: cdcommon.SyncTimeoutPolicySkip})
+		healthStatus, err := newOverride(pod, time.Now().Add(-time.Hour)).GetResourceHealth(pod)
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusHealthy, healthStatus.Status)
+		assert.Equal(t, "skipped after the sync timeout of 5m0s", healthStatus.Message)
+	})
+
+	t.Run("Hook", func(t *testing.T) {
+		pod := newProgressingPod(map[string]string{cdcommon.AnnotationSyncTimeout: "5m", synccommon.AnnotationKeyHook: "PreSync"})
+		pod.SetCreationTimestamp(metav1.NewTime(time.Now().Add(-time.Hour)))
+		healthStatus, err := (&syncTimeoutHealthOverride{}).GetResourceHealth(pod)
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusDegraded, healthStatus.Status)
+	})
+
+	t.Run("Healthy", func(t *testing.T) {
+		pod := newProgressingPod(map[string]string{cdcommon.AnnotationSyncTimeout: "5m"})
+		_ = unstructured.SetNestedField(pod.Object, "Succeeded", "status", "phase")
+		healthStatus, err := newOverride(pod, time.Now().Add(-time.Hour)).GetResourceHealth(pod)
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusHealthy, healthStatus.Status)
+		assert.Empty(t, healthStatus.Message)
+	})
+}
+
+func TestGetResourceAppliedAt(t *testing.T) {
+	pod := testingutils.NewPod()
+	pod.SetAnnotations(map[string]string{cdcommon.AnnotationSyncTimeout: "5m"})
+	key := kube.GetResourceKey(pod)
+	res := synccommon.ResourceSyncResult{ResourceKey: key, Status: synccommon.ResultCodeSynced}
+	now := metav1.Now()
+
+	assert.Equal(t, &now, getResourceAppliedAt(res, nil, pod, nil, false, now))
+	assert.Nil(t, getResourceAppliedAt(res, nil, pod, nil, true, now))
+	assert.Nil(t, getResourceAppliedAt(res, nil, testingutils.NewPod(), nil, false, now))
+	assert.Nil(t, getResourceAppliedAt(synccommon.ResourceSyncResult{ResourceKey: key, Status: synccommon.ResultCodeSyncFailed}, nil, pod, nil, false, now))
+	assert.Nil(t, getResourceAppliedAt(synccommon.ResourceSyncResult{ResourceKey: key, Status: synccommon.ResultCodeSynced, HookType: synccommon.HookTypePreSync}, nil, pod, nil, false, now))
+
+	// the time of the first apply is kept by the next runs of the sync operation
+	appliedAt := now.Add(-time.Minute)
+	assert.Equal(t, appliedAt, getResourceAppliedAt(res, map[kube.ResourceKey]time.Time{key: appliedAt}, pod, nil, false, now).Time)
+}
+
+func TestValidateResourceSyncTimeout(t *testing.T) {
+	require.NoError(t, validateResourceSyncTimeout(newProgressingPod(nil)))
+	require.NoError(t, validateResourceSyncTimeout(newProgressingPod(map[string]string{cdcommon.AnnotationSyncTimeout: "5m", cdcommon.AnnotationSyncTimeoutPolicy: cdcommon.SyncTimeoutPolicySkip})))
+	require.ErrorContains(t, validateResourceSyncTimeout(newProgressingPod(map[string]string{cdcommon.AnnotationSyncTimeout: "5"})), `invalid argocd.argoproj.io/sync-timeout annotation "5"`)
+	require.EqualError(t, validateResourceSyncTimeout(newProgressingPod(map[string]string{cdcommon.AnnotationSyncTimeoutPolicy: "skip"})), `invalid argocd.argoproj.io/sync-timeout-policy annotation "skip": must be Fail or Skip`)
+}
+
+func TestSyncTimeoutHealthOverrideRetryStrategy(t *testing.T) {
+	pod := newProgressingPod(nil)
+	override := &syncTimeoutHealthOverride{
+		kindTimeouts: []settings.ResourceSyncTimeout{
+			{Kind: "Pod", Timeout: "5m", Retry: &v1alpha1.RetryStrategy{Limit: 2}},
+			{Group: "batch", Kind: "Job", Timeout: "5m", Retry: &v1alpha1.RetryStrategy{Limit: 5}},
+		},
+		appliedAt: map[kube.ResourceKey]time.Time{kube.GetResourceKey(pod): time.Now().Add(-time.Hour)},
+	}
+	assert.Nil(t, override.retryStrategy())
+
+	healthStatus, err := override.GetResourceHealth(pod)
+	require.NoError(t, err)
+	assert.Equal(t, health.HealthStatusDegraded, healthStatus.Status)
+	// only the kinds of the resources which timed out are retried
+	assert.Equal(t, &v1alpha1.RetryStrategy{Limit: 2}, override.retryStrategy())
+
+	override.kindTimeouts = append(override.kindTimeouts, settings.ResourceSyncTimeout{Kind: "Pod", Timeout: "5m", Retry: &v1alpha1.RetryStrategy{Limit: -1}})
+	assert.Equal(t, &v1alpha1.RetryStrategy{Limit: -1}, override.retryStrategy())
+}
@@ -0,0 +1,95 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/sync/hook"
+	"github.com/argoproj/gitops-engine/pkg/sync/syncwaves"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	cdcommon "github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// isInSyncWave returns whether the target object is a resource, not a hook, of the given phase and wave
+func isInSyncWave(target *unstructured.Unstructured, phase common.SyncPhase, wave int) bool {
+	return target != nil && phase == common.SyncPhaseSync && !hook.IsHook(target) && syncwaves.Wave(target) == wave
+}
+
+// syncWavePause returns the longest pause requested by the resources of a sync wave with the
+// argocd.argoproj.io/sync-wave-pause annotation, zero if no pause is requested
+func syncWavePause(targets []*unstructured.Unstructured, phase common.SyncPhase, wave int) time.Duration {
+	var pause time.Duration
+	for _, target := range targets {
+		if !isInSyncWave(target, phase, wave) {
+			continue
+		}
+		value, ok := target.GetAnnotations()[cdcommon.AnnotationSyncWavePause]
+		if !ok {
+			continue
+		}
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			log.Warnf("Ignoring invalid %s annotation '%s' of %s/%s: %v", cdcommon.AnnotationSyncWavePause, value, target.GetKind(), target.GetName(), err)
+			continue
+		}
+		if duration > pause {
+			pause = duration
+		}
+	}
+	return pause
+}
+
+// newSyncWaveHook returns a SyncWaveHook which records in the sync result the pause requested by the resources of the
+// applied wave, before delaying the next wave
+func newSyncWaveHook(targets []*unstructured.Unstructured, syncRes *v1alpha1.SyncOperationResult, dryRun bool) common.SyncWaveHook {
+	return func(phase common.SyncPhase, wave int, finalWave bool) error {
+		if !finalWave && !dryRun {
+			if pause := syncWavePause(targets, phase, wave); pause > 0 {
+				syncRes.WavePause = &v1alpha1.SyncWavePause{
+					Phase: phase,
+					Wave:  int64(wave),
+					Until: metav1.NewTime(time.Now().Add(pause)),
+				}
+			}
+		}
+		return delayBetweenSyncWaves(phase, wave, finalWave)
+	}
+}
+
+// degradedSyncWaveResources returns the resources of the paused sync wave which are degraded
+func degradedSyncWaveResources(resources []managedResource, pause *v1alpha1.SyncWavePause, healthOverride health.HealthOverride) []string {
+	var degraded []string
+	for _, res := range resources {
+		if res.Live == nil || !isInSyncWave(res.Target, pause.Phase, int(pause.Wave)) {
+			continue
+		}
+		healthStatus, err := health.GetResourceHealth(res.Live, healthOverride)
+		if err != nil || healthStatus == nil || healthStatus.Status != health.HealthStatusDegraded {
+			continue
+		}
+		resource := fmt.Sprintf("%s/%s", res.Kind, res.Name)
+		if healthStatus.Message != "" {
+			resource = fmt.Sprintf("%s: %s", resource, healthStatus.Message)
+		}
+		degraded = append(degraded, resource)
+	}
+	return degraded
+}
+
+// syncWavePauseMessage returns the message of a sync operation paused after a sync wave
+func syncWavePauseMessage(pause *v1alpha1.SyncWavePause) string {
+	return fmt.Sprintf("Sync paused after wave %d until %s", pause.Wave, pause.Until.UTC().Format(time.RFC3339))
+}
+
+// syncWaveDegradedMessage returns the message of a sync operation failed because resources of the paused sync wave
+// degraded
+func syncWaveDegradedMessage(pause *v1alpha1.SyncWavePause, degraded []string) string {
+	return fmt.Sprintf("one or more resources of sync wave %d degraded during the pause: %s", pause.Wave, strings.Join(degraded, ", "))
+}
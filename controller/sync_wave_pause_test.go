@@ -0,0 +1,132 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	synctesting "github.com/argoproj/gitops-engine/pkg/utils/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func newSyncWavePausePod(name, wave, pause string) *unstructured.Unstructured {
+	pod := synctesting.NewPod()
+	pod.SetName(name)
+	pod.SetNamespace(test.FakeDestNamespace)
+	annotations := map[string]string{"argocd.argoproj.io/sync-wave": wave}
+	if pause != "" {
+		annotations["argocd.argoproj.io/sync-wave-pause"] = pause
+	}
+	pod.SetAnnotations(annotations)
+	return pod
+}
+
+func TestSyncWavePause(t *testing.T) {
+	hookPod := newSyncWavePausePod("hook", "1", "")
+	hookPod.SetAnnotations(map[string]string{"argocd.argoproj.io/sync-wave": "1", "argocd.argoproj.io/hook": "PreSync", "argocd.argoproj.io/sync-wave-pause": "1h"})
+	targets := []*unstructured.Unstructured{
+		nil,
+		newSyncWavePausePod("canary", "1", "5m"),
+		newSyncWavePausePod("other", "1", "10m"),
+		newSyncWavePausePod("invalid", "1", "later"),
+		newSyncWavePausePod("no-pause", "2", ""),
+		hookPod,
+	}
+	assert.Equal(t, 10*time.Minute, syncWavePause(targets, common.SyncPhaseSync, 1))
+	assert.Zero(t, syncWavePause(targets, common.SyncPhaseSync, 2))
+	assert.Zero(t, syncWavePause(targets, common.SyncPhasePreSync, 1))
+}
+
+func TestNewSyncWaveHook(t *testing.T) {
+	t.Setenv(EnvVarSyncWaveDelay, "0")
+	targets := []*unstructured.Unstructured{newSyncWavePausePod("canary", "1", "5m")}
+
+	syncRes := &v1alpha1.SyncOperationResult{}
+	require.NoError(t, newSyncWaveHook(targets, syncRes, false)(common.SyncPhaseSync, 1, false))
+	require.NotNil(t, syncRes.WavePause)
+	assert.EqualValues(t, common.SyncPhaseSync, syncRes.WavePause.Phase)
+	assert.Equal(t, int64(1), syncRes.WavePause.Wave)
+	assert.WithinDuration(t, time.Now().Add(5*time.Minute), syncRes.WavePause.Until.Time, time.Minute)
+
+	// no pause after the final wave or during a dry-run
+	syncRes = &v1alpha1.SyncOperationResult{}
+	require.NoError(t, newSyncWaveHook(targets, syncRes, false)(common.SyncPhaseSync, 1, true))
+	require.NoError(t, newSyncWaveHook(targets, syncRes, true)(common.SyncPhaseSync, 1, false))
+	assert.Nil(t, syncRes.WavePause)
+}
+
+func TestSyncAppStateWavePause(t *testing.T) {
+	canary := newSyncWavePausePod("canary", "1", "5m")
+	newController := func(live *unstructured.Unstructured) *ApplicationController {
+		app := newFakeApp()
+		app.Status.OperationState = nil
+		app.Status.History = nil
+		return newFakeController(&fakeData{
+			apps: []runtime.Object{app, &defaultProj},
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: []string{toJSON(t, canary)},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+			managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+				kube.GetResourceKey(live): live,
+			},
+		}, nil)
+	}
+	newOpState := func(until time.Time) *v1alpha1.OperationState {
+		return &v1alpha1.OperationState{
+			Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Source: &v1alpha1.ApplicationSource{}}},
+			Phase:     common.OperationRunning,
+			SyncResult: &v1alpha1.SyncOperationResult{
+				Revision:  "abc123",
+				WavePause: &v1alpha1.SyncWavePause{Phase: common.SyncPhaseSync, Wave: 1, Until: metav1.NewTime(until)},
+			},
+		}
+	}
+
+	t.Run("Paused", func(t *testing.T) {
+		live := canary.DeepCopy()
+		live.Object["status"] = map[string]any{"phase": "Running"}
+		ctrl := newController(live)
+		opState := newOpState(time.Now().Add(time.Hour))
+
+		ctrl.appStateManager.SyncAppState(newFakeApp(), opState)
+
+		assert.Equal(t, common.OperationRunning, opState.Phase)
+		assert.Contains(t, opState.Message, "Sync paused after wave 1 until")
+		assert.NotNil(t, opState.SyncResult.WavePause)
+	})
+	t.Run("Degraded", func(t *testing.T) {
+		live := canary.DeepCopy()
+		live.Object["status"] = map[string]any{"phase": "Failed", "message": "crashed"}
+		ctrl := newController(live)
+		opState := newOpState(time.Now().Add(time.Hour))
+
+		ctrl.appStateManager.SyncAppState(newFakeApp(), opState)
+
+		assert.Equal(t, common.OperationFailed, opState.Phase)
+		assert.Equal(t, "one or more resources of sync wave 1 degraded during the pause: Pod/canary: crashed", opState.Message)
+		assert.Nil(t, opState.SyncResult.WavePause)
+	})
+	t.Run("Resumed", func(t *testing.T) {
+		live := canary.DeepCopy()
+		live.Object["status"] = map[string]any{"phase": "Running"}
+		ctrl := newController(live)
+		opState := newOpState(time.Now().Add(-time.Minute))
+
+		ctrl.appStateManager.SyncAppState(newFakeApp(), opState)
+
+		assert.NotContains(t, opState.Message, "Sync paused")
+		assert.Nil(t, opState.SyncResult.WavePause)
+	})
+}
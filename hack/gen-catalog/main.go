@@ -10,7 +10,6 @@ import (
 	"strings"
 
 	"github.com/argoproj/notifications-engine/pkg/services"
-	"github.com/argoproj/notifications-engine/pkg/triggers"
 	"github.com/argoproj/notifications-engine/pkg/util/misc"
 	"github.com/olekukonko/tablewriter"
 	log "github.com/sirupsen/logrus"
@@ -21,6 +20,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/admin"
+	"github.com/argoproj/argo-cd/v3/util/notification/settings"
 )
 
 func main() {
@@ -114,7 +114,7 @@ func newDocsCommand() *cobra.Command {
 	}
 }
 
-func generateBuiltInTriggersDocs(out io.Writer, triggers map[string][]triggers.Condition, templates map[string]services.Notification) {
+func generateBuiltInTriggersDocs(out io.Writer, triggers map[string][]settings.ResourceCondition, templates map[string]services.Notification) {
 	_, _ = fmt.Fprintln(out, "# Triggers and Templates Catalog")
 
 	_, _ = fmt.Fprintln(out, "## Getting Started")
@@ -189,7 +189,7 @@ func dieOnError(err error, msg string) {
 	}
 }
 
-func buildConfigFromFS(templatesDir string, triggersDir string) (map[string]services.Notification, map[string][]triggers.Condition, error) {
+func buildConfigFromFS(templatesDir string, triggersDir string) (map[string]services.Notification, map[string][]settings.ResourceCondition, error) {
 	templatesCfg := map[string]services.Notification{}
 	err := filepath.Walk(templatesDir, func(p string, info os.FileInfo, e error) error {
 		if e != nil {
@@ -214,7 +214,7 @@ func buildConfigFromFS(templatesDir string, triggersDir string) (map[string]serv
 		return nil, nil, err
 	}
 
-	triggersCfg := map[string][]triggers.Condition{}
+	triggersCfg := map[string][]settings.ResourceCondition{}
 	err = filepath.Walk(triggersDir, func(p string, info os.FileInfo, e error) error {
 		if e != nil {
 			return fmt.Errorf("error navigating the triggers dirctory: %s : %w", triggersDir, e)
@@ -227,7 +227,7 @@ func buildConfigFromFS(templatesDir string, triggersDir string) (map[string]serv
 			return fmt.Errorf("error reading the trigger file: %s : %w", p, err)
 		}
 		name := strings.Split(path.Base(p), ".")[0]
-		var trigger []triggers.Condition
+		var trigger []settings.ResourceCondition
 		if err := yaml.Unmarshal(data, &trigger); err != nil {
 			return fmt.Errorf("error unmarshaling the data from file: %s : %w", p, err)
 		}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
 	"sort"
 	"strings"
 
@@ -25,6 +26,15 @@ func generateNotificationsDocs() {
 	if err != nil {
 		log.Fatal(err)
 	}
+	// the docs of the notification services implemented in Argo CD rather than in the notifications engine
+	argocdFiles, err := filepath.Glob("docs/operator-manual/notifications/argocd-services/*.md")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := listServicesDocs("./docs/operator-manual/notifications/services/overview.md", argocdFiles); err != nil {
+		log.Fatal(err)
+	}
+	files = append(files, argocdFiles...)
 	if files != nil {
 		if e := updateMkDocsNav("Operator Manual", "Notifications", "Notification Services", files); e != nil {
 			log.Fatal(e)
@@ -32,6 +42,25 @@ func generateNotificationsDocs() {
 	}
 }
 
+// listServicesDocs appends the links to the docs of the notification services implemented in Argo CD to the list of
+// services of the overview copied from the notifications engine. The title of a service is the heading of its doc.
+func listServicesDocs(overviewPath string, files []string) error {
+	overview, err := os.ReadFile(overviewPath)
+	if err != nil {
+		return fmt.Errorf("error reading %s: %w", overviewPath, err)
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("error reading %s: %w", file, err)
+		}
+		heading, _, _ := strings.Cut(string(data), "\n")
+		title := strings.TrimSpace(strings.TrimPrefix(heading, "#"))
+		overview = fmt.Appendf(overview, "\n* [%s](../argocd-services/%s)", title, filepath.Base(file))
+	}
+	return os.WriteFile(overviewPath, overview, 0o644)
+}
+
 func updateMkDocsNav(parent string, child string, subchild string, files []string) error {
 	trimPrefixes(files, "docs/")
 	sort.Strings(files)
@@ -2,6 +2,9 @@
 # Wrapper script to perform GPG signature validation on git commit SHAs and
 # annotated tags.
 #
+# SSH signatures are verified without allowed signers, the keys are matched
+# against the fingerprints configured in the project instead.
+#
 # We capture stderr to stdout, so we can have the output in the logs. Also,
 # we ignore error codes that are emitted if signature verification failed.
 #
@@ -17,12 +20,12 @@ TYPE=
 if git describe --exact-match "${REVISION}" >/dev/null 2>&1; then
 	IFS=''
 	TYPE=tag
-	OUTPUT=$(git verify-tag "$REVISION" 2>&1)
+	OUTPUT=$(git -c gpg.ssh.allowedSignersFile=/dev/null verify-tag "$REVISION" 2>&1)
 	RET=$?
 else
 	IFS=''
 	TYPE=commit
-	OUTPUT=$(git verify-commit "$REVISION" 2>&1)
+	OUTPUT=$(git -c gpg.ssh.allowedSignersFile=/dev/null verify-commit "$REVISION" 2>&1)
 	RET=$?
 fi
 
@@ -0,0 +1,402 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/argoproj/notifications-engine/pkg/api"
+	"github.com/argoproj/notifications-engine/pkg/services"
+	"github.com/argoproj/notifications-engine/pkg/templates"
+	"github.com/argoproj/notifications-engine/pkg/triggers"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+	v1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/util/notification/delivery"
+	argocdservices "github.com/argoproj/argo-cd/v3/util/notification/services"
+	"github.com/argoproj/argo-cd/v3/util/notification/settings"
+)
+
+const (
+	serviceTypeVarName = "serviceType"
+	recipientVarName   = "recipient"
+)
+
+// apiFactory is an api.Factory adding to the APIs of the notifications engine the digests of the notifications, the
+// Argo CD notification services and the tracking and retries of the delivery of the notifications
+type apiFactory struct {
+	api.Factory
+	settings     api.Settings
+	cmLister     v1listers.ConfigMapLister
+	secretLister v1listers.SecretLister
+	digester     *digester
+	deliveries   *deliveryTracker
+
+	lock    sync.Mutex
+	configs map[string]*apiConfig
+}
+
+// apiConfig holds the notification settings of a namespace which are not handled by the notifications engine
+type apiConfig struct {
+	version          string
+	digests          map[string]digestConfig
+	services         *argocdservices.Config
+	triggerResources map[string]string
+	delivery         *deliveryConfig
+	getVars          api.GetVars
+}
+
+func newAPIFactory(settings api.Settings, namespace string, client dynamic.Interface, registerer prometheus.Registerer, secretInformer, configMapInformer cache.SharedIndexInformer) *apiFactory {
+	return &apiFactory{
+		Factory:      api.NewFactory(settings, namespace, secretInformer, configMapInformer),
+		settings:     settings,
+		cmLister:     v1listers.NewConfigMapLister(configMapInformer.GetIndexer()),
+		secretLister: v1listers.NewSecretLister(secretInformer.GetIndexer()),
+		digester:     newDigester(),
+		deliveries:   newDeliveryTracker(client, registerer),
+		configs:      map[string]*apiConfig{},
+	}
+}
+
+func (f *apiFactory) GetAPI() (api.API, error) {
+	notificationAPI, err := f.Factory.GetAPI()
+	if err != nil || notificationAPI == nil {
+		return notificationAPI, err
+	}
+	return f.wrap(notificationAPI), nil
+}
+
+func (f *apiFactory) GetAPIsFromNamespace(namespace string) (map[string]api.API, error) {
+	apis, err := f.Factory.GetAPIsFromNamespace(namespace)
+	for ns, notificationAPI := range apis {
+		apis[ns] = f.wrap(notificationAPI)
+	}
+	return apis, err
+}
+
+func (f *apiFactory) wrap(notificationAPI api.API) api.API {
+	namespace := notificationAPI.GetConfig().Namespace
+	config, err := f.getConfig(namespace)
+	if err != nil {
+		log.Warnf("Failed to get the notification settings in namespace %s: %v", namespace, err)
+		return notificationAPI
+	}
+	if config == nil || (len(config.digests) == 0 && len(config.services.Services) == 0 && config.delivery == nil) {
+		return notificationAPI
+	}
+	return &wrappedAPI{API: notificationAPI, factory: f, namespace: namespace, config: config}
+}
+
+// getConfig returns the notification settings of the namespace which are not handled by the notifications engine, or
+// nil if the namespace has no notifications ConfigMap
+func (f *apiFactory) getConfig(namespace string) (*apiConfig, error) {
+	cm, err := f.cmLister.ConfigMaps(namespace).Get(f.settings.ConfigMapName)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	secret, err := f.secretLister.Secrets(namespace).Get(f.settings.SecretName)
+	if apierrors.IsNotFound(err) {
+		secret = &corev1.Secret{}
+	} else if err != nil {
+		return nil, err
+	}
+	version := cm.ResourceVersion + "/" + secret.ResourceVersion
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if config, ok := f.configs[namespace]; ok && config.version == version {
+		return config, nil
+	}
+	digests, err := parseDigestConfigs(cm)
+	if err != nil {
+		return nil, err
+	}
+	servicesConfig, err := argocdservices.ParseConfig(cm, secret)
+	if err != nil {
+		return nil, err
+	}
+	triggerResources, err := settings.GetTriggerResources(cm)
+	if err != nil {
+		return nil, err
+	}
+	deliverySettings, err := parseDeliveryConfig(cm)
+	if err != nil {
+		return nil, err
+	}
+	config := &apiConfig{version: version, digests: digests, services: servicesConfig, triggerResources: triggerResources, delivery: deliverySettings}
+	if len(servicesConfig.Services) > 0 {
+		cfg, err := api.ParseConfig(cm, secret)
+		if err != nil {
+			return nil, err
+		}
+		if config.getVars, err = f.settings.InitGetVars(cfg, cm, secret); err != nil {
+			return nil, err
+		}
+	}
+	f.configs[namespace] = config
+	return config, nil
+}
+
+// Run periodically sends the digests whose interval has elapsed, retries the notifications which failed to be
+// delivered and records the delivery attempts
+func (f *apiFactory) Run(ctx context.Context) {
+	ticker := time.NewTicker(digestCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			f.flushDigests()
+			f.retryDeliveries()
+			f.deliveries.flush(ctx)
+		}
+	}
+}
+
+func (f *apiFactory) retryDeliveries() {
+	for _, p := range f.deliveries.takeDue() {
+		dest := services.Destination{Service: p.record.Service, Recipient: p.record.Recipient}
+		err := f.deliver(p.namespace, p.obj, p.record.Templates, dest)
+		if err := f.deliveries.track(p.namespace, p.obj, p.record, p.config, err); err != nil {
+			log.Errorf("Failed to deliver notification %v to %v after %d attempts using the configuration in namespace %s: %v", p.record.Templates, dest, p.record.Attempts+1, p.namespace, err)
+		}
+	}
+}
+
+// deliver sends the notification using the configuration of the namespace, without tracking its delivery
+func (f *apiFactory) deliver(namespace string, obj map[string]any, templates []string, dest services.Destination) error {
+	apis, err := f.GetAPIsFromNamespace(namespace)
+	notificationAPI, ok := apis[namespace]
+	if !ok {
+		if err == nil {
+			err = fmt.Errorf("no configuration in namespace %s", namespace)
+		}
+		return fmt.Errorf("failed to get api: %w", err)
+	}
+	if wrapped, ok := notificationAPI.(*wrappedAPI); ok {
+		return wrapped.deliver(obj, templates, dest)
+	}
+	return notificationAPI.Send(obj, templates, dest)
+}
+
+// flushDigests sends the digests whose interval has elapsed and retries the digests which failed to be sent. A digest
+// which failed to be sent is retried with the delivery settings of the namespace if its delivery is tracked, and
+// after its interval otherwise.
+func (f *apiFactory) flushDigests() {
+	for _, buffer := range f.digester.takeDue() {
+		key := buffer.key
+		err := f.sendDigest(key, buffer)
+		if buffer.delivery == nil {
+			if err != nil {
+				log.Errorf("Failed to send the digest of trigger %s to %v using the configuration in namespace %s, retrying in %v: %v", key.trigger, key.dest, key.namespace, buffer.config.interval(), err)
+				f.digester.requeue(buffer, f.digester.now().Add(buffer.config.interval()))
+			}
+			continue
+		}
+		buffer.record = f.deliveries.attempt(buffer.record, *buffer.delivery, err)
+		for _, entityKey := range buffer.keys {
+			f.deliveries.setRecord(buffer.entities[entityKey], buffer.record, *buffer.delivery)
+		}
+		switch buffer.record.Status {
+		case delivery.StatusRetrying:
+			f.digester.requeue(buffer, f.digester.now().Add(buffer.delivery.backoff(buffer.record.Attempts)))
+		case delivery.StatusFailed:
+			log.Errorf("Failed to send the digest of trigger %s to %v after %d attempts using the configuration in namespace %s: %v", key.trigger, key.dest, buffer.record.Attempts, key.namespace, err)
+		}
+	}
+}
+
+// restoreDeliveries adds to the digester the digests whose delivery is tracked which were not sent before the
+// controller restarted, from the delivery records of the given resources. The retries of the other notifications are
+// not kept across restarts, so their records which are still retrying, as well as the records of the digests which
+// can't be restored, are marked as failed.
+func (f *apiFactory) restoreDeliveries(objs []map[string]any) {
+	buffers := map[string]*digestBuffer{}
+	var ids []string
+	for _, obj := range objs {
+		records, err := delivery.GetRecords((&unstructured.Unstructured{Object: obj}).GetAnnotations())
+		if err != nil {
+			continue
+		}
+		for _, record := range records {
+			if record.Status != delivery.StatusPending && record.Status != delivery.StatusRetrying {
+				continue
+			}
+			if len(record.Templates) != 1 || !strings.HasPrefix(record.Templates[0], digestTemplatePrefix) {
+				if record.Status == delivery.StatusRetrying {
+					f.deliveries.expire(obj, record)
+				}
+				continue
+			}
+			buffer, ok := buffers[record.ID]
+			if !ok {
+				buffer = f.newRestoredDigest(record)
+				buffers[record.ID] = buffer
+				if buffer != nil {
+					ids = append(ids, record.ID)
+				}
+			}
+			if buffer != nil {
+				buffer.add(obj)
+			} else {
+				f.deliveries.expire(obj, record)
+			}
+		}
+	}
+	for _, id := range ids {
+		f.digester.restore(buffers[id])
+	}
+}
+
+// newRestoredDigest returns the digest of the given delivery record, or nil if the digest or the tracking of its
+// delivery is no longer configured
+func (f *apiFactory) newRestoredDigest(record delivery.Record) *digestBuffer {
+	key := digestKey{
+		namespace: record.Namespace,
+		trigger:   strings.TrimPrefix(record.Templates[0], digestTemplatePrefix),
+		dest:      services.Destination{Service: record.Service, Recipient: record.Recipient},
+	}
+	config, err := f.getConfig(key.namespace)
+	if err != nil {
+		log.Warnf("Failed to restore the digest of trigger %s to %v using the configuration in namespace %s: %v", key.trigger, key.dest, key.namespace, err)
+		return nil
+	}
+	if config == nil || config.delivery == nil {
+		return nil
+	}
+	digest, ok := config.digests[key.trigger]
+	if !ok {
+		return nil
+	}
+	buffer := newDigestBuffer(key, digest, config.delivery, record.Time.Time)
+	buffer.record = record
+	if record.Status == delivery.StatusRetrying {
+		buffer.retryAt = record.Time.Add(config.delivery.backoff(record.Attempts))
+	}
+	return buffer
+}
+
+func (f *apiFactory) sendDigest(key digestKey, buffer *digestBuffer) error {
+	apis, err := f.GetAPIsFromNamespace(key.namespace)
+	notificationAPI, ok := apis[key.namespace]
+	if !ok {
+		if err == nil {
+			err = fmt.Errorf("no configuration in namespace %s", key.namespace)
+		}
+		return fmt.Errorf("failed to get api: %w", err)
+	}
+	entities := make([]map[string]any, len(buffer.keys))
+	for i, entityKey := range buffer.keys {
+		entities[i] = buffer.entities[entityKey]
+	}
+	vars := map[string]any{
+		"trigger":          key.trigger,
+		"apps":             entities,
+		serviceTypeVarName: key.dest.Service,
+		recipientVarName:   key.dest.Recipient,
+	}
+	if cm, err := f.cmLister.ConfigMaps(key.namespace).Get(f.settings.ConfigMapName); err == nil {
+		context := map[string]string{}
+		if err := yaml.Unmarshal([]byte(cm.Data["context"]), &context); err == nil {
+			vars["context"] = context
+		}
+	}
+
+	if wrapped, ok := notificationAPI.(*wrappedAPI); ok {
+		if svc, ok := wrapped.config.services.Services[key.dest.Service]; ok {
+			if buffer.config.Template == "" {
+				return fmt.Errorf("the digests sent to the service %s require a template", key.dest.Service)
+			}
+			return wrapped.sendArgoCD(svc, vars, []string{buffer.config.Template}, key.dest)
+		}
+	}
+	notificationService, ok := notificationAPI.GetNotificationServices()[key.dest.Service]
+	if !ok {
+		return fmt.Errorf("notification service '%s' is not supported", key.dest.Service)
+	}
+	var notification services.Notification
+	if buffer.config.Template == "" {
+		notification.Message = defaultDigestMessage(key.trigger, buffer.keys)
+	} else {
+		templatesService, err := templates.NewService(notificationAPI.GetConfig().Templates)
+		if err != nil {
+			return err
+		}
+		formatted, err := templatesService.FormatNotification(vars, buffer.config.Template)
+		if err != nil {
+			return err
+		}
+		notification = *formatted
+	}
+	return notificationService.Send(notification, key.dest)
+}
+
+// wrappedAPI is an api.API adding the notifications of the triggers configured with a digest to the digests instead
+// of sending them, sending the notifications of the Argo CD notification services and tracking the delivery of the
+// notifications
+type wrappedAPI struct {
+	api.API
+	factory   *apiFactory
+	namespace string
+	config    *apiConfig
+}
+
+func (a *wrappedAPI) RunTrigger(triggerName string, vars map[string]any) ([]triggers.ConditionResult, error) {
+	res, err := a.API.RunTrigger(triggerName, vars)
+	if _, ok := a.config.digests[triggerName]; ok {
+		for i := range res {
+			res[i].Templates = []string{digestTemplatePrefix + triggerName}
+		}
+	}
+	return res, err
+}
+
+func (a *wrappedAPI) Send(obj map[string]any, templates []string, dest services.Destination) error {
+	if len(templates) == 1 && strings.HasPrefix(templates[0], digestTemplatePrefix) {
+		trigger := strings.TrimPrefix(templates[0], digestTemplatePrefix)
+		if config, ok := a.config.digests[trigger]; ok {
+			record := a.factory.digester.add(digestKey{namespace: a.namespace, trigger: trigger, dest: dest}, config, a.config.delivery, obj)
+			if a.config.delivery != nil {
+				a.factory.deliveries.setRecord(obj, record, *a.config.delivery)
+			}
+			return nil
+		}
+	}
+	if a.config.delivery == nil {
+		return a.deliver(obj, templates, dest)
+	}
+	err := a.deliver(obj, templates, dest)
+	return a.factory.deliveries.track(a.namespace, obj, newRecord(templates, dest), *a.config.delivery, err)
+}
+
+// deliver sends the notification using the Argo CD notification service or the service of the notifications engine
+func (a *wrappedAPI) deliver(obj map[string]any, templates []string, dest services.Destination) error {
+	if svc, ok := a.config.services.Services[dest.Service]; ok {
+		vars := a.config.getVars(obj, dest)
+		vars[serviceTypeVarName] = dest.Service
+		vars[recipientVarName] = dest.Recipient
+		return a.sendArgoCD(svc, vars, templates, dest)
+	}
+	return a.API.Send(obj, templates, dest)
+}
+
+// sendArgoCD sends the notification rendered from the given templates using the Argo CD notification service
+func (a *wrappedAPI) sendArgoCD(svc argocdservices.NotificationService, vars map[string]any, templates []string, dest services.Destination) error {
+	notification, err := a.config.services.FormatNotification(vars, templates...)
+	if err != nil {
+		return err
+	}
+	return svc.Send(*notification, dest)
+}
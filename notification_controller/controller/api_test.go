@@ -0,0 +1,115 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/argoproj/notifications-engine/pkg/api"
+	"github.com/argoproj/notifications-engine/pkg/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/v3/util/notification/k8s"
+)
+
+type recordingService struct {
+	notifications []services.Notification
+	err           error
+}
+
+func (s *recordingService) Send(notification services.Notification, _ services.Destination) error {
+	s.notifications = append(s.notifications, notification)
+	return s.err
+}
+
+func newTestAPIFactory(t *testing.T, data map[string]string) *apiFactory {
+	t.Helper()
+	k8sClient := k8sfake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-configmap", Namespace: "default"},
+		Data:       data,
+	})
+	secretInformer := k8s.NewSecretInformer(k8sClient, "default", "my-secret")
+	configMapInformer := k8s.NewConfigMapInformer(k8sClient, "default", "my-configmap")
+	go secretInformer.Run(t.Context().Done())
+	go configMapInformer.Run(t.Context().Done())
+	require.True(t, cache.WaitForCacheSync(t.Context().Done(), secretInformer.HasSynced, configMapInformer.HasSynced))
+
+	return newAPIFactory(api.Settings{
+		ConfigMapName: "my-configmap",
+		SecretName:    "my-secret",
+		InitGetVars: func(_ *api.Config, _ *corev1.ConfigMap, _ *corev1.Secret) (api.GetVars, error) {
+			return func(obj map[string]any, _ services.Destination) map[string]any {
+				return map[string]any{"app": obj}
+			}, nil
+		},
+	}, "default", dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()), nil, secretInformer, configMapInformer)
+}
+
+func newTestApp(name string, syncStatus string) map[string]any {
+	return map[string]any{
+		"metadata": map[string]any{"name": name, "namespace": "argocd"},
+		"status":   map[string]any{"sync": map[string]any{"status": syncStatus}},
+	}
+}
+
+func TestAPIFactoryWithoutExtensions(t *testing.T) {
+	f := newTestAPIFactory(t, map[string]string{
+		"template.app-sync-failed": `message: "{{.app.metadata.name}} sync failed"`,
+	})
+	notificationAPI, err := f.GetAPI()
+	require.NoError(t, err)
+	_, ok := notificationAPI.(*wrappedAPI)
+	assert.False(t, ok, "the API of the notifications engine should not be wrapped without digests or Argo CD services")
+}
+
+func TestAPIFactoryArgoCDService(t *testing.T) {
+	var events []map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		events = append(events, event)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	f := newTestAPIFactory(t, map[string]string{
+		"argocd.service.pagerdutyevents": `
+url: ` + server.URL + `
+serviceKeys:
+  my-service: my-integration-key`,
+		"template.app-sync-failed": `
+message: "{{.app.metadata.name}} sync failed"
+pagerdutyEvent:
+  condition: sync-failed
+  summary: "{{.app.metadata.name}} sync failed"`,
+	})
+	notificationAPI, err := f.GetAPI()
+	require.NoError(t, err)
+	slack := &recordingService{}
+	notificationAPI.AddNotificationService("slack", slack)
+
+	app := newTestApp("guestbook", "Failed")
+	require.NoError(t, notificationAPI.Send(app, []string{"app-sync-failed"}, services.Destination{Service: "pagerdutyevents", Recipient: "my-service"}))
+	require.Len(t, events, 1)
+	assert.Equal(t, "my-integration-key", events[0]["routing_key"])
+	assert.Equal(t, "trigger", events[0]["event_action"])
+	assert.Equal(t, "argocd/guestbook:sync-failed", events[0]["dedup_key"])
+	assert.Equal(t, map[string]any{"summary": "guestbook sync failed", "severity": "error", "source": "guestbook"}, events[0]["payload"])
+
+	// the notifications of the engine services are still sent by the engine
+	require.NoError(t, notificationAPI.Send(app, []string{"app-sync-failed"}, services.Destination{Service: "slack", Recipient: "my-channel"}))
+	require.Len(t, slack.notifications, 1)
+	assert.Equal(t, "guestbook sync failed", slack.notifications[0].Message)
+	assert.Len(t, events, 1)
+
+	err = notificationAPI.Send(app, []string{"app-sync-failed"}, services.Destination{Service: "pagerdutyevents", Recipient: "unknown"})
+	assert.ErrorContains(t, err, "no integration key configured for recipient unknown")
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/argoproj/notifications-engine/pkg/services"
 	"github.com/argoproj/notifications-engine/pkg/subscriptions"
 	httputil "github.com/argoproj/notifications-engine/pkg/util/http"
+	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -40,8 +41,9 @@ const (
 )
 
 var (
-	applications = schema.GroupVersionResource{Group: application.Group, Version: "v1alpha1", Resource: application.ApplicationPlural}
-	appProjects  = schema.GroupVersionResource{Group: application.Group, Version: "v1alpha1", Resource: application.AppProjectPlural}
+	applications    = schema.GroupVersionResource{Group: application.Group, Version: "v1alpha1", Resource: application.ApplicationPlural}
+	appProjects     = schema.GroupVersionResource{Group: application.Group, Version: "v1alpha1", Resource: application.AppProjectPlural}
+	applicationSets = schema.GroupVersionResource{Group: application.Group, Version: "v1alpha1", Resource: application.ApplicationSetPlural}
 )
 
 func newAppProjClient(client dynamic.Interface, namespace string) dynamic.ResourceInterface {
@@ -66,15 +68,19 @@ func NewController(
 	configMapName string,
 	selfServiceNotificationEnabled bool,
 ) *notificationController {
-	var appClient dynamic.ResourceInterface
+	var appClient, appSetClient dynamic.ResourceInterface
 
 	namespaceableAppClient := client.Resource(applications)
 	appClient = namespaceableAppClient
+	namespaceableAppSetClient := client.Resource(applicationSets)
+	appSetClient = namespaceableAppSetClient
 
 	if len(applicationNamespaces) == 0 {
 		appClient = namespaceableAppClient.Namespace(namespace)
+		appSetClient = namespaceableAppSetClient.Namespace(namespace)
 	}
 	appInformer := newInformer(appClient, namespace, applicationNamespaces, appLabelSelector)
+	appSetInformer := newInformer(appSetClient, namespace, applicationNamespaces, "")
 	appProjInformer := newInformer(newAppProjClient(client, namespace), namespace, []string{namespace}, "")
 	var notificationConfigNamespace string
 	if selfServiceNotificationEnabled {
@@ -84,15 +90,27 @@ func NewController(
 	}
 	secretInformer := k8s.NewSecretInformer(k8sClient, notificationConfigNamespace, secretName)
 	configMapInformer := k8s.NewConfigMapInformer(k8sClient, notificationConfigNamespace, configMapName)
-	apiFactory := api.NewFactory(settings.GetFactorySettings(argocdService, secretName, configMapName, selfServiceNotificationEnabled), namespace, secretInformer, configMapInformer)
+	var registerer prometheus.Registerer
+	if registry != nil {
+		registerer = registry
+	}
+	apiFactory := newAPIFactory(settings.GetFactorySettings(argocdService, secretName, configMapName, selfServiceNotificationEnabled), namespace, client, registerer, secretInformer, configMapInformer)
 
 	res := &notificationController{
 		secretInformer:    secretInformer,
 		configMapInformer: configMapInformer,
 		appInformer:       appInformer,
+		appSetInformer:    appSetInformer,
 		appProjInformer:   appProjInformer,
 		apiFactory:        apiFactory,
 	}
+	skipAppSetProcessingOpt := controller.WithSkipProcessing(func(obj metav1.Object) (bool, string) {
+		appSet, ok := (obj).(*unstructured.Unstructured)
+		if ok && checkAppNotInAdditionalNamespaces(appSet, namespace, applicationNamespaces) {
+			return true, "application set is not in one of the application-namespaces, nor the notification controller namespace"
+		}
+		return false, ""
+	})
 	skipProcessingOpt := controller.WithSkipProcessing(func(obj metav1.Object) (bool, string) {
 		app, ok := (obj).(*unstructured.Unstructured)
 		if !ok {
@@ -106,17 +124,24 @@ func NewController(
 	metricsRegistryOpt := controller.WithMetricsRegistry(registry)
 	alterDestinationsOpt := controller.WithAlterDestinations(res.alterDestinations)
 
-	if !selfServiceNotificationEnabled {
-		res.ctrl = controller.NewController(namespaceableAppClient, appInformer, apiFactory,
-			skipProcessingOpt,
-			metricsRegistryOpt,
-			alterDestinationsOpt)
-	} else {
-		res.ctrl = controller.NewControllerWithNamespaceSupport(namespaceableAppClient, appInformer, apiFactory,
-			skipProcessingOpt,
-			metricsRegistryOpt,
-			alterDestinationsOpt)
+	alterAppSetDestinationsOpt := controller.WithAlterDestinations(res.filterDestinations(application.ApplicationSetKind))
+	alterAppProjDestinationsOpt := controller.WithAlterDestinations(res.filterDestinations(application.AppProjectKind))
+
+	newController := controller.NewController
+	if selfServiceNotificationEnabled {
+		newController = controller.NewControllerWithNamespaceSupport
 	}
+	res.ctrl = newController(namespaceableAppClient, appInformer, apiFactory,
+		skipProcessingOpt,
+		metricsRegistryOpt,
+		alterDestinationsOpt)
+	res.appSetCtrl = newController(namespaceableAppSetClient, appSetInformer, apiFactory,
+		skipAppSetProcessingOpt,
+		metricsRegistryOpt,
+		alterAppSetDestinationsOpt)
+	res.appProjCtrl = newController(client.Resource(appProjects), appProjInformer, apiFactory,
+		metricsRegistryOpt,
+		alterAppProjDestinationsOpt)
 	return res
 }
 
@@ -135,7 +160,30 @@ func (c *notificationController) alterDestinations(obj metav1.Object, destinatio
 		destinations.Merge(subscriptions.NewAnnotations(proj.GetAnnotations()).GetDestinations(cfg.DefaultTriggers, cfg.ServiceDefaultTriggers))
 		destinations.Merge(settings.GetLegacyDestinations(proj.GetAnnotations(), cfg.DefaultTriggers, cfg.ServiceDefaultTriggers))
 	}
-	return destinations
+	return c.filterDestinations(application.ApplicationKind)(obj, destinations, cfg)
+}
+
+// filterDestinations returns a function removing from the destinations the triggers which are not evaluated for the
+// resources of the given kind
+func (c *notificationController) filterDestinations(kind string) func(obj metav1.Object, destinations services.Destinations, cfg api.Config) services.Destinations {
+	return func(_ metav1.Object, destinations services.Destinations, cfg api.Config) services.Destinations {
+		var triggerResources map[string]string
+		if config, err := c.apiFactory.getConfig(cfg.Namespace); err != nil {
+			log.Warnf("Failed to get the notification settings in namespace %s: %v", cfg.Namespace, err)
+		} else if config != nil {
+			triggerResources = config.triggerResources
+		}
+		for trigger := range destinations {
+			resource, ok := triggerResources[trigger]
+			if !ok {
+				resource = application.ApplicationKind
+			}
+			if resource != kind {
+				delete(destinations, trigger)
+			}
+		}
+		return destinations
+	}
 }
 
 func newInformer(resClient dynamic.ResourceInterface, controllerNamespace string, applicationNamespaces []string, selector string) cache.SharedIndexInformer {
@@ -173,9 +221,12 @@ func newInformer(resClient dynamic.ResourceInterface, controllerNamespace string
 }
 
 type notificationController struct {
-	apiFactory        api.Factory
+	apiFactory        *apiFactory
 	ctrl              controller.NotificationController
+	appSetCtrl        controller.NotificationController
+	appProjCtrl       controller.NotificationController
 	appInformer       cache.SharedIndexInformer
+	appSetInformer    cache.SharedIndexInformer
 	appProjInformer   cache.SharedIndexInformer
 	secretInformer    cache.SharedIndexInformer
 	configMapInformer cache.SharedIndexInformer
@@ -186,17 +237,30 @@ func (c *notificationController) Init(ctx context.Context) error {
 	httputil.SetCertResolver(argocert.GetCertificateForConnect)
 
 	go c.appInformer.Run(ctx.Done())
+	go c.appSetInformer.Run(ctx.Done())
 	go c.appProjInformer.Run(ctx.Done())
 	go c.secretInformer.Run(ctx.Done())
 	go c.configMapInformer.Run(ctx.Done())
 
-	if !cache.WaitForCacheSync(ctx.Done(), c.appInformer.HasSynced, c.appProjInformer.HasSynced, c.secretInformer.HasSynced, c.configMapInformer.HasSynced) {
+	if !cache.WaitForCacheSync(ctx.Done(), c.appInformer.HasSynced, c.appSetInformer.HasSynced, c.appProjInformer.HasSynced, c.secretInformer.HasSynced, c.configMapInformer.HasSynced) {
 		return errors.New("timed out waiting for caches to sync")
 	}
 	return nil
 }
 
 func (c *notificationController) Run(ctx context.Context, processors int) {
+	var objs []map[string]any
+	for _, informer := range []cache.SharedIndexInformer{c.appInformer, c.appSetInformer, c.appProjInformer} {
+		for _, obj := range informer.GetStore().List() {
+			if un, ok := obj.(*unstructured.Unstructured); ok {
+				objs = append(objs, un.Object)
+			}
+		}
+	}
+	c.apiFactory.restoreDeliveries(objs)
+	go c.apiFactory.Run(ctx)
+	go c.appSetCtrl.Run(processors, ctx.Done())
+	go c.appProjCtrl.Run(processors, ctx.Done())
 	c.ctrl.Run(processors, ctx.Done())
 }
 
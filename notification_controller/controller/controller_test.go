@@ -5,6 +5,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/argoproj/notifications-engine/pkg/api"
+	"github.com/argoproj/notifications-engine/pkg/services"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -192,3 +194,54 @@ func TestCheckAppNotInAdditionalNamespaces(t *testing.T) {
 	app.SetNamespace("namespace3")
 	assert.True(t, checkAppNotInAdditionalNamespaces(app, "", applicationNamespaces))
 }
+
+func TestAlterDestinations(t *testing.T) {
+	f := newTestAPIFactory(t, map[string]string{
+		"trigger.on-created":                 "- when: 'true'\n  send: [app-created]",
+		"trigger.on-appset-generation-error": "- when: 'true'\n  send: [appset-generation-error]\n  resource: ApplicationSet",
+		"trigger.on-project-window-opened":   "- when: 'true'\n  send: [project-window-opened]\n  resource: AppProject",
+	})
+	appSetInformer := cache.NewSharedIndexInformer(nil, nil, 0, cache.Indexers{})
+	require.NoError(t, appSetInformer.GetIndexer().Add(&unstructured.Unstructured{Object: map[string]any{
+		"kind": "ApplicationSet",
+		"metadata": map[string]any{
+			"name":        "my-appset",
+			"namespace":   "argocd",
+			"annotations": map[string]any{"notifications.argoproj.io/subscribe.on-deleted.slack": "appset-channel"},
+		},
+	}}))
+	c := &notificationController{
+		apiFactory:      f,
+		appSetInformer:  appSetInformer,
+		appProjInformer: cache.NewSharedIndexInformer(nil, nil, 0, cache.Indexers{}),
+	}
+	newDestinations := func() services.Destinations {
+		return services.Destinations{
+			"on-created":                 {{Service: "slack", Recipient: "my-channel"}},
+			"on-appset-generation-error": {{Service: "slack", Recipient: "my-channel"}},
+			"on-project-window-opened":   {{Service: "slack", Recipient: "my-channel"}},
+		}
+	}
+	cfg := api.Config{Namespace: "default"}
+
+	app := &unstructured.Unstructured{Object: map[string]any{
+		"kind": "Application",
+		"metadata": map[string]any{
+			"name":            "my-app",
+			"namespace":       "argocd",
+			"ownerReferences": []any{map[string]any{"apiVersion": "argoproj.io/v1alpha1", "kind": "ApplicationSet", "name": "my-appset"}},
+		},
+		"spec": map[string]any{},
+	}}
+	// the subscriptions of the ApplicationSet don't apply to the applications it generated
+	assert.Equal(t, services.Destinations{
+		"on-created": {{Service: "slack", Recipient: "my-channel"}},
+	}, c.alterDestinations(app, newDestinations(), cfg))
+
+	assert.Equal(t, services.Destinations{
+		"on-appset-generation-error": {{Service: "slack", Recipient: "my-channel"}},
+	}, c.filterDestinations("ApplicationSet")(nil, newDestinations(), cfg))
+	assert.Equal(t, services.Destinations{
+		"on-project-window-opened": {{Service: "slack", Recipient: "my-channel"}},
+	}, c.filterDestinations("AppProject")(nil, newDestinations(), cfg))
+}
@@ -0,0 +1,304 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/argoproj/notifications-engine/pkg/services"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
+	"github.com/argoproj/argo-cd/v3/util/notification/delivery"
+)
+
+const (
+	// deliveryKey is the key of the notifications ConfigMap holding the delivery settings. The delivery of the
+	// notifications is tracked and retried only if the key is set.
+	deliveryKey = "delivery"
+	// defaultDeliveryBackoff is the delay before the first retry of a notification when none is configured
+	defaultDeliveryBackoff = 30 * time.Second
+	// defaultDeliveryMaxBackoff is the maximum delay between two retries of a notification when none is configured
+	defaultDeliveryMaxBackoff = 10 * time.Minute
+	// defaultDeliveryHistoryLimit is the number of delivery records kept per resource when none is configured
+	defaultDeliveryHistoryLimit = 10
+)
+
+// deliveryConfig holds the settings of the tracking and of the retries of the delivery of the notifications
+type deliveryConfig struct {
+	// Retries is the number of times the delivery of a notification is retried after a failure
+	Retries int `json:"retries,omitempty"`
+	// Backoff is the delay before the first retry, doubled after each retry
+	Backoff metav1.Duration `json:"backoff,omitempty"`
+	// MaxBackoff is the maximum delay between two retries
+	MaxBackoff metav1.Duration `json:"maxBackoff,omitempty"`
+	// HistoryLimit is the number of delivery records kept in the annotation of a resource
+	HistoryLimit int `json:"historyLimit,omitempty"`
+}
+
+// backoff returns the delay before the retry following the given number of delivery attempts
+func (c deliveryConfig) backoff(attempts int) time.Duration {
+	backoff, maxBackoff := c.Backoff.Duration, c.MaxBackoff.Duration
+	if backoff <= 0 {
+		backoff = defaultDeliveryBackoff
+	}
+	if maxBackoff <= 0 {
+		maxBackoff = defaultDeliveryMaxBackoff
+	}
+	delay := float64(backoff) * math.Pow(2, float64(attempts-1))
+	if delay > float64(maxBackoff) {
+		return maxBackoff
+	}
+	return time.Duration(delay)
+}
+
+func (c deliveryConfig) historyLimit() int {
+	if c.HistoryLimit <= 0 {
+		return defaultDeliveryHistoryLimit
+	}
+	return c.HistoryLimit
+}
+
+// parseDeliveryConfig returns the delivery settings of the notifications ConfigMap, or nil if the delivery of the
+// notifications is not tracked
+func parseDeliveryConfig(cm *corev1.ConfigMap) (*deliveryConfig, error) {
+	v, ok := cm.Data[deliveryKey]
+	if !ok {
+		return nil, nil
+	}
+	config := &deliveryConfig{}
+	if err := yaml.Unmarshal([]byte(v), config); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal delivery settings: %w", err)
+	}
+	return config, nil
+}
+
+// deliveryResourceKey identifies the resource a notification is about
+type deliveryResourceKey struct {
+	gvr       schema.GroupVersionResource
+	namespace string
+	name      string
+}
+
+func newDeliveryResourceKey(obj map[string]any) (deliveryResourceKey, bool) {
+	kind, _ := obj["kind"].(string)
+	var gvr schema.GroupVersionResource
+	switch kind {
+	case application.ApplicationKind:
+		gvr = applications
+	case application.ApplicationSetKind:
+		gvr = applicationSets
+	case application.AppProjectKind:
+		gvr = appProjects
+	default:
+		return deliveryResourceKey{}, false
+	}
+	metadata, _ := obj["metadata"].(map[string]any)
+	name, _ := metadata["name"].(string)
+	namespace, _ := metadata["namespace"].(string)
+	return deliveryResourceKey{gvr: gvr, namespace: namespace, name: name}, name != ""
+}
+
+// pendingDelivery is a notification waiting to be retried
+type pendingDelivery struct {
+	namespace string
+	obj       map[string]any
+	record    delivery.Record
+	config    deliveryConfig
+	next      time.Time
+}
+
+// deliveryTracker records the delivery attempts of the notifications in the annotations of the resources they are
+// about, and holds the notifications to retry. The records are written asynchronously since the notifications
+// engine patches the annotations of the resources after sending their notifications, from its informer cache.
+type deliveryTracker struct {
+	client      dynamic.Interface
+	retries     *prometheus.CounterVec
+	deadLetters *prometheus.CounterVec
+	now         func() time.Time
+
+	lock    sync.Mutex
+	pending map[string]*pendingDelivery
+	records map[deliveryResourceKey][]delivery.Record
+	limits  map[deliveryResourceKey]int
+}
+
+func newDeliveryTracker(client dynamic.Interface, registerer prometheus.Registerer) *deliveryTracker {
+	t := &deliveryTracker{
+		client: client,
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "argocd_notifications_delivery_retries_total",
+			Help: "Number of retries of the notifications which failed to be delivered.",
+		}, []string{"service"}),
+		deadLetters: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "argocd_notifications_dead_letters_total",
+			Help: "Number of notifications which failed to be delivered after all the retries.",
+		}, []string{"service"}),
+		now:     time.Now,
+		pending: map[string]*pendingDelivery{},
+		records: map[deliveryResourceKey][]delivery.Record{},
+		limits:  map[deliveryResourceKey]int{},
+	}
+	if registerer != nil {
+		registerer.MustRegister(t.retries, t.deadLetters)
+	}
+	return t
+}
+
+// newRecord returns the record of the first delivery attempt of a notification
+func newRecord(templates []string, dest services.Destination) delivery.Record {
+	return delivery.Record{ID: uuid.NewString(), Templates: templates, Service: dest.Service, Recipient: dest.Recipient}
+}
+
+// track records the result of a delivery attempt of the notification and schedules its retry if it failed. The
+// returned error is nil if the notification is going to be retried.
+func (t *deliveryTracker) track(namespace string, obj map[string]any, record delivery.Record, config deliveryConfig, err error) error {
+	record = t.attempt(record, config, err)
+	if record.Status == delivery.StatusRetrying {
+		t.lock.Lock()
+		t.pending[record.ID] = &pendingDelivery{namespace: namespace, obj: obj, record: record, config: config, next: record.Time.Add(config.backoff(record.Attempts))}
+		t.lock.Unlock()
+	}
+	t.setRecord(obj, record, config)
+	if record.Status == delivery.StatusRetrying {
+		return nil
+	}
+	return err
+}
+
+// attempt returns the record updated with the result of a delivery attempt of the notification
+func (t *deliveryTracker) attempt(record delivery.Record, config deliveryConfig, err error) delivery.Record {
+	record.Attempts++
+	record.Time = metav1.NewTime(t.now())
+	record.HTTPStatus = delivery.HTTPStatus(err)
+	record.Message = ""
+	switch {
+	case err == nil:
+		record.Status = delivery.StatusSucceeded
+	case record.Attempts <= config.Retries:
+		record.Status = delivery.StatusRetrying
+		record.Message = delivery.Message(err)
+		// the record only holds a description of the error, without the URL of the service
+		log.Warnf("Failed to deliver notification %v to %s:%s, retrying after attempt %d: %v", record.Templates, record.Service, record.Recipient, record.Attempts, err)
+		t.retries.WithLabelValues(record.Service).Inc()
+	default:
+		record.Status = delivery.StatusFailed
+		record.Message = delivery.Message(err)
+		t.deadLetters.WithLabelValues(record.Service).Inc()
+	}
+	return record
+}
+
+// setRecord schedules the write of the delivery record to the annotations of the resource the notification is about
+func (t *deliveryTracker) setRecord(obj map[string]any, record delivery.Record, config deliveryConfig) {
+	key, ok := newDeliveryResourceKey(obj)
+	if !ok {
+		return
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.records[key] = delivery.SetRecord(t.records[key], record, 0)
+	t.limits[key] = config.historyLimit()
+}
+
+// expire schedules the write of the record of a notification which is no longer going to be retried, e.g. since the
+// controller restarted, as failed. The record replaces the existing one, so the history limit is left unchanged.
+func (t *deliveryTracker) expire(obj map[string]any, record delivery.Record) {
+	key, ok := newDeliveryResourceKey(obj)
+	if !ok {
+		return
+	}
+	record.Status = delivery.StatusFailed
+	record.Message = "the notification was not retried since the controller restarted"
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.records[key] = delivery.SetRecord(t.records[key], record, 0)
+	if _, ok := t.limits[key]; !ok {
+		t.limits[key] = 0
+	}
+}
+
+// takeDue removes and returns the notifications whose retry is due
+func (t *deliveryTracker) takeDue() []*pendingDelivery {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	var due []*pendingDelivery
+	now := t.now()
+	for id, p := range t.pending {
+		if !now.Before(p.next) {
+			due = append(due, p)
+			delete(t.pending, id)
+		}
+	}
+	return due
+}
+
+// flush writes the delivery records to the annotations of the resources
+func (t *deliveryTracker) flush(ctx context.Context) {
+	t.lock.Lock()
+	records, limits := t.records, t.limits
+	t.records, t.limits = map[deliveryResourceKey][]delivery.Record{}, map[deliveryResourceKey]int{}
+	t.lock.Unlock()
+
+	for key, updates := range records {
+		if err := t.persist(ctx, key, updates, limits[key]); err != nil {
+			log.Warnf("Failed to record the delivery of the notifications about %s %s/%s, retrying: %v", key.gvr.Resource, key.namespace, key.name, err)
+			t.requeue(key, updates, limits[key])
+		}
+	}
+}
+
+// requeue schedules again the write of the delivery records which failed to be written, before the records scheduled
+// since then
+func (t *deliveryTracker) requeue(key deliveryResourceKey, updates []delivery.Record, limit int) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for _, record := range t.records[key] {
+		updates = delivery.SetRecord(updates, record, 0)
+	}
+	t.records[key] = updates
+	if _, ok := t.limits[key]; !ok {
+		t.limits[key] = limit
+	}
+}
+
+func (t *deliveryTracker) persist(ctx context.Context, key deliveryResourceKey, updates []delivery.Record, limit int) error {
+	resClient := t.client.Resource(key.gvr).Namespace(key.namespace)
+	res, err := resClient.Get(ctx, key.name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	records, err := delivery.GetRecords(res.GetAnnotations())
+	if err != nil {
+		log.Warnf("Dropping the delivery records of %s %s/%s: %v", key.gvr.Resource, key.namespace, key.name, err)
+	}
+	for _, record := range updates {
+		records = delivery.SetRecord(records, record, limit)
+	}
+	value, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{"annotations": map[string]string{delivery.AnnotationKey: string(value)}},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = resClient.Patch(ctx, key.name, types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
@@ -0,0 +1,200 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/argoproj/notifications-engine/pkg/services"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubetesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-cd/v3/util/notification/delivery"
+)
+
+func TestDeliveryConfigBackoff(t *testing.T) {
+	config := deliveryConfig{}
+	assert.Equal(t, 30*time.Second, config.backoff(1))
+	assert.Equal(t, 2*time.Minute, config.backoff(3))
+	assert.Equal(t, 10*time.Minute, config.backoff(10))
+
+	config = deliveryConfig{Backoff: metav1.Duration{Duration: time.Second}, MaxBackoff: metav1.Duration{Duration: 5 * time.Second}}
+	assert.Equal(t, 2*time.Second, config.backoff(2))
+	assert.Equal(t, 5*time.Second, config.backoff(4))
+}
+
+func newDeliveryTestApp(t *testing.T, f *apiFactory) map[string]any {
+	t.Helper()
+	app := newTestApp("guestbook", "Failed")
+	app["apiVersion"] = "argoproj.io/v1alpha1"
+	app["kind"] = "Application"
+	_, err := f.deliveries.client.Resource(applications).Namespace("argocd").Create(t.Context(), &unstructured.Unstructured{Object: app}, metav1.CreateOptions{})
+	require.NoError(t, err)
+	return app
+}
+
+func getDeliveryRecords(t *testing.T, f *apiFactory) []delivery.Record {
+	t.Helper()
+	f.deliveries.flush(t.Context())
+	app, err := f.deliveries.client.Resource(applications).Namespace("argocd").Get(t.Context(), "guestbook", metav1.GetOptions{})
+	require.NoError(t, err)
+	records, err := delivery.GetRecords(app.GetAnnotations())
+	require.NoError(t, err)
+	return records
+}
+
+func TestAPIFactoryDeliveryRetries(t *testing.T) {
+	f := newTestAPIFactory(t, map[string]string{
+		"delivery":                 "retries: 2\nbackoff: 1m",
+		"template.app-sync-failed": `message: "{{.app.metadata.name}} sync failed"`,
+	})
+	now := time.Now()
+	f.deliveries.now = func() time.Time { return now }
+	notificationAPI, err := f.GetAPI()
+	require.NoError(t, err)
+	slack := &recordingService{err: errors.New("request to https://slack.com has failed with error code 503 : unavailable")}
+	notificationAPI.AddNotificationService("slack", slack)
+	app := newDeliveryTestApp(t, f)
+
+	// the failed notification is retried by the factory rather than by the notifications engine
+	require.NoError(t, notificationAPI.Send(app, []string{"app-sync-failed"}, services.Destination{Service: "slack", Recipient: "my-channel"}))
+	records := getDeliveryRecords(t, f)
+	require.Len(t, records, 1)
+	assert.Equal(t, delivery.StatusRetrying, records[0].Status)
+	assert.Equal(t, 1, records[0].Attempts)
+	assert.Equal(t, 503, records[0].HTTPStatus)
+
+	// the retry is not due before the backoff elapsed
+	f.retryDeliveries()
+	assert.Len(t, slack.notifications, 1)
+
+	now = now.Add(time.Minute)
+	slack.err = nil
+	f.retryDeliveries()
+	assert.Len(t, slack.notifications, 2)
+	records = getDeliveryRecords(t, f)
+	require.Len(t, records, 1)
+	assert.Equal(t, delivery.StatusSucceeded, records[0].Status)
+	assert.Equal(t, 2, records[0].Attempts)
+	assert.Zero(t, records[0].HTTPStatus)
+	assert.Empty(t, records[0].Message)
+	assert.InDelta(t, 1, testutil.ToFloat64(f.deliveries.retries.WithLabelValues("slack")), 0)
+}
+
+func TestAPIFactoryDeliveryDeadLetter(t *testing.T) {
+	f := newTestAPIFactory(t, map[string]string{
+		"delivery":                 "retries: 1\nbackoff: 1m",
+		"template.app-sync-failed": `message: "{{.app.metadata.name}} sync failed"`,
+	})
+	now := time.Now()
+	f.deliveries.now = func() time.Time { return now }
+	notificationAPI, err := f.GetAPI()
+	require.NoError(t, err)
+	slack := &recordingService{err: errors.New("connection refused")}
+	notificationAPI.AddNotificationService("slack", slack)
+	app := newDeliveryTestApp(t, f)
+
+	require.NoError(t, notificationAPI.Send(app, []string{"app-sync-failed"}, services.Destination{Service: "slack", Recipient: "my-channel"}))
+	now = now.Add(time.Minute)
+	f.retryDeliveries()
+	now = now.Add(time.Hour)
+	f.retryDeliveries()
+	assert.Len(t, slack.notifications, 2)
+
+	records := getDeliveryRecords(t, f)
+	require.Len(t, records, 1)
+	assert.Equal(t, delivery.StatusFailed, records[0].Status)
+	assert.Equal(t, 2, records[0].Attempts)
+	assert.Equal(t, "connection refused", records[0].Message)
+	assert.InDelta(t, 1, testutil.ToFloat64(f.deliveries.deadLetters.WithLabelValues("slack")), 0)
+}
+
+func TestAPIFactoryDeliveryHistoryLimit(t *testing.T) {
+	f := newTestAPIFactory(t, map[string]string{
+		"delivery":                 "historyLimit: 2",
+		"template.app-sync-failed": `message: "{{.app.metadata.name}} sync failed"`,
+	})
+	notificationAPI, err := f.GetAPI()
+	require.NoError(t, err)
+	notificationAPI.AddNotificationService("slack", &recordingService{})
+	app := newDeliveryTestApp(t, f)
+
+	for _, recipient := range []string{"channel-1", "channel-2", "channel-3"} {
+		require.NoError(t, notificationAPI.Send(app, []string{"app-sync-failed"}, services.Destination{Service: "slack", Recipient: recipient}))
+	}
+	records := getDeliveryRecords(t, f)
+	require.Len(t, records, 2)
+	assert.Equal(t, "channel-2", records[0].Recipient)
+	assert.Equal(t, "channel-3", records[1].Recipient)
+}
+
+func TestAPIFactoryDeliveryNotTracked(t *testing.T) {
+	f := newTestAPIFactory(t, map[string]string{
+		"template.app-sync-failed": `message: "{{.app.metadata.name}} sync failed"`,
+	})
+	notificationAPI, err := f.GetAPI()
+	require.NoError(t, err)
+	notificationAPI.AddNotificationService("slack", &recordingService{err: errors.New("connection refused")})
+	app := newDeliveryTestApp(t, f)
+
+	err = notificationAPI.Send(app, []string{"app-sync-failed"}, services.Destination{Service: "slack", Recipient: "my-channel"})
+	require.ErrorContains(t, err, "connection refused")
+	assert.Empty(t, getDeliveryRecords(t, f))
+}
+
+func TestAPIFactoryDeliveryRecordsPersistFailure(t *testing.T) {
+	f := newTestAPIFactory(t, map[string]string{
+		"delivery":                 "{}",
+		"template.app-sync-failed": `message: "{{.app.metadata.name}} sync failed"`,
+	})
+	notificationAPI, err := f.GetAPI()
+	require.NoError(t, err)
+	notificationAPI.AddNotificationService("slack", &recordingService{})
+	app := newDeliveryTestApp(t, f)
+	client := f.deliveries.client.(*dynamicfake.FakeDynamicClient)
+	client.PrependReactor("patch", "applications", func(_ kubetesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("conflict")
+	})
+
+	require.NoError(t, notificationAPI.Send(app, []string{"app-sync-failed"}, services.Destination{Service: "slack", Recipient: "channel-1"}))
+	f.deliveries.flush(t.Context())
+	require.NoError(t, notificationAPI.Send(app, []string{"app-sync-failed"}, services.Destination{Service: "slack", Recipient: "channel-2"}))
+
+	// the records which failed to be written are written with the next ones
+	client.ReactionChain = client.ReactionChain[1:]
+	records := getDeliveryRecords(t, f)
+	require.Len(t, records, 2)
+	assert.Equal(t, "channel-1", records[0].Recipient)
+	assert.Equal(t, "channel-2", records[1].Recipient)
+}
+
+func TestRestoreDeliveriesExpiresRetries(t *testing.T) {
+	f := newTestAPIFactory(t, map[string]string{
+		"delivery": "{}",
+	})
+	app := newDeliveryTestApp(t, f)
+	retrying := delivery.Record{ID: "1", Templates: []string{"app-sync-failed"}, Service: "slack", Status: delivery.StatusRetrying, Attempts: 1}
+	succeeded := delivery.Record{ID: "2", Templates: []string{"app-sync-failed"}, Service: "slack", Status: delivery.StatusSucceeded, Attempts: 1}
+	// the digest is no longer configured
+	digest := delivery.Record{ID: "3", Templates: []string{"digest:on-sync-failed"}, Service: "slack", Namespace: "default", Status: delivery.StatusPending}
+	value, err := json.Marshal([]delivery.Record{retrying, succeeded, digest})
+	require.NoError(t, err)
+	app["metadata"].(map[string]any)["annotations"] = map[string]any{delivery.AnnotationKey: string(value)}
+	_, err = f.deliveries.client.Resource(applications).Namespace("argocd").Update(t.Context(), &unstructured.Unstructured{Object: app}, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	f.restoreDeliveries([]map[string]any{app})
+	records := getDeliveryRecords(t, f)
+	require.Len(t, records, 3)
+	assert.Equal(t, delivery.StatusFailed, records[0].Status)
+	assert.Equal(t, "the notification was not retried since the controller restarted", records[0].Message)
+	assert.Equal(t, delivery.StatusSucceeded, records[1].Status)
+	assert.Equal(t, delivery.StatusFailed, records[2].Status)
+}
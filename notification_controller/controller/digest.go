@@ -0,0 +1,197 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/argoproj/notifications-engine/pkg/services"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/util/notification/delivery"
+)
+
+const (
+	// digestKeyPrefix is the prefix of the keys of the notifications ConfigMap holding the digest settings of a trigger
+	digestKeyPrefix = "digest."
+	// digestTemplatePrefix marks the condition results of the triggers whose notifications are aggregated in a digest.
+	// Template names come from ConfigMap keys, so they can't contain a colon.
+	digestTemplatePrefix = "digest:"
+	// defaultDigestInterval is the period the notifications of a trigger are aggregated over when none is configured
+	defaultDigestInterval = 10 * time.Minute
+	// digestCheckInterval is the period of the checks for the digests to send
+	digestCheckInterval = 10 * time.Second
+)
+
+// digestConfig holds the digest settings of a trigger
+type digestConfig struct {
+	// Interval is the period the notifications of the trigger are aggregated over before the digest is sent
+	Interval metav1.Duration `json:"interval,omitempty"`
+	// Template is the name of the template of the digest. The template gets the name of the trigger as {{.trigger}}
+	// and the applications which triggered a notification as {{.apps}}.
+	Template string `json:"template,omitempty"`
+}
+
+func (c digestConfig) interval() time.Duration {
+	if c.Interval.Duration <= 0 {
+		return defaultDigestInterval
+	}
+	return c.Interval.Duration
+}
+
+// parseDigestConfigs returns the digest settings of the triggers configured in the notifications ConfigMap
+func parseDigestConfigs(cm *corev1.ConfigMap) (map[string]digestConfig, error) {
+	configs := map[string]digestConfig{}
+	for k, v := range cm.Data {
+		if !strings.HasPrefix(k, digestKeyPrefix) {
+			continue
+		}
+		trigger := strings.TrimPrefix(k, digestKeyPrefix)
+		var config digestConfig
+		if err := yaml.Unmarshal([]byte(v), &config); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal digest of trigger %s: %w", trigger, err)
+		}
+		configs[trigger] = config
+	}
+	return configs, nil
+}
+
+type digestKey struct {
+	namespace string
+	trigger   string
+	dest      services.Destination
+}
+
+// digestBuffer holds the resources which triggered a notification since the last digest sent to a destination
+type digestBuffer struct {
+	key    digestKey
+	since  time.Time
+	config digestConfig
+	// delivery holds the delivery settings of the namespace if the delivery of the digest is tracked
+	delivery *deliveryConfig
+	// record is the delivery record of the digest, stored in the annotations of its resources if its delivery is
+	// tracked
+	record delivery.Record
+	// retryAt is the time of the next attempt to send the digest after it failed to be sent
+	retryAt  time.Time
+	keys     []string
+	entities map[string]map[string]any
+}
+
+func newDigestBuffer(key digestKey, config digestConfig, deliveryConfig *deliveryConfig, since time.Time) *digestBuffer {
+	record := newRecord([]string{digestTemplatePrefix + key.trigger}, key.dest)
+	record.Namespace = key.namespace
+	record.Status = delivery.StatusPending
+	record.Time = metav1.NewTime(since)
+	return &digestBuffer{key: key, since: since, config: config, delivery: deliveryConfig, record: record, entities: map[string]map[string]any{}}
+}
+
+func (b *digestBuffer) add(obj map[string]any) {
+	entityKey := digestEntityKey(obj)
+	if _, ok := b.entities[entityKey]; !ok {
+		b.keys = append(b.keys, entityKey)
+	}
+	b.entities[entityKey] = obj
+}
+
+// due returns whether the digest has to be sent
+func (b *digestBuffer) due(now time.Time) bool {
+	if !b.retryAt.IsZero() {
+		return !now.Before(b.retryAt)
+	}
+	return !now.Before(b.since.Add(b.config.interval()))
+}
+
+// digester holds the resources which triggered the notifications of the triggers configured with a digest until the
+// digests are sent
+type digester struct {
+	lock    sync.Mutex
+	buffers map[digestKey]*digestBuffer
+	// retries holds the digests which failed to be sent. The notifications triggered in the meantime are added to the
+	// next digests.
+	retries []*digestBuffer
+	now     func() time.Time
+}
+
+func newDigester() *digester {
+	return &digester{buffers: map[digestKey]*digestBuffer{}, now: time.Now}
+}
+
+// add adds the resource to the digest of the trigger sent to the destination, and returns the delivery record of the
+// digest
+func (d *digester) add(key digestKey, config digestConfig, deliveryConfig *deliveryConfig, obj map[string]any) delivery.Record {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	buffer, ok := d.buffers[key]
+	if !ok {
+		buffer = newDigestBuffer(key, config, deliveryConfig, d.now())
+		d.buffers[key] = buffer
+	}
+	buffer.add(obj)
+	return buffer.record
+}
+
+// takeDue removes and returns the digests whose interval has elapsed and the digests whose retry is due
+func (d *digester) takeDue() []*digestBuffer {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	var due []*digestBuffer
+	now := d.now()
+	for key, buffer := range d.buffers {
+		if buffer.due(now) {
+			due = append(due, buffer)
+			delete(d.buffers, key)
+		}
+	}
+	retries := d.retries[:0]
+	for _, buffer := range d.retries {
+		if buffer.due(now) {
+			due = append(due, buffer)
+		} else {
+			retries = append(retries, buffer)
+		}
+	}
+	d.retries = retries
+	return due
+}
+
+// requeue schedules the retry of the digest which failed to be sent
+func (d *digester) requeue(buffer *digestBuffer, retryAt time.Time) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	buffer.retryAt = retryAt
+	d.retries = append(d.retries, buffer)
+}
+
+// restore adds a digest which was pending before the controller restarted
+func (d *digester) restore(buffer *digestBuffer) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if _, ok := d.buffers[buffer.key]; ok || !buffer.retryAt.IsZero() {
+		if buffer.retryAt.IsZero() {
+			buffer.retryAt = buffer.since.Add(buffer.config.interval())
+		}
+		d.retries = append(d.retries, buffer)
+		return
+	}
+	d.buffers[buffer.key] = buffer
+}
+
+func defaultDigestMessage(trigger string, entityKeys []string) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d applications triggered %s:\n", len(entityKeys), trigger)
+	for _, entityKey := range entityKeys {
+		fmt.Fprintf(&sb, "- %s\n", entityKey)
+	}
+	return sb.String()
+}
+
+func digestEntityKey(obj map[string]any) string {
+	metadata, _ := obj["metadata"].(map[string]any)
+	name, _ := metadata["name"].(string)
+	namespace, _ := metadata["namespace"].(string)
+	return namespace + "/" + name
+}
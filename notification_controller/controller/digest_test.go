@@ -0,0 +1,233 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/argoproj/notifications-engine/pkg/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/util/notification/delivery"
+)
+
+func TestDigester(t *testing.T) {
+	f := newTestAPIFactory(t, map[string]string{
+		"trigger.on-sync-status-unknown": `
+- when: app.status.sync.status == 'Unknown'
+  send: [app-sync-status-unknown]`,
+		"trigger.on-sync-failed": `
+- when: app.status.sync.status == 'Failed'
+  send: [app-sync-failed]`,
+		"template.app-sync-status-unknown":        `message: "{{.app.metadata.name}} sync status is unknown"`,
+		"template.app-sync-failed":                `message: "{{.app.metadata.name}} sync failed"`,
+		"template.app-sync-status-unknown-digest": `message: "{{len .apps}} applications with an unknown sync status{{range .apps}} {{.metadata.name}}{{end}}"`,
+		"digest.on-sync-status-unknown": `
+interval: 5m
+template: app-sync-status-unknown-digest`,
+	})
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	f.digester.now = func() time.Time { return now }
+
+	notificationAPI, err := f.GetAPI()
+	require.NoError(t, err)
+	svc := &recordingService{}
+	notificationAPI.AddNotificationService("slack", svc)
+	dest := services.Destination{Service: "slack", Recipient: "my-channel"}
+
+	for _, app := range []map[string]any{newTestApp("guestbook", "Unknown"), newTestApp("helm-guestbook", "Unknown"), newTestApp("guestbook", "Unknown")} {
+		res, err := notificationAPI.RunTrigger("on-sync-status-unknown", app)
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, []string{"digest:on-sync-status-unknown"}, res[0].Templates)
+		require.NoError(t, notificationAPI.Send(app, res[0].Templates, dest))
+	}
+	assert.Empty(t, svc.notifications)
+
+	// the notifications of the triggers without digest are sent immediately
+	res, err := notificationAPI.RunTrigger("on-sync-failed", newTestApp("guestbook", "Failed"))
+	require.NoError(t, err)
+	require.NoError(t, notificationAPI.Send(newTestApp("guestbook", "Failed"), res[0].Templates, dest))
+	require.Len(t, svc.notifications, 1)
+	assert.Equal(t, "guestbook sync failed", svc.notifications[0].Message)
+
+	f.flushDigests()
+	assert.Len(t, svc.notifications, 1)
+
+	now = now.Add(5 * time.Minute)
+	f.flushDigests()
+	require.Len(t, svc.notifications, 2)
+	assert.Equal(t, "2 applications with an unknown sync status guestbook helm-guestbook", svc.notifications[1].Message)
+
+	now = now.Add(5 * time.Minute)
+	f.flushDigests()
+	assert.Len(t, svc.notifications, 2)
+}
+
+func TestDigesterDefaultMessage(t *testing.T) {
+	f := newTestAPIFactory(t, map[string]string{
+		"trigger.on-sync-status-unknown": `
+- when: app.status.sync.status == 'Unknown'
+  send: [app-sync-status-unknown]`,
+		"template.app-sync-status-unknown": `message: "{{.app.metadata.name}} sync status is unknown"`,
+		"digest.on-sync-status-unknown":    `{}`,
+	})
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	f.digester.now = func() time.Time { return now }
+
+	notificationAPI, err := f.GetAPI()
+	require.NoError(t, err)
+	svc := &recordingService{}
+	notificationAPI.AddNotificationService("slack", svc)
+
+	app := newTestApp("guestbook", "Unknown")
+	res, err := notificationAPI.RunTrigger("on-sync-status-unknown", app)
+	require.NoError(t, err)
+	require.NoError(t, notificationAPI.Send(app, res[0].Templates, services.Destination{Service: "slack", Recipient: "my-channel"}))
+
+	now = now.Add(defaultDigestInterval)
+	f.flushDigests()
+	require.Len(t, svc.notifications, 1)
+	assert.Equal(t, "1 applications triggered on-sync-status-unknown:\n- argocd/guestbook\n", svc.notifications[0].Message)
+}
+
+func TestParseDigestConfigs(t *testing.T) {
+	configs, err := parseDigestConfigs(&corev1.ConfigMap{Data: map[string]string{
+		"digest.on-sync-status-unknown":  "interval: 1h\ntemplate: my-digest",
+		"trigger.on-sync-status-unknown": "[]",
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]digestConfig{
+		"on-sync-status-unknown": {Interval: metav1.Duration{Duration: time.Hour}, Template: "my-digest"},
+	}, configs)
+
+	_, err = parseDigestConfigs(&corev1.ConfigMap{Data: map[string]string{"digest.on-sync-status-unknown": "interval: forever"}})
+	assert.ErrorContains(t, err, "failed to unmarshal digest of trigger on-sync-status-unknown")
+}
+
+func TestDigesterRetry(t *testing.T) {
+	f := newTestAPIFactory(t, map[string]string{
+		"digest.on-sync-status-unknown": `interval: 5m`,
+	})
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	f.digester.now = func() time.Time { return now }
+	notificationAPI, err := f.GetAPI()
+	require.NoError(t, err)
+	svc := &recordingService{err: errors.New("connection refused")}
+	notificationAPI.AddNotificationService("slack", svc)
+	dest := services.Destination{Service: "slack", Recipient: "my-channel"}
+	templates := []string{"digest:on-sync-status-unknown"}
+
+	require.NoError(t, notificationAPI.Send(newTestApp("guestbook", "Unknown"), templates, dest))
+	now = now.Add(5 * time.Minute)
+	f.flushDigests()
+	require.Len(t, svc.notifications, 1)
+
+	// the failed digest is retried after its interval, apart from the notifications triggered in the meantime
+	svc.err = nil
+	require.NoError(t, notificationAPI.Send(newTestApp("helm-guestbook", "Unknown"), templates, dest))
+	now = now.Add(5 * time.Minute)
+	f.flushDigests()
+	require.Len(t, svc.notifications, 3)
+	var messages []string
+	for _, notification := range svc.notifications[1:] {
+		messages = append(messages, notification.Message)
+	}
+	assert.ElementsMatch(t, []string{
+		"1 applications triggered on-sync-status-unknown:\n- argocd/guestbook\n",
+		"1 applications triggered on-sync-status-unknown:\n- argocd/helm-guestbook\n",
+	}, messages)
+
+	now = now.Add(5 * time.Minute)
+	f.flushDigests()
+	assert.Len(t, svc.notifications, 3)
+}
+
+func TestDigesterDeliveryTracking(t *testing.T) {
+	f := newTestAPIFactory(t, map[string]string{
+		"delivery":                      "retries: 1\nbackoff: 1m",
+		"digest.on-sync-status-unknown": `interval: 5m`,
+	})
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	f.digester.now = func() time.Time { return now }
+	f.deliveries.now = func() time.Time { return now }
+	notificationAPI, err := f.GetAPI()
+	require.NoError(t, err)
+	svc := &recordingService{err: errors.New("connection refused")}
+	notificationAPI.AddNotificationService("slack", svc)
+	app := newDeliveryTestApp(t, f)
+
+	require.NoError(t, notificationAPI.Send(app, []string{"digest:on-sync-status-unknown"}, services.Destination{Service: "slack", Recipient: "my-channel"}))
+	records := getDeliveryRecords(t, f)
+	require.Len(t, records, 1)
+	assert.Equal(t, delivery.StatusPending, records[0].Status)
+	assert.Equal(t, []string{"digest:on-sync-status-unknown"}, records[0].Templates)
+	assert.Equal(t, "default", records[0].Namespace)
+
+	now = now.Add(5 * time.Minute)
+	f.flushDigests()
+	records = getDeliveryRecords(t, f)
+	require.Len(t, records, 1)
+	assert.Equal(t, delivery.StatusRetrying, records[0].Status)
+	assert.Equal(t, 1, records[0].Attempts)
+
+	// the digest is retried after the backoff of the delivery settings
+	svc.err = nil
+	now = now.Add(time.Minute)
+	f.flushDigests()
+	require.Len(t, svc.notifications, 2)
+	records = getDeliveryRecords(t, f)
+	require.Len(t, records, 1)
+	assert.Equal(t, delivery.StatusSucceeded, records[0].Status)
+	assert.Equal(t, 2, records[0].Attempts)
+}
+
+func TestRestoreDigests(t *testing.T) {
+	f := newTestAPIFactory(t, map[string]string{
+		"delivery":                      "{}",
+		"digest.on-sync-status-unknown": `interval: 5m`,
+	})
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	f.digester.now = func() time.Time { return now }
+	notificationAPI, err := f.GetAPI()
+	require.NoError(t, err)
+	svc := &recordingService{}
+	notificationAPI.AddNotificationService("slack", svc)
+
+	newApp := func(name string, records ...delivery.Record) map[string]any {
+		value, err := json.Marshal(records)
+		require.NoError(t, err)
+		app := newTestApp(name, "Unknown")
+		app["metadata"].(map[string]any)["annotations"] = map[string]any{delivery.AnnotationKey: string(value)}
+		return app
+	}
+	pending := delivery.Record{
+		ID:        "1",
+		Templates: []string{"digest:on-sync-status-unknown"},
+		Service:   "slack",
+		Recipient: "my-channel",
+		Namespace: "default",
+		Status:    delivery.StatusPending,
+		Time:      metav1.NewTime(now.Add(-time.Minute)),
+	}
+	sent := pending
+	sent.ID, sent.Status = "2", delivery.StatusSucceeded
+	notDigest := pending
+	notDigest.ID, notDigest.Templates = "3", []string{"app-sync-status-unknown"}
+	f.restoreDeliveries([]map[string]any{
+		newApp("guestbook", pending, sent),
+		newApp("helm-guestbook", pending, notDigest),
+		newApp("kustomize-guestbook", sent),
+	})
+
+	f.flushDigests()
+	assert.Empty(t, svc.notifications)
+	now = now.Add(4 * time.Minute)
+	f.flushDigests()
+	require.Len(t, svc.notifications, 1)
+	assert.Equal(t, "2 applications triggered on-sync-status-unknown:\n- argocd/guestbook\n- argocd/helm-guestbook\n", svc.notifications[0].Message)
+}
@@ -719,6 +719,191 @@ func (m *ListAccountRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ListAccountRequest proto.InternalMessageInfo
 
+type ListExpiringTokensRequest struct {
+	// within is the duration the tokens expire within, e.g. 168h. Defaults to 168h
+	Within               string   `protobuf:"bytes,1,opt,name=within,proto3" json:"within,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListExpiringTokensRequest) Reset()         { *m = ListExpiringTokensRequest{} }
+func (m *ListExpiringTokensRequest) String() string { return proto.CompactTextString(m) }
+func (*ListExpiringTokensRequest) ProtoMessage()    {}
+func (*ListExpiringTokensRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56d089a9b5e998c0, []int{13}
+}
+func (m *ListExpiringTokensRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListExpiringTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListExpiringTokensRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListExpiringTokensRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListExpiringTokensRequest.Merge(m, src)
+}
+func (m *ListExpiringTokensRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListExpiringTokensRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListExpiringTokensRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListExpiringTokensRequest proto.InternalMessageInfo
+
+func (m *ListExpiringTokensRequest) GetWithin() string {
+	if m != nil {
+		return m.Within
+	}
+	return ""
+}
+
+type ExpiringToken struct {
+	// kind is the kind of owner of the token, either account or project
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// name is the name of the local account or of the project owning the token
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// role is the project role of the token
+	Role                 string   `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Id                   string   `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	IssuedAt             int64    `protobuf:"varint,5,opt,name=issuedAt,proto3" json:"issuedAt,omitempty"`
+	ExpiresAt            int64    `protobuf:"varint,6,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExpiringToken) Reset()         { *m = ExpiringToken{} }
+func (m *ExpiringToken) String() string { return proto.CompactTextString(m) }
+func (*ExpiringToken) ProtoMessage()    {}
+func (*ExpiringToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56d089a9b5e998c0, []int{14}
+}
+func (m *ExpiringToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExpiringToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExpiringToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExpiringToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpiringToken.Merge(m, src)
+}
+func (m *ExpiringToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExpiringToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpiringToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExpiringToken proto.InternalMessageInfo
+
+func (m *ExpiringToken) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *ExpiringToken) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ExpiringToken) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *ExpiringToken) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ExpiringToken) GetIssuedAt() int64 {
+	if m != nil {
+		return m.IssuedAt
+	}
+	return 0
+}
+
+func (m *ExpiringToken) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+type ExpiringTokensList struct {
+	Items                []*ExpiringToken `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ExpiringTokensList) Reset()         { *m = ExpiringTokensList{} }
+func (m *ExpiringTokensList) String() string { return proto.CompactTextString(m) }
+func (*ExpiringTokensList) ProtoMessage()    {}
+func (*ExpiringTokensList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56d089a9b5e998c0, []int{15}
+}
+func (m *ExpiringTokensList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExpiringTokensList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExpiringTokensList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExpiringTokensList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpiringTokensList.Merge(m, src)
+}
+func (m *ExpiringTokensList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExpiringTokensList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpiringTokensList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExpiringTokensList proto.InternalMessageInfo
+
+func (m *ExpiringTokensList) GetItems() []*ExpiringToken {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
 type EmptyResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -729,7 +914,7 @@ func (m *EmptyResponse) Reset()         { *m = EmptyResponse{} }
 func (m *EmptyResponse) String() string { return proto.CompactTextString(m) }
 func (*EmptyResponse) ProtoMessage()    {}
 func (*EmptyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56d089a9b5e998c0, []int{13}
+	return fileDescriptor_56d089a9b5e998c0, []int{16}
 }
 func (m *EmptyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -772,60 +957,70 @@ func init() {
 	proto.RegisterType((*CreateTokenResponse)(nil), "account.CreateTokenResponse")
 	proto.RegisterType((*DeleteTokenRequest)(nil), "account.DeleteTokenRequest")
 	proto.RegisterType((*ListAccountRequest)(nil), "account.ListAccountRequest")
+	proto.RegisterType((*ListExpiringTokensRequest)(nil), "account.ListExpiringTokensRequest")
+	proto.RegisterType((*ExpiringToken)(nil), "account.ExpiringToken")
+	proto.RegisterType((*ExpiringTokensList)(nil), "account.ExpiringTokensList")
 	proto.RegisterType((*EmptyResponse)(nil), "account.EmptyResponse")
 }
 
 func init() { proto.RegisterFile("server/account/account.proto", fileDescriptor_56d089a9b5e998c0) }
 
 var fileDescriptor_56d089a9b5e998c0 = []byte{
-	// 738 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcd, 0x6e, 0xd3, 0x4a,
-	0x14, 0x96, 0x93, 0xa6, 0x3f, 0x27, 0xb9, 0xe9, 0xed, 0xdc, 0x36, 0xd7, 0x32, 0x21, 0xa4, 0xd3,
-	0xaa, 0x0d, 0x41, 0xad, 0x45, 0x8b, 0x10, 0xaa, 0x60, 0xd1, 0x16, 0x84, 0x2a, 0xb1, 0x80, 0xf0,
-	0xb3, 0x28, 0xab, 0x89, 0x33, 0x0a, 0x43, 0x13, 0xdb, 0xf5, 0x8c, 0x13, 0x50, 0x94, 0x0d, 0x3c,
-	0x02, 0x5b, 0x1e, 0x88, 0x25, 0x12, 0x2f, 0x80, 0x2a, 0x1e, 0x04, 0x79, 0x3c, 0xe3, 0x38, 0x4e,
-	0x8a, 0x58, 0xd9, 0xe7, 0x67, 0xce, 0xf7, 0x9d, 0x6f, 0xce, 0xb1, 0xa1, 0xca, 0x69, 0x30, 0xa0,
-	0x81, 0x4d, 0x1c, 0xc7, 0x0b, 0x5d, 0xa1, 0x9f, 0xfb, 0x7e, 0xe0, 0x09, 0x0f, 0x2d, 0x29, 0xd3,
-	0xaa, 0x76, 0x3d, 0xaf, 0xdb, 0xa3, 0x36, 0xf1, 0x99, 0x4d, 0x5c, 0xd7, 0x13, 0x44, 0x30, 0xcf,
-	0xe5, 0x71, 0x1a, 0x1e, 0xc2, 0xc6, 0x6b, 0xbf, 0x43, 0x04, 0x7d, 0x4e, 0x38, 0x1f, 0x7a, 0x41,
-	0xa7, 0x45, 0x2f, 0x43, 0xca, 0x05, 0xaa, 0x43, 0xd1, 0xa5, 0x43, 0xed, 0x35, 0x8d, 0xba, 0xd1,
-	0x58, 0x69, 0xa5, 0x5d, 0xa8, 0x01, 0xab, 0x4e, 0x18, 0x04, 0xd4, 0x15, 0x49, 0x56, 0x4e, 0x66,
-	0x65, 0xdd, 0x08, 0xc1, 0x82, 0x4b, 0xfa, 0xd4, 0xcc, 0xcb, 0xb0, 0x7c, 0xc7, 0x26, 0x54, 0xb2,
-	0xc0, 0xdc, 0xf7, 0x5c, 0x4e, 0xb1, 0x03, 0xc5, 0x53, 0xe2, 0x9e, 0x69, 0x22, 0x16, 0x2c, 0x07,
-	0x94, 0x7b, 0x61, 0xe0, 0x50, 0xc5, 0x22, 0xb1, 0x51, 0x05, 0x16, 0x89, 0x13, 0xb5, 0xa3, 0x90,
-	0x95, 0x15, 0x91, 0xe7, 0x61, 0x3b, 0x39, 0x16, 0xe3, 0xa6, 0x5d, 0x78, 0x1b, 0x4a, 0x31, 0x48,
-	0x0c, 0x8a, 0xd6, 0xa1, 0x30, 0x20, 0xbd, 0x50, 0x43, 0xc4, 0x06, 0xde, 0x85, 0xb5, 0xa7, 0x54,
-	0x1c, 0xc7, 0x4a, 0x6a, 0x42, 0xba, 0x1b, 0x23, 0xd5, 0xcd, 0x67, 0x03, 0x96, 0x54, 0xda, 0xbc,
-	0x38, 0x32, 0x61, 0x89, 0xba, 0xa4, 0xdd, 0xa3, 0xb1, 0x46, 0xcb, 0x2d, 0x6d, 0x22, 0x0c, 0x25,
-	0x87, 0xf8, 0xa4, 0xcd, 0x7a, 0x4c, 0x30, 0xca, 0xcd, 0x7c, 0x3d, 0xdf, 0x58, 0x69, 0x4d, 0xf9,
-	0xd0, 0x0e, 0x2c, 0x0a, 0xef, 0x82, 0xba, 0xdc, 0x5c, 0xa8, 0xe7, 0x1b, 0xc5, 0x83, 0xf2, 0xbe,
-	0xbe, 0xeb, 0x57, 0x91, 0xbb, 0xa5, 0xa2, 0xf8, 0x3e, 0x94, 0x14, 0x09, 0xfe, 0x8c, 0x71, 0x81,
-	0x76, 0xa0, 0xc0, 0x04, 0xed, 0x73, 0xd3, 0x90, 0xc7, 0xfe, 0x4d, 0x8e, 0xe9, 0x8e, 0xe2, 0x30,
-	0x7e, 0x01, 0x05, 0x59, 0x08, 0x95, 0x21, 0xc7, 0xf4, 0x5d, 0xe7, 0x58, 0x27, 0xd2, 0x9e, 0x71,
-	0x1e, 0xd2, 0xce, 0xb1, 0x90, 0xbc, 0xf3, 0xad, 0xc4, 0x46, 0x55, 0x58, 0xa1, 0x1f, 0x7c, 0x16,
-	0x50, 0x7e, 0x2c, 0xa4, 0xc2, 0xf9, 0xd6, 0xc4, 0x81, 0x0f, 0x00, 0x64, 0xc9, 0x98, 0xc8, 0xf6,
-	0x34, 0x91, 0x2c, 0x7f, 0x45, 0xe3, 0x0d, 0xa0, 0xd3, 0x80, 0x12, 0x41, 0x63, 0xef, 0xf5, 0x72,
-	0xa7, 0xb0, 0xcf, 0x5c, 0x45, 0x6c, 0xe2, 0x50, 0x5d, 0xe4, 0x75, 0x17, 0xf8, 0x0e, 0xfc, 0x37,
-	0x55, 0x77, 0x72, 0xe5, 0x52, 0x37, 0x7d, 0xe5, 0xd2, 0xc0, 0x0f, 0x00, 0x3d, 0xa6, 0x3d, 0xfa,
-	0x17, 0x24, 0x62, 0x98, 0x5c, 0x02, 0xb3, 0x0e, 0x28, 0x6a, 0x76, 0x7a, 0x5a, 0xf0, 0x2a, 0xfc,
-	0xf3, 0xa4, 0xef, 0x8b, 0x8f, 0x1a, 0xf6, 0xe0, 0x6b, 0x01, 0xca, 0x2a, 0xe7, 0x25, 0x0d, 0x06,
-	0xcc, 0xa1, 0x68, 0x08, 0x0b, 0xd1, 0x30, 0xa2, 0xf5, 0x44, 0x97, 0xd4, 0x02, 0x58, 0x1b, 0x19,
-	0xaf, 0x5a, 0x93, 0x93, 0x4f, 0x3f, 0x7e, 0x7d, 0xc9, 0x3d, 0x44, 0x47, 0x72, 0xb3, 0x07, 0x77,
-	0x93, 0xef, 0x80, 0x43, 0xdc, 0x3d, 0x66, 0x8f, 0xf4, 0xa8, 0x8f, 0xed, 0x51, 0xbc, 0x15, 0x63,
-	0x7b, 0x94, 0xda, 0x80, 0x47, 0xcd, 0xe6, 0x18, 0x0d, 0xa0, 0x3c, 0xbd, 0x84, 0xa8, 0x96, 0x80,
-	0xcd, 0xfd, 0x2c, 0x58, 0xb7, 0xae, 0x8d, 0x2b, 0x5a, 0x5b, 0x92, 0xd6, 0x4d, 0xcb, 0xcc, 0xd2,
-	0xf2, 0x55, 0xe6, 0x91, 0xd1, 0x44, 0x6f, 0xa1, 0x94, 0x92, 0x8a, 0xa3, 0x1b, 0x49, 0xd5, 0x59,
-	0x05, 0x53, 0xfd, 0xa7, 0x87, 0x1b, 0xff, 0x2f, 0x81, 0xd6, 0xd0, 0x6a, 0x06, 0x08, 0x9d, 0x03,
-	0x4c, 0x96, 0x16, 0x59, 0xc9, 0xe9, 0x99, 0x4d, 0xb6, 0x66, 0x16, 0x02, 0xd7, 0x64, 0x51, 0x13,
-	0x55, 0xb2, 0xec, 0x47, 0xd1, 0x95, 0x8f, 0xd1, 0x25, 0x14, 0x53, 0xa3, 0x94, 0xe2, 0x3d, 0x3b,
-	0xb8, 0x56, 0x75, 0x7e, 0x50, 0xe9, 0xb4, 0x2b, 0x91, 0x36, 0x71, 0x75, 0x3e, 0x92, 0x2d, 0xa7,
-	0x31, 0xd2, 0xaa, 0x0f, 0xc5, 0xd4, 0x40, 0xa6, 0x20, 0x67, 0xc7, 0xd4, 0xaa, 0x24, 0xc1, 0xa9,
-	0x99, 0xc3, 0xb7, 0x25, 0xd8, 0x56, 0x73, 0xf3, 0x4f, 0x60, 0xf6, 0x88, 0x75, 0xc6, 0x27, 0x27,
-	0xdf, 0xae, 0x6a, 0xc6, 0xf7, 0xab, 0x9a, 0xf1, 0xf3, 0xaa, 0x66, 0x9c, 0xdf, 0xeb, 0x32, 0xf1,
-	0x2e, 0x6c, 0xef, 0x3b, 0x5e, 0xdf, 0x26, 0x41, 0xd7, 0xf3, 0x03, 0xef, 0xbd, 0x7c, 0xd9, 0x73,
-	0x3a, 0xf6, 0xe0, 0xd0, 0xf6, 0x2f, 0xba, 0x51, 0x49, 0xa7, 0xc7, 0xe8, 0xe4, 0x0f, 0xd4, 0x5e,
-	0x94, 0xff, 0x96, 0xc3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x18, 0x8a, 0x2b, 0x33, 0xa2, 0x06,
-	0x00, 0x00,
+	// 851 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xd6, 0xda, 0x8e, 0xd3, 0x1c, 0xa7, 0x09, 0x3d, 0xa4, 0x66, 0xd9, 0x1a, 0xd7, 0x9d, 0x56,
+	0xad, 0x31, 0x34, 0x2b, 0x12, 0x84, 0x50, 0x05, 0x17, 0x71, 0xa9, 0x50, 0x25, 0x2e, 0xc0, 0xfc,
+	0x5c, 0x94, 0xab, 0xf1, 0x7a, 0xe4, 0x0e, 0xb1, 0x77, 0xb7, 0x3b, 0xb3, 0x36, 0xc8, 0x32, 0x17,
+	0xf0, 0x08, 0x88, 0x3b, 0x1e, 0x88, 0x4b, 0x24, 0x5e, 0x00, 0x45, 0x3c, 0x08, 0xda, 0xd9, 0x99,
+	0xf5, 0xec, 0xda, 0x8d, 0xb8, 0xca, 0x9e, 0x33, 0x33, 0xe7, 0xfb, 0xce, 0xdf, 0x17, 0x43, 0x47,
+	0xb0, 0x64, 0xc1, 0x12, 0x9f, 0x06, 0x41, 0x94, 0x86, 0xd2, 0xfc, 0x3d, 0x8d, 0x93, 0x48, 0x46,
+	0xb8, 0xaf, 0x4d, 0xaf, 0x33, 0x8d, 0xa2, 0xe9, 0x8c, 0xf9, 0x34, 0xe6, 0x3e, 0x0d, 0xc3, 0x48,
+	0x52, 0xc9, 0xa3, 0x50, 0xe4, 0xd7, 0xc8, 0x12, 0x6e, 0x7f, 0x1b, 0x4f, 0xa8, 0x64, 0x5f, 0x52,
+	0x21, 0x96, 0x51, 0x32, 0x19, 0xb1, 0x57, 0x29, 0x13, 0x12, 0x7b, 0xd0, 0x0a, 0xd9, 0xd2, 0x78,
+	0x5d, 0xa7, 0xe7, 0xf4, 0x0f, 0x46, 0xb6, 0x0b, 0xfb, 0x70, 0x1c, 0xa4, 0x49, 0xc2, 0x42, 0x59,
+	0xdc, 0xaa, 0xa9, 0x5b, 0x55, 0x37, 0x22, 0x34, 0x42, 0x3a, 0x67, 0x6e, 0x5d, 0x1d, 0xab, 0x6f,
+	0xe2, 0x42, 0xbb, 0x0a, 0x2c, 0xe2, 0x28, 0x14, 0x8c, 0x04, 0xd0, 0x7a, 0x4a, 0xc3, 0xe7, 0x86,
+	0x88, 0x07, 0x37, 0x12, 0x26, 0xa2, 0x34, 0x09, 0x98, 0x66, 0x51, 0xd8, 0xd8, 0x86, 0x26, 0x0d,
+	0xb2, 0x74, 0x34, 0xb2, 0xb6, 0x32, 0xf2, 0x22, 0x1d, 0x17, 0xcf, 0x72, 0x5c, 0xdb, 0x45, 0x1e,
+	0xc0, 0x61, 0x0e, 0x92, 0x83, 0xe2, 0x09, 0xec, 0x2d, 0xe8, 0x2c, 0x35, 0x10, 0xb9, 0x41, 0x1e,
+	0xc1, 0xad, 0xcf, 0x99, 0xbc, 0xc8, 0x2b, 0x69, 0x08, 0x99, 0x6c, 0x1c, 0x2b, 0x9b, 0x5f, 0x1d,
+	0xd8, 0xd7, 0xd7, 0x76, 0x9d, 0xa3, 0x0b, 0xfb, 0x2c, 0xa4, 0xe3, 0x19, 0xcb, 0x6b, 0x74, 0x63,
+	0x64, 0x4c, 0x24, 0x70, 0x18, 0xd0, 0x98, 0x8e, 0xf9, 0x8c, 0x4b, 0xce, 0x84, 0x5b, 0xef, 0xd5,
+	0xfb, 0x07, 0xa3, 0x92, 0x0f, 0x1f, 0x42, 0x53, 0x46, 0x97, 0x2c, 0x14, 0x6e, 0xa3, 0x57, 0xef,
+	0xb7, 0xce, 0x8e, 0x4e, 0x4d, 0xaf, 0xbf, 0xc9, 0xdc, 0x23, 0x7d, 0x4a, 0x3e, 0x82, 0x43, 0x4d,
+	0x42, 0x7c, 0xc1, 0x85, 0xc4, 0x87, 0xb0, 0xc7, 0x25, 0x9b, 0x0b, 0xd7, 0x51, 0xcf, 0xde, 0x28,
+	0x9e, 0x99, 0x8c, 0xf2, 0x63, 0xf2, 0x15, 0xec, 0xa9, 0x40, 0x78, 0x04, 0x35, 0x6e, 0x7a, 0x5d,
+	0xe3, 0x93, 0xac, 0xf6, 0x5c, 0x88, 0x94, 0x4d, 0x2e, 0xa4, 0xe2, 0x5d, 0x1f, 0x15, 0x36, 0x76,
+	0xe0, 0x80, 0xfd, 0x18, 0xf3, 0x84, 0x89, 0x0b, 0xa9, 0x2a, 0x5c, 0x1f, 0x6d, 0x1c, 0xe4, 0x0c,
+	0x40, 0x85, 0xcc, 0x89, 0x3c, 0x28, 0x13, 0xa9, 0xf2, 0xd7, 0x34, 0xbe, 0x03, 0x7c, 0x9a, 0x30,
+	0x2a, 0x59, 0xee, 0x7d, 0x7d, 0xb9, 0x2d, 0xec, 0xe7, 0xa1, 0x26, 0xb6, 0x71, 0xe8, 0x2c, 0xea,
+	0x26, 0x0b, 0xf2, 0x1e, 0xbc, 0x59, 0x8a, 0xbb, 0x69, 0xb9, 0xaa, 0x9b, 0x69, 0xb9, 0x32, 0xc8,
+	0xc7, 0x80, 0x9f, 0xb1, 0x19, 0xfb, 0x1f, 0x24, 0x72, 0x98, 0x5a, 0x01, 0x73, 0x02, 0x98, 0x25,
+	0x5b, 0x9e, 0x16, 0x72, 0x0e, 0x6f, 0x67, 0xde, 0x67, 0x19, 0x3b, 0x1e, 0x4e, 0xf3, 0xa2, 0x98,
+	0xb0, 0x6d, 0x68, 0x2e, 0xb9, 0x7c, 0xc9, 0x0d, 0x07, 0x6d, 0x91, 0xdf, 0x1d, 0xb8, 0x59, 0x7a,
+	0x91, 0x11, 0xb8, 0xe4, 0xa1, 0xe9, 0x8d, 0xfa, 0x2e, 0x48, 0xd5, 0x2c, 0x52, 0x08, 0x8d, 0x24,
+	0x9a, 0x15, 0xab, 0x96, 0x7d, 0x6b, 0xa2, 0x8d, 0x9d, 0x5d, 0xdd, 0xbb, 0xae, 0xab, 0xcd, 0x6a,
+	0x57, 0x87, 0x80, 0xe5, 0x44, 0x54, 0x77, 0xdf, 0x2f, 0x77, 0xb7, 0x5d, 0x74, 0xb7, 0x74, 0xd7,
+	0x74, 0xf9, 0x18, 0x6e, 0x3e, 0x9b, 0xc7, 0xf2, 0x27, 0xd3, 0x87, 0xb3, 0x3f, 0x9a, 0x70, 0xa4,
+	0x8b, 0xf6, 0x35, 0x4b, 0x16, 0x3c, 0x60, 0xb8, 0x84, 0x46, 0xb6, 0x9d, 0x78, 0x52, 0x84, 0xb2,
+	0x14, 0xc1, 0xbb, 0x5d, 0xf1, 0x6a, 0xdd, 0x18, 0xfe, 0xf2, 0xf7, 0xbf, 0xbf, 0xd5, 0x3e, 0xc1,
+	0x27, 0x4a, 0xea, 0x16, 0x1f, 0x14, 0xc2, 0x18, 0xd0, 0xf0, 0x31, 0xf7, 0x57, 0x66, 0xf7, 0xd7,
+	0xfe, 0x2a, 0x97, 0x89, 0xb5, 0xbf, 0xb2, 0x24, 0xe1, 0xd3, 0xc1, 0x60, 0x8d, 0x0b, 0x38, 0x2a,
+	0xab, 0x12, 0x76, 0x0b, 0xb0, 0x9d, 0x3a, 0xe9, 0xdd, 0x7d, 0xed, 0xb9, 0xa6, 0x75, 0x5f, 0xd1,
+	0x7a, 0xc7, 0x73, 0xab, 0xb4, 0x62, 0x7d, 0xf3, 0x89, 0x33, 0xc0, 0xef, 0xe1, 0xd0, 0x9a, 0x1d,
+	0x81, 0x77, 0x8a, 0xa8, 0xdb, 0x23, 0x65, 0xe5, 0x6f, 0x6f, 0x3b, 0x79, 0x4b, 0x01, 0xdd, 0xc2,
+	0xe3, 0x0a, 0x10, 0xbe, 0x00, 0xd8, 0xa8, 0x18, 0x7a, 0xc5, 0xeb, 0x2d, 0x69, 0xf3, 0xb6, 0x14,
+	0x82, 0x74, 0x55, 0x50, 0x17, 0xdb, 0x55, 0xf6, 0xab, 0x6c, 0xdc, 0xd6, 0xf8, 0x0a, 0x5a, 0xd6,
+	0x6e, 0x59, 0xbc, 0xb7, 0x37, 0xd9, 0xeb, 0xec, 0x3e, 0xd4, 0x75, 0x7a, 0xa4, 0x90, 0xee, 0x91,
+	0xce, 0x6e, 0x24, 0x5f, 0xad, 0x67, 0x56, 0xab, 0x9f, 0xf3, 0x3d, 0x2b, 0x0f, 0x22, 0x92, 0x52,
+	0xc5, 0x76, 0xae, 0x9b, 0x77, 0x67, 0xf7, 0x64, 0xe6, 0xe5, 0xd3, 0xf8, 0x78, 0xb7, 0x8a, 0xaf,
+	0x80, 0x85, 0xcf, 0xf4, 0x13, 0x9c, 0x43, 0xcb, 0x52, 0x08, 0x2b, 0xe5, 0x6d, 0xdd, 0xf0, 0xac,
+	0x5d, 0xb0, 0x67, 0x9e, 0xbc, 0xab, 0xc0, 0xee, 0x0f, 0xee, 0x5d, 0x97, 0xac, 0xbf, 0xe2, 0x93,
+	0xf5, 0x70, 0xf8, 0xe7, 0x55, 0xd7, 0xf9, 0xeb, 0xaa, 0xeb, 0xfc, 0x73, 0xd5, 0x75, 0x5e, 0x7c,
+	0x38, 0xe5, 0xf2, 0x65, 0x3a, 0x3e, 0x0d, 0xa2, 0xb9, 0x4f, 0x93, 0x69, 0x14, 0x27, 0xd1, 0x0f,
+	0xea, 0xe3, 0x71, 0x30, 0xf1, 0x17, 0xe7, 0x7e, 0x7c, 0x39, 0xcd, 0x42, 0x06, 0x33, 0xce, 0x36,
+	0x3f, 0x09, 0xc6, 0x4d, 0xf5, 0xcf, 0xfe, 0xfc, 0xbf, 0x01, 0x00, 0x72, 0x40, 0x48, 0x7b, 0x33,
+	0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -850,6 +1045,8 @@ type AccountServiceClient interface {
 	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*Account, error)
 	// CreateToken creates a token
 	CreateToken(ctx context.Context, in *CreateTokenRequest, opts ...grpc.CallOption) (*CreateTokenResponse, error)
+	// ListExpiringTokens returns the tokens of the local accounts and of the project roles expiring soon
+	ListExpiringTokens(ctx context.Context, in *ListExpiringTokensRequest, opts ...grpc.CallOption) (*ExpiringTokensList, error)
 	// DeleteToken deletes a token
 	DeleteToken(ctx context.Context, in *DeleteTokenRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 }
@@ -907,6 +1104,15 @@ func (c *accountServiceClient) CreateToken(ctx context.Context, in *CreateTokenR
 	return out, nil
 }
 
+func (c *accountServiceClient) ListExpiringTokens(ctx context.Context, in *ListExpiringTokensRequest, opts ...grpc.CallOption) (*ExpiringTokensList, error) {
+	out := new(ExpiringTokensList)
+	err := c.cc.Invoke(ctx, "/account.AccountService/ListExpiringTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *accountServiceClient) DeleteToken(ctx context.Context, in *DeleteTokenRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
 	out := new(EmptyResponse)
 	err := c.cc.Invoke(ctx, "/account.AccountService/DeleteToken", in, out, opts...)
@@ -928,6 +1134,8 @@ type AccountServiceServer interface {
 	GetAccount(context.Context, *GetAccountRequest) (*Account, error)
 	// CreateToken creates a token
 	CreateToken(context.Context, *CreateTokenRequest) (*CreateTokenResponse, error)
+	// ListExpiringTokens returns the tokens of the local accounts and of the project roles expiring soon
+	ListExpiringTokens(context.Context, *ListExpiringTokensRequest) (*ExpiringTokensList, error)
 	// DeleteToken deletes a token
 	DeleteToken(context.Context, *DeleteTokenRequest) (*EmptyResponse, error)
 }
@@ -951,6 +1159,9 @@ func (*UnimplementedAccountServiceServer) GetAccount(ctx context.Context, req *G
 func (*UnimplementedAccountServiceServer) CreateToken(ctx context.Context, req *CreateTokenRequest) (*CreateTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateToken not implemented")
 }
+func (*UnimplementedAccountServiceServer) ListExpiringTokens(ctx context.Context, req *ListExpiringTokensRequest) (*ExpiringTokensList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExpiringTokens not implemented")
+}
 func (*UnimplementedAccountServiceServer) DeleteToken(ctx context.Context, req *DeleteTokenRequest) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteToken not implemented")
 }
@@ -1049,6 +1260,24 @@ func _AccountService_CreateToken_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountService_ListExpiringTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExpiringTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ListExpiringTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.AccountService/ListExpiringTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ListExpiringTokens(ctx, req.(*ListExpiringTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AccountService_DeleteToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteTokenRequest)
 	if err := dec(in); err != nil {
@@ -1091,6 +1320,10 @@ var _AccountService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateToken",
 			Handler:    _AccountService_CreateToken_Handler,
 		},
+		{
+			MethodName: "ListExpiringTokens",
+			Handler:    _AccountService_ListExpiringTokens_Handler,
+		},
 		{
 			MethodName: "DeleteToken",
 			Handler:    _AccountService_DeleteToken_Handler,
@@ -1632,6 +1865,146 @@ func (m *ListAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ListExpiringTokensRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListExpiringTokensRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListExpiringTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Within) > 0 {
+		i -= len(m.Within)
+		copy(dAtA[i:], m.Within)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Within)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExpiringToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExpiringToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExpiringToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintAccount(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.IssuedAt != 0 {
+		i = encodeVarintAccount(dAtA, i, uint64(m.IssuedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExpiringTokensList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExpiringTokensList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExpiringTokensList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1922,13 +2295,81 @@ func (m *ListAccountRequest) Size() (n int) {
 	return n
 }
 
-func (m *EmptyResponse) Size() (n int) {
+func (m *ListExpiringTokensRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.XXX_unrecognized != nil {
+	l = len(m.Within)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExpiringToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if m.IssuedAt != 0 {
+		n += 1 + sovAccount(uint64(m.IssuedAt))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovAccount(uint64(m.ExpiresAt))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExpiringTokensList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EmptyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
@@ -3294,6 +3735,391 @@ func (m *ListAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ListExpiringTokensRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListExpiringTokensRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListExpiringTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Within", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Within = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExpiringToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpiringToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpiringToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			}
+			m.IssuedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExpiringTokensList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpiringTokensList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpiringTokensList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &ExpiringToken{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EmptyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
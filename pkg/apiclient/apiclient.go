@@ -411,7 +411,7 @@ func (c *client) refreshAuthToken(localCfg *localconfig.LocalConfig, ctxName, co
 	}
 
 	log.Debug("Auth token no longer valid. Refreshing")
-	rawIDToken, refreshToken, err := c.redeemRefreshToken()
+	rawIDToken, refreshToken, err := c.redeemRefreshToken(claims.Issuer)
 	if err != nil {
 		return err
 	}
@@ -429,8 +429,9 @@ func (c *client) refreshAuthToken(localCfg *localconfig.LocalConfig, ctxName, co
 	return nil
 }
 
-// redeemRefreshToken performs the exchange of a refresh_token for a new id_token and refresh_token
-func (c *client) redeemRefreshToken() (string, string, error) {
+// redeemRefreshToken performs the exchange of a refresh_token for a new id_token and refresh_token with the provider of
+// the given issuer
+func (c *client) redeemRefreshToken(issuer string) (string, string, error) {
 	setConn, setIf, err := c.NewSettingsClient()
 	if err != nil {
 		return "", "", err
@@ -445,6 +446,12 @@ func (c *client) redeemRefreshToken() (string, string, error) {
 	if err != nil {
 		return "", "", err
 	}
+	for _, config := range acdSet.AdditionalOIDCConfigs {
+		if config.Issuer == issuer {
+			acdSet.OIDCConfig = config
+			break
+		}
+	}
 	oauth2conf, _, err := c.OIDCConfig(ctx, acdSet)
 	if err != nil {
 		return "", "", err
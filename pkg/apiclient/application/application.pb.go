@@ -57,7 +57,9 @@ type ApplicationQuery struct {
 	// the application's namespace
 	AppNamespace *string `protobuf:"bytes,7,opt,name=appNamespace" json:"appNamespace,omitempty"`
 	// the project names to restrict returned list applications (legacy name for backwards-compatibility)
-	Project              []string `protobuf:"bytes,8,rep,name=project" json:"project,omitempty"`
+	Project []string `protobuf:"bytes,8,rep,name=project" json:"project,omitempty"`
+	// scopes the requested refresh to these resources, in the GROUP:KIND:NAME or GROUP:KIND:NAMESPACE/NAME format
+	RefreshResources     []string `protobuf:"bytes,9,rep,name=refreshResources" json:"refreshResources,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -152,6 +154,13 @@ func (m *ApplicationQuery) GetProject() []string {
 	return nil
 }
 
+func (m *ApplicationQuery) GetRefreshResources() []string {
+	if m != nil {
+		return m.RefreshResources
+	}
+	return nil
+}
+
 type NodeQuery struct {
 	// the application's name
 	Name                 *string  `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
@@ -390,12 +399,22 @@ func (m *ApplicationResourceEventsQuery) GetProject() string {
 
 // ManifestQuery is a query for manifest resources
 type ApplicationManifestQuery struct {
-	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Revision             *string  `protobuf:"bytes,2,opt,name=revision" json:"revision,omitempty"`
-	AppNamespace         *string  `protobuf:"bytes,3,opt,name=appNamespace" json:"appNamespace,omitempty"`
-	Project              *string  `protobuf:"bytes,4,opt,name=project" json:"project,omitempty"`
-	SourcePositions      []int64  `protobuf:"varint,5,rep,name=sourcePositions" json:"sourcePositions,omitempty"`
-	Revisions            []string `protobuf:"bytes,6,rep,name=revisions" json:"revisions,omitempty"`
+	Name            *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Revision        *string  `protobuf:"bytes,2,opt,name=revision" json:"revision,omitempty"`
+	AppNamespace    *string  `protobuf:"bytes,3,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project         *string  `protobuf:"bytes,4,opt,name=project" json:"project,omitempty"`
+	SourcePositions []int64  `protobuf:"varint,5,rep,name=sourcePositions" json:"sourcePositions,omitempty"`
+	Revisions       []string `protobuf:"bytes,6,rep,name=revisions" json:"revisions,omitempty"`
+	// group, kind, namespace and resourceName only return the manifests of the matching resources
+	Group        *string `protobuf:"bytes,7,opt,name=group" json:"group,omitempty"`
+	Kind         *string `protobuf:"bytes,8,opt,name=kind" json:"kind,omitempty"`
+	Namespace    *string `protobuf:"bytes,9,opt,name=namespace" json:"namespace,omitempty"`
+	ResourceName *string `protobuf:"bytes,10,opt,name=resourceName" json:"resourceName,omitempty"`
+	// fields only returns the given fields of the manifests, as dot-separated paths, in addition to apiVersion and kind
+	Fields []string `protobuf:"bytes,11,rep,name=fields" json:"fields,omitempty"`
+	// offset and limit only return a page of the manifests, all manifests after the offset if limit is zero
+	Offset               *int64   `protobuf:"varint,12,opt,name=offset" json:"offset,omitempty"`
+	Limit                *int64   `protobuf:"varint,13,opt,name=limit" json:"limit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -476,6 +495,55 @@ func (m *ApplicationManifestQuery) GetRevisions() []string {
 	return nil
 }
 
+func (m *ApplicationManifestQuery) GetGroup() string {
+	if m != nil && m.Group != nil {
+		return *m.Group
+	}
+	return ""
+}
+
+func (m *ApplicationManifestQuery) GetKind() string {
+	if m != nil && m.Kind != nil {
+		return *m.Kind
+	}
+	return ""
+}
+
+func (m *ApplicationManifestQuery) GetNamespace() string {
+	if m != nil && m.Namespace != nil {
+		return *m.Namespace
+	}
+	return ""
+}
+
+func (m *ApplicationManifestQuery) GetResourceName() string {
+	if m != nil && m.ResourceName != nil {
+		return *m.ResourceName
+	}
+	return ""
+}
+
+func (m *ApplicationManifestQuery) GetFields() []string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *ApplicationManifestQuery) GetOffset() int64 {
+	if m != nil && m.Offset != nil {
+		return *m.Offset
+	}
+	return 0
+}
+
+func (m *ApplicationManifestQuery) GetLimit() int64 {
+	if m != nil && m.Limit != nil {
+		return *m.Limit
+	}
+	return 0
+}
+
 type FileChunk struct {
 	Chunk                []byte   `protobuf:"bytes,1,req,name=chunk" json:"chunk,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -993,6 +1061,8 @@ type ApplicationSyncRequest struct {
 	Project              *string                           `protobuf:"bytes,13,opt,name=project" json:"project,omitempty"`
 	SourcePositions      []int64                           `protobuf:"varint,14,rep,name=sourcePositions" json:"sourcePositions,omitempty"`
 	Revisions            []string                          `protobuf:"bytes,15,rep,name=revisions" json:"revisions,omitempty"`
+	Resume               *bool                             `protobuf:"varint,16,opt,name=resume" json:"resume,omitempty"`
+	ForceConflictsFor    []string                          `protobuf:"bytes,17,rep,name=forceConflictsFor" json:"forceConflictsFor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
 	XXX_unrecognized     []byte                            `json:"-"`
 	XXX_sizecache        int32                             `json:"-"`
@@ -1129,6 +1199,20 @@ func (m *ApplicationSyncRequest) GetRevisions() []string {
 	return nil
 }
 
+func (m *ApplicationSyncRequest) GetResume() bool {
+	if m != nil && m.Resume != nil {
+		return *m.Resume
+	}
+	return false
+}
+
+func (m *ApplicationSyncRequest) GetForceConflictsFor() []string {
+	if m != nil {
+		return m.ForceConflictsFor
+	}
+	return nil
+}
+
 // ApplicationUpdateSpecRequest is a request to update application spec
 type ApplicationUpdateSpecRequest struct {
 	Name                 *string                   `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
@@ -1290,12 +1374,14 @@ func (m *ApplicationPatchRequest) GetProject() string {
 }
 
 type ApplicationRollbackRequest struct {
-	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Id                   *int64   `protobuf:"varint,2,req,name=id" json:"id,omitempty"`
-	DryRun               *bool    `protobuf:"varint,3,opt,name=dryRun" json:"dryRun,omitempty"`
-	Prune                *bool    `protobuf:"varint,4,opt,name=prune" json:"prune,omitempty"`
-	AppNamespace         *string  `protobuf:"bytes,6,opt,name=appNamespace" json:"appNamespace,omitempty"`
-	Project              *string  `protobuf:"bytes,7,opt,name=project" json:"project,omitempty"`
+	Name         *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Id           *int64  `protobuf:"varint,2,req,name=id" json:"id,omitempty"`
+	DryRun       *bool   `protobuf:"varint,3,opt,name=dryRun" json:"dryRun,omitempty"`
+	Prune        *bool   `protobuf:"varint,4,opt,name=prune" json:"prune,omitempty"`
+	AppNamespace *string `protobuf:"bytes,6,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project      *string `protobuf:"bytes,7,opt,name=project" json:"project,omitempty"`
+	// the revision (Git) or chart version (Helm) to roll back to, the id is ignored if set
+	Revision             *string  `protobuf:"bytes,8,opt,name=revision" json:"revision,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1376,6 +1462,13 @@ func (m *ApplicationRollbackRequest) GetProject() string {
 	return ""
 }
 
+func (m *ApplicationRollbackRequest) GetRevision() string {
+	if m != nil && m.Revision != nil {
+		return *m.Revision
+	}
+	return ""
+}
+
 type ApplicationResourceRequest struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
 	Namespace            *string  `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
@@ -2177,6 +2270,188 @@ func (m *LogEntry) GetPodName() string {
 	return ""
 }
 
+// ApplicationApproveSyncRequest is a request to approve the automated sync of an application to a revision
+type ApplicationApproveSyncRequest struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Revision             *string  `protobuf:"bytes,2,req,name=revision" json:"revision,omitempty"`
+	AppNamespace         *string  `protobuf:"bytes,3,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project              *string  `protobuf:"bytes,4,opt,name=project" json:"project,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationApproveSyncRequest) Reset()         { *m = ApplicationApproveSyncRequest{} }
+func (m *ApplicationApproveSyncRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationApproveSyncRequest) ProtoMessage()    {}
+func (*ApplicationApproveSyncRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{25}
+}
+func (m *ApplicationApproveSyncRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationApproveSyncRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationApproveSyncRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationApproveSyncRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationApproveSyncRequest.Merge(m, src)
+}
+func (m *ApplicationApproveSyncRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationApproveSyncRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationApproveSyncRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationApproveSyncRequest proto.InternalMessageInfo
+
+func (m *ApplicationApproveSyncRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationApproveSyncRequest) GetRevision() string {
+	if m != nil && m.Revision != nil {
+		return *m.Revision
+	}
+	return ""
+}
+
+func (m *ApplicationApproveSyncRequest) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
+func (m *ApplicationApproveSyncRequest) GetProject() string {
+	if m != nil && m.Project != nil {
+		return *m.Project
+	}
+	return ""
+}
+
+type ApplicationDriftHistoryQuery struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	AppNamespace         *string  `protobuf:"bytes,2,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project              *string  `protobuf:"bytes,3,opt,name=project" json:"project,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationDriftHistoryQuery) Reset()         { *m = ApplicationDriftHistoryQuery{} }
+func (m *ApplicationDriftHistoryQuery) String() string { return proto.CompactTextString(m) }
+func (*ApplicationDriftHistoryQuery) ProtoMessage()    {}
+func (*ApplicationDriftHistoryQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{26}
+}
+func (m *ApplicationDriftHistoryQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationDriftHistoryQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationDriftHistoryQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationDriftHistoryQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDriftHistoryQuery.Merge(m, src)
+}
+func (m *ApplicationDriftHistoryQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationDriftHistoryQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDriftHistoryQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationDriftHistoryQuery proto.InternalMessageInfo
+
+func (m *ApplicationDriftHistoryQuery) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationDriftHistoryQuery) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
+func (m *ApplicationDriftHistoryQuery) GetProject() string {
+	if m != nil && m.Project != nil {
+		return *m.Project
+	}
+	return ""
+}
+
+type ApplicationDriftHistoryResponse struct {
+	Items                []*v1alpha1.DriftEvent `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ApplicationDriftHistoryResponse) Reset()         { *m = ApplicationDriftHistoryResponse{} }
+func (m *ApplicationDriftHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplicationDriftHistoryResponse) ProtoMessage()    {}
+func (*ApplicationDriftHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{27}
+}
+func (m *ApplicationDriftHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationDriftHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationDriftHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationDriftHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDriftHistoryResponse.Merge(m, src)
+}
+func (m *ApplicationDriftHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationDriftHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDriftHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationDriftHistoryResponse proto.InternalMessageInfo
+
+func (m *ApplicationDriftHistoryResponse) GetItems() []*v1alpha1.DriftEvent {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
 type OperationTerminateRequest struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
 	AppNamespace         *string  `protobuf:"bytes,2,opt,name=appNamespace" json:"appNamespace,omitempty"`
@@ -2190,7 +2465,7 @@ func (m *OperationTerminateRequest) Reset()         { *m = OperationTerminateReq
 func (m *OperationTerminateRequest) String() string { return proto.CompactTextString(m) }
 func (*OperationTerminateRequest) ProtoMessage()    {}
 func (*OperationTerminateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{25}
+	return fileDescriptor_df6e82b174b5eaec, []int{28}
 }
 func (m *OperationTerminateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2253,7 +2528,7 @@ func (m *ApplicationSyncWindowsQuery) Reset()         { *m = ApplicationSyncWind
 func (m *ApplicationSyncWindowsQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindowsQuery) ProtoMessage()    {}
 func (*ApplicationSyncWindowsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{26}
+	return fileDescriptor_df6e82b174b5eaec, []int{29}
 }
 func (m *ApplicationSyncWindowsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2316,7 +2591,7 @@ func (m *ApplicationSyncWindowsResponse) Reset()         { *m = ApplicationSyncW
 func (m *ApplicationSyncWindowsResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindowsResponse) ProtoMessage()    {}
 func (*ApplicationSyncWindowsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{27}
+	return fileDescriptor_df6e82b174b5eaec, []int{30}
 }
 func (m *ApplicationSyncWindowsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2380,7 +2655,7 @@ func (m *ApplicationSyncWindow) Reset()         { *m = ApplicationSyncWindow{} }
 func (m *ApplicationSyncWindow) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindow) ProtoMessage()    {}
 func (*ApplicationSyncWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{28}
+	return fileDescriptor_df6e82b174b5eaec, []int{31}
 }
 func (m *ApplicationSyncWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2447,7 +2722,7 @@ func (m *OperationTerminateResponse) Reset()         { *m = OperationTerminateRe
 func (m *OperationTerminateResponse) String() string { return proto.CompactTextString(m) }
 func (*OperationTerminateResponse) ProtoMessage()    {}
 func (*OperationTerminateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{29}
+	return fileDescriptor_df6e82b174b5eaec, []int{32}
 }
 func (m *OperationTerminateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2494,7 +2769,7 @@ func (m *ResourcesQuery) Reset()         { *m = ResourcesQuery{} }
 func (m *ResourcesQuery) String() string { return proto.CompactTextString(m) }
 func (*ResourcesQuery) ProtoMessage()    {}
 func (*ResourcesQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{30}
+	return fileDescriptor_df6e82b174b5eaec, []int{33}
 }
 func (m *ResourcesQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2590,7 +2865,7 @@ func (m *ManagedResourcesResponse) Reset()         { *m = ManagedResourcesRespon
 func (m *ManagedResourcesResponse) String() string { return proto.CompactTextString(m) }
 func (*ManagedResourcesResponse) ProtoMessage()    {}
 func (*ManagedResourcesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{31}
+	return fileDescriptor_df6e82b174b5eaec, []int{34}
 }
 func (m *ManagedResourcesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2626,28 +2901,29 @@ func (m *ManagedResourcesResponse) GetItems() []*v1alpha1.ResourceDiff {
 	return nil
 }
 
-type LinkInfo struct {
-	Title                *string  `protobuf:"bytes,1,req,name=title" json:"title,omitempty"`
-	Url                  *string  `protobuf:"bytes,2,req,name=url" json:"url,omitempty"`
-	Description          *string  `protobuf:"bytes,3,opt,name=description" json:"description,omitempty"`
-	IconClass            *string  `protobuf:"bytes,4,opt,name=iconClass" json:"iconClass,omitempty"`
+type ApplicationServerSideDiffQuery struct {
+	AppName      *string `protobuf:"bytes,1,req,name=appName" json:"appName,omitempty"`
+	AppNamespace *string `protobuf:"bytes,2,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project      *string `protobuf:"bytes,3,opt,name=project" json:"project,omitempty"`
+	// TargetManifests are the manifests to compare with the live state, the target state of the application is used if empty
+	TargetManifests      []string `protobuf:"bytes,4,rep,name=targetManifests" json:"targetManifests,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LinkInfo) Reset()         { *m = LinkInfo{} }
-func (m *LinkInfo) String() string { return proto.CompactTextString(m) }
-func (*LinkInfo) ProtoMessage()    {}
-func (*LinkInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{32}
+func (m *ApplicationServerSideDiffQuery) Reset()         { *m = ApplicationServerSideDiffQuery{} }
+func (m *ApplicationServerSideDiffQuery) String() string { return proto.CompactTextString(m) }
+func (*ApplicationServerSideDiffQuery) ProtoMessage()    {}
+func (*ApplicationServerSideDiffQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{35}
 }
-func (m *LinkInfo) XXX_Unmarshal(b []byte) error {
+func (m *ApplicationServerSideDiffQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LinkInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApplicationServerSideDiffQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LinkInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApplicationServerSideDiffQuery.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2657,65 +2933,191 @@ func (m *LinkInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *LinkInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LinkInfo.Merge(m, src)
+func (m *ApplicationServerSideDiffQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationServerSideDiffQuery.Merge(m, src)
 }
-func (m *LinkInfo) XXX_Size() int {
+func (m *ApplicationServerSideDiffQuery) XXX_Size() int {
 	return m.Size()
 }
-func (m *LinkInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_LinkInfo.DiscardUnknown(m)
+func (m *ApplicationServerSideDiffQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationServerSideDiffQuery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LinkInfo proto.InternalMessageInfo
+var xxx_messageInfo_ApplicationServerSideDiffQuery proto.InternalMessageInfo
 
-func (m *LinkInfo) GetTitle() string {
-	if m != nil && m.Title != nil {
-		return *m.Title
+func (m *ApplicationServerSideDiffQuery) GetAppName() string {
+	if m != nil && m.AppName != nil {
+		return *m.AppName
 	}
 	return ""
 }
 
-func (m *LinkInfo) GetUrl() string {
-	if m != nil && m.Url != nil {
-		return *m.Url
+func (m *ApplicationServerSideDiffQuery) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
 	}
 	return ""
 }
 
-func (m *LinkInfo) GetDescription() string {
-	if m != nil && m.Description != nil {
-		return *m.Description
+func (m *ApplicationServerSideDiffQuery) GetProject() string {
+	if m != nil && m.Project != nil {
+		return *m.Project
 	}
 	return ""
 }
 
-func (m *LinkInfo) GetIconClass() string {
-	if m != nil && m.IconClass != nil {
-		return *m.IconClass
+func (m *ApplicationServerSideDiffQuery) GetTargetManifests() []string {
+	if m != nil {
+		return m.TargetManifests
 	}
-	return ""
+	return nil
 }
 
-type LinksResponse struct {
-	Items                []*LinkInfo `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type ApplicationServerSideDiffResponse struct {
+	Items                []*v1alpha1.ResourceDiff `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
+	Modified             *bool                    `protobuf:"varint,2,req,name=modified" json:"modified,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *LinksResponse) Reset()         { *m = LinksResponse{} }
-func (m *LinksResponse) String() string { return proto.CompactTextString(m) }
-func (*LinksResponse) ProtoMessage()    {}
-func (*LinksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{33}
+func (m *ApplicationServerSideDiffResponse) Reset()         { *m = ApplicationServerSideDiffResponse{} }
+func (m *ApplicationServerSideDiffResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplicationServerSideDiffResponse) ProtoMessage()    {}
+func (*ApplicationServerSideDiffResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{36}
 }
-func (m *LinksResponse) XXX_Unmarshal(b []byte) error {
+func (m *ApplicationServerSideDiffResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LinksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApplicationServerSideDiffResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LinksResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApplicationServerSideDiffResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationServerSideDiffResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationServerSideDiffResponse.Merge(m, src)
+}
+func (m *ApplicationServerSideDiffResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationServerSideDiffResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationServerSideDiffResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationServerSideDiffResponse proto.InternalMessageInfo
+
+func (m *ApplicationServerSideDiffResponse) GetItems() []*v1alpha1.ResourceDiff {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *ApplicationServerSideDiffResponse) GetModified() bool {
+	if m != nil && m.Modified != nil {
+		return *m.Modified
+	}
+	return false
+}
+
+type LinkInfo struct {
+	Title                *string  `protobuf:"bytes,1,req,name=title" json:"title,omitempty"`
+	Url                  *string  `protobuf:"bytes,2,req,name=url" json:"url,omitempty"`
+	Description          *string  `protobuf:"bytes,3,opt,name=description" json:"description,omitempty"`
+	IconClass            *string  `protobuf:"bytes,4,opt,name=iconClass" json:"iconClass,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LinkInfo) Reset()         { *m = LinkInfo{} }
+func (m *LinkInfo) String() string { return proto.CompactTextString(m) }
+func (*LinkInfo) ProtoMessage()    {}
+func (*LinkInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{37}
+}
+func (m *LinkInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LinkInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LinkInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LinkInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinkInfo.Merge(m, src)
+}
+func (m *LinkInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *LinkInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinkInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LinkInfo proto.InternalMessageInfo
+
+func (m *LinkInfo) GetTitle() string {
+	if m != nil && m.Title != nil {
+		return *m.Title
+	}
+	return ""
+}
+
+func (m *LinkInfo) GetUrl() string {
+	if m != nil && m.Url != nil {
+		return *m.Url
+	}
+	return ""
+}
+
+func (m *LinkInfo) GetDescription() string {
+	if m != nil && m.Description != nil {
+		return *m.Description
+	}
+	return ""
+}
+
+func (m *LinkInfo) GetIconClass() string {
+	if m != nil && m.IconClass != nil {
+		return *m.IconClass
+	}
+	return ""
+}
+
+type LinksResponse struct {
+	Items                []*LinkInfo `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *LinksResponse) Reset()         { *m = LinksResponse{} }
+func (m *LinksResponse) String() string { return proto.CompactTextString(m) }
+func (*LinksResponse) ProtoMessage()    {}
+func (*LinksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{38}
+}
+func (m *LinksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LinksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LinksResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2757,7 +3159,7 @@ func (m *ListAppLinksRequest) Reset()         { *m = ListAppLinksRequest{} }
 func (m *ListAppLinksRequest) String() string { return proto.CompactTextString(m) }
 func (*ListAppLinksRequest) ProtoMessage()    {}
 func (*ListAppLinksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{34}
+	return fileDescriptor_df6e82b174b5eaec, []int{39}
 }
 func (m *ListAppLinksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2833,6 +3235,9 @@ func init() {
 	proto.RegisterType((*ApplicationResourceResponse)(nil), "application.ApplicationResourceResponse")
 	proto.RegisterType((*ApplicationPodLogsQuery)(nil), "application.ApplicationPodLogsQuery")
 	proto.RegisterType((*LogEntry)(nil), "application.LogEntry")
+	proto.RegisterType((*ApplicationApproveSyncRequest)(nil), "application.ApplicationApproveSyncRequest")
+	proto.RegisterType((*ApplicationDriftHistoryQuery)(nil), "application.ApplicationDriftHistoryQuery")
+	proto.RegisterType((*ApplicationDriftHistoryResponse)(nil), "application.ApplicationDriftHistoryResponse")
 	proto.RegisterType((*OperationTerminateRequest)(nil), "application.OperationTerminateRequest")
 	proto.RegisterType((*ApplicationSyncWindowsQuery)(nil), "application.ApplicationSyncWindowsQuery")
 	proto.RegisterType((*ApplicationSyncWindowsResponse)(nil), "application.ApplicationSyncWindowsResponse")
@@ -2840,6 +3245,8 @@ func init() {
 	proto.RegisterType((*OperationTerminateResponse)(nil), "application.OperationTerminateResponse")
 	proto.RegisterType((*ResourcesQuery)(nil), "application.ResourcesQuery")
 	proto.RegisterType((*ManagedResourcesResponse)(nil), "application.ManagedResourcesResponse")
+	proto.RegisterType((*ApplicationServerSideDiffQuery)(nil), "application.ApplicationServerSideDiffQuery")
+	proto.RegisterType((*ApplicationServerSideDiffResponse)(nil), "application.ApplicationServerSideDiffResponse")
 	proto.RegisterType((*LinkInfo)(nil), "application.LinkInfo")
 	proto.RegisterType((*LinksResponse)(nil), "application.LinksResponse")
 	proto.RegisterType((*ListAppLinksRequest)(nil), "application.ListAppLinksRequest")
@@ -2850,180 +3257,203 @@ func init() {
 }
 
 var fileDescriptor_df6e82b174b5eaec = []byte{
-	// 2759 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4d, 0x8c, 0x1b, 0x49,
-	0xf5, 0xff, 0x97, 0x3d, 0x9e, 0xf1, 0x3c, 0xcf, 0x64, 0x92, 0xda, 0x64, 0xfe, 0xbd, 0xce, 0x6c,
-	0xf0, 0x76, 0x92, 0x8d, 0x77, 0x92, 0xb1, 0x93, 0x49, 0x40, 0xd9, 0xd9, 0x5d, 0x41, 0x32, 0xf9,
-	0x84, 0x49, 0x36, 0xf4, 0x24, 0x04, 0x2d, 0x07, 0xa8, 0xed, 0xae, 0xb1, 0x9b, 0xb1, 0xbb, 0x3b,
-	0xdd, 0x6d, 0x87, 0x51, 0xc8, 0x65, 0x11, 0x17, 0xb4, 0x02, 0x01, 0x7b, 0x40, 0x08, 0x01, 0xda,
-	0xd5, 0x4a, 0x08, 0x81, 0xb8, 0x20, 0x84, 0x84, 0x90, 0xe0, 0x00, 0x82, 0x03, 0xd2, 0x0a, 0x8e,
-	0x5c, 0x50, 0x84, 0x38, 0xc2, 0x65, 0xcf, 0x08, 0x55, 0x75, 0x55, 0x77, 0xb5, 0x3f, 0xda, 0x1e,
-	0x6c, 0xb4, 0xb9, 0xf5, 0x2b, 0x57, 0xbd, 0xf7, 0x7b, 0xaf, 0x5e, 0xbd, 0xf7, 0xea, 0x95, 0xe1,
-	0x44, 0x40, 0xfd, 0x2e, 0xf5, 0xeb, 0xc4, 0xf3, 0x5a, 0xb6, 0x49, 0x42, 0xdb, 0x75, 0xd4, 0xef,
-	0x9a, 0xe7, 0xbb, 0xa1, 0x8b, 0x4b, 0xca, 0x50, 0x79, 0xa5, 0xe1, 0xba, 0x8d, 0x16, 0xad, 0x13,
-	0xcf, 0xae, 0x13, 0xc7, 0x71, 0x43, 0x3e, 0x1c, 0x44, 0x53, 0xcb, 0xfa, 0xee, 0xc5, 0xa0, 0x66,
-	0xbb, 0xfc, 0x57, 0xd3, 0xf5, 0x69, 0xbd, 0x7b, 0xae, 0xde, 0xa0, 0x0e, 0xf5, 0x49, 0x48, 0x2d,
-	0x31, 0xe7, 0x42, 0x32, 0xa7, 0x4d, 0xcc, 0xa6, 0xed, 0x50, 0x7f, 0xaf, 0xee, 0xed, 0x36, 0xd8,
-	0x40, 0x50, 0x6f, 0xd3, 0x90, 0x0c, 0x5a, 0xb5, 0xd5, 0xb0, 0xc3, 0x66, 0xe7, 0x8d, 0x9a, 0xe9,
-	0xb6, 0xeb, 0xc4, 0x6f, 0xb8, 0x9e, 0xef, 0x7e, 0x91, 0x7f, 0xac, 0x99, 0x56, 0xbd, 0x7b, 0x3e,
-	0x61, 0xa0, 0xea, 0xd2, 0x3d, 0x47, 0x5a, 0x5e, 0x93, 0xf4, 0x73, 0xbb, 0x3a, 0x82, 0x9b, 0x4f,
-	0x3d, 0x57, 0xd8, 0x86, 0x7f, 0xda, 0xa1, 0xeb, 0xef, 0x29, 0x9f, 0x11, 0x1b, 0xfd, 0x03, 0x04,
-	0x07, 0x2f, 0x25, 0xf2, 0x3e, 0xdd, 0xa1, 0xfe, 0x1e, 0xc6, 0x30, 0xe3, 0x90, 0x36, 0xd5, 0x50,
-	0x05, 0x55, 0xe7, 0x0d, 0xfe, 0x8d, 0x35, 0x98, 0xf3, 0xe9, 0x8e, 0x4f, 0x83, 0xa6, 0x96, 0xe3,
-	0xc3, 0x92, 0xc4, 0x65, 0x28, 0x32, 0xe1, 0xd4, 0x0c, 0x03, 0x2d, 0x5f, 0xc9, 0x57, 0xe7, 0x8d,
-	0x98, 0xc6, 0x55, 0x58, 0xf2, 0x69, 0xe0, 0x76, 0x7c, 0x93, 0x7e, 0x86, 0xfa, 0x81, 0xed, 0x3a,
-	0xda, 0x0c, 0x5f, 0xdd, 0x3b, 0xcc, 0xb8, 0x04, 0xb4, 0x45, 0xcd, 0xd0, 0xf5, 0xb5, 0x02, 0x9f,
-	0x12, 0xd3, 0x0c, 0x0f, 0x03, 0xae, 0xcd, 0x46, 0x78, 0xd8, 0x37, 0xd6, 0x61, 0x81, 0x78, 0xde,
-	0x6d, 0xd2, 0xa6, 0x81, 0x47, 0x4c, 0xaa, 0xcd, 0xf1, 0xdf, 0x52, 0x63, 0x0c, 0xb3, 0x40, 0xa2,
-	0x15, 0x39, 0x30, 0x49, 0xea, 0x9b, 0x30, 0x7f, 0xdb, 0xb5, 0xe8, 0x70, 0x75, 0x7b, 0xd9, 0xe7,
-	0xfa, 0xd9, 0xeb, 0xbf, 0x43, 0x70, 0xc4, 0xa0, 0x5d, 0x9b, 0xe1, 0xbf, 0x45, 0x43, 0x62, 0x91,
-	0x90, 0xf4, 0x72, 0xcc, 0xc5, 0x1c, 0xcb, 0x50, 0xf4, 0xc5, 0x64, 0x2d, 0xc7, 0xc7, 0x63, 0xba,
-	0x4f, 0x5a, 0x3e, 0x5b, 0x99, 0xc8, 0x84, 0x92, 0xc4, 0x15, 0x28, 0x45, 0xb6, 0xbc, 0xe9, 0x58,
-	0xf4, 0x4b, 0xdc, 0x7a, 0x05, 0x43, 0x1d, 0xc2, 0x2b, 0x30, 0xdf, 0x8d, 0xec, 0x7c, 0xd3, 0xe2,
-	0x56, 0x2c, 0x18, 0xc9, 0x80, 0xfe, 0x0f, 0x04, 0xc7, 0x14, 0x1f, 0x30, 0xc4, 0xce, 0x5c, 0xed,
-	0x52, 0x27, 0x0c, 0x86, 0x2b, 0x74, 0x06, 0x0e, 0xc9, 0x4d, 0xec, 0xb5, 0x53, 0xff, 0x0f, 0x4c,
-	0x45, 0x75, 0x50, 0xaa, 0xa8, 0x8e, 0x31, 0x45, 0x24, 0x7d, 0xef, 0xe6, 0x15, 0xa1, 0xa6, 0x3a,
-	0xd4, 0x67, 0xa8, 0x42, 0xb6, 0xa1, 0x66, 0x53, 0x86, 0xd2, 0xdf, 0x47, 0xa0, 0x29, 0x8a, 0xde,
-	0x22, 0x8e, 0xbd, 0x43, 0x83, 0x70, 0xdc, 0x3d, 0x43, 0x53, 0xdc, 0xb3, 0x2a, 0x2c, 0x45, 0x5a,
-	0xdd, 0x61, 0xe7, 0x91, 0xc5, 0x1f, 0xad, 0x50, 0xc9, 0x57, 0xf3, 0x46, 0xef, 0x30, 0xdb, 0x3b,
-	0x29, 0x33, 0xd0, 0x66, 0xb9, 0x1b, 0x27, 0x03, 0xfa, 0xf3, 0x30, 0x7f, 0xcd, 0x6e, 0xd1, 0xcd,
-	0x66, 0xc7, 0xd9, 0xc5, 0x87, 0xa1, 0x60, 0xb2, 0x0f, 0xae, 0xc3, 0x82, 0x11, 0x11, 0xfa, 0x37,
-	0x11, 0x3c, 0x3f, 0x4c, 0xeb, 0xfb, 0x76, 0xd8, 0x64, 0xeb, 0x83, 0x61, 0xea, 0x9b, 0x4d, 0x6a,
-	0xee, 0x06, 0x9d, 0xb6, 0x74, 0x59, 0x49, 0x4f, 0xa6, 0xbe, 0xfe, 0x63, 0x04, 0xd5, 0x91, 0x98,
-	0xee, 0xfb, 0xc4, 0xf3, 0xa8, 0x8f, 0xaf, 0x41, 0xe1, 0x01, 0xfb, 0x81, 0x1f, 0xd0, 0xd2, 0x7a,
-	0xad, 0xa6, 0x06, 0xf8, 0x91, 0x5c, 0x6e, 0xfc, 0x9f, 0x11, 0x2d, 0xc7, 0x35, 0x69, 0x9e, 0x1c,
-	0xe7, 0xb3, 0x9c, 0xe2, 0x13, 0x5b, 0x91, 0xcd, 0xe7, 0xd3, 0x2e, 0xcf, 0xc2, 0x8c, 0x47, 0xfc,
-	0x50, 0x3f, 0x02, 0xcf, 0xa4, 0x8f, 0x87, 0xe7, 0x3a, 0x01, 0xd5, 0x7f, 0x95, 0xf6, 0xa6, 0x4d,
-	0x9f, 0x92, 0x90, 0x1a, 0xf4, 0x41, 0x87, 0x06, 0x21, 0xde, 0x05, 0x35, 0xe7, 0x70, 0xab, 0x96,
-	0xd6, 0x6f, 0xd6, 0x92, 0xa0, 0x5d, 0x93, 0x41, 0x9b, 0x7f, 0x7c, 0xde, 0xb4, 0x6a, 0xdd, 0xf3,
-	0x35, 0x6f, 0xb7, 0x51, 0x63, 0x29, 0x20, 0x85, 0x4c, 0xa6, 0x00, 0x55, 0x55, 0x43, 0xe5, 0x8e,
-	0x97, 0x61, 0xb6, 0xe3, 0x05, 0xd4, 0x0f, 0xb9, 0x66, 0x45, 0x43, 0x50, 0x6c, 0xff, 0xba, 0xa4,
-	0x65, 0x5b, 0x24, 0x8c, 0xf6, 0xa7, 0x68, 0xc4, 0xb4, 0xfe, 0xeb, 0x34, 0xfa, 0x7b, 0x9e, 0xf5,
-	0x61, 0xa1, 0x57, 0x51, 0xe6, 0xd2, 0x28, 0x55, 0x0f, 0xca, 0xa7, 0x3d, 0xe8, 0xe7, 0x69, 0xfc,
-	0x57, 0x68, 0x8b, 0x26, 0xf8, 0x07, 0x39, 0xb3, 0x06, 0x73, 0x26, 0x09, 0x4c, 0x62, 0x49, 0x29,
-	0x92, 0x64, 0x81, 0xcc, 0xf3, 0x5d, 0x8f, 0x34, 0x38, 0xa7, 0x3b, 0x6e, 0xcb, 0x36, 0xf7, 0x84,
-	0xb8, 0xfe, 0x1f, 0xfa, 0x1c, 0x7f, 0x26, 0xdb, 0xf1, 0x0b, 0x69, 0xd8, 0xc7, 0xa1, 0xb4, 0xbd,
-	0xe7, 0x98, 0xaf, 0x79, 0xd1, 0xe1, 0x3e, 0x0c, 0x05, 0x3b, 0xa4, 0xed, 0x40, 0x43, 0xfc, 0x60,
-	0x47, 0x84, 0xfe, 0xef, 0x02, 0x2c, 0x2b, 0xba, 0xb1, 0x05, 0x59, 0x9a, 0x65, 0x45, 0xa9, 0x65,
-	0x98, 0xb5, 0xfc, 0x3d, 0xa3, 0xe3, 0x08, 0x07, 0x10, 0x14, 0x13, 0xec, 0xf9, 0x1d, 0x27, 0x82,
-	0x5f, 0x34, 0x22, 0x02, 0xef, 0x40, 0x31, 0x08, 0x59, 0x95, 0xd1, 0xd8, 0xe3, 0xc0, 0x4b, 0xeb,
-	0x9f, 0x9c, 0x6c, 0xd3, 0x19, 0xf4, 0x6d, 0xc1, 0xd1, 0x88, 0x79, 0xe3, 0x07, 0x2c, 0xa6, 0x45,
-	0x81, 0x2e, 0xd0, 0xe6, 0x2a, 0xf9, 0x6a, 0x69, 0x7d, 0x7b, 0x72, 0x41, 0xaf, 0x79, 0xac, 0x42,
-	0x52, 0x32, 0x98, 0x91, 0x48, 0x61, 0x61, 0xb4, 0x2d, 0xe2, 0x43, 0x20, 0xaa, 0x81, 0x64, 0x00,
-	0x7f, 0x16, 0x0a, 0xb6, 0xb3, 0xe3, 0x06, 0xda, 0x3c, 0x07, 0x73, 0x79, 0x32, 0x30, 0x37, 0x9d,
-	0x1d, 0xd7, 0x88, 0x18, 0xe2, 0x07, 0xb0, 0xe8, 0xd3, 0xd0, 0xdf, 0x93, 0x56, 0xd0, 0x80, 0xdb,
-	0xf5, 0x53, 0x93, 0x49, 0x30, 0x54, 0x96, 0x46, 0x5a, 0x02, 0xde, 0x80, 0x52, 0x90, 0xf8, 0x98,
-	0x56, 0xe2, 0x02, 0xb5, 0x14, 0x23, 0xc5, 0x07, 0x0d, 0x75, 0x72, 0x9f, 0x77, 0x2f, 0x64, 0x7b,
-	0xf7, 0xe2, 0xc8, 0xac, 0x76, 0x60, 0x8c, 0xac, 0xb6, 0xd4, 0x9b, 0xd5, 0xfe, 0x85, 0x60, 0xa5,
-	0x2f, 0x38, 0x6d, 0x7b, 0x34, 0xf3, 0x18, 0x10, 0x98, 0x09, 0x3c, 0x6a, 0xf2, 0x4c, 0x55, 0x5a,
-	0xbf, 0x35, 0xb5, 0x68, 0xc5, 0xe5, 0x72, 0xd6, 0x59, 0x01, 0x75, 0xc2, 0xb8, 0xf0, 0x03, 0x04,
-	0xff, 0xaf, 0xc8, 0xbc, 0x43, 0x42, 0xb3, 0x99, 0xa5, 0x2c, 0x3b, 0xbf, 0x6c, 0x8e, 0xc8, 0xcb,
-	0x11, 0xc1, 0xac, 0xca, 0x3f, 0xee, 0xee, 0x79, 0x0c, 0x20, 0xfb, 0x25, 0x19, 0x98, 0xb0, 0x78,
-	0xfa, 0x09, 0x82, 0xb2, 0x1a, 0xc3, 0xdd, 0x56, 0xeb, 0x0d, 0x62, 0xee, 0x66, 0x81, 0x3c, 0x00,
-	0x39, 0xdb, 0xe2, 0x08, 0xf3, 0x46, 0xce, 0xb6, 0xf6, 0x19, 0x8c, 0x7a, 0xe1, 0xce, 0x66, 0xc3,
-	0x9d, 0x4b, 0xc3, 0xfd, 0xa0, 0x07, 0xae, 0x0c, 0x09, 0x19, 0x70, 0x57, 0x60, 0xde, 0xe9, 0x29,
-	0x64, 0x93, 0x81, 0x01, 0x05, 0x6c, 0xae, 0xaf, 0x80, 0xd5, 0x60, 0xae, 0x1b, 0x5f, 0x73, 0xd8,
-	0xcf, 0x92, 0x64, 0x2a, 0x36, 0x7c, 0xb7, 0xe3, 0x09, 0xa3, 0x47, 0x04, 0x43, 0xb1, 0x6b, 0x3b,
-	0xac, 0x24, 0xe7, 0x28, 0xd8, 0xf7, 0xfe, 0x2f, 0x36, 0x29, 0xb5, 0x7f, 0x9a, 0x83, 0x8f, 0x0c,
-	0x50, 0x7b, 0xa4, 0x3f, 0x3d, 0x1d, 0xba, 0xc7, 0x5e, 0x3d, 0x37, 0xd4, 0xab, 0x8b, 0xa3, 0xbc,
-	0x7a, 0x3e, 0xdb, 0x5e, 0x90, 0xb6, 0xd7, 0x8f, 0x72, 0x50, 0x19, 0x60, 0xaf, 0xd1, 0xe5, 0xc4,
-	0x53, 0x63, 0xb0, 0x1d, 0xd7, 0x17, 0x5e, 0x52, 0x34, 0x22, 0x82, 0x9d, 0x33, 0xd7, 0xf7, 0x9a,
-	0xc4, 0xe1, 0xde, 0x51, 0x34, 0x04, 0x35, 0xa1, 0xa9, 0xbe, 0x96, 0x03, 0x4d, 0xda, 0xe7, 0x92,
-	0xc9, 0xad, 0xd5, 0x71, 0x9e, 0x7e, 0x13, 0x2d, 0xc3, 0x2c, 0xe1, 0x68, 0x85, 0x53, 0x09, 0xaa,
-	0xcf, 0x18, 0xc5, 0x6c, 0x63, 0xcc, 0xa7, 0x8d, 0xf1, 0x55, 0x04, 0x47, 0xd3, 0xc6, 0x08, 0xb6,
-	0xec, 0x20, 0x94, 0x97, 0x03, 0xbc, 0x03, 0x73, 0x91, 0x9c, 0xa8, 0xb4, 0x2b, 0xad, 0x6f, 0x4d,
-	0x9a, 0xf0, 0x53, 0x86, 0x97, 0xcc, 0xf5, 0x97, 0xe0, 0xe8, 0xc0, 0x28, 0x27, 0x60, 0x94, 0xa1,
-	0x28, 0x8b, 0x1c, 0xb1, 0x35, 0x31, 0xad, 0xbf, 0x3b, 0x93, 0x4e, 0x39, 0xae, 0xb5, 0xe5, 0x36,
-	0x32, 0xee, 0xfb, 0xd9, 0xdb, 0xc9, 0x4c, 0xe5, 0x5a, 0xca, 0xd5, 0x5e, 0x92, 0x6c, 0x9d, 0xe9,
-	0x3a, 0x21, 0xb1, 0x1d, 0xea, 0x8b, 0xac, 0x98, 0x0c, 0xb0, 0x6d, 0x08, 0x6c, 0xc7, 0xa4, 0xdb,
-	0xd4, 0x74, 0x1d, 0x2b, 0xe0, 0xfb, 0x99, 0x37, 0x52, 0x63, 0xf8, 0x06, 0xcc, 0x73, 0xfa, 0xae,
-	0xdd, 0x8e, 0xd2, 0x40, 0x69, 0x7d, 0xb5, 0x16, 0xf5, 0xe0, 0x6a, 0x6a, 0x0f, 0x2e, 0xb1, 0x61,
-	0x9b, 0x86, 0xa4, 0xd6, 0x3d, 0x57, 0x63, 0x2b, 0x8c, 0x64, 0x31, 0xc3, 0x12, 0x12, 0xbb, 0xb5,
-	0x65, 0x3b, 0xbc, 0xf0, 0x64, 0xa2, 0x92, 0x01, 0xe6, 0x2a, 0x3b, 0x6e, 0xab, 0xe5, 0x3e, 0x94,
-	0xe7, 0x26, 0xa2, 0xd8, 0xaa, 0x8e, 0x13, 0xda, 0x2d, 0x2e, 0x3f, 0x72, 0x84, 0x64, 0x80, 0xaf,
-	0xb2, 0x5b, 0x21, 0xf5, 0xc5, 0x81, 0x11, 0x54, 0xec, 0x8c, 0xa5, 0xa8, 0xad, 0x24, 0xcf, 0x6b,
-	0xe4, 0xb6, 0x0b, 0xaa, 0xdb, 0xf6, 0x1e, 0x85, 0xc5, 0x01, 0xbd, 0x11, 0xde, 0x65, 0xa3, 0x5d,
-	0xdb, 0xed, 0xb0, 0x9a, 0x8a, 0x97, 0x1e, 0x92, 0xee, 0x73, 0xe5, 0xa5, 0x6c, 0x57, 0x3e, 0x98,
-	0x2e, 0xda, 0x78, 0x65, 0x1c, 0x9a, 0xcd, 0x4d, 0x12, 0x50, 0xed, 0x10, 0x67, 0x9d, 0x0c, 0xe8,
-	0xbf, 0x41, 0x50, 0xdc, 0x72, 0x1b, 0x57, 0x9d, 0xd0, 0xdf, 0xe3, 0x77, 0x28, 0xd7, 0x09, 0xa9,
-	0x23, 0xbd, 0x49, 0x92, 0x6c, 0x8b, 0x42, 0xbb, 0x4d, 0xb7, 0x43, 0xd2, 0xf6, 0x44, 0x05, 0xb6,
-	0xaf, 0x2d, 0x8a, 0x17, 0x33, 0xb3, 0xb5, 0x48, 0x10, 0xf2, 0x78, 0x50, 0x34, 0xf8, 0x37, 0x53,
-	0x30, 0x9e, 0xb0, 0x1d, 0xfa, 0x22, 0x18, 0xa4, 0xc6, 0x54, 0x07, 0x2c, 0x44, 0xd8, 0x04, 0xa9,
-	0xb7, 0xe1, 0xd9, 0xf8, 0x6a, 0x70, 0x97, 0xfa, 0x6d, 0xdb, 0x21, 0xd9, 0xb1, 0x7d, 0x8c, 0xe6,
-	0x5f, 0xc6, 0xcd, 0xd4, 0x4d, 0x1d, 0x49, 0x56, 0x69, 0xdf, 0xb7, 0x1d, 0xcb, 0x7d, 0x98, 0x71,
-	0xb4, 0x26, 0x13, 0xf8, 0xe7, 0x74, 0xff, 0x4e, 0x91, 0x18, 0xc7, 0x81, 0x1b, 0xb0, 0xc8, 0x22,
-	0x46, 0x97, 0x8a, 0x1f, 0x44, 0x50, 0xd2, 0x87, 0xb5, 0x52, 0x12, 0x1e, 0x46, 0x7a, 0x21, 0xde,
-	0x82, 0x25, 0x12, 0x04, 0x76, 0xc3, 0xa1, 0x96, 0xe4, 0x95, 0x1b, 0x9b, 0x57, 0xef, 0xd2, 0xe8,
-	0x52, 0xce, 0x67, 0x88, 0xfd, 0x96, 0xa4, 0xfe, 0x15, 0x04, 0x47, 0x06, 0x32, 0x89, 0xcf, 0x15,
-	0x52, 0x82, 0x7c, 0x19, 0x8a, 0x81, 0xd9, 0xa4, 0x56, 0xa7, 0x45, 0x65, 0xa7, 0x4a, 0xd2, 0xec,
-	0x37, 0xab, 0x13, 0xed, 0xbe, 0x48, 0x32, 0x31, 0x8d, 0x8f, 0x01, 0xb4, 0x89, 0xd3, 0x21, 0x2d,
-	0x0e, 0x61, 0x86, 0x43, 0x50, 0x46, 0xf4, 0x15, 0x28, 0x0f, 0x72, 0x1d, 0xd1, 0x01, 0xfa, 0x27,
-	0x82, 0x03, 0x32, 0xe4, 0x8a, 0xdd, 0xad, 0xc2, 0x92, 0x62, 0x86, 0xdb, 0xc9, 0x46, 0xf7, 0x0e,
-	0x8f, 0x08, 0xa7, 0xd2, 0x4b, 0xf2, 0xe9, 0x16, 0x7c, 0x37, 0xd5, 0x44, 0x1f, 0x3b, 0x1b, 0xa2,
-	0x29, 0x55, 0x97, 0x5f, 0x06, 0xed, 0x16, 0x71, 0x48, 0x83, 0x5a, 0xb1, 0xda, 0xb1, 0x8b, 0x7d,
-	0x41, 0x6d, 0x65, 0x4c, 0xdc, 0x38, 0x88, 0x0b, 0x31, 0x7b, 0x67, 0x47, 0xb6, 0x45, 0x7c, 0x28,
-	0x6e, 0xd9, 0xce, 0x2e, 0xbb, 0x5d, 0x33, 0x8d, 0x43, 0x3b, 0x6c, 0x49, 0xeb, 0x46, 0x04, 0x3e,
-	0x08, 0xf9, 0x8e, 0xdf, 0x12, 0x1e, 0xc0, 0x3e, 0x71, 0x05, 0x4a, 0x16, 0x0d, 0x4c, 0xdf, 0xf6,
-	0xc4, 0xfe, 0xf3, 0x96, 0xb2, 0x32, 0xc4, 0xf6, 0xc1, 0x36, 0x5d, 0x67, 0xb3, 0x45, 0x82, 0x40,
-	0xa6, 0xa7, 0x78, 0x40, 0x7f, 0x05, 0x16, 0x99, 0xcc, 0x44, 0xcd, 0xd3, 0x69, 0x35, 0x8f, 0xa4,
-	0xe0, 0x4b, 0x78, 0x12, 0x31, 0x81, 0x67, 0x58, 0x55, 0x70, 0xc9, 0xf3, 0x04, 0x93, 0x31, 0x8b,
-	0xa5, 0xfc, 0xa0, 0xec, 0x3a, 0xb0, 0x93, 0xba, 0xfe, 0xd7, 0xe3, 0x80, 0xd5, 0x73, 0x42, 0xfd,
-	0xae, 0x6d, 0x52, 0xfc, 0x2d, 0x04, 0x33, 0x4c, 0x34, 0x7e, 0x6e, 0xd8, 0xb1, 0xe4, 0xfe, 0x5a,
-	0x9e, 0xde, 0x35, 0x99, 0x49, 0xd3, 0x57, 0xde, 0xfc, 0xcb, 0xdf, 0xbf, 0x9d, 0x5b, 0xc6, 0x87,
-	0xf9, 0xfb, 0x59, 0xf7, 0x9c, 0xfa, 0x96, 0x15, 0xe0, 0xb7, 0x10, 0x60, 0x51, 0x25, 0x29, 0x2f,
-	0x0c, 0xf8, 0xf4, 0x30, 0x88, 0x03, 0x5e, 0x22, 0xca, 0xcf, 0x29, 0x59, 0xa5, 0x66, 0xba, 0x3e,
-	0x65, 0x39, 0x84, 0x4f, 0xe0, 0x00, 0x56, 0x39, 0x80, 0x13, 0x58, 0x1f, 0x04, 0xa0, 0xfe, 0x88,
-	0x59, 0xf4, 0x71, 0x9d, 0x46, 0x72, 0xdf, 0x41, 0x50, 0xb8, 0xcf, 0x6f, 0x18, 0x23, 0x8c, 0xb4,
-	0x3d, 0x35, 0x23, 0x71, 0x71, 0x1c, 0xad, 0x7e, 0x9c, 0x23, 0x7d, 0x0e, 0x1f, 0x95, 0x48, 0x83,
-	0xd0, 0xa7, 0xa4, 0x9d, 0x02, 0x7c, 0x16, 0xe1, 0xf7, 0x10, 0xcc, 0x46, 0xad, 0x65, 0x7c, 0x72,
-	0x18, 0xca, 0x54, 0xeb, 0xb9, 0x3c, 0xbd, 0x3e, 0xad, 0xfe, 0x22, 0xc7, 0x78, 0x5c, 0x1f, 0xb8,
-	0x9d, 0x1b, 0xa9, 0x2e, 0xee, 0xdb, 0x08, 0xf2, 0xd7, 0xe9, 0x48, 0x7f, 0x9b, 0x22, 0xb8, 0x3e,
-	0x03, 0x0e, 0xd8, 0x6a, 0xfc, 0x2e, 0x82, 0x67, 0xaf, 0xd3, 0x70, 0x70, 0x7a, 0xc4, 0xd5, 0xd1,
-	0x39, 0x4b, 0xb8, 0xdd, 0xe9, 0x31, 0x66, 0xc6, 0x79, 0xa1, 0xce, 0x91, 0xbd, 0x88, 0x4f, 0x65,
-	0x39, 0x61, 0xb0, 0xe7, 0x98, 0x0f, 0x05, 0x8e, 0x3f, 0x22, 0x38, 0xd8, 0xfb, 0x92, 0x88, 0xd3,
-	0x09, 0x75, 0xe0, 0x43, 0x63, 0xf9, 0xf6, 0xa4, 0x51, 0x36, 0xcd, 0x54, 0xbf, 0xc4, 0x91, 0xbf,
-	0x8c, 0x5f, 0xca, 0x42, 0x1e, 0xf7, 0xe9, 0xea, 0x8f, 0xe4, 0xe7, 0x63, 0xfe, 0xea, 0xcd, 0x61,
-	0xff, 0x09, 0xc1, 0x61, 0xc9, 0x77, 0xb3, 0x49, 0xfc, 0xf0, 0x0a, 0x65, 0x15, 0x76, 0x30, 0x96,
-	0x3e, 0x13, 0x66, 0x0d, 0x55, 0x9e, 0x7e, 0x95, 0xeb, 0xf2, 0x71, 0xfc, 0xea, 0xbe, 0x75, 0x31,
-	0x19, 0x1b, 0x4b, 0xc0, 0x7e, 0x13, 0xc1, 0xc2, 0x75, 0x1a, 0xde, 0x8a, 0x7b, 0xc5, 0x27, 0xc7,
-	0x7a, 0x7f, 0x2a, 0xaf, 0xd4, 0x94, 0xc7, 0x76, 0xf9, 0x53, 0xec, 0x22, 0x6b, 0x1c, 0xdc, 0x29,
-	0x7c, 0x32, 0x0b, 0x5c, 0xd2, 0x9f, 0x7e, 0x07, 0xc1, 0x11, 0x15, 0x44, 0xf2, 0x6e, 0xf7, 0xd1,
-	0xfd, 0xbd, 0x86, 0x89, 0x37, 0xb5, 0x11, 0xe8, 0xd6, 0x39, 0xba, 0x33, 0xfa, 0x60, 0x07, 0x6e,
-	0xf7, 0xa1, 0xd8, 0x40, 0xab, 0x55, 0x84, 0x7f, 0x8b, 0x60, 0x36, 0x6a, 0xd5, 0x0e, 0xb7, 0x51,
-	0xea, 0x9d, 0x69, 0x9a, 0xd1, 0x40, 0xec, 0x76, 0xf9, 0xec, 0x60, 0x83, 0xaa, 0xeb, 0xa5, 0xab,
-	0xd6, 0xb8, 0x95, 0xd3, 0x61, 0xec, 0x17, 0x08, 0x20, 0x69, 0x37, 0xe3, 0x17, 0xb3, 0xf5, 0x50,
-	0x5a, 0xd2, 0xe5, 0xe9, 0x36, 0x9c, 0xf5, 0x1a, 0xd7, 0xa7, 0x5a, 0xae, 0x64, 0xc6, 0x10, 0x8f,
-	0x9a, 0x1b, 0x51, 0x6b, 0xfa, 0x87, 0x08, 0x0a, 0xbc, 0xcb, 0x87, 0x4f, 0x0c, 0xc3, 0xac, 0x36,
-	0x01, 0xa7, 0x69, 0xfa, 0x17, 0x38, 0xd4, 0xca, 0x7a, 0x56, 0x20, 0xde, 0x40, 0xab, 0xb8, 0x0b,
-	0xb3, 0x51, 0x5f, 0x6d, 0xb8, 0x7b, 0xa4, 0xfa, 0x6e, 0xe5, 0x4a, 0x46, 0x61, 0x10, 0x39, 0xaa,
-	0xc8, 0x01, 0xab, 0xa3, 0x72, 0xc0, 0x0c, 0x0b, 0xd3, 0xf8, 0x78, 0x56, 0x10, 0xff, 0x1f, 0x18,
-	0xe6, 0x34, 0x47, 0x77, 0x52, 0xaf, 0x8c, 0xca, 0x03, 0xcc, 0x3a, 0xdf, 0x41, 0x70, 0xb0, 0xb7,
-	0xb8, 0xc6, 0x47, 0x7b, 0x62, 0xa6, 0x7a, 0xd7, 0x28, 0xa7, 0xad, 0x38, 0xac, 0x30, 0xd7, 0x3f,
-	0xc1, 0x51, 0x6c, 0xe0, 0x8b, 0x23, 0x4f, 0xc6, 0x6d, 0x19, 0x75, 0x18, 0xa3, 0xb5, 0xe4, 0xed,
-	0xec, 0x97, 0x08, 0x16, 0x24, 0xdf, 0xbb, 0x3e, 0xa5, 0xd9, 0xb0, 0xa6, 0x77, 0x10, 0x98, 0x2c,
-	0xfd, 0x15, 0x0e, 0xff, 0x63, 0xf8, 0xc2, 0x98, 0xf0, 0x25, 0xec, 0xb5, 0x90, 0x21, 0xfd, 0x3d,
-	0x82, 0x43, 0xf7, 0x23, 0xbf, 0xff, 0x90, 0xf0, 0x6f, 0x72, 0xfc, 0xaf, 0xe2, 0x97, 0x33, 0xea,
-	0xbc, 0x51, 0x6a, 0x9c, 0x45, 0xf8, 0x67, 0x08, 0x8a, 0xf2, 0xcd, 0x05, 0x9f, 0x1a, 0x7a, 0x30,
-	0xd2, 0xaf, 0x32, 0xd3, 0x74, 0x66, 0x51, 0xd4, 0xe8, 0x27, 0x32, 0xd3, 0xa9, 0x90, 0xcf, 0x1c,
-	0xfa, 0x6d, 0x04, 0x38, 0xbe, 0x33, 0xc7, 0xb7, 0x68, 0xfc, 0x42, 0x4a, 0xd4, 0xd0, 0xc6, 0x4c,
-	0xf9, 0xd4, 0xc8, 0x79, 0xe9, 0x54, 0xba, 0x9a, 0x99, 0x4a, 0xdd, 0x58, 0xfe, 0xd7, 0x11, 0x94,
-	0xae, 0xd3, 0xf8, 0x0e, 0x92, 0x61, 0xcb, 0xf4, 0x93, 0x51, 0xb9, 0x3a, 0x7a, 0xa2, 0x40, 0x74,
-	0x86, 0x23, 0x7a, 0x01, 0x67, 0x9b, 0x4a, 0x02, 0xf8, 0x1e, 0x82, 0xc5, 0x3b, 0xaa, 0x8b, 0xe2,
-	0x33, 0xa3, 0x24, 0xa5, 0x22, 0xf9, 0xf8, 0xb8, 0xce, 0x73, 0x5c, 0x6b, 0xfa, 0x58, 0xb8, 0x36,
-	0xc4, 0xeb, 0xcb, 0xf7, 0x51, 0x74, 0x89, 0xed, 0xe9, 0x76, 0xff, 0xb7, 0x76, 0xcb, 0x68, 0x9a,
-	0xeb, 0x17, 0x38, 0xbe, 0x1a, 0x3e, 0x33, 0x0e, 0xbe, 0xba, 0x68, 0x81, 0xe3, 0xef, 0x22, 0x38,
-	0xc4, 0x5f, 0x22, 0x54, 0xc6, 0x3d, 0x29, 0x66, 0xd8, 0xbb, 0xc5, 0x18, 0x29, 0x46, 0xc4, 0x1f,
-	0x7d, 0x5f, 0xa0, 0x36, 0xe4, 0x2b, 0xc3, 0x37, 0x10, 0x1c, 0x90, 0x49, 0x4d, 0xec, 0xee, 0xda,
-	0x28, 0xc3, 0xed, 0x37, 0x09, 0x0a, 0x77, 0x5b, 0x1d, 0xcf, 0xdd, 0xde, 0x43, 0x30, 0x27, 0x7a,
-	0xfd, 0x19, 0xa5, 0x82, 0xf2, 0x18, 0x50, 0xee, 0xe9, 0x71, 0x88, 0x66, 0xb0, 0xfe, 0x39, 0x2e,
-	0xf6, 0x1e, 0xae, 0x67, 0x89, 0xf5, 0x5c, 0x2b, 0xa8, 0x3f, 0x12, 0x9d, 0xd8, 0xc7, 0xf5, 0x96,
-	0xdb, 0x08, 0x5e, 0xd7, 0x71, 0x66, 0x42, 0x64, 0x73, 0xce, 0x22, 0x1c, 0xc2, 0x3c, 0x73, 0x0e,
-	0xde, 0x38, 0xc1, 0x95, 0x9e, 0x36, 0x4b, 0x5f, 0x4f, 0xa5, 0x5c, 0xee, 0x6b, 0xc4, 0x24, 0x19,
-	0x50, 0x5c, 0x63, 0xf1, 0xf3, 0x99, 0x62, 0xb9, 0xa0, 0xb7, 0x10, 0x1c, 0x52, 0xbd, 0x3d, 0x12,
-	0x3f, 0xb6, 0xaf, 0x67, 0xa1, 0x10, 0x45, 0x35, 0x5e, 0x1d, 0xcb, 0x91, 0x38, 0x9c, 0xcb, 0xd7,
-	0xfe, 0xf0, 0xe4, 0x18, 0x7a, 0xff, 0xc9, 0x31, 0xf4, 0xb7, 0x27, 0xc7, 0xd0, 0xeb, 0x17, 0xc7,
-	0xfb, 0x07, 0xb1, 0xd9, 0xb2, 0xa9, 0x13, 0xaa, 0xec, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0x88,
-	0xb6, 0x78, 0x6a, 0x27, 0x2d, 0x00, 0x00,
+	// 3127 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0xdf, 0x8f, 0x1c, 0x47,
+	0xf1, 0xff, 0xf6, 0xee, 0xdd, 0xde, 0x5e, 0xef, 0x9d, 0x7d, 0xee, 0xc4, 0xf7, 0x9d, 0xac, 0x7f,
+	0xe4, 0x32, 0x76, 0xe2, 0xf3, 0xd9, 0xb7, 0x6b, 0x5f, 0x4c, 0x94, 0x5c, 0x12, 0x81, 0x73, 0xfe,
+	0x19, 0xce, 0x8e, 0x99, 0x73, 0x30, 0x0a, 0x12, 0x30, 0x99, 0xe9, 0xdd, 0x6d, 0x6e, 0x76, 0x66,
+	0xdc, 0x33, 0xbb, 0xe1, 0x08, 0x79, 0x20, 0x08, 0x1e, 0x50, 0x00, 0x01, 0x79, 0x40, 0x08, 0x41,
+	0x48, 0x14, 0x29, 0x20, 0x21, 0x5e, 0x22, 0x84, 0x84, 0x90, 0x40, 0x02, 0x14, 0x1e, 0x90, 0x10,
+	0xfc, 0x03, 0x28, 0x42, 0x3c, 0xc2, 0x0b, 0x7f, 0x00, 0xea, 0x9e, 0xee, 0x99, 0xee, 0xfd, 0x31,
+	0xbb, 0xc7, 0x6e, 0x88, 0xdf, 0xa6, 0x7a, 0x7a, 0xaa, 0x3e, 0x5d, 0x5d, 0x5d, 0x55, 0x5d, 0xb5,
+	0x0b, 0x4f, 0x46, 0x98, 0x76, 0x31, 0xad, 0xdb, 0x61, 0xe8, 0x11, 0xc7, 0x8e, 0x49, 0xe0, 0xab,
+	0xcf, 0xb5, 0x90, 0x06, 0x71, 0x80, 0x2a, 0xca, 0x50, 0xf5, 0x68, 0x33, 0x08, 0x9a, 0x1e, 0xae,
+	0xdb, 0x21, 0xa9, 0xdb, 0xbe, 0x1f, 0xc4, 0x7c, 0x38, 0x4a, 0xa6, 0x56, 0xcd, 0xdd, 0xc7, 0xa3,
+	0x1a, 0x09, 0xf8, 0x5b, 0x27, 0xa0, 0xb8, 0xde, 0x3d, 0x5f, 0x6f, 0x62, 0x1f, 0x53, 0x3b, 0xc6,
+	0xae, 0x98, 0x73, 0x21, 0x9b, 0xd3, 0xb6, 0x9d, 0x16, 0xf1, 0x31, 0xdd, 0xab, 0x87, 0xbb, 0x4d,
+	0x36, 0x10, 0xd5, 0xdb, 0x38, 0xb6, 0x07, 0x7d, 0xb5, 0xdd, 0x24, 0x71, 0xab, 0xf3, 0x62, 0xcd,
+	0x09, 0xda, 0x75, 0x9b, 0x36, 0x83, 0x90, 0x06, 0x9f, 0xe7, 0x0f, 0xeb, 0x8e, 0x5b, 0xef, 0x3e,
+	0x9a, 0x31, 0x50, 0xd7, 0xd2, 0x3d, 0x6f, 0x7b, 0x61, 0xcb, 0xee, 0xe7, 0x76, 0x79, 0x04, 0x37,
+	0x8a, 0xc3, 0x40, 0xe8, 0x86, 0x3f, 0x92, 0x38, 0xa0, 0x7b, 0xca, 0x63, 0xc2, 0xc6, 0x7c, 0xa3,
+	0x00, 0x97, 0x2e, 0x66, 0xf2, 0x3e, 0xd1, 0xc1, 0x74, 0x0f, 0x21, 0x38, 0xe3, 0xdb, 0x6d, 0x6c,
+	0x80, 0x15, 0xb0, 0x3a, 0x6f, 0xf1, 0x67, 0x64, 0xc0, 0x39, 0x8a, 0x1b, 0x14, 0x47, 0x2d, 0xa3,
+	0xc0, 0x87, 0x25, 0x89, 0xaa, 0xb0, 0xcc, 0x84, 0x63, 0x27, 0x8e, 0x8c, 0xe2, 0x4a, 0x71, 0x75,
+	0xde, 0x4a, 0x69, 0xb4, 0x0a, 0x0f, 0x52, 0x1c, 0x05, 0x1d, 0xea, 0xe0, 0x4f, 0x62, 0x1a, 0x91,
+	0xc0, 0x37, 0x66, 0xf8, 0xd7, 0xbd, 0xc3, 0x8c, 0x4b, 0x84, 0x3d, 0xec, 0xc4, 0x01, 0x35, 0x66,
+	0xf9, 0x94, 0x94, 0x66, 0x78, 0x18, 0x70, 0xa3, 0x94, 0xe0, 0x61, 0xcf, 0xc8, 0x84, 0x0b, 0x76,
+	0x18, 0xde, 0xb4, 0xdb, 0x38, 0x0a, 0x6d, 0x07, 0x1b, 0x73, 0xfc, 0x9d, 0x36, 0xc6, 0x30, 0x0b,
+	0x24, 0x46, 0x99, 0x03, 0x93, 0x24, 0x5a, 0x83, 0x4b, 0x02, 0xbe, 0x25, 0x70, 0x44, 0xc6, 0x3c,
+	0x9f, 0xd2, 0x37, 0x6e, 0x6e, 0xc1, 0xf9, 0x9b, 0x81, 0x8b, 0x87, 0xab, 0xa6, 0x17, 0x4a, 0xa1,
+	0x1f, 0x8a, 0xf9, 0x7b, 0x00, 0x0f, 0x5b, 0xb8, 0x4b, 0xd8, 0x5a, 0x6f, 0xe0, 0xd8, 0x76, 0xed,
+	0xd8, 0xee, 0xe5, 0x58, 0x48, 0x39, 0x56, 0x61, 0x99, 0x8a, 0xc9, 0x46, 0x81, 0x8f, 0xa7, 0x74,
+	0x9f, 0xb4, 0x62, 0xfe, 0xc2, 0x13, 0x75, 0xa7, 0x0b, 0x5f, 0x81, 0x95, 0x64, 0x5d, 0xd7, 0x7d,
+	0x17, 0x7f, 0x81, 0x6b, 0x7a, 0xd6, 0x52, 0x87, 0xd0, 0x51, 0x38, 0xdf, 0x4d, 0xf6, 0xe4, 0xba,
+	0xcb, 0x35, 0x3e, 0x6b, 0x65, 0x03, 0xe6, 0x3f, 0x00, 0x3c, 0xae, 0xd8, 0x8b, 0xd4, 0xd2, 0xe5,
+	0x2e, 0xf6, 0xe3, 0x68, 0xf8, 0x82, 0xce, 0xc2, 0x43, 0x72, 0xc3, 0x7b, 0xf5, 0xd4, 0xff, 0x82,
+	0x2d, 0x51, 0x1d, 0x94, 0x4b, 0x54, 0xc7, 0xd8, 0x42, 0x24, 0xfd, 0xfc, 0xf5, 0x4b, 0x62, 0x99,
+	0xea, 0x50, 0x9f, 0xa2, 0x66, 0xf3, 0x15, 0x55, 0xd2, 0x14, 0x65, 0xbe, 0x5a, 0x84, 0x86, 0xb2,
+	0xd0, 0x1b, 0xb6, 0x4f, 0x1a, 0x38, 0x8a, 0xc7, 0xdd, 0x33, 0x30, 0xc5, 0x3d, 0x5b, 0x85, 0x07,
+	0x93, 0x55, 0xdd, 0x62, 0x67, 0x97, 0xf9, 0x2a, 0x63, 0x76, 0xa5, 0xb8, 0x5a, 0xb4, 0x7a, 0x87,
+	0xd9, 0xde, 0x49, 0x99, 0x91, 0x51, 0xe2, 0xf6, 0x9c, 0x0d, 0xa0, 0xfb, 0xe1, 0x6c, 0x93, 0x06,
+	0x9d, 0x50, 0x9c, 0x95, 0x84, 0x60, 0x6b, 0xd9, 0x25, 0xbe, 0x6b, 0x94, 0x13, 0x8b, 0x66, 0xcf,
+	0x8c, 0x8f, 0x9f, 0x82, 0x9d, 0xe7, 0x2f, 0xb2, 0x81, 0xbe, 0xed, 0x81, 0x03, 0xb6, 0x67, 0x19,
+	0x96, 0x1a, 0x04, 0x7b, 0x6e, 0x64, 0x54, 0x38, 0x0c, 0x41, 0xb1, 0xf1, 0xa0, 0xd1, 0x88, 0x70,
+	0x6c, 0x2c, 0xac, 0x80, 0xd5, 0xa2, 0x25, 0x28, 0x86, 0xcd, 0x23, 0x6d, 0x12, 0x1b, 0x8b, 0x7c,
+	0x38, 0x21, 0xcc, 0x87, 0xe0, 0xfc, 0x15, 0xe2, 0xe1, 0xad, 0x56, 0xc7, 0xdf, 0x65, 0x53, 0x1c,
+	0xf6, 0xc0, 0xb5, 0xbe, 0x60, 0x25, 0x84, 0xf9, 0x6d, 0x00, 0x1f, 0x1a, 0xb6, 0x4f, 0x77, 0x48,
+	0xdc, 0x62, 0xdf, 0x47, 0xc3, 0x36, 0xcc, 0x69, 0x61, 0x67, 0x37, 0xea, 0xb4, 0xe5, 0x21, 0x93,
+	0xf4, 0x64, 0x1b, 0x66, 0xfe, 0x14, 0xc0, 0xd5, 0x91, 0x98, 0xee, 0x50, 0x3b, 0x0c, 0x31, 0x45,
+	0x57, 0xe0, 0xec, 0x5d, 0xf6, 0x82, 0xbb, 0x94, 0xca, 0x46, 0xad, 0xa6, 0x86, 0xaf, 0x91, 0x5c,
+	0xae, 0xfd, 0x9f, 0x95, 0x7c, 0x8e, 0x6a, 0x52, 0x3d, 0x05, 0xce, 0x67, 0x59, 0xe3, 0x93, 0x6a,
+	0x91, 0xcd, 0xe7, 0xd3, 0x9e, 0x29, 0xc1, 0x99, 0xd0, 0xa6, 0xb1, 0x79, 0x18, 0xde, 0xa7, 0x1f,
+	0xe8, 0x30, 0xf0, 0x23, 0x6c, 0xfe, 0x0a, 0x68, 0xf6, 0xbf, 0x45, 0xb1, 0x1d, 0x63, 0x0b, 0xdf,
+	0xed, 0xe0, 0x28, 0x46, 0xbb, 0x50, 0x8d, 0xa8, 0x5c, 0xab, 0x95, 0x8d, 0xeb, 0xb5, 0x2c, 0x24,
+	0xd5, 0x64, 0x48, 0xe2, 0x0f, 0x9f, 0x75, 0xdc, 0x5a, 0xf7, 0xd1, 0x5a, 0xb8, 0xdb, 0xac, 0xb1,
+	0x00, 0xa7, 0x21, 0x93, 0x01, 0x4e, 0x5d, 0xaa, 0xa5, 0x72, 0x67, 0x26, 0xd3, 0x09, 0x23, 0x4c,
+	0x63, 0xbe, 0xb2, 0xb2, 0x25, 0x28, 0xb6, 0x7f, 0x5d, 0xdb, 0x23, 0xae, 0x1d, 0x27, 0xfb, 0x53,
+	0xb6, 0x52, 0xda, 0xfc, 0xb5, 0x8e, 0xfe, 0xf9, 0xd0, 0xfd, 0xb0, 0xd0, 0xab, 0x28, 0x0b, 0x3a,
+	0x4a, 0xd5, 0x82, 0x8a, 0xba, 0x05, 0xbd, 0xab, 0xe3, 0xbf, 0x84, 0x3d, 0x9c, 0xe1, 0x1f, 0x64,
+	0xcc, 0x06, 0x9c, 0x73, 0xec, 0xc8, 0xb1, 0x5d, 0x29, 0x45, 0x92, 0xcc, 0xf5, 0x86, 0x34, 0x08,
+	0xed, 0x26, 0xe7, 0x74, 0x2b, 0xf0, 0x88, 0xb3, 0x27, 0xc4, 0xf5, 0xbf, 0xe8, 0x33, 0xfc, 0x99,
+	0x7c, 0xc3, 0x9f, 0xd5, 0x61, 0x9f, 0x80, 0x95, 0x9d, 0x3d, 0xdf, 0x79, 0x2e, 0x8c, 0xa5, 0xc3,
+	0x21, 0x31, 0x6e, 0x47, 0x06, 0xe0, 0x3e, 0x20, 0x21, 0xcc, 0xdf, 0x95, 0xe0, 0xb2, 0xb2, 0x36,
+	0xf6, 0x41, 0xde, 0xca, 0xf2, 0xfc, 0xea, 0x32, 0x2c, 0xb9, 0x74, 0xcf, 0xea, 0xf8, 0xc2, 0x00,
+	0x04, 0xc5, 0x04, 0x87, 0xb4, 0xe3, 0x27, 0xf0, 0xcb, 0x56, 0x42, 0xa0, 0x06, 0x2c, 0x47, 0x31,
+	0xcb, 0xa1, 0x9a, 0x7b, 0x1c, 0x78, 0x65, 0xe3, 0xd9, 0xc9, 0x36, 0x9d, 0x41, 0xdf, 0x11, 0x1c,
+	0xad, 0x94, 0x37, 0xba, 0x0b, 0xe7, 0xa5, 0x2f, 0x8c, 0x8c, 0xb9, 0x95, 0xe2, 0x6a, 0x65, 0x63,
+	0x67, 0x72, 0x41, 0xcf, 0x85, 0x98, 0x26, 0xf6, 0x25, 0x78, 0x5b, 0x99, 0x14, 0xe6, 0xb0, 0xdb,
+	0xc2, 0x3f, 0x44, 0x22, 0xd7, 0xc9, 0x06, 0xd0, 0xa7, 0xe0, 0x2c, 0xf1, 0x1b, 0x41, 0x92, 0xe2,
+	0x54, 0x36, 0x9e, 0x99, 0x0c, 0xcc, 0x75, 0xbf, 0x11, 0x58, 0x09, 0x43, 0x74, 0x17, 0x2e, 0x52,
+	0x1c, 0xd3, 0x3d, 0xa9, 0x05, 0x1e, 0x0b, 0x2a, 0x1b, 0x1f, 0x9f, 0x4c, 0x82, 0xa5, 0xb2, 0xb4,
+	0x74, 0x09, 0x68, 0x13, 0x56, 0xa2, 0xcc, 0xc6, 0x8c, 0x0a, 0x17, 0x68, 0x68, 0x8c, 0x14, 0x1b,
+	0xb4, 0xd4, 0xc9, 0x7d, 0xd6, 0xbd, 0x90, 0x6f, 0xdd, 0x8b, 0x23, 0xe3, 0xf0, 0x81, 0x31, 0xe2,
+	0xf0, 0xc1, 0xde, 0x38, 0xbc, 0x0c, 0x4b, 0x14, 0x47, 0x9d, 0x36, 0x36, 0x96, 0x12, 0xab, 0x4d,
+	0x28, 0x76, 0x52, 0x1b, 0x01, 0x75, 0xf0, 0x56, 0xe0, 0x37, 0x3c, 0xe2, 0xc4, 0xd1, 0x95, 0x80,
+	0x1a, 0x87, 0xf8, 0xd7, 0xfd, 0x2f, 0xcc, 0x7f, 0x01, 0x78, 0xb4, 0xcf, 0xc5, 0xed, 0x84, 0x38,
+	0xf7, 0x30, 0xd9, 0x70, 0x26, 0x0a, 0xb1, 0xc3, 0xe3, 0x5d, 0x65, 0xe3, 0xc6, 0xd4, 0x7c, 0x1e,
+	0x97, 0xcb, 0x59, 0xe7, 0xb9, 0xe5, 0x09, 0xbd, 0xcb, 0x8f, 0x00, 0xfc, 0x7f, 0x45, 0xe6, 0x2d,
+	0x3b, 0x76, 0x5a, 0x79, 0x8b, 0x65, 0x5e, 0x80, 0xcd, 0x11, 0xd1, 0x3d, 0x21, 0xd8, 0xde, 0xf0,
+	0x87, 0xdb, 0x7b, 0x21, 0x03, 0xc8, 0xde, 0x64, 0x03, 0x13, 0x26, 0x8d, 0xef, 0x01, 0x58, 0x55,
+	0x23, 0x41, 0xe0, 0x79, 0x2f, 0xda, 0xce, 0x6e, 0x1e, 0xc8, 0x03, 0xb0, 0x40, 0x5c, 0x8e, 0xb0,
+	0x68, 0x15, 0x88, 0xbb, 0x4f, 0x97, 0xd6, 0x0b, 0xb7, 0x94, 0x0f, 0x77, 0x4e, 0x37, 0x68, 0xd5,
+	0xb5, 0x96, 0x75, 0xd7, 0x6a, 0xfe, 0xbb, 0x67, 0x29, 0xd2, 0xe9, 0xe4, 0x2c, 0x45, 0xcb, 0x1a,
+	0x0b, 0xa3, 0xb2, 0xc6, 0x44, 0xf5, 0xda, 0x18, 0x83, 0xda, 0x4d, 0xaf, 0x89, 0xec, 0xb5, 0x24,
+	0xb3, 0xdc, 0x75, 0x76, 0x50, 0xee, 0x5a, 0x4a, 0x50, 0xb0, 0xe7, 0xfd, 0x5f, 0x0c, 0xb5, 0x1d,
+	0xfc, 0x59, 0x01, 0x3e, 0x38, 0x60, 0xd9, 0x23, 0x6d, 0xed, 0xde, 0x58, 0x7b, 0x6a, 0xf1, 0x73,
+	0x43, 0x2d, 0xbe, 0x3c, 0xca, 0xe2, 0xe7, 0xf3, 0xf5, 0x05, 0x75, 0x7d, 0xbd, 0x53, 0x80, 0x2b,
+	0x03, 0xf4, 0x35, 0x3a, 0x61, 0xb9, 0x67, 0x14, 0xc6, 0x3d, 0x2b, 0xb7, 0x92, 0xb2, 0x95, 0x10,
+	0xfc, 0x92, 0x42, 0xc3, 0x96, 0x9d, 0x9c, 0x8a, 0xb2, 0x25, 0xa8, 0x09, 0x55, 0xf5, 0xf5, 0x02,
+	0x34, 0xa4, 0x7e, 0x2e, 0x3a, 0x5c, 0x5b, 0x1d, 0xff, 0xde, 0x57, 0xd1, 0x32, 0x2c, 0xd9, 0x1c,
+	0xad, 0x30, 0x2a, 0x41, 0xf5, 0x29, 0xa3, 0x9c, 0xaf, 0x8c, 0x79, 0x5d, 0x19, 0x5f, 0x05, 0xf0,
+	0x88, 0xae, 0x8c, 0x68, 0x9b, 0x44, 0xb1, 0xbc, 0x7e, 0xa0, 0x06, 0x9c, 0x4b, 0xe4, 0x24, 0xc9,
+	0x63, 0x65, 0x63, 0x7b, 0xd2, 0x94, 0x42, 0x53, 0xbc, 0x64, 0x6e, 0x3e, 0x01, 0x8f, 0x0c, 0xf4,
+	0x72, 0x02, 0x46, 0x15, 0x96, 0x65, 0x1a, 0x25, 0xb6, 0x26, 0xa5, 0xcd, 0xb7, 0x66, 0xf4, 0x70,
+	0x14, 0xb8, 0xdb, 0x41, 0x33, 0xa7, 0x06, 0x92, 0xbf, 0x9d, 0x4c, 0x55, 0x81, 0xab, 0x94, 0x3b,
+	0x24, 0xc9, 0xbe, 0x73, 0x02, 0x3f, 0xb6, 0x89, 0x8f, 0xa9, 0x88, 0x98, 0xd9, 0x00, 0xdb, 0x86,
+	0x88, 0xf8, 0x0e, 0xde, 0xc1, 0x4e, 0xe0, 0xbb, 0x11, 0xdf, 0xcf, 0xa2, 0xa5, 0x8d, 0xa1, 0x6b,
+	0x70, 0x9e, 0xd3, 0xb7, 0x49, 0x3b, 0x09, 0x11, 0x95, 0x8d, 0xb5, 0x5a, 0x52, 0xc3, 0xac, 0xa9,
+	0x35, 0xcc, 0x4c, 0x87, 0xac, 0x86, 0x59, 0xeb, 0x9e, 0xaf, 0xb1, 0x2f, 0xac, 0xec, 0x63, 0x86,
+	0x25, 0xb6, 0x89, 0xb7, 0x4d, 0x7c, 0x9e, 0xda, 0x32, 0x51, 0xd9, 0x00, 0xbf, 0xf4, 0x07, 0x9e,
+	0x17, 0xbc, 0x24, 0xcf, 0x4d, 0x42, 0xb1, 0xaf, 0x3a, 0x7e, 0x4c, 0x3c, 0x2e, 0x5f, 0x94, 0x13,
+	0xd2, 0x01, 0xfe, 0x15, 0xf1, 0x62, 0x4c, 0xc5, 0x81, 0x11, 0x54, 0x6a, 0x8c, 0x15, 0xa5, 0x30,
+	0x91, 0x9a, 0xed, 0x82, 0x6a, 0xb6, 0xbd, 0x47, 0x61, 0x71, 0x40, 0x41, 0x82, 0x57, 0x29, 0x71,
+	0x97, 0x04, 0x1d, 0x96, 0xb5, 0xf1, 0xb4, 0x44, 0xd2, 0x7d, 0xa6, 0x7c, 0x30, 0xdf, 0x94, 0x97,
+	0xf4, 0x28, 0xca, 0x73, 0xef, 0xd8, 0x69, 0x6d, 0xd9, 0x11, 0x36, 0x0e, 0x71, 0xd6, 0xd9, 0x80,
+	0xf9, 0x1b, 0x00, 0xcb, 0xdb, 0x41, 0xf3, 0xb2, 0x1f, 0xd3, 0x3d, 0xc6, 0x84, 0xed, 0x1c, 0xf6,
+	0xa5, 0x35, 0x49, 0x92, 0x6d, 0x51, 0x4c, 0xda, 0x78, 0x27, 0xb6, 0xdb, 0xa1, 0xc8, 0xce, 0xf6,
+	0xb5, 0x45, 0xe9, 0xc7, 0x4c, 0x6d, 0x9e, 0x1d, 0xc5, 0xdc, 0x1f, 0x94, 0x2d, 0xfe, 0xcc, 0x16,
+	0x98, 0x4e, 0xd8, 0x89, 0xa9, 0x70, 0x06, 0xda, 0x98, 0x6a, 0x80, 0xb3, 0x09, 0x36, 0x41, 0x9a,
+	0xdf, 0x00, 0xf0, 0x98, 0x62, 0xe8, 0x17, 0xc3, 0x90, 0x06, 0x5d, 0xbc, 0xbf, 0x7b, 0xdb, 0x14,
+	0x6b, 0x98, 0x66, 0xa8, 0x25, 0xbe, 0x97, 0x28, 0x69, 0xc4, 0xd7, 0x48, 0xc4, 0x8a, 0xda, 0xc3,
+	0x0f, 0xdf, 0x18, 0x35, 0xda, 0x9c, 0xeb, 0xf8, 0x97, 0x01, 0x7c, 0x70, 0x88, 0xc8, 0xd4, 0x55,
+	0x7c, 0x46, 0xbd, 0xec, 0x56, 0x36, 0xae, 0x4d, 0xe6, 0xaf, 0xb8, 0x08, 0x5e, 0x55, 0x95, 0xd7,
+	0xe6, 0x36, 0x7c, 0x20, 0xbd, 0x02, 0xde, 0xc6, 0xb4, 0x4d, 0x7c, 0x3b, 0x3f, 0xc2, 0x4e, 0xb6,
+	0xe4, 0x40, 0x73, 0x8c, 0x6c, 0xb3, 0xef, 0x10, 0xdf, 0x0d, 0x5e, 0x8a, 0x3e, 0x28, 0x1d, 0xff,
+	0x45, 0xaf, 0x2c, 0x2b, 0x12, 0x53, 0x15, 0x5f, 0x83, 0x8b, 0xcc, 0x6f, 0x77, 0xb1, 0x78, 0x21,
+	0x54, 0x6d, 0x0e, 0x2b, 0x99, 0x65, 0x3c, 0x2c, 0xfd, 0x43, 0xb4, 0x0d, 0x0f, 0xda, 0x51, 0x44,
+	0x9a, 0x3e, 0x76, 0x25, 0xaf, 0xc2, 0xd8, 0xbc, 0x7a, 0x3f, 0x4d, 0x8a, 0x2f, 0x7c, 0x86, 0x38,
+	0x75, 0x92, 0x34, 0xbf, 0x02, 0xe0, 0xe1, 0x81, 0x4c, 0x52, 0xef, 0x06, 0x94, 0x50, 0xcb, 0x7a,
+	0x20, 0x4e, 0x0b, 0xbb, 0x1d, 0x0f, 0xcb, 0x23, 0x23, 0x69, 0xf6, 0xce, 0xed, 0x24, 0xbb, 0x2f,
+	0x42, 0x7d, 0x4a, 0xa3, 0xe3, 0x10, 0xb6, 0x6d, 0xbf, 0x63, 0x7b, 0x1c, 0xc2, 0x0c, 0x87, 0xa0,
+	0x8c, 0x98, 0x47, 0x61, 0x75, 0x90, 0xe9, 0x88, 0x4a, 0xdf, 0x3f, 0x01, 0x3c, 0x90, 0x76, 0x3b,
+	0x92, 0xdd, 0x5d, 0x85, 0x07, 0x15, 0x35, 0xdc, 0xcc, 0x36, 0xba, 0x77, 0x78, 0x44, 0x50, 0x93,
+	0x56, 0x52, 0xd4, 0x1b, 0x49, 0x5d, 0xad, 0x15, 0x34, 0x76, 0x4e, 0x02, 0xa6, 0x94, 0xe3, 0x7f,
+	0x09, 0x1a, 0x37, 0x6c, 0xdf, 0x6e, 0x62, 0x37, 0x5d, 0x76, 0x6a, 0x62, 0x9f, 0xd3, 0x4f, 0xf1,
+	0xb3, 0xd3, 0xc9, 0x3a, 0x2e, 0x91, 0x46, 0x43, 0x9e, 0xe3, 0x37, 0x7b, 0xec, 0x9c, 0xf7, 0xe8,
+	0x76, 0x88, 0xcb, 0x27, 0x25, 0xea, 0x37, 0xe0, 0x9c, 0x58, 0x8a, 0x0c, 0x13, 0x82, 0x9c, 0xec,
+	0x88, 0xb1, 0x6d, 0x8d, 0x6d, 0xda, 0xc4, 0xf1, 0x8d, 0xb4, 0x56, 0x34, 0xc3, 0xcb, 0x0b, 0xbd,
+	0xc3, 0xe6, 0x8f, 0xf5, 0xaa, 0xba, 0x0e, 0xf2, 0x7f, 0xa7, 0x2c, 0x9e, 0x7f, 0x05, 0x2e, 0x69,
+	0x10, 0x9c, 0xdc, 0x91, 0xcb, 0x56, 0x4a, 0x9b, 0x14, 0x96, 0xb7, 0x89, 0xbf, 0xcb, 0xca, 0x51,
+	0xcc, 0x74, 0x62, 0x12, 0x7b, 0x52, 0x5f, 0x09, 0x81, 0x96, 0x60, 0xb1, 0x43, 0x3d, 0x71, 0x94,
+	0xd8, 0x23, 0xeb, 0x1a, 0xb9, 0x38, 0x72, 0x28, 0x09, 0xc5, 0x41, 0xe2, 0x5d, 0x23, 0x65, 0x88,
+	0x19, 0x34, 0x71, 0x02, 0x7f, 0xcb, 0xb3, 0xa3, 0x48, 0x66, 0x5b, 0xe9, 0x80, 0xf9, 0x14, 0x5c,
+	0x64, 0x32, 0x33, 0x7b, 0x39, 0xa3, 0xab, 0xe0, 0xb0, 0xb6, 0x34, 0x09, 0x4f, 0x6e, 0xbd, 0x0d,
+	0xef, 0x63, 0x49, 0xee, 0xc5, 0x30, 0x14, 0x4c, 0xc6, 0xcc, 0xfd, 0x8b, 0x83, 0x92, 0xc5, 0x81,
+	0xb1, 0x71, 0xe3, 0x6b, 0xa7, 0x21, 0xea, 0xd9, 0x38, 0xe2, 0x60, 0xf4, 0x1d, 0x00, 0x67, 0x98,
+	0x68, 0x74, 0x6c, 0x98, 0x7f, 0xe3, 0x96, 0x57, 0x9d, 0x5e, 0x45, 0x88, 0x49, 0x33, 0x8f, 0xbe,
+	0xfa, 0xd7, 0xbf, 0x7f, 0xb7, 0xb0, 0x8c, 0xee, 0xe7, 0xed, 0xf4, 0xee, 0x79, 0xb5, 0xb5, 0x1d,
+	0xa1, 0xd7, 0x00, 0x44, 0x22, 0xe9, 0x57, 0x9a, 0x88, 0xe8, 0xcc, 0x30, 0x88, 0x03, 0x9a, 0x8d,
+	0xd5, 0x63, 0x4a, 0x92, 0x54, 0x73, 0x02, 0x8a, 0x59, 0x4a, 0xc4, 0x27, 0x70, 0x00, 0x6b, 0x1c,
+	0xc0, 0x49, 0x64, 0x0e, 0x02, 0x50, 0x7f, 0x99, 0x69, 0xf4, 0x95, 0x3a, 0x4e, 0xe4, 0xbe, 0x09,
+	0xe0, 0xec, 0x1d, 0x7e, 0x61, 0x1e, 0xa1, 0xa4, 0x9d, 0xa9, 0x29, 0x89, 0x8b, 0xe3, 0x68, 0xcd,
+	0x13, 0x1c, 0xe9, 0x31, 0x74, 0x44, 0x22, 0x8d, 0x62, 0x8a, 0xed, 0xb6, 0x06, 0xf8, 0x1c, 0x40,
+	0x6f, 0x03, 0x58, 0x4a, 0x7a, 0x31, 0xe8, 0xe1, 0x61, 0x28, 0xb5, 0x5e, 0x4d, 0x75, 0x7a, 0x8d,
+	0x0d, 0xf3, 0x34, 0xc7, 0x78, 0xc2, 0x1c, 0xb8, 0x9d, 0x9b, 0x5a, 0xdb, 0xe3, 0x75, 0x00, 0x8b,
+	0x57, 0xf1, 0x48, 0x7b, 0x9b, 0x22, 0xb8, 0x3e, 0x05, 0x0e, 0xd8, 0x6a, 0xf4, 0x16, 0x80, 0x0f,
+	0x5c, 0xc5, 0xf1, 0xe0, 0x3c, 0x03, 0xad, 0x8e, 0x0e, 0xfe, 0xc2, 0xec, 0xce, 0x8c, 0x31, 0x33,
+	0x0d, 0xb0, 0x75, 0x8e, 0xec, 0x34, 0x3a, 0x95, 0x67, 0x84, 0xac, 0x4c, 0xfd, 0x92, 0xc0, 0xf1,
+	0x47, 0x00, 0x97, 0x7a, 0x7f, 0x2c, 0x80, 0xf4, 0xcc, 0x64, 0xe0, 0x6f, 0x09, 0xaa, 0x37, 0x27,
+	0xf5, 0xc0, 0x3a, 0x53, 0xf3, 0x22, 0x47, 0xfe, 0x24, 0x7a, 0x22, 0x0f, 0x79, 0x5a, 0xd8, 0xae,
+	0xbf, 0x2c, 0x1f, 0x5f, 0xa9, 0xb7, 0x05, 0x0b, 0xf4, 0x27, 0x00, 0xef, 0x97, 0x7c, 0xb7, 0x5a,
+	0x36, 0x8d, 0x2f, 0x61, 0x76, 0x61, 0x8c, 0xc6, 0x5a, 0xcf, 0x84, 0x11, 0x45, 0x95, 0x67, 0x5e,
+	0xe6, 0x6b, 0xf9, 0x28, 0x7a, 0x7a, 0xdf, 0x6b, 0x71, 0x18, 0x1b, 0x57, 0xc0, 0x7e, 0x15, 0xc0,
+	0x85, 0xab, 0x4a, 0xa8, 0x1c, 0x7e, 0x0c, 0xb5, 0x86, 0x6d, 0xf5, 0x68, 0x4d, 0xf9, 0xed, 0x8d,
+	0x7c, 0x95, 0x9a, 0xc8, 0x3a, 0x07, 0x77, 0x0a, 0x3d, 0x9c, 0x07, 0x2e, 0x6b, 0xe8, 0xbc, 0x09,
+	0xe0, 0x61, 0x15, 0x44, 0xd6, 0xe8, 0xfe, 0xc8, 0xfe, 0xda, 0xc7, 0xa2, 0x09, 0x3d, 0x02, 0xdd,
+	0x06, 0x47, 0x77, 0xd6, 0x1c, 0x6c, 0xc0, 0xed, 0x3e, 0x14, 0x9b, 0x60, 0x6d, 0x15, 0xa0, 0xdf,
+	0x02, 0x58, 0x4a, 0xba, 0x12, 0xc3, 0x75, 0xa4, 0x35, 0x66, 0xa7, 0xe9, 0x0d, 0xc4, 0x6e, 0x57,
+	0xcf, 0x0d, 0x56, 0xa8, 0xfa, 0xbd, 0x34, 0xd5, 0x1a, 0xd7, 0xb2, 0xee, 0xc6, 0x7e, 0x01, 0x20,
+	0xcc, 0x3a, 0x2b, 0xe8, 0x74, 0xfe, 0x3a, 0x94, 0xee, 0x4b, 0x75, 0xba, 0xbd, 0x15, 0xb3, 0xc6,
+	0xd7, 0xb3, 0x5a, 0x5d, 0xc9, 0xf5, 0x21, 0x21, 0x76, 0x36, 0x93, 0x2e, 0xcc, 0x1b, 0x00, 0xce,
+	0xf2, 0xa2, 0x35, 0x3a, 0x39, 0x0c, 0xb3, 0x5a, 0xd3, 0x9e, 0xa6, 0xea, 0x1f, 0xe1, 0x50, 0x57,
+	0x36, 0xf2, 0x1c, 0xf1, 0x26, 0x58, 0x43, 0x5d, 0x58, 0x4a, 0xca, 0xc4, 0xc3, 0xcd, 0x43, 0x2b,
+	0x23, 0x57, 0x57, 0x72, 0x12, 0x83, 0xc4, 0x50, 0x45, 0x0c, 0x58, 0x1b, 0x15, 0x03, 0x66, 0x98,
+	0x9b, 0x46, 0x27, 0xf2, 0x9c, 0xf8, 0x07, 0xa0, 0x98, 0x33, 0x1c, 0xdd, 0xc3, 0xe6, 0xca, 0xa8,
+	0x38, 0xc0, 0xb4, 0xf3, 0x3d, 0x00, 0x97, 0x7a, 0x6f, 0x29, 0xe8, 0x48, 0x8f, 0xcf, 0x54, 0x2f,
+	0x6d, 0x55, 0x5d, 0x8b, 0xc3, 0x6e, 0x38, 0xe6, 0xc7, 0x38, 0x8a, 0x4d, 0xf4, 0xf8, 0xc8, 0x93,
+	0x71, 0x53, 0x7a, 0x1d, 0xc6, 0x68, 0x3d, 0x6b, 0x36, 0xff, 0x04, 0xc0, 0x03, 0xfa, 0x8d, 0x60,
+	0x78, 0xce, 0x36, 0xe0, 0x7a, 0x53, 0xad, 0x8d, 0x37, 0x39, 0x45, 0xbc, 0xc9, 0x11, 0x5f, 0x30,
+	0xeb, 0x43, 0x11, 0x27, 0x48, 0x93, 0x9f, 0x3b, 0xae, 0x47, 0xc4, 0xc5, 0xeb, 0x2e, 0x69, 0x34,
+	0x98, 0x1a, 0x7f, 0x09, 0xe0, 0x82, 0xd4, 0xc1, 0x6d, 0x8a, 0x71, 0xbe, 0x0a, 0xa7, 0x77, 0x68,
+	0x99, 0x2c, 0xf3, 0x29, 0x0e, 0xfc, 0x31, 0x74, 0x61, 0x4c, 0x55, 0x4b, 0x15, 0xaf, 0xc7, 0x0c,
+	0xe9, 0x1f, 0x00, 0x3c, 0x74, 0x27, 0x39, 0xa3, 0x1f, 0x12, 0xfe, 0x2d, 0x8e, 0xff, 0x69, 0xf4,
+	0x64, 0x4e, 0x4e, 0x3a, 0x6a, 0x19, 0xe7, 0x00, 0xfa, 0x39, 0x80, 0x65, 0xd9, 0x0a, 0x45, 0xa7,
+	0x86, 0x1e, 0x62, 0xbd, 0x59, 0x3a, 0xcd, 0x83, 0x27, 0x12, 0x30, 0xf3, 0x64, 0x6e, 0xe8, 0x17,
+	0xf2, 0x99, 0xd5, 0xbc, 0x0b, 0x60, 0x45, 0x29, 0x73, 0xa2, 0xb5, 0x61, 0xa0, 0xfb, 0x6b, 0xa1,
+	0xd3, 0xc4, 0x2d, 0x9c, 0xbe, 0x79, 0x22, 0x0f, 0xb7, 0x9d, 0x40, 0x60, 0xb0, 0xbf, 0x0f, 0xe0,
+	0x82, 0x5a, 0x9b, 0x1c, 0x1e, 0xaf, 0xfa, 0x8a, 0xa6, 0xd5, 0xb3, 0xe3, 0x4c, 0x4d, 0x8f, 0xe4,
+	0x79, 0x8e, 0xec, 0x0c, 0x3a, 0x9d, 0x87, 0xcc, 0x65, 0x5f, 0xae, 0xb7, 0x04, 0x96, 0xd7, 0x01,
+	0x44, 0x69, 0xf1, 0x29, 0x2d, 0x47, 0xa1, 0x47, 0x34, 0xb9, 0x43, 0x2b, 0x9c, 0xd5, 0x53, 0x23,
+	0xe7, 0xe9, 0xa9, 0xd4, 0x5a, 0x6e, 0x2a, 0x15, 0xa4, 0xf2, 0xbf, 0x09, 0x60, 0xe5, 0x2a, 0x4e,
+	0xef, 0xa0, 0x39, 0xf6, 0xa9, 0x77, 0xc0, 0xab, 0xab, 0xa3, 0x27, 0x0a, 0x44, 0x67, 0x39, 0xa2,
+	0x47, 0x50, 0xbe, 0xf9, 0x49, 0x00, 0x3f, 0x00, 0x70, 0xf1, 0x96, 0x7a, 0xec, 0xd1, 0xd9, 0x51,
+	0x92, 0xb4, 0x48, 0x3e, 0x3e, 0xae, 0x47, 0x39, 0xae, 0x75, 0x73, 0x2c, 0x5c, 0x9b, 0xa2, 0x99,
+	0xfc, 0x0e, 0x60, 0x5d, 0xcc, 0x76, 0xd0, 0xc5, 0x92, 0xdf, 0x15, 0xe2, 0xdb, 0x1e, 0xf9, 0x22,
+	0xa6, 0xd1, 0xf8, 0xca, 0x1b, 0x1d, 0xca, 0xc5, 0xd5, 0xc3, 0x7c, 0x6c, 0x1c, 0x70, 0x75, 0xca,
+	0x11, 0xad, 0x37, 0x52, 0x28, 0xec, 0x38, 0xfc, 0x10, 0x24, 0xf5, 0x96, 0x9e, 0x3e, 0xe3, 0x7f,
+	0xbb, 0xc5, 0x39, 0xed, 0x4a, 0xf3, 0x02, 0x47, 0x5b, 0x43, 0x67, 0xc7, 0x42, 0x2b, 0x9a, 0x8f,
+	0xec, 0xbc, 0x1e, 0xe2, 0x3d, 0x60, 0x95, 0x71, 0x4f, 0x36, 0x34, 0xac, 0x63, 0x3c, 0x86, 0x0a,
+	0x45, 0xf8, 0x31, 0xf7, 0x05, 0x6a, 0x53, 0xf6, 0x77, 0xbf, 0x05, 0xe0, 0x01, 0x99, 0x7f, 0x09,
+	0x43, 0x5c, 0x1f, 0xa5, 0xb8, 0xfd, 0xe6, 0x6b, 0xe2, 0x64, 0xac, 0x8d, 0x77, 0x32, 0xde, 0x06,
+	0x70, 0x4e, 0x74, 0x59, 0x73, 0xb2, 0x5a, 0xa5, 0x0d, 0x5b, 0xed, 0x29, 0xc7, 0x89, 0x36, 0x9c,
+	0xf9, 0x69, 0x2e, 0xf6, 0x79, 0x54, 0xcf, 0x13, 0x1b, 0x06, 0x6e, 0x54, 0x7f, 0x59, 0xf4, 0xc0,
+	0x5e, 0xa9, 0x7b, 0x41, 0x33, 0x7a, 0xc1, 0x44, 0xb9, 0xb9, 0x1b, 0x9b, 0x73, 0x0e, 0xa0, 0x18,
+	0xce, 0x33, 0xe3, 0xe0, 0x35, 0x3e, 0xa4, 0x2b, 0x61, 0x40, 0xf9, 0xaf, 0x5a, 0xed, 0xab, 0x19,
+	0x66, 0xc9, 0x9a, 0xa8, 0xb8, 0xa0, 0x87, 0x72, 0xc5, 0x72, 0x41, 0xaf, 0x01, 0x78, 0x48, 0xb5,
+	0xf6, 0x44, 0xfc, 0xd8, 0xb6, 0x9e, 0x87, 0x42, 0xdc, 0xff, 0xd0, 0xda, 0x58, 0x86, 0xc4, 0xe1,
+	0x3c, 0x73, 0xe5, 0xbd, 0xf7, 0x8f, 0x83, 0x3f, 0xbf, 0x7f, 0x1c, 0xfc, 0xed, 0xfd, 0xe3, 0xe0,
+	0x85, 0xc7, 0xc7, 0xfb, 0xef, 0x8b, 0xe3, 0x11, 0xec, 0xc7, 0x2a, 0xfb, 0xff, 0x0c, 0x00, 0xc0,
+	0xfd, 0xbb, 0x01, 0xe1, 0x33, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3070,18 +3500,26 @@ type ApplicationServiceClient interface {
 	Sync(ctx context.Context, in *ApplicationSyncRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
 	// ManagedResources returns list of managed resources
 	ManagedResources(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*ManagedResourcesResponse, error)
+	// ServerSideDiff compares the target state with the live state using a server-side apply dry-run in the destination cluster
+	ServerSideDiff(ctx context.Context, in *ApplicationServerSideDiffQuery, opts ...grpc.CallOption) (*ApplicationServerSideDiffResponse, error)
 	// ResourceTree returns resource tree
 	ResourceTree(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationTree, error)
 	// Watch returns stream of application resource tree
 	WatchResourceTree(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (ApplicationService_WatchResourceTreeClient, error)
 	// Rollback syncs an application to its target state
 	Rollback(ctx context.Context, in *ApplicationRollbackRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
+	// ApproveSync approves the automated sync of an application requiring a diff approval to a revision
+	ApproveSync(ctx context.Context, in *ApplicationApproveSyncRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
+	// DriftHistory returns the out-of-band changes of the live resources of an application reverted by the self-heal
+	DriftHistory(ctx context.Context, in *ApplicationDriftHistoryQuery, opts ...grpc.CallOption) (*ApplicationDriftHistoryResponse, error)
 	// TerminateOperation terminates the currently running operation
 	TerminateOperation(ctx context.Context, in *OperationTerminateRequest, opts ...grpc.CallOption) (*OperationTerminateResponse, error)
 	// GetResource returns single application resource
 	GetResource(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*ApplicationResourceResponse, error)
 	// PatchResource patch single application resource
 	PatchResource(ctx context.Context, in *ApplicationResourcePatchRequest, opts ...grpc.CallOption) (*ApplicationResourceResponse, error)
+	// RemoveResourceFinalizers removes the finalizers of an application resource pending deletion
+	RemoveResourceFinalizers(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*ApplicationResponse, error)
 	// ListResourceActions returns list of resource actions
 	ListResourceActions(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*ResourceActionsListResponse, error)
 	// RunResourceAction run resource action
@@ -3296,6 +3734,15 @@ func (c *applicationServiceClient) ManagedResources(ctx context.Context, in *Res
 	return out, nil
 }
 
+func (c *applicationServiceClient) ServerSideDiff(ctx context.Context, in *ApplicationServerSideDiffQuery, opts ...grpc.CallOption) (*ApplicationServerSideDiffResponse, error) {
+	out := new(ApplicationServerSideDiffResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/ServerSideDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationServiceClient) ResourceTree(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationTree, error) {
 	out := new(v1alpha1.ApplicationTree)
 	err := c.cc.Invoke(ctx, "/application.ApplicationService/ResourceTree", in, out, opts...)
@@ -3346,6 +3793,24 @@ func (c *applicationServiceClient) Rollback(ctx context.Context, in *Application
 	return out, nil
 }
 
+func (c *applicationServiceClient) ApproveSync(ctx context.Context, in *ApplicationApproveSyncRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+	out := new(v1alpha1.Application)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/ApproveSync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) DriftHistory(ctx context.Context, in *ApplicationDriftHistoryQuery, opts ...grpc.CallOption) (*ApplicationDriftHistoryResponse, error) {
+	out := new(ApplicationDriftHistoryResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/DriftHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationServiceClient) TerminateOperation(ctx context.Context, in *OperationTerminateRequest, opts ...grpc.CallOption) (*OperationTerminateResponse, error) {
 	out := new(OperationTerminateResponse)
 	err := c.cc.Invoke(ctx, "/application.ApplicationService/TerminateOperation", in, out, opts...)
@@ -3373,6 +3838,15 @@ func (c *applicationServiceClient) PatchResource(ctx context.Context, in *Applic
 	return out, nil
 }
 
+func (c *applicationServiceClient) RemoveResourceFinalizers(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*ApplicationResponse, error) {
+	out := new(ApplicationResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/RemoveResourceFinalizers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationServiceClient) ListResourceActions(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*ResourceActionsListResponse, error) {
 	out := new(ResourceActionsListResponse)
 	err := c.cc.Invoke(ctx, "/application.ApplicationService/ListResourceActions", in, out, opts...)
@@ -3484,18 +3958,26 @@ type ApplicationServiceServer interface {
 	Sync(context.Context, *ApplicationSyncRequest) (*v1alpha1.Application, error)
 	// ManagedResources returns list of managed resources
 	ManagedResources(context.Context, *ResourcesQuery) (*ManagedResourcesResponse, error)
+	// ServerSideDiff compares the target state with the live state using a server-side apply dry-run in the destination cluster
+	ServerSideDiff(context.Context, *ApplicationServerSideDiffQuery) (*ApplicationServerSideDiffResponse, error)
 	// ResourceTree returns resource tree
 	ResourceTree(context.Context, *ResourcesQuery) (*v1alpha1.ApplicationTree, error)
 	// Watch returns stream of application resource tree
 	WatchResourceTree(*ResourcesQuery, ApplicationService_WatchResourceTreeServer) error
 	// Rollback syncs an application to its target state
 	Rollback(context.Context, *ApplicationRollbackRequest) (*v1alpha1.Application, error)
+	// ApproveSync approves the automated sync of an application requiring a diff approval to a revision
+	ApproveSync(context.Context, *ApplicationApproveSyncRequest) (*v1alpha1.Application, error)
+	// DriftHistory returns the out-of-band changes of the live resources of an application reverted by the self-heal
+	DriftHistory(context.Context, *ApplicationDriftHistoryQuery) (*ApplicationDriftHistoryResponse, error)
 	// TerminateOperation terminates the currently running operation
 	TerminateOperation(context.Context, *OperationTerminateRequest) (*OperationTerminateResponse, error)
 	// GetResource returns single application resource
 	GetResource(context.Context, *ApplicationResourceRequest) (*ApplicationResourceResponse, error)
 	// PatchResource patch single application resource
 	PatchResource(context.Context, *ApplicationResourcePatchRequest) (*ApplicationResourceResponse, error)
+	// RemoveResourceFinalizers removes the finalizers of an application resource pending deletion
+	RemoveResourceFinalizers(context.Context, *ApplicationResourceRequest) (*ApplicationResponse, error)
 	// ListResourceActions returns list of resource actions
 	ListResourceActions(context.Context, *ApplicationResourceRequest) (*ResourceActionsListResponse, error)
 	// RunResourceAction run resource action
@@ -3562,6 +4044,9 @@ func (*UnimplementedApplicationServiceServer) Sync(ctx context.Context, req *App
 func (*UnimplementedApplicationServiceServer) ManagedResources(ctx context.Context, req *ResourcesQuery) (*ManagedResourcesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ManagedResources not implemented")
 }
+func (*UnimplementedApplicationServiceServer) ServerSideDiff(ctx context.Context, req *ApplicationServerSideDiffQuery) (*ApplicationServerSideDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServerSideDiff not implemented")
+}
 func (*UnimplementedApplicationServiceServer) ResourceTree(ctx context.Context, req *ResourcesQuery) (*v1alpha1.ApplicationTree, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResourceTree not implemented")
 }
@@ -3571,6 +4056,12 @@ func (*UnimplementedApplicationServiceServer) WatchResourceTree(req *ResourcesQu
 func (*UnimplementedApplicationServiceServer) Rollback(ctx context.Context, req *ApplicationRollbackRequest) (*v1alpha1.Application, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Rollback not implemented")
 }
+func (*UnimplementedApplicationServiceServer) ApproveSync(ctx context.Context, req *ApplicationApproveSyncRequest) (*v1alpha1.Application, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveSync not implemented")
+}
+func (*UnimplementedApplicationServiceServer) DriftHistory(ctx context.Context, req *ApplicationDriftHistoryQuery) (*ApplicationDriftHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DriftHistory not implemented")
+}
 func (*UnimplementedApplicationServiceServer) TerminateOperation(ctx context.Context, req *OperationTerminateRequest) (*OperationTerminateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TerminateOperation not implemented")
 }
@@ -3580,6 +4071,9 @@ func (*UnimplementedApplicationServiceServer) GetResource(ctx context.Context, r
 func (*UnimplementedApplicationServiceServer) PatchResource(ctx context.Context, req *ApplicationResourcePatchRequest) (*ApplicationResourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PatchResource not implemented")
 }
+func (*UnimplementedApplicationServiceServer) RemoveResourceFinalizers(ctx context.Context, req *ApplicationResourceRequest) (*ApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveResourceFinalizers not implemented")
+}
 func (*UnimplementedApplicationServiceServer) ListResourceActions(ctx context.Context, req *ApplicationResourceRequest) (*ResourceActionsListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListResourceActions not implemented")
 }
@@ -3902,6 +4396,24 @@ func _ApplicationService_ManagedResources_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationService_ServerSideDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationServerSideDiffQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ServerSideDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/ServerSideDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ServerSideDiff(ctx, req.(*ApplicationServerSideDiffQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApplicationService_ResourceTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResourcesQuery)
 	if err := dec(in); err != nil {
@@ -3959,6 +4471,42 @@ func _ApplicationService_Rollback_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationService_ApproveSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationApproveSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ApproveSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/ApproveSync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ApproveSync(ctx, req.(*ApplicationApproveSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_DriftHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationDriftHistoryQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).DriftHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/DriftHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).DriftHistory(ctx, req.(*ApplicationDriftHistoryQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApplicationService_TerminateOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(OperationTerminateRequest)
 	if err := dec(in); err != nil {
@@ -4013,6 +4561,24 @@ func _ApplicationService_PatchResource_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationService_RemoveResourceFinalizers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).RemoveResourceFinalizers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/RemoveResourceFinalizers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).RemoveResourceFinalizers(ctx, req.(*ApplicationResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApplicationService_ListResourceActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApplicationResourceRequest)
 	if err := dec(in); err != nil {
@@ -4184,6 +4750,10 @@ var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ManagedResources",
 			Handler:    _ApplicationService_ManagedResources_Handler,
 		},
+		{
+			MethodName: "ServerSideDiff",
+			Handler:    _ApplicationService_ServerSideDiff_Handler,
+		},
 		{
 			MethodName: "ResourceTree",
 			Handler:    _ApplicationService_ResourceTree_Handler,
@@ -4192,6 +4762,14 @@ var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Rollback",
 			Handler:    _ApplicationService_Rollback_Handler,
 		},
+		{
+			MethodName: "ApproveSync",
+			Handler:    _ApplicationService_ApproveSync_Handler,
+		},
+		{
+			MethodName: "DriftHistory",
+			Handler:    _ApplicationService_DriftHistory_Handler,
+		},
 		{
 			MethodName: "TerminateOperation",
 			Handler:    _ApplicationService_TerminateOperation_Handler,
@@ -4204,6 +4782,10 @@ var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PatchResource",
 			Handler:    _ApplicationService_PatchResource_Handler,
 		},
+		{
+			MethodName: "RemoveResourceFinalizers",
+			Handler:    _ApplicationService_RemoveResourceFinalizers_Handler,
+		},
 		{
 			MethodName: "ListResourceActions",
 			Handler:    _ApplicationService_ListResourceActions_Handler,
@@ -4274,6 +4856,15 @@ func (m *ApplicationQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.RefreshResources) > 0 {
+		for iNdEx := len(m.RefreshResources) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RefreshResources[iNdEx])
+			copy(dAtA[i:], m.RefreshResources[iNdEx])
+			i = encodeVarintApplication(dAtA, i, uint64(len(m.RefreshResources[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Project) > 0 {
 		for iNdEx := len(m.Project) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Project[iNdEx])
@@ -4542,6 +5133,53 @@ func (m *ApplicationManifestQuery) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Limit != nil {
+		i = encodeVarintApplication(dAtA, i, uint64(*m.Limit))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Offset != nil {
+		i = encodeVarintApplication(dAtA, i, uint64(*m.Offset))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Fields[iNdEx])
+			copy(dAtA[i:], m.Fields[iNdEx])
+			i = encodeVarintApplication(dAtA, i, uint64(len(m.Fields[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.ResourceName != nil {
+		i -= len(*m.ResourceName)
+		copy(dAtA[i:], *m.ResourceName)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.ResourceName)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Namespace != nil {
+		i -= len(*m.Namespace)
+		copy(dAtA[i:], *m.Namespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Namespace)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Kind != nil {
+		i -= len(*m.Kind)
+		copy(dAtA[i:], *m.Kind)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Kind)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Group != nil {
+		i -= len(*m.Group)
+		copy(dAtA[i:], *m.Group)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Group)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.Revisions) > 0 {
 		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Revisions[iNdEx])
@@ -5037,20 +5675,43 @@ func (m *ApplicationSyncRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Revisions) > 0 {
-		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Revisions[iNdEx])
-			copy(dAtA[i:], m.Revisions[iNdEx])
-			i = encodeVarintApplication(dAtA, i, uint64(len(m.Revisions[iNdEx])))
+	if len(m.ForceConflictsFor) > 0 {
+		for iNdEx := len(m.ForceConflictsFor) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ForceConflictsFor[iNdEx])
+			copy(dAtA[i:], m.ForceConflictsFor[iNdEx])
+			i = encodeVarintApplication(dAtA, i, uint64(len(m.ForceConflictsFor[iNdEx])))
 			i--
-			dAtA[i] = 0x7a
-		}
-	}
-	if len(m.SourcePositions) > 0 {
-		for iNdEx := len(m.SourcePositions) - 1; iNdEx >= 0; iNdEx-- {
-			i = encodeVarintApplication(dAtA, i, uint64(m.SourcePositions[iNdEx]))
+			dAtA[i] = 0x1
 			i--
-			dAtA[i] = 0x70
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.Resume != nil {
+		i--
+		if *m.Resume {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.Revisions) > 0 {
+		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Revisions[iNdEx])
+			copy(dAtA[i:], m.Revisions[iNdEx])
+			i = encodeVarintApplication(dAtA, i, uint64(len(m.Revisions[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.SourcePositions) > 0 {
+		for iNdEx := len(m.SourcePositions) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintApplication(dAtA, i, uint64(m.SourcePositions[iNdEx]))
+			i--
+			dAtA[i] = 0x70
 		}
 	}
 	if m.Project != nil {
@@ -5345,6 +6006,13 @@ func (m *ApplicationRollbackRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Revision != nil {
+		i -= len(*m.Revision)
+		copy(dAtA[i:], *m.Revision)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Revision)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.Project != nil {
 		i -= len(*m.Project)
 		copy(dAtA[i:], *m.Project)
@@ -6128,6 +6796,156 @@ func (m *LogEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationApproveSyncRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationApproveSyncRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationApproveSyncRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Revision == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("revision")
+	} else {
+		i -= len(*m.Revision)
+		copy(dAtA[i:], *m.Revision)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Revision)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationDriftHistoryQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationDriftHistoryQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationDriftHistoryQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationDriftHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationDriftHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationDriftHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OperationTerminateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6514,7 +7332,7 @@ func (m *ManagedResourcesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *LinkInfo) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationServerSideDiffQuery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6524,12 +7342,12 @@ func (m *LinkInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LinkInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationServerSideDiffQuery) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LinkInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationServerSideDiffQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6538,42 +7356,42 @@ func (m *LinkInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.IconClass != nil {
-		i -= len(*m.IconClass)
-		copy(dAtA[i:], *m.IconClass)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.IconClass)))
-		i--
-		dAtA[i] = 0x22
+	if len(m.TargetManifests) > 0 {
+		for iNdEx := len(m.TargetManifests) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TargetManifests[iNdEx])
+			copy(dAtA[i:], m.TargetManifests[iNdEx])
+			i = encodeVarintApplication(dAtA, i, uint64(len(m.TargetManifests[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.Description != nil {
-		i -= len(*m.Description)
-		copy(dAtA[i:], *m.Description)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Description)))
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Url == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("url")
-	} else {
-		i -= len(*m.Url)
-		copy(dAtA[i:], *m.Url)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Url)))
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Title == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("title")
+	if m.AppName == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("appName")
 	} else {
-		i -= len(*m.Title)
-		copy(dAtA[i:], *m.Title)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Title)))
+		i -= len(*m.AppName)
+		copy(dAtA[i:], *m.AppName)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppName)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *LinksResponse) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationServerSideDiffResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6583,12 +7401,12 @@ func (m *LinksResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LinksResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationServerSideDiffResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LinksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationServerSideDiffResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6597,6 +7415,18 @@ func (m *LinksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Modified == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("modified")
+	} else {
+		i--
+		if *m.Modified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Items) > 0 {
 		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -6614,7 +7444,7 @@ func (m *LinksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ListAppLinksRequest) Marshal() (dAtA []byte, err error) {
+func (m *LinkInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6624,12 +7454,12 @@ func (m *ListAppLinksRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListAppLinksRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *LinkInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListAppLinksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LinkInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6638,32 +7468,132 @@ func (m *ListAppLinksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Project != nil {
-		i -= len(*m.Project)
-		copy(dAtA[i:], *m.Project)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+	if m.IconClass != nil {
+		i -= len(*m.IconClass)
+		copy(dAtA[i:], *m.IconClass)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.IconClass)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.Namespace != nil {
-		i -= len(*m.Namespace)
-		copy(dAtA[i:], *m.Namespace)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Namespace)))
+	if m.Description != nil {
+		i -= len(*m.Description)
+		copy(dAtA[i:], *m.Description)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Description)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Name == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	if m.Url == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("url")
 	} else {
-		i -= len(*m.Name)
-		copy(dAtA[i:], *m.Name)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i -= len(*m.Url)
+		copy(dAtA[i:], *m.Url)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Url)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-
+	if m.Title == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("title")
+	} else {
+		i -= len(*m.Title)
+		copy(dAtA[i:], *m.Title)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LinksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LinksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LinksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListAppLinksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAppLinksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAppLinksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Namespace != nil {
+		i -= len(*m.Namespace)
+		copy(dAtA[i:], *m.Namespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Namespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintApplication(dAtA []byte, offset int, v uint64) int {
 	offset -= sovApplication(v)
 	base := offset
@@ -6717,6 +7647,12 @@ func (m *ApplicationQuery) Size() (n int) {
 			n += 1 + l + sovApplication(uint64(l))
 		}
 	}
+	if len(m.RefreshResources) > 0 {
+		for _, s := range m.RefreshResources {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6846,6 +7782,34 @@ func (m *ApplicationManifestQuery) Size() (n int) {
 			n += 1 + l + sovApplication(uint64(l))
 		}
 	}
+	if m.Group != nil {
+		l = len(*m.Group)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Kind != nil {
+		l = len(*m.Kind)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Namespace != nil {
+		l = len(*m.Namespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.ResourceName != nil {
+		l = len(*m.ResourceName)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.Offset != nil {
+		n += 1 + sovApplication(uint64(*m.Offset))
+	}
+	if m.Limit != nil {
+		n += 1 + sovApplication(uint64(*m.Limit))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7110,6 +8074,15 @@ func (m *ApplicationSyncRequest) Size() (n int) {
 			n += 1 + l + sovApplication(uint64(l))
 		}
 	}
+	if m.Resume != nil {
+		n += 3
+	}
+	if len(m.ForceConflictsFor) > 0 {
+		for _, s := range m.ForceConflictsFor {
+			l = len(s)
+			n += 2 + l + sovApplication(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7206,6 +8179,10 @@ func (m *ApplicationRollbackRequest) Size() (n int) {
 		l = len(*m.Project)
 		n += 1 + l + sovApplication(uint64(l))
 	}
+	if m.Revision != nil {
+		l = len(*m.Revision)
+		n += 1 + l + sovApplication(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7546,6 +8523,76 @@ func (m *LogEntry) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationApproveSyncRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Revision != nil {
+		l = len(*m.Revision)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationDriftHistoryQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationDriftHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *OperationTerminateRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7722,6 +8769,57 @@ func (m *ManagedResourcesResponse) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationServerSideDiffQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppName != nil {
+		l = len(*m.AppName)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if len(m.TargetManifests) > 0 {
+		for _, s := range m.TargetManifests {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationServerSideDiffResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.Modified != nil {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *LinkInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8089,11 +9187,43 @@ func (m *ApplicationQuery) Unmarshal(dAtA []byte) error {
 			}
 			m.Project = append(m.Project, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshResources", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefreshResources = append(m.RefreshResources, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthApplication
@@ -8985,6 +10115,210 @@ func (m *ApplicationManifestQuery) Unmarshal(dAtA []byte) error {
 			}
 			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Group = &s
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Kind = &s
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Namespace = &s
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ResourceName = &s
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Offset = &v
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Limit = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -10557,17 +11891,70 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resume", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApplication
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			b := bool(v != 0)
+			m.Resume = &b
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceConflictsFor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForceConflictsFor = append(m.ForceConflictsFor, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
 			iNdEx += skippy
@@ -11220,6 +12607,39 @@ func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.Project = &s
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Revision = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -13689,7 +15109,7 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperationTerminateRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplicationApproveSyncRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -13713,10 +15133,10 @@ func (m *OperationTerminateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperationTerminateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationApproveSyncRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperationTerminateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationApproveSyncRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13754,6 +15174,40 @@ func (m *OperationTerminateRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Revision = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
 			}
@@ -13786,7 +15240,7 @@ func (m *OperationTerminateRequest) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.AppNamespace = &s
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
@@ -13838,13 +15292,16 @@ func (m *OperationTerminateRequest) Unmarshal(dAtA []byte) error {
 	if hasFields[0]&uint64(0x00000001) == 0 {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("revision")
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *ApplicationSyncWindowsQuery) Unmarshal(dAtA []byte) error {
+func (m *ApplicationDriftHistoryQuery) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -13868,10 +15325,10 @@ func (m *ApplicationSyncWindowsQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSyncWindowsQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationDriftHistoryQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSyncWindowsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationDriftHistoryQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13999,8 +15456,7 @@ func (m *ApplicationSyncWindowsQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSyncWindowsResponse) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
+func (m *ApplicationDriftHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14023,49 +15479,15 @@ func (m *ApplicationSyncWindowsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSyncWindowsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationDriftHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSyncWindowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationDriftHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveWindows", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ActiveWindows = append(m.ActiveWindows, &ApplicationSyncWindow{})
-			if err := m.ActiveWindows[len(m.ActiveWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssignedWindows", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14092,33 +15514,11 @@ func (m *ApplicationSyncWindowsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssignedWindows = append(m.AssignedWindows, &ApplicationSyncWindow{})
-			if err := m.AssignedWindows[len(m.AssignedWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, &v1alpha1.DriftEvent{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanSync", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.CanSync = &b
-			hasFields[0] |= uint64(0x00000001)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -14135,16 +15535,13 @@ func (m *ApplicationSyncWindowsResponse) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("canSync")
-	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
+func (m *OperationTerminateRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -14168,15 +15565,15 @@ func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSyncWindow: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperationTerminateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSyncWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperationTerminateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14205,12 +15602,12 @@ func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Kind = &s
+			m.Name = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14239,12 +15636,11 @@ func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Schedule = &s
+			m.AppNamespace = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14273,31 +15669,8 @@ func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Duration = &s
+			m.Project = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000004)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManualSync", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.ManualSync = &b
-			hasFields[0] |= uint64(0x00000008)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -14315,16 +15688,7 @@ func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
-	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("schedule")
-	}
-	if hasFields[0]&uint64(0x00000004) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("duration")
-	}
-	if hasFields[0]&uint64(0x00000008) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("manualSync")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	}
 
 	if iNdEx > l {
@@ -14332,7 +15696,8 @@ func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperationTerminateResponse) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSyncWindowsQuery) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14355,17 +15720,504 @@ func (m *OperationTerminateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperationTerminateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSyncWindowsQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperationTerminateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSyncWindowsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSyncWindowsResponse) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSyncWindowsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSyncWindowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveWindows = append(m.ActiveWindows, &ApplicationSyncWindow{})
+			if err := m.ActiveWindows[len(m.ActiveWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignedWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssignedWindows = append(m.AssignedWindows, &ApplicationSyncWindow{})
+			if err := m.AssignedWindows[len(m.AssignedWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanSync", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.CanSync = &b
+			hasFields[0] |= uint64(0x00000001)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("canSync")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSyncWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSyncWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Kind = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Schedule = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Duration = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManualSync", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.ManualSync = &b
+			hasFields[0] |= uint64(0x00000008)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("schedule")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("duration")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("manualSync")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperationTerminateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperationTerminateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperationTerminateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthApplication
@@ -14394,28 +16246,128 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowApplication
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourcesQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourcesQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ApplicationName = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Namespace = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourcesQuery: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourcesQuery: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14444,12 +16396,11 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.ApplicationName = &s
+			m.Version = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14478,11 +16429,11 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Namespace = &s
+			m.Group = &s
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14511,11 +16462,11 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			m.Kind = &s
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14544,11 +16495,11 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Version = &s
+			m.AppNamespace = &s
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14577,11 +16528,151 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Group = &s
+			m.Project = &s
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("applicationName")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ManagedResourcesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManagedResourcesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManagedResourcesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &v1alpha1.ResourceDiff{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationServerSideDiffQuery) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationServerSideDiffQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationServerSideDiffQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14610,9 +16701,10 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Kind = &s
+			m.AppName = &s
 			iNdEx = postIndex
-		case 7:
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
 			}
@@ -14645,7 +16737,7 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.AppNamespace = &s
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
@@ -14678,6 +16770,38 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.Project = &s
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetManifests", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetManifests = append(m.TargetManifests, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -14695,7 +16819,7 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("applicationName")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("appName")
 	}
 
 	if iNdEx > l {
@@ -14703,7 +16827,8 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ManagedResourcesResponse) Unmarshal(dAtA []byte) error {
+func (m *ApplicationServerSideDiffResponse) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14726,10 +16851,10 @@ func (m *ManagedResourcesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ManagedResourcesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationServerSideDiffResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManagedResourcesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationServerSideDiffResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14766,6 +16891,28 @@ func (m *ManagedResourcesResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Modified = &b
+			hasFields[0] |= uint64(0x00000001)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -14782,6 +16929,9 @@ func (m *ManagedResourcesResponse) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("modified")
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
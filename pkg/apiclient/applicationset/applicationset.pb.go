@@ -483,6 +483,73 @@ func (m *ApplicationSetGenerateResponse) GetApplications() []*v1alpha1.Applicati
 	return nil
 }
 
+// ApplicationSetPauseRequest is a request to pause or resume the reconciliation of Applications generated by an applicationset
+type ApplicationSetPauseRequest struct {
+	// the applicationset's name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The application set namespace. Default empty is argocd control plane namespace
+	AppsetNamespace string `protobuf:"bytes,2,opt,name=appsetNamespace,proto3" json:"appsetNamespace,omitempty"`
+	// the names of the generated applications
+	Applications         []string `protobuf:"bytes,3,rep,name=applications,proto3" json:"applications,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationSetPauseRequest) Reset()         { *m = ApplicationSetPauseRequest{} }
+func (m *ApplicationSetPauseRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSetPauseRequest) ProtoMessage()    {}
+func (*ApplicationSetPauseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacb9df0ce5738fa, []int{8}
+}
+func (m *ApplicationSetPauseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetPauseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSetPauseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationSetPauseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetPauseRequest.Merge(m, src)
+}
+func (m *ApplicationSetPauseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetPauseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetPauseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetPauseRequest proto.InternalMessageInfo
+
+func (m *ApplicationSetPauseRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationSetPauseRequest) GetAppsetNamespace() string {
+	if m != nil {
+		return m.AppsetNamespace
+	}
+	return ""
+}
+
+func (m *ApplicationSetPauseRequest) GetApplications() []string {
+	if m != nil {
+		return m.Applications
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ApplicationSetGetQuery)(nil), "applicationset.ApplicationSetGetQuery")
 	proto.RegisterType((*ApplicationSetListQuery)(nil), "applicationset.ApplicationSetListQuery")
@@ -492,6 +559,7 @@ func init() {
 	proto.RegisterType((*ApplicationSetTreeQuery)(nil), "applicationset.ApplicationSetTreeQuery")
 	proto.RegisterType((*ApplicationSetGenerateRequest)(nil), "applicationset.ApplicationSetGenerateRequest")
 	proto.RegisterType((*ApplicationSetGenerateResponse)(nil), "applicationset.ApplicationSetGenerateResponse")
+	proto.RegisterType((*ApplicationSetPauseRequest)(nil), "applicationset.ApplicationSetPauseRequest")
 }
 
 func init() {
@@ -499,49 +567,53 @@ func init() {
 }
 
 var fileDescriptor_eacb9df0ce5738fa = []byte{
-	// 664 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0xcf, 0x6b, 0xd4, 0x40,
-	0x14, 0xc7, 0x99, 0xb6, 0x6c, 0xb7, 0xd3, 0xa2, 0x30, 0x60, 0xbb, 0x46, 0x5d, 0x97, 0x80, 0xb5,
-	0xb6, 0x76, 0x42, 0x5b, 0x4f, 0xf5, 0xe4, 0x0f, 0x28, 0x85, 0x22, 0x9a, 0x15, 0x05, 0x3d, 0xc8,
-	0x34, 0xfb, 0x48, 0x63, 0xb3, 0xc9, 0x38, 0x33, 0x09, 0x94, 0xe2, 0x45, 0xf0, 0xec, 0x41, 0xf4,
-	0x0f, 0xd0, 0x8b, 0x7f, 0x80, 0x77, 0x0f, 0x5e, 0x3c, 0x0a, 0xfe, 0x03, 0x52, 0xfc, 0x33, 0x3c,
-	0x48, 0x26, 0xd9, 0x6d, 0x33, 0xec, 0x8f, 0x82, 0xd1, 0x5b, 0x5e, 0x66, 0xf2, 0xde, 0x67, 0xbe,
-	0xef, 0xe5, 0xcb, 0xe0, 0x65, 0x09, 0x22, 0x05, 0xe1, 0x30, 0xce, 0xc3, 0xc0, 0x63, 0x2a, 0x88,
-	0x23, 0x09, 0xca, 0x08, 0x29, 0x17, 0xb1, 0x8a, 0xc9, 0x99, 0xf2, 0x5b, 0xeb, 0xa2, 0x1f, 0xc7,
-	0x7e, 0x08, 0x0e, 0xe3, 0x81, 0xc3, 0xa2, 0x28, 0x56, 0xf9, 0x4a, 0xbe, 0xdb, 0xda, 0xf1, 0x03,
-	0xb5, 0x97, 0xec, 0x52, 0x2f, 0xee, 0x3a, 0x4c, 0xf8, 0x31, 0x17, 0xf1, 0x73, 0xfd, 0xb0, 0xea,
-	0x75, 0x9c, 0x74, 0xc3, 0xe1, 0xfb, 0x7e, 0xf6, 0xa5, 0x3c, 0x59, 0xcb, 0x49, 0xd7, 0x58, 0xc8,
-	0xf7, 0xd8, 0x9a, 0xe3, 0x43, 0x04, 0x82, 0x29, 0xe8, 0xe4, 0xd9, 0xec, 0x47, 0x78, 0xfe, 0xd6,
-	0xf1, 0xbe, 0x36, 0xa8, 0x2d, 0x50, 0x0f, 0x12, 0x10, 0x07, 0x84, 0xe0, 0xa9, 0x88, 0x75, 0xa1,
-	0x81, 0x5a, 0x68, 0x69, 0xc6, 0xd5, 0xcf, 0x64, 0x09, 0x9f, 0x65, 0x9c, 0x4b, 0x50, 0xf7, 0x58,
-	0x17, 0x24, 0x67, 0x1e, 0x34, 0x26, 0xf4, 0xb2, 0xf9, 0xda, 0x3e, 0xc4, 0x0b, 0xe5, 0xbc, 0x3b,
-	0x81, 0x2c, 0x12, 0x5b, 0xb8, 0x9e, 0x31, 0x83, 0xa7, 0x64, 0x03, 0xb5, 0x26, 0x97, 0x66, 0xdc,
-	0x7e, 0x9c, 0xad, 0x49, 0x08, 0xc1, 0x53, 0xb1, 0x28, 0x32, 0xf7, 0xe3, 0x41, 0xc5, 0x27, 0x07,
-	0x17, 0xff, 0x84, 0xcc, 0x53, 0xb9, 0x20, 0x79, 0x26, 0x2e, 0x69, 0xe0, 0xe9, 0xa2, 0x58, 0x71,
-	0xb0, 0x5e, 0x48, 0x14, 0x36, 0xfa, 0xa0, 0x01, 0x66, 0xd7, 0x77, 0xe8, 0xb1, 0xe0, 0xb4, 0x27,
-	0xb8, 0x7e, 0x78, 0xe6, 0x75, 0x68, 0xba, 0x41, 0xf9, 0xbe, 0x4f, 0x33, 0xc1, 0xe9, 0x89, 0xcf,
-	0x69, 0x4f, 0x70, 0x6a, 0x70, 0x18, 0x35, 0xec, 0xaf, 0x08, 0x5f, 0x28, 0x6f, 0xb9, 0x23, 0x80,
-	0x29, 0x70, 0xe1, 0x45, 0x02, 0x72, 0x10, 0x15, 0xfa, 0xf7, 0x54, 0x64, 0x1e, 0xd7, 0x12, 0x2e,
-	0x41, 0xe4, 0x1a, 0xd4, 0xdd, 0x22, 0xca, 0xde, 0x77, 0xc4, 0x81, 0x9b, 0x44, 0x5a, 0xf9, 0xba,
-	0x5b, 0x44, 0xf6, 0x53, 0xf3, 0x10, 0x77, 0x21, 0x84, 0xe3, 0x43, 0xfc, 0xdd, 0x28, 0x3d, 0x36,
-	0x47, 0xe9, 0xa1, 0x00, 0xa8, 0x62, 0x46, 0xdf, 0x21, 0x7c, 0xc9, 0x1c, 0xfe, 0xfc, 0xef, 0x18,
-	0xac, 0x7e, 0xfb, 0x3f, 0xa8, 0xdf, 0x06, 0x65, 0xbf, 0x41, 0xb8, 0x39, 0x8c, 0xab, 0x18, 0xe3,
-	0x2e, 0x9e, 0x3b, 0xd9, 0x32, 0xfd, 0x1f, 0xcd, 0xae, 0x6f, 0x57, 0x86, 0xe5, 0x96, 0xd2, 0xaf,
-	0xff, 0x9e, 0xc6, 0xe7, 0xca, 0x44, 0x6d, 0x10, 0x69, 0xe0, 0x01, 0xf9, 0x88, 0xf0, 0xe4, 0x16,
-	0x28, 0xb2, 0x48, 0x0d, 0x6b, 0x1b, 0xec, 0x2a, 0x56, 0xa5, 0xca, 0xd9, 0x8b, 0xaf, 0x7e, 0xfc,
-	0x7a, 0x3b, 0xd1, 0x22, 0x4d, 0xed, 0x95, 0xe9, 0x9a, 0xe1, 0xaf, 0xd2, 0x39, 0xcc, 0x46, 0xe2,
-	0x25, 0x79, 0x8f, 0x70, 0xbd, 0xa7, 0x21, 0x59, 0x1d, 0x87, 0x5a, 0x9a, 0x01, 0x8b, 0x9e, 0x76,
-	0x7b, 0xde, 0x1a, 0x7b, 0x45, 0x33, 0x5d, 0xb1, 0x5b, 0xc3, 0x98, 0x7a, 0x16, 0xbc, 0x89, 0x96,
-	0xc9, 0x07, 0x84, 0xa7, 0x32, 0x67, 0x24, 0x57, 0x47, 0x57, 0xe9, 0xbb, 0xa7, 0x75, 0xbf, 0x4a,
-	0x01, 0xb3, 0xb4, 0xf6, 0x65, 0x0d, 0x7c, 0x9e, 0x2c, 0x0c, 0x01, 0x26, 0x9f, 0x11, 0xae, 0xe5,
-	0xae, 0x44, 0x56, 0x46, 0x63, 0x96, 0xbc, 0xab, 0xe2, 0x5e, 0x3b, 0x1a, 0xf3, 0x9a, 0x3d, 0x0c,
-	0x73, 0xd3, 0x34, 0xb1, 0xd7, 0x08, 0xd7, 0x72, 0x1f, 0x1a, 0x87, 0x5d, 0x72, 0x2b, 0x6b, 0xcc,
-	0x28, 0xf7, 0x1b, 0x5d, 0x0c, 0xdf, 0xf2, 0xb8, 0xe1, 0xfb, 0x82, 0xf0, 0x9c, 0x0b, 0x32, 0x4e,
-	0x84, 0x07, 0x99, 0x75, 0x8d, 0xeb, 0x75, 0xdf, 0xde, 0xaa, 0xed, 0x75, 0x96, 0xd6, 0xbe, 0xa1,
-	0x99, 0x29, 0xb9, 0x3e, 0x9a, 0xd9, 0x11, 0x05, 0xef, 0xaa, 0x12, 0x00, 0xb7, 0xb7, 0xbf, 0x1d,
-	0x35, 0xd1, 0xf7, 0xa3, 0x26, 0xfa, 0x79, 0xd4, 0x44, 0x4f, 0x6e, 0x9e, 0xee, 0x02, 0xe2, 0x85,
-	0x01, 0x44, 0xe6, 0x8d, 0x67, 0xb7, 0xa6, 0xaf, 0x1d, 0x1b, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0xc5, 0x6a, 0x1d, 0x1e, 0x20, 0x09, 0x00, 0x00,
+	// 732 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0xcf, 0x6b, 0x13, 0x4d,
+	0x18, 0xc7, 0x99, 0xa6, 0x6f, 0xde, 0x74, 0x5a, 0xde, 0x17, 0x06, 0x6c, 0xe3, 0xaa, 0x31, 0x0c,
+	0xb6, 0xd6, 0xd4, 0xee, 0xda, 0xd6, 0x53, 0x3d, 0xf9, 0x03, 0x4a, 0xa1, 0x48, 0xdd, 0x88, 0x82,
+	0x1e, 0x64, 0xba, 0x79, 0x48, 0x63, 0x93, 0xec, 0x38, 0x33, 0x1b, 0x28, 0x45, 0x0f, 0x82, 0x37,
+	0xd1, 0x83, 0xe8, 0xc9, 0x93, 0x5e, 0xfc, 0x03, 0xbc, 0x7b, 0xf0, 0xe2, 0x51, 0xf0, 0x1f, 0x90,
+	0xe2, 0x1f, 0x22, 0x33, 0xbb, 0x49, 0xb3, 0x43, 0x7e, 0x14, 0xdc, 0x7a, 0xdb, 0x99, 0x9d, 0x7d,
+	0xe6, 0x33, 0xdf, 0xe7, 0x79, 0xbe, 0x3b, 0xb8, 0x22, 0x41, 0x74, 0x40, 0x78, 0x8c, 0xf3, 0x66,
+	0x23, 0x60, 0xaa, 0x11, 0xb6, 0x25, 0x28, 0x6b, 0xe8, 0x72, 0x11, 0xaa, 0x90, 0xfc, 0x97, 0x9e,
+	0x75, 0xce, 0xd6, 0xc3, 0xb0, 0xde, 0x04, 0x8f, 0xf1, 0x86, 0xc7, 0xda, 0xed, 0x50, 0xc5, 0x6f,
+	0xe2, 0xd5, 0xce, 0x56, 0xbd, 0xa1, 0x76, 0xa3, 0x1d, 0x37, 0x08, 0x5b, 0x1e, 0x13, 0xf5, 0x90,
+	0x8b, 0xf0, 0xb1, 0x79, 0x58, 0x0e, 0x6a, 0x5e, 0x67, 0xcd, 0xe3, 0x7b, 0x75, 0xfd, 0xa5, 0xec,
+	0xdf, 0xcb, 0xeb, 0xac, 0xb0, 0x26, 0xdf, 0x65, 0x2b, 0x5e, 0x1d, 0xda, 0x20, 0x98, 0x82, 0x5a,
+	0x1c, 0x8d, 0xde, 0xc3, 0xb3, 0xd7, 0x8f, 0xd6, 0x55, 0x41, 0x6d, 0x80, 0xba, 0x13, 0x81, 0xd8,
+	0x27, 0x04, 0x4f, 0xb6, 0x59, 0x0b, 0x8a, 0xa8, 0x8c, 0x16, 0xa7, 0x7c, 0xf3, 0x4c, 0x16, 0xf1,
+	0xff, 0x8c, 0x73, 0x09, 0xea, 0x36, 0x6b, 0x81, 0xe4, 0x2c, 0x80, 0xe2, 0x84, 0x79, 0x6d, 0x4f,
+	0xd3, 0x03, 0x3c, 0x97, 0x8e, 0xbb, 0xd5, 0x90, 0x49, 0x60, 0x07, 0x17, 0x34, 0x33, 0x04, 0x4a,
+	0x16, 0x51, 0x39, 0xb7, 0x38, 0xe5, 0xf7, 0xc6, 0xfa, 0x9d, 0x84, 0x26, 0x04, 0x2a, 0x14, 0x49,
+	0xe4, 0xde, 0x78, 0xd0, 0xe6, 0xb9, 0xc1, 0x9b, 0x7f, 0x42, 0xf6, 0xa9, 0x7c, 0x90, 0x5c, 0x8b,
+	0x4b, 0x8a, 0xf8, 0xdf, 0x64, 0xb3, 0xe4, 0x60, 0xdd, 0x21, 0x51, 0xd8, 0xca, 0x83, 0x01, 0x98,
+	0x5e, 0xdd, 0x72, 0x8f, 0x04, 0x77, 0xbb, 0x82, 0x9b, 0x87, 0x47, 0x41, 0xcd, 0xed, 0xac, 0xb9,
+	0x7c, 0xaf, 0xee, 0x6a, 0xc1, 0xdd, 0xbe, 0xcf, 0xdd, 0xae, 0xe0, 0xae, 0xc5, 0x61, 0xed, 0x41,
+	0xbf, 0x22, 0x7c, 0x26, 0xbd, 0xe4, 0xa6, 0x00, 0xa6, 0xc0, 0x87, 0x27, 0x11, 0xc8, 0x41, 0x54,
+	0xe8, 0xe4, 0xa9, 0xc8, 0x2c, 0xce, 0x47, 0x5c, 0x82, 0x88, 0x35, 0x28, 0xf8, 0xc9, 0x48, 0xcf,
+	0xd7, 0xc4, 0xbe, 0x1f, 0xb5, 0x8d, 0xf2, 0x05, 0x3f, 0x19, 0xd1, 0x87, 0xf6, 0x21, 0x6e, 0x41,
+	0x13, 0x8e, 0x0e, 0xf1, 0x67, 0xa5, 0x74, 0xdf, 0x2e, 0xa5, 0xbb, 0x02, 0x20, 0x8b, 0x1a, 0x7d,
+	0x8b, 0xf0, 0x39, 0xbb, 0xf8, 0xe3, 0xee, 0x18, 0xac, 0x7e, 0xf5, 0x2f, 0xa8, 0x5f, 0x05, 0x45,
+	0x5f, 0x23, 0x5c, 0x1a, 0xc6, 0x95, 0x94, 0x71, 0x0b, 0xcf, 0xf4, 0xa7, 0xcc, 0xf4, 0xd1, 0xf4,
+	0xea, 0x66, 0x66, 0x58, 0x7e, 0x2a, 0x3c, 0x7d, 0x86, 0x9d, 0x34, 0xd0, 0x36, 0x8b, 0x64, 0x36,
+	0xe9, 0x25, 0xd4, 0x3a, 0x4a, 0xce, 0x58, 0x42, 0x6a, 0x6e, 0xf5, 0x3d, 0xc6, 0xa7, 0xd2, 0x00,
+	0x55, 0x10, 0x9d, 0x46, 0x00, 0xe4, 0x23, 0xc2, 0xb9, 0x0d, 0x50, 0x64, 0xc1, 0xb5, 0xac, 0x75,
+	0xb0, 0xab, 0x39, 0x99, 0x66, 0x8e, 0x2e, 0x3c, 0xff, 0xf1, 0xeb, 0xcd, 0x44, 0x99, 0x94, 0x8c,
+	0x57, 0x77, 0x56, 0x2c, 0x7f, 0x97, 0xde, 0x81, 0x16, 0xe3, 0x29, 0x79, 0x87, 0x70, 0xa1, 0x9b,
+	0x43, 0xb2, 0x3c, 0x0e, 0x35, 0x55, 0x83, 0x8e, 0x7b, 0xdc, 0xe5, 0x71, 0x69, 0xd0, 0x25, 0xc3,
+	0x34, 0x4f, 0xcb, 0xc3, 0x98, 0xba, 0xbf, 0x80, 0x75, 0x54, 0x21, 0x1f, 0x10, 0x9e, 0xd4, 0xce,
+	0x4c, 0x2e, 0x8e, 0xde, 0xa5, 0xe7, 0xde, 0xce, 0x76, 0x96, 0x02, 0xea, 0xb0, 0xf4, 0xbc, 0x01,
+	0x3e, 0x4d, 0xe6, 0x86, 0x00, 0x93, 0xcf, 0x08, 0xe7, 0x63, 0x57, 0x24, 0x4b, 0xa3, 0x31, 0x53,
+	0xde, 0x99, 0x71, 0xae, 0x3d, 0x83, 0x79, 0x89, 0x0e, 0xc3, 0x5c, 0xb7, 0x4d, 0xf4, 0x05, 0xc2,
+	0xf9, 0xd8, 0x07, 0xc7, 0x61, 0xa7, 0xdc, 0xd2, 0x19, 0x53, 0xca, 0xbd, 0x44, 0x27, 0xc5, 0x57,
+	0x19, 0x57, 0x7c, 0x2f, 0x11, 0xfe, 0xc7, 0xf4, 0x2b, 0xa9, 0x8c, 0x8e, 0xdc, 0xdf, 0xd4, 0xc7,
+	0xa6, 0xe8, 0xca, 0x72, 0x61, 0x34, 0x85, 0xc7, 0x75, 0x70, 0x5d, 0x72, 0xaf, 0x10, 0xce, 0xfb,
+	0x20, 0xa3, 0xd6, 0xc9, 0xf0, 0x5c, 0x31, 0x3c, 0x15, 0x3a, 0x3f, 0x86, 0x47, 0x18, 0x04, 0x0d,
+	0xf4, 0x05, 0xe1, 0x19, 0x1f, 0x64, 0x18, 0x89, 0x00, 0xf4, 0xaf, 0x65, 0x5c, 0x2f, 0xf4, 0x7e,
+	0x3f, 0xd9, 0xf6, 0x82, 0x0e, 0x4b, 0xaf, 0x1a, 0x7a, 0x97, 0x5c, 0x1e, 0x4f, 0x6f, 0x78, 0x97,
+	0x95, 0x00, 0xb8, 0xb1, 0xf9, 0xed, 0xb0, 0x84, 0xbe, 0x1f, 0x96, 0xd0, 0xcf, 0xc3, 0x12, 0x7a,
+	0x70, 0xed, 0x78, 0x17, 0xc4, 0xa0, 0xd9, 0x80, 0xb6, 0x7d, 0x23, 0xdd, 0xc9, 0x9b, 0x6b, 0xe1,
+	0xda, 0xef, 0x01, 0x00, 0x79, 0xd3, 0x8e, 0xc7, 0xc0, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -566,6 +638,10 @@ type ApplicationSetServiceClient interface {
 	Create(ctx context.Context, in *ApplicationSetCreateRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSet, error)
 	// Delete deletes an application set
 	Delete(ctx context.Context, in *ApplicationSetDeleteRequest, opts ...grpc.CallOption) (*ApplicationSetResponse, error)
+	// Pause excludes generated applications from the reconciliation of the application set, without deleting them
+	Pause(ctx context.Context, in *ApplicationSetPauseRequest, opts ...grpc.CallOption) (*ApplicationSetResponse, error)
+	// Resume resumes the reconciliation of paused generated applications
+	Resume(ctx context.Context, in *ApplicationSetPauseRequest, opts ...grpc.CallOption) (*ApplicationSetResponse, error)
 	// ResourceTree returns resource tree
 	ResourceTree(ctx context.Context, in *ApplicationSetTreeQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationSetTree, error)
 }
@@ -623,6 +699,24 @@ func (c *applicationSetServiceClient) Delete(ctx context.Context, in *Applicatio
 	return out, nil
 }
 
+func (c *applicationSetServiceClient) Pause(ctx context.Context, in *ApplicationSetPauseRequest, opts ...grpc.CallOption) (*ApplicationSetResponse, error) {
+	out := new(ApplicationSetResponse)
+	err := c.cc.Invoke(ctx, "/applicationset.ApplicationSetService/Pause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationSetServiceClient) Resume(ctx context.Context, in *ApplicationSetPauseRequest, opts ...grpc.CallOption) (*ApplicationSetResponse, error) {
+	out := new(ApplicationSetResponse)
+	err := c.cc.Invoke(ctx, "/applicationset.ApplicationSetService/Resume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationSetServiceClient) ResourceTree(ctx context.Context, in *ApplicationSetTreeQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationSetTree, error) {
 	out := new(v1alpha1.ApplicationSetTree)
 	err := c.cc.Invoke(ctx, "/applicationset.ApplicationSetService/ResourceTree", in, out, opts...)
@@ -644,6 +738,10 @@ type ApplicationSetServiceServer interface {
 	Create(context.Context, *ApplicationSetCreateRequest) (*v1alpha1.ApplicationSet, error)
 	// Delete deletes an application set
 	Delete(context.Context, *ApplicationSetDeleteRequest) (*ApplicationSetResponse, error)
+	// Pause excludes generated applications from the reconciliation of the application set, without deleting them
+	Pause(context.Context, *ApplicationSetPauseRequest) (*ApplicationSetResponse, error)
+	// Resume resumes the reconciliation of paused generated applications
+	Resume(context.Context, *ApplicationSetPauseRequest) (*ApplicationSetResponse, error)
 	// ResourceTree returns resource tree
 	ResourceTree(context.Context, *ApplicationSetTreeQuery) (*v1alpha1.ApplicationSetTree, error)
 }
@@ -667,6 +765,12 @@ func (*UnimplementedApplicationSetServiceServer) Create(ctx context.Context, req
 func (*UnimplementedApplicationSetServiceServer) Delete(ctx context.Context, req *ApplicationSetDeleteRequest) (*ApplicationSetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedApplicationSetServiceServer) Pause(ctx context.Context, req *ApplicationSetPauseRequest) (*ApplicationSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+}
+func (*UnimplementedApplicationSetServiceServer) Resume(ctx context.Context, req *ApplicationSetPauseRequest) (*ApplicationSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resume not implemented")
+}
 func (*UnimplementedApplicationSetServiceServer) ResourceTree(ctx context.Context, req *ApplicationSetTreeQuery) (*v1alpha1.ApplicationSetTree, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResourceTree not implemented")
 }
@@ -765,6 +869,42 @@ func _ApplicationSetService_Delete_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationSetService_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationSetPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationSetServiceServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/applicationset.ApplicationSetService/Pause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationSetServiceServer).Pause(ctx, req.(*ApplicationSetPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationSetService_Resume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationSetPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationSetServiceServer).Resume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/applicationset.ApplicationSetService/Resume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationSetServiceServer).Resume(ctx, req.(*ApplicationSetPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApplicationSetService_ResourceTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApplicationSetTreeQuery)
 	if err := dec(in); err != nil {
@@ -807,6 +947,14 @@ var _ApplicationSetService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _ApplicationSetService_Delete_Handler,
 		},
+		{
+			MethodName: "Pause",
+			Handler:    _ApplicationSetService_Pause_Handler,
+		},
+		{
+			MethodName: "Resume",
+			Handler:    _ApplicationSetService_Resume_Handler,
+		},
 		{
 			MethodName: "ResourceTree",
 			Handler:    _ApplicationSetService_ResourceTree_Handler,
@@ -1174,6 +1322,56 @@ func (m *ApplicationSetGenerateResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationSetPauseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetPauseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetPauseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Applications) > 0 {
+		for iNdEx := len(m.Applications) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Applications[iNdEx])
+			copy(dAtA[i:], m.Applications[iNdEx])
+			i = encodeVarintApplicationset(dAtA, i, uint64(len(m.Applications[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AppsetNamespace) > 0 {
+		i -= len(m.AppsetNamespace)
+		copy(dAtA[i:], m.AppsetNamespace)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.AppsetNamespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintApplicationset(dAtA []byte, offset int, v uint64) int {
 	offset -= sovApplicationset(v)
 	base := offset
@@ -1347,6 +1545,32 @@ func (m *ApplicationSetGenerateResponse) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationSetPauseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	l = len(m.AppsetNamespace)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	if len(m.Applications) > 0 {
+		for _, s := range m.Applications {
+			l = len(s)
+			n += 1 + l + sovApplicationset(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovApplicationset(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2263,6 +2487,153 @@ func (m *ApplicationSetGenerateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ApplicationSetPauseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetPauseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetPauseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppsetNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppsetNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applications", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Applications = append(m.Applications, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipApplicationset(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
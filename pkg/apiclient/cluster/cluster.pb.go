@@ -17,6 +17,7 @@ import (
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	io "io"
+	v1 "k8s.io/api/core/v1"
 	math "math"
 	math_bits "math/bits"
 )
@@ -311,56 +312,171 @@ func (m *ClusterUpdateRequest) GetId() *ClusterID {
 	return nil
 }
 
+// ClusterSecretValidateRequest is a request to validate a declarative cluster secret
+type ClusterSecretValidateRequest struct {
+	Secret *v1.Secret `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// checkConnection tests the connection to the cluster server
+	CheckConnection      bool     `protobuf:"varint,2,opt,name=checkConnection,proto3" json:"checkConnection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClusterSecretValidateRequest) Reset()         { *m = ClusterSecretValidateRequest{} }
+func (m *ClusterSecretValidateRequest) String() string { return proto.CompactTextString(m) }
+func (*ClusterSecretValidateRequest) ProtoMessage()    {}
+func (*ClusterSecretValidateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6b5ba0b5aa57b32, []int{5}
+}
+func (m *ClusterSecretValidateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterSecretValidateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterSecretValidateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClusterSecretValidateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterSecretValidateRequest.Merge(m, src)
+}
+func (m *ClusterSecretValidateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterSecretValidateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterSecretValidateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterSecretValidateRequest proto.InternalMessageInfo
+
+func (m *ClusterSecretValidateRequest) GetSecret() *v1.Secret {
+	if m != nil {
+		return m.Secret
+	}
+	return nil
+}
+
+func (m *ClusterSecretValidateRequest) GetCheckConnection() bool {
+	if m != nil {
+		return m.CheckConnection
+	}
+	return false
+}
+
+// ClusterSecretValidateResponse holds the problems found in a cluster secret
+type ClusterSecretValidateResponse struct {
+	Problems             []string `protobuf:"bytes,1,rep,name=problems,proto3" json:"problems,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClusterSecretValidateResponse) Reset()         { *m = ClusterSecretValidateResponse{} }
+func (m *ClusterSecretValidateResponse) String() string { return proto.CompactTextString(m) }
+func (*ClusterSecretValidateResponse) ProtoMessage()    {}
+func (*ClusterSecretValidateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6b5ba0b5aa57b32, []int{6}
+}
+func (m *ClusterSecretValidateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterSecretValidateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterSecretValidateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClusterSecretValidateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterSecretValidateResponse.Merge(m, src)
+}
+func (m *ClusterSecretValidateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterSecretValidateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterSecretValidateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterSecretValidateResponse proto.InternalMessageInfo
+
+func (m *ClusterSecretValidateResponse) GetProblems() []string {
+	if m != nil {
+		return m.Problems
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ClusterID)(nil), "cluster.ClusterID")
 	proto.RegisterType((*ClusterQuery)(nil), "cluster.ClusterQuery")
 	proto.RegisterType((*ClusterResponse)(nil), "cluster.ClusterResponse")
 	proto.RegisterType((*ClusterCreateRequest)(nil), "cluster.ClusterCreateRequest")
 	proto.RegisterType((*ClusterUpdateRequest)(nil), "cluster.ClusterUpdateRequest")
+	proto.RegisterType((*ClusterSecretValidateRequest)(nil), "cluster.ClusterSecretValidateRequest")
+	proto.RegisterType((*ClusterSecretValidateResponse)(nil), "cluster.ClusterSecretValidateResponse")
 }
 
 func init() { proto.RegisterFile("server/cluster/cluster.proto", fileDescriptor_a6b5ba0b5aa57b32) }
 
 var fileDescriptor_a6b5ba0b5aa57b32 = []byte{
-	// 596 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0xe5, 0x6e, 0x74, 0xcc, 0x03, 0x06, 0xd6, 0x40, 0x51, 0xf7, 0x43, 0x25, 0x20, 0x28,
-	0x68, 0xb5, 0xd5, 0x76, 0x5c, 0xb8, 0xb1, 0x0e, 0x50, 0xa5, 0x5d, 0x08, 0xe2, 0xc2, 0x61, 0x93,
-	0x97, 0x3c, 0xa5, 0x66, 0x59, 0x62, 0x62, 0x27, 0xd2, 0x84, 0xb8, 0xec, 0xc4, 0x0d, 0x21, 0xae,
-	0x5c, 0xf9, 0x43, 0xb8, 0x21, 0x71, 0x41, 0xe2, 0x1f, 0x40, 0x15, 0x7f, 0x08, 0x8a, 0x93, 0xb4,
-	0xb4, 0xd3, 0xaa, 0x21, 0x15, 0x4e, 0xf5, 0x7b, 0xea, 0xf3, 0xf7, 0xf3, 0xbe, 0x7e, 0x8e, 0xf1,
-	0x9a, 0x82, 0x38, 0x85, 0x98, 0xb9, 0x41, 0xa2, 0xf4, 0xe8, 0x97, 0xca, 0x38, 0xd2, 0x11, 0x59,
-	0x28, 0xc2, 0xda, 0x9a, 0x1f, 0x45, 0x7e, 0x00, 0x8c, 0x4b, 0xc1, 0x78, 0x18, 0x46, 0x9a, 0x6b,
-	0x11, 0x85, 0x2a, 0xff, 0x5b, 0x6d, 0xd7, 0x17, 0xba, 0x9f, 0x1c, 0x50, 0x37, 0x3a, 0x62, 0x3c,
-	0xf6, 0x23, 0x19, 0x47, 0xaf, 0xcc, 0xa2, 0xe9, 0x7a, 0x2c, 0xed, 0x30, 0x79, 0xe8, 0x67, 0x95,
-	0x8a, 0x71, 0x29, 0x03, 0xe1, 0x9a, 0x5a, 0x96, 0xb6, 0x78, 0x20, 0xfb, 0xbc, 0xc5, 0x7c, 0x08,
-	0x21, 0xe6, 0x1a, 0xbc, 0x7c, 0x37, 0xfb, 0x01, 0x5e, 0xec, 0xe6, 0xb2, 0xbd, 0x1d, 0x42, 0xf0,
-	0xbc, 0x3e, 0x96, 0x60, 0xa1, 0x3a, 0x6a, 0x2c, 0x3a, 0x66, 0x4d, 0x56, 0xf0, 0x85, 0x94, 0x07,
-	0x09, 0x58, 0x15, 0x93, 0xcc, 0x03, 0x7b, 0x0f, 0x5f, 0x2a, 0xca, 0x9e, 0x25, 0x10, 0x1f, 0x93,
-	0x1b, 0xb8, 0x9a, 0xf7, 0x56, 0xd4, 0x16, 0x51, 0xb6, 0x63, 0xc8, 0x8f, 0xca, 0x62, 0xb3, 0x26,
-	0x36, 0xae, 0x08, 0xcf, 0x9a, 0xab, 0xa3, 0xc6, 0x52, 0x9b, 0xd0, 0xd2, 0x83, 0x21, 0x85, 0x53,
-	0x11, 0x9e, 0x7d, 0x0d, 0x2f, 0x17, 0x09, 0x07, 0x94, 0x8c, 0x42, 0x05, 0xf6, 0x7b, 0x84, 0x57,
-	0x8a, 0x5c, 0x37, 0x06, 0xae, 0xc1, 0x81, 0xd7, 0x09, 0x28, 0x4d, 0xf6, 0x71, 0xe9, 0x9c, 0x11,
-	0x5f, 0x6a, 0x3f, 0xa6, 0x23, 0x8b, 0x68, 0x69, 0x91, 0x59, 0xec, 0xbb, 0x1e, 0x4d, 0x3b, 0x54,
-	0x1e, 0xfa, 0x34, 0xb3, 0x88, 0xfe, 0x61, 0x11, 0x2d, 0x2d, 0x2a, 0x49, 0x9c, 0x72, 0xd7, 0xac,
-	0xb9, 0x44, 0x2a, 0x88, 0xb5, 0x69, 0xe3, 0xa2, 0x53, 0x44, 0xf6, 0x97, 0x11, 0xd1, 0x0b, 0xe9,
-	0xfd, 0x4f, 0xa2, 0xdb, 0xf8, 0x72, 0x62, 0x14, 0xbd, 0x27, 0x02, 0x02, 0x4f, 0x59, 0x95, 0xfa,
-	0x5c, 0x63, 0xd1, 0x19, 0x4f, 0x9e, 0xc7, 0xe8, 0xf6, 0xb7, 0x05, 0x7c, 0xa5, 0xc8, 0x3c, 0x87,
-	0x38, 0x15, 0x2e, 0x90, 0x13, 0x84, 0xe7, 0x77, 0x85, 0xd2, 0xe4, 0xfa, 0x64, 0x8d, 0x39, 0xeb,
-	0x5a, 0x6f, 0x26, 0xcd, 0x64, 0x0a, 0xb6, 0x75, 0xf2, 0xe3, 0xd7, 0xc7, 0x0a, 0x21, 0x57, 0xcd,
-	0xac, 0xa7, 0xad, 0xf2, 0x46, 0x28, 0xf2, 0x01, 0xe1, 0x6a, 0x7e, 0xcc, 0x64, 0x7d, 0x12, 0x63,
-	0xec, 0xf8, 0x6b, 0xb3, 0xf1, 0xd6, 0xbe, 0x69, 0x50, 0x56, 0xed, 0x53, 0x28, 0x0f, 0x87, 0xae,
-	0xbf, 0x43, 0x78, 0xee, 0x29, 0x9c, 0xe9, 0xcb, 0x8c, 0x40, 0x6e, 0x19, 0x90, 0x75, 0xb2, 0x3a,
-	0x09, 0xc2, 0xde, 0x08, 0x8f, 0x9a, 0xeb, 0xf7, 0x96, 0x7c, 0x42, 0xb8, 0x9a, 0xcf, 0xdc, 0x69,
-	0x7b, 0xc6, 0x66, 0x71, 0x56, 0x54, 0x9b, 0x86, 0xea, 0x4e, 0x6d, 0x1a, 0xd5, 0xc8, 0xa9, 0x3d,
-	0x5c, 0xdd, 0x81, 0x00, 0x34, 0x9c, 0xe5, 0x95, 0x35, 0x99, 0x1e, 0x5e, 0xf3, 0xa2, 0xfd, 0xfb,
-	0x53, 0xdb, 0x0f, 0x31, 0x76, 0xb2, 0xcf, 0x22, 0x3c, 0x4a, 0x74, 0xff, 0xef, 0x35, 0x98, 0xd1,
-	0xb8, 0x67, 0xdf, 0x9d, 0xa2, 0xc1, 0x62, 0x23, 0xd0, 0xe4, 0x99, 0xc2, 0x67, 0x84, 0x97, 0x7b,
-	0x61, 0xca, 0x03, 0x91, 0x59, 0xdb, 0xe5, 0x6e, 0x1f, 0xfe, 0xf1, 0x14, 0x6c, 0x19, 0x44, 0x6a,
-	0x6f, 0x4e, 0x43, 0x14, 0x43, 0xa4, 0xa6, 0x9b, 0x31, 0x6d, 0x6f, 0x7f, 0x1d, 0x6c, 0xa0, 0xef,
-	0x83, 0x0d, 0xf4, 0x73, 0xb0, 0x81, 0x5e, 0x6e, 0x9d, 0xef, 0xa5, 0x70, 0x03, 0x01, 0xa1, 0x2e,
-	0x05, 0x0e, 0xaa, 0xe6, 0x61, 0xe8, 0xfc, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xf8, 0x3f, 0x7b, 0x15,
-	0xad, 0x06, 0x00, 0x00,
+	// 722 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0x4d, 0x6f, 0xd3, 0x4c,
+	0x10, 0xc7, 0xb5, 0x69, 0x9f, 0x3c, 0xed, 0x16, 0x5a, 0x58, 0x15, 0x14, 0xb9, 0x2f, 0x0a, 0x86,
+	0x96, 0x50, 0xda, 0xb5, 0x92, 0x16, 0x09, 0x95, 0x13, 0x4d, 0x01, 0x55, 0xea, 0x05, 0x23, 0x38,
+	0x70, 0x68, 0xe5, 0xda, 0xa3, 0x64, 0x89, 0xeb, 0x35, 0xeb, 0xb5, 0xa5, 0x0a, 0xb8, 0xf4, 0xc4,
+	0x01, 0x09, 0x21, 0xae, 0x5c, 0xf9, 0x20, 0xdc, 0x38, 0x22, 0x71, 0xe3, 0x84, 0x2a, 0x3e, 0x08,
+	0xf2, 0x7a, 0xed, 0xb4, 0x09, 0x0d, 0x45, 0x0a, 0x9c, 0xb2, 0x3b, 0xd9, 0xd9, 0xf9, 0xcd, 0x7f,
+	0x67, 0xc6, 0x78, 0x36, 0x02, 0x91, 0x80, 0xb0, 0x5c, 0x3f, 0x8e, 0x64, 0xf7, 0x97, 0x86, 0x82,
+	0x4b, 0x4e, 0xfe, 0xd7, 0x5b, 0x63, 0xb6, 0xc5, 0x79, 0xcb, 0x07, 0xcb, 0x09, 0x99, 0xe5, 0x04,
+	0x01, 0x97, 0x8e, 0x64, 0x3c, 0x88, 0xb2, 0x63, 0x86, 0xd9, 0xb9, 0x1d, 0x51, 0xc6, 0xd5, 0xbf,
+	0x2e, 0x17, 0x60, 0x25, 0x75, 0xab, 0x05, 0x01, 0x08, 0x47, 0x82, 0xa7, 0xcf, 0x6c, 0xb7, 0x98,
+	0x6c, 0xc7, 0x7b, 0xd4, 0xe5, 0xfb, 0x96, 0x23, 0x5a, 0x3c, 0x14, 0xfc, 0x99, 0x5a, 0xac, 0xb8,
+	0x9e, 0x95, 0xac, 0x5a, 0x61, 0xa7, 0x95, 0xfa, 0x47, 0x96, 0x13, 0x86, 0x3e, 0x73, 0xd5, 0xfd,
+	0x56, 0x52, 0x77, 0xfc, 0xb0, 0xed, 0xf4, 0xdd, 0x66, 0xde, 0xc2, 0xe3, 0xcd, 0x0c, 0x6d, 0x6b,
+	0x93, 0x10, 0x3c, 0x2a, 0x0f, 0x42, 0xa8, 0xa0, 0x2a, 0xaa, 0x8d, 0xdb, 0x6a, 0x4d, 0xa6, 0xf1,
+	0x7f, 0x89, 0xe3, 0xc7, 0x50, 0x29, 0x29, 0x63, 0xb6, 0x31, 0x77, 0xf0, 0x39, 0xed, 0xf6, 0x30,
+	0x06, 0x71, 0x40, 0x2e, 0xe3, 0x72, 0x96, 0xbf, 0xf6, 0xd5, 0xbb, 0xf4, 0xc6, 0xc0, 0xd9, 0xcf,
+	0x9d, 0xd5, 0x9a, 0x98, 0xb8, 0xc4, 0xbc, 0xca, 0x48, 0x15, 0xd5, 0x26, 0x1a, 0x84, 0xe6, 0x3a,
+	0x15, 0x14, 0x76, 0x89, 0x79, 0xe6, 0x45, 0x3c, 0xa5, 0x0d, 0x36, 0x44, 0x21, 0x0f, 0x22, 0x30,
+	0xdf, 0x22, 0x3c, 0xad, 0x6d, 0x4d, 0x01, 0x8e, 0x04, 0x1b, 0x9e, 0xc7, 0x10, 0x49, 0xb2, 0x8b,
+	0x73, 0x75, 0x55, 0xf0, 0x89, 0xc6, 0x3d, 0xda, 0x95, 0x88, 0xe6, 0x12, 0xa9, 0xc5, 0xae, 0xeb,
+	0xd1, 0x64, 0x95, 0x86, 0x9d, 0x16, 0x4d, 0x25, 0xa2, 0xc7, 0x24, 0xa2, 0xb9, 0x44, 0x39, 0x89,
+	0x9d, 0xdf, 0x9a, 0x26, 0x17, 0x87, 0x11, 0x08, 0xa9, 0xd2, 0x18, 0xb3, 0xf5, 0xce, 0xfc, 0xd4,
+	0x25, 0x7a, 0x1c, 0x7a, 0xff, 0x92, 0xe8, 0x1a, 0x3e, 0x1f, 0xab, 0x88, 0xde, 0x7d, 0x06, 0xbe,
+	0x17, 0x55, 0x4a, 0xd5, 0x91, 0xda, 0xb8, 0x7d, 0xd2, 0x78, 0x26, 0xa1, 0x5f, 0xe2, 0x59, 0x6d,
+	0x78, 0x04, 0xae, 0x00, 0xf9, 0xc4, 0xf1, 0xd9, 0xf1, 0x54, 0x1a, 0xe9, 0xc3, 0xa6, 0x7f, 0xe8,
+	0x4c, 0x0c, 0x9a, 0x95, 0x68, 0x4a, 0x4b, 0xd3, 0x12, 0xa5, 0x49, 0x9d, 0x66, 0xae, 0xb6, 0x3e,
+	0x49, 0x6a, 0x78, 0xca, 0x6d, 0x83, 0xdb, 0x69, 0xf2, 0x20, 0x00, 0x37, 0x4d, 0x45, 0x0b, 0xd7,
+	0x6b, 0x36, 0xef, 0xe0, 0xb9, 0x53, 0xa2, 0x67, 0x8f, 0x4e, 0x0c, 0x3c, 0x16, 0x0a, 0xbe, 0xe7,
+	0xc3, 0x7e, 0x54, 0x41, 0x2a, 0xc7, 0x62, 0xdf, 0xf8, 0x36, 0x86, 0x27, 0x0b, 0x6f, 0x91, 0x30,
+	0x17, 0xc8, 0x21, 0xc2, 0xa3, 0xdb, 0x2c, 0x92, 0xe4, 0x52, 0x6f, 0xba, 0xaa, 0x4c, 0x8d, 0xad,
+	0xa1, 0xbc, 0x43, 0x1a, 0xc1, 0xac, 0x1c, 0x7e, 0xfd, 0xf1, 0xbe, 0x44, 0xc8, 0x05, 0xd5, 0xac,
+	0x49, 0x3d, 0x6f, 0xf8, 0x88, 0xbc, 0x43, 0xb8, 0x9c, 0x55, 0x28, 0x99, 0xeb, 0xc5, 0x38, 0x51,
+	0xb9, 0xc6, 0x70, 0xca, 0xc2, 0xbc, 0xa2, 0x50, 0x66, 0xcc, 0x3e, 0x94, 0xf5, 0xa2, 0x60, 0x5e,
+	0x23, 0x3c, 0xf2, 0x00, 0x4e, 0xd5, 0x65, 0x48, 0x20, 0x57, 0x15, 0xc8, 0x1c, 0x99, 0xe9, 0x05,
+	0xb1, 0x5e, 0x30, 0x8f, 0xaa, 0xc9, 0xf1, 0x8a, 0x7c, 0x40, 0xb8, 0x9c, 0xb5, 0x4b, 0xbf, 0x3c,
+	0x27, 0xda, 0x68, 0x58, 0x54, 0xcb, 0x8a, 0x6a, 0xd1, 0x18, 0x44, 0xd5, 0x55, 0x6a, 0x07, 0x97,
+	0x37, 0xc1, 0x07, 0x09, 0xa7, 0x69, 0x55, 0xe9, 0x35, 0x17, 0x13, 0x4a, 0xa7, 0xbf, 0x34, 0x30,
+	0xfd, 0x00, 0x63, 0x3b, 0x9d, 0xfa, 0x70, 0x37, 0x96, 0xed, 0x3f, 0x8f, 0x61, 0xa9, 0x18, 0x37,
+	0xcc, 0xeb, 0x03, 0x62, 0x58, 0x42, 0x05, 0x58, 0x71, 0xd2, 0x08, 0x1f, 0x11, 0x9e, 0xda, 0x0a,
+	0x12, 0xdd, 0x58, 0x4d, 0xc7, 0x6d, 0xc3, 0x5f, 0xae, 0x82, 0x35, 0x85, 0x48, 0xcd, 0xe5, 0x41,
+	0x88, 0xac, 0x40, 0x5a, 0x71, 0x15, 0xd3, 0x1b, 0x84, 0x27, 0xf3, 0xf6, 0xcf, 0x86, 0x01, 0x59,
+	0xe8, 0xc5, 0xfc, 0xe5, 0x88, 0x32, 0x16, 0x7f, 0x77, 0x4c, 0x4b, 0x77, 0x53, 0x71, 0x2d, 0x98,
+	0xd5, 0x3e, 0xae, 0x02, 0x25, 0x1b, 0x60, 0xeb, 0x68, 0x69, 0x63, 0xe3, 0xf3, 0xd1, 0x3c, 0xfa,
+	0x72, 0x34, 0x8f, 0xbe, 0x1f, 0xcd, 0xa3, 0xa7, 0x6b, 0x67, 0xfb, 0xe6, 0xba, 0x3e, 0x83, 0x40,
+	0xe6, 0xf7, 0xee, 0x95, 0xd5, 0x27, 0x76, 0xf5, 0xe7, 0x00, 0x69, 0x88, 0x48, 0x5c, 0x1b, 0x08,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -389,6 +505,8 @@ type ClusterServiceClient interface {
 	RotateAuth(ctx context.Context, in *ClusterQuery, opts ...grpc.CallOption) (*ClusterResponse, error)
 	// InvalidateCache invalidates cluster cache
 	InvalidateCache(ctx context.Context, in *ClusterQuery, opts ...grpc.CallOption) (*v1alpha1.Cluster, error)
+	// ValidateSecret checks a declarative cluster secret for problems which prevent Argo CD from using it
+	ValidateSecret(ctx context.Context, in *ClusterSecretValidateRequest, opts ...grpc.CallOption) (*ClusterSecretValidateResponse, error)
 }
 
 type clusterServiceClient struct {
@@ -462,6 +580,15 @@ func (c *clusterServiceClient) InvalidateCache(ctx context.Context, in *ClusterQ
 	return out, nil
 }
 
+func (c *clusterServiceClient) ValidateSecret(ctx context.Context, in *ClusterSecretValidateRequest, opts ...grpc.CallOption) (*ClusterSecretValidateResponse, error) {
+	out := new(ClusterSecretValidateResponse)
+	err := c.cc.Invoke(ctx, "/cluster.ClusterService/ValidateSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClusterServiceServer is the server API for ClusterService service.
 type ClusterServiceServer interface {
 	// List returns list of clusters
@@ -478,6 +605,8 @@ type ClusterServiceServer interface {
 	RotateAuth(context.Context, *ClusterQuery) (*ClusterResponse, error)
 	// InvalidateCache invalidates cluster cache
 	InvalidateCache(context.Context, *ClusterQuery) (*v1alpha1.Cluster, error)
+	// ValidateSecret checks a declarative cluster secret for problems which prevent Argo CD from using it
+	ValidateSecret(context.Context, *ClusterSecretValidateRequest) (*ClusterSecretValidateResponse, error)
 }
 
 // UnimplementedClusterServiceServer can be embedded to have forward compatible implementations.
@@ -505,6 +634,9 @@ func (*UnimplementedClusterServiceServer) RotateAuth(ctx context.Context, req *C
 func (*UnimplementedClusterServiceServer) InvalidateCache(ctx context.Context, req *ClusterQuery) (*v1alpha1.Cluster, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InvalidateCache not implemented")
 }
+func (*UnimplementedClusterServiceServer) ValidateSecret(ctx context.Context, req *ClusterSecretValidateRequest) (*ClusterSecretValidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateSecret not implemented")
+}
 
 func RegisterClusterServiceServer(s *grpc.Server, srv ClusterServiceServer) {
 	s.RegisterService(&_ClusterService_serviceDesc, srv)
@@ -636,6 +768,24 @@ func _ClusterService_InvalidateCache_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClusterService_ValidateSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterSecretValidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).ValidateSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cluster.ClusterService/ValidateSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).ValidateSecret(ctx, req.(*ClusterSecretValidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ClusterService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cluster.ClusterService",
 	HandlerType: (*ClusterServiceServer)(nil),
@@ -668,6 +818,10 @@ var _ClusterService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InvalidateCache",
 			Handler:    _ClusterService_InvalidateCache_Handler,
 		},
+		{
+			MethodName: "ValidateSecret",
+			Handler:    _ClusterService_ValidateSecret_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "server/cluster/cluster.proto",
@@ -903,6 +1057,91 @@ func (m *ClusterUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClusterSecretValidateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterSecretValidateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterSecretValidateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CheckConnection {
+		i--
+		if m.CheckConnection {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Secret != nil {
+		{
+			size, err := m.Secret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCluster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterSecretValidateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterSecretValidateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterSecretValidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Problems) > 0 {
+		for iNdEx := len(m.Problems) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Problems[iNdEx])
+			copy(dAtA[i:], m.Problems[iNdEx])
+			i = encodeVarintCluster(dAtA, i, uint64(len(m.Problems[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCluster(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCluster(v)
 	base := offset
@@ -1015,6 +1254,43 @@ func (m *ClusterUpdateRequest) Size() (n int) {
 	return n
 }
 
+func (m *ClusterSecretValidateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Secret != nil {
+		l = m.Secret.Size()
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	if m.CheckConnection {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterSecretValidateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Problems) > 0 {
+		for _, s := range m.Problems {
+			l = len(s)
+			n += 1 + l + sovCluster(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovCluster(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1600,6 +1876,196 @@ func (m *ClusterUpdateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClusterSecretValidateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCluster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterSecretValidateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterSecretValidateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Secret == nil {
+				m.Secret = &v1.Secret{}
+			}
+			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckConnection", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CheckConnection = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCluster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterSecretValidateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCluster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterSecretValidateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterSecretValidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Problems", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Problems = append(m.Problems, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCluster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCluster(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -128,6 +128,80 @@ func (_m *SessionServiceClient) GetUserInfo(ctx context.Context, in *session.Get
 	return r0, r1
 }
 
+// ListSessions provides a mock function with given fields: ctx, in, opts
+func (_m *SessionServiceClient) ListSessions(ctx context.Context, in *session.SessionListRequest, opts ...grpc.CallOption) (*session.SessionList, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessions")
+	}
+
+	var r0 *session.SessionList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *session.SessionListRequest, ...grpc.CallOption) (*session.SessionList, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *session.SessionListRequest, ...grpc.CallOption) *session.SessionList); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*session.SessionList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *session.SessionListRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeSession provides a mock function with given fields: ctx, in, opts
+func (_m *SessionServiceClient) RevokeSession(ctx context.Context, in *session.SessionRevokeRequest, opts ...grpc.CallOption) (*session.ActiveSession, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 *session.ActiveSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *session.SessionRevokeRequest, ...grpc.CallOption) (*session.ActiveSession, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *session.SessionRevokeRequest, ...grpc.CallOption) *session.ActiveSession); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*session.ActiveSession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *session.SessionRevokeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewSessionServiceClient creates a new instance of SessionServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSessionServiceClient(t interface {
@@ -104,6 +104,66 @@ func (_m *SessionServiceServer) GetUserInfo(_a0 context.Context, _a1 *session.Ge
 	return r0, r1
 }
 
+// ListSessions provides a mock function with given fields: _a0, _a1
+func (_m *SessionServiceServer) ListSessions(_a0 context.Context, _a1 *session.SessionListRequest) (*session.SessionList, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessions")
+	}
+
+	var r0 *session.SessionList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *session.SessionListRequest) (*session.SessionList, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *session.SessionListRequest) *session.SessionList); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*session.SessionList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *session.SessionListRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeSession provides a mock function with given fields: _a0, _a1
+func (_m *SessionServiceServer) RevokeSession(_a0 context.Context, _a1 *session.SessionRevokeRequest) (*session.ActiveSession, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 *session.ActiveSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *session.SessionRevokeRequest) (*session.ActiveSession, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *session.SessionRevokeRequest) *session.ActiveSession); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*session.ActiveSession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *session.SessionRevokeRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewSessionServiceServer creates a new instance of SessionServiceServer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSessionServiceServer(t interface {
@@ -295,44 +295,301 @@ func (m *GetUserInfoResponse) GetGroups() []string {
 	return nil
 }
 
+// SessionListRequest is for listing the active sessions.
+type SessionListRequest struct {
+	// the subject to list the sessions of, all the subjects if empty
+	Subject              string   `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SessionListRequest) Reset()         { *m = SessionListRequest{} }
+func (m *SessionListRequest) String() string { return proto.CompactTextString(m) }
+func (*SessionListRequest) ProtoMessage()    {}
+func (*SessionListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87870a51a62685ed, []int{5}
+}
+func (m *SessionListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SessionListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionListRequest.Merge(m, src)
+}
+func (m *SessionListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SessionListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionListRequest proto.InternalMessageInfo
+
+func (m *SessionListRequest) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+// ActiveSession is a session of a user which was active in the last 24 hours.
+type ActiveSession struct {
+	// the id of the token of the session
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Subject              string   `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Issuer               string   `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	IssuedAt             int64    `protobuf:"varint,4,opt,name=issuedAt,proto3" json:"issuedAt,omitempty"`
+	ExpiresAt            int64    `protobuf:"varint,5,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	LastActivity         int64    `protobuf:"varint,6,opt,name=lastActivity,proto3" json:"lastActivity,omitempty"`
+	ClientIP             string   `protobuf:"bytes,7,opt,name=clientIP,proto3" json:"clientIP,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActiveSession) Reset()         { *m = ActiveSession{} }
+func (m *ActiveSession) String() string { return proto.CompactTextString(m) }
+func (*ActiveSession) ProtoMessage()    {}
+func (*ActiveSession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87870a51a62685ed, []int{6}
+}
+func (m *ActiveSession) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActiveSession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActiveSession.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActiveSession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActiveSession.Merge(m, src)
+}
+func (m *ActiveSession) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActiveSession) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActiveSession.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActiveSession proto.InternalMessageInfo
+
+func (m *ActiveSession) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ActiveSession) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *ActiveSession) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *ActiveSession) GetIssuedAt() int64 {
+	if m != nil {
+		return m.IssuedAt
+	}
+	return 0
+}
+
+func (m *ActiveSession) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *ActiveSession) GetLastActivity() int64 {
+	if m != nil {
+		return m.LastActivity
+	}
+	return 0
+}
+
+func (m *ActiveSession) GetClientIP() string {
+	if m != nil {
+		return m.ClientIP
+	}
+	return ""
+}
+
+type SessionList struct {
+	Items                []*ActiveSession `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SessionList) Reset()         { *m = SessionList{} }
+func (m *SessionList) String() string { return proto.CompactTextString(m) }
+func (*SessionList) ProtoMessage()    {}
+func (*SessionList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87870a51a62685ed, []int{7}
+}
+func (m *SessionList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SessionList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionList.Merge(m, src)
+}
+func (m *SessionList) XXX_Size() int {
+	return m.Size()
+}
+func (m *SessionList) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionList proto.InternalMessageInfo
+
+func (m *SessionList) GetItems() []*ActiveSession {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+// SessionRevokeRequest is for revoking an active session.
+type SessionRevokeRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SessionRevokeRequest) Reset()         { *m = SessionRevokeRequest{} }
+func (m *SessionRevokeRequest) String() string { return proto.CompactTextString(m) }
+func (*SessionRevokeRequest) ProtoMessage()    {}
+func (*SessionRevokeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87870a51a62685ed, []int{8}
+}
+func (m *SessionRevokeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionRevokeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionRevokeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SessionRevokeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionRevokeRequest.Merge(m, src)
+}
+func (m *SessionRevokeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SessionRevokeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionRevokeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionRevokeRequest proto.InternalMessageInfo
+
+func (m *SessionRevokeRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*SessionCreateRequest)(nil), "session.SessionCreateRequest")
 	proto.RegisterType((*SessionDeleteRequest)(nil), "session.SessionDeleteRequest")
 	proto.RegisterType((*SessionResponse)(nil), "session.SessionResponse")
 	proto.RegisterType((*GetUserInfoRequest)(nil), "session.GetUserInfoRequest")
 	proto.RegisterType((*GetUserInfoResponse)(nil), "session.GetUserInfoResponse")
+	proto.RegisterType((*SessionListRequest)(nil), "session.SessionListRequest")
+	proto.RegisterType((*ActiveSession)(nil), "session.ActiveSession")
+	proto.RegisterType((*SessionList)(nil), "session.SessionList")
+	proto.RegisterType((*SessionRevokeRequest)(nil), "session.SessionRevokeRequest")
 }
 
 func init() { proto.RegisterFile("server/session/session.proto", fileDescriptor_87870a51a62685ed) }
 
 var fileDescriptor_87870a51a62685ed = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x8e, 0xd3, 0x30,
-	0x18, 0x85, 0x95, 0x14, 0x42, 0xc7, 0x48, 0x0c, 0x98, 0x68, 0x88, 0x42, 0xa9, 0xaa, 0x6c, 0x18,
-	0x8d, 0x44, 0x23, 0x18, 0x56, 0x2c, 0x0b, 0x12, 0xea, 0x36, 0x15, 0x9b, 0x4a, 0x2c, 0xd2, 0xe4,
-	0xc7, 0xb8, 0x4d, 0xfd, 0x07, 0xdb, 0x49, 0xf7, 0x5c, 0x81, 0xc3, 0x70, 0x05, 0x96, 0x48, 0x5c,
-	0x00, 0x55, 0x1c, 0x04, 0x25, 0x4e, 0x42, 0x9b, 0x56, 0xac, 0xe2, 0xe7, 0xdf, 0xf9, 0xde, 0xb3,
-	0x9e, 0xc9, 0x48, 0x81, 0x2c, 0x41, 0x86, 0x0a, 0x94, 0xe2, 0x28, 0xda, 0xef, 0x34, 0x97, 0xa8,
-	0x91, 0xde, 0x6b, 0xa4, 0x3f, 0x62, 0x88, 0x2c, 0x83, 0x30, 0xce, 0x79, 0x18, 0x0b, 0x81, 0x3a,
-	0xd6, 0x1c, 0x85, 0x32, 0xc7, 0x82, 0x94, 0xb8, 0x0b, 0x73, 0xf0, 0xad, 0x84, 0x58, 0x43, 0x04,
-	0x5f, 0x0a, 0x50, 0x9a, 0xfa, 0x64, 0x58, 0x28, 0x90, 0x22, 0xde, 0x82, 0x67, 0x4d, 0xac, 0xeb,
-	0x8b, 0xa8, 0xd3, 0xd5, 0x2c, 0x8f, 0x95, 0xda, 0xa1, 0x4c, 0x3d, 0xdb, 0xcc, 0x5a, 0x4d, 0x5d,
-	0x72, 0x57, 0xe3, 0x06, 0x84, 0x37, 0xa8, 0x07, 0x46, 0x04, 0x57, 0x9d, 0xcb, 0x3b, 0xc8, 0xa0,
-	0x73, 0x09, 0x9e, 0x93, 0xcb, 0x66, 0x3f, 0x02, 0x95, 0xa3, 0x50, 0xf0, 0x0f, 0x60, 0x1d, 0x02,
-	0x5c, 0x42, 0xdf, 0x83, 0xfe, 0xa0, 0x40, 0xce, 0xc5, 0x27, 0x6c, 0x7f, 0xdf, 0x91, 0xc7, 0x47,
-	0xbb, 0x0d, 0xc2, 0x27, 0xc3, 0x0c, 0x19, 0x83, 0x74, 0x6e, 0x28, 0xc3, 0xa8, 0xd3, 0x47, 0xf7,
-	0xb2, 0x7b, 0xf7, 0x7a, 0x48, 0x06, 0x5c, 0xa9, 0x26, 0x79, 0xb5, 0xa4, 0x57, 0xc4, 0x61, 0x12,
-	0x8b, 0x5c, 0x79, 0x77, 0x26, 0x83, 0xeb, 0x8b, 0xa8, 0x51, 0xaf, 0xbe, 0xdb, 0xe4, 0x41, 0x13,
-	0x7c, 0x01, 0xb2, 0xe4, 0x09, 0xd0, 0x35, 0xb9, 0x7f, 0x90, 0x85, 0x3e, 0x9d, 0xb6, 0x75, 0x9c,
-	0xe6, 0xf6, 0x47, 0xe7, 0x87, 0x26, 0x7e, 0x30, 0xf9, 0xfa, 0xeb, 0xcf, 0x37, 0xdb, 0xa7, 0x5e,
-	0x5d, 0x59, 0xf9, 0xb2, 0x2b, 0xb8, 0x0a, 0xca, 0x2b, 0xf8, 0x47, 0xe2, 0x98, 0xb6, 0xe8, 0xb3,
-	0x8e, 0x74, 0xae, 0x45, 0xdf, 0xeb, 0x8f, 0x3b, 0x13, 0xbf, 0x36, 0x71, 0x83, 0xcb, 0x9e, 0xc9,
-	0x1b, 0xeb, 0x86, 0x2e, 0x89, 0x63, 0x6a, 0x3a, 0xc5, 0x1f, 0xd5, 0xf7, 0x1f, 0xfc, 0x93, 0x1a,
-	0xff, 0xe8, 0xa6, 0x8f, 0x9f, 0xcd, 0x7e, 0xec, 0xc7, 0xd6, 0xcf, 0xfd, 0xd8, 0xfa, 0xbd, 0x1f,
-	0x5b, 0xcb, 0xd7, 0x8c, 0xeb, 0xcf, 0xc5, 0x6a, 0x9a, 0xe0, 0x36, 0x8c, 0x25, 0xc3, 0x5c, 0xe2,
-	0xba, 0x5e, 0xbc, 0x48, 0xd2, 0xb0, 0xbc, 0x0d, 0xf3, 0x0d, 0xab, 0x00, 0x49, 0xc6, 0x41, 0xe8,
-	0x96, 0xb1, 0x72, 0xea, 0xa7, 0x7b, 0xfb, 0x37, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x96, 0x29, 0xbd,
-	0x01, 0x03, 0x00, 0x00,
+	// 607 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x96, 0x93, 0x36, 0x6d, 0xa7, 0x7f, 0xb0, 0x44, 0x65, 0x65, 0xd2, 0x12, 0x59, 0x02, 0xa2,
+	0x0a, 0x62, 0xd1, 0x72, 0x82, 0x53, 0x0b, 0x12, 0xaa, 0xc4, 0x01, 0xb9, 0xe2, 0x52, 0x89, 0x83,
+	0x6b, 0x0f, 0x66, 0xdb, 0xc4, 0x6b, 0x76, 0x36, 0x29, 0x08, 0x71, 0xe1, 0xca, 0x91, 0x97, 0x42,
+	0xe2, 0x82, 0xc4, 0x0b, 0xa0, 0x8a, 0x07, 0x41, 0xf6, 0xae, 0xdd, 0x38, 0x69, 0x38, 0x79, 0xbf,
+	0x9d, 0xd9, 0x6f, 0xfe, 0xbe, 0x31, 0x74, 0x08, 0xd5, 0x18, 0x95, 0x4f, 0x48, 0x24, 0x64, 0x5a,
+	0x7e, 0xfb, 0x99, 0x92, 0x5a, 0xb2, 0x25, 0x0b, 0xdd, 0x4e, 0x22, 0x65, 0x32, 0x40, 0x3f, 0xcc,
+	0x84, 0x1f, 0xa6, 0xa9, 0xd4, 0xa1, 0x16, 0x32, 0x25, 0xe3, 0xe6, 0xc5, 0xd0, 0x3e, 0x36, 0x8e,
+	0xcf, 0x15, 0x86, 0x1a, 0x03, 0xfc, 0x30, 0x42, 0xd2, 0xcc, 0x85, 0xe5, 0x11, 0xa1, 0x4a, 0xc3,
+	0x21, 0x72, 0xa7, 0xeb, 0xf4, 0x56, 0x82, 0x0a, 0xe7, 0xb6, 0x2c, 0x24, 0xba, 0x90, 0x2a, 0xe6,
+	0x0d, 0x63, 0x2b, 0x31, 0x6b, 0xc3, 0xa2, 0x96, 0xe7, 0x98, 0xf2, 0x66, 0x61, 0x30, 0xc0, 0xdb,
+	0xaa, 0xa2, 0xbc, 0xc0, 0x01, 0x56, 0x51, 0xbc, 0x07, 0xb0, 0x69, 0xef, 0x03, 0xa4, 0x4c, 0xa6,
+	0x84, 0x57, 0x04, 0xce, 0x24, 0x41, 0x1b, 0xd8, 0x4b, 0xd4, 0x6f, 0x08, 0xd5, 0x51, 0xfa, 0x4e,
+	0x96, 0xcf, 0x2f, 0xe0, 0x56, 0xed, 0xd6, 0x52, 0xb8, 0xb0, 0x3c, 0x90, 0x49, 0x82, 0xf1, 0x91,
+	0x61, 0x59, 0x0e, 0x2a, 0x5c, 0xab, 0xab, 0x31, 0x55, 0xd7, 0x0d, 0x68, 0x0a, 0x22, 0x9b, 0x79,
+	0x7e, 0x64, 0x5b, 0xd0, 0x4a, 0x94, 0x1c, 0x65, 0xc4, 0x17, 0xba, 0xcd, 0xde, 0x4a, 0x60, 0x91,
+	0xd7, 0x07, 0x66, 0xf3, 0x7e, 0x25, 0x48, 0x97, 0x3d, 0xe3, 0xb0, 0x44, 0xa3, 0xd3, 0x33, 0x8c,
+	0xb4, 0x4d, 0xbe, 0x84, 0xde, 0x4f, 0x07, 0xd6, 0x0f, 0x22, 0x2d, 0xc6, 0x68, 0x9f, 0xb1, 0x0d,
+	0x68, 0x88, 0xd8, 0xba, 0x35, 0x44, 0x3c, 0xf9, 0xb6, 0x51, 0x7b, 0x9b, 0xe7, 0x20, 0x88, 0x46,
+	0xa8, 0x6c, 0x62, 0x16, 0xe5, 0x95, 0x14, 0xa7, 0xf8, 0x40, 0xf3, 0x85, 0xae, 0xd3, 0x6b, 0x06,
+	0x15, 0x66, 0x1d, 0x58, 0xc1, 0x8f, 0x99, 0x50, 0x48, 0x07, 0x9a, 0x2f, 0x16, 0xc6, 0xab, 0x0b,
+	0xe6, 0xc1, 0xda, 0x20, 0x24, 0x5d, 0x24, 0x24, 0xf4, 0x27, 0xde, 0x2a, 0x1c, 0x6a, 0x77, 0x39,
+	0x7b, 0x34, 0x10, 0x98, 0xea, 0xa3, 0xd7, 0x7c, 0xc9, 0xf4, 0xa9, 0xc4, 0xde, 0x33, 0x58, 0x9d,
+	0xa8, 0x9e, 0x3d, 0x84, 0x45, 0xa1, 0x71, 0x48, 0xdc, 0xe9, 0x36, 0x7b, 0xab, 0x7b, 0x5b, 0xfd,
+	0x52, 0x88, 0xb5, 0x8a, 0x03, 0xe3, 0xe4, 0xdd, 0xaf, 0xa4, 0x10, 0xe0, 0x58, 0x9e, 0x57, 0x82,
+	0x9b, 0x6a, 0xc8, 0xde, 0xb7, 0x05, 0xd8, 0xb0, 0x8e, 0xc7, 0xa8, 0xc6, 0x22, 0x42, 0x76, 0x06,
+	0xab, 0x13, 0xe3, 0x66, 0x77, 0xaa, 0x40, 0xb3, 0xd2, 0x70, 0x3b, 0xd7, 0x1b, 0x8d, 0x42, 0xbc,
+	0xee, 0xd7, 0xdf, 0x7f, 0xbf, 0x37, 0x5c, 0xc6, 0x8b, 0xad, 0x18, 0x3f, 0xae, 0x76, 0x28, 0xd7,
+	0x82, 0xc8, 0xc9, 0xdf, 0x42, 0xcb, 0x2c, 0x04, 0xdb, 0xae, 0x98, 0xae, 0x5b, 0x14, 0x97, 0x4f,
+	0x9b, 0xab, 0x20, 0x6e, 0x11, 0xa4, 0xfd, 0xd4, 0xd9, 0xf5, 0x36, 0xa7, 0xe2, 0xb0, 0x13, 0x68,
+	0x99, 0x4d, 0x98, 0xa5, 0xaf, 0x6d, 0xc8, 0x7f, 0xe8, 0x6f, 0x17, 0xf4, 0x37, 0x77, 0x67, 0xb8,
+	0x23, 0x58, 0xcb, 0xe7, 0x62, 0xfd, 0x69, 0xa2, 0x4f, 0xb3, 0x9a, 0x75, 0xdb, 0xd7, 0x19, 0xe7,
+	0xf7, 0x87, 0x4a, 0xd2, 0x21, 0xac, 0x9b, 0xf9, 0x95, 0x82, 0xde, 0x9e, 0x4d, 0x74, 0x62, 0xbc,
+	0xee, 0x1c, 0x55, 0x78, 0xf7, 0x8a, 0x48, 0x77, 0x77, 0xb7, 0xe7, 0x45, 0xf2, 0x3f, 0x8b, 0xf8,
+	0xcb, 0xe1, 0xe1, 0x8f, 0xcb, 0x1d, 0xe7, 0xd7, 0xe5, 0x8e, 0xf3, 0xe7, 0x72, 0xc7, 0x39, 0x79,
+	0x92, 0x08, 0xfd, 0x7e, 0x74, 0xda, 0x8f, 0xe4, 0xd0, 0x0f, 0x55, 0x22, 0x33, 0x25, 0xcf, 0x8a,
+	0xc3, 0xa3, 0x28, 0xf6, 0xc7, 0xfb, 0x7e, 0x76, 0x9e, 0xe4, 0x74, 0x46, 0xb0, 0x25, 0xd3, 0x69,
+	0xab, 0xf8, 0xe3, 0xed, 0xff, 0x1b, 0x00, 0x2f, 0xef, 0x1c, 0x84, 0x38, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -353,6 +610,10 @@ type SessionServiceClient interface {
 	Create(ctx context.Context, in *SessionCreateRequest, opts ...grpc.CallOption) (*SessionResponse, error)
 	// Delete an existing JWT cookie if using HTTP
 	Delete(ctx context.Context, in *SessionDeleteRequest, opts ...grpc.CallOption) (*SessionResponse, error)
+	// ListSessions lists the sessions which were active in the last 24 hours
+	ListSessions(ctx context.Context, in *SessionListRequest, opts ...grpc.CallOption) (*SessionList, error)
+	// RevokeSession revokes an active session, whose token is rejected until it expires
+	RevokeSession(ctx context.Context, in *SessionRevokeRequest, opts ...grpc.CallOption) (*ActiveSession, error)
 }
 
 type sessionServiceClient struct {
@@ -390,6 +651,24 @@ func (c *sessionServiceClient) Delete(ctx context.Context, in *SessionDeleteRequ
 	return out, nil
 }
 
+func (c *sessionServiceClient) ListSessions(ctx context.Context, in *SessionListRequest, opts ...grpc.CallOption) (*SessionList, error) {
+	out := new(SessionList)
+	err := c.cc.Invoke(ctx, "/session.SessionService/ListSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) RevokeSession(ctx context.Context, in *SessionRevokeRequest, opts ...grpc.CallOption) (*ActiveSession, error) {
+	out := new(ActiveSession)
+	err := c.cc.Invoke(ctx, "/session.SessionService/RevokeSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SessionServiceServer is the server API for SessionService service.
 type SessionServiceServer interface {
 	// Get the current user's info
@@ -398,6 +677,10 @@ type SessionServiceServer interface {
 	Create(context.Context, *SessionCreateRequest) (*SessionResponse, error)
 	// Delete an existing JWT cookie if using HTTP
 	Delete(context.Context, *SessionDeleteRequest) (*SessionResponse, error)
+	// ListSessions lists the sessions which were active in the last 24 hours
+	ListSessions(context.Context, *SessionListRequest) (*SessionList, error)
+	// RevokeSession revokes an active session, whose token is rejected until it expires
+	RevokeSession(context.Context, *SessionRevokeRequest) (*ActiveSession, error)
 }
 
 // UnimplementedSessionServiceServer can be embedded to have forward compatible implementations.
@@ -413,6 +696,12 @@ func (*UnimplementedSessionServiceServer) Create(ctx context.Context, req *Sessi
 func (*UnimplementedSessionServiceServer) Delete(ctx context.Context, req *SessionDeleteRequest) (*SessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedSessionServiceServer) ListSessions(ctx context.Context, req *SessionListRequest) (*SessionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (*UnimplementedSessionServiceServer) RevokeSession(ctx context.Context, req *SessionRevokeRequest) (*ActiveSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
 
 func RegisterSessionServiceServer(s *grpc.Server, srv SessionServiceServer) {
 	s.RegisterService(&_SessionService_serviceDesc, srv)
@@ -472,6 +761,42 @@ func _SessionService_Delete_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/session.SessionService/ListSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).ListSessions(ctx, req.(*SessionListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionRevokeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/session.SessionService/RevokeSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).RevokeSession(ctx, req.(*SessionRevokeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _SessionService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "session.SessionService",
 	HandlerType: (*SessionServiceServer)(nil),
@@ -488,6 +813,14 @@ var _SessionService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _SessionService_Delete_Handler,
 		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _SessionService_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _SessionService_RevokeSession_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "server/session/session.proto",
@@ -689,60 +1022,239 @@ func (m *GetUserInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintSession(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSession(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SessionListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *SessionCreateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Username)
-	if l > 0 {
-		n += 1 + l + sovSession(uint64(l))
-	}
-	l = len(m.Password)
-	if l > 0 {
-		n += 1 + l + sovSession(uint64(l))
-	}
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovSession(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func (m *SessionListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SessionDeleteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SessionListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintSession(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SessionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ActiveSession) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ActiveSession) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActiveSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Token)
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ClientIP) > 0 {
+		i -= len(m.ClientIP)
+		copy(dAtA[i:], m.ClientIP)
+		i = encodeVarintSession(dAtA, i, uint64(len(m.ClientIP)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.LastActivity != 0 {
+		i = encodeVarintSession(dAtA, i, uint64(m.LastActivity))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintSession(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IssuedAt != 0 {
+		i = encodeVarintSession(dAtA, i, uint64(m.IssuedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintSession(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintSession(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintSession(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SessionList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSession(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SessionRevokeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionRevokeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionRevokeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintSession(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSession(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSession(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SessionCreateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SessionDeleteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SessionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
 	if l > 0 {
 		n += 1 + l + sovSession(uint64(l))
 	}
@@ -793,6 +1305,93 @@ func (m *GetUserInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *SessionListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActiveSession) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	if m.IssuedAt != 0 {
+		n += 1 + sovSession(uint64(m.IssuedAt))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovSession(uint64(m.ExpiresAt))
+	}
+	if m.LastActivity != 0 {
+		n += 1 + sovSession(uint64(m.LastActivity))
+	}
+	l = len(m.ClientIP)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SessionList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovSession(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SessionRevokeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovSession(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1298,6 +1897,493 @@ func (m *GetUserInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SessionListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSession
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSession(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSession
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActiveSession) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSession
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActiveSession: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActiveSession: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			}
+			m.IssuedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastActivity", wireType)
+			}
+			m.LastActivity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastActivity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientIP", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientIP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSession(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSession
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSession
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &ActiveSession{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSession(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSession
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionRevokeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSession
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionRevokeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionRevokeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSession(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSession
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSession(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
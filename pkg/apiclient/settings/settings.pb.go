@@ -88,26 +88,29 @@ type Settings struct {
 	Plugins            []*Plugin `protobuf:"bytes,10,rep,name=plugins,proto3" json:"plugins,omitempty"`
 	UserLoginsDisabled bool      `protobuf:"varint,11,opt,name=userLoginsDisabled,proto3" json:"userLoginsDisabled,omitempty"`
 	// Deprecated: use sidecar plugins instead.
-	ConfigManagementPlugins   []*v1alpha1.ConfigManagementPlugin `protobuf:"bytes,12,rep,name=configManagementPlugins,proto3" json:"configManagementPlugins,omitempty"`
-	KustomizeVersions         []string                           `protobuf:"bytes,13,rep,name=kustomizeVersions,proto3" json:"kustomizeVersions,omitempty"`
-	UiCssURL                  string                             `protobuf:"bytes,14,opt,name=uiCssURL,proto3" json:"uiCssURL,omitempty"`
-	UiBannerContent           string                             `protobuf:"bytes,15,opt,name=uiBannerContent,proto3" json:"uiBannerContent,omitempty"`
-	UiBannerURL               string                             `protobuf:"bytes,16,opt,name=uiBannerURL,proto3" json:"uiBannerURL,omitempty"`
-	PasswordPattern           string                             `protobuf:"bytes,17,opt,name=passwordPattern,proto3" json:"passwordPattern,omitempty"`
-	TrackingMethod            string                             `protobuf:"bytes,18,opt,name=trackingMethod,proto3" json:"trackingMethod,omitempty"`
-	UiBannerPermanent         bool                               `protobuf:"varint,19,opt,name=uiBannerPermanent,proto3" json:"uiBannerPermanent,omitempty"`
-	UiBannerPosition          string                             `protobuf:"bytes,20,opt,name=uiBannerPosition,proto3" json:"uiBannerPosition,omitempty"`
-	StatusBadgeRootUrl        string                             `protobuf:"bytes,21,opt,name=statusBadgeRootUrl,proto3" json:"statusBadgeRootUrl,omitempty"`
-	ExecEnabled               bool                               `protobuf:"varint,22,opt,name=execEnabled,proto3" json:"execEnabled,omitempty"`
-	ControllerNamespace       string                             `protobuf:"bytes,23,opt,name=controllerNamespace,proto3" json:"controllerNamespace,omitempty"`
-	AppsInAnyNamespaceEnabled bool                               `protobuf:"varint,24,opt,name=appsInAnyNamespaceEnabled,proto3" json:"appsInAnyNamespaceEnabled,omitempty"`
-	ImpersonationEnabled      bool                               `protobuf:"varint,25,opt,name=impersonationEnabled,proto3" json:"impersonationEnabled,omitempty"`
-	InstallationID            string                             `protobuf:"bytes,26,opt,name=installationID,proto3" json:"installationID,omitempty"`
-	AdditionalURLs            []string                           `protobuf:"bytes,27,rep,name=additionalUrls,proto3" json:"additionalUrls,omitempty"`
-	HydratorEnabled           bool                               `protobuf:"varint,28,opt,name=hydratorEnabled,proto3" json:"hydratorEnabled,omitempty"`
-	XXX_NoUnkeyedLiteral      struct{}                           `json:"-"`
-	XXX_unrecognized          []byte                             `json:"-"`
-	XXX_sizecache             int32                              `json:"-"`
+	ConfigManagementPlugins   []*v1alpha1.ConfigManagementPlugin    `protobuf:"bytes,12,rep,name=configManagementPlugins,proto3" json:"configManagementPlugins,omitempty"`
+	KustomizeVersions         []string                              `protobuf:"bytes,13,rep,name=kustomizeVersions,proto3" json:"kustomizeVersions,omitempty"`
+	UiCssURL                  string                                `protobuf:"bytes,14,opt,name=uiCssURL,proto3" json:"uiCssURL,omitempty"`
+	UiBannerContent           string                                `protobuf:"bytes,15,opt,name=uiBannerContent,proto3" json:"uiBannerContent,omitempty"`
+	UiBannerURL               string                                `protobuf:"bytes,16,opt,name=uiBannerURL,proto3" json:"uiBannerURL,omitempty"`
+	PasswordPattern           string                                `protobuf:"bytes,17,opt,name=passwordPattern,proto3" json:"passwordPattern,omitempty"`
+	TrackingMethod            string                                `protobuf:"bytes,18,opt,name=trackingMethod,proto3" json:"trackingMethod,omitempty"`
+	UiBannerPermanent         bool                                  `protobuf:"varint,19,opt,name=uiBannerPermanent,proto3" json:"uiBannerPermanent,omitempty"`
+	UiBannerPosition          string                                `protobuf:"bytes,20,opt,name=uiBannerPosition,proto3" json:"uiBannerPosition,omitempty"`
+	StatusBadgeRootUrl        string                                `protobuf:"bytes,21,opt,name=statusBadgeRootUrl,proto3" json:"statusBadgeRootUrl,omitempty"`
+	ExecEnabled               bool                                  `protobuf:"varint,22,opt,name=execEnabled,proto3" json:"execEnabled,omitempty"`
+	ControllerNamespace       string                                `protobuf:"bytes,23,opt,name=controllerNamespace,proto3" json:"controllerNamespace,omitempty"`
+	AppsInAnyNamespaceEnabled bool                                  `protobuf:"varint,24,opt,name=appsInAnyNamespaceEnabled,proto3" json:"appsInAnyNamespaceEnabled,omitempty"`
+	ImpersonationEnabled      bool                                  `protobuf:"varint,25,opt,name=impersonationEnabled,proto3" json:"impersonationEnabled,omitempty"`
+	InstallationID            string                                `protobuf:"bytes,26,opt,name=installationID,proto3" json:"installationID,omitempty"`
+	AdditionalURLs            []string                              `protobuf:"bytes,27,rep,name=additionalUrls,proto3" json:"additionalUrls,omitempty"`
+	HydratorEnabled           bool                                  `protobuf:"varint,28,opt,name=hydratorEnabled,proto3" json:"hydratorEnabled,omitempty"`
+	ResourceIgnoreDifferences []*v1alpha1.ResourceIgnoreDifferences `protobuf:"bytes,29,rep,name=resourceIgnoreDifferences,proto3" json:"resourceIgnoreDifferences,omitempty"`
+	// the OIDC providers the users can log in with in addition to the one of oidcConfig or dexConfig
+	AdditionalOIDCConfigs []*OIDCConfig `protobuf:"bytes,30,rep,name=additionalOIDCConfigs,proto3" json:"additionalOIDCConfigs,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}      `json:"-"`
+	XXX_unrecognized      []byte        `json:"-"`
+	XXX_sizecache         int32         `json:"-"`
 }
 
 func (m *Settings) Reset()         { *m = Settings{} }
@@ -339,6 +342,20 @@ func (m *Settings) GetHydratorEnabled() bool {
 	return false
 }
 
+func (m *Settings) GetResourceIgnoreDifferences() []*v1alpha1.ResourceIgnoreDifferences {
+	if m != nil {
+		return m.ResourceIgnoreDifferences
+	}
+	return nil
+}
+
+func (m *Settings) GetAdditionalOIDCConfigs() []*OIDCConfig {
+	if m != nil {
+		return m.AdditionalOIDCConfigs
+	}
+	return nil
+}
+
 type GoogleAnalyticsConfig struct {
 	TrackingID           string   `protobuf:"bytes,1,opt,name=trackingID,proto3" json:"trackingID,omitempty"`
 	AnonymizeUsers       bool     `protobuf:"varint,2,opt,name=anonymizeUsers,proto3" json:"anonymizeUsers,omitempty"`
@@ -772,88 +789,92 @@ func init() {
 func init() { proto.RegisterFile("server/settings/settings.proto", fileDescriptor_a480d494da040caa) }
 
 var fileDescriptor_a480d494da040caa = []byte{
-	// 1291 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xd7, 0xd6, 0x69, 0x62, 0xbf, 0x34, 0x71, 0x32, 0x4d, 0xd3, 0xad, 0xbf, 0xfd, 0x26, 0xc6,
-	0x87, 0xca, 0x20, 0x58, 0x37, 0x89, 0x10, 0xa8, 0xa2, 0x82, 0xd8, 0xae, 0x5a, 0x53, 0xb7, 0x0d,
-	0xd3, 0xa6, 0x07, 0x2e, 0xd5, 0x64, 0x77, 0x58, 0x2f, 0x59, 0xcf, 0xac, 0x66, 0x66, 0x4d, 0xdd,
-	0x23, 0x7f, 0x00, 0x17, 0xf8, 0x6b, 0xb8, 0x23, 0x38, 0x22, 0x71, 0x8f, 0x90, 0xc5, 0x1f, 0xc1,
-	0x11, 0xcd, 0xec, 0x8f, 0x6c, 0xd6, 0x4e, 0x41, 0xea, 0x6d, 0xe6, 0xf3, 0x79, 0xbf, 0xe6, 0xcd,
-	0x7b, 0x33, 0x0f, 0x76, 0x24, 0x15, 0x13, 0x2a, 0x3a, 0x92, 0x2a, 0x15, 0x30, 0x5f, 0xe6, 0x0b,
-	0x27, 0x12, 0x5c, 0x71, 0xb4, 0xe2, 0x86, 0xb1, 0x54, 0x54, 0x34, 0xb6, 0x7c, 0xee, 0x73, 0x83,
-	0x75, 0xf4, 0x2a, 0xa1, 0x1b, 0xb7, 0x7d, 0xce, 0xfd, 0x90, 0x76, 0x48, 0x14, 0x74, 0x08, 0x63,
-	0x5c, 0x11, 0x15, 0x70, 0x96, 0x2a, 0x37, 0x86, 0x7e, 0xa0, 0x46, 0xf1, 0x89, 0xe3, 0xf2, 0x71,
-	0x87, 0x08, 0xa3, 0xfe, 0xad, 0x59, 0x7c, 0xe4, 0x7a, 0x9d, 0xc9, 0x41, 0x27, 0x3a, 0xf5, 0xb5,
-	0xa6, 0xec, 0x90, 0x28, 0x0a, 0x03, 0xd7, 0xe8, 0x76, 0x26, 0x7b, 0x24, 0x8c, 0x46, 0x64, 0xaf,
-	0xe3, 0x53, 0x46, 0x05, 0x51, 0xd4, 0x4b, 0xad, 0x7d, 0xf1, 0x2f, 0xd6, 0xca, 0x27, 0xe1, 0x81,
-	0xe7, 0x76, 0xdc, 0x90, 0x04, 0xe3, 0x34, 0x9e, 0x56, 0x1d, 0xd6, 0x9e, 0xa7, 0xec, 0x57, 0x31,
-	0x15, 0xd3, 0xd6, 0xdf, 0xd7, 0xa0, 0x9a, 0x21, 0xe8, 0x16, 0x54, 0x62, 0x11, 0xda, 0x56, 0xd3,
-	0x6a, 0xd7, 0xba, 0x2b, 0xb3, 0xb3, 0xdd, 0xca, 0x31, 0x1e, 0x62, 0x8d, 0xa1, 0xbb, 0x50, 0xf3,
-	0xe8, 0xeb, 0x1e, 0x67, 0xdf, 0x04, 0xbe, 0x7d, 0xa5, 0x69, 0xb5, 0x57, 0xf7, 0x91, 0x93, 0x66,
-	0xc6, 0xe9, 0x67, 0x0c, 0x3e, 0x17, 0x42, 0x3d, 0x00, 0xed, 0x3f, 0x55, 0xa9, 0x18, 0x95, 0xeb,
-	0xb9, 0xca, 0xb3, 0x41, 0xbf, 0x97, 0x50, 0xdd, 0xf5, 0xd9, 0xd9, 0x2e, 0x9c, 0xef, 0x71, 0x41,
-	0x0d, 0x35, 0x61, 0x95, 0x44, 0xd1, 0x90, 0x9c, 0xd0, 0xf0, 0x31, 0x9d, 0xda, 0x4b, 0x3a, 0x32,
-	0x5c, 0x84, 0xd0, 0x4b, 0xd8, 0x14, 0x54, 0xf2, 0x58, 0xb8, 0xf4, 0xd9, 0x84, 0x0a, 0x11, 0x78,
-	0x54, 0xda, 0x57, 0x9b, 0x95, 0xf6, 0xea, 0x7e, 0x3b, 0xf7, 0x96, 0x9d, 0xd0, 0xc1, 0x65, 0xd1,
-	0x07, 0x4c, 0x89, 0x29, 0x9e, 0x37, 0x81, 0x1c, 0x40, 0x52, 0x11, 0x15, 0xcb, 0x2e, 0xf1, 0x7c,
-	0xfa, 0x80, 0x91, 0x93, 0x90, 0x7a, 0xf6, 0x72, 0xd3, 0x6a, 0x57, 0xf1, 0x02, 0x06, 0x3d, 0x82,
-	0x7a, 0x52, 0x09, 0x87, 0x8c, 0x84, 0x53, 0x15, 0xb8, 0xd2, 0x5e, 0x31, 0x67, 0xde, 0xc9, 0xa3,
-	0x78, 0x78, 0x91, 0x4f, 0x8f, 0x5b, 0x56, 0x43, 0x6f, 0x60, 0xe3, 0x34, 0x96, 0x8a, 0x8f, 0x83,
-	0x37, 0xf4, 0x59, 0x64, 0xaa, 0xc9, 0xae, 0x1a, 0x53, 0x4f, 0x9d, 0xf3, 0x02, 0x70, 0xb2, 0x02,
-	0x30, 0x8b, 0x57, 0xae, 0xe7, 0x4c, 0x0e, 0x9c, 0xe8, 0xd4, 0x77, 0x74, 0x39, 0x39, 0x85, 0x72,
-	0x72, 0xb2, 0x72, 0x72, 0x1e, 0x97, 0xac, 0xe2, 0x39, 0x3f, 0xe8, 0x3d, 0x58, 0x1a, 0xd1, 0x30,
-	0xb2, 0x6b, 0xc6, 0xdf, 0x5a, 0x1e, 0xfa, 0x23, 0x1a, 0x46, 0xd8, 0x50, 0xe8, 0x7d, 0x58, 0x89,
-	0xc2, 0xd8, 0x0f, 0x98, 0xb4, 0xc1, 0xa4, 0xb9, 0x9e, 0x4b, 0x1d, 0x19, 0x1c, 0x67, 0xbc, 0xce,
-	0x61, 0x2c, 0xa9, 0x18, 0x72, 0xbd, 0xeb, 0x07, 0x32, 0xc9, 0xe1, 0x6a, 0x92, 0xc3, 0x79, 0x06,
-	0xfd, 0x60, 0xc1, 0x4d, 0xd7, 0x64, 0xe5, 0x09, 0x61, 0xc4, 0xa7, 0x63, 0xca, 0xd4, 0x51, 0xea,
-	0xeb, 0x9a, 0xf1, 0xf5, 0xe2, 0xdd, 0x32, 0xd0, 0x5b, 0x68, 0x1c, 0x5f, 0xe6, 0x14, 0x7d, 0x08,
-	0x9b, 0x79, 0x8a, 0x5e, 0x52, 0x21, 0xcd, 0x5d, 0xac, 0x35, 0x2b, 0xed, 0x1a, 0x9e, 0x27, 0x50,
-	0x03, 0xaa, 0x71, 0xd0, 0x93, 0xf2, 0x18, 0x0f, 0xed, 0x75, 0x53, 0xa9, 0xf9, 0x1e, 0xb5, 0xa1,
-	0x1e, 0x07, 0x5d, 0xc2, 0x18, 0x15, 0x3d, 0xce, 0x14, 0x65, 0xca, 0xae, 0x1b, 0x91, 0x32, 0xac,
-	0x4b, 0x3e, 0x83, 0xb4, 0xa1, 0x8d, 0xa4, 0xe4, 0x0b, 0x90, 0xb6, 0x15, 0x11, 0x29, 0xbf, 0xe3,
-	0xc2, 0x3b, 0x22, 0x4a, 0x51, 0xc1, 0xec, 0xcd, 0xc4, 0x56, 0x09, 0x46, 0x77, 0x60, 0x5d, 0x09,
-	0xe2, 0x9e, 0x06, 0xcc, 0x7f, 0x42, 0xd5, 0x88, 0x7b, 0x36, 0x32, 0x82, 0x25, 0x54, 0x9f, 0x33,
-	0x73, 0x70, 0x44, 0xc5, 0x98, 0x30, 0x1d, 0xdf, 0x75, 0x73, 0x4f, 0xf3, 0x04, 0xfa, 0x00, 0x36,
-	0x72, 0x90, 0xcb, 0x40, 0xa7, 0xd8, 0xde, 0x32, 0x76, 0xe7, 0xf0, 0x52, 0x1b, 0x61, 0xce, 0xd5,
-	0xb1, 0x08, 0xed, 0x1b, 0x46, 0x7a, 0x01, 0xa3, 0x4f, 0x4f, 0x5f, 0x53, 0x37, 0xeb, 0xb7, 0x6d,
-	0x13, 0x43, 0x11, 0x42, 0x77, 0xe1, 0xba, 0xcb, 0x99, 0x12, 0x3c, 0x0c, 0xa9, 0x78, 0x4a, 0xc6,
-	0x54, 0x46, 0xc4, 0xa5, 0xf6, 0x4d, 0x63, 0x72, 0x11, 0x85, 0x3e, 0x83, 0x5b, 0x24, 0x8a, 0xe4,
-	0x80, 0x1d, 0xb2, 0x69, 0x8e, 0x66, 0x1e, 0x6c, 0xe3, 0xe1, 0x72, 0x01, 0xb4, 0x0f, 0x5b, 0xc1,
-	0x38, 0xa2, 0x42, 0x72, 0x66, 0xaa, 0x29, 0x53, 0xbc, 0x65, 0x14, 0x17, 0x72, 0x3a, 0xef, 0x01,
-	0x93, 0x8a, 0x84, 0xa1, 0x81, 0x07, 0x7d, 0xbb, 0x91, 0xe4, 0xfd, 0x22, 0x8a, 0xee, 0xc1, 0x3a,
-	0xf1, 0x3c, 0x93, 0x29, 0x12, 0x1e, 0x8b, 0x50, 0xda, 0xff, 0xd3, 0xc5, 0xd5, 0x45, 0xb3, 0xb3,
-	0xdd, 0xf5, 0xc3, 0x73, 0x06, 0x0f, 0x25, 0x2e, 0x49, 0xea, 0x2a, 0x18, 0x4d, 0x3d, 0x41, 0x14,
-	0x17, 0x59, 0x48, 0xb7, 0x4d, 0x48, 0x65, 0xb8, 0xf1, 0x93, 0x05, 0xdb, 0x8b, 0x1f, 0x3e, 0xb4,
-	0x01, 0x95, 0x53, 0x3a, 0x4d, 0x5e, 0x7c, 0xac, 0x97, 0xc8, 0x83, 0xab, 0x13, 0x12, 0xc6, 0x34,
-	0x7d, 0xe4, 0xdf, 0xf1, 0xc9, 0x29, 0xbb, 0xc5, 0x89, 0xf1, 0x7b, 0x57, 0x3e, 0xb5, 0x5a, 0xaf,
-	0xe0, 0xc6, 0xc2, 0x17, 0x11, 0xed, 0x00, 0x64, 0xf5, 0x39, 0xe8, 0xa7, 0xb1, 0x15, 0x10, 0x9d,
-	0x5d, 0xc2, 0x38, 0x9b, 0xea, 0xe6, 0x3b, 0x96, 0x54, 0x48, 0x13, 0x6b, 0x15, 0x97, 0xd0, 0x56,
-	0x1f, 0x6e, 0x66, 0x0f, 0x7f, 0xda, 0xd0, 0x98, 0xca, 0x88, 0x33, 0x49, 0x8b, 0x8f, 0x98, 0xf5,
-	0xf6, 0x47, 0xac, 0xf5, 0xb3, 0x05, 0x4b, 0xfa, 0xf9, 0x43, 0x36, 0xac, 0xb8, 0x23, 0x62, 0xea,
-	0x37, 0x89, 0x29, 0xdb, 0xea, 0xc6, 0xd7, 0xcb, 0x17, 0xf4, 0xb5, 0x32, 0xa1, 0xd4, 0x70, 0xbe,
-	0x47, 0xf7, 0x01, 0x4e, 0x02, 0x46, 0xc4, 0xd4, 0x5c, 0x6f, 0xc5, 0x38, 0xfb, 0xff, 0x85, 0x77,
-	0xd5, 0xe9, 0xe6, 0x7c, 0xf2, 0x1b, 0x15, 0x14, 0x1a, 0xf7, 0xa1, 0x5e, 0xa2, 0x17, 0xdc, 0xd9,
-	0x56, 0xf1, 0xce, 0x6a, 0xc5, 0x1c, 0xdf, 0x86, 0xe5, 0xe4, 0x3c, 0x08, 0xc1, 0x12, 0x23, 0x63,
-	0x9a, 0xaa, 0x99, 0x75, 0xeb, 0x73, 0xa8, 0xe5, 0x5f, 0x37, 0xda, 0x07, 0x70, 0x39, 0x63, 0xd4,
-	0x55, 0x5c, 0x64, 0x59, 0x39, 0xff, 0xe2, 0x7b, 0x19, 0x85, 0x0b, 0x52, 0xad, 0x03, 0xa8, 0xe5,
-	0xc4, 0x22, 0x0f, 0x1a, 0x53, 0xd3, 0x28, 0x0b, 0xcc, 0xac, 0x5b, 0xbf, 0x54, 0xa0, 0xf0, 0xdd,
-	0x2f, 0x54, 0xdb, 0x86, 0xe5, 0x40, 0xca, 0x98, 0x8a, 0x54, 0x31, 0xdd, 0xa1, 0x36, 0x54, 0xdd,
-	0x30, 0xa0, 0x4c, 0x0d, 0xfa, 0x66, 0xa2, 0xa8, 0x75, 0xaf, 0xcd, 0xce, 0x76, 0xab, 0xbd, 0x14,
-	0xc3, 0x39, 0x8b, 0xf6, 0x60, 0xd5, 0x0d, 0x83, 0x8c, 0x48, 0x06, 0x87, 0x6e, 0x7d, 0x76, 0xb6,
-	0xbb, 0xda, 0x1b, 0x0e, 0x72, 0xf9, 0xa2, 0x8c, 0x76, 0x2a, 0x5d, 0x1e, 0xa5, 0xe3, 0x43, 0x0d,
-	0xa7, 0x3b, 0xf4, 0x0a, 0xd6, 0x02, 0xef, 0x05, 0x3f, 0xa5, 0xac, 0x67, 0x46, 0x29, 0x7b, 0xd9,
-	0xe4, 0xe6, 0xce, 0x82, 0x59, 0xc6, 0x19, 0x14, 0x05, 0xcd, 0x75, 0x75, 0x37, 0x67, 0x67, 0xbb,
-	0x6b, 0x83, 0x7e, 0x01, 0xc7, 0x17, 0xed, 0xa1, 0x7b, 0x60, 0x53, 0xd3, 0xaa, 0x47, 0x8f, 0x7b,
-	0x0f, 0x0e, 0x63, 0x35, 0xa2, 0x4c, 0xa5, 0x9d, 0x64, 0x66, 0x88, 0x2a, 0xbe, 0x94, 0x6f, 0x4c,
-	0x01, 0xcd, 0xfb, 0x5c, 0x50, 0x22, 0x4f, 0x2e, 0xb6, 0xf5, 0x27, 0x6f, 0x6d, 0xeb, 0x64, 0x8e,
-	0x74, 0xf2, 0x41, 0x58, 0x0f, 0x64, 0x8e, 0xb1, 0x5f, 0xa8, 0xad, 0xfd, 0x5f, 0x2d, 0xa8, 0x67,
-	0xfd, 0xf5, 0x9c, 0x8a, 0x49, 0xe0, 0x52, 0xf4, 0x25, 0x54, 0x1e, 0x52, 0x85, 0xb6, 0xe7, 0x26,
-	0x2f, 0x33, 0x6d, 0x36, 0x36, 0xe7, 0xf0, 0x96, 0xfd, 0xfd, 0x1f, 0x7f, 0xfd, 0x78, 0x05, 0xa1,
-	0x0d, 0x33, 0x41, 0x4f, 0xf6, 0xf2, 0xe9, 0x15, 0x8d, 0x00, 0x1e, 0xd2, 0xfc, 0x2b, 0xbe, 0xcc,
-	0x64, 0x73, 0x0e, 0x2f, 0xf5, 0x7a, 0xab, 0x69, 0x3c, 0x34, 0x90, 0x5d, 0xf6, 0xd0, 0x49, 0x5b,
-	0xbc, 0xdb, 0xfb, 0x6d, 0xb6, 0x63, 0xfd, 0x3e, 0xdb, 0xb1, 0xfe, 0x9c, 0xed, 0x58, 0x5f, 0x7f,
-	0xfc, 0xdf, 0x66, 0xf6, 0xa4, 0xd4, 0x72, 0x63, 0x27, 0xcb, 0x66, 0xc2, 0x3e, 0xf8, 0x27, 0x00,
-	0x00, 0xff, 0xff, 0xb3, 0xaf, 0x63, 0xe2, 0x50, 0x0c, 0x00, 0x00,
+	// 1350 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0x97, 0xe3, 0x34, 0xb1, 0x5f, 0x9a, 0x38, 0x99, 0x26, 0xe9, 0xc6, 0xb4, 0x89, 0xf1, 0xa1,
+	0x32, 0x08, 0xd6, 0x4d, 0x22, 0x04, 0xaa, 0xa8, 0x20, 0xb6, 0xab, 0xd6, 0x34, 0x6d, 0xc3, 0xb4,
+	0x29, 0x12, 0x97, 0x6a, 0xb2, 0xfb, 0x6a, 0x2f, 0x59, 0xcf, 0xac, 0x66, 0xc6, 0xa6, 0xee, 0x91,
+	0x0f, 0xc0, 0x05, 0xf8, 0x32, 0xdc, 0x11, 0x1c, 0x91, 0xb8, 0x47, 0xc8, 0xe2, 0x33, 0x70, 0x46,
+	0x3b, 0xfb, 0x27, 0x9b, 0xb5, 0x53, 0x90, 0xca, 0x6d, 0xe6, 0xf7, 0x7b, 0xff, 0xe6, 0xed, 0x7b,
+	0x33, 0x6f, 0x61, 0x5b, 0xa1, 0x1c, 0xa1, 0x6c, 0x2a, 0xd4, 0xda, 0xe3, 0x3d, 0x95, 0x2e, 0xec,
+	0x40, 0x0a, 0x2d, 0xc8, 0xa2, 0xe3, 0x0f, 0x95, 0x46, 0x59, 0x5d, 0xef, 0x89, 0x9e, 0x30, 0x58,
+	0x33, 0x5c, 0x45, 0x74, 0xf5, 0x46, 0x4f, 0x88, 0x9e, 0x8f, 0x4d, 0x16, 0x78, 0x4d, 0xc6, 0xb9,
+	0xd0, 0x4c, 0x7b, 0x82, 0xc7, 0xca, 0xd5, 0xc3, 0x9e, 0xa7, 0xfb, 0xc3, 0x13, 0xdb, 0x11, 0x83,
+	0x26, 0x93, 0x46, 0xfd, 0x1b, 0xb3, 0xf8, 0xd0, 0x71, 0x9b, 0xa3, 0xfd, 0x66, 0x70, 0xda, 0x0b,
+	0x35, 0x55, 0x93, 0x05, 0x81, 0xef, 0x39, 0x46, 0xb7, 0x39, 0xda, 0x65, 0x7e, 0xd0, 0x67, 0xbb,
+	0xcd, 0x1e, 0x72, 0x94, 0x4c, 0xa3, 0x1b, 0x5b, 0xfb, 0xfc, 0x5f, 0xac, 0xe5, 0x4f, 0x22, 0x3c,
+	0xd7, 0x69, 0x3a, 0x3e, 0xf3, 0x06, 0x71, 0x3c, 0xf5, 0x0a, 0x2c, 0x3f, 0x8d, 0xd9, 0x2f, 0x87,
+	0x28, 0xc7, 0xf5, 0xbf, 0x57, 0xa0, 0x94, 0x20, 0x64, 0x0b, 0x8a, 0x43, 0xe9, 0x5b, 0x85, 0x5a,
+	0xa1, 0x51, 0x6e, 0x2d, 0x4e, 0xce, 0x76, 0x8a, 0xc7, 0xf4, 0x90, 0x86, 0x18, 0xb9, 0x0d, 0x65,
+	0x17, 0x5f, 0xb5, 0x05, 0x7f, 0xe9, 0xf5, 0xac, 0xb9, 0x5a, 0xa1, 0xb1, 0xb4, 0x47, 0xec, 0x38,
+	0x33, 0x76, 0x27, 0x61, 0xe8, 0xb9, 0x10, 0x69, 0x03, 0x84, 0xfe, 0x63, 0x95, 0xa2, 0x51, 0xb9,
+	0x96, 0xaa, 0x3c, 0xe9, 0x76, 0xda, 0x11, 0xd5, 0x5a, 0x99, 0x9c, 0xed, 0xc0, 0xf9, 0x9e, 0x66,
+	0xd4, 0x48, 0x0d, 0x96, 0x58, 0x10, 0x1c, 0xb2, 0x13, 0xf4, 0x1f, 0xe2, 0xd8, 0x9a, 0x0f, 0x23,
+	0xa3, 0x59, 0x88, 0x3c, 0x87, 0x35, 0x89, 0x4a, 0x0c, 0xa5, 0x83, 0x4f, 0x46, 0x28, 0xa5, 0xe7,
+	0xa2, 0xb2, 0xae, 0xd4, 0x8a, 0x8d, 0xa5, 0xbd, 0x46, 0xea, 0x2d, 0x39, 0xa1, 0x4d, 0xf3, 0xa2,
+	0xf7, 0xb8, 0x96, 0x63, 0x3a, 0x6d, 0x82, 0xd8, 0x40, 0x94, 0x66, 0x7a, 0xa8, 0x5a, 0xcc, 0xed,
+	0xe1, 0x3d, 0xce, 0x4e, 0x7c, 0x74, 0xad, 0x85, 0x5a, 0xa1, 0x51, 0xa2, 0x33, 0x18, 0xf2, 0x00,
+	0x2a, 0x51, 0x25, 0x1c, 0x70, 0xe6, 0x8f, 0xb5, 0xe7, 0x28, 0x6b, 0xd1, 0x9c, 0x79, 0x3b, 0x8d,
+	0xe2, 0xfe, 0x45, 0x3e, 0x3e, 0x6e, 0x5e, 0x8d, 0xbc, 0x86, 0xd5, 0xd3, 0xa1, 0xd2, 0x62, 0xe0,
+	0xbd, 0xc6, 0x27, 0x81, 0xa9, 0x26, 0xab, 0x64, 0x4c, 0x3d, 0xb6, 0xcf, 0x0b, 0xc0, 0x4e, 0x0a,
+	0xc0, 0x2c, 0x5e, 0x38, 0xae, 0x3d, 0xda, 0xb7, 0x83, 0xd3, 0x9e, 0x1d, 0x96, 0x93, 0x9d, 0x29,
+	0x27, 0x3b, 0x29, 0x27, 0xfb, 0x61, 0xce, 0x2a, 0x9d, 0xf2, 0x43, 0xde, 0x85, 0xf9, 0x3e, 0xfa,
+	0x81, 0x55, 0x36, 0xfe, 0x96, 0xd3, 0xd0, 0x1f, 0xa0, 0x1f, 0x50, 0x43, 0x91, 0xf7, 0x60, 0x31,
+	0xf0, 0x87, 0x3d, 0x8f, 0x2b, 0x0b, 0x4c, 0x9a, 0x2b, 0xa9, 0xd4, 0x91, 0xc1, 0x69, 0xc2, 0x87,
+	0x39, 0x1c, 0x2a, 0x94, 0x87, 0x22, 0xdc, 0x75, 0x3c, 0x15, 0xe5, 0x70, 0x29, 0xca, 0xe1, 0x34,
+	0x43, 0xbe, 0x2f, 0xc0, 0x75, 0xc7, 0x64, 0xe5, 0x11, 0xe3, 0xac, 0x87, 0x03, 0xe4, 0xfa, 0x28,
+	0xf6, 0x75, 0xd5, 0xf8, 0x7a, 0xf6, 0x76, 0x19, 0x68, 0xcf, 0x34, 0x4e, 0x2f, 0x73, 0x4a, 0x3e,
+	0x80, 0xb5, 0x34, 0x45, 0xcf, 0x51, 0x2a, 0xf3, 0x2d, 0x96, 0x6b, 0xc5, 0x46, 0x99, 0x4e, 0x13,
+	0xa4, 0x0a, 0xa5, 0xa1, 0xd7, 0x56, 0xea, 0x98, 0x1e, 0x5a, 0x2b, 0xa6, 0x52, 0xd3, 0x3d, 0x69,
+	0x40, 0x65, 0xe8, 0xb5, 0x18, 0xe7, 0x28, 0xdb, 0x82, 0x6b, 0xe4, 0xda, 0xaa, 0x18, 0x91, 0x3c,
+	0x1c, 0x96, 0x7c, 0x02, 0x85, 0x86, 0x56, 0xa3, 0x92, 0xcf, 0x40, 0xa1, 0xad, 0x80, 0x29, 0xf5,
+	0xad, 0x90, 0xee, 0x11, 0xd3, 0x1a, 0x25, 0xb7, 0xd6, 0x22, 0x5b, 0x39, 0x98, 0xdc, 0x82, 0x15,
+	0x2d, 0x99, 0x73, 0xea, 0xf1, 0xde, 0x23, 0xd4, 0x7d, 0xe1, 0x5a, 0xc4, 0x08, 0xe6, 0xd0, 0xf0,
+	0x9c, 0x89, 0x83, 0x23, 0x94, 0x03, 0xc6, 0xc3, 0xf8, 0xae, 0x99, 0xef, 0x34, 0x4d, 0x90, 0xf7,
+	0x61, 0x35, 0x05, 0x85, 0xf2, 0xc2, 0x14, 0x5b, 0xeb, 0xc6, 0xee, 0x14, 0x9e, 0x6b, 0x23, 0x2a,
+	0x84, 0x3e, 0x96, 0xbe, 0xb5, 0x61, 0xa4, 0x67, 0x30, 0xe1, 0xe9, 0xf1, 0x15, 0x3a, 0x49, 0xbf,
+	0x6d, 0x9a, 0x18, 0xb2, 0x10, 0xb9, 0x0d, 0xd7, 0x1c, 0xc1, 0xb5, 0x14, 0xbe, 0x8f, 0xf2, 0x31,
+	0x1b, 0xa0, 0x0a, 0x98, 0x83, 0xd6, 0x75, 0x63, 0x72, 0x16, 0x45, 0x3e, 0x85, 0x2d, 0x16, 0x04,
+	0xaa, 0xcb, 0x0f, 0xf8, 0x38, 0x45, 0x13, 0x0f, 0x96, 0xf1, 0x70, 0xb9, 0x00, 0xd9, 0x83, 0x75,
+	0x6f, 0x10, 0xa0, 0x54, 0x82, 0x9b, 0x6a, 0x4a, 0x14, 0xb7, 0x8c, 0xe2, 0x4c, 0x2e, 0xcc, 0xbb,
+	0xc7, 0x95, 0x66, 0xbe, 0x6f, 0xe0, 0x6e, 0xc7, 0xaa, 0x46, 0x79, 0xbf, 0x88, 0x92, 0x3b, 0xb0,
+	0xc2, 0x5c, 0xd7, 0x64, 0x8a, 0xf9, 0xc7, 0xd2, 0x57, 0xd6, 0x3b, 0x61, 0x71, 0xb5, 0xc8, 0xe4,
+	0x6c, 0x67, 0xe5, 0xe0, 0x9c, 0xa1, 0x87, 0x8a, 0xe6, 0x24, 0xc3, 0x2a, 0xe8, 0x8f, 0x5d, 0xc9,
+	0xb4, 0x90, 0x49, 0x48, 0x37, 0x4c, 0x48, 0x79, 0x98, 0xfc, 0x54, 0x80, 0xad, 0xe4, 0x82, 0xeb,
+	0xf6, 0xb8, 0x90, 0xd8, 0xf1, 0x5e, 0xbe, 0x44, 0x89, 0xdc, 0x41, 0x65, 0xdd, 0x34, 0x8d, 0xf5,
+	0xd5, 0xdb, 0x35, 0x16, 0xbd, 0xcc, 0x3c, 0xbd, 0xdc, 0x33, 0x71, 0x61, 0xe3, 0xfc, 0x4c, 0xe7,
+	0x0f, 0x80, 0xb2, 0xb6, 0x6b, 0xc5, 0xcb, 0x1e, 0x8b, 0xad, 0xc9, 0xd9, 0xce, 0xc6, 0xc1, 0x2c,
+	0x2d, 0x3a, 0xdb, 0x58, 0xf5, 0xc7, 0x02, 0x6c, 0xce, 0xbe, 0xf6, 0xc9, 0x2a, 0x14, 0x4f, 0x71,
+	0x1c, 0xbd, 0x77, 0x34, 0x5c, 0x12, 0x17, 0xae, 0x8c, 0x98, 0x3f, 0x44, 0x6b, 0xee, 0xff, 0xb8,
+	0x70, 0xf3, 0x6e, 0x69, 0x64, 0xfc, 0xce, 0xdc, 0x27, 0x85, 0xfa, 0x0b, 0xd8, 0x98, 0xf9, 0x1e,
+	0x90, 0x6d, 0x80, 0xa4, 0x3b, 0xbb, 0x9d, 0x38, 0xb6, 0x0c, 0x12, 0xd6, 0x16, 0xe3, 0x82, 0x8f,
+	0xc3, 0xab, 0xe7, 0x58, 0xa1, 0x54, 0x26, 0xd6, 0x12, 0xcd, 0xa1, 0xf5, 0x0e, 0x5c, 0x4f, 0x9e,
+	0xbd, 0xf8, 0x3a, 0xa3, 0xa8, 0x02, 0xc1, 0x15, 0x66, 0xaf, 0xf0, 0xc2, 0x9b, 0xaf, 0xf0, 0xfa,
+	0xcf, 0x05, 0x98, 0x0f, 0x2f, 0x7f, 0x62, 0xc1, 0xa2, 0xd3, 0x67, 0xa6, 0x7b, 0xa3, 0x98, 0x92,
+	0x6d, 0x78, 0xed, 0x85, 0xcb, 0x67, 0xf8, 0x4a, 0x9b, 0x50, 0xca, 0x34, 0xdd, 0x93, 0xbb, 0x00,
+	0x27, 0x1e, 0x67, 0x72, 0x6c, 0x8a, 0xbb, 0x68, 0x9c, 0xdd, 0xbc, 0xf0, 0xaa, 0xd8, 0xad, 0x94,
+	0x8f, 0xde, 0xe2, 0x8c, 0x42, 0xf5, 0x2e, 0x54, 0x72, 0xf4, 0x8c, 0x6f, 0xb6, 0x9e, 0xfd, 0x66,
+	0xe5, 0x6c, 0x8e, 0x6f, 0xc0, 0x42, 0x74, 0x1e, 0x42, 0x60, 0x9e, 0xb3, 0x01, 0xc6, 0x6a, 0x66,
+	0x5d, 0xff, 0x0c, 0xca, 0xe9, 0xe0, 0x42, 0xf6, 0x00, 0x1c, 0xc1, 0x39, 0x3a, 0x5a, 0xc8, 0x24,
+	0x2b, 0xe7, 0x03, 0x4e, 0x3b, 0xa1, 0x68, 0x46, 0xaa, 0xbe, 0x0f, 0xe5, 0x94, 0x98, 0xe5, 0x21,
+	0xc4, 0xf4, 0x38, 0x48, 0x02, 0x33, 0xeb, 0xfa, 0x2f, 0x45, 0xc8, 0x0c, 0x3b, 0x33, 0xd5, 0x36,
+	0x61, 0xc1, 0x53, 0x6a, 0x88, 0x32, 0x56, 0x8c, 0x77, 0xa4, 0x01, 0x25, 0xc7, 0xf7, 0x90, 0xeb,
+	0x6e, 0xc7, 0xcc, 0x53, 0xe5, 0xd6, 0xd5, 0xc9, 0xd9, 0x4e, 0xa9, 0x1d, 0x63, 0x34, 0x65, 0xc9,
+	0x2e, 0x2c, 0x39, 0xbe, 0x97, 0x10, 0xd1, 0xd8, 0xd4, 0xaa, 0x4c, 0xce, 0x76, 0x96, 0xda, 0x87,
+	0xdd, 0x54, 0x3e, 0x2b, 0x13, 0x3a, 0x55, 0x8e, 0x08, 0xe2, 0xe1, 0xa9, 0x4c, 0xe3, 0x1d, 0x79,
+	0x01, 0xcb, 0x9e, 0xfb, 0x4c, 0x9c, 0x22, 0x6f, 0x9b, 0x41, 0xd2, 0x5a, 0x30, 0xb9, 0xb9, 0x35,
+	0xa3, 0x39, 0xed, 0x6e, 0x56, 0xd0, 0x7c, 0xae, 0xd6, 0xda, 0xe4, 0x6c, 0x67, 0xb9, 0xdb, 0xc9,
+	0xe0, 0xf4, 0xa2, 0x3d, 0x72, 0x07, 0x2c, 0x34, 0x17, 0xd5, 0xd1, 0xc3, 0xf6, 0xbd, 0x83, 0xa1,
+	0xee, 0x23, 0xd7, 0x71, 0x27, 0x99, 0x09, 0xaa, 0x44, 0x2f, 0xe5, 0xab, 0x63, 0x20, 0xd3, 0x3e,
+	0x67, 0x94, 0xc8, 0xa3, 0x8b, 0x6d, 0xfd, 0xf1, 0x1b, 0xdb, 0x3a, 0x9a, 0xa2, 0xed, 0xf4, 0x37,
+	0x20, 0x1c, 0x47, 0x6d, 0x63, 0x3f, 0x53, 0x5b, 0x7b, 0xbf, 0x16, 0xa0, 0x92, 0xf4, 0xd7, 0x53,
+	0x94, 0x23, 0xcf, 0x41, 0xf2, 0x05, 0x14, 0xef, 0xa3, 0x26, 0x9b, 0x53, 0x73, 0xa7, 0x99, 0xb5,
+	0xab, 0x6b, 0x53, 0x78, 0xdd, 0xfa, 0xee, 0x8f, 0xbf, 0x7e, 0x98, 0x23, 0x64, 0xd5, 0xfc, 0x3f,
+	0x8c, 0x76, 0xd3, 0xd9, 0x9d, 0xf4, 0x01, 0xee, 0x63, 0x3a, 0x88, 0x5c, 0x66, 0xb2, 0x36, 0x85,
+	0xe7, 0x7a, 0xbd, 0x5e, 0x33, 0x1e, 0xaa, 0xc4, 0xca, 0x7b, 0x68, 0xc6, 0x2d, 0xde, 0x6a, 0xff,
+	0x36, 0xd9, 0x2e, 0xfc, 0x3e, 0xd9, 0x2e, 0xfc, 0x39, 0xd9, 0x2e, 0x7c, 0xfd, 0xd1, 0x7f, 0xfb,
+	0x63, 0x89, 0x4a, 0x2d, 0x35, 0x76, 0xb2, 0x60, 0xfe, 0x2f, 0xf6, 0xff, 0x19, 0x00, 0x66, 0xe3,
+	0x22, 0xfc, 0x4e, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1027,6 +1048,38 @@ func (m *Settings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.AdditionalOIDCConfigs) > 0 {
+		for iNdEx := len(m.AdditionalOIDCConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalOIDCConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettings(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.ResourceIgnoreDifferences) > 0 {
+		for iNdEx := len(m.ResourceIgnoreDifferences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResourceIgnoreDifferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettings(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
 	if m.HydratorEnabled {
 		i--
 		if m.HydratorEnabled {
@@ -1847,6 +1900,18 @@ func (m *Settings) Size() (n int) {
 	if m.HydratorEnabled {
 		n += 3
 	}
+	if len(m.ResourceIgnoreDifferences) > 0 {
+		for _, e := range m.ResourceIgnoreDifferences {
+			l = e.Size()
+			n += 2 + l + sovSettings(uint64(l))
+		}
+	}
+	if len(m.AdditionalOIDCConfigs) > 0 {
+		for _, e := range m.AdditionalOIDCConfigs {
+			l = e.Size()
+			n += 2 + l + sovSettings(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3041,6 +3106,74 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.HydratorEnabled = bool(v != 0)
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceIgnoreDifferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceIgnoreDifferences = append(m.ResourceIgnoreDifferences, &v1alpha1.ResourceIgnoreDifferences{})
+			if err := m.ResourceIgnoreDifferences[len(m.ResourceIgnoreDifferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalOIDCConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalOIDCConfigs = append(m.AdditionalOIDCConfigs, &OIDCConfig{})
+			if err := m.AdditionalOIDCConfigs[len(m.AdditionalOIDCConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSettings(dAtA[iNdEx:])
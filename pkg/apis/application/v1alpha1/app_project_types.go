@@ -2,6 +2,7 @@ package v1alpha1
 
 import (
 	"fmt"
+	"path"
 	"sort"
 	"strconv"
 	"strings"
@@ -22,6 +23,9 @@ const (
 	// serviceAccountDisallowedCharSet contains the characters that are not allowed to be present
 	// in a DefaultServiceAccount configured for a DestinationServiceAccount
 	serviceAccountDisallowedCharSet = "!*[]{}\\/"
+
+	// maxParentProjectsDepth is the maximum number of ancestors of a project
+	maxParentProjectsDepth = 10
 )
 
 type ErrApplicationNotAllowedToUseProject struct {
@@ -73,6 +77,286 @@ type AppProjectStatus struct {
 	JWTTokensByRole map[string]JWTTokens `json:"jwtTokensByRole,omitempty" protobuf:"bytes,1,opt,name=jwtTokensByRole"`
 }
 
+// GetApplicationResourceQuota returns the resource quota of the applications of the project: the given global quota
+// overridden by the limits set in the project
+func (proj *AppProject) GetApplicationResourceQuota(global *ApplicationResourceQuota) *ApplicationResourceQuota {
+	quota := &ApplicationResourceQuota{}
+	if global != nil {
+		quota = global.DeepCopy()
+	}
+	if projQuota := proj.Spec.ApplicationResourceQuota; projQuota != nil {
+		if projQuota.MaxResources > 0 {
+			quota.MaxResources = projQuota.MaxResources
+		}
+		if projQuota.MaxManifestsSize != nil {
+			quota.MaxManifestsSize = projQuota.MaxManifestsSize
+		}
+	}
+	return quota
+}
+
+// WithParentProjects returns a copy of the project inheriting the destinations, the source repositories, the roles and
+// the allowed resources of its ancestors, retrieved with the given function. The roles of the project override the
+// inherited roles with the same name. An error is returned if an ancestor can't be retrieved or if the parent projects
+// form a cycle.
+func (proj *AppProject) WithParentProjects(getProject func(name string) (*AppProject, error)) (*AppProject, error) {
+	result := proj.DeepCopy()
+	visited := map[string]bool{proj.Name: true}
+	for parentName := proj.Spec.ParentProject; parentName != ""; {
+		if visited[parentName] {
+			return nil, fmt.Errorf("the parent projects of project '%s' form a cycle through project '%s'", proj.Name, parentName)
+		}
+		if len(visited) > maxParentProjectsDepth {
+			return nil, fmt.Errorf("project '%s' has more than %d ancestors", proj.Name, maxParentProjectsDepth)
+		}
+		visited[parentName] = true
+		parent, err := getProject(parentName)
+		if err != nil {
+			return nil, fmt.Errorf("error getting parent project '%s': %w", parentName, err)
+		}
+		result.inheritFrom(parent)
+		parentName = parent.Spec.ParentProject
+	}
+	return result, nil
+}
+
+// inheritFrom adds the destinations, the source repositories, the roles and the allowed resources of an ancestor to
+// the project
+func (proj *AppProject) inheritFrom(ancestor *AppProject) {
+	proj.Spec.Destinations = append(proj.Spec.Destinations, ancestor.Spec.Destinations...)
+	proj.Spec.SourceRepos = append(proj.Spec.SourceRepos, ancestor.Spec.SourceRepos...)
+	proj.Spec.ClusterResourceWhitelist = append(proj.Spec.ClusterResourceWhitelist, ancestor.Spec.ClusterResourceWhitelist...)
+	proj.Spec.NamespaceResourceWhitelist = append(proj.Spec.NamespaceResourceWhitelist, ancestor.Spec.NamespaceResourceWhitelist...)
+	for _, role := range ancestor.Spec.Roles {
+		if _, _, err := proj.GetRoleByName(role.Name); err == nil {
+			continue
+		}
+		proj.Spec.Roles = append(proj.Spec.Roles, proj.inheritRole(ancestor.Name, role))
+	}
+}
+
+// inheritRole returns a copy of a role of an ancestor, without its tokens, whose policies apply to the project instead
+// of the ancestor
+func (proj *AppProject) inheritRole(ancestor string, role ProjectRole) ProjectRole {
+	inherited := ProjectRole{Name: role.Name, Description: role.Description, Groups: append([]string(nil), role.Groups...)}
+	for _, policy := range role.Policies {
+		components := strings.Split(policy, ",")
+		for i := range components {
+			components[i] = strings.TrimSpace(components[i])
+		}
+		if len(components) == 6 {
+			components[1] = fmt.Sprintf("proj:%s:%s", proj.Name, role.Name)
+			if components[4] == ancestor {
+				components[4] = proj.Name
+			} else if rest, ok := strings.CutPrefix(components[4], ancestor+"/"); ok {
+				components[4] = proj.Name + "/" + rest
+			}
+		}
+		inherited.Policies = append(inherited.Policies, strings.Join(components, ", "))
+	}
+	return inherited
+}
+
+// GetQuotaUsage returns the usage of the quota of the project by its applications among the given applications. The
+// clusters are identified by the server URL of the destinations, or by their name if the server is not set.
+func (proj *AppProject) GetQuotaUsage(apps []*Application) *ProjectQuotaUsage {
+	usage := &ProjectQuotaUsage{}
+	for _, app := range apps {
+		if app.Spec.GetProject() != proj.Name {
+			continue
+		}
+		usage.Applications++
+		usage.Resources += int64(len(app.Status.Resources))
+	}
+	usage.Clusters = int64(len(proj.getQuotaClusters(apps)))
+	return usage
+}
+
+// CheckQuota returns an error if adding the given application to the project, or updating it, exceeds the quota of the
+// project. The given applications may include the current state of the application, which is then not counted twice.
+func (proj *AppProject) CheckQuota(apps []*Application, app *Application) error {
+	quota := proj.Spec.Quota
+	if quota == nil {
+		return nil
+	}
+	var others []*Application
+	exists := false
+	for _, a := range apps {
+		if a.Name == app.Name && a.Namespace == app.Namespace {
+			exists = a.Spec.GetProject() == proj.Name
+			continue
+		}
+		others = append(others, a)
+	}
+	usage := proj.GetQuotaUsage(others)
+	if !exists {
+		if quota.MaxApplications > 0 && usage.Applications >= quota.MaxApplications {
+			return fmt.Errorf("project '%s' has reached its quota of %d applications", proj.Name, quota.MaxApplications)
+		}
+		if quota.MaxResources > 0 && usage.Resources >= quota.MaxResources {
+			return fmt.Errorf("project '%s' has reached its quota of %d resources", proj.Name, quota.MaxResources)
+		}
+	}
+	if quota.MaxClusters > 0 && usage.Clusters >= quota.MaxClusters && !proj.getQuotaClusters(others)[quotaClusterKey(app.Spec.Destination)] {
+		return fmt.Errorf("project '%s' has reached its quota of %d clusters", proj.Name, quota.MaxClusters)
+	}
+	return nil
+}
+
+// getQuotaClusters returns the clusters the applications of the project among the given applications are deployed to
+func (proj *AppProject) getQuotaClusters(apps []*Application) map[string]bool {
+	clusters := make(map[string]bool)
+	for _, app := range apps {
+		if app.Spec.GetProject() == proj.Name {
+			clusters[quotaClusterKey(app.Spec.Destination)] = true
+		}
+	}
+	return clusters
+}
+
+func quotaClusterKey(dest ApplicationDestination) string {
+	if dest.Server != "" {
+		return dest.Server
+	}
+	return "name:" + dest.Name
+}
+
+// GetManifestGenerationWebhooks returns the webhooks called to mutate or reject the manifests rendered by the
+// applications of the project
+func (proj *AppProject) GetManifestGenerationWebhooks() []*ManifestGenerationWebhook {
+	var webhooks []*ManifestGenerationWebhook
+	for i := range proj.Spec.ManifestGenerationWebhooks {
+		webhooks = append(webhooks, &proj.Spec.ManifestGenerationWebhooks[i])
+	}
+	return webhooks
+}
+
+// GetSyncOptions returns the sync options of a sync of an application of the project: the default sync options of the
+// project overridden by the given options of the application or of the operation, without the options denied by the
+// project
+func (proj *AppProject) GetSyncOptions(options SyncOptions) SyncOptions {
+	policy := proj.Spec.SyncPolicy
+	if policy == nil {
+		return options
+	}
+	var result SyncOptions
+	for _, option := range policy.SyncOptions {
+		if !hasSyncOptionWithName(options, syncOptionName(option)) && !policy.IsSyncOptionDenied(option) {
+			result = result.AddOption(option)
+		}
+	}
+	for _, option := range options {
+		if !policy.IsSyncOptionDenied(option) {
+			result = result.AddOption(option)
+		}
+	}
+	return result
+}
+
+// GetRetryStrategy returns the given retry strategy of an application of the project, or the default retry strategy
+// of the project if the application has none
+func (proj *AppProject) GetRetryStrategy(retry *RetryStrategy) *RetryStrategy {
+	if retry == nil && proj.Spec.SyncPolicy != nil {
+		return proj.Spec.SyncPolicy.Retry
+	}
+	return retry
+}
+
+// GetEffectiveSyncPolicy returns the given sync policy of an application of the project with the defaults of the
+// project applied and the automated prune and self-heal turned off if the project disables them
+func (proj *AppProject) GetEffectiveSyncPolicy(syncPolicy *SyncPolicy) *SyncPolicy {
+	if syncPolicy == nil || proj.Spec.SyncPolicy == nil {
+		return syncPolicy
+	}
+	effective := syncPolicy.DeepCopy()
+	effective.SyncOptions = proj.GetSyncOptions(syncPolicy.SyncOptions)
+	effective.Retry = proj.GetRetryStrategy(syncPolicy.Retry)
+	if effective.Automated != nil {
+		if proj.Spec.SyncPolicy.DisableAutomatedPrune {
+			effective.Automated.Prune = false
+		}
+		if proj.Spec.SyncPolicy.DisableSelfHeal {
+			effective.Automated.SelfHeal = false
+		}
+	}
+	return effective
+}
+
+// ValidateSyncPolicy returns an error if the given sync policy of an application uses a sync option denied by the
+// project, or enables the automated prune or the self-heal while the project disables them
+func (proj *AppProject) ValidateSyncPolicy(syncPolicy *SyncPolicy) error {
+	policy := proj.Spec.SyncPolicy
+	if syncPolicy == nil || policy == nil {
+		return nil
+	}
+	for _, option := range syncPolicy.SyncOptions {
+		if policy.IsSyncOptionDenied(option) {
+			return fmt.Errorf("sync option %s is denied in project '%s'", option, proj.Name)
+		}
+	}
+	if syncPolicy.Automated != nil {
+		if syncPolicy.Automated.Prune && policy.DisableAutomatedPrune {
+			return fmt.Errorf("automated prune is disabled in project '%s'", proj.Name)
+		}
+		if syncPolicy.Automated.SelfHeal && policy.DisableSelfHeal {
+			return fmt.Errorf("self-heal is disabled in project '%s'", proj.Name)
+		}
+	}
+	return nil
+}
+
+// IsSyncOptionDenied returns whether the applications of the project can't use the given sync option
+func (p *ProjectSyncPolicy) IsSyncOptionDenied(option string) bool {
+	for _, denied := range p.DeniedSyncOptions {
+		if strings.EqualFold(strings.TrimSpace(denied), strings.TrimSpace(option)) {
+			return true
+		}
+	}
+	return false
+}
+
+// syncOptionName returns the name of a sync option, e.g. ServerSideApply for ServerSideApply=true
+func syncOptionName(option string) string {
+	name, _, _ := strings.Cut(option, "=")
+	return strings.TrimSpace(name)
+}
+
+func hasSyncOptionWithName(options SyncOptions, name string) bool {
+	for _, option := range options {
+		if syncOptionName(option) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSignatureKeys returns the keys the commits must be signed with for the application sources of the given path
+func (proj *AppProject) GetSignatureKeys(sourcePath string) []SignatureKey {
+	sourcePath = strings.TrimPrefix(path.Clean(sourcePath), "/")
+	if sourcePath == "." {
+		sourcePath = ""
+	}
+	var keys []SignatureKey
+	for _, key := range proj.Spec.SignatureKeys {
+		if len(key.Paths) == 0 {
+			keys = append(keys, key)
+			continue
+		}
+		for _, pattern := range key.Paths {
+			if glob.Match(strings.TrimPrefix(pattern, "/"), sourcePath, '/') {
+				keys = append(keys, key)
+				break
+			}
+		}
+	}
+	return keys
+}
+
+// IsSignatureRequired returns whether the commits must be signed for the application sources of the given path
+func (proj *AppProject) IsSignatureRequired(sourcePath string) bool {
+	return len(proj.GetSignatureKeys(sourcePath)) > 0
+}
+
 // GetRoleByName returns the role in a project by the name with its index
 func (proj *AppProject) GetRoleByName(name string) (*ProjectRole, int, error) {
 	for i, role := range proj.Spec.Roles {
@@ -303,6 +587,52 @@ func (proj *AppProject) ValidateProject() error {
 		destServiceAccts[key] = true
 	}
 
+	for _, key := range proj.Spec.SignatureKeys {
+		for _, pattern := range key.Paths {
+			if _, err := globutil.Compile(pattern, '/'); err != nil {
+				return status.Errorf(codes.InvalidArgument, "signature key '%s' has an invalid path pattern '%s'", key.KeyID, pattern)
+			}
+		}
+	}
+
+	webhookNames := make(map[string]bool)
+	for _, webhook := range proj.Spec.ManifestGenerationWebhooks {
+		if err := webhook.Validate(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid manifest generation webhook '%s': %v", webhook.Name, err)
+		}
+		if webhookNames[webhook.Name] {
+			return status.Errorf(codes.InvalidArgument, "manifest generation webhook '%s' already added", webhook.Name)
+		}
+		webhookNames[webhook.Name] = true
+	}
+
+	for _, restriction := range proj.Spec.SourceRestrictions {
+		for _, pattern := range append(append([]string{restriction.RepoURL}, restriction.Paths...), restriction.TargetRevisions...) {
+			if _, err := globutil.Compile(strings.TrimPrefix(pattern, "!"), '/'); err != nil {
+				return status.Errorf(codes.InvalidArgument, "source restriction has an invalid pattern '%s'", pattern)
+			}
+		}
+	}
+
+	if policy := proj.Spec.SyncPolicy; policy != nil {
+		for _, option := range policy.SyncOptions {
+			if !strings.Contains(option, "=") {
+				return status.Errorf(codes.InvalidArgument, "sync option '%s' has an invalid format, expected <name>=<value>", option)
+			}
+			if policy.IsSyncOptionDenied(option) {
+				return status.Errorf(codes.InvalidArgument, "default sync option '%s' is also denied", option)
+			}
+		}
+	}
+
+	if proj.Spec.ParentProject != "" && proj.Spec.ParentProject == proj.Name {
+		return status.Errorf(codes.InvalidArgument, "project can't be its own parent project")
+	}
+
+	if quota := proj.Spec.Quota; quota != nil && (quota.MaxApplications < 0 || quota.MaxResources < 0 || quota.MaxClusters < 0) {
+		return status.Errorf(codes.InvalidArgument, "quota limits can't be negative")
+	}
+
 	return nil
 }
 
@@ -459,6 +789,67 @@ func (proj AppProject) IsSourcePermitted(src ApplicationSource) bool {
 	return anySourceMatched
 }
 
+// CheckSourceRestrictions returns an error if the path of the given application source, or the given revision, are not
+// permitted by the source restrictions of the project matching the repository of the source. The target revision of
+// the source is checked if the revision is empty.
+func (proj AppProject) CheckSourceRestrictions(src ApplicationSource, revision string) error {
+	if err := proj.CheckSourcePathRestrictions(src); err != nil {
+		return err
+	}
+	if revision == "" {
+		revision = src.TargetRevision
+	}
+	if revision == "" {
+		revision = "HEAD"
+	}
+	srcNormalized := git.NormalizeGitURL(src.RepoURL)
+	for _, restriction := range proj.Spec.SourceRestrictions {
+		if restriction.RepoURL != "" && !globMatch(git.NormalizeGitURL(restriction.RepoURL), srcNormalized, false, '/') {
+			continue
+		}
+		if len(restriction.TargetRevisions) > 0 && !isPermittedByPatterns(restriction.TargetRevisions, revision) {
+			return fmt.Errorf("target revision %s of repo %s is not permitted in project '%s'", revision, src.RepoURL, proj.Name)
+		}
+	}
+	return nil
+}
+
+// CheckSourcePathRestrictions returns an error if the path of the given application source is not permitted by the
+// source restrictions of the project matching the repository of the source
+func (proj AppProject) CheckSourcePathRestrictions(src ApplicationSource) error {
+	srcPath := strings.TrimPrefix(path.Clean(src.Path), "/")
+	srcNormalized := git.NormalizeGitURL(src.RepoURL)
+	for _, restriction := range proj.Spec.SourceRestrictions {
+		if restriction.RepoURL != "" && !globMatch(git.NormalizeGitURL(restriction.RepoURL), srcNormalized, false, '/') {
+			continue
+		}
+		// the sources of Helm charts and the sources referencing value files have no path
+		if src.Path != "" && len(restriction.Paths) > 0 && !isPermittedByPatterns(restriction.Paths, srcPath) {
+			return fmt.Errorf("path %s of repo %s is not permitted in project '%s'", src.Path, src.RepoURL, proj.Name)
+		}
+	}
+	return nil
+}
+
+// isPermittedByPatterns returns whether the value matches none of the deny patterns prefixed with !, and any of the
+// other patterns if there are some
+func isPermittedByPatterns(patterns []string, val string) bool {
+	hasAllowPatterns := false
+	allowed := false
+	for _, pattern := range patterns {
+		// the patterns of the paths may start with a slash, the paths are relative to the root of the repository
+		if isDenyPattern(pattern) {
+			if glob.Match(strings.TrimPrefix(pattern[1:], "/"), val, '/') {
+				return false
+			}
+			continue
+		}
+		hasAllowPatterns = true
+		allowed = allowed || globMatch(strings.TrimPrefix(pattern, "/"), val, false, '/')
+	}
+	return allowed || !hasAllowPatterns
+}
+
 // IsDestinationPermitted validates if the provided application's destination is one of the allowed destinations for the project
 func (proj AppProject) IsDestinationPermitted(destCluster *Cluster, destNamespace string, projectClusters func(project string) ([]*Cluster, error)) (bool, error) {
 	if destCluster == nil {
@@ -4,8 +4,21 @@ const (
 	// AnnotationKeyRefresh is the annotation key which indicates that app needs to be refreshed. Removed by application controller after app is refreshed.
 	// Might take values 'normal'/'hard'. Value 'hard' means manifest cache and target cluster state cache should be invalidated before refresh.
 	AnnotationKeyRefresh string = "argocd.argoproj.io/refresh"
+	// AnnotationKeyRefreshResources is the annotation key which scopes a requested refresh to a comma-separated list of
+	// resources in the GROUP:KIND:NAME or GROUP:KIND:NAMESPACE/NAME format. Only these resources are re-compared, while a
+	// hard refresh still invalidates the manifest cache and re-compares the resources whose manifests changed. Removed by
+	// application controller together with the refresh annotation.
+	AnnotationKeyRefreshResources string = "argocd.argoproj.io/refresh-resources"
 	// AnnotationKeyHydrate is the annotation key which indicates that app needs to be hydrated. Removed by application controller after app is hydrated.
 	AnnotationKeyHydrate string = "argocd.argoproj.io/hydrate"
+	// AnnotationKeyIgnoreManagedFieldsManagers is the annotation key which contains a comma-separated list of field
+	// managers, e.g. kube-controller-manager. The fields of all the resources of the app owned by these managers are
+	// ignored during the diff, as with the managedFieldsManagers of spec.ignoreDifferences.
+	AnnotationKeyIgnoreManagedFieldsManagers string = "argocd.argoproj.io/ignore-managed-fields-managers"
+	// AnnotationKeySyncApprovedRevision is the annotation key which contains the revision, or the comma-separated
+	// revisions of a multi-source app, approved for the automated sync of an app with the RequireDiffApproval=true sync
+	// option. Set by the approve API.
+	AnnotationKeySyncApprovedRevision string = "argocd.argoproj.io/sync-approved-revision"
 
 	// AnnotationKeyManifestGeneratePaths is an annotation that contains a list of semicolon-separated paths in the
 	// manifests repository that affects the manifest generation. Paths might be either relative or absolute. The
@@ -85,8 +85,24 @@ type ApplicationPreservedFields struct {
 type ApplicationSetStrategy struct {
 	Type        string                         `json:"type,omitempty" protobuf:"bytes,1,opt,name=type"`
 	RollingSync *ApplicationSetRolloutStrategy `json:"rollingSync,omitempty" protobuf:"bytes,2,opt,name=rollingSync"`
-	// RollingUpdate *ApplicationSetRolloutStrategy `json:"rollingUpdate,omitempty" protobuf:"bytes,3,opt,name=rollingUpdate"`
+	// RollingUpdate rolls out the changes of the generated Applications in stages, with the RollingUpdate type
+	RollingUpdate *ApplicationSetRollingUpdateStrategy `json:"rollingUpdate,omitempty" protobuf:"bytes,3,opt,name=rollingUpdate"`
 }
+
+// ApplicationSetRollingUpdateStrategy updates the generated Applications in stages instead of all at once. The
+// Applications of a stage are updated only once the Applications of the previous stages are Healthy.
+type ApplicationSetRollingUpdateStrategy struct {
+	// Steps select the Applications of each stage by their labels. The Applications matching no step are updated last.
+	// MaxUpdate limits the number of Applications of the step updated at the same time, instead of MaxConcurrent.
+	Steps []ApplicationSetRolloutStep `json:"steps,omitempty" protobuf:"bytes,1,opt,name=steps"`
+	// MaxConcurrent is the number, or the percentage of the generated Applications, of Applications being updated at
+	// the same time, the updated Applications which are not Healthy yet. Defaults to 1.
+	MaxConcurrent *intstr.IntOrString `json:"maxConcurrent,omitempty" protobuf:"bytes,2,opt,name=maxConcurrent"`
+	// MaxUnhealthy is the number, or the percentage of the generated Applications, of Degraded Applications above
+	// which the rollout is paused. Defaults to 0.
+	MaxUnhealthy *intstr.IntOrString `json:"maxUnhealthy,omitempty" protobuf:"bytes,3,opt,name=maxUnhealthy"`
+}
+
 type ApplicationSetRolloutStrategy struct {
 	Steps []ApplicationSetRolloutStep `json:"steps,omitempty" protobuf:"bytes,1,opt,name=steps"`
 }
@@ -135,8 +151,24 @@ type ApplicationSetSyncPolicy struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Enum=create-only;create-update;create-delete;sync
 	ApplicationsSync *ApplicationsSyncPolicy `json:"applicationsSync,omitempty" protobuf:"bytes,2,opt,name=applicationsSync,casttype=ApplicationsSyncPolicy"`
+	// OrphanPolicy defines what happens to the Applications which are no longer generated. Possible values are delete, the default, and orphan.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=delete;orphan
+	OrphanPolicy ApplicationSetOrphanPolicy `json:"orphanPolicy,omitempty" protobuf:"bytes,3,opt,name=orphanPolicy,casttype=ApplicationSetOrphanPolicy"`
 }
 
+// ApplicationSetOrphanPolicy defines what happens to the Applications which are no longer generated by their
+// ApplicationSet.
+type ApplicationSetOrphanPolicy string
+
+const (
+	// ApplicationSetOrphanPolicyDelete deletes the Applications which are no longer generated
+	ApplicationSetOrphanPolicyDelete ApplicationSetOrphanPolicy = "delete"
+	// ApplicationSetOrphanPolicyOrphan removes the ownership of the ApplicationSet on the Applications which are no
+	// longer generated, instead of deleting them
+	ApplicationSetOrphanPolicyOrphan ApplicationSetOrphanPolicy = "orphan"
+)
+
 // ApplicationSetIgnoreDifferences configures how the ApplicationSet controller will ignore differences in live
 // applications when applying changes from generated applications.
 type ApplicationSetIgnoreDifferences []ApplicationSetResourceIgnoreDifferences
@@ -201,6 +233,10 @@ type ApplicationSetGenerator struct {
 	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,9,name=selector"`
 
 	Plugin *PluginGenerator `json:"plugin,omitempty" protobuf:"bytes,10,name=plugin"`
+
+	AWSOrganization *AWSOrganizationGenerator `json:"awsOrganization,omitempty" protobuf:"bytes,11,name=awsOrganization"`
+
+	HTTP *HTTPGenerator `json:"http,omitempty" protobuf:"bytes,12,name=http"`
 }
 
 // ApplicationSetNestedGenerator represents a generator nested within a combination-type generator (MatrixGenerator or
@@ -223,6 +259,10 @@ type ApplicationSetNestedGenerator struct {
 	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,9,name=selector"`
 
 	Plugin *PluginGenerator `json:"plugin,omitempty" protobuf:"bytes,10,name=plugin"`
+
+	AWSOrganization *AWSOrganizationGenerator `json:"awsOrganization,omitempty" protobuf:"bytes,11,name=awsOrganization"`
+
+	HTTP *HTTPGenerator `json:"http,omitempty" protobuf:"bytes,12,name=http"`
 }
 
 type ApplicationSetNestedGenerators []ApplicationSetNestedGenerator
@@ -242,6 +282,10 @@ type ApplicationSetTerminalGenerator struct {
 
 	// Selector allows to post-filter all generator.
 	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,8,name=selector"`
+
+	AWSOrganization *AWSOrganizationGenerator `json:"awsOrganization,omitempty" protobuf:"bytes,9,name=awsOrganization"`
+
+	HTTP *HTTPGenerator `json:"http,omitempty" protobuf:"bytes,10,name=http"`
 }
 
 type ApplicationSetTerminalGenerators []ApplicationSetTerminalGenerator
@@ -261,6 +305,8 @@ func (g ApplicationSetTerminalGenerators) toApplicationSetNestedGenerators() []A
 			PullRequest:             terminalGenerator.PullRequest,
 			Plugin:                  terminalGenerator.Plugin,
 			Selector:                terminalGenerator.Selector,
+			AWSOrganization:         terminalGenerator.AWSOrganization,
+			HTTP:                    terminalGenerator.HTTP,
 		}
 	}
 	return nestedGenerators
@@ -413,6 +459,11 @@ type GitGenerator struct {
 
 	// Values contains key/value pairs which are passed directly as parameters to the template
 	Values map[string]string `json:"values,omitempty" protobuf:"bytes,8,name=values"`
+
+	// TemplatePatchFile is the name of a file, e.g. .argocd-appset-patch.yaml, in the directory of each generated
+	// Application, whose content is applied to the Application as a strategic merge patch, after the templatePatch of
+	// the ApplicationSet. The project of the Application can't be changed by the patch.
+	TemplatePatchFile string `json:"templatePatchFile,omitempty" protobuf:"bytes,9,name=templatePatchFile"`
 }
 
 type GitDirectoryGeneratorItem struct {
@@ -422,6 +473,9 @@ type GitDirectoryGeneratorItem struct {
 
 type GitFileGeneratorItem struct {
 	Path string `json:"path" protobuf:"bytes,1,name=path"`
+	// Exclude excludes the files matching the path from the files matched by the other items. The path supports the
+	// '**' glob, matching any number of directories.
+	Exclude bool `json:"exclude,omitempty" protobuf:"bytes,2,name=exclude"`
 }
 
 // SCMProviderGenerator defines a generator that scrapes a SCMaaS API to find candidate repos.
@@ -476,6 +530,8 @@ type SCMProviderGeneratorGitea struct {
 	AllBranches bool `json:"allBranches,omitempty" protobuf:"varint,4,opt,name=allBranches"`
 	// Allow self-signed TLS / Certificates; default: false
 	Insecure bool `json:"insecure,omitempty" protobuf:"varint,5,opt,name=insecure"`
+	// ConfigMap key holding the trusted certificates
+	CARef *ConfigMapKeyRef `json:"caRef,omitempty" protobuf:"bytes,6,opt,name=caRef"`
 }
 
 // SCMProviderGeneratorGithub defines connection info specific to GitHub.
@@ -560,6 +616,10 @@ type SCMProviderGeneratorAzureDevOps struct {
 	AccessTokenRef *SecretRef `json:"accessTokenRef" protobuf:"bytes,8,opt,name=accessTokenRef"`
 	// Scan all branches instead of just the default branch.
 	AllBranches bool `json:"allBranches,omitempty" protobuf:"varint,9,opt,name=allBranches"`
+	// Allow self-signed TLS / Certificates; default: false
+	Insecure bool `json:"insecure,omitempty" protobuf:"varint,10,opt,name=insecure"`
+	// ConfigMap key holding the trusted certificates
+	CARef *ConfigMapKeyRef `json:"caRef,omitempty" protobuf:"bytes,11,opt,name=caRef"`
 }
 
 type TagFilter struct {
@@ -567,6 +627,64 @@ type TagFilter struct {
 	Value string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
 }
 
+// AWSOrganizationGenerator generates parameters for the active accounts of an AWS Organization, or for the EKS clusters
+// of these accounts.
+type AWSOrganizationGenerator struct {
+	// Role is the ARN of the role assumed to list the accounts of the Organization. The credentials of the
+	// ApplicationSet controller are used if empty.
+	Role string `json:"role,omitempty" protobuf:"bytes,1,opt,name=role"`
+	// OrganizationalUnits restricts the accounts to the accounts directly under the given organizational units or roots,
+	// e.g. ou-ab12-cd34ef56. All the accounts of the Organization are listed if empty.
+	OrganizationalUnits []string `json:"organizationalUnits,omitempty" protobuf:"bytes,2,rep,name=organizationalUnits"`
+	// TagFilters restricts the accounts to the accounts having all the given tags. An empty value matches any value.
+	TagFilters []*TagFilter `json:"tagFilters,omitempty" protobuf:"bytes,3,rep,name=tagFilters"`
+	// EKSClusters generates parameters for each EKS cluster of the accounts instead of for each account.
+	EKSClusters *AWSOrganizationEKSClusters `json:"eksClusters,omitempty" protobuf:"bytes,4,opt,name=eksClusters"`
+	// Standard parameters.
+	RequeueAfterSeconds *int64                 `json:"requeueAfterSeconds,omitempty" protobuf:"varint,5,opt,name=requeueAfterSeconds"`
+	Template            ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,6,opt,name=template"`
+	// Values contains key/value pairs which are passed directly as parameters to the template
+	Values map[string]string `json:"values,omitempty" protobuf:"bytes,7,name=values"`
+}
+
+// AWSOrganizationEKSClusters defines how the EKS clusters of the accounts of an AWS Organization are listed.
+type AWSOrganizationEKSClusters struct {
+	// RoleName is the name of the role assumed in each account to list its EKS clusters, e.g. argocd-discovery.
+	RoleName string `json:"roleName" protobuf:"bytes,1,opt,name=roleName"`
+	// Regions are the regions in which the EKS clusters are listed.
+	Regions []string `json:"regions" protobuf:"bytes,2,rep,name=regions"`
+}
+
+// HTTPGenerator generates parameters from the JSON array returned by an HTTP endpoint.
+type HTTPGenerator struct {
+	// URL is the URL of the endpoint, fetched with a GET request.
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// TokenRef is a reference to the Secret key holding the bearer token sent to the endpoint, in the namespace of the
+	// ApplicationSet.
+	TokenRef *SecretRef `json:"tokenRef,omitempty" protobuf:"bytes,2,opt,name=tokenRef"`
+	// Headers are additional headers sent to the endpoint.
+	Headers map[string]string `json:"headers,omitempty" protobuf:"bytes,3,rep,name=headers"`
+	// JSONPath extracts the array of parameters from the response, e.g. '{.items}'. The whole response is used if
+	// empty.
+	JSONPath string `json:"jsonPath,omitempty" protobuf:"bytes,4,opt,name=jsonPath"`
+	// Insecure skips the verification of the TLS certificate of the endpoint.
+	Insecure bool `json:"insecure,omitempty" protobuf:"varint,5,opt,name=insecure"`
+	// Standard parameters.
+	RequeueAfterSeconds *int64                 `json:"requeueAfterSeconds,omitempty" protobuf:"varint,6,opt,name=requeueAfterSeconds"`
+	Template            ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,7,opt,name=template"`
+	// Values contains key/value pairs which are passed directly as parameters to the template
+	Values map[string]string `json:"values,omitempty" protobuf:"bytes,8,name=values"`
+	// CEL is a CEL expression extracting the array of parameters from the response, available as the response
+	// variable, e.g. 'response.items.filter(i, i.enabled)'. It can't be set with JSONPath.
+	CEL string `json:"cel,omitempty" protobuf:"bytes,9,opt,name=cel"`
+}
+
+// CustomApiUrl returns the URL of the endpoint, which is restricted by the allowed SCM providers like the custom API URLs
+// of the SCM provider and pull request generators
+func (g *HTTPGenerator) CustomApiUrl() string { //nolint:revive //FIXME(var-naming)
+	return g.URL
+}
+
 // SCMProviderGeneratorAWSCodeCommit defines connection info specific to AWS CodeCommit.
 type SCMProviderGeneratorAWSCodeCommit struct {
 	// TagFilters provides the tag filter(s) for repo discovery
@@ -614,9 +732,25 @@ type PullRequestGenerator struct {
 	AzureDevOps *PullRequestGeneratorAzureDevOps `json:"azuredevops,omitempty" protobuf:"bytes,9,opt,name=azuredevops"`
 	// Values contains key/value pairs which are passed directly as parameters to the template
 	Values map[string]string `json:"values,omitempty" protobuf:"bytes,10,name=values"`
+	// ParameterOverrides allows setting additional parameters with the labels and the comments of the pull requests.
+	ParameterOverrides *PullRequestGeneratorParameterOverrides `json:"parameterOverrides,omitempty" protobuf:"bytes,11,opt,name=parameterOverrides"`
 	// If you add a new SCM provider, update CustomApiUrl below.
 }
 
+// PullRequestGeneratorParameterOverrides defines how the labels and the comments of the pull requests set the
+// overrides parameters of the Pull Request generator.
+type PullRequestGeneratorParameterOverrides struct {
+	// Keys is the list of the parameters which can be set, the other parameters are ignored.
+	Keys []string `json:"keys" protobuf:"bytes,1,rep,name=keys"`
+	// LabelPrefix enables setting the parameters with labels made of the prefix followed by key=value, e.g.
+	// preview/image=foo:bar with the preview/ prefix.
+	LabelPrefix string `json:"labelPrefix,omitempty" protobuf:"bytes,2,opt,name=labelPrefix"`
+	// CommentCommand enables setting the parameters with comment lines made of the command followed by key=value
+	// pairs, e.g. /argocd set image=foo:bar with the /argocd set command. Only the GitHub, GitLab and Gitea
+	// providers support it.
+	CommentCommand string `json:"commentCommand,omitempty" protobuf:"bytes,3,opt,name=commentCommand"`
+}
+
 func (p *PullRequestGenerator) CustomApiUrl() string { //nolint:revive //FIXME(var-naming)
 	if p.Github != nil {
 		return p.Github.API
@@ -651,6 +785,8 @@ type PullRequestGeneratorGitea struct {
 	TokenRef *SecretRef `json:"tokenRef,omitempty" protobuf:"bytes,4,opt,name=tokenRef"`
 	// Allow insecure tls, for self-signed certificates; default: false.
 	Insecure bool `json:"insecure,omitempty" protobuf:"varint,5,opt,name=insecure"`
+	// ConfigMap key holding the trusted certificates
+	CARef *ConfigMapKeyRef `json:"caRef,omitempty" protobuf:"bytes,6,opt,name=caRef"`
 }
 
 // PullRequestGeneratorAzureDevOps defines connection info specific to AzureDevOps.
@@ -667,6 +803,10 @@ type PullRequestGeneratorAzureDevOps struct {
 	TokenRef *SecretRef `json:"tokenRef,omitempty" protobuf:"bytes,5,opt,name=tokenRef"`
 	// Labels is used to filter the PRs that you want to target
 	Labels []string `json:"labels,omitempty" protobuf:"bytes,6,rep,name=labels"`
+	// Allow self-signed TLS / Certificates; default: false
+	Insecure bool `json:"insecure,omitempty" protobuf:"varint,7,opt,name=insecure"`
+	// ConfigMap key holding the trusted certificates
+	CARef *ConfigMapKeyRef `json:"caRef,omitempty" protobuf:"bytes,8,opt,name=caRef"`
 }
 
 // PullRequestGenerator defines connection info specific to GitHub.
@@ -797,6 +937,20 @@ type ApplicationSetStatus struct {
 	ApplicationStatus []ApplicationSetApplicationStatus `json:"applicationStatus,omitempty" protobuf:"bytes,2,name=applicationStatus"`
 	// Resources is a list of Applications resources managed by this application set.
 	Resources []ResourceStatus `json:"resources,omitempty" protobuf:"bytes,3,opt,name=resources"`
+	// ApplicationsSummary is a rollup of the health and sync statuses of the Applications managed by this application set.
+	ApplicationsSummary *ApplicationSetApplicationsSummary `json:"applicationsSummary,omitempty" protobuf:"bytes,4,opt,name=applicationsSummary"`
+}
+
+// ApplicationSetApplicationsSummary counts the Applications managed by an ApplicationSet per health and sync status
+type ApplicationSetApplicationsSummary struct {
+	// Total is the number of Applications managed by the ApplicationSet
+	Total int64 `json:"total" protobuf:"varint,1,opt,name=total"`
+	// Health is the number of Applications per health status, e.g. Healthy or Degraded
+	Health map[string]int64 `json:"health,omitempty" protobuf:"bytes,2,rep,name=health"`
+	// Sync is the number of Applications per sync status, e.g. Synced or OutOfSync
+	Sync map[string]int64 `json:"sync,omitempty" protobuf:"bytes,3,rep,name=sync"`
+	// Degraded is the sorted list of the names of the Degraded Applications
+	Degraded []string `json:"degraded,omitempty" protobuf:"bytes,4,rep,name=degraded"`
 }
 
 // ApplicationSetCondition contains details about an applicationset condition, which is usually an error or warning
@@ -856,6 +1010,7 @@ const (
 	ApplicationSetReasonApplicationValidationError       = "ApplicationValidationError"
 	ApplicationSetReasonApplicationSetModified           = "ApplicationSetModified"
 	ApplicationSetReasonApplicationSetRolloutComplete    = "ApplicationSetRolloutComplete"
+	ApplicationSetReasonApplicationSetRolloutPaused      = "ApplicationSetRolloutPaused"
 	ApplicationSetReasonSyncApplicationError             = "SyncApplicationError"
 )
 
@@ -16,6 +16,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 	"unicode"
 
@@ -27,8 +28,10 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -45,6 +48,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/env"
 	"github.com/argoproj/argo-cd/v3/util/helm"
 	utilhttp "github.com/argoproj/argo-cd/v3/util/http"
+	kubeutil "github.com/argoproj/argo-cd/v3/util/kube"
 	"github.com/argoproj/argo-cd/v3/util/security"
 )
 
@@ -92,6 +96,15 @@ type ApplicationSpec struct {
 
 	// SourceHydrator provides a way to push hydrated manifests back to git before syncing them to the cluster.
 	SourceHydrator *SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,9,opt,name=sourceHydrator"`
+
+	// DependsOn is a list of names of the Applications, in the namespace of this Application, this Application depends
+	// on. The Application is not automatically synced until all its dependencies are Synced and Healthy.
+	DependsOn []string `json:"dependsOn,omitempty" protobuf:"bytes,10,opt,name=dependsOn"`
+
+	// DestinationNamespaces maps the namespace-scoped resources, which have not set a value for .metadata.namespace,
+	// to destination namespaces according to their labels. The first matching mapping is used. Resources matching no
+	// mapping are deployed to the namespace of the destination.
+	DestinationNamespaces []DestinationNamespaceMapping `json:"destinationNamespaces,omitempty" protobuf:"bytes,11,rep,name=destinationNamespaces"`
 }
 
 type IgnoreDifferences []ResourceIgnoreDifferences
@@ -198,6 +211,8 @@ type ApplicationSource struct {
 	Ref string `json:"ref,omitempty" protobuf:"bytes,13,opt,name=ref"`
 	// Name is used to refer to a source and is displayed in the UI. It is used in multi-source Applications.
 	Name string `json:"name,omitempty" protobuf:"bytes,14,opt,name=name"`
+	// CUE holds CUE specific options
+	CUE *ApplicationSourceCUE `json:"cue,omitempty" protobuf:"bytes,15,opt,name=cue"`
 }
 
 // ApplicationSources contains list of required information about the sources of an application
@@ -306,6 +321,12 @@ func (source *ApplicationSource) IsHelm() bool {
 	return source.Chart != ""
 }
 
+// IsHelmLookupsEnabled returns true when the Helm templates of the application source can look up the resources of
+// the destination cluster
+func (source *ApplicationSource) IsHelmLookupsEnabled() bool {
+	return source.Helm != nil && source.Helm.EnableLookups
+}
+
 // IsHelmOci returns true when the application source is of type Helm OCI
 func (source *ApplicationSource) IsHelmOci() bool {
 	if source.Chart == "" {
@@ -314,6 +335,12 @@ func (source *ApplicationSource) IsHelmOci() bool {
 	return helm.IsHelmOciRepo(source.RepoURL)
 }
 
+// IsOCI returns true when the application source is an OCI artifact holding manifests or a Kustomize base, e.g.
+// oci://ghcr.io/my-org/my-manifests
+func (source *ApplicationSource) IsOCI() bool {
+	return source != nil && source.Chart == "" && strings.HasPrefix(source.RepoURL, "oci://")
+}
+
 // IsZero returns true if the application source is considered empty
 func (source *ApplicationSource) IsZero() bool {
 	return source == nil ||
@@ -323,7 +350,8 @@ func (source *ApplicationSource) IsZero() bool {
 			source.Helm.IsZero() &&
 			source.Kustomize.IsZero() &&
 			source.Directory.IsZero() &&
-			source.Plugin.IsZero()
+			source.Plugin.IsZero() &&
+			source.CUE.IsZero()
 }
 
 // GetNamespaceOrDefault gets the static namespace configured in the source. If none is configured, returns the given
@@ -379,6 +407,7 @@ const (
 	ApplicationSourceTypeKustomize ApplicationSourceType = "Kustomize"
 	ApplicationSourceTypeDirectory ApplicationSourceType = "Directory"
 	ApplicationSourceTypePlugin    ApplicationSourceType = "Plugin"
+	ApplicationSourceTypeCUE       ApplicationSourceType = "CUE"
 )
 
 // SourceHydrator specifies a dry "don't repeat yourself" source for manifests, a sync source from which to sync
@@ -476,6 +505,8 @@ type RefTarget struct {
 	Repo           Repository `protobuf:"bytes,1,opt,name=repo"`
 	TargetRevision string     `protobuf:"bytes,2,opt,name=targetRevision"`
 	Chart          string     `protobuf:"bytes,3,opt,name=chart"`
+	// Source is the referenced source, only set when the source generates Helm values for other sources
+	Source *ApplicationSource `protobuf:"bytes,4,opt,name=source"`
 }
 
 type RefTargetRevisionMapping map[string]*RefTarget
@@ -516,6 +547,34 @@ type ApplicationSourceHelm struct {
 	SkipTests bool `json:"skipTests,omitempty" protobuf:"bytes,14,opt,name=skipTests"`
 	// SkipSchemaValidation skips JSON schema validation (Helm's --skip-schema-validation)
 	SkipSchemaValidation bool `json:"skipSchemaValidation,omitempty" protobuf:"bytes,15,opt,name=skipSchemaValidation"`
+	// ValuesFrom is a list of Helm values generated by other sources of a multi-source Application. The referenced
+	// sources are rendered by the repo server before this source and their values take precedence over the value files.
+	ValuesFrom []HelmValuesFromSource `json:"valuesFrom,omitempty" protobuf:"bytes,16,opt,name=valuesFrom"`
+	// EnableLookups renders the chart with access to the destination cluster (Helm's --dry-run=server), so that the
+	// `lookup` function of the templates returns the live resources. The cluster is accessed through a read-only proxy
+	// of the repo server.
+	EnableLookups bool `json:"enableLookups,omitempty" protobuf:"bytes,17,opt,name=enableLookups"`
+}
+
+// HelmValuesFromSource references Helm values generated by another source of a multi-source Application
+type HelmValuesFromSource struct {
+	// Ref is the `ref` of the source generating the values
+	Ref string `json:"ref" protobuf:"bytes,1,opt,name=ref"`
+	// ConfigMap is the name of the ConfigMap, generated by the referenced source, holding the values
+	ConfigMap string `json:"configMap" protobuf:"bytes,2,opt,name=configMap"`
+	// Key is the key of the ConfigMap holding the values in YAML. Defaults to `values.yaml`
+	Key string `json:"key,omitempty" protobuf:"bytes,3,opt,name=key"`
+}
+
+// DefaultHelmValuesFromSourceKey is the default key of the ConfigMap holding the values of a HelmValuesFromSource
+const DefaultHelmValuesFromSourceKey = "values.yaml"
+
+// GetKey returns the key of the ConfigMap holding the values, or the default one if not set
+func (v HelmValuesFromSource) GetKey() string {
+	if v.Key == "" {
+		return DefaultHelmValuesFromSourceKey
+	}
+	return v.Key
 }
 
 // HelmParameter is a parameter that's passed to helm template during manifest generation
@@ -597,7 +656,7 @@ func (ash *ApplicationSourceHelm) AddFileParameter(p HelmFileParameter) {
 
 // IsZero Returns true if the Helm options in an application source are considered zero
 func (ash *ApplicationSourceHelm) IsZero() bool {
-	return ash == nil || (ash.Version == "") && (ash.ReleaseName == "") && len(ash.ValueFiles) == 0 && len(ash.Parameters) == 0 && len(ash.FileParameters) == 0 && ash.ValuesIsEmpty() && !ash.PassCredentials && !ash.IgnoreMissingValueFiles && !ash.SkipCrds && !ash.SkipTests && !ash.SkipSchemaValidation && ash.KubeVersion == "" && len(ash.APIVersions) == 0 && ash.Namespace == ""
+	return ash == nil || (ash.Version == "") && (ash.ReleaseName == "") && len(ash.ValueFiles) == 0 && len(ash.Parameters) == 0 && len(ash.FileParameters) == 0 && ash.ValuesIsEmpty() && !ash.PassCredentials && !ash.IgnoreMissingValueFiles && !ash.SkipCrds && !ash.SkipTests && !ash.SkipSchemaValidation && ash.KubeVersion == "" && len(ash.APIVersions) == 0 && ash.Namespace == "" && len(ash.ValuesFrom) == 0 && !ash.EnableLookups
 }
 
 // KustomizeImage represents a Kustomize image definition in the format [old_image_name=]<image_name>:<image_tag>
@@ -673,6 +732,9 @@ type ApplicationSourceKustomize struct {
 	APIVersions []string `json:"apiVersions,omitempty" protobuf:"bytes,16,opt,name=apiVersions"`
 	// LabelIncludeTemplates specifies whether to apply common labels to resource templates or not
 	LabelIncludeTemplates bool `json:"labelIncludeTemplates,omitempty" protobuf:"bytes,18,opt,name=labelIncludeTemplates"`
+	// BuildOptions overrides the kustomize build options of the Argo CD instance for this source, e.g. --enable-helm.
+	// Only the options allowed by the kustomize.allowedBuildOptions setting can be used.
+	BuildOptions string `json:"buildOptions,omitempty" protobuf:"bytes,19,opt,name=buildOptions"`
 }
 
 type KustomizeReplica struct {
@@ -780,7 +842,8 @@ func (k *ApplicationSourceKustomize) IsZero() bool {
 			len(k.Components) == 0 &&
 			k.KubeVersion == "" &&
 			len(k.APIVersions) == 0 &&
-			!k.IgnoreMissingComponents
+			!k.IgnoreMissingComponents &&
+			k.BuildOptions == ""
 }
 
 // MergeImage merges a new Kustomize image identifier in to a list of images
@@ -816,8 +879,11 @@ func (rs KustomizeReplicas) FindByName(name string) int {
 // JsonnetVar represents a variable to be passed to jsonnet during manifest generation
 type JsonnetVar struct {
 	Name  string `json:"name" protobuf:"bytes,1,opt,name=name"`
-	Value string `json:"value" protobuf:"bytes,2,opt,name=value"`
+	Value string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
 	Code  bool   `json:"code,omitempty" protobuf:"bytes,3,opt,name=code"`
+	// File is the path of a file of the repository holding the value of the variable, relative to the application
+	// path. The value is ignored if the file is set.
+	File string `json:"file,omitempty" protobuf:"bytes,4,opt,name=file"`
 }
 
 // NewJsonnetVar parses a Jsonnet variable from a string in the format name=value
@@ -829,6 +895,13 @@ func NewJsonnetVar(s string, code bool) JsonnetVar {
 	return JsonnetVar{Name: s, Code: code}
 }
 
+// NewJsonnetFileVar parses a Jsonnet variable read from a file from a string in the format name=path
+func NewJsonnetFileVar(s string, code bool) JsonnetVar {
+	v := NewJsonnetVar(s, code)
+	v.File, v.Value = v.Value, ""
+	return v
+}
+
 // ApplicationSourceJsonnet holds options specific to applications of type Jsonnet
 type ApplicationSourceJsonnet struct {
 	// ExtVars is a list of Jsonnet External Variables
@@ -837,11 +910,14 @@ type ApplicationSourceJsonnet struct {
 	TLAs []JsonnetVar `json:"tlas,omitempty" protobuf:"bytes,2,opt,name=tlas"`
 	// Additional library search dirs
 	Libs []string `json:"libs,omitempty" protobuf:"bytes,3,opt,name=libs"`
+	// Bundler installs the dependencies locked by the jsonnetfile.lock.json file of the application path with
+	// jsonnet-bundler before rendering, and adds them to the library search dirs
+	Bundler bool `json:"bundler,omitempty" protobuf:"varint,4,opt,name=bundler"`
 }
 
 // IsZero returns true if the JSonnet options of an application are considered to be empty
 func (j *ApplicationSourceJsonnet) IsZero() bool {
-	return j == nil || len(j.ExtVars) == 0 && len(j.TLAs) == 0 && len(j.Libs) == 0
+	return j == nil || len(j.ExtVars) == 0 && len(j.TLAs) == 0 && len(j.Libs) == 0 && !j.Bundler
 }
 
 // ApplicationSourceDirectory holds options for applications of type plain YAML or Jsonnet
@@ -861,6 +937,24 @@ func (d *ApplicationSourceDirectory) IsZero() bool {
 	return d == nil || !d.Recurse && d.Jsonnet.IsZero()
 }
 
+// ApplicationSourceCUE holds options specific to CUE applications
+type ApplicationSourceCUE struct {
+	// Packages is a list of CUE packages to export, relative to the application path. Defaults to the package of the
+	// application path.
+	Packages []string `json:"packages,omitempty" protobuf:"bytes,1,rep,name=packages"`
+	// Tags is a list of key=value pairs injected into the fields with a matching @tag attribute
+	Tags []string `json:"tags,omitempty" protobuf:"bytes,2,rep,name=tags"`
+	// Values is a YAML or JSON document unified with the exported packages
+	Values string `json:"values,omitempty" protobuf:"bytes,3,opt,name=values"`
+	// Expression is a CUE expression selecting the manifests to export, e.g. objects. Defaults to the whole package.
+	Expression string `json:"expression,omitempty" protobuf:"bytes,4,opt,name=expression"`
+}
+
+// IsZero returns true if the ApplicationSourceCUE is considered empty
+func (c *ApplicationSourceCUE) IsZero() bool {
+	return c == nil || len(c.Packages) == 0 && len(c.Tags) == 0 && c.Values == "" && c.Expression == ""
+}
+
 type OptionalMap struct {
 	// Map is the value of a map type parameter.
 	// +optional
@@ -1119,6 +1213,14 @@ type ApplicationDestination struct {
 	Name string `json:"name,omitempty" protobuf:"bytes,3,opt,name=name"`
 }
 
+// DestinationNamespaceMapping maps the resources matching a label selector to a destination namespace
+type DestinationNamespaceMapping struct {
+	// Namespace is the target namespace of the resources matching the selector
+	Namespace string `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	// Selector selects the resources by their labels
+	Selector metav1.LabelSelector `json:"selector" protobuf:"bytes,2,opt,name=selector"`
+}
+
 type ResourceHealthLocation string
 
 var (
@@ -1157,6 +1259,32 @@ type ApplicationStatus struct {
 	ControllerNamespace string `json:"controllerNamespace,omitempty" protobuf:"bytes,13,opt,name=controllerNamespace"`
 	// SourceHydrator stores information about the current state of source hydration
 	SourceHydrator SourceHydratorStatus `json:"sourceHydrator,omitempty" protobuf:"bytes,14,opt,name=sourceHydrator"`
+	// DriftHistory holds the most recent out-of-band changes of the live resources reverted by the self-heal
+	DriftHistory []DriftEvent `json:"driftHistory,omitempty" protobuf:"bytes,15,opt,name=driftHistory"`
+}
+
+// DriftEvent is a revert by the self-heal of the changes made out of band to the live resources of an application
+type DriftEvent struct {
+	// RevertedAt holds the time the self-heal sync reverting the changes was initiated
+	RevertedAt metav1.Time `json:"revertedAt" protobuf:"bytes,1,opt,name=revertedAt"`
+	// Revision holds the revision the resources were reverted to
+	Revision string `json:"revision,omitempty" protobuf:"bytes,2,opt,name=revision"`
+	// Resources holds the drifted resources
+	Resources []DriftedResource `json:"resources,omitempty" protobuf:"bytes,3,rep,name=resources"`
+}
+
+// DriftedResource is a live resource changed out of band
+type DriftedResource struct {
+	Group     string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	Version   string `json:"version,omitempty" protobuf:"bytes,2,opt,name=version"`
+	Kind      string `json:"kind" protobuf:"bytes,3,opt,name=kind"`
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,4,opt,name=namespace"`
+	Name      string `json:"name" protobuf:"bytes,5,opt,name=name"`
+	// Managers holds the field managers of the live resource which updated it since the last sync
+	Managers []string `json:"managers,omitempty" protobuf:"bytes,6,rep,name=managers"`
+	// Diff holds the JSON merge patch from the target state to the live state of the resource, truncated if too large.
+	// Not recorded for the secrets.
+	Diff string `json:"diff,omitempty" protobuf:"bytes,7,opt,name=diff"`
 }
 
 // SourceHydratorStatus contains information about the current state of source hydration
@@ -1303,6 +1431,32 @@ func (in RevisionHistories) Trunc(n int) RevisionHistories {
 	return in
 }
 
+// TruncByAge removes the entries deployed before the given time, the most recent entry is always kept
+func (in RevisionHistories) TruncByAge(deployedAfter time.Time) RevisionHistories {
+	i := 0
+	for i < len(in)-1 && in[i].DeployedAt.Time.Before(deployedAfter) {
+		i++
+	}
+	return in[i:]
+}
+
+// TruncBySize keeps the most recent entries whose total JSON encoded size does not exceed maxBytes, the most recent
+// entry is always kept
+func (in RevisionHistories) TruncBySize(maxBytes int) RevisionHistories {
+	size := 0
+	for i := len(in) - 1; i >= 0; i-- {
+		data, err := json.Marshal(in[i])
+		if err != nil {
+			continue
+		}
+		size += len(data)
+		if size > maxBytes && i < len(in)-1 {
+			return in[i+1:]
+		}
+	}
+	return in
+}
+
 // HasIdentity determines whether a sync operation is identified by a manifest
 func (r SyncOperationResource) HasIdentity(name string, namespace string, gvk schema.GroupVersionKind) bool {
 	if name == r.Name && gvk.Kind == r.Kind && gvk.Group == r.Group && (r.Namespace == "" || namespace == r.Namespace) {
@@ -1350,6 +1504,14 @@ type SyncOperation struct {
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,11,opt,name=revisions"`
 	// SelfHealAttemptsCount contains the number of auto-heal attempts
 	SelfHealAttemptsCount int64 `json:"autoHealAttemptsCount,omitempty" protobuf:"bytes,12,opt,name=autoHealAttemptsCount"`
+	// Rollback is set if the sync operation is a rollback, and is recorded in the deployment history
+	Rollback *RollbackInfo `json:"rollback,omitempty" protobuf:"bytes,13,opt,name=rollback"`
+	// Resume skips the tasks successfully completed by the previous sync operation of the application, if it failed or was
+	// terminated while syncing the same revision, so that the sync continues from its last completed wave
+	Resume bool `json:"resume,omitempty" protobuf:"bytes,14,opt,name=resume"`
+	// ForceConflictsFor lists the field managers whose fields are taken over by the server-side apply of the resources.
+	// The field ownership conflicts with other field managers are reported and fail the sync of the resource.
+	ForceConflictsFor []string `json:"forceConflictsFor,omitempty" protobuf:"bytes,15,rep,name=forceConflictsFor"`
 }
 
 // IsApplyStrategy returns true if the sync strategy is "apply"
@@ -1429,9 +1591,24 @@ type SyncPolicy struct {
 	Retry *RetryStrategy `json:"retry,omitempty" protobuf:"bytes,3,opt,name=retry"`
 	// ManagedNamespaceMetadata controls metadata in the given namespace (if CreateNamespace=true)
 	ManagedNamespaceMetadata *ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,4,opt,name=managedNamespaceMetadata"`
+	// ReconcileInterval overrides the reconciliation timeout of the application controller for this application, e.g. 5m
+	ReconcileInterval string `json:"reconcileInterval,omitempty" protobuf:"bytes,5,opt,name=reconcileInterval"`
+	// Priority is the priority of the application in the work queues of the application controller, one of high, normal (default) or low
+	// +kubebuilder:validation:Enum=high;normal;low
+	Priority ApplicationPriority `json:"priority,omitempty" protobuf:"bytes,6,opt,name=priority,casttype=ApplicationPriority"`
+	// Hooks are the hooks of the hook library of Argo CD run by the syncs of the application, in addition to the hooks of its sources
+	Hooks []SyncHookReference `json:"hooks,omitempty" protobuf:"bytes,7,rep,name=hooks"`
 	// If you add a field here, be sure to update IsZero.
 }
 
+// SyncHookReference references a hook of the hook library of Argo CD, rendered with the parameters of the application
+type SyncHookReference struct {
+	// Name is the name of the hook in the hook library
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Parameters are the values of the ${PARAMETER} references of the hook
+	Parameters Env `json:"parameters,omitempty" protobuf:"bytes,2,opt,name=parameters"`
+}
+
 // IsAutomatedSyncEnabled checks if the automated sync is enabled or disabled
 func (p *SyncPolicy) IsAutomatedSyncEnabled() bool {
 	if p.Automated != nil && (p.Automated.Enabled == nil || *p.Automated.Enabled) {
@@ -1442,7 +1619,45 @@ func (p *SyncPolicy) IsAutomatedSyncEnabled() bool {
 
 // IsZero returns true if the sync policy is empty
 func (p *SyncPolicy) IsZero() bool {
-	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil && p.ManagedNamespaceMetadata == nil)
+	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil && p.ManagedNamespaceMetadata == nil && p.ReconcileInterval == "" && p.Priority == "" && len(p.Hooks) == 0)
+}
+
+// ApplicationPriority is the priority of an application in the work queues of the application controller
+type ApplicationPriority string
+
+const (
+	ApplicationPriorityHigh   ApplicationPriority = "high"
+	ApplicationPriorityNormal ApplicationPriority = "normal"
+	ApplicationPriorityLow    ApplicationPriority = "low"
+)
+
+// GetPriority returns the priority of the application, normal if unset. An error is returned if the priority is not
+// one of high, normal or low.
+func (p *SyncPolicy) GetPriority() (ApplicationPriority, error) {
+	if p == nil || p.Priority == "" {
+		return ApplicationPriorityNormal, nil
+	}
+	switch p.Priority {
+	case ApplicationPriorityHigh, ApplicationPriorityNormal, ApplicationPriorityLow:
+		return p.Priority, nil
+	}
+	return ApplicationPriorityNormal, fmt.Errorf("invalid priority '%s': must be one of %s, %s or %s", p.Priority, ApplicationPriorityHigh, ApplicationPriorityNormal, ApplicationPriorityLow)
+}
+
+// GetReconcileInterval returns the reconcile interval of the application, zero if the reconciliation timeout of the
+// application controller is used
+func (p *SyncPolicy) GetReconcileInterval() (time.Duration, error) {
+	if p == nil || p.ReconcileInterval == "" {
+		return 0, nil
+	}
+	interval, err := time.ParseDuration(p.ReconcileInterval)
+	if err != nil {
+		return 0, fmt.Errorf("invalid reconcile interval '%s': %w", p.ReconcileInterval, err)
+	}
+	if interval <= 0 {
+		return 0, fmt.Errorf("invalid reconcile interval '%s': must be positive", p.ReconcileInterval)
+	}
+	return interval, nil
 }
 
 // RetryStrategy contains information about the strategy to apply when a sync failed
@@ -1596,6 +1811,22 @@ type SyncOperationResult struct {
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,5,opt,name=revisions"`
 	// ManagedNamespaceMetadata contains the current sync state of managed namespace metadata
 	ManagedNamespaceMetadata *ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,6,opt,name=managedNamespaceMetadata"`
+	// WavePause is set while the sync operation is paused after a sync wave
+	WavePause *SyncWavePause `json:"wavePause,omitempty" protobuf:"bytes,7,opt,name=wavePause"`
+	// OutOfSyncWaves holds the sync waves with out-of-sync resources at the start of the sync operation, recorded when
+	// the hooks are only run for these waves
+	OutOfSyncWaves []int64 `json:"outOfSyncWaves,omitempty" protobuf:"varint,8,rep,name=outOfSyncWaves"`
+}
+
+// SyncWavePause contains the state of a pause between two sync waves, requested by the resources of a wave with the
+// argocd.argoproj.io/sync-wave-pause annotation
+type SyncWavePause struct {
+	// Phase is the sync phase of the wave after which the sync is paused
+	Phase synccommon.SyncPhase `json:"phase" protobuf:"bytes,1,opt,name=phase"`
+	// Wave is the sync wave after which the sync is paused
+	Wave int64 `json:"wave" protobuf:"varint,2,opt,name=wave"`
+	// Until is the time at which the pause ends
+	Until metav1.Time `json:"until" protobuf:"bytes,3,opt,name=until"`
 }
 
 // ResourceResult holds the operation result details of a specific resource
@@ -1621,6 +1852,20 @@ type ResourceResult struct {
 	HookPhase synccommon.OperationPhase `json:"hookPhase,omitempty" protobuf:"bytes,9,opt,name=hookPhase"`
 	// SyncPhase indicates the particular phase of the sync that this result was acquired in
 	SyncPhase synccommon.SyncPhase `json:"syncPhase,omitempty" protobuf:"bytes,10,opt,name=syncPhase"`
+	// Conflicts lists the field ownership conflicts with other field managers hit by the server-side apply of the resource
+	Conflicts []ServerSideApplyConflict `json:"conflicts,omitempty" protobuf:"bytes,11,rep,name=conflicts"`
+	// AppliedAt is the time at which the resource was applied by the sync, only recorded for the resources with a sync timeout
+	AppliedAt *metav1.Time `json:"appliedAt,omitempty" protobuf:"bytes,12,opt,name=appliedAt"`
+}
+
+// ServerSideApplyConflict is a field ownership conflict with another field manager hit by a server-side apply
+type ServerSideApplyConflict struct {
+	// Manager is the name of the field manager owning the conflicting fields
+	Manager string `json:"manager" protobuf:"bytes,1,opt,name=manager"`
+	// Fields are the paths of the conflicting fields
+	Fields []string `json:"fields,omitempty" protobuf:"bytes,2,rep,name=fields"`
+	// Forced is set if the fields were taken over from the field manager
+	Forced bool `json:"forced,omitempty" protobuf:"bytes,3,opt,name=forced"`
 }
 
 // GroupVersionKind returns the GVK schema information for a given resource within a sync result
@@ -1674,6 +1919,20 @@ type RevisionHistory struct {
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,9,opt,name=revisions"`
 	// InitiatedBy contains information about who initiated the operations
 	InitiatedBy OperationInitiator `json:"initiatedBy,omitempty" protobuf:"bytes,10,opt,name=initiatedBy"`
+	// Rollback is set if the deployment is the result of a rollback
+	Rollback *RollbackInfo `json:"rollback,omitempty" protobuf:"bytes,11,opt,name=rollback"`
+}
+
+// RollbackInfo contains information about the origin of a rollback
+type RollbackInfo struct {
+	// Revision is the revision the application was rolled back to, as requested: a Git revision or chart version, or
+	// the revision of the deployment history entry
+	Revision string `json:"revision,omitempty" protobuf:"bytes,1,opt,name=revision"`
+	// HistoryID is the ID of the deployment history entry the application was rolled back to. Unset if the application
+	// was rolled back to a revision.
+	HistoryID *int64 `json:"historyID,omitempty" protobuf:"varint,2,opt,name=historyID"`
+	// FromRevision is the revision deployed when the rollback was initiated
+	FromRevision string `json:"fromRevision,omitempty" protobuf:"bytes,3,opt,name=fromRevision"`
 }
 
 // ApplicationWatchEvent contains information about application change.
@@ -1741,6 +2000,8 @@ const (
 	ApplicationConditionExcludedResourceWarning = "ExcludedResourceWarning"
 	// ApplicationConditionOrphanedResourceWarning indicates that application has orphaned resources
 	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// ApplicationConditionSyncApprovalPending indicates that the automated sync of the application waits for the approval of its diff
+	ApplicationConditionSyncApprovalPending = "SyncApprovalPending"
 )
 
 // ApplicationCondition contains details about an application condition, which is usually an error or warning
@@ -2055,6 +2316,17 @@ type ResourceStatus struct {
 	SyncWave int64 `json:"syncWave,omitempty" protobuf:"bytes,10,opt,name=syncWave"`
 	// RequiresDeletionConfirmation is true if the resource requires explicit user confirmation before deletion.
 	RequiresDeletionConfirmation bool `json:"requiresDeletionConfirmation,omitempty" protobuf:"bytes,11,opt,name=requiresDeletionConfirmation"`
+	// PendingDeletion is set if the deletion of the live resource was requested but the resource still exists, e.g.
+	// because of its finalizers
+	PendingDeletion *PendingDeletion `json:"pendingDeletion,omitempty" protobuf:"bytes,12,opt,name=pendingDeletion"`
+}
+
+// PendingDeletion holds the state of a resource whose deletion was requested
+type PendingDeletion struct {
+	// DeletionRequestedAt holds the deletion timestamp of the resource
+	DeletionRequestedAt metav1.Time `json:"deletionRequestedAt" protobuf:"bytes,1,opt,name=deletionRequestedAt"`
+	// Finalizers holds the finalizers of the resource blocking its deletion
+	Finalizers []string `json:"finalizers,omitempty" protobuf:"bytes,2,rep,name=finalizers"`
 }
 
 // GroupVersionKind returns the GVK schema type for given resource status
@@ -2154,6 +2426,11 @@ type Cluster struct {
 	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,12,opt,name=labels"`
 	// Annotations for cluster secret metadata
 	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,13,opt,name=annotations"`
+	// Maintenance indicates that the cluster is in maintenance. Automated sync and self-heal are suspended for the
+	// applications deployed to the cluster, while their status is still refreshed.
+	Maintenance bool `json:"maintenance,omitempty" protobuf:"bytes,14,opt,name=maintenance"`
+	// SyncWindows controls when syncs can be run for the applications deployed to the cluster, regardless of their project
+	SyncWindows SyncWindows `json:"syncWindows,omitempty" protobuf:"bytes,15,opt,name=syncWindows"`
 }
 
 // Equals returns true if two cluster objects are considered to be equal
@@ -2183,6 +2460,14 @@ func (c *Cluster) Equals(other *Cluster) bool {
 		return false
 	}
 
+	if c.Maintenance != other.Maintenance {
+		return false
+	}
+
+	if !reflect.DeepEqual(c.SyncWindows, other.SyncWindows) {
+		return false
+	}
+
 	if !maps.Equal(c.Annotations, other.Annotations) {
 		return false
 	}
@@ -2206,6 +2491,10 @@ type ClusterInfo struct {
 	ApplicationsCount int64 `json:"applicationsCount" protobuf:"bytes,4,opt,name=applicationsCount"`
 	// APIVersions contains list of API versions supported by the cluster
 	APIVersions []string `json:"apiVersions,omitempty" protobuf:"bytes,5,opt,name=apiVersions"`
+	// CapacityInfo contains information about the capacity of the cluster nodes, as observed by the cluster cache
+	CapacityInfo *ClusterCapacityInfo `json:"capacityInfo,omitempty" protobuf:"bytes,6,opt,name=capacityInfo"`
+	// LatencyInfo contains information about the latency of the connection to the cluster API server
+	LatencyInfo *ClusterLatencyInfo `json:"latencyInfo,omitempty" protobuf:"bytes,7,opt,name=latencyInfo"`
 }
 
 func (c *ClusterInfo) GetKubeVersion() string {
@@ -2226,6 +2515,33 @@ type ClusterCacheInfo struct {
 	LastCacheSyncTime *metav1.Time `json:"lastCacheSyncTime,omitempty" protobuf:"bytes,3,opt,name=lastCacheSyncTime"`
 }
 
+// ClusterCapacityInfo contains information about the capacity of the cluster nodes
+type ClusterCapacityInfo struct {
+	// NodesCount holds the number of nodes of the cluster
+	NodesCount int64 `json:"nodesCount,omitempty" protobuf:"bytes,1,opt,name=nodesCount"`
+	// PodsCount holds the number of pods which are not terminated
+	PodsCount int64 `json:"podsCount,omitempty" protobuf:"bytes,2,opt,name=podsCount"`
+	// AllocatablePods holds the number of pods which can be scheduled on the nodes
+	AllocatablePods int64 `json:"allocatablePods,omitempty" protobuf:"bytes,3,opt,name=allocatablePods"`
+	// AllocatableCPU holds the CPU of the nodes available for pods, in millicores
+	AllocatableCPU int64 `json:"allocatableCPU,omitempty" protobuf:"bytes,4,opt,name=allocatableCPU"`
+	// AllocatableMemory holds the memory of the nodes available for pods, in bytes
+	AllocatableMemory int64 `json:"allocatableMemory,omitempty" protobuf:"bytes,5,opt,name=allocatableMemory"`
+	// KubeletVersions holds the distinct kubelet versions of the nodes
+	KubeletVersions []string `json:"kubeletVersions,omitempty" protobuf:"bytes,6,rep,name=kubeletVersions"`
+}
+
+// ClusterLatencyInfo contains information about the latency of the connection to the cluster API server
+type ClusterLatencyInfo struct {
+	// APIServerRTTMilliseconds holds the round-trip time of a request to the API server, in milliseconds
+	APIServerRTTMilliseconds int64 `json:"apiServerRTTMilliseconds,omitempty" protobuf:"bytes,1,opt,name=apiServerRTTMilliseconds"`
+	// WatchLagMilliseconds holds the maximum delay between the modification of a resource and the reception of its
+	// watch event since the previous measurement, in milliseconds. The delay is measured with second precision.
+	WatchLagMilliseconds int64 `json:"watchLagMilliseconds,omitempty" protobuf:"bytes,2,opt,name=watchLagMilliseconds"`
+	// WatchEventsCount holds the number of watch events used to measure the watch lag
+	WatchEventsCount int64 `json:"watchEventsCount,omitempty" protobuf:"bytes,3,opt,name=watchEventsCount"`
+}
+
 // ClusterList is a collection of Clusters.
 type ClusterList struct {
 	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
@@ -2263,6 +2579,24 @@ type ExecProviderConfig struct {
 	InstallHint string `json:"installHint,omitempty" protobuf:"bytes,5,opt,name=installHint"`
 }
 
+// execArgs returns the arguments of the argocd-k8s-auth command which resolves the external credentials
+func (c *ExternalCredentialsConfig) execArgs() []string {
+	args := []string{"external"}
+	if c.BearerTokenRef != "" {
+		args = append(args, "--token-ref", c.BearerTokenRef)
+	}
+	if c.CertDataRef != "" {
+		args = append(args, "--cert-ref", c.CertDataRef)
+	}
+	if c.KeyDataRef != "" {
+		args = append(args, "--key-ref", c.KeyDataRef)
+	}
+	if c.CacheTTL != "" {
+		args = append(args, "--ttl", c.CacheTTL)
+	}
+	return args
+}
+
 // ClusterConfig is the configuration attributes. This structure is subset of the go-client
 // rest.Config with annotations added for marshalling.
 type ClusterConfig struct {
@@ -2287,8 +2621,158 @@ type ClusterConfig struct {
 	// DisableCompression bypasses automatic GZip compression requests to the server.
 	DisableCompression bool `json:"disableCompression,omitempty" protobuf:"bytes,7,opt,name=disableCompression"`
 
-	// ProxyURL is the URL to the proxy to be used for all requests send to the server
+	// ProxyURL is the URL to the proxy to be used for all requests send to the server. HTTP, HTTPS and SOCKS5 proxies
+	// are supported.
 	ProxyUrl string `json:"proxyUrl,omitempty" protobuf:"bytes,8,opt,name=proxyUrl"` //nolint:revive //FIXME(var-naming)
+
+	// ExternalCredentialsConfig contains references to cluster credentials held by an external secret manager
+	ExternalCredentialsConfig *ExternalCredentialsConfig `json:"externalCredentialsConfig,omitempty" protobuf:"bytes,9,opt,name=externalCredentialsConfig"`
+
+	// ImpersonationConfig contains the user and groups to impersonate when connecting to the cluster
+	ImpersonationConfig *ImpersonationConfig `json:"impersonationConfig,omitempty" protobuf:"bytes,10,opt,name=impersonationConfig"`
+
+	// RateLimitConfig overrides the global rate limit of the requests sent to the cluster
+	RateLimitConfig *ClusterRateLimitConfig `json:"rateLimitConfig,omitempty" protobuf:"bytes,11,opt,name=rateLimitConfig"`
+
+	// ProxyCredentialsSecretRef references the secret holding the credentials used to authenticate to the proxy
+	ProxyCredentialsSecretRef *ProxyCredentialsSecretRef `json:"proxyCredentialsSecretRef,omitempty" protobuf:"bytes,12,opt,name=proxyCredentialsSecretRef"`
+
+	// SSHTunnelConfig contains the SSH server through which the connections to the cluster are tunneled
+	SSHTunnelConfig *SSHTunnelConfig `json:"sshTunnelConfig,omitempty" protobuf:"bytes,13,opt,name=sshTunnelConfig"`
+
+	// BootstrapToken is the kubeadm bootstrap token, as <token-id>.<token-secret>, which must have signed the
+	// cluster-info ConfigMap of kube-public when the CA data of the cluster is refreshed from it
+	BootstrapToken string `json:"bootstrapToken,omitempty" protobuf:"bytes,14,opt,name=bootstrapToken"`
+
+	// ProxyUsername is the proxy username resolved from ProxyCredentialsSecretRef. Not exposed via API.
+	ProxyUsername string `json:"-"`
+	// ProxyPassword is the proxy password resolved from ProxyCredentialsSecretRef. Not exposed via API.
+	ProxyPassword string `json:"-"`
+}
+
+// ProxyCredentialsSecretRef references a secret in the Argo CD namespace holding the username and password used to
+// authenticate to the proxy of a cluster
+type ProxyCredentialsSecretRef struct {
+	// SecretName is the name of the secret
+	SecretName string `json:"secretName" protobuf:"bytes,1,opt,name=secretName"`
+	// UsernameKey is the key of the username in the secret. Defaults to "username"
+	UsernameKey string `json:"usernameKey,omitempty" protobuf:"bytes,2,opt,name=usernameKey"`
+	// PasswordKey is the key of the password in the secret. Defaults to "password"
+	PasswordKey string `json:"passwordKey,omitempty" protobuf:"bytes,3,opt,name=passwordKey"`
+}
+
+// GetUsernameKey returns the key of the username in the secret
+func (r *ProxyCredentialsSecretRef) GetUsernameKey() string {
+	if r.UsernameKey == "" {
+		return "username"
+	}
+	return r.UsernameKey
+}
+
+// GetPasswordKey returns the key of the password in the secret
+func (r *ProxyCredentialsSecretRef) GetPasswordKey() string {
+	if r.PasswordKey == "" {
+		return "password"
+	}
+	return r.PasswordKey
+}
+
+// SSHTunnelConfig contains the SSH server, e.g. a bastion host, through which Argo CD connects to a cluster whose API
+// server is not directly reachable. The connections to the API server are forwarded by the SSH server.
+type SSHTunnelConfig struct {
+	// Host is the address of the SSH server, as host[:port]. The port defaults to 22
+	Host string `json:"host" protobuf:"bytes,1,opt,name=host"`
+	// User is the SSH user
+	User string `json:"user" protobuf:"bytes,2,opt,name=user"`
+	// PrivateKey is the PEM-encoded private key used to authenticate to the SSH server
+	PrivateKey string `json:"privateKey,omitempty" protobuf:"bytes,3,opt,name=privateKey"`
+	// HostKey is the public key of the SSH server, in the authorized_keys format
+	HostKey string `json:"hostKey,omitempty" protobuf:"bytes,4,opt,name=hostKey"`
+	// InsecureIgnoreHostKey disables the verification of the SSH server host key
+	InsecureIgnoreHostKey bool `json:"insecureIgnoreHostKey,omitempty" protobuf:"bytes,5,opt,name=insecureIgnoreHostKey"`
+}
+
+// clusterSSHTunnels holds the SSH tunnels of the clusters, so the clients of a cluster share the same SSH connection
+var clusterSSHTunnels sync.Map
+
+// tunnel returns the SSH tunnel shared by the clients using the same SSH tunnel configuration
+func (c *SSHTunnelConfig) tunnel() (*kubeutil.SSHTunnel, error) {
+	key := *c
+	if tunnel, ok := clusterSSHTunnels.Load(key); ok {
+		return tunnel.(*kubeutil.SSHTunnel), nil
+	}
+	tunnel, err := kubeutil.NewSSHTunnel(c.Host, c.User, []byte(c.PrivateKey), c.HostKey, c.InsecureIgnoreHostKey)
+	if err != nil {
+		return nil, err
+	}
+	actual, _ := clusterSSHTunnels.LoadOrStore(key, tunnel)
+	return actual.(*kubeutil.SSHTunnel), nil
+}
+
+// ClusterRateLimitConfig contains the client side rate limit of the requests sent to a cluster. The rate limit is
+// shared by all the clients of the cluster and is lowered while the API server throttles requests with 429 Too Many
+// Requests responses.
+type ClusterRateLimitConfig struct {
+	// QPS is the maximum number of requests per second sent to the cluster. Defaults to ARGOCD_K8S_CLIENT_QPS
+	QPS int64 `json:"qps,omitempty" protobuf:"bytes,1,opt,name=qps"`
+	// Burst is the maximum burst of requests sent to the cluster. Defaults to twice the QPS
+	Burst int64 `json:"burst,omitempty" protobuf:"bytes,2,opt,name=burst"`
+}
+
+// clusterRateLimiterKey identifies the rate limiter of a cluster
+type clusterRateLimiterKey struct {
+	server string
+	qps    float32
+	burst  int
+}
+
+// clusterRateLimiters holds the rate limiters of the clusters with a rate limit configuration, so the clients of a
+// cluster share the same rate limit
+var clusterRateLimiters sync.Map
+
+// qpsAndBurst returns the QPS and burst of the rate limit, defaulting to the global client settings
+func (c *ClusterRateLimitConfig) qpsAndBurst() (float32, int) {
+	qps := K8sClientConfigQPS
+	if c.QPS > 0 {
+		qps = float32(c.QPS)
+	}
+	burst := int(2 * qps)
+	if c.Burst > 0 {
+		burst = int(c.Burst)
+	}
+	return qps, burst
+}
+
+// rateLimiter returns the rate limiter shared by the clients of the cluster
+func (c *ClusterRateLimitConfig) rateLimiter(server string) *kubeutil.AdaptiveRateLimiter {
+	qps, burst := c.qpsAndBurst()
+	key := clusterRateLimiterKey{server: server, qps: qps, burst: burst}
+	limiter, _ := clusterRateLimiters.LoadOrStore(key, kubeutil.NewAdaptiveRateLimiter(qps, burst))
+	return limiter.(*kubeutil.AdaptiveRateLimiter)
+}
+
+// ImpersonationConfig contains the user and groups Argo CD impersonates when connecting to a cluster. This allows a
+// single set of credentials to act as different identities, which requires the credentials to be granted the
+// `impersonate` permission in the cluster.
+type ImpersonationConfig struct {
+	// Username is the user to impersonate
+	Username string `json:"username,omitempty" protobuf:"bytes,1,opt,name=username"`
+	// Groups are the groups to impersonate
+	Groups []string `json:"groups,omitempty" protobuf:"bytes,2,rep,name=groups"`
+}
+
+// ExternalCredentialsConfig contains references to the cluster credentials held by an external secret manager, such as
+// `vault:secret/data/cluster-foo#token` or an AWS Secrets Manager secret ARN. The credentials are resolved by
+// argocd-k8s-auth whenever a connection to the cluster is made, so they are never stored in the cluster secret.
+type ExternalCredentialsConfig struct {
+	// BearerTokenRef references the bearer token
+	BearerTokenRef string `json:"bearerTokenRef,omitempty" protobuf:"bytes,1,opt,name=bearerTokenRef"`
+	// CertDataRef references the PEM-encoded client certificate
+	CertDataRef string `json:"certDataRef,omitempty" protobuf:"bytes,2,opt,name=certDataRef"`
+	// KeyDataRef references the PEM-encoded client certificate key
+	KeyDataRef string `json:"keyDataRef,omitempty" protobuf:"bytes,3,opt,name=keyDataRef"`
+	// CacheTTL is how long resolved credentials are cached before they are resolved again (e.g. "10m"). Defaults to 5m
+	CacheTTL string `json:"cacheTTL,omitempty" protobuf:"bytes,4,opt,name=cacheTTL"`
 }
 
 // TLSClientConfig contains settings to enable transport layer security
@@ -2341,6 +2825,7 @@ type rawResourceOverride struct {
 	IgnoreDifferences     string           `json:"ignoreDifferences,omitempty"`
 	IgnoreResourceUpdates string           `json:"ignoreResourceUpdates,omitempty"`
 	KnownTypeFields       []KnownTypeField `json:"knownTypeFields,omitempty"`
+	HealthCEL             string           `json:"health.cel,omitempty"`
 }
 
 // ResourceOverride holds configuration to customize resource diffing and health assessment
@@ -2357,6 +2842,8 @@ type ResourceOverride struct {
 	IgnoreResourceUpdates OverrideIgnoreDiff `protobuf:"bytes,6,opt,name=ignoreResourceUpdates"`
 	// KnownTypeFields lists fields for which unit conversions should be applied.
 	KnownTypeFields []KnownTypeField `protobuf:"bytes,4,opt,name=knownTypeFields"`
+	// HealthCEL contains a CEL expression that defines custom health checks for the resource, used instead of HealthLua.
+	HealthCEL string `protobuf:"bytes,7,opt,name=healthCEL"`
 }
 
 // UnmarshalJSON unmarshals a JSON byte slice into a ResourceOverride object.
@@ -2369,6 +2856,7 @@ func (ro *ResourceOverride) UnmarshalJSON(data []byte) error {
 	}
 	ro.KnownTypeFields = raw.KnownTypeFields
 	ro.HealthLua = raw.HealthLua
+	ro.HealthCEL = raw.HealthCEL
 	ro.UseOpenLibs = raw.UseOpenLibs
 	ro.Actions = raw.Actions
 	err := yaml.Unmarshal([]byte(raw.IgnoreDifferences), &ro.IgnoreDifferences)
@@ -2393,7 +2881,7 @@ func (ro ResourceOverride) MarshalJSON() ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	raw := &rawResourceOverride{ro.HealthLua, ro.UseOpenLibs, ro.Actions, string(ignoreDifferencesData), string(ignoreResourceUpdatesData), ro.KnownTypeFields}
+	raw := &rawResourceOverride{ro.HealthLua, ro.UseOpenLibs, ro.Actions, string(ignoreDifferencesData), string(ignoreResourceUpdatesData), ro.KnownTypeFields, ro.HealthCEL}
 	return json.Marshal(raw)
 }
 
@@ -2583,8 +3071,11 @@ func (s *OrphanedResourcesMonitorSettings) IsWarn() bool {
 
 // SignatureKey is the specification of a key required to verify commit signatures with
 type SignatureKey struct {
-	// The ID of the key in hexadecimal notation
+	// The ID of the key in hexadecimal notation, or the SHA256 fingerprint of an SSH key (SHA256:...)
 	KeyID string `json:"keyID" protobuf:"bytes,1,name=keyID"`
+	// Paths is a list of glob patterns of the source paths the key is required for. The key is required for all
+	// the paths if empty.
+	Paths []string `json:"paths,omitempty" protobuf:"bytes,2,rep,name=paths"`
 }
 
 // AppProjectSpec is the specification of an AppProject
@@ -2607,7 +3098,7 @@ type AppProjectSpec struct {
 	SyncWindows SyncWindows `json:"syncWindows,omitempty" protobuf:"bytes,8,opt,name=syncWindows"`
 	// NamespaceResourceWhitelist contains list of whitelisted namespace level resources
 	NamespaceResourceWhitelist []metav1.GroupKind `json:"namespaceResourceWhitelist,omitempty" protobuf:"bytes,9,opt,name=namespaceResourceWhitelist"`
-	// SignatureKeys contains a list of PGP key IDs that commits in Git must be signed with in order to be allowed for sync
+	// SignatureKeys contains a list of PGP key IDs or SSH key fingerprints that commits in Git must be signed with in order to be allowed for sync
 	SignatureKeys []SignatureKey `json:"signatureKeys,omitempty" protobuf:"bytes,10,opt,name=signatureKeys"`
 	// ClusterResourceBlacklist contains list of blacklisted cluster level resources
 	ClusterResourceBlacklist []metav1.GroupKind `json:"clusterResourceBlacklist,omitempty" protobuf:"bytes,11,opt,name=clusterResourceBlacklist"`
@@ -2617,6 +3108,166 @@ type AppProjectSpec struct {
 	PermitOnlyProjectScopedClusters bool `json:"permitOnlyProjectScopedClusters,omitempty" protobuf:"bytes,13,opt,name=permitOnlyProjectScopedClusters"`
 	// DestinationServiceAccounts holds information about the service accounts to be impersonated for the application sync operation for each destination.
 	DestinationServiceAccounts []ApplicationDestinationServiceAccount `json:"destinationServiceAccounts,omitempty" protobuf:"bytes,14,name=destinationServiceAccounts"`
+	// ApplicationResourceQuota limits the number of resources and the size of the manifests rendered by the applications of the project
+	ApplicationResourceQuota *ApplicationResourceQuota `json:"applicationResourceQuota,omitempty" protobuf:"bytes,15,opt,name=applicationResourceQuota"`
+	// ManifestGenerationWebhooks are called in order by the repo server to mutate or reject the manifests rendered by the applications of the project
+	ManifestGenerationWebhooks []ManifestGenerationWebhook `json:"manifestGenerationWebhooks,omitempty" protobuf:"bytes,16,rep,name=manifestGenerationWebhooks"`
+	// SyncPolicy holds the sync defaults inherited by the applications of the project and the restrictions enforced on their syncs
+	SyncPolicy *ProjectSyncPolicy `json:"syncPolicy,omitempty" protobuf:"bytes,17,opt,name=syncPolicy"`
+	// SourceRestrictions restrict the paths and the target revisions the sources of the applications of the project can use
+	SourceRestrictions []SourceRestriction `json:"sourceRestrictions,omitempty" protobuf:"bytes,18,rep,name=sourceRestrictions"`
+	// Quota limits the applications of the project and the resources and clusters they manage
+	Quota *ProjectQuota `json:"quota,omitempty" protobuf:"bytes,19,opt,name=quota"`
+	// ParentProject is the name of the project whose destinations, source repositories, roles and allowed resources are
+	// inherited by the project, in addition to its own
+	ParentProject string `json:"parentProject,omitempty" protobuf:"bytes,20,opt,name=parentProject"`
+}
+
+// SourceRestriction restricts the paths and the target revisions the sources of the applications of a project can use
+// in the repositories matching a pattern. Like the source repositories of the project, the patterns prefixed with !
+// deny the matching values
+type SourceRestriction struct {
+	// RepoURL is a glob pattern of the URLs of the repositories the restriction applies to, all the repositories if empty
+	RepoURL string `json:"repoURL,omitempty" protobuf:"bytes,1,opt,name=repoURL"`
+	// Paths are glob patterns of the paths the sources can use, any path if empty
+	Paths []string `json:"paths,omitempty" protobuf:"bytes,2,rep,name=paths"`
+	// TargetRevisions are glob patterns of the target revisions the sources can use, e.g. release/*, any revision if empty
+	TargetRevisions []string `json:"targetRevisions,omitempty" protobuf:"bytes,3,rep,name=targetRevisions"`
+}
+
+// ProjectQuota limits the applications of a project and the resources and clusters they manage. A zero limit means
+// unlimited
+type ProjectQuota struct {
+	// MaxApplications is the maximum number of applications of the project
+	MaxApplications int64 `json:"maxApplications,omitempty" protobuf:"varint,1,opt,name=maxApplications"`
+	// MaxResources is the maximum total number of resources managed by the applications of the project. Applications
+	// can't be added to the project once it is reached
+	MaxResources int64 `json:"maxResources,omitempty" protobuf:"varint,2,opt,name=maxResources"`
+	// MaxClusters is the maximum number of clusters the applications of the project are deployed to
+	MaxClusters int64 `json:"maxClusters,omitempty" protobuf:"varint,3,opt,name=maxClusters"`
+}
+
+// ProjectQuotaUsage is the usage of the quota of a project by its applications
+type ProjectQuotaUsage struct {
+	// Applications is the number of applications of the project
+	Applications int64 `json:"applications" protobuf:"varint,1,opt,name=applications"`
+	// Resources is the total number of resources managed by the applications of the project
+	Resources int64 `json:"resources" protobuf:"varint,2,opt,name=resources"`
+	// Clusters is the number of clusters the applications of the project are deployed to
+	Clusters int64 `json:"clusters" protobuf:"varint,3,opt,name=clusters"`
+}
+
+// ProjectSyncPolicy holds the sync defaults inherited by the applications of a project and the restrictions enforced on
+// their syncs, so that guardrails can be set without changing every application
+type ProjectSyncPolicy struct {
+	// SyncOptions are the default sync options of the applications of the project. An option of an application overrides
+	// the default option with the same name, e.g. ServerSideApply=false overrides ServerSideApply=true
+	SyncOptions SyncOptions `json:"syncOptions,omitempty" protobuf:"bytes,1,opt,name=syncOptions"`
+	// Retry is the retry strategy of the applications of the project which don't define one
+	Retry *RetryStrategy `json:"retry,omitempty" protobuf:"bytes,2,opt,name=retry"`
+	// DeniedSyncOptions are the sync options the applications of the project can't use, e.g. Replace=true
+	DeniedSyncOptions []string `json:"deniedSyncOptions,omitempty" protobuf:"bytes,3,rep,name=deniedSyncOptions"`
+	// DisableAutomatedPrune prevents the automated syncs of the applications of the project from pruning resources
+	DisableAutomatedPrune bool `json:"disableAutomatedPrune,omitempty" protobuf:"bytes,4,opt,name=disableAutomatedPrune"`
+	// DisableSelfHeal prevents the applications of the project from enabling self-heal
+	DisableSelfHeal bool `json:"disableSelfHeal,omitempty" protobuf:"bytes,5,opt,name=disableSelfHeal"`
+}
+
+const (
+	// ManifestGenerationWebhookFailurePolicyFail fails the manifest generation when the webhook can't be called
+	ManifestGenerationWebhookFailurePolicyFail = "Fail"
+	// ManifestGenerationWebhookFailurePolicyIgnore ignores the webhook when it can't be called
+	ManifestGenerationWebhookFailurePolicyIgnore = "Ignore"
+
+	// DefaultManifestGenerationWebhookTimeout is the timeout of the calls to a webhook which has no timeout
+	DefaultManifestGenerationWebhookTimeout = 10 * time.Second
+)
+
+// ManifestGenerationWebhook is an HTTPS endpoint the rendered manifests are posted to before being cached, which may
+// mutate or reject them
+type ManifestGenerationWebhook struct {
+	// Name identifies the webhook in the errors and the logs
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// URL is the HTTPS URL the manifests are posted to
+	URL string `json:"url" protobuf:"bytes,2,opt,name=url"`
+	// Timeout is the timeout of the calls to the webhook, e.g. 5s, defaults to 10s
+	Timeout string `json:"timeout,omitempty" protobuf:"bytes,3,opt,name=timeout"`
+	// FailurePolicy is either Fail (the default) to fail the manifest generation, or Ignore to ignore the webhook, when
+	// the webhook can't be called or returns an invalid response
+	FailurePolicy string `json:"failurePolicy,omitempty" protobuf:"bytes,4,opt,name=failurePolicy"`
+	// CAData contains the PEM encoded certificates of the authorities used to verify the certificate of the webhook,
+	// in addition to the system ones
+	CAData string `json:"caData,omitempty" protobuf:"bytes,5,opt,name=caData"`
+}
+
+// GetTimeout returns the timeout of the calls to the webhook
+func (w *ManifestGenerationWebhook) GetTimeout() (time.Duration, error) {
+	if w.Timeout == "" {
+		return DefaultManifestGenerationWebhookTimeout, nil
+	}
+	return time.ParseDuration(w.Timeout)
+}
+
+// IsFailureIgnored returns true if the webhook is ignored when it can't be called
+func (w *ManifestGenerationWebhook) IsFailureIgnored() bool {
+	return w.FailurePolicy == ManifestGenerationWebhookFailurePolicyIgnore
+}
+
+// Validate checks that the webhook is correctly configured
+func (w *ManifestGenerationWebhook) Validate() error {
+	if w.Name == "" {
+		return errors.New("name is required")
+	}
+	u, err := url.Parse(w.URL)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("url '%s' must be an HTTPS URL", w.URL)
+	}
+	if timeout, err := w.GetTimeout(); err != nil || timeout <= 0 {
+		return fmt.Errorf("timeout '%s' must be a positive duration", w.Timeout)
+	}
+	switch w.FailurePolicy {
+	case "", ManifestGenerationWebhookFailurePolicyFail, ManifestGenerationWebhookFailurePolicyIgnore:
+	default:
+		return fmt.Errorf("failurePolicy '%s' must be either %s or %s", w.FailurePolicy, ManifestGenerationWebhookFailurePolicyFail, ManifestGenerationWebhookFailurePolicyIgnore)
+	}
+	return nil
+}
+
+// ApplicationResourceQuota limits the number of resources and the size of the manifests rendered by an application
+type ApplicationResourceQuota struct {
+	// MaxResources is the maximum number of resources rendered by an application, unlimited if zero
+	MaxResources int64 `json:"maxResources,omitempty" protobuf:"varint,1,opt,name=maxResources"`
+	// MaxManifestsSize is the maximum total size of the manifests rendered by an application, e.g. 10Mi, unlimited if unset
+	MaxManifestsSize *resource.Quantity `json:"maxManifestsSize,omitempty" protobuf:"bytes,2,opt,name=maxManifestsSize"`
+}
+
+// GetMaxManifestsSize returns the maximum total size in bytes of the manifests rendered by an application, zero if
+// unlimited
+func (q *ApplicationResourceQuota) GetMaxManifestsSize() int64 {
+	if q == nil || q.MaxManifestsSize == nil {
+		return 0
+	}
+	return q.MaxManifestsSize.Value()
+}
+
+// CheckManifests returns an error if the manifests rendered by an application exceed the quota
+func (q *ApplicationResourceQuota) CheckManifests(manifests []string) error {
+	if q == nil {
+		return nil
+	}
+	if q.MaxResources > 0 && int64(len(manifests)) > q.MaxResources {
+		return fmt.Errorf("application renders %d resources, exceeding the maximum of %d resources", len(manifests), q.MaxResources)
+	}
+	if maxSize := q.GetMaxManifestsSize(); maxSize > 0 {
+		var size int64
+		for _, manifest := range manifests {
+			size += int64(len(manifest))
+		}
+		if size > maxSize {
+			return fmt.Errorf("application manifests total %d bytes, exceeding the maximum size of %s", size, q.MaxManifestsSize.String())
+		}
+	}
+	return nil
 }
 
 // SyncWindows is a collection of sync windows in this project
@@ -2788,6 +3439,39 @@ func (spec *AppProjectSpec) DeleteWindow(id int) error {
 	return nil
 }
 
+// AddSyncWindow adds a sync window with the given parameters to the cluster. Without applications and namespaces the
+// window applies to all the applications deployed to the cluster.
+func (c *Cluster) AddSyncWindow(knd string, sch string, dur string, app []string, ns []string, ms bool, timeZone string, andOperator bool) error {
+	window := &SyncWindow{
+		Kind:           knd,
+		Schedule:       sch,
+		Duration:       dur,
+		ManualSync:     ms,
+		TimeZone:       timeZone,
+		UseAndOperator: andOperator,
+	}
+	if len(app) > 0 {
+		window.Applications = app
+	}
+	if len(ns) > 0 {
+		window.Namespaces = ns
+	}
+	if err := window.Validate(); err != nil {
+		return err
+	}
+	c.SyncWindows = append(c.SyncWindows, window)
+	return nil
+}
+
+// DeleteSyncWindow deletes the sync window with the given id from the cluster
+func (c *Cluster) DeleteSyncWindow(id int) error {
+	if id < 0 || id >= len(c.SyncWindows) {
+		return fmt.Errorf("window with id '%d' not found", id)
+	}
+	c.SyncWindows = append(c.SyncWindows[:id], c.SyncWindows[id+1:]...)
+	return nil
+}
+
 // Matches returns a list of sync windows that are defined for a given application
 // It will use the AND operator if the UseAndOperator is set to true otherwise will default to the OR operator
 func (w *SyncWindows) Matches(app *Application) *SyncWindows {
@@ -2864,6 +3548,59 @@ func (w *SyncWindows) Matches(app *Application) *SyncWindows {
 	return nil
 }
 
+// MatchesWithCluster returns the sync windows of the project and of the destination cluster that are defined for the
+// given application. A cluster window without applications and namespaces applies to all the applications deployed to
+// the cluster. Use CanSyncWithCluster to check whether the windows allow a sync, since the cluster windows are
+// evaluated separately.
+func (w *SyncWindows) MatchesWithCluster(app *Application, cluster *Cluster) *SyncWindows {
+	windows := w.Matches(app)
+	clusterWindows := cluster.matchingSyncWindows(app)
+	if !clusterWindows.HasWindows() {
+		return windows
+	}
+	var matchingWindows SyncWindows
+	if windows != nil {
+		matchingWindows = append(matchingWindows, *windows...)
+	}
+	matchingWindows = append(matchingWindows, *clusterWindows...)
+	return &matchingWindows
+}
+
+// CanSyncWithCluster returns true if both the sync windows of the project and the sync windows of the destination
+// cluster which are defined for the given application currently allow a sync. The windows of the cluster are evaluated
+// separately, so that a project allow window does not override a cluster deny window.
+func (w *SyncWindows) CanSyncWithCluster(app *Application, cluster *Cluster, isManual bool) (bool, error) {
+	canSync, err := w.Matches(app).CanSync(isManual)
+	if err != nil || !canSync {
+		return canSync, err
+	}
+	return cluster.matchingSyncWindows(app).CanSync(isManual)
+}
+
+// matchingSyncWindows returns the sync windows of the cluster that are defined for the given application
+func (c *Cluster) matchingSyncWindows(app *Application) *SyncWindows {
+	if c == nil || !c.SyncWindows.HasWindows() {
+		return nil
+	}
+	var matchingWindows SyncWindows
+	for _, window := range c.SyncWindows {
+		if len(window.Applications) == 0 && len(window.Namespaces) == 0 {
+			matchingWindows = append(matchingWindows, window)
+			continue
+		}
+		// the clusters of a cluster window are irrelevant, it only matches the applications of the cluster
+		clusterWindow := *window
+		clusterWindow.Clusters = nil
+		if (&SyncWindows{&clusterWindow}).Matches(app) != nil {
+			matchingWindows = append(matchingWindows, window)
+		}
+	}
+	if len(matchingWindows) > 0 {
+		return &matchingWindows
+	}
+	return nil
+}
+
 // CanSync returns true if a sync window currently allows a sync. isManual indicates whether the sync has been triggered manually.
 func (w *SyncWindows) CanSync(isManual bool) (bool, error) {
 	if !w.HasWindows() {
@@ -3132,6 +3869,61 @@ func (app *Application) IsRefreshRequested() (RefreshType, bool) {
 	return refreshType, true
 }
 
+// GetIgnoreDifferences returns the ignore differences of the application, including the fields owned by the managers
+// of the argocd.argoproj.io/ignore-managed-fields-managers annotation for all the resources
+func (app *Application) GetIgnoreDifferences() IgnoreDifferences {
+	var managers []string
+	for _, manager := range strings.Split(app.GetAnnotations()[AnnotationKeyIgnoreManagedFieldsManagers], ",") {
+		if manager = strings.TrimSpace(manager); manager != "" {
+			managers = append(managers, manager)
+		}
+	}
+	if len(managers) == 0 {
+		return app.Spec.IgnoreDifferences
+	}
+	ignoreDifferences := make(IgnoreDifferences, 0, len(app.Spec.IgnoreDifferences)+1)
+	ignoreDifferences = append(ignoreDifferences, app.Spec.IgnoreDifferences...)
+	return append(ignoreDifferences, ResourceIgnoreDifferences{Group: "*", Kind: "*", ManagedFieldsManagers: managers})
+}
+
+// GetRefreshResources returns the resources a requested refresh is scoped to, or nil if no refresh is requested or
+// the refresh is not scoped. Malformed resources of the annotation are ignored.
+func (app *Application) GetRefreshResources() []SyncOperationResource {
+	if _, ok := app.IsRefreshRequested(); !ok {
+		return nil
+	}
+	value := app.GetAnnotations()[AnnotationKeyRefreshResources]
+	if value == "" {
+		return nil
+	}
+	var resources []SyncOperationResource
+	for _, item := range strings.Split(value, ",") {
+		resource, err := ParseResourceRef(strings.TrimSpace(item))
+		if err != nil {
+			continue
+		}
+		resources = append(resources, resource)
+	}
+	return resources
+}
+
+// ParseResourceRef parses a resource in the GROUP:KIND:NAME or GROUP:KIND:NAMESPACE/NAME format
+func ParseResourceRef(resource string) (SyncOperationResource, error) {
+	fields := strings.Split(resource, ":")
+	if len(fields) != 3 || fields[1] == "" || fields[2] == "" {
+		return SyncOperationResource{}, fmt.Errorf("resource should have GROUP:KIND:NAME or GROUP:KIND:NAMESPACE/NAME, but instead got: %s", resource)
+	}
+	res := SyncOperationResource{Group: fields[0], Kind: fields[1], Name: fields[2]}
+	if namespace, name, ok := strings.Cut(fields[2], "/"); ok {
+		if name == "" || strings.Contains(name, "/") {
+			return SyncOperationResource{}, fmt.Errorf("resource with namespace should have GROUP:KIND:NAMESPACE/NAME, but instead got: %s", resource)
+		}
+		res.Namespace = namespace
+		res.Name = name
+	}
+	return res, nil
+}
+
 // IsHydrateRequested returns whether hydration has been requested for an application
 func (app *Application) IsHydrateRequested() bool {
 	annotations := app.GetAnnotations()
@@ -3314,6 +4106,9 @@ func (source *ApplicationSource) ExplicitType() (*ApplicationSourceType, error)
 	if source.Plugin != nil {
 		appTypes = append(appTypes, ApplicationSourceTypePlugin)
 	}
+	if source.CUE != nil {
+		appTypes = append(appTypes, ApplicationSourceTypeCUE)
+	}
 	if len(appTypes) == 0 {
 		return nil, nil
 	}
@@ -3344,6 +4139,21 @@ func (spec ApplicationSpec) GetRevisionHistoryLimit() int {
 	return RevisionHistoryLimit
 }
 
+// GetDestinationNamespace returns the destination namespace of a namespace-scoped resource with the given labels which
+// has not set a value for .metadata.namespace
+func (spec ApplicationSpec) GetDestinationNamespace(resourceLabels map[string]string) (string, error) {
+	for _, mapping := range spec.DestinationNamespaces {
+		selector, err := metav1.LabelSelectorAsSelector(&mapping.Selector)
+		if err != nil {
+			return "", fmt.Errorf("invalid selector of destination namespace '%s': %w", mapping.Namespace, err)
+		}
+		if selector.Matches(labels.Set(resourceLabels)) {
+			return mapping.Namespace, nil
+		}
+	}
+	return spec.Destination.Namespace, nil
+}
+
 func isResourceInList(res metav1.GroupKind, list []metav1.GroupKind) bool {
 	for _, item := range list {
 		ok, err := filepath.Match(item.Kind, res.Kind)
@@ -3393,6 +4203,10 @@ func SetK8SConfigDefaults(config *rest.Config) error {
 		Timeout:   K8sTCPTimeout,
 		KeepAlive: K8sTCPKeepAlive,
 	}).DialContext
+	if config.Dial != nil {
+		// e.g. the SSH tunnel of the cluster, which would be ignored by the custom transport otherwise
+		dial = config.Dial
+	}
 	transport := utilnet.SetTransportDefaults(&http.Transport{
 		Proxy:               http.ProxyFromEnvironment,
 		TLSHandshakeTimeout: K8sTLSHandshakeTimeout,
@@ -3421,6 +4235,8 @@ func SetK8SConfigDefaults(config *rest.Config) error {
 	config.Timeout = K8sServerSideTimeout
 
 	config.Transport = tr
+	// the transport wrappers are part of the custom transport, so they must not be applied again
+	config.WrapTransport = nil
 	maxRetries := env.ParseInt64FromEnv(utilhttp.EnvRetryMax, 0, 1, math.MaxInt64)
 	if maxRetries > 0 {
 		backoffDurationMS := env.ParseInt64FromEnv(utilhttp.EnvRetryBaseBackoff, 100, 1, math.MaxInt64)
@@ -3499,6 +4315,17 @@ func (c *Cluster) RawRestConfig() (*rest.Config, error) {
 					InteractiveMode: api.NeverExecInteractiveMode,
 				},
 			}
+		case c.Config.ExternalCredentialsConfig != nil:
+			config = &rest.Config{
+				Host:            c.Server,
+				TLSClientConfig: tlsClientConfig,
+				ExecProvider: &api.ExecConfig{
+					APIVersion:      "client.authentication.k8s.io/v1beta1",
+					Command:         "argocd-k8s-auth",
+					Args:            c.Config.ExternalCredentialsConfig.execArgs(),
+					InteractiveMode: api.NeverExecInteractiveMode,
+				},
+			}
 		case c.Config.ExecProviderConfig != nil:
 			var env []api.ExecEnvVar
 			if c.Config.ExecProviderConfig.Env != nil {
@@ -3539,12 +4366,34 @@ func (c *Cluster) RawRestConfig() (*rest.Config, error) {
 		if err != nil {
 			return nil, fmt.Errorf("unable to create K8s REST config, can`t parse proxy url: %w", err)
 		}
+		if c.Config.ProxyUsername != "" {
+			u.User = url.UserPassword(c.Config.ProxyUsername, c.Config.ProxyPassword)
+		}
 		config.Proxy = http.ProxyURL(u)
 	}
+	if c.Config.SSHTunnelConfig != nil {
+		tunnel, err := c.Config.SSHTunnelConfig.tunnel()
+		if err != nil {
+			return nil, fmt.Errorf("unable to create K8s REST config, invalid SSH tunnel config: %w", err)
+		}
+		config.Dial = tunnel.DialContext
+	}
+	if c.Config.ImpersonationConfig != nil {
+		config.Impersonate = rest.ImpersonationConfig{
+			UserName: c.Config.ImpersonationConfig.Username,
+			Groups:   c.Config.ImpersonationConfig.Groups,
+		}
+	}
 	config.DisableCompression = c.Config.DisableCompression
 	config.Timeout = K8sServerSideTimeout
 	config.QPS = K8sClientConfigQPS
 	config.Burst = K8sClientConfigBurst
+	if c.Config.RateLimitConfig != nil {
+		limiter := c.Config.RateLimitConfig.rateLimiter(c.Server)
+		config.QPS, config.Burst = c.Config.RateLimitConfig.qpsAndBurst()
+		config.RateLimiter = limiter
+		config.Wrap(limiter.WrapTransport)
+	}
 	return config, nil
 }
 
@@ -3558,6 +4407,9 @@ func (c *Cluster) RESTConfig() (*rest.Config, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to apply K8s REST config defaults: %w", err)
 	}
+	if c.Config.RateLimitConfig != nil {
+		config.QPS, config.Burst = c.Config.RateLimitConfig.qpsAndBurst()
+	}
 	return config, nil
 }
 
@@ -1,24 +1,34 @@
 package v1alpha1
 
 import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
 	"encoding/json"
+	"encoding/pem"
 	"errors"
 	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
 	"k8s.io/utils/ptr"
 
 	argocdcommon "github.com/argoproj/argo-cd/v3/common"
 
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/rest"
 )
 
 func TestAppProject_IsSourcePermitted(t *testing.T) {
@@ -827,6 +837,52 @@ func TestAppProject_ValidateSyncWindowList(t *testing.T) {
 	})
 }
 
+func TestAppProject_ValidateManifestGenerationWebhooks(t *testing.T) {
+	p := newTestProject()
+	p.Spec.ManifestGenerationWebhooks = []ManifestGenerationWebhook{{Name: "policy", URL: "https://policy.example.com/review", Timeout: "5s", FailurePolicy: ManifestGenerationWebhookFailurePolicyIgnore}}
+	require.NoError(t, p.ValidateProject())
+
+	badWebhooks := []struct {
+		webhook ManifestGenerationWebhook
+		errmsg  string
+	}{
+		{ManifestGenerationWebhook{URL: "https://policy.example.com"}, "name is required"},
+		{ManifestGenerationWebhook{Name: "policy", URL: "http://policy.example.com"}, "must be an HTTPS URL"},
+		{ManifestGenerationWebhook{Name: "policy", URL: "https://policy.example.com", Timeout: "5"}, "must be a positive duration"},
+		{ManifestGenerationWebhook{Name: "policy", URL: "https://policy.example.com", FailurePolicy: "Retry"}, "must be either Fail or Ignore"},
+	}
+	for _, bad := range badWebhooks {
+		p.Spec.ManifestGenerationWebhooks = []ManifestGenerationWebhook{bad.webhook}
+		assert.ErrorContains(t, p.ValidateProject(), bad.errmsg)
+	}
+
+	p.Spec.ManifestGenerationWebhooks = []ManifestGenerationWebhook{
+		{Name: "policy", URL: "https://policy.example.com"},
+		{Name: "policy", URL: "https://other.example.com"},
+	}
+	require.ErrorContains(t, p.ValidateProject(), "manifest generation webhook 'policy' already added")
+}
+
+func TestAppProject_GetSignatureKeys(t *testing.T) {
+	p := newTestProject()
+	p.Spec.SignatureKeys = []SignatureKey{
+		{KeyID: "4AEE18F83AFDEB23"},
+		{KeyID: "SHA256:deAWW1bT8yqjG55QrNOzkLJwMPF0J9yDb+nJO+N/leQ", Paths: []string{"clusters/prod/**"}},
+	}
+	require.NoError(t, p.ValidateProject())
+	assert.Len(t, p.GetSignatureKeys("clusters/prod/guestbook"), 2)
+	assert.Len(t, p.GetSignatureKeys("./clusters/prod/guestbook/"), 2)
+	assert.Len(t, p.GetSignatureKeys("clusters/staging/guestbook"), 1)
+
+	p.Spec.SignatureKeys = p.Spec.SignatureKeys[1:]
+	assert.True(t, p.IsSignatureRequired("/clusters/prod/guestbook"))
+	assert.False(t, p.IsSignatureRequired("clusters/staging/guestbook"))
+	assert.False(t, p.IsSignatureRequired(""))
+
+	p.Spec.SignatureKeys[0].Paths = []string{"clusters/[prod"}
+	require.ErrorContains(t, p.ValidateProject(), "has an invalid path pattern 'clusters/[prod'")
+}
+
 // TestInvalidPolicyRules checks various errors in policy rules
 func TestAppProject_InvalidPolicyRules(t *testing.T) {
 	p := newTestProject()
@@ -1326,6 +1382,8 @@ func TestRepository_CopySettingsFrom(t *testing.T) {
 		{"TestNil", nil, Repository{}},
 		{"TestHasRepo", &Repository{Repo: "foo"}, Repository{}},
 		{"TestHasEnableLFS", &Repository{EnableLFS: true}, Repository{EnableLFS: true}},
+		{"TestHasDepth", &Repository{Depth: 1}, Repository{Depth: 1}},
+		{"TestHasFilter", &Repository{Filter: "blob:none"}, Repository{Filter: "blob:none"}},
 		{"TestHasInsecure", &Repository{Insecure: true}, Repository{Insecure: true}},
 		{"TestHasInsecureIgnoreHostKey", &Repository{InsecureIgnoreHostKey: true}, Repository{InsecureIgnoreHostKey: true}},
 	}
@@ -1465,6 +1523,25 @@ func TestApplicationSource_IsZero(t *testing.T) {
 	}
 }
 
+func TestApplicationSource_IsOCI(t *testing.T) {
+	tests := []struct {
+		name   string
+		source *ApplicationSource
+		want   bool
+	}{
+		{"Nil", nil, false},
+		{"Git", &ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps"}, false},
+		{"HelmOCI", &ApplicationSource{RepoURL: "ghcr.io/my-org", Chart: "my-chart"}, false},
+		{"HelmOCIWithScheme", &ApplicationSource{RepoURL: "oci://ghcr.io/my-org", Chart: "my-chart"}, false},
+		{"OCI", &ApplicationSource{RepoURL: "oci://ghcr.io/my-org/my-manifests"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.source.IsOCI())
+		})
+	}
+}
+
 func TestApplicationSourceHelm_AddParameter(t *testing.T) {
 	src := ApplicationSourceHelm{}
 	t.Run("Add", func(t *testing.T) {
@@ -2347,6 +2424,83 @@ func TestSyncWindows_Matches(t *testing.T) {
 	})
 }
 
+func TestSyncWindows_MatchesWithCluster(t *testing.T) {
+	app := newTestApp()
+	projectWindow := &SyncWindow{Kind: "allow", Schedule: "* * * * *", Duration: "1h", Applications: []string{"test-app"}}
+	projectWindows := SyncWindows{projectWindow}
+	allAppsWindow := &SyncWindow{Kind: "deny", Schedule: "0 22 * * *", Duration: "8h", Clusters: []string{"ignored"}}
+	matchingWindow := &SyncWindow{Kind: "deny", Schedule: "0 22 * * *", Duration: "8h", Namespaces: []string{"def*"}}
+	otherWindow := &SyncWindow{Kind: "deny", Schedule: "0 22 * * *", Duration: "8h", Applications: []string{"other-app"}, Clusters: []string{"cluster1"}}
+	cluster := &Cluster{Server: "cluster1", SyncWindows: SyncWindows{allAppsWindow, matchingWindow, otherWindow}}
+
+	t.Run("ProjectAndCluster", func(t *testing.T) {
+		windows := projectWindows.MatchesWithCluster(app, cluster)
+		assert.Equal(t, &SyncWindows{projectWindow, allAppsWindow, matchingWindow}, windows)
+	})
+	t.Run("ClusterOnly", func(t *testing.T) {
+		var windows SyncWindows
+		assert.Equal(t, &SyncWindows{allAppsWindow, matchingWindow}, windows.MatchesWithCluster(app, cluster))
+	})
+	t.Run("NoCluster", func(t *testing.T) {
+		assert.Equal(t, &SyncWindows{projectWindow}, projectWindows.MatchesWithCluster(app, nil))
+		var windows SyncWindows
+		assert.Nil(t, windows.MatchesWithCluster(app, &Cluster{Server: "cluster1"}))
+	})
+}
+
+func TestSyncWindows_CanSyncWithCluster(t *testing.T) {
+	app := newTestApp()
+	projectAllow := &SyncWindow{Kind: "allow", Schedule: "* * * * *", Duration: "1h", Applications: []string{"test-app"}}
+	projectWindows := SyncWindows{projectAllow}
+
+	t.Run("ClusterDenyOverridesProjectAllow", func(t *testing.T) {
+		cluster := &Cluster{SyncWindows: SyncWindows{{Kind: "deny", Schedule: "* * * * *", Duration: "1h"}}}
+		canSync, err := projectWindows.CanSyncWithCluster(app, cluster, false)
+		require.NoError(t, err)
+		assert.False(t, canSync)
+	})
+	t.Run("InactiveClusterAllowOverridesProjectAllow", func(t *testing.T) {
+		cluster := &Cluster{SyncWindows: SyncWindows{{Kind: "allow", Schedule: "0 0 1 1 *", Duration: "1m"}}}
+		canSync, err := projectWindows.CanSyncWithCluster(app, cluster, false)
+		require.NoError(t, err)
+		assert.False(t, canSync)
+	})
+	t.Run("ClusterAllowDoesNotOverrideProjectDeny", func(t *testing.T) {
+		projectDeny := SyncWindows{{Kind: "deny", Schedule: "* * * * *", Duration: "1h", Applications: []string{"test-app"}}}
+		cluster := &Cluster{SyncWindows: SyncWindows{{Kind: "allow", Schedule: "* * * * *", Duration: "1h"}}}
+		canSync, err := projectDeny.CanSyncWithCluster(app, cluster, false)
+		require.NoError(t, err)
+		assert.False(t, canSync)
+	})
+	t.Run("ManualSyncAllowedByClusterDeny", func(t *testing.T) {
+		cluster := &Cluster{SyncWindows: SyncWindows{{Kind: "deny", Schedule: "* * * * *", Duration: "1h", ManualSync: true}}}
+		canSync, err := projectWindows.CanSyncWithCluster(app, cluster, true)
+		require.NoError(t, err)
+		assert.True(t, canSync)
+	})
+	t.Run("NoCluster", func(t *testing.T) {
+		canSync, err := projectWindows.CanSyncWithCluster(app, nil, false)
+		require.NoError(t, err)
+		assert.True(t, canSync)
+	})
+}
+
+func TestCluster_SyncWindows(t *testing.T) {
+	cluster := &Cluster{Server: "cluster1"}
+	require.NoError(t, cluster.AddSyncWindow("deny", "0 22 * * *", "8h", nil, []string{"prod-*"}, true, "", false))
+	require.NoError(t, cluster.AddSyncWindow("allow", "0 8 * * 1-5", "10h", []string{"app"}, nil, false, "Europe/Amsterdam", false))
+	require.EqualError(t, cluster.AddSyncWindow("block", "0 22 * * *", "8h", nil, nil, false, "", false), "kind 'block' mismatch: can only be allow or deny")
+	assert.Equal(t, SyncWindows{
+		{Kind: "deny", Schedule: "0 22 * * *", Duration: "8h", Namespaces: []string{"prod-*"}, ManualSync: true, TimeZone: "UTC"},
+		{Kind: "allow", Schedule: "0 8 * * 1-5", Duration: "10h", Applications: []string{"app"}, TimeZone: "Europe/Amsterdam"},
+	}, cluster.SyncWindows)
+
+	require.EqualError(t, cluster.DeleteSyncWindow(2), "window with id '2' not found")
+	require.NoError(t, cluster.DeleteSyncWindow(0))
+	require.Len(t, cluster.SyncWindows, 1)
+	assert.Equal(t, "allow", cluster.SyncWindows[0].Kind)
+}
+
 func TestSyncWindows_Matches_AND_Operator(t *testing.T) {
 	proj := newTestProjectWithSyncWindowsAndOperator()
 	app := newTestApp()
@@ -3343,6 +3497,244 @@ func TestSyncPolicy_IsZero(t *testing.T) {
 	assert.False(t, (&SyncPolicy{Automated: &SyncPolicyAutomated{}}).IsZero())
 	assert.False(t, (&SyncPolicy{SyncOptions: SyncOptions{""}}).IsZero())
 	assert.False(t, (&SyncPolicy{Retry: &RetryStrategy{}}).IsZero())
+	assert.False(t, (&SyncPolicy{ReconcileInterval: "5m"}).IsZero())
+	assert.False(t, (&SyncPolicy{Priority: ApplicationPriorityHigh}).IsZero())
+}
+
+func TestSyncPolicy_GetReconcileInterval(t *testing.T) {
+	var nilPolicy *SyncPolicy
+	interval, err := nilPolicy.GetReconcileInterval()
+	require.NoError(t, err)
+	assert.Zero(t, interval)
+
+	interval, err = (&SyncPolicy{ReconcileInterval: "5m"}).GetReconcileInterval()
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, interval)
+
+	_, err = (&SyncPolicy{ReconcileInterval: "later"}).GetReconcileInterval()
+	require.ErrorContains(t, err, "invalid reconcile interval 'later'")
+	_, err = (&SyncPolicy{ReconcileInterval: "-1m"}).GetReconcileInterval()
+	require.ErrorContains(t, err, "must be positive")
+}
+
+func TestSyncPolicy_GetPriority(t *testing.T) {
+	var nilPolicy *SyncPolicy
+	priority, err := nilPolicy.GetPriority()
+	require.NoError(t, err)
+	assert.Equal(t, ApplicationPriorityNormal, priority)
+
+	priority, err = (&SyncPolicy{Priority: ApplicationPriorityHigh}).GetPriority()
+	require.NoError(t, err)
+	assert.Equal(t, ApplicationPriorityHigh, priority)
+
+	priority, err = (&SyncPolicy{Priority: "urgent"}).GetPriority()
+	require.ErrorContains(t, err, "invalid priority 'urgent'")
+	assert.Equal(t, ApplicationPriorityNormal, priority)
+}
+
+func TestApplicationResourceQuota_CheckManifests(t *testing.T) {
+	manifests := []string{`{"kind":"ConfigMap"}`, `{"kind":"Secret"}`}
+
+	var nilQuota *ApplicationResourceQuota
+	require.NoError(t, nilQuota.CheckManifests(manifests))
+	require.NoError(t, (&ApplicationResourceQuota{}).CheckManifests(manifests))
+	require.NoError(t, (&ApplicationResourceQuota{MaxResources: 2}).CheckManifests(manifests))
+	require.ErrorContains(t, (&ApplicationResourceQuota{MaxResources: 1}).CheckManifests(manifests), "application renders 2 resources, exceeding the maximum of 1 resources")
+	require.NoError(t, (&ApplicationResourceQuota{MaxManifestsSize: ptr.To(resource.MustParse("1Ki"))}).CheckManifests(manifests))
+	require.ErrorContains(t, (&ApplicationResourceQuota{MaxManifestsSize: ptr.To(resource.MustParse("20"))}).CheckManifests(manifests), "application manifests total 37 bytes, exceeding the maximum size of 20")
+}
+
+func TestAppProject_GetApplicationResourceQuota(t *testing.T) {
+	global := &ApplicationResourceQuota{MaxResources: 100, MaxManifestsSize: ptr.To(resource.MustParse("1Mi"))}
+
+	proj := &AppProject{}
+	assert.Equal(t, &ApplicationResourceQuota{}, proj.GetApplicationResourceQuota(nil))
+	assert.Equal(t, global, proj.GetApplicationResourceQuota(global))
+
+	proj.Spec.ApplicationResourceQuota = &ApplicationResourceQuota{MaxResources: 10}
+	quota := proj.GetApplicationResourceQuota(global)
+	assert.Equal(t, int64(10), quota.MaxResources)
+	assert.Equal(t, int64(1024*1024), quota.GetMaxManifestsSize())
+	assert.Equal(t, int64(100), global.MaxResources)
+}
+
+func TestAppProject_CheckSourceRestrictions(t *testing.T) {
+	proj := newTestProject()
+	source := ApplicationSource{RepoURL: "https://github.com/argoproj/prod-apps.git", Path: "apps/guestbook", TargetRevision: "release/1.0"}
+	require.NoError(t, proj.CheckSourceRestrictions(source, ""))
+
+	proj.Spec.SourceRestrictions = []SourceRestriction{
+		{RepoURL: "https://github.com/argoproj/prod-*", Paths: []string{"/apps/*", "!apps/experimental"}, TargetRevisions: []string{"release/*"}},
+		{TargetRevisions: []string{"!feature/*"}},
+	}
+	require.NoError(t, proj.ValidateProject())
+	require.NoError(t, proj.CheckSourceRestrictions(source, ""))
+	require.ErrorContains(t, proj.CheckSourceRestrictions(source, "main"), "target revision main of repo https://github.com/argoproj/prod-apps.git is not permitted in project 'my-proj'")
+
+	source.Path = "apps/experimental"
+	require.ErrorContains(t, proj.CheckSourceRestrictions(source, ""), "path apps/experimental of repo https://github.com/argoproj/prod-apps.git is not permitted in project 'my-proj'")
+	source.Path = "clusters/prod"
+	require.ErrorContains(t, proj.CheckSourceRestrictions(source, ""), "path clusters/prod")
+	require.ErrorContains(t, proj.CheckSourcePathRestrictions(source), "path clusters/prod")
+	source.Path = "apps/guestbook"
+	require.NoError(t, proj.CheckSourcePathRestrictions(source))
+
+	// the other repositories are only restricted by the second restriction
+	other := ApplicationSource{RepoURL: "https://github.com/argoproj/dev-apps.git", Path: "clusters/dev"}
+	require.NoError(t, proj.CheckSourceRestrictions(other, ""))
+	require.ErrorContains(t, proj.CheckSourceRestrictions(other, "feature/new"), "target revision feature/new")
+
+	// Helm chart sources have no path
+	chart := ApplicationSource{RepoURL: "https://github.com/argoproj/prod-charts", Chart: "guestbook", TargetRevision: "release/2.0"}
+	require.NoError(t, proj.CheckSourceRestrictions(chart, ""))
+
+	proj.Spec.SourceRestrictions = []SourceRestriction{{Paths: []string{"apps/[a-"}}}
+	require.ErrorContains(t, proj.ValidateProject(), "source restriction has an invalid pattern 'apps/[a-'")
+}
+
+func TestAppProject_WithParentProjects(t *testing.T) {
+	org := &AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "org"},
+		Spec: AppProjectSpec{
+			SourceRepos:                []string{"https://github.com/org/*"},
+			Destinations:               []ApplicationDestination{{Server: "https://prod", Namespace: "shared"}},
+			NamespaceResourceWhitelist: []metav1.GroupKind{{Group: "", Kind: "ConfigMap"}},
+			Roles: []ProjectRole{
+				{Name: "viewer", Groups: []string{"org:all"}, Policies: []string{"p, proj:org:viewer, applications, get, org/*, allow"}},
+				{Name: "admin", Policies: []string{"p, proj:org:admin, applications, *, org/*, allow"}},
+			},
+		},
+	}
+	team := &AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "team"},
+		Spec: AppProjectSpec{
+			ParentProject: "org",
+			SourceRepos:   []string{"https://github.com/team/*"},
+			Destinations:  []ApplicationDestination{{Server: "https://prod", Namespace: "team-*"}},
+			Roles: []ProjectRole{
+				{Name: "admin", Groups: []string{"org:team"}, Policies: []string{"p, proj:team:admin, applications, *, team/*, allow"}},
+			},
+		},
+	}
+	projects := map[string]*AppProject{"org": org, "team": team}
+	getProject := func(name string) (*AppProject, error) {
+		if proj, ok := projects[name]; ok {
+			return proj, nil
+		}
+		return nil, fmt.Errorf("project %s not found", name)
+	}
+
+	proj, err := team.WithParentProjects(getProject)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://github.com/team/*", "https://github.com/org/*"}, proj.Spec.SourceRepos)
+	assert.Equal(t, []ApplicationDestination{{Server: "https://prod", Namespace: "team-*"}, {Server: "https://prod", Namespace: "shared"}}, proj.Spec.Destinations)
+	assert.Equal(t, []metav1.GroupKind{{Group: "", Kind: "ConfigMap"}}, proj.Spec.NamespaceResourceWhitelist)
+	// the admin role of the project overrides the role of the parent, and the inherited role applies to the project
+	assert.Equal(t, []ProjectRole{
+		{Name: "admin", Groups: []string{"org:team"}, Policies: []string{"p, proj:team:admin, applications, *, team/*, allow"}},
+		{Name: "viewer", Groups: []string{"org:all"}, Policies: []string{"p, proj:team:viewer, applications, get, team/*, allow"}},
+	}, proj.Spec.Roles)
+	require.NoError(t, proj.ValidateProject())
+	// the project itself is not modified
+	assert.Len(t, team.Spec.Roles, 1)
+
+	team.Spec.ParentProject = "missing"
+	_, err = team.WithParentProjects(getProject)
+	require.ErrorContains(t, err, "error getting parent project 'missing'")
+
+	team.Spec.ParentProject = "org"
+	org.Spec.ParentProject = "team"
+	_, err = team.WithParentProjects(getProject)
+	require.EqualError(t, err, "the parent projects of project 'team' form a cycle through project 'team'")
+
+	org.Spec.ParentProject = "org"
+	require.EqualError(t, org.ValidateProject(), "rpc error: code = InvalidArgument desc = project can't be its own parent project")
+}
+
+func TestAppProject_CheckQuota(t *testing.T) {
+	newApp := func(name, project, server string, resources int) *Application {
+		app := &Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+			Spec:       ApplicationSpec{Project: project, Destination: ApplicationDestination{Server: server, Namespace: "default"}},
+		}
+		for i := 0; i < resources; i++ {
+			app.Status.Resources = append(app.Status.Resources, ResourceStatus{Kind: "ConfigMap", Name: fmt.Sprintf("cm-%d", i)})
+		}
+		return app
+	}
+	apps := []*Application{
+		newApp("app1", "my-proj", "https://cluster-1", 3),
+		newApp("app2", "my-proj", "https://cluster-2", 2),
+		newApp("other", "other-proj", "https://cluster-3", 10),
+	}
+
+	proj := newTestProject()
+	assert.Equal(t, &ProjectQuotaUsage{Applications: 2, Resources: 5, Clusters: 2}, proj.GetQuotaUsage(apps))
+	require.NoError(t, proj.CheckQuota(apps, newApp("app3", "my-proj", "https://cluster-3", 0)))
+
+	proj.Spec.Quota = &ProjectQuota{MaxApplications: 2}
+	require.EqualError(t, proj.CheckQuota(apps, newApp("app3", "my-proj", "https://cluster-1", 0)), "project 'my-proj' has reached its quota of 2 applications")
+	// the applications already in the project can be updated
+	require.NoError(t, proj.CheckQuota(apps, newApp("app1", "my-proj", "https://cluster-1", 0)))
+	// an application moved to the project counts as a new application
+	require.Error(t, proj.CheckQuota(apps, newApp("other", "my-proj", "https://cluster-3", 0)))
+
+	proj.Spec.Quota = &ProjectQuota{MaxResources: 5}
+	require.EqualError(t, proj.CheckQuota(apps, newApp("app3", "my-proj", "https://cluster-1", 0)), "project 'my-proj' has reached its quota of 5 resources")
+	require.NoError(t, proj.CheckQuota(apps, newApp("app2", "my-proj", "https://cluster-2", 2)))
+
+	proj.Spec.Quota = &ProjectQuota{MaxClusters: 2}
+	require.NoError(t, proj.CheckQuota(apps, newApp("app3", "my-proj", "https://cluster-2", 0)))
+	require.EqualError(t, proj.CheckQuota(apps, newApp("app3", "my-proj", "https://cluster-3", 0)), "project 'my-proj' has reached its quota of 2 clusters")
+	// moving the only application of a cluster to another cluster doesn't change the number of clusters
+	require.NoError(t, proj.CheckQuota(apps, newApp("app2", "my-proj", "https://cluster-3", 0)))
+
+	proj.Spec.Quota = &ProjectQuota{MaxClusters: -1}
+	require.EqualError(t, proj.ValidateProject(), "rpc error: code = InvalidArgument desc = quota limits can't be negative")
+}
+
+func TestAppProject_GetEffectiveSyncPolicy(t *testing.T) {
+	appPolicy := &SyncPolicy{
+		Automated:   &SyncPolicyAutomated{Prune: true, SelfHeal: true},
+		SyncOptions: SyncOptions{"ServerSideApply=false", "Replace=true"},
+	}
+
+	proj := &AppProject{}
+	assert.Same(t, appPolicy, proj.GetEffectiveSyncPolicy(appPolicy))
+
+	proj.Spec.SyncPolicy = &ProjectSyncPolicy{
+		SyncOptions:           SyncOptions{"ServerSideApply=true", "CreateNamespace=true"},
+		Retry:                 &RetryStrategy{Limit: 3},
+		DeniedSyncOptions:     []string{"Replace=true"},
+		DisableAutomatedPrune: true,
+	}
+	effective := proj.GetEffectiveSyncPolicy(appPolicy)
+	assert.Equal(t, SyncOptions{"CreateNamespace=true", "ServerSideApply=false"}, effective.SyncOptions)
+	assert.Equal(t, &RetryStrategy{Limit: 3}, effective.Retry)
+	assert.False(t, effective.Automated.Prune)
+	assert.True(t, effective.Automated.SelfHeal)
+	assert.True(t, appPolicy.Automated.Prune)
+
+	appPolicy.Retry = &RetryStrategy{Limit: 1}
+	assert.Equal(t, &RetryStrategy{Limit: 1}, proj.GetEffectiveSyncPolicy(appPolicy).Retry)
+	assert.Nil(t, proj.GetEffectiveSyncPolicy(nil))
+}
+
+func TestAppProject_ValidateSyncPolicy(t *testing.T) {
+	proj := newTestProject()
+	require.NoError(t, proj.ValidateSyncPolicy(&SyncPolicy{SyncOptions: SyncOptions{"Replace=true"}}))
+
+	proj.Spec.SyncPolicy = &ProjectSyncPolicy{DeniedSyncOptions: []string{"Replace=true"}, DisableSelfHeal: true}
+	require.NoError(t, proj.ValidateSyncPolicy(nil))
+	require.NoError(t, proj.ValidateSyncPolicy(&SyncPolicy{Automated: &SyncPolicyAutomated{Prune: true}, SyncOptions: SyncOptions{"Replace=false"}}))
+	require.ErrorContains(t, proj.ValidateSyncPolicy(&SyncPolicy{SyncOptions: SyncOptions{"Replace=true"}}), "sync option Replace=true is denied in project 'my-proj'")
+	require.ErrorContains(t, proj.ValidateSyncPolicy(&SyncPolicy{Automated: &SyncPolicyAutomated{SelfHeal: true}}), "self-heal is disabled in project 'my-proj'")
+
+	require.NoError(t, proj.ValidateProject())
+	proj.Spec.SyncPolicy.SyncOptions = SyncOptions{"Replace=true"}
+	require.ErrorContains(t, proj.ValidateProject(), "default sync option 'Replace=true' is also denied")
+	proj.Spec.SyncPolicy.SyncOptions = SyncOptions{"Prune"}
+	require.ErrorContains(t, proj.ValidateProject(), "sync option 'Prune' has an invalid format")
 }
 
 func TestSyncOptions_HasOption(t *testing.T) {
@@ -3364,6 +3756,37 @@ func TestSyncOptions_RemoveOption(t *testing.T) {
 	assert.Empty(t, options.RemoveOption("a=1").RemoveOption("a=1"))
 }
 
+func TestRevisionHistories_TruncByAge(t *testing.T) {
+	now := time.Now()
+	deployedAt := func(ago time.Duration) metav1.Time {
+		return metav1.NewTime(now.Add(-ago))
+	}
+	histories := RevisionHistories{
+		{ID: 1, DeployedAt: deployedAt(3 * time.Hour)},
+		{ID: 2, DeployedAt: deployedAt(2 * time.Hour)},
+		{ID: 3, DeployedAt: deployedAt(time.Minute)},
+	}
+	assert.Empty(t, RevisionHistories{}.TruncByAge(now))
+	assert.Equal(t, histories, histories.TruncByAge(now.Add(-4*time.Hour)))
+	assert.Equal(t, histories[1:], histories.TruncByAge(now.Add(-150*time.Minute)))
+	// the most recent entry is always kept
+	assert.Equal(t, histories[2:], histories.TruncByAge(now))
+}
+
+func TestRevisionHistories_TruncBySize(t *testing.T) {
+	histories := RevisionHistories{{ID: 1, Revision: "a"}, {ID: 2, Revision: "b"}, {ID: 3, Revision: "c"}}
+	data, err := json.Marshal(histories[0])
+	require.NoError(t, err)
+	size := len(data)
+
+	assert.Empty(t, RevisionHistories{}.TruncBySize(size))
+	assert.Equal(t, histories, histories.TruncBySize(3*size))
+	assert.Equal(t, histories[1:], histories.TruncBySize(3*size-1))
+	assert.Equal(t, histories[2:], histories.TruncBySize(size))
+	// the most recent entry is always kept
+	assert.Equal(t, histories[2:], histories.TruncBySize(1))
+}
+
 func TestRevisionHistories_Trunc(t *testing.T) {
 	assert.Empty(t, RevisionHistories{}.Trunc(1))
 	assert.Len(t, RevisionHistories{{}}.Trunc(1), 1)
@@ -3372,6 +3795,38 @@ func TestRevisionHistories_Trunc(t *testing.T) {
 	assert.Equal(t, RevisionHistories{{Revision: "my-revision"}}, RevisionHistories{{}, {}, {Revision: "my-revision"}}.Trunc(1))
 }
 
+func TestApplication_GetIgnoreDifferences(t *testing.T) {
+	app := &Application{Spec: ApplicationSpec{IgnoreDifferences: IgnoreDifferences{{Kind: "Deployment", JSONPointers: []string{"/spec/replicas"}}}}}
+	assert.Equal(t, app.Spec.IgnoreDifferences, app.GetIgnoreDifferences())
+
+	app.SetAnnotations(map[string]string{AnnotationKeyIgnoreManagedFieldsManagers: " kube-controller-manager, ,my-operator"})
+	assert.Equal(t, IgnoreDifferences{
+		{Kind: "Deployment", JSONPointers: []string{"/spec/replicas"}},
+		{Group: "*", Kind: "*", ManagedFieldsManagers: []string{"kube-controller-manager", "my-operator"}},
+	}, app.GetIgnoreDifferences())
+	// the spec is not modified
+	assert.Len(t, app.Spec.IgnoreDifferences, 1)
+}
+
+func TestApplicationSpec_GetDestinationNamespace(t *testing.T) {
+	spec := ApplicationSpec{
+		Destination: ApplicationDestination{Namespace: "default"},
+		DestinationNamespaces: []DestinationNamespaceMapping{
+			{Namespace: "frontend", Selector: metav1.LabelSelector{MatchLabels: map[string]string{"tier": "frontend"}}},
+			{Namespace: "backend", Selector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: metav1.LabelSelectorOpIn, Values: []string{"backend", "frontend"}}}}},
+		},
+	}
+	for labels, expected := range map[string]string{"frontend": "frontend", "backend": "backend", "database": "default"} {
+		namespace, err := spec.GetDestinationNamespace(map[string]string{"tier": labels})
+		require.NoError(t, err)
+		assert.Equal(t, expected, namespace)
+	}
+
+	spec.DestinationNamespaces = []DestinationNamespaceMapping{{Namespace: "invalid", Selector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: "Invalid"}}}}}
+	_, err := spec.GetDestinationNamespace(nil)
+	require.ErrorContains(t, err, "invalid selector of destination namespace 'invalid'")
+}
+
 func TestApplicationSpec_GetRevisionHistoryLimit(t *testing.T) {
 	// default
 	assert.Equal(t, 10, ApplicationSpec{}.GetRevisionHistoryLimit())
@@ -4507,3 +4962,219 @@ func TestCluster_ParseProxyUrl(t *testing.T) {
 		}
 	}
 }
+
+func TestCluster_RawRestConfigProxyCredentials(t *testing.T) {
+	cluster := &Cluster{
+		Server: "https://cluster.example.com",
+		Config: ClusterConfig{
+			ProxyUrl:      "socks5://proxy.example.com:1080",
+			ProxyUsername: "admin",
+			ProxyPassword: "p@ss",
+		},
+	}
+	config, err := cluster.RawRestConfig()
+	require.NoError(t, err)
+	require.NotNil(t, config.Proxy)
+	proxyURL, err := config.Proxy(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "socks5", proxyURL.Scheme)
+	assert.Equal(t, "proxy.example.com:1080", proxyURL.Host)
+	assert.Equal(t, "admin", proxyURL.User.Username())
+	password, _ := proxyURL.User.Password()
+	assert.Equal(t, "p@ss", password)
+
+	// the resolved credentials are never serialized
+	data, err := json.Marshal(cluster.Config)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "p@ss")
+
+	cluster.Config.ProxyUsername = ""
+	cluster.Config.ProxyPassword = ""
+	config, err = cluster.RawRestConfig()
+	require.NoError(t, err)
+	proxyURL, err = config.Proxy(nil)
+	require.NoError(t, err)
+	assert.Nil(t, proxyURL.User)
+}
+
+func TestProxyCredentialsSecretRef_Keys(t *testing.T) {
+	ref := &ProxyCredentialsSecretRef{SecretName: "proxy"}
+	assert.Equal(t, "username", ref.GetUsernameKey())
+	assert.Equal(t, "password", ref.GetPasswordKey())
+
+	ref = &ProxyCredentialsSecretRef{SecretName: "proxy", UsernameKey: "user", PasswordKey: "pass"}
+	assert.Equal(t, "user", ref.GetUsernameKey())
+	assert.Equal(t, "pass", ref.GetPasswordKey())
+}
+
+func TestCluster_RawRestConfigExternalCredentials(t *testing.T) {
+	cluster := &Cluster{
+		Server: "https://cluster.example.com",
+		Config: ClusterConfig{
+			BearerToken: "ignored",
+			ExternalCredentialsConfig: &ExternalCredentialsConfig{
+				BearerTokenRef: "vault:secret/data/cluster-foo#token",
+				CacheTTL:       "10m",
+			},
+		},
+	}
+	config, err := cluster.RawRestConfig()
+	require.NoError(t, err)
+	assert.Empty(t, config.BearerToken)
+	require.NotNil(t, config.ExecProvider)
+	assert.Equal(t, "argocd-k8s-auth", config.ExecProvider.Command)
+	assert.Equal(t, []string{"external", "--token-ref", "vault:secret/data/cluster-foo#token", "--ttl", "10m"}, config.ExecProvider.Args)
+}
+
+func TestCluster_RESTConfigRateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	cluster := &Cluster{
+		Server: server.URL,
+		Config: ClusterConfig{
+			BearerToken:     "token",
+			RateLimitConfig: &ClusterRateLimitConfig{QPS: 20},
+		},
+	}
+	config, err := cluster.RESTConfig()
+	require.NoError(t, err)
+	assert.InDelta(t, 20, config.QPS, 0.01)
+	assert.Equal(t, 40, config.Burst)
+	require.NotNil(t, config.RateLimiter)
+
+	other, err := cluster.RESTConfig()
+	require.NoError(t, err)
+	assert.Same(t, config.RateLimiter, other.RateLimiter, "the clients of a cluster must share the rate limiter")
+
+	client, err := rest.HTTPClientFor(config)
+	require.NoError(t, err)
+	resp, err := client.Get(server.URL + "/version")
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.InDelta(t, 10, config.RateLimiter.QPS(), 0.01, "the rate must be lowered when the API server throttles requests")
+
+	cluster.Config.RateLimitConfig = &ClusterRateLimitConfig{QPS: 20, Burst: 25}
+	config, err = cluster.RESTConfig()
+	require.NoError(t, err)
+	assert.Equal(t, 25, config.Burst)
+	assert.NotSame(t, other.RateLimiter, config.RateLimiter)
+
+	cluster.Config.RateLimitConfig = nil
+	config, err = cluster.RESTConfig()
+	require.NoError(t, err)
+	assert.Equal(t, K8sClientConfigQPS, config.QPS)
+	assert.Nil(t, config.RateLimiter)
+}
+
+func TestCluster_RawRestConfigSSHTunnel(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	block, err := ssh.MarshalPrivateKey(key, "")
+	require.NoError(t, err)
+	cluster := &Cluster{
+		Server: "http://10.0.0.5:8080",
+		Config: ClusterConfig{
+			BearerToken: "token",
+			SSHTunnelConfig: &SSHTunnelConfig{
+				Host:                  "bastion.example.com",
+				User:                  "argocd",
+				PrivateKey:            string(pem.EncodeToMemory(block)),
+				InsecureIgnoreHostKey: true,
+			},
+		},
+	}
+	config, err := cluster.RawRestConfig()
+	require.NoError(t, err)
+	assert.Equal(t, "http://10.0.0.5:8080", config.Host)
+	require.NotNil(t, config.Dial)
+
+	tunnel, err := cluster.Config.SSHTunnelConfig.tunnel()
+	require.NoError(t, err)
+	other, err := cluster.DeepCopy().Config.SSHTunnelConfig.tunnel()
+	require.NoError(t, err)
+	assert.Same(t, tunnel, other, "the clients of a cluster must share the SSH tunnel")
+
+	cluster.Config.SSHTunnelConfig.InsecureIgnoreHostKey = false
+	_, err = cluster.RawRestConfig()
+	require.ErrorContains(t, err, "SSH host key is required")
+}
+
+func TestSetK8SConfigDefaults_Dial(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var dialed []string
+	config := &rest.Config{
+		Host: server.URL,
+		// stands in for the SSH tunnel of the cluster
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			dialed = append(dialed, address)
+			return (&net.Dialer{}).DialContext(ctx, network, address)
+		},
+	}
+	require.NoError(t, SetK8SConfigDefaults(config))
+	client, err := rest.HTTPClientFor(config)
+	require.NoError(t, err)
+	resp, err := client.Get(server.URL + "/version")
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, []string{server.Listener.Addr().String()}, dialed, "the connections must be established by the dialer of the config")
+}
+
+func TestCluster_RawRestConfigImpersonation(t *testing.T) {
+	cluster := &Cluster{
+		Server: "https://cluster.example.com",
+		Config: ClusterConfig{
+			BearerToken: "token",
+			ImpersonationConfig: &ImpersonationConfig{
+				Username: "tenant-a",
+				Groups:   []string{"tenant-a-admins", "system:authenticated"},
+			},
+		},
+	}
+	config, err := cluster.RawRestConfig()
+	require.NoError(t, err)
+	assert.Equal(t, "token", config.BearerToken)
+	assert.Equal(t, "tenant-a", config.Impersonate.UserName)
+	assert.Equal(t, []string{"tenant-a-admins", "system:authenticated"}, config.Impersonate.Groups)
+
+	cluster.Config.ImpersonationConfig = nil
+	config, err = cluster.RawRestConfig()
+	require.NoError(t, err)
+	assert.Empty(t, config.Impersonate)
+}
+
+func TestParseResourceRef(t *testing.T) {
+	res, err := ParseResourceRef("apps:Deployment:guestbook")
+	require.NoError(t, err)
+	assert.Equal(t, SyncOperationResource{Group: "apps", Kind: "Deployment", Name: "guestbook"}, res)
+
+	res, err = ParseResourceRef(":Service:default/guestbook")
+	require.NoError(t, err)
+	assert.Equal(t, SyncOperationResource{Kind: "Service", Namespace: "default", Name: "guestbook"}, res)
+
+	for _, resource := range []string{"", "apps:Deployment", "apps::guestbook", "apps:Deployment:", "apps:Deployment:default/", "apps:Deployment:a/b/c"} {
+		_, err = ParseResourceRef(resource)
+		require.Error(t, err, resource)
+	}
+}
+
+func TestApplication_GetRefreshResources(t *testing.T) {
+	app := newTestApp()
+	assert.Nil(t, app.GetRefreshResources())
+
+	app.Annotations = map[string]string{AnnotationKeyRefreshResources: "apps:Deployment:guestbook, :Service:default/guestbook,invalid"}
+	// no refresh requested
+	assert.Nil(t, app.GetRefreshResources())
+
+	app.Annotations[AnnotationKeyRefresh] = string(RefreshTypeHard)
+	assert.Equal(t, []SyncOperationResource{
+		{Group: "apps", Kind: "Deployment", Name: "guestbook"},
+		{Kind: "Service", Namespace: "default", Name: "guestbook"},
+	}, app.GetRefreshResources())
+}
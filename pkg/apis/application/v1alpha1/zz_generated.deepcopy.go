@@ -42,6 +42,77 @@ func (in *AWSAuthConfig) DeepCopy() *AWSAuthConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSOrganizationEKSClusters) DeepCopyInto(out *AWSOrganizationEKSClusters) {
+	*out = *in
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSOrganizationEKSClusters.
+func (in *AWSOrganizationEKSClusters) DeepCopy() *AWSOrganizationEKSClusters {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSOrganizationEKSClusters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSOrganizationGenerator) DeepCopyInto(out *AWSOrganizationGenerator) {
+	*out = *in
+	if in.OrganizationalUnits != nil {
+		in, out := &in.OrganizationalUnits, &out.OrganizationalUnits
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TagFilters != nil {
+		in, out := &in.TagFilters, &out.TagFilters
+		*out = make([]*TagFilter, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(TagFilter)
+				**out = **in
+			}
+		}
+	}
+	if in.EKSClusters != nil {
+		in, out := &in.EKSClusters, &out.EKSClusters
+		*out = new(AWSOrganizationEKSClusters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequeueAfterSeconds != nil {
+		in, out := &in.RequeueAfterSeconds, &out.RequeueAfterSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSOrganizationGenerator.
+func (in *AWSOrganizationGenerator) DeepCopy() *AWSOrganizationGenerator {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSOrganizationGenerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AppProject) DeepCopyInto(out *AppProject) {
 	*out = *in
@@ -157,7 +228,9 @@ func (in *AppProjectSpec) DeepCopyInto(out *AppProjectSpec) {
 	if in.SignatureKeys != nil {
 		in, out := &in.SignatureKeys, &out.SignatureKeys
 		*out = make([]SignatureKey, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.ClusterResourceBlacklist != nil {
 		in, out := &in.ClusterResourceBlacklist, &out.ClusterResourceBlacklist
@@ -174,6 +247,33 @@ func (in *AppProjectSpec) DeepCopyInto(out *AppProjectSpec) {
 		*out = make([]ApplicationDestinationServiceAccount, len(*in))
 		copy(*out, *in)
 	}
+	if in.ApplicationResourceQuota != nil {
+		in, out := &in.ApplicationResourceQuota, &out.ApplicationResourceQuota
+		*out = new(ApplicationResourceQuota)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ManifestGenerationWebhooks != nil {
+		in, out := &in.ManifestGenerationWebhooks, &out.ManifestGenerationWebhooks
+		*out = make([]ManifestGenerationWebhook, len(*in))
+		copy(*out, *in)
+	}
+	if in.SyncPolicy != nil {
+		in, out := &in.SyncPolicy, &out.SyncPolicy
+		*out = new(ProjectSyncPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceRestrictions != nil {
+		in, out := &in.SourceRestrictions, &out.SourceRestrictions
+		*out = make([]SourceRestriction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = new(ProjectQuota)
+		**out = **in
+	}
 	return
 }
 
@@ -375,6 +475,27 @@ func (in *ApplicationPreservedFields) DeepCopy() *ApplicationPreservedFields {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationResourceQuota) DeepCopyInto(out *ApplicationResourceQuota) {
+	*out = *in
+	if in.MaxManifestsSize != nil {
+		in, out := &in.MaxManifestsSize, &out.MaxManifestsSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationResourceQuota.
+func (in *ApplicationResourceQuota) DeepCopy() *ApplicationResourceQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationResourceQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSet) DeepCopyInto(out *ApplicationSet) {
 	*out = *in
@@ -428,6 +549,41 @@ func (in *ApplicationSetApplicationStatus) DeepCopy() *ApplicationSetApplication
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetApplicationsSummary) DeepCopyInto(out *ApplicationSetApplicationsSummary) {
+	*out = *in
+	if in.Health != nil {
+		in, out := &in.Health, &out.Health
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Sync != nil {
+		in, out := &in.Sync, &out.Sync
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Degraded != nil {
+		in, out := &in.Degraded, &out.Degraded
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetApplicationsSummary.
+func (in *ApplicationSetApplicationsSummary) DeepCopy() *ApplicationSetApplicationsSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetApplicationsSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetCondition) DeepCopyInto(out *ApplicationSetCondition) {
 	*out = *in
@@ -501,6 +657,16 @@ func (in *ApplicationSetGenerator) DeepCopyInto(out *ApplicationSetGenerator) {
 		*out = new(PluginGenerator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AWSOrganization != nil {
+		in, out := &in.AWSOrganization, &out.AWSOrganization
+		*out = new(AWSOrganizationGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPGenerator)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -622,6 +788,16 @@ func (in *ApplicationSetNestedGenerator) DeepCopyInto(out *ApplicationSetNestedG
 		*out = new(PluginGenerator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AWSOrganization != nil {
+		in, out := &in.AWSOrganization, &out.AWSOrganization
+		*out = new(AWSOrganizationGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPGenerator)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -683,6 +859,39 @@ func (in *ApplicationSetResourceIgnoreDifferences) DeepCopy() *ApplicationSetRes
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetRollingUpdateStrategy) DeepCopyInto(out *ApplicationSetRollingUpdateStrategy) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]ApplicationSetRolloutStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MaxConcurrent != nil {
+		in, out := &in.MaxConcurrent, &out.MaxConcurrent
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnhealthy != nil {
+		in, out := &in.MaxUnhealthy, &out.MaxUnhealthy
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetRollingUpdateStrategy.
+func (in *ApplicationSetRollingUpdateStrategy) DeepCopy() *ApplicationSetRollingUpdateStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetRollingUpdateStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetRolloutStep) DeepCopyInto(out *ApplicationSetRolloutStep) {
 	*out = *in
@@ -814,6 +1023,11 @@ func (in *ApplicationSetStatus) DeepCopyInto(out *ApplicationSetStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ApplicationsSummary != nil {
+		in, out := &in.ApplicationsSummary, &out.ApplicationsSummary
+		*out = new(ApplicationSetApplicationsSummary)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -835,6 +1049,11 @@ func (in *ApplicationSetStrategy) DeepCopyInto(out *ApplicationSetStrategy) {
 		*out = new(ApplicationSetRolloutStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(ApplicationSetRollingUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -965,6 +1184,16 @@ func (in *ApplicationSetTerminalGenerator) DeepCopyInto(out *ApplicationSetTermi
 		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AWSOrganization != nil {
+		in, out := &in.AWSOrganization, &out.AWSOrganization
+		*out = new(AWSOrganizationGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPGenerator)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1046,6 +1275,11 @@ func (in *ApplicationSource) DeepCopyInto(out *ApplicationSource) {
 		*out = new(ApplicationSourcePlugin)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CUE != nil {
+		in, out := &in.CUE, &out.CUE
+		*out = new(ApplicationSourceCUE)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1059,6 +1293,32 @@ func (in *ApplicationSource) DeepCopy() *ApplicationSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSourceCUE) DeepCopyInto(out *ApplicationSourceCUE) {
+	*out = *in
+	if in.Packages != nil {
+		in, out := &in.Packages, &out.Packages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSourceCUE.
+func (in *ApplicationSourceCUE) DeepCopy() *ApplicationSourceCUE {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSourceCUE)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSourceDirectory) DeepCopyInto(out *ApplicationSourceDirectory) {
 	*out = *in
@@ -1104,6 +1364,11 @@ func (in *ApplicationSourceHelm) DeepCopyInto(out *ApplicationSourceHelm) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ValuesFrom != nil {
+		in, out := &in.ValuesFrom, &out.ValuesFrom
+		*out = make([]HelmValuesFromSource, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1357,6 +1622,18 @@ func (in *ApplicationSpec) DeepCopyInto(out *ApplicationSpec) {
 		*out = new(SourceHydrator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DestinationNamespaces != nil {
+		in, out := &in.DestinationNamespaces, &out.DestinationNamespaces
+		*out = make([]DestinationNamespaceMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1416,6 +1693,13 @@ func (in *ApplicationStatus) DeepCopyInto(out *ApplicationStatus) {
 		copy(*out, *in)
 	}
 	in.SourceHydrator.DeepCopyInto(&out.SourceHydrator)
+	if in.DriftHistory != nil {
+		in, out := &in.DriftHistory, &out.DriftHistory
+		*out = make([]DriftEvent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1648,6 +1932,17 @@ func (in *Cluster) DeepCopyInto(out *Cluster) {
 			(*out)[key] = val
 		}
 	}
+	if in.SyncWindows != nil {
+		in, out := &in.SyncWindows, &out.SyncWindows
+		*out = make(SyncWindows, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(SyncWindow)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	return
 }
 
@@ -1681,6 +1976,27 @@ func (in *ClusterCacheInfo) DeepCopy() *ClusterCacheInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterCapacityInfo) DeepCopyInto(out *ClusterCapacityInfo) {
+	*out = *in
+	if in.KubeletVersions != nil {
+		in, out := &in.KubeletVersions, &out.KubeletVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterCapacityInfo.
+func (in *ClusterCapacityInfo) DeepCopy() *ClusterCapacityInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterCapacityInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 	*out = *in
@@ -1695,6 +2011,31 @@ func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 		*out = new(ExecProviderConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ExternalCredentialsConfig != nil {
+		in, out := &in.ExternalCredentialsConfig, &out.ExternalCredentialsConfig
+		*out = new(ExternalCredentialsConfig)
+		**out = **in
+	}
+	if in.ImpersonationConfig != nil {
+		in, out := &in.ImpersonationConfig, &out.ImpersonationConfig
+		*out = new(ImpersonationConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RateLimitConfig != nil {
+		in, out := &in.RateLimitConfig, &out.RateLimitConfig
+		*out = new(ClusterRateLimitConfig)
+		**out = **in
+	}
+	if in.ProxyCredentialsSecretRef != nil {
+		in, out := &in.ProxyCredentialsSecretRef, &out.ProxyCredentialsSecretRef
+		*out = new(ProxyCredentialsSecretRef)
+		**out = **in
+	}
+	if in.SSHTunnelConfig != nil {
+		in, out := &in.SSHTunnelConfig, &out.SSHTunnelConfig
+		*out = new(SSHTunnelConfig)
+		**out = **in
+	}
 	return
 }
 
@@ -1743,6 +2084,16 @@ func (in *ClusterInfo) DeepCopyInto(out *ClusterInfo) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.CapacityInfo != nil {
+		in, out := &in.CapacityInfo, &out.CapacityInfo
+		*out = new(ClusterCapacityInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LatencyInfo != nil {
+		in, out := &in.LatencyInfo, &out.LatencyInfo
+		*out = new(ClusterLatencyInfo)
+		**out = **in
+	}
 	return
 }
 
@@ -1756,6 +2107,22 @@ func (in *ClusterInfo) DeepCopy() *ClusterInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterLatencyInfo) DeepCopyInto(out *ClusterLatencyInfo) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterLatencyInfo.
+func (in *ClusterLatencyInfo) DeepCopy() *ClusterLatencyInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterLatencyInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterList) DeepCopyInto(out *ClusterList) {
 	*out = *in
@@ -1780,6 +2147,22 @@ func (in *ClusterList) DeepCopy() *ClusterList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRateLimitConfig) DeepCopyInto(out *ClusterRateLimitConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRateLimitConfig.
+func (in *ClusterRateLimitConfig) DeepCopy() *ClusterRateLimitConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRateLimitConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Command) DeepCopyInto(out *Command) {
 	*out = *in
@@ -1912,6 +2295,68 @@ func (in *ConnectionState) DeepCopy() *ConnectionState {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DestinationNamespaceMapping) DeepCopyInto(out *DestinationNamespaceMapping) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DestinationNamespaceMapping.
+func (in *DestinationNamespaceMapping) DeepCopy() *DestinationNamespaceMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(DestinationNamespaceMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftEvent) DeepCopyInto(out *DriftEvent) {
+	*out = *in
+	in.RevertedAt.DeepCopyInto(&out.RevertedAt)
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]DriftedResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftEvent.
+func (in *DriftEvent) DeepCopy() *DriftEvent {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftEvent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftedResource) DeepCopyInto(out *DriftedResource) {
+	*out = *in
+	if in.Managers != nil {
+		in, out := &in.Managers, &out.Managers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftedResource.
+func (in *DriftedResource) DeepCopy() *DriftedResource {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftedResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DrySource) DeepCopyInto(out *DrySource) {
 	*out = *in
@@ -2044,6 +2489,22 @@ func (in *ExecProviderConfig) DeepCopy() *ExecProviderConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalCredentialsConfig) DeepCopyInto(out *ExternalCredentialsConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalCredentialsConfig.
+func (in *ExternalCredentialsConfig) DeepCopy() *ExternalCredentialsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalCredentialsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GitDirectoryGeneratorItem) DeepCopyInto(out *GitDirectoryGeneratorItem) {
 	*out = *in
@@ -2153,6 +2614,47 @@ func (in *GnuPGPublicKeyList) DeepCopy() *GnuPGPublicKeyList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPGenerator) DeepCopyInto(out *HTTPGenerator) {
+	*out = *in
+	if in.TokenRef != nil {
+		in, out := &in.TokenRef, &out.TokenRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RequeueAfterSeconds != nil {
+		in, out := &in.RequeueAfterSeconds, &out.RequeueAfterSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPGenerator.
+func (in *HTTPGenerator) DeepCopy() *HTTPGenerator {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPGenerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HealthStatus) DeepCopyInto(out *HealthStatus) {
 	*out = *in
@@ -2226,6 +2728,22 @@ func (in *HelmParameter) DeepCopy() *HelmParameter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmValuesFromSource) DeepCopyInto(out *HelmValuesFromSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmValuesFromSource.
+func (in *HelmValuesFromSource) DeepCopy() *HelmValuesFromSource {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmValuesFromSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostInfo) DeepCopyInto(out *HostInfo) {
 	*out = *in
@@ -2324,6 +2842,27 @@ func (in IgnoreDifferences) DeepCopy() IgnoreDifferences {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImpersonationConfig) DeepCopyInto(out *ImpersonationConfig) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImpersonationConfig.
+func (in *ImpersonationConfig) DeepCopy() *ImpersonationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ImpersonationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Info) DeepCopyInto(out *Info) {
 	*out = *in
@@ -2642,12 +3181,28 @@ func (in *ManagedNamespaceMetadata) DeepCopyInto(out *ManagedNamespaceMetadata)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedNamespaceMetadata.
-func (in *ManagedNamespaceMetadata) DeepCopy() *ManagedNamespaceMetadata {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedNamespaceMetadata.
+func (in *ManagedNamespaceMetadata) DeepCopy() *ManagedNamespaceMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedNamespaceMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestGenerationWebhook) DeepCopyInto(out *ManifestGenerationWebhook) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestGenerationWebhook.
+func (in *ManifestGenerationWebhook) DeepCopy() *ManifestGenerationWebhook {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagedNamespaceMetadata)
+	out := new(ManifestGenerationWebhook)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2950,6 +3505,28 @@ func (in *OverrideIgnoreDiff) DeepCopy() *OverrideIgnoreDiff {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingDeletion) DeepCopyInto(out *PendingDeletion) {
+	*out = *in
+	in.DeletionRequestedAt.DeepCopyInto(&out.DeletionRequestedAt)
+	if in.Finalizers != nil {
+		in, out := &in.Finalizers, &out.Finalizers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingDeletion.
+func (in *PendingDeletion) DeepCopy() *PendingDeletion {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingDeletion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PluginConfigMapRef) DeepCopyInto(out *PluginConfigMapRef) {
 	*out = *in
@@ -3042,6 +3619,38 @@ func (in PluginParameters) DeepCopy() PluginParameters {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectQuota) DeepCopyInto(out *ProjectQuota) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectQuota.
+func (in *ProjectQuota) DeepCopy() *ProjectQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectQuotaUsage) DeepCopyInto(out *ProjectQuotaUsage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectQuotaUsage.
+func (in *ProjectQuotaUsage) DeepCopy() *ProjectQuotaUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectQuotaUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectRole) DeepCopyInto(out *ProjectRole) {
 	*out = *in
@@ -3073,6 +3682,53 @@ func (in *ProjectRole) DeepCopy() *ProjectRole {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectSyncPolicy) DeepCopyInto(out *ProjectSyncPolicy) {
+	*out = *in
+	if in.SyncOptions != nil {
+		in, out := &in.SyncOptions, &out.SyncOptions
+		*out = make(SyncOptions, len(*in))
+		copy(*out, *in)
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(RetryStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeniedSyncOptions != nil {
+		in, out := &in.DeniedSyncOptions, &out.DeniedSyncOptions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSyncPolicy.
+func (in *ProjectSyncPolicy) DeepCopy() *ProjectSyncPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectSyncPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyCredentialsSecretRef) DeepCopyInto(out *ProxyCredentialsSecretRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyCredentialsSecretRef.
+func (in *ProxyCredentialsSecretRef) DeepCopy() *ProxyCredentialsSecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyCredentialsSecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PullRequestGenerator) DeepCopyInto(out *PullRequestGenerator) {
 	*out = *in
@@ -3126,6 +3782,11 @@ func (in *PullRequestGenerator) DeepCopyInto(out *PullRequestGenerator) {
 			(*out)[key] = val
 		}
 	}
+	if in.ParameterOverrides != nil {
+		in, out := &in.ParameterOverrides, &out.ParameterOverrides
+		*out = new(PullRequestGeneratorParameterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3152,6 +3813,11 @@ func (in *PullRequestGeneratorAzureDevOps) DeepCopyInto(out *PullRequestGenerato
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.CARef != nil {
+		in, out := &in.CARef, &out.CARef
+		*out = new(ConfigMapKeyRef)
+		**out = **in
+	}
 	return
 }
 
@@ -3287,6 +3953,11 @@ func (in *PullRequestGeneratorGitea) DeepCopyInto(out *PullRequestGeneratorGitea
 		*out = new(SecretRef)
 		**out = **in
 	}
+	if in.CARef != nil {
+		in, out := &in.CARef, &out.CARef
+		*out = new(ConfigMapKeyRef)
+		**out = **in
+	}
 	return
 }
 
@@ -3326,10 +3997,36 @@ func (in *PullRequestGeneratorGithub) DeepCopy() *PullRequestGeneratorGithub {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PullRequestGeneratorParameterOverrides) DeepCopyInto(out *PullRequestGeneratorParameterOverrides) {
+	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullRequestGeneratorParameterOverrides.
+func (in *PullRequestGeneratorParameterOverrides) DeepCopy() *PullRequestGeneratorParameterOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(PullRequestGeneratorParameterOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RefTarget) DeepCopyInto(out *RefTarget) {
 	*out = *in
 	in.Repo.DeepCopyInto(&out.Repo)
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(ApplicationSource)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3783,6 +4480,17 @@ func (in *ResourceRef) DeepCopy() *ResourceRef {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceResult) DeepCopyInto(out *ResourceResult) {
 	*out = *in
+	if in.Conflicts != nil {
+		in, out := &in.Conflicts, &out.Conflicts
+		*out = make([]ServerSideApplyConflict, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AppliedAt != nil {
+		in, out := &in.AppliedAt, &out.AppliedAt
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -3805,7 +4513,7 @@ func (in ResourceResults) DeepCopyInto(out *ResourceResults) {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
 				*out = new(ResourceResult)
-				**out = **in
+				(*in).DeepCopyInto(*out)
 			}
 		}
 		return
@@ -3830,6 +4538,11 @@ func (in *ResourceStatus) DeepCopyInto(out *ResourceStatus) {
 		*out = new(HealthStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PendingDeletion != nil {
+		in, out := &in.PendingDeletion, &out.PendingDeletion
+		*out = new(PendingDeletion)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3908,6 +4621,11 @@ func (in *RevisionHistory) DeepCopyInto(out *RevisionHistory) {
 		copy(*out, *in)
 	}
 	out.InitiatedBy = in.InitiatedBy
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackInfo)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3943,6 +4661,27 @@ func (in *RevisionMetadata) DeepCopy() *RevisionMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollbackInfo) DeepCopyInto(out *RollbackInfo) {
+	*out = *in
+	if in.HistoryID != nil {
+		in, out := &in.HistoryID, &out.HistoryID
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollbackInfo.
+func (in *RollbackInfo) DeepCopy() *RollbackInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(RollbackInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SCMProviderGenerator) DeepCopyInto(out *SCMProviderGenerator) {
 	*out = *in
@@ -4049,6 +4788,11 @@ func (in *SCMProviderGeneratorAzureDevOps) DeepCopyInto(out *SCMProviderGenerato
 		*out = new(SecretRef)
 		**out = **in
 	}
+	if in.CARef != nil {
+		in, out := &in.CARef, &out.CARef
+		*out = new(ConfigMapKeyRef)
+		**out = **in
+	}
 	return
 }
 
@@ -4163,6 +4907,11 @@ func (in *SCMProviderGeneratorGitea) DeepCopyInto(out *SCMProviderGeneratorGitea
 		*out = new(SecretRef)
 		**out = **in
 	}
+	if in.CARef != nil {
+		in, out := &in.CARef, &out.CARef
+		*out = new(ConfigMapKeyRef)
+		**out = **in
+	}
 	return
 }
 
@@ -4228,6 +4977,22 @@ func (in *SCMProviderGeneratorGitlab) DeepCopy() *SCMProviderGeneratorGitlab {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHTunnelConfig) DeepCopyInto(out *SSHTunnelConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHTunnelConfig.
+func (in *SSHTunnelConfig) DeepCopy() *SSHTunnelConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHTunnelConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretRef) DeepCopyInto(out *SecretRef) {
 	*out = *in
@@ -4244,9 +5009,35 @@ func (in *SecretRef) DeepCopy() *SecretRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerSideApplyConflict) DeepCopyInto(out *ServerSideApplyConflict) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerSideApplyConflict.
+func (in *ServerSideApplyConflict) DeepCopy() *ServerSideApplyConflict {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerSideApplyConflict)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SignatureKey) DeepCopyInto(out *SignatureKey) {
 	*out = *in
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -4309,6 +5100,32 @@ func (in *SourceHydratorStatus) DeepCopy() *SourceHydratorStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceRestriction) DeepCopyInto(out *SourceRestriction) {
+	*out = *in
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetRevisions != nil {
+		in, out := &in.TargetRevisions, &out.TargetRevisions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceRestriction.
+func (in *SourceRestriction) DeepCopy() *SourceRestriction {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceRestriction)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SuccessfulHydrateOperation) DeepCopyInto(out *SuccessfulHydrateOperation) {
 	*out = *in
@@ -4326,6 +5143,33 @@ func (in *SuccessfulHydrateOperation) DeepCopy() *SuccessfulHydrateOperation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncHookReference) DeepCopyInto(out *SyncHookReference) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(Env, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(EnvEntry)
+				**out = **in
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncHookReference.
+func (in *SyncHookReference) DeepCopy() *SyncHookReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncHookReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncOperation) DeepCopyInto(out *SyncOperation) {
 	*out = *in
@@ -4366,6 +5210,16 @@ func (in *SyncOperation) DeepCopyInto(out *SyncOperation) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ForceConflictsFor != nil {
+		in, out := &in.ForceConflictsFor, &out.ForceConflictsFor
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -4405,7 +5259,7 @@ func (in *SyncOperationResult) DeepCopyInto(out *SyncOperationResult) {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
 				*out = new(ResourceResult)
-				**out = **in
+				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
@@ -4427,6 +5281,16 @@ func (in *SyncOperationResult) DeepCopyInto(out *SyncOperationResult) {
 		*out = new(ManagedNamespaceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.WavePause != nil {
+		in, out := &in.WavePause, &out.WavePause
+		*out = new(SyncWavePause)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OutOfSyncWaves != nil {
+		in, out := &in.OutOfSyncWaves, &out.OutOfSyncWaves
+		*out = make([]int64, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -4483,6 +5347,13 @@ func (in *SyncPolicy) DeepCopyInto(out *SyncPolicy) {
 		*out = new(ManagedNamespaceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = make([]SyncHookReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -4614,6 +5485,23 @@ func (in *SyncStrategyHook) DeepCopy() *SyncStrategyHook {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncWavePause) DeepCopyInto(out *SyncWavePause) {
+	*out = *in
+	in.Until.DeepCopyInto(&out.Until)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncWavePause.
+func (in *SyncWavePause) DeepCopy() *SyncWavePause {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncWavePause)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncWindow) DeepCopyInto(out *SyncWindow) {
 	*out = *in
@@ -63,10 +63,20 @@ type ManifestRequest struct {
 	// argocd.argoproj.io/manifest-generate-paths annotation value of the Application to allow optimize which resources propagated to cmpserver
 	AnnotationManifestGeneratePaths string `protobuf:"bytes,26,opt,name=annotationManifestGeneratePaths,proto3" json:"annotationManifestGeneratePaths,omitempty"`
 	// Holds instance installation id
-	InstallationID       string   `protobuf:"bytes,27,opt,name=installationID,proto3" json:"installationID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	InstallationID string `protobuf:"bytes,27,opt,name=installationID,proto3" json:"installationID,omitempty"`
+	// Helm values generated by the sources referenced in applicationSource.helm.valuesFrom, resolved by the repo server
+	SourceValues []string `protobuf:"bytes,28,rep,name=sourceValues,proto3" json:"sourceValues,omitempty"`
+	// Maximum number of resources rendered by the application, unlimited if zero
+	MaxResources int64 `protobuf:"varint,29,opt,name=maxResources,proto3" json:"maxResources,omitempty"`
+	// Maximum total size in bytes of the manifests rendered by the application, unlimited if zero
+	MaxManifestsSize int64 `protobuf:"varint,30,opt,name=maxManifestsSize,proto3" json:"maxManifestsSize,omitempty"`
+	// Proxy of the application controller to the destination cluster, only set when the Helm templates of the source look up its resources
+	HelmLookupProxy *HelmLookupProxy `protobuf:"bytes,31,opt,name=helmLookupProxy,proto3" json:"helmLookupProxy,omitempty"`
+	// Webhooks of the project called in order to mutate or reject the rendered manifests
+	ManifestGenerationWebhooks []*v1alpha1.ManifestGenerationWebhook `protobuf:"bytes,32,rep,name=manifestGenerationWebhooks,proto3" json:"manifestGenerationWebhooks,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{}                              `json:"-"`
+	XXX_unrecognized           []byte                                `json:"-"`
+	XXX_sizecache              int32                                 `json:"-"`
 }
 
 func (m *ManifestRequest) Reset()         { *m = ManifestRequest{} }
@@ -270,6 +280,100 @@ func (m *ManifestRequest) GetInstallationID() string {
 	return ""
 }
 
+func (m *ManifestRequest) GetSourceValues() []string {
+	if m != nil {
+		return m.SourceValues
+	}
+	return nil
+}
+
+func (m *ManifestRequest) GetMaxResources() int64 {
+	if m != nil {
+		return m.MaxResources
+	}
+	return 0
+}
+
+func (m *ManifestRequest) GetMaxManifestsSize() int64 {
+	if m != nil {
+		return m.MaxManifestsSize
+	}
+	return 0
+}
+
+func (m *ManifestRequest) GetHelmLookupProxy() *HelmLookupProxy {
+	if m != nil {
+		return m.HelmLookupProxy
+	}
+	return nil
+}
+
+func (m *ManifestRequest) GetManifestGenerationWebhooks() []*v1alpha1.ManifestGenerationWebhook {
+	if m != nil {
+		return m.ManifestGenerationWebhooks
+	}
+	return nil
+}
+
+// HelmLookupProxy is a session of the proxy of the application controller, giving the `lookup` function of the Helm
+// templates read access to the destination namespace of the application
+type HelmLookupProxy struct {
+	// URL of the proxy
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Token authenticating the session
+	Token                string   `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HelmLookupProxy) Reset()         { *m = HelmLookupProxy{} }
+func (m *HelmLookupProxy) String() string { return proto.CompactTextString(m) }
+func (*HelmLookupProxy) ProtoMessage()    {}
+func (*HelmLookupProxy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{1}
+}
+func (m *HelmLookupProxy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HelmLookupProxy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HelmLookupProxy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HelmLookupProxy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HelmLookupProxy.Merge(m, src)
+}
+func (m *HelmLookupProxy) XXX_Size() int {
+	return m.Size()
+}
+func (m *HelmLookupProxy) XXX_DiscardUnknown() {
+	xxx_messageInfo_HelmLookupProxy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HelmLookupProxy proto.InternalMessageInfo
+
+func (m *HelmLookupProxy) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *HelmLookupProxy) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
 type ManifestRequestWithFiles struct {
 	// Types that are valid to be assigned to Part:
 	//	*ManifestRequestWithFiles_Request
@@ -285,7 +389,7 @@ func (m *ManifestRequestWithFiles) Reset()         { *m = ManifestRequestWithFil
 func (m *ManifestRequestWithFiles) String() string { return proto.CompactTextString(m) }
 func (*ManifestRequestWithFiles) ProtoMessage()    {}
 func (*ManifestRequestWithFiles) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{1}
+	return fileDescriptor_dd8723cfcc820480, []int{2}
 }
 func (m *ManifestRequestWithFiles) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,7 +489,7 @@ func (m *ManifestFileMetadata) Reset()         { *m = ManifestFileMetadata{} }
 func (m *ManifestFileMetadata) String() string { return proto.CompactTextString(m) }
 func (*ManifestFileMetadata) ProtoMessage()    {}
 func (*ManifestFileMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{2}
+	return fileDescriptor_dd8723cfcc820480, []int{3}
 }
 func (m *ManifestFileMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -439,7 +543,7 @@ func (m *ManifestFileChunk) Reset()         { *m = ManifestFileChunk{} }
 func (m *ManifestFileChunk) String() string { return proto.CompactTextString(m) }
 func (*ManifestFileChunk) ProtoMessage()    {}
 func (*ManifestFileChunk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{3}
+	return fileDescriptor_dd8723cfcc820480, []int{4}
 }
 func (m *ManifestFileChunk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,7 +591,7 @@ func (m *TestRepositoryRequest) Reset()         { *m = TestRepositoryRequest{} }
 func (m *TestRepositoryRequest) String() string { return proto.CompactTextString(m) }
 func (*TestRepositoryRequest) ProtoMessage()    {}
 func (*TestRepositoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{4}
+	return fileDescriptor_dd8723cfcc820480, []int{5}
 }
 func (m *TestRepositoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -536,7 +640,7 @@ func (m *TestRepositoryResponse) Reset()         { *m = TestRepositoryResponse{}
 func (m *TestRepositoryResponse) String() string { return proto.CompactTextString(m) }
 func (*TestRepositoryResponse) ProtoMessage()    {}
 func (*TestRepositoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{5}
+	return fileDescriptor_dd8723cfcc820480, []int{6}
 }
 func (m *TestRepositoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -587,7 +691,7 @@ func (m *ResolveRevisionRequest) Reset()         { *m = ResolveRevisionRequest{}
 func (m *ResolveRevisionRequest) String() string { return proto.CompactTextString(m) }
 func (*ResolveRevisionRequest) ProtoMessage()    {}
 func (*ResolveRevisionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{6}
+	return fileDescriptor_dd8723cfcc820480, []int{7}
 }
 func (m *ResolveRevisionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -658,7 +762,7 @@ func (m *ResolveRevisionResponse) Reset()         { *m = ResolveRevisionResponse
 func (m *ResolveRevisionResponse) String() string { return proto.CompactTextString(m) }
 func (*ResolveRevisionResponse) ProtoMessage()    {}
 func (*ResolveRevisionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{7}
+	return fileDescriptor_dd8723cfcc820480, []int{8}
 }
 func (m *ResolveRevisionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -721,7 +825,7 @@ func (m *ManifestResponse) Reset()         { *m = ManifestResponse{} }
 func (m *ManifestResponse) String() string { return proto.CompactTextString(m) }
 func (*ManifestResponse) ProtoMessage()    {}
 func (*ManifestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{8}
+	return fileDescriptor_dd8723cfcc820480, []int{9}
 }
 func (m *ManifestResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -810,7 +914,7 @@ func (m *ListRefsRequest) Reset()         { *m = ListRefsRequest{} }
 func (m *ListRefsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListRefsRequest) ProtoMessage()    {}
 func (*ListRefsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{9}
+	return fileDescriptor_dd8723cfcc820480, []int{10}
 }
 func (m *ListRefsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -859,7 +963,7 @@ func (m *Refs) Reset()         { *m = Refs{} }
 func (m *Refs) String() string { return proto.CompactTextString(m) }
 func (*Refs) ProtoMessage()    {}
 func (*Refs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{10}
+	return fileDescriptor_dd8723cfcc820480, []int{11}
 }
 func (m *Refs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -916,7 +1020,7 @@ func (m *ListAppsRequest) Reset()         { *m = ListAppsRequest{} }
 func (m *ListAppsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListAppsRequest) ProtoMessage()    {}
 func (*ListAppsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{11}
+	return fileDescriptor_dd8723cfcc820480, []int{12}
 }
 func (m *ListAppsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -978,7 +1082,7 @@ func (m *AppList) Reset()         { *m = AppList{} }
 func (m *AppList) String() string { return proto.CompactTextString(m) }
 func (*AppList) ProtoMessage()    {}
 func (*AppList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{12}
+	return fileDescriptor_dd8723cfcc820480, []int{13}
 }
 func (m *AppList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1025,7 +1129,7 @@ func (m *PluginInfo) Reset()         { *m = PluginInfo{} }
 func (m *PluginInfo) String() string { return proto.CompactTextString(m) }
 func (*PluginInfo) ProtoMessage()    {}
 func (*PluginInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{13}
+	return fileDescriptor_dd8723cfcc820480, []int{14}
 }
 func (m *PluginInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1073,7 +1177,7 @@ func (m *PluginList) Reset()         { *m = PluginList{} }
 func (m *PluginList) String() string { return proto.CompactTextString(m) }
 func (*PluginList) ProtoMessage()    {}
 func (*PluginList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{14}
+	return fileDescriptor_dd8723cfcc820480, []int{15}
 }
 func (m *PluginList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1131,7 +1235,7 @@ func (m *RepoServerAppDetailsQuery) Reset()         { *m = RepoServerAppDetailsQ
 func (m *RepoServerAppDetailsQuery) String() string { return proto.CompactTextString(m) }
 func (*RepoServerAppDetailsQuery) ProtoMessage()    {}
 func (*RepoServerAppDetailsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{15}
+	return fileDescriptor_dd8723cfcc820480, []int{16}
 }
 func (m *RepoServerAppDetailsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1253,7 +1357,7 @@ func (m *RepoAppDetailsResponse) Reset()         { *m = RepoAppDetailsResponse{}
 func (m *RepoAppDetailsResponse) String() string { return proto.CompactTextString(m) }
 func (*RepoAppDetailsResponse) ProtoMessage()    {}
 func (*RepoAppDetailsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{16}
+	return fileDescriptor_dd8723cfcc820480, []int{17}
 }
 func (m *RepoAppDetailsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1333,7 +1437,7 @@ func (m *RepoServerRevisionMetadataRequest) Reset()         { *m = RepoServerRev
 func (m *RepoServerRevisionMetadataRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoServerRevisionMetadataRequest) ProtoMessage()    {}
 func (*RepoServerRevisionMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{17}
+	return fileDescriptor_dd8723cfcc820480, []int{18}
 }
 func (m *RepoServerRevisionMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1399,7 +1503,7 @@ func (m *RepoServerRevisionChartDetailsRequest) Reset()         { *m = RepoServe
 func (m *RepoServerRevisionChartDetailsRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoServerRevisionChartDetailsRequest) ProtoMessage()    {}
 func (*RepoServerRevisionChartDetailsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{18}
+	return fileDescriptor_dd8723cfcc820480, []int{19}
 }
 func (m *RepoServerRevisionChartDetailsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1468,7 +1572,7 @@ func (m *HelmAppSpec) Reset()         { *m = HelmAppSpec{} }
 func (m *HelmAppSpec) String() string { return proto.CompactTextString(m) }
 func (*HelmAppSpec) ProtoMessage()    {}
 func (*HelmAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{19}
+	return fileDescriptor_dd8723cfcc820480, []int{20}
 }
 func (m *HelmAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1545,7 +1649,7 @@ func (m *KustomizeAppSpec) Reset()         { *m = KustomizeAppSpec{} }
 func (m *KustomizeAppSpec) String() string { return proto.CompactTextString(m) }
 func (*KustomizeAppSpec) ProtoMessage()    {}
 func (*KustomizeAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{20}
+	return fileDescriptor_dd8723cfcc820480, []int{21}
 }
 func (m *KustomizeAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1592,7 +1696,7 @@ func (m *DirectoryAppSpec) Reset()         { *m = DirectoryAppSpec{} }
 func (m *DirectoryAppSpec) String() string { return proto.CompactTextString(m) }
 func (*DirectoryAppSpec) ProtoMessage()    {}
 func (*DirectoryAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{21}
+	return fileDescriptor_dd8723cfcc820480, []int{22}
 }
 func (m *DirectoryAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1652,7 +1756,7 @@ func (m *ParameterAnnouncement) Reset()         { *m = ParameterAnnouncement{} }
 func (m *ParameterAnnouncement) String() string { return proto.CompactTextString(m) }
 func (*ParameterAnnouncement) ProtoMessage()    {}
 func (*ParameterAnnouncement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{22}
+	return fileDescriptor_dd8723cfcc820480, []int{23}
 }
 func (m *ParameterAnnouncement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1756,7 +1860,7 @@ func (m *PluginAppSpec) Reset()         { *m = PluginAppSpec{} }
 func (m *PluginAppSpec) String() string { return proto.CompactTextString(m) }
 func (*PluginAppSpec) ProtoMessage()    {}
 func (*PluginAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{23}
+	return fileDescriptor_dd8723cfcc820480, []int{24}
 }
 func (m *PluginAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1803,7 +1907,7 @@ func (m *HelmChartsRequest) Reset()         { *m = HelmChartsRequest{} }
 func (m *HelmChartsRequest) String() string { return proto.CompactTextString(m) }
 func (*HelmChartsRequest) ProtoMessage()    {}
 func (*HelmChartsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{24}
+	return fileDescriptor_dd8723cfcc820480, []int{25}
 }
 func (m *HelmChartsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1851,7 +1955,7 @@ func (m *HelmChart) Reset()         { *m = HelmChart{} }
 func (m *HelmChart) String() string { return proto.CompactTextString(m) }
 func (*HelmChart) ProtoMessage()    {}
 func (*HelmChart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{25}
+	return fileDescriptor_dd8723cfcc820480, []int{26}
 }
 func (m *HelmChart) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1905,7 +2009,7 @@ func (m *HelmChartsResponse) Reset()         { *m = HelmChartsResponse{} }
 func (m *HelmChartsResponse) String() string { return proto.CompactTextString(m) }
 func (*HelmChartsResponse) ProtoMessage()    {}
 func (*HelmChartsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{26}
+	return fileDescriptor_dd8723cfcc820480, []int{27}
 }
 func (m *HelmChartsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1958,7 +2062,7 @@ func (m *GitFilesRequest) Reset()         { *m = GitFilesRequest{} }
 func (m *GitFilesRequest) String() string { return proto.CompactTextString(m) }
 func (*GitFilesRequest) ProtoMessage()    {}
 func (*GitFilesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{27}
+	return fileDescriptor_dd8723cfcc820480, []int{28}
 }
 func (m *GitFilesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2048,7 +2152,7 @@ func (m *GitFilesResponse) Reset()         { *m = GitFilesResponse{} }
 func (m *GitFilesResponse) String() string { return proto.CompactTextString(m) }
 func (*GitFilesResponse) ProtoMessage()    {}
 func (*GitFilesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{28}
+	return fileDescriptor_dd8723cfcc820480, []int{29}
 }
 func (m *GitFilesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2085,21 +2189,25 @@ func (m *GitFilesResponse) GetMap() map[string][]byte {
 }
 
 type GitDirectoriesRequest struct {
-	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	SubmoduleEnabled     bool                 `protobuf:"varint,2,opt,name=submoduleEnabled,proto3" json:"submoduleEnabled,omitempty"`
-	Revision             string               `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`
-	NoRevisionCache      bool                 `protobuf:"varint,4,opt,name=noRevisionCache,proto3" json:"noRevisionCache,omitempty"`
-	VerifyCommit         bool                 `protobuf:"varint,5,opt,name=verifyCommit,proto3" json:"verifyCommit,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Repo             *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	SubmoduleEnabled bool                 `protobuf:"varint,2,opt,name=submoduleEnabled,proto3" json:"submoduleEnabled,omitempty"`
+	Revision         string               `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	NoRevisionCache  bool                 `protobuf:"varint,4,opt,name=noRevisionCache,proto3" json:"noRevisionCache,omitempty"`
+	VerifyCommit     bool                 `protobuf:"varint,5,opt,name=verifyCommit,proto3" json:"verifyCommit,omitempty"`
+	// IncludePaths are the patterns of the directories to return, all the directories are returned if empty
+	IncludePaths []string `protobuf:"bytes,6,rep,name=includePaths,proto3" json:"includePaths,omitempty"`
+	// ExcludePaths are the patterns of the directories not to return, even if matched by an include pattern
+	ExcludePaths         []string `protobuf:"bytes,7,rep,name=excludePaths,proto3" json:"excludePaths,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GitDirectoriesRequest) Reset()         { *m = GitDirectoriesRequest{} }
 func (m *GitDirectoriesRequest) String() string { return proto.CompactTextString(m) }
 func (*GitDirectoriesRequest) ProtoMessage()    {}
 func (*GitDirectoriesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{29}
+	return fileDescriptor_dd8723cfcc820480, []int{30}
 }
 func (m *GitDirectoriesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2163,6 +2271,20 @@ func (m *GitDirectoriesRequest) GetVerifyCommit() bool {
 	return false
 }
 
+func (m *GitDirectoriesRequest) GetIncludePaths() []string {
+	if m != nil {
+		return m.IncludePaths
+	}
+	return nil
+}
+
+func (m *GitDirectoriesRequest) GetExcludePaths() []string {
+	if m != nil {
+		return m.ExcludePaths
+	}
+	return nil
+}
+
 type GitDirectoriesResponse struct {
 	// A set of directory paths
 	Paths                []string `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
@@ -2175,7 +2297,7 @@ func (m *GitDirectoriesResponse) Reset()         { *m = GitDirectoriesResponse{}
 func (m *GitDirectoriesResponse) String() string { return proto.CompactTextString(m) }
 func (*GitDirectoriesResponse) ProtoMessage()    {}
 func (*GitDirectoriesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{30}
+	return fileDescriptor_dd8723cfcc820480, []int{31}
 }
 func (m *GitDirectoriesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2236,7 +2358,7 @@ func (m *UpdateRevisionForPathsRequest) Reset()         { *m = UpdateRevisionFor
 func (m *UpdateRevisionForPathsRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateRevisionForPathsRequest) ProtoMessage()    {}
 func (*UpdateRevisionForPathsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{31}
+	return fileDescriptor_dd8723cfcc820480, []int{32}
 }
 func (m *UpdateRevisionForPathsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2385,7 +2507,7 @@ func (m *UpdateRevisionForPathsResponse) Reset()         { *m = UpdateRevisionFo
 func (m *UpdateRevisionForPathsResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateRevisionForPathsResponse) ProtoMessage()    {}
 func (*UpdateRevisionForPathsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{32}
+	return fileDescriptor_dd8723cfcc820480, []int{33}
 }
 func (m *UpdateRevisionForPathsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2432,6 +2554,7 @@ func init() {
 	proto.RegisterType((*ManifestRequest)(nil), "repository.ManifestRequest")
 	proto.RegisterMapType((map[string]bool)(nil), "repository.ManifestRequest.EnabledSourceTypesEntry")
 	proto.RegisterMapType((map[string]*v1alpha1.RefTarget)(nil), "repository.ManifestRequest.RefSourcesEntry")
+	proto.RegisterType((*HelmLookupProxy)(nil), "repository.HelmLookupProxy")
 	proto.RegisterType((*ManifestRequestWithFiles)(nil), "repository.ManifestRequestWithFiles")
 	proto.RegisterType((*ManifestFileMetadata)(nil), "repository.ManifestFileMetadata")
 	proto.RegisterType((*ManifestFileChunk)(nil), "repository.ManifestFileChunk")
@@ -2478,156 +2601,165 @@ func init() {
 }
 
 var fileDescriptor_dd8723cfcc820480 = []byte{
-	// 2377 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1a, 0x4d, 0x73, 0x1c, 0x47,
-	0x55, 0xfb, 0x25, 0xed, 0x3e, 0x59, 0x5f, 0x6d, 0x5b, 0x1e, 0xaf, 0x6d, 0xa1, 0x0c, 0xd8, 0xe5,
-	0xd8, 0xc9, 0xaa, 0x6c, 0x57, 0x62, 0x70, 0x42, 0x28, 0x45, 0xb6, 0x25, 0xc7, 0x96, 0x2d, 0xc6,
-	0x4e, 0x28, 0x83, 0x81, 0xea, 0x9d, 0x6d, 0xed, 0x76, 0x34, 0x1f, 0xed, 0x99, 0x1e, 0x05, 0xb9,
-	0x8a, 0x0b, 0x50, 0x5c, 0xb8, 0x73, 0xe0, 0xca, 0x6f, 0xa0, 0x38, 0x72, 0xa0, 0x28, 0x38, 0x52,
-	0x5c, 0xb8, 0x50, 0x05, 0xe5, 0x5f, 0x42, 0xf5, 0xc7, 0x7c, 0xee, 0xec, 0x4a, 0x61, 0x65, 0x05,
-	0xb8, 0x48, 0xd3, 0xaf, 0x5f, 0xbf, 0xf7, 0xfa, 0x7d, 0xf5, 0x7b, 0xdd, 0x0b, 0x57, 0x02, 0xc2,
-	0xfc, 0x90, 0x04, 0xfb, 0x24, 0x58, 0x93, 0x9f, 0x94, 0xfb, 0xc1, 0x41, 0xe6, 0xb3, 0xc3, 0x02,
-	0x9f, 0xfb, 0x08, 0x52, 0x48, 0xfb, 0x51, 0x9f, 0xf2, 0x41, 0xd4, 0xed, 0xd8, 0xbe, 0xbb, 0x86,
-	0x83, 0xbe, 0xcf, 0x02, 0xff, 0x73, 0xf9, 0xf1, 0xae, 0xdd, 0x5b, 0xdb, 0xbf, 0xb5, 0xc6, 0xf6,
-	0xfa, 0x6b, 0x98, 0xd1, 0x70, 0x0d, 0x33, 0xe6, 0x50, 0x1b, 0x73, 0xea, 0x7b, 0x6b, 0xfb, 0x37,
-	0xb0, 0xc3, 0x06, 0xf8, 0xc6, 0x5a, 0x9f, 0x78, 0x24, 0xc0, 0x9c, 0xf4, 0x14, 0xe5, 0xf6, 0x85,
-	0xbe, 0xef, 0xf7, 0x1d, 0xb2, 0x26, 0x47, 0xdd, 0x68, 0x77, 0x8d, 0xb8, 0x8c, 0x6b, 0xb6, 0xe6,
-	0x3f, 0xe6, 0x60, 0x61, 0x1b, 0x7b, 0x74, 0x97, 0x84, 0xdc, 0x22, 0x2f, 0x23, 0x12, 0x72, 0xf4,
-	0x02, 0xea, 0x42, 0x18, 0xa3, 0xb2, 0x5a, 0xb9, 0x3a, 0x7b, 0x73, 0xab, 0x93, 0x4a, 0xd3, 0x89,
-	0xa5, 0x91, 0x1f, 0x3f, 0xb6, 0x7b, 0x9d, 0xfd, 0x5b, 0x1d, 0xb6, 0xd7, 0xef, 0x08, 0x69, 0x3a,
-	0x19, 0x69, 0x3a, 0xb1, 0x34, 0x1d, 0x2b, 0xd9, 0x96, 0x25, 0xa9, 0xa2, 0x36, 0x34, 0x03, 0xb2,
-	0x4f, 0x43, 0xea, 0x7b, 0x46, 0x75, 0xb5, 0x72, 0xb5, 0x65, 0x25, 0x63, 0x64, 0xc0, 0x8c, 0xe7,
-	0x6f, 0x60, 0x7b, 0x40, 0x8c, 0xda, 0x6a, 0xe5, 0x6a, 0xd3, 0x8a, 0x87, 0x68, 0x15, 0x66, 0x31,
-	0x63, 0x8f, 0x70, 0x97, 0x38, 0x0f, 0xc9, 0x81, 0x51, 0x97, 0x0b, 0xb3, 0x20, 0xb1, 0x16, 0x33,
-	0xf6, 0x18, 0xbb, 0xc4, 0x68, 0xc8, 0xd9, 0x78, 0x88, 0x2e, 0x42, 0xcb, 0xc3, 0x2e, 0x09, 0x19,
-	0xb6, 0x89, 0xd1, 0x94, 0x73, 0x29, 0x00, 0xfd, 0x14, 0x96, 0x32, 0x82, 0x3f, 0xf5, 0xa3, 0xc0,
-	0x26, 0x06, 0xc8, 0xad, 0x3f, 0x99, 0x6c, 0xeb, 0xeb, 0x45, 0xb2, 0xd6, 0x30, 0x27, 0xf4, 0x23,
-	0x68, 0x48, 0xcb, 0x1b, 0xb3, 0xab, 0xb5, 0x63, 0xd5, 0xb6, 0x22, 0x8b, 0x3c, 0x98, 0x61, 0x4e,
-	0xd4, 0xa7, 0x5e, 0x68, 0x9c, 0x92, 0x1c, 0x9e, 0x4d, 0xc6, 0x61, 0xc3, 0xf7, 0x76, 0x69, 0x7f,
-	0x1b, 0x7b, 0xb8, 0x4f, 0x5c, 0xe2, 0xf1, 0x1d, 0x49, 0xdc, 0x8a, 0x99, 0xa0, 0x57, 0xb0, 0xb8,
-	0x17, 0x85, 0xdc, 0x77, 0xe9, 0x2b, 0xf2, 0x84, 0x89, 0xb5, 0xa1, 0x31, 0x27, 0xb5, 0xf9, 0x78,
-	0x32, 0xc6, 0x0f, 0x0b, 0x54, 0xad, 0x21, 0x3e, 0xc2, 0x49, 0xf6, 0xa2, 0x2e, 0xf9, 0x8c, 0x04,
-	0xd2, 0xbb, 0xe6, 0x95, 0x93, 0x64, 0x40, 0xca, 0x8d, 0xa8, 0x1e, 0x85, 0xc6, 0xc2, 0x6a, 0x4d,
-	0xb9, 0x51, 0x02, 0x42, 0x57, 0x61, 0x61, 0x9f, 0x04, 0x74, 0xf7, 0xe0, 0x29, 0xed, 0x7b, 0x98,
-	0x47, 0x01, 0x31, 0x16, 0xa5, 0x2b, 0x16, 0xc1, 0xc8, 0x85, 0xb9, 0x01, 0x71, 0x5c, 0xa1, 0xf2,
-	0x8d, 0x80, 0xf4, 0x42, 0x63, 0x49, 0xea, 0x77, 0x73, 0x72, 0x0b, 0x4a, 0x72, 0x56, 0x9e, 0xba,
-	0x10, 0xcc, 0xf3, 0x2d, 0x1d, 0x29, 0x2a, 0x46, 0x90, 0x12, 0xac, 0x00, 0x46, 0x57, 0x60, 0x9e,
-	0x07, 0xd8, 0xde, 0xa3, 0x5e, 0x7f, 0x9b, 0xf0, 0x81, 0xdf, 0x33, 0x4e, 0x4b, 0x4d, 0x14, 0xa0,
-	0xc8, 0x06, 0x44, 0x3c, 0xdc, 0x75, 0x48, 0x4f, 0xf9, 0xe2, 0xb3, 0x03, 0x46, 0x42, 0xe3, 0x8c,
-	0xdc, 0xc5, 0xad, 0x4e, 0x26, 0x43, 0x15, 0x12, 0x44, 0xe7, 0xde, 0xd0, 0xaa, 0x7b, 0x1e, 0x0f,
-	0x0e, 0xac, 0x12, 0x72, 0x68, 0x0f, 0x66, 0xc5, 0x3e, 0x62, 0x57, 0x38, 0x2b, 0x5d, 0xe1, 0xc1,
-	0x64, 0x3a, 0xda, 0x4a, 0x09, 0x5a, 0x59, 0xea, 0xa8, 0x03, 0x68, 0x80, 0xc3, 0xed, 0xc8, 0xe1,
-	0x94, 0x39, 0x44, 0x89, 0x11, 0x1a, 0xcb, 0x52, 0x4d, 0x25, 0x33, 0xe8, 0x21, 0x40, 0x40, 0x76,
-	0x63, 0xbc, 0x73, 0x72, 0xe7, 0xd7, 0xc7, 0xed, 0xdc, 0x4a, 0xb0, 0xd5, 0x8e, 0x33, 0xcb, 0x05,
-	0x73, 0xb1, 0x0d, 0x62, 0x73, 0x1d, 0xed, 0x32, 0xac, 0x0d, 0xe9, 0x62, 0x25, 0x33, 0xc2, 0x17,
-	0x35, 0x54, 0x26, 0xad, 0xf3, 0xca, 0x5b, 0x33, 0x20, 0xb4, 0x05, 0x5f, 0xc3, 0x9e, 0xe7, 0x73,
-	0xb9, 0xfd, 0x58, 0x94, 0x4d, 0x9d, 0xde, 0x77, 0x30, 0x1f, 0x84, 0x46, 0x5b, 0xae, 0x3a, 0x0c,
-	0x4d, 0xb8, 0x04, 0xf5, 0x42, 0x8e, 0x1d, 0x47, 0x22, 0x3d, 0xb8, 0x6b, 0x5c, 0x50, 0x2e, 0x91,
-	0x87, 0xb6, 0xef, 0xc1, 0xb9, 0x11, 0xc6, 0x45, 0x8b, 0x50, 0xdb, 0x23, 0x07, 0xf2, 0x50, 0x68,
-	0x59, 0xe2, 0x13, 0x9d, 0x81, 0xc6, 0x3e, 0x76, 0x22, 0x22, 0xd3, 0x78, 0xd3, 0x52, 0x83, 0x3b,
-	0xd5, 0x6f, 0x56, 0xda, 0xbf, 0xac, 0xc0, 0x42, 0x41, 0x55, 0x25, 0xeb, 0x7f, 0x98, 0x5d, 0x7f,
-	0x0c, 0x81, 0xb3, 0xfb, 0x0c, 0x07, 0x7d, 0xc2, 0x33, 0x82, 0x98, 0x7f, 0xab, 0x80, 0x51, 0xb0,
-	0xe1, 0xf7, 0x28, 0x1f, 0xdc, 0xa7, 0x0e, 0x09, 0xd1, 0x6d, 0x98, 0x09, 0x14, 0x4c, 0x1f, 0x75,
-	0x17, 0xc6, 0x98, 0x7e, 0x6b, 0xca, 0x8a, 0xb1, 0xd1, 0x47, 0xd0, 0x74, 0x09, 0xc7, 0x3d, 0xcc,
-	0xb1, 0x96, 0x7d, 0xb5, 0x6c, 0xa5, 0xe0, 0xb2, 0xad, 0xf1, 0xb6, 0xa6, 0xac, 0x64, 0x0d, 0x7a,
-	0x0f, 0x1a, 0xf6, 0x20, 0xf2, 0xf6, 0xe4, 0x21, 0x37, 0x7b, 0xf3, 0xd2, 0xa8, 0xc5, 0x1b, 0x02,
-	0x69, 0x6b, 0xca, 0x52, 0xd8, 0x1f, 0x4f, 0x43, 0x9d, 0xe1, 0x80, 0x9b, 0xf7, 0xe1, 0x4c, 0x19,
-	0x0b, 0x71, 0xb2, 0xda, 0x03, 0x62, 0xef, 0x85, 0x91, 0xab, 0xd5, 0x9c, 0x8c, 0x11, 0x82, 0x7a,
-	0x48, 0x5f, 0x29, 0x55, 0xd7, 0x2c, 0xf9, 0x6d, 0xbe, 0x0d, 0x4b, 0x43, 0xdc, 0x84, 0x51, 0x95,
-	0x6c, 0x82, 0xc2, 0x29, 0xcd, 0xda, 0x8c, 0xe0, 0xec, 0x33, 0xa9, 0x8b, 0xe4, 0x78, 0x39, 0x89,
-	0x5a, 0xc1, 0xdc, 0x82, 0xe5, 0x22, 0xdb, 0x90, 0xf9, 0x5e, 0x48, 0x44, 0xb0, 0xc9, 0x7c, 0x4c,
-	0x49, 0x2f, 0x9d, 0x95, 0x52, 0x34, 0xad, 0x92, 0x19, 0xf3, 0xb7, 0x55, 0x58, 0xb6, 0x48, 0xe8,
-	0x3b, 0xfb, 0x24, 0x4e, 0x96, 0x27, 0x53, 0xee, 0xfc, 0x00, 0x6a, 0x98, 0x31, 0xed, 0x26, 0x0f,
-	0x8e, 0xad, 0xa0, 0xb0, 0x04, 0x55, 0xf4, 0x0e, 0x2c, 0x61, 0xb7, 0x4b, 0xfb, 0x91, 0x1f, 0x85,
-	0xf1, 0xb6, 0xa4, 0x53, 0xb5, 0xac, 0xe1, 0x09, 0x91, 0x70, 0x42, 0x19, 0x91, 0x0f, 0xbc, 0x1e,
-	0xf9, 0x89, 0xac, 0xa1, 0x6a, 0x56, 0x16, 0x64, 0xda, 0x70, 0x6e, 0x48, 0x49, 0x5a, 0xe1, 0xd9,
-	0xb2, 0xad, 0x52, 0x28, 0xdb, 0x4a, 0xc5, 0xa8, 0x8e, 0x10, 0xc3, 0x7c, 0x5d, 0x81, 0xc5, 0x34,
-	0xb8, 0x34, 0xf9, 0x8b, 0xd0, 0x72, 0x35, 0x2c, 0x34, 0x2a, 0x32, 0x67, 0xa6, 0x80, 0x7c, 0x05,
-	0x57, 0x2d, 0x56, 0x70, 0xcb, 0x30, 0xad, 0x0a, 0x6c, 0xbd, 0x75, 0x3d, 0xca, 0x89, 0x5c, 0x2f,
-	0x88, 0xbc, 0x02, 0x10, 0x26, 0x19, 0xce, 0x98, 0x96, 0xb3, 0x19, 0x08, 0x32, 0xe1, 0x94, 0x3a,
-	0xef, 0x2d, 0x12, 0x46, 0x0e, 0x37, 0x66, 0x24, 0x46, 0x0e, 0x26, 0xe3, 0xcd, 0x77, 0x5d, 0xec,
-	0xf5, 0x42, 0xa3, 0x29, 0x45, 0x4e, 0xc6, 0xa6, 0x0f, 0x0b, 0x8f, 0xa8, 0xd8, 0xdf, 0x6e, 0x78,
-	0x32, 0xa1, 0xf2, 0x3e, 0xd4, 0x05, 0x33, 0x21, 0x54, 0x37, 0xc0, 0x9e, 0x3d, 0x20, 0xb1, 0x1e,
-	0x93, 0xb1, 0x48, 0x02, 0x1c, 0xf7, 0x43, 0xa3, 0x2a, 0xe1, 0xf2, 0xdb, 0xfc, 0x7d, 0x55, 0x49,
-	0xba, 0xce, 0x58, 0xf8, 0xd5, 0x37, 0x00, 0xe5, 0x25, 0x49, 0x6d, 0xb8, 0x24, 0x29, 0x88, 0xfc,
-	0x65, 0x4a, 0x92, 0x63, 0x3a, 0xe4, 0xcc, 0x08, 0x66, 0xd6, 0x19, 0x13, 0x82, 0xa0, 0x1b, 0x50,
-	0xc7, 0x8c, 0x29, 0x85, 0x17, 0xf2, 0xb9, 0x46, 0x11, 0xff, 0xb5, 0x48, 0x12, 0xb5, 0x7d, 0x1b,
-	0x5a, 0x09, 0xe8, 0x30, 0xb6, 0xad, 0x2c, 0xdb, 0x55, 0x00, 0x55, 0x73, 0x3f, 0xf0, 0x76, 0x7d,
-	0x61, 0x52, 0x11, 0x08, 0x7a, 0xa9, 0xfc, 0x36, 0xef, 0xc4, 0x18, 0x52, 0xb6, 0x77, 0xa0, 0x41,
-	0x39, 0x71, 0x63, 0xe1, 0x96, 0xb3, 0xc2, 0xa5, 0x84, 0x2c, 0x85, 0x64, 0xfe, 0xb9, 0x09, 0xe7,
-	0x85, 0xc5, 0x9e, 0xca, 0x10, 0x5a, 0x67, 0xec, 0x2e, 0xe1, 0x98, 0x3a, 0xe1, 0x77, 0x23, 0x12,
-	0x1c, 0xbc, 0x61, 0xc7, 0xe8, 0xc3, 0xb4, 0x8a, 0x40, 0x9d, 0x2d, 0x8f, 0xbd, 0xfd, 0xd2, 0xe4,
-	0xd3, 0x9e, 0xab, 0xf6, 0x66, 0x7a, 0xae, 0xb2, 0x1e, 0xa8, 0x7e, 0x42, 0x3d, 0xd0, 0xe8, 0x36,
-	0x38, 0xd3, 0x5c, 0x4f, 0xe7, 0x9b, 0xeb, 0x92, 0xd6, 0x62, 0xe6, 0xa8, 0xad, 0x45, 0xb3, 0xb4,
-	0xb5, 0x70, 0x4b, 0xe3, 0xb8, 0x25, 0xd5, 0xfd, 0xed, 0xac, 0x07, 0x8e, 0xf4, 0xb5, 0x49, 0x9a,
-	0x0c, 0x78, 0xa3, 0x4d, 0xc6, 0xa7, 0xb9, 0xa6, 0x41, 0xb5, 0xed, 0xef, 0x1d, 0x6d, 0x4f, 0x63,
-	0xda, 0x87, 0xff, 0xbb, 0xd2, 0xfb, 0x17, 0xb2, 0xe2, 0x62, 0x7e, 0xaa, 0x83, 0xe4, 0xb0, 0x17,
-	0xe7, 0x90, 0x38, 0x76, 0x75, 0xd2, 0x12, 0xdf, 0xe8, 0x3a, 0xd4, 0x85, 0x92, 0x75, 0x49, 0x7c,
-	0x2e, 0xab, 0x4f, 0x61, 0x89, 0x75, 0xc6, 0x9e, 0x32, 0x62, 0x5b, 0x12, 0x09, 0xdd, 0x81, 0x56,
-	0xe2, 0xf8, 0x3a, 0xb2, 0x2e, 0x66, 0x57, 0x24, 0x71, 0x12, 0x2f, 0x4b, 0xd1, 0xc5, 0xda, 0x1e,
-	0x0d, 0x88, 0x2d, 0x0b, 0xc6, 0xc6, 0xf0, 0xda, 0xbb, 0xf1, 0x64, 0xb2, 0x36, 0x41, 0x47, 0x37,
-	0x60, 0x5a, 0xdd, 0x73, 0xc8, 0x08, 0x9a, 0xbd, 0x79, 0x7e, 0x38, 0x99, 0xc6, 0xab, 0x34, 0xa2,
-	0xf9, 0xa7, 0x0a, 0xbc, 0x95, 0x3a, 0x44, 0x1c, 0x4d, 0x71, 0xcd, 0xfe, 0xd5, 0x9f, 0xb8, 0x57,
-	0x60, 0x5e, 0x36, 0x09, 0xe9, 0x75, 0x87, 0xba, 0x79, 0x2b, 0x40, 0xcd, 0xdf, 0x55, 0xe0, 0xf2,
-	0xf0, 0x3e, 0x36, 0x06, 0x38, 0xe0, 0x89, 0x79, 0x4f, 0x62, 0x2f, 0xf1, 0x81, 0x57, 0x4d, 0x0f,
-	0xbc, 0xdc, 0xfe, 0x6a, 0xf9, 0xfd, 0x99, 0x7f, 0xa8, 0xc2, 0x6c, 0xc6, 0x81, 0xca, 0x0e, 0x4c,
-	0x51, 0x0c, 0x4a, 0xbf, 0x95, 0x6d, 0xa1, 0x3c, 0x14, 0x5a, 0x56, 0x06, 0x82, 0xf6, 0x00, 0x18,
-	0x0e, 0xb0, 0x4b, 0x38, 0x09, 0x44, 0x26, 0x17, 0x11, 0xff, 0x70, 0xf2, 0xec, 0xb2, 0x13, 0xd3,
-	0xb4, 0x32, 0xe4, 0x45, 0x35, 0x2b, 0x59, 0x87, 0x3a, 0x7f, 0xeb, 0x11, 0xfa, 0x02, 0xe6, 0x77,
-	0xa9, 0x43, 0x76, 0x52, 0x41, 0xa6, 0xa5, 0x20, 0x4f, 0x26, 0x17, 0xe4, 0x7e, 0x96, 0xae, 0x55,
-	0x60, 0x63, 0x5e, 0x83, 0xc5, 0x62, 0x3c, 0x09, 0x21, 0xa9, 0x8b, 0xfb, 0x89, 0xb6, 0xf4, 0xc8,
-	0x44, 0xb0, 0x58, 0x8c, 0x1f, 0xf3, 0x9f, 0x55, 0x38, 0x9b, 0x90, 0x5b, 0xf7, 0x3c, 0x3f, 0xf2,
-	0x6c, 0x79, 0x75, 0x58, 0x6a, 0x8b, 0x33, 0xd0, 0xe0, 0x94, 0x3b, 0x49, 0xe1, 0x23, 0x07, 0xe2,
-	0xec, 0xe2, 0xbe, 0xef, 0x70, 0xca, 0xb4, 0x81, 0xe3, 0xa1, 0xb2, 0xfd, 0xcb, 0x88, 0x06, 0xa4,
-	0x27, 0x33, 0x41, 0xd3, 0x4a, 0xc6, 0x62, 0x4e, 0x54, 0x35, 0xb2, 0xc4, 0x57, 0xca, 0x4c, 0xc6,
-	0xd2, 0xef, 0x7d, 0xc7, 0x21, 0xb6, 0x50, 0x47, 0xa6, 0x09, 0x28, 0x40, 0x65, 0x73, 0xc1, 0x03,
-	0xea, 0xf5, 0x75, 0x0b, 0xa0, 0x47, 0x42, 0x4e, 0x1c, 0x04, 0xf8, 0x40, 0x57, 0xfe, 0x6a, 0x80,
-	0x3e, 0x84, 0x9a, 0x8b, 0x99, 0x3e, 0xe8, 0xae, 0xe5, 0xb2, 0x43, 0x99, 0x06, 0x3a, 0xdb, 0x98,
-	0xa9, 0x93, 0x40, 0x2c, 0x6b, 0xbf, 0x0f, 0xcd, 0x18, 0xf0, 0xa5, 0x4a, 0xc2, 0xcf, 0x61, 0x2e,
-	0x97, 0x7c, 0xd0, 0x73, 0x58, 0x4e, 0x3d, 0x2a, 0xcb, 0x50, 0x17, 0x81, 0x6f, 0x1d, 0x2a, 0x99,
-	0x35, 0x82, 0x80, 0xf9, 0x12, 0x96, 0x84, 0xcb, 0xc8, 0xc0, 0x3f, 0xa1, 0xd6, 0xe6, 0x03, 0x68,
-	0x25, 0x2c, 0x4b, 0x7d, 0xa6, 0x0d, 0xcd, 0xfd, 0xf8, 0x4a, 0x57, 0xf5, 0x36, 0xc9, 0xd8, 0x5c,
-	0x07, 0x94, 0x95, 0x57, 0x9f, 0x40, 0xd7, 0xf3, 0x45, 0xf1, 0xd9, 0xe2, 0x71, 0x23, 0xd1, 0xe3,
-	0x9a, 0xf8, 0xef, 0x55, 0x58, 0xd8, 0xa4, 0xf2, 0x8e, 0xe4, 0x84, 0x92, 0xdc, 0x35, 0x58, 0x0c,
-	0xa3, 0xae, 0xeb, 0xf7, 0x22, 0x87, 0xe8, 0xa2, 0x40, 0x9f, 0xf4, 0x43, 0xf0, 0x71, 0xc9, 0x4f,
-	0x28, 0x8b, 0x61, 0x3e, 0xd0, 0xdd, 0xaf, 0xfc, 0x46, 0x1f, 0xc2, 0xf9, 0xc7, 0xe4, 0x0b, 0xbd,
-	0x9f, 0x4d, 0xc7, 0xef, 0x76, 0xa9, 0xd7, 0x8f, 0x99, 0x34, 0x24, 0x93, 0xd1, 0x08, 0x65, 0xa5,
-	0xe2, 0x74, 0x79, 0xa9, 0x98, 0x74, 0xd0, 0x1b, 0xbe, 0xeb, 0x52, 0xae, 0x2b, 0xca, 0x1c, 0xcc,
-	0xfc, 0x79, 0x05, 0x16, 0x53, 0xcd, 0x6a, 0xdb, 0xdc, 0x56, 0x31, 0xa4, 0x2c, 0x73, 0x39, 0x6b,
-	0x99, 0x22, 0xea, 0x7f, 0x1e, 0x3e, 0xa7, 0xb2, 0xe1, 0xf3, 0xab, 0x2a, 0x9c, 0xdd, 0xa4, 0x3c,
-	0x4e, 0x5c, 0xf4, 0x7f, 0xcd, 0xca, 0x25, 0x36, 0xa9, 0x1f, 0xcd, 0x26, 0x8d, 0x12, 0x9b, 0x74,
-	0x60, 0xb9, 0xa8, 0x0c, 0x6d, 0x98, 0x33, 0xd0, 0x60, 0xf2, 0xd2, 0x59, 0xdd, 0x2b, 0xa8, 0x81,
-	0xf9, 0xb3, 0x19, 0xb8, 0xf4, 0x29, 0xeb, 0x61, 0x9e, 0xdc, 0x19, 0xdd, 0xf7, 0x03, 0x79, 0xeb,
-	0x7c, 0x32, 0x5a, 0x2c, 0xbc, 0x0c, 0x56, 0xc7, 0xbe, 0x0c, 0xd6, 0xc6, 0xbc, 0x0c, 0xd6, 0x8f,
-	0xf4, 0x32, 0xd8, 0x38, 0xb1, 0x97, 0xc1, 0xe1, 0x5e, 0x6b, 0xba, 0xb4, 0xd7, 0x7a, 0x9e, 0xeb,
-	0x47, 0x66, 0x64, 0xd8, 0x7c, 0x2b, 0x1b, 0x36, 0x63, 0xad, 0x33, 0xf6, 0x49, 0xa3, 0xf0, 0xa0,
-	0xd6, 0x3c, 0xf4, 0x41, 0xad, 0x35, 0xfc, 0xa0, 0x56, 0xfe, 0x26, 0x03, 0x23, 0xdf, 0x64, 0xae,
-	0xc0, 0x7c, 0x78, 0xe0, 0xd9, 0xa4, 0x97, 0xdc, 0x24, 0xce, 0xaa, 0x6d, 0xe7, 0xa1, 0xb9, 0x88,
-	0x38, 0x55, 0x88, 0x88, 0xc4, 0x53, 0xe7, 0x32, 0x9e, 0x5a, 0x16, 0x27, 0xf3, 0x23, 0xdb, 0xdc,
-	0xc2, 0x73, 0xc9, 0x42, 0xe9, 0x73, 0xc9, 0x7f, 0x4d, 0xb3, 0xf5, 0x19, 0xac, 0x8c, 0xb2, 0xb2,
-	0x0e, 0x5e, 0x03, 0x66, 0xec, 0x01, 0xf6, 0xfa, 0xf2, 0x5a, 0x50, 0x76, 0xff, 0x7a, 0x38, 0xae,
-	0x3b, 0xb8, 0xf9, 0x47, 0x80, 0xa5, 0xb4, 0xea, 0x17, 0x7f, 0xa9, 0x4d, 0xd0, 0x13, 0x58, 0x8c,
-	0x9f, 0x97, 0xe2, 0x8b, 0x5c, 0x34, 0xee, 0xed, 0xa4, 0x7d, 0xb1, 0x7c, 0x52, 0x89, 0x66, 0x4e,
-	0x21, 0x1b, 0xce, 0x17, 0x09, 0xa6, 0xcf, 0x34, 0xdf, 0x18, 0x43, 0x39, 0xc1, 0x3a, 0x8c, 0xc5,
-	0xd5, 0x0a, 0x7a, 0x0e, 0xf3, 0xf9, 0xc7, 0x04, 0x94, 0x2b, 0x83, 0x4a, 0xdf, 0x37, 0xda, 0xe6,
-	0x38, 0x94, 0x44, 0xfe, 0x17, 0xc2, 0x0d, 0x72, 0xf7, 0xe6, 0xc8, 0xcc, 0xdf, 0x08, 0x94, 0xbd,
-	0x3c, 0xb4, 0xbf, 0x3e, 0x16, 0x27, 0xa1, 0xfe, 0x01, 0x34, 0xe3, 0xbb, 0xe4, 0xbc, 0x9a, 0x0b,
-	0x37, 0xcc, 0xed, 0xc5, 0x3c, 0xbd, 0xdd, 0xd0, 0x9c, 0x42, 0x1f, 0xa9, 0xc5, 0xeb, 0x8c, 0x95,
-	0x2c, 0xce, 0xdc, 0xa0, 0xb6, 0x4f, 0x97, 0xdc, 0x5a, 0x9a, 0x53, 0xe8, 0x3b, 0x30, 0x2b, 0xbe,
-	0x76, 0xf4, 0xf3, 0xfe, 0x72, 0x47, 0xfd, 0x9a, 0xa4, 0x13, 0xff, 0x9a, 0xa4, 0x73, 0xcf, 0x65,
-	0xfc, 0xa0, 0x5d, 0x72, 0xad, 0xa8, 0x09, 0xbc, 0x80, 0xb9, 0x4d, 0xc2, 0xd3, 0x5b, 0x00, 0x74,
-	0xf9, 0x48, 0x77, 0x25, 0x6d, 0xb3, 0x88, 0x36, 0x7c, 0x91, 0x60, 0x4e, 0xa1, 0x5f, 0x57, 0xe0,
-	0xf4, 0x26, 0xe1, 0xc5, 0xbe, 0x1a, 0xbd, 0x5b, 0xce, 0x64, 0x44, 0xff, 0xdd, 0x7e, 0x3c, 0x69,
-	0x4c, 0xe6, 0xc9, 0x9a, 0x53, 0xe8, 0x37, 0x15, 0x38, 0x97, 0x11, 0x2c, 0xdb, 0x28, 0xa3, 0x1b,
-	0xe3, 0x85, 0x2b, 0x69, 0xaa, 0xdb, 0x9f, 0x4c, 0xf8, 0xab, 0x8d, 0x0c, 0x49, 0x73, 0x0a, 0xed,
-	0x48, 0x9b, 0xa4, 0x75, 0x31, 0xba, 0x54, 0x5a, 0x00, 0x27, 0xdc, 0x57, 0x46, 0x4d, 0x27, 0x76,
-	0xf8, 0x04, 0x66, 0x37, 0x09, 0x8f, 0x0b, 0xb4, 0xbc, 0xa7, 0x15, 0x6a, 0xe7, 0x7c, 0xa8, 0x16,
-	0x6b, 0x3a, 0xe9, 0x31, 0x4b, 0x8a, 0x56, 0xa6, 0x08, 0xc9, 0xc7, 0x6a, 0x69, 0xb5, 0x96, 0xf7,
-	0x98, 0xf2, 0x1a, 0xc6, 0x9c, 0x42, 0x2f, 0x61, 0xb9, 0x3c, 0x55, 0xa2, 0xb7, 0x8f, 0x7c, 0x68,
-	0xb6, 0xaf, 0x1d, 0x05, 0x35, 0x66, 0xf9, 0xf1, 0xfa, 0x5f, 0x5e, 0xaf, 0x54, 0xfe, 0xfa, 0x7a,
-	0xa5, 0xf2, 0xaf, 0xd7, 0x2b, 0x95, 0xef, 0xdf, 0x3a, 0xe4, 0xd7, 0x5d, 0x99, 0x1f, 0x8c, 0x61,
-	0x46, 0x6d, 0x87, 0x12, 0x8f, 0x77, 0xa7, 0x65, 0xbc, 0xdd, 0xfa, 0x77, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x17, 0x3e, 0x16, 0x5a, 0x4f, 0x26, 0x00, 0x00,
+	// 2526 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1a, 0x5d, 0x73, 0x1c, 0x47,
+	0x51, 0x7b, 0x27, 0x9d, 0xee, 0x5a, 0xb6, 0x74, 0x9a, 0xd8, 0xf2, 0x7a, 0x2d, 0x2b, 0xca, 0x82,
+	0x5d, 0x8a, 0x9d, 0x9c, 0xca, 0x76, 0x25, 0x26, 0x4e, 0x80, 0x52, 0x64, 0x5b, 0x72, 0x6c, 0xd9,
+	0x62, 0xed, 0xd8, 0x65, 0x30, 0x50, 0x73, 0x7b, 0xa3, 0xbb, 0xcd, 0xed, 0xc7, 0x78, 0x3f, 0x14,
+	0xcb, 0x55, 0xbc, 0x00, 0xc5, 0x4f, 0x80, 0x2a, 0x5e, 0xf9, 0x05, 0x3c, 0x50, 0x3c, 0xf2, 0x40,
+	0x51, 0xf0, 0x48, 0xc1, 0x03, 0x8f, 0x50, 0xfe, 0x25, 0xd4, 0x7c, 0xec, 0xde, 0xec, 0xde, 0xde,
+	0x49, 0xe1, 0x6c, 0x05, 0x78, 0x91, 0x76, 0x7a, 0x7a, 0xba, 0x7b, 0x7a, 0xba, 0xa7, 0x3f, 0xe6,
+	0xe0, 0x62, 0x48, 0x68, 0x10, 0x91, 0x70, 0x9f, 0x84, 0xeb, 0xfc, 0xd3, 0x89, 0x83, 0xf0, 0x40,
+	0xf9, 0x6c, 0xd1, 0x30, 0x88, 0x03, 0x04, 0x03, 0x88, 0x71, 0xaf, 0xeb, 0xc4, 0xbd, 0xa4, 0xdd,
+	0xb2, 0x03, 0x6f, 0x1d, 0x87, 0xdd, 0x80, 0x86, 0xc1, 0x17, 0xfc, 0xe3, 0x7d, 0xbb, 0xb3, 0xbe,
+	0x7f, 0x6d, 0x9d, 0xf6, 0xbb, 0xeb, 0x98, 0x3a, 0xd1, 0x3a, 0xa6, 0xd4, 0x75, 0x6c, 0x1c, 0x3b,
+	0x81, 0xbf, 0xbe, 0x7f, 0x05, 0xbb, 0xb4, 0x87, 0xaf, 0xac, 0x77, 0x89, 0x4f, 0x42, 0x1c, 0x93,
+	0x8e, 0xa0, 0x6c, 0x9c, 0xeb, 0x06, 0x41, 0xd7, 0x25, 0xeb, 0x7c, 0xd4, 0x4e, 0xf6, 0xd6, 0x89,
+	0x47, 0x63, 0xc9, 0xd6, 0xfc, 0x6d, 0x13, 0x16, 0x76, 0xb0, 0xef, 0xec, 0x91, 0x28, 0xb6, 0xc8,
+	0xf3, 0x84, 0x44, 0x31, 0x7a, 0x06, 0xd3, 0x4c, 0x18, 0x5d, 0x5b, 0xd5, 0xd6, 0xe6, 0xae, 0x6e,
+	0xb7, 0x06, 0xd2, 0xb4, 0x52, 0x69, 0xf8, 0xc7, 0x8f, 0xed, 0x4e, 0x6b, 0xff, 0x5a, 0x8b, 0xf6,
+	0xbb, 0x2d, 0x26, 0x4d, 0x4b, 0x91, 0xa6, 0x95, 0x4a, 0xd3, 0xb2, 0xb2, 0x6d, 0x59, 0x9c, 0x2a,
+	0x32, 0xa0, 0x1e, 0x92, 0x7d, 0x27, 0x72, 0x02, 0x5f, 0xaf, 0xac, 0x6a, 0x6b, 0x0d, 0x2b, 0x1b,
+	0x23, 0x1d, 0x66, 0xfd, 0x60, 0x13, 0xdb, 0x3d, 0xa2, 0x57, 0x57, 0xb5, 0xb5, 0xba, 0x95, 0x0e,
+	0xd1, 0x2a, 0xcc, 0x61, 0x4a, 0xef, 0xe1, 0x36, 0x71, 0xef, 0x92, 0x03, 0x7d, 0x9a, 0x2f, 0x54,
+	0x41, 0x6c, 0x2d, 0xa6, 0xf4, 0x3e, 0xf6, 0x88, 0x3e, 0xc3, 0x67, 0xd3, 0x21, 0x5a, 0x86, 0x86,
+	0x8f, 0x3d, 0x12, 0x51, 0x6c, 0x13, 0xbd, 0xce, 0xe7, 0x06, 0x00, 0xf4, 0x13, 0x58, 0x54, 0x04,
+	0x7f, 0x18, 0x24, 0xa1, 0x4d, 0x74, 0xe0, 0x5b, 0x7f, 0x30, 0xd9, 0xd6, 0x37, 0x8a, 0x64, 0xad,
+	0x61, 0x4e, 0xe8, 0x47, 0x30, 0xc3, 0x4f, 0x5e, 0x9f, 0x5b, 0xad, 0xbe, 0x56, 0x6d, 0x0b, 0xb2,
+	0xc8, 0x87, 0x59, 0xea, 0x26, 0x5d, 0xc7, 0x8f, 0xf4, 0x13, 0x9c, 0xc3, 0xa3, 0xc9, 0x38, 0x6c,
+	0x06, 0xfe, 0x9e, 0xd3, 0xdd, 0xc1, 0x3e, 0xee, 0x12, 0x8f, 0xf8, 0xf1, 0x2e, 0x27, 0x6e, 0xa5,
+	0x4c, 0xd0, 0x4b, 0x68, 0xf6, 0x93, 0x28, 0x0e, 0x3c, 0xe7, 0x25, 0x79, 0x40, 0xd9, 0xda, 0x48,
+	0x3f, 0xc9, 0xb5, 0x79, 0x7f, 0x32, 0xc6, 0x77, 0x0b, 0x54, 0xad, 0x21, 0x3e, 0xcc, 0x48, 0xfa,
+	0x49, 0x9b, 0x3c, 0x26, 0x21, 0xb7, 0xae, 0x79, 0x61, 0x24, 0x0a, 0x48, 0x98, 0x91, 0x23, 0x47,
+	0x91, 0xbe, 0xb0, 0x5a, 0x15, 0x66, 0x94, 0x81, 0xd0, 0x1a, 0x2c, 0xec, 0x93, 0xd0, 0xd9, 0x3b,
+	0x78, 0xe8, 0x74, 0x7d, 0x1c, 0x27, 0x21, 0xd1, 0x9b, 0xdc, 0x14, 0x8b, 0x60, 0xe4, 0xc1, 0xc9,
+	0x1e, 0x71, 0x3d, 0xa6, 0xf2, 0xcd, 0x90, 0x74, 0x22, 0x7d, 0x91, 0xeb, 0x77, 0x6b, 0xf2, 0x13,
+	0xe4, 0xe4, 0xac, 0x3c, 0x75, 0x26, 0x98, 0x1f, 0x58, 0xd2, 0x53, 0x84, 0x8f, 0x20, 0x21, 0x58,
+	0x01, 0x8c, 0x2e, 0xc2, 0x7c, 0x1c, 0x62, 0xbb, 0xef, 0xf8, 0xdd, 0x1d, 0x12, 0xf7, 0x82, 0x8e,
+	0xfe, 0x16, 0xd7, 0x44, 0x01, 0x8a, 0x6c, 0x40, 0xc4, 0xc7, 0x6d, 0x97, 0x74, 0x84, 0x2d, 0x3e,
+	0x3a, 0xa0, 0x24, 0xd2, 0x4f, 0xf1, 0x5d, 0x5c, 0x6b, 0x29, 0x37, 0x54, 0xe1, 0x82, 0x68, 0xdd,
+	0x1a, 0x5a, 0x75, 0xcb, 0x8f, 0xc3, 0x03, 0xab, 0x84, 0x1c, 0xea, 0xc3, 0x1c, 0xdb, 0x47, 0x6a,
+	0x0a, 0xa7, 0xb9, 0x29, 0xdc, 0x99, 0x4c, 0x47, 0xdb, 0x03, 0x82, 0x96, 0x4a, 0x1d, 0xb5, 0x00,
+	0xf5, 0x70, 0xb4, 0x93, 0xb8, 0xb1, 0x43, 0x5d, 0x22, 0xc4, 0x88, 0xf4, 0x25, 0xae, 0xa6, 0x92,
+	0x19, 0x74, 0x17, 0x20, 0x24, 0x7b, 0x29, 0xde, 0x19, 0xbe, 0xf3, 0xcb, 0xe3, 0x76, 0x6e, 0x65,
+	0xd8, 0x62, 0xc7, 0xca, 0x72, 0xc6, 0x9c, 0x6d, 0x83, 0xd8, 0xb1, 0x80, 0x70, 0x5f, 0xd4, 0x75,
+	0x6e, 0x62, 0x25, 0x33, 0xcc, 0x16, 0x25, 0x94, 0x5f, 0x5a, 0x67, 0x85, 0xb5, 0x2a, 0x20, 0xb4,
+	0x0d, 0x6f, 0x63, 0xdf, 0x0f, 0x62, 0xbe, 0xfd, 0x54, 0x94, 0x2d, 0x79, 0xbd, 0xef, 0xe2, 0xb8,
+	0x17, 0xe9, 0x06, 0x5f, 0x75, 0x18, 0x1a, 0x33, 0x09, 0xc7, 0x8f, 0x62, 0xec, 0xba, 0x1c, 0xe9,
+	0xce, 0x4d, 0xfd, 0x9c, 0x30, 0x89, 0x3c, 0x14, 0x99, 0x70, 0x22, 0xe2, 0x22, 0x3e, 0xc6, 0x6e,
+	0x42, 0x22, 0x7d, 0x99, 0x4b, 0x9f, 0x83, 0x31, 0x1c, 0x0f, 0xbf, 0xb0, 0x48, 0x24, 0xd5, 0x76,
+	0x7e, 0x55, 0x5b, 0xab, 0x5a, 0x39, 0x18, 0xba, 0x04, 0x4d, 0x0f, 0xbf, 0x48, 0x65, 0x89, 0x1e,
+	0x3a, 0x2f, 0x89, 0xbe, 0xc2, 0xf1, 0x86, 0xe0, 0xe8, 0x16, 0x2c, 0xb0, 0x33, 0xbc, 0x17, 0x04,
+	0xfd, 0x84, 0xee, 0x86, 0xc1, 0x8b, 0x03, 0xfd, 0x6d, 0x6e, 0x25, 0xe7, 0xd4, 0x93, 0xd8, 0xce,
+	0xa3, 0x58, 0xc5, 0x35, 0xe8, 0x57, 0x1a, 0x18, 0x5e, 0x7e, 0xf3, 0x4e, 0xe0, 0x3f, 0x21, 0xed,
+	0x5e, 0x10, 0xf4, 0x23, 0x7d, 0x95, 0x1f, 0xee, 0x93, 0xc9, 0x0c, 0x6f, 0x67, 0x14, 0x7d, 0x6b,
+	0x0c, 0x6b, 0xe3, 0x16, 0x9c, 0x19, 0xe1, 0x31, 0xa8, 0x09, 0xd5, 0x3e, 0x39, 0xe0, 0x91, 0xb6,
+	0x61, 0xb1, 0x4f, 0x74, 0x0a, 0x66, 0xf6, 0x99, 0x9e, 0x79, 0x6c, 0xac, 0x5b, 0x62, 0x70, 0xa3,
+	0xf2, 0x2d, 0xcd, 0xf8, 0x85, 0x06, 0x0b, 0x05, 0xfb, 0x2b, 0x59, 0xff, 0x43, 0x75, 0xfd, 0x6b,
+	0xb8, 0x8d, 0xf6, 0x1e, 0xe1, 0xb0, 0x4b, 0x62, 0x45, 0x10, 0xf3, 0x23, 0x58, 0x28, 0x9c, 0x06,
+	0x93, 0x23, 0x09, 0xdd, 0x54, 0x8e, 0x24, 0x74, 0xd9, 0x3e, 0xe2, 0xa0, 0x4f, 0xd2, 0x18, 0x2f,
+	0x06, 0xe6, 0xdf, 0x34, 0xd0, 0x0b, 0x3e, 0xf5, 0xc4, 0x89, 0x7b, 0xb7, 0x1d, 0x97, 0x44, 0xe8,
+	0x3a, 0xcc, 0x86, 0x02, 0xa6, 0x6b, 0xc3, 0x06, 0x50, 0x58, 0xb6, 0x3d, 0x65, 0xa5, 0xd8, 0xe8,
+	0x3b, 0x50, 0xf7, 0x48, 0x8c, 0x3b, 0x38, 0xc6, 0x72, 0xdb, 0xab, 0x65, 0x2b, 0x19, 0x97, 0x1d,
+	0x89, 0xb7, 0x3d, 0x65, 0x65, 0x6b, 0xd0, 0x07, 0x30, 0x63, 0xf7, 0x12, 0xbf, 0xcf, 0x93, 0x8e,
+	0xb9, 0xab, 0xe7, 0x47, 0x2d, 0xde, 0x64, 0x48, 0xdb, 0x53, 0x96, 0xc0, 0xfe, 0xb4, 0x06, 0xd3,
+	0x14, 0x87, 0xb1, 0x79, 0x1b, 0x4e, 0x95, 0xb1, 0x60, 0x99, 0x8e, 0xdd, 0x23, 0x76, 0x3f, 0x4a,
+	0x3c, 0xa9, 0x99, 0x6c, 0x8c, 0x10, 0x4c, 0x47, 0xcc, 0x29, 0x2a, 0xdc, 0x29, 0xf8, 0xb7, 0xf9,
+	0x2e, 0x2c, 0x0e, 0x71, 0x63, 0x7a, 0x14, 0xb2, 0x31, 0x0a, 0x27, 0x24, 0x6b, 0x33, 0x81, 0xd3,
+	0x8f, 0xb8, 0x2e, 0xb2, 0x70, 0x7f, 0x1c, 0xb9, 0x9b, 0xb9, 0x0d, 0x4b, 0x45, 0xb6, 0x11, 0x0d,
+	0xfc, 0x88, 0xb0, 0xcb, 0x8f, 0xc7, 0x47, 0x87, 0x74, 0x06, 0xb3, 0x5c, 0x8a, 0xba, 0x55, 0x32,
+	0x63, 0xfe, 0xa6, 0x02, 0x4b, 0xec, 0xba, 0x70, 0xf7, 0x49, 0x1a, 0xbc, 0x8e, 0x27, 0xfd, 0xfc,
+	0x01, 0x54, 0x31, 0xa5, 0x7a, 0xe5, 0x75, 0xc4, 0x21, 0x25, 0xc1, 0xb3, 0x18, 0x55, 0xf4, 0x1e,
+	0x2c, 0x62, 0xaf, 0xed, 0x74, 0x93, 0x20, 0x89, 0xd2, 0x6d, 0x71, 0xa3, 0x6a, 0x58, 0xc3, 0x13,
+	0x2c, 0x00, 0x88, 0xfb, 0xf2, 0x8e, 0xdf, 0x21, 0x2f, 0x78, 0x4e, 0x5b, 0xb5, 0x54, 0x90, 0x69,
+	0xc3, 0x99, 0x21, 0x25, 0x49, 0x85, 0xab, 0x69, 0xb4, 0x56, 0x48, 0xa3, 0x4b, 0xc5, 0xa8, 0x8c,
+	0x10, 0xc3, 0x7c, 0xa5, 0x41, 0x73, 0xe0, 0x5c, 0x92, 0xfc, 0x32, 0x34, 0xd2, 0x1b, 0x2d, 0xd2,
+	0x35, 0x1e, 0x05, 0x06, 0x80, 0x7c, 0x46, 0x5d, 0x29, 0x66, 0xd4, 0x4b, 0x50, 0x13, 0x05, 0x8f,
+	0xdc, 0xba, 0x1c, 0xe5, 0x44, 0x9e, 0x2e, 0x88, 0xbc, 0x02, 0x10, 0x65, 0x97, 0xa3, 0x5e, 0xe3,
+	0xb3, 0x0a, 0x84, 0x05, 0x1d, 0x91, 0x7f, 0x59, 0x24, 0x4a, 0xdc, 0x58, 0x9f, 0xe5, 0x18, 0x39,
+	0x18, 0xf7, 0xb7, 0xc0, 0xf3, 0xb0, 0xdf, 0x89, 0xf4, 0x3a, 0x17, 0x39, 0x1b, 0x9b, 0x01, 0x2c,
+	0xdc, 0x73, 0xd8, 0xfe, 0xf6, 0xa2, 0xe3, 0x71, 0x95, 0x0f, 0x61, 0x9a, 0x31, 0x63, 0x42, 0xb5,
+	0x43, 0xec, 0xdb, 0x3d, 0x92, 0xea, 0x31, 0x1b, 0xb3, 0x4b, 0x20, 0xc6, 0xdd, 0x48, 0xaf, 0x70,
+	0x38, 0xff, 0x36, 0x7f, 0x5f, 0x11, 0x92, 0x6e, 0x50, 0x1a, 0x7d, 0xfd, 0x05, 0x59, 0x79, 0x8a,
+	0x58, 0x1d, 0x4e, 0x11, 0x0b, 0x22, 0x7f, 0x95, 0x14, 0xf1, 0x35, 0xc5, 0x47, 0x33, 0x81, 0xd9,
+	0x0d, 0x4a, 0x99, 0x20, 0xe8, 0x0a, 0x4c, 0x63, 0x4a, 0x85, 0xc2, 0x0b, 0xf7, 0xb9, 0x44, 0x61,
+	0xff, 0xa5, 0x48, 0x1c, 0xd5, 0xb8, 0x0e, 0x8d, 0x0c, 0x74, 0x18, 0xdb, 0x86, 0xca, 0x76, 0x15,
+	0x40, 0xd4, 0x40, 0x77, 0xfc, 0xbd, 0x80, 0x1d, 0x29, 0x73, 0x04, 0xb9, 0x94, 0x7f, 0x9b, 0x37,
+	0x52, 0x0c, 0x2e, 0xdb, 0x7b, 0x30, 0xe3, 0xc4, 0xc4, 0x4b, 0x85, 0x5b, 0x52, 0x85, 0x1b, 0x10,
+	0xb2, 0x04, 0x92, 0xf9, 0xe7, 0x3a, 0x9c, 0x65, 0x27, 0xf6, 0x90, 0xbb, 0xd0, 0x06, 0xa5, 0x37,
+	0x49, 0x8c, 0x1d, 0x37, 0xfa, 0x5e, 0x42, 0xc2, 0x83, 0x37, 0x6c, 0x18, 0x5d, 0xa8, 0x09, 0x0f,
+	0xd4, 0x2b, 0x6f, 0xa6, 0x1c, 0xae, 0x45, 0x85, 0x1a, 0xb8, 0xfa, 0x66, 0x6a, 0xe0, 0xb2, 0x9a,
+	0x74, 0xfa, 0x98, 0x6a, 0xd2, 0xd1, 0x6d, 0x09, 0xa5, 0xd9, 0x51, 0xcb, 0x37, 0x3b, 0x4a, 0x4a,
+	0xbd, 0xd9, 0xa3, 0x96, 0x7a, 0xf5, 0xd2, 0x52, 0xcf, 0x2b, 0xf5, 0xe3, 0x06, 0x57, 0xf7, 0xb7,
+	0x55, 0x0b, 0x1c, 0x69, 0x6b, 0x93, 0x14, 0x7d, 0xf0, 0x46, 0x8b, 0xbe, 0xcf, 0x73, 0x45, 0x9c,
+	0x68, 0xa3, 0x7c, 0x70, 0xb4, 0x3d, 0x8d, 0x29, 0xe7, 0xfe, 0xef, 0xb2, 0xf6, 0x9f, 0xf3, 0x8c,
+	0x8b, 0x06, 0x03, 0x1d, 0x64, 0xc1, 0x9e, 0xc5, 0x21, 0x16, 0x76, 0xe5, 0xa5, 0xc5, 0xbe, 0xd1,
+	0x65, 0x98, 0x66, 0x4a, 0x96, 0x29, 0xf1, 0x99, 0x62, 0x29, 0xb6, 0x41, 0xe9, 0x43, 0x4a, 0x6c,
+	0x8b, 0x23, 0xa1, 0x1b, 0xd0, 0xc8, 0x0c, 0x5f, 0x7a, 0xd6, 0xb2, 0xba, 0x22, 0xf3, 0x93, 0x74,
+	0xd9, 0x00, 0x9d, 0xad, 0xed, 0x38, 0x21, 0xb1, 0x19, 0xa2, 0x3e, 0x33, 0xbc, 0xf6, 0x66, 0x3a,
+	0x99, 0xad, 0xcd, 0xd0, 0xd1, 0x15, 0xa8, 0x89, 0xbe, 0x13, 0xf7, 0xa0, 0xb9, 0xab, 0x67, 0x87,
+	0x2f, 0xd3, 0x74, 0x95, 0x44, 0x34, 0xff, 0xa4, 0xc1, 0x3b, 0x03, 0x83, 0x48, 0xbd, 0x29, 0xcd,
+	0xd9, 0xbf, 0xfe, 0x88, 0x7b, 0x11, 0xe6, 0x79, 0x91, 0x30, 0x68, 0x3f, 0x89, 0x4e, 0x68, 0x01,
+	0x6a, 0xfe, 0x4e, 0x83, 0x0b, 0xc3, 0xfb, 0xd8, 0xec, 0xe1, 0x30, 0xce, 0x8e, 0xf7, 0x38, 0xf6,
+	0x92, 0x06, 0xbc, 0xca, 0x20, 0xe0, 0xe5, 0xf6, 0x57, 0xcd, 0xef, 0xcf, 0xfc, 0x43, 0x05, 0xe6,
+	0x14, 0x03, 0x2a, 0x0b, 0x98, 0x2c, 0x19, 0xe4, 0x76, 0xcb, 0xcb, 0x42, 0x1e, 0x14, 0x1a, 0x96,
+	0x02, 0x41, 0x7d, 0x00, 0x8a, 0x43, 0xec, 0x91, 0x98, 0x84, 0xec, 0x26, 0x67, 0x1e, 0x7f, 0x77,
+	0xf2, 0xdb, 0x65, 0x37, 0xa5, 0x69, 0x29, 0xe4, 0x59, 0x36, 0xbb, 0x2f, 0x9a, 0x21, 0xe2, 0xfe,
+	0x96, 0x23, 0xf4, 0x25, 0xcc, 0xef, 0x39, 0x2e, 0xd9, 0x1d, 0x08, 0x52, 0x5b, 0xad, 0x4e, 0x1e,
+	0x25, 0x99, 0x20, 0xb7, 0x55, 0xba, 0x56, 0x81, 0x8d, 0x79, 0x09, 0x9a, 0x45, 0x7f, 0x62, 0x42,
+	0x3a, 0x1e, 0xee, 0x66, 0xda, 0x92, 0x23, 0x13, 0x41, 0xb3, 0xe8, 0x3f, 0xe6, 0x3f, 0x2b, 0x70,
+	0x3a, 0x23, 0xb7, 0xe1, 0xfb, 0x41, 0xe2, 0xdb, 0xbc, 0x95, 0x5b, 0x7a, 0x16, 0xac, 0x8e, 0x77,
+	0x62, 0x37, 0x4b, 0x7c, 0xf8, 0x80, 0xc5, 0xae, 0x38, 0x08, 0x58, 0x33, 0x4d, 0x1e, 0x70, 0x3a,
+	0x14, 0x67, 0xff, 0x3c, 0x71, 0x42, 0xd2, 0xe1, 0x37, 0x41, 0xdd, 0xca, 0xc6, 0x6c, 0x8e, 0x65,
+	0x35, 0x3c, 0xc5, 0x17, 0xca, 0xcc, 0xc6, 0xdc, 0xee, 0x03, 0xd7, 0x25, 0x36, 0x53, 0x87, 0x52,
+	0x04, 0x14, 0xa0, 0x6c, 0xa7, 0x51, 0x1c, 0x3a, 0x7e, 0x57, 0x96, 0x00, 0x72, 0xc4, 0xe4, 0xc4,
+	0x61, 0x88, 0x0f, 0x64, 0xe6, 0x2f, 0x06, 0xe8, 0x13, 0xa8, 0x7a, 0x98, 0xca, 0x40, 0x77, 0x29,
+	0x77, 0x3b, 0x94, 0x69, 0xa0, 0xb5, 0x83, 0xa9, 0x88, 0x04, 0x6c, 0x99, 0xf1, 0x21, 0xd4, 0x53,
+	0xc0, 0x57, 0x4a, 0x09, 0xbf, 0x80, 0x93, 0xb9, 0xcb, 0x07, 0x3d, 0x85, 0xa5, 0x81, 0x45, 0xa9,
+	0x0c, 0x65, 0x12, 0xf8, 0xce, 0xa1, 0x92, 0x59, 0x23, 0x08, 0x98, 0xcf, 0x61, 0x91, 0x99, 0x0c,
+	0x77, 0xfc, 0x63, 0x2a, 0x6d, 0x3e, 0x86, 0x46, 0xc6, 0xb2, 0xd4, 0x66, 0x0c, 0xa8, 0xef, 0xa7,
+	0x2d, 0x76, 0x51, 0xdb, 0x64, 0x63, 0x73, 0x03, 0x90, 0x2a, 0xaf, 0x8c, 0x40, 0x97, 0xf3, 0x49,
+	0xf1, 0xe9, 0x62, 0xb8, 0xe1, 0xe8, 0x69, 0x4e, 0xfc, 0x8f, 0x0a, 0x2c, 0x6c, 0x39, 0xbc, 0x47,
+	0x72, 0x4c, 0x97, 0xdc, 0x25, 0x68, 0x46, 0x49, 0xdb, 0x0b, 0x3a, 0x89, 0x4b, 0x64, 0x52, 0x20,
+	0x23, 0xfd, 0x10, 0x7c, 0xdc, 0xe5, 0xc7, 0x94, 0x45, 0x71, 0xdc, 0x93, 0xd5, 0x2f, 0xff, 0x46,
+	0x9f, 0xc0, 0xd9, 0xfb, 0xe4, 0x4b, 0xb9, 0x9f, 0x2d, 0x37, 0x68, 0xb7, 0x1d, 0xbf, 0x9b, 0x32,
+	0x99, 0xe1, 0x4c, 0x46, 0x23, 0x94, 0xa5, 0x8a, 0xb5, 0xf2, 0x54, 0x31, 0xab, 0xa0, 0x37, 0x03,
+	0xcf, 0x73, 0x62, 0x99, 0x51, 0xe6, 0x60, 0xe6, 0xcf, 0x34, 0x68, 0x0e, 0x34, 0x2b, 0xcf, 0xe6,
+	0xba, 0xf0, 0x21, 0x71, 0x32, 0x17, 0xd4, 0x93, 0x29, 0xa2, 0xfe, 0xe7, 0xee, 0x73, 0x42, 0x75,
+	0x9f, 0xbf, 0x57, 0xe0, 0xf4, 0x96, 0x13, 0xa7, 0x17, 0x97, 0xf3, 0xbf, 0x76, 0xca, 0x25, 0x67,
+	0x32, 0x7d, 0xb4, 0x33, 0x99, 0x19, 0x3e, 0x13, 0x86, 0xe3, 0xf8, 0xb6, 0x9b, 0x74, 0x64, 0xc7,
+	0xbf, 0x26, 0x5a, 0xf2, 0x2a, 0x8c, 0xe1, 0x90, 0x17, 0x0a, 0xce, 0xac, 0xc0, 0x51, 0x61, 0x66,
+	0x0b, 0x96, 0x8a, 0x4a, 0x95, 0x07, 0x7c, 0x0a, 0x66, 0x28, 0x5f, 0x26, 0xfa, 0x13, 0x62, 0x60,
+	0xfe, 0x74, 0x16, 0xce, 0x7f, 0x4e, 0x3b, 0x38, 0xce, 0x7a, 0x4f, 0xb7, 0x83, 0x90, 0x93, 0x3a,
+	0x9e, 0xd3, 0x28, 0xbc, 0xf8, 0x56, 0xc6, 0xbe, 0xf8, 0x56, 0xc7, 0xbc, 0xf8, 0x4e, 0x1f, 0xe9,
+	0xc5, 0x77, 0xe6, 0xd8, 0x5e, 0x7c, 0x87, 0x6b, 0xb6, 0x5a, 0x69, 0xcd, 0xf6, 0x34, 0x57, 0xd7,
+	0xcc, 0x72, 0xf7, 0xfb, 0x48, 0x75, 0xbf, 0xb1, 0xa7, 0x33, 0xf6, 0xa9, 0xaa, 0xf0, 0x50, 0x5a,
+	0x3f, 0xf4, 0xa1, 0xb4, 0x31, 0xfc, 0x50, 0x5a, 0xfe, 0xd6, 0x06, 0x23, 0xdf, 0xda, 0x2e, 0xc2,
+	0x7c, 0x74, 0xe0, 0xdb, 0xa4, 0x93, 0x0a, 0xac, 0xcf, 0x89, 0x6d, 0xe7, 0xa1, 0x39, 0xcf, 0x3a,
+	0x51, 0xf0, 0xac, 0xcc, 0x52, 0x4f, 0x2a, 0x96, 0x5a, 0xe6, 0x6f, 0xf3, 0x23, 0xcb, 0xe5, 0xc2,
+	0x33, 0xd8, 0x42, 0xd9, 0x33, 0xd8, 0x7f, 0x4f, 0xd1, 0xf6, 0x18, 0x56, 0x46, 0x9d, 0xb2, 0x74,
+	0x5e, 0x1d, 0x66, 0xed, 0x1e, 0xf6, 0xbb, 0xbc, 0xbd, 0xc8, 0xbb, 0x08, 0x72, 0x38, 0xae, 0xca,
+	0xb8, 0xfa, 0x47, 0x80, 0xc5, 0x41, 0xf5, 0xc0, 0xfe, 0x3a, 0x36, 0x41, 0x0f, 0xa0, 0x99, 0x3e,
+	0x1b, 0xa6, 0x0d, 0x61, 0x34, 0xee, 0x0d, 0xc6, 0x58, 0x2e, 0x9f, 0x14, 0xa2, 0x99, 0x53, 0xc8,
+	0x86, 0xb3, 0x45, 0x82, 0x83, 0xe7, 0x9e, 0x6f, 0x8e, 0xa1, 0x9c, 0x61, 0x1d, 0xc6, 0x62, 0x4d,
+	0x43, 0x4f, 0x61, 0x3e, 0xff, 0x28, 0x81, 0x72, 0xe9, 0x54, 0xe9, 0x3b, 0x89, 0x61, 0x8e, 0x43,
+	0xc9, 0xe4, 0x7f, 0x06, 0x0b, 0x85, 0xfe, 0x3b, 0x32, 0xf3, 0x9d, 0x85, 0xb2, 0x17, 0x0c, 0xe3,
+	0x1b, 0x63, 0x71, 0x32, 0xea, 0x1f, 0x43, 0x3d, 0xed, 0x49, 0xe7, 0xd5, 0x5c, 0xe8, 0x54, 0x1b,
+	0xcd, 0x3c, 0xbd, 0xbd, 0xc8, 0x9c, 0x62, 0x6f, 0x5e, 0x69, 0xcf, 0x75, 0x78, 0xb1, 0xd2, 0x89,
+	0x35, 0xde, 0x2a, 0xe9, 0x7e, 0x9a, 0x53, 0xe8, 0xbb, 0x30, 0xc7, 0xbe, 0x76, 0xe5, 0xcf, 0x36,
+	0x96, 0x5a, 0xe2, 0x57, 0x42, 0xad, 0xf4, 0x57, 0x42, 0xad, 0x5b, 0xec, 0x57, 0x42, 0x46, 0x49,
+	0x7b, 0x52, 0x12, 0x78, 0x06, 0x27, 0xb7, 0x48, 0x3c, 0xe8, 0x26, 0xa0, 0x0b, 0x47, 0xea, 0xb9,
+	0x18, 0x66, 0x11, 0x6d, 0xb8, 0x21, 0x61, 0x4e, 0xa1, 0x5f, 0x6a, 0xf0, 0xd6, 0x16, 0x89, 0x8b,
+	0xf5, 0x39, 0x7a, 0xbf, 0x9c, 0xc9, 0x88, 0x3a, 0xde, 0xb8, 0x3f, 0xa9, 0x4f, 0xe6, 0xc9, 0x9a,
+	0x53, 0xe8, 0xd7, 0x1a, 0x9c, 0x51, 0x04, 0x53, 0x0b, 0x6e, 0x74, 0x65, 0xbc, 0x70, 0x25, 0xc5,
+	0xb9, 0xf1, 0xd9, 0x84, 0xbf, 0xc6, 0x51, 0x48, 0x9a, 0x53, 0x68, 0x97, 0x9f, 0xc9, 0x20, 0xbf,
+	0x46, 0xe7, 0x4b, 0x13, 0xe9, 0x8c, 0xfb, 0xca, 0xa8, 0xe9, 0xec, 0x1c, 0x3e, 0x83, 0xb9, 0x2d,
+	0x12, 0xa7, 0x89, 0x5e, 0xde, 0xd2, 0x0a, 0x39, 0xb8, 0xb1, 0x5c, 0x3e, 0xa9, 0x78, 0xd3, 0xa2,
+	0xa0, 0xa5, 0x24, 0x21, 0x79, 0x5f, 0x2d, 0xcd, 0xfa, 0x0c, 0x73, 0x1c, 0x4a, 0x46, 0xfd, 0x39,
+	0x2c, 0x95, 0x5f, 0x95, 0xe8, 0xdd, 0x23, 0x07, 0x4d, 0xe3, 0xd2, 0x51, 0x50, 0x53, 0x96, 0x9f,
+	0x6e, 0xfc, 0xe5, 0xd5, 0x8a, 0xf6, 0xd7, 0x57, 0x2b, 0xda, 0xbf, 0x5e, 0xad, 0x68, 0xdf, 0xbf,
+	0x76, 0xc8, 0xaf, 0xf6, 0x94, 0x1f, 0x02, 0x62, 0xea, 0xd8, 0xae, 0x43, 0xfc, 0xb8, 0x5d, 0xe3,
+	0xfe, 0x76, 0xed, 0xdf, 0x03, 0x00, 0xa0, 0x04, 0x20, 0x24, 0x27, 0x28, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3265,6 +3397,61 @@ func (m *ManifestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ManifestGenerationWebhooks) > 0 {
+		for iNdEx := len(m.ManifestGenerationWebhooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ManifestGenerationWebhooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.HelmLookupProxy != nil {
+		{
+			size, err := m.HelmLookupProxy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	if m.MaxManifestsSize != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.MaxManifestsSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	if m.MaxResources != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.MaxResources))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if len(m.SourceValues) > 0 {
+		for iNdEx := len(m.SourceValues) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SourceValues[iNdEx])
+			copy(dAtA[i:], m.SourceValues[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.SourceValues[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
 	if len(m.InstallationID) > 0 {
 		i -= len(m.InstallationID)
 		copy(dAtA[i:], m.InstallationID)
@@ -3551,6 +3738,47 @@ func (m *ManifestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HelmLookupProxy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HelmLookupProxy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HelmLookupProxy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ManifestRequestWithFiles) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5195,6 +5423,24 @@ func (m *GitDirectoriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ExcludePaths) > 0 {
+		for iNdEx := len(m.ExcludePaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExcludePaths[iNdEx])
+			copy(dAtA[i:], m.ExcludePaths[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.ExcludePaths[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.IncludePaths) > 0 {
+		for iNdEx := len(m.IncludePaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IncludePaths[iNdEx])
+			copy(dAtA[i:], m.IncludePaths[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.IncludePaths[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.VerifyCommit {
 		i--
 		if m.VerifyCommit {
@@ -5630,6 +5876,48 @@ func (m *ManifestRequest) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovRepository(uint64(l))
 	}
+	if len(m.SourceValues) > 0 {
+		for _, s := range m.SourceValues {
+			l = len(s)
+			n += 2 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.MaxResources != 0 {
+		n += 2 + sovRepository(uint64(m.MaxResources))
+	}
+	if m.MaxManifestsSize != 0 {
+		n += 2 + sovRepository(uint64(m.MaxManifestsSize))
+	}
+	if m.HelmLookupProxy != nil {
+		l = m.HelmLookupProxy.Size()
+		n += 2 + l + sovRepository(uint64(l))
+	}
+	if len(m.ManifestGenerationWebhooks) > 0 {
+		for _, e := range m.ManifestGenerationWebhooks {
+			l = e.Size()
+			n += 2 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HelmLookupProxy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6392,6 +6680,18 @@ func (m *GitDirectoriesRequest) Size() (n int) {
 	if m.VerifyCommit {
 		n += 2
 	}
+	if len(m.IncludePaths) > 0 {
+		for _, s := range m.IncludePaths {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if len(m.ExcludePaths) > 0 {
+		for _, s := range m.ExcludePaths {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7475,9 +7775,264 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.InstallationID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRepository(dAtA[iNdEx:])
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceValues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceValues = append(m.SourceValues, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxResources", wireType)
+			}
+			m.MaxResources = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxResources |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxManifestsSize", wireType)
+			}
+			m.MaxManifestsSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxManifestsSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HelmLookupProxy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HelmLookupProxy == nil {
+				m.HelmLookupProxy = &HelmLookupProxy{}
+			}
+			if err := m.HelmLookupProxy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManifestGenerationWebhooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManifestGenerationWebhooks = append(m.ManifestGenerationWebhooks, &v1alpha1.ManifestGenerationWebhook{})
+			if err := m.ManifestGenerationWebhooks[len(m.ManifestGenerationWebhooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HelmLookupProxy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HelmLookupProxy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HelmLookupProxy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
@@ -12148,6 +12703,70 @@ func (m *GitDirectoriesRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.VerifyCommit = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludePaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncludePaths = append(m.IncludePaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludePaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExcludePaths = append(m.ExcludePaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
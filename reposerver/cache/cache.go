@@ -44,6 +44,19 @@ type ClusterRuntimeInfo interface {
 	GetKubeVersion() string
 }
 
+// manifestGenerationWebhooksInfo is implemented by the manifest requests holding the webhooks of the project
+type manifestGenerationWebhooksInfo interface {
+	// GetManifestGenerationWebhooks returns the webhooks mutating the manifests
+	GetManifestGenerationWebhooks() []*appv1.ManifestGenerationWebhook
+}
+
+// helmLookupProxyInfo is implemented by the manifest requests giving the Helm templates access to the destination
+// cluster
+type helmLookupProxyInfo interface {
+	// GetHelmLookupProxy returns the session of the lookup proxy of the application controller
+	GetHelmLookupProxy() *apiclient.HelmLookupProxy
+}
+
 func NewCache(cache *cacheutil.Cache, repoCacheExpiration time.Duration, revisionCacheExpiration time.Duration, revisionCacheLockTimeout time.Duration) *Cache {
 	return &Cache{cache, repoCacheExpiration, revisionCacheExpiration, revisionCacheLockTimeout}
 }
@@ -300,6 +313,17 @@ func manifestCacheKey(revision string, appSrc *appv1.ApplicationSource, srcRefs
 	if installationID != "" {
 		key = fmt.Sprintf("%s|%s", key, installationID)
 	}
+	// the manifests are mutated by the manifest generation webhooks of the project
+	if webhooksInfo, ok := info.(manifestGenerationWebhooksInfo); ok && len(webhooksInfo.GetManifestGenerationWebhooks()) > 0 {
+		webhooks, _ := json.Marshal(webhooksInfo.GetManifestGenerationWebhooks())
+		key = fmt.Sprintf("%s|%d", key, hash.FNVa(string(webhooks)))
+	}
+	// the manifests rendered with and without the Helm lookups differ
+	if appSrc != nil && appSrc.IsHelmLookupsEnabled() {
+		if lookupInfo, ok := info.(helmLookupProxyInfo); ok && lookupInfo.GetHelmLookupProxy() != nil {
+			key += "|lookups"
+		}
+	}
 	return key
 }
 
@@ -397,6 +421,74 @@ func (c *Cache) DeleteManifests(revision string, appSrc *appv1.ApplicationSource
 		&cacheutil.CacheActionOpts{Delete: true})
 }
 
+func manifestsLockKey(revision string, appSrc *appv1.ApplicationSource, srcRefs appv1.RefTargetRevisionMapping, namespace string, trackingMethod string, appLabelKey string, appName string, info ClusterRuntimeInfo, refSourceCommitSHAs ResolvedRevisions, installationID string) string {
+	return "lock|" + manifestCacheKey(revision, appSrc, srcRefs, namespace, trackingMethod, appLabelKey, appName, info, refSourceCommitSHAs, installationID)
+}
+
+// TryLockManifests attempts to lock the generation of the manifests for the given lock ID if no replica of the
+// repo-server holds the lock, and returns the ID of the owner of the lock. The lock expires after the given duration.
+func (c *Cache) TryLockManifests(revision string, appSrc *appv1.ApplicationSource, srcRefs appv1.RefTargetRevisionMapping, clusterInfo ClusterRuntimeInfo, namespace string, trackingMethod string, appLabelKey string, appName string, refSourceCommitSHAs ResolvedRevisions, installationID string, lockId string, expiration time.Duration) (string, error) {
+	key := manifestsLockKey(revision, appSrc, srcRefs, namespace, trackingMethod, appLabelKey, appName, clusterInfo, refSourceCommitSHAs, installationID)
+	// DisableOverwrite makes sure that a single replica owns the lock, see TryLockGitRefCache
+	err := c.cache.SetItem(key, lockId, &cacheutil.CacheActionOpts{Expiration: expiration, DisableOverwrite: true})
+	if err != nil {
+		// Log but ignore this error since we'll want to retry, failing to obtain the lock should not throw an error
+		log.Errorf("Error attempting to acquire manifests generation lock: %v", err)
+	}
+	var owner string
+	err = c.cache.GetItem(key, &owner)
+	if errors.Is(err, ErrCacheMiss) {
+		return "", nil
+	}
+	return owner, err
+}
+
+// UnlockManifests releases the lock of the generation of the manifests if it is owned by the given lock ID
+func (c *Cache) UnlockManifests(revision string, appSrc *appv1.ApplicationSource, srcRefs appv1.RefTargetRevisionMapping, clusterInfo ClusterRuntimeInfo, namespace string, trackingMethod string, appLabelKey string, appName string, refSourceCommitSHAs ResolvedRevisions, installationID string, lockId string) error {
+	key := manifestsLockKey(revision, appSrc, srcRefs, namespace, trackingMethod, appLabelKey, appName, clusterInfo, refSourceCommitSHAs, installationID)
+	var owner string
+	err := c.cache.GetItem(key, &owner)
+	if err == nil && owner == lockId {
+		return c.cache.SetItem(key, "", &cacheutil.CacheActionOpts{Delete: true})
+	}
+	if errors.Is(err, ErrCacheMiss) {
+		return nil
+	}
+	return err
+}
+
+func helmChartKey(repo, credsKey, chart, version string) string {
+	return fmt.Sprintf("helm-chart|%s|%s|%s|%s", repo, credsKey, chart, version)
+}
+
+// SetHelmChart stores the archive of a chart version of a Helm repository, downloaded with the credentials identified
+// by credsKey, so that the replicas of the repo-server don't download it again with the same credentials
+func (c *Cache) SetHelmChart(repo, credsKey, chart, version string, data []byte) error {
+	return c.cache.SetItem(helmChartKey(repo, credsKey, chart, version), data, &cacheutil.CacheActionOpts{Expiration: c.repoCacheExpiration})
+}
+
+// GetHelmChart retrieves the archive of a chart version of a Helm repository downloaded with the credentials
+// identified by credsKey from cache
+func (c *Cache) GetHelmChart(repo, credsKey, chart, version string, data *[]byte) error {
+	return c.cache.GetItem(helmChartKey(repo, credsKey, chart, version), data)
+}
+
+func helmDependenciesKey(lockDigest, credsKey string) string {
+	return fmt.Sprintf("helm-dependencies|%s|%s", lockDigest, credsKey)
+}
+
+// SetHelmDependencies stores the archive of the dependencies built by `helm dependency build` for the digest of a
+// Chart.lock file, with the credentials of the dependency repositories identified by credsKey
+func (c *Cache) SetHelmDependencies(lockDigest, credsKey string, data []byte) error {
+	return c.cache.SetItem(helmDependenciesKey(lockDigest, credsKey), data, &cacheutil.CacheActionOpts{Expiration: c.repoCacheExpiration})
+}
+
+// GetHelmDependencies retrieves the archive of the dependencies built for the digest of a Chart.lock file with the
+// credentials identified by credsKey from cache
+func (c *Cache) GetHelmDependencies(lockDigest, credsKey string, data *[]byte) error {
+	return c.cache.GetItem(helmDependenciesKey(lockDigest, credsKey), data)
+}
+
 func appDetailsCacheKey(revision string, appSrc *appv1.ApplicationSource, srcRefs appv1.RefTargetRevisionMapping, trackingMethod appv1.TrackingMethod, refSourceCommitSHAs ResolvedRevisions) string {
 	if trackingMethod == "" {
 		trackingMethod = argo.TrackingMethodLabel
@@ -482,6 +574,25 @@ func (c *Cache) GetGitDirectories(repoURL, revision string) ([]string, error) {
 	return item, c.cache.GetItem(gitDirectoriesKey(repoURL, revision), &item)
 }
 
+func filteredGitDirectoriesKey(repoURL, revision string, includePaths, excludePaths []string) string {
+	return fmt.Sprintf("gitdirs|%s|%s|%s|%s", repoURL, revision, strings.Join(includePaths, ","), strings.Join(excludePaths, ","))
+}
+
+// SetFilteredGitDirectories caches the directories of the repository matching the given include and exclude patterns
+func (c *Cache) SetFilteredGitDirectories(repoURL, revision string, includePaths, excludePaths []string, directories []string) error {
+	return c.cache.SetItem(
+		filteredGitDirectoriesKey(repoURL, revision, includePaths, excludePaths),
+		&directories,
+		&cacheutil.CacheActionOpts{Expiration: c.repoCacheExpiration})
+}
+
+// GetFilteredGitDirectories returns the cached directories of the repository matching the given include and exclude
+// patterns
+func (c *Cache) GetFilteredGitDirectories(repoURL, revision string, includePaths, excludePaths []string) ([]string, error) {
+	var item []string
+	return item, c.cache.GetItem(filteredGitDirectoriesKey(repoURL, revision, includePaths, excludePaths), &item)
+}
+
 func (cmr *CachedManifestResponse) shallowCopy() *CachedManifestResponse {
 	if cmr == nil {
 		return nil
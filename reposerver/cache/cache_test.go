@@ -122,6 +122,19 @@ func TestCache_GetManifests(t *testing.T) {
 		err = cache.GetManifests("my-revision", &v1alpha1.ApplicationSource{}, q.RefSources, q, "my-namespace", "", "my-app-label-key", "other-app-label-value", value, map[string]string{"my-referenced-source": "my-referenced-revision"}, "")
 		require.ErrorIs(t, err, ErrCacheMiss)
 	})
+	t.Run("expect cache miss because of changed manifest generation webhooks", func(t *testing.T) {
+		webhooksQuery := &apiclient.ManifestRequest{ManifestGenerationWebhooks: []*v1alpha1.ManifestGenerationWebhook{{Name: "my-webhook", URL: "https://my-webhook"}}}
+		err = cache.GetManifests("my-revision", &v1alpha1.ApplicationSource{}, q.RefSources, webhooksQuery, "my-namespace", "", "my-app-label-key", "my-app-label-value", value, nil, "")
+		require.ErrorIs(t, err, ErrCacheMiss)
+	})
+	t.Run("expect cache miss because of the Helm lookups", func(t *testing.T) {
+		lookupsSource := &v1alpha1.ApplicationSource{Helm: &v1alpha1.ApplicationSourceHelm{EnableLookups: true}}
+		err = cache.SetManifests("my-revision", lookupsSource, q.RefSources, q, "my-namespace", "", "my-app-label-key", "my-app-label-value", value, nil, "")
+		require.NoError(t, err)
+		lookupsQuery := &apiclient.ManifestRequest{HelmLookupProxy: &apiclient.HelmLookupProxy{Url: "http://controller:8084", Token: "token"}}
+		err = cache.GetManifests("my-revision", lookupsSource, q.RefSources, lookupsQuery, "my-namespace", "", "my-app-label-key", "my-app-label-value", value, nil, "")
+		require.ErrorIs(t, err, ErrCacheMiss)
+	})
 	t.Run("expect cache hit", func(t *testing.T) {
 		err = cache.SetManifests(
 			"my-revision1", &v1alpha1.ApplicationSource{}, q.RefSources, q, "my-namespace", "", "my-app-label-key", "my-app-label-value",
@@ -134,7 +147,7 @@ func TestCache_GetManifests(t *testing.T) {
 		assert.Equal(t, "my-source-type", value.ManifestResponse.SourceType)
 		assert.Equal(t, "my-revision1", value.ManifestResponse.Revision)
 	})
-	mockCache.AssertCacheCalledTimes(t, &mocks.CacheCallCounts{ExternalSets: 2, ExternalGets: 8})
+	mockCache.AssertCacheCalledTimes(t, &mocks.CacheCallCounts{ExternalSets: 3, ExternalGets: 10})
 }
 
 func TestCache_GetAppDetails(t *testing.T) {
@@ -573,6 +586,58 @@ func TestUnlockGitReferences(t *testing.T) {
 	})
 }
 
+func TestTryLockManifests(t *testing.T) {
+	fixtures := newFixtures()
+	t.Cleanup(fixtures.mockCache.StopRedisCallback)
+	cache := fixtures.cache
+	appSrc := &v1alpha1.ApplicationSource{Path: "my-path"}
+
+	owner, err := cache.TryLockManifests("my-revision", appSrc, nil, nil, "my-namespace", "", "my-label-key", "my-app", nil, "", "my-lock-id", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, "my-lock-id", owner)
+
+	// the lock is owned by the first replica
+	owner, err = cache.TryLockManifests("my-revision", appSrc, nil, nil, "my-namespace", "", "my-label-key", "my-app", nil, "", "other-lock-id", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, "my-lock-id", owner)
+
+	// the lock isn't released by the other replicas
+	require.NoError(t, cache.UnlockManifests("my-revision", appSrc, nil, nil, "my-namespace", "", "my-label-key", "my-app", nil, "", "other-lock-id"))
+	owner, err = cache.TryLockManifests("my-revision", appSrc, nil, nil, "my-namespace", "", "my-label-key", "my-app", nil, "", "other-lock-id", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, "my-lock-id", owner)
+
+	require.NoError(t, cache.UnlockManifests("my-revision", appSrc, nil, nil, "my-namespace", "", "my-label-key", "my-app", nil, "", "my-lock-id"))
+	owner, err = cache.TryLockManifests("my-revision", appSrc, nil, nil, "my-namespace", "", "my-label-key", "my-app", nil, "", "other-lock-id", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, "other-lock-id", owner)
+
+	// the lock doesn't hide the manifests
+	res := &CachedManifestResponse{}
+	err = cache.GetManifests("my-revision", appSrc, nil, nil, "my-namespace", "", "my-label-key", "my-app", res, nil, "")
+	assert.ErrorIs(t, err, ErrCacheMiss)
+}
+
+func TestHelmChartAndDependencies(t *testing.T) {
+	fixtures := newFixtures()
+	t.Cleanup(fixtures.mockCache.StopRedisCallback)
+	cache := fixtures.cache
+
+	var data []byte
+	require.ErrorIs(t, cache.GetHelmChart("my-repo", "my-creds", "my-chart", "1.0.0", &data), ErrCacheMiss)
+	require.NoError(t, cache.SetHelmChart("my-repo", "my-creds", "my-chart", "1.0.0", []byte("my-chart-archive")))
+	require.NoError(t, cache.GetHelmChart("my-repo", "my-creds", "my-chart", "1.0.0", &data))
+	assert.Equal(t, "my-chart-archive", string(data))
+	require.ErrorIs(t, cache.GetHelmChart("my-repo", "my-creds", "my-chart", "2.0.0", &data), ErrCacheMiss)
+	require.ErrorIs(t, cache.GetHelmChart("my-repo", "other-creds", "my-chart", "1.0.0", &data), ErrCacheMiss)
+
+	require.ErrorIs(t, cache.GetHelmDependencies("my-digest", "my-creds", &data), ErrCacheMiss)
+	require.NoError(t, cache.SetHelmDependencies("my-digest", "my-creds", []byte("my-dependencies-archive")))
+	require.NoError(t, cache.GetHelmDependencies("my-digest", "my-creds", &data))
+	assert.Equal(t, "my-dependencies-archive", string(data))
+	require.ErrorIs(t, cache.GetHelmDependencies("my-digest", "other-creds", &data), ErrCacheMiss)
+}
+
 func TestSetHelmIndex(t *testing.T) {
 	t.Run("SetHelmIndex with valid data", func(t *testing.T) {
 		fixtures := newFixtures()
@@ -711,6 +776,20 @@ func TestGetGitDirectories(t *testing.T) {
 		assert.Equal(t, expectedItem, directories)
 		fixtures.mockCache.AssertCacheCalledTimes(t, &mocks.CacheCallCounts{ExternalGets: 1, ExternalSets: 1})
 	})
+
+	t.Run("SetFilteredGitDirectories", func(t *testing.T) {
+		fixtures := newFixtures()
+		t.Cleanup(fixtures.mockCache.StopRedisCallback)
+		expectedItem := []string{"test/dir"}
+		err := fixtures.cache.SetFilteredGitDirectories("test-repo", "test-revision", []string{"test/*"}, []string{"test/dir2"}, expectedItem)
+		require.NoError(t, err)
+		directories, err := fixtures.cache.GetFilteredGitDirectories("test-repo", "test-revision", []string{"test/*"}, []string{"test/dir2"})
+		require.NoError(t, err)
+		assert.Equal(t, expectedItem, directories)
+		_, err = fixtures.cache.GetGitDirectories("test-repo", "test-revision")
+		require.ErrorIs(t, err, ErrCacheMiss)
+		fixtures.mockCache.AssertCacheCalledTimes(t, &mocks.CacheCallCounts{ExternalGets: 2, ExternalSets: 1})
+	})
 }
 
 func TestGetGitFiles(t *testing.T) {
@@ -19,6 +19,9 @@ type MetricsServer struct {
 	repoPendingRequestsGauge *prometheus.GaugeVec
 	redisRequestCounter      *prometheus.CounterVec
 	redisRequestHistogram    *prometheus.HistogramVec
+	manifestGenHistogram     *prometheus.HistogramVec
+	manifestGenCPUCounter    *prometheus.CounterVec
+	manifestGenOutputCounter *prometheus.CounterVec
 }
 
 type GitRequestType string
@@ -99,6 +102,34 @@ func NewMetricsServer() *MetricsServer {
 	)
 	registry.MustRegister(redisRequestHistogram)
 
+	manifestGenHistogram := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "argocd_repo_manifest_generation_duration_seconds",
+			Help:    "Manifest generation duration seconds, by application and source type.",
+			Buckets: []float64{0.1, 0.25, .5, 1, 2, 4, 10, 20, 60},
+		},
+		[]string{"application", "source_type", "failed"},
+	)
+	registry.MustRegister(manifestGenHistogram)
+
+	manifestGenCPUCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_repo_manifest_generation_cpu_seconds_total",
+			Help: "CPU seconds consumed by the config management tools generating the manifests, by application and source type.",
+		},
+		[]string{"application", "source_type"},
+	)
+	registry.MustRegister(manifestGenCPUCounter)
+
+	manifestGenOutputCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_repo_manifest_generation_output_bytes_total",
+			Help: "Size in bytes of the generated manifests, by application and source type.",
+		},
+		[]string{"application", "source_type"},
+	)
+	registry.MustRegister(manifestGenOutputCounter)
+
 	return &MetricsServer{
 		handler:                  promhttp.HandlerFor(registry, promhttp.HandlerOpts{}),
 		gitFetchFailCounter:      gitFetchFailCounter,
@@ -108,6 +139,9 @@ func NewMetricsServer() *MetricsServer {
 		repoPendingRequestsGauge: repoPendingRequestsGauge,
 		redisRequestCounter:      redisRequestCounter,
 		redisRequestHistogram:    redisRequestHistogram,
+		manifestGenHistogram:     manifestGenHistogram,
+		manifestGenCPUCounter:    manifestGenCPUCounter,
+		manifestGenOutputCounter: manifestGenOutputCounter,
 	}
 }
 
@@ -147,3 +181,11 @@ func (m *MetricsServer) IncRedisRequest(failed bool) {
 func (m *MetricsServer) ObserveRedisRequestDuration(duration time.Duration) {
 	m.redisRequestHistogram.WithLabelValues("argocd-repo-server").Observe(duration.Seconds())
 }
+
+// ObserveManifestGeneration records the cost of generating the manifests of an application: the duration of the
+// generation, the CPU time of the config management tools it ran and the size of the generated manifests.
+func (m *MetricsServer) ObserveManifestGeneration(application string, sourceType string, failed bool, duration time.Duration, cpu time.Duration, outputSize int) {
+	m.manifestGenHistogram.WithLabelValues(application, sourceType, strconv.FormatBool(failed)).Observe(duration.Seconds())
+	m.manifestGenCPUCounter.WithLabelValues(application, sourceType).Add(cpu.Seconds())
+	m.manifestGenOutputCounter.WithLabelValues(application, sourceType).Add(float64(outputSize))
+}
@@ -0,0 +1,24 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObserveManifestGeneration(t *testing.T) {
+	m := NewMetricsServer()
+	m.ObserveManifestGeneration("argocd/guestbook", "Helm", false, 2*time.Second, 1500*time.Millisecond, 1024)
+	m.ObserveManifestGeneration("argocd/guestbook", "Helm", true, time.Second, 500*time.Millisecond, 0)
+
+	rr := httptest.NewRecorder()
+	m.GetHandler().ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/metrics", http.NoBody))
+	body := rr.Body.String()
+	assert.Contains(t, body, `argocd_repo_manifest_generation_duration_seconds_count{application="argocd/guestbook",failed="false",source_type="Helm"} 1`)
+	assert.Contains(t, body, `argocd_repo_manifest_generation_duration_seconds_sum{application="argocd/guestbook",failed="true",source_type="Helm"} 1`)
+	assert.Contains(t, body, `argocd_repo_manifest_generation_cpu_seconds_total{application="argocd/guestbook",source_type="Helm"} 2`)
+	assert.Contains(t, body, `argocd_repo_manifest_generation_output_bytes_total{application="argocd/guestbook",source_type="Helm"} 1024`)
+}
@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+
+	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
+	"github.com/argoproj/argo-cd/v3/util/helm"
+	"github.com/argoproj/argo-cd/v3/util/io/files"
+)
+
+// helmDependencyCache shares the dependencies built by `helm dependency build` between the replicas of the repo-server
+type helmDependencyCache interface {
+	SetHelmDependencies(lockDigest, credsKey string, data []byte) error
+	GetHelmDependencies(lockDigest, credsKey string, data *[]byte) error
+}
+
+// helmChartLock is the part of the Chart.lock file listing the locked dependencies
+type helmChartLock struct {
+	Dependencies []struct {
+		Repository string `json:"repository"`
+	} `json:"dependencies"`
+}
+
+// helmDependenciesDigest returns the digest identifying the dependencies of the chart, or an empty string if the
+// dependencies can't be shared: the chart has no Chart.lock file, or depends on charts of the repository.
+func helmDependenciesDigest(appPath string) (string, error) {
+	chartYAML, err := os.ReadFile(filepath.Join(appPath, "Chart.yaml"))
+	if err != nil {
+		return "", fmt.Errorf("failed to read Chart.yaml: %w", err)
+	}
+	chartLock, err := os.ReadFile(filepath.Join(appPath, "Chart.lock"))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to read Chart.lock: %w", err)
+	}
+	var lock helmChartLock
+	if err := yaml.Unmarshal(chartLock, &lock); err != nil {
+		return "", fmt.Errorf("failed to unmarshal Chart.lock: %w", err)
+	}
+	for _, dependency := range lock.Dependencies {
+		// the archives of the local dependencies are built from the files of the repository
+		if dependency.Repository == "" || strings.HasPrefix(dependency.Repository, "file://") {
+			return "", nil
+		}
+	}
+	hash := sha256.New()
+	hash.Write(chartYAML)
+	hash.Write([]byte{0})
+	hash.Write(chartLock)
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// helmRepositoriesCredsKey returns the digest identifying the credentials of the repositories used to build the
+// dependencies, so that the dependencies are only shared with the requests using the same credentials
+func helmRepositoriesCredsKey(repos []helm.HelmRepository) (string, error) {
+	keys := make([]string, 0, len(repos))
+	for _, repo := range repos {
+		credsKey, err := helm.CredsKey(repo.Creds)
+		if err != nil {
+			return "", fmt.Errorf("failed to get the credentials key of the helm repository %s: %w", repo.Repo, err)
+		}
+		keys = append(keys, repo.Repo+"|"+credsKey)
+	}
+	sort.Strings(keys)
+	hash := sha256.New()
+	for _, key := range keys {
+		hash.Write([]byte(key))
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// chartArchives returns the names of the archives of the charts directory of the chart
+func chartArchives(appPath string) (map[string]bool, error) {
+	entries, err := os.ReadDir(filepath.Join(appPath, "charts"))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	archives := map[string]bool{}
+	for _, entry := range entries {
+		if entry.Type().IsRegular() && strings.HasSuffix(entry.Name(), ".tgz") {
+			archives[entry.Name()] = true
+		}
+	}
+	return archives, nil
+}
+
+// restoreHelmDependencies writes the dependency archives shared by the other replicas to the charts directory of the
+// chart, and returns whether the dependencies were shared
+func restoreHelmDependencies(appPath string, lockDigest string, credsKey string, dependencyCache helmDependencyCache) (bool, error) {
+	var data []byte
+	if err := dependencyCache.GetHelmDependencies(lockDigest, credsKey, &data); err != nil {
+		if !errors.Is(err, cacheutil.ErrCacheMiss) {
+			log.Warnf("Failed to get the shared helm dependencies of %s: %v", appPath, err)
+		}
+		return false, nil
+	}
+	if len(data) == 0 {
+		return false, nil
+	}
+	chartsDir := filepath.Join(appPath, "charts")
+	if err := os.MkdirAll(chartsDir, 0o755); err != nil {
+		return false, fmt.Errorf("failed to create the charts directory: %w", err)
+	}
+	// the archives are extracted next to the charts directory, so that they can be renamed to it
+	tempDir, err := os.MkdirTemp(appPath, ".argocd-helm-dependencies-")
+	if err != nil {
+		return false, fmt.Errorf("failed to create the temporary dependencies directory: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(tempDir) }()
+	// the archive was written by a replica, its size is bounded by the maximum size of the shared archives
+	if err := files.Untgz(tempDir, bytes.NewReader(data), math.MaxInt64, false); err != nil {
+		return false, fmt.Errorf("failed to extract the shared helm dependencies: %w", err)
+	}
+	entries, err := os.ReadDir(tempDir)
+	if err != nil {
+		return false, err
+	}
+	for _, entry := range entries {
+		if err := os.Rename(filepath.Join(tempDir, entry.Name()), filepath.Join(chartsDir, entry.Name())); err != nil {
+			return false, fmt.Errorf("failed to restore the shared helm dependency %s: %w", entry.Name(), err)
+		}
+	}
+	log.Debugf("Using the shared helm dependencies of %s", appPath)
+	return true, nil
+}
+
+// shareHelmDependencies shares the dependency archives added by `helm dependency build` to the charts directory of
+// the chart with the other replicas, if the archives don't exceed the maximum size
+func shareHelmDependencies(appPath string, lockDigest string, credsKey string, existingArchives map[string]bool, dependencyCache helmDependencyCache, maxSize int64) error {
+	archives, err := chartArchives(appPath)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	gzw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gzw)
+	added := 0
+	for name := range archives {
+		if existingArchives[name] {
+			continue
+		}
+		added++
+		data, err := os.ReadFile(filepath.Join(appPath, "charts", name))
+		if err != nil {
+			return err
+		}
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(data)), Typeflag: tar.TypeReg}); err != nil {
+			return err
+		}
+		if _, err := tw.Write(data); err != nil {
+			return err
+		}
+		if int64(buf.Len()) > maxSize {
+			return nil
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	if err := gzw.Close(); err != nil {
+		return err
+	}
+	if added == 0 || int64(buf.Len()) > maxSize {
+		return nil
+	}
+	return dependencyCache.SetHelmDependencies(lockDigest, credsKey, buf.Bytes())
+}
@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/util/cache"
+	"github.com/argoproj/argo-cd/v3/util/helm"
+)
+
+type fakeHelmDependencyCache struct {
+	dependencies map[string][]byte
+}
+
+func (f *fakeHelmDependencyCache) SetHelmDependencies(lockDigest, credsKey string, data []byte) error {
+	f.dependencies[lockDigest+"|"+credsKey] = data
+	return nil
+}
+
+func (f *fakeHelmDependencyCache) GetHelmDependencies(lockDigest, credsKey string, data *[]byte) error {
+	var ok bool
+	if *data, ok = f.dependencies[lockDigest+"|"+credsKey]; !ok {
+		return cache.ErrCacheMiss
+	}
+	return nil
+}
+
+// fakeHelm builds the dependencies by writing an archive to the charts directory
+type fakeHelm struct {
+	helm.Helm
+	appPath string
+	builds  int
+}
+
+func (f *fakeHelm) DependencyBuild() error {
+	f.builds++
+	if err := os.MkdirAll(filepath.Join(f.appPath, "charts"), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(f.appPath, "charts", "my-dependency-1.0.0.tgz"), []byte("my-dependency"), 0o644)
+}
+
+func writeHelmChart(t *testing.T, lock string) string {
+	t.Helper()
+	appPath := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(appPath, "Chart.yaml"), []byte("name: my-chart\nversion: 1.0.0\n"), 0o644))
+	if lock != "" {
+		require.NoError(t, os.WriteFile(filepath.Join(appPath, "Chart.lock"), []byte(lock), 0o644))
+	}
+	return appPath
+}
+
+func TestHelmDependenciesDigest(t *testing.T) {
+	t.Run("Locked", func(t *testing.T) {
+		digest, err := helmDependenciesDigest(writeHelmChart(t, "dependencies:\n- name: my-dependency\n  repository: https://my-charts.example.com\n  version: 1.0.0\n"))
+		require.NoError(t, err)
+		assert.Len(t, digest, 64)
+	})
+	t.Run("NotLocked", func(t *testing.T) {
+		digest, err := helmDependenciesDigest(writeHelmChart(t, ""))
+		require.NoError(t, err)
+		assert.Empty(t, digest)
+	})
+	t.Run("LocalDependency", func(t *testing.T) {
+		digest, err := helmDependenciesDigest(writeHelmChart(t, "dependencies:\n- name: my-dependency\n  repository: file://../my-dependency\n  version: 1.0.0\n"))
+		require.NoError(t, err)
+		assert.Empty(t, digest)
+	})
+}
+
+func TestRunHelmBuild_SharedDependencies(t *testing.T) {
+	const lock = "dependencies:\n- name: my-dependency\n  repository: https://my-charts.example.com\n  version: 1.0.0\n"
+	dependencyCache := &fakeHelmDependencyCache{dependencies: map[string][]byte{}}
+	opt := newGenerateManifestOpt(WithHelmDependencyCache(dependencyCache, 1024*1024))
+
+	helmRepos := []helm.HelmRepository{{Repo: "https://my-charts.example.com", Creds: helm.HelmCreds{Username: "my-user", Password: "my-password"}}}
+
+	appPath := writeHelmChart(t, lock)
+	builder := &fakeHelm{appPath: appPath}
+	require.NoError(t, runHelmBuild(appPath, builder, helmRepos, opt))
+	assert.Equal(t, 1, builder.builds)
+	assert.Len(t, dependencyCache.dependencies, 1)
+
+	// another replica restores the shared dependencies instead of building them
+	otherAppPath := writeHelmChart(t, lock)
+	otherBuilder := &fakeHelm{appPath: otherAppPath}
+	require.NoError(t, runHelmBuild(otherAppPath, otherBuilder, helmRepos, opt))
+	assert.Equal(t, 0, otherBuilder.builds)
+	data, err := os.ReadFile(filepath.Join(otherAppPath, "charts", "my-dependency-1.0.0.tgz"))
+	require.NoError(t, err)
+	assert.Equal(t, "my-dependency", string(data))
+	entries, err := os.ReadDir(otherAppPath)
+	require.NoError(t, err)
+	assert.Len(t, entries, 4, "only Chart.yaml, Chart.lock, charts and the marker file are expected")
+
+	t.Run("OtherCredentials", func(t *testing.T) {
+		appPath := writeHelmChart(t, lock)
+		builder := &fakeHelm{appPath: appPath}
+		otherHelmRepos := []helm.HelmRepository{{Repo: "https://my-charts.example.com", Creds: helm.HelmCreds{Username: "other-user", Password: "other-password"}}}
+		require.NoError(t, runHelmBuild(appPath, builder, otherHelmRepos, opt))
+		assert.Equal(t, 1, builder.builds, "the dependencies downloaded with other credentials must not be shared")
+		assert.Len(t, dependencyCache.dependencies, 2)
+	})
+
+	t.Run("ExceedsMaxSize", func(t *testing.T) {
+		dependencyCache := &fakeHelmDependencyCache{dependencies: map[string][]byte{}}
+		appPath := writeHelmChart(t, lock)
+		require.NoError(t, runHelmBuild(appPath, &fakeHelm{appPath: appPath}, helmRepos, newGenerateManifestOpt(WithHelmDependencyCache(dependencyCache, 10))))
+		assert.Empty(t, dependencyCache.dependencies)
+	})
+}
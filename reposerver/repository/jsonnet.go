@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/argoproj/pkg/v2/sync"
+	log "github.com/sirupsen/logrus"
+
+	executil "github.com/argoproj/argo-cd/v3/util/exec"
+)
+
+const (
+	jsonnetBundlerFile     = "jsonnetfile.json"
+	jsonnetBundlerLockFile = "jsonnetfile.lock.json"
+	// jsonnetBundleInstalled marks the bundles which were completely installed
+	jsonnetBundleInstalled = ".installed"
+)
+
+var (
+	// jsonnetBundlerBinary is the path of the jsonnet-bundler binary
+	jsonnetBundlerBinary = "jb"
+	// defaultJsonnetBundleCacheDir holds the dependencies installed by jsonnet-bundler if the cache directory isn't set
+	defaultJsonnetBundleCacheDir = filepath.Join(os.TempDir(), "_argocd-jsonnet-bundles")
+	// jsonnetBundleCacheMaxBundles is the maximum number of bundles kept in the cache directory, the least recently used
+	// ones are evicted
+	jsonnetBundleCacheMaxBundles = 50
+	// jsonnetBundleLock is read-locked while a bundle is used, and write-locked while it is installed or evicted
+	jsonnetBundleLock = sync.NewKeyLock()
+)
+
+// installJsonnetBundle installs the dependencies locked by the jsonnetfile.lock.json file of the application path with
+// jsonnet-bundler, and returns the vendor directory holding them, along with a function releasing it once the manifests
+// are rendered. The dependencies are installed once per content of the jsonnetfile.json and jsonnetfile.lock.json files
+// in the cache directory, and fail to install if the lockfile doesn't lock all the dependencies of the jsonnetfile.json
+// file.
+func installJsonnetBundle(ctx context.Context, appPath string, cacheDir string) (string, func(), error) {
+	jsonnetfile, err := os.ReadFile(filepath.Join(appPath, jsonnetBundlerFile))
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to read %s: %w", jsonnetBundlerFile, err)
+	}
+	lockfile, err := os.ReadFile(filepath.Join(appPath, jsonnetBundlerLockFile))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", nil, fmt.Errorf("the dependencies of %s must be locked by %s", jsonnetBundlerFile, jsonnetBundlerLockFile)
+		}
+		return "", nil, fmt.Errorf("failed to read %s: %w", jsonnetBundlerLockFile, err)
+	}
+
+	hash := sha256.New()
+	hash.Write(jsonnetfile)
+	hash.Write([]byte{0})
+	hash.Write(lockfile)
+	key := hex.EncodeToString(hash.Sum(nil))
+	dir := filepath.Join(cacheDir, key)
+	vendorDir := filepath.Join(dir, "vendor")
+	marker := filepath.Join(dir, jsonnetBundleInstalled)
+
+	for {
+		jsonnetBundleLock.RLock(key)
+		if _, err := os.Stat(marker); err == nil {
+			// the modification time of the marker records the last use of the bundle, for the eviction
+			now := time.Now()
+			_ = os.Chtimes(marker, now, now)
+			return vendorDir, func() { jsonnetBundleLock.RUnlock(key) }, nil
+		}
+		jsonnetBundleLock.RUnlock(key)
+
+		jsonnetBundleLock.Lock(key)
+		err := installJsonnetBundleDir(ctx, dir, jsonnetfile, lockfile)
+		jsonnetBundleLock.Unlock(key)
+		if err != nil {
+			return "", nil, err
+		}
+		evictJsonnetBundles(cacheDir, key)
+	}
+}
+
+// installJsonnetBundleDir installs the dependencies in the directory of the bundle, unless they are already installed.
+// The caller must hold the write lock of the bundle.
+func installJsonnetBundleDir(ctx context.Context, dir string, jsonnetfile []byte, lockfile []byte) error {
+	if _, err := os.Stat(filepath.Join(dir, jsonnetBundleInstalled)); err == nil {
+		return nil
+	}
+	if _, err := exec.LookPath(jsonnetBundlerBinary); err != nil {
+		return fmt.Errorf("the jsonnet-bundler binary %s is not installed in the repo server: %w", jsonnetBundlerBinary, err)
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("failed to clean the jsonnet bundle directory: %w", err)
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create the jsonnet bundle directory: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, jsonnetBundlerFile), jsonnetfile, 0o600); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, jsonnetBundlerLockFile), lockfile, 0o600); err != nil {
+		return err
+	}
+
+	cmd := exec.CommandContext(ctx, jsonnetBundlerBinary, "install")
+	cmd.Dir = dir
+	// jsonnet-bundler clones the dependencies with git, which must not prompt for credentials
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	if _, err := executil.Run(cmd); err != nil {
+		_ = os.RemoveAll(dir)
+		return fmt.Errorf("failed to install the jsonnet dependencies: %w", err)
+	}
+
+	installedLockfile, err := os.ReadFile(filepath.Join(dir, jsonnetBundlerLockFile))
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		return fmt.Errorf("failed to read the installed %s: %w", jsonnetBundlerLockFile, err)
+	}
+	if !equalJSON(lockfile, installedLockfile) {
+		_ = os.RemoveAll(dir)
+		return fmt.Errorf("%s doesn't lock all the dependencies of %s, run jb install and commit %s", jsonnetBundlerLockFile, jsonnetBundlerFile, jsonnetBundlerLockFile)
+	}
+	if err := os.WriteFile(filepath.Join(dir, jsonnetBundleInstalled), nil, 0o600); err != nil {
+		return err
+	}
+	log.WithField("dir", dir).Info("Installed jsonnet bundle")
+	return nil
+}
+
+// evictJsonnetBundles removes the least recently used bundles of the cache directory beyond
+// jsonnetBundleCacheMaxBundles, except the given one. The bundles are removed once they are no longer used.
+func evictJsonnetBundles(cacheDir string, keep string) {
+	entries, err := os.ReadDir(cacheDir)
+	if err != nil {
+		log.Warnf("Failed to list the jsonnet bundles: %v", err)
+		return
+	}
+	type bundle struct {
+		key      string
+		lastUsed time.Time
+	}
+	var bundles []bundle
+	for _, entry := range entries {
+		if !entry.IsDir() || entry.Name() == keep {
+			continue
+		}
+		// the bundles being installed have no marker yet, and are considered the most recently used
+		lastUsed := time.Now()
+		if info, err := os.Stat(filepath.Join(cacheDir, entry.Name(), jsonnetBundleInstalled)); err == nil {
+			lastUsed = info.ModTime()
+		}
+		bundles = append(bundles, bundle{key: entry.Name(), lastUsed: lastUsed})
+	}
+	if len(bundles) < jsonnetBundleCacheMaxBundles {
+		return
+	}
+	sort.Slice(bundles, func(i, j int) bool {
+		return bundles[i].lastUsed.Before(bundles[j].lastUsed)
+	})
+	for _, b := range bundles[:len(bundles)-jsonnetBundleCacheMaxBundles+1] {
+		jsonnetBundleLock.Lock(b.key)
+		if err := os.RemoveAll(filepath.Join(cacheDir, b.key)); err != nil {
+			log.Warnf("Failed to evict the jsonnet bundle %s: %v", b.key, err)
+		}
+		jsonnetBundleLock.Unlock(b.key)
+	}
+}
+
+// equalJSON returns whether the JSON documents are equal, regardless of their formatting
+func equalJSON(a, b []byte) bool {
+	if bytes.Equal(a, b) {
+		return true
+	}
+	var objA, objB any
+	if json.Unmarshal(a, &objA) != nil || json.Unmarshal(b, &objB) != nil {
+		return false
+	}
+	return reflect.DeepEqual(objA, objB)
+}
+
+// jsonnetFileImport returns the Jsonnet code importing the file, as code or as a string
+func jsonnetFileImport(path string, code bool) string {
+	keyword := "importstr"
+	if code {
+		keyword = "import"
+	}
+	return fmt.Sprintf("%s @'%s'", keyword, strings.ReplaceAll(path, "'", "''"))
+}
@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+)
+
+func TestGenerateJsonnetManifestWithFileVars(t *testing.T) {
+	service := newService(t, ".")
+
+	q := apiclient.ManifestRequest{
+		Repo: &v1alpha1.Repository{},
+		ApplicationSource: &v1alpha1.ApplicationSource{
+			Path: "./testdata/jsonnet-files",
+			Directory: &v1alpha1.ApplicationSourceDirectory{
+				Include: "main.jsonnet",
+				Jsonnet: v1alpha1.ApplicationSourceJsonnet{
+					ExtVars: []v1alpha1.JsonnetVar{{Name: "greeting", File: "greeting.txt"}},
+					TLAs:    []v1alpha1.JsonnetVar{{Name: "config", File: "config.jsonnet", Code: true}},
+				},
+			},
+		},
+		ProjectName:        "something",
+		ProjectSourceRepos: []string{"*"},
+	}
+	res, err := service.GenerateManifest(t.Context(), &q)
+	require.NoError(t, err)
+	require.Len(t, res.Manifests, 1)
+	assert.Contains(t, res.Manifests[0], `"name":"my-cm"`)
+	assert.Contains(t, res.Manifests[0], `"greeting":"hello"`)
+}
+
+func TestGenerateJsonnetManifestWithFileVarOutside(t *testing.T) {
+	service := newService(t, ".")
+
+	q := apiclient.ManifestRequest{
+		Repo: &v1alpha1.Repository{},
+		ApplicationSource: &v1alpha1.ApplicationSource{
+			Path: "./testdata/jsonnet-files",
+			Directory: &v1alpha1.ApplicationSourceDirectory{
+				Include: "main.jsonnet",
+				Jsonnet: v1alpha1.ApplicationSourceJsonnet{
+					ExtVars: []v1alpha1.JsonnetVar{{Name: "greeting", File: "../../../../../etc/passwd"}},
+					TLAs:    []v1alpha1.JsonnetVar{{Name: "config", File: "config.jsonnet", Code: true}},
+				},
+			},
+		},
+		ProjectName:        "something",
+		ProjectSourceRepos: []string{"*"},
+	}
+	_, err := service.GenerateManifest(t.Context(), &q)
+	require.ErrorContains(t, err, "failed to resolve the file of the external variable greeting")
+}
+
+// fakeJsonnetBundler replaces the jsonnet-bundler binary by a script running the command
+func fakeJsonnetBundler(t *testing.T, command string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "jb")
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\nset -e\n"+command+"\n"), 0o755))
+	previous := jsonnetBundlerBinary
+	jsonnetBundlerBinary = path
+	t.Cleanup(func() {
+		jsonnetBundlerBinary = previous
+	})
+}
+
+func writeJsonnetBundle(t *testing.T, lockfile string) string {
+	t.Helper()
+	appPath := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(appPath, jsonnetBundlerFile), []byte(`{"version":1,"dependencies":[{"source":{"git":{"remote":"https://github.com/my-org/my-lib.git","subdir":""}},"version":"main"}]}`), 0o644))
+	if lockfile != "" {
+		require.NoError(t, os.WriteFile(filepath.Join(appPath, jsonnetBundlerLockFile), []byte(lockfile), 0o644))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(appPath, "main.jsonnet"), []byte(`{apiVersion: 'v1', kind: 'ConfigMap', metadata: {name: import 'my-lib/name.libsonnet'}}`), 0o644))
+	return appPath
+}
+
+const testJsonnetLockfile = `{"version":1,"dependencies":[{"source":{"git":{"remote":"https://github.com/my-org/my-lib.git","subdir":""}},"version":"0123456789abcdef","sum":"47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU="}]}`
+
+func TestInstallJsonnetBundle(t *testing.T) {
+	counter := filepath.Join(t.TempDir(), "counter")
+	fakeJsonnetBundler(t, `echo install >> `+counter+`
+mkdir -p vendor/my-lib
+echo "'my-cm'" > vendor/my-lib/name.libsonnet`)
+	appPath := writeJsonnetBundle(t, testJsonnetLockfile)
+	cacheDir := t.TempDir()
+
+	vendorDir, release, err := installJsonnetBundle(t.Context(), appPath, cacheDir)
+	require.NoError(t, err)
+	release()
+	assert.FileExists(t, filepath.Join(vendorDir, "my-lib", "name.libsonnet"))
+
+	// the installed bundle is cached
+	cachedVendorDir, release, err := installJsonnetBundle(t.Context(), appPath, cacheDir)
+	require.NoError(t, err)
+	release()
+	assert.Equal(t, vendorDir, cachedVendorDir)
+	installs, err := os.ReadFile(counter)
+	require.NoError(t, err)
+	assert.Equal(t, "install\n", string(installs))
+
+	t.Run("GenerateManifests", func(t *testing.T) {
+		q := &apiclient.ManifestRequest{
+			Repo: &v1alpha1.Repository{},
+			ApplicationSource: &v1alpha1.ApplicationSource{
+				Directory: &v1alpha1.ApplicationSourceDirectory{
+					Jsonnet: v1alpha1.ApplicationSourceJsonnet{Bundler: true},
+				},
+			},
+		}
+		res, err := GenerateManifests(t.Context(), appPath, appPath, "", q, false, nil, resource.MustParse("0"), nil, WithJsonnetBundleCacheDir(cacheDir))
+		require.NoError(t, err)
+		require.Len(t, res.Manifests, 1)
+		assert.Contains(t, res.Manifests[0], `"name":"my-cm"`)
+	})
+}
+
+func TestInstallJsonnetBundle_MissingLockfile(t *testing.T) {
+	fakeJsonnetBundler(t, "exit 1")
+	appPath := writeJsonnetBundle(t, "")
+
+	_, _, err := installJsonnetBundle(t.Context(), appPath, t.TempDir())
+	assert.ErrorContains(t, err, "must be locked by jsonnetfile.lock.json")
+}
+
+func TestInstallJsonnetBundle_OutdatedLockfile(t *testing.T) {
+	// jsonnet-bundler locks the dependencies missing from the lockfile
+	fakeJsonnetBundler(t, `echo '`+testJsonnetLockfile+`' > `+jsonnetBundlerLockFile)
+	appPath := writeJsonnetBundle(t, `{"version":1,"dependencies":[]}`)
+	cacheDir := t.TempDir()
+
+	_, _, err := installJsonnetBundle(t.Context(), appPath, cacheDir)
+	require.ErrorContains(t, err, "doesn't lock all the dependencies of jsonnetfile.json")
+	entries, err := os.ReadDir(cacheDir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestInstallJsonnetBundle_Failure(t *testing.T) {
+	fakeJsonnetBundler(t, "echo 'failed to clone' >&2; exit 1")
+	appPath := writeJsonnetBundle(t, testJsonnetLockfile)
+
+	_, _, err := installJsonnetBundle(t.Context(), appPath, t.TempDir())
+	assert.ErrorContains(t, err, "failed to install the jsonnet dependencies")
+}
+
+func TestInstallJsonnetBundle_MissingBinary(t *testing.T) {
+	fakeJsonnetBundler(t, "exit 0")
+	jsonnetBundlerBinary = filepath.Join(t.TempDir(), "jb")
+	appPath := writeJsonnetBundle(t, testJsonnetLockfile)
+
+	_, _, err := installJsonnetBundle(t.Context(), appPath, t.TempDir())
+	assert.ErrorContains(t, err, "is not installed in the repo server")
+}
+
+func TestInstallJsonnetBundle_Eviction(t *testing.T) {
+	fakeJsonnetBundler(t, "mkdir -p vendor")
+	previous := jsonnetBundleCacheMaxBundles
+	jsonnetBundleCacheMaxBundles = 2
+	t.Cleanup(func() {
+		jsonnetBundleCacheMaxBundles = previous
+	})
+	cacheDir := t.TempDir()
+
+	var vendorDirs []string
+	for _, version := range []string{"1", "2", "3"} {
+		appPath := writeJsonnetBundle(t, strings.ReplaceAll(testJsonnetLockfile, "0123456789abcdef", version))
+		vendorDir, release, err := installJsonnetBundle(t.Context(), appPath, cacheDir)
+		require.NoError(t, err)
+		release()
+		vendorDirs = append(vendorDirs, vendorDir)
+		// the modification times of the markers must differ
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// the least recently used bundle is evicted
+	assert.NoDirExists(t, vendorDirs[0])
+	assert.DirExists(t, vendorDirs[1])
+	assert.DirExists(t, vendorDirs[2])
+	entries, err := os.ReadDir(cacheDir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+}
+
+func TestEqualJSON(t *testing.T) {
+	assert.True(t, equalJSON([]byte(`{"a": [1, 2]}`), []byte("{\n  \"a\": [\n    1,\n    2\n  ]\n}\n")))
+	assert.False(t, equalJSON([]byte(`{"a": [1, 2]}`), []byte(`{"a": [2, 1]}`)))
+	assert.False(t, equalJSON([]byte(`{"a": [1, 2]}`), []byte(`invalid`)))
+}
+
+func TestJsonnetFileImport(t *testing.T) {
+	assert.Equal(t, `import @'/repo/config.jsonnet'`, jsonnetFileImport("/repo/config.jsonnet", true))
+	assert.Equal(t, `importstr @'/repo/it''s.txt'`, jsonnetFileImport("/repo/it's.txt", false))
+}
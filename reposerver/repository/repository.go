@@ -13,6 +13,7 @@ import (
 	"path"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -27,6 +28,7 @@ import (
 	"github.com/google/go-jsonnet"
 	"github.com/google/uuid"
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/sync/semaphore"
 	"google.golang.org/grpc/codes"
@@ -36,6 +38,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubeyaml "k8s.io/apimachinery/pkg/util/yaml"
 	"sigs.k8s.io/yaml"
 
@@ -49,6 +52,8 @@ import (
 	apppathutil "github.com/argoproj/argo-cd/v3/util/app/path"
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/cmp"
+	"github.com/argoproj/argo-cd/v3/util/cue"
+	executil "github.com/argoproj/argo-cd/v3/util/exec"
 	"github.com/argoproj/argo-cd/v3/util/git"
 	"github.com/argoproj/argo-cd/v3/util/glob"
 	"github.com/argoproj/argo-cd/v3/util/gpg"
@@ -59,6 +64,7 @@ import (
 	pathutil "github.com/argoproj/argo-cd/v3/util/io/path"
 	"github.com/argoproj/argo-cd/v3/util/kustomize"
 	"github.com/argoproj/argo-cd/v3/util/manifeststream"
+	"github.com/argoproj/argo-cd/v3/util/oci"
 	"github.com/argoproj/argo-cd/v3/util/text"
 )
 
@@ -86,6 +92,7 @@ type Service struct {
 	resourceTracking          argo.ResourceTracking
 	newGitClient              func(rawRepoURL string, root string, creds git.Creds, insecure bool, enableLfs bool, proxy string, noProxy string, opts ...git.ClientOpts) (git.Client, error)
 	newHelmClient             func(repoURL string, creds helm.Creds, enableOci bool, proxy string, noProxy string, opts ...helm.ClientOpts) helm.Client
+	newOCIClient              func(repoURL string, creds helm.Creds, proxy string, noProxy string, opts ...oci.ClientOpts) (oci.Client, error)
 	initConstants             RepoServerInitConstants
 	// now is usually just time.Now, but may be replaced by unit tests for testing purposes
 	now func() time.Time
@@ -105,11 +112,29 @@ type RepoServerInitConstants struct {
 	HelmManifestMaxExtractedSize                 int64
 	HelmRegistryMaxIndexSize                     int64
 	DisableHelmManifestMaxExtractedSize          bool
+	OCIManifestMaxExtractedSize                  int64
 	IncludeHiddenDirectories                     bool
 	CMPUseManifestGeneratePaths                  bool
-}
-
-var manifestGenerateLock = sync.NewKeyLock()
+	// HelmSecretValuesResolver resolves the secrets referenced by the Helm values, the references are not resolved if nil
+	HelmSecretValuesResolver helm.SecretValuesResolver
+	// SandboxConfig configures the sandboxes of the config management tools, the tools run without sandbox if nil
+	SandboxConfig *SandboxConfig
+	// OCISignatureVerifier verifies the cosign signatures of the OCI artifacts, the signatures are not verified if nil
+	OCISignatureVerifier *oci.SignatureVerifier
+	// ManifestGenerationLockTimeout is the maximum duration a replica waits for another replica generating the same
+	// manifests, the replicas don't wait for each other if zero
+	ManifestGenerationLockTimeout time.Duration
+	// SharedChartCacheMaxSize is the maximum size of the chart archives shared between the replicas through the cache,
+	// the archives aren't shared if zero
+	SharedChartCacheMaxSize int64
+}
+
+var (
+	manifestGenerateLock = sync.NewKeyLock()
+	// manifestGenerationLockPollInterval is the interval at which a replica checks whether the manifests generated by
+	// another replica are cached
+	manifestGenerationLockPollInterval = time.Second
+)
 
 // NewService returns a new instance of the Manifest service
 func NewService(metricsServer *metrics.MetricsServer, cache *cache.Cache, initConstants RepoServerInitConstants, resourceTracking argo.ResourceTracking, gitCredsStore git.CredsStore, rootDir string) *Service {
@@ -130,6 +155,7 @@ func NewService(metricsServer *metrics.MetricsServer, cache *cache.Cache, initCo
 		newHelmClient: func(repoURL string, creds helm.Creds, enableOci bool, proxy string, noProxy string, opts ...helm.ClientOpts) helm.Client {
 			return helm.NewClientWithLock(repoURL, creds, sync.NewKeyLock(), enableOci, proxy, noProxy, opts...)
 		},
+		newOCIClient:       oci.NewClient,
 		initConstants:      initConstants,
 		now:                time.Now,
 		gitCredsStore:      gitCredsStore,
@@ -298,16 +324,23 @@ func (s *Service) runRepoOperation(
 
 	var gitClient git.Client
 	var helmClient helm.Client
+	var ociClient oci.Client
 	var err error
 	gitClientOpts := git.WithCache(s.cache, !settings.noRevisionCache && !settings.noCache)
 	revision = textutils.FirstNonEmpty(revision, source.TargetRevision)
 	unresolvedRevision := revision
-	if source.IsHelm() {
+	switch {
+	case source.IsHelm():
 		helmClient, revision, err = s.newHelmClientResolveRevision(repo, revision, source.Chart, settings.noCache || settings.noRevisionCache)
 		if err != nil {
 			return err
 		}
-	} else {
+	case source.IsOCI():
+		ociClient, revision, err = s.newOCIClientResolveRevision(ctx, repo, revision)
+		if err != nil {
+			return err
+		}
+	default:
 		gitClient, revision, err = s.newClientResolveRevision(repo, revision, gitClientOpts)
 		if err != nil {
 			return err
@@ -372,6 +405,36 @@ func (s *Service) runRepoOperation(
 			return &operationContext{chartPath, ""}, nil
 		})
 	}
+	if source.IsOCI() {
+		artifactPath, closer, err := ociClient.Extract(ctx, revision)
+		if err != nil {
+			return err
+		}
+		defer io.Close(closer)
+		if !s.initConstants.AllowOutOfBoundsSymlinks {
+			err := apppathutil.CheckOutOfBoundsSymlinks(artifactPath)
+			if err != nil {
+				oobError := &apppathutil.OutOfBoundsSymlinkError{}
+				if errors.As(err, &oobError) {
+					log.WithFields(log.Fields{
+						common.SecurityField: common.SecurityHigh,
+						"repo":               repo.Repo,
+						"revision":           revision,
+						"file":               oobError.File,
+					}).Warn("OCI artifact contains out-of-bounds symlink")
+					return fmt.Errorf("OCI artifact contains out-of-bounds symlinks. file: %s", oobError.File)
+				}
+				return err
+			}
+		}
+		return operation(artifactPath, revision, revision, func() (*operationContext, error) {
+			appPath, err := apppathutil.Path(artifactPath, source.Path)
+			if err != nil {
+				return nil, err
+			}
+			return &operationContext{appPath, ""}, nil
+		})
+	}
 	closer, err := s.repoLock.Lock(gitClient.Root(), revision, settings.allowConcurrent, func() (goio.Closer, error) {
 		return s.checkoutRevision(gitClient, revision, s.initConstants.SubmoduleEnabled)
 	})
@@ -461,7 +524,7 @@ type gitClientGetter func(repo *v1alpha1.Repository, revision string, opts ...gi
 // should be updated.
 func resolveReferencedSources(hasMultipleSources bool, source *v1alpha1.ApplicationSourceHelm, refSources map[string]*v1alpha1.RefTarget, newClientResolveRevision gitClientGetter, gitClientOpts git.ClientOpts) (map[string]string, error) {
 	repoRefs := make(map[string]string)
-	if !hasMultipleSources || source == nil {
+	if source == nil {
 		return repoRefs, nil
 	}
 
@@ -472,11 +535,16 @@ func resolveReferencedSources(hasMultipleSources bool, source *v1alpha1.Applicat
 	refCandidates := append(source.ValueFiles, refFileParams...)
 
 	for _, valueFile := range refCandidates {
-		if strings.HasPrefix(valueFile, "$") {
-			refVar := strings.Split(valueFile, "/")[0]
-
+		refVar, err := getValueFileRefVar(valueFile, hasMultipleSources)
+		if err != nil {
+			return nil, err
+		}
+		if refVar != "" {
 			refSourceMapping, ok := refSources[refVar]
 			if !ok {
+				if argo.IsRepoValueFile(valueFile) {
+					return nil, fmt.Errorf("the repository of value file %q was not resolved", valueFile)
+				}
 				if len(refSources) == 0 {
 					return nil, fmt.Errorf("source referenced %q, but no source has a 'ref' field defined", refVar)
 				}
@@ -502,6 +570,24 @@ func resolveReferencedSources(hasMultipleSources bool, source *v1alpha1.Applicat
 			}
 		}
 	}
+
+	// The values generated by the sources referenced in valuesFrom depend on the revisions of these sources
+	for _, valuesFrom := range source.ValuesFrom {
+		refSourceMapping, ok := refSources["$"+valuesFrom.Ref]
+		if !ok || refSourceMapping.Chart != "" {
+			continue
+		}
+		normalizedRepoURL := git.NormalizeGitURL(refSourceMapping.Repo.Repo)
+		if _, ok := repoRefs[normalizedRepoURL]; ok {
+			continue
+		}
+		_, referencedCommitSHA, err := newClientResolveRevision(&refSourceMapping.Repo, refSourceMapping.TargetRevision, gitClientOpts)
+		if err != nil {
+			log.Errorf("Failed to get git client for repo %s: %v", refSourceMapping.Repo.Repo, err)
+			return nil, fmt.Errorf("failed to get git client for repo %s", refSourceMapping.Repo.Repo)
+		}
+		repoRefs[normalizedRepoURL] = referencedCommitSHA
+	}
 	return repoRefs, nil
 }
 
@@ -519,8 +605,34 @@ func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestReq
 		return res, err
 	}
 
+	// Render the sources generating Helm values for this source first. This happens before acquiring the repository
+	// lock, since the referenced sources might be in the same repository.
+	sourceValues, err := s.resolveSourceValues(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	if len(sourceValues) > 0 {
+		withSourceValues := *q
+		withSourceValues.SourceValues = sourceValues
+		q = &withSourceValues
+	}
+
+	var unlockManifests func()
+	defer func() {
+		if unlockManifests != nil {
+			unlockManifests()
+		}
+	}()
+
 	cacheFn := func(cacheKey string, refSourceCommitSHAs cache.ResolvedRevisions, firstInvocation bool) (bool, error) {
 		ok, resp, err := s.getManifestCacheEntry(cacheKey, q, refSourceCommitSHAs, firstInvocation)
+		if !ok && firstInvocation && s.initConstants.ManifestGenerationLockTimeout > 0 {
+			var generated bool
+			generated, unlockManifests = s.lockManifestGeneration(cacheKey, q, refSourceCommitSHAs)
+			if generated {
+				ok, resp, err = s.getManifestCacheEntry(cacheKey, q, refSourceCommitSHAs, firstInvocation)
+			}
+		}
 		res = resp
 		return ok, err
 	}
@@ -572,9 +684,105 @@ func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestReq
 			return nil, err
 		}
 	}
+	if err == nil && res != nil {
+		// the quota is checked on cached manifests too, so that changes of the quota apply immediately
+		quota := &v1alpha1.ApplicationResourceQuota{MaxResources: q.MaxResources}
+		if q.MaxManifestsSize > 0 {
+			quota.MaxManifestsSize = resource.NewQuantity(q.MaxManifestsSize, resource.BinarySI)
+		}
+		if err := quota.CheckManifests(res.Manifests); err != nil {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+	}
 	return res, err
 }
 
+// resolveSourceValues generates the manifests of the sources referenced in the Helm valuesFrom of the requested source
+// and returns the values found in their ConfigMaps, in the order of valuesFrom.
+func (s *Service) resolveSourceValues(ctx context.Context, q *apiclient.ManifestRequest) ([]string, error) {
+	if !q.HasMultipleSources || q.ApplicationSource.Helm == nil || len(q.ApplicationSource.Helm.ValuesFrom) == 0 {
+		return nil, nil
+	}
+	if err := checkValuesFromCycle(q.ApplicationSource, q.RefSources); err != nil {
+		return nil, err
+	}
+
+	values := make([]string, 0, len(q.ApplicationSource.Helm.ValuesFrom))
+	for _, valuesFrom := range q.ApplicationSource.Helm.ValuesFrom {
+		refSourceMapping, ok := q.RefSources["$"+valuesFrom.Ref]
+		if !ok || refSourceMapping.Source == nil {
+			return nil, fmt.Errorf("source referenced %q in valuesFrom, but no source has this 'ref' field defined", valuesFrom.Ref)
+		}
+		refRequest := *q
+		refRequest.Repo = &refSourceMapping.Repo
+		refRequest.Revision = refSourceMapping.TargetRevision
+		refRequest.ApplicationSource = refSourceMapping.Source
+		refRequest.SourceValues = nil
+		res, err := s.GenerateManifest(ctx, &refRequest)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate manifests for source %q referenced in valuesFrom: %w", valuesFrom.Ref, err)
+		}
+		value, err := getSourceValues(res.Manifests, valuesFrom)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// checkValuesFromCycle returns an error if the sources referenced in the Helm valuesFrom of the given source depend,
+// directly or not, on each other.
+func checkValuesFromCycle(source *v1alpha1.ApplicationSource, refSources map[string]*v1alpha1.RefTarget) error {
+	var visit func(source *v1alpha1.ApplicationSource, path []string) error
+	visit = func(source *v1alpha1.ApplicationSource, path []string) error {
+		if source.Helm == nil {
+			return nil
+		}
+		for _, valuesFrom := range source.Helm.ValuesFrom {
+			refPath := append(slices.Clone(path), valuesFrom.Ref)
+			if slices.Contains(path, valuesFrom.Ref) {
+				return fmt.Errorf("circular dependency between sources in valuesFrom: %s", strings.Join(refPath, " -> "))
+			}
+			refSourceMapping, ok := refSources["$"+valuesFrom.Ref]
+			if !ok || refSourceMapping.Source == nil {
+				continue
+			}
+			if err := visit(refSourceMapping.Source, refPath); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	var path []string
+	if source.Ref != "" {
+		path = append(path, source.Ref)
+	}
+	return visit(source, path)
+}
+
+// getSourceValues returns the Helm values held by the ConfigMap referenced in valuesFrom from the given manifests
+func getSourceValues(manifests []string, valuesFrom v1alpha1.HelmValuesFromSource) (string, error) {
+	for _, manifest := range manifests {
+		obj := &unstructured.Unstructured{}
+		if err := json.Unmarshal([]byte(manifest), obj); err != nil {
+			return "", fmt.Errorf("failed to unmarshal manifest of source %q: %w", valuesFrom.Ref, err)
+		}
+		if obj.GroupVersionKind().GroupKind() != (schema.GroupKind{Kind: "ConfigMap"}) || obj.GetName() != valuesFrom.ConfigMap {
+			continue
+		}
+		value, ok, err := unstructured.NestedString(obj.Object, "data", valuesFrom.GetKey())
+		if err != nil {
+			return "", fmt.Errorf("failed to get key %q of ConfigMap %q: %w", valuesFrom.GetKey(), valuesFrom.ConfigMap, err)
+		}
+		if !ok {
+			return "", fmt.Errorf("key %q not found in ConfigMap %q generated by source %q", valuesFrom.GetKey(), valuesFrom.ConfigMap, valuesFrom.Ref)
+		}
+		return value, nil
+	}
+	return "", fmt.Errorf("ConfigMap %q not found in the manifests generated by source %q", valuesFrom.ConfigMap, valuesFrom.Ref)
+}
+
 func (s *Service) GenerateManifestWithFiles(stream apiclient.RepoServerService_GenerateManifestWithFilesServer) error {
 	workDir, err := files.CreateTempDir("")
 	if err != nil {
@@ -706,97 +914,106 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 	if err == nil {
 		// Much of the multi-source handling logic is duplicated in resolveReferencedSources. If making changes here,
 		// check whether they should be replicated in resolveReferencedSources.
-		if q.HasMultipleSources {
-			if q.ApplicationSource.Helm != nil {
-				refFileParams := make([]string, 0)
-				for _, fileParam := range q.ApplicationSource.Helm.FileParameters {
-					refFileParams = append(refFileParams, fileParam.Path)
-				}
-				refCandidates := append(q.ApplicationSource.Helm.ValueFiles, refFileParams...)
+		// The value files of other repositories are also referenced by single source applications.
+		if q.ApplicationSource.Helm != nil {
+			refFileParams := make([]string, 0)
+			for _, fileParam := range q.ApplicationSource.Helm.FileParameters {
+				refFileParams = append(refFileParams, fileParam.Path)
+			}
+			refCandidates := append(q.ApplicationSource.Helm.ValueFiles, refFileParams...)
 
-				// Checkout every one of the referenced sources to the target revision before generating Manifests
-				for _, valueFile := range refCandidates {
-					if strings.HasPrefix(valueFile, "$") {
-						refVar := strings.Split(valueFile, "/")[0]
+			// Checkout every one of the referenced sources to the target revision before generating Manifests
+			for _, valueFile := range refCandidates {
+				refVar, err := getValueFileRefVar(valueFile, q.HasMultipleSources)
+				if err != nil {
+					ch.errCh <- err
+					return
+				}
+				if refVar != "" {
+					refSourceMapping, ok := q.RefSources[refVar]
+					if !ok {
+						if argo.IsRepoValueFile(valueFile) {
+							ch.errCh <- fmt.Errorf("the repository of value file %q was not resolved", valueFile)
+							return
+						}
+						if len(q.RefSources) == 0 {
+							ch.errCh <- fmt.Errorf("source referenced %q, but no source has a 'ref' field defined", refVar)
+						}
+						refKeys := make([]string, 0)
+						for refKey := range q.RefSources {
+							refKeys = append(refKeys, refKey)
+						}
+						ch.errCh <- fmt.Errorf("source referenced %q, which is not one of the available sources (%s)", refVar, strings.Join(refKeys, ", "))
+						return
+					}
+					if refSourceMapping.Chart != "" {
+						ch.errCh <- errors.New("source has a 'chart' field defined, but Helm charts are not yet not supported for 'ref' sources")
+						return
+					}
+					normalizedRepoURL := git.NormalizeGitURL(refSourceMapping.Repo.Repo)
+					closer, ok := repoRefs[normalizedRepoURL]
+					if ok {
+						if closer.revision != refSourceMapping.TargetRevision {
+							ch.errCh <- fmt.Errorf("cannot reference multiple revisions for the same repository (%s references %q while %s references %q)", refVar, refSourceMapping.TargetRevision, closer.key, closer.revision)
+							return
+						}
+					} else {
+						gitClient, referencedCommitSHA, err := s.newClientResolveRevision(&refSourceMapping.Repo, refSourceMapping.TargetRevision, git.WithCache(s.cache, !q.NoRevisionCache && !q.NoCache))
+						if err != nil {
+							log.Errorf("Failed to get git client for repo %s: %v", refSourceMapping.Repo.Repo, err)
+							ch.errCh <- fmt.Errorf("failed to get git client for repo %s", refSourceMapping.Repo.Repo)
+							return
+						}
 
-						refSourceMapping, ok := q.RefSources[refVar]
-						if !ok {
-							if len(q.RefSources) == 0 {
-								ch.errCh <- fmt.Errorf("source referenced %q, but no source has a 'ref' field defined", refVar)
-							}
-							refKeys := make([]string, 0)
-							for refKey := range q.RefSources {
-								refKeys = append(refKeys, refKey)
-							}
-							ch.errCh <- fmt.Errorf("source referenced %q, which is not one of the available sources (%s)", refVar, strings.Join(refKeys, ", "))
+						if git.NormalizeGitURL(q.ApplicationSource.RepoURL) == normalizedRepoURL && commitSHA != referencedCommitSHA {
+							ch.errCh <- fmt.Errorf("cannot reference a different revision of the same repository (%s references %q which resolves to %q while the application references %q which resolves to %q)", refVar, refSourceMapping.TargetRevision, referencedCommitSHA, q.Revision, commitSHA)
 							return
 						}
-						if refSourceMapping.Chart != "" {
-							ch.errCh <- errors.New("source has a 'chart' field defined, but Helm charts are not yet not supported for 'ref' sources")
+						closer, err := s.repoLock.Lock(gitClient.Root(), referencedCommitSHA, true, func() (goio.Closer, error) {
+							return s.checkoutRevision(gitClient, referencedCommitSHA, s.initConstants.SubmoduleEnabled)
+						})
+						if err != nil {
+							log.Errorf("failed to acquire lock for referenced source %s", normalizedRepoURL)
+							ch.errCh <- err
 							return
 						}
-						normalizedRepoURL := git.NormalizeGitURL(refSourceMapping.Repo.Repo)
-						closer, ok := repoRefs[normalizedRepoURL]
-						if ok {
-							if closer.revision != refSourceMapping.TargetRevision {
-								ch.errCh <- fmt.Errorf("cannot reference multiple revisions for the same repository (%s references %q while %s references %q)", refVar, refSourceMapping.TargetRevision, closer.key, closer.revision)
-								return
-							}
-						} else {
-							gitClient, referencedCommitSHA, err := s.newClientResolveRevision(&refSourceMapping.Repo, refSourceMapping.TargetRevision, git.WithCache(s.cache, !q.NoRevisionCache && !q.NoCache))
+						defer func(closer goio.Closer) {
+							err := closer.Close()
 							if err != nil {
-								log.Errorf("Failed to get git client for repo %s: %v", refSourceMapping.Repo.Repo, err)
-								ch.errCh <- fmt.Errorf("failed to get git client for repo %s", refSourceMapping.Repo.Repo)
-								return
+								log.Errorf("Failed to release repo lock: %v", err)
 							}
+						}(closer)
 
-							if git.NormalizeGitURL(q.ApplicationSource.RepoURL) == normalizedRepoURL && commitSHA != referencedCommitSHA {
-								ch.errCh <- fmt.Errorf("cannot reference a different revision of the same repository (%s references %q which resolves to %q while the application references %q which resolves to %q)", refVar, refSourceMapping.TargetRevision, referencedCommitSHA, q.Revision, commitSHA)
-								return
-							}
-							closer, err := s.repoLock.Lock(gitClient.Root(), referencedCommitSHA, true, func() (goio.Closer, error) {
-								return s.checkoutRevision(gitClient, referencedCommitSHA, s.initConstants.SubmoduleEnabled)
-							})
+						// Symlink check must happen after acquiring lock.
+						if !s.initConstants.AllowOutOfBoundsSymlinks {
+							err := apppathutil.CheckOutOfBoundsSymlinks(gitClient.Root())
 							if err != nil {
-								log.Errorf("failed to acquire lock for referenced source %s", normalizedRepoURL)
-								ch.errCh <- err
-								return
-							}
-							defer func(closer goio.Closer) {
-								err := closer.Close()
-								if err != nil {
-									log.Errorf("Failed to release repo lock: %v", err)
-								}
-							}(closer)
-
-							// Symlink check must happen after acquiring lock.
-							if !s.initConstants.AllowOutOfBoundsSymlinks {
-								err := apppathutil.CheckOutOfBoundsSymlinks(gitClient.Root())
-								if err != nil {
-									oobError := &apppathutil.OutOfBoundsSymlinkError{}
-									if errors.As(err, &oobError) {
-										log.WithFields(log.Fields{
-											common.SecurityField: common.SecurityHigh,
-											"repo":               refSourceMapping.Repo,
-											"revision":           refSourceMapping.TargetRevision,
-											"file":               oobError.File,
-										}).Warn("repository contains out-of-bounds symlink")
-										ch.errCh <- fmt.Errorf("repository contains out-of-bounds symlinks. file: %s", oobError.File)
-										return
-									}
-									ch.errCh <- err
+								oobError := &apppathutil.OutOfBoundsSymlinkError{}
+								if errors.As(err, &oobError) {
+									log.WithFields(log.Fields{
+										common.SecurityField: common.SecurityHigh,
+										"repo":               refSourceMapping.Repo,
+										"revision":           refSourceMapping.TargetRevision,
+										"file":               oobError.File,
+									}).Warn("repository contains out-of-bounds symlink")
+									ch.errCh <- fmt.Errorf("repository contains out-of-bounds symlinks. file: %s", oobError.File)
 									return
 								}
+								ch.errCh <- err
+								return
 							}
-
-							repoRefs[normalizedRepoURL] = repoRef{revision: refSourceMapping.TargetRevision, commitSHA: referencedCommitSHA, key: refVar}
 						}
+
+						repoRefs[normalizedRepoURL] = repoRef{revision: refSourceMapping.TargetRevision, commitSHA: referencedCommitSHA, key: refVar}
 					}
 				}
 			}
 		}
 
-		manifestGenResult, err = GenerateManifests(ctx, opContext.appPath, repoRoot, commitSHA, q, false, s.gitCredsStore, s.initConstants.MaxCombinedDirectoryManifestsSize, s.gitRepoPaths, WithCMPTarDoneChannel(ch.tarDoneCh), WithCMPTarExcludedGlobs(s.initConstants.CMPTarExcludedGlobs), WithCMPUseManifestGeneratePaths(s.initConstants.CMPUseManifestGeneratePaths))
+		cpuUsage := &executil.CPUUsage{}
+		generationStart := time.Now()
+		manifestGenResult, err = GenerateManifests(ctx, opContext.appPath, repoRoot, commitSHA, q, false, s.gitCredsStore, s.initConstants.MaxCombinedDirectoryManifestsSize, s.gitRepoPaths, WithCMPTarDoneChannel(ch.tarDoneCh), WithCMPTarExcludedGlobs(s.initConstants.CMPTarExcludedGlobs), WithCMPUseManifestGeneratePaths(s.initConstants.CMPUseManifestGeneratePaths), WithHelmSecretValuesResolver(s.initConstants.HelmSecretValuesResolver), WithSandboxConfig(s.initConstants.SandboxConfig), s.withSharedChartCache(q.NoCache), WithCPUUsage(cpuUsage))
+		s.observeManifestGeneration(q, manifestGenResult, err, time.Since(generationStart), cpuUsage.Get())
 	}
 	refSourceCommitSHAs := make(map[string]string)
 	if len(repoRefs) > 0 {
@@ -859,13 +1076,23 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 	}
 	manifestGenResult.Revision = commitSHA
 	manifestGenResult.VerifyResult = opContext.verificationResult
-	err = s.cache.SetManifests(cacheKey, appSourceCopy, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &manifestGenCacheEntry, refSourceCommitSHAs, q.InstallationID)
-	if err != nil {
-		log.Warnf("manifest cache set error %s/%s: %v", appSourceCopy.String(), cacheKey, err)
+	if s.hasHelmSecretValues(q) {
+		log.WithField("application", q.AppName).Debug("Not caching the manifests rendered with the secrets of the Helm values")
+	} else {
+		err = s.cache.SetManifests(cacheKey, appSourceCopy, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &manifestGenCacheEntry, refSourceCommitSHAs, q.InstallationID)
+		if err != nil {
+			log.Warnf("manifest cache set error %s/%s: %v", appSourceCopy.String(), cacheKey, err)
+		}
 	}
 	ch.responseCh <- manifestGenCacheEntry.ManifestResponse
 }
 
+// hasHelmSecretValues returns whether the manifests of the request are rendered with the secrets referenced by the Helm
+// values, in which case they are not stored in the manifest cache
+func (s *Service) hasHelmSecretValues(q *apiclient.ManifestRequest) bool {
+	return s.initConstants.HelmSecretValuesResolver != nil && q.ApplicationSource.Helm != nil && helm.HasSecretValues(q.ApplicationSource.Helm.ValuesYAML())
+}
+
 // getManifestCacheEntry returns false if the 'generate manifests' operation should be run by runRepoOperation, e.g.:
 // - If the cache result is empty for the requested key
 // - If the cache is not empty, but the cached value is a manifest generation error AND we have not yet met the failure threshold (e.g. res.NumberOfConsecutiveFailures > 0 && res.NumberOfConsecutiveFailures <  s.initConstants.PauseGenerationAfterFailedGenerationAttempts)
@@ -957,6 +1184,42 @@ func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRe
 	return false, nil, nil
 }
 
+// lockManifestGeneration waits until this replica of the repo-server owns the lock of the generation of the manifests,
+// so that the replicas don't generate the same manifests concurrently. It returns true if another replica cached the
+// manifests in the meantime, and the function releasing the lock if this replica owns it. The manifests are generated
+// without lock if the lock isn't released within the timeout.
+func (s *Service) lockManifestGeneration(cacheKey string, q *apiclient.ManifestRequest, refSourceCommitSHAs cache.ResolvedRevisions) (bool, func()) {
+	timeout := s.initConstants.ManifestGenerationLockTimeout
+	lockId := uuid.NewString()
+	waitUntil := time.Now().Add(timeout)
+	for {
+		owner, err := s.cache.TryLockManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs, q.InstallationID, lockId, timeout)
+		if err != nil {
+			log.Warnf("manifest generation lock error %s/%s: %v", q.ApplicationSource.String(), cacheKey, err)
+			return false, nil
+		}
+		if owner == lockId {
+			return false, func() {
+				err := s.cache.UnlockManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs, q.InstallationID, lockId)
+				if err != nil {
+					log.Warnf("manifest generation unlock error %s/%s: %v", q.ApplicationSource.String(), cacheKey, err)
+				}
+			}
+		}
+		if !time.Now().Before(waitUntil) {
+			log.Infof("manifest generation lock timeout: %s/%s", q.ApplicationSource.String(), cacheKey)
+			return false, nil
+		}
+		time.Sleep(manifestGenerationLockPollInterval)
+
+		res := cache.CachedManifestResponse{}
+		if err := s.cache.GetManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &res, refSourceCommitSHAs, q.InstallationID); err == nil {
+			log.Infof("manifests generated by another replica: %s/%s", q.ApplicationSource.String(), cacheKey)
+			return true, nil
+		}
+	}
+}
+
 func getHelmRepos(appPath string, repositories []*v1alpha1.Repository, helmRepoCreds []*v1alpha1.RepoCreds) ([]helm.HelmRepository, error) {
 	dependencies, err := getHelmDependencyRepos(appPath)
 	if err != nil {
@@ -965,7 +1228,7 @@ func getHelmRepos(appPath string, repositories []*v1alpha1.Repository, helmRepoC
 	reposByName := make(map[string]*v1alpha1.Repository)
 	reposByURL := make(map[string]*v1alpha1.Repository)
 	for _, repo := range repositories {
-		reposByURL[repo.Repo] = repo
+		reposByURL[helmRepoURL(repo.Repo)] = repo
 		if repo.Name != "" {
 			reposByName[repo.Name] = repo
 		}
@@ -974,7 +1237,7 @@ func getHelmRepos(appPath string, repositories []*v1alpha1.Repository, helmRepoC
 	repos := make([]helm.HelmRepository, 0)
 	for _, dep := range dependencies {
 		// find matching repo credentials by URL or name
-		repo, ok := reposByURL[dep.Repo]
+		repo, ok := reposByURL[helmRepoURL(dep.Repo)]
 		if !ok && dep.Name != "" {
 			repo, ok = reposByName[dep.Name]
 		}
@@ -982,7 +1245,8 @@ func getHelmRepos(appPath string, repositories []*v1alpha1.Repository, helmRepoC
 			// if no matching repo credentials found, use the repo creds from the credential list
 			repo = &v1alpha1.Repository{Repo: dep.Repo, Name: dep.Name, EnableOCI: dep.EnableOCI}
 			if repositoryCredential := getRepoCredential(helmRepoCreds, dep.Repo); repositoryCredential != nil {
-				repo.EnableOCI = repositoryCredential.EnableOCI
+				// the scheme of the dependency tells whether it is an OCI dependency
+				repo.EnableOCI = dep.EnableOCI || repositoryCredential.EnableOCI
 				repo.Password = repositoryCredential.Password
 				repo.Username = repositoryCredential.Username
 				repo.SSHPrivateKey = repositoryCredential.SSHPrivateKey
@@ -990,18 +1254,24 @@ func getHelmRepos(appPath string, repositories []*v1alpha1.Repository, helmRepoC
 				repo.TLSClientCertKey = repositoryCredential.TLSClientCertKey
 				repo.UseAzureWorkloadIdentity = repositoryCredential.UseAzureWorkloadIdentity
 			} else if repo.EnableOCI {
-				// finally if repo is OCI and no credentials found, use the first OCI credential matching by hostname
+				// finally if repo is OCI and no credentials found, use the OCI credential with the longest URL matching the dependency
 				// see https://github.com/argoproj/argo-cd/issues/14636
+				var match *v1alpha1.Repository
 				for _, cred := range repositories {
 					// if the repo is OCI, don't match the repository URL exactly, but only as a dependent repository prefix just like in the getRepoCredential function
 					// see https://github.com/argoproj/argo-cd/issues/12436
-					if _, err := url.Parse("oci://" + dep.Repo); err == nil && cred.EnableOCI && strings.HasPrefix(dep.Repo, cred.Repo) {
-						repo.Username = cred.Username
-						repo.Password = cred.Password
-						repo.UseAzureWorkloadIdentity = cred.UseAzureWorkloadIdentity
-						break
+					if _, err := url.Parse("oci://" + dep.Repo); err == nil && cred.EnableOCI && strings.HasPrefix(dep.Repo, cred.Repo) &&
+						(match == nil || len(cred.Repo) > len(match.Repo)) {
+						match = cred
 					}
 				}
+				if match != nil {
+					repo.Username = match.Username
+					repo.Password = match.Password
+					repo.TLSClientCertData = match.TLSClientCertData
+					repo.TLSClientCertKey = match.TLSClientCertKey
+					repo.UseAzureWorkloadIdentity = match.UseAzureWorkloadIdentity
+				}
 			}
 		}
 		repos = append(repos, helm.HelmRepository{Name: repo.Name, Repo: repo.Repo, Creds: repo.GetHelmCreds(), EnableOci: repo.EnableOCI})
@@ -1052,6 +1322,12 @@ func getHelmDependencyRepos(appPath string) ([]*v1alpha1.Repository, error) {
 	return repos, nil
 }
 
+// helmRepoURL returns the URL of a Helm repository without the OCI scheme and the trailing slash, so that the URLs of
+// the dependencies can be matched against the URLs of the configured repositories and credentials
+func helmRepoURL(repoURL string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(repoURL, ociPrefix), "/")
+}
+
 func sanitizeRepoName(repoName string) string {
 	return strings.ReplaceAll(repoName, "/", "-")
 }
@@ -1060,7 +1336,9 @@ func sanitizeRepoName(repoName string) string {
 // if multiple threads are trying to run it.
 // Multiple goroutines might process same helm app in one repo concurrently when repo server process multiple
 // manifest generation requests of the same commit.
-func runHelmBuild(appPath string, h helm.Helm) error {
+// The dependencies are shared with the other replicas of the repo-server using the same credentials for the helm
+// repositories if the cache of the dependencies is defined.
+func runHelmBuild(appPath string, h helm.Helm, helmRepos []helm.HelmRepository, opt *generateManifestOpt) error {
 	manifestGenerateLock.Lock(appPath)
 	defer manifestGenerateLock.Unlock(appPath)
 
@@ -1075,10 +1353,38 @@ func runHelmBuild(appPath string, h helm.Helm) error {
 		return err
 	}
 
+	var lockDigest, credsKey string
+	var existingArchives map[string]bool
+	if opt.helmDependencyCache != nil {
+		if lockDigest, err = helmDependenciesDigest(appPath); err != nil {
+			return err
+		}
+	}
+	if lockDigest != "" {
+		if credsKey, err = helmRepositoriesCredsKey(helmRepos); err != nil {
+			return err
+		}
+		restored, err := restoreHelmDependencies(appPath, lockDigest, credsKey, opt.helmDependencyCache)
+		if err != nil {
+			return err
+		}
+		if restored {
+			return os.WriteFile(markerFile, []byte("marker"), 0o644)
+		}
+		if existingArchives, err = chartArchives(appPath); err != nil {
+			return err
+		}
+	}
+
 	err = h.DependencyBuild()
 	if err != nil {
 		return fmt.Errorf("error building helm chart dependencies: %w", err)
 	}
+	if lockDigest != "" {
+		if err := shareHelmDependencies(appPath, lockDigest, credsKey, existingArchives, opt.helmDependencyCache, opt.helmDependencyCacheMaxSize); err != nil {
+			log.Warnf("Failed to share the helm dependencies of %s: %v", appPath, err)
+		}
+	}
 	return os.WriteFile(markerFile, []byte("marker"), 0o644)
 }
 
@@ -1087,7 +1393,7 @@ func isSourcePermitted(url string, repos []string) bool {
 	return p.IsSourcePermitted(v1alpha1.ApplicationSource{RepoURL: url})
 }
 
-func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, isLocal bool, gitRepoPaths io.TempPaths) ([]*unstructured.Unstructured, string, error) {
+func helmTemplate(ctx context.Context, appPath string, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, isLocal bool, gitRepoPaths io.TempPaths, opt *generateManifestOpt) ([]*unstructured.Unstructured, string, error) {
 	// We use the app name as Helm's release name property, which must not
 	// contain any underscore characters and must not exceed 53 characters.
 	// We are not interested in the fully qualified application name while
@@ -1102,11 +1408,14 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 		Set:         map[string]string{},
 		SetString:   map[string]string{},
 		SetFile:     map[string]pathutil.ResolvedFilePath{},
+		Sandbox:     opt.sandboxConfig.Helm(q.ProjectName),
+		CPUUsage:    opt.cpuUsage,
 	}
 
 	appHelm := q.ApplicationSource.Helm
 	var version string
 	var passCredentials bool
+	var secretValues []string
 	if appHelm != nil {
 		if appHelm.Version != "" {
 			version = appHelm.Version
@@ -1125,6 +1434,22 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 
 		templateOpts.Values = resolvedValueFiles
 
+		for _, sourceValues := range q.SourceValues {
+			rand, err := uuid.NewRandom()
+			if err != nil {
+				return nil, "", fmt.Errorf("error generating random filename for Helm values file: %w", err)
+			}
+			p := path.Join(os.TempDir(), rand.String())
+			defer func() {
+				_ = os.RemoveAll(p)
+			}()
+			err = os.WriteFile(p, []byte(sourceValues), 0o644)
+			if err != nil {
+				return nil, "", fmt.Errorf("error writing helm values file: %w", err)
+			}
+			templateOpts.Values = append(templateOpts.Values, pathutil.ResolvedFilePath(p))
+		}
+
 		if !appHelm.ValuesIsEmpty() {
 			rand, err := uuid.NewRandom()
 			if err != nil {
@@ -1137,7 +1462,14 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 					_ = os.RemoveAll(p)
 				}
 			}()
-			err = os.WriteFile(p, appHelm.ValuesYAML(), 0o644)
+			values := appHelm.ValuesYAML()
+			if opt.helmSecretValuesResolver != nil && helm.HasSecretValues(values) {
+				values, secretValues, err = helm.ResolveSecretValues(ctx, opt.helmSecretValuesResolver, q.ProjectName, values)
+				if err != nil {
+					return nil, "", fmt.Errorf("error resolving the secrets of the helm values: %w", err)
+				}
+			}
+			err = os.WriteFile(p, values, 0o600)
 			if err != nil {
 				return nil, "", fmt.Errorf("error writing helm values file: %w", err)
 			}
@@ -1200,13 +1532,39 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 
 	defer h.Dispose()
 
-	out, command, err := h.Template(templateOpts)
+	if q.ApplicationSource.IsHelmLookupsEnabled() {
+		if q.HelmLookupProxy == nil {
+			log.WithField("application", q.AppName).Debug("Rendering the Helm templates without lookups, since the lookup proxy of the application controller is not provided")
+		} else {
+			kubeconfigPath, err := helm.NewLookupKubeconfig(q.HelmLookupProxy.Url, q.HelmLookupProxy.Token, templateOpts.Namespace)
+			if err != nil {
+				return nil, "", err
+			}
+			defer func() {
+				if err := os.Remove(kubeconfigPath); err != nil && !os.IsNotExist(err) {
+					log.Warnf("Failed to remove the kubeconfig of the Helm lookup proxy: %v", err)
+				}
+			}()
+			templateOpts.Kubeconfig = kubeconfigPath
+		}
+	}
+
+	// The errors of helm can include the values, in which case the resolved secrets must not be surfaced
+	runTemplate := func() (string, string, error) {
+		out, command, err := h.Template(templateOpts)
+		if err != nil && len(secretValues) > 0 {
+			err = errors.New(helm.RedactSecretValues(err.Error(), secretValues))
+		}
+		return out, command, err
+	}
+
+	out, command, err := runTemplate()
 	if err != nil {
 		if !helm.IsMissingDependencyErr(err) {
 			return nil, "", err
 		}
 
-		err = runHelmBuild(appPath, h)
+		err = runHelmBuild(appPath, h, helmRepos, opt)
 		if err != nil {
 			var reposNotPermitted []string
 			// We do a sanity check here to give a nicer error message in case any of the Helm repositories are not permitted by
@@ -1229,14 +1587,24 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 			return nil, "", err
 		}
 
-		out, command, err = h.Template(templateOpts)
+		out, command, err = runTemplate()
 		if err != nil {
 			return nil, "", err
 		}
 	}
 	objs, err := kube.SplitYAML([]byte(out))
+	// The resolved secrets are only kept in the data of the Kubernetes Secrets, so that they are masked when the rendered
+	// manifests are shown to the users
+	if len(secretValues) > 0 {
+		for _, obj := range objs {
+			helm.RedactSecretValuesFromObject(obj, secretValues)
+		}
+	}
 
 	redactedCommand := redactPaths(command, gitRepoPaths, templateOpts.ExtraValues)
+	if templateOpts.Kubeconfig != "" {
+		redactedCommand = strings.ReplaceAll(redactedCommand, templateOpts.Kubeconfig, "<temp kubeconfig of the lookup proxy>")
+	}
 
 	return objs, redactedCommand, err
 }
@@ -1274,8 +1642,12 @@ func getResolvedValueFiles(
 		var resolvedPath pathutil.ResolvedFilePath
 		var err error
 
-		referencedSource := getReferencedSource(rawValueFile, refSources)
-		if referencedSource != nil {
+		if argo.IsRepoValueFile(rawValueFile) {
+			resolvedPath, err = getResolvedRepoValueFile(rawValueFile, env, allowedValueFilesSchemas, refSources, gitRepoPaths)
+			if err != nil {
+				return nil, fmt.Errorf("error resolving value file path: %w", err)
+			}
+		} else if referencedSource := getReferencedSource(rawValueFile, refSources); referencedSource != nil {
 			// If the $-prefixed path appears to reference another source, do env substitution _after_ resolving that source.
 			resolvedPath, err = getResolvedRefValueFile(rawValueFile, env, allowedValueFilesSchemas, referencedSource.Repo.Repo, gitRepoPaths)
 			if err != nil {
@@ -1327,6 +1699,52 @@ func getResolvedRefValueFile(
 	return resolvedPath, nil
 }
 
+// getResolvedRepoValueFile resolves the path of a value file of another repository, relative to the checkout of the
+// repository
+func getResolvedRepoValueFile(
+	rawValueFile string,
+	env *v1alpha1.Env,
+	allowedValueFilesSchemas []string,
+	refSources map[string]*v1alpha1.RefTarget,
+	gitRepoPaths io.TempPaths,
+) (pathutil.ResolvedFilePath, error) {
+	repoValueFile, err := argo.ParseRepoValueFile(rawValueFile)
+	if err != nil {
+		return "", err
+	}
+	referencedSource, ok := refSources[repoValueFile.RefKey()]
+	if !ok {
+		return "", fmt.Errorf("the repository of value file %q was not resolved", rawValueFile)
+	}
+	repoPath := gitRepoPaths.GetPathIfExists(git.NormalizeGitURL(referencedSource.Repo.Repo))
+	if repoPath == "" {
+		return "", fmt.Errorf("failed to find repo %q", referencedSource.Repo.Repo)
+	}
+	// Resolve the path relative to the referenced repo and block any attempt at traversal.
+	resolvedPath, _, err := pathutil.ResolveValueFilePathOrUrl(repoPath, repoPath, env.Envsubst("/"+repoValueFile.Path), allowedValueFilesSchemas)
+	if err != nil {
+		return "", fmt.Errorf("error resolving value file path: %w", err)
+	}
+	return resolvedPath, nil
+}
+
+// getValueFileRefVar returns the key of the referenced source holding the value file: the ref of another source of
+// the application ($ref/values.yaml) or the repository of the value file (repo://). It returns an empty string if the
+// value file is a file of the source.
+func getValueFileRefVar(valueFile string, hasMultipleSources bool) (string, error) {
+	if argo.IsRepoValueFile(valueFile) {
+		repoValueFile, err := argo.ParseRepoValueFile(valueFile)
+		if err != nil {
+			return "", err
+		}
+		return repoValueFile.RefKey(), nil
+	}
+	if hasMultipleSources && strings.HasPrefix(valueFile, "$") {
+		return strings.Split(valueFile, "/")[0], nil
+	}
+	return "", nil
+}
+
 func getReferencedSource(rawValueFile string, refSources map[string]*v1alpha1.RefTarget) *v1alpha1.RefTarget {
 	if !strings.HasPrefix(rawValueFile, "$") {
 		return nil
@@ -1336,14 +1754,17 @@ func getReferencedSource(rawValueFile string, refSources map[string]*v1alpha1.Re
 	return referencedSource
 }
 
+// getRepoCredential returns the credential template with the longest URL matching the URL of the repository
 func getRepoCredential(repoCredentials []*v1alpha1.RepoCreds, repoURL string) *v1alpha1.RepoCreds {
+	var match *v1alpha1.RepoCreds
+	repoURL = strings.TrimPrefix(repoURL, ociPrefix)
 	for _, cred := range repoCredentials {
-		url := strings.TrimPrefix(repoURL, ociPrefix)
-		if strings.HasPrefix(url, cred.URL) {
-			return cred
+		credURL := strings.TrimPrefix(cred.URL, ociPrefix)
+		if strings.HasPrefix(repoURL, credURL) && (match == nil || len(credURL) > len(strings.TrimPrefix(match.URL, ociPrefix))) {
+			match = cred
 		}
 	}
-	return nil
+	return match
 }
 
 type (
@@ -1352,11 +1773,17 @@ type (
 		cmpTarDoneCh                chan<- bool
 		cmpTarExcludedGlobs         []string
 		cmpUseManifestGeneratePaths bool
+		helmSecretValuesResolver    helm.SecretValuesResolver
+		sandboxConfig               *SandboxConfig
+		jsonnetBundleCacheDir       string
+		helmDependencyCache         helmDependencyCache
+		helmDependencyCacheMaxSize  int64
+		cpuUsage                    *executil.CPUUsage
 	}
 )
 
 func newGenerateManifestOpt(opts ...GenerateManifestOpt) *generateManifestOpt {
-	o := &generateManifestOpt{}
+	o := &generateManifestOpt{jsonnetBundleCacheDir: defaultJsonnetBundleCacheDir}
 	for _, opt := range opts {
 		opt(o)
 	}
@@ -1388,6 +1815,45 @@ func WithCMPUseManifestGeneratePaths(enabled bool) GenerateManifestOpt {
 	}
 }
 
+// WithHelmSecretValuesResolver defines the resolver of the secrets referenced by the Helm values. The references are
+// passed as is to helm if not defined.
+func WithHelmSecretValuesResolver(resolver helm.SecretValuesResolver) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.helmSecretValuesResolver = resolver
+	}
+}
+
+// WithSandboxConfig defines the sandboxes of the config management tools. The tools run without sandbox if not
+// defined.
+func WithSandboxConfig(config *SandboxConfig) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.sandboxConfig = config
+	}
+}
+
+// WithCPUUsage accumulates the CPU time of the `helm template` and `kustomize build` commands generating the manifests.
+func WithCPUUsage(usage *executil.CPUUsage) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.cpuUsage = usage
+	}
+}
+
+// WithJsonnetBundleCacheDir defines the directory caching the dependencies installed by jsonnet-bundler.
+func WithJsonnetBundleCacheDir(dir string) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.jsonnetBundleCacheDir = dir
+	}
+}
+
+// WithHelmDependencyCache shares the dependencies built by `helm dependency build` which don't exceed the maximum size
+// through the cache.
+func WithHelmDependencyCache(dependencyCache helmDependencyCache, maxSize int64) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.helmDependencyCache = dependencyCache
+		o.helmDependencyCacheMaxSize = maxSize
+	}
+}
+
 // GenerateManifests generates manifests from a path. Overrides are applied as a side effect on the given ApplicationSource.
 func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string, q *apiclient.ManifestRequest, isLocal bool, gitCredsStore git.CredsStore, maxCombinedManifestQuantity resource.Quantity, gitRepoPaths io.TempPaths, opts ...GenerateManifestOpt) (*apiclient.ManifestResponse, error) {
 	opt := newGenerateManifestOpt(opts...)
@@ -1411,7 +1877,7 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 	switch appSourceType {
 	case v1alpha1.ApplicationSourceTypeHelm:
 		var command string
-		targetObjs, command, err = helmTemplate(appPath, repoRoot, env, q, isLocal, gitRepoPaths)
+		targetObjs, command, err = helmTemplate(ctx, appPath, repoRoot, env, q, isLocal, gitRepoPaths, opt)
 		commands = append(commands, command)
 	case v1alpha1.ApplicationSourceTypeKustomize:
 		kustomizeBinary := ""
@@ -1422,7 +1888,12 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 		targetObjs, _, commands, err = k.Build(q.ApplicationSource.Kustomize, q.KustomizeOptions, env, &kustomize.BuildOpts{
 			KubeVersion: text.SemVer(q.ApplicationSource.GetKubeVersionOrDefault(q.KubeVersion)),
 			APIVersions: q.ApplicationSource.GetAPIVersionsOrDefault(q.ApiVersions),
+			Sandbox:     opt.sandboxConfig.Kustomize(q.ProjectName),
+			CPUUsage:    opt.cpuUsage,
 		})
+	case v1alpha1.ApplicationSourceTypeCUE:
+		c := cue.NewCUEApp(repoRoot, appPath, "")
+		targetObjs, commands, err = c.Export(q.ApplicationSource.CUE, env)
 	case v1alpha1.ApplicationSourceTypePlugin:
 		pluginName := ""
 		if q.ApplicationSource.Plugin != nil {
@@ -1439,7 +1910,16 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 			directory = &v1alpha1.ApplicationSourceDirectory{}
 		}
 		logCtx := log.WithField("application", q.AppName)
-		targetObjs, err = findManifests(logCtx, appPath, repoRoot, env, *directory, q.EnabledSourceTypes, maxCombinedManifestQuantity)
+		var jsonnetBundleDir string
+		if directory.Jsonnet.Bundler {
+			var releaseJsonnetBundle func()
+			jsonnetBundleDir, releaseJsonnetBundle, err = installJsonnetBundle(ctx, appPath, opt.jsonnetBundleCacheDir)
+			if err != nil {
+				return nil, err
+			}
+			defer releaseJsonnetBundle()
+		}
+		targetObjs, err = findManifests(logCtx, appPath, repoRoot, env, *directory, q.EnabledSourceTypes, maxCombinedManifestQuantity, jsonnetBundleDir)
 	}
 	if err != nil {
 		return nil, err
@@ -1486,6 +1966,13 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 		}
 	}
 
+	if len(q.ManifestGenerationWebhooks) > 0 {
+		manifests, err = runManifestGenerationWebhooks(ctx, q, revision, manifests)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &apiclient.ManifestResponse{
 		Manifests:  manifests,
 		SourceType: string(appSourceType),
@@ -1633,7 +2120,7 @@ func isNullList(obj *unstructured.Unstructured) bool {
 var manifestFile = regexp.MustCompile(`^.*\.(yaml|yml|json|jsonnet)$`)
 
 // findManifests looks at all yaml files in a directory and unmarshals them into a list of unstructured objects
-func findManifests(logCtx *log.Entry, appPath string, repoRoot string, env *v1alpha1.Env, directory v1alpha1.ApplicationSourceDirectory, enabledManifestGeneration map[string]bool, maxCombinedManifestQuantity resource.Quantity) ([]*unstructured.Unstructured, error) {
+func findManifests(logCtx *log.Entry, appPath string, repoRoot string, env *v1alpha1.Env, directory v1alpha1.ApplicationSourceDirectory, enabledManifestGeneration map[string]bool, maxCombinedManifestQuantity resource.Quantity, jsonnetBundleDir string) ([]*unstructured.Unstructured, error) {
 	// Validate the directory before loading any manifests to save memory.
 	potentiallyValidManifests, err := getPotentiallyValidManifests(logCtx, appPath, repoRoot, directory.Recurse, directory.Include, directory.Exclude, maxCombinedManifestQuantity)
 	if err != nil {
@@ -1646,11 +2133,16 @@ func findManifests(logCtx *log.Entry, appPath string, repoRoot string, env *v1al
 		manifestPath := potentiallyValidManifest.path
 		manifestFileInfo := potentiallyValidManifest.fileInfo
 
+		// the files of jsonnet-bundler are not manifests
+		if jsonnetBundleDir != "" && (manifestFileInfo.Name() == jsonnetBundlerFile || manifestFileInfo.Name() == jsonnetBundlerLockFile) {
+			continue
+		}
+
 		if strings.HasSuffix(manifestFileInfo.Name(), ".jsonnet") {
 			if !discovery.IsManifestGenerationEnabled(v1alpha1.ApplicationSourceTypeDirectory, enabledManifestGeneration) {
 				continue
 			}
-			vm, err := makeJsonnetVM(appPath, repoRoot, directory.Jsonnet, env)
+			vm, err := makeJsonnetVM(appPath, repoRoot, directory.Jsonnet, env, jsonnetBundleDir)
 			if err != nil {
 				return nil, err
 			}
@@ -1871,25 +2363,41 @@ func getPotentiallyValidManifests(logCtx *log.Entry, appPath string, repoRoot st
 	return potentiallyValidManifests, nil
 }
 
-func makeJsonnetVM(appPath string, repoRoot string, sourceJsonnet v1alpha1.ApplicationSourceJsonnet, env *v1alpha1.Env) (*jsonnet.VM, error) {
+func makeJsonnetVM(appPath string, repoRoot string, sourceJsonnet v1alpha1.ApplicationSourceJsonnet, env *v1alpha1.Env, bundleDir string) (*jsonnet.VM, error) {
 	vm := jsonnet.MakeVM()
 	for i, j := range sourceJsonnet.TLAs {
 		sourceJsonnet.TLAs[i].Value = env.Envsubst(j.Value)
+		sourceJsonnet.TLAs[i].File = env.Envsubst(j.File)
 	}
 	for i, j := range sourceJsonnet.ExtVars {
 		sourceJsonnet.ExtVars[i].Value = env.Envsubst(j.Value)
+		sourceJsonnet.ExtVars[i].File = env.Envsubst(j.File)
 	}
 	for _, arg := range sourceJsonnet.TLAs {
-		if arg.Code {
+		switch {
+		case arg.File != "":
+			path, _, err := pathutil.ResolveValueFilePathOrUrl(appPath, repoRoot, arg.File, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve the file of the top-level argument %s: %w", arg.Name, err)
+			}
+			vm.TLACode(arg.Name, jsonnetFileImport(string(path), arg.Code))
+		case arg.Code:
 			vm.TLACode(arg.Name, arg.Value)
-		} else {
+		default:
 			vm.TLAVar(arg.Name, arg.Value)
 		}
 	}
 	for _, extVar := range sourceJsonnet.ExtVars {
-		if extVar.Code {
+		switch {
+		case extVar.File != "":
+			path, _, err := pathutil.ResolveValueFilePathOrUrl(appPath, repoRoot, extVar.File, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve the file of the external variable %s: %w", extVar.Name, err)
+			}
+			vm.ExtCode(extVar.Name, jsonnetFileImport(string(path), extVar.Code))
+		case extVar.Code:
 			vm.ExtCode(extVar.Name, extVar.Value)
-		} else {
+		default:
 			vm.ExtVar(extVar.Name, extVar.Value)
 		}
 	}
@@ -1904,6 +2412,10 @@ func makeJsonnetVM(appPath string, repoRoot string, sourceJsonnet v1alpha1.Appli
 		}
 		jpaths = append(jpaths, string(jpath))
 	}
+	// the dependencies installed by jsonnet-bundler are imported like the vendor directory of the jsonnet CLI
+	if bundleDir != "" {
+		jpaths = append(jpaths, bundleDir)
+	}
 
 	vm.Importer(&jsonnet.FileImporter{
 		JPaths: jpaths,
@@ -1987,7 +2499,12 @@ func runConfigManagementPluginSidecars(ctx context.Context, appPath, repoPath, p
 	}
 
 	// generate manifests using commands provided in plugin config file in detected cmp-server sidecar
-	cmpManifests, err := generateManifestsCMP(ctx, appPath, rootPath, env, cmpClient, tarDoneCh, tarExcludedGlobs)
+	var cmpManifests *pluginclient.ManifestResponse
+	if pluginConfigResponse.ProtocolVersion >= pluginclient.ProtocolVersion && pluginConfigResponse.GetCapabilities().GetGenerateStream() {
+		cmpManifests, err = generateManifestsCMPStream(ctx, appPath, rootPath, env, cmpClient, tarDoneCh, tarExcludedGlobs)
+	} else {
+		cmpManifests, err = generateManifestsCMP(ctx, appPath, rootPath, env, cmpClient, tarDoneCh, tarExcludedGlobs)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error generating manifests in cmp: %w", err)
 	}
@@ -2027,6 +2544,39 @@ func generateManifestsCMP(ctx context.Context, appPath, rootPath string, env []s
 	return generateManifestStream.CloseAndRecv()
 }
 
+// generateManifestsCMPStream will send the appPath files to the cmp-server over a gRPC stream,
+// and receive the manifests streamed by the cmp-server as they are generated.
+func generateManifestsCMPStream(ctx context.Context, appPath, rootPath string, env []string, cmpClient pluginclient.ConfigManagementPluginServiceClient, tarDoneCh chan<- bool, tarExcludedGlobs []string) (*pluginclient.ManifestResponse, error) {
+	generateManifestStream, err := cmpClient.GenerateManifestStream(ctx, grpc_retry.Disable())
+	if err != nil {
+		return nil, fmt.Errorf("error getting generateManifestStream: %w", err)
+	}
+	opts := []cmp.SenderOption{
+		cmp.WithTarDoneChan(tarDoneCh),
+	}
+
+	err = cmp.SendRepoStream(generateManifestStream.Context(), appPath, rootPath, generateManifestStream, env, tarExcludedGlobs, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error sending file to cmp-server: %w", err)
+	}
+	err = generateManifestStream.CloseSend()
+	if err != nil {
+		return nil, fmt.Errorf("error closing the stream to cmp-server: %w", err)
+	}
+
+	response := &pluginclient.ManifestResponse{}
+	for {
+		res, err := generateManifestStream.Recv()
+		if errors.Is(err, goio.EOF) {
+			return response, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		response.Manifests = append(response.Manifests, res.Manifest)
+	}
+}
+
 func (s *Service) GetAppDetails(ctx context.Context, q *apiclient.RepoServerAppDetailsQuery) (*apiclient.RepoAppDetailsResponse, error) {
 	res := &apiclient.RepoAppDetailsResponse{}
 
@@ -2236,6 +2786,16 @@ func populatePluginAppDetails(ctx context.Context, res *apiclient.RepoAppDetails
 	}
 	defer io.Close(conn)
 
+	pluginConfigResponse, err := cmpClient.CheckPluginConfiguration(ctx, &emptypb.Empty{})
+	if err != nil {
+		return fmt.Errorf("error calling cmp-server checkPluginConfiguration: %w", err)
+	}
+	// The files aren't sent to the plugins which advertise that they announce no parameters
+	if pluginConfigResponse.ProtocolVersion >= pluginclient.ProtocolVersion && !pluginConfigResponse.GetCapabilities().GetParameters() {
+		res.Plugin = &apiclient.PluginAppSpec{}
+		return nil
+	}
+
 	parametersAnnouncementStream, err := cmpClient.GetParametersAnnouncement(ctx, grpc_retry.Disable())
 	if err != nil {
 		return fmt.Errorf("error getting parametersAnnouncementStream: %w", err)
@@ -2257,7 +2817,10 @@ func populatePluginAppDetails(ctx context.Context, res *apiclient.RepoAppDetails
 	return nil
 }
 
-func (s *Service) GetRevisionMetadata(_ context.Context, q *apiclient.RepoServerRevisionMetadataRequest) (*v1alpha1.RevisionMetadata, error) {
+func (s *Service) GetRevisionMetadata(ctx context.Context, q *apiclient.RepoServerRevisionMetadataRequest) (*v1alpha1.RevisionMetadata, error) {
+	if oci.IsOCIRepo(q.Repo.Repo) {
+		return s.getOCIRevisionMetadata(ctx, q)
+	}
 	if !git.IsCommitSHA(q.Revision) && !git.IsTruncatedCommitSHA(q.Revision) {
 		return nil, fmt.Errorf("revision %s must be resolved", q.Revision)
 	}
@@ -2320,7 +2883,7 @@ func (s *Service) GetRevisionMetadata(_ context.Context, q *apiclient.RepoServer
 			if vr.Result == gpg.VerifyResultUnknown {
 				signatureInfo = "UNKNOWN signature: " + vr.Message
 			} else {
-				signatureInfo = fmt.Sprintf("%s signature from %s key %s", vr.Result, vr.Cipher, gpg.KeyID(vr.KeyID))
+				signatureInfo = fmt.Sprintf("%s signature from %s key %s", vr.Result, vr.Cipher, gpg.SignatureKeyID(vr.KeyID))
 			}
 		} else {
 			signatureInfo = "Revision is not signed."
@@ -2332,6 +2895,40 @@ func (s *Service) GetRevisionMetadata(_ context.Context, q *apiclient.RepoServer
 	return metadata, nil
 }
 
+// getOCIRevisionMetadata returns the metadata of an OCI artifact, read from the annotations of its manifest
+func (s *Service) getOCIRevisionMetadata(ctx context.Context, q *apiclient.RepoServerRevisionMetadataRequest) (*v1alpha1.RevisionMetadata, error) {
+	if !oci.IsDigest(q.Revision) {
+		return nil, fmt.Errorf("revision %s must be resolved", q.Revision)
+	}
+	metadata, err := s.cache.GetRevisionMetadata(q.Repo.Repo, q.Revision)
+	if err == nil {
+		return metadata, nil
+	}
+	if !errors.Is(err, cache.ErrCacheMiss) {
+		log.Warnf("revision metadata cache error %s/%s: %v", q.Repo.Repo, q.Revision, err)
+	}
+	ociClient, err := s.newOCIClient(q.Repo.Repo, q.Repo.GetHelmCreds(), q.Repo.Proxy, q.Repo.NoProxy)
+	if err != nil {
+		return nil, err
+	}
+	annotations, err := ociClient.Annotations(ctx, q.Revision)
+	if err != nil {
+		return nil, err
+	}
+	metadata = &v1alpha1.RevisionMetadata{
+		Author:  annotations[ocispec.AnnotationAuthors],
+		Message: textutils.FirstNonEmpty(annotations[ocispec.AnnotationDescription], annotations[ocispec.AnnotationTitle]),
+	}
+	if version := annotations[ocispec.AnnotationVersion]; version != "" {
+		metadata.Tags = []string{version}
+	}
+	if created, err := time.Parse(time.RFC3339, annotations[ocispec.AnnotationCreated]); err == nil {
+		metadata.Date = metav1.Time{Time: created}
+	}
+	_ = s.cache.SetRevisionMetadata(q.Repo.Repo, q.Revision, metadata)
+	return metadata, nil
+}
+
 // GetRevisionChartDetails returns the helm chart details of a given version
 func (s *Service) GetRevisionChartDetails(_ context.Context, q *apiclient.RepoServerRevisionChartDetailsRequest) (*v1alpha1.ChartDetails, error) {
 	details, err := s.cache.GetRevisionChartDetails(q.Repo.Repo, q.Name, q.Revision)
@@ -2382,7 +2979,7 @@ func (s *Service) newClient(repo *v1alpha1.Repository, opts ...git.ClientOpts) (
 	if err != nil {
 		return nil, err
 	}
-	opts = append(opts, git.WithEventHandlers(metrics.NewGitClientEventHandlers(s.metricsServer)))
+	opts = append(opts, git.WithEventHandlers(metrics.NewGitClientEventHandlers(s.metricsServer)), git.WithDepth(repo.Depth), git.WithFilter(repo.Filter))
 	return s.newGitClient(repo.Repo, repoPath, repo.GetGitCreds(s.gitCredsStore), repo.IsInsecure(), repo.EnableLFS, repo.Proxy, repo.NoProxy, opts...)
 }
 
@@ -2401,9 +2998,68 @@ func (s *Service) newClientResolveRevision(repo *v1alpha1.Repository, revision s
 	return gitClient, commitSHA, nil
 }
 
+// newOCIClientResolveRevision returns a client of the OCI repository, and the digest of the artifact referenced by the
+// revision, which is either a tag or a digest.
+func (s *Service) newOCIClientResolveRevision(ctx context.Context, repo *v1alpha1.Repository, revision string) (oci.Client, string, error) {
+	var opts []oci.ClientOpts
+	if s.initConstants.OCIManifestMaxExtractedSize > 0 {
+		opts = append(opts, oci.WithMaxExtractedSize(s.initConstants.OCIManifestMaxExtractedSize))
+	}
+	if s.initConstants.OCISignatureVerifier != nil {
+		opts = append(opts, oci.WithSignatureVerifier(s.initConstants.OCISignatureVerifier))
+	}
+	ociClient, err := s.newOCIClient(repo.Repo, repo.GetHelmCreds(), repo.Proxy, repo.NoProxy, opts...)
+	if err != nil {
+		return nil, "", err
+	}
+	if oci.IsDigest(revision) {
+		return ociClient, revision, nil
+	}
+	digest, err := ociClient.ResolveRevision(ctx, revision)
+	if err != nil {
+		return nil, "", err
+	}
+	return ociClient, digest, nil
+}
+
+// withSharedChartCache shares the Helm dependencies through the cache if the shared chart cache is enabled, the
+// dependencies are built again on hard refresh
+func (s *Service) withSharedChartCache(noCache bool) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		if s.initConstants.SharedChartCacheMaxSize > 0 && s.cache != nil && !noCache {
+			WithHelmDependencyCache(s.cache, s.initConstants.SharedChartCacheMaxSize)(o)
+		}
+	}
+}
+
+// observeManifestGeneration records the render cost of the manifests of the application
+func (s *Service) observeManifestGeneration(q *apiclient.ManifestRequest, res *apiclient.ManifestResponse, err error, duration time.Duration, cpu time.Duration) {
+	sourceType := ""
+	outputSize := 0
+	if res != nil {
+		sourceType = res.SourceType
+		for _, manifest := range res.Manifests {
+			outputSize += len(manifest)
+		}
+	} else if q.ApplicationSource != nil {
+		if explicitType, typeErr := q.ApplicationSource.ExplicitType(); typeErr == nil && explicitType != nil {
+			sourceType = string(*explicitType)
+		}
+	}
+	if sourceType == "" {
+		sourceType = "Unknown"
+	}
+	s.metricsServer.ObserveManifestGeneration(q.AppName, sourceType, err != nil, duration, cpu, outputSize)
+}
+
 func (s *Service) newHelmClientResolveRevision(repo *v1alpha1.Repository, revision string, chart string, noRevisionCache bool) (helm.Client, string, error) {
 	enableOCI := repo.EnableOCI || helm.IsHelmOciRepo(repo.Repo)
-	helmClient := s.newHelmClient(repo.Repo, repo.GetHelmCreds(), enableOCI, repo.Proxy, repo.NoProxy, helm.WithIndexCache(s.cache), helm.WithChartPaths(s.chartPaths))
+	opts := []helm.ClientOpts{helm.WithIndexCache(s.cache), helm.WithChartPaths(s.chartPaths)}
+	// the charts are downloaded again on hard refresh
+	if s.initConstants.SharedChartCacheMaxSize > 0 && !noRevisionCache {
+		opts = append(opts, helm.WithChartCache(s.cache, s.initConstants.SharedChartCacheMaxSize))
+	}
+	helmClient := s.newHelmClient(repo.Repo, repo.GetHelmCreds(), enableOCI, repo.Proxy, repo.NoProxy, opts...)
 	if helm.IsVersion(revision) {
 		return helmClient, revision, nil
 	}
@@ -2576,7 +3232,7 @@ func (s *Service) GetHelmCharts(_ context.Context, q *apiclient.HelmChartsReques
 	return &res, nil
 }
 
-func (s *Service) TestRepository(_ context.Context, q *apiclient.TestRepositoryRequest) (*apiclient.TestRepositoryResponse, error) {
+func (s *Service) TestRepository(ctx context.Context, q *apiclient.TestRepositoryRequest) (*apiclient.TestRepositoryResponse, error) {
 	repo := q.Repo
 	// per Type doc, "git" should be assumed if empty or absent
 	if repo.Type == "" {
@@ -2597,6 +3253,13 @@ func (s *Service) TestRepository(_ context.Context, q *apiclient.TestRepositoryR
 			_, err := helm.NewClient(repo.Repo, repo.GetHelmCreds(), repo.EnableOCI, repo.Proxy, repo.NoProxy).GetIndex(false, s.initConstants.HelmRegistryMaxIndexSize)
 			return err
 		},
+		"oci": func() error {
+			ociClient, err := s.newOCIClient(repo.Repo, repo.GetHelmCreds(), repo.Proxy, repo.NoProxy)
+			if err != nil {
+				return err
+			}
+			return ociClient.TestRepository(ctx)
+		},
 	}
 	check := checks[repo.Type]
 	apiResp := &apiclient.TestRepositoryResponse{VerifiedRepository: false}
@@ -2608,7 +3271,7 @@ func (s *Service) TestRepository(_ context.Context, q *apiclient.TestRepositoryR
 }
 
 // ResolveRevision resolves the revision/ambiguousRevision specified in the ResolveRevisionRequest request into a concrete revision.
-func (s *Service) ResolveRevision(_ context.Context, q *apiclient.ResolveRevisionRequest) (*apiclient.ResolveRevisionResponse, error) {
+func (s *Service) ResolveRevision(ctx context.Context, q *apiclient.ResolveRevisionRequest) (*apiclient.ResolveRevisionResponse, error) {
 	repo := q.Repo
 	app := q.App
 	ambiguousRevision := q.AmbiguousRevision
@@ -2624,6 +3287,16 @@ func (s *Service) ResolveRevision(_ context.Context, q *apiclient.ResolveRevisio
 			AmbiguousRevision: fmt.Sprintf("%v (%v)", ambiguousRevision, revision),
 		}, nil
 	}
+	if source.IsOCI() {
+		_, revision, err := s.newOCIClientResolveRevision(ctx, repo, ambiguousRevision)
+		if err != nil {
+			return &apiclient.ResolveRevisionResponse{Revision: "", AmbiguousRevision: ""}, err
+		}
+		return &apiclient.ResolveRevisionResponse{
+			Revision:          revision,
+			AmbiguousRevision: fmt.Sprintf("%s (%s)", textutils.FirstNonEmpty(ambiguousRevision, oci.DefaultTag), revision),
+		}, nil
+	}
 	gitClient, err := git.NewClient(repo.Repo, repo.GetGitCreds(s.gitCredsStore), repo.IsInsecure(), repo.IsLFSEnabled(), repo.Proxy, repo.NoProxy)
 	if err != nil {
 		return &apiclient.ResolveRevisionResponse{Revision: "", AmbiguousRevision: ""}, err
@@ -2722,7 +3395,7 @@ func verifyCommitSignature(verifyCommit bool, gitClient git.Client, revision str
 		if vr.Result == gpg.VerifyResultUnknown {
 			return fmt.Errorf("UNKNOWN signature: %s", vr.Message)
 		}
-		log.Debugf("%s signature from %s key %s", vr.Result, vr.Cipher, gpg.KeyID(vr.KeyID))
+		log.Debugf("%s signature from %s key %s", vr.Result, vr.Cipher, gpg.SignatureKeyID(vr.KeyID))
 	}
 	return nil
 }
@@ -2744,11 +3417,21 @@ func (s *Service) GetGitDirectories(_ context.Context, request *apiclient.GitDir
 		return nil, err
 	}
 
-	// check the cache and return the results if present
+	includePaths, excludePaths := request.GetIncludePaths(), request.GetExcludePaths()
+	filtered := len(includePaths) > 0 || len(excludePaths) > 0
+
+	// check the cache and return the results if present. The directories matching the patterns of the request are
+	// cached apart from the whole tree, which is filtered if it is cached already.
+	if filtered {
+		if cachedPaths, err := s.cache.GetFilteredGitDirectories(repo.Repo, revision, includePaths, excludePaths); err == nil {
+			log.Debugf("cache hit for repo: %s revision: %s", repo.Repo, revision)
+			return &apiclient.GitDirectoriesResponse{Paths: cachedPaths}, nil
+		}
+	}
 	if cachedPaths, err := s.cache.GetGitDirectories(repo.Repo, revision); err == nil {
 		log.Debugf("cache hit for repo: %s revision: %s", repo.Repo, revision)
 		return &apiclient.GitDirectoriesResponse{
-			Paths: cachedPaths,
+			Paths: filterGitDirectories(cachedPaths, includePaths, excludePaths),
 		}, nil
 	}
 
@@ -2787,6 +3470,10 @@ func (s *Service) GetGitDirectories(_ context.Context, request *apiclient.GitDir
 			return nil
 		}
 
+		if !mayMatchGitDirectory(includePaths, filepath.ToSlash(relativePath)) {
+			return filepath.SkipDir // Skip the directories which can't lead to a requested path
+		}
+
 		paths = append(paths, relativePath)
 
 		return nil
@@ -2795,14 +3482,71 @@ func (s *Service) GetGitDirectories(_ context.Context, request *apiclient.GitDir
 	}
 
 	log.Debugf("found %d git paths from %s", len(paths), repo.Repo)
-	err = s.cache.SetGitDirectories(repo.Repo, revision, paths)
+	if filtered {
+		paths = filterGitDirectories(paths, includePaths, excludePaths)
+		err = s.cache.SetFilteredGitDirectories(repo.Repo, revision, includePaths, excludePaths, paths)
+	} else {
+		err = s.cache.SetGitDirectories(repo.Repo, revision, paths)
+	}
 	if err != nil {
 		log.Warnf("error caching git directories for repo %s with revision %s: %v", repo.Repo, revision, err)
 	}
 
-	return &apiclient.GitDirectoriesResponse{
-		Paths: paths,
-	}, nil
+	return &apiclient.GitDirectoriesResponse{Paths: paths}, nil
+}
+
+// filterGitDirectories returns the paths matching one of the include patterns, or all the paths if there are none, and
+// none of the exclude patterns.
+func filterGitDirectories(paths []string, includePaths []string, excludePaths []string) []string {
+	if len(includePaths) == 0 && len(excludePaths) == 0 {
+		return paths
+	}
+	matchesAny := func(patterns []string, dirPath string) bool {
+		for _, pattern := range patterns {
+			if match, err := path.Match(pattern, dirPath); err == nil && match {
+				return true
+			}
+		}
+		return false
+	}
+	res := []string{}
+	for _, dirPath := range paths {
+		if len(includePaths) > 0 && !matchesAny(includePaths, dirPath) {
+			continue
+		}
+		if matchesAny(excludePaths, dirPath) {
+			continue
+		}
+		res = append(res, dirPath)
+	}
+	return res
+}
+
+// mayMatchGitDirectory returns whether the directory or one of its subdirectories may match one of the include
+// patterns, or true if there are none. The wildcards of the patterns don't match the separators, so a subdirectory
+// may match a pattern only if the segments of the directory match the leading segments of the pattern.
+func mayMatchGitDirectory(includePaths []string, dirPath string) bool {
+	if len(includePaths) == 0 {
+		return true
+	}
+	dirSegments := strings.Split(dirPath, "/")
+	for _, pattern := range includePaths {
+		patternSegments := strings.Split(pattern, "/")
+		if len(dirSegments) > len(patternSegments) {
+			continue
+		}
+		matches := true
+		for i, segment := range dirSegments {
+			if match, err := path.Match(patternSegments[i], segment); err != nil || !match {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
 }
 
 // UpdateRevisionForPaths compares two git revisions and checks if the files in the given paths have changed
@@ -29,6 +29,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -48,6 +50,7 @@ import (
 	helmmocks "github.com/argoproj/argo-cd/v3/util/helm/mocks"
 	"github.com/argoproj/argo-cd/v3/util/io"
 	iomocks "github.com/argoproj/argo-cd/v3/util/io/mocks"
+	"github.com/argoproj/argo-cd/v3/util/oci"
 )
 
 const testSignature = `gpg: Signature made Wed Feb 26 23:22:34 2020 CET
@@ -227,6 +230,32 @@ func TestGenerateYamlManifestInDir(t *testing.T) {
 	assert.Len(t, res2.Manifests, 3)
 }
 
+func TestGenerateManifest_ResourceQuota(t *testing.T) {
+	service := newService(t, "./testdata/concatenated")
+
+	newRequest := func(maxResources int64, maxManifestsSize int64) *apiclient.ManifestRequest {
+		return &apiclient.ManifestRequest{
+			Repo:               &v1alpha1.Repository{},
+			ApplicationSource:  &v1alpha1.ApplicationSource{Path: "."},
+			ProjectName:        "something",
+			ProjectSourceRepos: []string{"*"},
+			MaxResources:       maxResources,
+			MaxManifestsSize:   maxManifestsSize,
+		}
+	}
+
+	res, err := service.GenerateManifest(t.Context(), newRequest(3, 0))
+	require.NoError(t, err)
+	assert.Len(t, res.Manifests, 3)
+
+	_, err = service.GenerateManifest(t.Context(), newRequest(2, 0))
+	require.ErrorContains(t, err, "application renders 3 resources, exceeding the maximum of 2 resources")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = service.GenerateManifest(t.Context(), newRequest(0, 10))
+	require.ErrorContains(t, err, "exceeding the maximum size of 10")
+}
+
 func Test_GenerateManifests_NoOutOfBoundsAccess(t *testing.T) {
 	testCases := []struct {
 		name                    string
@@ -331,6 +360,68 @@ func TestGenerateManifests_K8SAPIResetCache(t *testing.T) {
 	assert.Greater(t, len(res.Manifests), 1)
 }
 
+func TestGenerateManifests_ManifestGenerationLock(t *testing.T) {
+	previousInterval := manifestGenerationLockPollInterval
+	manifestGenerationLockPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() {
+		manifestGenerationLockPollInterval = previousInterval
+	})
+
+	src := v1alpha1.ApplicationSource{Path: "."}
+	q := apiclient.ManifestRequest{
+		Repo:               &v1alpha1.Repository{},
+		ApplicationSource:  &src,
+		ProjectName:        "something",
+		ProjectSourceRepos: []string{"*"},
+	}
+
+	t.Run("WaitsForOtherReplica", func(t *testing.T) {
+		service, gitMocks, _ := newServiceWithMocks(t, "./testdata/several-files", false)
+		service.initConstants.ManifestGenerationLockTimeout = time.Minute
+
+		owner, err := service.cache.TryLockManifests(mock.Anything, &src, q.RefSources, &q, "", "", "", "", nil, "", "other-replica", time.Minute)
+		require.NoError(t, err)
+		require.Equal(t, "other-replica", owner)
+
+		cachedFakeResponse := &apiclient.ManifestResponse{Manifests: []string{"Fake"}, Revision: mock.Anything}
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			_ = service.cache.SetManifests(mock.Anything, &src, q.RefSources, &q, "", "", "", "", &cache.CachedManifestResponse{ManifestResponse: cachedFakeResponse}, nil, "")
+		}()
+
+		res, err := service.GenerateManifest(t.Context(), &q)
+		require.NoError(t, err)
+		assert.Equal(t, cachedFakeResponse, res)
+		gitMocks.AssertNotCalled(t, "Fetch", mock.Anything)
+	})
+
+	t.Run("GeneratesAfterTimeout", func(t *testing.T) {
+		service, gitMocks, _ := newServiceWithMocks(t, "./testdata/several-files", false)
+		service.initConstants.ManifestGenerationLockTimeout = 50 * time.Millisecond
+
+		_, err := service.cache.TryLockManifests(mock.Anything, &src, q.RefSources, &q, "", "", "", "", nil, "", "other-replica", time.Minute)
+		require.NoError(t, err)
+
+		res, err := service.GenerateManifest(t.Context(), &q)
+		require.NoError(t, err)
+		assert.NotEmpty(t, res.Manifests)
+		gitMocks.AssertCalled(t, "Fetch", mock.Anything)
+	})
+
+	t.Run("ReleasesLock", func(t *testing.T) {
+		service, _, _ := newServiceWithMocks(t, "./testdata/several-files", false)
+		service.initConstants.ManifestGenerationLockTimeout = time.Minute
+
+		res, err := service.GenerateManifest(t.Context(), &q)
+		require.NoError(t, err)
+		assert.NotEmpty(t, res.Manifests)
+
+		owner, err := service.cache.TryLockManifests(mock.Anything, &src, q.RefSources, &q, "", "", "", "", nil, "", "other-replica", time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, "other-replica", owner)
+	})
+}
+
 func TestGenerateManifests_EmptyCache(t *testing.T) {
 	service, gitMocks, mockCache := newServiceWithMocks(t, "../../manifests/base", false)
 
@@ -638,6 +729,81 @@ func TestHelmChartReferencingExternalValues_OutOfBounds_Symlink(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestResolveSourceValues(t *testing.T) {
+	spec := v1alpha1.ApplicationSpec{
+		Sources: []v1alpha1.ApplicationSource{
+			{RepoURL: "https://helm.example.com", Chart: "my-chart", TargetRevision: ">= 1.0.0", Helm: &v1alpha1.ApplicationSourceHelm{
+				ValuesFrom: []v1alpha1.HelmValuesFromSource{{Ref: "config", ConfigMap: "chart-values"}},
+			}},
+			{Ref: "config", RepoURL: "https://git.example.com/test/repo", Path: "./testdata/values-from"},
+		},
+	}
+	refSources, err := argo.GetRefSources(t.Context(), spec.Sources, spec.Project, func(_ context.Context, _ string, _ string) (*v1alpha1.Repository, error) {
+		return &v1alpha1.Repository{
+			Repo: "https://git.example.com/test/repo",
+		}, nil
+	}, []string{}, false)
+	require.NoError(t, err)
+	newRequest := func(source *v1alpha1.ApplicationSource) *apiclient.ManifestRequest {
+		return &apiclient.ManifestRequest{
+			Repo: &v1alpha1.Repository{}, ApplicationSource: source, NoCache: true, RefSources: refSources, HasMultipleSources: true, ProjectName: "something",
+			ProjectSourceRepos: []string{"*"},
+		}
+	}
+
+	t.Run("Values", func(t *testing.T) {
+		values, err := newService(t, ".").resolveSourceValues(t.Context(), newRequest(&spec.Sources[0]))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"replicas: 3\n"}, values)
+	})
+	t.Run("NoValuesFrom", func(t *testing.T) {
+		values, err := newService(t, ".").resolveSourceValues(t.Context(), newRequest(&spec.Sources[1]))
+		require.NoError(t, err)
+		assert.Empty(t, values)
+	})
+	t.Run("UnknownRef", func(t *testing.T) {
+		source := spec.Sources[0].DeepCopy()
+		source.Helm.ValuesFrom[0].Ref = "unknown"
+		_, err := newService(t, ".").resolveSourceValues(t.Context(), newRequest(source))
+		require.ErrorContains(t, err, `source referenced "unknown" in valuesFrom`)
+	})
+	t.Run("UnknownConfigMap", func(t *testing.T) {
+		source := spec.Sources[0].DeepCopy()
+		source.Helm.ValuesFrom[0].ConfigMap = "unknown"
+		_, err := newService(t, ".").resolveSourceValues(t.Context(), newRequest(source))
+		require.ErrorContains(t, err, `ConfigMap "unknown" not found in the manifests generated by source "config"`)
+	})
+	t.Run("UnknownKey", func(t *testing.T) {
+		source := spec.Sources[0].DeepCopy()
+		source.Helm.ValuesFrom[0].Key = "unknown"
+		_, err := newService(t, ".").resolveSourceValues(t.Context(), newRequest(source))
+		require.ErrorContains(t, err, `key "unknown" not found in ConfigMap "chart-values"`)
+	})
+}
+
+func TestCheckValuesFromCycle(t *testing.T) {
+	newSource := func(ref string, valuesFrom ...string) *v1alpha1.ApplicationSource {
+		source := &v1alpha1.ApplicationSource{Ref: ref, Path: ref, Helm: &v1alpha1.ApplicationSourceHelm{}}
+		for _, r := range valuesFrom {
+			source.Helm.ValuesFrom = append(source.Helm.ValuesFrom, v1alpha1.HelmValuesFromSource{Ref: r, ConfigMap: "values"})
+		}
+		return source
+	}
+	refSources := map[string]*v1alpha1.RefTarget{
+		"$a": {Source: newSource("a", "b")},
+		"$b": {Source: newSource("b", "c")},
+		"$c": {Source: newSource("c")},
+		"$d": {Source: newSource("d", "e")},
+		"$e": {Source: newSource("e", "d")},
+		"$f": {Source: newSource("f", "f")},
+	}
+
+	require.NoError(t, checkValuesFromCycle(newSource("", "a", "c"), refSources))
+	require.NoError(t, checkValuesFromCycle(refSources["$a"].Source, refSources))
+	require.EqualError(t, checkValuesFromCycle(newSource("", "d"), refSources), "circular dependency between sources in valuesFrom: d -> e -> d")
+	require.EqualError(t, checkValuesFromCycle(refSources["$f"].Source, refSources), "circular dependency between sources in valuesFrom: f -> f")
+}
+
 func TestGenerateManifestsUseExactRevision(t *testing.T) {
 	service, gitClient, _ := newServiceWithMocks(t, ".", false)
 
@@ -1550,6 +1716,29 @@ func TestGenerateFromUTF16(t *testing.T) {
 	assert.Len(t, res1.Manifests, 2)
 }
 
+func TestGenerateCUEManifests(t *testing.T) {
+	appPath := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(appPath, "guestbook.cue"), []byte("package guestbook\n"), 0o644))
+	binDir := t.TempDir()
+	script := "#!/bin/sh\necho '{\"configMap\": {\"apiVersion\": \"v1\", \"kind\": \"ConfigMap\", \"metadata\": {\"name\": \"guestbook\"}}}'\n"
+	require.NoError(t, os.WriteFile(filepath.Join(binDir, "cue"), []byte(script), 0o755))
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	q := apiclient.ManifestRequest{
+		Repo:               &v1alpha1.Repository{},
+		ApplicationSource:  &v1alpha1.ApplicationSource{},
+		ProjectName:        "something",
+		ProjectSourceRepos: []string{"*"},
+		EnabledSourceTypes: map[string]bool{string(v1alpha1.ApplicationSourceTypeCUE): true},
+	}
+	res, err := GenerateManifests(t.Context(), appPath, appPath, "", &q, false, &git.NoopCredsStore{}, resource.MustParse("0"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, string(v1alpha1.ApplicationSourceTypeCUE), res.SourceType)
+	require.Len(t, res.Manifests, 1)
+	assert.Contains(t, res.Manifests[0], `"name":"guestbook"`)
+	assert.Equal(t, []string{"cue export --out=json -- ."}, res.Commands)
+}
+
 func TestListApps(t *testing.T) {
 	service := newService(t, "./testdata")
 
@@ -2334,7 +2523,7 @@ func TestFindResources(t *testing.T) {
 				Recurse: true,
 				Include: tc.include,
 				Exclude: tc.exclude,
-			}, map[string]bool{}, resource.MustParse("0"))
+			}, map[string]bool{}, resource.MustParse("0"), "")
 			require.NoError(t, err)
 			var names []string
 			for i := range objs {
@@ -2349,7 +2538,7 @@ func TestFindManifests_Exclude(t *testing.T) {
 	objs, err := findManifests(&log.Entry{}, "testdata/app-include-exclude", ".", nil, v1alpha1.ApplicationSourceDirectory{
 		Recurse: true,
 		Exclude: "subdir/deploymentSub.yaml",
-	}, map[string]bool{}, resource.MustParse("0"))
+	}, map[string]bool{}, resource.MustParse("0"), "")
 
 	require.NoError(t, err)
 	require.Len(t, objs, 1)
@@ -2361,7 +2550,7 @@ func TestFindManifests_Exclude_NothingMatches(t *testing.T) {
 	objs, err := findManifests(&log.Entry{}, "testdata/app-include-exclude", ".", nil, v1alpha1.ApplicationSourceDirectory{
 		Recurse: true,
 		Exclude: "nothing.yaml",
-	}, map[string]bool{}, resource.MustParse("0"))
+	}, map[string]bool{}, resource.MustParse("0"), "")
 
 	require.NoError(t, err)
 	require.Len(t, objs, 2)
@@ -2701,7 +2890,7 @@ func Test_findManifests(t *testing.T) {
 		err = os.Chmod(appDir, 0o000)
 		require.NoError(t, err)
 
-		manifests, err := findManifests(logCtx, appDir, appDir, nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, appDir, appDir, nil, noRecurse, nil, resource.MustParse("0"), "")
 		assert.Empty(t, manifests)
 		require.Error(t, err)
 
@@ -2713,20 +2902,20 @@ func Test_findManifests(t *testing.T) {
 	})
 
 	t.Run("no recursion when recursion is disabled", func(t *testing.T) {
-		manifests, err := findManifests(logCtx, "./testdata/recurse", "./testdata/recurse", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/recurse", "./testdata/recurse", nil, noRecurse, nil, resource.MustParse("0"), "")
 		assert.Len(t, manifests, 2)
 		require.NoError(t, err)
 	})
 
 	t.Run("recursion when recursion is enabled", func(t *testing.T) {
 		recurse := v1alpha1.ApplicationSourceDirectory{Recurse: true}
-		manifests, err := findManifests(logCtx, "./testdata/recurse", "./testdata/recurse", nil, recurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/recurse", "./testdata/recurse", nil, recurse, nil, resource.MustParse("0"), "")
 		assert.Len(t, manifests, 4)
 		require.NoError(t, err)
 	})
 
 	t.Run("non-JSON/YAML is skipped", func(t *testing.T) {
-		manifests, err := findManifests(logCtx, "./testdata/non-manifest-file", "./testdata/non-manifest-file", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/non-manifest-file", "./testdata/non-manifest-file", nil, noRecurse, nil, resource.MustParse("0"), "")
 		assert.Empty(t, manifests)
 		require.NoError(t, err)
 	})
@@ -2741,14 +2930,14 @@ func Test_findManifests(t *testing.T) {
 		t.Chdir(testDir)
 		require.NoError(t, fileutil.CreateSymlink(t, "a.json", "b.json"))
 		require.NoError(t, fileutil.CreateSymlink(t, "b.json", "a.json"))
-		manifests, err := findManifests(logCtx, "./testdata/circular-link", "./testdata/circular-link", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/circular-link", "./testdata/circular-link", nil, noRecurse, nil, resource.MustParse("0"), "")
 		assert.Empty(t, manifests)
 		require.Error(t, err)
 	})
 
 	t.Run("out-of-bounds symlink should throw an error", func(t *testing.T) {
 		require.DirExists(t, "./testdata/out-of-bounds-link")
-		manifests, err := findManifests(logCtx, "./testdata/out-of-bounds-link", "./testdata/out-of-bounds-link", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/out-of-bounds-link", "./testdata/out-of-bounds-link", nil, noRecurse, nil, resource.MustParse("0"), "")
 		assert.Empty(t, manifests)
 		require.Error(t, err)
 	})
@@ -2758,13 +2947,13 @@ func Test_findManifests(t *testing.T) {
 		require.NoError(t, err)
 		appPath, err := filepath.Abs("./testdata/in-bounds-link/app")
 		require.NoError(t, err)
-		manifests, err := findManifests(logCtx, appPath, repoRoot, nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, appPath, repoRoot, nil, noRecurse, nil, resource.MustParse("0"), "")
 		assert.Len(t, manifests, 1)
 		require.NoError(t, err)
 	})
 
 	t.Run("symlink to nowhere should be ignored", func(t *testing.T) {
-		manifests, err := findManifests(logCtx, "./testdata/link-to-nowhere", "./testdata/link-to-nowhere", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/link-to-nowhere", "./testdata/link-to-nowhere", nil, noRecurse, nil, resource.MustParse("0"), "")
 		assert.Empty(t, manifests)
 		require.NoError(t, err)
 	})
@@ -2775,75 +2964,75 @@ func Test_findManifests(t *testing.T) {
 		appPath, err := filepath.Abs("./testdata/in-bounds-link/app")
 		require.NoError(t, err)
 		// The file is 35 bytes.
-		manifests, err := findManifests(logCtx, appPath, repoRoot, nil, noRecurse, nil, resource.MustParse("34"))
+		manifests, err := findManifests(logCtx, appPath, repoRoot, nil, noRecurse, nil, resource.MustParse("34"), "")
 		assert.Empty(t, manifests)
 		assert.ErrorIs(t, err, ErrExceededMaxCombinedManifestFileSize)
 	})
 
 	t.Run("group of files should be limited at precisely the sum of their size", func(t *testing.T) {
 		// There is a total of 10 files, each file being 10 bytes.
-		manifests, err := findManifests(logCtx, "./testdata/several-files", "./testdata/several-files", nil, noRecurse, nil, resource.MustParse("365"))
+		manifests, err := findManifests(logCtx, "./testdata/several-files", "./testdata/several-files", nil, noRecurse, nil, resource.MustParse("365"), "")
 		assert.Len(t, manifests, 10)
 		require.NoError(t, err)
 
-		manifests, err = findManifests(logCtx, "./testdata/several-files", "./testdata/several-files", nil, noRecurse, nil, resource.MustParse("364"))
+		manifests, err = findManifests(logCtx, "./testdata/several-files", "./testdata/several-files", nil, noRecurse, nil, resource.MustParse("364"), "")
 		assert.Empty(t, manifests)
 		assert.ErrorIs(t, err, ErrExceededMaxCombinedManifestFileSize)
 	})
 
 	t.Run("jsonnet isn't counted against size limit", func(t *testing.T) {
 		// Each file is 36 bytes. Only the 36-byte json file should be counted against the limit.
-		manifests, err := findManifests(logCtx, "./testdata/jsonnet-and-json", "./testdata/jsonnet-and-json", nil, noRecurse, nil, resource.MustParse("36"))
+		manifests, err := findManifests(logCtx, "./testdata/jsonnet-and-json", "./testdata/jsonnet-and-json", nil, noRecurse, nil, resource.MustParse("36"), "")
 		assert.Len(t, manifests, 2)
 		require.NoError(t, err)
 
-		manifests, err = findManifests(logCtx, "./testdata/jsonnet-and-json", "./testdata/jsonnet-and-json", nil, noRecurse, nil, resource.MustParse("35"))
+		manifests, err = findManifests(logCtx, "./testdata/jsonnet-and-json", "./testdata/jsonnet-and-json", nil, noRecurse, nil, resource.MustParse("35"), "")
 		assert.Empty(t, manifests)
 		assert.ErrorIs(t, err, ErrExceededMaxCombinedManifestFileSize)
 	})
 
 	t.Run("partially valid YAML file throws an error", func(t *testing.T) {
 		require.DirExists(t, "./testdata/partially-valid-yaml")
-		manifests, err := findManifests(logCtx, "./testdata/partially-valid-yaml", "./testdata/partially-valid-yaml", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/partially-valid-yaml", "./testdata/partially-valid-yaml", nil, noRecurse, nil, resource.MustParse("0"), "")
 		assert.Empty(t, manifests)
 		require.Error(t, err)
 	})
 
 	t.Run("invalid manifest throws an error", func(t *testing.T) {
 		require.DirExists(t, "./testdata/invalid-manifests")
-		manifests, err := findManifests(logCtx, "./testdata/invalid-manifests", "./testdata/invalid-manifests", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/invalid-manifests", "./testdata/invalid-manifests", nil, noRecurse, nil, resource.MustParse("0"), "")
 		assert.Empty(t, manifests)
 		require.Error(t, err)
 	})
 
 	t.Run("irrelevant YAML gets skipped, relevant YAML gets parsed", func(t *testing.T) {
-		manifests, err := findManifests(logCtx, "./testdata/irrelevant-yaml", "./testdata/irrelevant-yaml", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/irrelevant-yaml", "./testdata/irrelevant-yaml", nil, noRecurse, nil, resource.MustParse("0"), "")
 		assert.Len(t, manifests, 1)
 		require.NoError(t, err)
 	})
 
 	t.Run("multiple JSON objects in one file throws an error", func(t *testing.T) {
 		require.DirExists(t, "./testdata/json-list")
-		manifests, err := findManifests(logCtx, "./testdata/json-list", "./testdata/json-list", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/json-list", "./testdata/json-list", nil, noRecurse, nil, resource.MustParse("0"), "")
 		assert.Empty(t, manifests)
 		require.Error(t, err)
 	})
 
 	t.Run("invalid JSON throws an error", func(t *testing.T) {
 		require.DirExists(t, "./testdata/invalid-json")
-		manifests, err := findManifests(logCtx, "./testdata/invalid-json", "./testdata/invalid-json", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/invalid-json", "./testdata/invalid-json", nil, noRecurse, nil, resource.MustParse("0"), "")
 		assert.Empty(t, manifests)
 		require.Error(t, err)
 	})
 
 	t.Run("valid JSON returns manifest and no error", func(t *testing.T) {
-		manifests, err := findManifests(logCtx, "./testdata/valid-json", "./testdata/valid-json", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/valid-json", "./testdata/valid-json", nil, noRecurse, nil, resource.MustParse("0"), "")
 		assert.Len(t, manifests, 1)
 		require.NoError(t, err)
 	})
 
 	t.Run("YAML with an empty document doesn't throw an error", func(t *testing.T) {
-		manifests, err := findManifests(logCtx, "./testdata/yaml-with-empty-document", "./testdata/yaml-with-empty-document", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/yaml-with-empty-document", "./testdata/yaml-with-empty-document", nil, noRecurse, nil, resource.MustParse("0"), "")
 		assert.Len(t, manifests, 1)
 		require.NoError(t, err)
 	})
@@ -2912,6 +3101,124 @@ func TestResolveRevisionNegativeScenarios(t *testing.T) {
 	assert.Equal(t, expectedResolveRevisionResponse, resolveRevisionResponse)
 }
 
+// fakeOCIClient serves the artifacts of an OCI repository from directories
+type fakeOCIClient struct {
+	// digests are the digests of the tags
+	digests map[string]string
+	// paths are the directories of the artifacts by digest
+	paths       map[string]string
+	annotations map[string]string
+}
+
+func (c *fakeOCIClient) ResolveRevision(_ context.Context, revision string) (string, error) {
+	if revision == "" {
+		revision = oci.DefaultTag
+	}
+	if d, ok := c.digests[revision]; ok {
+		return d, nil
+	}
+	return "", fmt.Errorf("unknown tag %s", revision)
+}
+
+func (c *fakeOCIClient) Extract(_ context.Context, digest string) (string, io.Closer, error) {
+	if path, ok := c.paths[digest]; ok {
+		return path, io.NopCloser, nil
+	}
+	return "", nil, fmt.Errorf("unknown digest %s", digest)
+}
+
+func (c *fakeOCIClient) Annotations(_ context.Context, _ string) (map[string]string, error) {
+	return c.annotations, nil
+}
+
+func (c *fakeOCIClient) TestRepository(_ context.Context) error {
+	return nil
+}
+
+func newServiceWithOCIClient(t *testing.T, client *fakeOCIClient) *Service {
+	t.Helper()
+	service := newService(t, ".")
+	service.newOCIClient = func(_ string, _ helm.Creds, _ string, _ string, _ ...oci.ClientOpts) (oci.Client, error) {
+		return client, nil
+	}
+	return service
+}
+
+func TestGenerateManifest_OCI(t *testing.T) {
+	digest := "sha256:9834876dcfb05cb167a5c24953eba58c4ac89b1adf57f28f2f9d09af107ee8f0"
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "base"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "base", "cm.yaml"), []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: my-cm\n"), 0o644))
+	service := newServiceWithOCIClient(t, &fakeOCIClient{digests: map[string]string{"1.0.0": digest}, paths: map[string]string{digest: dir}})
+
+	for _, revision := range []string{"1.0.0", digest} {
+		src := &v1alpha1.ApplicationSource{RepoURL: "oci://example.com/my-org/my-manifests", TargetRevision: revision, Path: "base"}
+		res, err := service.GenerateManifest(t.Context(), &apiclient.ManifestRequest{
+			Repo:               &v1alpha1.Repository{Repo: src.RepoURL},
+			ApplicationSource:  src,
+			NoCache:            true,
+			ProjectName:        "something",
+			ProjectSourceRepos: []string{"*"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, digest, res.Revision)
+		require.Len(t, res.Manifests, 1)
+		assert.Contains(t, res.Manifests[0], "my-cm")
+	}
+
+	src := &v1alpha1.ApplicationSource{RepoURL: "oci://example.com/my-org/my-manifests", TargetRevision: "2.0.0", Path: "base"}
+	_, err := service.GenerateManifest(t.Context(), &apiclient.ManifestRequest{
+		Repo:               &v1alpha1.Repository{Repo: src.RepoURL},
+		ApplicationSource:  src,
+		NoCache:            true,
+		ProjectName:        "something",
+		ProjectSourceRepos: []string{"*"},
+	})
+	assert.ErrorContains(t, err, "unknown tag 2.0.0")
+}
+
+func TestResolveRevision_OCI(t *testing.T) {
+	digest := "sha256:9834876dcfb05cb167a5c24953eba58c4ac89b1adf57f28f2f9d09af107ee8f0"
+	service := newServiceWithOCIClient(t, &fakeOCIClient{digests: map[string]string{"latest": digest}})
+	app := &v1alpha1.Application{Spec: v1alpha1.ApplicationSpec{Source: &v1alpha1.ApplicationSource{RepoURL: "oci://example.com/my-org/my-manifests"}}}
+
+	res, err := service.ResolveRevision(t.Context(), &apiclient.ResolveRevisionRequest{
+		Repo: &v1alpha1.Repository{Repo: "oci://example.com/my-org/my-manifests"},
+		App:  app,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &apiclient.ResolveRevisionResponse{
+		Revision:          digest,
+		AmbiguousRevision: "latest (" + digest + ")",
+	}, res)
+}
+
+func TestGetRevisionMetadata_OCI(t *testing.T) {
+	digest := "sha256:9834876dcfb05cb167a5c24953eba58c4ac89b1adf57f28f2f9d09af107ee8f0"
+	service := newServiceWithOCIClient(t, &fakeOCIClient{annotations: map[string]string{
+		"org.opencontainers.image.authors":     "John Doe <john_doe@my-company.com>",
+		"org.opencontainers.image.created":     "2024-01-02T03:04:05Z",
+		"org.opencontainers.image.description": "my manifests",
+		"org.opencontainers.image.version":     "1.0.0",
+	}})
+
+	metadata, err := service.GetRevisionMetadata(t.Context(), &apiclient.RepoServerRevisionMetadataRequest{
+		Repo:     &v1alpha1.Repository{Repo: "oci://example.com/my-org/my-manifests"},
+		Revision: digest,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "John Doe <john_doe@my-company.com>", metadata.Author)
+	assert.Equal(t, "my manifests", metadata.Message)
+	assert.Equal(t, []string{"1.0.0"}, metadata.Tags)
+	assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), metadata.Date.UTC())
+
+	_, err = service.GetRevisionMetadata(t.Context(), &apiclient.RepoServerRevisionMetadataRequest{
+		Repo:     &v1alpha1.Repository{Repo: "oci://example.com/my-org/my-manifests"},
+		Revision: "1.0.0",
+	})
+	assert.ErrorContains(t, err, "must be resolved")
+}
+
 func TestDirectoryPermissionInitializer(t *testing.T) {
 	dir := t.TempDir()
 
@@ -3234,6 +3541,27 @@ func TestGetHelmRepos_OCIDependenciesWithRepo(t *testing.T) {
 	assert.Equal(t, "example.com/myrepo", helmRepos[0].Repo)
 }
 
+func TestGetHelmRepos_OCIDependenciesWithLongestMatchingCredential(t *testing.T) {
+	helmRepos, err := getHelmRepos("./testdata/oci-dependencies", nil, []*v1alpha1.RepoCreds{
+		{URL: "example.com", Username: "registry", Password: "registry", EnableOCI: true},
+		{URL: "oci://example.com/myrepo", Username: "myrepo", Password: "myrepo"},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, helmRepos, 1)
+	assert.Equal(t, "myrepo", helmRepos[0].GetUsername())
+	assert.True(t, helmRepos[0].EnableOci)
+}
+
+func TestGetHelmRepos_OCIDependenciesWithTrailingSlashRepo(t *testing.T) {
+	helmRepos, err := getHelmRepos("./testdata/oci-dependencies", []*v1alpha1.Repository{{Repo: "example.com/myrepo/", Username: "test", Password: "test", EnableOCI: true}}, nil)
+	require.NoError(t, err)
+
+	require.Len(t, helmRepos, 1)
+	assert.Equal(t, "test", helmRepos[0].GetUsername())
+	assert.Equal(t, "example.com/myrepo/", helmRepos[0].Repo)
+}
+
 func TestGetHelmRepo_NamedRepos(t *testing.T) {
 	src := v1alpha1.ApplicationSource{Path: "."}
 	q := apiclient.ManifestRequest{Repo: &v1alpha1.Repository{}, ApplicationSource: &src, Repos: []*v1alpha1.Repository{{
@@ -3349,6 +3677,40 @@ func Test_getResolvedValueFiles(t *testing.T) {
 			},
 			expectedErr: true,
 		},
+		{
+			name:    "value file of another repo",
+			rawPath: "repo://https://github.com/org/repo1//prod/values.yaml?ref=v1.0.0",
+			env:     &v1alpha1.Env{},
+			refSources: map[string]*v1alpha1.RefTarget{
+				"repo://https://github.com/org/repo1@v1.0.0": {
+					Repo: v1alpha1.Repository{
+						Repo: "https://github.com/org/repo1",
+					},
+					TargetRevision: "v1.0.0",
+				},
+			},
+			expectedPath: path.Join(tempDir, "repo1", "prod", "values.yaml"),
+		},
+		{
+			name:        "value file of an unresolved repo",
+			rawPath:     "repo://https://github.com/org/repo1//prod/values.yaml",
+			env:         &v1alpha1.Env{},
+			refSources:  map[string]*v1alpha1.RefTarget{},
+			expectedErr: true,
+		},
+		{
+			name:    "traversal in value file of another repo is blocked",
+			rawPath: "repo://https://github.com/org/repo1//../values.yaml",
+			env:     &v1alpha1.Env{},
+			refSources: map[string]*v1alpha1.RefTarget{
+				"repo://https://github.com/org/repo1@HEAD": {
+					Repo: v1alpha1.Repository{
+						Repo: "https://github.com/org/repo1",
+					},
+				},
+			},
+			expectedErr: true,
+		},
 		{
 			name:    "env var is resolved",
 			rawPath: "$ref/$APP_PATH/values.yaml",
@@ -3531,6 +3893,61 @@ func TestGetGitDirectories(t *testing.T) {
 	})
 }
 
+func TestGetGitDirectoriesWithPaths(t *testing.T) {
+	root := "./testdata/git-files-dirs"
+	s, _, cacheMocks := newServiceWithOpt(t, func(gitClient *gitmocks.Client, _ *helmmocks.Client, paths *iomocks.TempPaths) {
+		gitClient.On("Init").Return(nil)
+		gitClient.On("IsRevisionPresent", mock.Anything).Return(false)
+		gitClient.On("Fetch", mock.Anything).Return(nil)
+		gitClient.On("Checkout", mock.Anything, mock.Anything).Twice().Return("", nil)
+		gitClient.On("LsRemote", "HEAD").Return("632039659e542ed7de0c170a4fcc1c571b288fc0", nil)
+		gitClient.On("Root").Return(root)
+		paths.On("GetPath", mock.Anything).Return(root, nil)
+		paths.On("GetPathIfExists", mock.Anything).Return(root, nil)
+	}, root)
+	dirRequest := &apiclient.GitDirectoriesRequest{
+		Repo:         &v1alpha1.Repository{Repo: "a-url.com"},
+		Revision:     "HEAD",
+		IncludePaths: []string{"app/*"},
+		ExcludePaths: []string{"app/bar"},
+	}
+	directories, err := s.GetGitDirectories(t.Context(), dirRequest)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"app/foo"}, directories.GetPaths())
+
+	// the directories matching the patterns are cached apart from the whole tree
+	directories, err = s.GetGitDirectories(t.Context(), dirRequest)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"app/foo"}, directories.GetPaths())
+	cacheMocks.mockCache.AssertCacheCalledTimes(t, &repositorymocks.CacheCallCounts{
+		ExternalSets: 1,
+		ExternalGets: 3,
+	})
+
+	directories, err = s.GetGitDirectories(t.Context(), &apiclient.GitDirectoriesRequest{Repo: dirRequest.Repo, Revision: "HEAD"})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"app", "app/bar", "app/foo", "app/foo/bar", "somedir"}, directories.GetPaths())
+
+	// the cached tree is filtered with the patterns of the request
+	dirRequest.IncludePaths, dirRequest.ExcludePaths = []string{"somedir"}, nil
+	directories, err = s.GetGitDirectories(t.Context(), dirRequest)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"somedir"}, directories.GetPaths())
+	cacheMocks.mockCache.AssertCacheCalledTimes(t, &repositorymocks.CacheCallCounts{
+		ExternalSets: 2,
+		ExternalGets: 6,
+	})
+}
+
+func TestMayMatchGitDirectory(t *testing.T) {
+	assert.True(t, mayMatchGitDirectory(nil, "app/foo/bar"))
+	assert.True(t, mayMatchGitDirectory([]string{"app/*"}, "app"))
+	assert.True(t, mayMatchGitDirectory([]string{"app/*"}, "app/foo"))
+	assert.False(t, mayMatchGitDirectory([]string{"app/*"}, "app/foo/bar"))
+	assert.False(t, mayMatchGitDirectory([]string{"app/*"}, "somedir"))
+	assert.True(t, mayMatchGitDirectory([]string{"app/*", "*/bar"}, "somedir"))
+}
+
 func TestGetGitDirectoriesWithHiddenDirSupported(t *testing.T) {
 	// test not using the cache
 	root := "./testdata/git-files-dirs"
@@ -4413,3 +4830,14 @@ func Test_SkipSchemaValidation(t *testing.T) {
 		require.ErrorContains(t, err, "values don't meet the specifications of the schema(s)")
 	})
 }
+
+func TestHasHelmSecretValues(t *testing.T) {
+	service := newService(t, ".")
+	q := &apiclient.ManifestRequest{ApplicationSource: &v1alpha1.ApplicationSource{Helm: &v1alpha1.ApplicationSourceHelm{Values: "password: $secret:argocd/guestbook:password"}}}
+	assert.False(t, service.hasHelmSecretValues(q))
+
+	service.initConstants.HelmSecretValuesResolver = helm.NewSecretValuesResolver(helm.SecretValuesResolverOpts{})
+	assert.True(t, service.hasHelmSecretValues(q))
+	assert.False(t, service.hasHelmSecretValues(&apiclient.ManifestRequest{ApplicationSource: &v1alpha1.ApplicationSource{Helm: &v1alpha1.ApplicationSourceHelm{Values: "replicas: 2"}}}))
+	assert.False(t, service.hasHelmSecretValues(&apiclient.ManifestRequest{ApplicationSource: &v1alpha1.ApplicationSource{}}))
+}
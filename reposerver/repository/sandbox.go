@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+
+	executil "github.com/argoproj/argo-cd/v3/util/exec"
+)
+
+// ToolSandboxes holds the sandboxes of the config management tools run by the repo server. The commands of a tool run
+// without sandbox if nil.
+type ToolSandboxes struct {
+	Helm      *executil.Sandbox `json:"helm,omitempty"`
+	Kustomize *executil.Sandbox `json:"kustomize,omitempty"`
+}
+
+// SandboxConfig configures the sandboxes of the config management tools, e.g.
+//
+//	helm:
+//	  cpu: 500m
+//	  memory: 512Mi
+//	  timeout: 1m
+//	projects:
+//	  untrusted:
+//	    helm:
+//	      memory: 128Mi
+//
+// The sandbox of a tool defined for a project replaces the sandbox of the tool for the Applications of the project.
+type SandboxConfig struct {
+	ToolSandboxes `json:",inline"`
+	Projects      map[string]ToolSandboxes `json:"projects,omitempty"`
+}
+
+// ParseSandboxConfig parses the YAML configuration of the sandboxes. It returns nil if the configuration is empty.
+func ParseSandboxConfig(data string) (*SandboxConfig, error) {
+	if data == "" {
+		return nil, nil
+	}
+	var config SandboxConfig
+	if err := yaml.UnmarshalStrict([]byte(data), &config); err != nil {
+		return nil, fmt.Errorf("failed to parse the sandbox configuration: %w", err)
+	}
+	return &config, nil
+}
+
+// Helm returns the sandbox of the helm commands of the Applications of the project.
+func (c *SandboxConfig) Helm(project string) *executil.Sandbox {
+	if c == nil {
+		return nil
+	}
+	if p, ok := c.Projects[project]; ok && p.Helm != nil {
+		return p.Helm
+	}
+	return c.ToolSandboxes.Helm
+}
+
+// Kustomize returns the sandbox of the kustomize commands of the Applications of the project.
+func (c *SandboxConfig) Kustomize(project string) *executil.Sandbox {
+	if c == nil {
+		return nil
+	}
+	if p, ok := c.Projects[project]; ok && p.Kustomize != nil {
+		return p.Kustomize
+	}
+	return c.ToolSandboxes.Kustomize
+}
+
+// HasResourceLimits returns whether a sandbox limits the CPU or the memory of the commands.
+func (c *SandboxConfig) HasResourceLimits() bool {
+	if c == nil {
+		return false
+	}
+	if c.ToolSandboxes.Helm.HasResourceLimits() || c.ToolSandboxes.Kustomize.HasResourceLimits() {
+		return true
+	}
+	for _, p := range c.Projects {
+		if p.Helm.HasResourceLimits() || p.Kustomize.HasResourceLimits() {
+			return true
+		}
+	}
+	return false
+}
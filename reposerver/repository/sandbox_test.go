@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSandboxConfig(t *testing.T) {
+	t.Run("Empty", func(t *testing.T) {
+		config, err := ParseSandboxConfig("")
+		require.NoError(t, err)
+		assert.Nil(t, config)
+		assert.Nil(t, config.Helm("default"))
+		assert.Nil(t, config.Kustomize("default"))
+		assert.False(t, config.HasResourceLimits())
+	})
+
+	t.Run("Per tool and per project", func(t *testing.T) {
+		config, err := ParseSandboxConfig(`
+helm:
+  cpu: 500m
+  memory: 512Mi
+  timeout: 1m
+kustomize:
+  network: true
+projects:
+  untrusted:
+    helm:
+      memory: 128Mi
+`)
+		require.NoError(t, err)
+		assert.True(t, config.HasResourceLimits())
+
+		helm := config.Helm("default")
+		require.NotNil(t, helm)
+		assert.Equal(t, "500m", helm.CPU.String())
+		assert.Equal(t, "512Mi", helm.Memory.String())
+		assert.Equal(t, time.Minute, helm.GetTimeout())
+		assert.False(t, helm.Network)
+
+		helm = config.Helm("untrusted")
+		require.NotNil(t, helm)
+		assert.Nil(t, helm.CPU)
+		assert.Equal(t, "128Mi", helm.Memory.String())
+
+		kustomize := config.Kustomize("untrusted")
+		require.NotNil(t, kustomize)
+		assert.True(t, kustomize.Network)
+		assert.False(t, kustomize.HasResourceLimits())
+	})
+
+	t.Run("Unknown field", func(t *testing.T) {
+		_, err := ParseSandboxConfig("helm:\n  memroy: 128Mi\n")
+		require.ErrorContains(t, err, "failed to parse the sandbox configuration")
+	})
+}
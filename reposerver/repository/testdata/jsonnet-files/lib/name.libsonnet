@@ -0,0 +1 @@
+'my-cm'
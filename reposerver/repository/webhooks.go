@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+)
+
+// maxManifestGenerationWebhookResponseSize is the maximum size of the responses of the manifest generation webhooks
+const maxManifestGenerationWebhookResponseSize = 100 * 1024 * 1024
+
+// manifestGenerationWebhookRequest is the body posted to the manifest generation webhooks
+type manifestGenerationWebhookRequest struct {
+	AppName   string            `json:"appName"`
+	Namespace string            `json:"namespace"`
+	Project   string            `json:"project"`
+	RepoURL   string            `json:"repoURL"`
+	Revision  string            `json:"revision"`
+	Path      string            `json:"path"`
+	Manifests []json.RawMessage `json:"manifests"`
+}
+
+// manifestGenerationWebhookResponse is the body returned by the manifest generation webhooks. The manifests are
+// replaced by the returned ones, if any.
+type manifestGenerationWebhookResponse struct {
+	Allowed   bool              `json:"allowed"`
+	Message   string            `json:"message,omitempty"`
+	Manifests []json.RawMessage `json:"manifests,omitempty"`
+}
+
+// errManifestsRejected is returned when a webhook rejects the manifests, whatever its failure policy
+var errManifestsRejected = errors.New("manifests rejected")
+
+// runManifestGenerationWebhooks posts the manifests to the webhooks of the project in order, each webhook receiving
+// the manifests returned by the previous one, and returns the manifests returned by the last webhook
+func runManifestGenerationWebhooks(ctx context.Context, q *apiclient.ManifestRequest, revision string, manifests []string) ([]string, error) {
+	for _, webhook := range q.ManifestGenerationWebhooks {
+		res, err := callManifestGenerationWebhook(ctx, webhook, q, revision, manifests)
+		if err != nil {
+			if webhook.IsFailureIgnored() && !errors.Is(err, errManifestsRejected) {
+				log.WithField("application", q.AppName).Warnf("Ignoring the failure of the manifest generation webhook %s: %v", webhook.Name, err)
+				continue
+			}
+			return nil, fmt.Errorf("manifest generation webhook %s failed: %w", webhook.Name, err)
+		}
+		manifests = res
+	}
+	return manifests, nil
+}
+
+func callManifestGenerationWebhook(ctx context.Context, webhook *v1alpha1.ManifestGenerationWebhook, q *apiclient.ManifestRequest, revision string, manifests []string) ([]string, error) {
+	if err := webhook.Validate(); err != nil {
+		return nil, err
+	}
+	timeout, _ := webhook.GetTimeout()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	reviewReq := manifestGenerationWebhookRequest{
+		AppName:   q.AppName,
+		Namespace: q.Namespace,
+		Project:   q.ProjectName,
+		Revision:  revision,
+		Manifests: make([]json.RawMessage, 0, len(manifests)),
+	}
+	if q.Repo != nil {
+		reviewReq.RepoURL = q.Repo.Repo
+	}
+	if q.ApplicationSource != nil {
+		reviewReq.Path = q.ApplicationSource.Path
+	}
+	for _, manifest := range manifests {
+		reviewReq.Manifests = append(reviewReq.Manifests, json.RawMessage(manifest))
+	}
+	body, err := json.Marshal(reviewReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the request: %w", err)
+	}
+
+	client, err := manifestGenerationWebhookClient(webhook)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxManifestGenerationWebhookResponseSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the response: %w", err)
+	}
+	if len(data) > maxManifestGenerationWebhookResponseSize {
+		return nil, fmt.Errorf("response exceeds the maximum size of %d bytes", maxManifestGenerationWebhookResponseSize)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	var reviewRes manifestGenerationWebhookResponse
+	if err := json.Unmarshal(data, &reviewRes); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the response: %w", err)
+	}
+	if !reviewRes.Allowed {
+		return nil, fmt.Errorf("%w: %s", errManifestsRejected, reviewRes.Message)
+	}
+	if reviewRes.Manifests == nil {
+		return manifests, nil
+	}
+	mutated := make([]string, 0, len(reviewRes.Manifests))
+	for _, manifest := range reviewRes.Manifests {
+		var obj map[string]any
+		if err := json.Unmarshal(manifest, &obj); err != nil || obj == nil {
+			return nil, errors.New("the response holds a manifest which isn't a JSON object")
+		}
+		mutated = append(mutated, string(manifest))
+	}
+	return mutated, nil
+}
+
+// manifestGenerationWebhookClient returns the client calling the webhook, trusting the certificate authorities of the
+// webhook in addition to the system ones
+func manifestGenerationWebhookClient(webhook *v1alpha1.ManifestGenerationWebhook) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if webhook.CAData != "" {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM([]byte(webhook.CAData)) {
+			return nil, errors.New("caData holds no valid PEM encoded certificate")
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: rootCAs, MinVersion: tls.VersionTLS12}
+	}
+	return &http.Client{
+		Transport: transport,
+		// the manifests must not be posted to another endpoint than the configured one
+		CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}, nil
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+)
+
+func newManifestGenerationWebhookServer(t *testing.T, handler func(req manifestGenerationWebhookRequest) (int, manifestGenerationWebhookResponse)) (*httptest.Server, string) {
+	t.Helper()
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req manifestGenerationWebhookRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		status, res := handler(req)
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(res)
+	}))
+	t.Cleanup(server.Close)
+	caData := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+	return server, caData
+}
+
+func TestRunManifestGenerationWebhooks(t *testing.T) {
+	manifests := []string{`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"my-config"}}`}
+	newRequest := func(webhooks ...*v1alpha1.ManifestGenerationWebhook) *apiclient.ManifestRequest {
+		return &apiclient.ManifestRequest{
+			AppName:                    "my-app",
+			ProjectName:                "my-project",
+			Repo:                       &v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps"},
+			ApplicationSource:          &v1alpha1.ApplicationSource{Path: "guestbook"},
+			ManifestGenerationWebhooks: webhooks,
+		}
+	}
+
+	t.Run("Mutate", func(t *testing.T) {
+		server, caData := newManifestGenerationWebhookServer(t, func(req manifestGenerationWebhookRequest) (int, manifestGenerationWebhookResponse) {
+			assert.Equal(t, "my-app", req.AppName)
+			assert.Equal(t, "my-project", req.Project)
+			assert.Equal(t, "guestbook", req.Path)
+			assert.Equal(t, "abc123", req.Revision)
+			require.Len(t, req.Manifests, 1)
+			return http.StatusOK, manifestGenerationWebhookResponse{Allowed: true, Manifests: []json.RawMessage{
+				req.Manifests[0],
+				json.RawMessage(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"injected"}}`),
+			}}
+		})
+		res, err := runManifestGenerationWebhooks(t.Context(), newRequest(&v1alpha1.ManifestGenerationWebhook{Name: "inject", URL: server.URL, CAData: caData}), "abc123", manifests)
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		assert.JSONEq(t, manifests[0], res[0])
+		assert.Contains(t, res[1], "injected")
+	})
+
+	t.Run("AllowWithoutMutation", func(t *testing.T) {
+		server, caData := newManifestGenerationWebhookServer(t, func(_ manifestGenerationWebhookRequest) (int, manifestGenerationWebhookResponse) {
+			return http.StatusOK, manifestGenerationWebhookResponse{Allowed: true}
+		})
+		res, err := runManifestGenerationWebhooks(t.Context(), newRequest(&v1alpha1.ManifestGenerationWebhook{Name: "audit", URL: server.URL, CAData: caData}), "abc123", manifests)
+		require.NoError(t, err)
+		assert.Equal(t, manifests, res)
+	})
+
+	t.Run("Reject", func(t *testing.T) {
+		server, caData := newManifestGenerationWebhookServer(t, func(_ manifestGenerationWebhookRequest) (int, manifestGenerationWebhookResponse) {
+			return http.StatusOK, manifestGenerationWebhookResponse{Allowed: false, Message: "missing cost labels"}
+		})
+		// the rejections are not ignored
+		_, err := runManifestGenerationWebhooks(t.Context(), newRequest(&v1alpha1.ManifestGenerationWebhook{Name: "policy", URL: server.URL, CAData: caData, FailurePolicy: v1alpha1.ManifestGenerationWebhookFailurePolicyIgnore}), "abc123", manifests)
+		require.ErrorContains(t, err, "manifest generation webhook policy failed: manifests rejected: missing cost labels")
+	})
+
+	t.Run("FailurePolicy", func(t *testing.T) {
+		server, caData := newManifestGenerationWebhookServer(t, func(_ manifestGenerationWebhookRequest) (int, manifestGenerationWebhookResponse) {
+			return http.StatusInternalServerError, manifestGenerationWebhookResponse{}
+		})
+		_, err := runManifestGenerationWebhooks(t.Context(), newRequest(&v1alpha1.ManifestGenerationWebhook{Name: "broken", URL: server.URL, CAData: caData}), "abc123", manifests)
+		require.ErrorContains(t, err, "manifest generation webhook broken failed: unexpected status 500")
+
+		res, err := runManifestGenerationWebhooks(t.Context(), newRequest(&v1alpha1.ManifestGenerationWebhook{Name: "broken", URL: server.URL, CAData: caData, FailurePolicy: v1alpha1.ManifestGenerationWebhookFailurePolicyIgnore}), "abc123", manifests)
+		require.NoError(t, err)
+		assert.Equal(t, manifests, res)
+	})
+
+	t.Run("UntrustedCertificate", func(t *testing.T) {
+		server, _ := newManifestGenerationWebhookServer(t, func(_ manifestGenerationWebhookRequest) (int, manifestGenerationWebhookResponse) {
+			return http.StatusOK, manifestGenerationWebhookResponse{Allowed: true}
+		})
+		_, err := runManifestGenerationWebhooks(t.Context(), newRequest(&v1alpha1.ManifestGenerationWebhook{Name: "untrusted", URL: server.URL}), "abc123", manifests)
+		require.ErrorContains(t, err, "certificate")
+	})
+}
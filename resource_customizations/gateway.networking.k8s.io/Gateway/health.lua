@@ -0,0 +1,57 @@
+local hs = {}
+
+local function getCondition(conditions, conditionType)
+  if conditions ~= nil then
+    for _, condition in ipairs(conditions) do
+      if condition.type == conditionType then
+        return condition
+      end
+    end
+  end
+  return nil
+end
+
+local function isObserved(condition)
+  return condition.observedGeneration == nil or obj.metadata.generation == nil or condition.observedGeneration >= obj.metadata.generation
+end
+
+if obj.status ~= nil and obj.status.conditions ~= nil then
+  local accepted = getCondition(obj.status.conditions, "Accepted")
+  local programmed = getCondition(obj.status.conditions, "Programmed")
+
+  if accepted ~= nil and isObserved(accepted) and accepted.status == "False" then
+    hs.status = "Degraded"
+    hs.message = accepted.message
+    return hs
+  end
+
+  if obj.status.listeners ~= nil then
+    for _, listener in ipairs(obj.status.listeners) do
+      for _, conditionType in ipairs({"Accepted", "ResolvedRefs", "Programmed"}) do
+        local condition = getCondition(listener.conditions, conditionType)
+        if condition ~= nil and isObserved(condition) and condition.status == "False" and condition.reason ~= "Pending" then
+          hs.status = "Degraded"
+          hs.message = "Listener " .. listener.name .. ": " .. condition.message
+          return hs
+        end
+      end
+    end
+  end
+
+  if programmed ~= nil and isObserved(programmed) then
+    if programmed.status == "True" then
+      hs.status = "Healthy"
+      hs.message = programmed.message
+      return hs
+    end
+    if programmed.status == "False" and programmed.reason ~= "Pending" then
+      hs.status = "Degraded"
+      hs.message = programmed.message
+      return hs
+    end
+  end
+end
+
+hs.status = "Progressing"
+hs.message = "Waiting for the gateway to be programmed"
+return hs
@@ -0,0 +1,58 @@
+local hs = {}
+
+local function getCondition(conditions, conditionType)
+  if conditions ~= nil then
+    for _, condition in ipairs(conditions) do
+      if condition.type == conditionType then
+        return condition
+      end
+    end
+  end
+  return nil
+end
+
+local function isObserved(condition)
+  return condition.observedGeneration == nil or obj.metadata.generation == nil or condition.observedGeneration >= obj.metadata.generation
+end
+
+if obj.status == nil or obj.status.parents == nil or #obj.status.parents == 0 then
+  hs.status = "Progressing"
+  hs.message = "Waiting for the route to be accepted by its parents"
+  return hs
+end
+
+local pending = false
+for _, parent in ipairs(obj.status.parents) do
+  local parentName = parent.parentRef.name
+  if parent.parentRef.sectionName ~= nil then
+    parentName = parentName .. "/" .. parent.parentRef.sectionName
+  end
+  local accepted = getCondition(parent.conditions, "Accepted")
+  local resolvedRefs = getCondition(parent.conditions, "ResolvedRefs")
+  if accepted == nil or not isObserved(accepted) or accepted.status == "Unknown" then
+    pending = true
+  elseif accepted.status == "False" then
+    if accepted.reason == "Pending" then
+      pending = true
+    else
+      hs.status = "Degraded"
+      hs.message = "Parent " .. parentName .. ": " .. accepted.message
+      return hs
+    end
+  end
+  if resolvedRefs ~= nil and isObserved(resolvedRefs) and resolvedRefs.status == "False" then
+    hs.status = "Degraded"
+    hs.message = "Parent " .. parentName .. ": " .. resolvedRefs.message
+    return hs
+  end
+end
+
+if pending then
+  hs.status = "Progressing"
+  hs.message = "Waiting for the route to be accepted by its parents"
+  return hs
+end
+
+hs.status = "Healthy"
+hs.message = "Route accepted by its parents"
+return hs
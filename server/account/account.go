@@ -12,10 +12,14 @@ import (
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/kubectl/pkg/util/slice"
 
+	timeutil "github.com/argoproj/pkg/v2/time"
+
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/account"
+	applisters "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/v3/util/password"
 	"github.com/argoproj/argo-cd/v3/util/rbac"
@@ -23,16 +27,20 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/settings"
 )
 
+// defaultExpiringTokensWithin is the duration the tokens listed as expiring expire within by default
+const defaultExpiringTokensWithin = 7 * 24 * time.Hour
+
 // Server provides a Session service
 type Server struct {
 	sessionMgr  *session.SessionManager
 	settingsMgr *settings.SettingsManager
 	enf         *rbac.Enforcer
+	projLister  applisters.AppProjectNamespaceLister
 }
 
 // NewServer returns a new instance of the Session service
-func NewServer(sessionMgr *session.SessionManager, settingsMgr *settings.SettingsManager, enf *rbac.Enforcer) *Server {
-	return &Server{sessionMgr, settingsMgr, enf}
+func NewServer(sessionMgr *session.SessionManager, settingsMgr *settings.SettingsManager, enf *rbac.Enforcer, projLister applisters.AppProjectNamespaceLister) *Server {
+	return &Server{sessionMgr, settingsMgr, enf, projLister}
 }
 
 // UpdatePassword updates the password of the currently authenticated account or the account specified in the request.
@@ -211,8 +219,17 @@ func (s *Server) CreateToken(ctx context.Context, r *account.CreateTokenRequest)
 		id = uniqueId.String()
 	}
 
+	argoSettings, err := s.settingsMgr.GetSettings()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get settings: %w", err)
+	}
+	expiresIn, err := settings.GetTokenExpiresIn(r.ExpiresIn, argoSettings.AccountTokenMaxTTL)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	var tokenString string
-	err := s.settingsMgr.UpdateAccount(r.Name, func(account *settings.Account) error {
+	err = s.settingsMgr.UpdateAccount(r.Name, func(account *settings.Account) error {
 		if account.TokenIndex(id) > -1 {
 			return fmt.Errorf("account already has token with id '%s'", id)
 		}
@@ -222,14 +239,14 @@ func (s *Server) CreateToken(ctx context.Context, r *account.CreateTokenRequest)
 
 		now := time.Now()
 		var err error
-		tokenString, err = s.sessionMgr.Create(fmt.Sprintf("%s:%s", r.Name, settings.AccountCapabilityApiKey), r.ExpiresIn, id)
+		tokenString, err = s.sessionMgr.Create(fmt.Sprintf("%s:%s", r.Name, settings.AccountCapabilityApiKey), expiresIn, id)
 		if err != nil {
 			return err
 		}
 
 		var expiresAt int64
-		if r.ExpiresIn > 0 {
-			expiresAt = now.Add(time.Duration(r.ExpiresIn) * time.Second).Unix()
+		if expiresIn > 0 {
+			expiresAt = now.Add(time.Duration(expiresIn) * time.Second).Unix()
 		}
 		account.Tokens = append(account.Tokens, settings.Token{
 			ID:        id,
@@ -262,3 +279,56 @@ func (s *Server) DeleteToken(ctx context.Context, r *account.DeleteTokenRequest)
 	}
 	return &account.EmptyResponse{}, nil
 }
+
+// ListExpiringTokens returns the tokens of the local accounts and of the project roles expiring soon
+func (s *Server) ListExpiringTokens(ctx context.Context, r *account.ListExpiringTokensRequest) (*account.ExpiringTokensList, error) {
+	within := defaultExpiringTokensWithin
+	if r.Within != "" {
+		duration, err := timeutil.ParseDuration(r.Within)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid duration '%s': %v", r.Within, err)
+		}
+		within = *duration
+	}
+	deadline := time.Now().Add(within).Unix()
+	isExpiring := func(expiresAt int64) bool {
+		return expiresAt > 0 && expiresAt <= deadline
+	}
+
+	resp := account.ExpiringTokensList{}
+	accounts, err := s.settingsMgr.GetAccounts()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounts: %w", err)
+	}
+	for name, a := range accounts {
+		if err := s.ensureHasAccountPermission(ctx, rbac.ActionGet, name); err != nil {
+			continue
+		}
+		for _, t := range a.Tokens {
+			if isExpiring(t.ExpiresAt) {
+				resp.Items = append(resp.Items, &account.ExpiringToken{Kind: "account", Name: name, Id: t.ID, IssuedAt: t.IssuedAt, ExpiresAt: t.ExpiresAt})
+			}
+		}
+	}
+
+	projects, err := s.projLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+	for _, proj := range projects {
+		if !s.enf.Enforce(ctx.Value("claims"), rbac.ResourceProjects, rbac.ActionGet, proj.Name) {
+			continue
+		}
+		for _, role := range proj.Spec.Roles {
+			for _, t := range role.JWTTokens {
+				if isExpiring(t.ExpiresAt) {
+					resp.Items = append(resp.Items, &account.ExpiringToken{Kind: "project", Name: proj.Name, Role: role.Name, Id: t.ID, IssuedAt: t.IssuedAt, ExpiresAt: t.ExpiresAt})
+				}
+			}
+		}
+	}
+	sort.Slice(resp.Items, func(i, j int) bool {
+		return resp.Items[i].ExpiresAt < resp.Items[j].ExpiresAt
+	})
+	return &resp, nil
+}
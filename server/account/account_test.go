@@ -70,7 +70,7 @@ func newTestAccountServerExt(t *testing.T, ctx context.Context, enforceFn rbac.C
 	enforcer := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
 	enforcer.SetClaimsEnforcerFunc(enforceFn)
 
-	return NewServer(sessionMgr, settingsMgr, enforcer), session.NewServer(sessionMgr, settingsMgr, nil, nil, nil)
+	return NewServer(sessionMgr, settingsMgr, enforcer, test.NewFakeProjLister()), session.NewServer(sessionMgr, settingsMgr, nil, nil, nil)
 }
 
 func getAdminAccount(mgr *settings.SettingsManager) (*settings.Account, error) {
@@ -269,6 +269,25 @@ func TestCreateToken_SuccessfullyCreated(t *testing.T) {
 	assert.Len(t, acc.Tokens, 1)
 }
 
+func TestCreateToken_MaxTTL(t *testing.T) {
+	ctx := adminContext(t.Context())
+	accountServer, _ := newTestAccountServer(t, ctx, func(cm *corev1.ConfigMap, _ *corev1.Secret) {
+		cm.Data["accounts.account1"] = "apiKey"
+		cm.Data["users.tokens.maxTTL"] = "24h"
+	})
+
+	_, err := accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account1", ExpiresIn: int64((48 * time.Hour).Seconds())})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account1"})
+	require.NoError(t, err)
+
+	acc, err := accountServer.GetAccount(ctx, &account.GetAccountRequest{Name: "account1"})
+	require.NoError(t, err)
+	require.Len(t, acc.Tokens, 1)
+	assert.Equal(t, int64((24 * time.Hour).Seconds()), acc.Tokens[0].ExpiresAt-acc.Tokens[0].IssuedAt)
+}
+
 func TestCreateToken_DoesNotHaveCapability(t *testing.T) {
 	ctx := adminContext(t.Context())
 	accountServer, _ := newTestAccountServer(t, ctx, func(cm *corev1.ConfigMap, _ *corev1.Secret) {
@@ -309,6 +328,34 @@ func TestDeleteToken_SuccessfullyRemoved(t *testing.T) {
 	assert.Empty(t, acc.Tokens)
 }
 
+func TestListExpiringTokens(t *testing.T) {
+	ctx := adminContext(t.Context())
+	accountServer, _ := newTestAccountServer(t, ctx, func(cm *corev1.ConfigMap, _ *corev1.Secret) {
+		cm.Data["accounts.account1"] = "apiKey"
+	})
+
+	_, err := accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account1", Id: "expiring", ExpiresIn: int64(time.Hour.Seconds())})
+	require.NoError(t, err)
+	_, err = accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account1", Id: "long-lived", ExpiresIn: int64((30 * 24 * time.Hour).Seconds())})
+	require.NoError(t, err)
+	_, err = accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account1", Id: "never-expiring"})
+	require.NoError(t, err)
+
+	tokens, err := accountServer.ListExpiringTokens(ctx, &account.ListExpiringTokensRequest{})
+	require.NoError(t, err)
+	require.Len(t, tokens.Items, 1)
+	assert.Equal(t, "account", tokens.Items[0].Kind)
+	assert.Equal(t, "account1", tokens.Items[0].Name)
+	assert.Equal(t, "expiring", tokens.Items[0].Id)
+
+	tokens, err = accountServer.ListExpiringTokens(ctx, &account.ListExpiringTokensRequest{Within: "60d"})
+	require.NoError(t, err)
+	assert.Len(t, tokens.Items, 2)
+
+	_, err = accountServer.ListExpiringTokens(ctx, &account.ListExpiringTokensRequest{Within: "invalid"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 func TestCanI_GetLogsAllow(t *testing.T) {
 	accountServer, _ := newTestAccountServer(t, t.Context(), func(_ *corev1.ConfigMap, _ *corev1.Secret) {
 	})
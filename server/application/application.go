@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -146,6 +147,16 @@ func NewServer(
 	return s, s.getAppResources
 }
 
+// rbacObject returns the RBAC object of the application, carrying the labels of the application and of its project
+// matched by the label rules of the policies
+func (s *Server) rbacObject(a *v1alpha1.Application) rbac.Object {
+	obj := rbac.Object{Name: a.RBACName(s.ns), Labels: a.Labels}
+	if proj, err := applisters.NewAppProjectLister(s.projInformer.GetIndexer()).AppProjects(s.ns).Get(a.Spec.GetProject()); err == nil {
+		obj.ProjectLabels = proj.Labels
+	}
+	return obj
+}
+
 // getAppEnforceRBAC gets the Application with the given name in the given namespace. If no namespace is
 // specified, the Application is fetched from the default namespace (the one in which the API server is running).
 //
@@ -166,22 +177,28 @@ func (s *Server) getAppEnforceRBAC(ctx context.Context, action, project, namespa
 		"application": name,
 		"namespace":   namespace,
 	})
+	var a *v1alpha1.Application
+	var err error
 	if project != "" {
 		// The user has provided everything we need to perform an initial RBAC check.
 		givenRBACName := security.RBACName(s.ns, project, namespace, name)
-		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, action, givenRBACName); err != nil {
-			logCtx.WithFields(map[string]any{
-				"project":                project,
-				argocommon.SecurityField: argocommon.SecurityMedium,
-			}).Warnf("user tried to %s application which they do not have access to: %s", action, err)
+		if enfErr := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, action, givenRBACName); enfErr != nil {
 			// Do a GET on the app. This ensures that the timing of a "no access" response is the same as a "yes access,
 			// but the app is in a different project" response. We don't want the user inferring the existence of the
-			// app from response time.
-			_, _ = getApp()
-			return nil, nil, argocommon.PermissionDeniedAPIError
+			// app from response time. The label rules of the policies can only be matched against the fetched app.
+			a, err = getApp()
+			if err != nil || !s.enf.Enforce(ctx.Value("claims"), rbac.ResourceApplications, action, s.rbacObject(a)) {
+				logCtx.WithFields(map[string]any{
+					"project":                project,
+					argocommon.SecurityField: argocommon.SecurityMedium,
+				}).Warnf("user tried to %s application which they do not have access to: %s", action, enfErr)
+				return nil, nil, argocommon.PermissionDeniedAPIError
+			}
 		}
 	}
-	a, err := getApp()
+	if a == nil {
+		a, err = getApp()
+	}
 	if err != nil {
 		if apierrors.IsNotFound(err) {
 			if project != "" {
@@ -199,7 +216,7 @@ func (s *Server) getAppEnforceRBAC(ctx context.Context, action, project, namespa
 	// Even if we performed an initial RBAC check (because the request was fully parameterized), we still need to
 	// perform a second RBAC check to ensure that the user has access to the actual Application's project (not just the
 	// project they specified in the request).
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, action, a.RBACName(s.ns)); err != nil {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, action, s.rbacObject(a)); err != nil {
 		logCtx.WithFields(map[string]any{
 			"project":                a.Spec.Project,
 			argocommon.SecurityField: argocommon.SecurityMedium,
@@ -299,7 +316,7 @@ func (s *Server) List(ctx context.Context, q *application.ApplicationQuery) (*v1
 		if !s.isNamespaceEnabled(a.Namespace) {
 			continue
 		}
-		if s.enf.Enforce(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionGet, a.RBACName(s.ns)) {
+		if s.enf.Enforce(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionGet, s.rbacObject(a)) {
 			newItems = append(newItems, *a)
 		}
 	}
@@ -325,6 +342,7 @@ func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateReq
 	}
 	a := q.GetApplication()
 
+	// the labels of the request are chosen by the caller, the label rules only match the labels of existing applications
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionCreate, a.RBACName(s.ns)); err != nil {
 		return nil, err
 	}
@@ -362,6 +380,15 @@ func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateReq
 		a.Operation = nil
 	}
 
+	// Don't let the app creator approve its automated sync. Approvals should always go through the ApproveSync API.
+	if _, ok := a.Annotations[v1alpha1.AnnotationKeySyncApprovedRevision]; ok {
+		log.WithFields(log.Fields{
+			"application":            a.Name,
+			argocommon.SecurityField: argocommon.SecurityLow,
+		}).Warn("User attempted to set the sync approved revision on application creation. This could have allowed them to approve their own diff. Ignoring the set approval.")
+		delete(a.Annotations, v1alpha1.AnnotationKeySyncApprovedRevision)
+	}
+
 	created, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Create(ctx, a, metav1.CreateOptions{})
 	if err == nil {
 		s.logAppEvent(ctx, created, argo.EventReasonResourceCreated, "created application")
@@ -394,7 +421,7 @@ func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateReq
 	if q.Upsert == nil || !*q.Upsert {
 		return nil, status.Errorf(codes.InvalidArgument, "existing application spec is different, use upsert flag to force update")
 	}
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionUpdate, a.RBACName(s.ns)); err != nil {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionUpdate, s.rbacObject(existing)); err != nil {
 		return nil, err
 	}
 	updated, err := s.updateApp(ctx, existing, a, true)
@@ -460,6 +487,10 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 		return nil, security.NamespaceNotPermittedError(a.Namespace)
 	}
 
+	if q.GetOffset() < 0 || q.GetLimit() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "offset and limit must not be negative")
+	}
+
 	manifestInfos := make([]*apiclient.ManifestResponse, 0)
 	err = s.queryRepoServer(ctx, proj, func(
 		client apiclient.RepoServerServiceClient, helmRepos []*v1alpha1.Repository, helmCreds []*v1alpha1.RepoCreds, helmOptions *v1alpha1.HelmOptions, enableGenerateManifests map[string]bool,
@@ -529,6 +560,8 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 				return fmt.Errorf("error getting installation ID: %w", err)
 			}
 
+			// The Helm lookups are only run by the application controller, which holds the credentials of the destination
+			// cluster, so the manifests are rendered here without them
 			manifestInfo, err := client.GenerateManifest(ctx, &apiclient.ManifestRequest{
 				Repo:                            repo,
 				Revision:                        source.TargetRevision,
@@ -550,6 +583,7 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 				RefSources:                      refSources,
 				AnnotationManifestGeneratePaths: a.GetAnnotation(v1alpha1.AnnotationKeyManifestGeneratePaths),
 				InstallationID:                  installationID,
+				ManifestGenerationWebhooks:      proj.GetManifestGenerationWebhooks(),
 			})
 			if err != nil {
 				return fmt.Errorf("error generating manifests: %w", err)
@@ -562,32 +596,68 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 		return nil, err
 	}
 
+	// the hooks of the hook library are run by the syncs of the whole application
+	if len(q.SourcePositions) == 0 && len(manifestInfos) > 0 {
+		libraryHooks, err := argo.GetLibraryHooks(a, s.settingsMgr, argo.NewResourceTracking(), s.ns, manifestInfos[0].Revision)
+		if err != nil {
+			return nil, fmt.Errorf("error getting hooks of the hook library: %w", err)
+		}
+		hooksInfo := &apiclient.ManifestResponse{}
+		for _, obj := range libraryHooks {
+			data, err := json.Marshal(obj)
+			if err != nil {
+				return nil, fmt.Errorf("error marshaling hook: %w", err)
+			}
+			hooksInfo.Manifests = append(hooksInfo.Manifests, string(data))
+		}
+		manifestInfos = append(manifestInfos, hooksInfo)
+	}
+
+	filter := argo.ManifestFilter{Group: q.GetGroup(), Kind: q.GetKind(), Namespace: q.GetNamespace(), Name: q.GetResourceName()}
 	manifests := &apiclient.ManifestResponse{}
 	for _, manifestInfo := range manifestInfos {
-		for i, manifest := range manifestInfo.Manifests {
+		for _, manifest := range manifestInfo.Manifests {
 			obj := &unstructured.Unstructured{}
 			err = json.Unmarshal([]byte(manifest), obj)
 			if err != nil {
 				return nil, fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
 			}
+			if !filter.Matches(obj) {
+				continue
+			}
+			modified := len(q.Fields) > 0
 			if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
 				obj, _, err = diff.HideSecretData(obj, nil, s.settingsMgr.GetSensitiveAnnotations())
 				if err != nil {
 					return nil, fmt.Errorf("error hiding secret data: %w", err)
 				}
-				data, err := json.Marshal(obj)
+				modified = true
+			}
+			if modified {
+				data, err := json.Marshal(argo.ProjectManifestFields(obj, q.Fields))
 				if err != nil {
 					return nil, fmt.Errorf("error marshaling manifest: %w", err)
 				}
-				manifestInfo.Manifests[i] = string(data)
+				manifest = string(data)
 			}
+			manifests.Manifests = append(manifests.Manifests, manifest)
 		}
-		manifests.Manifests = append(manifests.Manifests, manifestInfo.Manifests...)
 	}
+	manifests.Manifests = paginateManifests(manifests.Manifests, q.GetOffset(), q.GetLimit())
 
 	return manifests, nil
 }
 
+// paginateManifests returns the page of the manifests starting at the offset, with at most limit manifests if limit is
+// positive
+func paginateManifests(manifests []string, offset, limit int64) []string {
+	manifests = manifests[min(offset, int64(len(manifests))):]
+	if limit > 0 && limit < int64(len(manifests)) {
+		manifests = manifests[:limit]
+	}
+	return manifests
+}
+
 func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_GetManifestsWithFilesServer) error {
 	ctx := stream.Context()
 	query, err := manifeststream.ReceiveApplicationManifestQueryWithFiles(stream)
@@ -667,6 +737,7 @@ func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_Get
 			ProjectName:                     proj.Name,
 			ProjectSourceRepos:              proj.Spec.SourceRepos,
 			AnnotationManifestGeneratePaths: a.GetAnnotation(v1alpha1.AnnotationKeyManifestGeneratePaths),
+			ManifestGenerationWebhooks:      proj.GetManifestGenerationWebhooks(),
 		}
 
 		repoStreamClient, err := client.GenerateManifestWithFiles(stream.Context())
@@ -745,6 +816,11 @@ func (s *Server) Get(ctx context.Context, q *application.ApplicationQuery) (*v1a
 	if *q.Refresh == string(v1alpha1.RefreshTypeHard) {
 		refreshType = v1alpha1.RefreshTypeHard
 	}
+	for _, resource := range q.GetRefreshResources() {
+		if _, err := v1alpha1.ParseResourceRef(resource); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
 	appIf := s.appclientset.ArgoprojV1alpha1().Applications(appNs)
 
 	// subscribe early with buffered channel to ensure we don't miss events
@@ -754,12 +830,13 @@ func (s *Server) Get(ctx context.Context, q *application.ApplicationQuery) (*v1a
 	})
 	defer unsubscribe()
 
-	app, err := argo.RefreshApp(appIf, appName, refreshType, true)
+	app, err := argo.RefreshAppResources(appIf, appName, refreshType, true, q.GetRefreshResources())
 	if err != nil {
 		return nil, fmt.Errorf("error refreshing the app: %w", err)
 	}
 
-	if refreshType == v1alpha1.RefreshTypeHard {
+	// a hard refresh scoped to resources does not invalidate the cached application details
+	if refreshType == v1alpha1.RefreshTypeHard && len(q.GetRefreshResources()) == 0 {
 		// force refresh cached application details
 		if err := s.queryRepoServer(ctx, proj, func(
 			client apiclient.RepoServerServiceClient,
@@ -943,6 +1020,7 @@ func (s *Server) waitSync(app *v1alpha1.Application) {
 
 func (s *Server) updateApp(ctx context.Context, app *v1alpha1.Application, newApp *v1alpha1.Application, merge bool) (*v1alpha1.Application, error) {
 	for i := 0; i < 10; i++ {
+		approvedRevision, approved := app.Annotations[v1alpha1.AnnotationKeySyncApprovedRevision]
 		app.Spec = newApp.Spec
 		if merge {
 			app.Labels = collections.Merge(app.Labels, newApp.Labels)
@@ -951,6 +1029,7 @@ func (s *Server) updateApp(ctx context.Context, app *v1alpha1.Application, newAp
 			app.Labels = newApp.Labels
 			app.Annotations = newApp.Annotations
 		}
+		keepSyncApprovedRevision(app, approvedRevision, approved)
 
 		app.Finalizers = newApp.Finalizers
 
@@ -973,13 +1052,27 @@ func (s *Server) updateApp(ctx context.Context, app *v1alpha1.Application, newAp
 	return nil, status.Errorf(codes.Internal, "Failed to update application. Too many conflicts")
 }
 
+// keepSyncApprovedRevision restores the sync approved revision annotation of an updated application, so that the users
+// allowed to update the application cannot approve their own diff: it is only set by the ApproveSync API, which requires
+// the approve action
+func keepSyncApprovedRevision(app *v1alpha1.Application, approvedRevision string, approved bool) {
+	if !approved {
+		delete(app.Annotations, v1alpha1.AnnotationKeySyncApprovedRevision)
+		return
+	}
+	if app.Annotations == nil {
+		app.Annotations = map[string]string{}
+	}
+	app.Annotations[v1alpha1.AnnotationKeySyncApprovedRevision] = approvedRevision
+}
+
 // Update updates an application
 func (s *Server) Update(ctx context.Context, q *application.ApplicationUpdateRequest) (*v1alpha1.Application, error) {
 	if q.GetApplication() == nil {
 		return nil, errors.New("error updating application: application is nil in request")
 	}
 	a := q.GetApplication()
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionUpdate, a.RBACName(s.ns)); err != nil {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionUpdate, s.rbacObject(a)); err != nil {
 		return nil, err
 	}
 
@@ -1019,7 +1112,7 @@ func (s *Server) Patch(ctx context.Context, q *application.ApplicationPatchReque
 		return nil, err
 	}
 
-	if err = s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionUpdate, app.RBACName(s.ns)); err != nil {
+	if err = s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionUpdate, s.rbacObject(app)); err != nil {
 		return nil, err
 	}
 
@@ -1094,7 +1187,7 @@ func (s *Server) Delete(ctx context.Context, q *application.ApplicationDeleteReq
 	s.projectLock.RLock(a.Spec.Project)
 	defer s.projectLock.RUnlock(a.Spec.Project)
 
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionDelete, a.RBACName(s.ns)); err != nil {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionDelete, s.rbacObject(a)); err != nil {
 		return nil, err
 	}
 
@@ -1161,7 +1254,7 @@ func (s *Server) isApplicationPermitted(selector labels.Selector, minVersion int
 		return false
 	}
 
-	if !s.enf.Enforce(claims, rbac.ResourceApplications, rbac.ActionGet, a.RBACName(s.ns)) {
+	if !s.enf.Enforce(claims, rbac.ResourceApplications, rbac.ActionGet, s.rbacObject(&a)) {
 		// do not emit apps user does not have accessing
 		return false
 	}
@@ -1268,11 +1361,12 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *v1alpha1.Appl
 	if currApp != nil && currApp.Spec.GetProject() != app.Spec.GetProject() {
 		// When changing projects, caller must have application create & update privileges in new project
 		// NOTE: the update check was already verified in the caller to this function
+		// The label rules don't match the labels of the request, which are chosen by the caller
 		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionCreate, app.RBACName(s.ns)); err != nil {
 			return err
 		}
 		// They also need 'update' privileges in the old project
-		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionUpdate, currApp.RBACName(s.ns)); err != nil {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionUpdate, s.rbacObject(currApp)); err != nil {
 			return err
 		}
 	}
@@ -1303,6 +1397,22 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *v1alpha1.Appl
 		return status.Errorf(codes.InvalidArgument, "application spec for %s is invalid: %s", app.Name, argo.FormatAppConditions(conditions))
 	}
 
+	if err := proj.ValidateSyncPolicy(app.Spec.SyncPolicy); err != nil {
+		return status.Errorf(codes.InvalidArgument, "application spec for %s is invalid: %s", app.Name, err.Error())
+	}
+
+	if proj.Spec.Quota != nil {
+		apps, err := s.appLister.List(labels.Everything())
+		if err != nil {
+			return fmt.Errorf("error listing applications: %w", err)
+		}
+		quotaApp := *app
+		quotaApp.Namespace = appNs
+		if err := proj.CheckQuota(apps, &quotaApp); err != nil {
+			return status.Errorf(codes.FailedPrecondition, "application %s exceeds the project quota: %s", app.Name, err.Error())
+		}
+	}
+
 	app.Spec = *argo.NormalizeApplicationSpec(&app.Spec)
 	return nil
 }
@@ -1509,6 +1619,41 @@ func (s *Server) DeleteResource(ctx context.Context, q *application.ApplicationR
 	return &application.ApplicationResponse{}, nil
 }
 
+// RemoveResourceFinalizers removes the finalizers of a resource pending deletion
+func (s *Server) RemoveResourceFinalizers(ctx context.Context, q *application.ApplicationResourceRequest) (*application.ApplicationResponse, error) {
+	res, config, a, err := s.getAppLiveResource(ctx, rbac.ActionRemoveFinalizers, q)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := s.kubectl.GetResource(ctx, config, res.GroupKindVersion(), res.Name, res.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("error getting resource: %w", err)
+	}
+	if obj.GetDeletionTimestamp() == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s %s is not pending deletion", res.Kind, res.Name)
+	}
+	finalizers := obj.GetFinalizers()
+	if len(finalizers) == 0 {
+		return &application.ApplicationResponse{}, nil
+	}
+	// the resource version fails the patch if the resource was updated in the meantime
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"finalizers":      nil,
+			"resourceVersion": obj.GetResourceVersion(),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling finalizers patch: %w", err)
+	}
+	_, err = s.kubectl.PatchResource(ctx, config, res.GroupKindVersion(), res.Name, res.Namespace, types.MergePatchType, patch)
+	if err != nil {
+		return nil, fmt.Errorf("error removing the finalizers of the resource: %w", err)
+	}
+	s.logAppEvent(ctx, a, argo.EventReasonResourceUpdated, fmt.Sprintf("removed the finalizers %s of resource %s/%s '%s'", strings.Join(finalizers, ", "), q.GetGroup(), q.GetKind(), q.GetResourceName()))
+	return &application.ApplicationResponse{}, nil
+}
+
 func (s *Server) ResourceTree(ctx context.Context, q *application.ResourcesQuery) (*v1alpha1.ApplicationTree, error) {
 	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetApplicationName())
 	if err != nil {
@@ -1558,7 +1703,7 @@ func (s *Server) RevisionMetadata(ctx context.Context, q *application.RevisionMe
 	return repoClient.GetRevisionMetadata(ctx, &apiclient.RepoServerRevisionMetadataRequest{
 		Repo:           repo,
 		Revision:       q.GetRevision(),
-		CheckSignature: len(proj.Spec.SignatureKeys) > 0,
+		CheckSignature: proj.IsSignatureRequired(source.Path),
 	})
 }
 
@@ -1733,7 +1878,7 @@ func (s *Server) PodLogs(q *application.ApplicationPodLogsQuery, ws application.
 		return err
 	}
 
-	if err := s.enf.EnforceErr(ws.Context().Value("claims"), rbac.ResourceLogs, rbac.ActionGet, a.RBACName(s.ns)); err != nil {
+	if err := s.enf.EnforceErr(ws.Context().Value("claims"), rbac.ResourceLogs, rbac.ActionGet, s.rbacObject(a)); err != nil {
 		return err
 	}
 
@@ -1925,7 +2070,7 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 
 	s.inferResourcesStatusHealth(a)
 
-	canSync, err := proj.Spec.SyncWindows.Matches(a).CanSync(true)
+	canSync, err := proj.Spec.SyncWindows.CanSyncWithCluster(a, s.getSyncWindowsCluster(ctx, a), true)
 	if err != nil {
 		return a, status.Errorf(codes.PermissionDenied, "cannot sync: invalid sync window: %v", err)
 	}
@@ -1933,12 +2078,12 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 		return a, status.Errorf(codes.PermissionDenied, "cannot sync: blocked by sync window")
 	}
 
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionSync, a.RBACName(s.ns)); err != nil {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionSync, s.rbacObject(a)); err != nil {
 		return nil, err
 	}
 
 	if syncReq.Manifests != nil {
-		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionOverride, a.RBACName(s.ns)); err != nil {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionOverride, s.rbacObject(a)); err != nil {
 			return nil, err
 		}
 		if a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.IsAutomatedSyncEnabled() && !syncReq.GetDryRun() {
@@ -1949,6 +2094,10 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 		return nil, status.Errorf(codes.FailedPrecondition, "application is deleting")
 	}
 
+	if err := checkSyncSourceRestrictions(proj, a, syncReq); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
 	revision, displayRevision, sourceRevisions, displayRevisions, err := s.resolveSourceRevisions(ctx, a, syncReq)
 	if err != nil {
 		return nil, err
@@ -1966,13 +2115,24 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	if syncReq.SyncOptions != nil {
 		syncOptions = syncReq.SyncOptions.Items
 	}
+	if proj.Spec.SyncPolicy != nil {
+		for _, option := range syncOptions {
+			if proj.Spec.SyncPolicy.IsSyncOptionDenied(option) {
+				return nil, status.Errorf(codes.FailedPrecondition, "sync option %s is denied in project '%s'", option, proj.Name)
+			}
+		}
+	}
+	syncOptions = proj.GetSyncOptions(syncOptions)
+	retry = proj.GetRetryStrategy(retry)
 
 	if syncOptions.HasOption(common.SyncOptionReplace) && !s.syncWithReplaceAllowed {
 		return nil, status.Error(codes.FailedPrecondition, "sync with replace was disabled on the API Server level via the server configuration")
 	}
 
 	// We cannot use local manifests if we're only allowed to sync to signed commits
-	if syncReq.Manifests != nil && len(proj.Spec.SignatureKeys) > 0 {
+	if syncReq.Manifests != nil && slices.ContainsFunc(a.Spec.GetSources(), func(source v1alpha1.ApplicationSource) bool {
+		return proj.IsSignatureRequired(source.Path)
+	}) {
 		return nil, status.Errorf(codes.FailedPrecondition, "Cannot use local sync when signature keys are required.")
 	}
 
@@ -1986,15 +2146,17 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	}
 	op := v1alpha1.Operation{
 		Sync: &v1alpha1.SyncOperation{
-			Revision:     revision,
-			Prune:        syncReq.GetPrune(),
-			DryRun:       syncReq.GetDryRun(),
-			SyncOptions:  syncOptions,
-			SyncStrategy: syncReq.Strategy,
-			Resources:    resources,
-			Manifests:    syncReq.Manifests,
-			Sources:      a.Spec.Sources,
-			Revisions:    sourceRevisions,
+			Revision:          revision,
+			Prune:             syncReq.GetPrune(),
+			DryRun:            syncReq.GetDryRun(),
+			SyncOptions:       syncOptions,
+			SyncStrategy:      syncReq.Strategy,
+			Resources:         resources,
+			Manifests:         syncReq.Manifests,
+			Sources:           a.Spec.Sources,
+			Revisions:         sourceRevisions,
+			Resume:            syncReq.GetResume(),
+			ForceConflictsFor: syncReq.ForceConflictsFor,
 		},
 		InitiatedBy: v1alpha1.OperationInitiator{Username: session.Username(ctx)},
 		Info:        syncReq.Infos,
@@ -2027,6 +2189,28 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	return a, nil
 }
 
+// checkSyncSourceRestrictions returns an error if a revision requested by the sync is not permitted by the source
+// restrictions of the project
+func checkSyncSourceRestrictions(proj *v1alpha1.AppProject, a *v1alpha1.Application, syncReq *application.ApplicationSyncRequest) error {
+	if a.Spec.SourceHydrator != nil {
+		return nil
+	}
+	if !a.Spec.HasMultipleSources() {
+		return proj.CheckSourceRestrictions(a.Spec.GetSource(), syncReq.GetRevision())
+	}
+	sources := a.Spec.GetSources()
+	for i, pos := range syncReq.SourcePositions {
+		// the positions out of range are rejected when resolving the revisions
+		if pos <= 0 || pos > int64(len(sources)) || i >= len(syncReq.Revisions) {
+			continue
+		}
+		if err := proj.CheckSourceRestrictions(sources[pos-1], syncReq.Revisions[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *Server) resolveSourceRevisions(ctx context.Context, a *v1alpha1.Application, syncReq *application.ApplicationSyncRequest) (string, string, []string, []string, error) {
 	if a.Spec.HasMultipleSources() {
 		numOfSources := int64(len(a.Spec.GetSources()))
@@ -2069,7 +2253,7 @@ func (s *Server) resolveSourceRevisions(ctx context.Context, a *v1alpha1.Applica
 }
 
 func (s *Server) Rollback(ctx context.Context, rollbackReq *application.ApplicationRollbackRequest) (*v1alpha1.Application, error) {
-	a, _, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionSync, rollbackReq.GetProject(), rollbackReq.GetAppNamespace(), rollbackReq.GetName(), "")
+	a, proj, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionSync, rollbackReq.GetProject(), rollbackReq.GetAppNamespace(), rollbackReq.GetName(), "")
 	if err != nil {
 		return nil, err
 	}
@@ -2083,42 +2267,68 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *application.Applicat
 		return nil, status.Errorf(codes.FailedPrecondition, "rollback cannot be initiated when auto-sync is enabled")
 	}
 
-	var deploymentInfo *v1alpha1.RevisionHistory
-	for _, info := range a.Status.History {
-		if info.ID == rollbackReq.GetId() {
-			deploymentInfo = &info
-			break
-		}
-	}
-	if deploymentInfo == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "application %s does not have deployment with id %v", a.QualifiedName(), rollbackReq.GetId())
-	}
-	if deploymentInfo.Source.IsZero() && deploymentInfo.Sources.IsZero() {
-		// Since source type was introduced to history starting with v0.12, and is now required for
-		// rollback, we cannot support rollback to revisions deployed using Argo CD v0.11 or below
-		// As multi source doesn't use app.Source, we need to check to the Sources length
-		return nil, status.Errorf(codes.FailedPrecondition, "cannot rollback to revision deployed with Argo CD v0.11 or lower. sync to revision instead.")
-	}
-
 	var syncOptions v1alpha1.SyncOptions
 	if a.Spec.SyncPolicy != nil {
 		syncOptions = a.Spec.SyncPolicy.SyncOptions
 	}
+	fromRevision := a.Status.Sync.Revision
+	if a.Spec.HasMultipleSources() {
+		fromRevision = strings.Join(a.Status.Sync.Revisions, ",")
+	}
 
 	// Rollback is just a convenience around Sync
 	op := v1alpha1.Operation{
 		Sync: &v1alpha1.SyncOperation{
-			Revision:     deploymentInfo.Revision,
-			Revisions:    deploymentInfo.Revisions,
 			DryRun:       rollbackReq.GetDryRun(),
 			Prune:        rollbackReq.GetPrune(),
 			SyncOptions:  syncOptions,
 			SyncStrategy: &v1alpha1.SyncStrategy{Apply: &v1alpha1.SyncStrategyApply{}},
-			Source:       &deploymentInfo.Source,
-			Sources:      deploymentInfo.Sources,
 		},
 		InitiatedBy: v1alpha1.OperationInitiator{Username: session.Username(ctx)},
 	}
+	var eventMessage string
+	if revision := rollbackReq.GetRevision(); revision != "" {
+		if a.Spec.HasMultipleSources() {
+			return nil, status.Errorf(codes.InvalidArgument, "rollback to a revision is not supported for applications with multiple sources, rollback to a deployment history id instead")
+		}
+		source := a.Spec.GetSource()
+		source.TargetRevision = revision
+		// unlike the revisions of the deployment history, the revision was never permitted by the project
+		if err := proj.CheckSourceRestrictions(source, revision); err != nil {
+			return nil, status.Errorf(codes.PermissionDenied, "cannot rollback to revision %s: %v", revision, err)
+		}
+		op.Sync.Revision = revision
+		op.Sync.Source = &source
+		op.Sync.Rollback = &v1alpha1.RollbackInfo{Revision: revision, FromRevision: fromRevision}
+		eventMessage = "initiated rollback to revision " + revision
+	} else {
+		var deploymentInfo *v1alpha1.RevisionHistory
+		for _, info := range a.Status.History {
+			if info.ID == rollbackReq.GetId() {
+				deploymentInfo = &info
+				break
+			}
+		}
+		if deploymentInfo == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "application %s does not have deployment with id %v", a.QualifiedName(), rollbackReq.GetId())
+		}
+		if deploymentInfo.Source.IsZero() && deploymentInfo.Sources.IsZero() {
+			// Since source type was introduced to history starting with v0.12, and is now required for
+			// rollback, we cannot support rollback to revisions deployed using Argo CD v0.11 or below
+			// As multi source doesn't use app.Source, we need to check to the Sources length
+			return nil, status.Errorf(codes.FailedPrecondition, "cannot rollback to revision deployed with Argo CD v0.11 or lower. sync to revision instead.")
+		}
+		op.Sync.Revision = deploymentInfo.Revision
+		op.Sync.Revisions = deploymentInfo.Revisions
+		op.Sync.Source = &deploymentInfo.Source
+		op.Sync.Sources = deploymentInfo.Sources
+		historyRevision := deploymentInfo.Revision
+		if len(deploymentInfo.Revisions) > 0 {
+			historyRevision = strings.Join(deploymentInfo.Revisions, ",")
+		}
+		op.Sync.Rollback = &v1alpha1.RollbackInfo{Revision: historyRevision, HistoryID: ptr.To(deploymentInfo.ID), FromRevision: fromRevision}
+		eventMessage = fmt.Sprintf("initiated rollback to %d", rollbackReq.GetId())
+	}
 	appName := rollbackReq.GetName()
 	appNs := s.appNamespaceOrDefault(rollbackReq.GetAppNamespace())
 	appIf := s.appclientset.ArgoprojV1alpha1().Applications(appNs)
@@ -2126,7 +2336,7 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *application.Applicat
 	if err != nil {
 		return nil, fmt.Errorf("error setting app operation: %w", err)
 	}
-	s.logAppEvent(ctx, a, argo.EventReasonOperationStarted, fmt.Sprintf("initiated rollback to %d", rollbackReq.GetId()))
+	s.logAppEvent(ctx, a, argo.EventReasonOperationStarted, eventMessage)
 	return a, nil
 }
 
@@ -2299,6 +2509,52 @@ func (s *Server) resolveRevision(ctx context.Context, app *v1alpha1.Application,
 	return resolveRevisionResponse.Revision, resolveRevisionResponse.AmbiguousRevision, nil
 }
 
+// ApproveSync approves the automated sync of an application with the RequireDiffApproval=true sync option to a revision
+func (s *Server) ApproveSync(ctx context.Context, approveReq *application.ApplicationApproveSyncRequest) (*v1alpha1.Application, error) {
+	appName := approveReq.GetName()
+	appNs := s.appNamespaceOrDefault(approveReq.GetAppNamespace())
+	a, _, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionApprove, approveReq.GetProject(), appNs, appName, "")
+	if err != nil {
+		return nil, err
+	}
+	revision := approveReq.GetRevision()
+	if revision == "" {
+		return nil, status.Error(codes.InvalidArgument, "revision is required")
+	}
+	if a.Spec.SyncPolicy == nil || !a.Spec.SyncPolicy.SyncOptions.HasOption("RequireDiffApproval=true") {
+		return nil, status.Errorf(codes.FailedPrecondition, "application %s does not require the approval of its diff", a.QualifiedName())
+	}
+
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{
+				v1alpha1.AnnotationKeySyncApprovedRevision: revision,
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling approved revision: %w", err)
+	}
+	updated, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Patch(ctx, appName, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error patching application with approved revision: %w", err)
+	}
+	s.logAppEvent(ctx, a, argo.EventReasonResourceUpdated, "approved sync to revision "+revision)
+	return updated, nil
+}
+
+func (s *Server) DriftHistory(ctx context.Context, q *application.ApplicationDriftHistoryQuery) (*application.ApplicationDriftHistoryResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName(), "")
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*v1alpha1.DriftEvent, len(a.Status.DriftHistory))
+	for i := range a.Status.DriftHistory {
+		items[i] = &a.Status.DriftHistory[i]
+	}
+	return &application.ApplicationDriftHistoryResponse{Items: items}, nil
+}
+
 func (s *Server) TerminateOperation(ctx context.Context, termOpReq *application.OperationTerminateRequest) (*application.OperationTerminateResponse, error) {
 	appName := termOpReq.GetName()
 	appNs := s.appNamespaceOrDefault(termOpReq.GetAppNamespace())
@@ -2353,7 +2609,7 @@ func (s *Server) logResourceEvent(ctx context.Context, res *v1alpha1.ResourceNod
 }
 
 func (s *Server) ListResourceActions(ctx context.Context, q *application.ApplicationResourceRequest) (*application.ResourceActionsListResponse, error) {
-	obj, _, _, _, err := s.getUnstructuredLiveResourceOrApp(ctx, rbac.ActionGet, q)
+	obj, _, a, _, err := s.getUnstructuredLiveResourceOrApp(ctx, rbac.ActionGet, q)
 	if err != nil {
 		return nil, err
 	}
@@ -2368,6 +2624,11 @@ func (s *Server) ListResourceActions(ctx context.Context, q *application.Applica
 	}
 	actionsPtr := []*v1alpha1.ResourceAction{}
 	for i := range availableActions {
+		// the actions the user is not allowed to run are listed as disabled
+		actionRequest := fmt.Sprintf("%s/%s/%s/%s", rbac.ActionAction, q.GetGroup(), q.GetKind(), availableActions[i].Name)
+		if !s.enf.Enforce(ctx.Value("claims"), rbac.ResourceApplications, actionRequest, s.rbacObject(a)) {
+			availableActions[i].Disabled = true
+		}
 		actionsPtr = append(actionsPtr, &availableActions[i])
 	}
 
@@ -2380,7 +2641,7 @@ func (s *Server) getUnstructuredLiveResourceOrApp(ctx context.Context, rbacReque
 		if err != nil {
 			return nil, nil, nil, nil, err
 		}
-		if err = s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbacRequest, app.RBACName(s.ns)); err != nil {
+		if err = s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbacRequest, s.rbacObject(app)); err != nil {
 			return nil, nil, nil, nil, err
 		}
 		config, err = s.getApplicationClusterConfig(ctx, app)
@@ -2638,14 +2899,26 @@ func splitStatusPatch(patch []byte) ([]byte, []byte, error) {
 	return nonStatusPatch, statusPatch, nil
 }
 
+// getSyncWindowsCluster returns the destination cluster whose sync windows apply to the application. If the
+// destination cluster cannot be found the sync fails anyway, so nil is returned and only the project windows apply.
+func (s *Server) getSyncWindowsCluster(ctx context.Context, a *v1alpha1.Application) *v1alpha1.Cluster {
+	destCluster, err := argo.GetDestinationCluster(ctx, a.Spec.Destination, s.db)
+	if err != nil {
+		log.WithField("application", a.Name).Debugf("Ignoring sync windows of the destination cluster: %v", err)
+		return nil
+	}
+	return destCluster
+}
+
 func (s *Server) GetApplicationSyncWindows(ctx context.Context, q *application.ApplicationSyncWindowsQuery) (*application.ApplicationSyncWindowsResponse, error) {
 	a, proj, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName(), "")
 	if err != nil {
 		return nil, err
 	}
 
-	windows := proj.Spec.SyncWindows.Matches(a)
-	sync, err := windows.CanSync(true)
+	destCluster := s.getSyncWindowsCluster(ctx, a)
+	windows := proj.Spec.SyncWindows.MatchesWithCluster(a, destCluster)
+	sync, err := proj.Spec.SyncWindows.CanSyncWithCluster(a, destCluster, true)
 	if err != nil {
 		return nil, fmt.Errorf("invalid sync windows: %w", err)
 	}
@@ -802,6 +802,8 @@ func TestNoAppEnumeration(t *testing.T) {
 	t.Run("GetManifests", func(t *testing.T) {
 		_, err := appServer.GetManifests(adminCtx, &application.ApplicationManifestQuery{Name: ptr.To("test")})
 		require.NoError(t, err)
+		_, err = appServer.GetManifests(adminCtx, &application.ApplicationManifestQuery{Name: ptr.To("test"), Offset: ptr.To(int64(-1))})
+		require.EqualError(t, err, "rpc error: code = InvalidArgument desc = offset and limit must not be negative")
 		_, err = appServer.GetManifests(noRoleCtx, &application.ApplicationManifestQuery{Name: ptr.To("test")})
 		require.EqualError(t, err, common.PermissionDeniedAPIError.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
 		_, err = appServer.GetManifests(adminCtx, &application.ApplicationManifestQuery{Name: ptr.To("doest-not-exist")})
@@ -1489,6 +1491,102 @@ func TestCreateAppWithDestName(t *testing.T) {
 	assert.NotNil(t, app)
 }
 
+func TestCreateAndSyncAppWithProjectSyncPolicy(t *testing.T) {
+	ctx := t.Context()
+	guardedProj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "guarded", Namespace: "default"},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			SyncPolicy: &v1alpha1.ProjectSyncPolicy{
+				SyncOptions:       v1alpha1.SyncOptions{"ServerSideApply=true"},
+				Retry:             &v1alpha1.RetryStrategy{Limit: 2},
+				DeniedSyncOptions: []string{"Replace=true"},
+			},
+		},
+	}
+	appServer := newTestAppServer(t, guardedProj)
+
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Spec.Project = "guarded"
+		app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{SyncOptions: v1alpha1.SyncOptions{"Replace=true"}}
+	})
+	_, err := appServer.Create(ctx, &application.ApplicationCreateRequest{Application: testApp})
+	require.ErrorContains(t, err, "sync option Replace=true is denied in project 'guarded'")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	testApp.Spec.SyncPolicy = nil
+	app, err := appServer.Create(ctx, &application.ApplicationCreateRequest{Application: testApp})
+	require.NoError(t, err)
+
+	_, err = appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &app.Name, SyncOptions: &application.SyncOptions{Items: []string{"Replace=true"}}})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	app, err = appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &app.Name})
+	require.NoError(t, err)
+	require.NotNil(t, app.Operation)
+	assert.Equal(t, v1alpha1.SyncOptions{"ServerSideApply=true"}, app.Operation.Sync.SyncOptions)
+	assert.Equal(t, int64(2), app.Operation.Retry.Limit)
+}
+
+func TestCreateAndSyncAppWithSourceRestrictions(t *testing.T) {
+	ctx := t.Context()
+	prodProj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod", Namespace: "default"},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:        []string{"*"},
+			Destinations:       []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			SourceRestrictions: []v1alpha1.SourceRestriction{{TargetRevisions: []string{"release/*"}}},
+		},
+	}
+	appServer := newTestAppServer(t, prodProj)
+
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Spec.Project = "prod"
+		app.Spec.Source.TargetRevision = "main"
+	})
+	_, err := appServer.Create(ctx, &application.ApplicationCreateRequest{Application: testApp})
+	require.ErrorContains(t, err, "target revision main of repo https://github.com/argoproj/argocd-example-apps.git is not permitted in project 'prod'")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	testApp.Spec.Source.TargetRevision = "release/1.0"
+	app, err := appServer.Create(ctx, &application.ApplicationCreateRequest{Application: testApp})
+	require.NoError(t, err)
+
+	_, err = appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &app.Name, Revision: ptr.To("main")})
+	require.ErrorContains(t, err, "target revision main of repo https://github.com/argoproj/argocd-example-apps.git is not permitted in project 'prod'")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestCreateAppWithProjectQuota(t *testing.T) {
+	ctx := t.Context()
+	limitedProj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "limited", Namespace: "default"},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			Quota:        &v1alpha1.ProjectQuota{MaxApplications: 1},
+		},
+	}
+	existingApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "existing"
+		app.Spec.Project = "limited"
+	})
+	appServer := newTestAppServer(t, limitedProj, existingApp)
+
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Spec.Project = "limited"
+	})
+	_, err := appServer.Create(ctx, &application.ApplicationCreateRequest{Application: testApp})
+	require.ErrorContains(t, err, "project 'limited' has reached its quota of 1 applications")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// the applications of the project can still be updated
+	existingApp.Spec.Source.TargetRevision = "v1.0"
+	_, err = appServer.Update(ctx, &application.ApplicationUpdateRequest{Application: existingApp})
+	require.NoError(t, err)
+}
+
 // TestCreateAppWithOperation tests that an application created with an operation is created with the operation removed.
 // Avoids regressions of https://github.com/argoproj/argo-cd/security/advisories/GHSA-g623-jcgg-mhmm
 func TestCreateAppWithOperation(t *testing.T) {
@@ -1719,6 +1817,107 @@ p, test-user, applications, delete/fake.io/PodTest/*, default/test-app, deny
 	})
 }
 
+func TestRemoveResourceFinalizersRBAC(t *testing.T) {
+	ctx := t.Context()
+	//nolint:staticcheck
+	ctx = context.WithValue(ctx, "claims", &jwt.RegisteredClaims{Subject: "test-user"})
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
+	appServer.enf.SetDefaultRole("")
+
+	req := application.ApplicationResourceRequest{
+		Name:         &testApp.Name,
+		AppNamespace: &testApp.Namespace,
+		Group:        strToPtr("fake.io"),
+		Kind:         strToPtr("PodTest"),
+		Namespace:    strToPtr("fake-ns"),
+		ResourceName: strToPtr("my-pod-test"),
+	}
+
+	t.Run("remove finalizers with update permission", func(t *testing.T) {
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, update, default/test-app, allow
+`)
+		_, err := appServer.RemoveResourceFinalizers(ctx, &req)
+		assert.Equal(t, codes.PermissionDenied.String(), status.Code(err).String())
+	})
+
+	t.Run("remove finalizers with remove-finalizers permission", func(t *testing.T) {
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, remove-finalizers, default/test-app, allow
+`)
+		_, err := appServer.RemoveResourceFinalizers(ctx, &req)
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = PodTest fake.io my-pod-test not found as part of application test-app")
+	})
+}
+
+func TestGetAppLabelRBAC(t *testing.T) {
+	ctx := t.Context()
+	//nolint:staticcheck
+	ctx = context.WithValue(ctx, "claims", &jwt.RegisteredClaims{Subject: "test-user"})
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Labels = map[string]string{"team": "payments"}
+	})
+	appServer := newTestAppServer(t, testApp)
+	appServer.enf.SetDefaultRole("")
+
+	t.Run("get with a label rule of another team", func(t *testing.T) {
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, get, label:team=billing, allow
+`)
+		_, err := appServer.Get(ctx, &application.ApplicationQuery{Name: &testApp.Name, Project: []string{"default"}})
+		assert.Equal(t, codes.PermissionDenied.String(), status.Code(err).String())
+	})
+
+	t.Run("get with a label rule of the team", func(t *testing.T) {
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, get, label:team=payments, allow
+`)
+		app, err := appServer.Get(ctx, &application.ApplicationQuery{Name: &testApp.Name, Project: []string{"default"}})
+		require.NoError(t, err)
+		assert.Equal(t, testApp.Name, app.Name)
+
+		app, err = appServer.Get(ctx, &application.ApplicationQuery{Name: &testApp.Name})
+		require.NoError(t, err)
+		assert.Equal(t, testApp.Name, app.Name)
+	})
+}
+
+func TestCreateAppLabelRBAC(t *testing.T) {
+	ctx := t.Context()
+	//nolint:staticcheck
+	ctx = context.WithValue(ctx, "claims", &jwt.RegisteredClaims{Subject: "test-user"})
+	appServer := newTestAppServer(t)
+	appServer.enf.SetDefaultRole("")
+	_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, create, label:team=payments, allow
+p, test-user, applications, update, label:team=payments, allow
+`)
+
+	t.Run("create with the labels of a label rule", func(t *testing.T) {
+		testApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Labels = map[string]string{"team": "payments"}
+		})
+		_, err := appServer.Create(ctx, &application.ApplicationCreateRequest{Application: testApp})
+		assert.Equal(t, codes.PermissionDenied.String(), status.Code(err).String())
+	})
+
+	t.Run("change project with the labels of a label rule", func(t *testing.T) {
+		testApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Labels = map[string]string{"team": "payments"}
+		})
+		appServer := newTestAppServer(t, testApp)
+		appServer.enf.SetDefaultRole("")
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, create, label:team=payments, allow
+p, test-user, applications, update, label:team=payments, allow
+`)
+		testApp.Spec.Project = "my-proj"
+		_, err := appServer.Update(ctx, &application.ApplicationUpdateRequest{Application: testApp})
+		assert.Equal(t, codes.PermissionDenied.String(), status.Code(err).String())
+	})
+}
+
 func TestPatchResourcesRBAC(t *testing.T) {
 	ctx := t.Context()
 	//nolint:staticcheck
@@ -1852,6 +2051,78 @@ func TestSyncAndTerminate(t *testing.T) {
 	assert.Equal(t, synccommon.OperationTerminating, app.Status.OperationState.Phase)
 }
 
+func TestApproveSync(t *testing.T) {
+	ctx := t.Context()
+	const revision = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	testApp := newTestApp()
+	otherApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "other-app"
+	})
+	testApp.Spec.SyncPolicy = &v1alpha1.SyncPolicy{SyncOptions: v1alpha1.SyncOptions{"RequireDiffApproval=true"}}
+	appServer := newTestAppServer(t, testApp, otherApp)
+
+	app, err := appServer.ApproveSync(ctx, &application.ApplicationApproveSyncRequest{Name: &testApp.Name, Revision: ptr.To(revision)})
+	require.NoError(t, err)
+	assert.Equal(t, revision, app.Annotations[v1alpha1.AnnotationKeySyncApprovedRevision])
+
+	_, err = appServer.ApproveSync(ctx, &application.ApplicationApproveSyncRequest{Name: &testApp.Name})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = appServer.ApproveSync(ctx, &application.ApplicationApproveSyncRequest{Name: &otherApp.Name, Revision: ptr.To(revision)})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestSyncApprovedRevisionIsOnlySetByApproveSync(t *testing.T) {
+	const revision = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	approve := func(app *v1alpha1.Application) {
+		app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{SyncOptions: v1alpha1.SyncOptions{"RequireDiffApproval=true"}}
+		app.Annotations = map[string]string{v1alpha1.AnnotationKeySyncApprovedRevision: revision}
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		appServer := newTestAppServer(t)
+		app, err := appServer.Create(t.Context(), &application.ApplicationCreateRequest{Application: newTestApp(approve)})
+		require.NoError(t, err)
+		assert.NotContains(t, app.Annotations, v1alpha1.AnnotationKeySyncApprovedRevision)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		testApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{SyncOptions: v1alpha1.SyncOptions{"RequireDiffApproval=true"}}
+		})
+		appServer := newTestAppServer(t, testApp)
+		app, err := appServer.Update(t.Context(), &application.ApplicationUpdateRequest{Application: newTestApp(approve)})
+		require.NoError(t, err)
+		assert.NotContains(t, app.Annotations, v1alpha1.AnnotationKeySyncApprovedRevision)
+	})
+
+	t.Run("Patch", func(t *testing.T) {
+		testApp := newTestApp(approve)
+		appServer := newTestAppServer(t, testApp)
+		app, err := appServer.Patch(t.Context(), &application.ApplicationPatchRequest{
+			Name:      &testApp.Name,
+			Patch:     ptr.To(`{"metadata":{"annotations":{"argocd.argoproj.io/sync-approved-revision":"bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"}}}`),
+			PatchType: ptr.To("merge"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, revision, app.Annotations[v1alpha1.AnnotationKeySyncApprovedRevision])
+	})
+}
+
+func TestDriftHistory(t *testing.T) {
+	testApp := newTestApp()
+	testApp.Status.DriftHistory = []v1alpha1.DriftEvent{{
+		Revision:  "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		Resources: []v1alpha1.DriftedResource{{Group: "apps", Kind: "Deployment", Name: "guestbook", Managers: []string{"kubectl-edit"}}},
+	}}
+	appServer := newTestAppServer(t, testApp)
+
+	res, err := appServer.DriftHistory(t.Context(), &application.ApplicationDriftHistoryQuery{Name: &testApp.Name})
+	require.NoError(t, err)
+	require.Len(t, res.Items, 1)
+	assert.Equal(t, []string{"kubectl-edit"}, res.Items[0].Resources[0].Managers)
+}
+
 func TestSyncHelm(t *testing.T) {
 	ctx := t.Context()
 	appServer := newTestAppServer(t)
@@ -1924,6 +2195,63 @@ func TestRollbackApp(t *testing.T) {
 	assert.NotNil(t, updatedApp.Operation.Sync)
 	assert.NotNil(t, updatedApp.Operation.Sync.Source)
 	assert.Equal(t, "abc", updatedApp.Operation.Sync.Revision)
+	assert.Equal(t, &v1alpha1.RollbackInfo{Revision: "abc", HistoryID: ptr.To(int64(1))}, updatedApp.Operation.Sync.Rollback)
+}
+
+func TestRollbackAppToRevision(t *testing.T) {
+	testApp := newTestApp()
+	testApp.Status.Sync.Revision = "def"
+	appServer := newTestAppServer(t, testApp)
+
+	updatedApp, err := appServer.Rollback(t.Context(), &application.ApplicationRollbackRequest{
+		Name:     &testApp.Name,
+		Id:       ptr.To(int64(0)),
+		Revision: ptr.To("v1.0.0"),
+	})
+	require.NoError(t, err)
+
+	require.NotNil(t, updatedApp.Operation)
+	require.NotNil(t, updatedApp.Operation.Sync)
+	assert.Equal(t, "v1.0.0", updatedApp.Operation.Sync.Revision)
+	require.NotNil(t, updatedApp.Operation.Sync.Source)
+	assert.Equal(t, testApp.Spec.Source.RepoURL, updatedApp.Operation.Sync.Source.RepoURL)
+	assert.Equal(t, "v1.0.0", updatedApp.Operation.Sync.Source.TargetRevision)
+	assert.Equal(t, &v1alpha1.RollbackInfo{Revision: "v1.0.0", FromRevision: "def"}, updatedApp.Operation.Sync.Rollback)
+
+	t.Run("Multiple sources", func(t *testing.T) {
+		multiSourceApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Spec.Sources = v1alpha1.ApplicationSources{{RepoURL: fakeRepoURL}, {RepoURL: fakeRepoURL}}
+		})
+		appServer := newTestAppServer(t, multiSourceApp)
+		_, err := appServer.Rollback(t.Context(), &application.ApplicationRollbackRequest{
+			Name:     &multiSourceApp.Name,
+			Id:       ptr.To(int64(0)),
+			Revision: ptr.To("v1.0.0"),
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("Revision not permitted by the project", func(t *testing.T) {
+		prodProj := &v1alpha1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "prod", Namespace: "default"},
+			Spec: v1alpha1.AppProjectSpec{
+				SourceRepos:        []string{"*"},
+				Destinations:       []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+				SourceRestrictions: []v1alpha1.SourceRestriction{{TargetRevisions: []string{"release/*"}}},
+			},
+		}
+		prodApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Spec.Project = "prod"
+			app.Spec.Source.TargetRevision = "release/1.0"
+		})
+		appServer := newTestAppServer(t, prodProj, prodApp)
+		_, err := appServer.Rollback(t.Context(), &application.ApplicationRollbackRequest{
+			Name:     &prodApp.Name,
+			Id:       ptr.To(int64(0)),
+			Revision: ptr.To("main"),
+		})
+		require.ErrorContains(t, err, "target revision main of repo https://github.com/argoproj/argocd-example-apps.git is not permitted in project 'prod'")
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
 }
 
 func TestUpdateAppProject(t *testing.T) {
@@ -2049,6 +2377,25 @@ func TestServer_GetApplicationSyncWindowsState(t *testing.T) {
 		require.NoError(t, err)
 		assert.Empty(t, active.ActiveWindows)
 	})
+	t.Run("ClusterWindow", func(t *testing.T) {
+		testApp := newTestApp()
+		testApp.Spec.Project = "default"
+		appServer := newTestAppServer(t, testApp)
+		cluster := fakeCluster()
+		cluster.SyncWindows = v1alpha1.SyncWindows{{Kind: "deny", Schedule: "* * * * *", Duration: "1h"}}
+		_, err := appServer.db.UpdateCluster(t.Context(), cluster)
+		require.NoError(t, err)
+
+		active, err := appServer.GetApplicationSyncWindows(t.Context(), &application.ApplicationSyncWindowsQuery{Name: &testApp.Name})
+		require.NoError(t, err)
+		assert.Len(t, active.ActiveWindows, 1)
+		assert.Len(t, active.AssignedWindows, 1)
+		assert.False(t, *active.CanSync)
+
+		_, err = appServer.Sync(t.Context(), &application.ApplicationSyncRequest{Name: &testApp.Name})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		require.ErrorContains(t, err, "blocked by sync window")
+	})
 	t.Run("ProjectDoesNotExist", func(t *testing.T) {
 		testApp := newTestApp()
 		testApp.Spec.Project = "none"
@@ -2411,6 +2758,56 @@ func TestGetAppRefresh_HardRefresh(t *testing.T) {
 	}
 }
 
+func TestGetAppRefresh_ScopedHardRefresh(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	testApp := newTestApp()
+	testApp.ResourceVersion = "1"
+	appServer := newTestAppServer(t, testApp)
+	// the cached application details are not invalidated
+	mockRepoServiceClient := mocks.RepoServerServiceClient{}
+	appServer.repoClientset = &mocks.Clientset{RepoServerServiceClient: &mockRepoServiceClient}
+
+	t.Run("Invalid resource", func(t *testing.T) {
+		_, err := appServer.Get(t.Context(), &application.ApplicationQuery{
+			Name:             &testApp.Name,
+			Refresh:          ptr.To(string(v1alpha1.RefreshTypeHard)),
+			RefreshResources: []string{"Deployment:guestbook"},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	refreshResources := make(chan string, 1)
+	go func() {
+		for ctx.Err() == nil {
+			a, err := appServer.appLister.Applications(testApp.Namespace).Get(testApp.Name)
+			require.NoError(t, err)
+			if a.GetAnnotations()[v1alpha1.AnnotationKeyRefresh] != "" {
+				refreshResources <- a.GetAnnotations()[v1alpha1.AnnotationKeyRefreshResources]
+				a.SetAnnotations(map[string]string{})
+				a.SetResourceVersion("999")
+				_, err = appServer.appclientset.ArgoprojV1alpha1().Applications(a.Namespace).Update(t.Context(), a, metav1.UpdateOptions{})
+				require.NoError(t, err)
+				return
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+	}()
+
+	_, err := appServer.Get(t.Context(), &application.ApplicationQuery{
+		Name:             &testApp.Name,
+		Refresh:          ptr.To(string(v1alpha1.RefreshTypeHard)),
+		RefreshResources: []string{"apps:Deployment:guestbook", ":Service:default/guestbook"},
+	})
+	require.NoError(t, err)
+	select {
+	case resources := <-refreshResources:
+		assert.Equal(t, "apps:Deployment:guestbook,:Service:default/guestbook", resources)
+	case <-time.After(10 * time.Second):
+		assert.Fail(t, "Out of time ( 10 seconds )")
+	}
+}
+
 func TestInferResourcesStatusHealth(t *testing.T) {
 	cacheClient := cache.NewCache(cache.NewInMemoryCache(1 * time.Hour))
 
@@ -2651,6 +3048,47 @@ func TestRunOldStyleResourceAction(t *testing.T) {
 	})
 }
 
+func TestListResourceActionsRBAC(t *testing.T) {
+	ctx := t.Context()
+	//nolint:staticcheck
+	ctx = context.WithValue(ctx, "claims", &jwt.RegisteredClaims{Subject: "test-user"})
+	cacheClient := cache.NewCache(cache.NewInMemoryCache(1 * time.Hour))
+	appStateCache := appstate.NewCache(cacheClient, time.Minute)
+
+	deployment := appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "nginx-deploy", Namespace: testNamespace},
+	}
+	testApp := newTestApp()
+	testApp.Status.ResourceHealthSource = v1alpha1.ResourceHealthLocationAppTree
+	testApp.Status.Resources = []v1alpha1.ResourceStatus{{Group: "apps", Kind: "Deployment", Name: "nginx-deploy", Namespace: testNamespace, Version: "v1"}}
+	appServer := newTestAppServer(t, testApp, kube.MustToUnstructured(&deployment))
+	appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute, time.Minute)
+	appServer.enf.SetDefaultRole("")
+	require.NoError(t, appStateCache.SetAppResourcesTree(testApp.Name, &v1alpha1.ApplicationTree{Nodes: []v1alpha1.ResourceNode{{
+		ResourceRef: v1alpha1.ResourceRef{Group: "apps", Kind: "Deployment", Version: "v1", Name: "nginx-deploy", Namespace: testNamespace, UID: "2"},
+	}}}))
+	_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, get, default/test-app, allow
+p, test-user, applications, action/apps/Deployment/restart, default/test-app, allow
+`)
+
+	res, err := appServer.ListResourceActions(ctx, &application.ApplicationResourceRequest{
+		Name:         &testApp.Name,
+		AppNamespace: &testApp.Namespace,
+		Namespace:    ptr.To(testNamespace),
+		ResourceName: ptr.To("nginx-deploy"),
+		Version:      ptr.To("v1"),
+		Group:        ptr.To("apps"),
+		Kind:         ptr.To("Deployment"),
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, res.Actions)
+	for _, action := range res.Actions {
+		assert.Equal(t, action.Name != "restart", action.Disabled, action.Name)
+	}
+}
+
 func TestIsApplicationPermitted(t *testing.T) {
 	t.Run("Incorrect project", func(t *testing.T) {
 		testApp := newTestApp()
@@ -3454,3 +3892,13 @@ func Test_DeepCopyInformers(t *testing.T) {
 		assert.NotSame(t, p, &spList[i])
 	}
 }
+
+func TestPaginateManifests(t *testing.T) {
+	manifests := []string{"a", "b", "c"}
+	assert.Equal(t, manifests, paginateManifests(manifests, 0, 0))
+	assert.Equal(t, []string{"b", "c"}, paginateManifests(manifests, 1, 0))
+	assert.Equal(t, []string{"a", "b"}, paginateManifests(manifests, 0, 2))
+	assert.Equal(t, []string{"c"}, paginateManifests(manifests, 2, 2))
+	assert.Empty(t, paginateManifests(manifests, 5, 2))
+	assert.Nil(t, paginateManifests(nil, 0, 0))
+}
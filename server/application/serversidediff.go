@@ -0,0 +1,313 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+
+	kubecache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/diff"
+	"github.com/argoproj/gitops-engine/pkg/sync/hook"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	argocommon "github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	argodiff "github.com/argoproj/argo-cd/v3/util/argo/diff"
+	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
+	kubeutil "github.com/argoproj/argo-cd/v3/util/kube"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+)
+
+// serverSideDiffObject is a resource compared by a server-side diff
+type serverSideDiffObject struct {
+	key    kube.ResourceKey
+	gvk    schema.GroupVersionKind
+	target *unstructured.Unstructured
+	// managed is true if the resource is a managed resource of the application
+	managed bool
+}
+
+// ServerSideDiff compares the target state of the application, or the given manifests, with the live state using a
+// server-side apply dry-run in the destination cluster, so the defaulting and mutating webhooks are reflected. Since
+// the dry-run applies the manifests with the credentials of the cluster, the user must be allowed to sync the
+// application, and only the managed resources of the application and new resources permitted by its project are
+// compared.
+func (s *Server) ServerSideDiff(ctx context.Context, q *application.ApplicationServerSideDiffQuery) (*application.ApplicationServerSideDiffResponse, error) {
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetAppName())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionSync, s.rbacObject(a)); err != nil {
+		return nil, err
+	}
+
+	managed := make([]*v1alpha1.ResourceDiff, 0)
+	err = s.getCachedAppState(ctx, a, func() error {
+		return s.cache.GetAppManagedResources(a.InstanceName(s.ns), &managed)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting cached app managed resources: %w", err)
+	}
+	destCluster, err := argo.GetDestinationCluster(ctx, a.Spec.Destination, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("error validating destination: %w", err)
+	}
+	config, err := destCluster.RESTConfig()
+	if err != nil {
+		return nil, fmt.Errorf("error getting cluster REST config: %w", err)
+	}
+	apiResources, err := s.kubectl.GetAPIResources(config, false, kubecache.NewNoopSettings())
+	if err != nil {
+		return nil, fmt.Errorf("error getting API resources: %w", err)
+	}
+	namespaced := map[schema.GroupKind]bool{}
+	for _, res := range apiResources {
+		namespaced[res.GroupKind] = res.Meta.Namespaced
+	}
+	appLabelKey, err := s.settingsMgr.GetAppInstanceLabelKey()
+	if err != nil {
+		return nil, fmt.Errorf("error getting app instance label key from settings: %w", err)
+	}
+	installationID, err := s.settingsMgr.GetInstallationID()
+	if err != nil {
+		return nil, fmt.Errorf("error getting installation ID: %w", err)
+	}
+	trackingMethod := argo.GetTrackingMethod(s.settingsMgr)
+	resourceTracking := argo.NewResourceTracking()
+
+	objs, err := serverSideDiffObjects(managed, q.GetTargetManifests(), a.Spec.Destination.Namespace, func(gk schema.GroupKind) bool {
+		return namespaced[gk]
+	}, func(obj *unstructured.Unstructured) error {
+		if kube.IsCRD(obj) {
+			return nil
+		}
+		return resourceTracking.SetAppInstance(obj, appLabelKey, a.InstanceName(s.ns), a.Spec.Destination.Namespace, trackingMethod, installationID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lives := make([]*unstructured.Unstructured, len(objs))
+	targets := make([]*unstructured.Unstructured, len(objs))
+	for i, obj := range objs {
+		if !obj.managed {
+			permitted, err := proj.IsResourcePermitted(obj.key.GroupKind(), obj.key.Namespace, destCluster, func(project string) ([]*v1alpha1.Cluster, error) {
+				return s.db.GetProjectClusters(ctx, project)
+			})
+			if err != nil {
+				return nil, fmt.Errorf("error checking whether %s is permitted in project %q: %w", obj.key, proj.Name, err)
+			}
+			if !permitted {
+				return nil, status.Errorf(codes.PermissionDenied, "resource %s is not permitted in project %s", obj.key, proj.Name)
+			}
+		}
+		live, err := s.kubectl.GetResource(ctx, config, obj.gvk, obj.key.Name, obj.key.Namespace)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("error getting live state of %s: %w", obj.key, err)
+		}
+		if err == nil {
+			if !obj.managed {
+				// the live state of the resources which are not managed by the application must not be returned
+				return nil, status.Errorf(codes.PermissionDenied, "resource %s already exists and is not managed by application %s", obj.key, a.QualifiedName())
+			}
+			lives[i] = live
+		}
+		targets[i] = obj.target
+	}
+
+	openAPISchema, gvkParser, err := s.kubectl.LoadOpenAPISchema(config)
+	if err != nil {
+		return nil, fmt.Errorf("error loading OpenAPI schema: %w", err)
+	}
+	applier, cleanup, err := kubeutil.ManageServerSideDiffDryRuns(config, openAPISchema, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating server-side diff dry-run applier: %w", err)
+	}
+	defer cleanup()
+	resourceOverrides, err := s.settingsMgr.GetResourceOverrides()
+	if err != nil {
+		return nil, fmt.Errorf("error getting resource overrides: %w", err)
+	}
+	compareOptions, err := s.settingsMgr.GetResourceCompareOptions()
+	if err != nil {
+		return nil, fmt.Errorf("error getting resource compare options: %w", err)
+	}
+	globalIgnoreDifferences, err := s.settingsMgr.GetResourceIgnoreDifferences()
+	if err != nil {
+		return nil, fmt.Errorf("error getting ignored differences: %w", err)
+	}
+	diffConfig, err := argodiff.NewDiffConfigBuilder().
+		WithDiffSettings(slices.Concat(a.GetIgnoreDifferences(), globalIgnoreDifferences), resourceOverrides, compareOptions.IgnoreAggregatedRoles, normalizers.IgnoreNormalizerOpts{JQExecutionTimeout: normalizers.DefaultJQExecutionTimeout}).
+		WithTracking(appLabelKey, string(trackingMethod)).
+		WithNoCache().
+		WithGVKParser(gvkParser).
+		WithManager(argocommon.ArgoCDSSAManager).
+		WithServerSideDiff(true).
+		WithServerSideDryRunner(diff.NewK8sServerSideDryRunner(applier)).
+		WithIgnoreMutationWebhook(false).
+		Build()
+	if err != nil {
+		return nil, fmt.Errorf("error building diff config: %w", err)
+	}
+	diffResults, err := argodiff.StateDiffs(lives, targets, diffConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error comparing the target state with the live state: %w", err)
+	}
+
+	res := &application.ApplicationServerSideDiffResponse{Modified: ptr.To(false)}
+	for i, obj := range objs {
+		diffRes := diffResults.Diffs[i]
+		item := &v1alpha1.ResourceDiff{
+			Group:               obj.key.Group,
+			Kind:                obj.key.Kind,
+			Namespace:           obj.key.Namespace,
+			Name:                obj.key.Name,
+			NormalizedLiveState: string(diffRes.NormalizedLive),
+			PredictedLiveState:  string(diffRes.PredictedLive),
+			Modified:            diffRes.Modified || lives[i] == nil || targets[i] == nil,
+		}
+		if targets[i] != nil {
+			targetState, err := json.Marshal(targets[i])
+			if err != nil {
+				return nil, fmt.Errorf("error marshaling target state of %s: %w", obj.key, err)
+			}
+			item.TargetState = string(targetState)
+		}
+		if obj.key.Group == "" && obj.key.Kind == kube.SecretKind {
+			if err := hideServerSideDiffSecretData(item, s.settingsMgr.GetSensitiveAnnotations()); err != nil {
+				return nil, fmt.Errorf("error hiding secret data of %s: %w", obj.key, err)
+			}
+		}
+		if item.Modified {
+			res.Modified = ptr.To(true)
+		}
+		res.Items = append(res.Items, item)
+	}
+	return res, nil
+}
+
+// hideServerSideDiffSecretData replaces the data of the secret compared by a server-side diff with placeholders, which
+// differ where the values of the predicted and the live state differ
+func hideServerSideDiffSecretData(item *v1alpha1.ResourceDiff, hideAnnotations map[string]bool) error {
+	predicted, err := v1alpha1.UnmarshalToUnstructured(item.PredictedLiveState)
+	if err != nil {
+		return err
+	}
+	live, err := v1alpha1.UnmarshalToUnstructured(item.NormalizedLiveState)
+	if err != nil {
+		return err
+	}
+	target, err := v1alpha1.UnmarshalToUnstructured(item.TargetState)
+	if err != nil {
+		return err
+	}
+	predicted, live, err = diff.HideSecretData(predicted, live, hideAnnotations)
+	if err != nil {
+		return err
+	}
+	target, _, err = diff.HideSecretData(target, nil, hideAnnotations)
+	if err != nil {
+		return err
+	}
+	setState := func(state *string, obj *unstructured.Unstructured) error {
+		if obj == nil {
+			return nil
+		}
+		data, err := json.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		*state = string(data)
+		return nil
+	}
+	if err := setState(&item.PredictedLiveState, predicted); err != nil {
+		return err
+	}
+	if err := setState(&item.NormalizedLiveState, live); err != nil {
+		return err
+	}
+	return setState(&item.TargetState, target)
+}
+
+// serverSideDiffObjects returns the resources compared by a server-side diff: the managed resources of the application
+// and the target objects, parsed from the manifests or taken from the target state of the managed resources if there
+// are no manifests. setAppInstance is called on the objects parsed from the manifests. Hooks are excluded.
+func serverSideDiffObjects(managed []*v1alpha1.ResourceDiff, manifests []string, namespace string, isNamespaced func(gk schema.GroupKind) bool, setAppInstance func(obj *unstructured.Unstructured) error) ([]serverSideDiffObject, error) {
+	targets := map[kube.ResourceKey]*unstructured.Unstructured{}
+	if len(manifests) > 0 {
+		for _, manifest := range manifests {
+			obj, err := v1alpha1.UnmarshalToUnstructured(manifest)
+			if err != nil {
+				return nil, fmt.Errorf("error unmarshaling manifest: %w", err)
+			}
+			if obj == nil {
+				continue
+			}
+			if obj.GetNamespace() == "" && isNamespaced(obj.GroupVersionKind().GroupKind()) {
+				obj.SetNamespace(namespace)
+			}
+			if err := setAppInstance(obj); err != nil {
+				return nil, fmt.Errorf("error setting app instance: %w", err)
+			}
+			targets[kube.GetResourceKey(obj)] = obj
+		}
+	} else {
+		for _, res := range managed {
+			obj, err := res.TargetObject()
+			if err != nil {
+				return nil, fmt.Errorf("error unmarshaling target state: %w", err)
+			}
+			if obj != nil {
+				targets[kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)] = obj
+			}
+		}
+	}
+
+	var objs []serverSideDiffObject
+	for _, res := range managed {
+		key := kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)
+		target := targets[key]
+		delete(targets, key)
+		obj := target
+		if obj == nil {
+			live, err := res.LiveObject()
+			if err != nil {
+				return nil, fmt.Errorf("error unmarshaling live state: %w", err)
+			}
+			obj = live
+		}
+		if res.Hook || obj == nil {
+			continue
+		}
+		objs = append(objs, serverSideDiffObject{key: key, gvk: obj.GroupVersionKind(), target: target, managed: true})
+	}
+	keys := make([]kube.ResourceKey, 0, len(targets))
+	for key := range targets {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+	for _, key := range keys {
+		objs = append(objs, serverSideDiffObject{key: key, gvk: targets[key].GroupVersionKind(), target: targets[key]})
+	}
+
+	filtered := objs[:0]
+	for _, obj := range objs {
+		if obj.target != nil && hook.IsHook(obj.target) {
+			continue
+		}
+		filtered = append(filtered, obj)
+	}
+	return filtered, nil
+}
@@ -0,0 +1,135 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func Test_serverSideDiffObjects(t *testing.T) {
+	managed := []*v1alpha1.ResourceDiff{
+		{
+			Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook",
+			LiveState:   `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook","namespace":"default"}}`,
+			TargetState: `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook","namespace":"default"},"spec":{"replicas":1}}`,
+		},
+		{
+			Kind: "Service", Namespace: "default", Name: "pruned",
+			LiveState:   `{"apiVersion":"v1","kind":"Service","metadata":{"name":"pruned","namespace":"default"}}`,
+			TargetState: "null",
+		},
+		{
+			Kind: "Secret", Namespace: "default", Name: "credentials",
+			LiveState:   `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"credentials","namespace":"default"}}`,
+			TargetState: `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"credentials","namespace":"default"}}`,
+		},
+		{
+			Group: "batch", Kind: "Job", Namespace: "default", Name: "migrate", Hook: true,
+			LiveState: `{"apiVersion":"batch/v1","kind":"Job","metadata":{"name":"migrate","namespace":"default"}}`,
+		},
+	}
+	isNamespaced := func(gk schema.GroupKind) bool {
+		return gk.Kind != "ClusterRole"
+	}
+
+	t.Run("Target state", func(t *testing.T) {
+		objs, err := serverSideDiffObjects(managed, nil, "default", isNamespaced, func(*unstructured.Unstructured) error {
+			t.Fatal("the target state of the managed resources is already tracked")
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, objs, 3)
+		assert.Equal(t, kube.NewResourceKey("apps", "Deployment", "default", "guestbook"), objs[0].key)
+		assert.Equal(t, schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, objs[0].gvk)
+		assert.Equal(t, int64(1), objs[0].target.Object["spec"].(map[string]any)["replicas"])
+		assert.Equal(t, kube.NewResourceKey("", "Service", "default", "pruned"), objs[1].key)
+		assert.Equal(t, schema.GroupVersionKind{Version: "v1", Kind: "Service"}, objs[1].gvk)
+		assert.Nil(t, objs[1].target)
+		assert.Equal(t, kube.NewResourceKey("", "Secret", "default", "credentials"), objs[2].key)
+		for _, obj := range objs {
+			assert.True(t, obj.managed)
+		}
+	})
+	t.Run("Manifests", func(t *testing.T) {
+		var tracked []string
+		objs, err := serverSideDiffObjects(managed, []string{
+			`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook"},"spec":{"replicas":2}}`,
+			`{"apiVersion":"rbac.authorization.k8s.io/v1","kind":"ClusterRole","metadata":{"name":"reader"}}`,
+			`{"apiVersion":"batch/v1","kind":"Job","metadata":{"name":"smoke","annotations":{"argocd.argoproj.io/hook":"PostSync"}}}`,
+		}, "default", isNamespaced, func(obj *unstructured.Unstructured) error {
+			tracked = append(tracked, obj.GetName())
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"guestbook", "reader", "smoke"}, tracked)
+		require.Len(t, objs, 4)
+		assert.Equal(t, kube.NewResourceKey("apps", "Deployment", "default", "guestbook"), objs[0].key)
+		assert.Equal(t, int64(2), objs[0].target.Object["spec"].(map[string]any)["replicas"])
+		assert.True(t, objs[0].managed)
+		// not part of the manifests anymore
+		assert.Equal(t, kube.NewResourceKey("", "Service", "default", "pruned"), objs[1].key)
+		assert.Nil(t, objs[1].target)
+		assert.Equal(t, kube.NewResourceKey("", "Secret", "default", "credentials"), objs[2].key)
+		assert.Nil(t, objs[2].target)
+		// new resources must be permitted by the project
+		assert.Equal(t, kube.NewResourceKey("rbac.authorization.k8s.io", "ClusterRole", "", "reader"), objs[3].key)
+		assert.Nil(t, objs[3].target.Object["metadata"].(map[string]any)["namespace"])
+		assert.False(t, objs[3].managed)
+	})
+	t.Run("Invalid manifest", func(t *testing.T) {
+		_, err := serverSideDiffObjects(managed, []string{"{"}, "default", isNamespaced, func(*unstructured.Unstructured) error { return nil })
+		require.ErrorContains(t, err, "error unmarshaling manifest")
+	})
+}
+
+func Test_hideServerSideDiffSecretData(t *testing.T) {
+	item := &v1alpha1.ResourceDiff{
+		Kind:                "Secret",
+		Namespace:           "default",
+		Name:                "credentials",
+		TargetState:         `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"credentials"},"stringData":{"password":"new"}}`,
+		NormalizedLiveState: `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"credentials"},"data":{"password":"b2xk","user":"YWRtaW4="}}`,
+		PredictedLiveState:  `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"credentials"},"data":{"password":"bmV3","user":"YWRtaW4="}}`,
+	}
+	require.NoError(t, hideServerSideDiffSecretData(item, nil))
+	for _, state := range []string{item.TargetState, item.NormalizedLiveState, item.PredictedLiveState} {
+		assert.NotContains(t, state, "new")
+		assert.NotContains(t, state, "b2xk")
+		assert.NotContains(t, state, "bmV3")
+		assert.NotContains(t, state, "YWRtaW4=")
+	}
+	live, err := v1alpha1.UnmarshalToUnstructured(item.NormalizedLiveState)
+	require.NoError(t, err)
+	predicted, err := v1alpha1.UnmarshalToUnstructured(item.PredictedLiveState)
+	require.NoError(t, err)
+	liveData, _, _ := unstructured.NestedStringMap(live.Object, "data")
+	predictedData, _, _ := unstructured.NestedStringMap(predicted.Object, "data")
+	assert.Equal(t, liveData["user"], predictedData["user"], "unchanged values must have the same placeholder")
+	assert.NotEqual(t, liveData["password"], predictedData["password"], "changed values must have different placeholders")
+}
+
+func TestServerSideDiff_RequiresSyncPermission(t *testing.T) {
+	ctx := t.Context()
+	//nolint:staticcheck
+	ctx = context.WithValue(ctx, "claims", &jwt.RegisteredClaims{Subject: "test-user"})
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
+	appServer.enf.SetDefaultRole("")
+	_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, get, default/test-app, allow
+`)
+
+	_, err := appServer.ServerSideDiff(ctx, &application.ApplicationServerSideDiffQuery{AppName: &testApp.Name, AppNamespace: &testApp.Namespace})
+	assert.Equal(t, codes.PermissionDenied.String(), status.Code(err).String())
+}
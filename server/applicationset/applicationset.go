@@ -3,6 +3,7 @@ package applicationset
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
@@ -19,6 +20,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
@@ -29,7 +31,9 @@ import (
 	"github.com/argoproj/argo-cd/v3/applicationset/services"
 	appsetstatus "github.com/argoproj/argo-cd/v3/applicationset/status"
 	appsetutils "github.com/argoproj/argo-cd/v3/applicationset/utils"
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
 	applisters "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
@@ -358,6 +362,68 @@ func (s *Server) ResourceTree(ctx context.Context, q *applicationset.Application
 	return s.buildApplicationSetTree(a)
 }
 
+// Pause excludes generated Applications from the reconciliation of the ApplicationSet, without deleting them
+func (s *Server) Pause(ctx context.Context, q *applicationset.ApplicationSetPauseRequest) (*applicationset.ApplicationSetResponse, error) {
+	return s.setApplicationsPaused(ctx, q, true)
+}
+
+// Resume resumes the reconciliation of paused generated Applications
+func (s *Server) Resume(ctx context.Context, q *applicationset.ApplicationSetPauseRequest) (*applicationset.ApplicationSetResponse, error) {
+	return s.setApplicationsPaused(ctx, q, false)
+}
+
+func (s *Server) setApplicationsPaused(ctx context.Context, q *applicationset.ApplicationSetPauseRequest, paused bool) (*applicationset.ApplicationSetResponse, error) {
+	namespace := s.appsetNamespaceOrDefault(q.AppsetNamespace)
+
+	if !s.isNamespaceEnabled(namespace) {
+		return nil, security.NamespaceNotPermittedError(namespace)
+	}
+	if len(q.Applications) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one application is required")
+	}
+
+	appset, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(namespace).Get(ctx, q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting ApplicationSet: %w", err)
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplicationSets, rbac.ActionUpdate, appset.RBACName(s.ns)); err != nil {
+		return nil, err
+	}
+
+	var pausedValue any
+	action := "resumed"
+	if paused {
+		pausedValue = "true"
+		action = "paused"
+	}
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]any{common.AnnotationApplicationSetPaused: pausedValue},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling the patch: %w", err)
+	}
+
+	appIf := s.appclientset.ArgoprojV1alpha1().Applications(namespace)
+	for _, appName := range q.Applications {
+		app, err := appIf.Get(ctx, appName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting Application: %w", err)
+		}
+		owner := metav1.GetControllerOf(app)
+		if owner == nil || owner.Kind != application.ApplicationSetKind || owner.Name != appset.Name {
+			return nil, status.Errorf(codes.InvalidArgument, "application %s is not generated by ApplicationSet %s", appName, appset.Name)
+		}
+		if _, err := appIf.Patch(ctx, appName, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			return nil, fmt.Errorf("error patching Application %s: %w", appName, err)
+		}
+		s.logAppSetEvent(ctx, appset, argo.EventReasonResourceUpdated, fmt.Sprintf("%s application %s", action, appName))
+	}
+
+	return &applicationset.ApplicationSetResponse{Project: appset.Spec.Template.Spec.Project, Applicationset: appset}, nil
+}
+
 func (s *Server) Generate(ctx context.Context, q *applicationset.ApplicationSetGenerateRequest) (*applicationset.ApplicationSetGenerateResponse, error) {
 	appset := q.GetApplicationSet()
 
@@ -14,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
 	k8scache "k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
@@ -505,6 +506,57 @@ func TestDeleteAppSet(t *testing.T) {
 	})
 }
 
+func TestPauseAppSetApplications(t *testing.T) {
+	appSet := newTestAppSet(func(appset *appsv1.ApplicationSet) {
+		appset.Name = "AppSet1"
+	})
+	generatedApp := &appsv1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app1",
+			Namespace: testNamespace,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "argoproj.io/v1alpha1",
+				Kind:       "ApplicationSet",
+				Name:       "AppSet1",
+				Controller: ptr.To(true),
+			}},
+		},
+	}
+	otherApp := &appsv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "app2", Namespace: testNamespace},
+	}
+
+	t.Run("Pause and resume a generated application", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, appSet, generatedApp, otherApp)
+
+		_, err := appSetServer.Pause(t.Context(), &applicationset.ApplicationSetPauseRequest{Name: "AppSet1", Applications: []string{"app1"}})
+		require.NoError(t, err)
+		app, err := appSetServer.appclientset.ArgoprojV1alpha1().Applications(testNamespace).Get(t.Context(), "app1", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "true", app.Annotations[common.AnnotationApplicationSetPaused])
+
+		_, err = appSetServer.Resume(t.Context(), &applicationset.ApplicationSetPauseRequest{Name: "AppSet1", Applications: []string{"app1"}})
+		require.NoError(t, err)
+		app, err = appSetServer.appclientset.ArgoprojV1alpha1().Applications(testNamespace).Get(t.Context(), "app1", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.NotContains(t, app.Annotations, common.AnnotationApplicationSetPaused)
+	})
+
+	t.Run("Application not generated by the application set", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, appSet, generatedApp, otherApp)
+
+		_, err := appSetServer.Pause(t.Context(), &applicationset.ApplicationSetPauseRequest{Name: "AppSet1", Applications: []string{"app2"}})
+		require.ErrorContains(t, err, "application app2 is not generated by ApplicationSet AppSet1")
+	})
+
+	t.Run("No application", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, appSet)
+
+		_, err := appSetServer.Pause(t.Context(), &applicationset.ApplicationSetPauseRequest{Name: "AppSet1"})
+		require.ErrorContains(t, err, "at least one application is required")
+	})
+}
+
 func TestUpdateAppSet(t *testing.T) {
 	appSet := newTestAppSet(func(appset *appsv1.ApplicationSet) {
 		appset.Annotations = map[string]string{
@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
@@ -146,6 +148,9 @@ func (s *Server) Create(ctx context.Context, q *cluster.ClusterCreateRequest) (*
 		return nil, fmt.Errorf("permission denied while creating cluster: %w", err)
 	}
 	c := q.Cluster
+	if err := validateSyncWindows(c); err != nil {
+		return nil, err
+	}
 	clusterRESTConfig, err := c.RESTConfig()
 	if err != nil {
 		return nil, fmt.Errorf("error getting REST config: %w", err)
@@ -167,6 +172,8 @@ func (s *Server) Create(ctx context.Context, q *cluster.ClusterCreateRequest) (*
 			return nil, status.Errorf(codes.Internal, "unable to check existing cluster details: %v", getErr)
 		}
 
+		// the maintenance is not set when adding a cluster, so it is kept when the cluster is upserted
+		c.Maintenance = existing.Maintenance
 		switch {
 		case existing.Equals(c):
 			clust = existing
@@ -291,6 +298,26 @@ var clusterFieldsByPath = map[string]func(updated *appv1.Cluster, existing *appv
 	"project": func(updated *appv1.Cluster, existing *appv1.Cluster) {
 		updated.Project = existing.Project
 	},
+	"maintenance": func(updated *appv1.Cluster, existing *appv1.Cluster) {
+		updated.Maintenance = existing.Maintenance
+	},
+	"syncWindows": func(updated *appv1.Cluster, existing *appv1.Cluster) {
+		updated.SyncWindows = existing.SyncWindows
+	},
+}
+
+// clusterFieldsWithoutConnection are the fields of a cluster which are updated without testing the connection to the
+// cluster, so that e.g. an unreachable cluster can be put into maintenance or have its sync windows edited
+var clusterFieldsWithoutConnection = sets.NewString("maintenance", "syncWindows")
+
+// validateSyncWindows returns an InvalidArgument error if one of the sync windows of the cluster is invalid
+func validateSyncWindows(c *appv1.Cluster) error {
+	for i, window := range c.SyncWindows {
+		if err := window.Validate(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid sync window %d: %v", i, err)
+		}
+	}
+	return nil
 }
 
 // Update updates a cluster
@@ -319,6 +346,16 @@ func (s *Server) Update(ctx context.Context, q *cluster.ClusterUpdateRequest) (*
 		}
 		q.Cluster = c
 	}
+	if err := validateSyncWindows(q.Cluster); err != nil {
+		return nil, err
+	}
+	if len(q.UpdatedFields) != 0 && clusterFieldsWithoutConnection.HasAll(q.UpdatedFields...) {
+		clust, err := s.db.UpdateCluster(ctx, q.Cluster)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update cluster in database: %w", err)
+		}
+		return s.toAPIResponse(clust), nil
+	}
 	clusterRESTConfig, err := q.Cluster.RESTConfig()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get REST config for cluster: %w", err)
@@ -476,6 +513,10 @@ func (s *Server) toAPIResponse(clust *appv1.Cluster) *appv1.Cluster {
 	clust.Config.Password = ""
 	clust.Config.BearerToken = ""
 	clust.Config.KeyData = nil
+	clust.Config.BootstrapToken = ""
+	if clust.Config.SSHTunnelConfig != nil {
+		clust.Config.SSHTunnelConfig.PrivateKey = ""
+	}
 	if clust.Config.ExecProviderConfig != nil {
 		// We can't know what the user has put into args or
 		// env vars on the exec provider that might be sensitive
@@ -506,3 +547,28 @@ func (s *Server) InvalidateCache(ctx context.Context, q *cluster.ClusterQuery) (
 	}
 	return s.toAPIResponse(cls), nil
 }
+
+// ValidateSecret checks a declarative cluster secret for problems which prevent Argo CD from using it
+func (s *Server) ValidateSecret(ctx context.Context, q *cluster.ClusterSecretValidateRequest) (*cluster.ClusterSecretValidateResponse, error) {
+	if q.Secret == nil {
+		return nil, status.Error(codes.InvalidArgument, "secret is required")
+	}
+	// the secret may be invalid, so the RBAC object is built from the raw data
+	project, server := secretDataValue(q.Secret, "project"), strings.TrimRight(secretDataValue(q.Secret, "server"), "/")
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceClusters, rbac.ActionCreate, CreateClusterRBACObject(project, server)); err != nil {
+		return nil, fmt.Errorf("permission denied while validating cluster secret: %w", err)
+	}
+	problems, err := s.db.ValidateClusterSecret(ctx, q.Secret, q.CheckConnection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate cluster secret: %w", err)
+	}
+	return &cluster.ClusterSecretValidateResponse{Problems: problems}, nil
+}
+
+// secretDataValue returns the value of the key from the data or the string data of the secret
+func secretDataValue(secret *corev1.Secret, key string) string {
+	if value, ok := secret.StringData[key]; ok {
+		return value
+	}
+	return string(secret.Data[key])
+}
@@ -3,6 +3,7 @@ package cluster
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
@@ -16,10 +17,13 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
 	"k8s.io/utils/ptr"
 
 	"github.com/argoproj/argo-cd/v3/common"
@@ -452,6 +456,126 @@ func TestUpdateCluster_FieldsPathSet(t *testing.T) {
 	assert.Equal(t, "minikube", updated.Name)
 	assert.Equal(t, []string{"default", "kube-system"}, updated.Namespaces)
 	assert.Equal(t, "new-project", updated.Project)
+
+	_, err = server.Update(t.Context(), &cluster.ClusterUpdateRequest{
+		Cluster: &v1alpha1.Cluster{
+			Server:      "https://127.0.0.1",
+			Maintenance: true,
+		},
+		UpdatedFields: []string{"maintenance"},
+	})
+
+	require.NoError(t, err)
+
+	assert.Equal(t, "minikube", updated.Name)
+	assert.Equal(t, []string{"default", "kube-system"}, updated.Namespaces)
+	assert.True(t, updated.Maintenance)
+
+	syncWindows := v1alpha1.SyncWindows{{Kind: "deny", Schedule: "0 22 * * *", Duration: "8h", TimeZone: "UTC"}}
+	_, err = server.Update(t.Context(), &cluster.ClusterUpdateRequest{
+		Cluster: &v1alpha1.Cluster{
+			Server:      "https://127.0.0.1",
+			SyncWindows: syncWindows,
+		},
+		UpdatedFields: []string{"syncWindows"},
+	})
+
+	require.NoError(t, err)
+
+	assert.Equal(t, "minikube", updated.Name)
+	assert.Equal(t, syncWindows, updated.SyncWindows)
+
+	_, err = server.Update(t.Context(), &cluster.ClusterUpdateRequest{
+		Cluster: &v1alpha1.Cluster{
+			Server:      "https://127.0.0.1",
+			SyncWindows: v1alpha1.SyncWindows{{Kind: "deny", Schedule: "invalid", Duration: "8h"}},
+		},
+		UpdatedFields: []string{"syncWindows"},
+	})
+
+	require.ErrorContains(t, err, "invalid sync window 0: cannot parse schedule 'invalid'")
+}
+
+type unreachableKubectl struct {
+	kubetest.MockKubectlCmd
+}
+
+func (k *unreachableKubectl) GetServerVersion(_ *rest.Config) (string, error) {
+	return "", errors.New("connection refused")
+}
+
+func TestUpdateCluster_Unreachable(t *testing.T) {
+	db := &dbmocks.ArgoDB{}
+	var updated *v1alpha1.Cluster
+	db.On("GetCluster", mock.Anything, "https://127.0.0.1").Return(&v1alpha1.Cluster{
+		Name:   "minikube",
+		Server: "https://127.0.0.1",
+	}, nil)
+	db.On("UpdateCluster", mock.Anything, mock.MatchedBy(func(c *v1alpha1.Cluster) bool {
+		updated = c
+		return true
+	})).Return(&v1alpha1.Cluster{}, nil)
+
+	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &unreachableKubectl{})
+
+	_, err := server.Update(t.Context(), &cluster.ClusterUpdateRequest{
+		Cluster: &v1alpha1.Cluster{
+			Server:      "https://127.0.0.1",
+			Maintenance: true,
+		},
+		UpdatedFields: []string{"maintenance"},
+	})
+	require.NoError(t, err)
+	assert.True(t, updated.Maintenance)
+
+	syncWindows := v1alpha1.SyncWindows{{Kind: "deny", Schedule: "0 22 * * *", Duration: "8h", TimeZone: "UTC"}}
+	_, err = server.Update(t.Context(), &cluster.ClusterUpdateRequest{
+		Cluster: &v1alpha1.Cluster{
+			Server:      "https://127.0.0.1",
+			SyncWindows: syncWindows,
+		},
+		UpdatedFields: []string{"syncWindows"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, syncWindows, updated.SyncWindows)
+
+	_, err = server.Update(t.Context(), &cluster.ClusterUpdateRequest{
+		Cluster: &v1alpha1.Cluster{
+			Server: "https://127.0.0.1",
+			Labels: map[string]string{"env": "qa"},
+		},
+		UpdatedFields: []string{"labels"},
+	})
+	require.ErrorContains(t, err, "failed to get server version: connection refused")
+}
+
+func TestCreateCluster_UpsertKeepsMaintenance(t *testing.T) {
+	db := &dbmocks.ArgoDB{}
+	var updated *v1alpha1.Cluster
+	db.On("CreateCluster", mock.Anything, mock.Anything).Return(nil, status.Errorf(codes.AlreadyExists, "cluster already exists"))
+	db.On("GetCluster", mock.Anything, "https://127.0.0.1").Return(&v1alpha1.Cluster{
+		Name:        "minikube",
+		Server:      "https://127.0.0.1",
+		Maintenance: true,
+	}, nil)
+	db.On("UpdateCluster", mock.Anything, mock.MatchedBy(func(c *v1alpha1.Cluster) bool {
+		updated = c
+		return true
+	})).Return(&v1alpha1.Cluster{}, nil)
+
+	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+
+	_, err := server.Create(t.Context(), &cluster.ClusterCreateRequest{
+		Cluster: &v1alpha1.Cluster{
+			Name:       "minikube",
+			Server:     "https://127.0.0.1",
+			Namespaces: []string{"default"},
+		},
+		Upsert: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"default"}, updated.Namespaces)
+	assert.True(t, updated.Maintenance)
 }
 
 func TestDeleteClusterByName(t *testing.T) {
@@ -854,3 +978,32 @@ func TestNoClusterEnumeration(t *testing.T) {
 		assert.ErrorIs(t, err, common.PermissionDeniedAPIError, "error message must be _only_ the permission error, to avoid leaking information about cluster existence")
 	})
 }
+
+func TestValidateSecret(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+		StringData: map[string]string{"server": "https://127.0.0.1/", "config": "{"},
+	}
+
+	t.Run("Problems", func(t *testing.T) {
+		db := &dbmocks.ArgoDB{}
+		db.On("ValidateClusterSecret", mock.Anything, secret, true).Return([]string{"malformed config"}, nil)
+		server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+
+		resp, err := server.ValidateSecret(t.Context(), &cluster.ClusterSecretValidateRequest{Secret: secret, CheckConnection: true})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"malformed config"}, resp.Problems)
+	})
+
+	t.Run("Missing secret", func(t *testing.T) {
+		server := NewServer(&dbmocks.ArgoDB{}, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+		_, err := server.ValidateSecret(t.Context(), &cluster.ClusterSecretValidateRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Permission denied", func(t *testing.T) {
+		server := NewServer(&dbmocks.ArgoDB{}, newEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+		_, err := server.ValidateSecret(t.Context(), &cluster.ClusterSecretValidateRequest{Secret: secret})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
@@ -2,22 +2,72 @@ package notification
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
 
 	"github.com/argoproj/notifications-engine/pkg/api"
+	"github.com/argoproj/notifications-engine/pkg/services"
+	"github.com/argoproj/notifications-engine/pkg/subscriptions"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 
+	argocommon "github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/notification"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
+	applisters "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/notification/delivery"
+	"github.com/argoproj/argo-cd/v3/util/notification/settings"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/argoproj/argo-cd/v3/util/security"
+	"github.com/argoproj/argo-cd/v3/util/session"
+)
+
+const (
+	// subscriptionSourceDefault is the source of the default subscriptions of the notifications ConfigMap
+	subscriptionSourceDefault = "Default"
 )
 
 // Server provides an Application service
 type Server struct {
-	apiFactory api.Factory
+	apiFactory        api.Factory
+	appclientset      appclientset.Interface
+	appLister         applisters.ApplicationLister
+	projLister        applisters.AppProjectNamespaceLister
+	enf               *rbac.Enforcer
+	ns                string
+	enabledNamespaces []string
 }
 
 // NewServer returns a new instance of the Application service
-func NewServer(apiFactory api.Factory) notification.NotificationServiceServer {
-	s := &Server{apiFactory: apiFactory}
+func NewServer(
+	apiFactory api.Factory,
+	appclientset appclientset.Interface,
+	appLister applisters.ApplicationLister,
+	projLister applisters.AppProjectNamespaceLister,
+	enf *rbac.Enforcer,
+	namespace string,
+	enabledNamespaces []string,
+) notification.NotificationServiceServer {
+	s := &Server{
+		apiFactory:        apiFactory,
+		appclientset:      appclientset,
+		appLister:         appLister,
+		projLister:        projLister,
+		enf:               enf,
+		ns:                namespace,
+		enabledNamespaces: enabledNamespaces,
+	}
 	return s
 }
 
@@ -67,3 +117,269 @@ func (s *Server) ListTemplates(_ context.Context, _ *notification.TemplatesListR
 	}
 	return &notification.TemplateList{Items: templates}, nil
 }
+
+// ListApplicationSubscriptions returns the subscriptions of the application, of its project and the default
+// subscriptions matching its labels
+func (s *Server) ListApplicationSubscriptions(ctx context.Context, q *notification.ApplicationSubscriptionsRequest) (*notification.SubscriptionList, error) {
+	a, err := s.getAppEnforceRBAC(ctx, rbac.ActionGet, q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := s.getConfig()
+	if err != nil {
+		return nil, err
+	}
+	return s.getSubscriptions(a, cfg), nil
+}
+
+// SubscribeApplication adds the recipients to the subscription annotation of the trigger and service of the application
+func (s *Server) SubscribeApplication(ctx context.Context, q *notification.ApplicationSubscriptionRequest) (*notification.SubscriptionList, error) {
+	a, err := s.getAppEnforceRBAC(ctx, rbac.ActionUpdate, q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := s.getConfig()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := cfg.Triggers[q.GetTrigger()]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "trigger '%s' is not configured", q.GetTrigger())
+	}
+	// the service is not validated since the services of Argo CD are not part of the configuration of the
+	// notifications engine
+	if q.GetService() == "" {
+		return nil, status.Error(codes.InvalidArgument, "service is required")
+	}
+	if len(q.Recipients) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one recipient is required")
+	}
+
+	a, err = s.patchSubscription(ctx, a, q.GetTrigger(), q.GetService(), func(recipients []string) ([]string, error) {
+		for _, recipient := range q.Recipients {
+			if !slices.Contains(recipients, recipient) {
+				recipients = append(recipients, recipient)
+			}
+		}
+		return recipients, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.logEvent(ctx, a, fmt.Sprintf("subscribed %s to the %s notifications of %s", strings.Join(q.Recipients, ", "), q.GetService(), q.GetTrigger()))
+	return s.getSubscriptions(a, cfg), nil
+}
+
+// UnsubscribeApplication removes the recipients from the subscription annotation of the trigger and service of the
+// application. All the recipients are removed if none is given.
+func (s *Server) UnsubscribeApplication(ctx context.Context, q *notification.ApplicationSubscriptionRequest) (*notification.SubscriptionList, error) {
+	a, err := s.getAppEnforceRBAC(ctx, rbac.ActionUpdate, q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := s.getConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	a, err = s.patchSubscription(ctx, a, q.GetTrigger(), q.GetService(), func(recipients []string) ([]string, error) {
+		if len(recipients) == 0 {
+			return nil, status.Errorf(codes.NotFound, "application is not subscribed to the %s notifications of %s", q.GetService(), q.GetTrigger())
+		}
+		if len(q.Recipients) == 0 {
+			return nil, nil
+		}
+		return slices.DeleteFunc(recipients, func(recipient string) bool {
+			return slices.Contains(q.Recipients, recipient)
+		}), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.logEvent(ctx, a, fmt.Sprintf("unsubscribed from the %s notifications of %s", q.GetService(), q.GetTrigger()))
+	return s.getSubscriptions(a, cfg), nil
+}
+
+// ListApplicationDeliveries returns the status of the delivery of the latest notifications about the application, as
+// recorded by the notifications controller
+func (s *Server) ListApplicationDeliveries(ctx context.Context, q *notification.ApplicationDeliveriesRequest) (*notification.NotificationDeliveryList, error) {
+	a, err := s.getAppEnforceRBAC(ctx, rbac.ActionGet, q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	records, err := delivery.GetRecords(a.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	list := &notification.NotificationDeliveryList{Items: []*notification.NotificationDelivery{}}
+	for _, record := range records {
+		list.Items = append(list.Items, &notification.NotificationDelivery{
+			Id:         ptr.To(record.ID),
+			Templates:  record.Templates,
+			Service:    ptr.To(record.Service),
+			Recipient:  ptr.To(record.Recipient),
+			Status:     ptr.To(record.Status),
+			Attempts:   ptr.To(int32(record.Attempts)),
+			HttpStatus: ptr.To(int32(record.HTTPStatus)),
+			Message:    ptr.To(record.Message),
+			Time:       ptr.To(record.Time.Unix()),
+		})
+	}
+	return list, nil
+}
+
+// TestApplicationNotification sends the notification rendered with the given template for the application to the
+// recipients, regardless of the triggers and subscriptions
+func (s *Server) TestApplicationNotification(ctx context.Context, q *notification.ApplicationNotificationTestRequest) (*notification.ApplicationNotificationTestResponse, error) {
+	a, err := s.getAppEnforceRBAC(ctx, rbac.ActionUpdate, q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	notificationAPI, err := s.apiFactory.GetAPI()
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, status.Error(codes.FailedPrecondition, "notifications are not configured")
+		}
+		return nil, err
+	}
+	cfg := notificationAPI.GetConfig()
+	if _, ok := cfg.Templates[q.GetTemplate()]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "template '%s' is not configured", q.GetTemplate())
+	}
+	if _, ok := cfg.Services[q.GetService()]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "service '%s' is not configured", q.GetService())
+	}
+	if len(q.Recipients) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one recipient is required")
+	}
+
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(a)
+	if err != nil {
+		return nil, fmt.Errorf("error converting application: %w", err)
+	}
+	obj["apiVersion"] = v1alpha1.SchemeGroupVersion.String()
+	obj["kind"] = application.ApplicationKind
+	for _, recipient := range q.Recipients {
+		if err := notificationAPI.Send(obj, []string{q.GetTemplate()}, services.Destination{Service: q.GetService(), Recipient: recipient}); err != nil {
+			return nil, status.Errorf(codes.Unavailable, "failed to send the notification to %s: %v", recipient, err)
+		}
+	}
+	s.logEvent(ctx, a, fmt.Sprintf("sent a test notification with template %s to the %s recipients %s", q.GetTemplate(), q.GetService(), strings.Join(q.Recipients, ", ")))
+	return &notification.ApplicationNotificationTestResponse{}, nil
+}
+
+// getAppEnforceRBAC returns the application if the user is allowed to perform the action on it. Permission denied is
+// returned when the application does not exist so that its existence is not leaked.
+func (s *Server) getAppEnforceRBAC(ctx context.Context, action, namespace, name string) (*v1alpha1.Application, error) {
+	if namespace == "" {
+		namespace = s.ns
+	}
+	if !security.IsNamespaceEnabled(namespace, s.ns, s.enabledNamespaces) {
+		return nil, security.NamespaceNotPermittedError(namespace)
+	}
+	a, err := s.appLister.Applications(namespace).Get(name)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			log.Errorf("failed to get application %s/%s: %v", namespace, name, err)
+		}
+		return nil, argocommon.PermissionDeniedAPIError
+	}
+	obj := rbac.Object{Name: a.RBACName(s.ns), Labels: a.Labels}
+	if proj, err := s.projLister.Get(a.Spec.GetProject()); err == nil {
+		obj.ProjectLabels = proj.Labels
+	}
+	if !s.enf.Enforce(ctx.Value("claims"), rbac.ResourceApplications, action, obj) {
+		return nil, argocommon.PermissionDeniedAPIError
+	}
+	return a, nil
+}
+
+func (s *Server) getConfig() (api.Config, error) {
+	notificationAPI, err := s.apiFactory.GetAPI()
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return api.Config{}, nil
+		}
+		return api.Config{}, err
+	}
+	return notificationAPI.GetConfig(), nil
+}
+
+// patchSubscription updates the recipients of the subscription annotation of the trigger and service of the
+// application, the annotation is removed if there is no recipient left. The recipients are read from the live
+// application rather than from the informer cache which might not include the latest updates yet.
+func (s *Server) patchSubscription(ctx context.Context, a *v1alpha1.Application, trigger, service string, update func(recipients []string) ([]string, error)) (*v1alpha1.Application, error) {
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(a.Namespace).Get(ctx, a.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting application: %w", err)
+	}
+	key := subscriptions.SubscribeAnnotationKey(trigger, service)
+	recipients, err := update(parseRecipients(a.Annotations[key]))
+	if err != nil {
+		return nil, err
+	}
+	var value any
+	if len(recipients) > 0 {
+		value = strings.Join(recipients, ";")
+	}
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{"annotations": map[string]any{key: value}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling subscription patch: %w", err)
+	}
+	a, err = s.appclientset.ArgoprojV1alpha1().Applications(a.Namespace).Patch(ctx, a.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error patching application subscriptions: %w", err)
+	}
+	return a, nil
+}
+
+// getSubscriptions returns the subscriptions which apply to the application, in the order they are merged by the
+// notifications controller
+func (s *Server) getSubscriptions(a *v1alpha1.Application, cfg api.Config) *notification.SubscriptionList {
+	list := &notification.SubscriptionList{Items: []*notification.Subscription{}}
+	add := func(destinations services.Destinations, source string) {
+		triggers := make([]string, 0, len(destinations))
+		for trigger := range destinations {
+			triggers = append(triggers, trigger)
+		}
+		sort.Strings(triggers)
+		for _, trigger := range triggers {
+			for _, dest := range destinations[trigger] {
+				list.Items = append(list.Items, &notification.Subscription{
+					Trigger:   ptr.To(trigger),
+					Service:   ptr.To(dest.Service),
+					Recipient: ptr.To(dest.Recipient),
+					Source:    ptr.To(source),
+				})
+			}
+		}
+	}
+
+	add(subscriptions.NewAnnotations(a.Annotations).GetDestinations(cfg.DefaultTriggers, cfg.ServiceDefaultTriggers), application.ApplicationKind)
+	if proj, err := s.projLister.Get(a.Spec.GetProject()); err == nil {
+		destinations := subscriptions.NewAnnotations(proj.Annotations).GetDestinations(cfg.DefaultTriggers, cfg.ServiceDefaultTriggers)
+		destinations.Merge(settings.GetLegacyDestinations(proj.Annotations, cfg.DefaultTriggers, cfg.ServiceDefaultTriggers))
+		add(destinations, application.AppProjectKind)
+	}
+	add(cfg.GetGlobalDestinations(a.Labels), subscriptionSourceDefault)
+	return list
+}
+
+func (s *Server) logEvent(ctx context.Context, a *v1alpha1.Application, action string) {
+	user := session.Username(ctx)
+	if user == "" {
+		user = "Unknown user"
+	}
+	log.WithFields(log.Fields{"application": a.Name, "namespace": a.Namespace, "user": user}).Info(action)
+}
+
+func parseRecipients(v string) []string {
+	var recipients []string
+	for _, recipient := range strings.Split(v, ";") {
+		if recipient = strings.TrimSpace(recipient); recipient != "" {
+			recipients = append(recipients, recipient)
+		}
+	}
+	return recipients
+}
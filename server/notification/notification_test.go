@@ -1,20 +1,35 @@
 package notification
 
 import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
 	"github.com/argoproj/notifications-engine/pkg/api"
+	"github.com/argoproj/notifications-engine/pkg/services"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/notification"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
+	appinformer "github.com/argoproj/argo-cd/v3/pkg/client/informers/externalversions"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient/mocks"
+	"github.com/argoproj/argo-cd/v3/util/assets"
 	service "github.com/argoproj/argo-cd/v3/util/notification/argocd"
+	"github.com/argoproj/argo-cd/v3/util/notification/delivery"
 	"github.com/argoproj/argo-cd/v3/util/notification/k8s"
 	"github.com/argoproj/argo-cd/v3/util/notification/settings"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
@@ -66,13 +81,13 @@ func TestNotificationServer(t *testing.T) {
 	}
 	mockRepoClient := &mocks.Clientset{RepoServerServiceClient: &mocks.RepoServerServiceClient{}}
 
-	argocdService, err := service.NewArgoCDService(kubeclientset, testNamespace, mockRepoClient)
+	argocdService, err := service.NewArgoCDService(kubeclientset, appclientset.NewSimpleClientset(), testNamespace, mockRepoClient)
 	require.NoError(t, err)
 	defer argocdService.Close()
 	apiFactory := api.NewFactory(settings.GetFactorySettings(argocdService, "argocd-notifications-secret", "argocd-notifications-cm", false), testNamespace, secretInformer, configMapInformer)
 
 	t.Run("TestListServices", func(t *testing.T) {
-		server := NewServer(apiFactory)
+		server := NewServer(apiFactory, nil, nil, nil, nil, testNamespace, nil)
 		services, err := server.ListServices(ctx, &notification.ServicesListRequest{})
 		require.NoError(t, err)
 		assert.Len(t, services.Items, 1)
@@ -80,7 +95,7 @@ func TestNotificationServer(t *testing.T) {
 		assert.NotEmpty(t, services.Items[0])
 	})
 	t.Run("TestListTriggers", func(t *testing.T) {
-		server := NewServer(apiFactory)
+		server := NewServer(apiFactory, nil, nil, nil, nil, testNamespace, nil)
 		triggers, err := server.ListTriggers(ctx, &notification.TriggersListRequest{})
 		require.NoError(t, err)
 		assert.Len(t, triggers.Items, 1)
@@ -88,7 +103,7 @@ func TestNotificationServer(t *testing.T) {
 		assert.NotEmpty(t, triggers.Items[0])
 	})
 	t.Run("TestListTemplates", func(t *testing.T) {
-		server := NewServer(apiFactory)
+		server := NewServer(apiFactory, nil, nil, nil, nil, testNamespace, nil)
 		templates, err := server.ListTemplates(ctx, &notification.TemplatesListRequest{})
 		require.NoError(t, err)
 		assert.Len(t, templates.Items, 1)
@@ -96,3 +111,217 @@ func TestNotificationServer(t *testing.T) {
 		assert.NotEmpty(t, templates.Items[0])
 	})
 }
+
+func newTestSubscriptionServer(t *testing.T, defaultRole string, data map[string]string, objects ...runtime.Object) (*Server, *appclientset.Clientset) {
+	t.Helper()
+	ctx := t.Context()
+	kubeclientset := fake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "argocd-notifications-cm"},
+		Data:       data,
+	}, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "argocd-notifications-secret"},
+	}, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: common.ArgoCDRBACConfigMapName},
+	})
+	secretInformer := k8s.NewSecretInformer(kubeclientset, testNamespace, "argocd-notifications-secret")
+	configMapInformer := k8s.NewConfigMapInformer(kubeclientset, testNamespace, "argocd-notifications-cm")
+	go secretInformer.Run(ctx.Done())
+	go configMapInformer.Run(ctx.Done())
+	require.True(t, k8scache.WaitForCacheSync(ctx.Done(), secretInformer.HasSynced, configMapInformer.HasSynced))
+	apiFactory := api.NewFactory(api.Settings{
+		ConfigMapName: "argocd-notifications-cm",
+		SecretName:    "argocd-notifications-secret",
+		InitGetVars: func(_ *api.Config, _ *corev1.ConfigMap, _ *corev1.Secret) (api.GetVars, error) {
+			return func(obj map[string]any, _ services.Destination) map[string]any {
+				return map[string]any{"app": obj}
+			}, nil
+		},
+	}, testNamespace, secretInformer, configMapInformer)
+
+	appClientset := appclientset.NewSimpleClientset(objects...)
+	factory := appinformer.NewSharedInformerFactoryWithOptions(appClientset, 0, appinformer.WithNamespace(testNamespace))
+	appInformer := factory.Argoproj().V1alpha1().Applications().Informer()
+	projInformer := factory.Argoproj().V1alpha1().AppProjects().Informer()
+	go appInformer.Run(ctx.Done())
+	go projInformer.Run(ctx.Done())
+	require.True(t, k8scache.WaitForCacheSync(ctx.Done(), appInformer.HasSynced, projInformer.HasSynced))
+
+	enf := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
+	require.NoError(t, enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV))
+	enf.SetDefaultRole(defaultRole)
+
+	server := NewServer(
+		apiFactory,
+		appClientset,
+		factory.Argoproj().V1alpha1().Applications().Lister(),
+		factory.Argoproj().V1alpha1().AppProjects().Lister().AppProjects(testNamespace),
+		enf,
+		testNamespace,
+		nil,
+	)
+	return server.(*Server), appClientset
+}
+
+func newSubscriptionTestObjects() []runtime.Object {
+	return []runtime.Object{
+		&v1alpha1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-proj", Namespace: testNamespace, Annotations: map[string]string{
+				"notifications.argoproj.io/subscribe.on-sync-failed.slack": "proj-channel",
+			}},
+		},
+		&v1alpha1.ApplicationSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-appset", Namespace: testNamespace, Annotations: map[string]string{
+				"notifications.argoproj.io/subscribe.on-sync-succeeded.slack": "appset-channel",
+			}},
+		},
+		&v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "guestbook",
+				Namespace:       testNamespace,
+				Labels:          map[string]string{"team": "guestbook"},
+				OwnerReferences: []metav1.OwnerReference{{Kind: "ApplicationSet", Name: "my-appset"}},
+				Annotations: map[string]string{
+					"notifications.argoproj.io/subscribe.on-sync-failed.slack": "app-channel",
+				},
+			},
+			Spec: v1alpha1.ApplicationSpec{Project: "my-proj"},
+		},
+	}
+}
+
+var subscriptionTestConfig = map[string]string{
+	"service.slack":               "token: my-token",
+	"trigger.on-sync-failed":      "- when: app.status.operationState.phase == 'Failed'\n  send: [app-sync-failed]",
+	"trigger.on-sync-succeeded":   "- when: app.status.operationState.phase == 'Succeeded'\n  send: [app-sync-succeeded]",
+	"template.app-sync-failed":    "message: '{{.app.metadata.name}} sync failed'",
+	"template.app-sync-succeeded": "message: '{{.app.metadata.name}} sync succeeded'",
+	"subscriptions":               "- recipients: [slack:team-channel]\n  triggers: [on-sync-failed]\n  selector: team=guestbook",
+}
+
+func subscriptionStrings(list *notification.SubscriptionList) []string {
+	var items []string
+	for _, item := range list.Items {
+		items = append(items, fmt.Sprintf("%s:%s:%s:%s", item.GetSource(), item.GetTrigger(), item.GetService(), item.GetRecipient()))
+	}
+	return items
+}
+
+func TestListApplicationSubscriptions(t *testing.T) {
+	server, _ := newTestSubscriptionServer(t, "role:readonly", subscriptionTestConfig, newSubscriptionTestObjects()...)
+
+	list, err := server.ListApplicationSubscriptions(t.Context(), &notification.ApplicationSubscriptionsRequest{Name: ptr.To("guestbook")})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"Application:on-sync-failed:slack:app-channel",
+		"AppProject:on-sync-failed:slack:proj-channel",
+		"Default:on-sync-failed:slack:team-channel",
+	}, subscriptionStrings(list))
+
+	_, err = server.ListApplicationSubscriptions(t.Context(), &notification.ApplicationSubscriptionsRequest{Name: ptr.To("unknown")})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestSubscribeApplication(t *testing.T) {
+	server, appClientset := newTestSubscriptionServer(t, "role:admin", subscriptionTestConfig, newSubscriptionTestObjects()...)
+	getAnnotation := func() (string, bool) {
+		app, err := appClientset.ArgoprojV1alpha1().Applications(testNamespace).Get(t.Context(), "guestbook", metav1.GetOptions{})
+		require.NoError(t, err)
+		value, ok := app.Annotations["notifications.argoproj.io/subscribe.on-sync-failed.slack"]
+		return value, ok
+	}
+
+	_, err := server.SubscribeApplication(t.Context(), &notification.ApplicationSubscriptionRequest{
+		Name: ptr.To("guestbook"), Trigger: ptr.To("on-sync-failed"), Service: ptr.To("slack"), Recipients: []string{"app-channel", "other-channel"},
+	})
+	require.NoError(t, err)
+	value, _ := getAnnotation()
+	assert.Equal(t, "app-channel;other-channel", value)
+
+	list, err := server.UnsubscribeApplication(t.Context(), &notification.ApplicationSubscriptionRequest{
+		Name: ptr.To("guestbook"), Trigger: ptr.To("on-sync-failed"), Service: ptr.To("slack"), Recipients: []string{"app-channel"},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, subscriptionStrings(list), "Application:on-sync-failed:slack:other-channel")
+	value, _ = getAnnotation()
+	assert.Equal(t, "other-channel", value)
+
+	_, err = server.UnsubscribeApplication(t.Context(), &notification.ApplicationSubscriptionRequest{
+		Name: ptr.To("guestbook"), Trigger: ptr.To("on-sync-failed"), Service: ptr.To("slack"),
+	})
+	require.NoError(t, err)
+	_, ok := getAnnotation()
+	assert.False(t, ok)
+
+	_, err = server.SubscribeApplication(t.Context(), &notification.ApplicationSubscriptionRequest{
+		Name: ptr.To("guestbook"), Trigger: ptr.To("on-unknown"), Service: ptr.To("slack"), Recipients: []string{"app-channel"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.SubscribeApplication(t.Context(), &notification.ApplicationSubscriptionRequest{
+		Name: ptr.To("guestbook"), Trigger: ptr.To("on-sync-failed"), Service: ptr.To("slack"),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSubscribeApplicationPermissionDenied(t *testing.T) {
+	server, _ := newTestSubscriptionServer(t, "role:readonly", subscriptionTestConfig, newSubscriptionTestObjects()...)
+
+	_, err := server.SubscribeApplication(t.Context(), &notification.ApplicationSubscriptionRequest{
+		Name: ptr.To("guestbook"), Trigger: ptr.To("on-sync-failed"), Service: ptr.To("slack"), Recipients: []string{"other-channel"},
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = server.TestApplicationNotification(t.Context(), &notification.ApplicationNotificationTestRequest{
+		Name: ptr.To("guestbook"), Template: ptr.To("app-sync-failed"), Service: ptr.To("slack"), Recipients: []string{"other-channel"},
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestTestApplicationNotification(t *testing.T) {
+	var bodies []string
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		bodies = append(bodies, string(body))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer webhook.Close()
+
+	server, _ := newTestSubscriptionServer(t, "role:admin", map[string]string{
+		"service.webhook.test":     "url: " + webhook.URL,
+		"template.app-sync-failed": "webhook:\n  test:\n    method: POST\n    body: '{{.app.metadata.name}} of {{.app.kind}} sync failed'",
+	}, newSubscriptionTestObjects()...)
+
+	_, err := server.TestApplicationNotification(t.Context(), &notification.ApplicationNotificationTestRequest{
+		Name: ptr.To("guestbook"), Template: ptr.To("app-sync-failed"), Service: ptr.To("test"), Recipients: []string{"ignored"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"guestbook of Application sync failed"}, bodies)
+
+	_, err = server.TestApplicationNotification(t.Context(), &notification.ApplicationNotificationTestRequest{
+		Name: ptr.To("guestbook"), Template: ptr.To("unknown"), Service: ptr.To("test"), Recipients: []string{"ignored"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListApplicationDeliveries(t *testing.T) {
+	objects := newSubscriptionTestObjects()
+	objects[2].(*v1alpha1.Application).Annotations[delivery.AnnotationKey] = `[{"id":"1","templates":["app-sync-failed"],"service":"slack","recipient":"app-channel","status":"Retrying","attempts":1,"httpStatus":503,"message":"unavailable","time":"2025-01-01T00:00:00Z"}]`
+	server, _ := newTestSubscriptionServer(t, "role:readonly", subscriptionTestConfig, objects...)
+
+	list, err := server.ListApplicationDeliveries(t.Context(), &notification.ApplicationDeliveriesRequest{Name: ptr.To("guestbook")})
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, &notification.NotificationDelivery{
+		Id:         ptr.To("1"),
+		Templates:  []string{"app-sync-failed"},
+		Service:    ptr.To("slack"),
+		Recipient:  ptr.To("app-channel"),
+		Status:     ptr.To("Retrying"),
+		Attempts:   ptr.To(int32(1)),
+		HttpStatus: ptr.To(int32(503)),
+		Message:    ptr.To("unavailable"),
+		Time:       ptr.To(int64(1735689600)),
+	}, list.Items[0])
+
+	_, err = server.ListApplicationDeliveries(t.Context(), &notification.ApplicationDeliveriesRequest{Name: ptr.To("unknown")})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
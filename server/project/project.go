@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
@@ -17,6 +18,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/retry"
@@ -52,18 +54,19 @@ type Server struct {
 	projectLock   sync.KeyLock
 	sessionMgr    *session.SessionManager
 	projInformer  cache.SharedIndexInformer
+	appLister     listersv1alpha1.ApplicationLister
 	settingsMgr   *settings.SettingsManager
 	db            db.ArgoDB
 }
 
 // NewServer returns a new instance of the Project service
 func NewServer(ns string, kubeclientset kubernetes.Interface, appclientset appclientset.Interface, enf *rbac.Enforcer, projectLock sync.KeyLock, sessionMgr *session.SessionManager, policyEnf *rbacpolicy.RBACPolicyEnforcer,
-	projInformer cache.SharedIndexInformer, settingsMgr *settings.SettingsManager, db db.ArgoDB, enableK8sEvent []string,
+	projInformer cache.SharedIndexInformer, appLister listersv1alpha1.ApplicationLister, settingsMgr *settings.SettingsManager, db db.ArgoDB, enableK8sEvent []string,
 ) *Server {
 	auditLogger := argo.NewAuditLogger(ns, kubeclientset, "argocd-server", enableK8sEvent)
 	return &Server{
 		enf: enf, policyEnf: policyEnf, appclientset: appclientset, kubeclientset: kubeclientset, ns: ns, projectLock: projectLock, auditLogger: auditLogger, sessionMgr: sessionMgr,
-		projInformer: projInformer, settingsMgr: settingsMgr, db: db,
+		projInformer: projInformer, appLister: appLister, settingsMgr: settingsMgr, db: db,
 	}
 }
 
@@ -79,6 +82,23 @@ func validateProject(proj *v1alpha1.AppProject) error {
 	return nil
 }
 
+// validateParentProject checks that the parent projects of the project exist and don't form a cycle, and that the user
+// can update the parent project when it is changed, since the project inherits its permissions
+func (s *Server) validateParentProject(ctx context.Context, proj *v1alpha1.AppProject, oldParentProject string) error {
+	if proj.Spec.ParentProject == "" {
+		return nil
+	}
+	if proj.Spec.ParentProject != oldParentProject {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceProjects, rbac.ActionUpdate, proj.Spec.ParentProject); err != nil {
+			return err
+		}
+	}
+	if _, err := proj.WithParentProjects(listersv1alpha1.NewAppProjectLister(s.projInformer.GetIndexer()).AppProjects(s.ns).Get); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid parent project: %s", err.Error())
+	}
+	return nil
+}
+
 // CreateToken creates a new token to access a project
 func (s *Server) CreateToken(ctx context.Context, q *project.ProjectTokenCreateRequest) (*project.ProjectTokenResponse, error) {
 	var resp *project.ProjectTokenResponse
@@ -120,8 +140,16 @@ func (s *Server) createToken(ctx context.Context, q *project.ProjectTokenCreateR
 		uniqueId, _ := uuid.NewRandom()
 		id = uniqueId.String()
 	}
+	argoSettings, err := s.settingsMgr.GetSettings()
+	if err != nil {
+		return nil, fmt.Errorf("error getting settings: %w", err)
+	}
+	expiresIn, err := settings.GetTokenExpiresIn(q.ExpiresIn, argoSettings.ProjectTokenMaxTTL)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	subject := fmt.Sprintf(JWTTokenSubFormat, q.Project, q.Role)
-	jwtToken, err := s.sessionMgr.Create(subject, q.ExpiresIn, id)
+	jwtToken, err := s.sessionMgr.Create(subject, expiresIn, id)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
@@ -259,6 +287,9 @@ func (s *Server) Create(ctx context.Context, q *project.ProjectCreateRequest) (*
 	if err != nil {
 		return nil, fmt.Errorf("error validating project: %w", err)
 	}
+	if err := s.validateParentProject(ctx, q.Project, ""); err != nil {
+		return nil, err
+	}
 	res, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Create(ctx, q.Project, metav1.CreateOptions{})
 	if apierrors.IsAlreadyExists(err) {
 		existing, getErr := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, q.Project.Name, metav1.GetOptions{})
@@ -283,6 +314,50 @@ func (s *Server) Create(ctx context.Context, q *project.ProjectCreateRequest) (*
 	return res, err
 }
 
+// CreateFromTemplate creates a new project from a project template
+func (s *Server) CreateFromTemplate(ctx context.Context, q *project.ProjectCreateFromTemplateRequest) (*v1alpha1.AppProject, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceProjects, rbac.ActionCreate, q.Name); err != nil {
+		return nil, err
+	}
+	projectTemplates, err := s.settingsMgr.GetProjectTemplates()
+	if err != nil {
+		return nil, fmt.Errorf("error getting project templates: %w", err)
+	}
+	idx := slices.IndexFunc(projectTemplates, func(t settings.ProjectTemplate) bool {
+		return t.Name == q.Template
+	})
+	if idx < 0 {
+		return nil, status.Errorf(codes.NotFound, "project template '%s' not found", q.Template)
+	}
+	proj, err := projectTemplates[idx].Render(q.Name, q.Parameters)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return s.Create(ctx, &project.ProjectCreateRequest{Project: proj, Upsert: q.Upsert})
+}
+
+// ListTemplates returns the project templates
+func (s *Server) ListTemplates(_ context.Context, _ *project.ProjectTemplatesQuery) (*project.ProjectTemplatesResponse, error) {
+	projectTemplates, err := s.settingsMgr.GetProjectTemplates()
+	if err != nil {
+		return nil, fmt.Errorf("error getting project templates: %w", err)
+	}
+	res := &project.ProjectTemplatesResponse{}
+	for _, t := range projectTemplates {
+		item := &project.ProjectTemplate{Name: t.Name, Description: t.Description}
+		for _, param := range t.Parameters {
+			item.Parameters = append(item.Parameters, &project.ProjectTemplateParameter{
+				Name:        param.Name,
+				Description: param.Description,
+				Default:     param.Default,
+				Required:    param.Required,
+			})
+		}
+		res.Items = append(res.Items, item)
+	}
+	return res, nil
+}
+
 // List returns list of projects
 func (s *Server) List(ctx context.Context, _ *project.ProjectQuery) (*v1alpha1.AppProjectList, error) {
 	list, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).List(ctx, metav1.ListOptions{})
@@ -311,11 +386,21 @@ func (s *Server) GetDetailedProject(ctx context.Context, q *project.ProjectQuery
 	proj.NormalizeJWTTokens()
 	globalProjects := argo.GetGlobalProjects(proj, listersv1alpha1.NewAppProjectLister(s.projInformer.GetIndexer()), s.settingsMgr)
 
+	var quotaUsage *v1alpha1.ProjectQuotaUsage
+	if proj.Spec.Quota != nil {
+		apps, err := s.appLister.List(labels.Everything())
+		if err != nil {
+			return nil, fmt.Errorf("error listing applications: %w", err)
+		}
+		quotaUsage = proj.GetQuotaUsage(apps)
+	}
+
 	return &project.DetailedProjectsResponse{
 		GlobalProjects: globalProjects,
 		Project:        proj,
 		Repositories:   repositories,
 		Clusters:       clusters,
+		QuotaUsage:     quotaUsage,
 	}, err
 }
 
@@ -368,6 +453,10 @@ func (s *Server) Update(ctx context.Context, q *project.ProjectUpdateRequest) (*
 		return nil, err
 	}
 
+	if err := s.validateParentProject(ctx, q.Project, oldProj.Spec.ParentProject); err != nil {
+		return nil, err
+	}
+
 	for _, cluster := range difference(q.Project.Spec.DestinationClusters(), oldProj.Spec.DestinationClusters()) {
 		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceClusters, rbac.ActionUpdate, cluster); err != nil {
 			return nil, err
@@ -475,6 +564,19 @@ func (s *Server) Delete(ctx context.Context, q *project.ProjectQuery) (*project.
 	if len(apps) > 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "project is referenced by %d applications", len(apps))
 	}
+	projects, err := listersv1alpha1.NewAppProjectLister(s.projInformer.GetIndexer()).AppProjects(s.ns).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	children := 0
+	for _, proj := range projects {
+		if proj.Spec.ParentProject == q.Name {
+			children++
+		}
+	}
+	if children > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "project is the parent project of %d projects", children)
+	}
 	err = s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Delete(ctx, q.Name, metav1.DeleteOptions{})
 	if err == nil {
 		s.logEvent(ctx, p, argo.EventReasonResourceDeleted, "deleted project")
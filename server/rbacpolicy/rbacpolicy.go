@@ -17,9 +17,25 @@ import (
 // roles, jwt tokens, and groups. It is backed by a AppProject informer/lister cache and does not
 // make any API calls during enforcement.
 type RBACPolicyEnforcer struct {
-	enf        *rbac.Enforcer
-	projLister applister.AppProjectNamespaceLister
-	scopes     []string
+	enf              *rbac.Enforcer
+	projLister       applister.AppProjectNamespaceLister
+	scopes           []string
+	provisionedUsers ProvisionedUsers
+	accessGrants     AccessGrants
+}
+
+// ProvisionedUsers returns the groups of the users provisioned by the identity providers, in addition to the groups of
+// the token claims
+type ProvisionedUsers interface {
+	// GetUserGroups returns the groups of the provisioned user identified by the subject of a token of the issuer, and
+	// whether the user is deactivated
+	GetUserGroups(issuer string, subject string) ([]string, bool)
+}
+
+// AccessGrants returns the roles granted to the users for a limited time in an emergency
+type AccessGrants interface {
+	// GetGrantedRoles returns the active roles granted to the user identified by the subject of a token of the issuer
+	GetGrantedRoles(issuer string, subject string) []string
 }
 
 // NewRBACPolicyEnforcer returns a new RBAC Enforcer for the Argo CD API Server
@@ -35,6 +51,17 @@ func (p *RBACPolicyEnforcer) SetScopes(scopes []string) {
 	p.scopes = scopes
 }
 
+// SetProvisionedUsers sets the users provisioned by the identity providers, whose groups are granted the permissions of
+// the groups of the token claims
+func (p *RBACPolicyEnforcer) SetProvisionedUsers(provisionedUsers ProvisionedUsers) {
+	p.provisionedUsers = provisionedUsers
+}
+
+// SetAccessGrants sets the roles granted to the users for a limited time in an emergency
+func (p *RBACPolicyEnforcer) SetAccessGrants(accessGrants AccessGrants) {
+	p.accessGrants = accessGrants
+}
+
 func (p *RBACPolicyEnforcer) GetScopes() []string {
 	scopes := p.scopes
 	if scopes == nil {
@@ -68,6 +95,17 @@ func (p *RBACPolicyEnforcer) EnforceClaims(claims jwt.Claims, rvals ...any) bool
 	}
 
 	subject := argoClaims.GetUserIdentifier()
+	var provisionedGroups []string
+	// the project tokens are never provisioned by the identity providers, nor are the local users, whose tokens are
+	// issued by Argo CD
+	if p.provisionedUsers != nil && !IsProjectSubject(subject) {
+		var deactivated bool
+		provisionedGroups, deactivated = p.provisionedUsers.GetUserGroups(argoClaims.Issuer, subject)
+		if deactivated {
+			log.WithFields(log.Fields{"subject": subject, "rval": rvals}).Debug("enforce failed: user deactivated by the identity provider")
+			return false
+		}
+	}
 	// Check if the request is for an application resource. We have special enforcement which takes
 	// into consideration the project's token and group bindings
 	var runtimePolicy string
@@ -91,12 +129,22 @@ func (p *RBACPolicyEnforcer) EnforceClaims(claims jwt.Claims, rvals ...any) bool
 		return true
 	}
 
+	// Check the roles granted to the user in an emergency
+	if p.accessGrants != nil && !IsProjectSubject(subject) {
+		for _, role := range p.accessGrants.GetGrantedRoles(argoClaims.Issuer, subject) {
+			vals := append([]any{role}, rvals[1:]...)
+			if p.enf.EnforceWithCustomEnforcer(enforcer, vals...) {
+				return true
+			}
+		}
+	}
+
 	scopes := p.scopes
 	if scopes == nil {
 		scopes = rbac.DefaultScopes
 	}
 	// Finally check if any of the user's groups grant them permissions
-	groups := jwtutil.GetScopeValues(mapClaims, scopes)
+	groups := append(jwtutil.GetScopeValues(mapClaims, scopes), provisionedGroups...)
 
 	// Get groups to reduce the amount to checking groups
 	groupingPolicies, err := enforcer.GetGroupingPolicy()
@@ -132,10 +180,23 @@ func (p *RBACPolicyEnforcer) getProjectFromRequest(rvals ...any) *v1alpha1.AppPr
 		if err != nil {
 			return nil
 		}
-		return proj
+		if proj.Spec.ParentProject == "" {
+			return proj
+		}
+		// the project inherits the roles of its ancestors
+		projWithParents, err := proj.WithParentProjects(p.projLister.Get)
+		if err != nil {
+			log.WithError(err).Warnf("failed to get the parent projects of project %s", projName)
+			return proj
+		}
+		return projWithParents
+	}
+	obj := rvals[3]
+	if o, ok := obj.(rbac.Object); ok {
+		obj = o.Name
 	}
 	if res, ok := rvals[1].(string); ok {
-		if obj, ok := rvals[3].(string); ok {
+		if obj, ok := obj.(string); ok {
 			switch res {
 			case rbac.ResourceApplications, rbac.ResourceRepositories, rbac.ResourceClusters, rbac.ResourceLogs, rbac.ResourceExec:
 				if objSplit := strings.Split(obj, "/"); len(objSplit) >= 2 {
@@ -12,6 +12,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/common"
 	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/test"
+	"github.com/argoproj/argo-cd/v3/util/assets"
 	"github.com/argoproj/argo-cd/v3/util/rbac"
 )
 
@@ -94,6 +95,84 @@ func TestEnforceAllPolicies(t *testing.T) {
 	assert.True(t, enf.Enforce(claims, "applications", "create", "my-proj/my-app"))
 }
 
+// fakeProvisionedUsers are the groups of the users keyed by the issuer and the subject of their tokens
+type fakeProvisionedUsers map[string][]string
+
+func (f fakeProvisionedUsers) GetUserGroups(issuer string, subject string) ([]string, bool) {
+	groups := f[issuer+"|"+subject]
+	return groups, len(groups) == 1 && groups[0] == "deactivated"
+}
+
+func TestEnforceProvisionedGroups(t *testing.T) {
+	kubeclientset := fake.NewClientset(test.NewFakeConfigMap())
+	projLister := test.NewFakeProjLister(newFakeProj())
+	enf := rbac.NewEnforcer(kubeclientset, test.FakeArgoCDNamespace, common.ArgoCDConfigMapName, nil)
+	_ = enf.SetBuiltinPolicy(`p, alice, applications, get, my-proj/*, allow`)
+	rbacEnf := NewRBACPolicyEnforcer(enf, projLister)
+	rbacEnf.SetProvisionedUsers(fakeProvisionedUsers{
+		"https://idp.example.com|bob":   {"my-org:my-team"},
+		"https://idp.example.com|alice": {"deactivated"},
+	})
+	enf.SetClaimsEnforcerFunc(rbacEnf.EnforceClaims)
+
+	// the provisioned groups of the user are bound to the project roles
+	claims := jwt.MapClaims{"iss": "https://idp.example.com", "sub": "bob"}
+	assert.True(t, enf.Enforce(claims, "applications", "create", "my-proj/my-app"))
+	claims = jwt.MapClaims{"iss": "https://other-idp.example.com", "sub": "bob", "email": "bob@example.com"}
+	assert.False(t, enf.Enforce(claims, "applications", "create", "my-proj/my-app"))
+
+	// the users deactivated by the identity provider are denied
+	claims = jwt.MapClaims{"iss": "https://idp.example.com", "sub": "alice"}
+	assert.False(t, enf.Enforce(claims, "applications", "get", "my-proj/my-app"))
+	claims = jwt.MapClaims{"iss": "https://other-idp.example.com", "sub": "alice"}
+	assert.True(t, enf.Enforce(claims, "applications", "get", "my-proj/my-app"))
+}
+
+// fakeAccessGrants are the roles granted to the users keyed by the issuer and the subject of their tokens
+type fakeAccessGrants map[string][]string
+
+func (f fakeAccessGrants) GetGrantedRoles(issuer string, subject string) []string {
+	return f[issuer+"|"+subject]
+}
+
+func TestEnforceAccessGrants(t *testing.T) {
+	kubeclientset := fake.NewClientset(test.NewFakeConfigMap())
+	projLister := test.NewFakeProjLister(newFakeProj())
+	enf := rbac.NewEnforcer(kubeclientset, test.FakeArgoCDNamespace, common.ArgoCDConfigMapName, nil)
+	_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+	rbacEnf := NewRBACPolicyEnforcer(enf, projLister)
+	rbacEnf.SetAccessGrants(fakeAccessGrants{"https://idp.example.com|alice": {"role:admin"}})
+	enf.SetClaimsEnforcerFunc(rbacEnf.EnforceClaims)
+
+	// the user is granted the permissions of the granted role
+	claims := jwt.MapClaims{"iss": "https://idp.example.com", "sub": "alice"}
+	assert.True(t, enf.Enforce(claims, "clusters", "delete", "https://kubernetes.default.svc"))
+	claims = jwt.MapClaims{"iss": "https://idp.example.com", "sub": "bob", "email": "alice"}
+	assert.False(t, enf.Enforce(claims, "clusters", "delete", "https://kubernetes.default.svc"))
+	// the subjects of the other issuers are other users
+	claims = jwt.MapClaims{"iss": "https://other-idp.example.com", "sub": "alice"}
+	assert.False(t, enf.Enforce(claims, "clusters", "delete", "https://kubernetes.default.svc"))
+}
+
+func TestEnforceParentProjectRoles(t *testing.T) {
+	childProj := &argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "child-proj", Namespace: test.FakeArgoCDNamespace},
+		Spec:       argoappv1.AppProjectSpec{ParentProject: "my-proj"},
+	}
+	kubeclientset := fake.NewClientset(test.NewFakeConfigMap())
+	projLister := test.NewFakeProjLister(newFakeProj(), childProj)
+	enf := rbac.NewEnforcer(kubeclientset, test.FakeArgoCDNamespace, common.ArgoCDConfigMapName, nil)
+	rbacEnf := NewRBACPolicyEnforcer(enf, projLister)
+	enf.SetClaimsEnforcerFunc(rbacEnf.EnforceClaims)
+
+	// the groups of the roles of the parent project are granted the same permissions in the child project
+	claims := jwt.MapClaims{"sub": "bob", "groups": []string{"my-org:my-team"}}
+	assert.True(t, enf.Enforce(claims, "applications", "create", "child-proj/my-app"))
+	assert.False(t, enf.Enforce(claims, "applications", "delete", "child-proj/my-app"))
+	claims = jwt.MapClaims{"sub": "carol", "groups": []string{"my-org:other-team"}}
+	assert.False(t, enf.Enforce(claims, "applications", "create", "child-proj/my-app"))
+}
+
 func TestEnforceActionActions(t *testing.T) {
 	kubeclientset := fake.NewClientset(test.NewFakeConfigMap())
 	projLister := test.NewFakeProjLister(newFakeProj())
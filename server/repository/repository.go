@@ -378,6 +378,21 @@ func (s *Server) GetAppDetails(ctx context.Context, q *repositorypkg.RepoAppDeta
 		if err != nil {
 			return nil, fmt.Errorf("failed to get ref sources: %w", err)
 		}
+	} else if q.Source != nil {
+		// The value files of other repositories are referenced by single source applications too
+		repoValueFiles, err := argo.GetRepoValueFiles(*q.Source)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		for _, valueFile := range repoValueFiles {
+			if err := s.isRepoPermittedInProject(ctx, valueFile.RepoURL, q.AppProject); err != nil {
+				return nil, err
+			}
+		}
+		refSources, err = argo.GetRefSources(ctx, v1alpha1.ApplicationSources{*q.Source}, q.AppProject, s.db.GetRepository, []string{}, false)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get ref sources: %w", err)
+		}
 	}
 
 	return repoClient.GetAppDetails(ctx, &apiclient.RepoServerAppDetailsQuery{
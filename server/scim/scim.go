@@ -0,0 +1,501 @@
+package scim
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+// URLPrefix is the path prefix of the SCIM 2.0 endpoints
+const URLPrefix = "/api/scim/v2"
+
+const contentType = "application/scim+json"
+
+var (
+	// filterPattern matches the equality filters sent by the identity providers to look up the users and the groups,
+	// e.g. userName eq "alice@example.com"
+	filterPattern = regexp.MustCompile(`^\s*(\w+)\s+eq\s+"((?:[^"\\]|\\.)*)"\s*$`)
+	// memberPathPattern matches the path of the patch operations targeting a member, e.g. members[value eq "id"]
+	memberPathPattern = regexp.MustCompile(`^members\[\s*value\s+eq\s+"([^"]*)"\s*\]$`)
+)
+
+// NewHandler returns a handler serving the SCIM 2.0 endpoints provisioning the users and the groups of the identity
+// providers. The endpoints are enabled by configuring the bearer token of the identity provider in argocd-secret.
+func NewHandler(store *Store, settingsMgr *settings.SettingsManager) http.Handler {
+	return &Handler{store: store, settingsMgr: settingsMgr}
+}
+
+// Handler serves the SCIM 2.0 users and groups endpoints
+type Handler struct {
+	store       *Store
+	settingsMgr *settings.SettingsManager
+}
+
+func writeResponse(w http.ResponseWriter, statusCode int, body any) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(statusCode)
+	if body != nil {
+		_ = json.NewEncoder(w).Encode(body)
+	}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	var scimErr *Error
+	if !errors.As(err, &scimErr) {
+		log.Errorf("SCIM request failed: %v", err)
+		scimErr = newError(http.StatusInternalServerError, "", err.Error())
+	}
+	writeResponse(w, scimErr.statusCode, scimErr)
+}
+
+// ServeHTTP serves the SCIM requests of the identity providers
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	argoSettings, err := h.settingsMgr.GetSettings()
+	if err != nil {
+		writeError(w, fmt.Errorf("failed to get settings: %w", err))
+		return
+	}
+	if argoSettings.SCIMBearerToken == "" || argoSettings.SCIMIssuer == "" {
+		writeError(w, newError(http.StatusNotFound, "", "SCIM provisioning is not enabled"))
+		return
+	}
+	if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte("Bearer "+argoSettings.SCIMBearerToken)) != 1 {
+		writeError(w, newError(http.StatusUnauthorized, "", "invalid bearer token"))
+		return
+	}
+
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, URLPrefix), "/"), "/")
+	if len(segments) > 2 {
+		writeError(w, newError(http.StatusNotFound, "", fmt.Sprintf("%s not found", r.URL.Path)))
+		return
+	}
+	id := ""
+	if len(segments) == 2 {
+		id = segments[1]
+	}
+	switch segments[0] {
+	case "Users":
+		h.serveUsers(w, r, id)
+	case "Groups":
+		h.serveGroups(w, r, id)
+	case "ServiceProviderConfig":
+		writeResponse(w, http.StatusOK, map[string]any{
+			"schemas":        []string{serviceProviderConfigSchema},
+			"patch":          map[string]bool{"supported": true},
+			"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+			"filter":         map[string]any{"supported": true, "maxResults": 0},
+			"changePassword": map[string]bool{"supported": false},
+			"sort":           map[string]bool{"supported": false},
+			"etag":           map[string]bool{"supported": false},
+			"authenticationSchemes": []map[string]string{{
+				"type": "oauthbearertoken",
+				"name": "OAuth Bearer Token",
+			}},
+		})
+	default:
+		writeError(w, newError(http.StatusNotFound, "", fmt.Sprintf("%s not found", r.URL.Path)))
+	}
+}
+
+func decodeBody(r *http.Request, v any) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return errInvalid("invalidSyntax", fmt.Sprintf("failed to decode the request: %v", err))
+	}
+	return nil
+}
+
+// parseFilter parses the equality filter of the list requests, the only filters supported
+func parseFilter(filter string) (string, string, error) {
+	if filter == "" {
+		return "", "", nil
+	}
+	match := filterPattern.FindStringSubmatch(filter)
+	if match == nil {
+		return "", "", errInvalid("invalidFilter", fmt.Sprintf("unsupported filter %s", filter))
+	}
+	return match[1], strings.ReplaceAll(match[2], `\"`, `"`), nil
+}
+
+// listResponse returns the page of the resources given by the startIndex and count query parameters
+func listResponse[T any](r *http.Request, resources []T) (*ListResponse, error) {
+	startIndex := 1
+	if value := r.URL.Query().Get("startIndex"); value != "" {
+		index, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, errInvalid("invalidValue", fmt.Sprintf("invalid startIndex %s", value))
+		}
+		startIndex = max(index, 1)
+	}
+	count := len(resources)
+	if value := r.URL.Query().Get("count"); value != "" {
+		c, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, errInvalid("invalidValue", fmt.Sprintf("invalid count %s", value))
+		}
+		count = max(c, 0)
+	}
+	page := []any{}
+	for i := startIndex - 1; i < len(resources) && len(page) < count; i++ {
+		page = append(page, resources[i])
+	}
+	return &ListResponse{
+		Schemas:      []string{listResponseSchema},
+		TotalResults: len(resources),
+		StartIndex:   startIndex,
+		ItemsPerPage: len(page),
+		Resources:    page,
+	}, nil
+}
+
+func setUserMeta(user *User) *User {
+	user.Schemas = []string{userSchema}
+	user.Meta = &Meta{ResourceType: "User"}
+	return user
+}
+
+func setGroupMeta(group *Group) *Group {
+	group.Schemas = []string{groupSchema}
+	group.Meta = &Meta{ResourceType: "Group"}
+	return group
+}
+
+func (h *Handler) serveUsers(w http.ResponseWriter, r *http.Request, id string) {
+	ctx := r.Context()
+	var user *User
+	var err error
+	switch {
+	case id == "" && r.Method == http.MethodGet:
+		var response *ListResponse
+		response, err = h.listUsers(r)
+		if err == nil {
+			writeResponse(w, http.StatusOK, response)
+			return
+		}
+	case id == "" && r.Method == http.MethodPost:
+		var newUser User
+		if err = decodeBody(r, &newUser); err == nil {
+			if newUser.UserName == "" {
+				writeError(w, errInvalid("invalidValue", "userName is required"))
+				return
+			}
+			newUser.Schemas, newUser.Meta = nil, nil
+			if user, err = h.store.CreateUser(ctx, newUser); err == nil {
+				writeResponse(w, http.StatusCreated, setUserMeta(user))
+				return
+			}
+		}
+	case id != "" && r.Method == http.MethodGet:
+		user, err = h.store.GetUser(ctx, id)
+	case id != "" && r.Method == http.MethodPut:
+		var newUser User
+		if err = decodeBody(r, &newUser); err == nil {
+			user, err = h.store.UpdateUser(ctx, id, func(user *User) error {
+				newUser.Schemas, newUser.Meta = nil, nil
+				*user = newUser
+				return nil
+			})
+		}
+	case id != "" && r.Method == http.MethodPatch:
+		var patch PatchRequest
+		if err = decodeBody(r, &patch); err == nil {
+			user, err = h.store.UpdateUser(ctx, id, func(user *User) error {
+				return patchUser(user, patch.Operations)
+			})
+		}
+	case id != "" && r.Method == http.MethodDelete:
+		if err = h.store.DeleteUser(ctx, id); err == nil {
+			writeResponse(w, http.StatusNoContent, nil)
+			return
+		}
+	default:
+		err = newError(http.StatusMethodNotAllowed, "", fmt.Sprintf("method %s is not allowed", r.Method))
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeResponse(w, http.StatusOK, setUserMeta(user))
+}
+
+func (h *Handler) listUsers(r *http.Request) (*ListResponse, error) {
+	attribute, value, err := parseFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		return nil, err
+	}
+	users, err := h.store.ListUsers(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	filtered := make([]*User, 0, len(users))
+	for i := range users {
+		user := &users[i]
+		switch {
+		case attribute == "":
+		case strings.EqualFold(attribute, "userName"):
+			if !strings.EqualFold(user.UserName, value) {
+				continue
+			}
+		case strings.EqualFold(attribute, "externalId"):
+			if user.ExternalID != value {
+				continue
+			}
+		default:
+			return nil, errInvalid("invalidFilter", fmt.Sprintf("unsupported filter attribute %s", attribute))
+		}
+		filtered = append(filtered, setUserMeta(user))
+	}
+	return listResponse(r, filtered)
+}
+
+// parseBool parses the boolean values of the patch operations, sent as strings by some identity providers
+func parseBool(value any) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case string:
+		b, err := strconv.ParseBool(strings.ToLower(v))
+		if err != nil {
+			return false, errInvalid("invalidValue", fmt.Sprintf("invalid boolean %s", v))
+		}
+		return b, nil
+	}
+	return false, errInvalid("invalidValue", fmt.Sprintf("invalid boolean %v", value))
+}
+
+func setUserAttribute(user *User, attribute string, value any) error {
+	switch strings.ToLower(attribute) {
+	case "active":
+		active, err := parseBool(value)
+		if err != nil {
+			return err
+		}
+		user.Active = &active
+	case "username":
+		user.UserName = fmt.Sprintf("%v", value)
+	case "displayname":
+		user.DisplayName = fmt.Sprintf("%v", value)
+	case "externalid":
+		user.ExternalID = fmt.Sprintf("%v", value)
+	}
+	// the other attributes are not used by Argo CD and are ignored
+	return nil
+}
+
+func patchUser(user *User, operations []PatchOperation) error {
+	for _, operation := range operations {
+		switch strings.ToLower(operation.Op) {
+		case "add", "replace":
+			if operation.Path != "" {
+				if err := setUserAttribute(user, operation.Path, operation.Value); err != nil {
+					return err
+				}
+				continue
+			}
+			values, ok := operation.Value.(map[string]any)
+			if !ok {
+				return errInvalid("invalidValue", "the value of a patch operation without path must be an object")
+			}
+			for attribute, value := range values {
+				if err := setUserAttribute(user, attribute, value); err != nil {
+					return err
+				}
+			}
+		case "remove":
+			if strings.EqualFold(operation.Path, "externalId") {
+				user.ExternalID = ""
+			} else if strings.EqualFold(operation.Path, "displayName") {
+				user.DisplayName = ""
+			}
+		default:
+			return errInvalid("invalidSyntax", fmt.Sprintf("unsupported patch operation %s", operation.Op))
+		}
+	}
+	return nil
+}
+
+func (h *Handler) serveGroups(w http.ResponseWriter, r *http.Request, id string) {
+	ctx := r.Context()
+	var group *Group
+	var err error
+	switch {
+	case id == "" && r.Method == http.MethodGet:
+		var response *ListResponse
+		response, err = h.listGroups(r)
+		if err == nil {
+			writeResponse(w, http.StatusOK, response)
+			return
+		}
+	case id == "" && r.Method == http.MethodPost:
+		var newGroup Group
+		if err = decodeBody(r, &newGroup); err == nil {
+			if newGroup.DisplayName == "" {
+				writeError(w, errInvalid("invalidValue", "displayName is required"))
+				return
+			}
+			newGroup.Schemas, newGroup.Meta = nil, nil
+			if group, err = h.store.CreateGroup(ctx, newGroup); err == nil {
+				writeResponse(w, http.StatusCreated, setGroupMeta(group))
+				return
+			}
+		}
+	case id != "" && r.Method == http.MethodGet:
+		group, err = h.store.GetGroup(ctx, id)
+	case id != "" && r.Method == http.MethodPut:
+		var newGroup Group
+		if err = decodeBody(r, &newGroup); err == nil {
+			group, err = h.store.UpdateGroup(ctx, id, func(group *Group) error {
+				newGroup.Schemas, newGroup.Meta = nil, nil
+				*group = newGroup
+				return nil
+			})
+		}
+	case id != "" && r.Method == http.MethodPatch:
+		var patch PatchRequest
+		if err = decodeBody(r, &patch); err == nil {
+			group, err = h.store.UpdateGroup(ctx, id, func(group *Group) error {
+				return patchGroup(group, patch.Operations)
+			})
+		}
+	case id != "" && r.Method == http.MethodDelete:
+		if err = h.store.DeleteGroup(ctx, id); err == nil {
+			writeResponse(w, http.StatusNoContent, nil)
+			return
+		}
+	default:
+		err = newError(http.StatusMethodNotAllowed, "", fmt.Sprintf("method %s is not allowed", r.Method))
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeResponse(w, http.StatusOK, setGroupMeta(group))
+}
+
+func (h *Handler) listGroups(r *http.Request) (*ListResponse, error) {
+	attribute, value, err := parseFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		return nil, err
+	}
+	groups, err := h.store.ListGroups(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	excludeMembers := strings.Contains(r.URL.Query().Get("excludedAttributes"), "members")
+	filtered := make([]*Group, 0, len(groups))
+	for i := range groups {
+		group := &groups[i]
+		switch {
+		case attribute == "":
+		case strings.EqualFold(attribute, "displayName"):
+			if group.DisplayName != value {
+				continue
+			}
+		case strings.EqualFold(attribute, "externalId"):
+			if group.ExternalID != value {
+				continue
+			}
+		default:
+			return nil, errInvalid("invalidFilter", fmt.Sprintf("unsupported filter attribute %s", attribute))
+		}
+		if excludeMembers {
+			group.Members = nil
+		}
+		filtered = append(filtered, setGroupMeta(group))
+	}
+	return listResponse(r, filtered)
+}
+
+// parseMembers parses the members of the value of a patch operation
+func parseMembers(value any) ([]Member, error) {
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return nil, errInvalid("invalidValue", fmt.Sprintf("invalid members: %v", err))
+	}
+	var members []Member
+	if err := json.Unmarshal(valueBytes, &members); err != nil {
+		var member Member
+		if err := json.Unmarshal(valueBytes, &member); err != nil {
+			return nil, errInvalid("invalidValue", fmt.Sprintf("invalid members: %v", err))
+		}
+		members = []Member{member}
+	}
+	return members, nil
+}
+
+func patchGroup(group *Group, operations []PatchOperation) error {
+	for _, operation := range operations {
+		op := strings.ToLower(operation.Op)
+		path := operation.Path
+		if op != "add" && op != "replace" && op != "remove" {
+			return errInvalid("invalidSyntax", fmt.Sprintf("unsupported patch operation %s", operation.Op))
+		}
+		if match := memberPathPattern.FindStringSubmatch(path); match != nil {
+			if op != "remove" {
+				return errInvalid("invalidPath", fmt.Sprintf("unsupported %s operation path %s", operation.Op, path))
+			}
+			group.removeMembers(func(member Member) bool { return member.Value == match[1] })
+			continue
+		}
+		switch {
+		case strings.EqualFold(path, "members"):
+			var members []Member
+			if operation.Value != nil {
+				var err error
+				if members, err = parseMembers(operation.Value); err != nil {
+					return err
+				}
+			}
+			switch op {
+			case "add":
+				group.addMembers(members)
+			case "replace":
+				group.Members = nil
+				group.addMembers(members)
+			case "remove":
+				if operation.Value == nil {
+					group.Members = nil
+				}
+				group.removeMembers(func(member Member) bool {
+					for _, removed := range members {
+						if removed.Value == member.Value {
+							return true
+						}
+					}
+					return false
+				})
+			}
+		case strings.EqualFold(path, "displayName"):
+			if op != "remove" {
+				group.DisplayName = fmt.Sprintf("%v", operation.Value)
+			}
+		case strings.EqualFold(path, "externalId"):
+			if op == "remove" {
+				group.ExternalID = ""
+			} else {
+				group.ExternalID = fmt.Sprintf("%v", operation.Value)
+			}
+		case path == "":
+			values, ok := operation.Value.(map[string]any)
+			if !ok || op == "remove" {
+				return errInvalid("invalidValue", "the value of a patch operation without path must be an object")
+			}
+			for attribute, value := range values {
+				if err := patchGroup(group, []PatchOperation{{Op: op, Path: attribute, Value: value}}); err != nil {
+					return err
+				}
+			}
+		default:
+			// the other attributes are not used by Argo CD and are ignored
+		}
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const testNamespace = "default"
+
+func newTestHandler(t *testing.T, bearerToken string) (http.Handler, *Store) {
+	t.Helper()
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "argocd-secret", Namespace: testNamespace},
+		Data: map[string][]byte{
+			"admin.password":   []byte("test"),
+			"server.secretkey": []byte("test"),
+		},
+	}
+	if bearerToken != "" {
+		secret.Data["scim.bearerToken"] = []byte(bearerToken)
+		secret.Data["scim.issuer"] = []byte("https://idp.example.com")
+	}
+	kubeClient := fake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-cm",
+			Namespace: testNamespace,
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+	}, secret)
+	settingsMgr := settings.NewSettingsManager(t.Context(), kubeClient, testNamespace)
+	store := NewStore(testNamespace, kubeClient, settingsMgr)
+	return NewHandler(store, settingsMgr), store
+}
+
+func doRequest(t *testing.T, handler http.Handler, method string, path string, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, URLPrefix+path, strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer test-token")
+	req.Header.Set("Content-Type", contentType)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func decodeResponse[T any](t *testing.T, rec *httptest.ResponseRecorder) T {
+	t.Helper()
+	var v T
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &v), rec.Body.String())
+	return v
+}
+
+func TestHandler_Authentication(t *testing.T) {
+	t.Run("Disabled", func(t *testing.T) {
+		handler, _ := newTestHandler(t, "")
+		rec := doRequest(t, handler, http.MethodGet, "/Users", "")
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("InvalidToken", func(t *testing.T) {
+		handler, _ := newTestHandler(t, "other-token")
+		rec := doRequest(t, handler, http.MethodGet, "/Users", "")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Equal(t, "401", decodeResponse[Error](t, rec).Status)
+	})
+
+	t.Run("ValidToken", func(t *testing.T) {
+		handler, _ := newTestHandler(t, "test-token")
+		rec := doRequest(t, handler, http.MethodGet, "/Users", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, 0, decodeResponse[ListResponse](t, rec).TotalResults)
+	})
+}
+
+func TestHandler_Users(t *testing.T) {
+	handler, _ := newTestHandler(t, "test-token")
+
+	rec := doRequest(t, handler, http.MethodPost, "/Users", `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],"userName":"alice@example.com","externalId":"00u1"}`)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	alice := decodeResponse[User](t, rec)
+	assert.NotEmpty(t, alice.ID)
+	assert.Equal(t, "alice@example.com", alice.UserName)
+	assert.Equal(t, []string{userSchema}, alice.Schemas)
+	assert.True(t, alice.IsActive())
+
+	rec = doRequest(t, handler, http.MethodPost, "/Users", `{"userName":"Alice@example.com"}`)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Equal(t, "uniqueness", decodeResponse[Error](t, rec).ScimType)
+
+	rec = doRequest(t, handler, http.MethodPost, "/Users", `{"userName":"bob@example.com"}`)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+
+	rec = doRequest(t, handler, http.MethodGet, `/Users?filter=userName+eq+"alice@example.com"`, "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	list := decodeResponse[ListResponse](t, rec)
+	assert.Equal(t, 1, list.TotalResults)
+
+	rec = doRequest(t, handler, http.MethodGet, "/Users?startIndex=2&count=1", "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	list = decodeResponse[ListResponse](t, rec)
+	assert.Equal(t, 2, list.TotalResults)
+	assert.Equal(t, 1, list.ItemsPerPage)
+
+	rec = doRequest(t, handler, http.MethodGet, `/Users?filter=emails+co+"example"`, "")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = doRequest(t, handler, http.MethodPatch, "/Users/"+alice.ID, `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"Replace","path":"active","value":"False"}]}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	alice = decodeResponse[User](t, rec)
+	assert.False(t, alice.IsActive())
+
+	rec = doRequest(t, handler, http.MethodPatch, "/Users/"+alice.ID, `{"Operations":[{"op":"replace","value":{"active":true,"displayName":"Alice"}}]}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	alice = decodeResponse[User](t, rec)
+	assert.True(t, alice.IsActive())
+	assert.Equal(t, "Alice", alice.DisplayName)
+
+	rec = doRequest(t, handler, http.MethodPut, "/Users/"+alice.ID, `{"userName":"alice@example.com","externalId":"00u2"}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	alice = decodeResponse[User](t, rec)
+	assert.Equal(t, "00u2", alice.ExternalID)
+	assert.Empty(t, alice.DisplayName)
+
+	rec = doRequest(t, handler, http.MethodDelete, "/Users/"+alice.ID, "")
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = doRequest(t, handler, http.MethodGet, "/Users/"+alice.ID, "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestHandler_Groups(t *testing.T) {
+	handler, store := newTestHandler(t, "test-token")
+
+	alice := decodeResponse[User](t, doRequest(t, handler, http.MethodPost, "/Users", `{"userName":"alice@example.com","emails":[{"value":"alice@corp.example.com","primary":true}]}`))
+	bob := decodeResponse[User](t, doRequest(t, handler, http.MethodPost, "/Users", `{"userName":"bob@example.com"}`))
+
+	rec := doRequest(t, handler, http.MethodPost, "/Groups", `{"displayName":"payments","members":[{"value":"`+alice.ID+`"}]}`)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	payments := decodeResponse[Group](t, rec)
+
+	rec = doRequest(t, handler, http.MethodPost, "/Groups", `{"displayName":"payments"}`)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	rec = doRequest(t, handler, http.MethodPatch, "/Groups/"+payments.ID, `{"Operations":[{"op":"add","path":"members","value":[{"value":"`+bob.ID+`"},{"value":"`+alice.ID+`"}]}]}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, []Member{{Value: alice.ID}, {Value: bob.ID}}, decodeResponse[Group](t, rec).Members)
+
+	rec = doRequest(t, handler, http.MethodGet, `/Groups?filter=displayName+eq+"payments"&excludedAttributes=members`, "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	list := decodeResponse[ListResponse](t, rec)
+	require.Equal(t, 1, list.TotalResults)
+	assert.NotContains(t, list.Resources[0], "members")
+
+	assert.Eventually(t, func() bool {
+		groups, deactivated := store.GetUserGroups("https://idp.example.com", "bob@example.com")
+		return !deactivated && assert.ObjectsAreEqual([]string{"payments"}, groups)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the users are only matched for the tokens of the issuer provisioning them, by their user name or external id
+	groups, _ := store.GetUserGroups("https://other-idp.example.com", "bob@example.com")
+	assert.Empty(t, groups)
+	groups, _ = store.GetUserGroups("argocd", "bob@example.com")
+	assert.Empty(t, groups)
+	groups, _ = store.GetUserGroups("https://idp.example.com", "alice@corp.example.com")
+	assert.Empty(t, groups, "the users are not matched by their emails")
+
+	rec = doRequest(t, handler, http.MethodPatch, "/Groups/"+payments.ID, `{"Operations":[{"op":"remove","path":"members[value eq \"`+bob.ID+`\"]"}]}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, []Member{{Value: alice.ID}}, decodeResponse[Group](t, rec).Members)
+
+	rec = doRequest(t, handler, http.MethodPatch, "/Users/"+alice.ID, `{"Operations":[{"op":"replace","path":"active","value":false}]}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	assert.Eventually(t, func() bool {
+		groups, deactivated := store.GetUserGroups("https://idp.example.com", "alice@example.com")
+		bobGroups, _ := store.GetUserGroups("https://idp.example.com", "bob@example.com")
+		return deactivated && assert.ObjectsAreEqual([]string{"payments"}, groups) && len(bobGroups) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	rec = doRequest(t, handler, http.MethodDelete, "/Users/"+alice.ID, "")
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	rec = doRequest(t, handler, http.MethodGet, "/Groups/"+payments.ID, "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Empty(t, decodeResponse[Group](t, rec).Members)
+
+	rec = doRequest(t, handler, http.MethodDelete, "/Groups/"+payments.ID, "")
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+func TestPatchGroup(t *testing.T) {
+	group := Group{DisplayName: "payments", Members: []Member{{Value: "1"}, {Value: "2"}}}
+	require.NoError(t, patchGroup(&group, []PatchOperation{
+		{Op: "replace", Value: map[string]any{"displayName": "billing"}},
+		{Op: "remove", Path: "members", Value: []any{map[string]any{"value": "1"}}},
+	}))
+	assert.Equal(t, Group{DisplayName: "billing", Members: []Member{{Value: "2"}}}, group)
+
+	require.NoError(t, patchGroup(&group, []PatchOperation{{Op: "replace", Path: "members", Value: []any{map[string]any{"value": "3"}}}}))
+	assert.Equal(t, []Member{{Value: "3"}}, group.Members)
+
+	require.NoError(t, patchGroup(&group, []PatchOperation{{Op: "remove", Path: "members"}}))
+	assert.Empty(t, group.Members)
+
+	assert.Error(t, patchGroup(&group, []PatchOperation{{Op: "move", Path: "members"}}))
+}
@@ -0,0 +1,364 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/util/session"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const (
+	userKeyPrefix  = "user."
+	groupKeyPrefix = "group."
+)
+
+// Store stores the users and the groups provisioned by the identity provider in the argocd-scim-cm ConfigMap, keyed by
+// their id
+type Store struct {
+	namespace   string
+	kubeClient  kubernetes.Interface
+	settingsMgr *settings.SettingsManager
+
+	mutex sync.Mutex
+	// data is the data of the ConfigMap the groups of the users were cached from
+	data       map[string]string
+	userGroups map[string]provisionedUser
+}
+
+type provisionedUser struct {
+	active bool
+	groups []string
+}
+
+// NewStore returns a new store of the provisioned users and groups
+func NewStore(namespace string, kubeClient kubernetes.Interface, settingsMgr *settings.SettingsManager) *Store {
+	return &Store{namespace: namespace, kubeClient: kubeClient, settingsMgr: settingsMgr}
+}
+
+func (s *Store) getConfigMap(ctx context.Context) (*corev1.ConfigMap, error) {
+	cm, err := s.kubeClient.CoreV1().ConfigMaps(s.namespace).Get(ctx, common.ArgoCDSCIMConfigMapName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return &corev1.ConfigMap{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving config map %s: %w", common.ArgoCDSCIMConfigMapName, err)
+	}
+	return cm, nil
+}
+
+// update updates the ConfigMap with the given function, creating the ConfigMap if it does not exist
+func (s *Store) update(ctx context.Context, f func(data map[string]string) error) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := s.kubeClient.CoreV1().ConfigMaps(s.namespace).Get(ctx, common.ArgoCDSCIMConfigMapName, metav1.GetOptions{})
+		create := apierrors.IsNotFound(err)
+		if create {
+			cm = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+				Name:      common.ArgoCDSCIMConfigMapName,
+				Namespace: s.namespace,
+				// the label lets the settings manager cache the ConfigMap
+				Labels: map[string]string{"app.kubernetes.io/part-of": "argocd"},
+			}}
+		} else if err != nil {
+			return fmt.Errorf("error retrieving config map %s: %w", common.ArgoCDSCIMConfigMapName, err)
+		}
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		if err := f(cm.Data); err != nil {
+			return err
+		}
+		if create {
+			_, err = s.kubeClient.CoreV1().ConfigMaps(s.namespace).Create(ctx, cm, metav1.CreateOptions{})
+		} else {
+			_, err = s.kubeClient.CoreV1().ConfigMaps(s.namespace).Update(ctx, cm, metav1.UpdateOptions{})
+		}
+		return err
+	})
+}
+
+func unmarshalEntries[T any](data map[string]string, prefix string) ([]T, error) {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	entries := make([]T, 0, len(keys))
+	for _, key := range keys {
+		var entry T
+		if err := json.Unmarshal([]byte(data[key]), &entry); err != nil {
+			return nil, fmt.Errorf("error unmarshaling %s: %w", key, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func marshalEntry(data map[string]string, key string, entry any) error {
+	entryBytes, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("error marshaling %s: %w", key, err)
+	}
+	data[key] = string(entryBytes)
+	return nil
+}
+
+// ListUsers returns the provisioned users
+func (s *Store) ListUsers(ctx context.Context) ([]User, error) {
+	cm, err := s.getConfigMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalEntries[User](cm.Data, userKeyPrefix)
+}
+
+// ListGroups returns the provisioned groups
+func (s *Store) ListGroups(ctx context.Context) ([]Group, error) {
+	cm, err := s.getConfigMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalEntries[Group](cm.Data, groupKeyPrefix)
+}
+
+// GetUser returns the provisioned user with the given id
+func (s *Store) GetUser(ctx context.Context, id string) (*User, error) {
+	cm, err := s.getConfigMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := cm.Data[userKeyPrefix+id]
+	if !ok {
+		return nil, errNotFound("User", id)
+	}
+	var user User
+	if err := json.Unmarshal([]byte(value), &user); err != nil {
+		return nil, fmt.Errorf("error unmarshaling user %s: %w", id, err)
+	}
+	return &user, nil
+}
+
+// GetGroup returns the provisioned group with the given id
+func (s *Store) GetGroup(ctx context.Context, id string) (*Group, error) {
+	cm, err := s.getConfigMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := cm.Data[groupKeyPrefix+id]
+	if !ok {
+		return nil, errNotFound("Group", id)
+	}
+	var group Group
+	if err := json.Unmarshal([]byte(value), &group); err != nil {
+		return nil, fmt.Errorf("error unmarshaling group %s: %w", id, err)
+	}
+	return &group, nil
+}
+
+// CreateUser provisions a new user, failing if a user with the same user name already exists
+func (s *Store) CreateUser(ctx context.Context, user User) (*User, error) {
+	user.ID = uuid.NewString()
+	err := s.update(ctx, func(data map[string]string) error {
+		users, err := unmarshalEntries[User](data, userKeyPrefix)
+		if err != nil {
+			return err
+		}
+		for _, existing := range users {
+			if strings.EqualFold(existing.UserName, user.UserName) {
+				return errConflict("User", "userName", user.UserName)
+			}
+		}
+		return marshalEntry(data, userKeyPrefix+user.ID, user)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// CreateGroup provisions a new group, failing if a group with the same display name already exists
+func (s *Store) CreateGroup(ctx context.Context, group Group) (*Group, error) {
+	group.ID = uuid.NewString()
+	err := s.update(ctx, func(data map[string]string) error {
+		groups, err := unmarshalEntries[Group](data, groupKeyPrefix)
+		if err != nil {
+			return err
+		}
+		for _, existing := range groups {
+			if existing.DisplayName == group.DisplayName {
+				return errConflict("Group", "displayName", group.DisplayName)
+			}
+		}
+		return marshalEntry(data, groupKeyPrefix+group.ID, group)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+// UpdateUser updates the provisioned user with the given id with the given function
+func (s *Store) UpdateUser(ctx context.Context, id string, f func(user *User) error) (*User, error) {
+	var user User
+	err := s.update(ctx, func(data map[string]string) error {
+		value, ok := data[userKeyPrefix+id]
+		if !ok {
+			return errNotFound("User", id)
+		}
+		user = User{}
+		if err := json.Unmarshal([]byte(value), &user); err != nil {
+			return fmt.Errorf("error unmarshaling user %s: %w", id, err)
+		}
+		if err := f(&user); err != nil {
+			return err
+		}
+		user.ID = id
+		return marshalEntry(data, userKeyPrefix+id, user)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// UpdateGroup updates the provisioned group with the given id with the given function
+func (s *Store) UpdateGroup(ctx context.Context, id string, f func(group *Group) error) (*Group, error) {
+	var group Group
+	err := s.update(ctx, func(data map[string]string) error {
+		value, ok := data[groupKeyPrefix+id]
+		if !ok {
+			return errNotFound("Group", id)
+		}
+		group = Group{}
+		if err := json.Unmarshal([]byte(value), &group); err != nil {
+			return fmt.Errorf("error unmarshaling group %s: %w", id, err)
+		}
+		if err := f(&group); err != nil {
+			return err
+		}
+		group.ID = id
+		return marshalEntry(data, groupKeyPrefix+id, group)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+// DeleteUser deprovisions the user with the given id and removes the user from the groups
+func (s *Store) DeleteUser(ctx context.Context, id string) error {
+	return s.update(ctx, func(data map[string]string) error {
+		if _, ok := data[userKeyPrefix+id]; !ok {
+			return errNotFound("User", id)
+		}
+		delete(data, userKeyPrefix+id)
+		groups, err := unmarshalEntries[Group](data, groupKeyPrefix)
+		if err != nil {
+			return err
+		}
+		for _, group := range groups {
+			if group.removeMembers(func(member Member) bool { return member.Value == id }) {
+				if err := marshalEntry(data, groupKeyPrefix+group.ID, group); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteGroup deprovisions the group with the given id
+func (s *Store) DeleteGroup(ctx context.Context, id string) error {
+	return s.update(ctx, func(data map[string]string) error {
+		if _, ok := data[groupKeyPrefix+id]; !ok {
+			return errNotFound("Group", id)
+		}
+		delete(data, groupKeyPrefix+id)
+		return nil
+	})
+}
+
+// GetUserGroups returns the display names of the provisioned groups of the provisioned user identified by the subject
+// of a token of the issuer, matched against the user name and the external id of the users. The users are only
+// matched for the issuer of the identity provider provisioning them. It returns whether the user is provisioned and
+// deactivated by the identity provider.
+func (s *Store) GetUserGroups(issuer string, subject string) ([]string, bool) {
+	if issuer == "" || issuer == session.SessionManagerClaimsIssuer || subject == "" {
+		return nil, false
+	}
+	argoSettings, err := s.settingsMgr.GetSettings()
+	if err != nil {
+		log.Warnf("Failed to get the issuer of the provisioned users: %v", err)
+		return nil, false
+	}
+	if argoSettings.SCIMIssuer == "" || issuer != argoSettings.SCIMIssuer {
+		return nil, false
+	}
+	userGroups, err := s.getUserGroups()
+	if err != nil {
+		log.Warnf("Failed to get the groups of the provisioned users: %v", err)
+		return nil, false
+	}
+	user, ok := userGroups[subject]
+	if !ok {
+		return nil, false
+	}
+	return user.groups, !user.active
+}
+
+// getUserGroups returns the provisioned users keyed by their identifiers. The users are cached until the
+// ConfigMap held by the settings manager changes.
+func (s *Store) getUserGroups() (map[string]provisionedUser, error) {
+	cm, err := s.settingsMgr.GetConfigMapByName(common.ArgoCDSCIMConfigMapName)
+	if apierrors.IsNotFound(err) {
+		return map[string]provisionedUser{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.userGroups != nil && maps.Equal(s.data, cm.Data) {
+		return s.userGroups, nil
+	}
+	users, err := unmarshalEntries[User](cm.Data, userKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := unmarshalEntries[Group](cm.Data, groupKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+	groupsByUserID := map[string][]string{}
+	for _, group := range groups {
+		for _, member := range group.Members {
+			groupsByUserID[member.Value] = append(groupsByUserID[member.Value], group.DisplayName)
+		}
+	}
+	userGroups := map[string]provisionedUser{}
+	for _, user := range users {
+		provisioned := provisionedUser{active: user.IsActive(), groups: groupsByUserID[user.ID]}
+		for _, identifier := range user.identifiers() {
+			userGroups[identifier] = provisioned
+		}
+	}
+	s.data = cm.Data
+	s.userGroups = userGroups
+	return userGroups, nil
+}
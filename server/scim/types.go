@@ -0,0 +1,152 @@
+package scim
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	userSchema                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	groupSchema                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	listResponseSchema          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	errorSchema                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	serviceProviderConfigSchema = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+)
+
+// Meta is the metadata of a SCIM resource
+type Meta struct {
+	ResourceType string `json:"resourceType"`
+}
+
+// Email is an email address of a SCIM user
+type Email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// User is a user provisioned by the identity provider
+type User struct {
+	Schemas     []string `json:"schemas,omitempty"`
+	ID          string   `json:"id"`
+	ExternalID  string   `json:"externalId,omitempty"`
+	UserName    string   `json:"userName"`
+	DisplayName string   `json:"displayName,omitempty"`
+	// Active is whether the user is active, the users are active unless deactivated by the identity provider
+	Active *bool   `json:"active,omitempty"`
+	Emails []Email `json:"emails,omitempty"`
+	Meta   *Meta   `json:"meta,omitempty"`
+}
+
+// IsActive returns whether the user is active
+func (u *User) IsActive() bool {
+	return u.Active == nil || *u.Active
+}
+
+// identifiers returns the identifiers of the user matched against the subjects and the emails of the tokens
+func (u *User) identifiers() []string {
+	identifiers := []string{u.UserName}
+	if u.ExternalID != "" {
+		identifiers = append(identifiers, u.ExternalID)
+	}
+	return identifiers
+}
+
+// Member is a member of a SCIM group, identified by the id of the user
+type Member struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+// Group is a group provisioned by the identity provider. The display name of the group is the group name matched by
+// the RBAC policies and by the group bindings of the project roles.
+type Group struct {
+	Schemas     []string `json:"schemas,omitempty"`
+	ID          string   `json:"id"`
+	ExternalID  string   `json:"externalId,omitempty"`
+	DisplayName string   `json:"displayName"`
+	Members     []Member `json:"members,omitempty"`
+	Meta        *Meta    `json:"meta,omitempty"`
+}
+
+// addMembers adds the members to the group unless already members
+func (g *Group) addMembers(members []Member) {
+	for _, member := range members {
+		found := false
+		for _, existing := range g.Members {
+			if existing.Value == member.Value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			g.Members = append(g.Members, Member{Value: member.Value, Display: member.Display})
+		}
+	}
+}
+
+// removeMembers removes the members matching the given function from the group and returns whether any was removed
+func (g *Group) removeMembers(match func(member Member) bool) bool {
+	members := make([]Member, 0, len(g.Members))
+	for _, member := range g.Members {
+		if !match(member) {
+			members = append(members, member)
+		}
+	}
+	removed := len(members) != len(g.Members)
+	g.Members = members
+	return removed
+}
+
+// ListResponse is the response of the SCIM list requests
+type ListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+// PatchOperation is an operation of a SCIM patch request
+type PatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path,omitempty"`
+	Value any    `json:"value,omitempty"`
+}
+
+// PatchRequest is a SCIM patch request
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+// Error is a SCIM error response
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+
+	statusCode int
+}
+
+func (e *Error) Error() string {
+	return e.Detail
+}
+
+func newError(statusCode int, scimType string, detail string) *Error {
+	return &Error{Schemas: []string{errorSchema}, Status: fmt.Sprintf("%d", statusCode), ScimType: scimType, Detail: detail, statusCode: statusCode}
+}
+
+func errNotFound(resourceType string, id string) *Error {
+	return newError(http.StatusNotFound, "", fmt.Sprintf("%s %s not found", resourceType, id))
+}
+
+func errConflict(resourceType string, attribute string, value string) *Error {
+	return newError(http.StatusConflict, "uniqueness", fmt.Sprintf("%s with %s %s already exists", resourceType, attribute, value))
+}
+
+func errInvalid(scimType string, detail string) *Error {
+	return newError(http.StatusBadRequest, scimType, detail)
+}
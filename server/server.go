@@ -49,6 +49,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 	"gopkg.in/yaml.v2"
@@ -100,11 +101,13 @@ import (
 	"github.com/argoproj/argo-cd/v3/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/v3/server/repocreds"
 	"github.com/argoproj/argo-cd/v3/server/repository"
+	"github.com/argoproj/argo-cd/v3/server/scim"
 	"github.com/argoproj/argo-cd/v3/server/session"
 	"github.com/argoproj/argo-cd/v3/server/settings"
 	"github.com/argoproj/argo-cd/v3/server/version"
 	"github.com/argoproj/argo-cd/v3/ui"
 	"github.com/argoproj/argo-cd/v3/util/assets"
+	"github.com/argoproj/argo-cd/v3/util/breakglass"
 	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
 	claimsutil "github.com/argoproj/argo-cd/v3/util/claims"
 	"github.com/argoproj/argo-cd/v3/util/db"
@@ -185,6 +188,8 @@ type ArgoCDServer struct {
 	projInformer   cache.SharedIndexInformer
 	projLister     applisters.AppProjectNamespaceLister
 	policyEnforcer *rbacpolicy.RBACPolicyEnforcer
+	scimStore      *scim.Store
+	accessGrants   *breakglass.Store
 	appInformer    cache.SharedIndexInformer
 	appLister      applisters.ApplicationLister
 	appsetInformer cache.SharedIndexInformer
@@ -327,6 +332,10 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts, appsetOpts Applicatio
 	enf.EnableLog(os.Getenv(common.EnvVarRBACDebug) == "1")
 
 	policyEnf := rbacpolicy.NewRBACPolicyEnforcer(enf, projLister)
+	scimStore := scim.NewStore(opts.Namespace, opts.KubeClientset, settingsMgr)
+	policyEnf.SetProvisionedUsers(scimStore)
+	accessGrantStore := breakglass.NewStore(opts.Namespace, opts.KubeClientset, settingsMgr, "argocd-server")
+	policyEnf.SetAccessGrants(accessGrantStore)
 	enf.SetClaimsEnforcerFunc(policyEnf.EnforceClaims)
 
 	var staticFS fs.FS = io.NewSubDirFS("dist/app", ui.Embedded)
@@ -334,7 +343,7 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts, appsetOpts Applicatio
 		staticFS = io.NewComposableFS(staticFS, os.DirFS(opts.StaticAssetsDir))
 	}
 
-	argocdService, err := service.NewArgoCDService(opts.KubeClientset, opts.Namespace, opts.RepoClientset)
+	argocdService, err := service.NewArgoCDService(opts.KubeClientset, opts.AppClientset, opts.Namespace, opts.RepoClientset)
 	errorsutil.CheckError(err)
 
 	secretInformer := k8s.NewSecretInformer(opts.KubeClientset, opts.Namespace, "argocd-notifications-secret")
@@ -369,6 +378,8 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts, appsetOpts Applicatio
 		appsetInformer:     appsetInformer,
 		appsetLister:       appsetLister,
 		policyEnforcer:     policyEnf,
+		scimStore:          scimStore,
+		accessGrants:       accessGrantStore,
 		userStateStorage:   userStateStorage,
 		staticAssets:       http.FS(staticFS),
 		db:                 dbInstance,
@@ -635,6 +646,7 @@ func (server *ArgoCDServer) Run(ctx context.Context, listeners *Listeners) {
 	}
 	go server.watchSettings()
 	go server.rbacPolicyLoader(ctx)
+	go server.accessGrants.Run(ctx)
 	go func() { server.checkServeErr("tcpm", tcpm.Serve()) }()
 	go func() { server.checkServeErr("metrics", metricsServ.Serve(listeners.Metrics)) }()
 	if !cache.WaitForCacheSync(ctx.Done(), server.projInformer.HasSynced, server.appInformer.HasSynced) {
@@ -781,6 +793,7 @@ func (server *ArgoCDServer) watchSettings() {
 	prevURL := server.settings.URL
 	prevAdditionalURLs := server.settings.AdditionalURLs
 	prevOIDCConfig := server.settings.OIDCConfig()
+	prevAdditionalOIDCConfigs := server.settings.AdditionalOIDCConfigs()
 	prevDexCfgBytes, err := dexutil.GenerateDexConfigYAML(server.settings, server.DexTLSConfig == nil || server.DexTLSConfig.DisableTLS)
 	errorsutil.CheckError(err)
 	prevGitHubSecret := server.settings.WebhookGitHubSecret
@@ -807,6 +820,10 @@ func (server *ArgoCDServer) watchSettings() {
 			log.Infof("oidc config modified. restarting")
 			break
 		}
+		if !reflect.DeepEqual(prevAdditionalOIDCConfigs, server.settings.AdditionalOIDCConfigs()) {
+			log.Infof("additional oidc configs modified. restarting")
+			break
+		}
 		if prevURL != server.settings.URL {
 			log.Infof("url modified. restarting")
 			break
@@ -1053,12 +1070,12 @@ func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 		a.EnableK8sEvent,
 	)
 
-	projectService := project.NewServer(a.Namespace, a.KubeClientset, a.AppClientset, a.enf, projectLock, a.sessionMgr, a.policyEnforcer, a.projInformer, a.settingsMgr, a.db, a.EnableK8sEvent)
+	projectService := project.NewServer(a.Namespace, a.KubeClientset, a.AppClientset, a.enf, projectLock, a.sessionMgr, a.policyEnforcer, a.projInformer, a.appLister, a.settingsMgr, a.db, a.EnableK8sEvent)
 	appsInAnyNamespaceEnabled := len(a.ApplicationNamespaces) > 0
 	settingsService := settings.NewServer(a.settingsMgr, a.RepoClientset, a, a.DisableAuth, appsInAnyNamespaceEnabled, a.HydratorEnabled)
-	accountService := account.NewServer(a.sessionMgr, a.settingsMgr, a.enf)
+	accountService := account.NewServer(a.sessionMgr, a.settingsMgr, a.enf, a.projLister)
 
-	notificationService := notification.NewServer(a.apiFactory)
+	notificationService := notification.NewServer(a.apiFactory, a.AppClientset, a.appLister, a.projLister, a.enf, a.Namespace, a.ApplicationNamespaces)
 	certificateService := certificate.NewServer(a.RepoClientset, a.db, a.enf)
 	gpgkeyService := gpgkey.NewServer(a.RepoClientset, a.db, a.enf)
 	versionService := version.NewServer(a, func() (bool, error) {
@@ -1186,6 +1203,7 @@ func (server *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWeb
 		log.WithField(common.SecurityField, common.SecurityHigh).Warnf("Content-Type enforcement is disabled, which may make your API vulnerable to CSRF attacks")
 	}
 	mux.Handle("/api/", handler)
+	mux.Handle(scim.URLPrefix+"/", scim.NewHandler(server.scimStore, server.settingsMgr))
 
 	terminalOpts := application.TerminalOptions{DisableAuth: server.DisableAuth, Enf: server.enf}
 
@@ -1498,6 +1516,8 @@ func (server *ArgoCDServer) Authenticate(ctx context.Context) (context.Context,
 	if claimsErr != nil {
 		//nolint:staticcheck
 		ctx = context.WithValue(ctx, util_session.AuthErrorCtxKey, claimsErr)
+	} else if claims != nil {
+		server.sessionMgr.RecordSession(ctx, claims, clientIP(ctx))
 	}
 
 	if claimsErr != nil {
@@ -1515,6 +1535,32 @@ func (server *ArgoCDServer) Authenticate(ctx context.Context) (context.Context,
 	return ctx, nil
 }
 
+// clientIP returns the address of the client of the request. The requests proxied by grpc-gateway come from the
+// loopback address, and their client is the right-most address of the x-forwarded-for header, which is the one
+// appended by grpc-gateway. The other addresses of the header are set by the client, and can't be trusted.
+func clientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return host
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if forwardedFor := md.Get("x-forwarded-for"); len(forwardedFor) > 0 {
+			hops := strings.Split(forwardedFor[len(forwardedFor)-1], ",")
+			if hop := strings.TrimSpace(hops[len(hops)-1]); hop != "" {
+				return hop
+			}
+		}
+	}
+	return host
+}
+
 func (server *ArgoCDServer) getClaims(ctx context.Context) (jwt.Claims, string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
@@ -1540,7 +1586,8 @@ func (server *ArgoCDServer) getClaims(ctx context.Context) (jwt.Claims, string,
 
 	// Some SSO implementations (Okta) require a call to the OIDC user info path to get attributes like groups
 	iss := jwtutil.StringField(mapClaims, "iss")
-	if iss != util_session.SessionManagerClaimsIssuer && server.settings.UserInfoGroupsEnabled() && server.settings.UserInfoPath() != "" {
+	// The user info of the additional OIDC providers is not queried
+	if iss != util_session.SessionManagerClaimsIssuer && server.settings.AdditionalOIDCConfigForIssuer(iss) == nil && server.settings.UserInfoGroupsEnabled() && server.settings.UserInfoPath() != "" {
 		userInfo, unauthorized, err := server.ssoClientApp.GetUserInfo(mapClaims, server.settings.IssuerURL(), server.settings.UserInfoPath())
 		if unauthorized {
 			log.Errorf("error while quering userinfo endpoint: %v", err)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -20,6 +21,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
@@ -1643,3 +1645,29 @@ func Test_enforceContentTypes(t *testing.T) {
 		assert.Equal(t, http.StatusUnsupportedMediaType, resp.StatusCode, "should not have passed, since a disallowed content type was provided")
 	})
 }
+
+func TestClientIP(t *testing.T) {
+	withPeer := func(addr string, forwardedFor ...string) context.Context {
+		ctx := peer.NewContext(t.Context(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(addr), Port: 40000}})
+		if len(forwardedFor) > 0 {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", strings.Join(forwardedFor, ", ")))
+		}
+		return ctx
+	}
+
+	t.Run("gRPC client", func(t *testing.T) {
+		assert.Equal(t, "10.0.0.1", clientIP(withPeer("10.0.0.1", "1.2.3.4")))
+	})
+	t.Run("grpc-gateway request", func(t *testing.T) {
+		assert.Equal(t, "10.0.0.2", clientIP(withPeer("127.0.0.1", "10.0.0.2")))
+	})
+	t.Run("grpc-gateway request with a forged header", func(t *testing.T) {
+		assert.Equal(t, "10.0.0.2", clientIP(withPeer("127.0.0.1", "1.2.3.4", "10.0.0.2")))
+	})
+	t.Run("grpc-gateway request without header", func(t *testing.T) {
+		assert.Equal(t, "127.0.0.1", clientIP(withPeer("127.0.0.1")))
+	})
+	t.Run("no peer", func(t *testing.T) {
+		assert.Empty(t, clientIP(t.Context()))
+	})
+}
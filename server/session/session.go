@@ -6,6 +6,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v3/util/settings"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -13,6 +14,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/session"
 	"github.com/argoproj/argo-cd/v3/server/rbacpolicy"
 	util "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
 	sessionmgr "github.com/argoproj/argo-cd/v3/util/session"
 )
 
@@ -96,3 +98,78 @@ func (s *Server) GetUserInfo(ctx context.Context, _ *session.GetUserInfoRequest)
 		Groups:   sessionmgr.Groups(ctx, s.policyEnf.GetScopes()),
 	}, nil
 }
+
+// enforce checks that the user is logged in and is allowed to perform the action on the account of the given subject,
+// since the session service does not require authentication
+func (s *Server) enforce(ctx context.Context, action string, subject string) error {
+	if !sessionmgr.LoggedIn(ctx) {
+		return status.Error(codes.Unauthenticated, "no session information")
+	}
+	claims, _ := ctx.Value("claims").(jwt.Claims)
+	if !s.policyEnf.EnforceClaims(claims, rbac.ResourceAccounts, action, subject) {
+		return status.Errorf(codes.PermissionDenied, "permission denied: %s, %s, %s", rbac.ResourceAccounts, action, subject)
+	}
+	return nil
+}
+
+func toActiveSession(sess sessionmgr.Session) *session.ActiveSession {
+	activeSession := &session.ActiveSession{
+		Id:           sess.ID,
+		Subject:      sess.Subject,
+		Issuer:       sess.Issuer,
+		IssuedAt:     sess.IssuedAt.Unix(),
+		LastActivity: sess.LastActivity.Unix(),
+		ClientIP:     sess.ClientIP,
+	}
+	if sess.ExpiresAt != nil {
+		activeSession.ExpiresAt = sess.ExpiresAt.Unix()
+	}
+	return activeSession
+}
+
+// ListSessions lists the sessions which were active in the last 24 hours of the accounts the user is allowed to get
+func (s *Server) ListSessions(ctx context.Context, q *session.SessionListRequest) (*session.SessionList, error) {
+	if !sessionmgr.LoggedIn(ctx) {
+		return nil, status.Error(codes.Unauthenticated, "no session information")
+	}
+	sessions, err := s.mgr.ListSessions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing sessions: %w", err)
+	}
+	list := &session.SessionList{Items: make([]*session.ActiveSession, 0)}
+	for _, sess := range sessions {
+		if q.Subject != "" && sess.Subject != q.Subject {
+			continue
+		}
+		if s.enforce(ctx, rbac.ActionGet, sess.Subject) != nil {
+			continue
+		}
+		list.Items = append(list.Items, toActiveSession(sess))
+	}
+	return list, nil
+}
+
+// RevokeSession revokes the active session with the given id, whose token is rejected until it expires
+func (s *Server) RevokeSession(ctx context.Context, q *session.SessionRevokeRequest) (*session.ActiveSession, error) {
+	if !sessionmgr.LoggedIn(ctx) {
+		return nil, status.Error(codes.Unauthenticated, "no session information")
+	}
+	sessions, err := s.mgr.ListSessions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing sessions: %w", err)
+	}
+	for _, sess := range sessions {
+		if sess.ID != q.Id {
+			continue
+		}
+		if err := s.enforce(ctx, rbac.ActionUpdate, sess.Subject); err != nil {
+			return nil, err
+		}
+		revoked, err := s.mgr.RevokeSession(ctx, sess.ID)
+		if err != nil {
+			return nil, err
+		}
+		return toActiveSession(*revoked), nil
+	}
+	return nil, status.Errorf(codes.NotFound, "session %s not found", q.Id)
+}
@@ -47,6 +47,14 @@ func (s *Server) Get(ctx context.Context, _ *settingspkg.SettingsQuery) (*settin
 		val := resourceOverrides[k]
 		overrides[k] = &val
 	}
+	resourceIgnoreDifferences, err := s.mgr.GetResourceIgnoreDifferences()
+	if err != nil {
+		return nil, err
+	}
+	ignoreDifferences := make([]*v1alpha1.ResourceIgnoreDifferences, len(resourceIgnoreDifferences))
+	for i := range resourceIgnoreDifferences {
+		ignoreDifferences[i] = &resourceIgnoreDifferences[i]
+	}
 	appInstanceLabelKey, err := s.mgr.GetAppInstanceLabelKey()
 	if err != nil {
 		return nil, err
@@ -90,12 +98,13 @@ func (s *Server) Get(ctx context.Context, _ *settingspkg.SettingsQuery) (*settin
 	}
 
 	set := settingspkg.Settings{
-		URL:                argoCDSettings.URL,
-		AdditionalURLs:     argoCDSettings.AdditionalURLs,
-		AppLabelKey:        appInstanceLabelKey,
-		ResourceOverrides:  overrides,
-		StatusBadgeEnabled: argoCDSettings.StatusBadgeEnabled,
-		StatusBadgeRootUrl: argoCDSettings.StatusBadgeRootUrl,
+		URL:                       argoCDSettings.URL,
+		AdditionalURLs:            argoCDSettings.AdditionalURLs,
+		AppLabelKey:               appInstanceLabelKey,
+		ResourceOverrides:         overrides,
+		ResourceIgnoreDifferences: ignoreDifferences,
+		StatusBadgeEnabled:        argoCDSettings.StatusBadgeEnabled,
+		StatusBadgeRootUrl:        argoCDSettings.StatusBadgeRootUrl,
 		KustomizeOptions: &v1alpha1.KustomizeOptions{
 			BuildOptions: argoCDSettings.KustomizeBuildOptions,
 		},
@@ -136,21 +145,29 @@ func (s *Server) Get(ctx context.Context, _ *settingspkg.SettingsQuery) (*settin
 		}
 	}
 	if oidcConfig := argoCDSettings.OIDCConfig(); oidcConfig != nil {
-		set.OIDCConfig = &settingspkg.OIDCConfig{
-			Name:                     oidcConfig.Name,
-			Issuer:                   oidcConfig.Issuer,
-			ClientID:                 oidcConfig.ClientID,
-			CLIClientID:              oidcConfig.CLIClientID,
-			Scopes:                   oidcConfig.RequestedScopes,
-			EnablePKCEAuthentication: oidcConfig.EnablePKCEAuthentication,
-		}
-		if len(argoCDSettings.OIDCConfig().RequestedIDTokenClaims) > 0 {
-			set.OIDCConfig.IDTokenClaims = argoCDSettings.OIDCConfig().RequestedIDTokenClaims
-		}
+		set.OIDCConfig = toOIDCConfig(oidcConfig)
+	}
+	for _, oidcConfig := range argoCDSettings.AdditionalOIDCConfigs() {
+		set.AdditionalOIDCConfigs = append(set.AdditionalOIDCConfigs, toOIDCConfig(oidcConfig))
 	}
 	return &set, nil
 }
 
+func toOIDCConfig(oidcConfig *settings.OIDCConfig) *settingspkg.OIDCConfig {
+	config := &settingspkg.OIDCConfig{
+		Name:                     oidcConfig.Name,
+		Issuer:                   oidcConfig.Issuer,
+		ClientID:                 oidcConfig.ClientID,
+		CLIClientID:              oidcConfig.CLIClientID,
+		Scopes:                   oidcConfig.RequestedScopes,
+		EnablePKCEAuthentication: oidcConfig.EnablePKCEAuthentication,
+	}
+	if len(oidcConfig.RequestedIDTokenClaims) > 0 {
+		config.IDTokenClaims = oidcConfig.RequestedIDTokenClaims
+	}
+	return config
+}
+
 // GetPlugins returns a list of plugins
 func (s *Server) GetPlugins(ctx context.Context, _ *settingspkg.SettingsQuery) (*settingspkg.SettingsPluginsResponse, error) {
 	plugins, err := s.plugins(ctx)
@@ -81,6 +81,16 @@ export class Login extends React.Component<RouteComponentProps<{}>, State> {
                                         )}
                                 </button>
                             </a>
+                            {(authSettings.additionalOIDCConfigs || []).map(config => (
+                                <a
+                                    key={config.name}
+                                    className='login__provider'
+                                    href={`auth/login?provider=${encodeURIComponent(config.name)}&return_url=${encodeURIComponent(this.state.returnUrl)}`}>
+                                    <button className='argo-button argo-button--base argo-button--full-width argo-button--xlg'>
+                                        <span>Log in via {config.name}</span>
+                                    </button>
+                                </a>
+                            ))}
                             {this.state.hasSsoLoginError && <div className='argo-form-row__error-msg'>Login failed.</div>}
                             {authSettings && !authSettings.userLoginsDisabled && (
                                 <div className='login__saml-separator'>
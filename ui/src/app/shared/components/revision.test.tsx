@@ -1,6 +1,6 @@
 import * as renderer from 'react-test-renderer';
 import * as React from 'react';
-import {isSHA, Revision} from './revision';
+import {isDigest, isSHA, Revision} from './revision';
 
 test('Revision.SHA1.Children', () => {
     const tree = renderer
@@ -42,3 +42,9 @@ test('isSHA1', () => {
     expect(isSHA('24eb0b24099b2e9afff72558724e88125eaa0176')).toBe(true);
     expect(isSHA('master')).toBe(false);
 });
+
+test('isDigest', () => {
+    expect(isDigest('sha256:9834876dcfb05cb167a5c24953eba58c4ac89b1adf57f28f2f9d09af107ee8f0')).toBe(true);
+    expect(isDigest('24eb0b24099b2e9afff72558724e88125eaa0176')).toBe(false);
+    expect(isDigest('1.0.0')).toBe(false);
+});
@@ -12,7 +12,7 @@ export const Revision = ({repoUrl, revision, path, isForPath, children}: {repoUr
     if (url !== null && hasPath) {
         url += '/' + path;
     }
-    const content = children || (isSHA(revision) ? revision.substr(0, 7) : revision);
+    const content = children || (isSHA(revision) ? revision.substr(0, 7) : isDigest(revision) ? revision.substr(0, 19) : revision);
     return url !== null ? (
         <a href={url} target='_blank' rel='noopener noreferrer'>
             {content} <i className='fa fa-external-link-alt' />
@@ -26,3 +26,8 @@ export const isSHA = (revision: string) => {
     // https://stackoverflow.com/questions/468370/a-regex-to-match-a-sha1
     return revision.match(/^[a-f0-9]{5,40}$/) !== null;
 };
+
+export const isDigest = (revision: string) => {
+    // the digests of the OCI artifacts, e.g. sha256:<hex>
+    return revision.match(/^[a-z0-9]+:[a-f0-9]{32,}$/) !== null;
+};
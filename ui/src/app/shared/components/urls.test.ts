@@ -54,3 +54,7 @@ test('bitbucket.org', () => {
 test('empty url', () => {
     expect(repoUrl('')).toBe(null);
 });
+
+test('oci url', () => {
+    expect(revisionUrl('oci://ghcr.io/my-org/my-manifests', 'sha256:9834876dcfb05cb167a5c24953eba58c4ac89b1adf57f28f2f9d09af107ee8f0', false)).toBe(null);
+});
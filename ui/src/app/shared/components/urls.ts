@@ -26,6 +26,9 @@ export function repoUrl(url: string): string {
 }
 
 export function revisionUrl(url: string, revision: string, forPath: boolean): string {
+    if (url.startsWith('oci://')) {
+        return null;
+    }
     let parsed;
     try {
         parsed = GitUrlParse(url);
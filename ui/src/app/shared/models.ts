@@ -33,6 +33,8 @@ export interface SyncOperation {
     prune: boolean;
     dryRun: boolean;
     resources?: SyncOperationResource[];
+    resume?: boolean;
+    forceConflictsFor?: string[];
 }
 
 export interface RetryBackoff {
@@ -104,6 +106,14 @@ export interface SyncOperationResult {
     resources: ResourceResult[];
     revision: string;
     revisions: string[];
+    wavePause?: SyncWavePause;
+    outOfSyncWaves?: number[];
+}
+
+export interface SyncWavePause {
+    phase: string;
+    wave: number;
+    until: string;
 }
 
 export type ResultCode = 'Synced' | 'SyncFailed' | 'Pruned' | 'PruneSkipped';
@@ -125,6 +135,14 @@ export interface ResourceResult {
     message: string;
     hookType: HookType;
     hookPhase: OperationPhase;
+    conflicts?: ServerSideApplyConflict[];
+    appliedAt?: models.Time;
+}
+
+export interface ServerSideApplyConflict {
+    manager: string;
+    fields?: string[];
+    forced?: boolean;
 }
 
 export type SyncResourceResult = ResourceResult & {
@@ -297,6 +315,14 @@ export interface SyncPolicy {
     automated?: Automated;
     syncOptions?: string[];
     retry?: RetryStrategy;
+    reconcileInterval?: string;
+    priority?: 'high' | 'normal' | 'low';
+    hooks?: SyncHookReference[];
+}
+
+export interface SyncHookReference {
+    name: string;
+    parameters?: EnvEntry[];
 }
 
 export interface Info {
@@ -314,6 +340,16 @@ export interface ApplicationSpec {
     ignoreDifferences?: ResourceIgnoreDifferences[];
     info?: Info[];
     revisionHistoryLimit?: number;
+    dependsOn?: string[];
+    destinationNamespaces?: DestinationNamespaceMapping[];
+}
+
+export interface DestinationNamespaceMapping {
+    namespace: string;
+    selector: {
+        matchLabels?: {[name: string]: string};
+        matchExpressions?: {key: string; operator: string; values?: string[]}[];
+    };
 }
 
 export interface ResourceIgnoreDifferences {
@@ -337,6 +373,13 @@ export interface RevisionHistory {
     deployStartedAt: models.Time;
     deployedAt: models.Time;
     initiatedBy: OperationInitiator;
+    rollback?: RollbackInfo;
+}
+
+export interface RollbackInfo {
+    revision?: string;
+    historyID?: number;
+    fromRevision?: string;
 }
 
 export type SyncStatusCode = 'Unknown' | 'Synced' | 'OutOfSync';
@@ -383,6 +426,12 @@ export interface ResourceStatus {
     requiresDeletionConfirmation?: boolean;
     syncWave?: number;
     orphaned?: boolean;
+    pendingDeletion?: PendingDeletion;
+}
+
+export interface PendingDeletion {
+    deletionRequestedAt: models.Time;
+    finalizers?: string[];
 }
 
 export interface ResourceRef {
@@ -470,6 +519,23 @@ export interface ApplicationStatus {
     operationState?: OperationState;
     summary?: ApplicationSummary;
     sourceHydrator?: SourceHydratorStatus;
+    driftHistory?: DriftEvent[];
+}
+
+export interface DriftEvent {
+    revertedAt: models.Time;
+    revision?: string;
+    resources?: DriftedResource[];
+}
+
+export interface DriftedResource {
+    group?: string;
+    version?: string;
+    kind: string;
+    namespace?: string;
+    name: string;
+    managers?: string[];
+    diff?: string;
 }
 
 export interface SourceHydratorStatus {
@@ -544,6 +610,12 @@ export interface AuthSettings {
         scopes: string[];
         enablePKCEAuthentication: boolean;
     };
+    additionalOIDCConfigs?: {
+        name: string;
+        issuer: string;
+        clientID: string;
+        scopes: string[];
+    }[];
     help: {
         chatUrl: string;
         chatText: string;
@@ -641,9 +713,13 @@ export interface Cluster {
         serverVersion: string;
         connectionState: ConnectionState;
         cacheInfo: ClusterCacheInfo;
+        capacityInfo?: ClusterCapacityInfo;
+        latencyInfo?: ClusterLatencyInfo;
     };
     annotations?: {[name: string]: string};
     labels?: {[name: string]: string};
+    maintenance?: boolean;
+    syncWindows?: SyncWindow[];
 }
 
 export interface ClusterCacheInfo {
@@ -652,6 +728,21 @@ export interface ClusterCacheInfo {
     lastCacheSyncTime: models.Time;
 }
 
+export interface ClusterCapacityInfo {
+    nodesCount?: number;
+    podsCount?: number;
+    allocatablePods?: number;
+    allocatableCPU?: number;
+    allocatableMemory?: number;
+    kubeletVersions?: string[];
+}
+
+export interface ClusterLatencyInfo {
+    apiServerRTTMilliseconds?: number;
+    watchLagMilliseconds?: number;
+    watchEventsCount?: number;
+}
+
 export interface ClusterList extends ItemsList<Cluster> {}
 
 export interface HelmChart {
@@ -802,6 +893,37 @@ export interface ProjectSpec {
     signatureKeys: ProjectSignatureKey[];
     orphanedResources?: {warn?: boolean; ignore: OrphanedResource[]};
     syncWindows?: SyncWindows;
+    applicationResourceQuota?: {maxResources?: number; maxManifestsSize?: string};
+    syncPolicy?: ProjectSyncPolicy;
+    sourceRestrictions?: SourceRestriction[];
+    quota?: ProjectQuota;
+    parentProject?: string;
+}
+
+export interface ProjectQuota {
+    maxApplications?: number;
+    maxResources?: number;
+    maxClusters?: number;
+}
+
+export interface ProjectQuotaUsage {
+    applications: number;
+    resources: number;
+    clusters: number;
+}
+
+export interface SourceRestriction {
+    repoURL?: string;
+    paths?: string[];
+    targetRevisions?: string[];
+}
+
+export interface ProjectSyncPolicy {
+    syncOptions?: string[];
+    retry?: RetryStrategy;
+    deniedSyncOptions?: string[];
+    disableAutomatedPrune?: boolean;
+    disableSelfHeal?: boolean;
 }
 
 export type SyncWindows = SyncWindow[];
@@ -831,6 +953,7 @@ export interface DetailedProjectsResponse {
     globalProjects: Project[];
     repositories: Repository[];
     clusters: Cluster[];
+    quotaUsage?: ProjectQuotaUsage;
 }
 
 export type ProjectList = ItemsList<Project>;
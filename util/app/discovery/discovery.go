@@ -19,6 +19,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/cmp"
+	"github.com/argoproj/argo-cd/v3/util/cue"
 	"github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/kustomize"
 )
@@ -52,6 +53,10 @@ func Discover(ctx context.Context, appPath, repoPath string, enableGenerateManif
 			return err
 		}
 		if info.IsDir() {
+			// The CUE module directory holds the module file and the dependencies of the CUE packages
+			if info.Name() == cue.ModuleDir {
+				return filepath.SkipDir
+			}
 			return nil
 		}
 		dir, err := filepath.Rel(appPath, filepath.Dir(path))
@@ -59,6 +64,10 @@ func Discover(ctx context.Context, appPath, repoPath string, enableGenerateManif
 			return err
 		}
 		base := filepath.Base(path)
+		// CUE is only detected if enabled explicitly, so that the directory apps holding CUE files keep their type
+		if strings.HasSuffix(base, cue.FileExtension) && apps[dir] == "" && enableGenerateManifests[string(v1alpha1.ApplicationSourceTypeCUE)] {
+			apps[dir] = string(v1alpha1.ApplicationSourceTypeCUE)
+		}
 		if strings.HasSuffix(base, "Chart.yaml") && IsManifestGenerationEnabled(v1alpha1.ApplicationSourceTypeHelm, enableGenerateManifests) {
 			apps[dir] = string(v1alpha1.ApplicationSourceTypeHelm)
 		}
@@ -16,6 +16,15 @@ func TestDiscover(t *testing.T) {
 		"foo": "Kustomize",
 		"baz": "Helm",
 	}, apps)
+
+	// CUE is only detected if enabled explicitly
+	apps, err = Discover(t.Context(), "./testdata", "./testdata", map[string]bool{string(v1alpha1.ApplicationSourceTypeCUE): true}, []string{}, []string{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"foo": "Kustomize",
+		"baz": "Helm",
+		"qux": "CUE",
+	}, apps)
 }
 
 func TestAppType(t *testing.T) {
@@ -27,6 +36,14 @@ func TestAppType(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "Helm", appType)
 
+	appType, err = AppType(t.Context(), "./testdata/qux", "./testdata", map[string]bool{}, []string{}, []string{})
+	require.NoError(t, err)
+	assert.Equal(t, "Directory", appType)
+
+	appType, err = AppType(t.Context(), "./testdata/qux", "./testdata", map[string]bool{string(v1alpha1.ApplicationSourceTypeCUE): true}, []string{}, []string{})
+	require.NoError(t, err)
+	assert.Equal(t, "CUE", appType)
+
 	appType, err = AppType(t.Context(), "./testdata", "./testdata", map[string]bool{}, []string{}, []string{})
 	require.NoError(t, err)
 	assert.Equal(t, "Directory", appType)
@@ -36,6 +53,7 @@ func TestAppType_Disabled(t *testing.T) {
 	enableManifestGeneration := map[string]bool{
 		string(v1alpha1.ApplicationSourceTypeKustomize): false,
 		string(v1alpha1.ApplicationSourceTypeHelm):      false,
+		string(v1alpha1.ApplicationSourceTypeCUE):       false,
 	}
 	appType, err := AppType(t.Context(), "./testdata/foo", "./testdata", enableManifestGeneration, []string{}, []string{})
 	require.NoError(t, err)
@@ -45,6 +63,10 @@ func TestAppType_Disabled(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "Directory", appType)
 
+	appType, err = AppType(t.Context(), "./testdata/qux", "./testdata", enableManifestGeneration, []string{}, []string{})
+	require.NoError(t, err)
+	assert.Equal(t, "Directory", appType)
+
 	appType, err = AppType(t.Context(), "./testdata", "./testdata", enableManifestGeneration, []string{}, []string{})
 	require.NoError(t, err)
 	assert.Equal(t, "Directory", appType)
@@ -0,0 +1,2 @@
+module: "example.com/qux"
+language: version: "v0.12.0"
@@ -0,0 +1,7 @@
+package qux
+
+objects: configMap: {
+	apiVersion: "v1"
+	kind:       "ConfigMap"
+	metadata: name: "qux"
+}
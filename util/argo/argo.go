@@ -20,6 +20,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -230,16 +231,28 @@ func FilterByNameP(apps []*argoappv1.Application, name string) []*argoappv1.Appl
 
 // RefreshApp updates the refresh annotation of an application to coerce the controller to process it
 func RefreshApp(appIf v1alpha1.ApplicationInterface, name string, refreshType argoappv1.RefreshType, hydrate bool) (*argoappv1.Application, error) {
+	return RefreshAppResources(appIf, name, refreshType, hydrate, nil)
+}
+
+// RefreshAppResources updates the refresh annotation of an application to coerce the controller to process it. If
+// resources are given, in the GROUP:KIND:NAME or GROUP:KIND:NAMESPACE/NAME format, the refresh is scoped to them.
+func RefreshAppResources(appIf v1alpha1.ApplicationInterface, name string, refreshType argoappv1.RefreshType, hydrate bool, resources []string) (*argoappv1.Application, error) {
+	annotations := map[string]any{
+		argoappv1.AnnotationKeyRefresh: string(refreshType),
+		// an unscoped refresh overrides a pending scoped refresh
+		argoappv1.AnnotationKeyRefreshResources: nil,
+	}
+	if len(resources) > 0 {
+		annotations[argoappv1.AnnotationKeyRefreshResources] = strings.Join(resources, ",")
+	}
+	if hydrate {
+		annotations[argoappv1.AnnotationKeyHydrate] = string(argoappv1.HydrateTypeNormal)
+	}
 	metadata := map[string]any{
 		"metadata": map[string]any{
-			"annotations": map[string]string{
-				argoappv1.AnnotationKeyRefresh: string(refreshType),
-			},
+			"annotations": annotations,
 		},
 	}
-	if hydrate {
-		metadata["metadata"].(map[string]any)["annotations"].(map[string]string)[argoappv1.AnnotationKeyHydrate] = string(argoappv1.HydrateTypeNormal)
-	}
 
 	var err error
 	patch, err := json.Marshal(metadata)
@@ -260,11 +273,14 @@ func RefreshApp(appIf v1alpha1.ApplicationInterface, name string, refreshType ar
 	return nil, err
 }
 
-func TestRepoWithKnownType(ctx context.Context, repoClient apiclient.RepoServerServiceClient, repo *argoappv1.Repository, isHelm bool, isHelmOci bool) error {
+func TestRepoWithKnownType(ctx context.Context, repoClient apiclient.RepoServerServiceClient, repo *argoappv1.Repository, isHelm bool, isHelmOci bool, isOCI bool) error {
 	repo = repo.DeepCopy()
-	if isHelm {
+	switch {
+	case isHelm:
 		repo.Type = "helm"
-	} else {
+	case isOCI:
+		repo.Type = "oci"
+	default:
 		repo.Type = "git"
 	}
 	repo.EnableOCI = repo.EnableOCI || isHelmOci
@@ -397,7 +413,7 @@ func validateRepo(ctx context.Context,
 		if err != nil {
 			return nil, err
 		}
-		if err := TestRepoWithKnownType(ctx, repoClient, repo, source.IsHelm(), source.IsHelmOci()); err != nil {
+		if err := TestRepoWithKnownType(ctx, repoClient, repo, source.IsHelm(), source.IsHelmOci(), source.IsOCI()); err != nil {
 			errMessage = fmt.Sprintf("repositories not accessible: %v: %v", repo.StringForLogging(), err)
 		}
 		repoAccessible := false
@@ -474,6 +490,15 @@ func GetRefSources(ctx context.Context, sources argoappv1.ApplicationSources, pr
 				refKeys[refKey] = true
 			}
 		}
+		// Sources generating Helm values for other sources are rendered by the repo server, so they are sent along
+		valuesFromRefs := make(map[string]bool)
+		for _, source := range sources {
+			if source.Helm != nil {
+				for _, valuesFrom := range source.Helm.ValuesFrom {
+					valuesFromRefs["$"+valuesFrom.Ref] = true
+				}
+			}
+		}
 		// Get Repositories for all sources before generating Manifests
 		for i, source := range sources {
 			if source.Ref != "" {
@@ -491,12 +516,72 @@ func GetRefSources(ctx context.Context, sources argoappv1.ApplicationSources, pr
 					TargetRevision: revision,
 					Chart:          source.Chart,
 				}
+				if valuesFromRefs[refKey] {
+					refSource := source.DeepCopy()
+					refSource.TargetRevision = revision
+					refSources[refKey].Source = refSource
+				}
+			}
+		}
+	}
+	// The value files of other repositories are referenced by single and multiple sources applications
+	for _, source := range sources {
+		repoValueFiles, err := GetRepoValueFiles(source)
+		if err != nil {
+			return nil, err
+		}
+		for _, repoValueFile := range repoValueFiles {
+			refKey := repoValueFile.RefKey()
+			if _, ok := refSources[refKey]; ok {
+				continue
+			}
+			repo, err := getRepository(ctx, repoValueFile.RepoURL, project)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get repository %s: %w", repoValueFile.RepoURL, err)
+			}
+			refSources[refKey] = &argoappv1.RefTarget{
+				Repo:           *repo,
+				TargetRevision: repoValueFile.Revision,
 			}
 		}
 	}
 	return refSources, nil
 }
 
+// validateRepoValueFiles validates the value files of other repositories referenced by the source, which must be
+// permitted by the project like the repositories of the sources
+func validateRepoValueFiles(source argoappv1.ApplicationSource, proj *argoappv1.AppProject) []argoappv1.ApplicationCondition {
+	var conditions []argoappv1.ApplicationCondition
+	repoValueFiles, err := GetRepoValueFiles(source)
+	if err != nil {
+		return append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: err.Error(),
+		})
+	}
+	for _, repoValueFile := range repoValueFiles {
+		if !proj.IsSourcePermitted(argoappv1.ApplicationSource{RepoURL: repoValueFile.RepoURL}) {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("value file repo %s is not permitted in project '%s'", repoValueFile.RepoURL, proj.Name),
+			})
+		}
+	}
+	return conditions
+}
+
+// validateSourceRestrictions validates the path and the target revision of the source, which must be permitted by the
+// source restrictions of the project
+func validateSourceRestrictions(source argoappv1.ApplicationSource, proj *argoappv1.AppProject) []argoappv1.ApplicationCondition {
+	if err := proj.CheckSourceRestrictions(source, ""); err != nil {
+		return []argoappv1.ApplicationCondition{{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: err.Error(),
+		}}
+	}
+	return nil
+}
+
 func validateSourcePermissions(source argoappv1.ApplicationSource, hasMultipleSources bool) []argoappv1.ApplicationCondition {
 	var conditions []argoappv1.ApplicationCondition
 	if hasMultipleSources {
@@ -567,6 +652,7 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 				Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", spec.GetSource().RepoURL, spec.Project),
 			})
 		}
+		conditions = append(conditions, validateSourceRestrictions(spec.SourceHydrator.GetDrySource(), proj)...)
 	case spec.HasMultipleSources():
 		for _, source := range spec.Sources {
 			condition := validateSourcePermissions(source, spec.HasMultipleSources())
@@ -581,6 +667,8 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 					Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", source.RepoURL, spec.Project),
 				})
 			}
+			conditions = append(conditions, validateRepoValueFiles(source, proj)...)
+			conditions = append(conditions, validateSourceRestrictions(source, proj)...)
 		}
 	default:
 		conditions = validateSourcePermissions(spec.GetSource(), spec.HasMultipleSources())
@@ -594,6 +682,22 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 				Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", spec.GetSource().RepoURL, spec.Project),
 			})
 		}
+		conditions = append(conditions, validateRepoValueFiles(spec.GetSource(), proj)...)
+		conditions = append(conditions, validateSourceRestrictions(spec.GetSource(), proj)...)
+	}
+
+	if _, err := spec.SyncPolicy.GetReconcileInterval(); err != nil {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: err.Error(),
+		})
+	}
+
+	if _, err := spec.SyncPolicy.GetPriority(); err != nil {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: err.Error(),
+		})
 	}
 
 	destCluster, err := GetDestinationCluster(ctx, spec.Destination, db)
@@ -618,6 +722,27 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 				Message: fmt.Sprintf("application destination server '%s' and namespace '%s' do not match any of the allowed destinations in project '%s'", spec.Destination.Server, spec.Destination.Namespace, spec.Project),
 			})
 		}
+		for _, mapping := range spec.DestinationNamespaces {
+			if _, err := metav1.LabelSelectorAsSelector(&mapping.Selector); err != nil {
+				conditions = append(conditions, argoappv1.ApplicationCondition{
+					Type:    argoappv1.ApplicationConditionInvalidSpecError,
+					Message: fmt.Sprintf("invalid selector of destination namespace '%s': %v", mapping.Namespace, err),
+				})
+				continue
+			}
+			permitted, err := proj.IsDestinationPermitted(destCluster, mapping.Namespace, func(project string) ([]*argoappv1.Cluster, error) {
+				return db.GetProjectClusters(ctx, project)
+			})
+			if err != nil {
+				return nil, err
+			}
+			if !permitted {
+				conditions = append(conditions, argoappv1.ApplicationCondition{
+					Type:    argoappv1.ApplicationConditionInvalidSpecError,
+					Message: fmt.Sprintf("application destination server '%s' and namespace '%s' do not match any of the allowed destinations in project '%s'", spec.Destination.Server, mapping.Namespace, spec.Project),
+				})
+			}
+		}
 	} else if destCluster.Server == "" {
 		conditions = append(conditions, argoappv1.ApplicationCondition{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: ErrDestinationMissing})
 	}
@@ -774,7 +899,7 @@ func verifyGenerateManifests(
 		}
 
 		verifySignature := false
-		if len(proj.Spec.SignatureKeys) > 0 && gpg.IsGPGEnabled() {
+		if proj.IsSignatureRequired(source.Path) && gpg.IsGPGEnabled() {
 			verifySignature = true
 		}
 
@@ -1047,13 +1172,61 @@ func GetGlobalProjects(proj *argoappv1.AppProject, projLister applicationsv1.App
 		if err != nil {
 			break
 		}
+		if len(gp.Sections) > 0 {
+			globalProj = getInheritedGlobalProjectSections(proj, globalProj, gp.Sections)
+			if globalProj == nil {
+				continue
+			}
+		}
 		globalProjects = append(globalProjects, globalProj)
 	}
 	return globalProjects
 }
 
+// getInheritedGlobalProjectSections returns a copy of the global project holding only the sections whose label
+// selector matches the project, or nil if the project inherits no section
+func getInheritedGlobalProjectSections(proj *argoappv1.AppProject, globalProj *argoappv1.AppProject, sections []settings.GlobalProjectSection) *argoappv1.AppProject {
+	inherited := &argoappv1.AppProject{ObjectMeta: *globalProj.ObjectMeta.DeepCopy()}
+	matched := false
+	for _, section := range sections {
+		selector, err := metav1.LabelSelectorAsSelector(&section.LabelSelector)
+		if err != nil {
+			log.Warnf("Invalid label selector of section '%s' of global project '%s': %v", section.Name, globalProj.Name, err)
+			continue
+		}
+		if !selector.Matches(labels.Set(proj.Labels)) {
+			continue
+		}
+		matched = true
+		spec := globalProj.Spec.DeepCopy()
+		switch section.Name {
+		case settings.GlobalProjectSectionClusterResourceWhitelist:
+			inherited.Spec.ClusterResourceWhitelist = spec.ClusterResourceWhitelist
+		case settings.GlobalProjectSectionClusterResourceBlacklist:
+			inherited.Spec.ClusterResourceBlacklist = spec.ClusterResourceBlacklist
+		case settings.GlobalProjectSectionNamespaceResourceWhitelist:
+			inherited.Spec.NamespaceResourceWhitelist = spec.NamespaceResourceWhitelist
+		case settings.GlobalProjectSectionNamespaceResourceBlacklist:
+			inherited.Spec.NamespaceResourceBlacklist = spec.NamespaceResourceBlacklist
+		case settings.GlobalProjectSectionSyncWindows:
+			inherited.Spec.SyncWindows = spec.SyncWindows
+		case settings.GlobalProjectSectionSourceRepos:
+			inherited.Spec.SourceRepos = spec.SourceRepos
+		case settings.GlobalProjectSectionDestinations:
+			inherited.Spec.Destinations = spec.Destinations
+		}
+	}
+	if !matched {
+		return nil
+	}
+	return inherited
+}
+
 func GetAppVirtualProject(proj *argoappv1.AppProject, projLister applicationsv1.AppProjectLister, settingsManager *settings.SettingsManager) (*argoappv1.AppProject, error) {
-	virtualProj := proj.DeepCopy()
+	virtualProj, err := proj.WithParentProjects(projLister.AppProjects(proj.Namespace).Get)
+	if err != nil {
+		return nil, err
+	}
 	globalProjects := GetGlobalProjects(proj, projLister, settingsManager)
 
 	for _, gp := range globalProjects {
@@ -49,6 +49,24 @@ func TestRefreshApp(t *testing.T) {
 	// assert.True(t, ok)
 }
 
+func TestRefreshAppResources(t *testing.T) {
+	var testApp argoappv1.Application
+	testApp.Name = "test-app"
+	testApp.Namespace = "default"
+	appClientset := appclientset.NewSimpleClientset(&testApp)
+	appIf := appClientset.ArgoprojV1alpha1().Applications("default")
+
+	app, err := RefreshAppResources(appIf, "test-app", argoappv1.RefreshTypeHard, false, []string{"apps:Deployment:guestbook", ":Service:default/guestbook"})
+	require.NoError(t, err)
+	assert.Equal(t, "apps:Deployment:guestbook,:Service:default/guestbook", app.Annotations[argoappv1.AnnotationKeyRefreshResources])
+
+	// an unscoped refresh removes the resources of the pending refresh
+	app, err = RefreshApp(appIf, "test-app", argoappv1.RefreshTypeHard, false)
+	require.NoError(t, err)
+	assert.NotContains(t, app.Annotations, argoappv1.AnnotationKeyRefreshResources)
+	assert.Equal(t, string(argoappv1.RefreshTypeHard), app.Annotations[argoappv1.AnnotationKeyRefresh])
+}
+
 func TestGetAppProjectWithNoProjDefined(t *testing.T) {
 	projName := "default"
 	namespace := "default"
@@ -710,6 +728,80 @@ func TestValidatePermissions(t *testing.T) {
 		assert.Contains(t, conditions[0].Message, "application repo http://some/where is not permitted")
 	})
 
+	t.Run("Value file repo is not permitted in project", func(t *testing.T) {
+		spec := argoappv1.ApplicationSpec{
+			Source: &argoappv1.ApplicationSource{
+				RepoURL:        "http://some/where",
+				Path:           "chart",
+				TargetRevision: "HEAD",
+				Helm: &argoappv1.ApplicationSourceHelm{
+					ValueFiles: []string{"repo://http://some/where/else.git/values.yaml"},
+				},
+			},
+			Destination: argoappv1.ApplicationDestination{
+				Server:    "https://127.0.0.1:6443",
+				Namespace: "testns",
+			},
+		}
+		proj := argoappv1.AppProject{
+			Spec: argoappv1.AppProjectSpec{
+				Destinations: []argoappv1.ApplicationDestination{
+					{
+						Server:    "*",
+						Namespace: "*",
+					},
+				},
+				SourceRepos: []string{"http://some/where"},
+			},
+		}
+		cluster := &argoappv1.Cluster{Server: "https://127.0.0.1:6443", Name: "test"}
+		db := &dbmocks.ArgoDB{}
+		db.On("GetCluster", t.Context(), spec.Destination.Server).Return(cluster, nil)
+		conditions, err := ValidatePermissions(t.Context(), &spec, &proj, db)
+		require.NoError(t, err)
+		assert.Len(t, conditions, 1)
+		assert.Contains(t, conditions[0].Message, "value file repo http://some/where/else.git is not permitted")
+	})
+
+	t.Run("Source target revision is not permitted in project", func(t *testing.T) {
+		spec := argoappv1.ApplicationSpec{
+			Source: &argoappv1.ApplicationSource{
+				RepoURL:        "http://some/where",
+				Path:           "apps/guestbook",
+				TargetRevision: "main",
+			},
+			Destination: argoappv1.ApplicationDestination{
+				Server:    "https://127.0.0.1:6443",
+				Namespace: "testns",
+			},
+		}
+		proj := argoappv1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "prod"},
+			Spec: argoappv1.AppProjectSpec{
+				Destinations: []argoappv1.ApplicationDestination{
+					{
+						Server:    "*",
+						Namespace: "*",
+					},
+				},
+				SourceRepos:        []string{"http://some/where"},
+				SourceRestrictions: []argoappv1.SourceRestriction{{Paths: []string{"apps/*"}, TargetRevisions: []string{"release/*"}}},
+			},
+		}
+		cluster := &argoappv1.Cluster{Server: "https://127.0.0.1:6443", Name: "test"}
+		db := &dbmocks.ArgoDB{}
+		db.On("GetCluster", t.Context(), spec.Destination.Server).Return(cluster, nil)
+		conditions, err := ValidatePermissions(t.Context(), &spec, &proj, db)
+		require.NoError(t, err)
+		assert.Len(t, conditions, 1)
+		assert.Equal(t, "target revision main of repo http://some/where is not permitted in project 'prod'", conditions[0].Message)
+
+		spec.Source.TargetRevision = "release/1.0"
+		conditions, err = ValidatePermissions(t.Context(), &spec, &proj, db)
+		require.NoError(t, err)
+		assert.Empty(t, conditions)
+	})
+
 	t.Run("Application destination is not permitted in project", func(t *testing.T) {
 		spec := argoappv1.ApplicationSpec{
 			Source: &argoappv1.ApplicationSource{
@@ -743,6 +835,45 @@ func TestValidatePermissions(t *testing.T) {
 		assert.Contains(t, conditions[0].Message, "application destination")
 	})
 
+	t.Run("Application destination namespaces are validated against the project", func(t *testing.T) {
+		spec := argoappv1.ApplicationSpec{
+			Source: &argoappv1.ApplicationSource{
+				RepoURL:        "http://some/where",
+				Path:           "",
+				Chart:          "somechart",
+				TargetRevision: "1.4.1",
+			},
+			Destination: argoappv1.ApplicationDestination{
+				Server:    "https://127.0.0.1:6443",
+				Namespace: "default",
+			},
+			DestinationNamespaces: []argoappv1.DestinationNamespaceMapping{
+				{Namespace: "default", Selector: metav1.LabelSelector{MatchLabels: map[string]string{"tier": "frontend"}}},
+				{Namespace: "testns", Selector: metav1.LabelSelector{MatchLabels: map[string]string{"tier": "backend"}}},
+				{Namespace: "default", Selector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: "Invalid"}}}},
+			},
+		}
+		proj := argoappv1.AppProject{
+			Spec: argoappv1.AppProjectSpec{
+				Destinations: []argoappv1.ApplicationDestination{
+					{
+						Server:    "*",
+						Namespace: "default",
+					},
+				},
+				SourceRepos: []string{"http://some/where"},
+			},
+		}
+		cluster := &argoappv1.Cluster{Server: "https://127.0.0.1:6443", Name: "test"}
+		db := &dbmocks.ArgoDB{}
+		db.On("GetCluster", t.Context(), spec.Destination.Server).Return(cluster, nil)
+		conditions, err := ValidatePermissions(t.Context(), &spec, &proj, db)
+		require.NoError(t, err)
+		require.Len(t, conditions, 2)
+		assert.Contains(t, conditions[0].Message, "namespace 'testns' do not match any of the allowed destinations")
+		assert.Contains(t, conditions[1].Message, "invalid selector of destination namespace 'default'")
+	})
+
 	t.Run("Destination cluster does not exist", func(t *testing.T) {
 		spec := argoappv1.ApplicationSpec{
 			Source: &argoappv1.ApplicationSource{
@@ -1163,6 +1294,139 @@ func TestGetGlobalProjects(t *testing.T) {
 		assert.Len(t, nonXGlobalProjects, 1)
 		assert.Equal(t, "default-non-x", nonXGlobalProjects[0].Name)
 	})
+
+	t.Run("Partial inheritance", func(t *testing.T) {
+		namespace := "default"
+
+		cm := corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "argocd-cm",
+				Namespace: test.FakeArgoCDNamespace,
+				Labels: map[string]string{
+					"app.kubernetes.io/part-of": "argocd",
+				},
+			},
+			Data: map[string]string{
+				"globalProjects": `
+ - projectName: global
+   labelSelector:
+     matchExpressions:
+      - key: team
+        operator: Exists
+   sections:
+    - name: clusterResourceBlacklist
+    - name: sourceRepos
+      labelSelector:
+        matchLabels:
+          inherit-repos: "true"
+`,
+			},
+		}
+
+		global := &argoappv1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "global", Namespace: namespace},
+			Spec: argoappv1.AppProjectSpec{
+				ClusterResourceBlacklist: []metav1.GroupKind{{Kind: "Volume"}},
+				SourceRepos:              []string{"https://github.com/org/*"},
+				Destinations:             []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			},
+		}
+		withRepos := &argoappv1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "with-repos",
+				Namespace: namespace,
+				Labels:    map[string]string{"team": "a", "inherit-repos": "true"},
+			},
+		}
+		withoutRepos := &argoappv1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "without-repos",
+				Namespace: namespace,
+				Labels:    map[string]string{"team": "b"},
+			},
+		}
+
+		projClientset := appclientset.NewSimpleClientset(global, withRepos, withoutRepos)
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+		indexers := cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}
+		informer := v1alpha1.NewAppProjectInformer(projClientset, namespace, 0, indexers)
+		go informer.Run(ctx.Done())
+		cache.WaitForCacheSync(ctx.Done(), informer.HasSynced)
+
+		kubeClient := fake.NewSimpleClientset(&cm)
+		settingsMgr := settings.NewSettingsManager(t.Context(), kubeClient, test.FakeArgoCDNamespace)
+		projLister := applisters.NewAppProjectLister(informer.GetIndexer())
+
+		globalProjects := GetGlobalProjects(withRepos, projLister, settingsMgr)
+		require.Len(t, globalProjects, 1)
+		assert.Equal(t, "global", globalProjects[0].Name)
+		assert.Equal(t, []metav1.GroupKind{{Kind: "Volume"}}, globalProjects[0].Spec.ClusterResourceBlacklist)
+		assert.Equal(t, []string{"https://github.com/org/*"}, globalProjects[0].Spec.SourceRepos)
+		assert.Empty(t, globalProjects[0].Spec.Destinations)
+
+		globalProjects = GetGlobalProjects(withoutRepos, projLister, settingsMgr)
+		require.Len(t, globalProjects, 1)
+		assert.Equal(t, []metav1.GroupKind{{Kind: "Volume"}}, globalProjects[0].Spec.ClusterResourceBlacklist)
+		assert.Empty(t, globalProjects[0].Spec.SourceRepos)
+		assert.Empty(t, globalProjects[0].Spec.Destinations)
+
+		// the global project in the cache must not be modified
+		cached, err := projLister.AppProjects(namespace).Get("global")
+		require.NoError(t, err)
+		assert.Len(t, cached.Spec.Destinations, 1)
+
+		virtualProj, err := GetAppVirtualProject(withoutRepos, projLister, settingsMgr)
+		require.NoError(t, err)
+		assert.Equal(t, []metav1.GroupKind{{Kind: "Volume"}}, virtualProj.Spec.ClusterResourceBlacklist)
+		assert.Empty(t, virtualProj.Spec.SourceRepos)
+	})
+}
+
+func TestGetAppVirtualProjectWithParentProject(t *testing.T) {
+	namespace := "default"
+	orgProj := &argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "org", Namespace: namespace},
+		Spec: argoappv1.AppProjectSpec{
+			SourceRepos:  []string{"https://github.com/org/*"},
+			Destinations: []argoappv1.ApplicationDestination{{Server: "https://prod", Namespace: "shared"}},
+		},
+	}
+	teamProj := &argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "team", Namespace: namespace},
+		Spec: argoappv1.AppProjectSpec{
+			ParentProject: "org",
+			SourceRepos:   []string{"https://github.com/team/*"},
+		},
+	}
+	orphanProj := &argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "orphan", Namespace: namespace},
+		Spec:       argoappv1.AppProjectSpec{ParentProject: "missing"},
+	}
+
+	projClientset := appclientset.NewSimpleClientset(orgProj, teamProj, orphanProj)
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	indexers := cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}
+	informer := v1alpha1.NewAppProjectInformer(projClientset, namespace, 0, indexers)
+	go informer.Run(ctx.Done())
+	cache.WaitForCacheSync(ctx.Done(), informer.HasSynced)
+
+	kubeClient := fake.NewSimpleClientset(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+		Name:      "argocd-cm",
+		Namespace: test.FakeArgoCDNamespace,
+		Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+	}})
+	settingsMgr := settings.NewSettingsManager(t.Context(), kubeClient, test.FakeArgoCDNamespace)
+	projLister := applisters.NewAppProjectLister(informer.GetIndexer())
+
+	proj, err := GetAppVirtualProject(teamProj, projLister, settingsMgr)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://github.com/team/*", "https://github.com/org/*"}, proj.Spec.SourceRepos)
+	assert.Equal(t, []argoappv1.ApplicationDestination{{Server: "https://prod", Namespace: "shared"}}, proj.Spec.Destinations)
+
+	_, err = GetAppVirtualProject(orphanProj, projLister, settingsMgr)
+	require.ErrorContains(t, err, "error getting parent project 'missing'")
 }
 
 func Test_GetDifferentPathsBetweenStructs(t *testing.T) {
@@ -1316,6 +1580,26 @@ func Test_GetRefSources(t *testing.T) {
 		assert.Equal(t, expectedRefSource, refSources)
 	})
 
+	t.Run("target ref generating values", func(t *testing.T) {
+		argoSpec := getMultiSourceAppSpec(argoappv1.ApplicationSources{
+			{RepoURL: "file://" + repoPath, Path: "config", TargetRevision: "main", Ref: "config"},
+			{RepoURL: "file://" + repoPath, Path: "chart", Helm: &argoappv1.ApplicationSourceHelm{
+				ValuesFrom: []argoappv1.HelmValuesFromSource{{Ref: "config", ConfigMap: "chart-values"}},
+			}},
+		})
+
+		refSources, err := GetRefSources(t.Context(), argoSpec.Sources, argoSpec.Project, func(_ context.Context, _ string, _ string) (*argoappv1.Repository, error) {
+			return repo, nil
+		}, []string{"abc123", ""}, true)
+
+		require.NoError(t, err)
+		require.Len(t, refSources, 1)
+		assert.Equal(t, "abc123", refSources["$config"].TargetRevision)
+		require.NotNil(t, refSources["$config"].Source)
+		assert.Equal(t, "config", refSources["$config"].Source.Path)
+		assert.Equal(t, "abc123", refSources["$config"].Source.TargetRevision)
+	})
+
 	t.Run("target ref does not exist", func(t *testing.T) {
 		argoSpec := getMultiSourceAppSpec(argoappv1.ApplicationSources{
 			{RepoURL: "file://does-not-exist", Ref: "source1"},
@@ -1357,6 +1641,33 @@ func Test_GetRefSources(t *testing.T) {
 		require.Error(t, err)
 		assert.Empty(t, refSources)
 	})
+
+	t.Run("value files of other repositories", func(t *testing.T) {
+		sources := argoappv1.ApplicationSources{{
+			RepoURL: "file://" + repoPath,
+			Path:    "chart",
+			Helm: &argoappv1.ApplicationSourceHelm{ValueFiles: []string{
+				"values.yaml",
+				"repo://https://github.com/org/env-values.git/prod/values.yaml?ref=v1.0.0",
+				"repo://https://github.com/org/env-values.git/prod/secrets.yaml?ref=v1.0.0",
+			}},
+		}}
+		envRepo := &argoappv1.Repository{Repo: "https://github.com/org/env-values.git"}
+
+		refSources, err := GetRefSources(t.Context(), sources, "default", func(_ context.Context, url string, _ string) (*argoappv1.Repository, error) {
+			assert.Equal(t, "https://github.com/org/env-values.git", url)
+			return envRepo, nil
+		}, []string{}, false)
+
+		require.NoError(t, err)
+		expectedRefSource := argoappv1.RefTargetRevisionMapping{
+			"repo://https://github.com/org/env-values@v1.0.0": &argoappv1.RefTarget{
+				Repo:           *envRepo,
+				TargetRevision: "v1.0.0",
+			},
+		}
+		assert.Equal(t, expectedRefSource, refSources)
+	})
 }
 
 func TestValidatePermissionsMultipleSources(t *testing.T) {
@@ -44,6 +44,9 @@ const (
 	EventReasonResourceActionRan  = "ResourceActionRan"
 	EventReasonOperationStarted   = "OperationStarted"
 	EventReasonOperationCompleted = "OperationCompleted"
+	EventReasonDriftReverted      = "DriftReverted"
+	EventReasonAccessGranted      = "AccessGranted"
+	EventReasonAccessRevoked      = "AccessRevoked"
 )
 
 func (l *AuditLogger) logEvent(objMeta ObjectRef, gvk schema.GroupVersionKind, info EventInfo, message string, logFields map[string]string, eventLabels map[string]string) {
@@ -179,6 +182,25 @@ func (l *AuditLogger) LogAppProjEvent(proj *v1alpha1.AppProject, info EventInfo,
 	l.logEvent(objectMeta, v1alpha1.AppProjectSchemaGroupVersionKind, info, message, nil, nil)
 }
 
+// LogAccessGrantEvent records an event of the ConfigMap of the emergency access grants. The event is recorded even if
+// its reason is not enabled, since the emergency accesses must always be audited.
+func (l *AuditLogger) LogAccessGrantEvent(cm *corev1.ConfigMap, info EventInfo, message, user string, fields map[string]string) {
+	objectMeta := ObjectRef{
+		Name:            cm.Name,
+		Namespace:       cm.Namespace,
+		ResourceVersion: cm.ResourceVersion,
+		UID:             cm.UID,
+	}
+	logFields := map[string]string{}
+	for field, val := range fields {
+		logFields[field] = val
+	}
+	if user != "" {
+		logFields["user"] = user
+	}
+	l.logEvent(objectMeta, corev1.SchemeGroupVersion.WithKind("ConfigMap"), info, message, logFields, nil)
+}
+
 func NewAuditLogger(ns string, kIf kubernetes.Interface, component string, enableK8sEvent []string) *AuditLogger {
 	return &AuditLogger{
 		ns:             ns,
@@ -19,6 +19,7 @@ import (
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube/scheme"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 // DiffConfigBuilder is used as a safe way to create valid DiffConfigs.
@@ -74,6 +75,13 @@ func (b *DiffConfigBuilder) WithCache(s *appstatecache.Cache, appName string) *D
 	return b
 }
 
+// WithRefreshedResources sets the resources whose cached diff is ignored, so that they are compared again while the
+// cached diff of the other resources is reused.
+func (b *DiffConfigBuilder) WithRefreshedResources(resources []v1alpha1.SyncOperationResource) *DiffConfigBuilder {
+	b.diffConfig.refreshedResources = resources
+	return b
+}
+
 // WithLogger sets the logger in the diff config.
 func (b *DiffConfigBuilder) WithLogger(l logr.Logger) *DiffConfigBuilder {
 	b.diffConfig.logger = &l
@@ -177,6 +185,7 @@ type diffConfig struct {
 	appName               string
 	noCache               bool
 	stateCache            *appstatecache.Cache
+	refreshedResources    []v1alpha1.SyncOperationResource
 	ignoreAggregatedRoles bool
 	logger                *logr.Logger
 	gvkParser             *k8smanagedfields.GvkParser
@@ -403,11 +412,32 @@ func (c *diffConfig) DiffFromCache(appName string) (bool, []*v1alpha1.ResourceDi
 			log.Errorf("DiffFromCache error: error getting managed resources for app %s: %s", appName, err)
 			return false, nil
 		}
-		return true, cachedDiff
+		return true, c.withoutRefreshedResources(cachedDiff)
 	}
 	return false, nil
 }
 
+// withoutRefreshedResources removes the refreshed resources from the cached diff
+func (c *diffConfig) withoutRefreshedResources(cachedDiff []*v1alpha1.ResourceDiff) []*v1alpha1.ResourceDiff {
+	if len(c.refreshedResources) == 0 {
+		return cachedDiff
+	}
+	filtered := make([]*v1alpha1.ResourceDiff, 0, len(cachedDiff))
+	for _, res := range cachedDiff {
+		refreshed := false
+		for _, r := range c.refreshedResources {
+			if r.HasIdentity(res.Name, res.Namespace, schema.GroupVersionKind{Group: res.Group, Kind: res.Kind}) {
+				refreshed = true
+				break
+			}
+		}
+		if !refreshed {
+			filtered = append(filtered, res)
+		}
+	}
+	return filtered
+}
+
 // preDiffNormalize applies the normalization of live and target resources before invoking
 // the diff. None of the attributes in the lives and targets params will be modified.
 func preDiffNormalize(lives, targets []*unstructured.Unstructured, diffConfig DiffConfig) (*NormalizationResult, error) {
@@ -2,6 +2,7 @@ package diff_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -12,6 +13,7 @@ import (
 	argo "github.com/argoproj/argo-cd/v3/util/argo/diff"
 	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
 	"github.com/argoproj/argo-cd/v3/util/argo/testdata"
+	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
 	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
 )
 
@@ -257,3 +259,42 @@ func TestDiffConfigBuilder(t *testing.T) {
 		require.Nil(t, diffConfig)
 	})
 }
+
+func TestDiffFromCache(t *testing.T) {
+	stateCache := appstatecache.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Minute)), time.Minute)
+	cachedDiff := []*v1alpha1.ResourceDiff{
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook"},
+		{Kind: "Service", Namespace: "default", Name: "guestbook"},
+		{Kind: "ConfigMap", Namespace: "default", Name: "guestbook"},
+	}
+	require.NoError(t, stateCache.SetAppManagedResources("app", cachedDiff))
+
+	t.Run("All resources", func(t *testing.T) {
+		diffConfig, err := argo.NewDiffConfigBuilder().
+			WithDiffSettings(nil, nil, false, normalizers.IgnoreNormalizerOpts{}).
+			WithCache(stateCache, "app").
+			Build()
+		require.NoError(t, err)
+		useCache, res := diffConfig.DiffFromCache("app")
+		assert.True(t, useCache)
+		assert.Len(t, res, 3)
+	})
+	t.Run("Refreshed resources", func(t *testing.T) {
+		diffConfig, err := argo.NewDiffConfigBuilder().
+			WithDiffSettings(nil, nil, false, normalizers.IgnoreNormalizerOpts{}).
+			WithCache(stateCache, "app").
+			WithRefreshedResources([]v1alpha1.SyncOperationResource{
+				{Group: "apps", Kind: "Deployment", Name: "guestbook"},
+				{Kind: "Service", Namespace: "other", Name: "guestbook"},
+			}).
+			Build()
+		require.NoError(t, err)
+		useCache, res := diffConfig.DiffFromCache("app")
+		assert.True(t, useCache)
+		var kinds []string
+		for _, r := range res {
+			kinds = append(kinds, r.Kind)
+		}
+		assert.ElementsMatch(t, []string{"Service", "ConfigMap"}, kinds)
+	})
+}
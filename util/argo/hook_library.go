@@ -0,0 +1,168 @@
+package argo
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/sync/hook"
+	resourceutil "github.com/argoproj/gitops-engine/pkg/sync/resource"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const (
+	// postDeleteHookType is the type of the hooks run on the deletion of the applications
+	postDeleteHookType     = "PostDelete"
+	helmHookAnnotation     = "helm.sh/hook"
+	helmPostDeleteHookType = "post-delete"
+)
+
+// GetLibraryHooks returns the hooks of the hook library referenced by the sync policy of the application, rendered for
+// the revision and tracked as the resources of the application
+func GetLibraryHooks(app *v1alpha1.Application, settingsMgr *settings.SettingsManager, resourceTracking ResourceTracking, controllerNamespace, revision string) ([]*unstructured.Unstructured, error) {
+	if app.Spec.SyncPolicy == nil || len(app.Spec.SyncPolicy.Hooks) == 0 {
+		return nil, nil
+	}
+	library, err := settingsMgr.GetHookLibrary()
+	if err != nil {
+		return nil, err
+	}
+	hooks, err := RenderLibraryHooks(app, library, revision)
+	if err != nil {
+		return nil, err
+	}
+	appLabelKey, err := settingsMgr.GetAppInstanceLabelKey()
+	if err != nil {
+		return nil, fmt.Errorf("error getting app instance label key: %w", err)
+	}
+	installationID, err := settingsMgr.GetInstallationID()
+	if err != nil {
+		return nil, fmt.Errorf("error getting installation ID: %w", err)
+	}
+	trackingMethod := GetTrackingMethod(settingsMgr)
+	for _, obj := range hooks {
+		err := resourceTracking.SetAppInstance(obj, appLabelKey, app.InstanceName(controllerNamespace), app.Spec.Destination.Namespace, trackingMethod, installationID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to set the tracking of %s/%s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+	}
+	return hooks, nil
+}
+
+// RenderLibraryHooks renders the hooks of the hook library referenced by the sync policy of the application. The
+// ${PARAMETER} references of the string values of the hooks are replaced by the parameters of the references and by
+// the variables of the application, e.g. ${ARGOCD_APP_NAME}.
+func RenderLibraryHooks(app *v1alpha1.Application, library map[string]string, revision string) ([]*unstructured.Unstructured, error) {
+	if app.Spec.SyncPolicy == nil {
+		return nil, nil
+	}
+	var hooks []*unstructured.Unstructured
+	for _, ref := range app.Spec.SyncPolicy.Hooks {
+		manifests, ok := library[ref.Name]
+		if !ok {
+			return nil, fmt.Errorf("hook %q is not defined in the hook library", ref.Name)
+		}
+		objs, err := renderLibraryHook(manifests, append(ref.Parameters, newLibraryHookEnv(app, revision)...))
+		if err != nil {
+			return nil, fmt.Errorf("failed to render hook %q of the hook library: %w", ref.Name, err)
+		}
+		hooks = append(hooks, objs...)
+	}
+	return hooks, nil
+}
+
+// newLibraryHookEnv returns the variables of the application available to the hooks of the hook library
+func newLibraryHookEnv(app *v1alpha1.Application, revision string) v1alpha1.Env {
+	return v1alpha1.Env{
+		&v1alpha1.EnvEntry{Name: "ARGOCD_APP_NAME", Value: app.Name},
+		&v1alpha1.EnvEntry{Name: "ARGOCD_APP_NAMESPACE", Value: app.Spec.Destination.Namespace},
+		&v1alpha1.EnvEntry{Name: "ARGOCD_APP_PROJECT_NAME", Value: app.Spec.GetProject()},
+		&v1alpha1.EnvEntry{Name: "ARGOCD_APP_REVISION", Value: revision},
+	}
+}
+
+func renderLibraryHook(manifests string, env v1alpha1.Env) ([]*unstructured.Unstructured, error) {
+	valByName := map[string]string{}
+	for _, item := range env {
+		valByName[item.Name] = item.Value
+	}
+	missing := map[string]bool{}
+	expand := func(value string) string {
+		return os.Expand(value, func(name string) string {
+			// allow escaping $ with $$
+			if name == "$" {
+				return "$"
+			}
+			val, ok := valByName[name]
+			if !ok {
+				missing[name] = true
+			}
+			return val
+		})
+	}
+	// the references are replaced once the manifests are parsed, so that the values can't change the structure of the
+	// hooks, e.g. add fields to them
+	objs, err := kube.SplitYAML([]byte(manifests))
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		expandLibraryHookStrings(obj.Object, expand)
+	}
+	if len(missing) > 0 {
+		names := make([]string, 0, len(missing))
+		for name := range missing {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("missing parameters: %s", strings.Join(names, ", "))
+	}
+	for _, obj := range objs {
+		// the post-delete hooks are run from the sources of the application on its deletion only
+		if !hook.IsHook(obj) || hasPostDeleteHookType(obj) {
+			return nil, fmt.Errorf("resource %s/%s is not a sync hook", obj.GetKind(), obj.GetName())
+		}
+	}
+	return objs, nil
+}
+
+// expandLibraryHookStrings replaces in place the references of the string values of a parsed hook
+func expandLibraryHookStrings(value any, expand func(string) string) any {
+	switch v := value.(type) {
+	case string:
+		return expand(v)
+	case map[string]any:
+		for key, item := range v {
+			v[key] = expandLibraryHookStrings(item, expand)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = expandLibraryHookStrings(item, expand)
+		}
+	}
+	return value
+}
+
+// IsPostDeleteHook returns true if the resource is a hook run on the deletion of the application, annotated either
+// with the PostDelete hook type or with the post-delete Helm hook. The annotation must hold the post-delete hook type
+// only, the resources annotated with several hook types are not run on the deletion of the application.
+func IsPostDeleteHook(obj *unstructured.Unstructured) bool {
+	if obj == nil {
+		return false
+	}
+	annotations := obj.GetAnnotations()
+	return annotations[synccommon.AnnotationKeyHook] == postDeleteHookType || annotations[helmHookAnnotation] == helmPostDeleteHookType
+}
+
+// hasPostDeleteHookType returns true if one of the hook types of the resource is a post-delete hook type
+func hasPostDeleteHookType(obj *unstructured.Unstructured) bool {
+	return slices.Contains(resourceutil.GetAnnotationCSVs(obj, synccommon.AnnotationKeyHook), postDeleteHookType) ||
+		slices.Contains(resourceutil.GetAnnotationCSVs(obj, helmHookAnnotation), helmPostDeleteHookType)
+}
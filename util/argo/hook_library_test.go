@@ -0,0 +1,122 @@
+package argo
+
+import (
+	"testing"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const dbMigrationHook = `apiVersion: batch/v1
+kind: Job
+metadata:
+  name: ${ARGOCD_APP_NAME}-db-migration
+  annotations:
+    argocd.argoproj.io/hook: PreSync
+spec:
+  template:
+    spec:
+      containers:
+      - name: migrate
+        image: ${IMAGE}
+        args: ["migrate", "--revision", "${ARGOCD_APP_REVISION}", "--cost", "$$5"]
+      restartPolicy: Never
+`
+
+func TestRenderLibraryHooks(t *testing.T) {
+	newApp := func(hooks ...v1alpha1.SyncHookReference) *v1alpha1.Application {
+		app := &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "argocd"},
+			Spec: v1alpha1.ApplicationSpec{
+				Destination: v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "default"},
+				Project:     "default",
+			},
+		}
+		if len(hooks) > 0 {
+			app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{Hooks: hooks}
+		}
+		return app
+	}
+	library := map[string]string{
+		"db-migration": dbMigrationHook,
+		"deployment":   "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: guestbook\n",
+		"post-delete":  "apiVersion: batch/v1\nkind: Job\nmetadata:\n  name: cleanup\n  annotations:\n    argocd.argoproj.io/hook: PostDelete\n",
+	}
+
+	t.Run("NoHooks", func(t *testing.T) {
+		hooks, err := RenderLibraryHooks(newApp(), library, "abc123")
+		require.NoError(t, err)
+		assert.Empty(t, hooks)
+	})
+
+	t.Run("Parameters", func(t *testing.T) {
+		app := newApp(v1alpha1.SyncHookReference{Name: "db-migration", Parameters: v1alpha1.Env{{Name: "IMAGE", Value: "migrate:v1"}}})
+		hooks, err := RenderLibraryHooks(app, library, "abc123")
+		require.NoError(t, err)
+		require.Len(t, hooks, 1)
+		assert.Equal(t, "my-app-db-migration", hooks[0].GetName())
+		assert.Equal(t, "PreSync", hooks[0].GetAnnotations()[synccommon.AnnotationKeyHook])
+		containers, _, err := unstructured.NestedSlice(hooks[0].Object, "spec", "template", "spec", "containers")
+		require.NoError(t, err)
+		container := containers[0].(map[string]any)
+		assert.Equal(t, "migrate:v1", container["image"])
+		assert.Equal(t, []any{"migrate", "--revision", "abc123", "--cost", "$5"}, container["args"])
+	})
+
+	t.Run("ParameterInjection", func(t *testing.T) {
+		// the values of the parameters can't add fields to the hooks
+		app := newApp(v1alpha1.SyncHookReference{Name: "db-migration", Parameters: v1alpha1.Env{{Name: "IMAGE", Value: "migrate:v1\n        securityContext:\n          privileged: true"}}})
+		hooks, err := RenderLibraryHooks(app, library, "abc123\"]\n        command: [\"sh")
+		require.NoError(t, err)
+		require.Len(t, hooks, 1)
+		containers, _, err := unstructured.NestedSlice(hooks[0].Object, "spec", "template", "spec", "containers")
+		require.NoError(t, err)
+		container := containers[0].(map[string]any)
+		assert.Equal(t, "migrate:v1\n        securityContext:\n          privileged: true", container["image"])
+		assert.Equal(t, []any{"migrate", "--revision", "abc123\"]\n        command: [\"sh", "--cost", "$5"}, container["args"])
+		assert.NotContains(t, container, "securityContext")
+		assert.NotContains(t, container, "command")
+	})
+
+	t.Run("MissingParameter", func(t *testing.T) {
+		_, err := RenderLibraryHooks(newApp(v1alpha1.SyncHookReference{Name: "db-migration"}), library, "abc123")
+		require.EqualError(t, err, `failed to render hook "db-migration" of the hook library: missing parameters: IMAGE`)
+	})
+
+	t.Run("UnknownHook", func(t *testing.T) {
+		_, err := RenderLibraryHooks(newApp(v1alpha1.SyncHookReference{Name: "unknown"}), library, "abc123")
+		require.EqualError(t, err, `hook "unknown" is not defined in the hook library`)
+	})
+
+	t.Run("NotAHook", func(t *testing.T) {
+		_, err := RenderLibraryHooks(newApp(v1alpha1.SyncHookReference{Name: "deployment"}), library, "abc123")
+		require.ErrorContains(t, err, "resource Deployment/guestbook is not a sync hook")
+	})
+
+	t.Run("PostDeleteHook", func(t *testing.T) {
+		_, err := RenderLibraryHooks(newApp(v1alpha1.SyncHookReference{Name: "post-delete"}), library, "abc123")
+		require.ErrorContains(t, err, "resource Job/cleanup is not a sync hook")
+	})
+}
+
+func TestIsPostDeleteHook(t *testing.T) {
+	newObj := func(annotations map[string]string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAnnotations(annotations)
+		return obj
+	}
+	assert.True(t, IsPostDeleteHook(newObj(map[string]string{"argocd.argoproj.io/hook": "PostDelete"})))
+	assert.True(t, IsPostDeleteHook(newObj(map[string]string{"helm.sh/hook": "post-delete"})))
+	// the controller only runs the hooks of the post-delete type alone on the deletion of the application
+	assert.False(t, IsPostDeleteHook(newObj(map[string]string{"argocd.argoproj.io/hook": "PreSync,PostDelete"})))
+	assert.True(t, hasPostDeleteHookType(newObj(map[string]string{"argocd.argoproj.io/hook": "PreSync,PostDelete"})))
+	assert.True(t, hasPostDeleteHookType(newObj(map[string]string{"helm.sh/hook": "pre-install,post-delete"})))
+	assert.False(t, IsPostDeleteHook(newObj(map[string]string{"argocd.argoproj.io/hook": "PostSync"})))
+	assert.False(t, IsPostDeleteHook(newObj(nil)))
+	assert.False(t, IsPostDeleteHook(nil))
+}
@@ -0,0 +1,43 @@
+package argo
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ManifestFilter selects manifests by group, kind, namespace and name. Empty fields match all the manifests.
+type ManifestFilter struct {
+	Group     string
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// Matches returns whether the manifest is selected by the filter
+func (f ManifestFilter) Matches(obj *unstructured.Unstructured) bool {
+	return (f.Group == "" || obj.GroupVersionKind().Group == f.Group) &&
+		(f.Kind == "" || obj.GetKind() == f.Kind) &&
+		(f.Namespace == "" || obj.GetNamespace() == f.Namespace) &&
+		(f.Name == "" || obj.GetName() == f.Name)
+}
+
+// ProjectManifestFields returns a copy of the manifest with only its apiVersion, its kind and the given fields, given
+// as dot-separated paths, e.g. metadata.name or spec.replicas. The manifest is returned as is if no fields are given.
+func ProjectManifestFields(obj *unstructured.Unstructured, fields []string) *unstructured.Unstructured {
+	if len(fields) == 0 {
+		return obj
+	}
+	projected := &unstructured.Unstructured{Object: map[string]any{}}
+	projected.SetAPIVersion(obj.GetAPIVersion())
+	projected.SetKind(obj.GetKind())
+	for _, field := range fields {
+		path := strings.Split(field, ".")
+		value, found, err := unstructured.NestedFieldCopy(obj.Object, path...)
+		if err != nil || !found {
+			continue
+		}
+		_ = unstructured.SetNestedField(projected.Object, value, path...)
+	}
+	return projected
+}
@@ -0,0 +1,44 @@
+package argo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newManifest(apiVersion, kind, namespace, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{"replicas": int64(2), "template": map[string]any{}},
+	}}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	return obj
+}
+
+func TestManifestFilter_Matches(t *testing.T) {
+	deployment := newManifest("apps/v1", "Deployment", "default", "guestbook-ui")
+	service := newManifest("v1", "Service", "default", "guestbook-ui")
+
+	assert.True(t, ManifestFilter{}.Matches(deployment))
+	assert.True(t, ManifestFilter{Kind: "Deployment", Name: "guestbook-ui"}.Matches(deployment))
+	assert.False(t, ManifestFilter{Kind: "Deployment", Name: "guestbook-ui"}.Matches(service))
+	assert.True(t, ManifestFilter{Group: "apps", Namespace: "default"}.Matches(deployment))
+	assert.False(t, ManifestFilter{Group: "apps"}.Matches(service))
+	assert.False(t, ManifestFilter{Namespace: "kube-system"}.Matches(deployment))
+	assert.False(t, ManifestFilter{Name: "other"}.Matches(deployment))
+}
+
+func TestProjectManifestFields(t *testing.T) {
+	deployment := newManifest("apps/v1", "Deployment", "default", "guestbook-ui")
+
+	assert.Same(t, deployment, ProjectManifestFields(deployment, nil))
+	assert.Equal(t, map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": "guestbook-ui"},
+		"spec":       map[string]any{"replicas": int64(2)},
+	}, ProjectManifestFields(deployment, []string{"metadata.name", "spec.replicas", "status.replicas"}).Object)
+}
@@ -0,0 +1,94 @@
+package argo
+
+import (
+	"fmt"
+	"strings"
+
+	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/git"
+)
+
+const (
+	// RepoValueFilePrefix is the prefix of the Helm value files of other repositories, e.g.
+	// repo://git@github.com:org/env-values.git/prod/values.yaml?ref=v1.0.0
+	RepoValueFilePrefix = "repo://"
+	// repoValueFileRevisionParam is the parameter of the revision of the repository holding the value file
+	repoValueFileRevisionParam = "?ref="
+	// repoValueFileDefaultRevision is the revision of the repository holding the value file if not specified
+	repoValueFileDefaultRevision = "HEAD"
+)
+
+// RepoValueFile is a Helm value file of another repository, referenced as
+// repo://<repository URL>/<path>[?ref=<revision>]. The repository URL ends either with ".git" or with "//" if it
+// doesn't end with ".git", e.g. repo://https://example.com/org/env-values//prod/values.yaml.
+type RepoValueFile struct {
+	// RepoURL is the URL of the repository holding the value file
+	RepoURL string
+	// Revision is the revision of the repository, HEAD if not specified
+	Revision string
+	// Path is the path of the value file, relative to the root of the repository
+	Path string
+}
+
+// IsRepoValueFile returns whether the value file references a file of another repository
+func IsRepoValueFile(valueFile string) bool {
+	return strings.HasPrefix(valueFile, RepoValueFilePrefix)
+}
+
+// ParseRepoValueFile parses a value file referencing a file of another repository
+func ParseRepoValueFile(valueFile string) (*RepoValueFile, error) {
+	ref, ok := strings.CutPrefix(valueFile, RepoValueFilePrefix)
+	if !ok {
+		return nil, fmt.Errorf("value file %q doesn't start with %s", valueFile, RepoValueFilePrefix)
+	}
+	revision := repoValueFileDefaultRevision
+	if i := strings.LastIndex(ref, repoValueFileRevisionParam); i >= 0 {
+		revision = ref[i+len(repoValueFileRevisionParam):]
+		ref = ref[:i]
+		if revision == "" {
+			return nil, fmt.Errorf("value file %q has an empty revision", valueFile)
+		}
+	}
+
+	var repoURL, path string
+	// the URLs of the repositories hold "//" after their scheme
+	schemeEnd := 0
+	if i := strings.Index(ref, "://"); i >= 0 {
+		schemeEnd = i + len("://")
+	}
+	if i := strings.Index(ref[schemeEnd:], "//"); i >= 0 {
+		repoURL, path = ref[:schemeEnd+i], ref[schemeEnd+i+len("//"):]
+	} else if i := strings.Index(ref, ".git/"); i >= 0 {
+		repoURL, path = ref[:i+len(".git")], ref[i+len(".git/"):]
+	} else {
+		return nil, fmt.Errorf("value file %q must separate the repository URL from the path with \".git/\" or \"//\"", valueFile)
+	}
+	if repoURL == "" || path == "" {
+		return nil, fmt.Errorf("value file %q must hold both a repository URL and a path", valueFile)
+	}
+	return &RepoValueFile{RepoURL: repoURL, Revision: revision, Path: path}, nil
+}
+
+// RefKey returns the key of the repository holding the value file in the referenced sources
+func (f *RepoValueFile) RefKey() string {
+	return RepoValueFilePrefix + git.NormalizeGitURL(f.RepoURL) + "@" + f.Revision
+}
+
+// GetRepoValueFiles returns the Helm value files of the source referencing files of other repositories
+func GetRepoValueFiles(source argoappv1.ApplicationSource) ([]*RepoValueFile, error) {
+	if source.Helm == nil {
+		return nil, nil
+	}
+	var valueFiles []*RepoValueFile
+	for _, valueFile := range source.Helm.ValueFiles {
+		if !IsRepoValueFile(valueFile) {
+			continue
+		}
+		repoValueFile, err := ParseRepoValueFile(valueFile)
+		if err != nil {
+			return nil, err
+		}
+		valueFiles = append(valueFiles, repoValueFile)
+	}
+	return valueFiles, nil
+}
@@ -0,0 +1,69 @@
+package argo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRepoValueFile(t *testing.T) {
+	testCases := []struct {
+		name      string
+		valueFile string
+		expected  *RepoValueFile
+		expectErr string
+	}{{
+		name:      "SSH URL ending with .git",
+		valueFile: "repo://git@github.com:org/env-values.git/prod/values.yaml",
+		expected:  &RepoValueFile{RepoURL: "git@github.com:org/env-values.git", Revision: "HEAD", Path: "prod/values.yaml"},
+	}, {
+		name:      "HTTPS URL with a revision",
+		valueFile: "repo://https://github.com/org/env-values.git/prod/values.yaml?ref=v1.0.0",
+		expected:  &RepoValueFile{RepoURL: "https://github.com/org/env-values.git", Revision: "v1.0.0", Path: "prod/values.yaml"},
+	}, {
+		name:      "URL separated from the path with //",
+		valueFile: "repo://https://example.com/org/env-values//prod/values.yaml?ref=main",
+		expected:  &RepoValueFile{RepoURL: "https://example.com/org/env-values", Revision: "main", Path: "prod/values.yaml"},
+	}, {
+		name:      "no separator",
+		valueFile: "repo://https://example.com/org/env-values/prod/values.yaml",
+		expectErr: "must separate the repository URL from the path",
+	}, {
+		name:      "empty path",
+		valueFile: "repo://https://example.com/org/env-values//",
+		expectErr: "must hold both a repository URL and a path",
+	}, {
+		name:      "empty revision",
+		valueFile: "repo://git@github.com:org/env-values.git/values.yaml?ref=",
+		expectErr: "has an empty revision",
+	}, {
+		name:      "not a repo value file",
+		valueFile: "values.yaml",
+		expectErr: "doesn't start with repo://",
+	}}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			valueFile, err := ParseRepoValueFile(tc.valueFile)
+			if tc.expectErr != "" {
+				require.ErrorContains(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, valueFile)
+		})
+	}
+}
+
+func TestRepoValueFile_RefKey(t *testing.T) {
+	first, err := ParseRepoValueFile("repo://https://github.com/Org/env-values.git/prod/values.yaml")
+	require.NoError(t, err)
+	second, err := ParseRepoValueFile("repo://https://github.com/org/env-values.git/staging/values.yaml?ref=HEAD")
+	require.NoError(t, err)
+	// the value files of the same repository and revision share the same checkout
+	assert.Equal(t, first.RefKey(), second.RefKey())
+
+	third, err := ParseRepoValueFile("repo://https://github.com/org/env-values.git/prod/values.yaml?ref=v1.0.0")
+	require.NoError(t, err)
+	assert.NotEqual(t, first.RefKey(), third.RefKey())
+}
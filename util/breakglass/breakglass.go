@@ -0,0 +1,362 @@
+package breakglass
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const (
+	grantKeyPrefix = "grant."
+	rolePrefix     = "role:"
+	// expiredRevoker is the revoker recorded for the grants revoked when they expire
+	expiredRevoker = "expiration"
+	// revokeExpiredInterval is the interval the expired grants are revoked at
+	revokeExpiredInterval = time.Minute
+)
+
+var errNothingToRevoke = errors.New("no expired grant to revoke")
+
+// Grant is an elevated role granted to a user for a limited time, in an emergency. The user is identified by the
+// issuer and the subject of its tokens.
+type Grant struct {
+	ID        string     `json:"id"`
+	Issuer    string     `json:"issuer"`
+	Subject   string     `json:"subject"`
+	Role      string     `json:"role"`
+	Reason    string     `json:"reason"`
+	GrantedBy string     `json:"grantedBy,omitempty"`
+	GrantedAt time.Time  `json:"grantedAt"`
+	ExpiresAt time.Time  `json:"expiresAt"`
+	RevokedBy string     `json:"revokedBy,omitempty"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+}
+
+// IsActive returns whether the grant is neither revoked nor expired at the given time
+func (g *Grant) IsActive(now time.Time) bool {
+	return g.RevokedAt == nil && now.Before(g.ExpiresAt)
+}
+
+// Store stores the grants in the argocd-access-grants-cm ConfigMap, keyed by their id. The revoked grants are kept as
+// a record of the emergency accesses, and every grant and revocation is recorded as a Kubernetes event of the
+// ConfigMap. Since the events expire, the API server also logs the grants and revocations it observes, so that they
+// can be kept by the log pipeline.
+type Store struct {
+	namespace   string
+	kubeClient  kubernetes.Interface
+	settingsMgr *settings.SettingsManager
+	auditLogger *argo.AuditLogger
+
+	mutex sync.Mutex
+	// data is the data of the ConfigMap the roles of the subjects were cached from
+	data   map[string]string
+	grants []Grant
+}
+
+// NewStore returns a new store of the grants
+func NewStore(namespace string, kubeClient kubernetes.Interface, settingsMgr *settings.SettingsManager, component string) *Store {
+	return &Store{
+		namespace:   namespace,
+		kubeClient:  kubeClient,
+		settingsMgr: settingsMgr,
+		auditLogger: argo.NewAuditLogger(namespace, kubeClient, component, argo.DefaultEnableEventList()),
+	}
+}
+
+// RoleName returns the name of the RBAC role of the given role, prefixed with role: if it is not already
+func RoleName(role string) string {
+	if strings.HasPrefix(role, rolePrefix) {
+		return role
+	}
+	return rolePrefix + role
+}
+
+func unmarshalGrants(data map[string]string) ([]Grant, error) {
+	var grants []Grant
+	for key, value := range data {
+		if !strings.HasPrefix(key, grantKeyPrefix) {
+			continue
+		}
+		var grant Grant
+		if err := json.Unmarshal([]byte(value), &grant); err != nil {
+			return nil, fmt.Errorf("error unmarshaling %s: %w", key, err)
+		}
+		grants = append(grants, grant)
+	}
+	sort.Slice(grants, func(i, j int) bool {
+		return grants[i].GrantedAt.Before(grants[j].GrantedAt)
+	})
+	return grants, nil
+}
+
+func marshalGrant(data map[string]string, grant Grant) error {
+	grantBytes, err := json.Marshal(grant)
+	if err != nil {
+		return fmt.Errorf("error marshaling grant %s: %w", grant.ID, err)
+	}
+	data[grantKeyPrefix+grant.ID] = string(grantBytes)
+	return nil
+}
+
+// update updates the ConfigMap with the given function, creating the ConfigMap if it does not exist, and returns the
+// updated ConfigMap
+func (s *Store) update(ctx context.Context, f func(data map[string]string) error) (*corev1.ConfigMap, error) {
+	var updated *corev1.ConfigMap
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := s.kubeClient.CoreV1().ConfigMaps(s.namespace).Get(ctx, common.ArgoCDAccessGrantsConfigMapName, metav1.GetOptions{})
+		create := apierrors.IsNotFound(err)
+		if create {
+			cm = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+				Name:      common.ArgoCDAccessGrantsConfigMapName,
+				Namespace: s.namespace,
+				// the label lets the settings manager cache the ConfigMap
+				Labels: map[string]string{"app.kubernetes.io/part-of": "argocd"},
+			}}
+		} else if err != nil {
+			return fmt.Errorf("error retrieving config map %s: %w", common.ArgoCDAccessGrantsConfigMapName, err)
+		}
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		if err := f(cm.Data); err != nil {
+			return err
+		}
+		if create {
+			updated, err = s.kubeClient.CoreV1().ConfigMaps(s.namespace).Create(ctx, cm, metav1.CreateOptions{})
+		} else {
+			updated, err = s.kubeClient.CoreV1().ConfigMaps(s.namespace).Update(ctx, cm, metav1.UpdateOptions{})
+		}
+		return err
+	})
+	return updated, err
+}
+
+// List returns the grants, including the revoked and expired ones
+func (s *Store) List(ctx context.Context) ([]Grant, error) {
+	cm, err := s.kubeClient.CoreV1().ConfigMaps(s.namespace).Get(ctx, common.ArgoCDAccessGrantsConfigMapName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving config map %s: %w", common.ArgoCDAccessGrantsConfigMapName, err)
+	}
+	return unmarshalGrants(cm.Data)
+}
+
+// Create grants the role to the subject of the tokens of the issuer for the given duration
+func (s *Store) Create(ctx context.Context, issuer, subject, role, reason, grantedBy string, duration time.Duration) (*Grant, error) {
+	if issuer == "" {
+		return nil, errors.New("issuer is required")
+	}
+	if subject == "" {
+		return nil, errors.New("subject is required")
+	}
+	if role == "" {
+		return nil, errors.New("role is required")
+	}
+	if reason == "" {
+		return nil, errors.New("reason is required")
+	}
+	if duration <= 0 {
+		return nil, errors.New("duration must be positive")
+	}
+	now := time.Now().UTC()
+	grant := Grant{
+		ID:        uuid.NewString(),
+		Issuer:    issuer,
+		Subject:   subject,
+		Role:      RoleName(role),
+		Reason:    reason,
+		GrantedBy: grantedBy,
+		GrantedAt: now,
+		ExpiresAt: now.Add(duration),
+	}
+	cm, err := s.update(ctx, func(data map[string]string) error {
+		return marshalGrant(data, grant)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.logEvent(cm, argo.EventReasonAccessGranted, grant, grantedBy,
+		fmt.Sprintf("Granted role %s to %s until %s: %s", grant.Role, grant.Subject, grant.ExpiresAt.Format(time.RFC3339), grant.Reason))
+	return &grant, nil
+}
+
+// Revoke revokes the grant with the given id
+func (s *Store) Revoke(ctx context.Context, id, revokedBy string) (*Grant, error) {
+	var grant Grant
+	cm, err := s.update(ctx, func(data map[string]string) error {
+		value, ok := data[grantKeyPrefix+id]
+		if !ok {
+			return fmt.Errorf("grant %s not found", id)
+		}
+		grant = Grant{}
+		if err := json.Unmarshal([]byte(value), &grant); err != nil {
+			return fmt.Errorf("error unmarshaling grant %s: %w", id, err)
+		}
+		if grant.RevokedAt != nil {
+			return fmt.Errorf("grant %s is already revoked", id)
+		}
+		now := time.Now().UTC()
+		grant.RevokedAt = &now
+		grant.RevokedBy = revokedBy
+		return marshalGrant(data, grant)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.logEvent(cm, argo.EventReasonAccessRevoked, grant, revokedBy, fmt.Sprintf("Revoked role %s of %s", grant.Role, grant.Subject))
+	return &grant, nil
+}
+
+// RevokeExpired revokes the grants which are expired
+func (s *Store) RevokeExpired(ctx context.Context) error {
+	var revoked []Grant
+	cm, err := s.update(ctx, func(data map[string]string) error {
+		revoked = nil
+		grants, err := unmarshalGrants(data)
+		if err != nil {
+			return err
+		}
+		now := time.Now().UTC()
+		for _, grant := range grants {
+			if grant.RevokedAt == nil && !grant.IsActive(now) {
+				grant.RevokedAt = &now
+				grant.RevokedBy = expiredRevoker
+				if err := marshalGrant(data, grant); err != nil {
+					return err
+				}
+				revoked = append(revoked, grant)
+			}
+		}
+		if len(revoked) == 0 {
+			return errNothingToRevoke
+		}
+		return nil
+	})
+	if errors.Is(err, errNothingToRevoke) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, grant := range revoked {
+		s.logEvent(cm, argo.EventReasonAccessRevoked, grant, expiredRevoker, fmt.Sprintf("Revoked expired role %s of %s", grant.Role, grant.Subject))
+	}
+	return nil
+}
+
+// Run revokes the expired grants periodically until the context is done
+func (s *Store) Run(ctx context.Context) {
+	ticker := time.NewTicker(revokeExpiredInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.RevokeExpired(ctx); err != nil {
+				log.Warnf("Failed to revoke the expired access grants: %v", err)
+			}
+		}
+	}
+}
+
+// GetGrantedRoles returns the roles granted to the subject of the tokens of the issuer which are neither revoked nor
+// expired. The grants are cached until the ConfigMap held by the settings manager changes.
+func (s *Store) GetGrantedRoles(issuer string, subject string) []string {
+	if issuer == "" || subject == "" {
+		return nil
+	}
+	grants, err := s.getGrants()
+	if err != nil {
+		log.Warnf("Failed to get the access grants: %v", err)
+		return nil
+	}
+	now := time.Now()
+	var roles []string
+	for _, grant := range grants {
+		if grant.IsActive(now) && grant.Issuer == issuer && grant.Subject == subject {
+			roles = append(roles, grant.Role)
+		}
+	}
+	return roles
+}
+
+func (s *Store) getGrants() ([]Grant, error) {
+	cm, err := s.settingsMgr.GetConfigMapByName(common.ArgoCDAccessGrantsConfigMapName)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.data != nil && maps.Equal(s.data, cm.Data) {
+		return s.grants, nil
+	}
+	grants, err := unmarshalGrants(cm.Data)
+	if err != nil {
+		return nil, err
+	}
+	// the grants loaded first are recorded by the ConfigMap, only the changes observed afterwards are logged
+	if s.data != nil {
+		logGrantChanges(s.grants, grants)
+	}
+	s.data = cm.Data
+	s.grants = grants
+	return grants, nil
+}
+
+// logGrantChanges logs the grants and the revocations which are not in the previous grants
+func logGrantChanges(previous []Grant, current []Grant) {
+	previousByID := map[string]Grant{}
+	for _, grant := range previous {
+		previousByID[grant.ID] = grant
+	}
+	for _, grant := range current {
+		logCtx := log.WithFields(log.Fields{
+			common.SecurityField: common.SecurityHigh,
+			"grant":              grant.ID,
+			"issuer":             grant.Issuer,
+			"subject":            grant.Subject,
+			"role":               grant.Role,
+			"reason":             grant.Reason,
+		})
+		previousGrant, ok := previousByID[grant.ID]
+		if !ok {
+			logCtx.WithFields(log.Fields{"grantedBy": grant.GrantedBy, "expiresAt": grant.ExpiresAt}).Warn("Access granted")
+		}
+		if grant.RevokedAt != nil && (!ok || previousGrant.RevokedAt == nil) {
+			logCtx.WithFields(log.Fields{"revokedBy": grant.RevokedBy, "revokedAt": *grant.RevokedAt}).Warn("Access revoked")
+		}
+	}
+}
+
+func (s *Store) logEvent(cm *corev1.ConfigMap, reason string, grant Grant, user string, message string) {
+	s.auditLogger.LogAccessGrantEvent(cm, argo.EventInfo{Type: corev1.EventTypeWarning, Reason: reason}, message, user, map[string]string{
+		"grant":   grant.ID,
+		"issuer":  grant.Issuer,
+		"subject": grant.Subject,
+		"role":    grant.Role,
+	})
+}
@@ -0,0 +1,146 @@
+package breakglass
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const testNamespace = "default"
+
+func newTestStore(t *testing.T) (*Store, *fake.Clientset) {
+	t.Helper()
+	kubeClient := fake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDConfigMapName,
+			Namespace: testNamespace,
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+	}, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDSecretName, Namespace: testNamespace},
+		Data:       map[string][]byte{"server.secretkey": []byte("test")},
+	})
+	settingsMgr := settings.NewSettingsManager(t.Context(), kubeClient, testNamespace)
+	return NewStore(testNamespace, kubeClient, settingsMgr, "argocd-server"), kubeClient
+}
+
+func getReasons(t *testing.T, kubeClient *fake.Clientset) []string {
+	t.Helper()
+	events, err := kubeClient.CoreV1().Events(testNamespace).List(t.Context(), metav1.ListOptions{})
+	require.NoError(t, err)
+	var reasons []string
+	for _, event := range events.Items {
+		assert.Equal(t, common.ArgoCDAccessGrantsConfigMapName, event.InvolvedObject.Name)
+		reasons = append(reasons, event.Reason)
+	}
+	return reasons
+}
+
+func TestStore_CreateAndRevoke(t *testing.T) {
+	store, kubeClient := newTestStore(t)
+
+	_, err := store.Create(t.Context(), "https://idp.example.com", "alice", "admin", "", "ops", time.Hour)
+	require.ErrorContains(t, err, "reason is required")
+	_, err = store.Create(t.Context(), "", "alice", "admin", "Production outage", "ops", time.Hour)
+	require.ErrorContains(t, err, "issuer is required")
+
+	grant, err := store.Create(t.Context(), "https://idp.example.com", "alice", "admin", "Production outage", "ops", time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, "role:admin", grant.Role)
+	assert.Equal(t, []string{"AccessGranted"}, getReasons(t, kubeClient))
+
+	assert.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual([]string{"role:admin"}, store.GetGrantedRoles("https://idp.example.com", "alice"))
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Empty(t, store.GetGrantedRoles("https://idp.example.com", "bob"))
+	assert.Empty(t, store.GetGrantedRoles("https://other-idp.example.com", "alice"))
+
+	revoked, err := store.Revoke(t.Context(), grant.ID, "ops")
+	require.NoError(t, err)
+	assert.NotNil(t, revoked.RevokedAt)
+	assert.ElementsMatch(t, []string{"AccessGranted", "AccessRevoked"}, getReasons(t, kubeClient))
+
+	_, err = store.Revoke(t.Context(), grant.ID, "ops")
+	require.ErrorContains(t, err, "already revoked")
+
+	assert.Eventually(t, func() bool {
+		return len(store.GetGrantedRoles("https://idp.example.com", "alice")) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the revoked grants are kept
+	grants, err := store.List(t.Context())
+	require.NoError(t, err)
+	require.Len(t, grants, 1)
+	assert.Equal(t, "ops", grants[0].RevokedBy)
+}
+
+func TestStore_RevokeExpired(t *testing.T) {
+	store, kubeClient := newTestStore(t)
+
+	expired := Grant{
+		ID:        "expired",
+		Issuer:    "https://idp.example.com",
+		Subject:   "alice",
+		Role:      "role:admin",
+		Reason:    "Production outage",
+		GrantedAt: time.Now().Add(-2 * time.Hour),
+		ExpiresAt: time.Now().Add(-time.Hour),
+	}
+	expiredBytes, err := json.Marshal(expired)
+	require.NoError(t, err)
+	_, err = kubeClient.CoreV1().ConfigMaps(testNamespace).Create(t.Context(), &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDAccessGrantsConfigMapName,
+			Namespace: testNamespace,
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+		Data: map[string]string{"grant.expired": string(expiredBytes)},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+	_, err = store.Create(t.Context(), "argocd", "bob", "readonly", "Investigation", "ops", time.Hour)
+	require.NoError(t, err)
+
+	require.NoError(t, store.RevokeExpired(t.Context()))
+
+	grants, err := store.List(t.Context())
+	require.NoError(t, err)
+	require.Len(t, grants, 2)
+	assert.Equal(t, "expired", grants[0].ID)
+	assert.Equal(t, expiredRevoker, grants[0].RevokedBy)
+	assert.Nil(t, grants[1].RevokedAt)
+	assert.ElementsMatch(t, []string{"AccessGranted", "AccessRevoked"}, getReasons(t, kubeClient))
+
+	// the grants already revoked are not revoked again
+	require.NoError(t, store.RevokeExpired(t.Context()))
+	assert.Len(t, getReasons(t, kubeClient), 2)
+}
+
+func TestLogGrantChanges(t *testing.T) {
+	hook := test.NewGlobal()
+	revokedAt := time.Now()
+	granted := Grant{ID: "granted", Issuer: "argocd", Subject: "alice", Role: "role:admin", Reason: "Production outage"}
+	revoked := Grant{ID: "revoked", Issuer: "argocd", Subject: "bob", Role: "role:admin", Reason: "Investigation"}
+
+	logGrantChanges([]Grant{revoked}, []Grant{granted, {ID: "revoked", Issuer: "argocd", Subject: "bob", Role: "role:admin", RevokedAt: &revokedAt}})
+	require.Len(t, hook.Entries, 2)
+	assert.Equal(t, "Access granted", hook.Entries[0].Message)
+	assert.Equal(t, "alice", hook.Entries[0].Data["subject"])
+	assert.Equal(t, common.SecurityHigh, hook.Entries[0].Data[common.SecurityField])
+	assert.Equal(t, "Access revoked", hook.Entries[1].Message)
+	assert.Equal(t, "bob", hook.Entries[1].Data["subject"])
+
+	// the unchanged grants are not logged again
+	hook.Reset()
+	logGrantChanges([]Grant{granted}, []Grant{granted})
+	assert.Empty(t, hook.Entries)
+}
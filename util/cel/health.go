@@ -0,0 +1,125 @@
+package cel
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// objVariable is the variable holding the resource in the health expressions
+	objVariable = "obj"
+	// costLimit is the maximum cost of the evaluation of an health expression, to bound the time spent evaluating it
+	costLimit = 1000000
+	// maxCachedPrograms is the maximum number of compiled health expressions kept in the cache
+	maxCachedPrograms   = 1000
+	invalidHealthStatus = "CEL returned an invalid health status"
+)
+
+var (
+	env     *cel.Env
+	envErr  error
+	envOnce sync.Once
+
+	programsLock sync.Mutex
+	programs     = map[string]cel.Program{}
+)
+
+func getEnv() (*cel.Env, error) {
+	envOnce.Do(func() {
+		env, envErr = cel.NewEnv(cel.Variable(objVariable, cel.DynType))
+	})
+	return env, envErr
+}
+
+// getProgram returns the program of the health expression, compiled once and cached since the health of the resources
+// is evaluated on each of their updates
+func getProgram(expression string) (cel.Program, error) {
+	programsLock.Lock()
+	defer programsLock.Unlock()
+	if program, ok := programs[expression]; ok {
+		return program, nil
+	}
+	env, err := getEnv()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the CEL environment: %w", err)
+	}
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("failed to compile the health expression: %w", issues.Err())
+	}
+	program, err := env.Program(ast, cel.CostLimit(costLimit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the program of the health expression: %w", err)
+	}
+	if len(programs) >= maxCachedPrograms {
+		programs = map[string]cel.Program{}
+	}
+	programs[expression] = program
+	return program, nil
+}
+
+// Validate returns an error if the health expression doesn't compile
+func Validate(expression string) error {
+	_, err := getProgram(expression)
+	return err
+}
+
+// GetResourceHealth evaluates the CEL health expression of the resource, available as the obj variable. The expression
+// returns either the health status, e.g. "Healthy", or a map holding the status and the message of the health, e.g.
+// {"status": "Degraded", "message": "the deployment failed"}.
+func GetResourceHealth(obj *unstructured.Unstructured, expression string) (*health.HealthStatus, error) {
+	program, err := getProgram(expression)
+	if err != nil {
+		return nil, err
+	}
+	val, _, err := program.Eval(map[string]any{objVariable: obj.Object})
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate the health expression: %w", err)
+	}
+	healthStatus, err := toHealthStatus(val)
+	if err != nil {
+		return nil, err
+	}
+	if !isValidHealthStatusCode(healthStatus.Status) {
+		return &health.HealthStatus{
+			Status:  health.HealthStatusUnknown,
+			Message: invalidHealthStatus,
+		}, nil
+	}
+	return healthStatus, nil
+}
+
+func toHealthStatus(val ref.Val) (*health.HealthStatus, error) {
+	switch val := val.(type) {
+	case types.String:
+		return &health.HealthStatus{Status: health.HealthStatusCode(val)}, nil
+	case traits.Mapper:
+		fields, err := val.ConvertToNative(reflect.TypeOf(map[string]string{}))
+		if err != nil {
+			return nil, fmt.Errorf("the health expression must return a map of strings: %w", err)
+		}
+		healthFields := fields.(map[string]string)
+		return &health.HealthStatus{
+			Status:  health.HealthStatusCode(healthFields["status"]),
+			Message: healthFields["message"],
+		}, nil
+	}
+	return nil, errors.New("the health expression must return a string or a map, got " + val.Type().TypeName())
+}
+
+func isValidHealthStatusCode(statusCode health.HealthStatusCode) bool {
+	switch statusCode {
+	case health.HealthStatusUnknown, health.HealthStatusProgressing, health.HealthStatusSuspended, health.HealthStatusHealthy, health.HealthStatusDegraded, health.HealthStatusMissing:
+		return true
+	}
+	return false
+}
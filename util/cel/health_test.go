@@ -0,0 +1,79 @@
+package cel
+
+import (
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+const certificate = `
+apiVersion: cert-manager.io/v1
+kind: Certificate
+metadata:
+  name: my-cert
+status:
+  conditions:
+  - type: Ready
+    status: "False"
+    message: the certificate is being issued
+`
+
+func newCertificate(t *testing.T) *unstructured.Unstructured {
+	t.Helper()
+	obj := &unstructured.Unstructured{}
+	require.NoError(t, yaml.Unmarshal([]byte(certificate), &obj.Object))
+	return obj
+}
+
+const readyExpression = `
+has(obj.status) && obj.status.conditions.exists(c, c.type == "Ready" && c.status == "True") ?
+  {"status": "Healthy"} :
+  {"status": "Progressing", "message": obj.status.conditions.filter(c, c.type == "Ready")[0].message}
+`
+
+func TestGetResourceHealth(t *testing.T) {
+	t.Run("Map", func(t *testing.T) {
+		status, err := GetResourceHealth(newCertificate(t), readyExpression)
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusProgressing, Message: "the certificate is being issued"}, status)
+	})
+
+	t.Run("String", func(t *testing.T) {
+		status, err := GetResourceHealth(newCertificate(t), `obj.metadata.name == "my-cert" ? "Healthy" : "Degraded"`)
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusHealthy}, status)
+	})
+
+	t.Run("InvalidStatus", func(t *testing.T) {
+		status, err := GetResourceHealth(newCertificate(t), `"Ready"`)
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusUnknown, Message: invalidHealthStatus}, status)
+	})
+
+	t.Run("InvalidReturnType", func(t *testing.T) {
+		_, err := GetResourceHealth(newCertificate(t), `1`)
+		require.ErrorContains(t, err, "must return a string or a map")
+	})
+
+	t.Run("MissingField", func(t *testing.T) {
+		_, err := GetResourceHealth(newCertificate(t), `obj.spec.secretName == "" ? "Degraded" : "Healthy"`)
+		require.ErrorContains(t, err, "failed to evaluate the health expression")
+	})
+
+	t.Run("CompilationError", func(t *testing.T) {
+		_, err := GetResourceHealth(newCertificate(t), `obj.status.conditions.exists(`)
+		require.ErrorContains(t, err, "failed to compile the health expression")
+	})
+}
+
+func TestGetProgram_Cache(t *testing.T) {
+	first, err := getProgram(readyExpression)
+	require.NoError(t, err)
+	second, err := getProgram(readyExpression)
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+}
@@ -0,0 +1,209 @@
+package clusterauth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	argorand "github.com/argoproj/argo-cd/v3/util/rand"
+)
+
+const (
+	// ArgoCDManagerUser is the common name of the client certificate used for managing a cluster
+	ArgoCDManagerUser = "argocd-manager"
+	// ArgoCDManagerCSRClusterRole is the name of the ClusterRole which allows the cluster manager to renew its client certificate
+	ArgoCDManagerCSRClusterRole = "argocd-manager-csr-role"
+	// ArgoCDManagerCSRClusterRoleBinding is the name of the binding of the ArgoCDManagerCSRClusterRole
+	ArgoCDManagerCSRClusterRoleBinding = "argocd-manager-csr-role-binding"
+)
+
+// ArgoCDManagerCSRPolicyRules are the policies which allow argocd-manager to request new client certificates, so that
+// the application controller can renew them. The requests must be approved by a cluster administrator or an approver
+// of the cluster: argocd-manager is not allowed to approve them, since approving requests for the
+// kube-apiserver-client signer allows issuing certificates for any identity.
+var ArgoCDManagerCSRPolicyRules = []rbacv1.PolicyRule{
+	{
+		APIGroups: []string{certificatesv1.GroupName},
+		Resources: []string{"certificatesigningrequests"},
+		Verbs:     []string{"create", "get", "delete"},
+	},
+}
+
+// InstallClusterManagerCertRBAC installs RBAC resources for a cluster manager to operate a cluster using the
+// ArgoCDManagerUser client certificate identity. If rules is nil, the default rules are used.
+func InstallClusterManagerCertRBAC(clientset kubernetes.Interface, namespaces []string, rules *ClusterManagerRBACRules) error {
+	rules = defaultClusterManagerRBACRules(rules, namespaces)
+	if err := rules.Validate(namespaces); err != nil {
+		return fmt.Errorf("invalid RBAC rules: %w", err)
+	}
+	subject := rbacv1.Subject{
+		Kind:     rbacv1.UserKind,
+		APIGroup: rbacv1.GroupName,
+		Name:     ArgoCDManagerUser,
+	}
+	if err := installClusterManagerRoles(clientset, namespaces, rules, subject); err != nil {
+		return err
+	}
+	if err := upsertClusterRole(clientset, ArgoCDManagerCSRClusterRole, ArgoCDManagerCSRPolicyRules); err != nil {
+		return err
+	}
+	return upsertClusterRoleBinding(clientset, ArgoCDManagerCSRClusterRoleBinding, ArgoCDManagerCSRClusterRole, subject)
+}
+
+// GenerateClusterManagerClientCertificate requests, approves and waits for a client certificate for the
+// ArgoCDManagerUser using the CertificateSigningRequest API. The request is approved with the credentials of the
+// clientset, which must be allowed to approve requests for the kube-apiserver-client signer, e.g. the credentials of
+// the cluster administrator running `argocd cluster add`. An expiration of zero uses the signer's default duration.
+// Returns the PEM encoded certificate and private key.
+func GenerateClusterManagerClientCertificate(clientset kubernetes.Interface, expiration time.Duration, timeout time.Duration) ([]byte, []byte, error) {
+	name, keyPEM, err := RequestClusterManagerClientCertificate(clientset, expiration)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer DeleteCertificateSigningRequest(clientset, name)
+
+	if err := approveCertificateSigningRequest(clientset, name); err != nil {
+		return nil, nil, err
+	}
+
+	var certPEM []byte
+	err = wait.PollUntilContextTimeout(context.Background(), 500*time.Millisecond, timeout, true, func(_ context.Context) (bool, error) {
+		certPEM, err = GetIssuedClientCertificate(clientset, name)
+		return len(certPEM) > 0, err
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get client certificate: %w", err)
+	}
+
+	return certPEM, keyPEM, nil
+}
+
+// RequestClusterManagerClientCertificate creates a CertificateSigningRequest for a client certificate for the
+// ArgoCDManagerUser, without approving it. An expiration of zero uses the signer's default duration. Returns the name
+// of the request and the PEM encoded private key of the certificate.
+func RequestClusterManagerClientCertificate(clientset kubernetes.Interface, expiration time.Duration) (string, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to generate private key: %w", err)
+	}
+	csrDER, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: ArgoCDManagerUser},
+	}, key)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create certificate request: %w", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to marshal private key: %w", err)
+	}
+
+	suffix, err := argorand.StringFromCharset(5, "abcdefghijklmnopqrstuvwxyz0123456789")
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to generate certificate signing request name: %w", err)
+	}
+	name := fmt.Sprintf("%s-%s", ArgoCDManagerUser, suffix)
+	csr := &certificatesv1.CertificateSigningRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: certificatesv1.CertificateSigningRequestSpec{
+			Request:    pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER}),
+			SignerName: certificatesv1.KubeAPIServerClientSignerName,
+			Usages:     []certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageClientAuth},
+		},
+	}
+	if expiration > 0 {
+		seconds := int32(expiration.Seconds())
+		csr.Spec.ExpirationSeconds = &seconds
+	}
+
+	_, err = clientset.CertificatesV1().CertificateSigningRequests().Create(context.Background(), csr, metav1.CreateOptions{})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create certificate signing request: %w", err)
+	}
+	return name, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), nil
+}
+
+func approveCertificateSigningRequest(clientset kubernetes.Interface, name string) error {
+	csrClient := clientset.CertificatesV1().CertificateSigningRequests()
+	csr, err := csrClient.Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get certificate signing request %q: %w", name, err)
+	}
+	csr.Status.Conditions = append(csr.Status.Conditions, certificatesv1.CertificateSigningRequestCondition{
+		Type:           certificatesv1.CertificateApproved,
+		Status:         corev1.ConditionTrue,
+		Reason:         "ArgoCDClusterManager",
+		Message:        "Approved by Argo CD for the cluster manager client certificate",
+		LastUpdateTime: metav1.Now(),
+	})
+	_, err = csrClient.UpdateApproval(context.Background(), name, csr, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to approve certificate signing request %q: %w", name, err)
+	}
+	return nil
+}
+
+// GetIssuedClientCertificate returns the PEM encoded certificate issued for the CertificateSigningRequest, or nil if
+// the request is not approved or not signed yet. An error is returned if the request was denied or failed.
+func GetIssuedClientCertificate(clientset kubernetes.Interface, name string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), common.ClusterAuthRequestTimeout)
+	defer cancel()
+	issued, err := clientset.CertificatesV1().CertificateSigningRequests().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get certificate signing request %q: %w", name, err)
+	}
+	for _, condition := range issued.Status.Conditions {
+		if condition.Type == certificatesv1.CertificateDenied || condition.Type == certificatesv1.CertificateFailed {
+			return nil, fmt.Errorf("certificate signing request %q was not issued: %s", name, condition.Message)
+		}
+	}
+	return issued.Status.Certificate, nil
+}
+
+// DeleteCertificateSigningRequest deletes the CertificateSigningRequest, logging any failure
+func DeleteCertificateSigningRequest(clientset kubernetes.Interface, name string) {
+	if err := clientset.CertificatesV1().CertificateSigningRequests().Delete(context.Background(), name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		log.Warnf("Failed to delete certificate signing request %q: %v", name, err)
+	}
+}
+
+// ParseClusterManagerClientCertificate parses the PEM encoded client certificate and returns it if it was issued
+// for the ArgoCDManagerUser
+func ParseClusterManagerClientCertificate(certPEM []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("failed to decode PEM certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse certificate: %w", err)
+	}
+	if cert.Subject.CommonName != ArgoCDManagerUser {
+		return nil, fmt.Errorf("certificate was not issued for %q", ArgoCDManagerUser)
+	}
+	return cert, nil
+}
+
+// IsClientCertificateRenewalDue returns true if less than a third of the lifetime of the certificate remains
+func IsClientCertificateRenewalDue(cert *x509.Certificate, now time.Time) bool {
+	lifetime := cert.NotAfter.Sub(cert.NotBefore)
+	return now.After(cert.NotAfter.Add(-lifetime / 3))
+}
@@ -0,0 +1,127 @@
+package clusterauth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	kubetesting "k8s.io/client-go/testing"
+)
+
+func newTestCertificate(t *testing.T, commonName string, notBefore, notAfter time.Time) []byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestInstallClusterManagerCertRBAC(t *testing.T) {
+	cs := fake.NewClientset()
+	require.NoError(t, InstallClusterManagerCertRBAC(cs, nil, nil))
+
+	binding, err := cs.RbacV1().ClusterRoleBindings().Get(t.Context(), ArgoCDManagerClusterRoleBinding, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []rbacv1.Subject{{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: ArgoCDManagerUser}}, binding.Subjects)
+	csrRole, err := cs.RbacV1().ClusterRoles().Get(t.Context(), ArgoCDManagerCSRClusterRole, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, ArgoCDManagerCSRPolicyRules, csrRole.Rules)
+	_, err = cs.RbacV1().ClusterRoleBindings().Get(t.Context(), ArgoCDManagerCSRClusterRoleBinding, metav1.GetOptions{})
+	require.NoError(t, err)
+}
+
+func TestGenerateClusterManagerClientCertificate(t *testing.T) {
+	issued := newTestCertificate(t, ArgoCDManagerUser, time.Now(), time.Now().Add(time.Hour))
+
+	t.Run("Success", func(t *testing.T) {
+		cs := fake.NewClientset()
+		var created *certificatesv1.CertificateSigningRequest
+		cs.PrependReactor("create", "certificatesigningrequests", func(action kubetesting.Action) (bool, runtime.Object, error) {
+			created = action.(kubetesting.CreateAction).GetObject().(*certificatesv1.CertificateSigningRequest)
+			return false, nil, nil
+		})
+		cs.PrependReactor("update", "certificatesigningrequests", func(action kubetesting.Action) (bool, runtime.Object, error) {
+			csr := action.(kubetesting.UpdateAction).GetObject().(*certificatesv1.CertificateSigningRequest)
+			require.Equal(t, "approval", action.GetSubresource())
+			require.Len(t, csr.Status.Conditions, 1)
+			assert.Equal(t, certificatesv1.CertificateApproved, csr.Status.Conditions[0].Type)
+			csr.Status.Certificate = issued
+			return false, nil, nil
+		})
+
+		certData, keyData, err := GenerateClusterManagerClientCertificate(cs, 2*time.Hour, testBearerTokenTimeout)
+		require.NoError(t, err)
+		assert.Equal(t, issued, certData)
+		block, _ := pem.Decode(keyData)
+		require.NotNil(t, block)
+		_, err = x509.ParseECPrivateKey(block.Bytes)
+		require.NoError(t, err)
+
+		require.NotNil(t, created)
+		assert.Equal(t, certificatesv1.KubeAPIServerClientSignerName, created.Spec.SignerName)
+		require.NotNil(t, created.Spec.ExpirationSeconds)
+		assert.Equal(t, int32(7200), *created.Spec.ExpirationSeconds)
+		block, _ = pem.Decode(created.Spec.Request)
+		require.NotNil(t, block)
+		request, err := x509.ParseCertificateRequest(block.Bytes)
+		require.NoError(t, err)
+		assert.Equal(t, ArgoCDManagerUser, request.Subject.CommonName)
+
+		_, err = cs.CertificatesV1().CertificateSigningRequests().Get(t.Context(), created.Name, metav1.GetOptions{})
+		assert.True(t, apierrors.IsNotFound(err), "the certificate signing request must be deleted")
+	})
+
+	t.Run("Denied", func(t *testing.T) {
+		cs := fake.NewClientset()
+		cs.PrependReactor("update", "certificatesigningrequests", func(action kubetesting.Action) (bool, runtime.Object, error) {
+			csr := action.(kubetesting.UpdateAction).GetObject().(*certificatesv1.CertificateSigningRequest)
+			csr.Status.Conditions = []certificatesv1.CertificateSigningRequestCondition{{Type: certificatesv1.CertificateDenied, Message: "denied"}}
+			return false, nil, nil
+		})
+		_, _, err := GenerateClusterManagerClientCertificate(cs, 0, testBearerTokenTimeout)
+		require.ErrorContains(t, err, "was not issued: denied")
+	})
+}
+
+func TestParseClusterManagerClientCertificate(t *testing.T) {
+	now := time.Now()
+	cert, err := ParseClusterManagerClientCertificate(newTestCertificate(t, ArgoCDManagerUser, now, now.Add(time.Hour)))
+	require.NoError(t, err)
+	assert.Equal(t, ArgoCDManagerUser, cert.Subject.CommonName)
+
+	_, err = ParseClusterManagerClientCertificate(newTestCertificate(t, "admin", now, now.Add(time.Hour)))
+	require.ErrorContains(t, err, "was not issued")
+
+	_, err = ParseClusterManagerClientCertificate([]byte("not-a-certificate"))
+	require.Error(t, err)
+}
+
+func TestIsClientCertificateRenewalDue(t *testing.T) {
+	notBefore := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cert := &x509.Certificate{NotBefore: notBefore, NotAfter: notBefore.Add(90 * time.Hour)}
+	assert.False(t, IsClientCertificateRenewalDue(cert, notBefore.Add(59*time.Hour)))
+	assert.True(t, IsClientCertificateRenewalDue(cert, notBefore.Add(61*time.Hour)))
+	assert.True(t, IsClientCertificateRenewalDue(cert, notBefore.Add(100*time.Hour)))
+}
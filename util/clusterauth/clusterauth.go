@@ -2,7 +2,13 @@ package clusterauth
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -14,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
+	clientcmdapiv1 "k8s.io/client-go/tools/clientcmd/api/v1"
+	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-cd/v3/common"
 )
@@ -175,46 +183,165 @@ func upsertRoleBinding(clientset kubernetes.Interface, name string, roleName str
 	})
 }
 
+// ClusterManagerRBACRules are user supplied policy rules granted to the cluster manager service account instead of
+// the default full access rules
+type ClusterManagerRBACRules struct {
+	// Rules are granted cluster wide using a ClusterRole, or using a Role in each managed namespace
+	Rules []rbacv1.PolicyRule `json:"rules,omitempty"`
+	// NamespaceRules are granted using a Role in the given managed namespace only
+	NamespaceRules map[string][]rbacv1.PolicyRule `json:"namespaceRules,omitempty"`
+}
+
+// namespaceRules returns the rules granted in the given managed namespace
+func (r *ClusterManagerRBACRules) namespaceRules(namespace string) []rbacv1.PolicyRule {
+	return append(append([]rbacv1.PolicyRule{}, r.Rules...), r.NamespaceRules[namespace]...)
+}
+
+// Validate verifies the rules can be installed and grant access to every one of the given managed namespaces. An empty
+// list of namespaces means the rules are granted cluster wide.
+func (r *ClusterManagerRBACRules) Validate(namespaces []string) error {
+	if len(namespaces) == 0 {
+		if len(r.NamespaceRules) > 0 {
+			return errors.New("namespaceRules require the list of managed namespaces to be set")
+		}
+		if len(r.Rules) == 0 {
+			return errors.New("at least one rule must be specified")
+		}
+		return validatePolicyRules(r.Rules, false)
+	}
+	managed := make(map[string]bool, len(namespaces))
+	for _, namespace := range namespaces {
+		managed[namespace] = true
+		rules := r.namespaceRules(namespace)
+		if len(rules) == 0 {
+			return fmt.Errorf("no rules specified for managed namespace '%s'", namespace)
+		}
+		if err := validatePolicyRules(rules, true); err != nil {
+			return fmt.Errorf("invalid rules for namespace '%s': %w", namespace, err)
+		}
+	}
+	for namespace := range r.NamespaceRules {
+		if !managed[namespace] {
+			return fmt.Errorf("namespaceRules specified for namespace '%s' which is not a managed namespace", namespace)
+		}
+	}
+	return nil
+}
+
+func validatePolicyRules(rules []rbacv1.PolicyRule, namespaced bool) error {
+	for i, rule := range rules {
+		if len(rule.Verbs) == 0 {
+			return fmt.Errorf("rule %d: verbs must not be empty", i)
+		}
+		if len(rule.NonResourceURLs) > 0 {
+			if namespaced {
+				return fmt.Errorf("rule %d: nonResourceURLs are not supported in namespaced Roles", i)
+			}
+			continue
+		}
+		if len(rule.APIGroups) == 0 || len(rule.Resources) == 0 {
+			return fmt.Errorf("rule %d: apiGroups and resources must not be empty", i)
+		}
+	}
+	return nil
+}
+
 // InstallClusterManagerRBAC installs RBAC resources for a cluster manager to operate a cluster. Returns a token
 func InstallClusterManagerRBAC(clientset kubernetes.Interface, ns string, namespaces []string, bearerTokenTimeout time.Duration) (string, error) {
+	return InstallClusterManagerRBACWithRules(clientset, ns, namespaces, nil, bearerTokenTimeout)
+}
+
+// InstallClusterManagerRBACWithRules installs RBAC resources for a cluster manager to operate a cluster, granting the
+// given rules rather than full access. If rules is nil, the default rules are used. Returns a token
+func InstallClusterManagerRBACWithRules(clientset kubernetes.Interface, ns string, namespaces []string, rules *ClusterManagerRBACRules, bearerTokenTimeout time.Duration) (string, error) {
+	rules = defaultClusterManagerRBACRules(rules, namespaces)
+	if err := rules.Validate(namespaces); err != nil {
+		return "", fmt.Errorf("invalid RBAC rules: %w", err)
+	}
+
 	err := CreateServiceAccount(clientset, ArgoCDManagerServiceAccount, ns)
 	if err != nil {
 		return "", err
 	}
 
+	err = installClusterManagerRoles(clientset, namespaces, rules, rbacv1.Subject{
+		Kind:      rbacv1.ServiceAccountKind,
+		Name:      ArgoCDManagerServiceAccount,
+		Namespace: ns,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return GetServiceAccountBearerToken(clientset, ns, ArgoCDManagerServiceAccount, bearerTokenTimeout)
+}
+
+// ClusterManagerRBACResources returns the kind and name of the RBAC resources installed by
+// InstallClusterManagerRBACWithRules, or by InstallClusterManagerCertRBAC if cert is true, without installing them. The
+// rules are validated in the same way.
+func ClusterManagerRBACResources(ns string, namespaces []string, rules *ClusterManagerRBACRules, cert bool) ([]string, error) {
+	rules = defaultClusterManagerRBACRules(rules, namespaces)
+	if err := rules.Validate(namespaces); err != nil {
+		return nil, fmt.Errorf("invalid RBAC rules: %w", err)
+	}
+	var resources []string
+	if !cert {
+		resources = append(resources,
+			fmt.Sprintf("ServiceAccount %s/%s", ns, ArgoCDManagerServiceAccount),
+			fmt.Sprintf("Secret %s/%s%s", ns, ArgoCDManagerServiceAccount, SATokenSecretSuffix))
+	}
+	if len(namespaces) == 0 {
+		resources = append(resources,
+			"ClusterRole "+ArgoCDManagerClusterRole,
+			"ClusterRoleBinding "+ArgoCDManagerClusterRoleBinding)
+	}
+	for _, namespace := range namespaces {
+		resources = append(resources,
+			fmt.Sprintf("Role %s/%s", namespace, ArgoCDManagerClusterRole),
+			fmt.Sprintf("RoleBinding %s/%s", namespace, ArgoCDManagerClusterRoleBinding))
+	}
+	if cert {
+		resources = append(resources,
+			"ClusterRole "+ArgoCDManagerCSRClusterRole,
+			"ClusterRoleBinding "+ArgoCDManagerCSRClusterRoleBinding,
+			"CertificateSigningRequest for the user "+ArgoCDManagerUser)
+	}
+	return resources, nil
+}
+
+// defaultClusterManagerRBACRules returns the given rules, or the default full access rules if rules is nil
+func defaultClusterManagerRBACRules(rules *ClusterManagerRBACRules, namespaces []string) *ClusterManagerRBACRules {
+	if rules != nil {
+		return rules
+	}
+	if len(namespaces) > 0 {
+		return &ClusterManagerRBACRules{Rules: ArgoCDManagerNamespacePolicyRules}
+	}
+	return &ClusterManagerRBACRules{Rules: ArgoCDManagerClusterPolicyRules}
+}
+
+// installClusterManagerRoles grants the rules to the subject using a ClusterRole, or using a Role in each of the
+// managed namespaces
+func installClusterManagerRoles(clientset kubernetes.Interface, namespaces []string, rules *ClusterManagerRBACRules, subject rbacv1.Subject) error {
 	if len(namespaces) == 0 {
-		err = upsertClusterRole(clientset, ArgoCDManagerClusterRole, ArgoCDManagerClusterPolicyRules)
+		err := upsertClusterRole(clientset, ArgoCDManagerClusterRole, rules.Rules)
 		if err != nil {
-			return "", err
+			return err
 		}
-
-		err = upsertClusterRoleBinding(clientset, ArgoCDManagerClusterRoleBinding, ArgoCDManagerClusterRole, rbacv1.Subject{
-			Kind:      rbacv1.ServiceAccountKind,
-			Name:      ArgoCDManagerServiceAccount,
-			Namespace: ns,
-		})
+		return upsertClusterRoleBinding(clientset, ArgoCDManagerClusterRoleBinding, ArgoCDManagerClusterRole, subject)
+	}
+	for _, namespace := range namespaces {
+		err := upsertRole(clientset, ArgoCDManagerClusterRole, namespace, rules.namespaceRules(namespace))
 		if err != nil {
-			return "", err
+			return err
 		}
-	} else {
-		for _, namespace := range namespaces {
-			err = upsertRole(clientset, ArgoCDManagerClusterRole, namespace, ArgoCDManagerNamespacePolicyRules)
-			if err != nil {
-				return "", err
-			}
 
-			err = upsertRoleBinding(clientset, ArgoCDManagerClusterRoleBinding, ArgoCDManagerClusterRole, namespace, rbacv1.Subject{
-				Kind:      rbacv1.ServiceAccountKind,
-				Name:      ArgoCDManagerServiceAccount,
-				Namespace: ns,
-			})
-			if err != nil {
-				return "", err
-			}
+		err = upsertRoleBinding(clientset, ArgoCDManagerClusterRoleBinding, ArgoCDManagerClusterRole, namespace, subject)
+		if err != nil {
+			return err
 		}
 	}
-
-	return GetServiceAccountBearerToken(clientset, ns, ArgoCDManagerServiceAccount, bearerTokenTimeout)
+	return nil
 }
 
 // GetServiceAccountBearerToken determines if a ServiceAccount has a
@@ -413,3 +540,128 @@ func RotateServiceAccountSecrets(clientset kubernetes.Interface, claims *Service
 	}
 	return nil
 }
+
+const (
+	// clusterInfoConfigMap is the name of the kube-public ConfigMap publishing the apiserver endpoints
+	clusterInfoConfigMap = "cluster-info"
+	// clusterInfoJWSPrefix is the key prefix of the signatures of the cluster-info kubeconfig by bootstrap tokens
+	clusterInfoJWSPrefix = "jws-kubeconfig-"
+)
+
+// KubePublicEndpoint is a kubernetes apiserver endpoint published in the kube-public cluster-info ConfigMap
+type KubePublicEndpoint struct {
+	// Name is the name of the cluster in the cluster-info kubeconfig
+	Name string `json:"name"`
+	// Server is the apiserver URL
+	Server string `json:"server"`
+	// CertificateAuthorityData is the PEM-encoded certificate authority of the apiserver
+	CertificateAuthorityData []byte `json:"certificateAuthorityData,omitempty"`
+	// SignedBy are the IDs of the bootstrap tokens which signed the cluster-info kubeconfig
+	SignedBy []string `json:"signedBy,omitempty"`
+}
+
+// GetKubePublicEndpoints returns the kubernetes apiserver endpoints and certificate authority data as published in
+// the kube-public. If a bootstrap token (<token-id>.<token-secret>) is given, the published kubeconfig must be signed
+// by it, the same way `kubeadm join` verifies the cluster-info ConfigMap before trusting it.
+func GetKubePublicEndpoints(ctx context.Context, client kubernetes.Interface, bootstrapToken string) ([]KubePublicEndpoint, error) {
+	clusterInfo, err := client.CoreV1().ConfigMaps(metav1.NamespacePublic).Get(ctx, clusterInfoConfigMap, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	kubeconfig, ok := clusterInfo.Data["kubeconfig"]
+	if !ok {
+		return nil, errors.New("cluster-info does not contain a public kubeconfig")
+	}
+	if bootstrapToken != "" {
+		if err := verifyClusterInfoSignature(clusterInfo, kubeconfig, bootstrapToken); err != nil {
+			return nil, err
+		}
+	}
+	// Parse Kubeconfig and get server address
+	config := &clientcmdapiv1.Config{}
+	err = yaml.Unmarshal([]byte(kubeconfig), config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse cluster-info kubeconfig: %w", err)
+	}
+	if len(config.Clusters) == 0 {
+		return nil, errors.New("cluster-info kubeconfig does not have any clusters")
+	}
+	var signedBy []string
+	for key := range clusterInfo.Data {
+		if tokenID, ok := strings.CutPrefix(key, clusterInfoJWSPrefix); ok {
+			signedBy = append(signedBy, tokenID)
+		}
+	}
+	sort.Strings(signedBy)
+	endpoints := make([]KubePublicEndpoint, 0, len(config.Clusters))
+	for _, cluster := range config.Clusters {
+		endpoints = append(endpoints, KubePublicEndpoint{
+			Name:                     cluster.Name,
+			Server:                   cluster.Cluster.Server,
+			CertificateAuthorityData: cluster.Cluster.CertificateAuthorityData,
+			SignedBy:                 signedBy,
+		})
+	}
+	return endpoints, nil
+}
+
+// FindKubePublicEndpoint returns the endpoint of the named cluster. If no name is given, the endpoints must contain a
+// single cluster.
+func FindKubePublicEndpoint(endpoints []KubePublicEndpoint, name string) (*KubePublicEndpoint, error) {
+	if name == "" {
+		if len(endpoints) != 1 {
+			names := make([]string, 0, len(endpoints))
+			for _, endpoint := range endpoints {
+				names = append(names, endpoint.Name)
+			}
+			return nil, fmt.Errorf("cluster-info kubeconfig lists %d clusters, a cluster name is required: %s", len(endpoints), strings.Join(names, ", "))
+		}
+		return &endpoints[0], nil
+	}
+	for i := range endpoints {
+		if endpoints[i].Name == name {
+			return &endpoints[i], nil
+		}
+	}
+	return nil, fmt.Errorf("cluster-info kubeconfig does not have a cluster named %q", name)
+}
+
+// verifyClusterInfoSignature verifies the detached JWS signature of the cluster-info kubeconfig by the bootstrap token
+func verifyClusterInfoSignature(clusterInfo *corev1.ConfigMap, kubeconfig string, bootstrapToken string) error {
+	tokenID, tokenSecret, ok := strings.Cut(bootstrapToken, ".")
+	if !ok || tokenID == "" || tokenSecret == "" {
+		return errors.New("invalid bootstrap token, expected <token-id>.<token-secret>")
+	}
+	signature, ok := clusterInfo.Data[clusterInfoJWSPrefix+tokenID]
+	if !ok {
+		return fmt.Errorf("cluster-info kubeconfig is not signed by bootstrap token %s", tokenID)
+	}
+	// the signature is a detached HS256 JWS, <header>..<signature>, keyed with the token secret
+	header, sig, ok := strings.Cut(signature, "..")
+	if !ok {
+		return fmt.Errorf("failed to parse cluster-info signature of bootstrap token %s", tokenID)
+	}
+	headerJSON, err := base64.RawURLEncoding.DecodeString(header)
+	if err != nil {
+		return fmt.Errorf("failed to parse cluster-info signature of bootstrap token %s: %w", tokenID, err)
+	}
+	var jwsHeader struct {
+		Algorithm string `json:"alg"`
+	}
+	if err := json.Unmarshal(headerJSON, &jwsHeader); err != nil {
+		return fmt.Errorf("failed to parse cluster-info signature of bootstrap token %s: %w", tokenID, err)
+	}
+	if jwsHeader.Algorithm != "HS256" {
+		return fmt.Errorf("unsupported cluster-info signature algorithm %q of bootstrap token %s", jwsHeader.Algorithm, tokenID)
+	}
+	actual, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil {
+		return fmt.Errorf("failed to parse cluster-info signature of bootstrap token %s: %w", tokenID, err)
+	}
+	mac := hmac.New(sha256.New, []byte(tokenSecret))
+	mac.Write([]byte(header + "." + base64.RawURLEncoding.EncodeToString([]byte(kubeconfig))))
+	if !hmac.Equal(actual, mac.Sum(nil)) {
+		return fmt.Errorf("cluster-info kubeconfig signature of bootstrap token %s is invalid", tokenID)
+	}
+	return nil
+}
@@ -1,6 +1,9 @@
 package clusterauth
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
 	"errors"
 	"os"
 	"testing"
@@ -10,12 +13,14 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes/fake"
 	kubetesting "k8s.io/client-go/testing"
+	clientcmdapiv1 "k8s.io/client-go/tools/clientcmd/api/v1"
 	"sigs.k8s.io/yaml"
 )
 
@@ -210,6 +215,112 @@ func TestInstallClusterManagerRBAC(t *testing.T) {
 	})
 }
 
+func TestInstallClusterManagerRBACWithRules(t *testing.T) {
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test"}}
+	longLivedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        ArgoCDManagerServiceAccount + SATokenSecretSuffix,
+			Namespace:   "test",
+			Annotations: map[string]string{corev1.ServiceAccountNameKey: ArgoCDManagerServiceAccount},
+		},
+		Type: corev1.SecretTypeServiceAccountToken,
+		Data: map[string][]byte{"token": []byte("barfoo")},
+	}
+	readOnly := []rbacv1.PolicyRule{{APIGroups: []string{"*"}, Resources: []string{"*"}, Verbs: []string{"get", "list", "watch"}}}
+	deployments := []rbacv1.PolicyRule{{APIGroups: []string{"apps"}, Resources: []string{"deployments"}, Verbs: []string{"*"}}}
+
+	t.Run("Cluster Scope", func(t *testing.T) {
+		cs := fake.NewClientset(ns, longLivedSecret)
+		token, err := InstallClusterManagerRBACWithRules(cs, "test", nil, &ClusterManagerRBACRules{Rules: readOnly}, testBearerTokenTimeout)
+		require.NoError(t, err)
+		assert.Equal(t, "barfoo", token)
+		role, err := cs.RbacV1().ClusterRoles().Get(t.Context(), ArgoCDManagerClusterRole, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, readOnly, role.Rules)
+	})
+
+	t.Run("Namespace Scope", func(t *testing.T) {
+		cs := fake.NewClientset(ns, longLivedSecret)
+		rules := &ClusterManagerRBACRules{Rules: readOnly, NamespaceRules: map[string][]rbacv1.PolicyRule{"nsb": deployments}}
+		_, err := InstallClusterManagerRBACWithRules(cs, "test", []string{"nsa", "nsb"}, rules, testBearerTokenTimeout)
+		require.NoError(t, err)
+		roleA, err := cs.RbacV1().Roles("nsa").Get(t.Context(), ArgoCDManagerClusterRole, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, readOnly, roleA.Rules)
+		roleB, err := cs.RbacV1().Roles("nsb").Get(t.Context(), ArgoCDManagerClusterRole, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, append(append([]rbacv1.PolicyRule{}, readOnly...), deployments...), roleB.Rules)
+		_, err = cs.RbacV1().ClusterRoles().Get(t.Context(), ArgoCDManagerClusterRole, metav1.GetOptions{})
+		assert.True(t, apierrors.IsNotFound(err))
+	})
+
+	t.Run("Invalid rules", func(t *testing.T) {
+		cs := fake.NewClientset(ns, longLivedSecret)
+		rules := &ClusterManagerRBACRules{NamespaceRules: map[string][]rbacv1.PolicyRule{"nsa": deployments}}
+		_, err := InstallClusterManagerRBACWithRules(cs, "test", []string{"nsa", "nsb"}, rules, testBearerTokenTimeout)
+		require.ErrorContains(t, err, "no rules specified for managed namespace 'nsb'")
+		_, err = cs.CoreV1().ServiceAccounts("test").Get(t.Context(), ArgoCDManagerServiceAccount, metav1.GetOptions{})
+		assert.True(t, apierrors.IsNotFound(err), "nothing must be installed if the rules are invalid")
+	})
+}
+
+func TestClusterManagerRBACResources(t *testing.T) {
+	resources, err := ClusterManagerRBACResources("kube-system", nil, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"ServiceAccount kube-system/argocd-manager",
+		"Secret kube-system/argocd-manager-long-lived-token",
+		"ClusterRole argocd-manager-role",
+		"ClusterRoleBinding argocd-manager-role-binding",
+	}, resources)
+
+	resources, err = ClusterManagerRBACResources("kube-system", []string{"team-a"}, nil, true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"Role team-a/argocd-manager-role",
+		"RoleBinding team-a/argocd-manager-role-binding",
+		"ClusterRole argocd-manager-csr-role",
+		"ClusterRoleBinding argocd-manager-csr-role-binding",
+		"CertificateSigningRequest for the user argocd-manager",
+	}, resources)
+
+	_, err = ClusterManagerRBACResources("kube-system", nil, &ClusterManagerRBACRules{}, false)
+	require.ErrorContains(t, err, "invalid RBAC rules")
+}
+
+func TestClusterManagerRBACRules_Validate(t *testing.T) {
+	resourceRule := rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"configmaps"}, Verbs: []string{"get"}}
+	nonResourceRule := rbacv1.PolicyRule{NonResourceURLs: []string{"/version"}, Verbs: []string{"get"}}
+
+	tests := []struct {
+		name       string
+		rules      ClusterManagerRBACRules
+		namespaces []string
+		err        string
+	}{
+		{name: "cluster rules", rules: ClusterManagerRBACRules{Rules: []rbacv1.PolicyRule{resourceRule, nonResourceRule}}},
+		{name: "no rules", rules: ClusterManagerRBACRules{}, err: "at least one rule must be specified"},
+		{name: "namespace rules without namespaces", rules: ClusterManagerRBACRules{NamespaceRules: map[string][]rbacv1.PolicyRule{"a": {resourceRule}}}, err: "require the list of managed namespaces"},
+		{name: "missing verbs", rules: ClusterManagerRBACRules{Rules: []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"pods"}}}}, err: "verbs must not be empty"},
+		{name: "missing resources", rules: ClusterManagerRBACRules{Rules: []rbacv1.PolicyRule{{APIGroups: []string{""}, Verbs: []string{"get"}}}}, err: "apiGroups and resources must not be empty"},
+		{name: "shared namespace rules", rules: ClusterManagerRBACRules{Rules: []rbacv1.PolicyRule{resourceRule}}, namespaces: []string{"a", "b"}},
+		{name: "per namespace rules", rules: ClusterManagerRBACRules{NamespaceRules: map[string][]rbacv1.PolicyRule{"a": {resourceRule}, "b": {resourceRule}}}, namespaces: []string{"a", "b"}},
+		{name: "namespace not covered", rules: ClusterManagerRBACRules{NamespaceRules: map[string][]rbacv1.PolicyRule{"a": {resourceRule}}}, namespaces: []string{"a", "b"}, err: "no rules specified for managed namespace 'b'"},
+		{name: "unmanaged namespace", rules: ClusterManagerRBACRules{Rules: []rbacv1.PolicyRule{resourceRule}, NamespaceRules: map[string][]rbacv1.PolicyRule{"c": {resourceRule}}}, namespaces: []string{"a"}, err: "'c' which is not a managed namespace"},
+		{name: "non resource URLs in Role", rules: ClusterManagerRBACRules{Rules: []rbacv1.PolicyRule{nonResourceRule}}, namespaces: []string{"a"}, err: "nonResourceURLs are not supported"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rules.Validate(tt.namespaces)
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
+
 func TestUninstallClusterManagerRBAC(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		cs := fake.NewClientset(newServiceAccountSecret(t))
@@ -404,3 +515,197 @@ func Test_getOrCreateServiceAccountTokenSecret_SAHasSecret(t *testing.T) {
 		assert.Equal(t, "sa-secret", sa.Secrets[0].Name)
 	}
 }
+
+func TestGetKubePublicEndpoints(t *testing.T) {
+	cases := []struct {
+		name             string
+		clusterInfo      *corev1.ConfigMap
+		expectedEndpoint string
+		expectedCAData   []byte
+		expectedSignedBy []string
+		expectError      bool
+	}{
+		{
+			name: "has public endpoint and certificate authority data",
+			clusterInfo: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "kube-public",
+					Name:      "cluster-info",
+				},
+				Data: map[string]string{
+					"kubeconfig": kubeconfigFixture("https://test-cluster:6443", []byte("test-ca-data")),
+				},
+			},
+			expectedEndpoint: "https://test-cluster:6443",
+			expectedCAData:   []byte("test-ca-data"),
+		},
+		{
+			name: "has public endpoint",
+			clusterInfo: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "kube-public",
+					Name:      "cluster-info",
+				},
+				Data: map[string]string{
+					"kubeconfig": kubeconfigFixture("https://test-cluster:6443", nil),
+				},
+			},
+			expectedEndpoint: "https://test-cluster:6443",
+			expectedCAData:   nil,
+		},
+		{
+			name: "signed by bootstrap tokens",
+			clusterInfo: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "kube-public",
+					Name:      "cluster-info",
+				},
+				Data: map[string]string{
+					"kubeconfig":            kubeconfigFixture("https://test-cluster:6443", nil),
+					"jws-kubeconfig-def456": "signature",
+					"jws-kubeconfig-abc123": "signature",
+				},
+			},
+			expectedEndpoint: "https://test-cluster:6443",
+			expectedSignedBy: []string{"abc123", "def456"},
+		},
+		{
+			name:        "no cluster-info",
+			expectError: true,
+		},
+		{
+			name: "no kubeconfig in cluster-info",
+			clusterInfo: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "kube-public",
+					Name:      "cluster-info",
+				},
+				Data: map[string]string{
+					"argo": "the project, not the movie",
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "no clusters in cluster-info kubeconfig",
+			clusterInfo: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "kube-public",
+					Name:      "cluster-info",
+				},
+				Data: map[string]string{
+					"kubeconfig": kubeconfigFixture("", nil),
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "can't parse kubeconfig",
+			clusterInfo: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "kube-public",
+					Name:      "cluster-info",
+				},
+				Data: map[string]string{
+					"kubeconfig": "this is not valid YAML",
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			objects := []runtime.Object{}
+			if tc.clusterInfo != nil {
+				objects = append(objects, tc.clusterInfo)
+			}
+			clientset := fake.NewClientset(objects...)
+			endpoints, err := GetKubePublicEndpoints(t.Context(), clientset, "")
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, endpoints, 1)
+			require.Equalf(t, tc.expectedEndpoint, endpoints[0].Server, "expected endpoint %s, got %s", tc.expectedEndpoint, endpoints[0].Server)
+			require.Equalf(t, tc.expectedCAData, endpoints[0].CertificateAuthorityData, "expected caData %s, got %s", tc.expectedCAData, endpoints[0].CertificateAuthorityData)
+			require.Equal(t, tc.expectedSignedBy, endpoints[0].SignedBy)
+		})
+	}
+}
+
+func TestGetKubePublicEndpoints_MultipleClusters(t *testing.T) {
+	kubeconfig, err := yaml.Marshal(&clientcmdapiv1.Config{Clusters: []clientcmdapiv1.NamedCluster{
+		{Name: "internal", Cluster: clientcmdapiv1.Cluster{Server: "https://10.0.0.1:6443", CertificateAuthorityData: []byte("ca")}},
+		{Name: "external", Cluster: clientcmdapiv1.Cluster{Server: "https://cluster.example.com:6443", CertificateAuthorityData: []byte("ca")}},
+	}})
+	require.NoError(t, err)
+	clientset := fake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "kube-public", Name: "cluster-info"},
+		Data:       map[string]string{"kubeconfig": string(kubeconfig)},
+	})
+
+	endpoints, err := GetKubePublicEndpoints(t.Context(), clientset, "")
+	require.NoError(t, err)
+	require.Len(t, endpoints, 2)
+
+	endpoint, err := FindKubePublicEndpoint(endpoints, "external")
+	require.NoError(t, err)
+	assert.Equal(t, "https://cluster.example.com:6443", endpoint.Server)
+	_, err = FindKubePublicEndpoint(endpoints, "")
+	require.EqualError(t, err, "cluster-info kubeconfig lists 2 clusters, a cluster name is required: internal, external")
+	_, err = FindKubePublicEndpoint(endpoints, "other")
+	require.EqualError(t, err, `cluster-info kubeconfig does not have a cluster named "other"`)
+	endpoint, err = FindKubePublicEndpoint(endpoints[:1], "")
+	require.NoError(t, err)
+	assert.Equal(t, "internal", endpoint.Name)
+}
+
+func TestGetKubePublicEndpoints_BootstrapTokenSignature(t *testing.T) {
+	kubeconfig := kubeconfigFixture("https://test-cluster:6443", []byte("test-ca-data"))
+	// detached JWS as created by kubeadm for the bootstrap token abc123.0123456789abcdef
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","kid":"abc123"}`))
+	mac := hmac.New(sha256.New, []byte("0123456789abcdef"))
+	mac.Write([]byte(header + "." + base64.RawURLEncoding.EncodeToString([]byte(kubeconfig))))
+	signature := header + ".." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+	clientset := fake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "kube-public", Name: "cluster-info"},
+		Data: map[string]string{
+			"kubeconfig":            kubeconfig,
+			"jws-kubeconfig-abc123": signature,
+		},
+	})
+
+	endpoints, err := GetKubePublicEndpoints(t.Context(), clientset, "abc123.0123456789abcdef")
+	require.NoError(t, err)
+	require.Len(t, endpoints, 1)
+	assert.Equal(t, []string{"abc123"}, endpoints[0].SignedBy)
+
+	_, err = GetKubePublicEndpoints(t.Context(), clientset, "abc123.wrongsecret00000")
+	require.EqualError(t, err, "cluster-info kubeconfig signature of bootstrap token abc123 is invalid")
+	_, err = GetKubePublicEndpoints(t.Context(), clientset, "def456.0123456789abcdef")
+	require.EqualError(t, err, "cluster-info kubeconfig is not signed by bootstrap token def456")
+	_, err = GetKubePublicEndpoints(t.Context(), clientset, "abc123")
+	require.ErrorContains(t, err, "invalid bootstrap token")
+}
+
+func kubeconfigFixture(endpoint string, certificateAuthorityData []byte) string {
+	kubeconfig := &clientcmdapiv1.Config{}
+	if len(endpoint) > 0 {
+		kubeconfig.Clusters = []clientcmdapiv1.NamedCluster{
+			{
+				Name: "test-kube",
+				Cluster: clientcmdapiv1.Cluster{
+					Server:                   endpoint,
+					CertificateAuthorityData: certificateAuthorityData,
+				},
+			},
+		}
+	}
+	configYAML, err := yaml.Marshal(kubeconfig)
+	if err != nil {
+		return ""
+	}
+	return string(configYAML)
+}
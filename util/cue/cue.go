@@ -0,0 +1,204 @@
+package cue
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	executil "github.com/argoproj/argo-cd/v3/util/exec"
+	"github.com/argoproj/argo-cd/v3/util/io/files"
+)
+
+const (
+	// ModuleDir is the directory holding the module file and the dependencies of a CUE module
+	ModuleDir = "cue.mod"
+	// FileExtension is the extension of the CUE files
+	FileExtension = ".cue"
+)
+
+// CUE provides wrapper functionality around the `cue` command.
+type CUE interface {
+	// Export returns the list of the unstructured objects exported by the `cue export` command, and the commands run
+	Export(opts *v1alpha1.ApplicationSourceCUE, envVars *v1alpha1.Env) ([]*unstructured.Unstructured, []string, error)
+}
+
+// NewCUEApp creates a new wrapper to run commands on the `cue` command-line tool.
+func NewCUEApp(repoRoot string, path string, binaryPath string) CUE {
+	return &cue{
+		repoRoot:   repoRoot,
+		path:       path,
+		binaryPath: binaryPath,
+	}
+}
+
+type cue struct {
+	// path to the Git repository root
+	repoRoot string
+	// path inside the checked out tree
+	path string
+	// the cue binary path
+	binaryPath string
+}
+
+func (c *cue) getBinaryPath() string {
+	if c.binaryPath != "" {
+		return c.binaryPath
+	}
+	return "cue"
+}
+
+func (c *cue) Export(opts *v1alpha1.ApplicationSourceCUE, envVars *v1alpha1.Env) ([]*unstructured.Unstructured, []string, error) {
+	if opts == nil {
+		opts = &v1alpha1.ApplicationSourceCUE{}
+	}
+	if envVars == nil {
+		envVars = &v1alpha1.Env{}
+	}
+	env := append(os.Environ(), envVars.Environ()...)
+
+	packages := opts.Packages
+	if len(packages) == 0 {
+		packages = []string{"."}
+	}
+
+	if err := c.validateOptions(packages, opts); err != nil {
+		return nil, nil, err
+	}
+
+	var objs []*unstructured.Unstructured
+	var commands []string
+	// Each package is exported separately, since the cue command outputs a single document per export
+	for _, pkg := range packages {
+		cmd := exec.Command(c.getBinaryPath(), exportArgs(pkg, opts, envVars)...)
+		cmd.Dir = c.path
+		cmd.Env = env
+		if opts.Values != "" {
+			cmd.Stdin = strings.NewReader(envVars.Envsubst(opts.Values))
+		}
+		commands = append(commands, strings.ReplaceAll(executil.GetCommandArgsToLog(cmd), c.repoRoot, "."))
+		out, err := executil.Run(cmd)
+		if err != nil {
+			return nil, nil, err
+		}
+		pkgObjs, err := parseExport([]byte(out))
+		if err != nil {
+			return nil, nil, fmt.Errorf("error parsing the export of the CUE package %s: %w", pkg, err)
+		}
+		objs = append(objs, pkgObjs...)
+	}
+	return objs, commands, nil
+}
+
+// validateOptions returns an error if a package is not a relative path inside the repository, optionally followed by a
+// package name, or if the expression is not a selector of the fields of the packages
+func (c *cue) validateOptions(packages []string, opts *v1alpha1.ApplicationSourceCUE) error {
+	repoRoot, err := filepath.Abs(c.repoRoot)
+	if err != nil {
+		return fmt.Errorf("error getting the absolute path of the repository: %w", err)
+	}
+	for _, pkg := range packages {
+		dir, _, _ := strings.Cut(pkg, ":")
+		dir = strings.TrimSuffix(dir, "/...")
+		// Paths which do not start with a dot are import paths for the cue command
+		if dir != "." && dir != ".." && !strings.HasPrefix(dir, "./") && !strings.HasPrefix(dir, "../") {
+			return fmt.Errorf("CUE package %q must be a relative path starting with ./", pkg)
+		}
+		target, err := filepath.Abs(filepath.Join(c.path, dir))
+		if err != nil {
+			return fmt.Errorf("error getting the absolute path of the CUE package %q: %w", pkg, err)
+		}
+		if target != repoRoot && !files.Inbound(target, repoRoot) {
+			return fmt.Errorf("CUE package %q is outside of the repository", pkg)
+		}
+	}
+	if opts.Expression != "" && !expressionRegex.MatchString(opts.Expression) {
+		return fmt.Errorf("CUE expression %q must be a selector of fields, e.g. objects.deployments", opts.Expression)
+	}
+	return nil
+}
+
+// expressionRegex matches the selectors of fields, e.g. objects.deployments
+var expressionRegex = regexp.MustCompile(`^[A-Za-z_$#][A-Za-z0-9_$#]*(\.[A-Za-z_$#][A-Za-z0-9_$#]*)*$`)
+
+func exportArgs(pkg string, opts *v1alpha1.ApplicationSourceCUE, envVars *v1alpha1.Env) []string {
+	args := []string{"export"}
+	for _, tag := range opts.Tags {
+		args = append(args, "--inject="+envVars.Envsubst(tag))
+	}
+	if opts.Expression != "" {
+		args = append(args, "--expression="+opts.Expression)
+	}
+	// The package and the values are passed after the end of the flags, so that they cannot be parsed as flags
+	args = append(args, "--out=json", "--", pkg)
+	if opts.Values != "" {
+		// The values are read from the standard input
+		args = append(args, "yaml:", "-")
+	}
+	return args
+}
+
+// parseExport returns the Kubernetes objects of the JSON exported by the cue command. The objects can be exported as
+// a list, or as structs, e.g. objects: deployment: {...}, in which case the objects are sorted by field name.
+func parseExport(out []byte) ([]*unstructured.Unstructured, error) {
+	decoder := json.NewDecoder(bytes.NewReader(out))
+	var objs []*unstructured.Unstructured
+	for {
+		var value any
+		err := decoder.Decode(&value)
+		if errors.Is(err, io.EOF) {
+			return objs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		found, err := findObjects(value)
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, found...)
+	}
+}
+
+func findObjects(value any) ([]*unstructured.Unstructured, error) {
+	var objs []*unstructured.Unstructured
+	switch v := value.(type) {
+	case []any:
+		for _, item := range v {
+			found, err := findObjects(item)
+			if err != nil {
+				return nil, err
+			}
+			objs = append(objs, found...)
+		}
+	case map[string]any:
+		if _, ok := v["kind"].(string); ok {
+			return []*unstructured.Unstructured{{Object: v}}, nil
+		}
+		fields := make([]string, 0, len(v))
+		for field := range v {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		for _, field := range fields {
+			found, err := findObjects(v[field])
+			if err != nil {
+				return nil, err
+			}
+			objs = append(objs, found...)
+		}
+	default:
+		return nil, fmt.Errorf("the CUE package exported a %T instead of a Kubernetes object", value)
+	}
+	return objs, nil
+}
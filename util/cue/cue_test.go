@@ -0,0 +1,103 @@
+package cue
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// fakeCUE writes a fake cue binary recording its arguments and standard input in its working directory, and printing
+// the output.
+func fakeCUE(t *testing.T, output string) string {
+	t.Helper()
+	binaryPath := filepath.Join(t.TempDir(), "cue")
+	script := "#!/bin/sh\necho \"$@\" >> args\ncat > stdin\ncat <<'EOF'\n" + output + "\nEOF\n"
+	require.NoError(t, os.WriteFile(binaryPath, []byte(script), 0o755))
+	return binaryPath
+}
+
+func TestExport(t *testing.T) {
+	appPath := t.TempDir()
+	output := `{"objects": {"service": {"apiVersion": "v1", "kind": "Service", "metadata": {"name": "guestbook"}}, "deployment": {"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "guestbook"}}}}`
+	c := NewCUEApp(appPath, appPath, fakeCUE(t, output))
+	env := &v1alpha1.Env{&v1alpha1.EnvEntry{Name: "ARGOCD_APP_NAME", Value: "guestbook"}}
+
+	objs, commands, err := c.Export(&v1alpha1.ApplicationSourceCUE{
+		Packages:   []string{"./prod"},
+		Tags:       []string{"env=prod", "app=${ARGOCD_APP_NAME}"},
+		Values:     "replicas: 3",
+		Expression: "objects",
+	}, env)
+	require.NoError(t, err)
+
+	require.Len(t, objs, 2)
+	assert.Equal(t, "Deployment", objs[0].GetKind())
+	assert.Equal(t, "Service", objs[1].GetKind())
+	assert.Len(t, commands, 1)
+
+	args, err := os.ReadFile(filepath.Join(appPath, "args"))
+	require.NoError(t, err)
+	assert.Equal(t, "export --inject=env=prod --inject=app=guestbook --expression=objects --out=json -- ./prod yaml: -\n", string(args))
+	stdin, err := os.ReadFile(filepath.Join(appPath, "stdin"))
+	require.NoError(t, err)
+	assert.Equal(t, "replicas: 3", string(stdin))
+}
+
+func TestExportPackages(t *testing.T) {
+	appPath := t.TempDir()
+	output := `[{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "config"}}]`
+	c := NewCUEApp(appPath, appPath, fakeCUE(t, output))
+
+	objs, commands, err := c.Export(&v1alpha1.ApplicationSourceCUE{Packages: []string{"./a", "./b"}}, nil)
+	require.NoError(t, err)
+
+	assert.Len(t, objs, 2)
+	assert.Len(t, commands, 2)
+	args, err := os.ReadFile(filepath.Join(appPath, "args"))
+	require.NoError(t, err)
+	assert.Equal(t, "export --out=json -- ./a\nexport --out=json -- ./b\n", string(args))
+}
+
+func TestExportInvalidOptions(t *testing.T) {
+	repoRoot := t.TempDir()
+	appPath := filepath.Join(repoRoot, "app")
+	require.NoError(t, os.Mkdir(appPath, 0o755))
+	c := NewCUEApp(repoRoot, appPath, fakeCUE(t, "[]"))
+
+	for _, opts := range []*v1alpha1.ApplicationSourceCUE{
+		{Packages: []string{"./prod:guestbook", "../common/..."}},
+		{Packages: []string{"."}, Expression: "objects.deployments"},
+	} {
+		_, _, err := c.Export(opts, nil)
+		require.NoError(t, err)
+	}
+
+	for _, tc := range []struct {
+		opts        *v1alpha1.ApplicationSourceCUE
+		expectedErr string
+	}{
+		{&v1alpha1.ApplicationSourceCUE{Packages: []string{"../.."}}, `CUE package "../.." is outside of the repository`},
+		{&v1alpha1.ApplicationSourceCUE{Packages: []string{"./../../etc"}}, `CUE package "./../../etc" is outside of the repository`},
+		{&v1alpha1.ApplicationSourceCUE{Packages: []string{"/etc"}}, `CUE package "/etc" must be a relative path starting with ./`},
+		{&v1alpha1.ApplicationSourceCUE{Packages: []string{"--help"}}, `CUE package "--help" must be a relative path starting with ./`},
+		{&v1alpha1.ApplicationSourceCUE{Expression: "objects --out yaml"}, `CUE expression "objects --out yaml" must be a selector of fields, e.g. objects.deployments`},
+	} {
+		_, _, err := c.Export(tc.opts, nil)
+		require.EqualError(t, err, tc.expectedErr)
+	}
+}
+
+func TestParseExport(t *testing.T) {
+	objs, err := parseExport([]byte(`{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "config"}}`))
+	require.NoError(t, err)
+	require.Len(t, objs, 1)
+	assert.Equal(t, "config", objs[0].GetName())
+
+	_, err = parseExport([]byte(`{"replicas": 3}`))
+	require.EqualError(t, err, "the CUE package exported a float64 instead of a Kubernetes object")
+}
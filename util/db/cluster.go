@@ -72,9 +72,9 @@ func (db *db) ListClusters(_ context.Context) (*appv1.ClusterList, error) {
 	inClusterEnabled := settings.InClusterEnabled
 	hasInClusterCredentials := false
 	for _, clusterSecret := range clusterSecrets {
-		cluster, err := SecretToCluster(clusterSecret)
+		cluster, err := db.secretToCluster(clusterSecret)
 		if err != nil {
-			log.Errorf("could not unmarshal cluster secret %s", clusterSecret.Name)
+			log.Errorf("could not unmarshal cluster secret %s: %v", clusterSecret.Name, err)
 			continue
 		}
 		if cluster.Server == appv1.KubernetesInternalAPIServerAddr {
@@ -101,18 +101,11 @@ func (db *db) CreateCluster(ctx context.Context, c *appv1.Cluster) (*appv1.Clust
 	if c.Server == appv1.KubernetesInternalAPIServerAddr && !settings.InClusterEnabled {
 		return nil, status.Errorf(codes.InvalidArgument, "cannot register cluster: in-cluster has been disabled")
 	}
-	secName, err := URIToSecretName("cluster", c.Server)
-	if err != nil {
-		return nil, err
-	}
-
-	clusterSecret := &corev1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Name: secName,
-		},
+	if err := db.validateExternalCredentials(c); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot register cluster: %v", err)
 	}
-
-	if err = clusterToSecret(c, clusterSecret); err != nil {
+	clusterSecret, err := NewClusterSecret(c, db.ns)
+	if err != nil {
 		return nil, err
 	}
 
@@ -124,7 +117,7 @@ func (db *db) CreateCluster(ctx context.Context, c *appv1.Cluster) (*appv1.Clust
 		return nil, err
 	}
 
-	cluster, err := SecretToCluster(clusterSecret)
+	cluster, err := db.secretToCluster(clusterSecret)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "could not unmarshal cluster secret %s", clusterSecret.Name)
 	}
@@ -161,7 +154,7 @@ func (db *db) WatchClusters(ctx context.Context,
 		common.LabelValueSecretTypeCluster,
 
 		func(secret *corev1.Secret) {
-			cluster, err := SecretToCluster(secret)
+			cluster, err := db.secretToCluster(secret)
 			if err != nil {
 				log.Errorf("could not unmarshal cluster secret %s", secret.Name)
 				return
@@ -178,12 +171,12 @@ func (db *db) WatchClusters(ctx context.Context,
 		},
 
 		func(oldSecret *corev1.Secret, newSecret *corev1.Secret) {
-			oldCluster, err := SecretToCluster(oldSecret)
+			oldCluster, err := db.secretToCluster(oldSecret)
 			if err != nil {
 				log.Errorf("could not unmarshal cluster secret %s", oldSecret.Name)
 				return
 			}
-			newCluster, err := SecretToCluster(newSecret)
+			newCluster, err := db.secretToCluster(newSecret)
 			if err != nil {
 				log.Errorf("could not unmarshal cluster secret %s", newSecret.Name)
 				return
@@ -243,7 +236,7 @@ func (db *db) GetCluster(_ context.Context, server string) (*appv1.Cluster, erro
 	}
 
 	if len(res) > 0 {
-		return SecretToCluster(res[0].(*corev1.Secret))
+		return db.secretToCluster(res[0].(*corev1.Secret))
 	}
 	if server == appv1.KubernetesInternalAPIServerAddr {
 		return db.getLocalCluster(), nil
@@ -264,7 +257,7 @@ func (db *db) GetProjectClusters(_ context.Context, project string) ([]*appv1.Cl
 	}
 	var res []*appv1.Cluster
 	for i := range secrets {
-		cluster, err := SecretToCluster(secrets[i].(*corev1.Secret))
+		cluster, err := db.secretToCluster(secrets[i].(*corev1.Secret))
 		if err != nil {
 			return nil, fmt.Errorf("failed to convert secret to cluster: %w", err)
 		}
@@ -318,6 +311,9 @@ func (db *db) UpdateCluster(ctx context.Context, c *appv1.Cluster) (*appv1.Clust
 		}
 		return nil, err
 	}
+	if err := db.validateExternalCredentials(c); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot update cluster: %v", err)
+	}
 	if err := clusterToSecret(c, clusterSecret); err != nil {
 		return nil, err
 	}
@@ -326,7 +322,7 @@ func (db *db) UpdateCluster(ctx context.Context, c *appv1.Cluster) (*appv1.Clust
 	if err != nil {
 		return nil, err
 	}
-	cluster, err := SecretToCluster(clusterSecret)
+	cluster, err := db.secretToCluster(clusterSecret)
 	if err != nil {
 		log.Errorf("could not unmarshal cluster secret %s", clusterSecret.Name)
 		return nil, err
@@ -349,6 +345,29 @@ func (db *db) DeleteCluster(ctx context.Context, server string) error {
 	return db.settingsMgr.ResyncInformers()
 }
 
+// NewClusterSecret returns the declarative cluster secret which is stored in the given namespace when the cluster
+// is created.
+func NewClusterSecret(c *appv1.Cluster, namespace string) (*corev1.Secret, error) {
+	secName, err := URIToSecretName("cluster", c.Server)
+	if err != nil {
+		return nil, err
+	}
+	clusterSecret := &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secName,
+			Namespace: namespace,
+		},
+	}
+	if err = clusterToSecret(c, clusterSecret); err != nil {
+		return nil, err
+	}
+	return clusterSecret, nil
+}
+
 // clusterToSecret converts a cluster object to string data for serialization to a secret
 func clusterToSecret(c *appv1.Cluster, secret *corev1.Secret) error {
 	data := make(map[string][]byte)
@@ -375,6 +394,16 @@ func clusterToSecret(c *appv1.Cluster, secret *corev1.Secret) error {
 	if c.Project != "" {
 		data["project"] = []byte(c.Project)
 	}
+	if c.Maintenance {
+		data["maintenance"] = []byte("true")
+	}
+	if c.SyncWindows.HasWindows() {
+		syncWindowsBytes, err := json.Marshal(c.SyncWindows)
+		if err != nil {
+			return err
+		}
+		data["syncWindows"] = syncWindowsBytes
+	}
 	secret.Data = data
 
 	secret.Labels = c.Labels
@@ -396,6 +425,79 @@ func clusterToSecret(c *appv1.Cluster, secret *corev1.Secret) error {
 	return nil
 }
 
+// secretToCluster converts a secret into a Cluster object and resolves the proxy credentials referenced by the cluster.
+// Clusters referencing external credentials which are not allowed are rejected.
+func (db *db) secretToCluster(s *corev1.Secret) (*appv1.Cluster, error) {
+	cluster, err := SecretToCluster(s)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.validateExternalCredentials(cluster); err != nil {
+		return nil, err
+	}
+	db.resolveProxyCredentials(cluster)
+	return cluster, nil
+}
+
+// validateExternalCredentials returns an error if the cluster references external credentials which do not match any
+// of the prefixes allowed in argocd-cm. The references are resolved with the identity of the Argo CD components, so
+// without the allowlist anyone who can register a cluster could read any secret those components can access.
+func (db *db) validateExternalCredentials(cluster *appv1.Cluster) error {
+	config := cluster.Config.ExternalCredentialsConfig
+	if config == nil {
+		return nil
+	}
+	prefixes, err := db.settingsMgr.GetClusterExternalCredentialsAllowedRefPrefixes()
+	if err != nil {
+		return err
+	}
+	for _, ref := range []string{config.BearerTokenRef, config.CertDataRef, config.KeyDataRef} {
+		if ref != "" && !isExternalCredentialsRefAllowed(ref, prefixes) {
+			return fmt.Errorf("external credentials reference %q of cluster %q is not allowed by cluster.externalCredentials.allowedRefPrefixes in argocd-cm", ref, cluster.Server)
+		}
+	}
+	return nil
+}
+
+// isExternalCredentialsRefAllowed returns true if the reference starts with one of the prefixes and does not traverse
+// out of it
+func isExternalCredentialsRefAllowed(ref string, prefixes []string) bool {
+	path, _, _ := strings.Cut(ref, "#")
+	for _, segment := range strings.Split(path, "/") {
+		if segment == ".." {
+			return false
+		}
+	}
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(ref, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveProxyCredentials populates the proxy username and password of the cluster from the secret referenced by its
+// config. Only secrets labelled as cluster proxy credentials can be referenced, so that a cluster cannot read any
+// other secret of the Argo CD namespace. The credentials are left empty if the secret cannot be read or is not
+// labelled, so the proxy rejects the requests.
+func (db *db) resolveProxyCredentials(cluster *appv1.Cluster) {
+	ref := cluster.Config.ProxyCredentialsSecretRef
+	if ref == nil {
+		return
+	}
+	secret, err := db.settingsMgr.GetSecretByName(ref.SecretName)
+	if err != nil {
+		log.Warnf("Failed to get proxy credentials secret %q of cluster %q: %v", ref.SecretName, cluster.Server, err)
+		return
+	}
+	if secret.Labels[common.LabelKeySecretType] != common.LabelValueSecretTypeClusterProxy {
+		log.Warnf("Proxy credentials secret %q of cluster %q is not labelled with %s=%s", ref.SecretName, cluster.Server, common.LabelKeySecretType, common.LabelValueSecretTypeClusterProxy)
+		return
+	}
+	cluster.Config.ProxyUsername = string(secret.Data[ref.GetUsernameKey()])
+	cluster.Config.ProxyPassword = string(secret.Data[ref.GetPasswordKey()])
+}
+
 // SecretToCluster converts a secret into a Cluster object
 func SecretToCluster(s *corev1.Secret) (*appv1.Cluster, error) {
 	var config appv1.ClusterConfig
@@ -406,6 +508,14 @@ func SecretToCluster(s *corev1.Secret) (*appv1.Cluster, error) {
 		}
 	}
 
+	var syncWindows appv1.SyncWindows
+	if len(s.Data["syncWindows"]) > 0 {
+		err := json.Unmarshal(s.Data["syncWindows"], &syncWindows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal cluster sync windows: %w", err)
+		}
+	}
+
 	var namespaces []string
 	for _, ns := range strings.Split(string(s.Data["namespaces"]), ",") {
 		if ns = strings.TrimSpace(ns); ns != "" {
@@ -456,6 +566,8 @@ func SecretToCluster(s *corev1.Secret) (*appv1.Cluster, error) {
 		Project:            string(s.Data["project"]),
 		Labels:             labels,
 		Annotations:        annotations,
+		Maintenance:        string(s.Data["maintenance"]) == "true",
+		SyncWindows:        syncWindows,
 	}
 	return &cluster, nil
 }
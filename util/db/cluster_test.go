@@ -2,6 +2,8 @@ package db
 
 import (
 	"context"
+	"encoding/json"
+	"strings"
 	"testing"
 	"time"
 
@@ -117,6 +119,58 @@ func TestClusterToSecret(t *testing.T) {
 	assert.Equal(t, cluster.Labels, s.Labels)
 }
 
+func TestClusterToSecret_Maintenance(t *testing.T) {
+	s := &corev1.Secret{}
+	err := clusterToSecret(&v1alpha1.Cluster{Server: "server", Maintenance: true}, s)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("true"), s.Data["maintenance"])
+
+	cluster, err := SecretToCluster(s)
+	require.NoError(t, err)
+	assert.True(t, cluster.Maintenance)
+
+	err = clusterToSecret(&v1alpha1.Cluster{Server: "server"}, s)
+	require.NoError(t, err)
+	assert.NotContains(t, s.Data, "maintenance")
+}
+
+func TestClusterToSecret_SyncWindows(t *testing.T) {
+	s := &corev1.Secret{}
+	windows := v1alpha1.SyncWindows{{Kind: "deny", Schedule: "0 22 * * *", Duration: "8h", TimeZone: "UTC"}}
+	err := clusterToSecret(&v1alpha1.Cluster{Server: "server", SyncWindows: windows}, s)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"kind":"deny","schedule":"0 22 * * *","duration":"8h","timeZone":"UTC"}]`, string(s.Data["syncWindows"]))
+
+	cluster, err := SecretToCluster(s)
+	require.NoError(t, err)
+	assert.Equal(t, windows, cluster.SyncWindows)
+
+	s.Data["syncWindows"] = []byte("invalid")
+	_, err = SecretToCluster(s)
+	require.ErrorContains(t, err, "failed to unmarshal cluster sync windows")
+}
+
+func TestNewClusterSecret(t *testing.T) {
+	cluster := &v1alpha1.Cluster{
+		Server:  "https://mycluster.example.com",
+		Name:    "test",
+		Project: "project",
+	}
+	s, err := NewClusterSecret(cluster, fakeNamespace)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Secret", s.Kind)
+	assert.Equal(t, "v1", s.APIVersion)
+	assert.Equal(t, fakeNamespace, s.Namespace)
+	assert.True(t, strings.HasPrefix(s.Name, "cluster-mycluster.example.com-"))
+	assert.Equal(t, common.LabelValueSecretTypeCluster, s.Labels[common.LabelKeySecretType])
+	assert.Equal(t, []byte(cluster.Server), s.Data["server"])
+	assert.Equal(t, []byte(cluster.Name), s.Data["name"])
+
+	_, err = NewClusterSecret(&v1alpha1.Cluster{Server: "://invalid"}, fakeNamespace)
+	require.Error(t, err)
+}
+
 func TestClusterToSecret_LastAppliedConfigurationRejected(t *testing.T) {
 	cluster := &v1alpha1.Cluster{
 		Server:      "server",
@@ -428,6 +482,156 @@ func TestGetCluster(t *testing.T) {
 	})
 }
 
+func TestGetCluster_ProxyCredentials(t *testing.T) {
+	argoCDConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDConfigMapName,
+			Namespace: fakeNamespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+	}
+	argoCDSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDSecretName,
+			Namespace: fakeNamespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+		Data: map[string][]byte{
+			"admin.password":   nil,
+			"server.secretkey": nil,
+		},
+	}
+	proxySecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "proxy-credentials",
+			Namespace: fakeNamespace,
+			Labels: map[string]string{
+				common.LabelKeySecretType: common.LabelValueSecretTypeClusterProxy,
+			},
+		},
+		Data: map[string][]byte{
+			"user": []byte("admin"),
+			"pass": []byte("secret"),
+		},
+	}
+	otherSecret := proxySecret.DeepCopy()
+	otherSecret.Name = "other-secret"
+	otherSecret.Labels = nil
+	newClusterSecret := func(name string, ref *v1alpha1.ProxyCredentialsSecretRef) *corev1.Secret {
+		config, err := json.Marshal(v1alpha1.ClusterConfig{ProxyUrl: "socks5://proxy:1080", ProxyCredentialsSecretRef: ref})
+		require.NoError(t, err)
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: fakeNamespace,
+				Labels: map[string]string{
+					common.LabelKeySecretType: common.LabelValueSecretTypeCluster,
+				},
+			},
+			Data: map[string][]byte{
+				"server": []byte("https://" + name),
+				"config": config,
+			},
+		}
+	}
+
+	kubeclientset := fake.NewClientset(
+		argoCDConfigMap, argoCDSecret, proxySecret, otherSecret,
+		newClusterSecret("mycluster1", &v1alpha1.ProxyCredentialsSecretRef{SecretName: "proxy-credentials", UsernameKey: "user", PasswordKey: "pass"}),
+		newClusterSecret("mycluster2", &v1alpha1.ProxyCredentialsSecretRef{SecretName: "missing"}),
+		newClusterSecret("mycluster3", &v1alpha1.ProxyCredentialsSecretRef{SecretName: "other-secret", UsernameKey: "user", PasswordKey: "pass"}),
+	)
+	settingsManager := settings.NewSettingsManager(t.Context(), kubeclientset, fakeNamespace)
+	db := NewDB(fakeNamespace, settingsManager, kubeclientset)
+
+	cluster, err := db.GetCluster(t.Context(), "https://mycluster1")
+	require.NoError(t, err)
+	assert.Equal(t, "admin", cluster.Config.ProxyUsername)
+	assert.Equal(t, "secret", cluster.Config.ProxyPassword)
+
+	cluster, err = db.GetCluster(t.Context(), "https://mycluster2")
+	require.NoError(t, err)
+	assert.Empty(t, cluster.Config.ProxyUsername)
+	assert.Empty(t, cluster.Config.ProxyPassword)
+
+	// secrets which are not labelled as cluster proxy credentials cannot be referenced
+	cluster, err = db.GetCluster(t.Context(), "https://mycluster3")
+	require.NoError(t, err)
+	assert.Empty(t, cluster.Config.ProxyUsername)
+	assert.Empty(t, cluster.Config.ProxyPassword)
+}
+
+func TestExternalCredentialsAllowedRefPrefixes(t *testing.T) {
+	argoCDConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDConfigMapName,
+			Namespace: fakeNamespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+		Data: map[string]string{"cluster.externalCredentials.allowedRefPrefixes": "- vault:secret/data/clusters/"},
+	}
+	argoCDSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDSecretName,
+			Namespace: fakeNamespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+		Data: map[string][]byte{
+			"admin.password":   nil,
+			"server.secretkey": nil,
+		},
+	}
+	newClusterSecret := func(name string, tokenRef string) *corev1.Secret {
+		config, err := json.Marshal(v1alpha1.ClusterConfig{ExternalCredentialsConfig: &v1alpha1.ExternalCredentialsConfig{BearerTokenRef: tokenRef}})
+		require.NoError(t, err)
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: fakeNamespace,
+				Labels: map[string]string{
+					common.LabelKeySecretType: common.LabelValueSecretTypeCluster,
+				},
+			},
+			Data: map[string][]byte{
+				"server": []byte("https://" + name),
+				"config": config,
+			},
+		}
+	}
+
+	kubeclientset := fake.NewClientset(
+		argoCDConfigMap, argoCDSecret,
+		newClusterSecret("allowed", "vault:secret/data/clusters/allowed#token"),
+		newClusterSecret("denied", "vault:secret/data/argocd#admin.password"),
+		newClusterSecret("traversal", "vault:secret/data/clusters/../argocd#admin.password"),
+	)
+	settingsManager := settings.NewSettingsManager(t.Context(), kubeclientset, fakeNamespace)
+	db := NewDB(fakeNamespace, settingsManager, kubeclientset)
+
+	cluster, err := db.GetCluster(t.Context(), "https://allowed")
+	require.NoError(t, err)
+	assert.Equal(t, "vault:secret/data/clusters/allowed#token", cluster.Config.ExternalCredentialsConfig.BearerTokenRef)
+	_, err = db.GetCluster(t.Context(), "https://denied")
+	require.ErrorContains(t, err, "is not allowed")
+	_, err = db.GetCluster(t.Context(), "https://traversal")
+	require.ErrorContains(t, err, "is not allowed")
+
+	_, err = db.CreateCluster(t.Context(), &v1alpha1.Cluster{
+		Server: "https://new",
+		Config: v1alpha1.ClusterConfig{ExternalCredentialsConfig: &v1alpha1.ExternalCredentialsConfig{BearerTokenRef: "arn:aws:secretsmanager:us-east-1:123456789012:secret:admin"}},
+	})
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 func TestListClusters(t *testing.T) {
 	emptyArgoCDConfigMap := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
@@ -0,0 +1,199 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// ValidateClusterSecret checks a declarative cluster secret for problems which prevent Argo CD from using it
+func (db *db) ValidateClusterSecret(_ context.Context, secret *corev1.Secret, checkConnection bool) ([]string, error) {
+	clusterSecrets, err := db.listSecretsByType(common.LabelValueSecretTypeCluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cluster secrets: %w", err)
+	}
+	var connect func(cluster *appv1.Cluster) error
+	if checkConnection {
+		connect = func(cluster *appv1.Cluster) error {
+			db.resolveProxyCredentials(cluster)
+			return checkClusterConnection(cluster)
+		}
+	}
+	return validateClusterSecret(secret, clusterSecrets, db.validateClusterCredentials, connect, time.Now()), nil
+}
+
+// validateClusterCredentials returns the problems of the credentials referenced by the cluster: external credentials
+// which are not allowed and proxy credentials secrets which are not labelled as such
+func (db *db) validateClusterCredentials(cluster *appv1.Cluster) []string {
+	var problems []string
+	if err := db.validateExternalCredentials(cluster); err != nil {
+		problems = append(problems, err.Error())
+	}
+	if ref := cluster.Config.ProxyCredentialsSecretRef; ref != nil {
+		secret, err := db.settingsMgr.GetSecretByName(ref.SecretName)
+		switch {
+		case err != nil:
+			problems = append(problems, fmt.Sprintf("failed to get proxy credentials secret %q: %v", ref.SecretName, err))
+		case secret.Labels[common.LabelKeySecretType] != common.LabelValueSecretTypeClusterProxy:
+			problems = append(problems, fmt.Sprintf("proxy credentials secret %q is not labeled with %s=%s", ref.SecretName, common.LabelKeySecretType, common.LabelValueSecretTypeClusterProxy))
+		}
+	}
+	return problems
+}
+
+// validateClusterSecret returns a description of each problem found in the cluster secret: a missing secret type
+// label, malformed data, expired certificates, credentials which cannot be used, and namespaces overlapping with another
+// cluster secret of the same server. The credentials are only checked if checkCredentials is not nil, and the
+// connection to the server is only tested if connect is not nil and the credentials have no problems, so that
+// credentials which are not allowed are never resolved or sent to the server.
+func validateClusterSecret(secret *corev1.Secret, clusterSecrets []*corev1.Secret, checkCredentials func(cluster *appv1.Cluster) []string, connect func(cluster *appv1.Cluster) error, now time.Time) []string {
+	secret = secret.DeepCopy()
+	// secrets read from manifests may not have been converted by the API server yet
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	for k, v := range secret.StringData {
+		secret.Data[k] = []byte(v)
+	}
+
+	var problems []string
+	if secret.Labels[common.LabelKeySecretType] != common.LabelValueSecretTypeCluster {
+		problems = append(problems, fmt.Sprintf("secret is not labeled with %s=%s and is ignored", common.LabelKeySecretType, common.LabelValueSecretTypeCluster))
+	}
+	if len(secret.Data["config"]) > 0 {
+		decoder := json.NewDecoder(bytes.NewReader(secret.Data["config"]))
+		decoder.DisallowUnknownFields()
+		var config appv1.ClusterConfig
+		if err := decoder.Decode(&config); err != nil {
+			problems = append(problems, fmt.Sprintf("malformed config: %v", err))
+		}
+	}
+	if len(secret.Data["syncWindows"]) > 0 {
+		var syncWindows appv1.SyncWindows
+		if err := json.Unmarshal(secret.Data["syncWindows"], &syncWindows); err != nil {
+			problems = append(problems, fmt.Sprintf("malformed syncWindows: %v", err))
+		}
+	}
+	if shard, ok := secret.Data["shard"]; ok {
+		if _, err := strconv.Atoi(string(shard)); err != nil {
+			problems = append(problems, fmt.Sprintf("invalid shard %q", shard))
+		}
+	}
+	cluster, err := SecretToCluster(secret)
+	if err != nil {
+		// the malformed config or sync windows are already reported
+		return problems
+	}
+
+	if cluster.Server == "" {
+		problems = append(problems, "server is missing")
+	} else if u, err := url.Parse(cluster.Server); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		problems = append(problems, fmt.Sprintf("invalid server URL %q", cluster.Server))
+	}
+	for i, window := range cluster.SyncWindows {
+		if err := window.Validate(); err != nil {
+			problems = append(problems, fmt.Sprintf("invalid sync window %d: %v", i, err))
+		}
+	}
+	problems = append(problems, validateClusterCertificates("client certificate", cluster.Config.CertData, now)...)
+	problems = append(problems, validateClusterCertificates("CA certificate", cluster.Config.CAData, now)...)
+	var credentialProblems []string
+	if checkCredentials != nil {
+		credentialProblems = checkCredentials(cluster)
+		problems = append(problems, credentialProblems...)
+	}
+
+	for _, other := range clusterSecrets {
+		// secrets read from manifests may not have a namespace yet
+		if other.Name == secret.Name && (secret.Namespace == "" || other.Namespace == secret.Namespace) {
+			continue
+		}
+		otherCluster, err := SecretToCluster(other)
+		if err != nil || otherCluster.Server != cluster.Server {
+			continue
+		}
+		if overlap := overlappingNamespaces(cluster.Namespaces, otherCluster.Namespaces); overlap != "" {
+			problems = append(problems, fmt.Sprintf("namespaces overlap with cluster secret %q of the same server: %s", other.Name, overlap))
+		}
+	}
+
+	if connect != nil && cluster.Server != "" && len(credentialProblems) == 0 {
+		if err := connect(cluster); err != nil {
+			problems = append(problems, fmt.Sprintf("failed to connect to server: %v", err))
+		}
+	}
+	return problems
+}
+
+// validateClusterCertificates returns the problems of the PEM encoded certificates: certificates which cannot be
+// parsed, have expired, or are not valid yet
+func validateClusterCertificates(name string, data []byte, now time.Time) []string {
+	if len(data) == 0 {
+		return nil
+	}
+	var problems []string
+	found := false
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		found = true
+		cert, err := x509.ParseCertificate(block.Bytes)
+		switch {
+		case err != nil:
+			problems = append(problems, fmt.Sprintf("%s cannot be parsed: %v", name, err))
+		case now.After(cert.NotAfter):
+			problems = append(problems, fmt.Sprintf("%s %q expired at %s", name, cert.Subject.CommonName, cert.NotAfter.UTC().Format(time.RFC3339)))
+		case now.Before(cert.NotBefore):
+			problems = append(problems, fmt.Sprintf("%s %q is not valid before %s", name, cert.Subject.CommonName, cert.NotBefore.UTC().Format(time.RFC3339)))
+		}
+	}
+	if !found {
+		problems = append(problems, name+" data does not contain a PEM encoded certificate")
+	}
+	return problems
+}
+
+// overlappingNamespaces returns a description of the namespaces managed through both lists of namespaces, where an
+// empty list means all namespaces. Returns an empty string if the namespaces do not overlap.
+func overlappingNamespaces(namespaces []string, other []string) string {
+	if len(namespaces) == 0 || len(other) == 0 {
+		return "all namespaces"
+	}
+	overlap := map[string]bool{}
+	for _, ns := range namespaces {
+		if slices.Contains(other, ns) {
+			overlap[ns] = true
+		}
+	}
+	return strings.Join(slices.Sorted(maps.Keys(overlap)), ", ")
+}
+
+// checkClusterConnection requests the version of the cluster API server
+func checkClusterConnection(cluster *appv1.Cluster) error {
+	config, err := cluster.RESTConfig()
+	if err != nil {
+		return err
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+	_, err = clientset.Discovery().ServerVersion()
+	return err
+}
@@ -0,0 +1,202 @@
+package db
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+func newValidationClusterSecret(name string, data map[string]string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: fakeNamespace,
+			Labels:    map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeCluster},
+		},
+		StringData: data,
+	}
+}
+
+func newValidationCertificate(t *testing.T, notBefore, notAfter time.Time) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func Test_validateClusterSecret(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	validCert := newValidationCertificate(t, now.Add(-time.Hour), now.Add(time.Hour))
+	expiredCert := newValidationCertificate(t, now.Add(-2*time.Hour), now.Add(-time.Hour))
+	futureCert := newValidationCertificate(t, now.Add(time.Hour), now.Add(2*time.Hour))
+	config := func(certData string) string {
+		data, err := json.Marshal(v1alpha1.ClusterConfig{BearerToken: "token", TLSClientConfig: v1alpha1.TLSClientConfig{CAData: []byte(certData)}})
+		require.NoError(t, err)
+		return string(data)
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		secret := newValidationClusterSecret("cluster", map[string]string{"server": "https://cluster", "config": config(validCert)})
+		assert.Empty(t, validateClusterSecret(secret, nil, nil, nil, now))
+	})
+	t.Run("missing label", func(t *testing.T) {
+		secret := newValidationClusterSecret("cluster", map[string]string{"server": "https://cluster"})
+		secret.Labels = nil
+		assert.Equal(t, []string{"secret is not labeled with argocd.argoproj.io/secret-type=cluster and is ignored"}, validateClusterSecret(secret, nil, nil, nil, now))
+	})
+	t.Run("malformed config", func(t *testing.T) {
+		secret := newValidationClusterSecret("cluster", map[string]string{"server": "https://cluster", "config": `{"bearerToken":`})
+		problems := validateClusterSecret(secret, nil, nil, nil, now)
+		require.Len(t, problems, 1)
+		assert.Contains(t, problems[0], "malformed config")
+	})
+	t.Run("unknown config field", func(t *testing.T) {
+		secret := newValidationClusterSecret("cluster", map[string]string{"server": "https://cluster", "config": `{"bearer_token":"token"}`})
+		problems := validateClusterSecret(secret, nil, nil, nil, now)
+		require.Len(t, problems, 1)
+		assert.Contains(t, problems[0], `unknown field "bearer_token"`)
+	})
+	t.Run("sync windows", func(t *testing.T) {
+		secret := newValidationClusterSecret("cluster", map[string]string{"server": "https://cluster", "syncWindows": `[{"kind":"deny"`})
+		problems := validateClusterSecret(secret, nil, nil, nil, now)
+		require.Len(t, problems, 1)
+		assert.Contains(t, problems[0], "malformed syncWindows")
+
+		secret = newValidationClusterSecret("cluster", map[string]string{"server": "https://cluster", "syncWindows": `[{"kind":"block","schedule":"0 22 * * *","duration":"1h"}]`})
+		assert.Equal(t, []string{"invalid sync window 0: kind 'block' mismatch: can only be allow or deny"}, validateClusterSecret(secret, nil, nil, nil, now))
+	})
+	t.Run("invalid server and shard", func(t *testing.T) {
+		secret := newValidationClusterSecret("cluster", map[string]string{"server": "cluster:6443", "shard": "first"})
+		assert.Equal(t, []string{`invalid shard "first"`, `invalid server URL "cluster:6443"`}, validateClusterSecret(secret, nil, nil, nil, now))
+
+		secret = newValidationClusterSecret("cluster", nil)
+		assert.Equal(t, []string{"server is missing"}, validateClusterSecret(secret, nil, nil, nil, now))
+	})
+	t.Run("certificates", func(t *testing.T) {
+		secret := newValidationClusterSecret("cluster", map[string]string{"server": "https://cluster", "config": config(expiredCert + futureCert)})
+		assert.Equal(t, []string{
+			`CA certificate "test" expired at 2024-05-31T23:00:00Z`,
+			`CA certificate "test" is not valid before 2024-06-01T01:00:00Z`,
+		}, validateClusterSecret(secret, nil, nil, nil, now))
+
+		secret = newValidationClusterSecret("cluster", map[string]string{"server": "https://cluster", "config": config("not a certificate")})
+		assert.Equal(t, []string{"CA certificate data does not contain a PEM encoded certificate"}, validateClusterSecret(secret, nil, nil, nil, now))
+	})
+	t.Run("namespace overlaps", func(t *testing.T) {
+		existing := []*corev1.Secret{
+			newValidationClusterSecret("cluster", map[string]string{"server": "https://cluster", "namespaces": "team-a,team-b"}),
+			newValidationClusterSecret("team-b", map[string]string{"server": "https://cluster/", "namespaces": "team-b,team-c"}),
+			newValidationClusterSecret("other", map[string]string{"server": "https://other"}),
+		}
+		for _, s := range existing {
+			s.Data = map[string][]byte{}
+			for k, v := range s.StringData {
+				s.Data[k] = []byte(v)
+			}
+			s.StringData = nil
+		}
+		secret := newValidationClusterSecret("cluster", map[string]string{"server": "https://cluster", "namespaces": "team-a,team-b"})
+		assert.Equal(t, []string{`namespaces overlap with cluster secret "team-b" of the same server: team-b`}, validateClusterSecret(secret, existing, nil, nil, now))
+
+		secret = newValidationClusterSecret("new", map[string]string{"server": "https://cluster"})
+		assert.Equal(t, []string{
+			`namespaces overlap with cluster secret "cluster" of the same server: all namespaces`,
+			`namespaces overlap with cluster secret "team-b" of the same server: all namespaces`,
+		}, validateClusterSecret(secret, existing, nil, nil, now))
+
+		secret = newValidationClusterSecret("new", map[string]string{"server": "https://cluster", "namespaces": "team-d"})
+		assert.Empty(t, validateClusterSecret(secret, existing, nil, nil, now))
+	})
+	t.Run("connection", func(t *testing.T) {
+		secret := newValidationClusterSecret("cluster", map[string]string{"server": "https://cluster"})
+		var connected *v1alpha1.Cluster
+		problems := validateClusterSecret(secret, nil, nil, func(cluster *v1alpha1.Cluster) error {
+			connected = cluster
+			return errors.New("connection refused")
+		}, now)
+		assert.Equal(t, []string{"failed to connect to server: connection refused"}, problems)
+		require.NotNil(t, connected)
+		assert.Equal(t, "https://cluster", connected.Server)
+	})
+	t.Run("credential problems skip connection", func(t *testing.T) {
+		secret := newValidationClusterSecret("cluster", map[string]string{"server": "https://cluster"})
+		problems := validateClusterSecret(secret, nil, func(_ *v1alpha1.Cluster) []string {
+			return []string{"not allowed"}
+		}, func(_ *v1alpha1.Cluster) error {
+			t.Fatal("connected to the server of a cluster with credential problems")
+			return nil
+		}, now)
+		assert.Equal(t, []string{"not allowed"}, problems)
+	})
+}
+
+func TestValidateClusterSecret(t *testing.T) {
+	existing := newValidationClusterSecret("existing", nil)
+	existing.Data = map[string][]byte{"server": []byte("https://cluster")}
+	kubeclientset := fake.NewClientset(existing)
+	settingsManager := settings.NewSettingsManager(t.Context(), kubeclientset, fakeNamespace)
+	db := NewDB(fakeNamespace, settingsManager, kubeclientset)
+
+	problems, err := db.ValidateClusterSecret(t.Context(), newValidationClusterSecret("new", map[string]string{"server": "https://cluster", "namespaces": "team-a"}), false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{`namespaces overlap with cluster secret "existing" of the same server: all namespaces`}, problems)
+}
+
+func TestValidateClusterSecret_Credentials(t *testing.T) {
+	dialed := false
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		dialed = true
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+	proxySecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "proxy", Namespace: fakeNamespace}}
+	kubeclientset := fake.NewClientset(proxySecret, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: fakeNamespace, Labels: map[string]string{"app.kubernetes.io/part-of": "argocd"}},
+		Data:       map[string]string{"cluster.externalCredentials.allowedRefPrefixes": "- vault://secret/argocd/"},
+	})
+	settingsManager := settings.NewSettingsManager(t.Context(), kubeclientset, fakeNamespace)
+	db := NewDB(fakeNamespace, settingsManager, kubeclientset)
+
+	config, err := json.Marshal(v1alpha1.ClusterConfig{
+		TLSClientConfig:           v1alpha1.TLSClientConfig{Insecure: true},
+		ExternalCredentialsConfig: &v1alpha1.ExternalCredentialsConfig{BearerTokenRef: "vault://secret/other/token"},
+		ProxyCredentialsSecretRef: &v1alpha1.ProxyCredentialsSecretRef{SecretName: "proxy"},
+	})
+	require.NoError(t, err)
+	problems, err := db.ValidateClusterSecret(t.Context(), newValidationClusterSecret("new", map[string]string{"server": server.URL, "config": string(config)}), true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		fmt.Sprintf(`external credentials reference "vault://secret/other/token" of cluster %q is not allowed by cluster.externalCredentials.allowedRefPrefixes in argocd-cm`, server.URL),
+		`proxy credentials secret "proxy" is not labeled with argocd.argoproj.io/secret-type=cluster-proxy`,
+	}, problems)
+	assert.False(t, dialed)
+}
@@ -44,6 +44,9 @@ type ArgoDB interface {
 	UpdateCluster(ctx context.Context, c *appv1.Cluster) (*appv1.Cluster, error)
 	// DeleteCluster deletes a cluster by name
 	DeleteCluster(ctx context.Context, server string) error
+	// ValidateClusterSecret checks a declarative cluster secret for problems which prevent Argo CD from using it,
+	// optionally testing the connection to the cluster, and returns a description of each problem found
+	ValidateClusterSecret(ctx context.Context, secret *corev1.Secret, checkConnection bool) ([]string, error)
 
 	// ListRepositories lists repositories
 	ListRepositories(ctx context.Context) ([]*appv1.Repository, error)
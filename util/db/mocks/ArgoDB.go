@@ -8,6 +8,8 @@ import (
 	db "github.com/argoproj/argo-cd/v3/util/db"
 	mock "github.com/stretchr/testify/mock"
 
+	v1 "k8s.io/api/core/v1"
+
 	v1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
@@ -1109,6 +1111,36 @@ func (_m *ArgoDB) UpdateWriteRepositoryCredentials(ctx context.Context, r *v1alp
 	return r0, r1
 }
 
+// ValidateClusterSecret provides a mock function with given fields: ctx, secret, checkConnection
+func (_m *ArgoDB) ValidateClusterSecret(ctx context.Context, secret *v1.Secret, checkConnection bool) ([]string, error) {
+	ret := _m.Called(ctx, secret, checkConnection)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateClusterSecret")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.Secret, bool) ([]string, error)); ok {
+		return rf(ctx, secret, checkConnection)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.Secret, bool) []string); ok {
+		r0 = rf(ctx, secret, checkConnection)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.Secret, bool) error); ok {
+		r1 = rf(ctx, secret, checkConnection)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // WatchClusters provides a mock function with given fields: ctx, handleAddEvent, handleModEvent, handleDeleteEvent
 func (_m *ArgoDB) WatchClusters(ctx context.Context, handleAddEvent func(*v1alpha1.Cluster), handleModEvent func(*v1alpha1.Cluster, *v1alpha1.Cluster), handleDeleteEvent func(string)) error {
 	ret := _m.Called(ctx, handleAddEvent, handleModEvent, handleDeleteEvent)
@@ -287,11 +287,16 @@ func (s *secretsRepositoryBackend) GetAllHelmRepoCreds(_ context.Context) ([]*ap
 	}
 
 	for _, secret := range secrets {
-		if strings.EqualFold(string(secret.Data["type"]), "helm") {
+		repoType := string(secret.Data["type"])
+		// the credentials of the OCI registries are used for the OCI dependencies of the charts
+		if strings.EqualFold(repoType, "helm") || strings.EqualFold(repoType, "oci") {
 			repoCreds, err := s.secretToRepoCred(secret)
 			if err != nil {
 				return nil, err
 			}
+			if strings.EqualFold(repoType, "oci") {
+				repoCreds.EnableOCI = true
+			}
 
 			helmRepoCreds = append(helmRepoCreds, repoCreds)
 		}
@@ -367,6 +372,13 @@ func secretToRepository(secret *corev1.Secret) (*appsv1.Repository, error) {
 	}
 	repository.UseAzureWorkloadIdentity = useAzureWorkloadIdentity
 
+	depth, err := intOrZero(secret, "depth")
+	if err != nil {
+		return repository, err
+	}
+	repository.Depth = depth
+	repository.Filter = string(secret.Data["filter"])
+
 	return repository, nil
 }
 
@@ -398,6 +410,8 @@ func (s *secretsRepositoryBackend) repositoryToSecret(repository *appsv1.Reposit
 	updateSecretString(secret, "gcpServiceAccountKey", repository.GCPServiceAccountKey)
 	updateSecretBool(secret, "forceHttpBasicAuth", repository.ForceHttpBasicAuth)
 	updateSecretBool(secret, "useAzureWorkloadIdentity", repository.UseAzureWorkloadIdentity)
+	updateSecretInt(secret, "depth", repository.Depth)
+	updateSecretString(secret, "filter", repository.Filter)
 	addSecretMetadata(secret, s.getSecretType())
 }
 
@@ -34,6 +34,8 @@ func TestSecretsRepositoryBackend_CreateRepository(t *testing.T) {
 		Password:              "somePassword",
 		InsecureIgnoreHostKey: false,
 		EnableLFS:             true,
+		Depth:                 1,
+		Filter:                "blob:none",
 	}
 	setupWithK8sObjects := func(objects ...runtime.Object) *fixture {
 		clientset := getClientset(objects...)
@@ -77,6 +79,8 @@ func TestSecretsRepositoryBackend_CreateRepository(t *testing.T) {
 		assert.Equal(t, repo.Password, string(secret.Data["password"]))
 		assert.Empty(t, string(secret.Data["insecureIgnoreHostKey"]))
 		assert.Equal(t, strconv.FormatBool(repo.EnableLFS), string(secret.Data["enableLfs"]))
+		assert.Equal(t, "1", string(secret.Data["depth"]))
+		assert.Equal(t, repo.Filter, string(secret.Data["filter"]))
 	})
 	t.Run("will return proper error if secret does not have expected label", func(t *testing.T) {
 		// given
@@ -914,6 +918,20 @@ func TestSecretsRepositoryBackend_GetAllHelmRepoCreds(t *testing.T) {
 				"type":     []byte("git"),
 			},
 		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   testNamespace,
+				Name:        RepoURLToSecretName(repoSecretPrefix, "registry.example.com", ""),
+				Annotations: map[string]string{common.AnnotationKeyManagedBy: common.AnnotationValueManagedByArgoCD},
+				Labels:      map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeRepoCreds},
+			},
+			Data: map[string][]byte{
+				"url":      []byte("registry.example.com"),
+				"username": []byte("someRegistryUsername"),
+				"password": []byte("someRegistryPassword"),
+				"type":     []byte("oci"),
+			},
+		},
 	}
 
 	clientset := getClientset(repoCredSecrets...)
@@ -925,7 +943,10 @@ func TestSecretsRepositoryBackend_GetAllHelmRepoCreds(t *testing.T) {
 
 	repoCreds, err := testee.GetAllHelmRepoCreds(t.Context())
 	require.NoError(t, err)
-	assert.Len(t, repoCreds, 1)
+	require.Len(t, repoCreds, 2)
+	for _, repoCred := range repoCreds {
+		assert.Equal(t, repoCred.URL == "registry.example.com", repoCred.EnableOCI)
+	}
 }
 
 func TestRepoCredsToSecret(t *testing.T) {
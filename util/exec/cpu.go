@@ -0,0 +1,28 @@
+package exec
+
+import (
+	"os"
+	"sync/atomic"
+	"time"
+)
+
+// CPUUsage accumulates the CPU time, user and system, of the commands it is passed to. It is safe for concurrent use.
+type CPUUsage struct {
+	nanos atomic.Int64
+}
+
+// Add adds the CPU time of the exited process to the usage. It does nothing if the usage is nil.
+func (u *CPUUsage) Add(state *os.ProcessState) {
+	if u == nil || state == nil {
+		return
+	}
+	u.nanos.Add(int64(state.UserTime() + state.SystemTime()))
+}
+
+// Get returns the accumulated CPU time, 0 if the usage is nil
+func (u *CPUUsage) Get() time.Duration {
+	if u == nil {
+		return 0
+	}
+	return time.Duration(u.nanos.Load())
+}
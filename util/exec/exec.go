@@ -33,6 +33,10 @@ type ExecRunOpts struct {
 	SkipErrorLogging bool
 	// CaptureStderr determines whether to capture stderr in addition to stdout
 	CaptureStderr bool
+	// Sandbox constrains the resources of the command, if not nil
+	Sandbox *Sandbox
+	// CPUUsage accumulates the CPU time of the command, if not nil
+	CPUUsage *CPUUsage
 }
 
 func init() {
@@ -57,7 +61,7 @@ func RunWithRedactor(cmd *exec.Cmd, redactor func(text string) string) (string,
 }
 
 func RunWithExecRunOpts(cmd *exec.Cmd, opts ExecRunOpts) (string, error) {
-	cmdOpts := CmdOpts{Timeout: timeout, Redactor: opts.Redactor, TimeoutBehavior: opts.TimeoutBehavior, SkipErrorLogging: opts.SkipErrorLogging}
+	cmdOpts := CmdOpts{Timeout: timeout, Redactor: opts.Redactor, TimeoutBehavior: opts.TimeoutBehavior, SkipErrorLogging: opts.SkipErrorLogging, Sandbox: opts.Sandbox, CPUUsage: opts.CPUUsage}
 	span := tracing.NewLoggingTracer(log.NewLogrusLogger(log.NewWithCurrentConfig())).StartSpan(fmt.Sprintf("exec %v", cmd.Args[0]))
 	span.SetBaggageItem("dir", cmd.Dir)
 	if cmdOpts.Redactor != nil {
@@ -138,6 +142,10 @@ type CmdOpts struct {
 	SkipErrorLogging bool
 	// CaptureStderr defines whether to capture stderr in addition to stdout
 	CaptureStderr bool
+	// Sandbox constrains the resources of the command, if not nil. Its timeout overrides Timeout.
+	Sandbox *Sandbox
+	// CPUUsage accumulates the CPU time of the command, if not nil
+	CPUUsage *CPUUsage
 }
 
 var DefaultCmdOpts = CmdOpts{
@@ -180,14 +188,27 @@ func RunCommandExt(cmd *exec.Cmd, opts CmdOpts) (string, error) {
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
 
+	release, err := opts.Sandbox.Prepare(cmd)
+	if err != nil {
+		return "", fmt.Errorf("failed to prepare the sandbox of the command: %w", err)
+	}
+
 	start := time.Now()
 	err = cmd.Start()
 	if err != nil {
+		_ = release()
 		return "", err
 	}
 
 	done := make(chan error)
-	go func() { done <- cmd.Wait() }()
+	go func() {
+		err := cmd.Wait()
+		opts.CPUUsage.Add(cmd.ProcessState)
+		if sandboxErr := release(); sandboxErr != nil {
+			err = sandboxErr
+		}
+		done <- err
+	}()
 
 	// Start a timer
 	timeout := DefaultCmdOpts.Timeout
@@ -195,6 +216,9 @@ func RunCommandExt(cmd *exec.Cmd, opts CmdOpts) (string, error) {
 	if opts.Timeout != time.Duration(0) {
 		timeout = opts.Timeout
 	}
+	if sandboxTimeout := opts.Sandbox.GetTimeout(); sandboxTimeout > 0 {
+		timeout = sandboxTimeout
+	}
 
 	var timoutCh <-chan time.Time
 	if timeout != 0 {
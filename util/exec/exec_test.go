@@ -192,3 +192,18 @@ func TestRunCaptureStderr(t *testing.T) {
 	assert.Equal(t, "hello world\nmy-error", output)
 	assert.NoError(t, err)
 }
+
+func TestRunCPUUsage(t *testing.T) {
+	usage := &CPUUsage{}
+	_, err := RunCommand("sh", CmdOpts{CPUUsage: usage}, "-c", "i=0; while [ $i -lt 100000 ]; do i=$((i+1)); done")
+	require.NoError(t, err)
+	consumed := usage.Get()
+	assert.Positive(t, consumed)
+
+	_, err = RunCommand("sh", CmdOpts{CPUUsage: usage}, "-c", "exit 1")
+	require.Error(t, err)
+	assert.GreaterOrEqual(t, usage.Get(), consumed)
+
+	var noUsage *CPUUsage
+	assert.Zero(t, noUsage.Get())
+}
@@ -0,0 +1,39 @@
+package exec
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Sandbox constrains the resources of a command, so that a malicious or runaway command can't exhaust the resources of
+// the process running it. The network is denied unless allowed.
+type Sandbox struct {
+	// CPU is the maximum number of cores used by the command and its children, unlimited if empty
+	CPU *resource.Quantity `json:"cpu,omitempty"`
+	// Memory is the maximum memory used by the command and its children, unlimited if empty
+	Memory *resource.Quantity `json:"memory,omitempty"`
+	// Timeout is the maximum duration of the command, defaults to the timeout of the commands (ARGOCD_EXEC_TIMEOUT)
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// Network allows the command to access the network
+	Network bool `json:"network,omitempty"`
+}
+
+// HasResourceLimits returns whether the sandbox limits the CPU or the memory, which requires the cgroup of the
+// sandboxes to be initialized with InitSandboxCgroup.
+func (s *Sandbox) HasResourceLimits() bool {
+	return s != nil && (s.CPU != nil && !s.CPU.IsZero() || s.Memory != nil && !s.Memory.IsZero())
+}
+
+// GetTimeout returns the maximum duration of the command, or 0 if not defined.
+func (s *Sandbox) GetTimeout() time.Duration {
+	if s == nil || s.Timeout == nil {
+		return 0
+	}
+	return s.Timeout.Duration
+}
+
+func noopRelease() error {
+	return nil
+}
@@ -0,0 +1,24 @@
+//go:build !linux
+
+package exec
+
+import (
+	"errors"
+	"os/exec"
+)
+
+var errSandboxUnsupported = errors.New("the sandboxes are only supported on Linux")
+
+// InitSandboxCgroup prepares the cgroup of the current process to hold the cgroups of the sandboxes, which are only
+// supported on Linux.
+func InitSandboxCgroup() error {
+	return errSandboxUnsupported
+}
+
+// Prepare configures the command to run in the sandbox, which are only supported on Linux.
+func (s *Sandbox) Prepare(_ *exec.Cmd) (func() error, error) {
+	if s == nil {
+		return noopRelease, nil
+	}
+	return nil, errSandboxUnsupported
+}
@@ -0,0 +1,179 @@
+//go:build linux
+
+package exec
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/util/rand"
+)
+
+const (
+	cgroupFS = "/sys/fs/cgroup"
+	// cpuPeriod is the period of the CPU quota of the sandboxes, in microseconds
+	cpuPeriod = 100000
+)
+
+// sandboxCgroupRoot is the cgroup holding the cgroups of the sandboxes
+var sandboxCgroupRoot string
+
+// InitSandboxCgroup prepares the cgroup of the current process to hold the cgroups of the sandboxes limiting the
+// resources of the commands. Since the resource controllers of a cgroup v2 can only be enabled for its children when
+// it has no processes, the processes of the cgroup, e.g. the processes of the container, are moved to a child cgroup.
+// It must be called once, before running commands in sandboxes with resource limits.
+func InitSandboxCgroup() error {
+	current, err := currentCgroup()
+	if err != nil {
+		return err
+	}
+	root := filepath.Join(cgroupFS, current)
+	leaf := filepath.Join(root, "argocd")
+	if err := os.Mkdir(leaf, 0o755); err != nil && !os.IsExist(err) {
+		return fmt.Errorf("failed to create the cgroup of the processes: %w", err)
+	}
+	procs, err := os.ReadFile(filepath.Join(root, "cgroup.procs"))
+	if err != nil {
+		return fmt.Errorf("failed to list the processes of the cgroup: %w", err)
+	}
+	for _, pid := range strings.Fields(string(procs)) {
+		// the processes which exited in the meantime can't be moved
+		if err := os.WriteFile(filepath.Join(leaf, "cgroup.procs"), []byte(pid), 0o644); err != nil && !errors.Is(err, syscall.ESRCH) {
+			return fmt.Errorf("failed to move the process %s to a child cgroup: %w", pid, err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(root, "cgroup.subtree_control"), []byte("+cpu +memory"), 0o644); err != nil {
+		return fmt.Errorf("failed to enable the cpu and memory controllers of the cgroup: %w", err)
+	}
+	sandboxCgroupRoot = root
+	return nil
+}
+
+func currentCgroup() (string, error) {
+	data, err := os.ReadFile("/proc/self/cgroup")
+	if err != nil {
+		return "", fmt.Errorf("failed to read the cgroup of the process: %w", err)
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		if path, ok := strings.CutPrefix(scanner.Text(), "0::"); ok {
+			return path, nil
+		}
+	}
+	return "", errors.New("the sandboxes require the cgroups v2")
+}
+
+// Prepare configures the command to run in the sandbox. It must be called before starting the command, and the
+// returned function must be called once the command exited. The function returns an error if the command exceeded the
+// memory limit of the sandbox.
+func (s *Sandbox) Prepare(cmd *exec.Cmd) (func() error, error) {
+	if s == nil {
+		return noopRelease, nil
+	}
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	if !s.Network {
+		// The command runs in a network namespace without interfaces. The user namespace allows the process to create
+		// the network namespace without privileges, and maps the user to itself.
+		uid, gid := os.Getuid(), os.Getgid()
+		cmd.SysProcAttr.Cloneflags |= syscall.CLONE_NEWUSER | syscall.CLONE_NEWNET
+		cmd.SysProcAttr.UidMappings = []syscall.SysProcIDMap{{ContainerID: uid, HostID: uid, Size: 1}}
+		cmd.SysProcAttr.GidMappings = []syscall.SysProcIDMap{{ContainerID: gid, HostID: gid, Size: 1}}
+	}
+	if !s.HasResourceLimits() {
+		return noopRelease, nil
+	}
+	if sandboxCgroupRoot == "" {
+		return nil, errors.New("the cgroup of the sandboxes is not initialized")
+	}
+
+	id, err := rand.RandHex(10)
+	if err != nil {
+		return nil, err
+	}
+	dir := filepath.Join(sandboxCgroupRoot, "sandbox-"+id)
+	if err := os.Mkdir(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create the cgroup of the sandbox: %w", err)
+	}
+	cgroup, err := s.configureCgroup(dir)
+	if err != nil {
+		removeCgroup(dir)
+		return nil, err
+	}
+	cmd.SysProcAttr.UseCgroupFD = true
+	cmd.SysProcAttr.CgroupFD = int(cgroup.Fd())
+
+	return func() error {
+		_ = cgroup.Close()
+		var exceeded error
+		if oomKilled(dir) {
+			exceeded = fmt.Errorf("the command exceeded the memory limit of %s of the sandbox", s.Memory.String())
+		}
+		// the children of the command which are still running are killed
+		_ = os.WriteFile(filepath.Join(dir, "cgroup.kill"), []byte("1"), 0o644)
+		removeCgroup(dir)
+		return exceeded
+	}, nil
+}
+
+func (s *Sandbox) configureCgroup(dir string) (*os.File, error) {
+	if s.Memory != nil && !s.Memory.IsZero() {
+		if err := os.WriteFile(filepath.Join(dir, "memory.max"), []byte(strconv.FormatInt(s.Memory.Value(), 10)), 0o644); err != nil {
+			return nil, fmt.Errorf("failed to limit the memory of the sandbox: %w", err)
+		}
+		// the swap isn't available on all the nodes
+		if err := os.WriteFile(filepath.Join(dir, "memory.swap.max"), []byte("0"), 0o644); err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("failed to limit the swap of the sandbox: %w", err)
+		}
+	}
+	if s.CPU != nil && !s.CPU.IsZero() {
+		quota := max(s.CPU.MilliValue()*cpuPeriod/1000, 1000)
+		if err := os.WriteFile(filepath.Join(dir, "cpu.max"), []byte(fmt.Sprintf("%d %d", quota, cpuPeriod)), 0o644); err != nil {
+			return nil, fmt.Errorf("failed to limit the CPU of the sandbox: %w", err)
+		}
+	}
+	cgroup, err := os.Open(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the cgroup of the sandbox: %w", err)
+	}
+	return cgroup, nil
+}
+
+// oomKilled returns whether a process of the cgroup was killed for exceeding the memory limit
+func oomKilled(dir string) bool {
+	events, err := os.ReadFile(filepath.Join(dir, "memory.events"))
+	if err != nil {
+		return false
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(events))
+	for scanner.Scan() {
+		if count, ok := strings.CutPrefix(scanner.Text(), "oom_kill "); ok {
+			return count != "0"
+		}
+	}
+	return false
+}
+
+// removeCgroup removes the cgroup of a sandbox, once its processes are reaped
+func removeCgroup(dir string) {
+	var err error
+	for range 10 {
+		if err = os.Remove(dir); err == nil || os.IsNotExist(err) {
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	logrus.Warnf("Failed to remove the cgroup %s of the sandbox: %v", dir, err)
+}
@@ -0,0 +1,63 @@
+//go:build linux
+
+package exec
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSandbox_Network(t *testing.T) {
+	// the network namespaces of the sandboxes are created in an unprivileged user namespace
+	if err := exec.Command("unshare", "--user", "--net", "true").Run(); err != nil {
+		t.Skipf("user namespaces are not supported: %v", err)
+	}
+
+	t.Run("Denied", func(t *testing.T) {
+		out, err := RunCommandExt(exec.Command("cat", "/proc/net/dev"), CmdOpts{Sandbox: &Sandbox{}})
+		require.NoError(t, err)
+		var interfaces []string
+		for _, line := range strings.Split(out, "\n")[2:] {
+			name, _, _ := strings.Cut(strings.TrimSpace(line), ":")
+			interfaces = append(interfaces, name)
+		}
+		assert.Equal(t, []string{"lo"}, interfaces)
+	})
+
+	t.Run("Allowed", func(t *testing.T) {
+		out, err := RunCommandExt(exec.Command("cat", "/proc/self/status"), CmdOpts{Sandbox: &Sandbox{Network: true}})
+		require.NoError(t, err)
+		assert.NotEmpty(t, out)
+	})
+}
+
+func TestSandbox_Timeout(t *testing.T) {
+	_, err := RunCommandExt(exec.Command("sleep", "30"), CmdOpts{
+		Timeout: time.Minute,
+		Sandbox: &Sandbox{Network: true, Timeout: &metav1.Duration{Duration: 100 * time.Millisecond}},
+	})
+	require.EqualError(t, err, "`sleep 30` failed timeout after 100ms")
+}
+
+func TestSandbox_ResourceLimitsWithoutCgroup(t *testing.T) {
+	memory := resource.MustParse("64Mi")
+	_, err := RunCommandExt(exec.Command("true"), CmdOpts{Sandbox: &Sandbox{Network: true, Memory: &memory}})
+	require.EqualError(t, err, "failed to prepare the sandbox of the command: the cgroup of the sandboxes is not initialized")
+}
+
+func TestSandbox_HasResourceLimits(t *testing.T) {
+	cpu := resource.MustParse("500m")
+	zero := resource.MustParse("0")
+	var sandbox *Sandbox
+	assert.False(t, sandbox.HasResourceLimits())
+	assert.False(t, (&Sandbox{Memory: &zero}).HasResourceLimits())
+	assert.False(t, (&Sandbox{Timeout: &metav1.Duration{Duration: time.Second}}).HasResourceLimits())
+	assert.True(t, (&Sandbox{CPU: &cpu}).HasResourceLimits())
+}
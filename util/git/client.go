@@ -119,6 +119,10 @@ type nativeGitClient struct {
 	proxy string
 	// list of targets that shouldn't use the proxy, applies only if the proxy is set
 	noProxy string
+	// number of commits fetched from the tip of each ref, the repository is shallow if greater than zero
+	depth int64
+	// partial clone filter used when fetching, e.g. blob:none
+	filter string
 }
 
 type runOpts struct {
@@ -164,6 +168,22 @@ func WithEventHandlers(handlers EventHandlers) ClientOpts {
 	}
 }
 
+// WithDepth sets the number of commits fetched from the tip of each ref. The repository is shallow cloned if the depth
+// is greater than zero, and is deepened when a revision missing from the shallow history is requested.
+func WithDepth(depth int64) ClientOpts {
+	return func(c *nativeGitClient) {
+		c.depth = depth
+	}
+}
+
+// WithFilter sets the partial clone filter (e.g. blob:none) used when fetching. The filtered objects are lazily
+// fetched from the remote when they are needed.
+func WithFilter(filter string) ClientOpts {
+	return func(c *nativeGitClient) {
+		c.filter = filter
+	}
+}
+
 func NewClient(rawRepoURL string, creds Creds, insecure bool, enableLfs bool, proxy string, noProxy string, opts ...ClientOpts) (Client, error) {
 	r := regexp.MustCompile("(/|:)")
 	normalizedGitURL := NormalizeGitURL(rawRepoURL)
@@ -372,13 +392,50 @@ func (m *nativeGitClient) IsLFSEnabled() bool {
 }
 
 func (m *nativeGitClient) fetch(revision string) error {
-	var err error
+	args := []string{"fetch", "origin"}
 	if revision != "" {
-		err = m.runCredentialedCmd("fetch", "origin", revision, "--tags", "--force", "--prune")
-	} else {
-		err = m.runCredentialedCmd("fetch", "origin", "--tags", "--force", "--prune")
+		args = append(args, revision)
 	}
-	return err
+	args = append(args, "--tags", "--force", "--prune")
+	args = append(args, m.fetchFilterArgs()...)
+	if m.depth > 0 {
+		args = append(args, fmt.Sprintf("--depth=%d", m.depth))
+	} else if m.isShallow() {
+		// the repository was shallow cloned before the depth was unset
+		args = append(args, "--unshallow")
+	}
+	return m.runCredentialedCmd(args...)
+}
+
+// fetchFilterArgs returns the arguments setting the partial clone filter of a fetch
+func (m *nativeGitClient) fetchFilterArgs() []string {
+	if m.filter == "" {
+		return nil
+	}
+	return []string{"--filter=" + m.filter}
+}
+
+// isShallow returns true if the local repository only holds part of the history
+func (m *nativeGitClient) isShallow() bool {
+	_, err := os.Stat(filepath.Join(m.root, ".git", "shallow"))
+	return err == nil
+}
+
+// deepen fetches the full history of a shallow repository, it returns false if the repository is not shallow
+func (m *nativeGitClient) deepen() (bool, error) {
+	if !m.isShallow() {
+		return false, nil
+	}
+	log.Infof("Deepening the shallow clone of %s", m.repoURL)
+	if m.OnFetch != nil {
+		done := m.OnFetch(m.repoURL)
+		defer done()
+	}
+	args := append([]string{"fetch", "origin", "--tags", "--force", "--prune", "--unshallow"}, m.fetchFilterArgs()...)
+	if err := m.runCredentialedCmd(args...); err != nil {
+		return false, fmt.Errorf("failed to deepen the shallow clone: %w", err)
+	}
+	return true, nil
 }
 
 // IsRevisionPresent checks to see if the given revision already exists locally.
@@ -403,6 +460,15 @@ func (m *nativeGitClient) Fetch(revision string) error {
 	}
 
 	err := m.fetch(revision)
+	if err != nil && revision != "" && m.depth > 0 {
+		// the server may refuse to fetch a commit which isn't the tip of a ref, fetch the full history instead
+		log.Infof("Failed to fetch revision %s of the shallow clone of %s: %v", revision, m.repoURL, err)
+		var deepened bool
+		deepened, err = m.deepen()
+		if err == nil && deepened {
+			err = m.runCredentialedCmd(append([]string{"fetch", "origin", revision, "--force"}, m.fetchFilterArgs()...)...)
+		}
+	}
 
 	// When we have LFS support enabled, check for large files and fetch them too.
 	if err == nil && m.IsLFSEnabled() {
@@ -481,7 +547,7 @@ func (m *nativeGitClient) Checkout(revision string, submoduleEnabled bool) (stri
 	if revision == "" || revision == "HEAD" {
 		revision = "origin/HEAD"
 	}
-	if out, err := m.runCmd("checkout", "--force", revision); err != nil {
+	if out, err := m.runPromisorCmd("checkout", "--force", revision); err != nil {
 		return out, fmt.Errorf("failed to checkout %s: %w", revision, err)
 	}
 	// We must populate LFS content by using lfs checkout, if we have at least
@@ -814,9 +880,16 @@ func (m *nativeGitClient) ChangedFiles(revision string, targetRevision string) (
 		return []string{}, errors.New("invalid revision provided, must be SHA")
 	}
 
-	out, err := m.runCmd("diff", "--name-only", fmt.Sprintf("%s..%s", revision, targetRevision))
+	diffArgs := []string{"diff", "--name-only", fmt.Sprintf("%s..%s", revision, targetRevision)}
+	out, err := m.runPromisorCmd(diffArgs...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to diff %s..%s: %w", revision, targetRevision, err)
+		// the revisions may be missing from the history of a shallow clone
+		if deepened, deepenErr := m.deepen(); deepenErr != nil || !deepened {
+			return nil, fmt.Errorf("failed to diff %s..%s: %w", revision, targetRevision, err)
+		}
+		if out, err = m.runPromisorCmd(diffArgs...); err != nil {
+			return nil, fmt.Errorf("failed to diff %s..%s: %w", revision, targetRevision, err)
+		}
 	}
 
 	if out == "" {
@@ -957,9 +1030,24 @@ func (m *nativeGitClient) runCmd(args ...string) (string, error) {
 
 // runCredentialedCmd is a convenience function to run a git command with username/password credentials
 func (m *nativeGitClient) runCredentialedCmd(args ...string) error {
+	_, err := m.runCredentialedCmdOutput(args...)
+	return err
+}
+
+// runPromisorCmd runs a git command which may lazily fetch the objects filtered out of a partial clone, and therefore
+// needs the credentials of the repository
+func (m *nativeGitClient) runPromisorCmd(args ...string) (string, error) {
+	if m.filter == "" {
+		return m.runCmd(args...)
+	}
+	return m.runCredentialedCmdOutput(args...)
+}
+
+// runCredentialedCmdOutput runs a git command with username/password credentials and returns its output
+func (m *nativeGitClient) runCredentialedCmdOutput(args ...string) (string, error) {
 	closer, environ, err := m.creds.Environ()
 	if err != nil {
-		return err
+		return "", err
 	}
 	defer func() { _ = closer.Close() }()
 
@@ -975,8 +1063,7 @@ func (m *nativeGitClient) runCredentialedCmd(args ...string) error {
 
 	cmd := exec.Command("git", args...)
 	cmd.Env = append(cmd.Env, environ...)
-	_, err = m.runCmdOutput(cmd, runOpts{})
-	return err
+	return m.runCmdOutput(cmd, runOpts{})
 }
 
 func (m *nativeGitClient) runCmdOutput(cmd *exec.Cmd, ropts runOpts) (string, error) {
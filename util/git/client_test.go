@@ -3,6 +3,7 @@ package git
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"os"
 	"os/exec"
@@ -90,6 +91,73 @@ func Test_nativeGitClient_Fetch_Prune(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func Test_nativeGitClient_Fetch_Shallow(t *testing.T) {
+	tempDir, err := _createEmptyGitRepo()
+	require.NoError(t, err)
+	first, err := outputCmd(tempDir, "git", "rev-parse", "HEAD")
+	require.NoError(t, err)
+	firstSha := strings.TrimSpace(string(first))
+	for i := range 3 {
+		err = runCmd(tempDir, "git", "commit", "-m", fmt.Sprintf("Commit %d", i), "--allow-empty")
+		require.NoError(t, err)
+	}
+	last, err := outputCmd(tempDir, "git", "rev-parse", "HEAD")
+	require.NoError(t, err)
+	lastSha := strings.TrimSpace(string(last))
+
+	client, err := NewClientExt("file://"+tempDir, t.TempDir(), NopCreds{}, true, false, "", "", WithDepth(1))
+	require.NoError(t, err)
+
+	err = client.Init()
+	require.NoError(t, err)
+
+	err = client.Fetch("")
+	require.NoError(t, err)
+
+	count, err := outputCmd(client.Root(), "git", "rev-list", "--count", "origin/master")
+	require.NoError(t, err)
+	assert.Equal(t, "1", strings.TrimSpace(string(count)))
+
+	// the shallow clone is deepened to diff against a commit missing from its history
+	changedFiles, err := client.ChangedFiles(firstSha, lastSha)
+	require.NoError(t, err)
+	assert.Empty(t, changedFiles)
+	count, err = outputCmd(client.Root(), "git", "rev-list", "--count", "origin/master")
+	require.NoError(t, err)
+	assert.Equal(t, "4", strings.TrimSpace(string(count)))
+}
+
+func Test_nativeGitClient_Fetch_Filter(t *testing.T) {
+	tempDir, err := _createEmptyGitRepo()
+	require.NoError(t, err)
+	err = os.WriteFile(path.Join(tempDir, "README"), []byte("Hello."), 0o644)
+	require.NoError(t, err)
+	err = runCmd(tempDir, "git", "add", "README")
+	require.NoError(t, err)
+	err = runCmd(tempDir, "git", "commit", "-m", "Add README")
+	require.NoError(t, err)
+
+	client, err := NewClientExt("file://"+tempDir, t.TempDir(), NopCreds{}, true, false, "", "", WithFilter("blob:none"))
+	require.NoError(t, err)
+
+	err = client.Init()
+	require.NoError(t, err)
+
+	err = client.Fetch("")
+	require.NoError(t, err)
+
+	filter, err := outputCmd(client.Root(), "git", "config", "remote.origin.partialclonefilter")
+	require.NoError(t, err)
+	assert.Equal(t, "blob:none", strings.TrimSpace(string(filter)))
+
+	// the blobs are lazily fetched on checkout
+	_, err = client.Checkout("origin/master", false)
+	require.NoError(t, err)
+	data, err := os.ReadFile(path.Join(client.Root(), "README"))
+	require.NoError(t, err)
+	assert.Equal(t, "Hello.", string(data))
+}
+
 func Test_IsAnnotatedTag(t *testing.T) {
 	tempDir := t.TempDir()
 	client, err := NewClient("file://"+tempDir, NopCreds{}, true, false, "", "")
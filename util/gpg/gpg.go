@@ -2,6 +2,7 @@ package gpg
 
 import (
 	"bufio"
+	"encoding/base64"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -43,6 +44,12 @@ var verificationAdditionalFields = regexp.MustCompile(`^gpg:\s+issuer\s.+$`)
 // Regular expression to match the signature status of a commit signature verification
 var verificationStatusMatch = regexp.MustCompile(`^gpg: ([a-zA-Z]+) signature from "([^"]+)" \[([a-zA-Z]+)\]$`)
 
+// Regular expression to match a good SSH signature of a commit signature verification
+var sshVerificationGoodMatch = regexp.MustCompile(`^Good "git" signature (?:for (.+) )?with ([A-Za-z0-9-]+) key (SHA256:[A-Za-z0-9+/]+)$`)
+
+// Regular expression to match an invalid SSH signature of a commit signature verification
+var sshVerificationFailedMatch = regexp.MustCompile(`^Signature verification failed: (.+)$`)
+
 // This is the recipe for automatic key generation, passed to gpg --batch --gen-key
 // for initializing our keyring with a trustdb. A new private key will be generated each
 // time argocd-server starts, so it's transient and is not used for anything except for
@@ -96,6 +103,26 @@ func IsShortKeyID(k string) bool {
 	return false
 }
 
+// IsSSHKeyFingerprint returns true if the string represents the SHA256 fingerprint of an SSH key, as printed by
+// ssh-keygen -l
+func IsSSHKeyFingerprint(k string) bool {
+	fingerprint, ok := strings.CutPrefix(k, "SHA256:")
+	if !ok || len(fingerprint) != 43 {
+		return false
+	}
+	_, err := base64.RawStdEncoding.DecodeString(fingerprint)
+	return err == nil
+}
+
+// SignatureKeyID returns the ID of a key commits may be signed with: the (short) key ID of a PGP key, or the
+// fingerprint of an SSH key. Returns the empty string if k is neither.
+func SignatureKeyID(k string) string {
+	if IsSSHKeyFingerprint(k) {
+		return k
+	}
+	return KeyID(k)
+}
+
 // Result of a git commit verification
 type PGPVerifyResult struct {
 	// Date the signature was made
@@ -588,6 +615,10 @@ func ParseGitCommitVerification(signature string) PGPVerifyResult {
 		}
 	}
 
+	if result, ok := parseSSHCommitVerification(signature); ok {
+		return result
+	}
+
 	scanner := bufio.NewScanner(strings.NewReader(signature))
 	for scanner.Scan() && linesParsed < MaxVerificationLinesToParse {
 		linesParsed++
@@ -677,6 +708,39 @@ func ParseGitCommitVerification(signature string) PGPVerifyResult {
 	return unknownResult("Could not parse output of verify-commit, no verification data found.")
 }
 
+// parseSSHCommitVerification parses the output of "git verify-commit" for a commit signed with an SSH key, and returns
+// false if the commit isn't signed with an SSH key. The signatures are verified without allowed signers, the key is
+// identified by its fingerprint.
+func parseSSHCommitVerification(signature string) (PGPVerifyResult, bool) {
+	scanner := bufio.NewScanner(strings.NewReader(signature))
+	for linesParsed := 0; scanner.Scan() && linesParsed < MaxVerificationLinesToParse; linesParsed++ {
+		if good := sshVerificationGoodMatch.FindStringSubmatch(scanner.Text()); len(good) == 4 {
+			identity := good[1]
+			if identity == "" {
+				identity = "unknown"
+			}
+			return PGPVerifyResult{
+				KeyID:    good[3],
+				Identity: identity,
+				Trust:    TrustUnknown,
+				Cipher:   good[2],
+				Result:   VerifyResultGood,
+				Message:  "Success verifying the commit signature.",
+			}, true
+		}
+		if failed := sshVerificationFailedMatch.FindStringSubmatch(scanner.Text()); len(failed) == 2 {
+			return PGPVerifyResult{
+				Identity: "unknown",
+				Trust:    TrustUnknown,
+				Cipher:   "SSH",
+				Result:   VerifyResultInvalid,
+				Message:  failed[1],
+			}, true
+		}
+	}
+	return PGPVerifyResult{}, false
+}
+
 // SyncKeyRingFromDirectory will sync the GPG keyring with files in a directory. This is a one-way sync,
 // with the configuration being the leading information.
 // Files must have a file name matching their Key ID. Keys that are found in the directory but are not
@@ -449,6 +449,31 @@ func Test_GPG_ParseGitCommitVerification(t *testing.T) {
 		assert.Equal(t, VerifyResultUnknown, res.Result)
 		assert.Contains(t, res.Message, "Invalid PGP key ID")
 	}
+
+	// Good case: SSH signature
+	{
+		c, err := os.ReadFile("testdata/good_signature_ssh.txt")
+		if err != nil {
+			panic(err.Error())
+		}
+		res := ParseGitCommitVerification(string(c))
+		assert.Equal(t, "SHA256:deAWW1bT8yqjG55QrNOzkLJwMPF0J9yDb+nJO+N/leQ", res.KeyID)
+		assert.Equal(t, "ED25519", res.Cipher)
+		assert.Equal(t, "unknown", res.Identity)
+		assert.Equal(t, VerifyResultGood, res.Result)
+	}
+
+	// Bad case: Manipulated SSH signature
+	{
+		c, err := os.ReadFile("testdata/bad_signature_ssh.txt")
+		if err != nil {
+			panic(err.Error())
+		}
+		res := ParseGitCommitVerification(string(c))
+		assert.Equal(t, VerifyResultInvalid, res.Result)
+		assert.Equal(t, "SSH", res.Cipher)
+		assert.Equal(t, "incorrect signature", res.Message)
+	}
 }
 
 func Test_GetGnuPGHomePath(t *testing.T) {
@@ -496,6 +521,15 @@ func Test_KeyID(t *testing.T) {
 	}
 }
 
+func Test_SignatureKeyID(t *testing.T) {
+	const fingerprint = "SHA256:deAWW1bT8yqjG55QrNOzkLJwMPF0J9yDb+nJO+N/leQ"
+	assert.Equal(t, shortKeyID, SignatureKeyID(longKeyID))
+	assert.Equal(t, fingerprint, SignatureKeyID(fingerprint))
+	assert.Empty(t, SignatureKeyID("SHA256:deAWW1bT8yqjG55QrNOzkLJwMPF0J9yDb+nJO+N/le"))
+	assert.Empty(t, SignatureKeyID("SHA256:deAWW1bT8yqjG55QrNOzkLJwMPF0J9yDb+nJO+N/le!"))
+	assert.Empty(t, SignatureKeyID("MD5:deAWW1bT8yqjG55QrNOzkLJwMPF0J9yDb+nJO+N/leQ"))
+}
+
 func Test_IsShortKeyID(t *testing.T) {
 	assert.True(t, IsShortKeyID(shortKeyID))
 	assert.False(t, IsShortKeyID(longKeyID))
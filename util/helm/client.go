@@ -45,6 +45,12 @@ type indexCache interface {
 	GetHelmIndex(repo string, indexData *[]byte) error
 }
 
+// chartCache shares the chart archives between the replicas of the repo-server
+type chartCache interface {
+	SetHelmChart(repo, credsKey, chart, version string, data []byte) error
+	GetHelmChart(repo, credsKey, chart, version string, data *[]byte) error
+}
+
 type Client interface {
 	CleanChartCache(chart string, version string) error
 	ExtractChart(chart string, version string, passCredentials bool, manifestMaxExtractedSize int64, disableManifestMaxExtractedSize bool) (string, argoio.Closer, error)
@@ -61,6 +67,14 @@ func WithIndexCache(indexCache indexCache) ClientOpts {
 	}
 }
 
+// WithChartCache shares the chart archives which don't exceed the maximum size through the cache
+func WithChartCache(chartCache chartCache, maxSize int64) ClientOpts {
+	return func(c *nativeHelmChart) {
+		c.chartCache = chartCache
+		c.chartCacheMaxSize = maxSize
+	}
+}
+
 func WithChartPaths(chartPaths argoio.TempPaths) ClientOpts {
 	return func(c *nativeHelmChart) {
 		c.chartCachePaths = chartPaths
@@ -98,6 +112,9 @@ type nativeHelmChart struct {
 	indexCache      indexCache
 	proxy           string
 	noProxy         string
+	// chartCache shares the archives which don't exceed chartCacheMaxSize, the archives aren't shared if nil
+	chartCache        chartCache
+	chartCacheMaxSize int64
 }
 
 func fileExist(filePath string) (bool, error) {
@@ -168,6 +185,14 @@ func (c *nativeHelmChart) ExtractChart(chart string, version string, passCredent
 		return "", nil, fmt.Errorf("error checking existence of cached chart path: %w", err)
 	}
 
+	if !exists && c.chartCache != nil {
+		exists, err = c.getSharedChart(chart, version, cachedChartPath)
+		if err != nil {
+			_ = os.RemoveAll(tempDir)
+			return "", nil, err
+		}
+	}
+
 	if !exists {
 		// create empty temp directory to extract chart from the registry
 		tempDest, err := files.CreateTempDir(os.TempDir())
@@ -223,6 +248,9 @@ func (c *nativeHelmChart) ExtractChart(chart string, version string, passCredent
 		if err != nil {
 			return "", nil, fmt.Errorf("error renaming file from %s to %s: %w", chartFilePath, cachedChartPath, err)
 		}
+		if c.chartCache != nil {
+			c.setSharedChart(chart, version, cachedChartPath)
+		}
 	}
 
 	err = untarChart(tempDir, cachedChartPath, manifestMaxExtractedSize, disableManifestMaxExtractedSize)
@@ -235,6 +263,61 @@ func (c *nativeHelmChart) ExtractChart(chart string, version string, passCredent
 	}), nil
 }
 
+// getSharedChart writes the archive of the chart shared by the other replicas to the cached chart path, and returns
+// whether the archive was shared
+func (c *nativeHelmChart) getSharedChart(chart, version, cachedChartPath string) (bool, error) {
+	credsKey, err := CredsKey(c.creds)
+	if err != nil {
+		return false, err
+	}
+	var data []byte
+	if err := c.chartCache.GetHelmChart(c.repoURL, credsKey, chart, version, &data); err != nil {
+		if !errors.Is(err, cache.ErrCacheMiss) {
+			log.Warnf("Failed to get the shared archive of chart %s/%s: %v", chart, version, err)
+		}
+		return false, nil
+	}
+	if len(data) == 0 {
+		return false, nil
+	}
+	// the archive is renamed once completely written, since the cached charts are reused if they exist
+	tempFile, err := os.CreateTemp(filepath.Dir(cachedChartPath), filepath.Base(cachedChartPath)+"-*")
+	if err != nil {
+		return false, fmt.Errorf("error creating temporary chart file: %w", err)
+	}
+	defer func() { _ = os.Remove(tempFile.Name()) }()
+	_, err = tempFile.Write(data)
+	if closeErr := tempFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return false, fmt.Errorf("error writing the shared chart archive: %w", err)
+	}
+	if err := os.Rename(tempFile.Name(), cachedChartPath); err != nil {
+		return false, fmt.Errorf("error renaming file from %s to %s: %w", tempFile.Name(), cachedChartPath, err)
+	}
+	log.Debugf("Using the shared archive of chart %s/%s", chart, version)
+	return true, nil
+}
+
+// setSharedChart shares the archive of the chart with the other replicas if it doesn't exceed the maximum size
+func (c *nativeHelmChart) setSharedChart(chart, version, cachedChartPath string) {
+	info, err := os.Stat(cachedChartPath)
+	if err != nil || info.Size() > c.chartCacheMaxSize {
+		return
+	}
+	credsKey, err := CredsKey(c.creds)
+	if err == nil {
+		var data []byte
+		if data, err = os.ReadFile(cachedChartPath); err == nil {
+			err = c.chartCache.SetHelmChart(c.repoURL, credsKey, chart, version, data)
+		}
+	}
+	if err != nil {
+		log.Warnf("Failed to share the archive of chart %s/%s: %v", chart, version, err)
+	}
+}
+
 func (c *nativeHelmChart) GetIndex(noCache bool, maxIndexSize int64) (*Index, error) {
 	indexLock.Lock(c.repoURL)
 	defer indexLock.Unlock(c.repoURL)
@@ -325,7 +408,7 @@ func (c *nativeHelmChart) loadRepoIndex(maxIndexSize int64) ([]byte, error) {
 		req.SetBasicAuth(c.creds.GetUsername(), helmPassword)
 	}
 
-	tlsConf, err := newTLSConfig(c.creds)
+	tlsConf, err := NewTLSConfig(c.creds)
 	if err != nil {
 		return nil, fmt.Errorf("error creating TLS config: %w", err)
 	}
@@ -348,7 +431,8 @@ func (c *nativeHelmChart) loadRepoIndex(maxIndexSize int64) ([]byte, error) {
 	return io.ReadAll(io.LimitReader(resp.Body, maxIndexSize))
 }
 
-func newTLSConfig(creds Creds) (*tls.Config, error) {
+// NewTLSConfig returns the TLS configuration of the connections authenticated with the credentials.
+func NewTLSConfig(creds Creds) (*tls.Config, error) {
 	tlsConfig := &tls.Config{InsecureSkipVerify: creds.GetInsecureSkipVerify()}
 
 	if creds.GetCAPath() != "" {
@@ -439,7 +523,7 @@ func (c *nativeHelmChart) GetTags(chart string, noCache bool) (*TagsList, error)
 		if err != nil {
 			return nil, fmt.Errorf("failed to initialize repository: %w", err)
 		}
-		tlsConf, err := newTLSConfig(c.creds)
+		tlsConf, err := NewTLSConfig(c.creds)
 		if err != nil {
 			return nil, fmt.Errorf("failed setup tlsConfig: %w", err)
 		}
@@ -17,7 +17,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v2"
 
+	"github.com/argoproj/argo-cd/v3/util/cache"
 	"github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/io/files"
 	"github.com/argoproj/argo-cd/v3/util/workloadidentity/mocks"
 )
 
@@ -104,6 +106,48 @@ func Test_nativeHelmChart_ExtractChart_insecure(t *testing.T) {
 	assert.True(t, info.IsDir())
 }
 
+type fakeChartCache struct {
+	charts map[string][]byte
+}
+
+func (f *fakeChartCache) SetHelmChart(repo, credsKey, chart, version string, data []byte) error {
+	f.charts[repo+"|"+credsKey+"|"+chart+"|"+version] = data
+	return nil
+}
+
+func (f *fakeChartCache) GetHelmChart(repo, credsKey, chart, version string, data *[]byte) error {
+	var ok bool
+	if *data, ok = f.charts[repo+"|"+credsKey+"|"+chart+"|"+version]; !ok {
+		return cache.ErrCacheMiss
+	}
+	return nil
+}
+
+func Test_nativeHelmChart_ExtractChart_sharedChart(t *testing.T) {
+	chartDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(chartDir, "my-chart"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(chartDir, "my-chart", "Chart.yaml"), []byte("name: my-chart\nversion: 1.0.0\n"), 0o644))
+	var archive bytes.Buffer
+	_, err := files.Tgz(chartDir, nil, nil, &archive)
+	require.NoError(t, err)
+
+	creds := HelmCreds{Username: "my-user", Password: "my-password"}
+	credsKey, err := CredsKey(creds)
+	require.NoError(t, err)
+	chartCache := &fakeChartCache{charts: map[string][]byte{"https://my-charts.example.com|" + credsKey + "|my-chart|1.0.0": archive.Bytes()}}
+	chartPaths := io.NewRandomizedTempPaths(t.TempDir())
+	// the chart isn't fetched from the unreachable repository since the archive is shared
+	client := NewClient("https://my-charts.example.com", creds, false, "", "", WithChartCache(chartCache, math.MaxInt64), WithChartPaths(chartPaths))
+	path, closer, err := client.ExtractChart("my-chart", "1.0.0", false, math.MaxInt64, false)
+	require.NoError(t, err)
+	defer io.Close(closer)
+	assert.FileExists(t, filepath.Join(path, "Chart.yaml"))
+
+	cachedChartPath, err := client.(*nativeHelmChart).getCachedChartPath("my-chart", "1.0.0")
+	require.NoError(t, err)
+	assert.FileExists(t, cachedChartPath)
+}
+
 func Test_normalizeChartName(t *testing.T) {
 	t.Run("Test non-slashed name", func(t *testing.T) {
 		n := normalizeChartName("mychart")
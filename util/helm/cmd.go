@@ -51,6 +51,12 @@ var redactor = func(text string) string {
 }
 
 func (c Cmd) run(args ...string) (string, string, error) {
+	return c.runInSandbox(nil, nil, args...)
+}
+
+// runInSandbox runs the helm command in the sandbox, which constrains the resources of the command if not nil. The CPU
+// time of the command is added to the CPU usage, if not nil.
+func (c Cmd) runInSandbox(sandbox *executil.Sandbox, cpuUsage *executil.CPUUsage, args ...string) (string, string, error) {
 	cmd := exec.Command("helm", args...)
 	cmd.Dir = c.WorkDir
 	cmd.Env = os.Environ()
@@ -68,7 +74,7 @@ func (c Cmd) run(args ...string) (string, string, error) {
 
 	cmd.Env = proxy.UpsertEnv(cmd, c.proxy, c.noProxy)
 
-	out, err := executil.RunWithRedactor(cmd, redactor)
+	out, err := executil.RunWithExecRunOpts(cmd, executil.ExecRunOpts{Redactor: redactor, Sandbox: sandbox, CPUUsage: cpuUsage})
 	fullCommand := executil.GetCommandArgsToLog(cmd)
 	if err != nil {
 		return out, fullCommand, fmt.Errorf("failed to get command args to log: %w", err)
@@ -355,6 +361,13 @@ type TemplateOpts struct {
 	SkipCrds             bool
 	SkipSchemaValidation bool
 	SkipTests            bool
+	// Kubeconfig is the path of the kubeconfig of the cluster the `lookup` function of the templates has access to.
+	// The templates are rendered without access to a cluster if empty.
+	Kubeconfig string
+	// Sandbox constrains the resources of the `helm template` command, if not nil
+	Sandbox *executil.Sandbox
+	// CPUUsage accumulates the CPU time of the `helm template` command, if not nil
+	CPUUsage *executil.CPUUsage
 }
 
 func cleanSetParameters(val string) string {
@@ -429,8 +442,11 @@ func (c *Cmd) template(chartPath string, opts *TemplateOpts) (string, string, er
 	if opts.SkipTests {
 		args = append(args, "--skip-tests")
 	}
+	if opts.Kubeconfig != "" {
+		args = append(args, "--dry-run=server", "--kubeconfig", opts.Kubeconfig)
+	}
 
-	out, command, err := c.run(args...)
+	out, command, err := c.runInSandbox(opts.Sandbox, opts.CPUUsage, args...)
 	if err != nil {
 		msg := err.Error()
 		if strings.Contains(msg, "--api-versions") {
@@ -1,7 +1,9 @@
 package helm
 
 import (
+	"crypto/sha256"
 	"crypto/tls"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -39,6 +41,27 @@ type Creds interface {
 	GetInsecureSkipVerify() bool
 }
 
+// CredsKey returns the digest identifying the credentials, which keys the data shared between the replicas of the
+// repo-server, so that the data fetched with some credentials is only shared with the requests using the same ones
+func CredsKey(creds Creds) (string, error) {
+	hash := sha256.New()
+	if creds != nil {
+		password := ""
+		// the access tokens of the workload identity expire, the identity is the one of the repo-server
+		if _, ok := creds.(AzureWorkloadIdentityCreds); !ok {
+			var err error
+			if password, err = creds.GetPassword(); err != nil {
+				return "", fmt.Errorf("failed to get the password: %w", err)
+			}
+		}
+		for _, field := range [][]byte{[]byte(creds.GetUsername()), []byte(password), []byte(creds.GetCAPath()), creds.GetCertData(), creds.GetKeyData()} {
+			hash.Write(field)
+			hash.Write([]byte{0})
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
 var _ Creds = HelmCreds{}
 
 type HelmCreds struct {
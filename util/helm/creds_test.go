@@ -253,3 +253,22 @@ func TestGetAccessTokenAfterChallenge_MalformedResponse(t *testing.T) {
 	require.ErrorContains(t, err, "failed to unmarshal response body")
 	assert.Empty(t, refreshToken)
 }
+
+func TestCredsKey(t *testing.T) {
+	key, err := CredsKey(HelmCreds{Username: "my-user", Password: "my-password"})
+	require.NoError(t, err)
+	assert.Len(t, key, 64)
+	assert.NotContains(t, key, "my-password")
+
+	sameKey, err := CredsKey(HelmCreds{Username: "my-user", Password: "my-password"})
+	require.NoError(t, err)
+	assert.Equal(t, key, sameKey)
+
+	otherKey, err := CredsKey(HelmCreds{Username: "my-user", Password: "other-password"})
+	require.NoError(t, err)
+	assert.NotEqual(t, key, otherKey)
+
+	anonymousKey, err := CredsKey(HelmCreds{})
+	require.NoError(t, err)
+	assert.NotEqual(t, key, anonymousKey)
+}
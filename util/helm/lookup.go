@@ -0,0 +1,218 @@
+package helm
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+)
+
+// LookupProxy is a read-only proxy of the Kubernetes API of the destination clusters, run by the application
+// controller. It gives the `lookup` function of the Helm templates rendered by the repo server access to the
+// destination namespace of the application, without passing the credentials of the cluster to the repo server.
+//
+// Every rendering opens a session, authenticated by its own token, which only allows reading the resources of the
+// destination namespace, except the Secrets. The other requests are answered as if the resources didn't exist, so that
+// the templates render as without lookups.
+type LookupProxy struct {
+	url      string
+	lock     sync.RWMutex
+	sessions map[string]*lookupSession
+}
+
+type lookupSession struct {
+	namespace string
+	handler   http.Handler
+}
+
+// NewLookupProxy creates a proxy reachable by the repo server at the given URL. The proxy must be served with Serve.
+func NewLookupProxy(url string) *LookupProxy {
+	return &LookupProxy{url: url, sessions: map[string]*lookupSession{}}
+}
+
+// URL returns the URL of the proxy, as reachable by the repo server
+func (p *LookupProxy) URL() string {
+	return p.url
+}
+
+// Serve serves the proxy on the listener until the context is done
+func (p *LookupProxy) Serve(ctx context.Context, listener net.Listener) error {
+	server := &http.Server{Handler: p, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+	if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// Open opens a session giving access to the namespace of the cluster of the given config. It returns the token
+// authenticating the session, and a function closing it, which must be called once the templates are rendered.
+func (p *LookupProxy) Open(config *rest.Config, namespace string) (string, func(), error) {
+	if namespace == "" {
+		return "", nil, errors.New("the Helm lookups require a destination namespace")
+	}
+	target, err := url.Parse(config.Host)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to parse the cluster URL: %w", err)
+	}
+	if target.Scheme == "" {
+		target.Scheme = "https"
+	}
+	transport, err := rest.TransportFor(config)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create the transport of the cluster: %w", err)
+	}
+	session := &lookupSession{
+		namespace: namespace,
+		handler: &httputil.ReverseProxy{
+			Rewrite: func(r *httputil.ProxyRequest) {
+				r.SetURL(target)
+				// The transport of the cluster authenticates the requests. The other headers, e.g. the impersonation
+				// ones, must not reach the cluster.
+				r.Out.Header = http.Header{}
+				for _, header := range []string{"Accept", "User-Agent"} {
+					if value := r.In.Header.Get(header); value != "" {
+						r.Out.Header.Set(header, value)
+					}
+				}
+			},
+			Transport: transport,
+		},
+	}
+	token := uuid.NewString()
+	p.lock.Lock()
+	p.sessions[token] = session
+	p.lock.Unlock()
+	return token, func() {
+		p.lock.Lock()
+		delete(p.sessions, token)
+		p.lock.Unlock()
+	}, nil
+}
+
+func (p *LookupProxy) getSession(r *http.Request) *lookupSession {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return nil
+	}
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	for sessionToken, session := range p.sessions {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(sessionToken)) == 1 {
+			return session
+		}
+	}
+	return nil
+}
+
+// ServeHTTP only forwards the authenticated requests reading the resources of the namespace of the session, since
+// rendering the templates must not modify the cluster. The watch requests are refused as well, since they would block
+// the rendering.
+func (p *LookupProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	session := p.getSession(r)
+	if session == nil {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	if r.Method != http.MethodGet {
+		http.Error(w, "the Helm lookup proxy only allows reading resources", http.StatusMethodNotAllowed)
+		return
+	}
+	if watch, _ := strconv.ParseBool(r.URL.Query().Get("watch")); watch {
+		http.Error(w, "the Helm lookup proxy does not allow watching resources", http.StatusForbidden)
+		return
+	}
+	if !isLookupAllowed(r.URL, session.namespace) {
+		writeNotFound(w, r.URL.Path)
+		return
+	}
+	session.handler.ServeHTTP(w, r)
+}
+
+// isLookupAllowed returns true if the request reads the discovery information of the cluster, or the resources of the
+// namespace other than the Secrets and the subresources
+func isLookupAllowed(u *url.URL, namespace string) bool {
+	if u.RawPath != "" || path.Clean(u.Path) != u.Path {
+		return false
+	}
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	group := ""
+	switch parts[0] {
+	case "version", "openapi":
+		return true
+	case "api":
+		parts = parts[1:]
+	case "apis":
+		parts = parts[1:]
+		if len(parts) == 0 {
+			return true
+		}
+		group = parts[0]
+		parts = parts[1:]
+	default:
+		return false
+	}
+	// the discovery of the versions and of their resources
+	if len(parts) <= 1 {
+		return true
+	}
+	// <version>/namespaces/<namespace>/<resource>[/<name>]
+	if len(parts) < 4 || len(parts) > 5 || parts[1] != "namespaces" || parts[2] != namespace {
+		return false
+	}
+	return group != "" || parts[3] != "secrets"
+}
+
+// writeNotFound answers with the status of the Kubernetes API for a missing resource, which the `lookup` function
+// renders as an empty result
+func writeNotFound(w http.ResponseWriter, path string) {
+	status := metav1.Status{
+		TypeMeta: metav1.TypeMeta{Kind: "Status", APIVersion: "v1"},
+		Status:   metav1.StatusFailure,
+		Message:  fmt.Sprintf("%s is not accessible to the Helm lookups", path),
+		Reason:   metav1.StatusReasonNotFound,
+		Code:     http.StatusNotFound,
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusNotFound)
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		log.Warnf("Failed to write the response of the Helm lookup proxy: %v", err)
+	}
+}
+
+// NewLookupKubeconfig writes the kubeconfig to access a session of the proxy in a temporary file, which must be removed
+// after use
+func NewLookupKubeconfig(proxyURL string, token string, namespace string) (string, error) {
+	kubeconfig, err := os.CreateTemp("", "helm-lookup-kubeconfig-")
+	if err != nil {
+		return "", fmt.Errorf("failed to create the kubeconfig of the lookup proxy: %w", err)
+	}
+	_ = kubeconfig.Close()
+	if err := kube.WriteKubeConfig(&rest.Config{Host: proxyURL, BearerToken: token}, namespace, kubeconfig.Name()); err != nil {
+		_ = os.Remove(kubeconfig.Name())
+		return "", fmt.Errorf("failed to write the kubeconfig of the lookup proxy: %w", err)
+	}
+	return kubeconfig.Name(), nil
+}
@@ -0,0 +1,111 @@
+package helm
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+func TestLookupProxy(t *testing.T) {
+	var forwarded []string
+	cluster := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		forwarded = append(forwarded, r.URL.RequestURI())
+		assert.Equal(t, "Bearer cluster-token", r.Header.Get("Authorization"))
+		assert.Empty(t, r.Header.Get("Impersonate-User"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer cluster.Close()
+
+	p := NewLookupProxy("http://argocd-application-controller:8084")
+	assert.Equal(t, "http://argocd-application-controller:8084", p.URL())
+	proxy := httptest.NewServer(p)
+	defer proxy.Close()
+
+	token, closeSession, err := p.Open(&rest.Config{Host: cluster.URL, BearerToken: "cluster-token"}, "guestbook")
+	require.NoError(t, err)
+	otherToken, closeOtherSession, err := p.Open(&rest.Config{Host: cluster.URL, BearerToken: "cluster-token"}, "other")
+	require.NoError(t, err)
+	closeOtherSession()
+
+	cases := []struct {
+		name           string
+		method         string
+		path           string
+		token          string
+		expectedStatus int
+	}{
+		{name: "forwards the reads of the namespace", method: http.MethodGet, path: "/api/v1/namespaces/guestbook/configmaps/config", token: token, expectedStatus: http.StatusOK},
+		{name: "forwards the reads of the namespace with selectors", method: http.MethodGet, path: "/apis/apps/v1/namespaces/guestbook/deployments?labelSelector=app%3Dguestbook", token: token, expectedStatus: http.StatusOK},
+		{name: "forwards the discovery", method: http.MethodGet, path: "/apis/apps/v1", token: token, expectedStatus: http.StatusOK},
+		{name: "forwards the version", method: http.MethodGet, path: "/version", token: token, expectedStatus: http.StatusOK},
+		{name: "hides the secrets", method: http.MethodGet, path: "/api/v1/namespaces/guestbook/secrets/password", token: token, expectedStatus: http.StatusNotFound},
+		{name: "hides the other namespaces", method: http.MethodGet, path: "/api/v1/namespaces/kube-system/configmaps", token: token, expectedStatus: http.StatusNotFound},
+		{name: "hides the resources of all namespaces", method: http.MethodGet, path: "/api/v1/configmaps", token: token, expectedStatus: http.StatusNotFound},
+		{name: "hides the cluster resources", method: http.MethodGet, path: "/apis/rbac.authorization.k8s.io/v1/clusterroles", token: token, expectedStatus: http.StatusNotFound},
+		{name: "hides the subresources", method: http.MethodGet, path: "/api/v1/namespaces/guestbook/pods/guestbook/log", token: token, expectedStatus: http.StatusNotFound},
+		{name: "hides the escaped paths", method: http.MethodGet, path: "/api/v1/namespaces/guestbook/configmaps/..%2F..%2Fkube-system%2Fsecrets", token: token, expectedStatus: http.StatusNotFound},
+		{name: "refuses the writes", method: http.MethodDelete, path: "/api/v1/namespaces/guestbook/configmaps/config", token: token, expectedStatus: http.StatusMethodNotAllowed},
+		{name: "refuses the watches", method: http.MethodGet, path: "/api/v1/namespaces/guestbook/configmaps?watch=true", token: token, expectedStatus: http.StatusForbidden},
+		{name: "refuses the closed sessions", method: http.MethodGet, path: "/api/v1/namespaces/other/configmaps", token: otherToken, expectedStatus: http.StatusUnauthorized},
+		{name: "refuses the unauthenticated requests", method: http.MethodGet, path: "/api/v1/namespaces/guestbook/configmaps", token: "cluster-token", expectedStatus: http.StatusUnauthorized},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			req, err := http.NewRequestWithContext(t.Context(), c.method, proxy.URL+c.path, http.NoBody)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer "+c.token)
+			req.Header.Set("Impersonate-User", "admin")
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			assert.Equal(t, c.expectedStatus, resp.StatusCode)
+			if c.expectedStatus == http.StatusNotFound {
+				var status metav1.Status
+				require.NoError(t, json.NewDecoder(resp.Body).Decode(&status))
+				assert.Equal(t, metav1.StatusReasonNotFound, status.Reason)
+			}
+		})
+	}
+	assert.Equal(t, []string{
+		"/api/v1/namespaces/guestbook/configmaps/config",
+		"/apis/apps/v1/namespaces/guestbook/deployments?labelSelector=app%3Dguestbook",
+		"/apis/apps/v1",
+		"/version",
+	}, forwarded)
+
+	closeSession()
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, proxy.URL+"/version", http.NoBody)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestLookupProxyRequiresNamespace(t *testing.T) {
+	_, _, err := NewLookupProxy("http://argocd-application-controller:8084").Open(&rest.Config{Host: "https://kubernetes.default.svc"}, "")
+	require.ErrorContains(t, err, "destination namespace")
+}
+
+func TestNewLookupKubeconfig(t *testing.T) {
+	path, err := NewLookupKubeconfig("http://argocd-application-controller:8084", "session-token", "guestbook")
+	require.NoError(t, err)
+	defer os.Remove(path)
+
+	kubeconfig, err := clientcmd.LoadFromFile(path)
+	require.NoError(t, err)
+	context := kubeconfig.Contexts[kubeconfig.CurrentContext]
+	require.NotNil(t, context)
+	assert.Equal(t, "guestbook", context.Namespace)
+	assert.Equal(t, "http://argocd-application-controller:8084", kubeconfig.Clusters[context.Cluster].Server)
+	assert.Equal(t, "session-token", kubeconfig.AuthInfos[context.AuthInfo].Token)
+}
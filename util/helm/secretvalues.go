@@ -0,0 +1,316 @@
+package helm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	gocache "github.com/patrickmn/go-cache"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/common"
+)
+
+const (
+	// SecretValuesKubernetesPrefix prefixes the Helm values referencing the key of a Kubernetes Secret, e.g.
+	// $secret:argocd/my-secret:key
+	SecretValuesKubernetesPrefix = "$secret:"
+	// SecretValuesVaultPrefix prefixes the Helm values referencing the key of a Vault secret, e.g.
+	// $vault:secret/data/my-secret:key
+	SecretValuesVaultPrefix = "$vault:"
+	// LabelValueSecretTypeHelmValues is the secret type of the Kubernetes Secrets which can be referenced by the Helm
+	// values
+	LabelValueSecretTypeHelmValues = "helm-values"
+	// AnnotationKeyHelmValuesProjects is the annotation of the Kubernetes Secrets referenced by the Helm values listing
+	// the comma separated projects allowed to reference them, or * for all the projects
+	AnnotationKeyHelmValuesProjects = "argocd.argoproj.io/helm-values-projects"
+	// redactedSecretValue replaces the resolved secret values in the output of the helm command
+	redactedSecretValue = "******"
+)
+
+// SecretValuesResolver resolves the references to secrets of the Helm values.
+type SecretValuesResolver interface {
+	// Resolve returns the value of the secret referenced by ref, e.g. $secret:argocd/my-secret:key, if the project is
+	// allowed to reference it
+	Resolve(ctx context.Context, project string, ref string) (string, error)
+}
+
+// SecretValuesResolverOpts configures the stores of the secrets referenced by the Helm values.
+type SecretValuesResolverOpts struct {
+	// Clientset reads the Kubernetes Secrets. The references to Kubernetes Secrets are refused if nil.
+	Clientset kubernetes.Interface
+	// VaultAddress is the address of the Vault server. The references to Vault secrets are refused if empty.
+	VaultAddress string
+	// VaultTokenEnv is the environment variable holding the Vault token, read before each request to Vault so that
+	// the token can be renewed.
+	VaultTokenEnv string
+	// VaultPathPrefix is the path under which every project has its own Vault secrets, e.g. the secrets of the
+	// my-project project are under <VaultPathPrefix>/my-project/.
+	VaultPathPrefix string
+	// CacheExpiration is the duration the resolved secrets are cached for.
+	CacheExpiration time.Duration
+}
+
+type secretValuesResolver struct {
+	opts   SecretValuesResolverOpts
+	client *http.Client
+	cache  *gocache.Cache
+}
+
+// NewSecretValuesResolver returns a resolver of the references to Kubernetes and Vault secrets of the Helm values.
+func NewSecretValuesResolver(opts SecretValuesResolverOpts) SecretValuesResolver {
+	return &secretValuesResolver{
+		opts:   opts,
+		client: &http.Client{Timeout: 30 * time.Second},
+		cache:  gocache.New(opts.CacheExpiration, opts.CacheExpiration),
+	}
+}
+
+func (r *secretValuesResolver) Resolve(ctx context.Context, project string, ref string) (string, error) {
+	// The secrets are cached per project, since whether they can be referenced depends on the project
+	cacheKey := project + "|" + ref
+	if value, ok := r.cache.Get(cacheKey); ok {
+		return value.(string), nil
+	}
+	var value string
+	var err error
+	switch {
+	case project == "":
+		err = errors.New("the project of the application is unknown")
+	case strings.HasPrefix(ref, SecretValuesKubernetesPrefix):
+		value, err = r.resolveKubernetes(ctx, project, strings.TrimPrefix(ref, SecretValuesKubernetesPrefix))
+	case strings.HasPrefix(ref, SecretValuesVaultPrefix):
+		value, err = r.resolveVault(ctx, project, strings.TrimPrefix(ref, SecretValuesVaultPrefix))
+	default:
+		err = errors.New("unknown secret store")
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve the secret %s: %w", ref, err)
+	}
+	r.cache.SetDefault(cacheKey, value)
+	return value, nil
+}
+
+// splitSecretRef splits a <path>:<key> reference
+func splitSecretRef(ref string) (string, string, error) {
+	i := strings.LastIndex(ref, ":")
+	if i <= 0 || i == len(ref)-1 {
+		return "", "", errors.New("the reference must have the format <path>:<key>")
+	}
+	return ref[:i], ref[i+1:], nil
+}
+
+func (r *secretValuesResolver) resolveKubernetes(ctx context.Context, project string, ref string) (string, error) {
+	if r.opts.Clientset == nil {
+		return "", errors.New("the references to Kubernetes Secrets are not enabled in the repo server")
+	}
+	path, key, err := splitSecretRef(ref)
+	if err != nil {
+		return "", err
+	}
+	namespace, name, ok := strings.Cut(path, "/")
+	if !ok || namespace == "" || name == "" {
+		return "", errors.New("the Secret must be referenced as <namespace>/<name>:<key>")
+	}
+	secret, err := r.opts.Clientset.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", errors.New("the Secret was not found")
+		}
+		return "", err
+	}
+	// Only the Secrets meant to be used by the Helm values can be referenced, so that the Application authors can't read
+	// the credentials of Argo CD
+	if secret.Labels[common.LabelKeySecretType] != LabelValueSecretTypeHelmValues {
+		return "", fmt.Errorf("the Secret must have the label %s=%s", common.LabelKeySecretType, LabelValueSecretTypeHelmValues)
+	}
+	if !isProjectAllowed(secret.Annotations[AnnotationKeyHelmValuesProjects], project) {
+		return "", fmt.Errorf("the project %s is not allowed by the annotation %s of the Secret", project, AnnotationKeyHelmValuesProjects)
+	}
+	value, ok := secret.Data[key]
+	if !ok {
+		return "", fmt.Errorf("the Secret has no key %s", key)
+	}
+	return string(value), nil
+}
+
+// isProjectAllowed returns whether the project is one of the comma separated projects, or * for all the projects
+func isProjectAllowed(projects string, project string) bool {
+	for _, allowed := range strings.Split(projects, ",") {
+		allowed = strings.TrimSpace(allowed)
+		if allowed == "*" || allowed == project {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *secretValuesResolver) resolveVault(ctx context.Context, project string, ref string) (string, error) {
+	if r.opts.VaultAddress == "" {
+		return "", errors.New("the references to Vault secrets are not enabled in the repo server")
+	}
+	secretPath, key, err := splitSecretRef(ref)
+	if err != nil {
+		return "", err
+	}
+	// Every project can only reference the secrets under its own path
+	projectPath := path.Join(r.opts.VaultPathPrefix, project) + "/"
+	if path.Clean(secretPath) != secretPath || !strings.HasPrefix(secretPath, projectPath) {
+		return "", fmt.Errorf("the Vault secrets of the project %s must be under %s", project, projectPath)
+	}
+	u, err := url.JoinPath(r.opts.VaultAddress, "v1", secretPath)
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, http.NoBody)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", os.Getenv(r.opts.VaultTokenEnv))
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %d from Vault", resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return "", err
+	}
+	var secret struct {
+		Data map[string]any `json:"data"`
+	}
+	if err := json.Unmarshal(body, &secret); err != nil {
+		return "", fmt.Errorf("failed to parse the response of Vault: %w", err)
+	}
+	data := secret.Data
+	// The secrets of the KV version 2 engine are nested in a data field, next to their metadata
+	if nested, ok := data["data"].(map[string]any); ok {
+		if _, ok := data["metadata"]; ok {
+			data = nested
+		}
+	}
+	value, ok := data[key]
+	if !ok {
+		return "", fmt.Errorf("the Vault secret has no key %s", key)
+	}
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	out, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// HasSecretValues returns whether the Helm values reference secrets.
+func HasSecretValues(values []byte) bool {
+	return bytes.Contains(values, []byte(SecretValuesKubernetesPrefix)) || bytes.Contains(values, []byte(SecretValuesVaultPrefix))
+}
+
+// ResolveSecretValues replaces the string values of the YAML Helm values which reference secrets by the values of the
+// secrets the project is allowed to reference. It returns the resolved values, and the secret values to redact from the
+// output of the helm command.
+func ResolveSecretValues(ctx context.Context, resolver SecretValuesResolver, project string, values []byte) ([]byte, []string, error) {
+	var parsed any
+	if err := yaml.Unmarshal(values, &parsed); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse the Helm values: %w", err)
+	}
+	var secrets []string
+	var resolve func(value any) (any, error)
+	resolve = func(value any) (any, error) {
+		switch v := value.(type) {
+		case map[string]any:
+			for key, item := range v {
+				resolved, err := resolve(item)
+				if err != nil {
+					return nil, err
+				}
+				v[key] = resolved
+			}
+		case []any:
+			for i, item := range v {
+				resolved, err := resolve(item)
+				if err != nil {
+					return nil, err
+				}
+				v[i] = resolved
+			}
+		case string:
+			if !strings.HasPrefix(v, SecretValuesKubernetesPrefix) && !strings.HasPrefix(v, SecretValuesVaultPrefix) {
+				return v, nil
+			}
+			secret, err := resolver.Resolve(ctx, project, v)
+			if err != nil {
+				return nil, err
+			}
+			if secret != "" {
+				secrets = append(secrets, secret)
+			}
+			return secret, nil
+		}
+		return value, nil
+	}
+	resolved, err := resolve(parsed)
+	if err != nil {
+		return nil, nil, err
+	}
+	out, err := yaml.Marshal(resolved)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal the Helm values: %w", err)
+	}
+	return out, secrets, nil
+}
+
+// RedactSecretValues replaces the secret values in s.
+func RedactSecretValues(s string, secrets []string) string {
+	for _, secret := range secrets {
+		s = strings.ReplaceAll(s, secret, redactedSecretValue)
+	}
+	return s
+}
+
+// RedactSecretValuesFromObject replaces the secret values in the string fields of the rendered resource, except in the
+// data of the Kubernetes Secrets, whose values are masked by Argo CD.
+func RedactSecretValuesFromObject(obj *unstructured.Unstructured, secrets []string) {
+	var redact func(value any) any
+	redact = func(value any) any {
+		switch v := value.(type) {
+		case map[string]any:
+			for key, item := range v {
+				v[key] = redact(item)
+			}
+		case []any:
+			for i, item := range v {
+				v[i] = redact(item)
+			}
+		case string:
+			return RedactSecretValues(v, secrets)
+		}
+		return value
+	}
+	isSecret := obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == ""
+	for key, value := range obj.Object {
+		if isSecret && (key == "data" || key == "stringData") {
+			continue
+		}
+		obj.Object[key] = redact(value)
+	}
+}
@@ -0,0 +1,189 @@
+package helm
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v3/common"
+)
+
+func newHelmValuesSecret(name string, secretType string, projects string, data map[string]string) *corev1.Secret {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "argocd",
+			Labels:      map[string]string{common.LabelKeySecretType: secretType},
+			Annotations: map[string]string{AnnotationKeyHelmValuesProjects: projects},
+		},
+		Data: map[string][]byte{},
+	}
+	for key, value := range data {
+		secret.Data[key] = []byte(value)
+	}
+	return secret
+}
+
+func TestSecretValuesResolver(t *testing.T) {
+	vaultRequests := 0
+	vault := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		vaultRequests++
+		if r.Header.Get("X-Vault-Token") != "vault-token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/secret/data/argocd/my-project/guestbook":
+			_, _ = w.Write([]byte(`{"data": {"data": {"password": "vault-password"}, "metadata": {"version": 1}}}`))
+		case "/v1/kv/my-project/guestbook":
+			_, _ = w.Write([]byte(`{"data": {"password": "kv-password"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer vault.Close()
+	t.Setenv("TEST_VAULT_TOKEN", "vault-token")
+
+	resolver := NewSecretValuesResolver(SecretValuesResolverOpts{
+		Clientset: fake.NewClientset(
+			newHelmValuesSecret("guestbook", LabelValueSecretTypeHelmValues, "other-project, my-project", map[string]string{"password": "secret-password"}),
+			newHelmValuesSecret("shared", LabelValueSecretTypeHelmValues, "*", map[string]string{"password": "shared-password"}),
+			newHelmValuesSecret("other", LabelValueSecretTypeHelmValues, "other-project", map[string]string{"password": "other-password"}),
+			newHelmValuesSecret("cluster", common.LabelValueSecretTypeCluster, "*", map[string]string{"password": "cluster-password"}),
+		),
+		VaultAddress:    vault.URL,
+		VaultTokenEnv:   "TEST_VAULT_TOKEN",
+		VaultPathPrefix: "secret/data/argocd",
+		CacheExpiration: time.Minute,
+	})
+	kvResolver := NewSecretValuesResolver(SecretValuesResolverOpts{
+		VaultAddress:    vault.URL,
+		VaultTokenEnv:   "TEST_VAULT_TOKEN",
+		VaultPathPrefix: "kv",
+	})
+
+	cases := []struct {
+		name          string
+		resolver      SecretValuesResolver
+		project       string
+		ref           string
+		expected      string
+		expectedError string
+	}{
+		{name: "Kubernetes Secret", ref: "$secret:argocd/guestbook:password", expected: "secret-password"},
+		{name: "Kubernetes Secret without the key", ref: "$secret:argocd/guestbook:username", expectedError: "failed to resolve the secret $secret:argocd/guestbook:username: the Secret has no key username"},
+		{name: "Kubernetes Secret of another type", ref: "$secret:argocd/cluster:password", expectedError: "failed to resolve the secret $secret:argocd/cluster:password: the Secret must have the label argocd.argoproj.io/secret-type=helm-values"},
+		{name: "missing Kubernetes Secret", ref: "$secret:argocd/missing:password", expectedError: "failed to resolve the secret $secret:argocd/missing:password: the Secret was not found"},
+		{name: "Kubernetes Secret without namespace", ref: "$secret:guestbook:password", expectedError: "failed to resolve the secret $secret:guestbook:password: the Secret must be referenced as <namespace>/<name>:<key>"},
+		{name: "Kubernetes Secret of all the projects", ref: "$secret:argocd/shared:password", expected: "shared-password"},
+		{name: "Kubernetes Secret of another project", ref: "$secret:argocd/other:password", expectedError: "failed to resolve the secret $secret:argocd/other:password: the project my-project is not allowed by the annotation argocd.argoproj.io/helm-values-projects of the Secret"},
+		{name: "Kubernetes Secret without project", project: "-", ref: "$secret:argocd/shared:password", expectedError: "failed to resolve the secret $secret:argocd/shared:password: the project of the application is unknown"},
+		{name: "Vault KV version 2 secret", ref: "$vault:secret/data/argocd/my-project/guestbook:password", expected: "vault-password"},
+		{name: "Vault KV version 1 secret", resolver: kvResolver, ref: "$vault:kv/my-project/guestbook:password", expected: "kv-password"},
+		{name: "Vault secret of another project", ref: "$vault:secret/data/argocd/other-project/guestbook:password", expectedError: "failed to resolve the secret $vault:secret/data/argocd/other-project/guestbook:password: the Vault secrets of the project my-project must be under secret/data/argocd/my-project/"},
+		{name: "Vault secret escaping the path of the project", ref: "$vault:secret/data/argocd/my-project/../other-project/guestbook:password", expectedError: "failed to resolve the secret $vault:secret/data/argocd/my-project/../other-project/guestbook:password: the Vault secrets of the project my-project must be under secret/data/argocd/my-project/"},
+		{name: "missing Vault secret", ref: "$vault:secret/data/argocd/my-project/missing:password", expectedError: "failed to resolve the secret $vault:secret/data/argocd/my-project/missing:password: unexpected status 404 from Vault"},
+		{name: "reference without key", ref: "$vault:secret/data/argocd/my-project/guestbook", expectedError: "failed to resolve the secret $vault:secret/data/argocd/my-project/guestbook: the reference must have the format <path>:<key>"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r := resolver
+			if c.resolver != nil {
+				r = c.resolver
+			}
+			project := "my-project"
+			if c.project == "-" {
+				project = ""
+			}
+			value, err := r.Resolve(t.Context(), project, c.ref)
+			if c.expectedError != "" {
+				require.EqualError(t, err, c.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, value)
+		})
+	}
+
+	t.Run("caches the secrets", func(t *testing.T) {
+		requests := vaultRequests
+		value, err := resolver.Resolve(t.Context(), "my-project", "$vault:secret/data/argocd/my-project/guestbook:password")
+		require.NoError(t, err)
+		assert.Equal(t, "vault-password", value)
+		assert.Equal(t, requests, vaultRequests)
+	})
+
+	t.Run("caches the secrets per project", func(t *testing.T) {
+		_, err := resolver.Resolve(t.Context(), "other-project", "$vault:secret/data/argocd/my-project/guestbook:password")
+		require.ErrorContains(t, err, "the Vault secrets of the project other-project must be under secret/data/argocd/other-project/")
+	})
+}
+
+func TestSecretValuesResolverDisabled(t *testing.T) {
+	resolver := NewSecretValuesResolver(SecretValuesResolverOpts{})
+
+	_, err := resolver.Resolve(t.Context(), "my-project", "$secret:argocd/guestbook:password")
+	require.EqualError(t, err, "failed to resolve the secret $secret:argocd/guestbook:password: the references to Kubernetes Secrets are not enabled in the repo server")
+	_, err = resolver.Resolve(t.Context(), "my-project", "$vault:secret/data/guestbook:password")
+	require.EqualError(t, err, "failed to resolve the secret $vault:secret/data/guestbook:password: the references to Vault secrets are not enabled in the repo server")
+}
+
+func TestResolveSecretValues(t *testing.T) {
+	resolver := NewSecretValuesResolver(SecretValuesResolverOpts{
+		Clientset: fake.NewClientset(newHelmValuesSecret("guestbook", LabelValueSecretTypeHelmValues, "my-project", map[string]string{
+			"password": "secret-password",
+			"token":    "secret-token",
+		})),
+	})
+	values := []byte(`
+database:
+  password: $secret:argocd/guestbook:password
+  users: [admin, $secret:argocd/guestbook:token]
+replicas: 2
+`)
+	require.True(t, HasSecretValues(values))
+
+	resolved, secrets, err := ResolveSecretValues(t.Context(), resolver, "my-project", values)
+	require.NoError(t, err)
+	assert.YAMLEq(t, `
+database:
+  password: secret-password
+  users: [admin, secret-token]
+replicas: 2
+`, string(resolved))
+	assert.ElementsMatch(t, []string{"secret-password", "secret-token"}, secrets)
+	assert.Equal(t, "invalid value ******", RedactSecretValues("invalid value secret-password", secrets))
+
+	assert.False(t, HasSecretValues([]byte("replicas: 2")))
+}
+
+func TestRedactSecretValuesFromObject(t *testing.T) {
+	secret := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   map[string]any{"name": "guestbook", "annotations": map[string]any{"password": "secret-password"}},
+		"data":       map[string]any{"password": "c2VjcmV0LXBhc3N3b3Jk"},
+		"stringData": map[string]any{"password": "secret-password"},
+	}}
+	RedactSecretValuesFromObject(secret, []string{"secret-password"})
+	assert.Equal(t, map[string]string{"password": "******"}, secret.GetAnnotations())
+	assert.Equal(t, map[string]any{"password": "secret-password"}, secret.Object["stringData"])
+	assert.Equal(t, map[string]any{"password": "c2VjcmV0LXBhc3N3b3Jk"}, secret.Object["data"])
+
+	configMap := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]any{"name": "guestbook"},
+		"data":       map[string]any{"url": "postgres://admin:secret-password@db", "users": []any{"admin", "secret-password"}},
+	}}
+	RedactSecretValuesFromObject(configMap, []string{"secret-password"})
+	assert.Equal(t, map[string]any{"url": "postgres://admin:******@db", "users": []any{"admin", "******"}}, configMap.Object["data"])
+}
@@ -68,7 +68,20 @@ func Untgz(dstPath string, r io.Reader, maxSize int64, preserveFileMode bool) er
 	}
 	defer gzr.Close()
 
-	lr := io.LimitReader(gzr, maxSize)
+	return Untar(dstPath, gzr, maxSize, preserveFileMode)
+}
+
+// Untar will loop over the uncompressed tar reader creating the file structure at dstPath, reading at most maxSize
+// bytes of the tarball. Callers must make sure dstPath is:
+//   - a full path
+//   - points to an empty directory or
+//   - points to a non existing directory
+func Untar(dstPath string, r io.Reader, maxSize int64, preserveFileMode bool) error {
+	if !filepath.IsAbs(dstPath) {
+		return fmt.Errorf("dstPath points to a relative path: %s", dstPath)
+	}
+
+	lr := io.LimitReader(r, maxSize)
 	tr := tar.NewReader(lr)
 
 	for {
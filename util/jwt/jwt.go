@@ -136,6 +136,59 @@ func GetGroups(mapClaims jwtgo.MapClaims, scopes []string) []string {
 	return GetScopeValues(mapClaims, scopes)
 }
 
+// MapGroupsClaim sets the groups claim to the value of the given claim. The claim is looked up by its name first, and
+// then as the path of a nested claim with its parts separated by dots, e.g. realm_access.roles. The groups claim is
+// removed if the given claim is missing.
+func MapGroupsClaim(claims jwtgo.MapClaims, groupsClaim string) {
+	if groupsClaim == "" || groupsClaim == "groups" {
+		return
+	}
+	value, ok := claims[groupsClaim]
+	if !ok {
+		value = map[string]any(claims)
+		for _, part := range strings.Split(groupsClaim, ".") {
+			m, ok := value.(map[string]any)
+			if !ok {
+				value = nil
+				break
+			}
+			value = m[part]
+		}
+	}
+	if value == nil {
+		delete(claims, "groups")
+		return
+	}
+	claims["groups"] = value
+}
+
+// PrefixIdentityClaims prefixes the subject, the email and the groups of the claims, so that the users and the groups
+// of an identity provider can't be mistaken for the ones of another provider in the RBAC policies. The federated claims
+// are removed, since their user ID would take precedence over the prefixed subject.
+func PrefixIdentityClaims(claims jwtgo.MapClaims, prefix string) {
+	for _, name := range []string{"sub", "email"} {
+		if value, ok := claims[name].(string); ok && value != "" {
+			claims[name] = prefix + value
+		}
+	}
+	switch groups := claims["groups"].(type) {
+	case nil:
+	case string:
+		claims["groups"] = prefix + groups
+	case []any:
+		prefixed := make([]any, 0, len(groups))
+		for _, group := range groups {
+			if group, ok := group.(string); ok {
+				prefixed = append(prefixed, prefix+group)
+			}
+		}
+		claims["groups"] = prefixed
+	default:
+		delete(claims, "groups")
+	}
+	delete(claims, "federated_claims")
+}
+
 func IsValid(token string) bool {
 	return len(strings.SplitN(token, ".", 3)) == 3
 }
@@ -39,6 +39,48 @@ func TestGetGroups(t *testing.T) {
 	assert.Equal(t, []string{"foo"}, GetGroups(jwt.MapClaims{"groups": []string{"foo"}}, []string{"groups"}))
 }
 
+func TestMapGroupsClaim(t *testing.T) {
+	claims := jwt.MapClaims{"groups": []any{"foo"}, "roles": []any{"bar"}}
+	MapGroupsClaim(claims, "")
+	assert.Equal(t, []any{"foo"}, claims["groups"])
+
+	MapGroupsClaim(claims, "roles")
+	assert.Equal(t, []any{"bar"}, claims["groups"])
+
+	claims = jwt.MapClaims{"realm_access": map[string]any{"roles": []any{"baz"}}, "https://example.com/groups": []any{"qux"}}
+	MapGroupsClaim(claims, "realm_access.roles")
+	assert.Equal(t, []any{"baz"}, claims["groups"])
+
+	MapGroupsClaim(claims, "https://example.com/groups")
+	assert.Equal(t, []any{"qux"}, claims["groups"])
+
+	MapGroupsClaim(claims, "realm_access.missing")
+	assert.NotContains(t, claims, "groups")
+}
+
+func TestPrefixIdentityClaims(t *testing.T) {
+	claims := jwt.MapClaims{
+		"sub":              "alice",
+		"email":            "alice@example.com",
+		"groups":           []any{"admins", 1},
+		"federated_claims": map[string]any{"user_id": "admin"},
+	}
+	PrefixIdentityClaims(claims, "customer:")
+	assert.Equal(t, "customer:alice", claims["sub"])
+	assert.Equal(t, "customer:alice@example.com", claims["email"])
+	assert.Equal(t, []any{"customer:admins"}, claims["groups"])
+	assert.NotContains(t, claims, "federated_claims")
+
+	claims = jwt.MapClaims{"groups": "admins"}
+	PrefixIdentityClaims(claims, "customer:")
+	assert.Equal(t, "customer:admins", claims["groups"])
+	assert.NotContains(t, claims, "sub")
+
+	claims = jwt.MapClaims{"groups": map[string]any{"admins": true}}
+	PrefixIdentityClaims(claims, "customer:")
+	assert.NotContains(t, claims, "groups")
+}
+
 func TestIssuedAtTime_Int64(t *testing.T) {
 	// Tuesday, 1 December 2020 14:00:00
 	claims := jwt.MapClaims{"iat": int64(1606831200)}
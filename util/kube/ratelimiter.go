@@ -0,0 +1,113 @@
+package kube
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+const (
+	// adaptiveRateLimitMinFactor is the lowest fraction of the configured QPS the rate limiter backs off to
+	adaptiveRateLimitMinFactor = 0.1
+	// adaptiveRateLimitBackoffInterval is the minimum interval between two decreases of the rate, so a burst of
+	// throttled requests only halves the rate once
+	adaptiveRateLimitBackoffInterval = time.Second
+	// adaptiveRateLimitRecoveryInterval is the interval between two increases of the rate once the API server no
+	// longer throttles the requests
+	adaptiveRateLimitRecoveryInterval = 5 * time.Second
+)
+
+// AdaptiveRateLimiter is a client side rate limiter for Kubernetes API requests which halves its rate when the API
+// server throttles requests with 429 Too Many Requests responses, and gradually recovers the configured rate once
+// the requests succeed again.
+type AdaptiveRateLimiter struct {
+	limiter *rate.Limiter
+	qps     float64
+	now     func() time.Time
+
+	lock       sync.Mutex
+	lastChange time.Time
+}
+
+var _ flowcontrol.RateLimiter = &AdaptiveRateLimiter{}
+
+// NewAdaptiveRateLimiter returns a rate limiter allowing qps requests per second with the given burst, when the API
+// server does not throttle requests.
+func NewAdaptiveRateLimiter(qps float32, burst int) *AdaptiveRateLimiter {
+	return &AdaptiveRateLimiter{
+		limiter: rate.NewLimiter(rate.Limit(qps), burst),
+		qps:     float64(qps),
+		now:     time.Now,
+	}
+}
+
+// TryAccept returns true if a request can be sent immediately
+func (r *AdaptiveRateLimiter) TryAccept() bool {
+	return r.limiter.AllowN(r.now(), 1)
+}
+
+// Accept blocks until a request can be sent
+func (r *AdaptiveRateLimiter) Accept() {
+	_ = r.limiter.Wait(context.Background())
+}
+
+// Wait blocks until a request can be sent, or the context is done
+func (r *AdaptiveRateLimiter) Wait(ctx context.Context) error {
+	return r.limiter.Wait(ctx)
+}
+
+// Stop is a no-op, the rate limiter does not hold any resources
+func (r *AdaptiveRateLimiter) Stop() {}
+
+// QPS returns the current rate of the rate limiter
+func (r *AdaptiveRateLimiter) QPS() float32 {
+	return float32(r.limiter.Limit())
+}
+
+// observe adjusts the rate based on the status code of an API server response
+func (r *AdaptiveRateLimiter) observe(statusCode int) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	now := r.now()
+	current := float64(r.limiter.Limit())
+	switch {
+	case statusCode == http.StatusTooManyRequests:
+		if now.Sub(r.lastChange) < adaptiveRateLimitBackoffInterval {
+			return
+		}
+		next := max(current/2, r.qps*adaptiveRateLimitMinFactor)
+		if next < current {
+			log.Warnf("Kubernetes API server throttled requests, lowering client rate limit to %.1f QPS", next)
+			r.limiter.SetLimitAt(now, rate.Limit(next))
+		}
+		r.lastChange = now
+	case current < r.qps && now.Sub(r.lastChange) >= adaptiveRateLimitRecoveryInterval:
+		// recover additively, reaching the configured rate after ten intervals without throttling
+		r.limiter.SetLimitAt(now, rate.Limit(min(current+r.qps*adaptiveRateLimitMinFactor, r.qps)))
+		r.lastChange = now
+	}
+}
+
+// WrapTransport returns a round tripper which adjusts the rate of the rate limiter based on the responses of the API
+// server
+func (r *AdaptiveRateLimiter) WrapTransport(rt http.RoundTripper) http.RoundTripper {
+	return &adaptiveRateLimitTransport{inner: rt, limiter: r}
+}
+
+type adaptiveRateLimitTransport struct {
+	inner   http.RoundTripper
+	limiter *AdaptiveRateLimiter
+}
+
+func (t *adaptiveRateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.inner.RoundTrip(req)
+	if err == nil {
+		t.limiter.observe(resp.StatusCode)
+	}
+	return resp, err
+}
@@ -0,0 +1,60 @@
+package kube
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdaptiveRateLimiter(t *testing.T) {
+	now := time.Now()
+	limiter := NewAdaptiveRateLimiter(100, 200)
+	limiter.now = func() time.Time { return now }
+	assert.InDelta(t, 100, limiter.QPS(), 0.01)
+
+	limiter.observe(http.StatusTooManyRequests)
+	assert.InDelta(t, 50, limiter.QPS(), 0.01)
+
+	// a burst of throttled requests only halves the rate once
+	limiter.observe(http.StatusTooManyRequests)
+	assert.InDelta(t, 50, limiter.QPS(), 0.01)
+
+	for range 10 {
+		now = now.Add(adaptiveRateLimitBackoffInterval)
+		limiter.observe(http.StatusTooManyRequests)
+	}
+	assert.InDelta(t, 10, limiter.QPS(), 0.01, "the rate must not drop below a tenth of the configured rate")
+
+	// the rate is not recovered before the recovery interval
+	limiter.observe(http.StatusOK)
+	assert.InDelta(t, 10, limiter.QPS(), 0.01)
+
+	now = now.Add(adaptiveRateLimitRecoveryInterval)
+	limiter.observe(http.StatusOK)
+	assert.InDelta(t, 20, limiter.QPS(), 0.01)
+
+	for range 20 {
+		now = now.Add(adaptiveRateLimitRecoveryInterval)
+		limiter.observe(http.StatusOK)
+	}
+	assert.InDelta(t, 100, limiter.QPS(), 0.01, "the rate must not exceed the configured rate")
+}
+
+func TestAdaptiveRateLimiter_WrapTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	limiter := NewAdaptiveRateLimiter(10, 20)
+	client := &http.Client{Transport: limiter.WrapTransport(http.DefaultTransport)}
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.InDelta(t, 5, limiter.QPS(), 0.01)
+}
@@ -0,0 +1,123 @@
+package kube
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	// sshTunnelDefaultPort is the port of the SSH server if the address does not have one
+	sshTunnelDefaultPort = "22"
+	// sshTunnelDialTimeout is the maximum time to establish the SSH connection
+	sshTunnelDialTimeout = 30 * time.Second
+)
+
+// SSHTunnel forwards connections to the hosts reachable from an SSH server, e.g. a bastion host in front of a private
+// API server. The SSH connection is established on the first dial, and re-established once it breaks.
+type SSHTunnel struct {
+	addr   string
+	config *ssh.ClientConfig
+
+	lock   sync.Mutex
+	client *ssh.Client
+}
+
+// NewSSHTunnel returns a tunnel through the SSH server at addr, authenticating as user with the PEM-encoded private
+// key. The host key of the server must match hostKey, in the authorized_keys format, unless insecureIgnoreHostKey is
+// set.
+func NewSSHTunnel(addr string, user string, privateKey []byte, hostKey string, insecureIgnoreHostKey bool) (*SSHTunnel, error) {
+	if addr == "" {
+		return nil, errors.New("SSH server address is required")
+	}
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(strings.Trim(addr, "[]"), sshTunnelDefaultPort)
+	}
+	signer, err := ssh.ParsePrivateKey(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse SSH private key: %w", err)
+	}
+	var hostKeyCallback ssh.HostKeyCallback
+	switch {
+	case insecureIgnoreHostKey:
+		hostKeyCallback = ssh.InsecureIgnoreHostKey() //nolint:gosec // explicitly requested by the cluster configuration
+	case hostKey != "":
+		key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(hostKey))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse SSH host key: %w", err)
+		}
+		hostKeyCallback = ssh.FixedHostKey(key)
+	default:
+		return nil, errors.New("SSH host key is required unless host key verification is disabled")
+	}
+	return &SSHTunnel{
+		addr: addr,
+		config: &ssh.ClientConfig{
+			User:            user,
+			Auth:            []ssh.AuthMethod{ssh.PublicKeys(signer)},
+			HostKeyCallback: hostKeyCallback,
+			Timeout:         sshTunnelDialTimeout,
+		},
+	}, nil
+}
+
+// DialContext connects to addr from the SSH server
+func (t *SSHTunnel) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	client, err := t.getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := client.DialContext(ctx, network, addr)
+	if err != nil {
+		// unless the SSH server rejected the forwarding, the SSH connection might be broken, so it is re-established
+		// on the next dial
+		var openChannelErr *ssh.OpenChannelError
+		if !errors.As(err, &openChannelErr) {
+			t.closeClient(client)
+		}
+		return nil, fmt.Errorf("failed to connect to %s through SSH server %s: %w", addr, t.addr, err)
+	}
+	return conn, nil
+}
+
+func (t *SSHTunnel) getClient(ctx context.Context) (*ssh.Client, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.client != nil {
+		return t.client, nil
+	}
+	dialer := net.Dialer{Timeout: sshTunnelDialTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", t.addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to SSH server %s: %w", t.addr, err)
+	}
+	sshConn, chans, reqs, err := ssh.NewClientConn(conn, t.addr, t.config)
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("failed to establish SSH connection to %s: %w", t.addr, err)
+	}
+	client := ssh.NewClient(sshConn, chans, reqs)
+	go func() {
+		_ = client.Wait()
+		log.Debugf("SSH connection to %s closed", t.addr)
+		t.closeClient(client)
+	}()
+	t.client = client
+	return client, nil
+}
+
+func (t *SSHTunnel) closeClient(client *ssh.Client) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.client == client {
+		t.client = nil
+	}
+	_ = client.Close()
+}
@@ -0,0 +1,162 @@
+package kube
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+// startSSHServer starts an SSH server forwarding direct-tcpip channels, which accepts the given client key
+func startSSHServer(t *testing.T, hostKey ssh.Signer, clientKey ssh.PublicKey) string {
+	t.Helper()
+	config := &ssh.ServerConfig{
+		PublicKeyCallback: func(_ ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if !bytes.Equal(key.Marshal(), clientKey.Marshal()) {
+				return nil, fmt.Errorf("unknown public key for %s", key.Type())
+			}
+			return nil, nil
+		},
+	}
+	config.AddHostKey(hostKey)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				_, chans, reqs, err := ssh.NewServerConn(conn, config)
+				if err != nil {
+					return
+				}
+				go ssh.DiscardRequests(reqs)
+				for newChannel := range chans {
+					var target struct {
+						Host     string
+						Port     uint32
+						OrigHost string
+						OrigPort uint32
+					}
+					if newChannel.ChannelType() != "direct-tcpip" || ssh.Unmarshal(newChannel.ExtraData(), &target) != nil {
+						_ = newChannel.Reject(ssh.UnknownChannelType, "unsupported channel")
+						continue
+					}
+					targetConn, err := net.Dial("tcp", net.JoinHostPort(target.Host, fmt.Sprint(target.Port)))
+					if err != nil {
+						_ = newChannel.Reject(ssh.ConnectionFailed, err.Error())
+						continue
+					}
+					channel, channelReqs, err := newChannel.Accept()
+					if err != nil {
+						_ = targetConn.Close()
+						continue
+					}
+					go ssh.DiscardRequests(channelReqs)
+					go func() {
+						_, _ = io.Copy(targetConn, channel)
+						_ = targetConn.Close()
+					}()
+					go func() {
+						_, _ = io.Copy(channel, targetConn)
+						_ = channel.Close()
+					}()
+				}
+			}()
+		}
+	}()
+	return listener.Addr().String()
+}
+
+func newSSHKey(t *testing.T) (ssh.Signer, []byte) {
+	t.Helper()
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	signer, err := ssh.NewSignerFromKey(key)
+	require.NoError(t, err)
+	block, err := ssh.MarshalPrivateKey(key, "")
+	require.NoError(t, err)
+	return signer, pem.EncodeToMemory(block)
+}
+
+func TestSSHTunnel(t *testing.T) {
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer target.Close()
+	hostKey, _ := newSSHKey(t)
+	clientKey, clientPrivateKey := newSSHKey(t)
+	addr := startSSHServer(t, hostKey, clientKey.PublicKey())
+	authorizedHostKey := string(ssh.MarshalAuthorizedKey(hostKey.PublicKey()))
+
+	get := func(tunnel *SSHTunnel) (string, error) {
+		client := &http.Client{Transport: &http.Transport{DialContext: tunnel.DialContext}}
+		resp, err := client.Get(target.URL)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		return string(body), err
+	}
+
+	t.Run("Forwarded", func(t *testing.T) {
+		tunnel, err := NewSSHTunnel(addr, "argocd", clientPrivateKey, authorizedHostKey, false)
+		require.NoError(t, err)
+		body, err := get(tunnel)
+		require.NoError(t, err)
+		assert.Equal(t, "ok", body)
+		// the SSH connection is reused
+		client := tunnel.client
+		_, err = get(tunnel)
+		require.NoError(t, err)
+		assert.Same(t, client, tunnel.client)
+	})
+	t.Run("Host key mismatch", func(t *testing.T) {
+		otherHostKey, _ := newSSHKey(t)
+		tunnel, err := NewSSHTunnel(addr, "argocd", clientPrivateKey, string(ssh.MarshalAuthorizedKey(otherHostKey.PublicKey())), false)
+		require.NoError(t, err)
+		_, err = get(tunnel)
+		require.ErrorContains(t, err, "host key mismatch")
+	})
+	t.Run("Host key verification disabled", func(t *testing.T) {
+		tunnel, err := NewSSHTunnel(addr, "argocd", clientPrivateKey, "", true)
+		require.NoError(t, err)
+		body, err := get(tunnel)
+		require.NoError(t, err)
+		assert.Equal(t, "ok", body)
+	})
+	t.Run("Unauthorized", func(t *testing.T) {
+		_, otherPrivateKey := newSSHKey(t)
+		tunnel, err := NewSSHTunnel(addr, "argocd", otherPrivateKey, authorizedHostKey, false)
+		require.NoError(t, err)
+		_, err = get(tunnel)
+		require.ErrorContains(t, err, "unable to authenticate")
+	})
+	t.Run("Invalid configuration", func(t *testing.T) {
+		_, err := NewSSHTunnel(addr, "argocd", clientPrivateKey, "", false)
+		require.EqualError(t, err, "SSH host key is required unless host key verification is disabled")
+		_, err = NewSSHTunnel(addr, "argocd", []byte("not a key"), authorizedHostKey, false)
+		require.ErrorContains(t, err, "failed to parse SSH private key")
+		_, err = NewSSHTunnel("", "argocd", clientPrivateKey, authorizedHostKey, false)
+		require.EqualError(t, err, "SSH server address is required")
+	})
+	t.Run("Default port", func(t *testing.T) {
+		tunnel, err := NewSSHTunnel("bastion.example.com", "argocd", clientPrivateKey, authorizedHostKey, false)
+		require.NoError(t, err)
+		assert.Equal(t, "bastion.example.com:22", tunnel.addr)
+	})
+}
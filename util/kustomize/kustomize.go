@@ -34,6 +34,10 @@ type Image = string
 type BuildOpts struct {
 	KubeVersion string
 	APIVersions []string
+	// Sandbox constrains the resources of the `kustomize build` command, if not nil
+	Sandbox *executil.Sandbox
+	// CPUUsage accumulates the CPU time of the `kustomize build` command, if not nil
+	CPUUsage *executil.CPUUsage
 }
 
 // Kustomize provides wrapper functionality around the `kustomize` command.
@@ -383,7 +387,13 @@ func (k *kustomize) Build(opts *v1alpha1.ApplicationSourceKustomize, kustomizeOp
 	cmd.Env = proxy.UpsertEnv(cmd, k.proxy, k.noProxy)
 	cmd.Dir = k.repoRoot
 	commands = append(commands, executil.GetCommandArgsToLog(cmd))
-	out, err := executil.Run(cmd)
+	var sandbox *executil.Sandbox
+	var cpuUsage *executil.CPUUsage
+	if buildOpts != nil {
+		sandbox = buildOpts.Sandbox
+		cpuUsage = buildOpts.CPUUsage
+	}
+	out, err := executil.RunWithExecRunOpts(cmd, executil.ExecRunOpts{Sandbox: sandbox, CPUUsage: cpuUsage})
 	if err != nil {
 		return nil, nil, nil, err
 	}
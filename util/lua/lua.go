@@ -19,6 +19,7 @@ import (
 
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/resource_customizations"
+	"github.com/argoproj/argo-cd/v3/util/cel"
 	"github.com/argoproj/argo-cd/v3/util/glob"
 )
 
@@ -43,6 +44,9 @@ func (e ScriptDoesNotExistError) Error() string {
 type ResourceHealthOverrides map[string]appv1.ResourceOverride
 
 func (overrides ResourceHealthOverrides) GetResourceHealth(obj *unstructured.Unstructured) (*health.HealthStatus, error) {
+	if expression := overrides.getHealthCEL(obj); expression != "" {
+		return cel.GetResourceHealth(obj, expression)
+	}
 	luaVM := VM{
 		ResourceOverrides: overrides,
 	}
@@ -62,6 +66,21 @@ func (overrides ResourceHealthOverrides) GetResourceHealth(obj *unstructured.Uns
 	return result, nil
 }
 
+// getHealthCEL returns the CEL health expression of the resource, if any. The CEL expression of a resource override
+// takes precedence over its Lua script, and the overrides of the resource take precedence over the wildcard ones.
+func (overrides ResourceHealthOverrides) getHealthCEL(obj *unstructured.Unstructured) string {
+	key := GetConfigMapKey(obj.GroupVersionKind())
+	if override, ok := overrides[key]; ok && (override.HealthCEL != "" || override.HealthLua != "") {
+		return override.HealthCEL
+	}
+	for overrideKey, override := range overrides {
+		if glob.Match(overrideKey, key) && override.HealthCEL != "" {
+			return override.HealthCEL
+		}
+	}
+	return ""
+}
+
 // VM Defines a struct that implements the luaVM
 type VM struct {
 	ResourceOverrides map[string]appv1.ResourceOverride
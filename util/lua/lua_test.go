@@ -881,4 +881,42 @@ return hs`
 		require.NoError(t, err)
 		assert.Nil(t, status)
 	})
+
+	t.Run("Get resource health for CEL override", func(t *testing.T) {
+		testObj := StrToUnstructured(testSA)
+		overrides := ResourceHealthOverrides{
+			"ServiceAccount": appv1.ResourceOverride{
+				HealthLua: script,
+				HealthCEL: `{"status": "Degraded", "message": "service account " + obj.metadata.name}`,
+			},
+		}
+		status, err := overrides.GetResourceHealth(testObj)
+		require.NoError(t, err)
+		expectedStatus := &health.HealthStatus{
+			Status:  health.HealthStatusDegraded,
+			Message: "service account test",
+		}
+		assert.Equal(t, expectedStatus, status)
+	})
+
+	t.Run("Get resource health for wildcard CEL override", func(t *testing.T) {
+		testObj := StrToUnstructured(ec2AWSCrossplaneObjJSON)
+		overrides := ResourceHealthOverrides{
+			"*.aws.crossplane.io/*": appv1.ResourceOverride{
+				HealthCEL: `"Suspended"`,
+			},
+		}
+		status, err := overrides.GetResourceHealth(testObj)
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusSuspended}, status)
+	})
+
+	t.Run("Lua override takes precedence over wildcard CEL override", func(t *testing.T) {
+		testObj := StrToUnstructured(testSA)
+		overrides := getHealthOverride(true)
+		overrides["*/*"] = appv1.ResourceOverride{HealthCEL: `"Degraded"`}
+		status, err := overrides.GetResourceHealth(testObj)
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusHealthy, status.Status)
+	})
 }
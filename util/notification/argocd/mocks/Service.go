@@ -9,6 +9,8 @@ import (
 
 	shared "github.com/argoproj/argo-cd/v3/util/notification/expression/shared"
 
+	time "time"
+
 	v1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
@@ -77,6 +79,66 @@ func (_m *Service) GetCommitMetadata(ctx context.Context, repoURL string, commit
 	return r0, r1
 }
 
+// GetExpiringAccountTokens provides a mock function with given fields: ctx, within
+func (_m *Service) GetExpiringAccountTokens(ctx context.Context, within time.Duration) ([]shared.ExpiringToken, error) {
+	ret := _m.Called(ctx, within)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExpiringAccountTokens")
+	}
+
+	var r0 []shared.ExpiringToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) ([]shared.ExpiringToken, error)); ok {
+		return rf(ctx, within)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) []shared.ExpiringToken); ok {
+		r0 = rf(ctx, within)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]shared.ExpiringToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration) error); ok {
+		r1 = rf(ctx, within)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetExpiringProjectTokens provides a mock function with given fields: ctx, project, within
+func (_m *Service) GetExpiringProjectTokens(ctx context.Context, project string, within time.Duration) ([]shared.ExpiringToken, error) {
+	ret := _m.Called(ctx, project, within)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExpiringProjectTokens")
+	}
+
+	var r0 []shared.ExpiringToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) ([]shared.ExpiringToken, error)); ok {
+		return rf(ctx, project, within)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) []shared.ExpiringToken); ok {
+		r0 = rf(ctx, project, within)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]shared.ExpiringToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Duration) error); ok {
+		r1 = rf(ctx, project, within)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewService creates a new instance of Service. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewService(t interface {
@@ -2,13 +2,21 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
 
 	"github.com/argoproj/argo-cd/v3/util/notification/expression/shared"
 
 	log "github.com/sirupsen/logrus"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
+	appinformer "github.com/argoproj/argo-cd/v3/pkg/client/informers/externalversions/application/v1alpha1"
+	applisters "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v3/util/db"
 	"github.com/argoproj/argo-cd/v3/util/settings"
@@ -17,9 +25,11 @@ import (
 type Service interface {
 	GetCommitMetadata(ctx context.Context, repoURL string, commitSHA string, project string) (*shared.CommitMetadata, error)
 	GetAppDetails(ctx context.Context, app *v1alpha1.Application) (*shared.AppDetail, error)
+	GetExpiringProjectTokens(ctx context.Context, project string, within time.Duration) ([]shared.ExpiringToken, error)
+	GetExpiringAccountTokens(ctx context.Context, within time.Duration) ([]shared.ExpiringToken, error)
 }
 
-func NewArgoCDService(clientset kubernetes.Interface, namespace string, repoClientset apiclient.Clientset) (*argoCDService, error) {
+func NewArgoCDService(clientset kubernetes.Interface, appClientset appclientset.Interface, namespace string, repoClientset apiclient.Clientset) (*argoCDService, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 	settingsMgr := settings.NewSettingsManager(ctx, clientset, namespace)
 	closer, repoClient, err := repoClientset.NewRepoServerClient()
@@ -34,15 +44,21 @@ func NewArgoCDService(clientset kubernetes.Interface, namespace string, repoClie
 			log.Warnf("Failed to close repo server connection: %v", err)
 		}
 	}
-	return &argoCDService{settingsMgr: settingsMgr, namespace: namespace, repoServerClient: repoClient, dispose: dispose}, nil
+	return &argoCDService{ctx: ctx, appClientset: appClientset, settingsMgr: settingsMgr, namespace: namespace, repoServerClient: repoClient, dispose: dispose}, nil
 }
 
 type argoCDService struct {
+	ctx              context.Context
 	clientset        kubernetes.Interface
+	appClientset     appclientset.Interface
 	namespace        string
 	settingsMgr      *settings.SettingsManager
 	repoServerClient apiclient.RepoServerServiceClient
 	dispose          func()
+
+	projListerOnce sync.Once
+	projLister     applisters.AppProjectNamespaceLister
+	projListerErr  error
 }
 
 func (svc *argoCDService) GetCommitMetadata(ctx context.Context, repoURL string, commitSHA string, project string) (*shared.CommitMetadata, error) {
@@ -126,6 +142,75 @@ func (svc *argoCDService) GetAppDetails(ctx context.Context, app *v1alpha1.Appli
 	}, nil
 }
 
+// GetExpiringProjectTokens returns the tokens of the roles of the given project expiring within the given duration
+func (svc *argoCDService) GetExpiringProjectTokens(_ context.Context, project string, within time.Duration) ([]shared.ExpiringToken, error) {
+	projLister, err := svc.getProjectLister()
+	if err != nil {
+		return nil, err
+	}
+	proj, err := projLister.Get(project)
+	if err != nil {
+		return nil, err
+	}
+	deadline := time.Now().Add(within).Unix()
+	var tokens []shared.ExpiringToken
+	for _, role := range proj.Spec.Roles {
+		for _, token := range role.JWTTokens {
+			if token.ExpiresAt > 0 && token.ExpiresAt <= deadline {
+				tokens = append(tokens, shared.ExpiringToken{
+					Project:   proj.Name,
+					Role:      role.Name,
+					ID:        token.ID,
+					IssuedAt:  time.Unix(token.IssuedAt, 0),
+					ExpiresAt: time.Unix(token.ExpiresAt, 0),
+				})
+			}
+		}
+	}
+	return tokens, nil
+}
+
+// GetExpiringAccountTokens returns the tokens of the local accounts expiring within the given duration
+func (svc *argoCDService) GetExpiringAccountTokens(_ context.Context, within time.Duration) ([]shared.ExpiringToken, error) {
+	accounts, err := svc.settingsMgr.GetAccounts()
+	if err != nil {
+		return nil, err
+	}
+	deadline := time.Now().Add(within).Unix()
+	var tokens []shared.ExpiringToken
+	for name, account := range accounts {
+		for _, token := range account.Tokens {
+			if token.ExpiresAt > 0 && token.ExpiresAt <= deadline {
+				tokens = append(tokens, shared.ExpiringToken{
+					Account:   name,
+					ID:        token.ID,
+					IssuedAt:  time.Unix(token.IssuedAt, 0),
+					ExpiresAt: time.Unix(token.ExpiresAt, 0),
+				})
+			}
+		}
+	}
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].ExpiresAt.Before(tokens[j].ExpiresAt)
+	})
+	return tokens, nil
+}
+
+// getProjectLister returns the lister of the projects of the Argo CD namespace. The informer backing it is started
+// on the first call, so that the projects are not watched unless the tokens of the projects are looked up.
+func (svc *argoCDService) getProjectLister() (applisters.AppProjectNamespaceLister, error) {
+	svc.projListerOnce.Do(func() {
+		informer := appinformer.NewAppProjectInformer(svc.appClientset, svc.namespace, 0, cache.Indexers{})
+		go informer.Run(svc.ctx.Done())
+		if !cache.WaitForCacheSync(svc.ctx.Done(), informer.HasSynced) {
+			svc.projListerErr = fmt.Errorf("failed to sync the projects of namespace %s", svc.namespace)
+			return
+		}
+		svc.projLister = applisters.NewAppProjectLister(informer.GetIndexer()).AppProjects(svc.namespace)
+	})
+	return svc.projLister, svc.projListerErr
+}
+
 func (svc *argoCDService) Close() {
 	svc.dispose()
 }
@@ -0,0 +1,116 @@
+package delivery
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AnnotationKey is the annotation holding the status of the delivery of the latest notifications about a resource
+const AnnotationKey = "deliveries.notifications.argoproj.io"
+
+const (
+	// StatusPending is the status of the notifications aggregated in a digest which is not sent yet
+	StatusPending = "Pending"
+	// StatusSucceeded is the status of the notifications which were delivered
+	StatusSucceeded = "Succeeded"
+	// StatusRetrying is the status of the notifications which failed to be delivered and are going to be retried
+	StatusRetrying = "Retrying"
+	// StatusFailed is the status of the notifications which failed to be delivered after all the retries
+	StatusFailed = "Failed"
+)
+
+// Record is the status of the delivery of a notification
+type Record struct {
+	// ID identifies the notification across its delivery attempts
+	ID        string   `json:"id"`
+	Templates []string `json:"templates"`
+	Service   string   `json:"service"`
+	Recipient string   `json:"recipient,omitempty"`
+	// Namespace is the namespace of the notification settings the digest holding the notification is sent with
+	Namespace string `json:"namespace,omitempty"`
+	Status    string `json:"status"`
+	// Attempts is the number of delivery attempts, including the retries
+	Attempts int `json:"attempts"`
+	// HTTPStatus is the status code of the response to the latest attempt, if the service returned one
+	HTTPStatus int `json:"httpStatus,omitempty"`
+	// Message describes the error of the latest attempt, without the URLs it may contain
+	Message string      `json:"message,omitempty"`
+	Time    metav1.Time `json:"time"`
+}
+
+// GetRecords returns the delivery records stored in the annotations of a resource, the oldest first
+func GetRecords(annotations map[string]string) ([]Record, error) {
+	v, ok := annotations[AnnotationKey]
+	if !ok {
+		return nil, nil
+	}
+	var records []Record
+	if err := json.Unmarshal([]byte(v), &records); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the delivery records: %w", err)
+	}
+	return records, nil
+}
+
+// SetRecord replaces the record with the same ID, or appends the record and drops the oldest records exceeding the
+// limit
+func SetRecord(records []Record, record Record, limit int) []Record {
+	for i := range records {
+		if records[i].ID == record.ID {
+			records[i] = record
+			return records
+		}
+	}
+	records = append(records, record)
+	if limit > 0 && len(records) > limit {
+		records = records[len(records)-limit:]
+	}
+	return records
+}
+
+// httpStatusPattern matches the status codes in the errors of the notification services, e.g. "request to ... has
+// failed with error code 503 : ..." or "teams webhook returned status 503: ..."
+var httpStatusPattern = regexp.MustCompile(`(?:error code|status) ([1-5][0-9]{2})\b`)
+
+// HTTPStatus returns the HTTP status code reported by the error of a notification service, or 0 if there is none
+func HTTPStatus(err error) int {
+	if err == nil {
+		return 0
+	}
+	match := httpStatusPattern.FindStringSubmatch(err.Error())
+	if match == nil {
+		return 0
+	}
+	code, _ := strconv.Atoi(match[1])
+	return code
+}
+
+// urlPattern matches the URLs in the errors of the notification services
+var urlPattern = regexp.MustCompile(`[a-zA-Z][a-zA-Z0-9+.-]*://[^\s"']*[^\s"'.,:;)]`)
+
+// Message returns the description of the error of a notification service which is stored in the delivery records.
+// The records are readable by the users allowed to get the resource, while the URLs of the services, which the errors
+// usually contain, may hold secrets, e.g. the signature of a webhook URL. The description is therefore limited to the
+// HTTP status code or the type of the error, and the URLs are removed from the other errors.
+func Message(err error) string {
+	if err == nil {
+		return ""
+	}
+	if status := HTTPStatus(err); status != 0 {
+		return fmt.Sprintf("the service returned status %d %s", status, http.StatusText(status))
+	}
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		if urlErr.Timeout() {
+			return "the request to the service timed out"
+		}
+		return fmt.Sprintf("the request to the service failed: %T", urlErr.Err)
+	}
+	return urlPattern.ReplaceAllString(err.Error(), "<redacted URL>")
+}
@@ -0,0 +1,65 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRecords(t *testing.T) {
+	records, err := GetRecords(map[string]string{})
+	require.NoError(t, err)
+	assert.Empty(t, records)
+
+	records, err = GetRecords(map[string]string{
+		AnnotationKey: `[{"id":"1","templates":["app-sync-failed"],"service":"slack","recipient":"my-channel","status":"Failed","attempts":3,"httpStatus":503,"time":"2025-01-01T00:00:00Z"}]`,
+	})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, StatusFailed, records[0].Status)
+	assert.Equal(t, 503, records[0].HTTPStatus)
+
+	_, err = GetRecords(map[string]string{AnnotationKey: "{"})
+	assert.ErrorContains(t, err, "failed to unmarshal the delivery records")
+}
+
+func TestSetRecord(t *testing.T) {
+	var records []Record
+	records = SetRecord(records, Record{ID: "1", Status: StatusRetrying}, 2)
+	records = SetRecord(records, Record{ID: "2", Status: StatusSucceeded}, 2)
+	records = SetRecord(records, Record{ID: "1", Status: StatusSucceeded, Attempts: 2}, 2)
+	assert.Equal(t, []Record{{ID: "1", Status: StatusSucceeded, Attempts: 2}, {ID: "2", Status: StatusSucceeded}}, records)
+
+	records = SetRecord(records, Record{ID: "3", Status: StatusFailed}, 2)
+	assert.Equal(t, []Record{{ID: "2", Status: StatusSucceeded}, {ID: "3", Status: StatusFailed}}, records)
+}
+
+func TestHTTPStatus(t *testing.T) {
+	assert.Equal(t, 503, HTTPStatus(errors.New("request to https://example.com has failed with error code 503 : unavailable")))
+	assert.Equal(t, 429, HTTPStatus(errors.New("teams webhook returned status 429: too many requests")))
+	assert.Equal(t, 0, HTTPStatus(errors.New("dial tcp: connection refused")))
+	assert.Equal(t, 0, HTTPStatus(nil))
+}
+
+func TestMessage(t *testing.T) {
+	assert.Empty(t, Message(nil))
+	assert.Equal(t, "the service returned status 503 Service Unavailable", Message(errors.New("request to https://example.com/hook?sig=secret has failed with error code 503 : unavailable")))
+	assert.Equal(t, "the request to the service failed: *net.OpError", Message(&url.Error{
+		Op:  "Post",
+		URL: "https://example.webhook.office.com/workflows?sig=secret",
+		Err: &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")},
+	}))
+	assert.Equal(t, "the request to the service timed out", Message(fmt.Errorf("failed to send: %w", &url.Error{
+		Op:  "Post",
+		URL: "https://example.webhook.office.com/workflows?sig=secret",
+		Err: context.DeadlineExceeded,
+	})))
+	assert.Equal(t, "failed to notify <redacted URL>: invalid payload", Message(errors.New("failed to notify https://hooks.example.com/T000/B000/secret: invalid payload")))
+	assert.Equal(t, "connection refused", Message(errors.New("connection refused")))
+}
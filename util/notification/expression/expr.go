@@ -8,6 +8,8 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/notification/expression/repo"
 	"github.com/argoproj/argo-cd/v3/util/notification/expression/strings"
 	"github.com/argoproj/argo-cd/v3/util/notification/expression/time"
+	"github.com/argoproj/argo-cd/v3/util/notification/expression/tokens"
+	"github.com/argoproj/argo-cd/v3/util/notification/expression/windows"
 )
 
 var helpers = map[string]any{}
@@ -31,6 +33,8 @@ func Spawn(app *unstructured.Unstructured, argocdService service.Service, vars m
 		clone[namespace] = helper
 	}
 	clone["repo"] = repo.NewExprs(argocdService, app)
+	clone["tokens"] = tokens.NewExprs(argocdService, app)
+	clone["windows"] = windows.NewExprs(app)
 
 	return clone
 }
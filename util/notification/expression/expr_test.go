@@ -11,6 +11,7 @@ func TestExpr(t *testing.T) {
 		"time",
 		"repo",
 		"strings",
+		"tokens",
 	}
 
 	for _, ns := range namespaces {
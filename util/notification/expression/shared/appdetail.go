@@ -19,6 +19,21 @@ type CommitMetadata struct {
 	Tags []string
 }
 
+type ExpiringToken struct {
+	// Local account owning the token, empty for the tokens of the project roles
+	Account string
+	// Project owning the token
+	Project string
+	// Project role of the token
+	Role string
+	// Token ID
+	ID string
+	// Token issue date
+	IssuedAt time.Time
+	// Token expiration date
+	ExpiresAt time.Time
+}
+
 type AppDetail struct {
 	// AppDetail Type
 	Type string
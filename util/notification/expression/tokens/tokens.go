@@ -0,0 +1,58 @@
+package tokens
+
+import (
+	"context"
+	"errors"
+
+	timeutil "github.com/argoproj/pkg/v2/time"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
+	service "github.com/argoproj/argo-cd/v3/util/notification/argocd"
+)
+
+func getExpiringProjectTokens(app *unstructured.Unstructured, within string, argocdService service.Service) (any, error) {
+	duration, err := timeutil.ParseDuration(within)
+	if err != nil {
+		return nil, err
+	}
+	project := app.GetName()
+	if app.GetKind() != application.AppProjectKind {
+		var ok bool
+		if project, ok, err = unstructured.NestedString(app.Object, "spec", "project"); err != nil {
+			return nil, err
+		} else if !ok {
+			return nil, errors.New("failed to get application project")
+		}
+	}
+	return argocdService.GetExpiringProjectTokens(context.Background(), project, *duration)
+}
+
+func getExpiringAccountTokens(within string, argocdService service.Service) (any, error) {
+	duration, err := timeutil.ParseDuration(within)
+	if err != nil {
+		return nil, err
+	}
+	return argocdService.GetExpiringAccountTokens(context.Background(), *duration)
+}
+
+func NewExprs(argocdService service.Service, app *unstructured.Unstructured) map[string]any {
+	return map[string]any{
+		"GetExpiring": func(within string) any {
+			tokens, err := getExpiringProjectTokens(app, within, argocdService)
+			if err != nil {
+				panic(err)
+			}
+
+			return tokens
+		},
+		"GetExpiringAccounts": func(within string) any {
+			tokens, err := getExpiringAccountTokens(within, argocdService)
+			if err != nil {
+				panic(err)
+			}
+
+			return tokens
+		},
+	}
+}
@@ -0,0 +1,85 @@
+package tokens
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/util/notification/argocd/mocks"
+	"github.com/argoproj/argo-cd/v3/util/notification/expression/shared"
+)
+
+func TestGetExpiring(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+	argocdService := mocks.NewService(t)
+	argocdService.On("GetExpiringProjectTokens", mock.Anything, "my-project", 24*time.Hour).Return([]shared.ExpiringToken{{
+		Project:   "my-project",
+		Role:      "ci",
+		ID:        "token-1",
+		ExpiresAt: expiresAt,
+	}}, nil)
+	app := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{"project": "my-project"},
+	}}
+
+	getExpiring, ok := NewExprs(argocdService, app)["GetExpiring"].(func(string) any)
+	assert.True(t, ok)
+	tokens := getExpiring("1d").([]shared.ExpiringToken)
+	assert.Len(t, tokens, 1)
+	assert.Equal(t, "token-1", tokens[0].ID)
+	assert.Equal(t, expiresAt, tokens[0].ExpiresAt)
+}
+
+func TestGetExpiring_Project(t *testing.T) {
+	argocdService := mocks.NewService(t)
+	argocdService.On("GetExpiringProjectTokens", mock.Anything, "my-project", 24*time.Hour).Return([]shared.ExpiringToken{}, nil)
+	project := &unstructured.Unstructured{Object: map[string]any{
+		"kind":     "AppProject",
+		"metadata": map[string]any{"name": "my-project"},
+	}}
+
+	getExpiring, ok := NewExprs(argocdService, project)["GetExpiring"].(func(string) any)
+	assert.True(t, ok)
+	assert.Empty(t, getExpiring("1d"))
+}
+
+func TestGetExpiring_InvalidDuration(t *testing.T) {
+	argocdService := mocks.NewService(t)
+	app := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{"project": "my-project"},
+	}}
+	getExpiring, ok := NewExprs(argocdService, app)["GetExpiring"].(func(string) any)
+	assert.True(t, ok)
+	assert.Panics(t, func() {
+		getExpiring("invalid")
+	})
+}
+
+func TestGetExpiring_NoProject(t *testing.T) {
+	argocdService := mocks.NewService(t)
+	getExpiring, ok := NewExprs(argocdService, &unstructured.Unstructured{Object: map[string]any{}})["GetExpiring"].(func(string) any)
+	assert.True(t, ok)
+	assert.Panics(t, func() {
+		getExpiring("1d")
+	})
+}
+
+func TestGetExpiringAccounts(t *testing.T) {
+	argocdService := mocks.NewService(t)
+	argocdService.On("GetExpiringAccountTokens", mock.Anything, 24*time.Hour).Return([]shared.ExpiringToken{{
+		Account: "ci",
+		ID:      "token-1",
+	}}, nil)
+
+	getExpiringAccounts, ok := NewExprs(argocdService, &unstructured.Unstructured{Object: map[string]any{}})["GetExpiringAccounts"].(func(string) any)
+	assert.True(t, ok)
+	tokens := getExpiringAccounts("1d").([]shared.ExpiringToken)
+	assert.Len(t, tokens, 1)
+	assert.Equal(t, "ci", tokens[0].Account)
+	assert.Panics(t, func() {
+		getExpiringAccounts("invalid")
+	})
+}
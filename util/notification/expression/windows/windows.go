@@ -0,0 +1,39 @@
+package windows
+
+import (
+	"errors"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func getActiveSyncWindows(obj *unstructured.Unstructured) (v1alpha1.SyncWindows, error) {
+	if obj.GetKind() != application.AppProjectKind {
+		return nil, errors.New("sync windows are only available in the notifications of projects")
+	}
+	var project v1alpha1.AppProject
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &project); err != nil {
+		return nil, err
+	}
+	active, err := project.Spec.SyncWindows.Active()
+	if err != nil || active == nil {
+		return nil, err
+	}
+	return *active, nil
+}
+
+func NewExprs(obj *unstructured.Unstructured) map[string]any {
+	return map[string]any{
+		"GetActive": func() any {
+			windows, err := getActiveSyncWindows(obj)
+			if err != nil {
+				panic(err)
+			}
+
+			return windows
+		},
+	}
+}
@@ -0,0 +1,48 @@
+package windows
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newTestProject(windows ...map[string]any) *unstructured.Unstructured {
+	syncWindows := make([]any, len(windows))
+	for i := range windows {
+		syncWindows[i] = windows[i]
+	}
+	return &unstructured.Unstructured{Object: map[string]any{
+		"kind":     "AppProject",
+		"metadata": map[string]any{"name": "my-project"},
+		"spec":     map[string]any{"syncWindows": syncWindows},
+	}}
+}
+
+func TestGetActive(t *testing.T) {
+	project := newTestProject(
+		map[string]any{"kind": "deny", "schedule": "* * * * *", "duration": "1h"},
+		map[string]any{"kind": "allow", "schedule": "0 0 1 1 *", "duration": "1m"},
+	)
+	getActive, ok := NewExprs(project)["GetActive"].(func() any)
+	assert.True(t, ok)
+	windows := getActive().(v1alpha1.SyncWindows)
+	assert.Len(t, windows, 1)
+	assert.Equal(t, "deny", windows[0].Kind)
+}
+
+func TestGetActive_NoWindows(t *testing.T) {
+	getActive, ok := NewExprs(newTestProject())["GetActive"].(func() any)
+	assert.True(t, ok)
+	assert.Empty(t, getActive())
+}
+
+func TestGetActive_Application(t *testing.T) {
+	getActive, ok := NewExprs(&unstructured.Unstructured{Object: map[string]any{"kind": "Application"}})["GetActive"].(func() any)
+	assert.True(t, ok)
+	assert.Panics(t, func() {
+		getActive()
+	})
+}
@@ -0,0 +1,181 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/argoproj/notifications-engine/pkg/services"
+	httputil "github.com/argoproj/notifications-engine/pkg/util/http"
+	log "github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const (
+	// defaultPagerDutyEventsURL is the URL of the PagerDuty Events API v2
+	defaultPagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+
+	pagerDutyEventActionTrigger     = "trigger"
+	pagerDutyEventActionAcknowledge = "acknowledge"
+	pagerDutyEventActionResolve     = "resolve"
+)
+
+// PagerDutyEventNotification holds the template of a PagerDuty Events API v2 event
+type PagerDutyEventNotification struct {
+	// Action is the action of the event: trigger, acknowledge or resolve. Defaults to trigger.
+	Action string `json:"action,omitempty"`
+	// Condition is the condition of the application the event is about, e.g. health-degraded. The events of the same
+	// application and condition are paired, so that a resolve event resolves the alert of the trigger event.
+	Condition string `json:"condition,omitempty"`
+	// DedupKey is the deduplication key of the event. Defaults to <namespace>/<application>:<condition>.
+	DedupKey string `json:"dedupKey,omitempty"`
+	// Summary is the summary of the alert, required by the trigger events
+	Summary string `json:"summary,omitempty"`
+	// Severity is the severity of the alert: critical, error, warning or info. Defaults to error.
+	Severity string `json:"severity,omitempty"`
+	// Source is the affected system. Defaults to the name of the application.
+	Source    string `json:"source,omitempty"`
+	Component string `json:"component,omitempty"`
+	Group     string `json:"group,omitempty"`
+	Class     string `json:"class,omitempty"`
+	// URL is the link of the alert. Defaults to the URL of the application in the Argo CD UI.
+	URL string `json:"url,omitempty"`
+	// CustomDetails is a YAML or JSON object of the custom details of the alert
+	CustomDetails string `json:"customDetails,omitempty"`
+}
+
+func (n *PagerDutyEventNotification) render(name string, vars map[string]any) error {
+	if err := render(name, vars, &n.Action, &n.Condition, &n.DedupKey, &n.Summary, &n.Severity, &n.Source, &n.Component, &n.Group, &n.Class, &n.URL, &n.CustomDetails); err != nil {
+		return err
+	}
+	namespace, appName := appNamespaceAndName(vars)
+	if n.DedupKey == "" && n.Condition != "" && appName != "" {
+		n.DedupKey = fmt.Sprintf("%s/%s:%s", namespace, appName, n.Condition)
+	}
+	if n.Source == "" {
+		n.Source = appName
+	}
+	if n.URL == "" {
+		n.URL = appURL(vars)
+	}
+	return nil
+}
+
+type pagerDutyEventPayload struct {
+	Summary       string         `json:"summary"`
+	Severity      string         `json:"severity"`
+	Source        string         `json:"source"`
+	Component     string         `json:"component,omitempty"`
+	Group         string         `json:"group,omitempty"`
+	Class         string         `json:"class,omitempty"`
+	CustomDetails map[string]any `json:"custom_details,omitempty"`
+}
+
+type pagerDutyEvent struct {
+	RoutingKey  string                 `json:"routing_key"`
+	EventAction string                 `json:"event_action"`
+	DedupKey    string                 `json:"dedup_key,omitempty"`
+	Client      string                 `json:"client,omitempty"`
+	ClientURL   string                 `json:"client_url,omitempty"`
+	Payload     *pagerDutyEventPayload `json:"payload,omitempty"`
+}
+
+// event returns the PagerDuty event of the notification
+func (n *PagerDutyEventNotification) event(routingKey string) (*pagerDutyEvent, error) {
+	event := &pagerDutyEvent{RoutingKey: routingKey, EventAction: n.Action, DedupKey: n.DedupKey}
+	if event.EventAction == "" {
+		event.EventAction = pagerDutyEventActionTrigger
+	}
+	switch event.EventAction {
+	case pagerDutyEventActionTrigger:
+		if n.Summary == "" {
+			return nil, fmt.Errorf("summary is required by %s events", pagerDutyEventActionTrigger)
+		}
+		event.Client = "Argo CD"
+		event.ClientURL = n.URL
+		event.Payload = &pagerDutyEventPayload{
+			Summary:   n.Summary,
+			Severity:  n.Severity,
+			Source:    n.Source,
+			Component: n.Component,
+			Group:     n.Group,
+			Class:     n.Class,
+		}
+		if event.Payload.Severity == "" {
+			event.Payload.Severity = "error"
+		}
+		if n.CustomDetails != "" {
+			if err := yaml.Unmarshal([]byte(n.CustomDetails), &event.Payload.CustomDetails); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal customDetails: %w", err)
+			}
+		}
+	case pagerDutyEventActionAcknowledge, pagerDutyEventActionResolve:
+		if n.DedupKey == "" {
+			return nil, fmt.Errorf("condition or dedupKey is required by %s events", event.EventAction)
+		}
+	default:
+		return nil, fmt.Errorf("unknown action '%s', must be one of: %s, %s, %s", event.EventAction, pagerDutyEventActionTrigger, pagerDutyEventActionAcknowledge, pagerDutyEventActionResolve)
+	}
+	return event, nil
+}
+
+// PagerDutyEventsOptions holds the settings of the PagerDuty Events API v2 service
+type PagerDutyEventsOptions struct {
+	// ServiceKeys maps the recipients to the integration keys of their PagerDuty services
+	ServiceKeys map[string]string `json:"serviceKeys"`
+	// URL is the URL of the Events API. Defaults to https://events.pagerduty.com/v2/enqueue.
+	URL string `json:"url,omitempty"`
+}
+
+// NewPagerDutyEventsService returns a service sending events to the PagerDuty Events API v2
+func NewPagerDutyEventsService(opts PagerDutyEventsOptions, secretValues map[string]string) NotificationService {
+	serviceKeys := make(map[string]string, len(opts.ServiceKeys))
+	for recipient, key := range opts.ServiceKeys {
+		serviceKeys[recipient] = settings.ReplaceStringSecret(key, secretValues)
+	}
+	opts.ServiceKeys = serviceKeys
+	if opts.URL == "" {
+		opts.URL = defaultPagerDutyEventsURL
+	}
+	return &pagerDutyEventsService{opts: opts}
+}
+
+type pagerDutyEventsService struct {
+	opts PagerDutyEventsOptions
+}
+
+func (s *pagerDutyEventsService) Send(notification Notification, dest services.Destination) error {
+	routingKey, ok := s.opts.ServiceKeys[dest.Recipient]
+	if !ok {
+		return fmt.Errorf("no integration key configured for recipient %s", dest.Recipient)
+	}
+	if notification.PagerDutyEvent == nil {
+		return fmt.Errorf("no pagerdutyEvent template found for recipient %s", dest.Recipient)
+	}
+	event, err := notification.PagerDutyEvent.event(routingKey)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	client := &http.Client{
+		Transport: httputil.NewLoggingRoundTripper(httputil.NewTransport(s.opts.URL, false), log.WithField("service", "pagerdutyevents")),
+	}
+	response, err := client.Post(s.opts.URL, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		body, _ := io.ReadAll(response.Body)
+		return fmt.Errorf("pagerduty returned status %d: %s", response.StatusCode, string(body))
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/argoproj/notifications-engine/pkg/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPagerDutyEventNotificationEvent(t *testing.T) {
+	event, err := (&PagerDutyEventNotification{Summary: "guestbook is degraded", Source: "guestbook", CustomDetails: "revision: abc"}).event("my-key")
+	require.NoError(t, err)
+	assert.Equal(t, &pagerDutyEvent{
+		RoutingKey:  "my-key",
+		EventAction: "trigger",
+		Client:      "Argo CD",
+		Payload: &pagerDutyEventPayload{
+			Summary:       "guestbook is degraded",
+			Severity:      "error",
+			Source:        "guestbook",
+			CustomDetails: map[string]any{"revision": "abc"},
+		},
+	}, event)
+
+	event, err = (&PagerDutyEventNotification{Action: "resolve", DedupKey: "argocd/guestbook:health-degraded"}).event("my-key")
+	require.NoError(t, err)
+	assert.Equal(t, &pagerDutyEvent{RoutingKey: "my-key", EventAction: "resolve", DedupKey: "argocd/guestbook:health-degraded"}, event)
+
+	_, err = (&PagerDutyEventNotification{}).event("my-key")
+	require.ErrorContains(t, err, "summary is required by trigger events")
+	_, err = (&PagerDutyEventNotification{Action: "acknowledge"}).event("my-key")
+	require.ErrorContains(t, err, "condition or dedupKey is required by acknowledge events")
+	_, err = (&PagerDutyEventNotification{Action: "snooze"}).event("my-key")
+	require.ErrorContains(t, err, "unknown action 'snooze'")
+}
+
+func TestPagerDutyEventsServiceSend(t *testing.T) {
+	var event map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	svc := NewPagerDutyEventsService(PagerDutyEventsOptions{URL: server.URL, ServiceKeys: map[string]string{"my-service": "$pagerduty-key"}}, map[string]string{"pagerduty-key": "my-key"})
+	notification := Notification{PagerDutyEvent: &PagerDutyEventNotification{Action: "resolve", DedupKey: "argocd/guestbook:health-degraded"}}
+	require.NoError(t, svc.Send(notification, services.Destination{Service: "pagerdutyevents", Recipient: "my-service"}))
+	assert.Equal(t, map[string]any{"routing_key": "my-key", "event_action": "resolve", "dedup_key": "argocd/guestbook:health-degraded"}, event)
+
+	err := svc.Send(Notification{}, services.Destination{Service: "pagerdutyevents", Recipient: "my-service"})
+	require.ErrorContains(t, err, "no pagerdutyEvent template found for recipient my-service")
+}
@@ -0,0 +1,166 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/Masterminds/sprig/v3"
+	"github.com/argoproj/notifications-engine/pkg/services"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// serviceKeyPrefix is the prefix of the keys of the notifications ConfigMap configuring the Argo CD notification
+	// services, with the format argocd.service.<type>(.<name>)
+	serviceKeyPrefix = "argocd.service."
+	// templateKeyPrefix is the prefix of the keys of the notifications ConfigMap holding the templates
+	templateKeyPrefix = "template."
+)
+
+var funcMap = sprig.TxtFuncMap()
+
+func init() {
+	delete(funcMap, "env")
+	delete(funcMap, "expandenv")
+}
+
+// Notification holds the fields of the templates specific to the Argo CD notification services. The notifications
+// engine ignores these fields, so they can be set in the same templates as the fields of the engine services.
+type Notification struct {
+	TeamsAdaptiveCard *TeamsAdaptiveCardNotification `json:"teamsAdaptiveCard,omitempty"`
+	PagerDutyEvent    *PagerDutyEventNotification    `json:"pagerdutyEvent,omitempty"`
+}
+
+// NotificationService sends the notifications of an Argo CD notification service
+type NotificationService interface {
+	Send(notification Notification, dest services.Destination) error
+}
+
+// Config holds the Argo CD notification services and the templates of their notifications
+type Config struct {
+	Services  map[string]NotificationService
+	Templates map[string]Notification
+}
+
+// NewService returns the Argo CD notification service of the given type
+func NewService(serviceType string, optsData []byte, secretValues map[string]string) (NotificationService, error) {
+	switch serviceType {
+	case "teamsadaptivecard":
+		var opts TeamsAdaptiveCardOptions
+		if err := yaml.Unmarshal(optsData, &opts); err != nil {
+			return nil, err
+		}
+		return NewTeamsAdaptiveCardService(opts, secretValues), nil
+	case "pagerdutyevents":
+		var opts PagerDutyEventsOptions
+		if err := yaml.Unmarshal(optsData, &opts); err != nil {
+			return nil, err
+		}
+		return NewPagerDutyEventsService(opts, secretValues), nil
+	default:
+		return nil, fmt.Errorf("service type '%s' is not supported", serviceType)
+	}
+}
+
+// ParseConfig returns the Argo CD notification services and templates configured in the notifications ConfigMap
+func ParseConfig(cm *corev1.ConfigMap, secret *corev1.Secret) (*Config, error) {
+	secretValues := map[string]string{}
+	if secret != nil {
+		for k, v := range secret.Data {
+			secretValues[k] = string(v)
+		}
+	}
+	cfg := &Config{Services: map[string]NotificationService{}, Templates: map[string]Notification{}}
+	for k, v := range cm.Data {
+		switch {
+		case strings.HasPrefix(k, serviceKeyPrefix):
+			serviceType, name, ok := strings.Cut(strings.TrimPrefix(k, serviceKeyPrefix), ".")
+			if !ok {
+				name = serviceType
+			}
+			svc, err := NewService(serviceType, []byte(v), secretValues)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create service %s: %w", name, err)
+			}
+			cfg.Services[name] = svc
+		case strings.HasPrefix(k, templateKeyPrefix):
+			name := strings.TrimPrefix(k, templateKeyPrefix)
+			var notification Notification
+			if err := yaml.Unmarshal([]byte(v), &notification); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal template %s: %w", name, err)
+			}
+			if notification.TeamsAdaptiveCard != nil || notification.PagerDutyEvent != nil {
+				cfg.Templates[name] = notification
+			}
+		}
+	}
+	return cfg, nil
+}
+
+// FormatNotification renders the Argo CD notification service fields of the given templates
+func (c *Config) FormatNotification(vars map[string]any, templates ...string) (*Notification, error) {
+	var notification Notification
+	for _, name := range templates {
+		tmpl, ok := c.Templates[name]
+		if !ok {
+			continue
+		}
+		if tmpl.TeamsAdaptiveCard != nil {
+			card := *tmpl.TeamsAdaptiveCard
+			if err := card.render(name, vars); err != nil {
+				return nil, fmt.Errorf("error in '%s' teamsAdaptiveCard: %w", name, err)
+			}
+			notification.TeamsAdaptiveCard = &card
+		}
+		if tmpl.PagerDutyEvent != nil {
+			event := *tmpl.PagerDutyEvent
+			if err := event.render(name, vars); err != nil {
+				return nil, fmt.Errorf("error in '%s' pagerdutyEvent: %w", name, err)
+			}
+			notification.PagerDutyEvent = &event
+		}
+	}
+	return &notification, nil
+}
+
+// render renders in place each of the given template fields
+func render(name string, vars map[string]any, fields ...*string) error {
+	for _, field := range fields {
+		if *field == "" {
+			continue
+		}
+		tmpl, err := texttemplate.New(name).Funcs(funcMap).Parse(*field)
+		if err != nil {
+			return err
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, vars); err != nil {
+			return err
+		}
+		*field = buf.String()
+	}
+	return nil
+}
+
+// appURL returns the URL of the application of the notification in the Argo CD UI, if the argocdUrl context variable
+// is set
+func appURL(vars map[string]any) string {
+	context, _ := vars["context"].(map[string]string)
+	argocdURL := context["argocdUrl"]
+	namespace, name := appNamespaceAndName(vars)
+	if argocdURL == "" || name == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/applications/%s/%s", strings.TrimSuffix(argocdURL, "/"), namespace, name)
+}
+
+func appNamespaceAndName(vars map[string]any) (string, string) {
+	app, _ := vars["app"].(map[string]any)
+	metadata, _ := app["metadata"].(map[string]any)
+	namespace, _ := metadata["namespace"].(string)
+	name, _ := metadata["name"].(string)
+	return namespace, name
+}
@@ -0,0 +1,71 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestParseConfig(t *testing.T) {
+	cfg, err := ParseConfig(&corev1.ConfigMap{Data: map[string]string{
+		"argocd.service.teamsadaptivecard": `
+recipientUrls:
+  my-channel: $teams-webhook`,
+		"argocd.service.pagerdutyevents.pagerduty-prod": `
+serviceKeys:
+  my-service: my-key`,
+		"service.slack":             `token: my-token`,
+		"template.app-sync-failed":  `teamsAdaptiveCard: {title: "{{.app.metadata.name}} sync failed"}`,
+		"template.app-sync-running": `message: "{{.app.metadata.name}} sync is running"`,
+	}}, &corev1.Secret{Data: map[string][]byte{"teams-webhook": []byte("https://example.com/webhook")}})
+	require.NoError(t, err)
+
+	require.Contains(t, cfg.Services, "teamsadaptivecard")
+	assert.Equal(t, map[string]string{"my-channel": "https://example.com/webhook"}, cfg.Services["teamsadaptivecard"].(*teamsAdaptiveCardService).opts.RecipientURLs)
+	require.Contains(t, cfg.Services, "pagerduty-prod")
+	assert.Equal(t, defaultPagerDutyEventsURL, cfg.Services["pagerduty-prod"].(*pagerDutyEventsService).opts.URL)
+	assert.Len(t, cfg.Services, 2)
+
+	assert.Contains(t, cfg.Templates, "app-sync-failed")
+	assert.NotContains(t, cfg.Templates, "app-sync-running")
+
+	_, err = ParseConfig(&corev1.ConfigMap{Data: map[string]string{"argocd.service.unknown": "{}"}}, nil)
+	assert.ErrorContains(t, err, "service type 'unknown' is not supported")
+}
+
+func TestFormatNotification(t *testing.T) {
+	cfg := &Config{Templates: map[string]Notification{
+		"app-health-degraded": {
+			TeamsAdaptiveCard: &TeamsAdaptiveCardNotification{Title: "{{.app.metadata.name}} is degraded", Style: "attention"},
+			PagerDutyEvent:    &PagerDutyEventNotification{Condition: "health-degraded", Summary: "{{.app.metadata.name}} is degraded"},
+		},
+	}}
+	vars := map[string]any{
+		"app":     map[string]any{"metadata": map[string]any{"name": "guestbook", "namespace": "argocd"}},
+		"context": map[string]string{"argocdUrl": "https://argocd.example.com/"},
+	}
+
+	notification, err := cfg.FormatNotification(vars, "app-health-degraded", "unknown")
+	require.NoError(t, err)
+	assert.Equal(t, &TeamsAdaptiveCardNotification{
+		Title:   "guestbook is degraded",
+		Style:   "attention",
+		Actions: `[{"title":"Open guestbook","url":"https://argocd.example.com/applications/argocd/guestbook"}]`,
+	}, notification.TeamsAdaptiveCard)
+	assert.Equal(t, &PagerDutyEventNotification{
+		Condition: "health-degraded",
+		DedupKey:  "argocd/guestbook:health-degraded",
+		Summary:   "guestbook is degraded",
+		Source:    "guestbook",
+		URL:       "https://argocd.example.com/applications/argocd/guestbook",
+	}, notification.PagerDutyEvent)
+
+	// the templates are not modified by the rendering
+	assert.Equal(t, "{{.app.metadata.name}} is degraded", cfg.Templates["app-health-degraded"].PagerDutyEvent.Summary)
+
+	cfg.Templates["invalid"] = Notification{PagerDutyEvent: &PagerDutyEventNotification{Summary: "{{.app.metadata.name"}}
+	_, err = cfg.FormatNotification(vars, "invalid")
+	assert.ErrorContains(t, err, "error in 'invalid' pagerdutyEvent")
+}
@@ -0,0 +1,173 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/argoproj/notifications-engine/pkg/services"
+	httputil "github.com/argoproj/notifications-engine/pkg/util/http"
+	log "github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const adaptiveCardContentType = "application/vnd.microsoft.card.adaptive"
+
+// TeamsAdaptiveCardNotification holds the template of a Microsoft Teams Adaptive Card
+type TeamsAdaptiveCardNotification struct {
+	// Title is the title of the card
+	Title string `json:"title,omitempty"`
+	// Text is the text of the card, in Markdown
+	Text string `json:"text,omitempty"`
+	// Style is the color of the title: default, good, warning or attention
+	Style string `json:"style,omitempty"`
+	// Facts is a YAML or JSON list of the facts of the card, each with a title and a value
+	Facts string `json:"facts,omitempty"`
+	// Actions is a YAML or JSON list of the buttons of the card, each with a title and a url. Defaults to a button
+	// opening the application in the Argo CD UI.
+	Actions string `json:"actions,omitempty"`
+	// Card is a JSON Adaptive Card replacing the card built from the other fields
+	Card string `json:"card,omitempty"`
+}
+
+func (n *TeamsAdaptiveCardNotification) render(name string, vars map[string]any) error {
+	if err := render(name, vars, &n.Title, &n.Text, &n.Style, &n.Facts, &n.Actions, &n.Card); err != nil {
+		return err
+	}
+	if n.Actions == "" {
+		if url := appURL(vars); url != "" {
+			_, appName := appNamespaceAndName(vars)
+			actions, err := json.Marshal([]adaptiveCardAction{{Title: "Open " + appName, URL: url}})
+			if err != nil {
+				return err
+			}
+			n.Actions = string(actions)
+		}
+	}
+	return nil
+}
+
+type adaptiveCardFact struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+type adaptiveCardAction struct {
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+// card returns the Adaptive Card of the notification
+func (n *TeamsAdaptiveCardNotification) card() (json.RawMessage, error) {
+	if n.Card != "" {
+		if !json.Valid([]byte(n.Card)) {
+			return nil, fmt.Errorf("card is not valid JSON: %s", n.Card)
+		}
+		return json.RawMessage(n.Card), nil
+	}
+	var body []map[string]any
+	if n.Title != "" {
+		body = append(body, map[string]any{"type": "TextBlock", "text": n.Title, "size": "Large", "weight": "Bolder", "wrap": true, "color": adaptiveCardColor(n.Style)})
+	}
+	if n.Text != "" {
+		body = append(body, map[string]any{"type": "TextBlock", "text": n.Text, "wrap": true})
+	}
+	if n.Facts != "" {
+		var facts []adaptiveCardFact
+		if err := yaml.Unmarshal([]byte(n.Facts), &facts); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal facts: %w", err)
+		}
+		body = append(body, map[string]any{"type": "FactSet", "facts": facts})
+	}
+	card := map[string]any{
+		"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+		"type":    "AdaptiveCard",
+		"version": "1.4",
+		"body":    body,
+	}
+	if n.Actions != "" {
+		var actions []adaptiveCardAction
+		if err := yaml.Unmarshal([]byte(n.Actions), &actions); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal actions: %w", err)
+		}
+		cardActions := make([]map[string]any, len(actions))
+		for i, action := range actions {
+			cardActions[i] = map[string]any{"type": "Action.OpenUrl", "title": action.Title, "url": action.URL}
+		}
+		card["actions"] = cardActions
+	}
+	return json.Marshal(card)
+}
+
+func adaptiveCardColor(style string) string {
+	switch style {
+	case "good", "warning", "attention":
+		return style
+	default:
+		return "default"
+	}
+}
+
+// TeamsAdaptiveCardOptions holds the settings of the Microsoft Teams Adaptive Cards service
+type TeamsAdaptiveCardOptions struct {
+	// RecipientURLs maps the recipients to the URLs of their Teams workflow webhooks
+	RecipientURLs map[string]string `json:"recipientUrls"`
+	// InsecureSkipVerify disables the verification of the TLS certificate of the webhooks
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
+// NewTeamsAdaptiveCardService returns a service posting Adaptive Cards to Microsoft Teams workflow webhooks
+func NewTeamsAdaptiveCardService(opts TeamsAdaptiveCardOptions, secretValues map[string]string) NotificationService {
+	recipientURLs := make(map[string]string, len(opts.RecipientURLs))
+	for recipient, url := range opts.RecipientURLs {
+		recipientURLs[recipient] = settings.ReplaceStringSecret(url, secretValues)
+	}
+	opts.RecipientURLs = recipientURLs
+	return &teamsAdaptiveCardService{opts: opts}
+}
+
+type teamsAdaptiveCardService struct {
+	opts TeamsAdaptiveCardOptions
+}
+
+func (s *teamsAdaptiveCardService) Send(notification Notification, dest services.Destination) error {
+	webhookURL, ok := s.opts.RecipientURLs[dest.Recipient]
+	if !ok {
+		return fmt.Errorf("no teams webhook configured for recipient %s", dest.Recipient)
+	}
+	if notification.TeamsAdaptiveCard == nil {
+		return fmt.Errorf("no teamsAdaptiveCard template found for recipient %s", dest.Recipient)
+	}
+	card, err := notification.TeamsAdaptiveCard.card()
+	if err != nil {
+		return err
+	}
+	message, err := json.Marshal(map[string]any{
+		"type": "message",
+		"attachments": []map[string]any{{
+			"contentType": adaptiveCardContentType,
+			"content":     card,
+		}},
+	})
+	if err != nil {
+		return err
+	}
+
+	client := &http.Client{
+		Transport: httputil.NewLoggingRoundTripper(httputil.NewTransport(webhookURL, s.opts.InsecureSkipVerify), log.WithField("service", "teamsadaptivecard")),
+	}
+	response, err := client.Post(webhookURL, "application/json", bytes.NewReader(message))
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		body, _ := io.ReadAll(response.Body)
+		return fmt.Errorf("teams webhook returned status %d: %s", response.StatusCode, string(body))
+	}
+	return nil
+}
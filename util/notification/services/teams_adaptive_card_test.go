@@ -0,0 +1,64 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/argoproj/notifications-engine/pkg/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamsAdaptiveCardNotificationCard(t *testing.T) {
+	card, err := (&TeamsAdaptiveCardNotification{
+		Title:   "guestbook is degraded",
+		Style:   "attention",
+		Facts:   "- title: Revision\n  value: abc",
+		Actions: `[{"title":"Open guestbook","url":"https://argocd.example.com/applications/argocd/guestbook"}]`,
+	}).card()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+  "$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+  "type": "AdaptiveCard",
+  "version": "1.4",
+  "body": [
+    {"type": "TextBlock", "text": "guestbook is degraded", "size": "Large", "weight": "Bolder", "wrap": true, "color": "attention"},
+    {"type": "FactSet", "facts": [{"title": "Revision", "value": "abc"}]}
+  ],
+  "actions": [
+    {"type": "Action.OpenUrl", "title": "Open guestbook", "url": "https://argocd.example.com/applications/argocd/guestbook"}
+  ]
+}`, string(card))
+
+	card, err = (&TeamsAdaptiveCardNotification{Title: "ignored", Card: `{"type": "AdaptiveCard"}`}).card()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type": "AdaptiveCard"}`, string(card))
+
+	_, err = (&TeamsAdaptiveCardNotification{Card: `{"type": `}).card()
+	require.ErrorContains(t, err, "card is not valid JSON")
+}
+
+func TestTeamsAdaptiveCardServiceSend(t *testing.T) {
+	var message map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&message))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	svc := NewTeamsAdaptiveCardService(TeamsAdaptiveCardOptions{RecipientURLs: map[string]string{"my-channel": "$teams-webhook"}}, map[string]string{"teams-webhook": server.URL})
+	notification := Notification{TeamsAdaptiveCard: &TeamsAdaptiveCardNotification{Card: `{"type": "AdaptiveCard"}`}}
+	require.NoError(t, svc.Send(notification, services.Destination{Service: "teamsadaptivecard", Recipient: "my-channel"}))
+	assert.Equal(t, map[string]any{
+		"type": "message",
+		"attachments": []any{map[string]any{
+			"contentType": "application/vnd.microsoft.card.adaptive",
+			"content":     map[string]any{"type": "AdaptiveCard"},
+		}},
+	}, message)
+
+	err := svc.Send(notification, services.Destination{Service: "teamsadaptivecard", Recipient: "unknown"})
+	require.ErrorContains(t, err, "no teams webhook configured for recipient unknown")
+}
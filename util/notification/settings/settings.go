@@ -9,6 +9,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/yaml"
 
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
 	"github.com/argoproj/argo-cd/v3/util/notification/expression"
 
 	service "github.com/argoproj/argo-cd/v3/util/notification/argocd"
@@ -66,8 +67,8 @@ func initGetVarsWithoutSecret(argocdService service.Service, cfg *api.Config, co
 
 	return func(obj map[string]any, dest services.Destination) map[string]any {
 		return expression.Spawn(&unstructured.Unstructured{Object: obj}, argocdService, map[string]any{
-			"app":     obj,
-			"context": injectLegacyVar(context, dest.Service),
+			getResourceVarName(obj): obj,
+			"context":               injectLegacyVar(context, dest.Service),
 		})
 	}, nil
 }
@@ -80,9 +81,22 @@ func initGetVars(argocdService service.Service, cfg *api.Config, configMap *core
 
 	return func(obj map[string]any, dest services.Destination) map[string]any {
 		return expression.Spawn(&unstructured.Unstructured{Object: obj}, argocdService, map[string]any{
-			"app":     obj,
-			"context": injectLegacyVar(context, dest.Service),
-			"secrets": secret.Data,
+			getResourceVarName(obj): obj,
+			"context":               injectLegacyVar(context, dest.Service),
+			"secrets":               secret.Data,
 		})
 	}, nil
 }
+
+// getResourceVarName returns the name of the variable holding the resource of the notification: appset for the
+// ApplicationSets, project for the AppProjects and app otherwise
+func getResourceVarName(obj map[string]any) string {
+	switch obj["kind"] {
+	case application.ApplicationSetKind:
+		return "appset"
+	case application.AppProjectKind:
+		return "project"
+	default:
+		return "app"
+	}
+}
@@ -12,6 +12,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 
+	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient/mocks"
 	service "github.com/argoproj/argo-cd/v3/util/notification/argocd"
 )
@@ -59,7 +60,7 @@ func TestInitGetVars(t *testing.T) {
 			Data: notificationsSecret.Data,
 		})
 	mockRepoClient := &mocks.Clientset{RepoServerServiceClient: &mocks.RepoServerServiceClient{}}
-	argocdService, err := service.NewArgoCDService(kubeclientset, testNamespace, mockRepoClient)
+	argocdService, err := service.NewArgoCDService(kubeclientset, appclientset.NewSimpleClientset(), testNamespace, mockRepoClient)
 	require.NoError(t, err)
 	defer argocdService.Close()
 	config := api.Config{}
@@ -78,6 +79,17 @@ func TestInitGetVars(t *testing.T) {
 		assert.NotNil(t, t, result["app"])
 		assert.Equal(t, result["app"], appData)
 	})
+	t.Run("Vars provider serves ApplicationSet and AppProject data on appset and project keys", func(t *testing.T) {
+		appSetData := map[string]any{"kind": "ApplicationSet"}
+		result := varsProvider(appSetData, testDestination)
+		assert.Equal(t, appSetData, result["appset"])
+		assert.NotContains(t, result, "app")
+
+		projectData := map[string]any{"kind": "AppProject"}
+		result = varsProvider(projectData, testDestination)
+		assert.Equal(t, projectData, result["project"])
+		assert.NotContains(t, result, "app")
+	})
 	t.Run("Vars provider serves notification context data on context key", func(t *testing.T) {
 		expectedContext := map[string]string{
 			testContextKey:     testContextKeyValue,
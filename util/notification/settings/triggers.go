@@ -0,0 +1,60 @@
+package settings
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
+)
+
+const triggerKeyPrefix = "trigger."
+
+// ResourceCondition is a triggers.Condition with the kind of the resources the trigger is evaluated for. The
+// notifications engine ignores the resource field.
+type ResourceCondition struct {
+	OncePer     string   `json:"oncePer,omitempty"`
+	When        string   `json:"when,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Send        []string `json:"send,omitempty"`
+	// Resource is the kind of the resources the trigger is evaluated for: Application, ApplicationSet or AppProject.
+	// Defaults to Application.
+	Resource string `json:"resource,omitempty"`
+}
+
+// GetTriggerResources returns the kind of the resources each trigger of the notifications ConfigMap is evaluated for
+func GetTriggerResources(cm *corev1.ConfigMap) (map[string]string, error) {
+	resources := map[string]string{}
+	for k, v := range cm.Data {
+		if !strings.HasPrefix(k, triggerKeyPrefix) {
+			continue
+		}
+		name := strings.TrimPrefix(k, triggerKeyPrefix)
+		var conditions []ResourceCondition
+		if err := yaml.Unmarshal([]byte(v), &conditions); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal trigger %s: %w", name, err)
+		}
+		var resource string
+		for _, condition := range conditions {
+			if condition.Resource == "" {
+				continue
+			}
+			switch condition.Resource {
+			case application.ApplicationKind, application.ApplicationSetKind, application.AppProjectKind:
+			default:
+				return nil, fmt.Errorf("trigger %s has an unknown resource '%s', must be one of: %s, %s, %s", name, condition.Resource, application.ApplicationKind, application.ApplicationSetKind, application.AppProjectKind)
+			}
+			if resource != "" && resource != condition.Resource {
+				return nil, fmt.Errorf("the conditions of trigger %s have different resources", name)
+			}
+			resource = condition.Resource
+		}
+		if resource == "" {
+			resource = application.ApplicationKind
+		}
+		resources[name] = resource
+	}
+	return resources, nil
+}
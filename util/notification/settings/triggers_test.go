@@ -0,0 +1,34 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestGetTriggerResources(t *testing.T) {
+	resources, err := GetTriggerResources(&corev1.ConfigMap{Data: map[string]string{
+		"trigger.on-created":                 "- when: 'true'\n  send: [app-created]",
+		"trigger.on-appset-generation-error": "- when: 'true'\n  send: [appset-generation-error]\n  resource: ApplicationSet",
+		"trigger.on-project-window-opened":   "- when: 'true'\n  send: [project-window-opened]\n  resource: AppProject",
+		"template.app-created":               "message: created",
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"on-created":                 "Application",
+		"on-appset-generation-error": "ApplicationSet",
+		"on-project-window-opened":   "AppProject",
+	}, resources)
+
+	_, err = GetTriggerResources(&corev1.ConfigMap{Data: map[string]string{
+		"trigger.on-created": "- when: 'true'\n  send: [app-created]\n  resource: Cluster",
+	}})
+	require.ErrorContains(t, err, "trigger on-created has an unknown resource 'Cluster'")
+
+	_, err = GetTriggerResources(&corev1.ConfigMap{Data: map[string]string{
+		"trigger.on-created": "- when: 'true'\n  send: [app-created]\n  resource: AppProject\n- when: 'true'\n  send: [app-created]\n  resource: ApplicationSet",
+	}})
+	require.ErrorContains(t, err, "the conditions of trigger on-created have different resources")
+}
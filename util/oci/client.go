@@ -0,0 +1,298 @@
+package oci
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	log "github.com/sirupsen/logrus"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+	"oras.land/oras-go/v2/registry/remote/credentials"
+
+	"github.com/argoproj/argo-cd/v3/util/helm"
+	argoio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/io/files"
+	"github.com/argoproj/argo-cd/v3/util/proxy"
+)
+
+const (
+	// Prefix is the scheme of the repository URLs of the OCI sources, e.g. oci://ghcr.io/my-org/my-manifests
+	Prefix = "oci://"
+	// DefaultTag is the tag pulled when the revision is empty
+	DefaultTag = "latest"
+	// maxManifestSize is the maximum size of the OCI manifests
+	maxManifestSize = 4 * 1024 * 1024
+	// DefaultMaxExtractedSize is the default maximum size of the extracted content of an artifact
+	DefaultMaxExtractedSize = 1024 * 1024 * 1024
+	// annotationUnpack marks the layers pushed by oras from a directory, which are tarballs to extract
+	annotationUnpack = "io.deis.oras.content.unpack"
+)
+
+// Client pulls the manifests bundles and Kustomize bases published to an OCI repository.
+type Client interface {
+	// ResolveRevision returns the digest of the artifact referenced by the revision, which is either a tag or a digest.
+	ResolveRevision(ctx context.Context, revision string) (string, error)
+	// Extract pulls the artifact with the digest and extracts its layers in a temporary directory, which is removed by
+	// the returned closer.
+	Extract(ctx context.Context, digest string) (string, argoio.Closer, error)
+	// Annotations returns the annotations of the manifest of the artifact with the digest.
+	Annotations(ctx context.Context, digest string) (map[string]string, error)
+	// TestRepository checks that the repository is accessible.
+	TestRepository(ctx context.Context) error
+}
+
+// ClientOpts configures the client.
+type ClientOpts func(c *nativeOCIClient)
+
+// WithMaxExtractedSize limits the size of the extracted content of the artifacts, which defaults to
+// DefaultMaxExtractedSize.
+func WithMaxExtractedSize(size int64) ClientOpts {
+	return func(c *nativeOCIClient) {
+		c.maxExtractedSize = size
+	}
+}
+
+// WithSignatureVerifier verifies the cosign signatures of the artifacts before extracting them.
+func WithSignatureVerifier(verifier *SignatureVerifier) ClientOpts {
+	return func(c *nativeOCIClient) {
+		c.verifier = verifier
+	}
+}
+
+type nativeOCIClient struct {
+	repoURL          string
+	repo             *remote.Repository
+	maxExtractedSize int64
+	verifier         *SignatureVerifier
+}
+
+var _ Client = &nativeOCIClient{}
+
+// IsOCIRepo returns whether the repository URL references an OCI repository.
+func IsOCIRepo(repoURL string) bool {
+	return strings.HasPrefix(repoURL, Prefix)
+}
+
+// NewClient returns a client of the OCI repository, e.g. oci://ghcr.io/my-org/my-manifests, authenticated with the
+// credentials, or with the Docker credentials of the environment if the credentials are empty.
+func NewClient(repoURL string, creds helm.Creds, proxyURL string, noProxy string, opts ...ClientOpts) (Client, error) {
+	if !IsOCIRepo(repoURL) {
+		return nil, fmt.Errorf("the OCI repository URL %s must start with %s", repoURL, Prefix)
+	}
+	reference := strings.TrimSuffix(strings.TrimPrefix(repoURL, Prefix), "/")
+	repo, err := remote.NewRepository(reference)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize repository: %w", err)
+	}
+	tlsConf, err := helm.NewTLSConfig(creds)
+	if err != nil {
+		return nil, fmt.Errorf("failed setup tlsConfig: %w", err)
+	}
+	password, err := creds.GetPassword()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get password for OCI repository: %w", err)
+	}
+	credential := auth.StaticCredential(repo.Reference.Registry, auth.Credential{
+		Username: creds.GetUsername(),
+		Password: password,
+	})
+	// Try to fallback to the environment config, but we shouldn't error if the file is not set
+	if creds.GetUsername() == "" && password == "" {
+		store, _ := credentials.NewStoreFromDocker(credentials.StoreOptions{})
+		if store != nil {
+			credential = credentials.Credential(store)
+		}
+	}
+	repo.Client = &auth.Client{
+		Client: &http.Client{Transport: &http.Transport{
+			Proxy:           proxy.GetCallback(proxyURL, noProxy),
+			TLSClientConfig: tlsConf,
+		}},
+		Credential: credential,
+	}
+
+	c := &nativeOCIClient{repoURL: repoURL, repo: repo, maxExtractedSize: DefaultMaxExtractedSize}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c, nil
+}
+
+// IsDigest returns whether the revision is the digest of an artifact, e.g. sha256:<hex>.
+func IsDigest(revision string) bool {
+	_, err := digest.Parse(revision)
+	return err == nil
+}
+
+func (c *nativeOCIClient) ResolveRevision(ctx context.Context, revision string) (string, error) {
+	if revision == "" || revision == "HEAD" {
+		revision = DefaultTag
+	}
+	desc, err := c.repo.Resolve(ctx, revision)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve revision %s of %s: %w", revision, c.repoURL, err)
+	}
+	return desc.Digest.String(), nil
+}
+
+func (c *nativeOCIClient) TestRepository(ctx context.Context) error {
+	return c.repo.Tags(ctx, "", func(_ []string) error {
+		return nil
+	})
+}
+
+func (c *nativeOCIClient) Annotations(ctx context.Context, revision string) (map[string]string, error) {
+	if !IsDigest(revision) {
+		return nil, fmt.Errorf("the revision %s must be resolved to a digest", revision)
+	}
+	manifest, err := c.fetchManifest(ctx, revision)
+	if err != nil {
+		return nil, err
+	}
+	return manifest.Annotations, nil
+}
+
+func (c *nativeOCIClient) Extract(ctx context.Context, revision string) (string, argoio.Closer, error) {
+	if !IsDigest(revision) {
+		return "", nil, fmt.Errorf("the revision %s must be resolved to a digest", revision)
+	}
+	manifest, err := c.fetchManifest(ctx, revision)
+	if err != nil {
+		return "", nil, err
+	}
+	if c.verifier != nil {
+		if err := c.verifier.Verify(ctx, c.repo, revision); err != nil {
+			return "", nil, fmt.Errorf("failed to verify the signature of %s@%s: %w", c.repoURL, revision, err)
+		}
+	}
+
+	dir, err := files.CreateTempDir("")
+	if err != nil {
+		return "", nil, fmt.Errorf("error creating temporary directory: %w", err)
+	}
+	closer := argoio.NewCloser(func() error {
+		return os.RemoveAll(dir)
+	})
+	remaining := c.maxExtractedSize
+	for _, layer := range manifest.Layers {
+		size, err := c.extractLayer(ctx, dir, layer, remaining)
+		if err != nil {
+			argoio.Close(closer)
+			return "", nil, fmt.Errorf("failed to extract layer %s of %s@%s: %w", layer.Digest, c.repoURL, revision, err)
+		}
+		remaining -= size
+	}
+	log.WithFields(log.Fields{"repo": c.repoURL, "digest": revision, "layers": len(manifest.Layers)}).Debug("Extracted OCI artifact")
+	return dir, closer, nil
+}
+
+func (c *nativeOCIClient) fetchManifest(ctx context.Context, revision string) (*ocispec.Manifest, error) {
+	desc, rc, err := c.repo.FetchReference(ctx, revision)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the manifest of %s@%s: %w", c.repoURL, revision, err)
+	}
+	defer rc.Close()
+	if desc.MediaType != ocispec.MediaTypeImageManifest {
+		return nil, fmt.Errorf("unsupported media type %s of %s@%s, only the OCI image manifests are supported", desc.MediaType, c.repoURL, revision)
+	}
+	if desc.Size > maxManifestSize {
+		return nil, fmt.Errorf("the manifest of %s@%s exceeds the maximum size of %d bytes", c.repoURL, revision, maxManifestSize)
+	}
+	data, err := content.ReadAll(rc, desc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the manifest of %s@%s: %w", c.repoURL, revision, err)
+	}
+	var manifest ocispec.Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse the manifest of %s@%s: %w", c.repoURL, revision, err)
+	}
+	return &manifest, nil
+}
+
+// extractLayer extracts the layer in dir, and returns the size of the extracted content. The gzipped tarballs are
+// extracted, and the other layers are written to the file named by their title annotation.
+func (c *nativeOCIClient) extractLayer(ctx context.Context, dir string, layer ocispec.Descriptor, maxSize int64) (int64, error) {
+	if maxSize <= 0 || (!isTarball(layer) && layer.Size > maxSize) {
+		return 0, fmt.Errorf("the content exceeds the maximum extracted size of %d bytes", c.maxExtractedSize)
+	}
+	rc, err := c.repo.Fetch(ctx, layer)
+	if err != nil {
+		return 0, err
+	}
+	defer rc.Close()
+	reader := content.NewVerifyReader(rc, layer)
+
+	if isTarball(layer) {
+		gzr, err := gzip.NewReader(reader)
+		if err != nil {
+			return 0, fmt.Errorf("error reading the tarball: %w", err)
+		}
+		defer gzr.Close()
+		// the decompressed bytes are counted, so that the size of the extracted content is bounded whatever the
+		// compression ratio of the layers
+		counter := &countingReader{r: gzr}
+		err = files.Untar(dir, counter, maxSize, false)
+		if counter.n >= maxSize {
+			return 0, fmt.Errorf("the content exceeds the maximum extracted size of %d bytes", c.maxExtractedSize)
+		} else if err != nil {
+			return 0, err
+		}
+		// the padding of the tarball is left unread by Untar
+		if _, err := io.Copy(io.Discard, reader); err != nil {
+			return 0, err
+		}
+		return counter.n, reader.Verify()
+	}
+
+	title := layer.Annotations[ocispec.AnnotationTitle]
+	if title == "" {
+		return 0, fmt.Errorf("unsupported layer of media type %s without title", layer.MediaType)
+	}
+	path, err := securejoin.SecureJoin(dir, title)
+	if err != nil {
+		return 0, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return 0, err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	if _, err := io.Copy(f, reader); err != nil {
+		return 0, err
+	}
+	return layer.Size, reader.Verify()
+}
+
+// isTarball returns whether the layer is a gzipped tarball
+func isTarball(layer ocispec.Descriptor) bool {
+	return layer.Annotations[annotationUnpack] == "true" ||
+		strings.HasSuffix(layer.MediaType, "tar+gzip") ||
+		strings.HasSuffix(layer.MediaType, ".tgz") ||
+		strings.HasSuffix(layer.MediaType, "tar.gzip")
+}
+
+// countingReader counts the bytes read from the underlying reader
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,309 @@
+package oci
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/util/helm"
+)
+
+// fakeRegistry serves the manifests and blobs of a single repository
+type fakeRegistry struct {
+	blobs     map[string][]byte
+	manifests map[string]ocispec.Descriptor
+}
+
+func newFakeRegistry() *fakeRegistry {
+	return &fakeRegistry{blobs: map[string][]byte{}, manifests: map[string]ocispec.Descriptor{}}
+}
+
+func (r *fakeRegistry) addBlob(mediaType string, data []byte, annotations map[string]string) ocispec.Descriptor {
+	d := digest.FromBytes(data)
+	r.blobs[d.String()] = data
+	return ocispec.Descriptor{MediaType: mediaType, Digest: d, Size: int64(len(data)), Annotations: annotations}
+}
+
+func (r *fakeRegistry) addManifest(t *testing.T, tag string, mediaType string, layers ...ocispec.Descriptor) digest.Digest {
+	t.Helper()
+	config := r.addBlob(ocispec.MediaTypeEmptyJSON, []byte("{}"), nil)
+	data, err := json.Marshal(ocispec.Manifest{MediaType: mediaType, Config: config, Layers: layers})
+	require.NoError(t, err)
+	desc := r.addBlob(mediaType, data, nil)
+	r.manifests[desc.Digest.String()] = desc
+	if tag != "" {
+		r.manifests[tag] = desc
+	}
+	return desc.Digest
+}
+
+func (r *fakeRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	path := strings.TrimPrefix(req.URL.Path, "/v2/my-org/my-manifests/")
+	switch {
+	case req.URL.Path == "/v2/":
+		w.WriteHeader(http.StatusOK)
+	case path == "tags/list":
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name":"my-org/my-manifests","tags":["latest"]}`))
+	case strings.HasPrefix(path, "manifests/"):
+		desc, ok := r.manifests[strings.TrimPrefix(path, "manifests/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		r.serveBlob(w, req, desc)
+	case strings.HasPrefix(path, "blobs/"):
+		d := strings.TrimPrefix(path, "blobs/")
+		if _, ok := r.blobs[d]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		r.serveBlob(w, req, ocispec.Descriptor{MediaType: "application/octet-stream", Digest: digest.Digest(d)})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (r *fakeRegistry) serveBlob(w http.ResponseWriter, req *http.Request, desc ocispec.Descriptor) {
+	data := r.blobs[desc.Digest.String()]
+	w.Header().Set("Content-Type", desc.MediaType)
+	w.Header().Set("Docker-Content-Digest", desc.Digest.String())
+	w.Header().Set("Content-Length", fmt.Sprint(len(data)))
+	if req.Method == http.MethodHead {
+		return
+	}
+	_, _ = w.Write(data)
+}
+
+func newTestClient(t *testing.T, registry *fakeRegistry, opts ...ClientOpts) Client {
+	t.Helper()
+	server := httptest.NewTLSServer(registry)
+	t.Cleanup(server.Close)
+	client, err := NewClient(Prefix+strings.TrimPrefix(server.URL, "https://")+"/my-org/my-manifests", helm.HelmCreds{InsecureSkipVerify: true}, "", "", opts...)
+	require.NoError(t, err)
+	return client
+}
+
+func tgz(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gzw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gzw)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gzw.Close())
+	return buf.Bytes()
+}
+
+func TestNewClient_InvalidURL(t *testing.T) {
+	_, err := NewClient("https://ghcr.io/my-org/my-manifests", helm.HelmCreds{}, "", "")
+	assert.ErrorContains(t, err, "must start with oci://")
+}
+
+func TestIsDigest(t *testing.T) {
+	assert.True(t, IsDigest(digest.FromString("foo").String()))
+	assert.False(t, IsDigest("1.0.0"))
+	assert.False(t, IsDigest("sha256:foo"))
+}
+
+func TestResolveRevision(t *testing.T) {
+	registry := newFakeRegistry()
+	layer := registry.addBlob("application/yaml", []byte("kind: ConfigMap"), map[string]string{ocispec.AnnotationTitle: "cm.yaml"})
+	latest := registry.addManifest(t, "latest", ocispec.MediaTypeImageManifest, layer)
+	v1 := registry.addManifest(t, "1.0.0", ocispec.MediaTypeImageManifest)
+	client := newTestClient(t, registry)
+
+	for revision, expected := range map[string]digest.Digest{"": latest, "HEAD": latest, "latest": latest, "1.0.0": v1, v1.String(): v1} {
+		resolved, err := client.ResolveRevision(t.Context(), revision)
+		require.NoError(t, err)
+		assert.Equal(t, expected.String(), resolved, "revision %q", revision)
+	}
+
+	_, err := client.ResolveRevision(t.Context(), "2.0.0")
+	assert.ErrorContains(t, err, "failed to resolve revision 2.0.0")
+}
+
+func TestTestRepository(t *testing.T) {
+	client := newTestClient(t, newFakeRegistry())
+	assert.NoError(t, client.TestRepository(t.Context()))
+}
+
+func TestExtract(t *testing.T) {
+	registry := newFakeRegistry()
+	raw := registry.addBlob("application/yaml", []byte("kind: ConfigMap"), map[string]string{ocispec.AnnotationTitle: "manifests/cm.yaml"})
+	base := registry.addBlob(ocispec.MediaTypeImageLayerGzip, tgz(t, map[string]string{"base/kustomization.yaml": "resources: [cm.yaml]"}), nil)
+	d := registry.addManifest(t, "latest", ocispec.MediaTypeImageManifest, raw, base)
+	index := registry.addManifest(t, "index", ocispec.MediaTypeImageIndex)
+	client := newTestClient(t, registry)
+
+	t.Run("Layers", func(t *testing.T) {
+		dir, closer, err := client.Extract(t.Context(), d.String())
+		require.NoError(t, err)
+		data, err := os.ReadFile(filepath.Join(dir, "manifests", "cm.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, "kind: ConfigMap", string(data))
+		data, err = os.ReadFile(filepath.Join(dir, "base", "kustomization.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, "resources: [cm.yaml]", string(data))
+
+		require.NoError(t, closer.Close())
+		assert.NoDirExists(t, dir)
+	})
+
+	t.Run("Tag", func(t *testing.T) {
+		_, _, err := client.Extract(t.Context(), "latest")
+		assert.ErrorContains(t, err, "must be resolved to a digest")
+	})
+
+	t.Run("Index", func(t *testing.T) {
+		_, _, err := client.Extract(t.Context(), index.String())
+		assert.ErrorContains(t, err, "only the OCI image manifests are supported")
+	})
+
+	t.Run("MaxExtractedSize", func(t *testing.T) {
+		client := newTestClient(t, registry, WithMaxExtractedSize(10))
+		_, _, err := client.Extract(t.Context(), d.String())
+		assert.ErrorContains(t, err, "exceeds the maximum extracted size")
+	})
+}
+
+func TestExtract_MaxExtractedSizeCompressed(t *testing.T) {
+	registry := newFakeRegistry()
+	content := strings.Repeat("a", 100*1024)
+	first := registry.addBlob(ocispec.MediaTypeImageLayerGzip, tgz(t, map[string]string{"first.yaml": content}), nil)
+	second := registry.addBlob(ocispec.MediaTypeImageLayerGzip, tgz(t, map[string]string{"second.yaml": content}), nil)
+	d := registry.addManifest(t, "", ocispec.MediaTypeImageManifest, first, second)
+
+	// the layers are much smaller than the maximum size once compressed, but not their content
+	client := newTestClient(t, registry, WithMaxExtractedSize(150*1024))
+	_, _, err := client.Extract(t.Context(), d.String())
+	require.ErrorContains(t, err, "exceeds the maximum extracted size")
+
+	client = newTestClient(t, registry, WithMaxExtractedSize(250*1024))
+	_, closer, err := client.Extract(t.Context(), d.String())
+	require.NoError(t, err)
+	require.NoError(t, closer.Close())
+}
+
+func TestExtract_WithoutTitle(t *testing.T) {
+	registry := newFakeRegistry()
+	layer := registry.addBlob("application/yaml", []byte("kind: ConfigMap"), nil)
+	d := registry.addManifest(t, "", ocispec.MediaTypeImageManifest, layer)
+	client := newTestClient(t, registry)
+
+	_, _, err := client.Extract(t.Context(), d.String())
+	assert.ErrorContains(t, err, "without title")
+}
+
+func TestExtract_OutOfBoundsTitle(t *testing.T) {
+	registry := newFakeRegistry()
+	layer := registry.addBlob("application/yaml", []byte("kind: ConfigMap"), map[string]string{ocispec.AnnotationTitle: "../../cm.yaml"})
+	d := registry.addManifest(t, "", ocispec.MediaTypeImageManifest, layer)
+	client := newTestClient(t, registry)
+
+	dir, closer, err := client.Extract(t.Context(), d.String())
+	require.NoError(t, err)
+	defer closer.Close()
+	assert.FileExists(t, filepath.Join(dir, "cm.yaml"))
+}
+
+func generateKey(t *testing.T) (*ecdsa.PrivateKey, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(t, err)
+	return key, string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func sign(t *testing.T, registry *fakeRegistry, key crypto.Signer, signed digest.Digest) {
+	t.Helper()
+	payload := []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"my-org/my-manifests"},"image":{"docker-manifest-digest":"%s"},"type":"cosign container image signature"}}`, signed))
+	hash := sha256.Sum256(payload)
+	signature, err := key.Sign(rand.Reader, hash[:], crypto.SHA256)
+	require.NoError(t, err)
+	layer := registry.addBlob(mediaTypeCosignSimpleSigning, payload, map[string]string{annotationCosignSignature: base64.StdEncoding.EncodeToString(signature)})
+	registry.addManifest(t, signatureTag(signed), ocispec.MediaTypeImageManifest, layer)
+}
+
+func TestNewSignatureVerifier(t *testing.T) {
+	_, key1 := generateKey(t)
+	_, key2 := generateKey(t)
+
+	verifier, err := NewSignatureVerifier(key1 + key2)
+	require.NoError(t, err)
+	assert.Len(t, verifier.keys, 2)
+
+	verifier, err = NewSignatureVerifier("")
+	require.NoError(t, err)
+	assert.Nil(t, verifier)
+
+	_, err = NewSignatureVerifier("foo")
+	assert.ErrorContains(t, err, "invalid PEM data")
+}
+
+func TestExtract_Signature(t *testing.T) {
+	key, publicKey := generateKey(t)
+	otherKey, otherPublicKey := generateKey(t)
+	verifier, err := NewSignatureVerifier(publicKey)
+	require.NoError(t, err)
+
+	registry := newFakeRegistry()
+	layer := registry.addBlob("application/yaml", []byte("kind: ConfigMap"), map[string]string{ocispec.AnnotationTitle: "cm.yaml"})
+	signed := registry.addManifest(t, "signed", ocispec.MediaTypeImageManifest, layer)
+	sign(t, registry, key, signed)
+	unsigned := registry.addManifest(t, "unsigned", ocispec.MediaTypeImageManifest,
+		registry.addBlob("application/yaml", []byte("kind: Secret"), map[string]string{ocispec.AnnotationTitle: "secret.yaml"}))
+	otherSigned := registry.addManifest(t, "other", ocispec.MediaTypeImageManifest,
+		registry.addBlob("application/yaml", []byte("kind: Service"), map[string]string{ocispec.AnnotationTitle: "svc.yaml"}))
+	sign(t, registry, otherKey, otherSigned)
+	client := newTestClient(t, registry, WithSignatureVerifier(verifier))
+
+	t.Run("Signed", func(t *testing.T) {
+		_, closer, err := client.Extract(t.Context(), signed.String())
+		require.NoError(t, err)
+		require.NoError(t, closer.Close())
+	})
+
+	t.Run("Unsigned", func(t *testing.T) {
+		_, _, err := client.Extract(t.Context(), unsigned.String())
+		assert.ErrorContains(t, err, "failed to fetch the signatures")
+	})
+
+	t.Run("OtherKey", func(t *testing.T) {
+		_, _, err := client.Extract(t.Context(), otherSigned.String())
+		assert.ErrorContains(t, err, "no signature made with the cosign public keys")
+
+		verifier, err := NewSignatureVerifier(publicKey + otherPublicKey)
+		require.NoError(t, err)
+		client := newTestClient(t, registry, WithSignatureVerifier(verifier))
+		_, closer, err := client.Extract(t.Context(), otherSigned.String())
+		require.NoError(t, err)
+		require.NoError(t, closer.Close())
+	})
+}
@@ -0,0 +1,163 @@
+package oci
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/registry/remote"
+)
+
+const (
+	// mediaTypeCosignSimpleSigning is the media type of the layers of the cosign signatures
+	mediaTypeCosignSimpleSigning = "application/vnd.dev.cosign.simplesigning.v1+json"
+	// annotationCosignSignature holds the base64 encoded signature of the payload of the layer
+	annotationCosignSignature = "dev.cosignproject.cosign/signature"
+	// maxSignaturePayloadSize is the maximum size of the payloads of the signatures
+	maxSignaturePayloadSize = 1024 * 1024
+)
+
+// SignatureVerifier verifies the cosign signatures of the OCI artifacts with public keys. An artifact is verified if
+// one of its signatures was made with one of the keys.
+type SignatureVerifier struct {
+	keys []crypto.PublicKey
+}
+
+// NewSignatureVerifier returns a verifier of the signatures made with the PEM encoded public keys. The keys may be
+// concatenated. It returns nil if there is no key.
+func NewSignatureVerifier(data string) (*SignatureVerifier, error) {
+	var keys []crypto.PublicKey
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the cosign public key: %w", err)
+		}
+		keys = append(keys, key)
+	}
+	if strings.TrimSpace(string(rest)) != "" {
+		return nil, errors.New("failed to parse the cosign public keys: invalid PEM data")
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	return &SignatureVerifier{keys: keys}, nil
+}
+
+// simpleSigningPayload is the payload signed by cosign
+type simpleSigningPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// signatureTag returns the tag of the cosign signatures of the artifact, e.g. sha256-<hex>.sig
+func signatureTag(d digest.Digest) string {
+	return fmt.Sprintf("%s-%s.sig", d.Algorithm(), d.Encoded())
+}
+
+// Verify checks that the artifact with the digest was signed with one of the public keys of the verifier.
+func (v *SignatureVerifier) Verify(ctx context.Context, repo *remote.Repository, revision string) error {
+	d, err := digest.Parse(revision)
+	if err != nil {
+		return err
+	}
+	desc, rc, err := repo.FetchReference(ctx, signatureTag(d))
+	if err != nil {
+		return fmt.Errorf("failed to fetch the signatures: %w", err)
+	}
+	defer rc.Close()
+	if desc.Size > maxManifestSize {
+		return fmt.Errorf("the signatures exceed the maximum size of %d bytes", maxManifestSize)
+	}
+	data, err := content.ReadAll(rc, desc)
+	if err != nil {
+		return fmt.Errorf("failed to read the signatures: %w", err)
+	}
+	var manifest ocispec.Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return fmt.Errorf("failed to parse the signatures: %w", err)
+	}
+
+	for _, layer := range manifest.Layers {
+		if layer.MediaType != mediaTypeCosignSimpleSigning || layer.Size > maxSignaturePayloadSize {
+			continue
+		}
+		signature, err := base64.StdEncoding.DecodeString(layer.Annotations[annotationCosignSignature])
+		if err != nil || len(signature) == 0 {
+			continue
+		}
+		payload, err := fetchPayload(ctx, repo, layer)
+		if err != nil {
+			return err
+		}
+		if !v.verifySignature(payload, signature) {
+			continue
+		}
+		var signed simpleSigningPayload
+		if err := json.Unmarshal(payload, &signed); err != nil {
+			continue
+		}
+		if signed.Critical.Image.DockerManifestDigest == d.String() {
+			return nil
+		}
+	}
+	return errors.New("no signature made with the cosign public keys")
+}
+
+func fetchPayload(ctx context.Context, repo *remote.Repository, layer ocispec.Descriptor) ([]byte, error) {
+	rc, err := repo.Fetch(ctx, layer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the signature payload: %w", err)
+	}
+	defer rc.Close()
+	payload, err := content.ReadAll(io.LimitReader(rc, layer.Size), layer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the signature payload: %w", err)
+	}
+	return payload, nil
+}
+
+// verifySignature returns whether the signature of the payload was made with one of the keys
+func (v *SignatureVerifier) verifySignature(payload, signature []byte) bool {
+	hash := sha256.Sum256(payload)
+	for _, key := range v.keys {
+		switch k := key.(type) {
+		case *ecdsa.PublicKey:
+			if ecdsa.VerifyASN1(k, hash[:], signature) {
+				return true
+			}
+		case *rsa.PublicKey:
+			if rsa.VerifyPKCS1v15(k, crypto.SHA256, hash[:], signature) == nil ||
+				rsa.VerifyPSS(k, crypto.SHA256, hash[:], signature, nil) == nil {
+				return true
+			}
+		case ed25519.PublicKey:
+			if ed25519.Verify(k, payload, signature) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -43,6 +43,9 @@ const (
 	ResponseTypeCode            = "code"
 	UserInfoResponseCachePrefix = "userinfo_response"
 	AccessTokenCachePrefix      = "access_token"
+	// providerStateSeparator separates the name of the additional OIDC provider a login flow is performed with from the
+	// random part of the state nonce. It is not part of the charset of the random part.
+	providerStateSeparator = "."
 )
 
 // OIDCConfiguration holds a subset of interested fields from the OIDC configuration spec
@@ -87,6 +90,11 @@ type ClientApp struct {
 	clientCache cache.CacheClient
 	// properties for azure workload identity.
 	azure azureApp
+	// additionalConfig is the config of the additional OIDC provider of the app, nil for the app of the provider
+	// configured by oidc.config or dex.config
+	additionalConfig *settings.OIDCConfig
+	// additionalApps are the apps of the additional OIDC providers, by provider name
+	additionalApps map[string]*ClientApp
 }
 
 type azureApp struct {
@@ -162,9 +170,69 @@ func NewClientApp(settings *settings.ArgoCDSettings, dexServerAddr string, dexTL
 	// NOTE: if we ever have replicas of Argo CD, this needs to switch to Redis cache
 	a.secureCookie = bool(u.Scheme == "https")
 	a.settings = settings
+
+	a.additionalApps = map[string]*ClientApp{}
+	for _, config := range settings.AdditionalOIDCConfigs() {
+		a.additionalApps[config.Name] = a.newAdditionalClientApp(config)
+	}
 	return &a, nil
 }
 
+// newAdditionalClientApp returns the client app of the given additional OIDC provider, which shares the redirect URI,
+// the encryption key and the cache of the main app
+func (a *ClientApp) newAdditionalClientApp(config *settings.OIDCConfig) *ClientApp {
+	log.Infof("Creating client app (%s) of OIDC provider %s", config.ClientID, config.Name)
+	transport := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		Dial: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).Dial,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+		TLSClientConfig:       a.settings.AdditionalOIDCTLSConfig(config),
+	}
+	client := &http.Client{Transport: transport}
+	if os.Getenv(common.EnvVarSSODebug) == "1" {
+		client.Transport = httputil.DebugTransport{T: client.Transport}
+	}
+	return &ClientApp{
+		clientID:                 config.ClientID,
+		clientSecret:             config.ClientSecret,
+		useAzureWorkloadIdentity: config.Azure != nil && config.Azure.UseWorkloadIdentity,
+		redirectURI:              a.redirectURI,
+		issuerURL:                config.Issuer,
+		userInfoPath:             config.UserInfoPath,
+		baseHRef:                 a.baseHRef,
+		client:                   client,
+		secureCookie:             a.secureCookie,
+		settings:                 a.settings,
+		encryptionKey:            a.encryptionKey,
+		provider:                 NewOIDCProvider(config.Issuer, client),
+		clientCache:              a.clientCache,
+		azure:                    azureApp{mtx: &sync.RWMutex{}},
+		additionalConfig:         config,
+	}
+}
+
+// oidcConfig returns the config of the additional OIDC provider of the app, or the one of oidc.config for the main app
+func (a *ClientApp) oidcConfig() *settings.OIDCConfig {
+	if a.additionalConfig != nil {
+		return a.additionalConfig
+	}
+	return a.settings.OIDCConfig()
+}
+
+// additionalAppForState returns the app of the additional OIDC provider the login flow with the given state nonce was
+// initiated with, or nil if it was initiated with the main app
+func (a *ClientApp) additionalAppForState(state string) *ClientApp {
+	name, _, ok := strings.Cut(state, providerStateSeparator)
+	if !ok {
+		return nil
+	}
+	return a.additionalApps[name]
+}
+
 func (a *ClientApp) oauth2Config(request *http.Request, scopes []string) (*oauth2.Config, error) {
 	endpoint, err := a.provider.Endpoint()
 	if err != nil {
@@ -193,6 +261,9 @@ func (a *ClientApp) generateAppState(returnURL string, w http.ResponseWriter) (s
 	if err != nil {
 		return "", fmt.Errorf("failed to generate app state: %w", err)
 	}
+	if a.additionalConfig != nil {
+		randStr = a.additionalConfig.Name + providerStateSeparator + randStr
+	}
 	if returnURL == "" {
 		returnURL = a.baseHRef
 	}
@@ -304,8 +375,22 @@ func isValidRedirectURL(redirectURL string, allowedURLs []string) bool {
 }
 
 // HandleLogin formulates the proper OAuth2 URL (auth code or implicit) and redirects the user to
-// the IDp login & consent page
+// the IDp login & consent page. The login is performed with the additional OIDC provider named by the provider
+// parameter if it is set.
 func (a *ClientApp) HandleLogin(w http.ResponseWriter, r *http.Request) {
+	if name := r.FormValue("provider"); name != "" {
+		app, ok := a.additionalApps[name]
+		if !ok {
+			http.Error(w, fmt.Sprintf("Unknown OIDC provider %q", html.EscapeString(name)), http.StatusBadRequest)
+			return
+		}
+		app.handleLogin(w, r)
+		return
+	}
+	a.handleLogin(w, r)
+}
+
+func (a *ClientApp) handleLogin(w http.ResponseWriter, r *http.Request) {
 	oidcConf, err := a.provider.ParseConfig()
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -313,7 +398,7 @@ func (a *ClientApp) HandleLogin(w http.ResponseWriter, r *http.Request) {
 	}
 	scopes := make([]string, 0)
 	var opts []oauth2.AuthCodeOption
-	if config := a.settings.OIDCConfig(); config != nil {
+	if config := a.oidcConfig(); config != nil {
 		scopes = GetScopesOrDefault(config.RequestedScopes)
 		opts = AppendClaimsAuthenticationRequestParameter(opts, config.RequestedIDTokenClaims)
 	} else if a.settings.IsDexConfigured() {
@@ -395,8 +480,17 @@ func (a *azureApp) getFederatedServiceAccountToken(context.Context) (string, err
 	return a.assertion, nil
 }
 
-// HandleCallback is the callback handler for an OAuth2 login flow
+// HandleCallback is the callback handler for an OAuth2 login flow. The callback of a login flow initiated with an
+// additional OIDC provider is handled by the app of the provider.
 func (a *ClientApp) HandleCallback(w http.ResponseWriter, r *http.Request) {
+	if app := a.additionalAppForState(r.FormValue("state")); app != nil {
+		app.handleCallback(w, r)
+		return
+	}
+	a.handleCallback(w, r)
+}
+
+func (a *ClientApp) handleCallback(w http.ResponseWriter, r *http.Request) {
 	oauth2Config, err := a.oauth2Config(r, nil)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -449,6 +449,56 @@ requestedScopes: ["oidc"]`, oidcTestServer.URL),
 	assert.NotContains(t, w.Body.String(), ErrInvalidRedirectURL.Error())
 }
 
+func Test_Login_Flow_AdditionalProvider(t *testing.T) {
+	oidcTestServer := test.GetOIDCTestServer(t)
+	t.Cleanup(oidcTestServer.Close)
+
+	cdSettings := &settings.ArgoCDSettings{
+		URL: "https://argocd.example.com",
+		OIDCConfigRAW: `
+name: Corporate
+issuer: https://corporate.example.com
+clientID: xxx
+clientSecret: yyy`,
+		AdditionalOIDCConfigsRAW: fmt.Sprintf(`
+- name: customer
+  issuer: %s
+  clientID: customer-client
+  clientSecret: zzz`, oidcTestServer.URL),
+		OIDCTLSInsecureSkipVerify: true,
+	}
+	app, err := NewClientApp(cdSettings, "", nil, "/", cache.NewInMemoryCache(24*time.Hour))
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "https://argocd.example.com/auth/login?provider=unknown", nil)
+	app.HandleLogin(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), `Unknown OIDC provider "unknown"`)
+
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodGet, "https://argocd.example.com/auth/login?provider=customer", nil)
+	app.HandleLogin(w, req)
+	require.Equal(t, http.StatusSeeOther, w.Code)
+
+	redirectURL, err := w.Result().Location()
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(redirectURL.String(), oidcTestServer.URL))
+	assert.Equal(t, "customer-client", redirectURL.Query().Get("client_id"))
+	state := redirectURL.Query().Get("state")
+	assert.True(t, strings.HasPrefix(state, "customer."))
+
+	// the callback is handled by the app of the additional provider, which does not query the main provider
+	req = httptest.NewRequest(http.MethodGet, "https://argocd.example.com/auth/callback?state="+url.QueryEscape(state)+"&code=abc", nil)
+	for _, cookie := range w.Result().Cookies() {
+		req.AddCookie(cookie)
+	}
+	w = httptest.NewRecorder()
+	app.HandleCallback(w, req)
+	assert.NotContains(t, w.Body.String(), "corporate.example.com")
+	assert.Contains(t, w.Body.String(), common.TokenVerificationError)
+}
+
 func TestClientApp_HandleCallback(t *testing.T) {
 	oidcTestServer := test.GetOIDCTestServer(t)
 	t.Cleanup(oidcTestServer.Close)
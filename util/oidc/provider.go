@@ -110,9 +110,9 @@ func (p *providerImpl) Verify(tokenString string, argoSettings *settings.ArgoCDS
 
 	var idToken *gooidc.IDToken
 	if !unverifiedHasAudClaim {
-		idToken, err = p.verify("", tokenString, argoSettings.SkipAudienceCheckWhenTokenHasNoAudience())
+		idToken, err = p.verify("", tokenString, argoSettings.SkipAudienceCheckWhenTokenHasNoAudienceForIssuer(p.issuerURL))
 	} else {
-		allowedAudiences := argoSettings.OAuth2AllowedAudiences()
+		allowedAudiences := argoSettings.OAuth2AllowedAudiencesForIssuer(p.issuerURL)
 		if len(allowedAudiences) == 0 {
 			return nil, errors.New("token has an audience claim, but no allowed audiences are configured")
 		}
@@ -0,0 +1,185 @@
+package rbac
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// PolicyRule is a policy line of the RBAC policy
+type PolicyRule struct {
+	Subject  string `json:"subject"`
+	Resource string `json:"resource"`
+	Action   string `json:"action"`
+	Object   string `json:"object"`
+	Effect   string `json:"effect"`
+}
+
+func (r PolicyRule) String() string {
+	return strings.Join([]string{"p", r.Subject, r.Resource, r.Action, r.Object, r.Effect}, ", ")
+}
+
+// SubjectTrace is the evaluation of a request for one of the subjects of the user
+type SubjectTrace struct {
+	Subject string `json:"subject"`
+	// DefaultRole is whether the subject is the default role, which every user is assigned to
+	DefaultRole bool `json:"defaultRole,omitempty"`
+	// Roles are the roles the subject is assigned to, directly or through other roles
+	Roles []string `json:"roles,omitempty"`
+	// MatchedRules are the policy lines of the subject and of its roles matching the request
+	MatchedRules []PolicyRule `json:"matchedRules,omitempty"`
+	Allowed      bool         `json:"allowed"`
+}
+
+// Explanation is the trace of an RBAC decision: the subjects the request was evaluated for, i.e. the default role,
+// the user and its groups, along with their roles and the policy lines matching the request
+type Explanation struct {
+	Resource string         `json:"resource"`
+	Action   string         `json:"action"`
+	Object   string         `json:"object"`
+	Project  string         `json:"project,omitempty"`
+	Subjects []SubjectTrace `json:"subjects"`
+	Allowed  bool           `json:"allowed"`
+}
+
+// Explain evaluates the request for the default role and each of the given subjects, which are the user followed by its
+// groups, using the policy augmented with the given run-time policy of the project, and returns the trace of the
+// decision. The request is allowed if it is allowed for any of the subjects.
+func (e *Enforcer) Explain(project, runtimePolicy string, subjects []string, resource, action, object string) (*Explanation, error) {
+	enf, err := e.tryGetCasbinEnforcer(project, runtimePolicy)
+	if err != nil {
+		return nil, err
+	}
+	groupingPolicies, err := enf.GetGroupingPolicy()
+	if err != nil {
+		return nil, fmt.Errorf("error getting grouping policy: %w", err)
+	}
+	matchFunc := e.matchFunc()
+	matches := func(val, pattern string) bool {
+		matched, err := matchFunc(val, pattern)
+		ok, _ := matched.(bool)
+		return err == nil && ok
+	}
+
+	explanation := &Explanation{Resource: resource, Action: action, Object: object, Project: project}
+	traceSubject := func(subject string, defaultRole bool) error {
+		trace := SubjectTrace{Subject: subject, DefaultRole: defaultRole, Roles: getRoles(groupingPolicies, subject)}
+		permissions, err := enf.GetImplicitPermissionsForUser(subject)
+		if err != nil {
+			return fmt.Errorf("error getting permissions of %s: %w", subject, err)
+		}
+		for _, permission := range permissions {
+			if len(permission) < 4 {
+				continue
+			}
+			rule := PolicyRule{Subject: permission[0], Resource: permission[1], Action: permission[2], Object: permission[3], Effect: "allow"}
+			if len(permission) > 4 {
+				rule.Effect = permission[4]
+			}
+			if matches(resource, rule.Resource) && matches(action, rule.Action) && matches(object, rule.Object) {
+				trace.MatchedRules = append(trace.MatchedRules, rule)
+			}
+		}
+		ok, err := enf.Enforce(subject, resource, action, object)
+		trace.Allowed = ok && err == nil
+		explanation.Allowed = explanation.Allowed || trace.Allowed
+		explanation.Subjects = append(explanation.Subjects, trace)
+		return nil
+	}
+
+	if e.defaultRole != "" {
+		if err := traceSubject(e.defaultRole, true); err != nil {
+			return nil, err
+		}
+	}
+	for _, subject := range subjects {
+		if err := traceSubject(subject, false); err != nil {
+			return nil, err
+		}
+	}
+	return explanation, nil
+}
+
+// getRoles returns the roles the subject is assigned to by the grouping policies, directly or through other roles
+func getRoles(groupingPolicies [][]string, subject string) []string {
+	var roles []string
+	queue := []string{subject}
+	for len(queue) > 0 {
+		member := queue[0]
+		queue = queue[1:]
+		for _, groupingPolicy := range groupingPolicies {
+			if len(groupingPolicy) < 2 || groupingPolicy[0] != member {
+				continue
+			}
+			role := groupingPolicy[1]
+			if role != subject && !slices.Contains(roles, role) {
+				roles = append(roles, role)
+				queue = append(queue, role)
+			}
+		}
+	}
+	return roles
+}
+
+// Request is an RBAC request of a subject
+type Request struct {
+	Subject  string `json:"subject"`
+	Resource string `json:"resource"`
+	Action   string `json:"action"`
+	Object   string `json:"object"`
+}
+
+// AccessChange is a request whose decision differs between two policies
+type AccessChange struct {
+	Request
+	Before bool `json:"before"`
+	After  bool `json:"after"`
+}
+
+// SimulationRequests returns the requests of every subject of the given policies, i.e. the subjects of their policy
+// lines and the members of their roles, for the resource, action and object of every policy line
+func SimulationRequests(policies ...string) ([]Request, error) {
+	var subjects []string
+	type target struct{ resource, action, object string }
+	var targets []target
+	for _, policy := range policies {
+		m := newBuiltInModel()
+		if err := newAdapter("", policy, "").LoadPolicy(m); err != nil {
+			return nil, err
+		}
+		for _, rule := range m["p"]["p"].Policy {
+			if !slices.Contains(subjects, rule[0]) {
+				subjects = append(subjects, rule[0])
+			}
+			t := target{resource: rule[1], action: rule[2], object: rule[3]}
+			if !slices.Contains(targets, t) {
+				targets = append(targets, t)
+			}
+		}
+		for _, groupingPolicy := range m["g"]["g"].Policy {
+			if !slices.Contains(subjects, groupingPolicy[0]) {
+				subjects = append(subjects, groupingPolicy[0])
+			}
+		}
+	}
+	var requests []Request
+	for _, subject := range subjects {
+		for _, t := range targets {
+			requests = append(requests, Request{Subject: subject, Resource: t.resource, Action: t.action, Object: t.object})
+		}
+	}
+	return requests, nil
+}
+
+// DiffAccess returns the requests whose decision differs between the current and the updated enforcers
+func DiffAccess(current, updated *Enforcer, requests []Request) []AccessChange {
+	var changes []AccessChange
+	for _, request := range requests {
+		before := current.Enforce(request.Subject, request.Resource, request.Action, request.Object)
+		after := updated.Enforce(request.Subject, request.Resource, request.Action, request.Object)
+		if before != after {
+			changes = append(changes, AccessChange{Request: request, Before: before, After: after})
+		}
+	}
+	return changes
+}
@@ -0,0 +1,87 @@
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplain(t *testing.T) {
+	enf := NewEnforcer(nil, fakeNamespace, fakeConfigMapName, nil)
+	require.NoError(t, enf.SetUserPolicy(`
+p, role:dev, applications, *, dev/*, allow
+p, role:dev, applications, delete, dev/*, deny
+p, role:viewer, applications, get, */*, allow
+g, role:dev, role:viewer
+g, my-org:dev-team, role:dev
+`))
+	enf.SetDefaultRole("role:viewer")
+
+	t.Run("AllowedThroughGroup", func(t *testing.T) {
+		explanation, err := enf.Explain("", "", []string{"alice", "my-org:dev-team"}, ResourceApplications, ActionSync, "dev/guestbook")
+		require.NoError(t, err)
+		assert.True(t, explanation.Allowed)
+		require.Len(t, explanation.Subjects, 3)
+
+		assert.Equal(t, SubjectTrace{Subject: "role:viewer", DefaultRole: true}, explanation.Subjects[0])
+		assert.Equal(t, SubjectTrace{Subject: "alice"}, explanation.Subjects[1])
+		assert.Equal(t, SubjectTrace{
+			Subject: "my-org:dev-team",
+			Roles:   []string{"role:dev", "role:viewer"},
+			MatchedRules: []PolicyRule{
+				{Subject: "role:dev", Resource: ResourceApplications, Action: "*", Object: "dev/*", Effect: "allow"},
+			},
+			Allowed: true,
+		}, explanation.Subjects[2])
+	})
+
+	t.Run("DeniedByDenyRule", func(t *testing.T) {
+		explanation, err := enf.Explain("", "", []string{"my-org:dev-team"}, ResourceApplications, ActionDelete, "dev/guestbook")
+		require.NoError(t, err)
+		assert.False(t, explanation.Allowed)
+		require.Len(t, explanation.Subjects, 2)
+		assert.ElementsMatch(t, []string{
+			"p, role:dev, applications, *, dev/*, allow",
+			"p, role:dev, applications, delete, dev/*, deny",
+		}, []string{explanation.Subjects[1].MatchedRules[0].String(), explanation.Subjects[1].MatchedRules[1].String()})
+		assert.False(t, explanation.Subjects[1].Allowed)
+	})
+
+	t.Run("ProjectRole", func(t *testing.T) {
+		runtimePolicy := "p, proj:dev:ci, applications, sync, dev/*, allow"
+		explanation, err := enf.Explain("dev", runtimePolicy, []string{"proj:dev:ci"}, ResourceApplications, ActionSync, "dev/guestbook")
+		require.NoError(t, err)
+		assert.True(t, explanation.Allowed)
+		assert.Equal(t, "dev", explanation.Project)
+		assert.Equal(t, []PolicyRule{{Subject: "proj:dev:ci", Resource: ResourceApplications, Action: ActionSync, Object: "dev/*", Effect: "allow"}}, explanation.Subjects[1].MatchedRules)
+	})
+}
+
+func TestDiffAccess(t *testing.T) {
+	currentPolicy := `
+p, role:dev, applications, *, dev/*, allow
+g, my-org:dev-team, role:dev
+`
+	updatedPolicy := `
+p, role:dev, applications, *, dev/*, allow
+p, role:dev, applications, delete, dev/*, deny
+g, my-org:dev-team, role:dev
+g, my-org:qa-team, role:dev
+`
+	current := NewEnforcer(nil, fakeNamespace, fakeConfigMapName, nil)
+	require.NoError(t, current.SetUserPolicy(currentPolicy))
+	updated := NewEnforcer(nil, fakeNamespace, fakeConfigMapName, nil)
+	require.NoError(t, updated.SetUserPolicy(updatedPolicy))
+
+	requests, err := SimulationRequests(currentPolicy, updatedPolicy)
+	require.NoError(t, err)
+	assert.Len(t, requests, 6)
+
+	changes := DiffAccess(current, updated, requests)
+	assert.ElementsMatch(t, []AccessChange{
+		{Request: Request{Subject: "role:dev", Resource: ResourceApplications, Action: ActionDelete, Object: "dev/*"}, Before: true},
+		{Request: Request{Subject: "my-org:dev-team", Resource: ResourceApplications, Action: ActionDelete, Object: "dev/*"}, Before: true},
+		{Request: Request{Subject: "my-org:qa-team", Resource: ResourceApplications, Action: "*", Object: "dev/*"}, After: true},
+	}, changes)
+}
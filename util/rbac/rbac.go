@@ -28,6 +28,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	informersv1 "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
@@ -80,6 +81,9 @@ const (
 	ActionOverride = "override"
 	ActionAction   = "action"
 	ActionInvoke   = "invoke"
+	ActionApprove  = "approve"
+	// ActionRemoveFinalizers allows removing the finalizers of the application resources pending deletion
+	ActionRemoveFinalizers = "remove-finalizers"
 )
 
 var (
@@ -107,6 +111,8 @@ var (
 		ActionOverride,
 		ActionAction,
 		ActionInvoke,
+		ActionApprove,
+		ActionRemoveFinalizers,
 	}
 )
 
@@ -178,10 +184,7 @@ func (e *Enforcer) tryGetCasbinEnforcer(project string, policy string) (CasbinEn
 	if cached != nil {
 		return cached.enforcer, nil
 	}
-	matchFunc := globMatchFunc
-	if e.matchMode == RegexMatchMode {
-		matchFunc = util.RegexMatchFunc
-	}
+	matchFunc := e.matchFunc()
 
 	var err error
 	var enforcer CasbinEnforcer
@@ -205,6 +208,14 @@ func (e *Enforcer) tryGetCasbinEnforcer(project string, policy string) (CasbinEn
 	return enforcer, nil
 }
 
+// matchFunc returns the function matching the requests against the resources, actions and objects of the policies
+func (e *Enforcer) matchFunc() govaluate.ExpressionFunction {
+	if e.matchMode == RegexMatchMode {
+		return objectMatchFunc(util.RegexMatchFunc)
+	}
+	return objectMatchFunc(globMatchFunc)
+}
+
 // ClaimsEnforcerFunc is func template to enforce a JWT claims. The subject is replaced
 type ClaimsEnforcerFunc func(claims jwt.Claims, rvals ...any) bool
 
@@ -280,6 +291,63 @@ func CheckUserDefinedRoleReferentialIntegrity(e CasbinEnforcer) error {
 	return nil
 }
 
+const (
+	// labelRulePrefix is the prefix of the policy objects matching the labels of the RBAC objects, e.g.
+	// label:team=payments
+	labelRulePrefix = "label:"
+	// projectLabelRulePrefix is the prefix of the policy objects matching the labels of the projects of the RBAC objects,
+	// e.g. project-label:team=payments
+	projectLabelRulePrefix = "project-label:"
+)
+
+// Object is an RBAC object carrying the labels matched by the label rules of the policies, e.g. an application and the
+// labels of the application and of its project. The other rules match the name of the object.
+type Object struct {
+	Name          string
+	Labels        map[string]string
+	ProjectLabels map[string]string
+}
+
+func (o Object) String() string {
+	return o.Name
+}
+
+// GetCacheKey returns the key of the enforcement results of the object cached by casbin
+func (o Object) GetCacheKey() string {
+	return o.Name + "$" + labels.Set(o.Labels).String() + "$" + labels.Set(o.ProjectLabels).String()
+}
+
+// matchLabels returns whether the labels match the label selector of the label rule
+func matchLabels(selector string, objLabels map[string]string) bool {
+	labelSelector, err := labels.Parse(selector)
+	if err != nil {
+		return false
+	}
+	return labelSelector.Matches(labels.Set(objLabels))
+}
+
+// objectMatchFunc wraps the match func of the policies to match the label rules against the labels of the objects
+func objectMatchFunc(matchFunc govaluate.ExpressionFunction) govaluate.ExpressionFunction {
+	return func(args ...any) (any, error) {
+		if len(args) < 2 {
+			return false, nil
+		}
+		obj, isObject := args[0].(Object)
+		if pattern, ok := args[1].(string); ok {
+			if selector, ok := strings.CutPrefix(pattern, labelRulePrefix); ok {
+				return isObject && matchLabels(selector, obj.Labels), nil
+			}
+			if selector, ok := strings.CutPrefix(pattern, projectLabelRulePrefix); ok {
+				return isObject && matchLabels(selector, obj.ProjectLabels), nil
+			}
+		}
+		if isObject {
+			args = append([]any{obj.Name}, args[1:]...)
+		}
+		return matchFunc(args...)
+	}
+}
+
 // Glob match func
 func globMatchFunc(args ...any) (any, error) {
 	if len(args) < 2 {
@@ -537,6 +605,24 @@ func ValidatePolicy(policy string) error {
 	if err != nil {
 		return fmt.Errorf("policy syntax error: %s", policy)
 	}
+	if enforcer, ok := casbinEnforcer.(*casbin.CachedEnforcer); ok {
+		policies, err := enforcer.GetPolicy()
+		if err != nil {
+			return fmt.Errorf("policy syntax error: %w", err)
+		}
+		for _, p := range policies {
+			if len(p) < 4 {
+				continue
+			}
+			for _, prefix := range []string{labelRulePrefix, projectLabelRulePrefix} {
+				if selector, ok := strings.CutPrefix(p[3], prefix); ok {
+					if _, err := labels.Parse(selector); err != nil {
+						return fmt.Errorf("invalid label selector of the policy %s: %w", strings.Join(p, ", "), err)
+					}
+				}
+			}
+		}
+	}
 
 	// Check for referential integrity
 	if err := CheckUserDefinedRoleReferentialIntegrity(casbinEnforcer); err != nil {
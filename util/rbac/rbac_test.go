@@ -461,6 +461,54 @@ p, alice, clusters, get, "https://github.com/argo[a-z]{4}/argo-[a-z]+.git", allo
 	assert.False(t, enf.Enforce("alice", "clusters", "get", "https://github.com/argoproj/1argo-cd.git"))
 }
 
+func TestLabelRules(t *testing.T) {
+	kubeclientset := fake.NewClientset()
+	enf := NewEnforcer(kubeclientset, fakeNamespace, fakeConfigMapName, nil)
+	require.NoError(t, enf.syncUpdate(fakeConfigMap(), noOpUpdate))
+	policy := `
+p, alice, applications, sync, label:team=payments, allow
+p, alice, applications, sync, label:env=prod, deny
+p, alice, applications, get, "project-label:tier in (frontend,backend)", allow
+p, alice, applications, get, my-proj/other-app, allow
+`
+	require.NoError(t, enf.SetUserPolicy(policy))
+
+	payments := Object{Name: "my-proj/my-app", Labels: map[string]string{"team": "payments"}}
+	assert.True(t, enf.Enforce("alice", "applications", "sync", payments))
+	assert.False(t, enf.Enforce("alice", "applications", "sync", Object{Name: "my-proj/my-app", Labels: map[string]string{"team": "payments", "env": "prod"}}))
+	assert.False(t, enf.Enforce("alice", "applications", "sync", Object{Name: "my-proj/my-app", Labels: map[string]string{"team": "billing"}}))
+	// the label rules do not match the objects given by name
+	assert.False(t, enf.Enforce("alice", "applications", "sync", "my-proj/my-app"))
+
+	assert.True(t, enf.Enforce("alice", "applications", "get", Object{Name: "my-proj/my-app", ProjectLabels: map[string]string{"tier": "backend"}}))
+	assert.False(t, enf.Enforce("alice", "applications", "get", payments))
+	assert.True(t, enf.Enforce("alice", "applications", "get", Object{Name: "my-proj/other-app"}))
+	assert.True(t, enf.Enforce("alice", "applications", "get", "my-proj/other-app"))
+}
+
+func TestLabelRulesRegexMatchMode(t *testing.T) {
+	cm := fakeConfigMap()
+	cm.Data[ConfigMapMatchModeKey] = RegexMatchMode
+	kubeclientset := fake.NewClientset()
+	enf := NewEnforcer(kubeclientset, fakeNamespace, fakeConfigMapName, nil)
+	require.NoError(t, enf.syncUpdate(cm, noOpUpdate))
+	policy := `
+p, alice, applications, sync, label:team=payments, allow
+p, alice, applications, get, "my-proj/.*", allow
+`
+	require.NoError(t, enf.SetUserPolicy(policy))
+
+	assert.True(t, enf.Enforce("alice", "applications", "sync", Object{Name: "my-proj/my-app", Labels: map[string]string{"team": "payments"}}))
+	assert.False(t, enf.Enforce("alice", "applications", "sync", Object{Name: "my-proj/my-app"}))
+	assert.True(t, enf.Enforce("alice", "applications", "get", Object{Name: "my-proj/my-app"}))
+}
+
+func TestValidatePolicyLabelRules(t *testing.T) {
+	require.NoError(t, ValidatePolicy("p, role:dev, applications, sync, label:team=payments, allow"))
+	require.NoError(t, ValidatePolicy(`p, role:dev, applications, sync, "project-label:team in (payments,billing)", allow`))
+	require.ErrorContains(t, ValidatePolicy("p, role:dev, applications, sync, label:team==!payments, allow"), "invalid label selector")
+}
+
 func TestGlobMatchFunc(t *testing.T) {
 	ok, _ := globMatchFunc("arg1")
 	assert.False(t, ok.(bool))
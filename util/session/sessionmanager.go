@@ -2,6 +2,8 @@ package session
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"math"
@@ -41,6 +43,8 @@ type SessionManager struct {
 	projectsLister                v1alpha1.AppProjectNamespaceLister
 	client                        *http.Client
 	prov                          oidcutil.Provider
+	additionalProvs               map[string]oidcutil.Provider
+	additionalProvsLock           sync.Mutex
 	storage                       UserStateStorage
 	sleep                         func(d time.Duration)
 	verificationDelayNoiseEnabled bool
@@ -201,6 +205,15 @@ func GetSubjectAccountAndCapability(subject string) (string, settings.AccountCap
 	return subject, capability
 }
 
+// checkTokenMaxTTL returns an error if the token issued at the given time is older than the maximum TTL of the tokens,
+// so that the tokens issued before the maximum TTL is configured are bounded as well
+func checkTokenMaxTTL(issuedAt time.Time, maxTTL time.Duration) error {
+	if maxTTL > 0 && time.Since(issuedAt) > maxTTL {
+		return fmt.Errorf("token issued at %s exceeds the maximum TTL of %s", issuedAt.UTC().Format(time.RFC3339), maxTTL)
+	}
+	return nil
+}
+
 // Parse tries to parse the provided string and returns the token claims for local login.
 func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error) {
 	// Parse takes the token string and a function for looking up the key. The latter is especially
@@ -244,6 +257,12 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error)
 		if err != nil {
 			return nil, "", err
 		}
+		if id != "" && mgr.storage.IsTokenRevoked(id) {
+			return nil, "", errors.New("token is revoked")
+		}
+		if err := checkTokenMaxTTL(issuedAt, argoCDSettings.ProjectTokenMaxTTL); err != nil {
+			return nil, "", err
+		}
 
 		return token.Claims, "", nil
 	}
@@ -269,6 +288,11 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error)
 	} else if capability == settings.AccountCapabilityApiKey && account.TokenIndex(id) == -1 {
 		return nil, "", fmt.Errorf("account %s does not have token with id %s", subject, id)
 	}
+	if capability == settings.AccountCapabilityApiKey {
+		if err := checkTokenMaxTTL(issuedAt, argoCDSettings.AccountTokenMaxTTL); err != nil {
+			return nil, "", err
+		}
+	}
 
 	if account.PasswordMtime != nil && issuedAt.Before(*account.PasswordMtime) {
 		return nil, "", errors.New("account password has changed since token issued")
@@ -534,11 +558,6 @@ func (mgr *SessionManager) VerifyToken(tokenString string) (jwt.Claims, string,
 		return mgr.Parse(tokenString)
 	default:
 		// IDP signed token
-		prov, err := mgr.provider()
-		if err != nil {
-			return nil, "", err
-		}
-
 		argoSettings, err := mgr.settingsMgr.GetSettings()
 		if err != nil {
 			return nil, "", fmt.Errorf("cannot access settings while verifying the token: %w", err)
@@ -547,6 +566,17 @@ func (mgr *SessionManager) VerifyToken(tokenString string) (jwt.Claims, string,
 			return nil, "", errors.New("settings are not available while verifying the token")
 		}
 
+		var prov oidcutil.Provider
+		additionalConfig := argoSettings.AdditionalOIDCConfigForIssuer(issuer)
+		if additionalConfig != nil {
+			prov = mgr.additionalProvider(argoSettings, additionalConfig)
+		} else {
+			prov, err = mgr.provider()
+			if err != nil {
+				return nil, "", err
+			}
+		}
+
 		idToken, err := prov.Verify(tokenString, argoSettings)
 		// The token verification has failed. If the token has expired, we will
 		// return a dummy claims only containing a value for the issuer, so the
@@ -568,6 +598,14 @@ func (mgr *SessionManager) VerifyToken(tokenString string) (jwt.Claims, string,
 		if err != nil {
 			return nil, "", err
 		}
+		if mgr.storage.IsTokenRevoked(SessionID(claims)) {
+			return nil, "", errors.New("token is revoked, please re-login")
+		}
+		jwtutil.MapGroupsClaim(claims, argoSettings.GroupsClaim(idToken.Issuer))
+		if additionalConfig != nil {
+			// the subjects and the groups of the additional providers are namespaced by the name of the provider
+			jwtutil.PrefixIdentityClaims(claims, additionalConfig.Name+":")
+		}
 		return claims, "", nil
 	}
 }
@@ -587,10 +625,109 @@ func (mgr *SessionManager) provider() (oidcutil.Provider, error) {
 	return mgr.prov, nil
 }
 
+// additionalProvider returns the provider of the given additional OIDC provider config, which is memoized by issuer
+func (mgr *SessionManager) additionalProvider(argoSettings *settings.ArgoCDSettings, config *settings.OIDCConfig) oidcutil.Provider {
+	mgr.additionalProvsLock.Lock()
+	defer mgr.additionalProvsLock.Unlock()
+	if prov, ok := mgr.additionalProvs[config.Issuer]; ok {
+		return prov
+	}
+	transport := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		Dial: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).Dial,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+		TLSClientConfig:       argoSettings.AdditionalOIDCTLSConfig(config),
+	}
+	client := &http.Client{Transport: transport}
+	if os.Getenv(common.EnvVarSSODebug) == "1" {
+		client.Transport = httputil.DebugTransport{T: client.Transport}
+	}
+	if mgr.additionalProvs == nil {
+		mgr.additionalProvs = map[string]oidcutil.Provider{}
+	}
+	prov := oidcutil.NewOIDCProvider(config.Issuer, client)
+	mgr.additionalProvs[config.Issuer] = prov
+	return prov
+}
+
 func (mgr *SessionManager) RevokeToken(ctx context.Context, id string, expiringAt time.Duration) error {
 	return mgr.storage.RevokeToken(ctx, id, expiringAt)
 }
 
+// SessionID returns the id of the session of the given claims, which is the id of their token if it has one, or a hash
+// of their issuer, subject and issue time otherwise
+func SessionID(claims jwt.MapClaims) string {
+	if id := jwtutil.StringField(claims, "jti"); id != "" {
+		return id
+	}
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s|%s|%v", jwtutil.StringField(claims, "iss"), jwtutil.StringField(claims, "sub"), claims["iat"])))
+	return hex.EncodeToString(hash[:16])
+}
+
+// RecordSession records the activity of the session of the given claims from the given client IP
+func (mgr *SessionManager) RecordSession(ctx context.Context, claims jwt.Claims, clientIP string) {
+	mapClaims, err := jwtutil.MapClaims(claims)
+	if err != nil {
+		return
+	}
+	argoClaims, err := claimsutil.MapClaimsToArgoClaims(mapClaims)
+	if err != nil {
+		return
+	}
+	session := Session{
+		ID:           SessionID(mapClaims),
+		Subject:      argoClaims.GetUserIdentifier(),
+		Issuer:       argoClaims.Issuer,
+		LastActivity: time.Now().UTC(),
+		ClientIP:     clientIP,
+	}
+	if argoClaims.IssuedAt != nil {
+		session.IssuedAt = argoClaims.IssuedAt.UTC()
+	}
+	if argoClaims.ExpiresAt != nil {
+		expiresAt := argoClaims.ExpiresAt.UTC()
+		session.ExpiresAt = &expiresAt
+	}
+	if err := mgr.storage.RecordSession(ctx, session); err != nil {
+		log.Warnf("Failed to record the activity of session %s: %v", session.ID, err)
+	}
+}
+
+// ListSessions lists the sessions which were active in the last 24 hours and are neither expired nor revoked
+func (mgr *SessionManager) ListSessions(ctx context.Context) ([]Session, error) {
+	return mgr.storage.ListSessions(ctx)
+}
+
+// RevokeSession revokes the active session with the given id, which is rejected until it expires
+func (mgr *SessionManager) RevokeSession(ctx context.Context, id string) (*Session, error) {
+	sessions, err := mgr.storage.ListSessions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, session := range sessions {
+		if session.ID != id {
+			continue
+		}
+		// the tokens which do not expire are revoked forever
+		var expiringAt time.Duration
+		if session.ExpiresAt != nil {
+			expiringAt = time.Until(*session.ExpiresAt)
+		}
+		if err := mgr.storage.RevokeToken(ctx, id, expiringAt); err != nil {
+			return nil, err
+		}
+		if err := mgr.storage.DeleteSession(ctx, id); err != nil {
+			return nil, err
+		}
+		return &session, nil
+	}
+	return nil, status.Errorf(codes.NotFound, "session %s not found", id)
+}
+
 func LoggedIn(ctx context.Context) bool {
 	return GetUserIdentifier(ctx) != "" && ctx.Value(AuthErrorCtxKey) == nil
 }
@@ -227,6 +227,42 @@ func TestSessionManager_ProjectToken(t *testing.T) {
 	})
 }
 
+func TestSessionManager_TokenMaxTTL(t *testing.T) {
+	issuedAt := time.Now().Add(-48 * time.Hour)
+	settingsMgr := settings.NewSettingsManager(t.Context(), getKubeClientWithConfig(map[string]string{
+		"accounts.ci":            "apiKey",
+		"users.tokens.maxTTL":    "24h",
+		"projects.tokens.maxTTL": "72h",
+	}, map[string][]byte{
+		"accounts.ci.tokens": []byte(fmt.Sprintf(`[{"id":"abc","iat":%d}]`, issuedAt.Unix())),
+	}), "argocd")
+	proj := appv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"},
+		Spec:       appv1.AppProjectSpec{Roles: []appv1.ProjectRole{{Name: "test"}}},
+		Status: appv1.AppProjectStatus{JWTTokensByRole: map[string]appv1.JWTTokens{
+			"test": {Items: []appv1.JWTToken{{ID: "def", IssuedAt: issuedAt.Unix()}}},
+		}},
+	}
+	mgr := newSessionManager(settingsMgr, getProjLister(&proj), NewUserStateStorage(nil))
+	sign := func(subject string, id string) string {
+		token, err := mgr.signClaims(jwt.RegisteredClaims{
+			IssuedAt: jwt.NewNumericDate(issuedAt),
+			Issuer:   SessionManagerClaimsIssuer,
+			Subject:  subject,
+			ID:       id,
+		})
+		require.NoError(t, err)
+		return token
+	}
+
+	// the tokens issued before the maximum TTL is configured are bounded as well
+	_, _, err := mgr.Parse(sign("ci", "abc"))
+	require.ErrorContains(t, err, "exceeds the maximum TTL of 24h0m0s")
+
+	_, _, err = mgr.Parse(sign("proj:default:test", "def"))
+	require.NoError(t, err)
+}
+
 type tokenVerifierMock struct {
 	claims jwt.Claims
 	err    error
@@ -707,6 +743,60 @@ rootCA: |
 		assert.ErrorIs(t, err, common.ErrTokenVerification)
 	})
 
+	t.Run("Additional OIDC provider, groups claim is mapped and prefixed", func(t *testing.T) {
+		cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: oidcTestServer.TLS.Certificates[0].Certificate[0]})
+
+		dexConfig := map[string]string{
+			"url": "",
+			"oidc.config": `
+name: Corporate
+issuer: https://corporate.example.com
+clientID: corporate-client
+clientSecret: yyy`,
+			"oidc.additionalConfigs": fmt.Sprintf(`
+- name: customer
+  issuer: %s
+  clientID: customer-client
+  clientSecret: zzz
+  groupsClaim: realm_access.roles
+  rootCA: |
+    %s
+`, oidcTestServer.URL, strings.ReplaceAll(string(cert), "\n", "\n    ")),
+		}
+
+		settingsMgr := settings.NewSettingsManager(t.Context(), getKubeClientWithConfig(dexConfig, nil), "argocd")
+		mgr := NewSessionManager(settingsMgr, getProjLister(), "", nil, NewUserStateStorage(nil))
+		mgr.verificationDelayNoiseEnabled = false
+
+		signToken := func(audience string) string {
+			token := jwt.NewWithClaims(jwt.SigningMethodRS512, jwt.MapClaims{
+				"iss":              oidcTestServer.URL,
+				"aud":              audience,
+				"sub":              "alice",
+				"exp":              time.Now().Add(time.Hour * 24).Unix(),
+				"realm_access":     map[string]any{"roles": []string{"admins"}},
+				"federated_claims": map[string]any{"connector_id": "local", "user_id": "admin"},
+			})
+			key, err := jwt.ParseRSAPrivateKeyFromPEM(utiltest.PrivateKey)
+			require.NoError(t, err)
+			tokenString, err := token.SignedString(key)
+			require.NoError(t, err)
+			return tokenString
+		}
+
+		claims, _, err := mgr.VerifyToken(signToken("customer-client"))
+		require.NoError(t, err)
+		mapClaims, err := jwtutil.MapClaims(claims)
+		require.NoError(t, err)
+		assert.Equal(t, []any{"customer:admins"}, mapClaims["groups"])
+		assert.Equal(t, "customer:alice", mapClaims["sub"])
+		assert.NotContains(t, mapClaims, "federated_claims")
+
+		// the audience of the main provider is not allowed for the additional provider
+		_, _, err = mgr.VerifyToken(signToken("corporate-client"))
+		require.ErrorIs(t, err, common.ErrTokenVerification)
+	})
+
 	t.Run("OIDC provider is external, TLS is configured", func(t *testing.T) {
 		dexConfig := map[string]string{
 			"url": "",
@@ -1246,3 +1336,46 @@ func Test_PickFailureAttemptWhenOverflowed(t *testing.T) {
 		}
 	})
 }
+
+func TestSessionID(t *testing.T) {
+	assert.Equal(t, "123", SessionID(jwt.MapClaims{"jti": "123", "sub": "alice"}))
+
+	id := SessionID(jwt.MapClaims{"iss": "https://dex", "sub": "alice", "iat": float64(1)})
+	assert.Len(t, id, 32)
+	assert.Equal(t, id, SessionID(jwt.MapClaims{"iss": "https://dex", "sub": "alice", "iat": float64(1)}))
+	assert.NotEqual(t, id, SessionID(jwt.MapClaims{"iss": "https://dex", "sub": "alice", "iat": float64(2)}))
+}
+
+func TestSessionManager_RevokeSession(t *testing.T) {
+	redisClient, closer := test.NewInMemoryRedis()
+	defer closer()
+
+	settingsMgr := settings.NewSettingsManager(t.Context(), getKubeClient(t, "pass", true), "argocd")
+	mgr := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(redisClient))
+
+	token, err := mgr.Create("admin:login", 0, "123")
+	require.NoError(t, err)
+	claims, _, err := mgr.Parse(token)
+	require.NoError(t, err)
+	mgr.RecordSession(t.Context(), claims, "10.0.0.1")
+
+	sessions, err := mgr.ListSessions(t.Context())
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, "123", sessions[0].ID)
+	assert.Equal(t, "admin", sessions[0].Subject)
+	assert.Equal(t, "10.0.0.1", sessions[0].ClientIP)
+
+	_, err = mgr.RevokeSession(t.Context(), "unknown")
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	revoked, err := mgr.RevokeSession(t.Context(), "123")
+	require.NoError(t, err)
+	assert.Equal(t, "admin", revoked.Subject)
+
+	_, _, err = mgr.Parse(token)
+	require.EqualError(t, err, "token is revoked, please re-login")
+	sessions, err = mgr.ListSessions(t.Context())
+	require.NoError(t, err)
+	assert.Empty(t, sessions)
+}
@@ -2,6 +2,10 @@ package session
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -15,8 +19,24 @@ import (
 const (
 	revokedTokenPrefix = "revoked-token|"
 	newRevokedTokenKey = "new-revoked-token"
+	sessionPrefix      = "session|"
+	// sessionInactivityTimeout is the duration after which the sessions without activity are no longer listed
+	sessionInactivityTimeout = 24 * time.Hour
+	// sessionActivityResolution is the minimum duration between two recordings of the activity of a session
+	sessionActivityResolution = time.Minute
 )
 
+// Session is an active session of a user, identified by the id of its token
+type Session struct {
+	ID           string     `json:"id"`
+	Subject      string     `json:"subject"`
+	Issuer       string     `json:"issuer,omitempty"`
+	IssuedAt     time.Time  `json:"issuedAt"`
+	ExpiresAt    *time.Time `json:"expiresAt,omitempty"`
+	LastActivity time.Time  `json:"lastActivity"`
+	ClientIP     string     `json:"clientIP,omitempty"`
+}
+
 type userStateStorage struct {
 	attempts            map[string]LoginAttempts
 	redis               *redis.Client
@@ -24,6 +44,9 @@ type userStateStorage struct {
 	recentRevokedTokens map[string]bool
 	lock                sync.RWMutex
 	resyncDuration      time.Duration
+	// sessionsRecordedAt holds the time the activity of the sessions was last recorded at, by session id
+	sessionsRecordedAt map[string]time.Time
+	sessionsLock       sync.Mutex
 }
 
 var _ UserStateStorage = &userStateStorage{}
@@ -35,6 +58,7 @@ func NewUserStateStorage(redis *redis.Client) *userStateStorage {
 		recentRevokedTokens: map[string]bool{},
 		resyncDuration:      time.Second * 15,
 		redis:               redis,
+		sessionsRecordedAt:  map[string]time.Time{},
 	}
 }
 
@@ -139,6 +163,82 @@ func (storage *userStateStorage) GetLockObject() *sync.RWMutex {
 	return &storage.lock
 }
 
+func (storage *userStateStorage) RecordSession(ctx context.Context, session Session) error {
+	if storage.redis == nil {
+		return nil
+	}
+	storage.sessionsLock.Lock()
+	recordedAt, ok := storage.sessionsRecordedAt[session.ID]
+	if ok && session.LastActivity.Sub(recordedAt) < sessionActivityResolution {
+		storage.sessionsLock.Unlock()
+		return nil
+	}
+	storage.sessionsRecordedAt[session.ID] = session.LastActivity
+	for id, recordedAt := range storage.sessionsRecordedAt {
+		if session.LastActivity.Sub(recordedAt) > sessionInactivityTimeout {
+			delete(storage.sessionsRecordedAt, id)
+		}
+	}
+	storage.sessionsLock.Unlock()
+
+	expiration := sessionInactivityTimeout
+	if session.ExpiresAt != nil {
+		expiration = min(expiration, session.ExpiresAt.Sub(session.LastActivity))
+		if expiration <= 0 {
+			return nil
+		}
+	}
+	sessionBytes, err := json.Marshal(session)
+	if err != nil {
+		return fmt.Errorf("error marshaling session %s: %w", session.ID, err)
+	}
+	return storage.redis.Set(ctx, sessionPrefix+session.ID, sessionBytes, expiration).Err()
+}
+
+func (storage *userStateStorage) ListSessions(ctx context.Context) ([]Session, error) {
+	if storage.redis == nil {
+		return nil, nil
+	}
+	var sessions []Session
+	iterator := storage.redis.Scan(ctx, 0, sessionPrefix+"*", 10000).Iterator()
+	for iterator.Next(ctx) {
+		value, err := storage.redis.Get(ctx, iterator.Val()).Bytes()
+		if errors.Is(err, redis.Nil) {
+			// the session expired since it was listed
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		var session Session
+		if err := json.Unmarshal(value, &session); err != nil {
+			log.Warnf("Failed to unmarshal session %s: %v", iterator.Val(), err)
+			continue
+		}
+		if storage.IsTokenRevoked(session.ID) {
+			continue
+		}
+		sessions = append(sessions, session)
+	}
+	if iterator.Err() != nil {
+		return nil, iterator.Err()
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastActivity.After(sessions[j].LastActivity)
+	})
+	return sessions, nil
+}
+
+func (storage *userStateStorage) DeleteSession(ctx context.Context, id string) error {
+	storage.sessionsLock.Lock()
+	delete(storage.sessionsRecordedAt, id)
+	storage.sessionsLock.Unlock()
+	if storage.redis == nil {
+		return nil
+	}
+	return storage.redis.Del(ctx, sessionPrefix+id).Err()
+}
+
 type UserStateStorage interface {
 	Init(ctx context.Context)
 	// GetLoginAttempts return number of concurrent login attempts
@@ -151,4 +251,11 @@ type UserStateStorage interface {
 	IsTokenRevoked(id string) bool
 	// GetLockObject returns a lock used by the storage
 	GetLockObject() *sync.RWMutex
+	// RecordSession records the activity of the given session, at most once per minute
+	RecordSession(ctx context.Context, session Session) error
+	// ListSessions lists the sessions which were active in the last 24 hours and are neither expired nor revoked, the
+	// most recently active first
+	ListSessions(ctx context.Context) ([]Session, error)
+	// DeleteSession deletes the given session from the active sessions
+	DeleteSession(ctx context.Context, id string) error
 }
@@ -27,3 +27,44 @@ func TestUserStateStorage_LoadRevokedTokens(t *testing.T) {
 
 	assert.True(t, storage.IsTokenRevoked("abc"))
 }
+
+func TestUserStateStorage_Sessions(t *testing.T) {
+	redis, closer := test.NewInMemoryRedis()
+	defer closer()
+
+	storage := NewUserStateStorage(redis)
+	now := time.Now().UTC().Truncate(time.Second)
+	expiresAt := now.Add(time.Hour)
+	require.NoError(t, storage.RecordSession(t.Context(), Session{ID: "abc", Subject: "alice", LastActivity: now.Add(-time.Hour), ExpiresAt: &expiresAt, ClientIP: "10.0.0.1"}))
+	require.NoError(t, storage.RecordSession(t.Context(), Session{ID: "def", Subject: "bob", LastActivity: now}))
+	// the activity is recorded at most once per minute
+	require.NoError(t, storage.RecordSession(t.Context(), Session{ID: "def", Subject: "bob", LastActivity: now.Add(time.Second), ClientIP: "10.0.0.2"}))
+
+	sessions, err := storage.ListSessions(t.Context())
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	assert.Equal(t, "def", sessions[0].ID)
+	assert.Empty(t, sessions[0].ClientIP)
+	assert.Equal(t, "abc", sessions[1].ID)
+	assert.Equal(t, "10.0.0.1", sessions[1].ClientIP)
+	assert.Equal(t, expiresAt, *sessions[1].ExpiresAt)
+
+	require.NoError(t, storage.DeleteSession(t.Context(), "abc"))
+	sessions, err = storage.ListSessions(t.Context())
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, "def", sessions[0].ID)
+}
+
+func TestUserStateStorage_RecordSession_Expired(t *testing.T) {
+	redis, closer := test.NewInMemoryRedis()
+	defer closer()
+
+	storage := NewUserStateStorage(redis)
+	expiresAt := time.Now().Add(-time.Minute)
+	require.NoError(t, storage.RecordSession(t.Context(), Session{ID: "abc", Subject: "alice", LastActivity: time.Now(), ExpiresAt: &expiresAt}))
+
+	sessions, err := storage.ListSessions(t.Context())
+	require.NoError(t, err)
+	assert.Empty(t, sessions)
+}
@@ -0,0 +1,107 @@
+package settings
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// projectTemplateNameParameter is the parameter holding the name of the project created from a template
+const projectTemplateNameParameter = "name"
+
+var projectTemplateFuncMap = sprig.TxtFuncMap()
+
+func init() {
+	delete(projectTemplateFuncMap, "env")
+	delete(projectTemplateFuncMap, "expandenv")
+	delete(projectTemplateFuncMap, "getHostByName")
+}
+
+// ProjectTemplate is a template of the projects created with `argocd proj create --from-template`
+type ProjectTemplate struct {
+	// Name is the name of the template
+	Name string `json:"name"`
+	// Description is a description of the template
+	Description string `json:"description,omitempty"`
+	// Parameters are the parameters of the template, in addition to the name of the project
+	Parameters []ProjectTemplateParameter `json:"parameters,omitempty"`
+	// Template is a Go template rendering the AppProject in YAML. The name of the project is available as {{.name}}
+	// and the parameters as {{.<parameter>}}.
+	Template string `json:"template"`
+}
+
+// ProjectTemplateParameter is a parameter of a project template
+type ProjectTemplateParameter struct {
+	// Name is the name of the parameter
+	Name string `json:"name"`
+	// Description is a description of the parameter
+	Description string `json:"description,omitempty"`
+	// Default is the value of the parameter when it is not given
+	Default string `json:"default,omitempty"`
+	// Required is true if the parameter must be given
+	Required bool `json:"required,omitempty"`
+}
+
+// Render renders the project with the given name from the template and the given parameters
+func (t *ProjectTemplate) Render(name string, parameters map[string]string) (*v1alpha1.AppProject, error) {
+	data := map[string]any{projectTemplateNameParameter: name}
+	known := map[string]bool{}
+	for _, param := range t.Parameters {
+		known[param.Name] = true
+		value, ok := parameters[param.Name]
+		if !ok {
+			if param.Required {
+				return nil, fmt.Errorf("parameter '%s' of project template '%s' is required", param.Name, t.Name)
+			}
+			value = param.Default
+		}
+		data[param.Name] = value
+	}
+	for key := range parameters {
+		if !known[key] {
+			return nil, fmt.Errorf("project template '%s' has no parameter '%s'", t.Name, key)
+		}
+	}
+
+	tmpl, err := template.New(t.Name).Funcs(projectTemplateFuncMap).Option("missingkey=error").Parse(t.Template)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing project template '%s': %w", t.Name, err)
+	}
+	var out bytes.Buffer
+	if err := tmpl.Execute(&out, data); err != nil {
+		return nil, fmt.Errorf("error rendering project template '%s': %w", t.Name, err)
+	}
+	var proj v1alpha1.AppProject
+	if err := yaml.UnmarshalStrict(out.Bytes(), &proj); err != nil {
+		return nil, fmt.Errorf("error unmarshalling project rendered by template '%s': %w", t.Name, err)
+	}
+	proj.Name = name
+	return &proj, nil
+}
+
+// GetProjectTemplates loads the project templates from argocd-cm ConfigMap
+func (mgr *SettingsManager) GetProjectTemplates() ([]ProjectTemplate, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving argocd-cm: %w", err)
+	}
+	projectTemplates := make([]ProjectTemplate, 0)
+	if value, ok := argoCDCM.Data[projectTemplatesKey]; ok && value != "" {
+		if err := yaml.Unmarshal([]byte(value), &projectTemplates); err != nil {
+			return nil, fmt.Errorf("error unmarshalling project templates: %w", err)
+		}
+	}
+	for _, tmpl := range projectTemplates {
+		for _, param := range tmpl.Parameters {
+			if param.Name == projectTemplateNameParameter {
+				return nil, fmt.Errorf("project template '%s' can't have a parameter named '%s'", tmpl.Name, projectTemplateNameParameter)
+			}
+		}
+	}
+	return projectTemplates, nil
+}
@@ -0,0 +1,114 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const testProjectTemplates = `
+- name: team
+  description: Project of a team
+  parameters:
+  - name: group
+    required: true
+  - name: clusters
+    default: https://kubernetes.default.svc
+  template: |
+    spec:
+      description: Project of the {{ .name }} team
+      sourceRepos:
+      - https://github.com/org/{{ .name }}-*
+      destinations:
+      {{- range splitList "," .clusters }}
+      - server: {{ . }}
+        namespace: {{ $.name }}-*
+      {{- end }}
+      roles:
+      - name: admin
+        groups:
+        - {{ .group }}
+        policies:
+        - p, proj:{{ .name }}:admin, applications, *, {{ .name }}/*, allow
+`
+
+func TestGetProjectTemplates(t *testing.T) {
+	t.Run("NotConfigured", func(t *testing.T) {
+		_, settingsManager := fixtures(nil)
+		projectTemplates, err := settingsManager.GetProjectTemplates()
+		require.NoError(t, err)
+		assert.Empty(t, projectTemplates)
+	})
+
+	t.Run("Configured", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{projectTemplatesKey: testProjectTemplates})
+		projectTemplates, err := settingsManager.GetProjectTemplates()
+		require.NoError(t, err)
+		require.Len(t, projectTemplates, 1)
+		assert.Equal(t, "team", projectTemplates[0].Name)
+		assert.Equal(t, []ProjectTemplateParameter{
+			{Name: "group", Required: true},
+			{Name: "clusters", Default: "https://kubernetes.default.svc"},
+		}, projectTemplates[0].Parameters)
+	})
+
+	t.Run("ReservedParameter", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{projectTemplatesKey: `
+- name: team
+  parameters:
+  - name: name
+  template: "spec: {}"
+`})
+		_, err := settingsManager.GetProjectTemplates()
+		assert.EqualError(t, err, "project template 'team' can't have a parameter named 'name'")
+	})
+}
+
+func TestProjectTemplate_Render(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{projectTemplatesKey: testProjectTemplates})
+	projectTemplates, err := settingsManager.GetProjectTemplates()
+	require.NoError(t, err)
+	tmpl := projectTemplates[0]
+
+	t.Run("Defaults", func(t *testing.T) {
+		proj, err := tmpl.Render("payments", map[string]string{"group": "payments-admins"})
+		require.NoError(t, err)
+		assert.Equal(t, "payments", proj.Name)
+		assert.Equal(t, "Project of the payments team", proj.Spec.Description)
+		assert.Equal(t, []string{"https://github.com/org/payments-*"}, proj.Spec.SourceRepos)
+		assert.Equal(t, []v1alpha1.ApplicationDestination{
+			{Server: "https://kubernetes.default.svc", Namespace: "payments-*"},
+		}, proj.Spec.Destinations)
+		require.Len(t, proj.Spec.Roles, 1)
+		assert.Equal(t, []string{"payments-admins"}, proj.Spec.Roles[0].Groups)
+		assert.Equal(t, []string{"p, proj:payments:admin, applications, *, payments/*, allow"}, proj.Spec.Roles[0].Policies)
+	})
+
+	t.Run("Parameters", func(t *testing.T) {
+		proj, err := tmpl.Render("payments", map[string]string{"group": "payments-admins", "clusters": "https://prod,https://staging"})
+		require.NoError(t, err)
+		assert.Equal(t, []v1alpha1.ApplicationDestination{
+			{Server: "https://prod", Namespace: "payments-*"},
+			{Server: "https://staging", Namespace: "payments-*"},
+		}, proj.Spec.Destinations)
+	})
+
+	t.Run("MissingRequiredParameter", func(t *testing.T) {
+		_, err := tmpl.Render("payments", nil)
+		assert.EqualError(t, err, "parameter 'group' of project template 'team' is required")
+	})
+
+	t.Run("UnknownParameter", func(t *testing.T) {
+		_, err := tmpl.Render("payments", map[string]string{"group": "payments-admins", "owner": "me"})
+		assert.EqualError(t, err, "project template 'team' has no parameter 'owner'")
+	})
+
+	t.Run("UnknownField", func(t *testing.T) {
+		invalid := ProjectTemplate{Name: "invalid", Template: "spec:\n  destination: {}\n"}
+		_, err := invalid.Render("payments", nil)
+		assert.ErrorContains(t, err, "error unmarshalling project rendered by template 'invalid'")
+	})
+}
@@ -14,6 +14,8 @@ import (
 	"net/url"
 	"path"
 	"reflect"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -60,6 +62,8 @@ type ArgoCDSettings struct {
 	DexConfig string `json:"dexConfig,omitempty"`
 	// OIDCConfigRAW holds OIDC configuration as a raw string
 	OIDCConfigRAW string `json:"oidcConfig,omitempty"`
+	// AdditionalOIDCConfigsRAW holds the configuration of the additional OIDC providers as a raw string
+	AdditionalOIDCConfigsRAW string `json:"additionalOIDCConfigs,omitempty"`
 	// ServerSignature holds the key used to generate JWT tokens.
 	ServerSignature []byte `json:"serverSignature,omitempty"`
 	// Certificate holds the certificate/private key for the Argo CD API server.
@@ -81,6 +85,10 @@ type ArgoCDSettings struct {
 	WebhookAzureDevOpsUsername string `json:"webhookAzureDevOpsUsername,omitempty"`
 	// WebhookAzureDevOpsPassword holds the password for authenticating Azure DevOps webhook events
 	WebhookAzureDevOpsPassword string `json:"webhookAzureDevOpsPassword,omitempty"`
+	// SCIMBearerToken holds the bearer token authenticating the SCIM provisioning requests of the identity providers
+	SCIMBearerToken string `json:"scimBearerToken,omitempty"`
+	// SCIMIssuer holds the issuer of the tokens of the users provisioned through the SCIM endpoint
+	SCIMIssuer string `json:"scimIssuer,omitempty"`
 	// Secrets holds all secrets in argocd-secret as a map[string]string
 	Secrets map[string]string `json:"secrets,omitempty"`
 	// KustomizeBuildOptions is a string of kustomize build parameters
@@ -89,6 +97,10 @@ type ArgoCDSettings struct {
 	AnonymousUserEnabled bool `json:"anonymousUserEnabled,omitempty"`
 	// Specifies token expiration duration
 	UserSessionDuration time.Duration `json:"userSessionDuration,omitempty"`
+	// AccountTokenMaxTTL is the maximum TTL of the tokens of the local accounts, the tokens never expire if 0
+	AccountTokenMaxTTL time.Duration `json:"accountTokenMaxTTL,omitempty"`
+	// ProjectTokenMaxTTL is the maximum TTL of the tokens of the project roles, the tokens never expire if 0
+	ProjectTokenMaxTTL time.Duration `json:"projectTokenMaxTTL,omitempty"`
 	// UiCssURL local or remote path to user-defined CSS to customize ArgoCD UI
 	UiCssURL string `json:"uiCssURL,omitempty"` //nolint:revive //FIXME(var-naming)
 	// Content of UI Banner
@@ -138,6 +150,38 @@ type GoogleAnalytics struct {
 type GlobalProjectSettings struct {
 	ProjectName   string               `json:"projectName,omitempty"`
 	LabelSelector metav1.LabelSelector `json:"labelSelector,omitempty"`
+	// Sections are the sections of the global project inherited by the projects matching the label selector. All the
+	// sections are inherited when no section is given.
+	Sections []GlobalProjectSection `json:"sections,omitempty"`
+}
+
+// GlobalProjectSection is a section of the spec of a global project, inherited by the projects matching both the label
+// selector of the global project and the label selector of the section
+type GlobalProjectSection struct {
+	// Name is the name of the field of the spec, e.g. clusterResourceBlacklist
+	Name          string               `json:"name"`
+	LabelSelector metav1.LabelSelector `json:"labelSelector,omitempty"`
+}
+
+const (
+	GlobalProjectSectionClusterResourceWhitelist   = "clusterResourceWhitelist"
+	GlobalProjectSectionClusterResourceBlacklist   = "clusterResourceBlacklist"
+	GlobalProjectSectionNamespaceResourceWhitelist = "namespaceResourceWhitelist"
+	GlobalProjectSectionNamespaceResourceBlacklist = "namespaceResourceBlacklist"
+	GlobalProjectSectionSyncWindows                = "syncWindows"
+	GlobalProjectSectionSourceRepos                = "sourceRepos"
+	GlobalProjectSectionDestinations               = "destinations"
+)
+
+// globalProjectSections are the sections of the spec of global projects that projects can inherit
+var globalProjectSections = []string{
+	GlobalProjectSectionClusterResourceWhitelist,
+	GlobalProjectSectionClusterResourceBlacklist,
+	GlobalProjectSectionNamespaceResourceWhitelist,
+	GlobalProjectSectionNamespaceResourceBlacklist,
+	GlobalProjectSectionSyncWindows,
+	GlobalProjectSectionSourceRepos,
+	GlobalProjectSectionDestinations,
 }
 
 // Help settings
@@ -180,9 +224,22 @@ func (o *oidcConfig) toExported() *OIDCConfig {
 		RootCA:                   o.RootCA,
 		EnablePKCEAuthentication: o.EnablePKCEAuthentication,
 		DomainHint:               o.DomainHint,
+		GroupsClaim:              o.GroupsClaim,
 	}
 }
 
+// allowedAudiences returns the audiences configured for the provider, or its client IDs if none are configured
+func (o *oidcConfig) allowedAudiences() []string {
+	if len(o.AllowedAudiences) > 0 {
+		return o.AllowedAudiences
+	}
+	allowedAudiences := []string{o.ClientID}
+	if o.CLIClientID != "" {
+		allowedAudiences = append(allowedAudiences, o.CLIClientID)
+	}
+	return allowedAudiences
+}
+
 type OIDCConfig struct {
 	Name                     string                 `json:"name,omitempty"`
 	Issuer                   string                 `json:"issuer,omitempty"`
@@ -198,7 +255,10 @@ type OIDCConfig struct {
 	RootCA                   string                 `json:"rootCA,omitempty"`
 	EnablePKCEAuthentication bool                   `json:"enablePKCEAuthentication,omitempty"`
 	DomainHint               string                 `json:"domainHint,omitempty"`
-	Azure                    *AzureOIDCConfig       `json:"azure,omitempty"`
+	// GroupsClaim is the claim of the tokens of the provider holding the groups of the users, e.g. roles or
+	// realm_access.roles. It is mapped to the groups claim used by the RBAC policies.
+	GroupsClaim string           `json:"groupsClaim,omitempty"`
+	Azure       *AzureOIDCConfig `json:"azure,omitempty"`
 }
 
 type AzureOIDCConfig struct {
@@ -229,8 +289,17 @@ type KustomizeVersion struct {
 type KustomizeSettings struct {
 	BuildOptions string
 	Versions     []KustomizeVersion
+	// AllowedBuildOptions are the build options which can be set by the sources of the applications, only --enable-helm
+	// is allowed if nil
+	AllowedBuildOptions []string
 }
 
+// oidcProviderNameRegexp matches the valid names of the additional OIDC providers
+var oidcProviderNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// defaultKustomizeAllowedBuildOptions are the build options the application sources can set by default
+var defaultKustomizeAllowedBuildOptions = []string{"--enable-helm"}
+
 var (
 	ByClusterURLIndexer     = "byClusterURL"
 	byClusterURLIndexerFunc = func(obj any) ([]string, error) {
@@ -308,17 +377,48 @@ func (ks *KustomizeSettings) GetOptions(source v1alpha1.ApplicationSource) (*v1a
 		// add build options for the default version
 		buildOptions = ks.BuildOptions
 	}
+	if source.Kustomize != nil && source.Kustomize.BuildOptions != "" {
+		if err := ks.validateSourceBuildOptions(source.Kustomize.BuildOptions); err != nil {
+			return nil, err
+		}
+		buildOptions = source.Kustomize.BuildOptions
+	}
 	return &v1alpha1.KustomizeOptions{
 		BuildOptions: buildOptions,
 		BinaryPath:   binaryPath,
 	}, nil
 }
 
+// validateSourceBuildOptions checks that the build options of an application source are allowed, since options such as
+// --enable-exec would let the application authors run commands in the repo server.
+func (ks *KustomizeSettings) validateSourceBuildOptions(buildOptions string) error {
+	allowed := ks.AllowedBuildOptions
+	if allowed == nil {
+		allowed = defaultKustomizeAllowedBuildOptions
+	}
+	flagSeen := false
+	for _, arg := range strings.Fields(buildOptions) {
+		if !strings.HasPrefix(arg, "-") {
+			// the value of the previous flag, e.g. --load-restrictor LoadRestrictionsNone
+			if !flagSeen {
+				return fmt.Errorf("invalid kustomize build options %q", buildOptions)
+			}
+			continue
+		}
+		name, _, _ := strings.Cut(arg, "=")
+		if !slices.Contains(allowed, name) {
+			return fmt.Errorf("kustomize build option %s is not allowed, the allowed options are configured with %s", name, kustomizeAllowedBuildOptionsKey)
+		}
+		flagSeen = true
+	}
+	return nil
+}
+
 // Credentials for accessing a Git repository
 type Repository struct {
 	// The URL to the repository
 	URL string `json:"url,omitempty"`
-	// the type of the repo, "git" or "helm", assumed to be "git" if empty or absent
+	// the type of the repo, "git", "helm" or "oci", assumed to be "git" if empty or absent
 	Type string `json:"type,omitempty"`
 	// helm only
 	Name string `json:"name,omitempty"`
@@ -384,7 +484,7 @@ type RepositoryCredentials struct {
 	GithubAppEnterpriseBaseURL string `json:"githubAppEnterpriseBaseUrl,omitempty"`
 	// EnableOCI specifies whether helm-oci support should be enabled for this repo
 	EnableOCI bool `json:"enableOCI,omitempty"`
-	// the type of the repositoryCredentials, "git" or "helm", assumed to be "git" if empty or absent
+	// the type of the repositoryCredentials, "git", "helm" or "oci", assumed to be "git" if empty or absent
 	Type string `json:"type,omitempty"`
 	// GCPServiceAccountKey specifies the service account key in JSON format to be used for getting credentials to Google Cloud Source repos
 	GCPServiceAccountKey *corev1.SecretKeySelector `json:"gcpServiceAccountKey,omitempty"`
@@ -408,6 +508,47 @@ type DeepLink struct {
 	Condition *string `json:"if,omitempty"`
 }
 
+const (
+	// ClusterDiscoveryProviderClusterAPI discovers the workload clusters of a Cluster API management cluster
+	ClusterDiscoveryProviderClusterAPI = "cluster-api"
+	// ClusterDiscoveryProviderRancher discovers the downstream clusters of a Rancher management cluster
+	ClusterDiscoveryProviderRancher = "rancher"
+)
+
+// ClusterDiscoverySource configures the automatic registration of the downstream clusters of a management cluster
+type ClusterDiscoverySource struct {
+	// Name identifies the source, the cluster secrets created for the downstream clusters are labeled with it
+	Name string `json:"name"`
+	// Provider is the type of the management cluster, either "cluster-api" or "rancher"
+	Provider string `json:"provider"`
+	// Server is the URL of the management cluster, which must be registered in Argo CD. Defaults to the in-cluster server
+	Server string `json:"server,omitempty"`
+	// Namespaces (optional) restricts the discovery to the Cluster API clusters of the given namespaces
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Selector (optional) restricts the discovery to the downstream cluster objects matching the label selector
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+	// RancherURL is the URL of the Rancher server, which proxies the requests to the downstream clusters
+	RancherURL string `json:"rancherURL,omitempty"`
+	// RancherCredentialsSecret is the name of the secret holding the Rancher API token in the "token" key and,
+	// optionally, the Rancher CA certificate in the "caData" key
+	RancherCredentialsSecret string `json:"rancherCredentialsSecret,omitempty"`
+	// Template of the cluster secrets created for the downstream clusters
+	Template ClusterDiscoveryTemplate `json:"template,omitempty"`
+}
+
+// ClusterDiscoveryTemplate holds the Go templates of the fields of discovered clusters. The templates are rendered
+// with the name, namespace, provider, labels and annotations of the downstream cluster object.
+type ClusterDiscoveryTemplate struct {
+	// Name of the cluster, defaults to the name of the downstream cluster
+	Name string `json:"name,omitempty"`
+	// Project the cluster is assigned to
+	Project string `json:"project,omitempty"`
+	// Labels of the cluster secret
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations of the cluster secret
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
 const (
 	// settingServerSignatureKey designates the key for a server secret key inside a Kubernetes secret.
 	settingServerSignatureKey = "server.secretkey"
@@ -431,6 +572,8 @@ const (
 	settingDexConfigKey = "dex.config"
 	// settingsOIDCConfigKey designates the key for OIDC config
 	settingsOIDCConfigKey = "oidc.config"
+	// settingsAdditionalOIDCConfigsKey designates the key for the config of the additional OIDC providers
+	settingsAdditionalOIDCConfigsKey = "oidc.additionalConfigs"
 	// statusBadgeEnabledKey holds the key which enables of disables status badge feature
 	statusBadgeEnabledKey = "statusbadge.enabled"
 	// statusBadgeRootURLKey holds the key for the root badge URL override
@@ -449,6 +592,10 @@ const (
 	settingsWebhookAzureDevOpsUsernameKey = "webhook.azuredevops.username"
 	// settingsWebhookAzureDevOpsPasswordKey is the key for Azure DevOps webhook password
 	settingsWebhookAzureDevOpsPasswordKey = "webhook.azuredevops.password"
+	// settingsSCIMBearerTokenKey is the key for the bearer token of the SCIM provisioning requests
+	settingsSCIMBearerTokenKey = "scim.bearerToken"
+	// settingsSCIMIssuerKey is the key for the issuer of the tokens of the users provisioned through SCIM
+	settingsSCIMIssuerKey = "scim.issuer"
 	// settingsWebhookMaxPayloadSize is the key for the maximum payload size for webhooks in MB
 	settingsWebhookMaxPayloadSizeMB = "webhook.maxPayloadSizeMB"
 	// settingsApplicationInstanceLabelKey is the key to configure injected app instance label key
@@ -475,6 +622,8 @@ const (
 	resourceExcludeEventLabelKeys = "resource.excludeEventLabelKeys"
 	// kustomizeBuildOptionsKey is a string of kustomize build parameters
 	kustomizeBuildOptionsKey = "kustomize.buildOptions"
+	// kustomizeAllowedBuildOptionsKey is the list of kustomize build options which can be set by the application sources
+	kustomizeAllowedBuildOptionsKey = "kustomize.allowedBuildOptions"
 	// kustomizeVersionKeyPrefix is a kustomize version key prefix
 	kustomizeVersionKeyPrefix = "kustomize.version"
 	// kustomizePathPrefixKey is a kustomize path for a specific version
@@ -483,8 +632,18 @@ const (
 	anonymousUserEnabledKey = "users.anonymous.enabled"
 	// userSessionDurationKey is the key which specifies token expiration duration
 	userSessionDurationKey = "users.session.duration"
+	// accountTokenMaxTTLKey is the key which specifies the maximum TTL of the tokens of the local accounts
+	accountTokenMaxTTLKey = "users.tokens.maxTTL"
+	// projectTokenMaxTTLKey is the key which specifies the maximum TTL of the tokens of the project roles
+	projectTokenMaxTTLKey = "projects.tokens.maxTTL"
 	// diffOptions is the key where diff options are configured
 	resourceCompareOptionsKey = "resource.compareoptions"
+	// resourceIgnoreDifferencesKey is the key where the differences ignored by all applications are configured
+	resourceIgnoreDifferencesKey = "resource.ignoreDifferences"
+	// resourceSyncTimeoutsKey is the key where the sync timeouts of the resource kinds are configured
+	resourceSyncTimeoutsKey = "resource.syncTimeouts"
+	// applicationResourceQuotaKey is the key where the resource quota of all applications is configured
+	applicationResourceQuotaKey = "application.resourceQuota"
 	// settingUICSSURLKey designates the key for user-defined CSS URL for UI customization
 	settingUICSSURLKey = "ui.cssurl"
 	// settingUIBannerContentKey designates the key for content of user-defined info banner for UI
@@ -499,6 +658,8 @@ const (
 	settingsBinaryUrlsKey = "help.download"
 	// globalProjectsKey designates the key for global project settings
 	globalProjectsKey = "globalProjects"
+	// projectTemplatesKey designates the key for the templates of the projects created with `argocd proj create --from-template`
+	projectTemplatesKey = "projectTemplates"
 	// initialPasswordSecretName is the name of the secret that will hold the initial admin password
 	initialPasswordSecretName = "argocd-initial-admin-secret"
 	// initialPasswordSecretField is the name of the field in initialPasswordSecretName to store the password
@@ -538,6 +699,16 @@ const (
 	RespectRBACValueNormal = "normal"
 	// impersonationEnabledKey is the key to configure whether the application sync decoupling through impersonation feature is enabled
 	impersonationEnabledKey = "application.sync.impersonation.enabled"
+	// clusterAuthRotationIntervalKey is the key to configure how often the application controller rotates cluster bearer tokens
+	clusterAuthRotationIntervalKey = "cluster.auth.rotation.interval"
+	// clusterCAAutoUpdateEnabledKey is the key to configure whether the application controller updates the CA data of
+	// clusters added from kube-public when the CA data published in kube-public changes
+	clusterCAAutoUpdateEnabledKey = "cluster.ca.autoUpdate.enabled"
+	// clusterDiscoveryKey is the key to configure the management clusters whose downstream clusters are registered automatically
+	clusterDiscoveryKey = "cluster.discovery"
+	// clusterExternalCredentialsAllowedRefPrefixesKey is the key to configure the prefixes of the external secret manager
+	// references which clusters may use to resolve their credentials
+	clusterExternalCredentialsAllowedRefPrefixesKey = "cluster.externalCredentials.allowedRefPrefixes"
 )
 
 const (
@@ -552,6 +723,13 @@ var sourceTypeToEnableGenerationKey = map[v1alpha1.ApplicationSourceType]string{
 	v1alpha1.ApplicationSourceTypeKustomize: "kustomize.enable",
 	v1alpha1.ApplicationSourceTypeHelm:      "helm.enable",
 	v1alpha1.ApplicationSourceTypeDirectory: "jsonnet.enable",
+	v1alpha1.ApplicationSourceTypeCUE:       "cue.enable",
+}
+
+// sourceTypesDisabledByDefault are the source types whose manifest generation is only enabled by setting their key to
+// true, e.g. since their detection could change the type of existing directory applications
+var sourceTypesDisabledByDefault = map[v1alpha1.ApplicationSourceType]bool{
+	v1alpha1.ApplicationSourceTypeCUE: true,
 }
 
 // SettingsManager holds config info for a new manager with which to access Kubernetes ConfigMaps.
@@ -570,6 +748,16 @@ type SettingsManager struct {
 	reposCache            []Repository
 	repoCredsCache        []RepositoryCredentials
 	reposOrClusterChanged func()
+	// ignoreDifferencesCache holds the parsed resource.ignoreDifferences setting, which is read on every refresh check
+	// of the applications
+	ignoreDifferencesCache *ignoreDifferencesCacheEntry
+}
+
+// ignoreDifferencesCacheEntry is the resource.ignoreDifferences setting parsed from a version of the argocd-cm ConfigMap
+type ignoreDifferencesCacheEntry struct {
+	resourceVersion   string
+	ignoreDifferences []v1alpha1.ResourceIgnoreDifferences
+	err               error
 }
 
 type incompleteSettingsError struct {
@@ -898,7 +1086,7 @@ func (mgr *SettingsManager) GetEnabledSourceTypes() (map[string]bool, error) {
 	}
 	res := map[string]bool{}
 	for sourceType := range sourceTypeToEnableGenerationKey {
-		res[string(sourceType)] = true
+		res[string(sourceType)] = !sourceTypesDisabledByDefault[sourceType]
 	}
 	for sourceType, key := range sourceTypeToEnableGenerationKey {
 		if val, ok := argoCDCM.Data[key]; ok && val != "" {
@@ -958,6 +1146,18 @@ func (mgr *SettingsManager) GetIsIgnoreResourceUpdatesEnabled() (bool, error) {
 	return strconv.ParseBool(argoCDCM.Data[resourceIgnoreResourceUpdatesEnabledKey])
 }
 
+// GetHookLibrary loads the hooks of the hook library from the argocd-hook-library-cm ConfigMap, keyed by their name
+func (mgr *SettingsManager) GetHookLibrary() (map[string]string, error) {
+	hookLibraryCM, err := mgr.GetConfigMapByName(common.ArgoCDHookLibraryConfigMapName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return map[string]string{}, nil
+		}
+		return nil, fmt.Errorf("error retrieving config map %s: %w", common.ArgoCDHookLibraryConfigMapName, err)
+	}
+	return hookLibraryCM.Data, nil
+}
+
 // GetResourceOverrides loads Resource Overrides from argocd-cm ConfigMap
 func (mgr *SettingsManager) GetResourceOverrides() (map[string]v1alpha1.ResourceOverride, error) {
 	argoCDCM, err := mgr.getConfigMap()
@@ -1040,7 +1240,14 @@ func (mgr *SettingsManager) appendResourceOverridesFromSplitKeys(cmData map[stri
 			continue
 		}
 
-		overrideKey, err := convertToOverrideKey(parts[3])
+		customizationType := parts[2]
+		groupKind := parts[3]
+		// the health checks in CEL are suffixed with .cel, e.g. resource.customizations.health.cert-manager.io_Certificate.cel
+		healthCEL := false
+		if customizationType == "health" {
+			groupKind, healthCEL = strings.CutSuffix(groupKind, ".cel")
+		}
+		overrideKey, err := convertToOverrideKey(groupKind)
 		if err != nil {
 			return err
 		}
@@ -1054,10 +1261,13 @@ func (mgr *SettingsManager) appendResourceOverridesFromSplitKeys(cmData map[stri
 			overrideVal = v1alpha1.ResourceOverride{}
 		}
 
-		customizationType := parts[2]
 		switch customizationType {
 		case "health":
-			overrideVal.HealthLua = v
+			if healthCEL {
+				overrideVal.HealthCEL = v
+			} else {
+				overrideVal.HealthLua = v
+			}
 		case "useOpenLibs":
 			useOpenLibs, err := strconv.ParseBool(v)
 			if err != nil {
@@ -1132,6 +1342,105 @@ func (mgr *SettingsManager) GetResourceCompareOptions() (ArgoCDDiffOptions, erro
 	return diffOptions, nil
 }
 
+// GetResourceIgnoreDifferences loads from the ConfigMap the differences ignored by all applications, in addition to
+// the ones declared in the applications
+func (mgr *SettingsManager) GetResourceIgnoreDifferences() ([]v1alpha1.ResourceIgnoreDifferences, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving config map: %w", err)
+	}
+	mgr.mutex.Lock()
+	defer mgr.mutex.Unlock()
+	if cached := mgr.ignoreDifferencesCache; cached != nil && argoCDCM.ResourceVersion != "" && cached.resourceVersion == argoCDCM.ResourceVersion {
+		return slices.Clone(cached.ignoreDifferences), cached.err
+	}
+	ignoreDifferences, err := parseResourceIgnoreDifferences(argoCDCM)
+	mgr.ignoreDifferencesCache = &ignoreDifferencesCacheEntry{resourceVersion: argoCDCM.ResourceVersion, ignoreDifferences: ignoreDifferences, err: err}
+	return slices.Clone(ignoreDifferences), err
+}
+
+func parseResourceIgnoreDifferences(argoCDCM *corev1.ConfigMap) ([]v1alpha1.ResourceIgnoreDifferences, error) {
+	value, ok := argoCDCM.Data[resourceIgnoreDifferencesKey]
+	if !ok || value == "" {
+		return nil, nil
+	}
+	var ignoreDifferences []v1alpha1.ResourceIgnoreDifferences
+	if err := yaml.UnmarshalStrict([]byte(value), &ignoreDifferences); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s: %w", resourceIgnoreDifferencesKey, err)
+	}
+	for i, ignoreDifference := range ignoreDifferences {
+		if ignoreDifference.Kind == "" {
+			return nil, fmt.Errorf("invalid %s entry %d: kind is required", resourceIgnoreDifferencesKey, i)
+		}
+		if len(ignoreDifference.JSONPointers) == 0 && len(ignoreDifference.JQPathExpressions) == 0 && len(ignoreDifference.ManagedFieldsManagers) == 0 {
+			return nil, fmt.Errorf("invalid %s entry %d: one of jsonPointers, jqPathExpressions or managedFieldsManagers is required", resourceIgnoreDifferencesKey, i)
+		}
+	}
+	return ignoreDifferences, nil
+}
+
+// ResourceSyncTimeout is the sync timeout of the resources of a kind, used unless the resources hold the sync timeout
+// annotation
+type ResourceSyncTimeout struct {
+	// Group is the API group of the resources, empty for the core group
+	Group string `json:"group,omitempty"`
+	// Kind is the kind of the resources
+	Kind string `json:"kind"`
+	// Timeout is the duration the sync waits for the resources to become healthy once applied, e.g. 10m
+	Timeout string `json:"timeout"`
+	// Policy is the policy applied when the resources are not healthy at the end of the timeout, Fail (default) or Skip
+	Policy string `json:"policy,omitempty"`
+	// Retry is the retry strategy of the syncs failed by the resources which are not healthy at the end of the timeout,
+	// used instead of the retry strategy of the sync operation
+	Retry *v1alpha1.RetryStrategy `json:"retry,omitempty"`
+}
+
+// GetResourceSyncTimeouts loads from the ConfigMap the sync timeouts of the resource kinds
+func (mgr *SettingsManager) GetResourceSyncTimeouts() ([]ResourceSyncTimeout, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving config map: %w", err)
+	}
+	value, ok := argoCDCM.Data[resourceSyncTimeoutsKey]
+	if !ok || value == "" {
+		return nil, nil
+	}
+	var syncTimeouts []ResourceSyncTimeout
+	if err := yaml.UnmarshalStrict([]byte(value), &syncTimeouts); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s: %w", resourceSyncTimeoutsKey, err)
+	}
+	for i, syncTimeout := range syncTimeouts {
+		if syncTimeout.Kind == "" {
+			return nil, fmt.Errorf("invalid %s entry %d: kind is required", resourceSyncTimeoutsKey, i)
+		}
+		if _, err := time.ParseDuration(syncTimeout.Timeout); err != nil {
+			return nil, fmt.Errorf("invalid %s entry %d: invalid timeout %q: %w", resourceSyncTimeoutsKey, i, syncTimeout.Timeout, err)
+		}
+		if syncTimeout.Policy != "" && syncTimeout.Policy != common.SyncTimeoutPolicyFail && syncTimeout.Policy != common.SyncTimeoutPolicySkip {
+			return nil, fmt.Errorf("invalid %s entry %d: policy must be %s or %s", resourceSyncTimeoutsKey, i, common.SyncTimeoutPolicyFail, common.SyncTimeoutPolicySkip)
+		}
+	}
+	return syncTimeouts, nil
+}
+
+// GetApplicationResourceQuota loads from the ConfigMap the resource quota of all applications, which can be overridden
+// by the projects. Nil is returned if no quota is configured.
+func (mgr *SettingsManager) GetApplicationResourceQuota() (*v1alpha1.ApplicationResourceQuota, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving config map: %w", err)
+	}
+	value, ok := argoCDCM.Data[applicationResourceQuotaKey]
+	if !ok || value == "" {
+		return nil, nil
+	}
+	quota := &v1alpha1.ApplicationResourceQuota{}
+	if err := yaml.UnmarshalStrict([]byte(value), quota); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s: %w", applicationResourceQuotaKey, err)
+	}
+	return quota, nil
+}
+
 // GetHelmSettings returns helm settings
 func (mgr *SettingsManager) GetHelmSettings() (*v1alpha1.HelmOptions, error) {
 	argoCDCM, err := mgr.getConfigMap()
@@ -1166,6 +1475,10 @@ func (mgr *SettingsManager) GetKustomizeSettings() (*KustomizeSettings, error) {
 		settings.BuildOptions = options
 	}
 
+	if options, ok := argoCDCM.Data[kustomizeAllowedBuildOptionsKey]; ok {
+		settings.AllowedBuildOptions = strings.Fields(options)
+	}
+
 	// extract per-version binary paths and build options
 	for k, v := range argoCDCM.Data {
 		// extract version and path from kustomize.version.<version>
@@ -1277,6 +1590,7 @@ func (mgr *SettingsManager) invalidateCache() {
 
 	mgr.reposCache = nil
 	mgr.repoCredsCache = nil
+	mgr.ignoreDifferencesCache = nil
 }
 
 func (mgr *SettingsManager) initialize(ctx context.Context) error {
@@ -1400,6 +1714,7 @@ func getDownloadBinaryUrlsFromConfigMap(argoCDCM *corev1.ConfigMap) map[string]s
 func updateSettingsFromConfigMap(settings *ArgoCDSettings, argoCDCM *corev1.ConfigMap) {
 	settings.DexConfig = argoCDCM.Data[settingDexConfigKey]
 	settings.OIDCConfigRAW = argoCDCM.Data[settingsOIDCConfigKey]
+	settings.AdditionalOIDCConfigsRAW = argoCDCM.Data[settingsAdditionalOIDCConfigsKey]
 	settings.KustomizeBuildOptions = argoCDCM.Data[kustomizeBuildOptionsKey]
 	settings.StatusBadgeEnabled = argoCDCM.Data[statusBadgeEnabledKey] == "true"
 	settings.StatusBadgeRootUrl = argoCDCM.Data[statusBadgeRootURLKey]
@@ -1435,6 +1750,8 @@ func updateSettingsFromConfigMap(settings *ArgoCDSettings, argoCDCM *corev1.Conf
 			settings.UserSessionDuration = *val
 		}
 	}
+	settings.AccountTokenMaxTTL = parseTokenMaxTTL(argoCDCM.Data, accountTokenMaxTTLKey)
+	settings.ProjectTokenMaxTTL = parseTokenMaxTTL(argoCDCM.Data, projectTokenMaxTTLKey)
 	settings.PasswordPattern = argoCDCM.Data[settingsPasswordPatternKey]
 	if settings.PasswordPattern == "" {
 		settings.PasswordPattern = common.PasswordPatten
@@ -1543,10 +1860,42 @@ func (mgr *SettingsManager) updateSettingsFromSecret(settings *ArgoCDSettings, a
 	settings.WebhookGogsSecret = ReplaceStringSecret(string(argoCDSecret.Data[settingsWebhookGogsSecretKey]), settings.Secrets)
 	settings.WebhookAzureDevOpsUsername = ReplaceStringSecret(string(argoCDSecret.Data[settingsWebhookAzureDevOpsUsernameKey]), settings.Secrets)
 	settings.WebhookAzureDevOpsPassword = ReplaceStringSecret(string(argoCDSecret.Data[settingsWebhookAzureDevOpsPasswordKey]), settings.Secrets)
+	settings.SCIMBearerToken = ReplaceStringSecret(string(argoCDSecret.Data[settingsSCIMBearerTokenKey]), settings.Secrets)
+	settings.SCIMIssuer = string(argoCDSecret.Data[settingsSCIMIssuerKey])
 
 	return nil
 }
 
+// parseTokenMaxTTL parses the maximum TTL of the tokens given by the key, 0 if not set or invalid
+func parseTokenMaxTTL(data map[string]string, key string) time.Duration {
+	value, ok := data[key]
+	if !ok || value == "" {
+		return 0
+	}
+	maxTTL, err := timeutil.ParseDuration(value)
+	if err != nil {
+		log.Warnf("Failed to parse '%s' key: %v", key, err)
+		return 0
+	}
+	return *maxTTL
+}
+
+// GetTokenExpiresIn returns the expiration in seconds of a new token given the requested expiration and the maximum
+// TTL of the tokens. The tokens expire after the maximum TTL when no expiration is requested, and the expirations
+// exceeding the maximum TTL are rejected.
+func GetTokenExpiresIn(expiresIn int64, maxTTL time.Duration) (int64, error) {
+	if maxTTL <= 0 {
+		return expiresIn, nil
+	}
+	if expiresIn <= 0 {
+		return int64(maxTTL.Seconds()), nil
+	}
+	if time.Duration(expiresIn)*time.Second > maxTTL {
+		return 0, fmt.Errorf("token expiration %s exceeds the maximum TTL of the tokens %s", time.Duration(expiresIn)*time.Second, maxTTL)
+	}
+	return expiresIn, nil
+}
+
 // externalServerTLSCertificate will try and load a TLS certificate from an
 // external secret, instead of tls.crt and tls.key in argocd-secret. If both
 // return values are nil, no external secret has been configured.
@@ -1587,6 +1936,11 @@ func (mgr *SettingsManager) SaveSettings(settings *ArgoCDSettings) error {
 		} else {
 			delete(argoCDCM.Data, settingsOIDCConfigKey)
 		}
+		if settings.AdditionalOIDCConfigsRAW != "" {
+			argoCDCM.Data[settingsAdditionalOIDCConfigsKey] = settings.AdditionalOIDCConfigsRAW
+		} else {
+			delete(argoCDCM.Data, settingsAdditionalOIDCConfigsKey)
+		}
 		if settings.UiCssURL != "" {
 			argoCDCM.Data[settingUICSSURLKey] = settings.UiCssURL
 		}
@@ -1797,6 +2151,136 @@ func ValidateOIDCConfig(configStr string) error {
 	return err
 }
 
+func (a *ArgoCDSettings) additionalOIDCConfigs() []oidcConfig {
+	if a.AdditionalOIDCConfigsRAW == "" {
+		return nil
+	}
+	var configs []map[string]any
+	err := yaml.Unmarshal([]byte(a.AdditionalOIDCConfigsRAW), &configs)
+	if err != nil {
+		log.Warnf("invalid additional oidc configs: %v", err)
+		return nil
+	}
+	for i := range configs {
+		configs[i] = ReplaceMapSecrets(configs[i], a.Secrets)
+	}
+	data, err := yaml.Marshal(configs)
+	if err != nil {
+		log.Warnf("invalid additional oidc configs: %v", err)
+		return nil
+	}
+
+	result, err := unmarshalAdditionalOIDCConfigs(string(data))
+	if err != nil {
+		log.Warnf("invalid additional oidc configs: %v", err)
+		return nil
+	}
+	// The additional providers are disabled altogether if any of them is invalid, since a provider without a unique
+	// name or issuer could impersonate the users of another one
+	if err := validateAdditionalOIDCConfigs(result, a.IssuerURL()); err != nil {
+		log.Warnf("invalid additional oidc configs, the additional providers are disabled: %v", err)
+		return nil
+	}
+	return result
+}
+
+// AdditionalOIDCConfigs returns the configs of the OIDC providers the users can log in with in addition to the one
+// configured by oidc.config or dex.config
+func (a *ArgoCDSettings) AdditionalOIDCConfigs() []*OIDCConfig {
+	configs := a.additionalOIDCConfigs()
+	result := make([]*OIDCConfig, 0, len(configs))
+	for i := range configs {
+		result = append(result, configs[i].toExported())
+	}
+	return result
+}
+
+// AdditionalOIDCConfig returns the config of the additional OIDC provider with the given name, or nil if there is none
+func (a *ArgoCDSettings) AdditionalOIDCConfig(name string) *OIDCConfig {
+	for _, config := range a.AdditionalOIDCConfigs() {
+		if config.Name == name {
+			return config
+		}
+	}
+	return nil
+}
+
+func (a *ArgoCDSettings) additionalOIDCConfigForIssuer(issuer string) *oidcConfig {
+	if issuer == "" {
+		return nil
+	}
+	configs := a.additionalOIDCConfigs()
+	for i := range configs {
+		if configs[i].Issuer == issuer {
+			return &configs[i]
+		}
+	}
+	return nil
+}
+
+// AdditionalOIDCConfigForIssuer returns the config of the additional OIDC provider with the given issuer, or nil if the
+// issuer is not one of the additional providers
+func (a *ArgoCDSettings) AdditionalOIDCConfigForIssuer(issuer string) *OIDCConfig {
+	return a.additionalOIDCConfigForIssuer(issuer).toExported()
+}
+
+// GroupsClaim returns the claim holding the groups of the users in the tokens of the given issuer, or an empty string
+// if the groups are held by the groups claim
+func (a *ArgoCDSettings) GroupsClaim(issuer string) string {
+	if config := a.additionalOIDCConfigForIssuer(issuer); config != nil {
+		return config.GroupsClaim
+	}
+	if config := a.oidcConfig(); config != nil && config.Issuer == issuer {
+		return config.GroupsClaim
+	}
+	return ""
+}
+
+func unmarshalAdditionalOIDCConfigs(configStr string) ([]oidcConfig, error) {
+	var configs []oidcConfig
+	err := yaml.Unmarshal([]byte(configStr), &configs)
+	return configs, err
+}
+
+// ValidateAdditionalOIDCConfigs validates the configs of the additional OIDC providers, which must have a unique name
+// made of alphanumeric characters, dashes and underscores, a client ID and an issuer which is neither the one of
+// another provider nor the given issuer of oidc.config or Dex
+func ValidateAdditionalOIDCConfigs(configStr string, mainIssuer string) error {
+	configs, err := unmarshalAdditionalOIDCConfigs(configStr)
+	if err != nil {
+		return err
+	}
+	return validateAdditionalOIDCConfigs(configs, mainIssuer)
+}
+
+func validateAdditionalOIDCConfigs(configs []oidcConfig, mainIssuer string) error {
+	names := map[string]bool{}
+	issuers := map[string]bool{}
+	for i, config := range configs {
+		if !oidcProviderNameRegexp.MatchString(config.Name) {
+			return fmt.Errorf("provider %d: name %q must consist of alphanumeric characters, '-' or '_'", i, config.Name)
+		}
+		if names[config.Name] {
+			return fmt.Errorf("provider %s: duplicate name", config.Name)
+		}
+		names[config.Name] = true
+		if config.Issuer == "" {
+			return fmt.Errorf("provider %s: issuer is required", config.Name)
+		}
+		if issuers[config.Issuer] {
+			return fmt.Errorf("provider %s: duplicate issuer %s", config.Name, config.Issuer)
+		}
+		if mainIssuer != "" && config.Issuer == mainIssuer {
+			return fmt.Errorf("provider %s: issuer %s is the issuer of oidc.config or Dex", config.Name, config.Issuer)
+		}
+		issuers[config.Issuer] = true
+		if config.ClientID == "" {
+			return fmt.Errorf("provider %s: clientID is required", config.Name)
+		}
+	}
+	return nil
+}
+
 // TLSConfig returns a tls.Config with the configured certificates
 func (a *ArgoCDSettings) TLSConfig() *tls.Config {
 	if a.Certificate == nil {
@@ -1866,14 +2350,7 @@ func (a *ArgoCDSettings) OAuth2ClientID() string {
 // as the only allowed audience. When using the bundled Dex, that client ID is always "argo-cd".
 func (a *ArgoCDSettings) OAuth2AllowedAudiences() []string {
 	if config := a.oidcConfig(); config != nil {
-		if len(config.AllowedAudiences) == 0 {
-			allowedAudiences := []string{config.ClientID}
-			if config.CLIClientID != "" {
-				allowedAudiences = append(allowedAudiences, config.CLIClientID)
-			}
-			return allowedAudiences
-		}
-		return config.AllowedAudiences
+		return config.allowedAudiences()
 	}
 	if a.DexConfig != "" {
 		return []string{common.ArgoCDClientAppID, common.ArgoCDCLIClientAppID}
@@ -1881,6 +2358,24 @@ func (a *ArgoCDSettings) OAuth2AllowedAudiences() []string {
 	return nil
 }
 
+// OAuth2AllowedAudiencesForIssuer returns the audiences allowed for the tokens of the given issuer, which are the ones of
+// the additional OIDC provider with the issuer if there is one, or the ones returned by OAuth2AllowedAudiences otherwise.
+func (a *ArgoCDSettings) OAuth2AllowedAudiencesForIssuer(issuer string) []string {
+	if config := a.additionalOIDCConfigForIssuer(issuer); config != nil {
+		return config.allowedAudiences()
+	}
+	return a.OAuth2AllowedAudiences()
+}
+
+// SkipAudienceCheckWhenTokenHasNoAudienceForIssuer is the same as SkipAudienceCheckWhenTokenHasNoAudience for the
+// tokens of the given issuer
+func (a *ArgoCDSettings) SkipAudienceCheckWhenTokenHasNoAudienceForIssuer(issuer string) bool {
+	if config := a.additionalOIDCConfigForIssuer(issuer); config != nil {
+		return config.SkipAudienceCheckWhenTokenHasNoAudience != nil && *config.SkipAudienceCheckWhenTokenHasNoAudience
+	}
+	return a.SkipAudienceCheckWhenTokenHasNoAudience()
+}
+
 func (a *ArgoCDSettings) SkipAudienceCheckWhenTokenHasNoAudience() bool {
 	if config := a.oidcConfig(); config != nil {
 		if config.SkipAudienceCheckWhenTokenHasNoAudience != nil {
@@ -1917,16 +2412,7 @@ func (a *ArgoCDSettings) OIDCTLSConfig() *tls.Config {
 
 	oidcConfig := a.OIDCConfig()
 	if oidcConfig != nil {
-		tlsConfig = &tls.Config{}
-		if oidcConfig.RootCA != "" {
-			certPool := x509.NewCertPool()
-			ok := certPool.AppendCertsFromPEM([]byte(oidcConfig.RootCA))
-			if !ok {
-				log.Warn("failed to append certificates from PEM: proceeding without custom rootCA")
-			} else {
-				tlsConfig.RootCAs = certPool
-			}
-		}
+		tlsConfig = rootCATLSConfig(oidcConfig.RootCA)
 	} else {
 		tlsConfig = a.TLSConfig()
 	}
@@ -1936,6 +2422,30 @@ func (a *ArgoCDSettings) OIDCTLSConfig() *tls.Config {
 	return tlsConfig
 }
 
+// AdditionalOIDCTLSConfig returns the TLS config for the given additional OIDC provider, using the root CAs (if any)
+// specified in its config
+func (a *ArgoCDSettings) AdditionalOIDCTLSConfig(config *OIDCConfig) *tls.Config {
+	tlsConfig := rootCATLSConfig(config.RootCA)
+	if a.OIDCTLSInsecureSkipVerify {
+		tlsConfig.InsecureSkipVerify = true
+	}
+	return tlsConfig
+}
+
+func rootCATLSConfig(rootCA string) *tls.Config {
+	tlsConfig := &tls.Config{}
+	if rootCA != "" {
+		certPool := x509.NewCertPool()
+		ok := certPool.AppendCertsFromPEM([]byte(rootCA))
+		if !ok {
+			log.Warn("failed to append certificates from PEM: proceeding without custom rootCA")
+		} else {
+			tlsConfig.RootCAs = certPool
+		}
+	}
+	return tlsConfig
+}
+
 func appendURLPath(inputURL string, inputPath string) (string, error) {
 	u, err := url.Parse(inputURL)
 	if err != nil {
@@ -2183,7 +2693,9 @@ func ReplaceStringSecret(val string, secretValues map[string]string) string {
 	return strings.TrimSpace(secretVal)
 }
 
-// GetGlobalProjectsSettings loads the global project settings from argocd-cm ConfigMap
+// GetGlobalProjectsSettings loads the global project settings from argocd-cm ConfigMap. Unknown sections are ignored
+// with a warning, so that a single typo does not disable the other sections and global projects. A global project
+// whose sections are all unknown is ignored, since its sections restrict what the projects inherit.
 func (mgr *SettingsManager) GetGlobalProjectsSettings() ([]GlobalProjectSettings, error) {
 	argoCDCM, err := mgr.getConfigMap()
 	if err != nil {
@@ -2198,7 +2710,28 @@ func (mgr *SettingsManager) GetGlobalProjectsSettings() ([]GlobalProjectSettings
 			}
 		}
 	}
-	return globalProjectSettings, nil
+	validGlobalProjectSettings := make([]GlobalProjectSettings, 0, len(globalProjectSettings))
+	for _, gp := range globalProjectSettings {
+		if len(gp.Sections) == 0 {
+			validGlobalProjectSettings = append(validGlobalProjectSettings, gp)
+			continue
+		}
+		var sections []GlobalProjectSection
+		for _, section := range gp.Sections {
+			if !slices.Contains(globalProjectSections, section.Name) {
+				log.Warnf("Ignoring unknown section '%s' of global project '%s', must be one of: %s", section.Name, gp.ProjectName, strings.Join(globalProjectSections, ", "))
+				continue
+			}
+			sections = append(sections, section)
+		}
+		if len(sections) == 0 {
+			log.Warnf("Ignoring global project '%s' without any known section", gp.ProjectName)
+			continue
+		}
+		gp.Sections = sections
+		validGlobalProjectSettings = append(validGlobalProjectSettings, gp)
+	}
+	return validGlobalProjectSettings, nil
 }
 
 func (mgr *SettingsManager) GetNamespace() string {
@@ -2298,3 +2831,86 @@ func (mgr *SettingsManager) IsImpersonationEnabled() (bool, error) {
 	}
 	return cm.Data[impersonationEnabledKey] == "true", nil
 }
+
+// GetClusterAuthRotationInterval returns the interval at which the application controller rotates the service account
+// bearer tokens of managed clusters. A zero duration means automatic rotation is disabled.
+func (mgr *SettingsManager) GetClusterAuthRotationInterval() (time.Duration, error) {
+	cm, err := mgr.getConfigMap()
+	if err != nil {
+		return 0, fmt.Errorf("error checking %s property in configmap: %w", clusterAuthRotationIntervalKey, err)
+	}
+	value := cm.Data[clusterAuthRotationIntervalKey]
+	if value == "" {
+		return 0, nil
+	}
+	interval, err := timeutil.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse %s: %w", clusterAuthRotationIntervalKey, err)
+	}
+	return *interval, nil
+}
+
+// IsClusterCAAutoUpdateEnabled returns true if the application controller should update the CA data of clusters
+// added from kube-public when the CA data published in kube-public changes
+func (mgr *SettingsManager) IsClusterCAAutoUpdateEnabled() (bool, error) {
+	cm, err := mgr.getConfigMap()
+	if err != nil {
+		return false, fmt.Errorf("error checking %s property in configmap: %w", clusterCAAutoUpdateEnabledKey, err)
+	}
+	return cm.Data[clusterCAAutoUpdateEnabledKey] == "true", nil
+}
+
+// GetClusterExternalCredentialsAllowedRefPrefixes returns the prefixes of the external secret manager references which
+// clusters may use to resolve their credentials. No reference is allowed if unset.
+func (mgr *SettingsManager) GetClusterExternalCredentialsAllowedRefPrefixes() ([]string, error) {
+	cm, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error checking %s property in configmap: %w", clusterExternalCredentialsAllowedRefPrefixesKey, err)
+	}
+	var prefixes []string
+	if value, ok := cm.Data[clusterExternalCredentialsAllowedRefPrefixesKey]; ok {
+		if err := yaml.Unmarshal([]byte(value), &prefixes); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s: %w", clusterExternalCredentialsAllowedRefPrefixesKey, err)
+		}
+	}
+	for _, prefix := range prefixes {
+		if prefix == "" {
+			return nil, fmt.Errorf("%s: prefixes must not be empty", clusterExternalCredentialsAllowedRefPrefixesKey)
+		}
+	}
+	return prefixes, nil
+}
+
+// GetClusterDiscoverySources returns the management clusters whose downstream clusters are registered automatically
+func (mgr *SettingsManager) GetClusterDiscoverySources() ([]ClusterDiscoverySource, error) {
+	cm, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error checking %s property in configmap: %w", clusterDiscoveryKey, err)
+	}
+	var sources []ClusterDiscoverySource
+	if value, ok := cm.Data[clusterDiscoveryKey]; ok {
+		if err := yaml.Unmarshal([]byte(value), &sources); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s: %w", clusterDiscoveryKey, err)
+		}
+	}
+	names := map[string]bool{}
+	for _, source := range sources {
+		if source.Name == "" {
+			return nil, fmt.Errorf("%s: source name is required", clusterDiscoveryKey)
+		}
+		if names[source.Name] {
+			return nil, fmt.Errorf("%s: duplicate source name %q", clusterDiscoveryKey, source.Name)
+		}
+		names[source.Name] = true
+		switch source.Provider {
+		case ClusterDiscoveryProviderClusterAPI:
+		case ClusterDiscoveryProviderRancher:
+			if source.RancherURL == "" {
+				return nil, fmt.Errorf("%s: source %q requires rancherURL", clusterDiscoveryKey, source.Name)
+			}
+		default:
+			return nil, fmt.Errorf("%s: source %q has unknown provider %q", clusterDiscoveryKey, source.Name, source.Provider)
+		}
+	}
+	return sources, nil
+}
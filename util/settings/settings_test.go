@@ -83,6 +83,32 @@ func TestGetConfigMapByName(t *testing.T) {
 	})
 }
 
+func TestGetHookLibrary(t *testing.T) {
+	t.Run("no hook library", func(t *testing.T) {
+		_, settingsManager := fixtures(nil)
+		hooks, err := settingsManager.GetHookLibrary()
+		require.NoError(t, err)
+		assert.Empty(t, hooks)
+	})
+	t.Run("hook library", func(t *testing.T) {
+		kubeClient, settingsManager := fixtures(nil)
+		_, err := kubeClient.CoreV1().ConfigMaps("default").Create(t.Context(), &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      common.ArgoCDHookLibraryConfigMapName,
+				Namespace: "default",
+				Labels: map[string]string{
+					"app.kubernetes.io/part-of": "argocd",
+				},
+			},
+			Data: map[string]string{"db-migration": "kind: Job"},
+		}, metav1.CreateOptions{})
+		require.NoError(t, err)
+		hooks, err := settingsManager.GetHookLibrary()
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"db-migration": "kind: Job"}, hooks)
+	})
+}
+
 func TestGetSecretByName(t *testing.T) {
 	t.Run("data is never nil", func(t *testing.T) {
 		_, settingsManager := fixtures(nil, func(secret *corev1.Secret) { secret.Data = nil })
@@ -463,6 +489,7 @@ func TestGetResourceOverrides_with_splitted_keys(t *testing.T) {
 			"resource.customizations.actions.Deployment":                         "bar",
 			"resource.customizations.health.iam-manager.k8s.io_Iamrole":          "bar",
 			"resource.customizations.health.Iamrole":                             "bar",
+			"resource.customizations.health.Iamrole.cel":                         "baz",
 			"resource.customizations.ignoreDifferences.iam-manager.k8s.io_Iamrole": `jsonPointers:
         - bar`,
 			"resource.customizations.ignoreDifferences.apps_Deployment": `jqPathExpressions:
@@ -496,6 +523,7 @@ func TestGetResourceOverrides_with_splitted_keys(t *testing.T) {
 		assert.Equal(t, "bar", overrides["Deployment"].Actions)
 		assert.Equal(t, "bar", overrides["iam-manager.k8s.io/Iamrole"].HealthLua)
 		assert.Equal(t, "bar", overrides["Iamrole"].HealthLua)
+		assert.Equal(t, "baz", overrides["Iamrole"].HealthCEL)
 		assert.Len(t, overrides["iam-manager.k8s.io/Iamrole"].IgnoreDifferences.JSONPointers, 1)
 		assert.Len(t, overrides["apps/Deployment"].IgnoreDifferences.JQPathExpressions, 1)
 		assert.Equal(t, "bar", overrides["apps/Deployment"].IgnoreDifferences.JQPathExpressions[0])
@@ -593,6 +621,155 @@ func TestConvertToOverrideKey(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGetResourceSyncTimeouts(t *testing.T) {
+	t.Run("Unset", func(t *testing.T) {
+		_, settingsManager := fixtures(nil)
+		syncTimeouts, err := settingsManager.GetResourceSyncTimeouts()
+		require.NoError(t, err)
+		assert.Empty(t, syncTimeouts)
+	})
+	t.Run("Valid", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			"resource.syncTimeouts": `
+- kind: PersistentVolumeClaim
+  timeout: 10m
+  policy: Skip
+- group: batch
+  kind: Job
+  timeout: 1h
+  retry:
+    limit: 2
+    backoff:
+      duration: 1m
+`,
+		})
+		syncTimeouts, err := settingsManager.GetResourceSyncTimeouts()
+		require.NoError(t, err)
+		assert.Equal(t, []ResourceSyncTimeout{
+			{Kind: "PersistentVolumeClaim", Timeout: "10m", Policy: "Skip"},
+			{Group: "batch", Kind: "Job", Timeout: "1h", Retry: &v1alpha1.RetryStrategy{Limit: 2, Backoff: &v1alpha1.Backoff{Duration: "1m"}}},
+		}, syncTimeouts)
+	})
+	t.Run("MissingKind", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			"resource.syncTimeouts": "- timeout: 10m",
+		})
+		_, err := settingsManager.GetResourceSyncTimeouts()
+		require.EqualError(t, err, "invalid resource.syncTimeouts entry 0: kind is required")
+	})
+	t.Run("InvalidTimeout", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			"resource.syncTimeouts": "- kind: Job\n  timeout: 10",
+		})
+		_, err := settingsManager.GetResourceSyncTimeouts()
+		require.ErrorContains(t, err, `invalid resource.syncTimeouts entry 0: invalid timeout "10"`)
+	})
+	t.Run("InvalidPolicy", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			"resource.syncTimeouts": "- kind: Job\n  timeout: 10m\n  policy: Retry",
+		})
+		_, err := settingsManager.GetResourceSyncTimeouts()
+		require.EqualError(t, err, "invalid resource.syncTimeouts entry 0: policy must be Fail or Skip")
+	})
+}
+
+func TestGetResourceIgnoreDifferences(t *testing.T) {
+	t.Run("Unset", func(t *testing.T) {
+		_, settingsManager := fixtures(nil)
+		ignoreDifferences, err := settingsManager.GetResourceIgnoreDifferences()
+		require.NoError(t, err)
+		assert.Empty(t, ignoreDifferences)
+	})
+	t.Run("Valid", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			"resource.ignoreDifferences": `
+- group: apps
+  kind: Deployment
+  jsonPointers:
+  - /spec/replicas
+- group: "*"
+  kind: "*"
+  namespace: kube-system
+  managedFieldsManagers:
+  - kube-controller-manager
+`,
+		})
+		ignoreDifferences, err := settingsManager.GetResourceIgnoreDifferences()
+		require.NoError(t, err)
+		assert.Equal(t, []v1alpha1.ResourceIgnoreDifferences{
+			{Group: "apps", Kind: "Deployment", JSONPointers: []string{"/spec/replicas"}},
+			{Group: "*", Kind: "*", Namespace: "kube-system", ManagedFieldsManagers: []string{"kube-controller-manager"}},
+		}, ignoreDifferences)
+	})
+	t.Run("UnknownField", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			"resource.ignoreDifferences": "- kind: Deployment\n  jsonPointer: /spec/replicas",
+		})
+		_, err := settingsManager.GetResourceIgnoreDifferences()
+		require.ErrorContains(t, err, "failed to unmarshal resource.ignoreDifferences")
+	})
+	t.Run("MissingKind", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			"resource.ignoreDifferences": "- jsonPointers: [/spec/replicas]",
+		})
+		_, err := settingsManager.GetResourceIgnoreDifferences()
+		require.EqualError(t, err, "invalid resource.ignoreDifferences entry 0: kind is required")
+	})
+	t.Run("NothingIgnored", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			"resource.ignoreDifferences": "- kind: Deployment",
+		})
+		_, err := settingsManager.GetResourceIgnoreDifferences()
+		require.ErrorContains(t, err, "one of jsonPointers, jqPathExpressions or managedFieldsManagers is required")
+	})
+	t.Run("Cached", func(t *testing.T) {
+		kubeClient, settingsManager := fixtures(map[string]string{
+			"resource.ignoreDifferences": "- kind: Deployment\n  jsonPointers: [/spec/replicas]",
+		})
+		cm, err := kubeClient.CoreV1().ConfigMaps("default").Get(t.Context(), common.ArgoCDConfigMapName, metav1.GetOptions{})
+		require.NoError(t, err)
+		cm.ResourceVersion = "1"
+		_, err = kubeClient.CoreV1().ConfigMaps("default").Update(t.Context(), cm, metav1.UpdateOptions{})
+		require.NoError(t, err)
+		ignoreDifferences, err := settingsManager.GetResourceIgnoreDifferences()
+		require.NoError(t, err)
+		require.NotNil(t, settingsManager.ignoreDifferencesCache)
+		assert.Equal(t, "1", settingsManager.ignoreDifferencesCache.resourceVersion)
+
+		// the setting is not parsed again until the ConfigMap changes
+		settingsManager.ignoreDifferencesCache.ignoreDifferences = nil
+		cached, err := settingsManager.GetResourceIgnoreDifferences()
+		require.NoError(t, err)
+		assert.Empty(t, cached)
+
+		settingsManager.invalidateCache()
+		parsed, err := settingsManager.GetResourceIgnoreDifferences()
+		require.NoError(t, err)
+		assert.Equal(t, ignoreDifferences, parsed)
+	})
+}
+
+func TestGetApplicationResourceQuota(t *testing.T) {
+	_, settingsManager := fixtures(nil)
+	quota, err := settingsManager.GetApplicationResourceQuota()
+	require.NoError(t, err)
+	assert.Nil(t, quota)
+
+	_, settingsManager = fixtures(map[string]string{
+		"application.resourceQuota": "maxResources: 1000\nmaxManifestsSize: 10Mi",
+	})
+	quota, err = settingsManager.GetApplicationResourceQuota()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1000), quota.MaxResources)
+	assert.Equal(t, int64(10*1024*1024), quota.GetMaxManifestsSize())
+
+	_, settingsManager = fixtures(map[string]string{
+		"application.resourceQuota": "maxObjects: 1000",
+	})
+	_, err = settingsManager.GetApplicationResourceQuota()
+	require.ErrorContains(t, err, "failed to unmarshal application.resourceQuota")
+}
+
 func TestGetResourceCompareOptions(t *testing.T) {
 	// ignoreAggregatedRules is true
 	{
@@ -680,6 +857,18 @@ func TestSettingsManager_GetKustomizeBuildOptions(t *testing.T) {
 		assert.Equal(t, []KustomizeVersion{{Name: "v3.2.1", Path: "somePath"}}, options.Versions)
 	})
 
+	t.Run("AllowedBuildOptions", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			"kustomize.allowedBuildOptions": "--enable-helm --load-restrictor",
+		})
+
+		options, err := settingsManager.GetKustomizeSettings()
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"--enable-helm", "--load-restrictor"}, options.AllowedBuildOptions)
+		assert.Empty(t, options.Versions)
+	})
+
 	t.Run("Kustomize settings per-version", func(t *testing.T) {
 		_, settingsManager := fixtures(map[string]string{
 			"kustomize.buildOptions":        "--global true",
@@ -819,6 +1008,36 @@ func TestKustomizeSettings_GetOptions(t *testing.T) {
 		assert.Equal(t, "path_v3", ver.BinaryPath)
 		assert.Equal(t, "--opt2 val2", ver.BuildOptions)
 	})
+
+	t.Run("SourceBuildOptions", func(t *testing.T) {
+		ver, err := settings.GetOptions(v1alpha1.ApplicationSource{
+			Kustomize: &v1alpha1.ApplicationSourceKustomize{Version: "v3", BuildOptions: "--enable-helm"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "path_v3", ver.BinaryPath)
+		assert.Equal(t, "--enable-helm", ver.BuildOptions)
+	})
+
+	t.Run("SourceBuildOptionsNotAllowed", func(t *testing.T) {
+		_, err := settings.GetOptions(v1alpha1.ApplicationSource{
+			Kustomize: &v1alpha1.ApplicationSourceKustomize{BuildOptions: "--enable-helm --enable-exec"},
+		})
+		require.EqualError(t, err, "kustomize build option --enable-exec is not allowed, the allowed options are configured with kustomize.allowedBuildOptions")
+	})
+
+	t.Run("SourceBuildOptionsWithValues", func(t *testing.T) {
+		settings := KustomizeSettings{AllowedBuildOptions: []string{"--load-restrictor", "--enable-helm"}}
+		ver, err := settings.GetOptions(v1alpha1.ApplicationSource{
+			Kustomize: &v1alpha1.ApplicationSourceKustomize{BuildOptions: "--load-restrictor LoadRestrictionsNone --enable-helm"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "--load-restrictor LoadRestrictionsNone --enable-helm", ver.BuildOptions)
+
+		_, err = settings.GetOptions(v1alpha1.ApplicationSource{
+			Kustomize: &v1alpha1.ApplicationSourceKustomize{BuildOptions: "LoadRestrictionsNone"},
+		})
+		require.EqualError(t, err, `invalid kustomize build options "LoadRestrictionsNone"`)
+	})
 }
 
 func TestGetGoogleAnalytics(t *testing.T) {
@@ -974,6 +1193,57 @@ func TestSettingsManager_GetSettings(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, time.Hour*10, s.UserSessionDuration)
 	})
+	t.Run("TokenMaxTTLProvided", func(t *testing.T) {
+		kubeClient := fake.NewClientset(
+			&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      common.ArgoCDConfigMapName,
+					Namespace: "default",
+					Labels: map[string]string{
+						"app.kubernetes.io/part-of": "argocd",
+					},
+				},
+				Data: map[string]string{
+					"users.tokens.maxTTL":    "30d",
+					"projects.tokens.maxTTL": "invalid",
+				},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      common.ArgoCDSecretName,
+					Namespace: "default",
+					Labels: map[string]string{
+						"app.kubernetes.io/part-of": "argocd",
+					},
+				},
+				Data: map[string][]byte{
+					"server.secretkey": nil,
+				},
+			},
+		)
+		settingsManager := NewSettingsManager(t.Context(), kubeClient, "default")
+		s, err := settingsManager.GetSettings()
+		require.NoError(t, err)
+		assert.Equal(t, time.Hour*24*30, s.AccountTokenMaxTTL)
+		assert.Zero(t, s.ProjectTokenMaxTTL)
+	})
+}
+
+func TestGetTokenExpiresIn(t *testing.T) {
+	expiresIn, err := GetTokenExpiresIn(3600, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3600), expiresIn)
+
+	expiresIn, err = GetTokenExpiresIn(0, time.Hour*24)
+	require.NoError(t, err)
+	assert.Equal(t, int64(86400), expiresIn)
+
+	expiresIn, err = GetTokenExpiresIn(3600, time.Hour*24)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3600), expiresIn)
+
+	_, err = GetTokenExpiresIn(86401, time.Hour*24)
+	require.Error(t, err)
 }
 
 func TestGetOIDCConfig(t *testing.T) {
@@ -1016,6 +1286,94 @@ func TestGetOIDCConfig(t *testing.T) {
 	assert.True(t, claim.Essential)
 }
 
+func TestAdditionalOIDCConfigs(t *testing.T) {
+	settings := &ArgoCDSettings{
+		OIDCConfigRAW: `
+name: Corporate
+issuer: https://corporate.example.com
+clientID: corporate
+groupsClaim: roles`,
+		AdditionalOIDCConfigsRAW: `
+- name: customer
+  issuer: https://customer.example.com
+  clientID: customer
+  cliClientID: customer-cli
+  clientSecret: $oidc.customer.clientSecret
+  groupsClaim: realm_access.roles
+- name: partner
+  issuer: https://partner.example.com
+  clientID: partner
+  allowedAudiences: [partner-api]
+  skipAudienceCheckWhenTokenHasNoAudience: true`,
+		Secrets: map[string]string{"oidc.customer.clientSecret": "customer-secret"},
+	}
+
+	configs := settings.AdditionalOIDCConfigs()
+	require.Len(t, configs, 2)
+	assert.Equal(t, "customer-secret", configs[0].ClientSecret)
+	assert.Equal(t, "partner", settings.AdditionalOIDCConfig("partner").Name)
+	assert.Nil(t, settings.AdditionalOIDCConfig("unknown"))
+	assert.Equal(t, "customer", settings.AdditionalOIDCConfigForIssuer("https://customer.example.com").Name)
+	assert.Nil(t, settings.AdditionalOIDCConfigForIssuer("https://corporate.example.com"))
+
+	assert.Equal(t, "roles", settings.GroupsClaim("https://corporate.example.com"))
+	assert.Equal(t, "realm_access.roles", settings.GroupsClaim("https://customer.example.com"))
+	assert.Empty(t, settings.GroupsClaim("https://partner.example.com"))
+
+	assert.Equal(t, []string{"corporate"}, settings.OAuth2AllowedAudiencesForIssuer("https://corporate.example.com"))
+	assert.Equal(t, []string{"customer", "customer-cli"}, settings.OAuth2AllowedAudiencesForIssuer("https://customer.example.com"))
+	assert.Equal(t, []string{"partner-api"}, settings.OAuth2AllowedAudiencesForIssuer("https://partner.example.com"))
+	assert.False(t, settings.SkipAudienceCheckWhenTokenHasNoAudienceForIssuer("https://customer.example.com"))
+	assert.True(t, settings.SkipAudienceCheckWhenTokenHasNoAudienceForIssuer("https://partner.example.com"))
+}
+
+func TestAdditionalOIDCConfigsInvalid(t *testing.T) {
+	settings := &ArgoCDSettings{
+		OIDCConfigRAW: `
+name: Corporate
+issuer: https://corporate.example.com
+clientID: corporate`,
+		AdditionalOIDCConfigsRAW: `
+- name: customer
+  issuer: https://customer.example.com
+  clientID: customer
+- name: impostor
+  issuer: https://corporate.example.com
+  clientID: impostor`,
+	}
+
+	assert.Empty(t, settings.AdditionalOIDCConfigs())
+	assert.Nil(t, settings.AdditionalOIDCConfigForIssuer("https://customer.example.com"))
+	assert.Nil(t, settings.AdditionalOIDCConfigForIssuer("https://corporate.example.com"))
+}
+
+func TestValidateAdditionalOIDCConfigs(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+		errMsg string
+	}{
+		{name: "Valid", config: "- {name: customer, issuer: https://customer.example.com, clientID: customer}"},
+		{name: "Invalid YAML", config: "name: customer", errMsg: "cannot unmarshal"},
+		{name: "Invalid name", config: "- {name: customer.com, issuer: https://customer.example.com, clientID: customer}", errMsg: "must consist of alphanumeric characters"},
+		{name: "Duplicate name", config: "- {name: customer, issuer: https://a.example.com, clientID: a}\n- {name: customer, issuer: https://b.example.com, clientID: b}", errMsg: "duplicate name"},
+		{name: "Duplicate issuer", config: "- {name: a, issuer: https://a.example.com, clientID: a}\n- {name: b, issuer: https://a.example.com, clientID: b}", errMsg: "duplicate issuer"},
+		{name: "Missing issuer", config: "- {name: customer, clientID: customer}", errMsg: "issuer is required"},
+		{name: "Missing client ID", config: "- {name: customer, issuer: https://customer.example.com}", errMsg: "clientID is required"},
+		{name: "Issuer of oidc.config", config: "- {name: customer, issuer: https://corporate.example.com, clientID: customer}", errMsg: "is the issuer of oidc.config or Dex"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAdditionalOIDCConfigs(tt.config, "https://corporate.example.com")
+			if tt.errMsg != "" {
+				assert.ErrorContains(t, err, tt.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestRedirectURL(t *testing.T) {
 	cases := map[string][]string{
 		"https://localhost:4000":         {"https://localhost:4000/auth/callback", "https://localhost:4000/api/dex/callback"},
@@ -1379,6 +1737,16 @@ func TestGetEnableManifestGeneration(t *testing.T) {
 		enabled: true,
 		data:    map[string]string{"kustomize.enable": `true`},
 		source:  string(v1alpha1.ApplicationSourceTypeKustomize),
+	}, {
+		name:    "disabled by default",
+		enabled: false,
+		data:    map[string]string{},
+		source:  string(v1alpha1.ApplicationSourceTypeCUE),
+	}, {
+		name:    "enabled explicitly",
+		enabled: true,
+		data:    map[string]string{"cue.enable": `true`},
+		source:  string(v1alpha1.ApplicationSourceTypeCUE),
 	}}
 	for i := range testCases {
 		tc := testCases[i]
@@ -1864,3 +2232,144 @@ func TestSettingsManager_GetHideSecretAnnotations(t *testing.T) {
 		})
 	}
 }
+
+func TestSettingsManager_GetClusterAuthRotationInterval(t *testing.T) {
+	t.Run("not set", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{})
+		interval, err := settingsManager.GetClusterAuthRotationInterval()
+		require.NoError(t, err)
+		assert.Equal(t, time.Duration(0), interval)
+	})
+	t.Run("valid", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{"cluster.auth.rotation.interval": "720h"})
+		interval, err := settingsManager.GetClusterAuthRotationInterval()
+		require.NoError(t, err)
+		assert.Equal(t, 720*time.Hour, interval)
+	})
+	t.Run("invalid", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{"cluster.auth.rotation.interval": "monthly"})
+		_, err := settingsManager.GetClusterAuthRotationInterval()
+		require.ErrorContains(t, err, "cluster.auth.rotation.interval")
+	})
+}
+
+func TestSettingsManager_IsClusterCAAutoUpdateEnabled(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	enabled, err := settingsManager.IsClusterCAAutoUpdateEnabled()
+	require.NoError(t, err)
+	assert.False(t, enabled)
+
+	_, settingsManager = fixtures(map[string]string{"cluster.ca.autoUpdate.enabled": "true"})
+	enabled, err = settingsManager.IsClusterCAAutoUpdateEnabled()
+	require.NoError(t, err)
+	assert.True(t, enabled)
+}
+
+func TestSettingsManager_GetClusterDiscoverySources(t *testing.T) {
+	t.Run("not set", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{})
+		sources, err := settingsManager.GetClusterDiscoverySources()
+		require.NoError(t, err)
+		assert.Empty(t, sources)
+	})
+	t.Run("valid", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{"cluster.discovery": `
+- name: capi
+  provider: cluster-api
+  namespaces: [fleet]
+  template:
+    project: '{{ .namespace }}'
+    labels:
+      env: '{{ index .labels "env" }}'
+- name: rancher
+  provider: rancher
+  rancherURL: https://rancher.example.com
+  rancherCredentialsSecret: rancher-token
+`})
+		sources, err := settingsManager.GetClusterDiscoverySources()
+		require.NoError(t, err)
+		require.Len(t, sources, 2)
+		assert.Equal(t, ClusterDiscoveryProviderClusterAPI, sources[0].Provider)
+		assert.Equal(t, []string{"fleet"}, sources[0].Namespaces)
+		assert.Equal(t, "{{ .namespace }}", sources[0].Template.Project)
+		assert.Equal(t, map[string]string{"env": `{{ index .labels "env" }}`}, sources[0].Template.Labels)
+		assert.Equal(t, "https://rancher.example.com", sources[1].RancherURL)
+		assert.Equal(t, "rancher-token", sources[1].RancherCredentialsSecret)
+	})
+	t.Run("invalid", func(t *testing.T) {
+		for value, expectedErr := range map[string]string{
+			"- provider: cluster-api": "source name is required",
+			"- {name: a, provider: cluster-api}\n- {name: a, provider: cluster-api}": `duplicate source name "a"`,
+			"- {name: a, provider: openshift}":                                       `unknown provider "openshift"`,
+			"- {name: a, provider: rancher}":                                         "requires rancherURL",
+			"not a list":                                                             "failed to unmarshal cluster.discovery",
+		} {
+			_, settingsManager := fixtures(map[string]string{"cluster.discovery": value})
+			_, err := settingsManager.GetClusterDiscoverySources()
+			require.ErrorContains(t, err, expectedErr, value)
+		}
+	})
+}
+
+func TestSettingsManager_GetClusterExternalCredentialsAllowedRefPrefixes(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	prefixes, err := settingsManager.GetClusterExternalCredentialsAllowedRefPrefixes()
+	require.NoError(t, err)
+	assert.Empty(t, prefixes)
+
+	_, settingsManager = fixtures(map[string]string{"cluster.externalCredentials.allowedRefPrefixes": `
+- vault:secret/data/clusters/
+- arn:aws:secretsmanager:us-east-1:123456789012:secret:clusters/
+`})
+	prefixes, err = settingsManager.GetClusterExternalCredentialsAllowedRefPrefixes()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"vault:secret/data/clusters/", "arn:aws:secretsmanager:us-east-1:123456789012:secret:clusters/"}, prefixes)
+
+	_, settingsManager = fixtures(map[string]string{"cluster.externalCredentials.allowedRefPrefixes": `- ""`})
+	_, err = settingsManager.GetClusterExternalCredentialsAllowedRefPrefixes()
+	require.ErrorContains(t, err, "prefixes must not be empty")
+}
+
+func TestGetGlobalProjectsSettings(t *testing.T) {
+	t.Run("Sections", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			"globalProjects": `
+- projectName: global
+  sections:
+  - name: clusterResourceBlacklist
+    labelSelector:
+      matchLabels:
+        tier: prod
+`,
+		})
+		globalProjects, err := settingsManager.GetGlobalProjectsSettings()
+		require.NoError(t, err)
+		require.Len(t, globalProjects, 1)
+		assert.Equal(t, []GlobalProjectSection{{
+			Name:          GlobalProjectSectionClusterResourceBlacklist,
+			LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"tier": "prod"}},
+		}}, globalProjects[0].Sections)
+	})
+
+	t.Run("UnknownSection", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			"globalProjects": `
+- projectName: global
+  sections:
+  - name: roles
+  - name: syncWindows
+- projectName: other
+  sections:
+  - name: roles
+- projectName: all
+`,
+		})
+		globalProjects, err := settingsManager.GetGlobalProjectsSettings()
+		require.NoError(t, err)
+		require.Len(t, globalProjects, 2)
+		assert.Equal(t, "global", globalProjects[0].ProjectName)
+		assert.Equal(t, []GlobalProjectSection{{Name: GlobalProjectSectionSyncWindows}}, globalProjects[0].Sections)
+		assert.Equal(t, "all", globalProjects[1].ProjectName)
+		assert.Empty(t, globalProjects[1].Sections)
+	})
+}
@@ -248,25 +248,13 @@ func (a *ArgoCDWebhookHandler) affectedRevisionInfo(payloadIf any) (webURLs []st
 	// Bitbucket does not include a list of changed files anywhere in it's payload
 	// so we cannot update changedFiles for this type of payload
 	case bitbucketserver.RepositoryReferenceChangedPayload:
-
-		// Webhook module does not parse the inner links
-		if payload.Repository.Links != nil {
-			for _, l := range payload.Repository.Links["clone"].([]any) {
-				link := l.(map[string]any)
-				if link["name"] == "http" {
-					webURLs = append(webURLs, link["href"].(string))
-				}
-				if link["name"] == "ssh" {
-					webURLs = append(webURLs, link["href"].(string))
-				}
-			}
-		}
-
-		// TODO: bitbucket includes multiple changes as part of a single event.
-		// We only pick the first but need to consider how to handle multiple
-		for _, change := range payload.Changes {
-			revision = ParseRevision(change.Reference.ID)
-			break
+		// See: https://confluence.atlassian.com/bitbucketserver/event-payload-938025882.html#Eventpayload-Push
+		webURLs = append(webURLs, BitbucketServerCloneURLs(payload.Repository)...)
+		// HandleEvent splits the events with multiple changes, so there is at most one change here
+		for _, c := range payload.Changes {
+			revision = ParseRevision(c.Reference.ID)
+			change.shaBefore = c.FromHash
+			change.shaAfter = c.ToHash
 		}
 		// Not actually sure how to check if the incoming change affected HEAD just by examining the
 		// payload alone. To be safe, we just return true and let the controller check for himself.
@@ -275,6 +263,17 @@ func (a *ArgoCDWebhookHandler) affectedRevisionInfo(payloadIf any) (webURLs []st
 		// Bitbucket does not include a list of changed files anywhere in it's payload
 		// so we cannot update changedFiles for this type of payload
 
+	case bitbucketserver.PullRequestFromReferenceUpdatedPayload:
+		// See: https://confluence.atlassian.com/bitbucketserver/event-payload-938025882.html#Eventpayload-Sourcebranchupdated
+		// The source branch of a pull request was pushed to, which is handled like a push to that branch
+		fromRef := payload.PullRequest.FromRef
+		webURLs = append(webURLs, BitbucketServerCloneURLs(fromRef.Repository)...)
+		revision = ParseRevision(fromRef.ID)
+		change.shaBefore = payload.PreviousFromHash
+		change.shaAfter = fromRef.LatestCommit
+		// the source branch of a pull request is not expected to be the default branch of the repository
+		touchedHead = false
+
 	case gogsclient.PushPayload:
 		webURLs = append(webURLs, payload.Repo.HTMLURL)
 		revision = ParseRevision(payload.Ref)
@@ -297,6 +296,17 @@ type changeInfo struct {
 
 // HandleEvent handles webhook events for repo push events
 func (a *ArgoCDWebhookHandler) HandleEvent(payload any) {
+	// Bitbucket Server includes all the references pushed at once in a single event, which is handled as one
+	// event per reference
+	if refsChanged, ok := payload.(bitbucketserver.RepositoryReferenceChangedPayload); ok && len(refsChanged.Changes) > 1 {
+		for _, c := range refsChanged.Changes {
+			refChanged := refsChanged
+			refChanged.Changes = []bitbucketserver.RepositoryChange{c}
+			a.HandleEvent(refChanged)
+		}
+		return
+	}
+
 	webURLs, revision, change, touchedHead, changedFiles := a.affectedRevisionInfo(payload)
 	// NOTE: the webURL does not include the .git extension
 	if len(webURLs) == 0 {
@@ -391,6 +401,27 @@ func (a *ArgoCDWebhookHandler) HandleEvent(payload any) {
 	}
 }
 
+// BitbucketServerCloneURLs returns the http and ssh clone URLs of a Bitbucket Server repository, which the webhook
+// module does not parse from the links of the repository
+func BitbucketServerCloneURLs(repository bitbucketserver.Repository) []string {
+	var cloneURLs []string
+	links, _ := repository.Links["clone"].([]any)
+	for _, l := range links {
+		link, ok := l.(map[string]any)
+		if !ok {
+			continue
+		}
+		href, ok := link["href"].(string)
+		if !ok {
+			continue
+		}
+		if name := link["name"]; name == "http" || name == "ssh" {
+			cloneURLs = append(cloneURLs, href)
+		}
+	}
+	return cloneURLs
+}
+
 // GetWebURLRegex compiles a regex that will match any targetRevision referring to the same repo as
 // the given webURL. webURL is expected to be a URL from an SCM webhook payload pointing to the web
 // page for the repo.
@@ -403,7 +434,9 @@ func GetWebURLRegex(webURL string) (*regexp.Regexp, error) {
 	// 6. Required: `:` or `/`
 	// 7. Required: path parsed from `webURL`
 	// 8. Optional: `.git` extension
-	return getURLRegex(webURL, `(?i)^((https?|ssh)://)?(%[1]s@)?((alt)?ssh\.)?%[2]s(:\d+)?[:/]%[3]s(\.git)?$`)
+	// The clone URLs of some SCMs (e.g. Bitbucket Server) are sent instead of the web URL, so the extension is
+	// removed from the path to match the repository URLs with and without it.
+	return getURLRegex(strings.TrimSuffix(webURL, ".git"), `(?i)^((https?|ssh)://)?(%[1]s@)?((alt)?ssh\.)?%[2]s(:\d+)?[:/]%[3]s(\.git)?$`)
 }
 
 // GetAPIURLRegex compiles a regex that will match any targetRevision referring to the same repo as
@@ -635,7 +668,7 @@ func (a *ArgoCDWebhookHandler) Handler(w http.ResponseWriter, r *http.Request) {
 			log.WithField(common.SecurityField, common.SecurityHigh).Infof("BitBucket webhook UUID verification failed")
 		}
 	case r.Header.Get("X-Event-Key") != "":
-		payload, err = a.bitbucketserver.Parse(r, bitbucketserver.RepositoryReferenceChangedEvent, bitbucketserver.DiagnosticsPingEvent)
+		payload, err = a.bitbucketserver.Parse(r, bitbucketserver.RepositoryReferenceChangedEvent, bitbucketserver.PullRequestFromReferenceUpdatedEvent, bitbucketserver.DiagnosticsPingEvent)
 		if errors.Is(err, bitbucketserver.ErrHMACVerificationFailed) {
 			log.WithField(common.SecurityField, common.SecurityHigh).Infof("BitBucket webhook HMAC verification failed")
 		}
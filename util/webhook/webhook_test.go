@@ -3,6 +3,9 @@ package webhook
 import (
 	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -432,6 +435,88 @@ func TestBitbucketServerRepositoryReferenceChangedEvent(t *testing.T) {
 	hook.Reset()
 }
 
+func TestBitbucketServerRepositoryReferenceChangedEventWithMultipleChanges(t *testing.T) {
+	hook := test.NewGlobal()
+	h := NewMockHandler(nil, []string{})
+	req := httptest.NewRequest(http.MethodPost, "/api/webhook", nil)
+	req.Header.Set("X-Event-Key", "repo:refs_changed")
+	eventJSON, err := os.ReadFile("testdata/bitbucket-server-multiple-changes-event.json")
+	require.NoError(t, err)
+	req.Body = io.NopCloser(bytes.NewReader(eventJSON))
+	w := httptest.NewRecorder()
+	h.Handler(w, req)
+	close(h.queue)
+	h.Wait()
+	assert.Equal(t, http.StatusOK, w.Code)
+	var messages []string
+	for _, entry := range hook.AllEntries() {
+		messages = append(messages, entry.Message)
+	}
+	assert.Contains(t, messages, "Received push event repo: https://bitbucketserver/scm/myproject/test-repo.git, revision: master, touchedHead: true")
+	assert.Contains(t, messages, "Received push event repo: https://bitbucketserver/scm/myproject/test-repo.git, revision: v1.0.0, touchedHead: true")
+	hook.Reset()
+}
+
+func TestBitbucketServerPullRequestFromReferenceUpdatedEvent(t *testing.T) {
+	hook := test.NewGlobal()
+	h := NewMockHandler(nil, []string{})
+	req := httptest.NewRequest(http.MethodPost, "/api/webhook", nil)
+	req.Header.Set("X-Event-Key", "pr:from_ref_updated")
+	eventJSON, err := os.ReadFile("testdata/bitbucket-server-pr-from-ref-updated-event.json")
+	require.NoError(t, err)
+	req.Body = io.NopCloser(bytes.NewReader(eventJSON))
+	w := httptest.NewRecorder()
+	h.Handler(w, req)
+	close(h.queue)
+	h.Wait()
+	assert.Equal(t, http.StatusOK, w.Code)
+	expectedLogResult := "Received push event repo: https://bitbucketserver/scm/myproject/test-repo.git, revision: feature, touchedHead: false"
+	assert.Equal(t, expectedLogResult, hook.LastEntry().Message)
+	hook.Reset()
+}
+
+func TestBitbucketServerWebhookHMACVerification(t *testing.T) {
+	h := NewMockHandler(nil, []string{})
+	var err error
+	h.bitbucketserver, err = bitbucketserver.New(bitbucketserver.Options.Secret("secret"))
+	require.NoError(t, err)
+	eventJSON, err := os.ReadFile("testdata/bitbucket-server-event.json")
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/webhook", bytes.NewReader(eventJSON))
+	req.Header.Set("X-Event-Key", "repo:refs_changed")
+	req.Header.Set("X-Hub-Signature", "sha256=invalid")
+	w := httptest.NewRecorder()
+	h.Handler(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	_, err = mac.Write(eventJSON)
+	require.NoError(t, err)
+	req = httptest.NewRequest(http.MethodPost, "/api/webhook", bytes.NewReader(eventJSON))
+	req.Header.Set("X-Event-Key", "repo:refs_changed")
+	req.Header.Set("X-Hub-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	w = httptest.NewRecorder()
+	h.Handler(w, req)
+	close(h.queue)
+	h.Wait()
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestBitbucketServerCloneURLs(t *testing.T) {
+	repository := bitbucketserver.Repository{Links: map[string]any{
+		"clone": []any{
+			map[string]any{"name": "ssh", "href": "ssh://git@bitbucketserver:7999/myproject/test-repo.git"},
+			map[string]any{"name": "http", "href": "https://bitbucketserver/scm/myproject/test-repo.git"},
+			map[string]any{"name": "other", "href": "https://bitbucketserver/other"},
+			"invalid",
+		},
+	}}
+	assert.Equal(t, []string{"ssh://git@bitbucketserver:7999/myproject/test-repo.git", "https://bitbucketserver/scm/myproject/test-repo.git"}, BitbucketServerCloneURLs(repository))
+	assert.Empty(t, BitbucketServerCloneURLs(bitbucketserver.Repository{Links: map[string]any{"clone": "invalid"}}))
+	assert.Empty(t, BitbucketServerCloneURLs(bitbucketserver.Repository{}))
+}
+
 func TestBitbucketServerRepositoryDiagnosticPingEvent(t *testing.T) {
 	hook := test.NewGlobal()
 	h := NewMockHandler(nil, []string{})
@@ -727,6 +812,8 @@ func Test_GetWebURLRegex(t *testing.T) {
 		{true, "https://example.com/org/repo", "https://example.com/org/repo", "exact match should match"},
 		{false, "https://example.com/org/repo", "https://example.com/org/repo-2", "partial match should not match"},
 		{true, "https://example.com/org/repo", "https://example.com/org/repo.git", "no .git should match with .git"},
+		{true, "https://example.com/org/repo.git", "https://example.com/org/repo", "clone URL with .git should match without .git"},
+		{true, "ssh://git@example.com:7999/org/repo.git", "ssh://git@example.com:7999/org/repo.git", "clone URL with .git should match with .git"},
 		{true, "https://example.com/org/repo", "git@example.com:org/repo", "git without protocol should match"},
 		{true, "https://example.com/org/repo", "user@example.com:org/repo", "git with non-git username should match"},
 		{true, "https://example.com/org/repo", "ssh://git@example.com/org/repo", "git with protocol should match"},